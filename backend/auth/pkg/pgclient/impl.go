@@ -101,7 +101,7 @@ func (c *clientImpl) Do(ctx context.Context, fn func(context.Context) error) err
 func (c *clientImpl) DoWithIsoLvl(ctx context.Context, isoLvl TxIsoLevel, fn func(context.Context) error) error {
 	tx, ok := ctx.Value(c.txKey).(pgx.Tx)
 	if ok && tx != nil {
-		depth, ok := ctx.Value(c.txKey).(int)
+		depth, ok := ctx.Value(c.txDepthKey).(int)
 		if !ok {
 			depth = 0
 		}
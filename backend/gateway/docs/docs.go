@@ -218,6 +218,18 @@ const docTemplate = `{
                         "description": "Offset",
                         "name": "offset",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы из nextCursor, пустое значение - первая страница. При передаче offset игнорируется",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Посчитать общее количество в режиме курсора",
+                        "name": "with_total",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -278,7 +290,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/ledger/budgets/{id}": {
+        "/ledger/budgets/auto-rollover": {
             "get": {
                 "security": [
                     {
@@ -291,21 +303,12 @@ const docTemplate = `{
                 "tags": [
                     "ledger"
                 ],
-                "summary": "Get budget",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "Get budget auto rollover setting of account",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ledger.BudgetGetHandlerOutput"
+                            "$ref": "#/definitions/ledger.BudgetAutoRolloverGetHandlerOutput"
                         }
                     },
                     "400": {
@@ -316,28 +319,39 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "ledger"
                 ],
-                "summary": "Delete budget",
+                "summary": "Enable or disable copying of previous month budgets at month start",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "JSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ledger.BudgetAutoRolloverSetHandlerInput"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.BudgetAutoRolloverSetHandlerOutput"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -346,8 +360,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/ledger/budgets/copy": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -362,7 +378,7 @@ const docTemplate = `{
                 "tags": [
                     "ledger"
                 ],
-                "summary": "Patch budget",
+                "summary": "Copy budgets of month to range of months",
                 "parameters": [
                     {
                         "description": "JSON",
@@ -370,22 +386,15 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/ledger.BudgetPatchHandlerInput"
+                            "$ref": "#/definitions/ledger.BudgetCopyHandlerInput"
                         }
-                    },
-                    {
-                        "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ledger.BudgetPatchHandlerOutput"
+                            "$ref": "#/definitions/ledger.BudgetCopyHandlerOutput"
                         }
                     },
                     "400": {
@@ -397,20 +406,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/ledger/categories": {
+        "/ledger/budgets/{id}": {
             "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ledger"
                 ],
-                "summary": "List categories",
+                "summary": "Get budget",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ledger.CategoryListHandlerOutput"
+                            "$ref": "#/definitions/ledger.BudgetGetHandlerOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса для If-Match"
+                            }
                         }
                     },
                     "400": {
@@ -420,41 +449,101 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/ledger/reports": {
-            "get": {
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Delete budget",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag из GET, при несовпадении версии - 412",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    },
+                    "412": {
+                        "description": "Версия изменилась, в details.current - текущее состояние",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            },
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ledger"
                 ],
-                "summary": "Reports",
+                "summary": "Patch budget",
                 "parameters": [
+                    {
+                        "description": "JSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ledger.BudgetPatchHandlerInput"
+                        }
+                    },
                     {
                         "type": "string",
-                        "description": "Фильтр по дате ОТ в формате 2025-01-30 (год-месяц-день)",
-                        "name": "date_from",
-                        "in": "query"
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Фильтр по дате ДО в формате 2025-01-30 (год-месяц-день)",
-                        "name": "date_to",
-                        "in": "query"
+                        "description": "ETag из GET, при несовпадении версии - 412",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ledger.ReportListHandlerOutput"
+                            "$ref": "#/definitions/ledger.BudgetPatchHandlerOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия ресурса"
+                            }
                         }
                     },
                     "400": {
@@ -462,11 +551,17 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/middleware.ErrorJSON"
                         }
+                    },
+                    "412": {
+                        "description": "Версия изменилась, в details.current - текущее состояние",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
                     }
                 }
             }
         },
-        "/ledger/transactions": {
+        "/ledger/categories": {
             "get": {
                 "security": [
                     {
@@ -479,30 +574,12 @@ const docTemplate = `{
                 "tags": [
                     "ledger"
                 ],
-                "summary": "List transactions",
+                "summary": "List categories",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Фильтр по дате ОТ в формате 2025-01-30 (год-месяц-день)",
-                        "name": "date_from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Фильтр по дате ДО в формате 2025-01-30 (год-месяц-день)",
-                        "name": "date_to",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Offset",
-                        "name": "offset",
+                        "type": "boolean",
+                        "description": "Filter by archived flag",
+                        "name": "is_archived",
                         "in": "query"
                     }
                 ],
@@ -510,7 +587,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ledger.TransactionListHandlerOutput"
+                            "$ref": "#/definitions/ledger.CategoryListHandlerOutput"
                         }
                     },
                     "400": {
@@ -536,7 +613,7 @@ const docTemplate = `{
                 "tags": [
                     "ledger"
                 ],
-                "summary": "Add transaction",
+                "summary": "Add category",
                 "parameters": [
                     {
                         "description": "JSON",
@@ -544,7 +621,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/ledger.TransactionAddHandlerInput"
+                            "$ref": "#/definitions/ledger.CategoryAddHandlerInput"
                         }
                     }
                 ],
@@ -552,7 +629,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ledger.TransactionAddHandlerOutput"
+                            "$ref": "#/definitions/ledger.CategoryAddHandlerOutput"
                         }
                     },
                     "400": {
@@ -564,49 +641,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/ledger/transactions/export": {
-            "get": {
+        "/ledger/categories/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "produces": [
-                    "text/csv"
-                ],
+                "description": "If the category has transactions or budgets, reassign_to_id is required - they will be moved to that category",
                 "tags": [
                     "ledger"
                 ],
-                "summary": "Export list transactions",
+                "summary": "Delete category",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Фильтр по дате ОТ в формате 2025-01-30 (год-месяц-день)",
-                        "name": "date_from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Фильтр по дате ДО в формате 2025-01-30 (год-месяц-день)",
-                        "name": "date_to",
-                        "in": "query"
-                    },
                     {
                         "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "integer",
-                        "description": "Offset",
-                        "name": "offset",
+                        "description": "Category ID to move transactions and budgets to",
+                        "name": "reassign_to_id",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "CSV file"
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -615,18 +679,15 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/ledger/transactions/import": {
-            "post": {
+            },
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upload CSV file with transactions for import",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
@@ -634,28 +695,34 @@ const docTemplate = `{
                 "tags": [
                     "ledger"
                 ],
-                "summary": "Import transactions from CSV",
+                "summary": "Patch category",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "CSV file with transactions",
-                        "name": "file",
-                        "in": "formData",
+                        "description": "JSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ledger.CategoryPatchHandlerInput"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Import started / completed successfully"
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/middleware.ErrorJSON"
+                            "$ref": "#/definitions/ledger.CategoryPatchHandlerOutput"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/middleware.ErrorJSON"
                         }
@@ -663,34 +730,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/ledger/transactions/{id}": {
+        "/ledger/category-rules": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Rules are ordered by priority, the first matching rule sets the category",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ledger"
                 ],
-                "summary": "Get transaction",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "List category rules",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ledger.TransactionGetHandlerOutput"
+                            "$ref": "#/definitions/ledger.CategoryRuleListHandlerOutput"
                         }
                     },
                     "400": {
@@ -701,16 +760,143 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "tags": [
+                "description": "Sets the category of transactions created or imported without a category",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
                     "ledger"
                 ],
-                "summary": "Delete transaction",
+                "summary": "Add category rule",
+                "parameters": [
+                    {
+                        "description": "JSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ledger.CategoryRuleAddHandlerInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.CategoryRuleAddHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/category-rules/reapply": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Re-categorizes transactions of the period (at most 366 days) by the account rules.\nTransactions that match no rule keep their category, budget limits are not checked",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Reapply category rules",
+                "parameters": [
+                    {
+                        "description": "JSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ledger.CategoryRuleReapplyHandlerInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.CategoryRuleReapplyHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/category-rules/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Get category rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.CategoryRuleGetHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Delete category rule",
                 "parameters": [
                     {
                         "type": "string",
@@ -738,6 +924,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
+                "description": "Conditions are replaced as a whole",
                 "consumes": [
                     "application/json"
                 ],
@@ -747,7 +934,7 @@ const docTemplate = `{
                 "tags": [
                     "ledger"
                 ],
-                "summary": "Patch transaction",
+                "summary": "Patch category rule",
                 "parameters": [
                     {
                         "description": "JSON",
@@ -755,7 +942,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/ledger.TransactionPatchHandlerInput"
+                            "$ref": "#/definitions/ledger.CategoryRulePatchHandlerInput"
                         }
                     },
                     {
@@ -770,7 +957,129 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ledger.TransactionPatchHandlerOutput"
+                            "$ref": "#/definitions/ledger.CategoryRulePatchHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/currency": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Get base currency of account",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.CurrencyGetHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Set base currency of account",
+                "parameters": [
+                    {
+                        "description": "JSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ledger.CurrencySetHandlerInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.CurrencySetHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/exchange-rates": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "List exchange rates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Фильтр по валюте (в любом направлении)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Фильтр по дате курса ОТ в формате 2025-01-30 (год-месяц-день)",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Фильтр по дате курса ДО в формате 2025-01-30 (год-месяц-день)",
+                        "name": "date_to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.ExchangeRateListHandlerOutput"
                         }
                     },
                     "400": {
@@ -780,157 +1089,2936 @@ const docTemplate = `{
                         }
                     }
                 }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Create or update exchange rates",
+                "parameters": [
+                    {
+                        "description": "JSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ledger.ExchangeRateUpsertHandlerInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.ExchangeRateUpsertHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/import-profiles": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "List import profiles",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.ImportProfileListHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Column is a header name or 1-based column number",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Add import profile",
+                "parameters": [
+                    {
+                        "description": "JSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ledger.ImportProfileAddHandlerInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.ImportProfileAddHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/import-profiles/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Get import profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.ImportProfileGetHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Delete import profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mapping is replaced as a whole",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Patch import profile",
+                "parameters": [
+                    {
+                        "description": "JSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ledger.ImportProfilePatchHandlerInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.ImportProfilePatchHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/recurring-rules": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "List recurring rules",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Filter by paused flag",
+                        "name": "is_paused",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.RecurringRuleListHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Add recurring rule",
+                "parameters": [
+                    {
+                        "description": "JSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ledger.RecurringRuleAddHandlerInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.RecurringRuleAddHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/recurring-rules/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Get recurring rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.RecurringRuleGetHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Delete recurring rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Patch recurring rule",
+                "parameters": [
+                    {
+                        "description": "JSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ledger.RecurringRulePatchHandlerInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.RecurringRulePatchHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/reports": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Reports",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Фильтр по дате ОТ в формате 2025-01-30 (год-месяц-день)",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Фильтр по дате ДО в формате 2025-01-30 (год-месяц-день)",
+                        "name": "date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Валюта отчета, по умолчанию - базовая валюта аккаунта",
+                        "name": "currency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.ReportListHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/reports/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Reports by period and category with category titles and budget columns",
+                "produces": [
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+                    "application/jsonl"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Export reports",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Фильтр по дате ОТ в формате 2025-01-30 (год-месяц-день)",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Фильтр по дате ДО в формате 2025-01-30 (год-месяц-день)",
+                        "name": "date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Валюта отчета, по умолчанию - базовая валюта аккаунта",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "csv (default), xlsx or jsonl",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Export file"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/transactions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "List transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Фильтр по дате ОТ в формате 2025-01-30 (год-месяц-день)",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Фильтр по дате ДО в формате 2025-01-30 (год-месяц-день)",
+                        "name": "date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Фильтр по кошельку",
+                        "name": "wallet_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Фильтр по категориям через запятую, например 1,5",
+                        "name": "category_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Фильтр по типу: true - доходы, false - расходы",
+                        "name": "is_income",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Минимальная сумма по модулю включительно",
+                        "name": "amount_min",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Максимальная сумма по модулю включительно",
+                        "name": "amount_max",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Полнотекстовый поиск по описанию (русский и английский), синтаксис websearch: фраза в кавычках, -исключение, or",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Транзакции со всеми перечисленными тегами через запятую",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "occurred_on_desc",
+                            "occurred_on_asc",
+                            "amount_desc",
+                            "amount_asc"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы из nextCursor, пустое значение - первая страница. При передаче offset игнорируется",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Посчитать общее количество в режиме курсора",
+                        "name": "with_total",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.TransactionListHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Add transaction",
+                "parameters": [
+                    {
+                        "description": "JSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ledger.TransactionAddHandlerInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.TransactionAddHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/transactions/batch": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Add transactions batch",
+                "parameters": [
+                    {
+                        "description": "JSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ledger.TransactionAddBatchHandlerInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.TransactionAddBatchHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/transactions/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Streams the whole filtered transaction history as CSV.\nxlsx and jsonl files are built at once and limited to 10000 transactions",
+                "produces": [
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+                    "application/jsonl"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Export list transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Фильтр по дате ОТ в формате 2025-01-30 (год-месяц-день)",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Фильтр по дате ДО в формате 2025-01-30 (год-месяц-день)",
+                        "name": "date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Фильтр по кошельку",
+                        "name": "wallet_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "csv (default), xlsx or jsonl",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Export file"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/transactions/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload CSV, OFX 2.x, QIF or camt.053 file with transactions for import.\nFormat is detected from file content unless format is set.\nOFX, QIF and camt.053 have no categories: default_category_id or import profile default category is used.\ndry_run validates rows without saving, mode=partial imports valid rows and returns rejected ones",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Import transactions from bank statement",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Statement file with transactions",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "csv, ofx, qif or camt053, detected from content if empty",
+                        "name": "format",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Import profile ID, standard format is used if empty",
+                        "name": "import_profile_id",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Category for OFX, QIF and camt.053 operations",
+                        "name": "default_category_id",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate rows without saving",
+                        "name": "dry_run",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "strict (default) or partial",
+                        "name": "mode",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "skip (default), update or flag",
+                        "name": "duplicate_policy",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Date tolerance in days for duplicate matching, 0-31",
+                        "name": "match_tolerance_days",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.TransactionImportHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/transactions/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Get transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.TransactionGetHandlerOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса для If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Delete transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag из GET, при несовпадении версии - 412",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    },
+                    "412": {
+                        "description": "Версия изменилась, в details.current - текущее состояние",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Patch transaction",
+                "parameters": [
+                    {
+                        "description": "JSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ledger.TransactionPatchHandlerInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag из GET, при несовпадении версии - 412",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.TransactionPatchHandlerOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    },
+                    "412": {
+                        "description": "Версия изменилась, в details.current - текущее состояние",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/transfers": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Add transfer between wallets",
+                "parameters": [
+                    {
+                        "description": "JSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ledger.TransferAddHandlerInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.TransferAddHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/transfers/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Get transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.TransferGetHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Delete transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Patch transfer",
+                "parameters": [
+                    {
+                        "description": "JSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ledger.TransferPatchHandlerInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.TransferPatchHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/wallets": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "List wallets",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Filter by archived flag",
+                        "name": "is_archived",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.WalletListHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Add wallet",
+                "parameters": [
+                    {
+                        "description": "JSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ledger.WalletAddHandlerInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.WalletAddHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/wallets/balances": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Wallet balances",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID кошельков через запятую, по умолчанию - все кошельки",
+                        "name": "wallet_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Остаток на конец дня в формате 2025-01-30 (год-месяц-день), по умолчанию - текущий",
+                        "name": "date_to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.WalletBalancesHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/wallets/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Get wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.WalletGetHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Delete wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Patch wallet",
+                "parameters": [
+                    {
+                        "description": "JSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ledger.WalletPatchHandlerInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.WalletPatchHandlerOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/middleware.ErrorJSON"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "auth.AccountOutDTO": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "profileName": {
+                    "type": "string"
+                },
+                "profileSurname": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.LoginHandlerIn": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 0
+                }
+            }
+        },
+        "auth.LoginHandlerOut": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "$ref": "#/definitions/auth.AccountOutDTO"
+                },
+                "tokens": {
+                    "$ref": "#/definitions/auth.TokensOutDTO"
+                }
+            }
+        },
+        "auth.RefreshHandlerIn": {
+            "type": "object",
+            "properties": {
+                "refreshJWT": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.RegisterHandlerIn": {
+            "type": "object",
+            "required": [
+                "email",
+                "profileName",
+                "profileSurname"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 0
+                },
+                "profileName": {
+                    "type": "string"
+                },
+                "profileSurname": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.TokensOutDTO": {
+            "type": "object",
+            "properties": {
+                "accessJWT": {
+                    "type": "string"
+                },
+                "refreshJWT": {
+                    "type": "string"
+                }
+            }
+        },
+        "ledger.BudgetAddHandlerInput": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "carryOver": {
+                    "description": "перенос остатка бюджета прошлого месяца",
+                    "type": "boolean"
+                },
+                "categoryID": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "description": "по умолчанию - базовая валюта аккаунта",
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "enforcement": {
+                    "description": "по умолчанию - hard",
+                    "type": "string",
+                    "enum": [
+                        "hard",
+                        "soft",
+                        "off"
+                    ],
+                    "example": "soft"
+                },
+                "period": {
+                    "$ref": "#/definitions/ledger.BudgetAddHandlerInputPeriod"
+                },
+                "warningThresholds": {
+                    "description": "пороги предупреждений в процентах от бюджета",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        80,
+                        100
+                    ]
+                }
+            }
+        },
+        "ledger.BudgetAddHandlerInputPeriod": {
+            "type": "object",
+            "properties": {
+                "month": {
+                    "type": "integer"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "ledger.BudgetAddHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/ledger.BudgetOutput"
+                }
+            }
+        },
+        "ledger.BudgetAutoRolloverGetHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "ledger.BudgetAutoRolloverSetHandlerInput": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "ledger.BudgetAutoRolloverSetHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "ledger.BudgetCopyHandlerInput": {
+            "type": "object",
+            "required": [
+                "mode"
+            ],
+            "properties": {
+                "mode": {
+                    "description": "skip - существующие бюджеты не меняются, overwrite - перезаписываются",
+                    "type": "string",
+                    "enum": [
+                        "skip",
+                        "overwrite"
+                    ],
+                    "example": "skip"
+                },
+                "sourcePeriod": {
+                    "$ref": "#/definitions/ledger.BudgetAddHandlerInputPeriod"
+                },
+                "targetPeriodFrom": {
+                    "$ref": "#/definitions/ledger.BudgetAddHandlerInputPeriod"
+                },
+                "targetPeriodTo": {
+                    "$ref": "#/definitions/ledger.BudgetAddHandlerInputPeriod"
+                }
+            }
+        },
+        "ledger.BudgetCopyHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledger.BudgetOutput"
+                    }
+                },
+                "overwritten": {
+                    "type": "integer"
+                },
+                "skipped": {
+                    "type": "integer"
+                }
+            }
+        },
+        "ledger.BudgetGetHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "hitCache": {
+                    "type": "boolean"
+                },
+                "item": {
+                    "$ref": "#/definitions/ledger.BudgetOutput"
+                }
+            }
+        },
+        "ledger.BudgetListHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "hit_cache": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledger.BudgetOutput"
+                    }
+                },
+                "nextCursor": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "ledger.BudgetOutput": {
+            "type": "object",
+            "properties": {
+                "accountID": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string"
+                },
+                "carryOver": {
+                    "type": "boolean"
+                },
+                "categoryID": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "enforcement": {
+                    "type": "string",
+                    "example": "hard"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "period": {
+                    "$ref": "#/definitions/ledger.BudgetOutputPeriod"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version - версия для заголовка If-Match, совпадает с ETag",
+                    "type": "integer"
+                },
+                "warningThresholds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "ledger.BudgetOutputPeriod": {
+            "type": "object",
+            "properties": {
+                "month": {
+                    "type": "integer"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "ledger.BudgetPatchHandlerInput": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "carryOver": {
+                    "type": "boolean"
+                },
+                "categoryID": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "enforcement": {
+                    "type": "string",
+                    "enum": [
+                        "hard",
+                        "soft",
+                        "off"
+                    ],
+                    "example": "soft"
+                },
+                "period": {
+                    "$ref": "#/definitions/ledger.BudgetAddHandlerInputPeriod"
+                },
+                "warningThresholds": {
+                    "description": "пустой массив - удалить пороги",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        80,
+                        100
+                    ]
+                }
+            }
+        },
+        "ledger.BudgetPatchHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/ledger.BudgetOutput"
+                }
+            }
+        },
+        "ledger.BudgetWarningOutput": {
+            "type": "object",
+            "properties": {
+                "budgetID": {
+                    "type": "string"
+                },
+                "categoryID": {
+                    "type": "integer"
+                },
+                "enforcement": {
+                    "type": "string",
+                    "example": "soft"
+                },
+                "exceeded": {
+                    "type": "boolean"
+                },
+                "period": {
+                    "$ref": "#/definitions/ledger.BudgetOutputPeriod"
+                },
+                "spentPercent": {
+                    "type": "string",
+                    "example": "85.5"
+                },
+                "threshold": {
+                    "description": "наибольший достигнутый порог, 0 - пороги не достигнуты",
+                    "type": "integer",
+                    "example": 80
+                }
+            }
+        },
+        "ledger.CategoryAddHandlerInput": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "parentID": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "ledger.CategoryAddHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/ledger.CategoryOutput"
+                }
+            }
+        },
+        "ledger.CategoryListHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledger.CategoryOutput"
+                    }
+                }
+            }
+        },
+        "ledger.CategoryOutput": {
+            "type": "object",
+            "properties": {
+                "accountID": {
+                    "type": "string"
+                },
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledger.CategoryOutput"
+                    }
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isArchived": {
+                    "type": "boolean"
+                },
+                "parentID": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "ledger.CategoryPatchHandlerInput": {
+            "type": "object",
+            "properties": {
+                "isArchived": {
+                    "type": "boolean"
+                },
+                "parentID": {
+                    "description": "0 - перенести категорию в корень",
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "ledger.CategoryPatchHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/ledger.CategoryOutput"
+                }
+            }
+        },
+        "ledger.CategoryRuleAddHandlerInput": {
+            "type": "object",
+            "required": [
+                "categoryID",
+                "name"
+            ],
+            "properties": {
+                "categoryID": {
+                    "type": "integer"
+                },
+                "conditions": {
+                    "$ref": "#/definitions/ledger.CategoryRuleConditionsDTO"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "description": "правила проверяются по возрастанию приоритета",
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "ledger.CategoryRuleAddHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/ledger.CategoryRuleOutput"
+                }
+            }
+        },
+        "ledger.CategoryRuleChangeOutput": {
+            "type": "object",
+            "properties": {
+                "newCategoryID": {
+                    "type": "integer"
+                },
+                "oldCategoryID": {
+                    "type": "integer"
+                },
+                "ruleID": {
+                    "type": "string"
+                },
+                "transactionID": {
+                    "type": "string"
+                }
+            }
+        },
+        "ledger.CategoryRuleConditionsDTO": {
+            "type": "object",
+            "properties": {
+                "amountMax": {
+                    "type": "string",
+                    "example": "5000"
+                },
+                "amountMin": {
+                    "description": "AmountMin, AmountMax - границы суммы по модулю включительно",
+                    "type": "string",
+                    "example": "100"
+                },
+                "descriptionContains": {
+                    "description": "DescriptionContains - подстрока описания без учета регистра",
+                    "type": "string",
+                    "example": "яндекс такси"
+                },
+                "descriptionRegex": {
+                    "description": "DescriptionRegex - регулярное выражение RE2",
+                    "type": "string",
+                    "example": "(?i)^(pyaterochka|magnit)"
+                },
+                "isIncome": {
+                    "type": "boolean"
+                },
+                "walletID": {
+                    "type": "string"
+                }
+            }
+        },
+        "ledger.CategoryRuleGetHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/ledger.CategoryRuleOutput"
+                }
+            }
+        },
+        "ledger.CategoryRuleListHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledger.CategoryRuleOutput"
+                    }
+                }
+            }
+        },
+        "ledger.CategoryRuleOutput": {
+            "type": "object",
+            "properties": {
+                "accountID": {
+                    "type": "string"
+                },
+                "categoryID": {
+                    "type": "integer"
+                },
+                "conditions": {
+                    "$ref": "#/definitions/ledger.CategoryRuleConditionsDTO"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "ledger.CategoryRulePatchHandlerInput": {
+            "type": "object",
+            "properties": {
+                "categoryID": {
+                    "type": "integer"
+                },
+                "conditions": {
+                    "description": "Conditions - заменяются целиком",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ledger.CategoryRuleConditionsDTO"
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "ledger.CategoryRulePatchHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/ledger.CategoryRuleOutput"
+                }
+            }
+        },
+        "ledger.CategoryRuleReapplyHandlerInput": {
+            "type": "object",
+            "properties": {
+                "dateFrom": {
+                    "type": "string",
+                    "example": "2025-12-01"
+                },
+                "dateTo": {
+                    "type": "string",
+                    "example": "2025-12-31"
+                },
+                "dryRun": {
+                    "description": "DryRun - изменения рассчитываются без сохранения",
+                    "type": "boolean"
+                }
+            }
+        },
+        "ledger.CategoryRuleReapplyHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledger.CategoryRuleChangeOutput"
+                    }
+                },
+                "checked": {
+                    "description": "Checked - количество проверенных транзакций без учета переводов",
+                    "type": "integer"
+                },
+                "dryRun": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "ledger.CurrencyGetHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "RUB"
+                }
+            }
+        },
+        "ledger.CurrencySetHandlerInput": {
+            "type": "object",
+            "required": [
+                "currency"
+            ],
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "RUB"
+                }
+            }
+        },
+        "ledger.CurrencySetHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "RUB"
+                }
+            }
+        },
+        "ledger.ExchangeRateListHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledger.ExchangeRateOutput"
+                    }
+                }
+            }
+        },
+        "ledger.ExchangeRateOutput": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "currencyFrom": {
+                    "type": "string"
+                },
+                "currencyTo": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "string"
+                },
+                "rateDate": {
+                    "type": "string",
+                    "example": "2025-12-20"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "ledger.ExchangeRateUpsertHandlerInput": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/ledger.ExchangeRateUpsertHandlerInputItem"
+                    }
+                }
+            }
+        },
+        "ledger.ExchangeRateUpsertHandlerInputItem": {
+            "type": "object",
+            "required": [
+                "currencyFrom",
+                "currencyTo",
+                "rate"
+            ],
+            "properties": {
+                "currencyFrom": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "currencyTo": {
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "rate": {
+                    "description": "Rate - сколько единиц currencyTo за одну единицу currencyFrom",
+                    "type": "string",
+                    "example": "90.5"
+                },
+                "rateDate": {
+                    "type": "string",
+                    "example": "2025-12-20"
+                }
+            }
+        },
+        "ledger.ExchangeRateUpsertHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledger.ExchangeRateOutput"
+                    }
+                }
+            }
+        },
+        "ledger.ImportProfileAddHandlerInput": {
+            "type": "object",
+            "required": [
+                "dateLayout",
+                "decimalSeparator",
+                "delimiter",
+                "name"
+            ],
+            "properties": {
+                "dateLayout": {
+                    "type": "string",
+                    "example": "DD.MM.YYYY"
+                },
+                "decimalSeparator": {
+                    "type": "string",
+                    "example": ","
+                },
+                "delimiter": {
+                    "type": "string",
+                    "example": ";"
+                },
+                "encoding": {
+                    "type": "string",
+                    "example": "windows-1251"
+                },
+                "mapping": {
+                    "$ref": "#/definitions/ledger.ImportProfileMappingDTO"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "skipRows": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "ledger.ImportProfileAddHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/ledger.ImportProfileOutput"
+                }
+            }
+        },
+        "ledger.ImportProfileColumnsDTO": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "Сумма"
+                },
+                "categoryID": {
+                    "type": "string"
+                },
+                "credit": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "Дата операции"
+                },
+                "debit": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "3"
+                },
+                "externalID": {
+                    "type": "string"
+                },
+                "isIncome": {
+                    "type": "string"
+                }
+            }
+        },
+        "ledger.ImportProfileGetHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/ledger.ImportProfileOutput"
+                }
+            }
+        },
+        "ledger.ImportProfileListHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledger.ImportProfileOutput"
+                    }
+                }
+            }
+        },
+        "ledger.ImportProfileMappingDTO": {
+            "type": "object",
+            "required": [
+                "signConvention"
+            ],
+            "properties": {
+                "columns": {
+                    "$ref": "#/definitions/ledger.ImportProfileColumnsDTO"
+                },
+                "defaultCategoryID": {
+                    "type": "integer"
+                },
+                "hasHeader": {
+                    "type": "boolean"
+                },
+                "incomeValues": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "signConvention": {
+                    "type": "string",
+                    "enum": [
+                        "signed",
+                        "inverted",
+                        "split",
+                        "flag"
+                    ],
+                    "example": "signed"
+                }
+            }
+        },
+        "ledger.ImportProfileOutput": {
+            "type": "object",
+            "properties": {
+                "accountID": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "dateLayout": {
+                    "type": "string"
+                },
+                "decimalSeparator": {
+                    "type": "string"
+                },
+                "delimiter": {
+                    "type": "string"
+                },
+                "encoding": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "mapping": {
+                    "$ref": "#/definitions/ledger.ImportProfileMappingDTO"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "skipRows": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "ledger.ImportProfilePatchHandlerInput": {
+            "type": "object",
+            "properties": {
+                "dateLayout": {
+                    "type": "string"
+                },
+                "decimalSeparator": {
+                    "type": "string"
+                },
+                "delimiter": {
+                    "type": "string"
+                },
+                "encoding": {
+                    "type": "string"
+                },
+                "mapping": {
+                    "description": "Mapping - заменяется целиком",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ledger.ImportProfileMappingDTO"
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string"
+                },
+                "skipRows": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "ledger.ImportProfilePatchHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/ledger.ImportProfileOutput"
+                }
+            }
+        },
+        "ledger.RecurringRuleAddHandlerInput": {
+            "type": "object",
+            "required": [
+                "frequency"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "categoryID": {
+                    "type": "integer"
+                },
+                "count": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "currency": {
+                    "description": "по умолчанию - валюта кошелька, либо базовая валюта аккаунта",
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "dayOfMonth": {
+                    "description": "DayOfMonth - только для monthly, по умолчанию - день даты начала",
+                    "type": "integer",
+                    "maximum": 31,
+                    "minimum": 0
+                },
+                "description": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string",
+                    "example": "2026-12-20"
+                },
+                "frequency": {
+                    "type": "string",
+                    "enum": [
+                        "weekly",
+                        "monthly",
+                        "yearly"
+                    ],
+                    "example": "monthly"
+                },
+                "interval": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "isIncome": {
+                    "type": "boolean"
+                },
+                "startDate": {
+                    "type": "string",
+                    "example": "2025-12-20"
+                },
+                "walletID": {
+                    "type": "string"
+                }
+            }
+        },
+        "ledger.RecurringRuleAddHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/ledger.RecurringRuleOutput"
+                }
+            }
+        },
+        "ledger.RecurringRuleGetHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/ledger.RecurringRuleOutput"
+                }
+            }
+        },
+        "ledger.RecurringRuleListHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledger.RecurringRuleOutput"
+                    }
+                }
             }
-        }
-    },
-    "definitions": {
-        "auth.AccountOutDTO": {
+        },
+        "ledger.RecurringRuleOutput": {
             "type": "object",
             "properties": {
-                "email": {
+                "accountID": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string"
+                },
+                "categoryID": {
+                    "type": "integer"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "dayOfMonth": {
+                    "type": "integer"
+                },
+                "description": {
                     "type": "string"
                 },
+                "endDate": {
+                    "type": "string",
+                    "example": "2026-12-20"
+                },
+                "frequency": {
+                    "type": "string",
+                    "example": "monthly"
+                },
                 "id": {
                     "type": "string"
                 },
-                "profileName": {
+                "interval": {
+                    "type": "integer"
+                },
+                "isIncome": {
+                    "type": "boolean"
+                },
+                "isPaused": {
+                    "type": "boolean"
+                },
+                "lastError": {
                     "type": "string"
                 },
-                "profileSurname": {
+                "nextOccurrenceOn": {
+                    "type": "string",
+                    "example": "2026-01-20"
+                },
+                "occurrencesCount": {
+                    "type": "integer"
+                },
+                "startDate": {
+                    "type": "string",
+                    "example": "2025-12-20"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "walletID": {
                     "type": "string"
                 }
             }
         },
-        "auth.LoginHandlerIn": {
+        "ledger.RecurringRulePatchHandlerInput": {
             "type": "object",
-            "required": [
-                "email"
-            ],
             "properties": {
-                "email": {
+                "amount": {
                     "type": "string"
                 },
-                "password": {
+                "categoryID": {
+                    "type": "integer"
+                },
+                "count": {
+                    "description": "0 снимает ограничение",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "currency": {
                     "type": "string",
-                    "minLength": 0
+                    "example": "RUB"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "description": "пустая строка снимает ограничение",
+                    "type": "string",
+                    "example": "2026-12-20"
+                },
+                "isPaused": {
+                    "type": "boolean"
+                },
+                "walletID": {
+                    "description": "пустая строка - отвязать от кошелька",
+                    "type": "string"
                 }
             }
         },
-        "auth.LoginHandlerOut": {
+        "ledger.RecurringRulePatchHandlerOutput": {
             "type": "object",
             "properties": {
-                "accout": {
-                    "$ref": "#/definitions/auth.AccountOutDTO"
-                },
-                "tokens": {
-                    "$ref": "#/definitions/auth.TokensOutDTO"
+                "item": {
+                    "$ref": "#/definitions/ledger.RecurringRuleOutput"
                 }
             }
         },
-        "auth.RefreshHandlerIn": {
+        "ledger.ReportListHandlerOutput": {
             "type": "object",
             "properties": {
-                "refreshJWT": {
+                "currency": {
                     "type": "string"
+                },
+                "hitCache": {
+                    "type": "boolean"
+                },
+                "reports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledger.ReportOutput"
+                    }
                 }
             }
         },
-        "auth.RegisterHandlerIn": {
+        "ledger.ReportOutput": {
             "type": "object",
-            "required": [
-                "email",
-                "profileName",
-                "profileSurname"
-            ],
             "properties": {
-                "email": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledger.ReportOutputItem"
+                    }
                 },
-                "password": {
+                "missingRates": {
+                    "description": "MissingRates - недостающие курсы, суммы и бюджеты в этих валютах не вошли в отчет",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "exchange rate USD/RUB not found"
+                    ]
+                },
+                "periodEnd": {
                     "type": "string",
-                    "minLength": 0
+                    "example": "2025-12-20"
                 },
-                "profileName": {
+                "periodStart": {
+                    "type": "string",
+                    "example": "2025-01-01"
+                }
+            }
+        },
+        "ledger.ReportOutputItem": {
+            "type": "object",
+            "properties": {
+                "carriedOver": {
                     "type": "string"
                 },
-                "profileSurname": {
+                "categoryID": {
+                    "type": "integer"
+                },
+                "effectiveBudget": {
+                    "type": "string"
+                },
+                "itemBudget": {
+                    "type": "string"
+                },
+                "parentCategoryID": {
+                    "type": "integer"
+                },
+                "spentBudget": {
+                    "type": "string"
+                },
+                "sum": {
+                    "type": "string"
+                },
+                "totalBudget": {
+                    "type": "string"
+                },
+                "totalSpentBudget": {
+                    "type": "string"
+                },
+                "totalSum": {
                     "type": "string"
                 }
             }
         },
-        "auth.TokensOutDTO": {
+        "ledger.TransactionAddBatchHandlerInput": {
             "type": "object",
+            "required": [
+                "items"
+            ],
             "properties": {
-                "accessJWT": {
-                    "type": "string"
+                "items": {
+                    "description": "создаются атомарно, при ошибке индекс элемента возвращается в hints как items[N]",
+                    "type": "array",
+                    "maxItems": 50000,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/ledger.TransactionAddHandlerInput"
+                    }
+                }
+            }
+        },
+        "ledger.TransactionAddBatchHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "description": "в порядке items",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "refreshJWT": {
-                    "type": "string"
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledger.BudgetWarningOutput"
+                    }
                 }
             }
         },
-        "ledger.BudgetAddHandlerInput": {
+        "ledger.TransactionAddHandlerInput": {
             "type": "object",
             "properties": {
                 "amount": {
                     "type": "string"
                 },
                 "categoryID": {
+                    "description": "0 - категория подбирается по правилам аккаунта",
                     "type": "integer"
                 },
-                "period": {
-                    "$ref": "#/definitions/ledger.BudgetAddHandlerInputPeriod"
+                "currency": {
+                    "description": "по умолчанию - валюта кошелька, либо базовая валюта аккаунта",
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "isIncome": {
+                    "type": "boolean"
+                },
+                "occurredOn": {
+                    "type": "string",
+                    "example": "2025-12-20"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "trip",
+                        "work"
+                    ]
+                },
+                "walletID": {
+                    "type": "string"
                 }
             }
         },
-        "ledger.BudgetAddHandlerInputPeriod": {
+        "ledger.TransactionAddHandlerOutput": {
             "type": "object",
             "properties": {
-                "month": {
-                    "type": "integer"
+                "item": {
+                    "$ref": "#/definitions/ledger.TransactionOutput"
                 },
-                "year": {
-                    "type": "integer"
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledger.BudgetWarningOutput"
+                    }
                 }
             }
         },
-        "ledger.BudgetAddHandlerOutput": {
+        "ledger.TransactionGetHandlerOutput": {
             "type": "object",
             "properties": {
                 "item": {
-                    "$ref": "#/definitions/ledger.BudgetOutput"
+                    "$ref": "#/definitions/ledger.TransactionOutput"
                 }
             }
         },
-        "ledger.BudgetGetHandlerOutput": {
+        "ledger.TransactionImportHandlerOutput": {
             "type": "object",
             "properties": {
-                "hitCache": {
+                "dryRun": {
+                    "type": "boolean"
+                },
+                "flagged": {
+                    "type": "integer"
+                },
+                "imported": {
+                    "type": "integer"
+                },
+                "rejected": {
+                    "type": "integer"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledger.TransactionImportRowOutput"
+                    }
+                },
+                "skipped": {
+                    "type": "integer"
+                },
+                "updated": {
+                    "type": "integer"
+                }
+            }
+        },
+        "ledger.TransactionImportRowOutput": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "categoryID": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "errorCodes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "externalID": {
+                    "type": "string"
+                },
+                "hints": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "isIncome": {
                     "type": "boolean"
                 },
-                "item": {
-                    "$ref": "#/definitions/ledger.BudgetOutput"
+                "line": {
+                    "type": "integer"
+                },
+                "matchedTransactionID": {
+                    "description": "MatchedTransactionID - существующая транзакция, с которой совпала строка",
+                    "type": "string"
+                },
+                "occurredOn": {
+                    "type": "string",
+                    "example": "2025-12-31"
+                },
+                "status": {
+                    "description": "Status - ok, error, duplicate, updated, flagged или budget_exceeded",
+                    "type": "string",
+                    "example": "ok"
                 }
             }
         },
-        "ledger.BudgetListHandlerOutput": {
+        "ledger.TransactionListHandlerOutput": {
             "type": "object",
             "properties": {
-                "hit_cache": {
-                    "type": "boolean"
-                },
                 "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ledger.BudgetOutput"
+                        "$ref": "#/definitions/ledger.TransactionOutput"
                     }
                 },
+                "nextCursor": {
+                    "type": "string"
+                },
                 "total": {
                     "type": "integer"
                 }
             }
         },
-        "ledger.BudgetOutput": {
+        "ledger.TransactionOutput": {
             "type": "object",
             "properties": {
                 "accountID": {
@@ -945,29 +4033,55 @@ const docTemplate = `{
                 "createdAt": {
                     "type": "string"
                 },
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "duplicateOfID": {
+                    "description": "DuplicateOfID - транзакция, с которой строка совпала при импорте с пометкой дубликатов",
+                    "type": "string"
+                },
+                "externalID": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
-                "period": {
-                    "$ref": "#/definitions/ledger.BudgetOutputPeriod"
+                "isIncome": {
+                    "type": "boolean"
+                },
+                "occurredOn": {
+                    "type": "string",
+                    "example": "2025-12-20"
+                },
+                "recurringRuleID": {
+                    "description": "RecurringRuleID - правило, по которому создана транзакция",
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "transferID": {
+                    "type": "string"
                 },
                 "updatedAt": {
                     "type": "string"
-                }
-            }
-        },
-        "ledger.BudgetOutputPeriod": {
-            "type": "object",
-            "properties": {
-                "month": {
-                    "type": "integer"
                 },
-                "year": {
+                "version": {
+                    "description": "Version - версия для заголовка If-Match, совпадает с ETag",
                     "type": "integer"
+                },
+                "walletID": {
+                    "type": "string"
                 }
             }
         },
-        "ledger.BudgetPatchHandlerInput": {
+        "ledger.TransactionPatchHandlerInput": {
             "type": "object",
             "properties": {
                 "amount": {
@@ -976,198 +4090,270 @@ const docTemplate = `{
                 "categoryID": {
                     "type": "integer"
                 },
-                "period": {
-                    "$ref": "#/definitions/ledger.BudgetAddHandlerInputPeriod"
+                "currency": {
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "occurredOn": {
+                    "type": "string",
+                    "example": "2025-12-20"
+                },
+                "tags": {
+                    "description": "теги заменяются целиком, пустой список - удалить все теги",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "walletID": {
+                    "description": "пустая строка - отвязать от кошелька",
+                    "type": "string"
                 }
             }
         },
-        "ledger.BudgetPatchHandlerOutput": {
+        "ledger.TransactionPatchHandlerOutput": {
             "type": "object",
             "properties": {
                 "item": {
-                    "$ref": "#/definitions/ledger.BudgetOutput"
-                }
-            }
-        },
-        "ledger.CategoryListHandlerOutput": {
-            "type": "object",
-            "properties": {
-                "items": {
+                    "$ref": "#/definitions/ledger.TransactionOutput"
+                },
+                "warnings": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ledger.CategoryOutput"
+                        "$ref": "#/definitions/ledger.BudgetWarningOutput"
                     }
                 }
             }
         },
-        "ledger.CategoryOutput": {
+        "ledger.TransferAddHandlerInput": {
             "type": "object",
+            "required": [
+                "fromWalletID",
+                "toWalletID"
+            ],
             "properties": {
-                "id": {
-                    "type": "integer"
+                "amount": {
+                    "type": "string"
                 },
-                "title": {
+                "description": {
+                    "type": "string"
+                },
+                "fromWalletID": {
+                    "type": "string"
+                },
+                "occurredOn": {
+                    "type": "string",
+                    "example": "2025-12-20"
+                },
+                "toWalletID": {
                     "type": "string"
                 }
             }
         },
-        "ledger.ReportListHandlerOutput": {
+        "ledger.TransferAddHandlerOutput": {
             "type": "object",
             "properties": {
-                "hitCache": {
-                    "type": "boolean"
-                },
-                "reports": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ledger.ReportOutput"
-                    }
+                "item": {
+                    "$ref": "#/definitions/ledger.TransferOutput"
                 }
             }
         },
-        "ledger.ReportOutput": {
+        "ledger.TransferGetHandlerOutput": {
             "type": "object",
             "properties": {
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ledger.ReportOutputItem"
-                    }
-                },
-                "periodEnd": {
-                    "type": "string",
-                    "example": "2025-12-20"
-                },
-                "periodStart": {
-                    "type": "string",
-                    "example": "2025-01-01"
+                "item": {
+                    "$ref": "#/definitions/ledger.TransferOutput"
                 }
             }
         },
-        "ledger.ReportOutputItem": {
+        "ledger.TransferOutput": {
             "type": "object",
             "properties": {
-                "categoryID": {
-                    "type": "integer"
+                "accountID": {
+                    "type": "string"
                 },
-                "itemBudget": {
+                "amount": {
                     "type": "string"
                 },
-                "spentBudget": {
+                "createdAt": {
                     "type": "string"
                 },
-                "sum": {
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "fromWalletID": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "occurredOn": {
+                    "type": "string",
+                    "example": "2025-12-20"
+                },
+                "toWalletID": {
+                    "type": "string"
+                },
+                "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "ledger.TransactionAddHandlerInput": {
+        "ledger.TransferPatchHandlerInput": {
             "type": "object",
             "properties": {
                 "amount": {
                     "type": "string"
                 },
-                "categoryID": {
-                    "type": "integer"
-                },
                 "description": {
                     "type": "string"
                 },
-                "isIncome": {
-                    "type": "boolean"
+                "fromWalletID": {
+                    "type": "string"
                 },
                 "occurredOn": {
                     "type": "string",
                     "example": "2025-12-20"
+                },
+                "toWalletID": {
+                    "type": "string"
                 }
             }
         },
-        "ledger.TransactionAddHandlerOutput": {
+        "ledger.TransferPatchHandlerOutput": {
             "type": "object",
             "properties": {
                 "item": {
-                    "$ref": "#/definitions/ledger.TransactionOutput"
+                    "$ref": "#/definitions/ledger.TransferOutput"
                 }
             }
         },
-        "ledger.TransactionGetHandlerOutput": {
+        "ledger.WalletAddHandlerInput": {
+            "type": "object",
+            "required": [
+                "currency",
+                "title"
+            ],
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "openingBalance": {
+                    "type": "string",
+                    "example": "0.00"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "ledger.WalletAddHandlerOutput": {
             "type": "object",
             "properties": {
                 "item": {
-                    "$ref": "#/definitions/ledger.TransactionOutput"
+                    "$ref": "#/definitions/ledger.WalletOutput"
                 }
             }
         },
-        "ledger.TransactionListHandlerOutput": {
+        "ledger.WalletBalanceOutput": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "string"
+                },
+                "transactionsSum": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/ledger.WalletOutput"
+                }
+            }
+        },
+        "ledger.WalletBalancesHandlerOutput": {
             "type": "object",
             "properties": {
                 "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ledger.TransactionOutput"
+                        "$ref": "#/definitions/ledger.WalletBalanceOutput"
                     }
-                },
-                "total": {
-                    "type": "integer"
                 }
             }
         },
-        "ledger.TransactionOutput": {
+        "ledger.WalletGetHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/ledger.WalletOutput"
+                }
+            }
+        },
+        "ledger.WalletListHandlerOutput": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledger.WalletOutput"
+                    }
+                }
+            }
+        },
+        "ledger.WalletOutput": {
             "type": "object",
             "properties": {
                 "accountID": {
                     "type": "string"
                 },
-                "amount": {
-                    "type": "string"
-                },
-                "categoryID": {
-                    "type": "integer"
-                },
                 "createdAt": {
                     "type": "string"
                 },
-                "description": {
+                "currency": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "isIncome": {
+                "isArchived": {
                     "type": "boolean"
                 },
-                "occurredOn": {
-                    "type": "string",
-                    "example": "2025-12-20"
+                "openingBalance": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
                 },
                 "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "ledger.TransactionPatchHandlerInput": {
+        "ledger.WalletPatchHandlerInput": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "string"
-                },
-                "categoryID": {
-                    "type": "integer"
+                "isArchived": {
+                    "type": "boolean"
                 },
-                "description": {
+                "openingBalance": {
                     "type": "string"
                 },
-                "occurredOn": {
-                    "type": "string",
-                    "example": "2025-12-20"
+                "title": {
+                    "type": "string"
                 }
             }
         },
-        "ledger.TransactionPatchHandlerOutput": {
+        "ledger.WalletPatchHandlerOutput": {
             "type": "object",
             "properties": {
                 "item": {
-                    "$ref": "#/definitions/ledger.TransactionOutput"
+                    "$ref": "#/definitions/ledger.WalletOutput"
                 }
             }
         },
@@ -8,6 +8,7 @@ const (
 	errorCodeForbidden     errorCode = 403
 	errorCodeNotFound      errorCode = 404
 	errorCodeConflict      errorCode = 409
+	errorCodePrecondition  errorCode = 412
 	errorCodeUnprocessable errorCode = 422
 	errorTooManyRequests   errorCode = 429
 	errorCodeInternal      errorCode = 500
@@ -62,6 +62,9 @@ var (
 	// ErrVersionConflict - конфликт по версии
 	ErrVersionConflict = ErrConflict.Extend("version conflict").WithTextCode("VERSION_CONFLICT")
 
+	// ErrVersionPrecondition - конфликт по версии из заголовка If-Match, отдается клиенту как 412 Precondition Failed
+	ErrVersionPrecondition = ErrVersionConflict.WithCode(errorCodePrecondition)
+
 	// ErrUniqueViolation - конфликт по уникальности
 	ErrUniqueViolation = ErrConflict.Extend("unique violation").WithTextCode("UNIQUE_VIOLATION")
 
@@ -45,9 +45,23 @@ func NewClientConn(cfg Config, logger *slog.Logger) (*grpc.ClientConn, error) {
 		intercepts = append(intercepts, logging.UnaryClientInterceptor(InterceptorLogger(logger), logOpts...))
 	}
 
+	// потоки не повторяются и не ограничиваются по времени: выгрузка может идти долго,
+	// а в лог попадает только завершение вызова, без каждой части ответа
+	streamIntercepts := []grpc.StreamClientInterceptor{
+		HeaderStreamClientInterceptor(nil),
+	}
+
+	if logger != nil {
+		streamIntercepts = append(
+			streamIntercepts,
+			logging.StreamClientInterceptor(InterceptorLogger(logger), logging.WithLogOnEvents(logging.FinishCall)),
+		)
+	}
+
 	cc, err := grpc.NewClient(cfg.Addr,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithChainUnaryInterceptor(intercepts...),
+		grpc.WithChainStreamInterceptor(streamIntercepts...),
 	)
 	if err != nil {
 		return nil, err
@@ -81,29 +95,44 @@ func HeaderUnaryClientInterceptor(headers map[string]string) grpc.UnaryClientInt
 		invoker grpc.UnaryInvoker,
 		opts ...grpc.CallOption,
 	) error {
-		md, ok := metadata.FromOutgoingContext(ctx)
-		if !ok {
-			md = metadata.New(nil)
-		} else {
-			md = md.Copy()
-		}
-
-		requestIP, ok := ctx.Value("requestIP").(string)
-		if ok && requestIP != "" {
-			md.Set("x-forwarded-for", requestIP)
-		}
-
-		accessToken, ok := auth.AccessToken(ctx)
-		if ok {
-			md.Set("authorization", "Bearer "+accessToken)
-		}
-
-		for k, v := range headers {
-			md.Set(k, v)
-		}
-
-		ctx = metadata.NewOutgoingContext(ctx, md)
-
-		return invoker(ctx, method, req, reply, cc, opts...)
+		return invoker(withOutgoingHeaders(ctx, headers), method, req, reply, cc, opts...)
 	}
 }
+
+func HeaderStreamClientInterceptor(headers map[string]string) grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		return streamer(withOutgoingHeaders(ctx, headers), desc, cc, method, opts...)
+	}
+}
+
+func withOutgoingHeaders(ctx context.Context, headers map[string]string) context.Context {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	} else {
+		md = md.Copy()
+	}
+
+	requestIP, ok := ctx.Value("requestIP").(string)
+	if ok && requestIP != "" {
+		md.Set("x-forwarded-for", requestIP)
+	}
+
+	accessToken, ok := auth.AccessToken(ctx)
+	if ok {
+		md.Set("authorization", "Bearer "+accessToken)
+	}
+
+	for k, v := range headers {
+		md.Set(k, v)
+	}
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
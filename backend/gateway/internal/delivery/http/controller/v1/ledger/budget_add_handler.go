@@ -17,6 +17,14 @@ type BudgetAddHandlerInput struct {
 	Amount     string                      `json:"amount"`
 	CategoryID uint64                      `json:"categoryID"`
 	Period     BudgetAddHandlerInputPeriod `json:"period"`
+	// по умолчанию - базовая валюта аккаунта
+	Currency *string `json:"currency" validate:"omitempty,len=3" example:"RUB"`
+	// перенос остатка бюджета прошлого месяца
+	CarryOver bool `json:"carryOver"`
+	// по умолчанию - hard
+	Enforcement *string `json:"enforcement" validate:"omitempty,oneof=hard soft off" example:"soft"`
+	// пороги предупреждений в процентах от бюджета
+	WarningThresholds []int32 `json:"warningThresholds" validate:"omitempty,max=10,dive,min=1,max=1000" example:"80,100"`
 }
 
 type BudgetAddHandlerOutput struct {
@@ -47,12 +55,17 @@ func (ctrl *Controller) BudgetAddHandler(c *fiber.Ctx) error {
 	}
 
 	request := &desc.AddBudgetRequest{
-		Amount: in.Amount,
+		Amount:   in.Amount,
+		Currency: in.Currency,
 		Period: &desc.DateMonth{
 			Year:  int32(in.Period.Year),
 			Month: int32(in.Period.Month),
 		},
 		CategoryId: int64(in.CategoryID),
+		CarryOver:  in.CarryOver,
+
+		Enforcement:       in.Enforcement,
+		WarningThresholds: in.WarningThresholds,
 	}
 
 	data, err := ctrl.ledgerAdapter.Api().AddBudget(c.Context(), request)
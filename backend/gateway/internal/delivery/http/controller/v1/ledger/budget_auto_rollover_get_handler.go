@@ -0,0 +1,34 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+)
+
+type BudgetAutoRolloverGetHandlerOutput struct {
+	Enabled bool `json:"enabled"`
+}
+
+// BudgetAutoRolloverGetHandler - get budget auto rollover setting
+// @Summary Get budget auto rollover setting of account
+// @Security BearerAuth
+// @Tags ledger
+// @Produce  json
+// @Success 200 {object} BudgetAutoRolloverGetHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/budgets/auto-rollover [get]
+func (ctrl *Controller) BudgetAutoRolloverGetHandler(c *fiber.Ctx) error {
+	const op = "BudgetAutoRolloverGetHandler"
+
+	data, err := ctrl.ledgerAdapter.Api().GetBudgetAutoRollover(c.Context(), &desc.GetBudgetAutoRolloverRequest{})
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := BudgetAutoRolloverGetHandlerOutput{
+		Enabled: data.Enabled,
+	}
+
+	return c.JSON(out)
+}
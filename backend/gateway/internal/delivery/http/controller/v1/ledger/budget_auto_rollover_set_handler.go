@@ -0,0 +1,54 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/gateway/internal/delivery/http/httperrs"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/m11ano/budget_planner/backend/gateway/pkg/validation"
+)
+
+type BudgetAutoRolloverSetHandlerInput struct {
+	Enabled bool `json:"enabled"`
+}
+
+type BudgetAutoRolloverSetHandlerOutput struct {
+	Enabled bool `json:"enabled"`
+}
+
+// BudgetAutoRolloverSetHandler - set budget auto rollover setting
+// @Summary Enable or disable copying of previous month budgets at month start
+// @Security BearerAuth
+// @Tags ledger
+// @Accept  json
+// @Produce  json
+// @Param request body BudgetAutoRolloverSetHandlerInput true "JSON"
+// @Success 200 {object} BudgetAutoRolloverSetHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/budgets/auto-rollover [put]
+func (ctrl *Controller) BudgetAutoRolloverSetHandler(c *fiber.Ctx) error {
+	const op = "BudgetAutoRolloverSetHandler"
+
+	in := &BudgetAutoRolloverSetHandlerInput{}
+
+	if err := c.BodyParser(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrCantParseBody, "%s.%s", ctrl.pkg, op)
+	}
+
+	if err := ctrl.vldtr.Struct(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().SetBudgetAutoRollover(c.Context(), &desc.SetBudgetAutoRolloverRequest{
+		Enabled: in.Enabled,
+	})
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := BudgetAutoRolloverSetHandlerOutput{
+		Enabled: data.Enabled,
+	}
+
+	return c.JSON(out)
+}
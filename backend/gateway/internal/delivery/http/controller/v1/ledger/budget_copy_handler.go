@@ -0,0 +1,82 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/gateway/internal/delivery/http/httperrs"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/m11ano/budget_planner/backend/gateway/pkg/validation"
+)
+
+type BudgetCopyHandlerInput struct {
+	SourcePeriod     BudgetAddHandlerInputPeriod `json:"sourcePeriod"`
+	TargetPeriodFrom BudgetAddHandlerInputPeriod `json:"targetPeriodFrom"`
+	TargetPeriodTo   BudgetAddHandlerInputPeriod `json:"targetPeriodTo"`
+	// skip - существующие бюджеты не меняются, overwrite - перезаписываются
+	Mode string `json:"mode" validate:"required,oneof=skip overwrite" example:"skip"`
+}
+
+type BudgetCopyHandlerOutput struct {
+	Items       []*BudgetOutput `json:"items"`
+	Created     int32           `json:"created"`
+	Overwritten int32           `json:"overwritten"`
+	Skipped     int32           `json:"skipped"`
+}
+
+// BudgetCopyHandler - copy budgets
+// @Summary Copy budgets of month to range of months
+// @Security BearerAuth
+// @Tags ledger
+// @Accept  json
+// @Produce  json
+// @Param request body BudgetCopyHandlerInput true "JSON"
+// @Success 200 {object} BudgetCopyHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/budgets/copy [post]
+func (ctrl *Controller) BudgetCopyHandler(c *fiber.Ctx) error {
+	const op = "BudgetCopyHandler"
+
+	in := &BudgetCopyHandlerInput{}
+
+	if err := c.BodyParser(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrCantParseBody, "%s.%s", ctrl.pkg, op)
+	}
+
+	if err := ctrl.vldtr.Struct(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
+	}
+
+	request := &desc.CopyBudgetsRequest{
+		SourcePeriod: &desc.DateMonth{
+			Year:  int32(in.SourcePeriod.Year),
+			Month: int32(in.SourcePeriod.Month),
+		},
+		TargetPeriodFrom: &desc.DateMonth{
+			Year:  int32(in.TargetPeriodFrom.Year),
+			Month: int32(in.TargetPeriodFrom.Month),
+		},
+		TargetPeriodTo: &desc.DateMonth{
+			Year:  int32(in.TargetPeriodTo.Year),
+			Month: int32(in.TargetPeriodTo.Month),
+		},
+		Mode: in.Mode,
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().CopyBudgets(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := BudgetCopyHandlerOutput{
+		Items:       make([]*BudgetOutput, 0, len(data.Items)),
+		Created:     data.Created,
+		Overwritten: data.Overwritten,
+		Skipped:     data.Skipped,
+	}
+
+	for _, item := range data.Items {
+		out.Items = append(out.Items, NewBudgetOutput(item))
+	}
+
+	return c.JSON(out)
+}
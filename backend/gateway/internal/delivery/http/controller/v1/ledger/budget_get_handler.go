@@ -19,6 +19,7 @@ type BudgetGetHandlerOutput struct {
 // @Produce  json
 // @Param id path string true "ID"
 // @Success 200 {object} BudgetGetHandlerOutput
+// @Header 200 {string} ETag "Версия ресурса для If-Match"
 // @Failure 400 {object} middleware.ErrorJSON
 // @Router /ledger/budgets/{id} [get]
 func (ctrl *Controller) BudgetGetHandler(c *fiber.Ctx) error {
@@ -42,6 +43,8 @@ func (ctrl *Controller) BudgetGetHandler(c *fiber.Ctx) error {
 		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
 	}
 
+	c.Set(fiber.HeaderETag, etagFromVersion(data.Item.Version))
+
 	out := BudgetGetHandlerOutput{
 		Item:     NewBudgetOutput(data.Item),
 		HitCache: data.HitCache,
@@ -7,12 +7,14 @@ import (
 	"github.com/gofiber/fiber/v2"
 	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
 	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/samber/lo"
 )
 
 type BudgetListHandlerOutput struct {
-	Items    []*BudgetOutput `json:"items"`
-	Total    int64           `json:"total"`
-	HitCache bool            `json:"hit_cache"`
+	Items      []*BudgetOutput `json:"items"`
+	Total      *int64          `json:"total"`
+	HitCache   bool            `json:"hit_cache"`
+	NextCursor *string         `json:"nextCursor"`
 }
 
 // BudgetListHandler - list budgets
@@ -24,6 +26,8 @@ type BudgetListHandlerOutput struct {
 // @Param period_to query string false "Фильтр по дате ДО в формате 2025-01 (год-месяц)"
 // @Param limit query int false "Limit"
 // @Param offset query int false "Offset"
+// @Param cursor query string false "Курсор следующей страницы из nextCursor, пустое значение - первая страница. При передаче offset игнорируется"
+// @Param with_total query bool false "Посчитать общее количество в режиме курсора"
 // @Success 200 {object} BudgetListHandlerOutput
 // @Failure 400 {object} middleware.ErrorJSON
 // @Router /ledger/budgets [get]
@@ -70,6 +74,11 @@ func (ctrl *Controller) BudgetListHandler(c *fiber.Ctx) error {
 		}
 	}
 
+	if c.Request().URI().QueryArgs().Has("cursor") {
+		request.Cursor = lo.ToPtr(c.Query("cursor"))
+		request.WithTotal = c.QueryBool("with_total", false)
+	}
+
 	data, err := ctrl.ledgerAdapter.Api().ListBudgets(c.Context(), request)
 	if err != nil {
 		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
@@ -81,6 +90,10 @@ func (ctrl *Controller) BudgetListHandler(c *fiber.Ctx) error {
 		HitCache: data.HitCache,
 	}
 
+	if data.NextCursor != "" {
+		out.NextCursor = &data.NextCursor
+	}
+
 	for _, data := range data.Items {
 		out.Items = append(out.Items, NewBudgetOutput(data))
 	}
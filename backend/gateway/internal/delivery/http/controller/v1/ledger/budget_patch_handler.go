@@ -14,6 +14,12 @@ type BudgetPatchHandlerInput struct {
 	Amount     *string                      `json:"amount"`
 	CategoryID *uint64                      `json:"categoryID"`
 	Period     *BudgetAddHandlerInputPeriod `json:"period"`
+	Currency   *string                      `json:"currency" validate:"omitempty,len=3" example:"RUB"`
+	CarryOver  *bool                        `json:"carryOver"`
+
+	Enforcement *string `json:"enforcement" validate:"omitempty,oneof=hard soft off" example:"soft"`
+	// пустой массив - удалить пороги
+	WarningThresholds *[]int32 `json:"warningThresholds" validate:"omitempty,max=10,dive,min=1,max=1000" example:"80,100"`
 }
 
 type BudgetPatchHandlerOutput struct {
@@ -28,8 +34,11 @@ type BudgetPatchHandlerOutput struct {
 // @Produce  json
 // @Param request body BudgetPatchHandlerInput true "JSON"
 // @Param id path string true "ID"
+// @Param If-Match header string false "ETag из GET, при несовпадении версии - 412"
 // @Success 200 {object} BudgetPatchHandlerOutput
+// @Header 200 {string} ETag "Новая версия ресурса"
 // @Failure 400 {object} middleware.ErrorJSON
+// @Failure 412 {object} middleware.ErrorJSON "Версия изменилась, в details.current - текущее состояние"
 // @Router /ledger/budgets/{id} [patch]
 func (ctrl *Controller) BudgetPatchHandler(c *fiber.Ctx) error {
 	const op = "BudgetPatchHandler"
@@ -53,9 +62,26 @@ func (ctrl *Controller) BudgetPatchHandler(c *fiber.Ctx) error {
 		)
 	}
 
+	version, err := versionFromIfMatch(c)
+	if err != nil {
+		return appErrors.Chainf(err, "%s.%s", ctrl.pkg, op)
+	}
+
 	request := &desc.PatchBudgetRequest{
-		Id:     id.String(),
-		Amount: in.Amount,
+		Id:        id.String(),
+		Amount:    in.Amount,
+		Currency:  in.Currency,
+		CarryOver: in.CarryOver,
+
+		Enforcement: in.Enforcement,
+
+		Version: version,
+	}
+
+	if in.WarningThresholds != nil {
+		request.WarningThresholds = &desc.BudgetWarningThresholds{
+			Items: *in.WarningThresholds,
+		}
 	}
 
 	if in.CategoryID != nil {
@@ -71,9 +97,11 @@ func (ctrl *Controller) BudgetPatchHandler(c *fiber.Ctx) error {
 
 	data, err := ctrl.ledgerAdapter.Api().PatchBudget(c.Context(), request)
 	if err != nil {
-		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+		return appErrors.Chainf(ctrl.withCurrentBudget(c, request.Id, version, appErrors.FromGRPCError(err)), "%s.%s", ctrl.pkg, op)
 	}
 
+	c.Set(fiber.HeaderETag, etagFromVersion(data.Item.Version))
+
 	out := BudgetPatchHandlerOutput{
 		Item: NewBudgetOutput(data.Item),
 	}
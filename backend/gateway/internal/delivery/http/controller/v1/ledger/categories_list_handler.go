@@ -1,6 +1,8 @@
 package ledger
 
 import (
+	"strconv"
+
 	"github.com/gofiber/fiber/v2"
 	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
 	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
@@ -12,8 +14,10 @@ type CategoryListHandlerOutput struct {
 
 // CategoryListHandler - list categories
 // @Summary List categories
+// @Security BearerAuth
 // @Tags ledger
 // @Produce  json
+// @Param is_archived query bool false "Filter by archived flag"
 // @Success 200 {object} CategoryListHandlerOutput
 // @Failure 400 {object} middleware.ErrorJSON
 // @Router /ledger/categories [get]
@@ -22,6 +26,19 @@ func (ctrl *Controller) CategoryListHandler(c *fiber.Ctx) error {
 
 	request := &desc.ListCategoriesRequest{}
 
+	filterIsArchivedStr := c.Query("is_archived")
+	if filterIsArchivedStr != "" {
+		filterIsArchived, err := strconv.ParseBool(filterIsArchivedStr)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid is_archived"),
+				"%s.%s", ctrl.pkg, op,
+			)
+		}
+
+		request.FilterIsArchived = &filterIsArchived
+	}
+
 	data, err := ctrl.ledgerAdapter.Api().ListCategories(c.Context(), request)
 	if err != nil {
 		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
@@ -0,0 +1,62 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/gateway/internal/delivery/http/httperrs"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/m11ano/budget_planner/backend/gateway/pkg/validation"
+	"github.com/samber/lo"
+)
+
+type CategoryAddHandlerInput struct {
+	Title    string  `json:"title" validate:"required"`
+	ParentID *uint64 `json:"parentID" validate:"omitempty,gt=0"`
+}
+
+type CategoryAddHandlerOutput struct {
+	Item *CategoryOutput `json:"item"`
+}
+
+// CategoryAddHandler - add category
+// @Summary Add category
+// @Security BearerAuth
+// @Tags ledger
+// @Accept  json
+// @Produce  json
+// @Param request body CategoryAddHandlerInput true "JSON"
+// @Success 200 {object} CategoryAddHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/categories [post]
+func (ctrl *Controller) CategoryAddHandler(c *fiber.Ctx) error {
+	const op = "CategoryAddHandler"
+
+	in := &CategoryAddHandlerInput{}
+
+	if err := c.BodyParser(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrCantParseBody, "%s.%s", ctrl.pkg, op)
+	}
+
+	if err := ctrl.vldtr.Struct(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
+	}
+
+	request := &desc.AddCategoryRequest{
+		Title: in.Title,
+	}
+
+	if in.ParentID != nil {
+		request.ParentId = lo.ToPtr(int64(*in.ParentID))
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().AddCategory(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := CategoryAddHandlerOutput{
+		Item: NewCategoryOutput(data.Item),
+	}
+
+	return c.JSON(out)
+}
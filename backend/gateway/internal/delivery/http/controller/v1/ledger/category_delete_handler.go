@@ -0,0 +1,56 @@
+package ledger
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+)
+
+// CategoryDeleteHandler - delete category
+// @Summary Delete category
+// @Description If the category has transactions or budgets, reassign_to_id is required - they will be moved to that category
+// @Security BearerAuth
+// @Tags ledger
+// @Param id path int true "ID"
+// @Param reassign_to_id query int false "Category ID to move transactions and budgets to"
+// @Success 200
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/categories/{id} [delete]
+func (ctrl *Controller) CategoryDeleteHandler(c *fiber.Ctx) error {
+	const op = "CategoryDeleteHandler"
+
+	idStr := c.Params("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return appErrors.Chainf(
+			appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid id"),
+			"%s.%s", ctrl.pkg, op,
+		)
+	}
+
+	request := &desc.DeleteCategoryRequest{
+		Id: id,
+	}
+
+	reassignToIDStr := c.Query("reassign_to_id")
+	if reassignToIDStr != "" {
+		reassignToID, err := strconv.ParseInt(reassignToIDStr, 10, 64)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid reassign_to_id"),
+				"%s.%s", ctrl.pkg, op,
+			)
+		}
+
+		request.ReassignToId = &reassignToID
+	}
+
+	_, err = ctrl.ledgerAdapter.Api().DeleteCategory(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	return nil
+}
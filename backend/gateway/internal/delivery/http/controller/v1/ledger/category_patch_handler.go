@@ -0,0 +1,78 @@
+package ledger
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/gateway/internal/delivery/http/httperrs"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/m11ano/budget_planner/backend/gateway/pkg/validation"
+	"github.com/samber/lo"
+)
+
+type CategoryPatchHandlerInput struct {
+	Title      *string `json:"title"`
+	IsArchived *bool   `json:"isArchived"`
+	// 0 - перенести категорию в корень
+	ParentID *uint64 `json:"parentID"`
+}
+
+type CategoryPatchHandlerOutput struct {
+	Item *CategoryOutput `json:"item"`
+}
+
+// CategoryPatchHandler - patch category
+// @Summary Patch category
+// @Security BearerAuth
+// @Tags ledger
+// @Accept  json
+// @Produce  json
+// @Param request body CategoryPatchHandlerInput true "JSON"
+// @Param id path int true "ID"
+// @Success 200 {object} CategoryPatchHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/categories/{id} [patch]
+func (ctrl *Controller) CategoryPatchHandler(c *fiber.Ctx) error {
+	const op = "CategoryPatchHandler"
+
+	in := &CategoryPatchHandlerInput{}
+
+	if err := c.BodyParser(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrCantParseBody, "%s.%s", ctrl.pkg, op)
+	}
+
+	if err := ctrl.vldtr.Struct(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
+	}
+
+	idStr := c.Params("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return appErrors.Chainf(
+			appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid id"),
+			"%s.%s", ctrl.pkg, op,
+		)
+	}
+
+	request := &desc.PatchCategoryRequest{
+		Id:         id,
+		Title:      in.Title,
+		IsArchived: in.IsArchived,
+	}
+
+	if in.ParentID != nil {
+		request.ParentId = lo.ToPtr(int64(*in.ParentID))
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().PatchCategory(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := CategoryPatchHandlerOutput{
+		Item: NewCategoryOutput(data.Item),
+	}
+
+	return c.JSON(out)
+}
@@ -0,0 +1,64 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/gateway/internal/delivery/http/httperrs"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/m11ano/budget_planner/backend/gateway/pkg/validation"
+)
+
+type CategoryRuleAddHandlerInput struct {
+	Name string `json:"name" validate:"required"`
+	// правила проверяются по возрастанию приоритета
+	Priority   int32                     `json:"priority" validate:"gte=0"`
+	CategoryID uint64                    `json:"categoryID" validate:"required"`
+	Conditions CategoryRuleConditionsDTO `json:"conditions"`
+}
+
+type CategoryRuleAddHandlerOutput struct {
+	Item *CategoryRuleOutput `json:"item"`
+}
+
+// CategoryRuleAddHandler - add category rule
+// @Summary Add category rule
+// @Description Sets the category of transactions created or imported without a category
+// @Security BearerAuth
+// @Tags ledger
+// @Accept  json
+// @Produce  json
+// @Param request body CategoryRuleAddHandlerInput true "JSON"
+// @Success 200 {object} CategoryRuleAddHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/category-rules [post]
+func (ctrl *Controller) CategoryRuleAddHandler(c *fiber.Ctx) error {
+	const op = "CategoryRuleAddHandler"
+
+	in := &CategoryRuleAddHandlerInput{}
+
+	if err := c.BodyParser(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrCantParseBody, "%s.%s", ctrl.pkg, op)
+	}
+
+	if err := ctrl.vldtr.Struct(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
+	}
+
+	request := &desc.AddCategoryRuleRequest{
+		Name:       in.Name,
+		Priority:   in.Priority,
+		CategoryId: int64(in.CategoryID),
+		Conditions: in.Conditions.ToProto(),
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().AddCategoryRule(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := CategoryRuleAddHandlerOutput{
+		Item: NewCategoryRuleOutput(data.Item),
+	}
+
+	return c.JSON(out)
+}
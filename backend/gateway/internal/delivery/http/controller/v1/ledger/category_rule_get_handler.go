@@ -0,0 +1,49 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+)
+
+type CategoryRuleGetHandlerOutput struct {
+	Item *CategoryRuleOutput `json:"item"`
+}
+
+// CategoryRuleGetHandler - get category rule
+// @Summary Get category rule
+// @Security BearerAuth
+// @Tags ledger
+// @Produce  json
+// @Param id path string true "ID"
+// @Success 200 {object} CategoryRuleGetHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/category-rules/{id} [get]
+func (ctrl *Controller) CategoryRuleGetHandler(c *fiber.Ctx) error {
+	const op = "CategoryRuleGetHandler"
+
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return appErrors.Chainf(
+			appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid id"),
+			"%s.%s", ctrl.pkg, op,
+		)
+	}
+
+	request := &desc.GetCategoryRuleRequest{
+		Id: id.String(),
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().GetCategoryRule(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := CategoryRuleGetHandlerOutput{
+		Item: NewCategoryRuleOutput(data.Item),
+	}
+
+	return c.JSON(out)
+}
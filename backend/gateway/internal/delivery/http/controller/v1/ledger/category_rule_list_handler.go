@@ -0,0 +1,41 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+)
+
+type CategoryRuleListHandlerOutput struct {
+	Items []*CategoryRuleOutput `json:"items"`
+}
+
+// CategoryRuleListHandler - list category rules
+// @Summary List category rules
+// @Description Rules are ordered by priority, the first matching rule sets the category
+// @Security BearerAuth
+// @Tags ledger
+// @Produce  json
+// @Success 200 {object} CategoryRuleListHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/category-rules [get]
+func (ctrl *Controller) CategoryRuleListHandler(c *fiber.Ctx) error {
+	const op = "CategoryRuleListHandler"
+
+	request := &desc.ListCategoryRulesRequest{}
+
+	data, err := ctrl.ledgerAdapter.Api().ListCategoryRules(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := CategoryRuleListHandlerOutput{
+		Items: make([]*CategoryRuleOutput, 0, len(data.Items)),
+	}
+
+	for _, data := range data.Items {
+		out.Items = append(out.Items, NewCategoryRuleOutput(data))
+	}
+
+	return c.JSON(out)
+}
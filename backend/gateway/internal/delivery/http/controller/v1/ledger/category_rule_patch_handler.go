@@ -0,0 +1,83 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/gateway/internal/delivery/http/httperrs"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/m11ano/budget_planner/backend/gateway/pkg/validation"
+)
+
+type CategoryRulePatchHandlerInput struct {
+	Name       *string `json:"name"`
+	Priority   *int32  `json:"priority" validate:"omitempty,gte=0"`
+	CategoryID *uint64 `json:"categoryID"`
+	// Conditions - заменяются целиком
+	Conditions *CategoryRuleConditionsDTO `json:"conditions"`
+}
+
+type CategoryRulePatchHandlerOutput struct {
+	Item *CategoryRuleOutput `json:"item"`
+}
+
+// CategoryRulePatchHandler - patch category rule
+// @Summary Patch category rule
+// @Description Conditions are replaced as a whole
+// @Security BearerAuth
+// @Tags ledger
+// @Accept  json
+// @Produce  json
+// @Param request body CategoryRulePatchHandlerInput true "JSON"
+// @Param id path string true "ID"
+// @Success 200 {object} CategoryRulePatchHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/category-rules/{id} [patch]
+func (ctrl *Controller) CategoryRulePatchHandler(c *fiber.Ctx) error {
+	const op = "CategoryRulePatchHandler"
+
+	in := &CategoryRulePatchHandlerInput{}
+
+	if err := c.BodyParser(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrCantParseBody, "%s.%s", ctrl.pkg, op)
+	}
+
+	if err := ctrl.vldtr.Struct(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
+	}
+
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return appErrors.Chainf(
+			appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid id"),
+			"%s.%s", ctrl.pkg, op,
+		)
+	}
+
+	request := &desc.PatchCategoryRuleRequest{
+		Id:       id.String(),
+		Name:     in.Name,
+		Priority: in.Priority,
+	}
+
+	if in.CategoryID != nil {
+		categoryID := int64(*in.CategoryID)
+		request.CategoryId = &categoryID
+	}
+
+	if in.Conditions != nil {
+		request.Conditions = in.Conditions.ToProto()
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().PatchCategoryRule(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := CategoryRulePatchHandlerOutput{
+		Item: NewCategoryRuleOutput(data.Item),
+	}
+
+	return c.JSON(out)
+}
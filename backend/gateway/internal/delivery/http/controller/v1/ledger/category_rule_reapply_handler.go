@@ -0,0 +1,78 @@
+package ledger
+
+import (
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/gateway/internal/delivery/http/httperrs"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/m11ano/budget_planner/backend/gateway/pkg/validation"
+)
+
+type CategoryRuleReapplyHandlerInput struct {
+	DateFrom civil.Date `json:"dateFrom" swaggertype:"string" example:"2025-12-01"`
+	DateTo   civil.Date `json:"dateTo" swaggertype:"string" example:"2025-12-31"`
+	// DryRun - изменения рассчитываются без сохранения
+	DryRun bool `json:"dryRun"`
+}
+
+type CategoryRuleReapplyHandlerOutput struct {
+	// Checked - количество проверенных транзакций без учета переводов
+	Checked int32                       `json:"checked"`
+	Changes []*CategoryRuleChangeOutput `json:"changes"`
+	DryRun  bool                        `json:"dryRun"`
+}
+
+// CategoryRuleReapplyHandler - reapply category rules
+// @Summary Reapply category rules
+// @Description Re-categorizes transactions of the period (at most 366 days) by the account rules.
+// @Description Transactions that match no rule keep their category, budget limits are not checked
+// @Security BearerAuth
+// @Tags ledger
+// @Accept  json
+// @Produce  json
+// @Param request body CategoryRuleReapplyHandlerInput true "JSON"
+// @Success 200 {object} CategoryRuleReapplyHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/category-rules/reapply [post]
+func (ctrl *Controller) CategoryRuleReapplyHandler(c *fiber.Ctx) error {
+	const op = "CategoryRuleReapplyHandler"
+
+	in := &CategoryRuleReapplyHandlerInput{}
+
+	if err := c.BodyParser(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrCantParseBody, "%s.%s", ctrl.pkg, op)
+	}
+
+	if err := ctrl.vldtr.Struct(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
+	}
+
+	request := &desc.ReapplyRulesRequest{
+		DateFrom: toProtoDate(in.DateFrom),
+		DateTo:   toProtoDate(in.DateTo),
+		DryRun:   in.DryRun,
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().ReapplyRules(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := CategoryRuleReapplyHandlerOutput{
+		Checked: data.Checked,
+		Changes: make([]*CategoryRuleChangeOutput, 0, len(data.Changes)),
+		DryRun:  data.DryRun,
+	}
+
+	for _, change := range data.Changes {
+		out.Changes = append(out.Changes, &CategoryRuleChangeOutput{
+			TransactionID: change.TransactionId,
+			RuleID:        change.RuleId,
+			OldCategoryID: uint64(change.OldCategoryId),
+			NewCategoryID: uint64(change.NewCategoryId),
+		})
+	}
+
+	return c.JSON(out)
+}
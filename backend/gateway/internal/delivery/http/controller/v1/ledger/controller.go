@@ -2,6 +2,7 @@ package ledger
 
 import (
 	"fmt"
+	"log/slog"
 
 	"github.com/go-playground/validator/v10"
 	ledgerAdapter "github.com/m11ano/budget_planner/backend/gateway/internal/adapter/ledger"
@@ -13,17 +14,20 @@ import (
 
 type Controller struct {
 	pkg           string
+	logger        *slog.Logger
 	vldtr         *validator.Validate
 	cfg           config.Config
 	ledgerAdapter ledgerAdapter.Adapter
 }
 
 func NewController(
+	logger *slog.Logger,
 	cfg config.Config,
 	ledgerAdapter ledgerAdapter.Adapter,
 ) *Controller {
 	controller := &Controller{
 		pkg:           "httpController.Ledger",
+		logger:        logger,
 		vldtr:         validation.New(),
 		cfg:           cfg,
 		ledgerAdapter: ledgerAdapter,
@@ -46,10 +50,18 @@ func RegisterRoutes(groups *v1.Groups, ctrl *Controller, mdwr *middleware.Contro
 
 	routeGroup.Post("/transactions", ctrl.TransactionAddHandler)
 
+	routeGroup.Post("/transactions/batch", ctrl.TransactionAddBatchHandler)
+
 	routeGroup.Patch("/transactions/:id<guid>", ctrl.TransactionPatchHandler)
 
 	routeGroup.Delete("/transactions/:id<guid>", ctrl.TransactionDeleteHandler)
 
+	routeGroup.Post("/budgets/copy", ctrl.BudgetCopyHandler)
+
+	routeGroup.Get("/budgets/auto-rollover", ctrl.BudgetAutoRolloverGetHandler)
+
+	routeGroup.Put("/budgets/auto-rollover", ctrl.BudgetAutoRolloverSetHandler)
+
 	routeGroup.Get("/budgets", ctrl.BudgetListHandler)
 
 	routeGroup.Get("/budgets/:id<guid>", ctrl.BudgetGetHandler)
@@ -62,5 +74,73 @@ func RegisterRoutes(groups *v1.Groups, ctrl *Controller, mdwr *middleware.Contro
 
 	routeGroup.Get("/categories", ctrl.CategoryListHandler)
 
+	routeGroup.Post("/categories", ctrl.CategoryAddHandler)
+
+	routeGroup.Patch("/categories/:id<int>", ctrl.CategoryPatchHandler)
+
+	routeGroup.Delete("/categories/:id<int>", ctrl.CategoryDeleteHandler)
+
 	routeGroup.Get("/reports", ctrl.ReportListHandler)
+
+	routeGroup.Get("/reports/export", ctrl.ReportExportHandler)
+
+	routeGroup.Get("/wallets/balances", ctrl.WalletBalancesHandler)
+
+	routeGroup.Get("/wallets", ctrl.WalletListHandler)
+
+	routeGroup.Get("/wallets/:id<guid>", ctrl.WalletGetHandler)
+
+	routeGroup.Post("/wallets", ctrl.WalletAddHandler)
+
+	routeGroup.Patch("/wallets/:id<guid>", ctrl.WalletPatchHandler)
+
+	routeGroup.Delete("/wallets/:id<guid>", ctrl.WalletDeleteHandler)
+
+	routeGroup.Get("/import-profiles", ctrl.ImportProfileListHandler)
+
+	routeGroup.Get("/import-profiles/:id<guid>", ctrl.ImportProfileGetHandler)
+
+	routeGroup.Post("/import-profiles", ctrl.ImportProfileAddHandler)
+
+	routeGroup.Patch("/import-profiles/:id<guid>", ctrl.ImportProfilePatchHandler)
+
+	routeGroup.Delete("/import-profiles/:id<guid>", ctrl.ImportProfileDeleteHandler)
+
+	routeGroup.Get("/category-rules", ctrl.CategoryRuleListHandler)
+
+	routeGroup.Get("/category-rules/:id<guid>", ctrl.CategoryRuleGetHandler)
+
+	routeGroup.Post("/category-rules", ctrl.CategoryRuleAddHandler)
+
+	routeGroup.Post("/category-rules/reapply", ctrl.CategoryRuleReapplyHandler)
+
+	routeGroup.Patch("/category-rules/:id<guid>", ctrl.CategoryRulePatchHandler)
+
+	routeGroup.Delete("/category-rules/:id<guid>", ctrl.CategoryRuleDeleteHandler)
+
+	routeGroup.Get("/transfers/:id<guid>", ctrl.TransferGetHandler)
+
+	routeGroup.Post("/transfers", ctrl.TransferAddHandler)
+
+	routeGroup.Patch("/transfers/:id<guid>", ctrl.TransferPatchHandler)
+
+	routeGroup.Delete("/transfers/:id<guid>", ctrl.TransferDeleteHandler)
+
+	routeGroup.Get("/currency", ctrl.CurrencyGetHandler)
+
+	routeGroup.Put("/currency", ctrl.CurrencySetHandler)
+
+	routeGroup.Get("/exchange-rates", ctrl.ExchangeRateListHandler)
+
+	routeGroup.Put("/exchange-rates", ctrl.ExchangeRateUpsertHandler)
+
+	routeGroup.Get("/recurring-rules", ctrl.RecurringRuleListHandler)
+
+	routeGroup.Get("/recurring-rules/:id<guid>", ctrl.RecurringRuleGetHandler)
+
+	routeGroup.Post("/recurring-rules", ctrl.RecurringRuleAddHandler)
+
+	routeGroup.Patch("/recurring-rules/:id<guid>", ctrl.RecurringRulePatchHandler)
+
+	routeGroup.Delete("/recurring-rules/:id<guid>", ctrl.RecurringRuleDeleteHandler)
 }
@@ -0,0 +1,34 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+)
+
+type CurrencyGetHandlerOutput struct {
+	Currency string `json:"currency" example:"RUB"`
+}
+
+// CurrencyGetHandler - get base currency
+// @Summary Get base currency of account
+// @Security BearerAuth
+// @Tags ledger
+// @Produce  json
+// @Success 200 {object} CurrencyGetHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/currency [get]
+func (ctrl *Controller) CurrencyGetHandler(c *fiber.Ctx) error {
+	const op = "CurrencyGetHandler"
+
+	data, err := ctrl.ledgerAdapter.Api().GetBaseCurrency(c.Context(), &desc.GetBaseCurrencyRequest{})
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := CurrencyGetHandlerOutput{
+		Currency: data.Currency,
+	}
+
+	return c.JSON(out)
+}
@@ -0,0 +1,56 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/gateway/internal/delivery/http/httperrs"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/m11ano/budget_planner/backend/gateway/pkg/validation"
+)
+
+type CurrencySetHandlerInput struct {
+	Currency string `json:"currency" validate:"required,len=3" example:"RUB"`
+}
+
+type CurrencySetHandlerOutput struct {
+	Currency string `json:"currency" example:"RUB"`
+}
+
+// CurrencySetHandler - set base currency
+// @Summary Set base currency of account
+// @Security BearerAuth
+// @Tags ledger
+// @Accept  json
+// @Produce  json
+// @Param request body CurrencySetHandlerInput true "JSON"
+// @Success 200 {object} CurrencySetHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/currency [put]
+func (ctrl *Controller) CurrencySetHandler(c *fiber.Ctx) error {
+	const op = "CurrencySetHandler"
+
+	in := &CurrencySetHandlerInput{}
+
+	if err := c.BodyParser(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrCantParseBody, "%s.%s", ctrl.pkg, op)
+	}
+
+	if err := ctrl.vldtr.Struct(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
+	}
+
+	request := &desc.SetBaseCurrencyRequest{
+		Currency: in.Currency,
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().SetBaseCurrency(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := CurrencySetHandlerOutput{
+		Currency: data.Currency,
+	}
+
+	return c.JSON(out)
+}
@@ -6,6 +6,7 @@ import (
 
 	"cloud.google.com/go/civil"
 	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/samber/lo"
 )
 
 type TransactionOutput struct {
@@ -13,11 +14,22 @@ type TransactionOutput struct {
 	AccountID   string     `json:"accountID"`
 	IsIncome    bool       `json:"isIncome"`
 	Amount      string     `json:"amount"`
+	Currency    string     `json:"currency"`
 	OccurredOn  civil.Date `json:"occurredOn" swaggertype:"string" example:"2025-12-20"`
 	CategoryID  uint64     `json:"categoryID"`
 	Description string     `json:"description"`
-	CreatedAt   *time.Time `json:"createdAt"`
-	UpdatedAt   *time.Time `json:"updatedAt"`
+	WalletID    *string    `json:"walletID"`
+	TransferID  *string    `json:"transferID"`
+	// RecurringRuleID - правило, по которому создана транзакция
+	RecurringRuleID *string `json:"recurringRuleID"`
+	ExternalID      *string `json:"externalID"`
+	// DuplicateOfID - транзакция, с которой строка совпала при импорте с пометкой дубликатов
+	DuplicateOfID *string    `json:"duplicateOfID"`
+	Tags          []string   `json:"tags"`
+	CreatedAt     *time.Time `json:"createdAt"`
+	UpdatedAt     *time.Time `json:"updatedAt"`
+	// Version - версия для заголовка If-Match, совпадает с ETag
+	Version int64 `json:"version"`
 }
 
 func NewTransactionOutput(transaction *desc.Transaction) *TransactionOutput {
@@ -32,15 +44,23 @@ func NewTransactionOutput(transaction *desc.Transaction) *TransactionOutput {
 	}
 
 	return &TransactionOutput{
-		ID:          transaction.Id,
-		AccountID:   transaction.AccountId,
-		IsIncome:    transaction.IsIncome,
-		Amount:      transaction.Amount,
-		OccurredOn:  occurredOn,
-		CategoryID:  uint64(transaction.CategoryId),
-		Description: transaction.Description,
-		CreatedAt:   fromProtoTimestamp(transaction.CreatedAt),
-		UpdatedAt:   fromProtoTimestamp(transaction.UpdatedAt),
+		ID:              transaction.Id,
+		AccountID:       transaction.AccountId,
+		IsIncome:        transaction.IsIncome,
+		Amount:          transaction.Amount,
+		Currency:        transaction.Currency,
+		OccurredOn:      occurredOn,
+		CategoryID:      uint64(transaction.CategoryId),
+		Description:     transaction.Description,
+		WalletID:        transaction.WalletId,
+		TransferID:      transaction.TransferId,
+		RecurringRuleID: transaction.RecurringRuleId,
+		ExternalID:      transaction.ExternalId,
+		DuplicateOfID:   transaction.DuplicateOfId,
+		Tags:            lo.Ternary(transaction.Tags != nil, transaction.Tags, []string{}),
+		CreatedAt:       fromProtoTimestamp(transaction.CreatedAt),
+		UpdatedAt:       fromProtoTimestamp(transaction.UpdatedAt),
+		Version:         transaction.Version,
 	}
 }
 
@@ -53,10 +73,17 @@ type BudgetOutput struct {
 	ID         string             `json:"id"`
 	AccountID  string             `json:"accountID"`
 	Amount     string             `json:"amount"`
+	Currency   string             `json:"currency"`
 	Period     BudgetOutputPeriod `json:"period"`
 	CategoryID uint64             `json:"categoryID"`
+	CarryOver  bool               `json:"carryOver"`
 	CreatedAt  *time.Time         `json:"createdAt"`
 	UpdatedAt  *time.Time         `json:"updatedAt"`
+	// Version - версия для заголовка If-Match, совпадает с ETag
+	Version int64 `json:"version"`
+
+	Enforcement       string  `json:"enforcement" example:"hard"`
+	WarningThresholds []int32 `json:"warningThresholds"`
 }
 
 func NewBudgetOutput(budget *desc.Budget) *BudgetOutput {
@@ -64,39 +91,104 @@ func NewBudgetOutput(budget *desc.Budget) *BudgetOutput {
 		ID:        budget.Id,
 		AccountID: budget.AccountId,
 		Amount:    budget.Amount,
+		Currency:  budget.Currency,
 		Period: BudgetOutputPeriod{
 			Month: budget.Period.Month,
 			Year:  budget.Period.Year,
 		},
 		CategoryID: uint64(budget.CategoryId),
+		CarryOver:  budget.CarryOver,
 		CreatedAt:  fromProtoTimestamp(budget.CreatedAt),
 		UpdatedAt:  fromProtoTimestamp(budget.UpdatedAt),
+		Version:    budget.Version,
+
+		Enforcement:       budget.Enforcement,
+		WarningThresholds: lo.Ternary(budget.WarningThresholds != nil, budget.WarningThresholds, []int32{}),
 	}
 }
 
+type BudgetWarningOutput struct {
+	BudgetID    string             `json:"budgetID"`
+	CategoryID  uint64             `json:"categoryID"`
+	Period      BudgetOutputPeriod `json:"period"`
+	Enforcement string             `json:"enforcement" example:"soft"`
+	// наибольший достигнутый порог, 0 - пороги не достигнуты
+	Threshold    int32   `json:"threshold" example:"80"`
+	SpentPercent *string `json:"spentPercent" example:"85.5"`
+	Exceeded     bool    `json:"exceeded"`
+}
+
+func NewBudgetWarningsOutput(warnings []*desc.BudgetWarning) []*BudgetWarningOutput {
+	return lo.Map(warnings, func(warning *desc.BudgetWarning, _ int) *BudgetWarningOutput {
+		return &BudgetWarningOutput{
+			BudgetID:   warning.BudgetId,
+			CategoryID: uint64(warning.CategoryId),
+			Period: BudgetOutputPeriod{
+				Month: warning.Period.GetMonth(),
+				Year:  warning.Period.GetYear(),
+			},
+			Enforcement:  warning.Enforcement,
+			Threshold:    warning.Threshold,
+			SpentPercent: warning.SpentPercent,
+			Exceeded:     warning.Exceeded,
+		}
+	})
+}
+
 type CategoryOutput struct {
-	ID    uint64 `json:"id"`
-	Title string `json:"title"`
+	ID         uint64            `json:"id"`
+	AccountID  *string           `json:"accountID"`
+	ParentID   *uint64           `json:"parentID"`
+	Title      string            `json:"title"`
+	IsArchived bool              `json:"isArchived"`
+	Children   []*CategoryOutput `json:"children,omitempty"`
+	CreatedAt  *time.Time        `json:"createdAt"`
+	UpdatedAt  *time.Time        `json:"updatedAt"`
 }
 
 func NewCategoryOutput(category *desc.Category) *CategoryOutput {
-	return &CategoryOutput{
-		ID:    uint64(category.Id),
-		Title: category.Title,
+	out := &CategoryOutput{
+		ID:         uint64(category.Id),
+		AccountID:  category.AccountId,
+		Title:      category.Title,
+		IsArchived: category.IsArchived,
+		CreatedAt:  fromProtoTimestamp(category.CreatedAt),
+		UpdatedAt:  fromProtoTimestamp(category.UpdatedAt),
 	}
+
+	if category.ParentId != nil {
+		out.ParentID = lo.ToPtr(uint64(*category.ParentId))
+	}
+
+	if len(category.Children) > 0 {
+		out.Children = make([]*CategoryOutput, 0, len(category.Children))
+		for _, child := range category.Children {
+			out.Children = append(out.Children, NewCategoryOutput(child))
+		}
+	}
+
+	return out
 }
 
 type ReportOutputItem struct {
-	CategoryID  uint64  `json:"categoryID"`
-	Sum         *string `json:"sum"`
-	SpentBudget *string `json:"spentBudget"`
-	ItemBudget  *string `json:"itemBudget"`
+	CategoryID       uint64  `json:"categoryID"`
+	ParentCategoryID *uint64 `json:"parentCategoryID"`
+	Sum              *string `json:"sum"`
+	SpentBudget      *string `json:"spentBudget"`
+	ItemBudget       *string `json:"itemBudget"`
+	CarriedOver      *string `json:"carriedOver"`
+	EffectiveBudget  *string `json:"effectiveBudget"`
+	TotalSum         *string `json:"totalSum"`
+	TotalSpentBudget *string `json:"totalSpentBudget"`
+	TotalBudget      *string `json:"totalBudget"`
 }
 
 type ReportOutput struct {
 	PeriodStart civil.Date          `json:"periodStart" swaggertype:"string" example:"2025-01-01"`
 	PeriodEnd   civil.Date          `json:"periodEnd" swaggertype:"string" example:"2025-12-20"`
 	Items       []*ReportOutputItem `json:"items"`
+	// MissingRates - недостающие курсы, суммы и бюджеты в этих валютах не вошли в отчет
+	MissingRates []string `json:"missingRates" example:"exchange rate USD/RUB not found"`
 }
 
 func NewReportOutput(report *desc.PeriodReport) *ReportOutput {
@@ -121,19 +213,356 @@ func NewReportOutput(report *desc.PeriodReport) *ReportOutput {
 	}
 
 	result := &ReportOutput{
-		PeriodStart: periodStart,
-		PeriodEnd:   periodEnd,
-		Items:       make([]*ReportOutputItem, 0, len(report.Items)),
+		PeriodStart:  periodStart,
+		PeriodEnd:    periodEnd,
+		Items:        make([]*ReportOutputItem, 0, len(report.Items)),
+		MissingRates: report.MissingRates,
 	}
 
 	for _, item := range report.Items {
-		result.Items = append(result.Items, &ReportOutputItem{
-			CategoryID:  uint64(item.CategoryId),
-			Sum:         item.Sum,
-			SpentBudget: item.SpentBudget,
-			ItemBudget:  item.ItemBudget,
-		})
+		outItem := &ReportOutputItem{
+			CategoryID:       uint64(item.CategoryId),
+			Sum:              item.Sum,
+			SpentBudget:      item.SpentBudget,
+			ItemBudget:       item.ItemBudget,
+			CarriedOver:      item.CarriedOver,
+			EffectiveBudget:  item.EffectiveBudget,
+			TotalSum:         item.TotalSum,
+			TotalSpentBudget: item.TotalSpentBudget,
+			TotalBudget:      item.TotalBudget,
+		}
+
+		if item.ParentCategoryId != nil {
+			outItem.ParentCategoryID = lo.ToPtr(uint64(*item.ParentCategoryId))
+		}
+
+		result.Items = append(result.Items, outItem)
 	}
 
 	return result
 }
+
+type WalletOutput struct {
+	ID             string     `json:"id"`
+	AccountID      string     `json:"accountID"`
+	Title          string     `json:"title"`
+	Currency       string     `json:"currency"`
+	OpeningBalance string     `json:"openingBalance"`
+	IsArchived     bool       `json:"isArchived"`
+	CreatedAt      *time.Time `json:"createdAt"`
+	UpdatedAt      *time.Time `json:"updatedAt"`
+}
+
+func NewWalletOutput(wallet *desc.Wallet) *WalletOutput {
+	return &WalletOutput{
+		ID:             wallet.Id,
+		AccountID:      wallet.AccountId,
+		Title:          wallet.Title,
+		Currency:       wallet.Currency,
+		OpeningBalance: wallet.OpeningBalance,
+		IsArchived:     wallet.IsArchived,
+		CreatedAt:      fromProtoTimestamp(wallet.CreatedAt),
+		UpdatedAt:      fromProtoTimestamp(wallet.UpdatedAt),
+	}
+}
+
+type WalletBalanceOutput struct {
+	Wallet          *WalletOutput `json:"wallet"`
+	TransactionsSum string        `json:"transactionsSum"`
+	Balance         string        `json:"balance"`
+}
+
+func NewWalletBalanceOutput(balance *desc.WalletBalance) *WalletBalanceOutput {
+	return &WalletBalanceOutput{
+		Wallet:          NewWalletOutput(balance.Wallet),
+		TransactionsSum: balance.TransactionsSum,
+		Balance:         balance.Balance,
+	}
+}
+
+type TransferOutput struct {
+	ID           string     `json:"id"`
+	AccountID    string     `json:"accountID"`
+	FromWalletID string     `json:"fromWalletID"`
+	ToWalletID   string     `json:"toWalletID"`
+	Amount       string     `json:"amount"`
+	Currency     string     `json:"currency"`
+	OccurredOn   civil.Date `json:"occurredOn" swaggertype:"string" example:"2025-12-20"`
+	Description  string     `json:"description"`
+	CreatedAt    *time.Time `json:"createdAt"`
+	UpdatedAt    *time.Time `json:"updatedAt"`
+}
+
+func NewTransferOutput(transfer *desc.Transfer) *TransferOutput {
+	occurredOn, err := civil.ParseDate(fmt.Sprintf(
+		"%04d-%02d-%02d",
+		transfer.OccurredOn.Year,
+		transfer.OccurredOn.Month,
+		transfer.OccurredOn.Day,
+	))
+	if err != nil {
+		panic(err)
+	}
+
+	return &TransferOutput{
+		ID:           transfer.Id,
+		AccountID:    transfer.AccountId,
+		FromWalletID: transfer.FromWalletId,
+		ToWalletID:   transfer.ToWalletId,
+		Amount:       transfer.Amount,
+		Currency:     transfer.Currency,
+		OccurredOn:   occurredOn,
+		Description:  transfer.Description,
+		CreatedAt:    fromProtoTimestamp(transfer.CreatedAt),
+		UpdatedAt:    fromProtoTimestamp(transfer.UpdatedAt),
+	}
+}
+
+type ExchangeRateOutput struct {
+	CurrencyFrom string     `json:"currencyFrom"`
+	CurrencyTo   string     `json:"currencyTo"`
+	RateDate     civil.Date `json:"rateDate" swaggertype:"string" example:"2025-12-20"`
+	Rate         string     `json:"rate"`
+	CreatedAt    *time.Time `json:"createdAt"`
+	UpdatedAt    *time.Time `json:"updatedAt"`
+}
+
+func NewExchangeRateOutput(rate *desc.ExchangeRate) *ExchangeRateOutput {
+	rateDate, err := civil.ParseDate(fmt.Sprintf(
+		"%04d-%02d-%02d",
+		rate.RateDate.Year,
+		rate.RateDate.Month,
+		rate.RateDate.Day,
+	))
+	if err != nil {
+		panic(err)
+	}
+
+	return &ExchangeRateOutput{
+		CurrencyFrom: rate.CurrencyFrom,
+		CurrencyTo:   rate.CurrencyTo,
+		RateDate:     rateDate,
+		Rate:         rate.Rate,
+		CreatedAt:    fromProtoTimestamp(rate.CreatedAt),
+		UpdatedAt:    fromProtoTimestamp(rate.UpdatedAt),
+	}
+}
+
+type RecurringRuleOutput struct {
+	ID          string  `json:"id"`
+	AccountID   string  `json:"accountID"`
+	IsIncome    bool    `json:"isIncome"`
+	Amount      string  `json:"amount"`
+	Currency    string  `json:"currency"`
+	CategoryID  uint64  `json:"categoryID"`
+	WalletID    *string `json:"walletID"`
+	Description string  `json:"description"`
+
+	Frequency        string      `json:"frequency" example:"monthly"`
+	Interval         int32       `json:"interval"`
+	DayOfMonth       int32       `json:"dayOfMonth"`
+	StartDate        civil.Date  `json:"startDate" swaggertype:"string" example:"2025-12-20"`
+	EndDate          *civil.Date `json:"endDate" swaggertype:"string" example:"2026-12-20"`
+	Count            *int32      `json:"count"`
+	OccurrencesCount int32       `json:"occurrencesCount"`
+	NextOccurrenceOn *civil.Date `json:"nextOccurrenceOn" swaggertype:"string" example:"2026-01-20"`
+	IsPaused         bool        `json:"isPaused"`
+	LastError        *string     `json:"lastError"`
+
+	CreatedAt *time.Time `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+func NewRecurringRuleOutput(rule *desc.RecurringRule) *RecurringRuleOutput {
+	out := &RecurringRuleOutput{
+		ID:               rule.Id,
+		AccountID:        rule.AccountId,
+		IsIncome:         rule.IsIncome,
+		Amount:           rule.Amount,
+		Currency:         rule.Currency,
+		CategoryID:       uint64(rule.CategoryId),
+		WalletID:         rule.WalletId,
+		Description:      rule.Description,
+		Frequency:        rule.Frequency,
+		Interval:         rule.Interval,
+		DayOfMonth:       rule.DayOfMonth,
+		StartDate:        fromProtoDate(rule.StartDate),
+		Count:            rule.Count,
+		OccurrencesCount: rule.OccurrencesCount,
+		IsPaused:         rule.IsPaused,
+		LastError:        rule.LastError,
+		CreatedAt:        fromProtoTimestamp(rule.CreatedAt),
+		UpdatedAt:        fromProtoTimestamp(rule.UpdatedAt),
+	}
+
+	if rule.EndDate != nil {
+		out.EndDate = lo.ToPtr(fromProtoDate(rule.EndDate))
+	}
+
+	if rule.NextOccurrenceOn != nil {
+		out.NextOccurrenceOn = lo.ToPtr(fromProtoDate(rule.NextOccurrenceOn))
+	}
+
+	return out
+}
+
+type ImportProfileColumnsDTO struct {
+	Date        string `json:"date" example:"Дата операции"`
+	Amount      string `json:"amount" example:"Сумма"`
+	Debit       string `json:"debit"`
+	Credit      string `json:"credit"`
+	IsIncome    string `json:"isIncome"`
+	Description string `json:"description" example:"3"`
+	CategoryID  string `json:"categoryID"`
+	Currency    string `json:"currency"`
+	ExternalID  string `json:"externalID"`
+}
+
+type ImportProfileMappingDTO struct {
+	HasHeader         bool                    `json:"hasHeader"`
+	SignConvention    string                  `json:"signConvention" validate:"required,oneof=signed inverted split flag" example:"signed"`
+	IncomeValues      []string                `json:"incomeValues"`
+	Columns           ImportProfileColumnsDTO `json:"columns"`
+	DefaultCategoryID *uint64                 `json:"defaultCategoryID"`
+}
+
+func (m *ImportProfileMappingDTO) ToProto() *desc.ImportProfileMapping {
+	return &desc.ImportProfileMapping{
+		HasHeader:      m.HasHeader,
+		SignConvention: m.SignConvention,
+		IncomeValues:   m.IncomeValues,
+		Columns: &desc.ImportProfileColumns{
+			Date:        m.Columns.Date,
+			Amount:      m.Columns.Amount,
+			Debit:       m.Columns.Debit,
+			Credit:      m.Columns.Credit,
+			IsIncome:    m.Columns.IsIncome,
+			Description: m.Columns.Description,
+			CategoryId:  m.Columns.CategoryID,
+			Currency:    m.Columns.Currency,
+			ExternalId:  m.Columns.ExternalID,
+		},
+		DefaultCategoryId: m.DefaultCategoryID,
+	}
+}
+
+type ImportProfileOutput struct {
+	ID               string                  `json:"id"`
+	AccountID        string                  `json:"accountID"`
+	Name             string                  `json:"name"`
+	Delimiter        string                  `json:"delimiter"`
+	DecimalSeparator string                  `json:"decimalSeparator"`
+	DateLayout       string                  `json:"dateLayout"`
+	SkipRows         int32                   `json:"skipRows"`
+	Encoding         string                  `json:"encoding"`
+	Mapping          ImportProfileMappingDTO `json:"mapping"`
+	CreatedAt        *time.Time              `json:"createdAt"`
+	UpdatedAt        *time.Time              `json:"updatedAt"`
+}
+
+func NewImportProfileOutput(importProfile *desc.ImportProfile) *ImportProfileOutput {
+	out := &ImportProfileOutput{
+		ID:               importProfile.Id,
+		AccountID:        importProfile.AccountId,
+		Name:             importProfile.Name,
+		Delimiter:        importProfile.Delimiter,
+		DecimalSeparator: importProfile.DecimalSeparator,
+		DateLayout:       importProfile.DateLayout,
+		SkipRows:         importProfile.SkipRows,
+		Encoding:         importProfile.Encoding,
+		CreatedAt:        fromProtoTimestamp(importProfile.CreatedAt),
+		UpdatedAt:        fromProtoTimestamp(importProfile.UpdatedAt),
+	}
+
+	if mapping := importProfile.Mapping; mapping != nil {
+		out.Mapping = ImportProfileMappingDTO{
+			HasHeader:         mapping.HasHeader,
+			SignConvention:    mapping.SignConvention,
+			IncomeValues:      mapping.IncomeValues,
+			DefaultCategoryID: mapping.DefaultCategoryId,
+		}
+
+		if columns := mapping.Columns; columns != nil {
+			out.Mapping.Columns = ImportProfileColumnsDTO{
+				Date:        columns.Date,
+				Amount:      columns.Amount,
+				Debit:       columns.Debit,
+				Credit:      columns.Credit,
+				IsIncome:    columns.IsIncome,
+				Description: columns.Description,
+				CategoryID:  columns.CategoryId,
+				Currency:    columns.Currency,
+				ExternalID:  columns.ExternalId,
+			}
+		}
+	}
+
+	return out
+}
+
+// CategoryRuleConditionsDTO - все заданные условия должны выполниться, незаданное условие не проверяется
+type CategoryRuleConditionsDTO struct {
+	// DescriptionContains - подстрока описания без учета регистра
+	DescriptionContains *string `json:"descriptionContains" example:"яндекс такси"`
+	// DescriptionRegex - регулярное выражение RE2
+	DescriptionRegex *string `json:"descriptionRegex" example:"(?i)^(pyaterochka|magnit)"`
+	// AmountMin, AmountMax - границы суммы по модулю включительно
+	AmountMin *string `json:"amountMin" example:"100"`
+	AmountMax *string `json:"amountMax" example:"5000"`
+	IsIncome  *bool   `json:"isIncome"`
+	WalletID  *string `json:"walletID" validate:"omitempty,uuid"`
+}
+
+func (m *CategoryRuleConditionsDTO) ToProto() *desc.CategoryRuleConditions {
+	return &desc.CategoryRuleConditions{
+		DescriptionContains: m.DescriptionContains,
+		DescriptionRegex:    m.DescriptionRegex,
+		AmountMin:           m.AmountMin,
+		AmountMax:           m.AmountMax,
+		IsIncome:            m.IsIncome,
+		WalletId:            m.WalletID,
+	}
+}
+
+type CategoryRuleOutput struct {
+	ID         string                    `json:"id"`
+	AccountID  string                    `json:"accountID"`
+	Name       string                    `json:"name"`
+	Priority   int32                     `json:"priority"`
+	CategoryID uint64                    `json:"categoryID"`
+	Conditions CategoryRuleConditionsDTO `json:"conditions"`
+	CreatedAt  *time.Time                `json:"createdAt"`
+	UpdatedAt  *time.Time                `json:"updatedAt"`
+}
+
+func NewCategoryRuleOutput(rule *desc.CategoryRule) *CategoryRuleOutput {
+	out := &CategoryRuleOutput{
+		ID:         rule.Id,
+		AccountID:  rule.AccountId,
+		Name:       rule.Name,
+		Priority:   rule.Priority,
+		CategoryID: uint64(rule.CategoryId),
+		CreatedAt:  fromProtoTimestamp(rule.CreatedAt),
+		UpdatedAt:  fromProtoTimestamp(rule.UpdatedAt),
+	}
+
+	if conditions := rule.Conditions; conditions != nil {
+		out.Conditions = CategoryRuleConditionsDTO{
+			DescriptionContains: conditions.DescriptionContains,
+			DescriptionRegex:    conditions.DescriptionRegex,
+			AmountMin:           conditions.AmountMin,
+			AmountMax:           conditions.AmountMax,
+			IsIncome:            conditions.IsIncome,
+			WalletID:            conditions.WalletId,
+		}
+	}
+
+	return out
+}
+
+type CategoryRuleChangeOutput struct {
+	TransactionID string `json:"transactionID"`
+	RuleID        string `json:"ruleID"`
+	OldCategoryID uint64 `json:"oldCategoryID"`
+	NewCategoryID uint64 `json:"newCategoryID"`
+}
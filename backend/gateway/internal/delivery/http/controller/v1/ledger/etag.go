@@ -0,0 +1,86 @@
+package ledger
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+)
+
+// etagFromVersion - ETag ресурса по его версии
+func etagFromVersion(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// versionFromIfMatch - ожидаемая версия из заголовка If-Match, nil - заголовок не передан или равен *
+func versionFromIfMatch(c *fiber.Ctx) (*int64, error) {
+	value := strings.TrimSpace(c.Get(fiber.HeaderIfMatch))
+	if value == "" || value == "*" {
+		return nil, nil
+	}
+
+	// прокси могут ослаблять ETag при сжатии ответа, поэтому слабый тег принимается как сильный
+	value = strings.TrimPrefix(value, "W/")
+
+	if len(value) < 2 || !strings.HasPrefix(value, `"`) || !strings.HasSuffix(value, `"`) {
+		return nil, appErrors.ErrBadRequest.WithHints("invalid If-Match")
+	}
+
+	version, err := strconv.ParseInt(value[1:len(value)-1], 10, 64)
+	if err != nil {
+		return nil, appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid If-Match")
+	}
+
+	return &version, nil
+}
+
+// versionConflictErr - конфликт по версии из If-Match отдается как 412, без заголовка остается 409,
+// как и в маршрутах, где версия передается в теле запроса
+func versionConflictErr(appErr *appErrors.AppError, version *int64) *appErrors.AppError {
+	if version == nil {
+		return appErr
+	}
+
+	return appErr.WithMeta(appErrors.ErrVersionPrecondition.Meta())
+}
+
+// withCurrentTransaction - дополняет конфликт версий текущим состоянием транзакции
+func (ctrl *Controller) withCurrentTransaction(c *fiber.Ctx, id string, version *int64, err error) error {
+	appErr, ok := appErrors.ExtractError(err)
+	if !ok || !errors.Is(err, appErrors.ErrVersionConflict) {
+		return err
+	}
+
+	appErr = versionConflictErr(appErr, version)
+
+	data, getErr := ctrl.ledgerAdapter.Api().GetTransaction(c.Context(), &desc.GetTransactionRequest{Id: id})
+	if getErr != nil {
+		return appErr
+	}
+
+	c.Set(fiber.HeaderETag, etagFromVersion(data.Item.Version))
+
+	return appErr.WithDetail("current", false, NewTransactionOutput(data.Item))
+}
+
+// withCurrentBudget - дополняет конфликт версий текущим состоянием бюджета
+func (ctrl *Controller) withCurrentBudget(c *fiber.Ctx, id string, version *int64, err error) error {
+	appErr, ok := appErrors.ExtractError(err)
+	if !ok || !errors.Is(err, appErrors.ErrVersionConflict) {
+		return err
+	}
+
+	appErr = versionConflictErr(appErr, version)
+
+	data, getErr := ctrl.ledgerAdapter.Api().GetBudget(c.Context(), &desc.GetBudgetRequest{Id: id})
+	if getErr != nil {
+		return appErr
+	}
+
+	c.Set(fiber.HeaderETag, etagFromVersion(data.Item.Version))
+
+	return appErr.WithDetail("current", false, NewBudgetOutput(data.Item))
+}
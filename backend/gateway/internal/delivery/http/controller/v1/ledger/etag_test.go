@@ -0,0 +1,68 @@
+package ledger
+
+import (
+	"errors"
+	"testing"
+
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+)
+
+func TestVersionConflictErr_Table(t *testing.T) {
+	t.Parallel()
+
+	version := int64(3)
+
+	tests := []struct {
+		name     string
+		version  *int64
+		wantCode int
+	}{
+		{
+			// версия из тела запроса (_version) и маршруты без If-Match получают 409
+			name:     "without_if_match",
+			wantCode: 409,
+		},
+		{
+			name:     "with_if_match",
+			version:  &version,
+			wantCode: 412,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := appErrors.Chainf(appErrors.ErrVersionConflict.WithHints("version mismatch"), "test")
+
+			appErr, ok := appErrors.ExtractError(err)
+			if !ok {
+				t.Fatal("expected app error")
+			}
+
+			got := versionConflictErr(appErr, tt.version)
+
+			if code := int(got.Meta().Code); code != tt.wantCode {
+				t.Errorf("code = %d, want %d", code, tt.wantCode)
+			}
+			if textCode := got.Meta().TextCode; textCode != "VERSION_CONFLICT" {
+				t.Errorf("text code = %q, want VERSION_CONFLICT", textCode)
+			}
+			if !errors.Is(got, appErrors.ErrVersionConflict) {
+				t.Error("expected ErrVersionConflict")
+			}
+			if hints := got.Hints(); len(hints) != 1 || hints[0] != "version mismatch" {
+				t.Errorf("hints = %v", hints)
+			}
+		})
+	}
+}
+
+func TestErrVersionConflict_KeepsConflictCode(t *testing.T) {
+	t.Parallel()
+
+	// auth и клиенты с версией в теле запроса ожидают 409
+	if code := int(appErrors.ErrVersionConflict.Meta().Code); code != 409 {
+		t.Errorf("ErrVersionConflict code = %d, want 409", code)
+	}
+}
@@ -0,0 +1,83 @@
+package ledger
+
+import (
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+)
+
+type ExchangeRateListHandlerOutput struct {
+	Items []*ExchangeRateOutput `json:"items"`
+}
+
+// ExchangeRateListHandler - list exchange rates
+// @Summary List exchange rates
+// @Security BearerAuth
+// @Tags ledger
+// @Produce  json
+// @Param currency query string false "Фильтр по валюте (в любом направлении)"
+// @Param date_from query string false "Фильтр по дате курса ОТ в формате 2025-01-30 (год-месяц-день)"
+// @Param date_to query string false "Фильтр по дате курса ДО в формате 2025-01-30 (год-месяц-день)"
+// @Success 200 {object} ExchangeRateListHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/exchange-rates [get]
+func (ctrl *Controller) ExchangeRateListHandler(c *fiber.Ctx) error {
+	const op = "ExchangeRateListHandler"
+
+	request := &desc.ListExchangeRatesRequest{}
+
+	filterCurrency := c.Query("currency")
+	if filterCurrency != "" {
+		request.FilterCurrency = &filterCurrency
+	}
+
+	filterDateFromStr := c.Query("date_from")
+	if filterDateFromStr != "" {
+		filterDateFrom, err := civil.ParseDate(filterDateFromStr)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid date_from"),
+				"%s.%s", ctrl.pkg, op,
+			)
+		}
+
+		request.FilterRateDateFrom = &desc.Date{
+			Year:  int32(filterDateFrom.Year),
+			Month: int32(filterDateFrom.Month),
+			Day:   int32(filterDateFrom.Day),
+		}
+	}
+
+	filterDateToStr := c.Query("date_to")
+	if filterDateToStr != "" {
+		filterDateTo, err := civil.ParseDate(filterDateToStr)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid date_to"),
+				"%s.%s", ctrl.pkg, op,
+			)
+		}
+
+		request.FilterRateDateTo = &desc.Date{
+			Year:  int32(filterDateTo.Year),
+			Month: int32(filterDateTo.Month),
+			Day:   int32(filterDateTo.Day),
+		}
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().ListExchangeRates(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := ExchangeRateListHandlerOutput{
+		Items: make([]*ExchangeRateOutput, 0, len(data.Items)),
+	}
+
+	for _, data := range data.Items {
+		out.Items = append(out.Items, NewExchangeRateOutput(data))
+	}
+
+	return c.JSON(out)
+}
@@ -0,0 +1,82 @@
+package ledger
+
+import (
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/gateway/internal/delivery/http/httperrs"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/m11ano/budget_planner/backend/gateway/pkg/validation"
+)
+
+type ExchangeRateUpsertHandlerInputItem struct {
+	CurrencyFrom string     `json:"currencyFrom" validate:"required,len=3" example:"USD"`
+	CurrencyTo   string     `json:"currencyTo" validate:"required,len=3" example:"RUB"`
+	RateDate     civil.Date `json:"rateDate" swaggertype:"string" example:"2025-12-20"`
+	// Rate - сколько единиц currencyTo за одну единицу currencyFrom
+	Rate string `json:"rate" validate:"required" example:"90.5"`
+}
+
+type ExchangeRateUpsertHandlerInput struct {
+	Items []*ExchangeRateUpsertHandlerInputItem `json:"items" validate:"required,min=1,dive,required"`
+}
+
+type ExchangeRateUpsertHandlerOutput struct {
+	Items []*ExchangeRateOutput `json:"items"`
+}
+
+// ExchangeRateUpsertHandler - upsert exchange rates
+// @Summary Create or update exchange rates
+// @Security BearerAuth
+// @Tags ledger
+// @Accept  json
+// @Produce  json
+// @Param request body ExchangeRateUpsertHandlerInput true "JSON"
+// @Success 200 {object} ExchangeRateUpsertHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/exchange-rates [put]
+func (ctrl *Controller) ExchangeRateUpsertHandler(c *fiber.Ctx) error {
+	const op = "ExchangeRateUpsertHandler"
+
+	in := &ExchangeRateUpsertHandlerInput{}
+
+	if err := c.BodyParser(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrCantParseBody, "%s.%s", ctrl.pkg, op)
+	}
+
+	if err := ctrl.vldtr.Struct(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
+	}
+
+	request := &desc.UpsertExchangeRatesRequest{
+		Items: make([]*desc.ExchangeRate, 0, len(in.Items)),
+	}
+
+	for _, item := range in.Items {
+		request.Items = append(request.Items, &desc.ExchangeRate{
+			CurrencyFrom: item.CurrencyFrom,
+			CurrencyTo:   item.CurrencyTo,
+			RateDate: &desc.Date{
+				Year:  int32(item.RateDate.Year),
+				Month: int32(item.RateDate.Month),
+				Day:   int32(item.RateDate.Day),
+			},
+			Rate: item.Rate,
+		})
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().UpsertExchangeRates(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := ExchangeRateUpsertHandlerOutput{
+		Items: make([]*ExchangeRateOutput, 0, len(data.Items)),
+	}
+
+	for _, data := range data.Items {
+		out.Items = append(out.Items, NewExchangeRateOutput(data))
+	}
+
+	return c.JSON(out)
+}
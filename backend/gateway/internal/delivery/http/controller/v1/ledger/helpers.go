@@ -1,8 +1,13 @@
 package ledger
 
 import (
+	"bytes"
+	"fmt"
 	"time"
 
+	"cloud.google.com/go/civil"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -13,3 +18,58 @@ func fromProtoTimestamp(ts *timestamppb.Timestamp) *time.Time {
 	t := ts.AsTime()
 	return &t
 }
+
+func fromProtoDate(d *desc.Date) civil.Date {
+	date, err := civil.ParseDate(fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day))
+	if err != nil {
+		panic(err)
+	}
+	return date
+}
+
+func toProtoDate(d civil.Date) *desc.Date {
+	return &desc.Date{
+		Year:  int32(d.Year),
+		Month: int32(d.Month),
+		Day:   int32(d.Day),
+	}
+}
+
+// importFormatSniffLen - сколько байт начала файла просматривается для определения формата
+const importFormatSniffLen = 4096
+
+// detectImportFormat - формат выписки по содержимому файла, по умолчанию csv
+func detectImportFormat(data []byte) string {
+	head := data[:min(len(data), importFormatSniffLen)]
+	head = bytes.TrimPrefix(head, []byte{0xEF, 0xBB, 0xBF})
+	head = bytes.ToUpper(bytes.TrimSpace(head))
+
+	switch {
+	case bytes.HasPrefix(head, []byte("OFXHEADER")),
+		bytes.HasPrefix(head, []byte("<")) && (bytes.Contains(head, []byte("<?OFX")) || bytes.Contains(head, []byte("<OFX>"))):
+		return "ofx"
+	case bytes.HasPrefix(head, []byte("!TYPE:")),
+		bytes.HasPrefix(head, []byte("!ACCOUNT")),
+		bytes.HasPrefix(head, []byte("!OPTION:")):
+		return "qif"
+	case bytes.HasPrefix(head, []byte("<")) &&
+		(bytes.Contains(head, []byte("CAMT.053")) || bytes.Contains(head, []byte("<BKTOCSTMRSTMT"))):
+		return "camt053"
+	}
+
+	return "csv"
+}
+
+// exportFormatFromQuery - формат выгрузки из query-параметра, пустое значение - csv
+func exportFormatFromQuery(value string) (desc.ExportFormat, bool) {
+	switch value {
+	case "", "csv":
+		return desc.ExportFormat_EXPORT_FORMAT_CSV, true
+	case "xlsx":
+		return desc.ExportFormat_EXPORT_FORMAT_XLSX, true
+	case "jsonl":
+		return desc.ExportFormat_EXPORT_FORMAT_JSONL, true
+	}
+
+	return desc.ExportFormat_EXPORT_FORMAT_UNSPECIFIED, false
+}
@@ -0,0 +1,87 @@
+package ledger
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDetectImportFormat_Table(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{
+			name: "ofx_sgml_header",
+			data: []byte("OFXHEADER:100\r\nDATA:OFXSGML\r\n\r\n<OFX>\r\n"),
+			want: "ofx",
+		},
+		{
+			name: "ofx_xml_processing_instruction",
+			data: []byte(`<?xml version="1.0"?><?OFX OFXHEADER="200" VERSION="220"?><OFX></OFX>`),
+			want: "ofx",
+		},
+		{
+			name: "ofx_xml_root_lowercase_with_bom",
+			data: []byte("\xEF\xBB\xBF  <ofx><signonmsgsrsv1/></ofx>"),
+			want: "ofx",
+		},
+		{
+			name: "qif_type",
+			data: []byte("!Type:Bank\nD01/15/2025\nT-5\n^\n"),
+			want: "qif",
+		},
+		{
+			name: "qif_account",
+			data: []byte("!Account\nNCard\n^\n"),
+			want: "qif",
+		},
+		{
+			name: "qif_option",
+			data: []byte("!Option:AutoSwitch\n!Type:Cash\n"),
+			want: "qif",
+		},
+		{
+			name: "camt053_namespace",
+			data: []byte(`<?xml version="1.0"?><Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08">`),
+			want: "camt053",
+		},
+		{
+			name: "camt053_without_namespace",
+			data: []byte(`<Document><BkToCstmrStmt><Stmt></Stmt></BkToCstmrStmt></Document>`),
+			want: "camt053",
+		},
+		{
+			name: "camt053_marker_after_sniff_window",
+			data: append([]byte("<Document>"), append(bytes.Repeat([]byte(" "), importFormatSniffLen), []byte("<BkToCstmrStmt>")...)...),
+			want: "csv",
+		},
+		{
+			name: "csv",
+			data: []byte("date;amount;description\n2025-01-15;-5;Coffee\n"),
+			want: "csv",
+		},
+		{
+			name: "unknown_xml",
+			data: []byte(`<?xml version="1.0"?><Invoice></Invoice>`),
+			want: "csv",
+		},
+		{
+			name: "empty",
+			data: nil,
+			want: "csv",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := detectImportFormat(tt.data); got != tt.want {
+				t.Errorf("detectImportFormat() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/gateway/internal/delivery/http/httperrs"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/m11ano/budget_planner/backend/gateway/pkg/validation"
+)
+
+type ImportProfileAddHandlerInput struct {
+	Name             string                  `json:"name" validate:"required"`
+	Delimiter        string                  `json:"delimiter" validate:"required" example:";"`
+	DecimalSeparator string                  `json:"decimalSeparator" validate:"required" example:","`
+	DateLayout       string                  `json:"dateLayout" validate:"required" example:"DD.MM.YYYY"`
+	SkipRows         int32                   `json:"skipRows" validate:"gte=0"`
+	Encoding         string                  `json:"encoding" example:"windows-1251"`
+	Mapping          ImportProfileMappingDTO `json:"mapping"`
+}
+
+type ImportProfileAddHandlerOutput struct {
+	Item *ImportProfileOutput `json:"item"`
+}
+
+// ImportProfileAddHandler - add import profile
+// @Summary Add import profile
+// @Description Column is a header name or 1-based column number
+// @Security BearerAuth
+// @Tags ledger
+// @Accept  json
+// @Produce  json
+// @Param request body ImportProfileAddHandlerInput true "JSON"
+// @Success 200 {object} ImportProfileAddHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/import-profiles [post]
+func (ctrl *Controller) ImportProfileAddHandler(c *fiber.Ctx) error {
+	const op = "ImportProfileAddHandler"
+
+	in := &ImportProfileAddHandlerInput{}
+
+	if err := c.BodyParser(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrCantParseBody, "%s.%s", ctrl.pkg, op)
+	}
+
+	if err := ctrl.vldtr.Struct(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
+	}
+
+	request := &desc.AddImportProfileRequest{
+		Name:             in.Name,
+		Delimiter:        in.Delimiter,
+		DecimalSeparator: in.DecimalSeparator,
+		DateLayout:       in.DateLayout,
+		SkipRows:         in.SkipRows,
+		Encoding:         in.Encoding,
+		Mapping:          in.Mapping.ToProto(),
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().AddImportProfile(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := ImportProfileAddHandlerOutput{
+		Item: NewImportProfileOutput(data.Item),
+	}
+
+	return c.JSON(out)
+}
@@ -0,0 +1,49 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+)
+
+type ImportProfileGetHandlerOutput struct {
+	Item *ImportProfileOutput `json:"item"`
+}
+
+// ImportProfileGetHandler - get import profile
+// @Summary Get import profile
+// @Security BearerAuth
+// @Tags ledger
+// @Produce  json
+// @Param id path string true "ID"
+// @Success 200 {object} ImportProfileGetHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/import-profiles/{id} [get]
+func (ctrl *Controller) ImportProfileGetHandler(c *fiber.Ctx) error {
+	const op = "ImportProfileGetHandler"
+
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return appErrors.Chainf(
+			appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid id"),
+			"%s.%s", ctrl.pkg, op,
+		)
+	}
+
+	request := &desc.GetImportProfileRequest{
+		Id: id.String(),
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().GetImportProfile(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := ImportProfileGetHandlerOutput{
+		Item: NewImportProfileOutput(data.Item),
+	}
+
+	return c.JSON(out)
+}
@@ -0,0 +1,40 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+)
+
+type ImportProfileListHandlerOutput struct {
+	Items []*ImportProfileOutput `json:"items"`
+}
+
+// ImportProfileListHandler - list import profiles
+// @Summary List import profiles
+// @Security BearerAuth
+// @Tags ledger
+// @Produce  json
+// @Success 200 {object} ImportProfileListHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/import-profiles [get]
+func (ctrl *Controller) ImportProfileListHandler(c *fiber.Ctx) error {
+	const op = "ImportProfileListHandler"
+
+	request := &desc.ListImportProfilesRequest{}
+
+	data, err := ctrl.ledgerAdapter.Api().ListImportProfiles(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := ImportProfileListHandlerOutput{
+		Items: make([]*ImportProfileOutput, 0, len(data.Items)),
+	}
+
+	for _, data := range data.Items {
+		out.Items = append(out.Items, NewImportProfileOutput(data))
+	}
+
+	return c.JSON(out)
+}
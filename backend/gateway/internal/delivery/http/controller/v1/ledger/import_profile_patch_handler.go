@@ -0,0 +1,85 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/gateway/internal/delivery/http/httperrs"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/m11ano/budget_planner/backend/gateway/pkg/validation"
+)
+
+type ImportProfilePatchHandlerInput struct {
+	Name             *string `json:"name"`
+	Delimiter        *string `json:"delimiter"`
+	DecimalSeparator *string `json:"decimalSeparator"`
+	DateLayout       *string `json:"dateLayout"`
+	SkipRows         *int32  `json:"skipRows" validate:"omitempty,gte=0"`
+	Encoding         *string `json:"encoding"`
+	// Mapping - заменяется целиком
+	Mapping *ImportProfileMappingDTO `json:"mapping"`
+}
+
+type ImportProfilePatchHandlerOutput struct {
+	Item *ImportProfileOutput `json:"item"`
+}
+
+// ImportProfilePatchHandler - patch import profile
+// @Summary Patch import profile
+// @Description Mapping is replaced as a whole
+// @Security BearerAuth
+// @Tags ledger
+// @Accept  json
+// @Produce  json
+// @Param request body ImportProfilePatchHandlerInput true "JSON"
+// @Param id path string true "ID"
+// @Success 200 {object} ImportProfilePatchHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/import-profiles/{id} [patch]
+func (ctrl *Controller) ImportProfilePatchHandler(c *fiber.Ctx) error {
+	const op = "ImportProfilePatchHandler"
+
+	in := &ImportProfilePatchHandlerInput{}
+
+	if err := c.BodyParser(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrCantParseBody, "%s.%s", ctrl.pkg, op)
+	}
+
+	if err := ctrl.vldtr.Struct(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
+	}
+
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return appErrors.Chainf(
+			appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid id"),
+			"%s.%s", ctrl.pkg, op,
+		)
+	}
+
+	request := &desc.PatchImportProfileRequest{
+		Id:               id.String(),
+		Name:             in.Name,
+		Delimiter:        in.Delimiter,
+		DecimalSeparator: in.DecimalSeparator,
+		DateLayout:       in.DateLayout,
+		SkipRows:         in.SkipRows,
+		Encoding:         in.Encoding,
+	}
+
+	if in.Mapping != nil {
+		request.Mapping = in.Mapping.ToProto()
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().PatchImportProfile(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := ImportProfilePatchHandlerOutput{
+		Item: NewImportProfileOutput(data.Item),
+	}
+
+	return c.JSON(out)
+}
@@ -0,0 +1,85 @@
+package ledger
+
+import (
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/gateway/internal/delivery/http/httperrs"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/m11ano/budget_planner/backend/gateway/pkg/validation"
+)
+
+type RecurringRuleAddHandlerInput struct {
+	Amount      string  `json:"amount"`
+	CategoryID  uint64  `json:"categoryID"`
+	Description string  `json:"description"`
+	IsIncome    bool    `json:"isIncome"`
+	WalletID    *string `json:"walletID" validate:"omitempty,uuid"`
+	// по умолчанию - валюта кошелька, либо базовая валюта аккаунта
+	Currency *string `json:"currency" validate:"omitempty,len=3" example:"RUB"`
+
+	Frequency string `json:"frequency" validate:"required,oneof=weekly monthly yearly" example:"monthly"`
+	Interval  int32  `json:"interval" validate:"min=1" example:"1"`
+	// DayOfMonth - только для monthly, по умолчанию - день даты начала
+	DayOfMonth int32       `json:"dayOfMonth" validate:"min=0,max=31"`
+	StartDate  civil.Date  `json:"startDate" swaggertype:"string" example:"2025-12-20"`
+	EndDate    *civil.Date `json:"endDate" swaggertype:"string" example:"2026-12-20"`
+	Count      *int32      `json:"count" validate:"omitempty,min=1"`
+}
+
+type RecurringRuleAddHandlerOutput struct {
+	Item *RecurringRuleOutput `json:"item"`
+}
+
+// RecurringRuleAddHandler - add recurring rule
+// @Summary Add recurring rule
+// @Security BearerAuth
+// @Tags ledger
+// @Accept  json
+// @Produce  json
+// @Param request body RecurringRuleAddHandlerInput true "JSON"
+// @Success 200 {object} RecurringRuleAddHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/recurring-rules [post]
+func (ctrl *Controller) RecurringRuleAddHandler(c *fiber.Ctx) error {
+	const op = "RecurringRuleAddHandler"
+
+	in := &RecurringRuleAddHandlerInput{}
+
+	if err := c.BodyParser(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrCantParseBody, "%s.%s", ctrl.pkg, op)
+	}
+
+	if err := ctrl.vldtr.Struct(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
+	}
+
+	request := &desc.AddRecurringRuleRequest{
+		Amount:      in.Amount,
+		IsIncome:    in.IsIncome,
+		CategoryId:  int64(in.CategoryID),
+		Description: in.Description,
+		WalletId:    in.WalletID,
+		Currency:    in.Currency,
+		Frequency:   in.Frequency,
+		Interval:    in.Interval,
+		DayOfMonth:  in.DayOfMonth,
+		StartDate:   toProtoDate(in.StartDate),
+		Count:       in.Count,
+	}
+
+	if in.EndDate != nil {
+		request.EndDate = toProtoDate(*in.EndDate)
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().AddRecurringRule(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := RecurringRuleAddHandlerOutput{
+		Item: NewRecurringRuleOutput(data.Item),
+	}
+
+	return c.JSON(out)
+}
@@ -0,0 +1,49 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+)
+
+type RecurringRuleGetHandlerOutput struct {
+	Item *RecurringRuleOutput `json:"item"`
+}
+
+// RecurringRuleGetHandler - get recurring rule
+// @Summary Get recurring rule
+// @Security BearerAuth
+// @Tags ledger
+// @Produce  json
+// @Param id path string true "ID"
+// @Success 200 {object} RecurringRuleGetHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/recurring-rules/{id} [get]
+func (ctrl *Controller) RecurringRuleGetHandler(c *fiber.Ctx) error {
+	const op = "RecurringRuleGetHandler"
+
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return appErrors.Chainf(
+			appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid id"),
+			"%s.%s", ctrl.pkg, op,
+		)
+	}
+
+	request := &desc.GetRecurringRuleRequest{
+		Id: id.String(),
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().GetRecurringRule(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := RecurringRuleGetHandlerOutput{
+		Item: NewRecurringRuleOutput(data.Item),
+	}
+
+	return c.JSON(out)
+}
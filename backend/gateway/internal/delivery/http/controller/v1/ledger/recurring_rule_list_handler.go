@@ -0,0 +1,56 @@
+package ledger
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+)
+
+type RecurringRuleListHandlerOutput struct {
+	Items []*RecurringRuleOutput `json:"items"`
+}
+
+// RecurringRuleListHandler - list recurring rules
+// @Summary List recurring rules
+// @Security BearerAuth
+// @Tags ledger
+// @Produce  json
+// @Param is_paused query bool false "Filter by paused flag"
+// @Success 200 {object} RecurringRuleListHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/recurring-rules [get]
+func (ctrl *Controller) RecurringRuleListHandler(c *fiber.Ctx) error {
+	const op = "RecurringRuleListHandler"
+
+	request := &desc.ListRecurringRulesRequest{}
+
+	filterIsPausedStr := c.Query("is_paused")
+	if filterIsPausedStr != "" {
+		filterIsPaused, err := strconv.ParseBool(filterIsPausedStr)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid is_paused"),
+				"%s.%s", ctrl.pkg, op,
+			)
+		}
+
+		request.FilterIsPaused = &filterIsPaused
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().ListRecurringRules(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := RecurringRuleListHandlerOutput{
+		Items: make([]*RecurringRuleOutput, 0, len(data.Items)),
+	}
+
+	for _, data := range data.Items {
+		out.Items = append(out.Items, NewRecurringRuleOutput(data))
+	}
+
+	return c.JSON(out)
+}
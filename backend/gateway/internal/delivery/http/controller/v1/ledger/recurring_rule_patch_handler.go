@@ -0,0 +1,105 @@
+package ledger
+
+import (
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/gateway/internal/delivery/http/httperrs"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/m11ano/budget_planner/backend/gateway/pkg/validation"
+)
+
+type RecurringRulePatchHandlerInput struct {
+	Amount      *string `json:"amount"`
+	Currency    *string `json:"currency" validate:"omitempty,len=3" example:"RUB"`
+	CategoryID  *uint64 `json:"categoryID"`
+	Description *string `json:"description"`
+	// пустая строка - отвязать от кошелька
+	WalletID *string `json:"walletID" validate:"omitempty,uuid"`
+	// пустая строка снимает ограничение
+	EndDate *string `json:"endDate" example:"2026-12-20"`
+	// 0 снимает ограничение
+	Count    *int32 `json:"count" validate:"omitempty,min=0"`
+	IsPaused *bool  `json:"isPaused"`
+}
+
+type RecurringRulePatchHandlerOutput struct {
+	Item *RecurringRuleOutput `json:"item"`
+}
+
+// RecurringRulePatchHandler - patch recurring rule
+// @Summary Patch recurring rule
+// @Security BearerAuth
+// @Tags ledger
+// @Accept  json
+// @Produce  json
+// @Param request body RecurringRulePatchHandlerInput true "JSON"
+// @Param id path string true "ID"
+// @Success 200 {object} RecurringRulePatchHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/recurring-rules/{id} [patch]
+func (ctrl *Controller) RecurringRulePatchHandler(c *fiber.Ctx) error {
+	const op = "RecurringRulePatchHandler"
+
+	in := &RecurringRulePatchHandlerInput{}
+
+	if err := c.BodyParser(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrCantParseBody, "%s.%s", ctrl.pkg, op)
+	}
+
+	if err := ctrl.vldtr.Struct(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
+	}
+
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return appErrors.Chainf(
+			appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid id"),
+			"%s.%s", ctrl.pkg, op,
+		)
+	}
+
+	request := &desc.PatchRecurringRuleRequest{
+		Id:          id.String(),
+		Amount:      in.Amount,
+		Currency:    in.Currency,
+		Description: in.Description,
+		WalletId:    in.WalletID,
+		Count:       in.Count,
+		IsPaused:    in.IsPaused,
+	}
+
+	if in.CategoryID != nil {
+		categoryID := int64(*in.CategoryID)
+		request.CategoryId = &categoryID
+	}
+
+	if in.EndDate != nil {
+		request.EndDate = &desc.Date{}
+
+		if *in.EndDate != "" {
+			endDate, err := civil.ParseDate(*in.EndDate)
+			if err != nil {
+				return appErrors.Chainf(
+					appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid endDate"),
+					"%s.%s", ctrl.pkg, op,
+				)
+			}
+
+			request.EndDate = toProtoDate(endDate)
+		}
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().PatchRecurringRule(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := RecurringRulePatchHandlerOutput{
+		Item: NewRecurringRuleOutput(data.Item),
+	}
+
+	return c.JSON(out)
+}
@@ -0,0 +1,81 @@
+package ledger
+
+import (
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+)
+
+// ReportExportHandler - export reports
+// @Summary Export reports
+// @Description Reports by period and category with category titles and budget columns
+// @Security BearerAuth
+// @Tags ledger
+// @Param date_from query string false "Фильтр по дате ОТ в формате 2025-01-30 (год-месяц-день)"
+// @Param date_to query string false "Фильтр по дате ДО в формате 2025-01-30 (год-месяц-день)"
+// @Param currency query string false "Валюта отчета, по умолчанию - базовая валюта аккаунта"
+// @Param format query string false "csv (default), xlsx or jsonl"
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Produce application/jsonl
+// @Success 200 "Export file"
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/reports/export [get]
+func (ctrl *Controller) ReportExportHandler(c *fiber.Ctx) error {
+	const op = "ReportExportHandler"
+
+	format, ok := exportFormatFromQuery(c.Query("format"))
+	if !ok {
+		return appErrors.Chainf(
+			appErrors.ErrBadRequest.WithHints("invalid format"),
+			"%s.%s", ctrl.pkg, op,
+		)
+	}
+
+	request := &desc.ExportReportsRequest{
+		Format: format,
+	}
+
+	filterDateFromStr := c.Query("date_from")
+	if filterDateFromStr != "" {
+		filterDateFrom, err := civil.ParseDate(filterDateFromStr)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid date_from"),
+				"%s.%s", ctrl.pkg, op,
+			)
+		}
+
+		request.DateFrom = toProtoDate(filterDateFrom)
+	}
+
+	filterDateToStr := c.Query("date_to")
+	if filterDateToStr != "" {
+		filterDateTo, err := civil.ParseDate(filterDateToStr)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid date_to"),
+				"%s.%s", ctrl.pkg, op,
+			)
+		}
+
+		request.DateTo = toProtoDate(filterDateTo)
+	}
+
+	currency := c.Query("currency")
+	if currency != "" {
+		request.Currency = &currency
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().ExportReports(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	c.Set("Content-Type", data.ContentType)
+	c.Attachment("reports." + data.FileExtension)
+	c.Set("Cache-Control", "no-store")
+
+	return c.Send(data.Data)
+}
@@ -10,6 +10,7 @@ import (
 type ReportListHandlerOutput struct {
 	Reports  []*ReportOutput `json:"reports"`
 	HitCache bool            `json:"hitCache"`
+	Currency string          `json:"currency"`
 }
 
 // ReportListHandler - reports
@@ -19,6 +20,7 @@ type ReportListHandlerOutput struct {
 // @Produce  json
 // @Param date_from query string false "Фильтр по дате ОТ в формате 2025-01-30 (год-месяц-день)"
 // @Param date_to query string false "Фильтр по дате ДО в формате 2025-01-30 (год-месяц-день)"
+// @Param currency query string false "Валюта отчета, по умолчанию - базовая валюта аккаунта"
 // @Success 200 {object} ReportListHandlerOutput
 // @Failure 400 {object} middleware.ErrorJSON
 // @Router /ledger/reports [get]
@@ -61,6 +63,11 @@ func (ctrl *Controller) ReportListHandler(c *fiber.Ctx) error {
 		}
 	}
 
+	currency := c.Query("currency")
+	if currency != "" {
+		request.Currency = &currency
+	}
+
 	data, err := ctrl.ledgerAdapter.Api().ListReports(c.Context(), request)
 	if err != nil {
 		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
@@ -69,6 +76,7 @@ func (ctrl *Controller) ReportListHandler(c *fiber.Ctx) error {
 	out := ReportListHandlerOutput{
 		Reports:  make([]*ReportOutput, 0, len(data.Reports)),
 		HitCache: data.HitCache,
+		Currency: data.Currency,
 	}
 
 	for _, data := range data.Reports {
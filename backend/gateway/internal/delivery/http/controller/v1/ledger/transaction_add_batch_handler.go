@@ -0,0 +1,63 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/gateway/internal/delivery/http/httperrs"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/m11ano/budget_planner/backend/gateway/pkg/validation"
+	"github.com/samber/lo"
+)
+
+type TransactionAddBatchHandlerInput struct {
+	// создаются атомарно, при ошибке индекс элемента возвращается в hints как items[N]
+	Items []*TransactionAddHandlerInput `json:"items" validate:"required,min=1,max=50000,dive,required"`
+}
+
+type TransactionAddBatchHandlerOutput struct {
+	// в порядке items
+	IDs      []string               `json:"ids"`
+	Warnings []*BudgetWarningOutput `json:"warnings"`
+}
+
+// TransactionAddBatchHandler - add transactions batch
+// @Summary Add transactions batch
+// @Security BearerAuth
+// @Tags ledger
+// @Accept  json
+// @Produce  json
+// @Param request body TransactionAddBatchHandlerInput true "JSON"
+// @Success 200 {object} TransactionAddBatchHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/transactions/batch [post]
+func (ctrl *Controller) TransactionAddBatchHandler(c *fiber.Ctx) error {
+	const op = "TransactionAddBatchHandler"
+
+	in := &TransactionAddBatchHandlerInput{}
+
+	if err := c.BodyParser(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrCantParseBody, "%s.%s", ctrl.pkg, op)
+	}
+
+	if err := ctrl.vldtr.Struct(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
+	}
+
+	request := &desc.AddTransactionsBatchRequest{
+		Items: lo.Map(in.Items, func(item *TransactionAddHandlerInput, _ int) *desc.AddTransactionRequest {
+			return newAddTransactionRequest(item)
+		}),
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().AddTransactionsBatch(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := TransactionAddBatchHandlerOutput{
+		IDs:      data.Ids,
+		Warnings: NewBudgetWarningsOutput(data.Warnings),
+	}
+
+	return c.JSON(out)
+}
@@ -10,15 +10,21 @@ import (
 )
 
 type TransactionAddHandlerInput struct {
-	Amount      string     `json:"amount"`
+	Amount string `json:"amount"`
+	// 0 - категория подбирается по правилам аккаунта
 	CategoryID  uint64     `json:"categoryID"`
 	Description string     `json:"description"`
 	IsIncome    bool       `json:"isIncome"`
 	OccurredOn  civil.Date `json:"occurredOn" swaggertype:"string" example:"2025-12-20"`
+	WalletID    *string    `json:"walletID" validate:"omitempty,uuid"`
+	// по умолчанию - валюта кошелька, либо базовая валюта аккаунта
+	Currency *string  `json:"currency" validate:"omitempty,len=3" example:"RUB"`
+	Tags     []string `json:"tags" example:"trip,work"`
 }
 
 type TransactionAddHandlerOutput struct {
-	Item *TransactionOutput `json:"item"`
+	Item     *TransactionOutput     `json:"item"`
+	Warnings []*BudgetWarningOutput `json:"warnings"`
 }
 
 // TransactionAddHandler - add transaction
@@ -44,7 +50,21 @@ func (ctrl *Controller) TransactionAddHandler(c *fiber.Ctx) error {
 		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
 	}
 
-	request := &desc.AddTransactionRequest{
+	data, err := ctrl.ledgerAdapter.Api().AddTransaction(c.Context(), newAddTransactionRequest(in))
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := TransactionAddHandlerOutput{
+		Item:     NewTransactionOutput(data.Item),
+		Warnings: NewBudgetWarningsOutput(data.Warnings),
+	}
+
+	return c.JSON(out)
+}
+
+func newAddTransactionRequest(in *TransactionAddHandlerInput) *desc.AddTransactionRequest {
+	return &desc.AddTransactionRequest{
 		Amount:   in.Amount,
 		IsIncome: in.IsIncome,
 		OccurredOn: &desc.Date{
@@ -54,16 +74,8 @@ func (ctrl *Controller) TransactionAddHandler(c *fiber.Ctx) error {
 		},
 		CategoryId:  int64(in.CategoryID),
 		Description: in.Description,
+		WalletId:    in.WalletID,
+		Currency:    in.Currency,
+		Tags:        in.Tags,
 	}
-
-	data, err := ctrl.ledgerAdapter.Api().AddTransaction(c.Context(), request)
-	if err != nil {
-		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
-	}
-
-	out := TransactionAddHandlerOutput{
-		Item: NewTransactionOutput(data.Item),
-	}
-
-	return c.JSON(out)
 }
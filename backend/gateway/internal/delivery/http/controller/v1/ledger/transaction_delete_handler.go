@@ -12,8 +12,10 @@ import (
 // @Security BearerAuth
 // @Tags ledger
 // @Param id path string true "ID"
+// @Param If-Match header string false "ETag из GET, при несовпадении версии - 412"
 // @Success 200
 // @Failure 400 {object} middleware.ErrorJSON
+// @Failure 412 {object} middleware.ErrorJSON "Версия изменилась, в details.current - текущее состояние"
 // @Router /ledger/transactions/{id} [delete]
 func (ctrl *Controller) TransactionDeleteHandler(c *fiber.Ctx) error {
 	const op = "TransactionDeleteHandler"
@@ -27,13 +29,19 @@ func (ctrl *Controller) TransactionDeleteHandler(c *fiber.Ctx) error {
 		)
 	}
 
+	version, err := versionFromIfMatch(c)
+	if err != nil {
+		return appErrors.Chainf(err, "%s.%s", ctrl.pkg, op)
+	}
+
 	request := &desc.DeleteTransactionRequest{
-		Id: id.String(),
+		Id:      id.String(),
+		Version: version,
 	}
 
 	_, err = ctrl.ledgerAdapter.Api().DeleteTransaction(c.Context(), request)
 	if err != nil {
-		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+		return appErrors.Chainf(ctrl.withCurrentTransaction(c, request.Id, version, appErrors.FromGRPCError(err)), "%s.%s", ctrl.pkg, op)
 	}
 
 	return nil
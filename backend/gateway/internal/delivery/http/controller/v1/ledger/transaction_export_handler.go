@@ -1,35 +1,49 @@
 package ledger
 
 import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+
 	"cloud.google.com/go/civil"
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
 	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/samber/lo"
 )
 
 // TransactionExportHandler - export list transactions
 // @Summary Export list transactions
+// @Description Streams the whole filtered transaction history as CSV.
+// @Description xlsx and jsonl files are built at once and limited to 10000 transactions
 // @Security BearerAuth
 // @Tags ledger
 // @Param date_from query string false "Фильтр по дате ОТ в формате 2025-01-30 (год-месяц-день)"
 // @Param date_to query string false "Фильтр по дате ДО в формате 2025-01-30 (год-месяц-день)"
-// @Param limit query int false "Limit"
-// @Param offset query int false "Offset"
+// @Param wallet_id query string false "Фильтр по кошельку"
+// @Param format query string false "csv (default), xlsx or jsonl"
 // @Produce text/csv
-// @Success 200 "CSV file"
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Produce application/jsonl
+// @Success 200 "Export file"
 // @Failure 400 {object} middleware.ErrorJSON
 // @Router /ledger/transactions/export [get]
 func (ctrl *Controller) TransactionExportHandler(c *fiber.Ctx) error {
 	const op = "TransactionExportHandler"
 
-	limit := c.QueryInt("limit", 100)
-	offset := c.QueryInt("offset", 0)
-
-	request := &desc.ListTransactionsRequest{
-		Limit:  int32(limit),
-		Offset: int64(offset),
+	format, ok := exportFormatFromQuery(c.Query("format"))
+	if !ok {
+		return appErrors.Chainf(
+			appErrors.ErrBadRequest.WithHints("invalid format"),
+			"%s.%s", ctrl.pkg, op,
+		)
 	}
 
+	request := &desc.StreamExportTransactionsRequest{}
+
 	filterDateFromStr := c.Query("date_from")
 	if filterDateFromStr != "" {
 		filterDateFrom, err := civil.ParseDate(filterDateFromStr)
@@ -64,8 +78,51 @@ func (ctrl *Controller) TransactionExportHandler(c *fiber.Ctx) error {
 		}
 	}
 
-	data, err := ctrl.ledgerAdapter.Api().CSVExportTransactions(c.Context(), request)
+	filterWalletIDStr := c.Query("wallet_id")
+	if filterWalletIDStr != "" {
+		filterWalletID, err := uuid.Parse(filterWalletIDStr)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid wallet_id"),
+				"%s.%s", ctrl.pkg, op,
+			)
+		}
+
+		request.FilterWalletId = lo.ToPtr(filterWalletID.String())
+	}
+
+	if format != desc.ExportFormat_EXPORT_FORMAT_CSV {
+		data, err := ctrl.ledgerAdapter.Api().ExportTransactions(c.Context(), &desc.ExportTransactionsRequest{
+			Format:               format,
+			FilterOccurredOnFrom: request.FilterOccurredOnFrom,
+			FilterOccurredOnTo:   request.FilterOccurredOnTo,
+			FilterWalletId:       request.FilterWalletId,
+		})
+		if err != nil {
+			return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+		}
+
+		c.Set("Content-Type", data.ContentType)
+		c.Attachment("transactions." + data.FileExtension)
+		c.Set("Cache-Control", "no-store")
+
+		return c.Send(data.Data)
+	}
+
+	// поток живет дольше обработчика: он дочитывается во время записи тела ответа
+	ctx, cancel := context.WithCancel(c.Context())
+
+	stream, err := ctrl.ledgerAdapter.Api().StreamExportTransactions(ctx, request)
 	if err != nil {
+		cancel()
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	// первая часть читается до начала ответа, чтобы ошибки авторизации и валидации
+	// вернулись обычным HTTP-ответом, а не оборванным файлом
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		cancel()
 		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
 	}
 
@@ -73,5 +130,37 @@ func (ctrl *Controller) TransactionExportHandler(c *fiber.Ctx) error {
 	c.Attachment("transactions.csv")
 	c.Set("Cache-Control", "no-store")
 
-	return c.Send(data.Data)
+	logger := ctrl.logger
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer cancel()
+
+		if first == nil {
+			return
+		}
+
+		chunk := first
+		for {
+			if _, err := w.Write(chunk.Chunk); err != nil {
+				return
+			}
+
+			// ошибка записи означает, что клиент отключился: отмена контекста закроет поток
+			if err := w.Flush(); err != nil {
+				return
+			}
+
+			chunk, err = stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if err != nil {
+				logger.ErrorContext(ctx, "stream export transactions", slog.Any("error", err))
+				return
+			}
+		}
+	})
+
+	return nil
 }
@@ -18,6 +18,7 @@ type TransactionGetHandlerOutput struct {
 // @Produce  json
 // @Param id path string true "ID"
 // @Success 200 {object} TransactionGetHandlerOutput
+// @Header 200 {string} ETag "Версия ресурса для If-Match"
 // @Failure 400 {object} middleware.ErrorJSON
 // @Router /ledger/transactions/{id} [get]
 func (ctrl *Controller) TransactionGetHandler(c *fiber.Ctx) error {
@@ -41,6 +42,8 @@ func (ctrl *Controller) TransactionGetHandler(c *fiber.Ctx) error {
 		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
 	}
 
+	c.Set(fiber.HeaderETag, etagFromVersion(data.Item.Version))
+
 	out := TransactionGetHandlerOutput{
 		Item: NewTransactionOutput(data.Item),
 	}
@@ -2,21 +2,63 @@ package ledger
 
 import (
 	"io"
+	"strconv"
+	"time"
 
+	"cloud.google.com/go/civil"
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
 	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/samber/lo"
 )
 
-// TransactionImportHandler - import transactions from CSV
-// @Summary Import transactions from CSV
-// @Description Upload CSV file with transactions for import
+type TransactionImportRowOutput struct {
+	Line int32 `json:"line"`
+	// Status - ok, error, duplicate, updated, flagged или budget_exceeded
+	Status      string      `json:"status" example:"ok"`
+	IsIncome    *bool       `json:"isIncome"`
+	Amount      *string     `json:"amount"`
+	Currency    *string     `json:"currency"`
+	OccurredOn  *civil.Date `json:"occurredOn" swaggertype:"string" example:"2025-12-31"`
+	CategoryID  *uint64     `json:"categoryID"`
+	Description *string     `json:"description"`
+	ErrorCodes  []string    `json:"errorCodes"`
+	Hints       []string    `json:"hints"`
+	ExternalID  *string     `json:"externalID"`
+	// MatchedTransactionID - существующая транзакция, с которой совпала строка
+	MatchedTransactionID *string `json:"matchedTransactionID"`
+}
+
+type TransactionImportHandlerOutput struct {
+	Rows     []*TransactionImportRowOutput `json:"rows"`
+	Imported int32                         `json:"imported"`
+	Rejected int32                         `json:"rejected"`
+	Skipped  int32                         `json:"skipped"`
+	Updated  int32                         `json:"updated"`
+	Flagged  int32                         `json:"flagged"`
+	DryRun   bool                          `json:"dryRun"`
+}
+
+// TransactionImportHandler - import transactions from bank statement
+// @Summary Import transactions from bank statement
+// @Description Upload CSV, OFX 2.x, QIF or camt.053 file with transactions for import.
+// @Description Format is detected from file content unless format is set.
+// @Description OFX, QIF and camt.053 have no categories: default_category_id or import profile default category is used.
+// @Description dry_run validates rows without saving, mode=partial imports valid rows and returns rejected ones
 // @Security BearerAuth
 // @Tags ledger
 // @Accept multipart/form-data
 // @Produce application/json
-// @Param file formData file true "CSV file with transactions"
-// @Success 200 "Import started / completed successfully"
+// @Param file formData file true "Statement file with transactions"
+// @Param format formData string false "csv, ofx, qif or camt053, detected from content if empty"
+// @Param import_profile_id formData string false "Import profile ID, standard format is used if empty"
+// @Param default_category_id formData int false "Category for OFX, QIF and camt.053 operations"
+// @Param dry_run formData bool false "Validate rows without saving"
+// @Param mode formData string false "strict (default) or partial"
+// @Param duplicate_policy formData string false "skip (default), update or flag"
+// @Param match_tolerance_days formData int false "Date tolerance in days for duplicate matching, 0-31"
+// @Success 200 {object} TransactionImportHandlerOutput
 // @Failure 400 {object} middleware.ErrorJSON
 // @Failure 500 {object} middleware.ErrorJSON
 // @Router /ledger/transactions/import [post]
@@ -49,14 +91,107 @@ func (ctrl *Controller) TransactionImportHandler(c *fiber.Ctx) error {
 		)
 	}
 
+	format := c.FormValue("format")
+	if format == "" {
+		format = detectImportFormat(fileData)
+	}
+
 	request := &desc.CSVImportTransactionsRequest{
-		Data: fileData,
+		Data:            fileData,
+		Format:          format,
+		Mode:            c.FormValue("mode"),
+		DuplicatePolicy: c.FormValue("duplicate_policy"),
 	}
 
-	_, err = ctrl.ledgerAdapter.Api().CSVImportTransactions(c.Context(), request)
+	if categoryIDStr := c.FormValue("default_category_id"); categoryIDStr != "" {
+		categoryID, err := strconv.ParseUint(categoryIDStr, 10, 64)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid default_category_id"),
+				"%s.%s", ctrl.pkg, op,
+			)
+		}
+
+		request.DefaultCategoryId = &categoryID
+	}
+
+	if toleranceStr := c.FormValue("match_tolerance_days"); toleranceStr != "" {
+		tolerance, err := strconv.ParseInt(toleranceStr, 10, 32)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid match_tolerance_days"),
+				"%s.%s", ctrl.pkg, op,
+			)
+		}
+
+		request.MatchToleranceDays = int32(tolerance)
+	}
+
+	if dryRunStr := c.FormValue("dry_run"); dryRunStr != "" {
+		dryRun, err := strconv.ParseBool(dryRunStr)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid dry_run"),
+				"%s.%s", ctrl.pkg, op,
+			)
+		}
+
+		request.DryRun = dryRun
+	}
+
+	if importProfileIDStr := c.FormValue("import_profile_id"); importProfileIDStr != "" {
+		importProfileID, err := uuid.Parse(importProfileIDStr)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid import_profile_id"),
+				"%s.%s", ctrl.pkg, op,
+			)
+		}
+
+		request.ImportProfileId = lo.ToPtr(importProfileID.String())
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().CSVImportTransactions(c.Context(), request)
 	if err != nil {
 		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
 	}
 
-	return nil
+	out := TransactionImportHandlerOutput{
+		Rows:     make([]*TransactionImportRowOutput, 0, len(data.Rows)),
+		Imported: data.Imported,
+		Rejected: data.Rejected,
+		Skipped:  data.Skipped,
+		Updated:  data.Updated,
+		Flagged:  data.Flagged,
+		DryRun:   data.DryRun,
+	}
+
+	for _, row := range data.Rows {
+		item := &TransactionImportRowOutput{
+			Line:        row.Line,
+			Status:      row.Status,
+			IsIncome:    row.IsIncome,
+			Amount:      row.Amount,
+			Currency:    row.Currency,
+			CategoryID:  row.CategoryId,
+			Description: row.Description,
+			ErrorCodes:  row.ErrorCodes,
+			Hints:       row.Hints,
+			ExternalID:  row.ExternalId,
+
+			MatchedTransactionID: row.MatchedTransactionId,
+		}
+
+		if row.OccurredOn != nil {
+			item.OccurredOn = &civil.Date{
+				Year:  int(row.OccurredOn.Year),
+				Month: time.Month(row.OccurredOn.Month),
+				Day:   int(row.OccurredOn.Day),
+			}
+		}
+
+		out.Rows = append(out.Rows, item)
+	}
+
+	return c.JSON(out)
 }
@@ -1,15 +1,28 @@
 package ledger
 
 import (
+	"strconv"
+	"strings"
+
 	"cloud.google.com/go/civil"
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
 	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/samber/lo"
 )
 
+var transactionListSorts = map[string]desc.TransactionListSort{
+	"occurred_on_desc": desc.TransactionListSort_TRANSACTION_LIST_SORT_OCCURRED_ON_DESC,
+	"occurred_on_asc":  desc.TransactionListSort_TRANSACTION_LIST_SORT_OCCURRED_ON_ASC,
+	"amount_desc":      desc.TransactionListSort_TRANSACTION_LIST_SORT_AMOUNT_DESC,
+	"amount_asc":       desc.TransactionListSort_TRANSACTION_LIST_SORT_AMOUNT_ASC,
+}
+
 type TransactionListHandlerOutput struct {
-	Items []*TransactionOutput `json:"items"`
-	Total int64                `json:"total"`
+	Items      []*TransactionOutput `json:"items"`
+	Total      *int64               `json:"total"`
+	NextCursor *string              `json:"nextCursor"`
 }
 
 // TransactionListHandler - list transactions
@@ -18,8 +31,18 @@ type TransactionListHandlerOutput struct {
 // @Tags ledger
 // @Param date_from query string false "Фильтр по дате ОТ в формате 2025-01-30 (год-месяц-день)"
 // @Param date_to query string false "Фильтр по дате ДО в формате 2025-01-30 (год-месяц-день)"
+// @Param wallet_id query string false "Фильтр по кошельку"
+// @Param category_ids query string false "Фильтр по категориям через запятую, например 1,5"
+// @Param is_income query bool false "Фильтр по типу: true - доходы, false - расходы"
+// @Param amount_min query string false "Минимальная сумма по модулю включительно"
+// @Param amount_max query string false "Максимальная сумма по модулю включительно"
+// @Param search query string false "Полнотекстовый поиск по описанию (русский и английский), синтаксис websearch: фраза в кавычках, -исключение, or"
+// @Param tags query string false "Транзакции со всеми перечисленными тегами через запятую"
+// @Param sort query string false "Сортировка" Enums(occurred_on_desc, occurred_on_asc, amount_desc, amount_asc)
 // @Param limit query int false "Limit"
 // @Param offset query int false "Offset"
+// @Param cursor query string false "Курсор следующей страницы из nextCursor, пустое значение - первая страница. При передаче offset игнорируется"
+// @Param with_total query bool false "Посчитать общее количество в режиме курсора"
 // @Produce json
 // @Success 200 {object} TransactionListHandlerOutput
 // @Failure 400 {object} middleware.ErrorJSON
@@ -69,6 +92,82 @@ func (ctrl *Controller) TransactionListHandler(c *fiber.Ctx) error {
 		}
 	}
 
+	filterWalletIDStr := c.Query("wallet_id")
+	if filterWalletIDStr != "" {
+		filterWalletID, err := uuid.Parse(filterWalletIDStr)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid wallet_id"),
+				"%s.%s", ctrl.pkg, op,
+			)
+		}
+
+		request.FilterWalletId = lo.ToPtr(filterWalletID.String())
+	}
+
+	filterCategoryIDsStr := c.Query("category_ids")
+	if filterCategoryIDsStr != "" {
+		for _, categoryIDStr := range strings.Split(filterCategoryIDsStr, ",") {
+			categoryID, err := strconv.ParseUint(strings.TrimSpace(categoryIDStr), 10, 63)
+			if err != nil {
+				return appErrors.Chainf(
+					appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid category_ids"),
+					"%s.%s", ctrl.pkg, op,
+				)
+			}
+
+			request.FilterCategoryIds = append(request.FilterCategoryIds, int64(categoryID))
+		}
+	}
+
+	filterIsIncomeStr := c.Query("is_income")
+	if filterIsIncomeStr != "" {
+		filterIsIncome, err := strconv.ParseBool(filterIsIncomeStr)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid is_income"),
+				"%s.%s", ctrl.pkg, op,
+			)
+		}
+
+		request.FilterIsIncome = &filterIsIncome
+	}
+
+	if amountMin := c.Query("amount_min"); amountMin != "" {
+		request.FilterAmountMin = &amountMin
+	}
+
+	if amountMax := c.Query("amount_max"); amountMax != "" {
+		request.FilterAmountMax = &amountMax
+	}
+
+	if search := c.Query("search"); search != "" {
+		request.FilterSearch = &search
+	}
+
+	filterTagsStr := c.Query("tags")
+	if filterTagsStr != "" {
+		request.FilterTags = strings.Split(filterTagsStr, ",")
+	}
+
+	sortStr := c.Query("sort")
+	if sortStr != "" {
+		sort, ok := transactionListSorts[sortStr]
+		if !ok {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithHints("invalid sort"),
+				"%s.%s", ctrl.pkg, op,
+			)
+		}
+
+		request.Sort = sort
+	}
+
+	if c.Request().URI().QueryArgs().Has("cursor") {
+		request.Cursor = lo.ToPtr(c.Query("cursor"))
+		request.WithTotal = c.QueryBool("with_total", false)
+	}
+
 	data, err := ctrl.ledgerAdapter.Api().ListTransactions(c.Context(), request)
 	if err != nil {
 		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
@@ -79,6 +178,10 @@ func (ctrl *Controller) TransactionListHandler(c *fiber.Ctx) error {
 		Total: data.Total,
 	}
 
+	if data.NextCursor != "" {
+		out.NextCursor = &data.NextCursor
+	}
+
 	for _, data := range data.Items {
 		out.Items = append(out.Items, NewTransactionOutput(data))
 	}
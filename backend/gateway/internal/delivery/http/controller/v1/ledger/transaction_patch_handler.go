@@ -16,10 +16,16 @@ type TransactionPatchHandlerInput struct {
 	CategoryID  *uint64     `json:"categoryID"`
 	Description *string     `json:"description"`
 	OccurredOn  *civil.Date `json:"occurredOn" swaggertype:"string" example:"2025-12-20"`
+	// пустая строка - отвязать от кошелька
+	WalletID *string `json:"walletID" validate:"omitempty,uuid"`
+	Currency *string `json:"currency" validate:"omitempty,len=3" example:"RUB"`
+	// теги заменяются целиком, пустой список - удалить все теги
+	Tags *[]string `json:"tags"`
 }
 
 type TransactionPatchHandlerOutput struct {
-	Item *TransactionOutput `json:"item"`
+	Item     *TransactionOutput     `json:"item"`
+	Warnings []*BudgetWarningOutput `json:"warnings"`
 }
 
 // TransactionPatchHandler - patch transaction
@@ -30,8 +36,11 @@ type TransactionPatchHandlerOutput struct {
 // @Produce  json
 // @Param request body TransactionPatchHandlerInput true "JSON"
 // @Param id path string true "ID"
+// @Param If-Match header string false "ETag из GET, при несовпадении версии - 412"
 // @Success 200 {object} TransactionPatchHandlerOutput
+// @Header 200 {string} ETag "Новая версия ресурса"
 // @Failure 400 {object} middleware.ErrorJSON
+// @Failure 412 {object} middleware.ErrorJSON "Версия изменилась, в details.current - текущее состояние"
 // @Router /ledger/transactions/{id} [patch]
 func (ctrl *Controller) TransactionPatchHandler(c *fiber.Ctx) error {
 	const op = "TransactionPatchHandler"
@@ -55,10 +64,23 @@ func (ctrl *Controller) TransactionPatchHandler(c *fiber.Ctx) error {
 		)
 	}
 
+	version, err := versionFromIfMatch(c)
+	if err != nil {
+		return appErrors.Chainf(err, "%s.%s", ctrl.pkg, op)
+	}
+
 	request := &desc.PatchTransactionRequest{
 		Id:          id.String(),
 		Amount:      in.Amount,
+		Currency:    in.Currency,
 		Description: in.Description,
+		WalletId:    in.WalletID,
+
+		Version: version,
+	}
+
+	if in.Tags != nil {
+		request.Tags = &desc.TransactionTags{Values: *in.Tags}
 	}
 
 	if in.CategoryID != nil {
@@ -75,11 +97,14 @@ func (ctrl *Controller) TransactionPatchHandler(c *fiber.Ctx) error {
 
 	data, err := ctrl.ledgerAdapter.Api().PatchTransaction(c.Context(), request)
 	if err != nil {
-		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+		return appErrors.Chainf(ctrl.withCurrentTransaction(c, request.Id, version, appErrors.FromGRPCError(err)), "%s.%s", ctrl.pkg, op)
 	}
 
+	c.Set(fiber.HeaderETag, etagFromVersion(data.Item.Version))
+
 	out := TransactionPatchHandlerOutput{
-		Item: NewTransactionOutput(data.Item),
+		Item:     NewTransactionOutput(data.Item),
+		Warnings: NewBudgetWarningsOutput(data.Warnings),
 	}
 
 	return c.JSON(out)
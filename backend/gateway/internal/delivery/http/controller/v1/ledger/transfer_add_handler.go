@@ -0,0 +1,69 @@
+package ledger
+
+import (
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/gateway/internal/delivery/http/httperrs"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/m11ano/budget_planner/backend/gateway/pkg/validation"
+)
+
+type TransferAddHandlerInput struct {
+	FromWalletID string     `json:"fromWalletID" validate:"required,uuid"`
+	ToWalletID   string     `json:"toWalletID" validate:"required,uuid"`
+	Amount       string     `json:"amount"`
+	OccurredOn   civil.Date `json:"occurredOn" swaggertype:"string" example:"2025-12-20"`
+	Description  string     `json:"description"`
+}
+
+type TransferAddHandlerOutput struct {
+	Item *TransferOutput `json:"item"`
+}
+
+// TransferAddHandler - add transfer
+// @Summary Add transfer between wallets
+// @Security BearerAuth
+// @Tags ledger
+// @Accept  json
+// @Produce  json
+// @Param request body TransferAddHandlerInput true "JSON"
+// @Success 200 {object} TransferAddHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/transfers [post]
+func (ctrl *Controller) TransferAddHandler(c *fiber.Ctx) error {
+	const op = "TransferAddHandler"
+
+	in := &TransferAddHandlerInput{}
+
+	if err := c.BodyParser(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrCantParseBody, "%s.%s", ctrl.pkg, op)
+	}
+
+	if err := ctrl.vldtr.Struct(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
+	}
+
+	request := &desc.AddTransferRequest{
+		FromWalletId: in.FromWalletID,
+		ToWalletId:   in.ToWalletID,
+		Amount:       in.Amount,
+		OccurredOn: &desc.Date{
+			Year:  int32(in.OccurredOn.Year),
+			Month: int32(in.OccurredOn.Month),
+			Day:   int32(in.OccurredOn.Day),
+		},
+		Description: in.Description,
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().AddTransfer(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := TransferAddHandlerOutput{
+		Item: NewTransferOutput(data.Item),
+	}
+
+	return c.JSON(out)
+}
@@ -0,0 +1,49 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+)
+
+type TransferGetHandlerOutput struct {
+	Item *TransferOutput `json:"item"`
+}
+
+// TransferGetHandler - get transfer
+// @Summary Get transfer
+// @Security BearerAuth
+// @Tags ledger
+// @Produce  json
+// @Param id path string true "ID"
+// @Success 200 {object} TransferGetHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/transfers/{id} [get]
+func (ctrl *Controller) TransferGetHandler(c *fiber.Ctx) error {
+	const op = "TransferGetHandler"
+
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return appErrors.Chainf(
+			appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid id"),
+			"%s.%s", ctrl.pkg, op,
+		)
+	}
+
+	request := &desc.GetTransferRequest{
+		Id: id.String(),
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().GetTransfer(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := TransferGetHandlerOutput{
+		Item: NewTransferOutput(data.Item),
+	}
+
+	return c.JSON(out)
+}
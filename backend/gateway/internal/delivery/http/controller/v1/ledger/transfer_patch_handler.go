@@ -0,0 +1,84 @@
+package ledger
+
+import (
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/gateway/internal/delivery/http/httperrs"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/m11ano/budget_planner/backend/gateway/pkg/validation"
+)
+
+type TransferPatchHandlerInput struct {
+	FromWalletID *string     `json:"fromWalletID" validate:"omitempty,uuid"`
+	ToWalletID   *string     `json:"toWalletID" validate:"omitempty,uuid"`
+	Amount       *string     `json:"amount"`
+	OccurredOn   *civil.Date `json:"occurredOn" swaggertype:"string" example:"2025-12-20"`
+	Description  *string     `json:"description"`
+}
+
+type TransferPatchHandlerOutput struct {
+	Item *TransferOutput `json:"item"`
+}
+
+// TransferPatchHandler - patch transfer
+// @Summary Patch transfer
+// @Security BearerAuth
+// @Tags ledger
+// @Accept  json
+// @Produce  json
+// @Param request body TransferPatchHandlerInput true "JSON"
+// @Param id path string true "ID"
+// @Success 200 {object} TransferPatchHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/transfers/{id} [patch]
+func (ctrl *Controller) TransferPatchHandler(c *fiber.Ctx) error {
+	const op = "TransferPatchHandler"
+
+	in := &TransferPatchHandlerInput{}
+
+	if err := c.BodyParser(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrCantParseBody, "%s.%s", ctrl.pkg, op)
+	}
+
+	if err := ctrl.vldtr.Struct(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
+	}
+
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return appErrors.Chainf(
+			appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid id"),
+			"%s.%s", ctrl.pkg, op,
+		)
+	}
+
+	request := &desc.PatchTransferRequest{
+		Id:           id.String(),
+		FromWalletId: in.FromWalletID,
+		ToWalletId:   in.ToWalletID,
+		Amount:       in.Amount,
+		Description:  in.Description,
+	}
+
+	if in.OccurredOn != nil {
+		request.OccurredOn = &desc.Date{
+			Year:  int32(in.OccurredOn.Year),
+			Month: int32(in.OccurredOn.Month),
+			Day:   int32(in.OccurredOn.Day),
+		}
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().PatchTransfer(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := TransferPatchHandlerOutput{
+		Item: NewTransferOutput(data.Item),
+	}
+
+	return c.JSON(out)
+}
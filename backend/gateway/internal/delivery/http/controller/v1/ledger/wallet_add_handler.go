@@ -0,0 +1,60 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/gateway/internal/delivery/http/httperrs"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/m11ano/budget_planner/backend/gateway/pkg/validation"
+)
+
+type WalletAddHandlerInput struct {
+	Title          string `json:"title" validate:"required"`
+	Currency       string `json:"currency" validate:"required,len=3" example:"RUB"`
+	OpeningBalance string `json:"openingBalance" example:"0.00"`
+}
+
+type WalletAddHandlerOutput struct {
+	Item *WalletOutput `json:"item"`
+}
+
+// WalletAddHandler - add wallet
+// @Summary Add wallet
+// @Security BearerAuth
+// @Tags ledger
+// @Accept  json
+// @Produce  json
+// @Param request body WalletAddHandlerInput true "JSON"
+// @Success 200 {object} WalletAddHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/wallets [post]
+func (ctrl *Controller) WalletAddHandler(c *fiber.Ctx) error {
+	const op = "WalletAddHandler"
+
+	in := &WalletAddHandlerInput{}
+
+	if err := c.BodyParser(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrCantParseBody, "%s.%s", ctrl.pkg, op)
+	}
+
+	if err := ctrl.vldtr.Struct(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
+	}
+
+	request := &desc.AddWalletRequest{
+		Title:          in.Title,
+		Currency:       in.Currency,
+		OpeningBalance: in.OpeningBalance,
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().AddWallet(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := WalletAddHandlerOutput{
+		Item: NewWalletOutput(data.Item),
+	}
+
+	return c.JSON(out)
+}
@@ -0,0 +1,78 @@
+package ledger
+
+import (
+	"strings"
+
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+)
+
+type WalletBalancesHandlerOutput struct {
+	Items []*WalletBalanceOutput `json:"items"`
+}
+
+// WalletBalancesHandler - wallet balances
+// @Summary Wallet balances
+// @Security BearerAuth
+// @Tags ledger
+// @Produce  json
+// @Param wallet_ids query string false "ID кошельков через запятую, по умолчанию - все кошельки"
+// @Param date_to query string false "Остаток на конец дня в формате 2025-01-30 (год-месяц-день), по умолчанию - текущий"
+// @Success 200 {object} WalletBalancesHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/wallets/balances [get]
+func (ctrl *Controller) WalletBalancesHandler(c *fiber.Ctx) error {
+	const op = "WalletBalancesHandler"
+
+	request := &desc.GetWalletBalancesRequest{}
+
+	walletIDsStr := c.Query("wallet_ids")
+	if walletIDsStr != "" {
+		for _, idStr := range strings.Split(walletIDsStr, ",") {
+			id, err := uuid.Parse(strings.TrimSpace(idStr))
+			if err != nil {
+				return appErrors.Chainf(
+					appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid wallet_ids"),
+					"%s.%s", ctrl.pkg, op,
+				)
+			}
+
+			request.WalletIds = append(request.WalletIds, id.String())
+		}
+	}
+
+	dateToStr := c.Query("date_to")
+	if dateToStr != "" {
+		dateTo, err := civil.ParseDate(dateToStr)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid date_to"),
+				"%s.%s", ctrl.pkg, op,
+			)
+		}
+
+		request.DateTo = &desc.Date{
+			Year:  int32(dateTo.Year),
+			Month: int32(dateTo.Month),
+			Day:   int32(dateTo.Day),
+		}
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().GetWalletBalances(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := WalletBalancesHandlerOutput{
+		Items: make([]*WalletBalanceOutput, 0, len(data.Items)),
+	}
+
+	for _, data := range data.Items {
+		out.Items = append(out.Items, NewWalletBalanceOutput(data))
+	}
+
+	return c.JSON(out)
+}
@@ -0,0 +1,56 @@
+package ledger
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+)
+
+type WalletListHandlerOutput struct {
+	Items []*WalletOutput `json:"items"`
+}
+
+// WalletListHandler - list wallets
+// @Summary List wallets
+// @Security BearerAuth
+// @Tags ledger
+// @Produce  json
+// @Param is_archived query bool false "Filter by archived flag"
+// @Success 200 {object} WalletListHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/wallets [get]
+func (ctrl *Controller) WalletListHandler(c *fiber.Ctx) error {
+	const op = "WalletListHandler"
+
+	request := &desc.ListWalletsRequest{}
+
+	filterIsArchivedStr := c.Query("is_archived")
+	if filterIsArchivedStr != "" {
+		filterIsArchived, err := strconv.ParseBool(filterIsArchivedStr)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid is_archived"),
+				"%s.%s", ctrl.pkg, op,
+			)
+		}
+
+		request.FilterIsArchived = &filterIsArchived
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().ListWallets(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := WalletListHandlerOutput{
+		Items: make([]*WalletOutput, 0, len(data.Items)),
+	}
+
+	for _, data := range data.Items {
+		out.Items = append(out.Items, NewWalletOutput(data))
+	}
+
+	return c.JSON(out)
+}
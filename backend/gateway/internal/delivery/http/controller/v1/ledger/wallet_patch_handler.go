@@ -0,0 +1,72 @@
+package ledger
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/gateway/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/gateway/internal/delivery/http/httperrs"
+	desc "github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service"
+	"github.com/m11ano/budget_planner/backend/gateway/pkg/validation"
+)
+
+type WalletPatchHandlerInput struct {
+	Title          *string `json:"title"`
+	OpeningBalance *string `json:"openingBalance"`
+	IsArchived     *bool   `json:"isArchived"`
+}
+
+type WalletPatchHandlerOutput struct {
+	Item *WalletOutput `json:"item"`
+}
+
+// WalletPatchHandler - patch wallet
+// @Summary Patch wallet
+// @Security BearerAuth
+// @Tags ledger
+// @Accept  json
+// @Produce  json
+// @Param request body WalletPatchHandlerInput true "JSON"
+// @Param id path string true "ID"
+// @Success 200 {object} WalletPatchHandlerOutput
+// @Failure 400 {object} middleware.ErrorJSON
+// @Router /ledger/wallets/{id} [patch]
+func (ctrl *Controller) WalletPatchHandler(c *fiber.Ctx) error {
+	const op = "WalletPatchHandler"
+
+	in := &WalletPatchHandlerInput{}
+
+	if err := c.BodyParser(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrCantParseBody, "%s.%s", ctrl.pkg, op)
+	}
+
+	if err := ctrl.vldtr.Struct(in); err != nil {
+		return appErrors.Chainf(httperrs.ErrValidation.WithHints(validation.FormatErrors(err)...), "%s.%s", ctrl.pkg, op)
+	}
+
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return appErrors.Chainf(
+			appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid id"),
+			"%s.%s", ctrl.pkg, op,
+		)
+	}
+
+	request := &desc.PatchWalletRequest{
+		Id:             id.String(),
+		Title:          in.Title,
+		OpeningBalance: in.OpeningBalance,
+		IsArchived:     in.IsArchived,
+	}
+
+	data, err := ctrl.ledgerAdapter.Api().PatchWallet(c.Context(), request)
+	if err != nil {
+		return appErrors.Chainf(appErrors.FromGRPCError(err), "%s.%s", ctrl.pkg, op)
+	}
+
+	out := WalletPatchHandlerOutput{
+		Item: NewWalletOutput(data.Item),
+	}
+
+	return c.JSON(out)
+}
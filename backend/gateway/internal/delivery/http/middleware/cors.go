@@ -9,6 +9,7 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/cors"
 )
 
+// Cors - ETag открыт для чтения из браузера, чтобы клиент мог передать его в If-Match
 func Cors(corsAllowOrigins []string) func(*fiber.Ctx) error {
 	regexps := make([]*regexp.Regexp, 0, len(corsAllowOrigins))
 
@@ -18,6 +19,7 @@ func Cors(corsAllowOrigins []string) func(*fiber.Ctx) error {
 		if raw == "*" {
 			return cors.New(cors.Config{
 				AllowCredentials: true,
+				ExposeHeaders:    fiber.HeaderETag,
 				AllowOriginsFunc: func(origin string) bool {
 					return true
 				},
@@ -42,6 +44,7 @@ func Cors(corsAllowOrigins []string) func(*fiber.Ctx) error {
 
 	return cors.New(cors.Config{
 		AllowCredentials: true,
+		ExposeHeaders:    fiber.HeaderETag,
 		AllowOriginsFunc: func(origin string) bool {
 			for _, r := range regexps {
 				if r.MatchString(origin) {
@@ -22,10 +22,127 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TransactionListSort int32
+
+const (
+	// по дате операции, новые первыми
+	TransactionListSort_TRANSACTION_LIST_SORT_UNSPECIFIED      TransactionListSort = 0
+	TransactionListSort_TRANSACTION_LIST_SORT_OCCURRED_ON_DESC TransactionListSort = 1
+	TransactionListSort_TRANSACTION_LIST_SORT_OCCURRED_ON_ASC  TransactionListSort = 2
+	// по сумме по модулю
+	TransactionListSort_TRANSACTION_LIST_SORT_AMOUNT_DESC TransactionListSort = 3
+	TransactionListSort_TRANSACTION_LIST_SORT_AMOUNT_ASC  TransactionListSort = 4
+)
+
+// Enum value maps for TransactionListSort.
+var (
+	TransactionListSort_name = map[int32]string{
+		0: "TRANSACTION_LIST_SORT_UNSPECIFIED",
+		1: "TRANSACTION_LIST_SORT_OCCURRED_ON_DESC",
+		2: "TRANSACTION_LIST_SORT_OCCURRED_ON_ASC",
+		3: "TRANSACTION_LIST_SORT_AMOUNT_DESC",
+		4: "TRANSACTION_LIST_SORT_AMOUNT_ASC",
+	}
+	TransactionListSort_value = map[string]int32{
+		"TRANSACTION_LIST_SORT_UNSPECIFIED":      0,
+		"TRANSACTION_LIST_SORT_OCCURRED_ON_DESC": 1,
+		"TRANSACTION_LIST_SORT_OCCURRED_ON_ASC":  2,
+		"TRANSACTION_LIST_SORT_AMOUNT_DESC":      3,
+		"TRANSACTION_LIST_SORT_AMOUNT_ASC":       4,
+	}
+)
+
+func (x TransactionListSort) Enum() *TransactionListSort {
+	p := new(TransactionListSort)
+	*p = x
+	return p
+}
+
+func (x TransactionListSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionListSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_ledger_service_service_proto_enumTypes[0].Descriptor()
+}
+
+func (TransactionListSort) Type() protoreflect.EnumType {
+	return &file_ledger_service_service_proto_enumTypes[0]
+}
+
+func (x TransactionListSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionListSort.Descriptor instead.
+func (TransactionListSort) EnumDescriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{0}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 1
+	// суммы и даты - типизированные ячейки
+	ExportFormat_EXPORT_FORMAT_XLSX ExportFormat = 2
+	// JSON Lines, суммы строками
+	ExportFormat_EXPORT_FORMAT_JSONL ExportFormat = 3
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_XLSX",
+		3: "EXPORT_FORMAT_JSONL",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_XLSX":        2,
+		"EXPORT_FORMAT_JSONL":       3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_ledger_service_service_proto_enumTypes[1].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_ledger_service_service_proto_enumTypes[1]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{1}
+}
+
 type Category struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	AccountId     *string                `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	IsArchived    bool                   `protobuf:"varint,4,opt,name=is_archived,json=isArchived,proto3" json:"is_archived,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ParentId      *int64                 `protobuf:"varint,7,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Children      []*Category            `protobuf:"bytes,8,rep,name=children,proto3" json:"children,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -74,6 +191,48 @@ func (x *Category) GetTitle() string {
 	return ""
 }
 
+func (x *Category) GetAccountId() string {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return ""
+}
+
+func (x *Category) GetIsArchived() bool {
+	if x != nil {
+		return x.IsArchived
+	}
+	return false
+}
+
+func (x *Category) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Category) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *Category) GetChildren() []*Category {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
 type Date struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
@@ -187,16 +346,26 @@ func (x *DateMonth) GetMonth() int32 {
 }
 
 type Transaction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	IsIncome      bool                   `protobuf:"varint,3,opt,name=is_income,json=isIncome,proto3" json:"is_income,omitempty"`
-	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	OccurredOn    *Date                  `protobuf:"bytes,5,opt,name=occurred_on,json=occurredOn,proto3" json:"occurred_on,omitempty"`
-	CategoryId    int64                  `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId       string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	IsIncome        bool                   `protobuf:"varint,3,opt,name=is_income,json=isIncome,proto3" json:"is_income,omitempty"`
+	Amount          string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	OccurredOn      *Date                  `protobuf:"bytes,5,opt,name=occurred_on,json=occurredOn,proto3" json:"occurred_on,omitempty"`
+	CategoryId      int64                  `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Description     string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	WalletId        *string                `protobuf:"bytes,10,opt,name=wallet_id,json=walletId,proto3,oneof" json:"wallet_id,omitempty"`
+	TransferId      *string                `protobuf:"bytes,11,opt,name=transfer_id,json=transferId,proto3,oneof" json:"transfer_id,omitempty"`
+	Currency        string                 `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+	RecurringRuleId *string                `protobuf:"bytes,13,opt,name=recurring_rule_id,json=recurringRuleId,proto3,oneof" json:"recurring_rule_id,omitempty"`
+	ExternalId      *string                `protobuf:"bytes,14,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
+	// duplicate_of_id - транзакция, с которой строка совпала при импорте с пометкой дубликатов
+	DuplicateOfId *string  `protobuf:"bytes,15,opt,name=duplicate_of_id,json=duplicateOfId,proto3,oneof" json:"duplicate_of_id,omitempty"`
+	Tags          []string `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
+	// version - версия для оптимистичной блокировки, меняется при каждом изменении
+	Version       int64 `protobuf:"varint,17,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -294,33 +463,84 @@ func (x *Transaction) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type Budget struct {
+func (x *Transaction) GetWalletId() string {
+	if x != nil && x.WalletId != nil {
+		return *x.WalletId
+	}
+	return ""
+}
+
+func (x *Transaction) GetTransferId() string {
+	if x != nil && x.TransferId != nil {
+		return *x.TransferId
+	}
+	return ""
+}
+
+func (x *Transaction) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Transaction) GetRecurringRuleId() string {
+	if x != nil && x.RecurringRuleId != nil {
+		return *x.RecurringRuleId
+	}
+	return ""
+}
+
+func (x *Transaction) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+func (x *Transaction) GetDuplicateOfId() string {
+	if x != nil && x.DuplicateOfId != nil {
+		return *x.DuplicateOfId
+	}
+	return ""
+}
+
+func (x *Transaction) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Transaction) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// TransactionTags - теги транзакции, при изменении заменяются целиком
+type TransactionTags struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Period        *DateMonth             `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
-	CategoryId    int64                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Amount        string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Budget) Reset() {
-	*x = Budget{}
+func (x *TransactionTags) Reset() {
+	*x = TransactionTags{}
 	mi := &file_ledger_service_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Budget) String() string {
+func (x *TransactionTags) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Budget) ProtoMessage() {}
+func (*TransactionTags) ProtoMessage() {}
 
-func (x *Budget) ProtoReflect() protoreflect.Message {
+func (x *TransactionTags) ProtoReflect() protoreflect.Message {
 	mi := &file_ledger_service_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -332,85 +552,149 @@ func (x *Budget) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
-func (*Budget) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransactionTags.ProtoReflect.Descriptor instead.
+func (*TransactionTags) Descriptor() ([]byte, []int) {
 	return file_ledger_service_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Budget) GetId() string {
+func (x *TransactionTags) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type Wallet struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId      string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Currency       string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	OpeningBalance string                 `protobuf:"bytes,5,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	IsArchived     bool                   `protobuf:"varint,6,opt,name=is_archived,json=isArchived,proto3" json:"is_archived,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Wallet) Reset() {
+	*x = Wallet{}
+	mi := &file_ledger_service_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Wallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wallet) ProtoMessage() {}
+
+func (x *Wallet) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wallet.ProtoReflect.Descriptor instead.
+func (*Wallet) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Wallet) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Budget) GetAccountId() string {
+func (x *Wallet) GetAccountId() string {
 	if x != nil {
 		return x.AccountId
 	}
 	return ""
 }
 
-func (x *Budget) GetPeriod() *DateMonth {
+func (x *Wallet) GetTitle() string {
 	if x != nil {
-		return x.Period
+		return x.Title
 	}
-	return nil
+	return ""
 }
 
-func (x *Budget) GetCategoryId() int64 {
+func (x *Wallet) GetCurrency() string {
 	if x != nil {
-		return x.CategoryId
+		return x.Currency
 	}
-	return 0
+	return ""
 }
 
-func (x *Budget) GetAmount() string {
+func (x *Wallet) GetOpeningBalance() string {
 	if x != nil {
-		return x.Amount
+		return x.OpeningBalance
 	}
 	return ""
 }
 
-func (x *Budget) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Wallet) GetIsArchived() bool {
+	if x != nil {
+		return x.IsArchived
+	}
+	return false
+}
+
+func (x *Wallet) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Budget) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Wallet) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return nil
 }
 
-type ReportItem struct {
+// ImportProfileColumns - название колонки из заголовка либо ее номер начиная с 1, пустое значение - колонки нет
+type ImportProfileColumns struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Sum           *string                `protobuf:"bytes,2,opt,name=sum,proto3,oneof" json:"sum,omitempty"`
-	SpentBudget   *string                `protobuf:"bytes,3,opt,name=spent_budget,json=spentBudget,proto3,oneof" json:"spent_budget,omitempty"`
-	ItemBudget    *string                `protobuf:"bytes,4,opt,name=item_budget,json=itemBudget,proto3,oneof" json:"item_budget,omitempty"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Debit         string                 `protobuf:"bytes,3,opt,name=debit,proto3" json:"debit,omitempty"`
+	Credit        string                 `protobuf:"bytes,4,opt,name=credit,proto3" json:"credit,omitempty"`
+	IsIncome      string                 `protobuf:"bytes,5,opt,name=is_income,json=isIncome,proto3" json:"is_income,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,9,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReportItem) Reset() {
-	*x = ReportItem{}
-	mi := &file_ledger_service_service_proto_msgTypes[5]
+func (x *ImportProfileColumns) Reset() {
+	*x = ImportProfileColumns{}
+	mi := &file_ledger_service_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReportItem) String() string {
+func (x *ImportProfileColumns) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportItem) ProtoMessage() {}
+func (*ImportProfileColumns) ProtoMessage() {}
 
-func (x *ReportItem) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[5]
+func (x *ImportProfileColumns) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -421,63 +705,102 @@ func (x *ReportItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportItem.ProtoReflect.Descriptor instead.
-func (*ReportItem) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ImportProfileColumns.ProtoReflect.Descriptor instead.
+func (*ImportProfileColumns) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ReportItem) GetCategoryId() int64 {
+func (x *ImportProfileColumns) GetDate() string {
 	if x != nil {
-		return x.CategoryId
+		return x.Date
 	}
-	return 0
+	return ""
 }
 
-func (x *ReportItem) GetSum() string {
-	if x != nil && x.Sum != nil {
-		return *x.Sum
+func (x *ImportProfileColumns) GetAmount() string {
+	if x != nil {
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *ReportItem) GetSpentBudget() string {
-	if x != nil && x.SpentBudget != nil {
-		return *x.SpentBudget
+func (x *ImportProfileColumns) GetDebit() string {
+	if x != nil {
+		return x.Debit
 	}
 	return ""
 }
 
-func (x *ReportItem) GetItemBudget() string {
-	if x != nil && x.ItemBudget != nil {
-		return *x.ItemBudget
+func (x *ImportProfileColumns) GetCredit() string {
+	if x != nil {
+		return x.Credit
 	}
 	return ""
 }
 
-type PeriodReport struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PeriodStart   *Date                  `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
-	PeriodEnd     *Date                  `protobuf:"bytes,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
-	Items         []*ReportItem          `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ImportProfileColumns) GetIsIncome() string {
+	if x != nil {
+		return x.IsIncome
+	}
+	return ""
 }
 
-func (x *PeriodReport) Reset() {
-	*x = PeriodReport{}
-	mi := &file_ledger_service_service_proto_msgTypes[6]
+func (x *ImportProfileColumns) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ImportProfileColumns) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ImportProfileColumns) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ImportProfileColumns) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+// ImportProfileMapping - колонки, способ определения знака и категория по умолчанию
+type ImportProfileMapping struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	HasHeader bool                   `protobuf:"varint,1,opt,name=has_header,json=hasHeader,proto3" json:"has_header,omitempty"`
+	// sign_convention - signed, inverted, split или flag
+	SignConvention    string                `protobuf:"bytes,2,opt,name=sign_convention,json=signConvention,proto3" json:"sign_convention,omitempty"`
+	IncomeValues      []string              `protobuf:"bytes,3,rep,name=income_values,json=incomeValues,proto3" json:"income_values,omitempty"`
+	Columns           *ImportProfileColumns `protobuf:"bytes,4,opt,name=columns,proto3" json:"columns,omitempty"`
+	DefaultCategoryId *uint64               `protobuf:"varint,5,opt,name=default_category_id,json=defaultCategoryId,proto3,oneof" json:"default_category_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ImportProfileMapping) Reset() {
+	*x = ImportProfileMapping{}
+	mi := &file_ledger_service_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PeriodReport) String() string {
+func (x *ImportProfileMapping) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeriodReport) ProtoMessage() {}
+func (*ImportProfileMapping) ProtoMessage() {}
 
-func (x *PeriodReport) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[6]
+func (x *ImportProfileMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -488,53 +811,5724 @@ func (x *PeriodReport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeriodReport.ProtoReflect.Descriptor instead.
-func (*PeriodReport) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ImportProfileMapping.ProtoReflect.Descriptor instead.
+func (*ImportProfileMapping) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *PeriodReport) GetPeriodStart() *Date {
+func (x *ImportProfileMapping) GetHasHeader() bool {
 	if x != nil {
-		return x.PeriodStart
+		return x.HasHeader
 	}
-	return nil
+	return false
+}
+
+func (x *ImportProfileMapping) GetSignConvention() string {
+	if x != nil {
+		return x.SignConvention
+	}
+	return ""
+}
+
+func (x *ImportProfileMapping) GetIncomeValues() []string {
+	if x != nil {
+		return x.IncomeValues
+	}
+	return nil
+}
+
+func (x *ImportProfileMapping) GetColumns() *ImportProfileColumns {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ImportProfileMapping) GetDefaultCategoryId() uint64 {
+	if x != nil && x.DefaultCategoryId != nil {
+		return *x.DefaultCategoryId
+	}
+	return 0
+}
+
+type ImportProfile struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId        string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Delimiter        string                 `protobuf:"bytes,4,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	DecimalSeparator string                 `protobuf:"bytes,5,opt,name=decimal_separator,json=decimalSeparator,proto3" json:"decimal_separator,omitempty"`
+	DateLayout       string                 `protobuf:"bytes,6,opt,name=date_layout,json=dateLayout,proto3" json:"date_layout,omitempty"`
+	SkipRows         int32                  `protobuf:"varint,7,opt,name=skip_rows,json=skipRows,proto3" json:"skip_rows,omitempty"`
+	Encoding         string                 `protobuf:"bytes,8,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	Mapping          *ImportProfileMapping  `protobuf:"bytes,9,opt,name=mapping,proto3" json:"mapping,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ImportProfile) Reset() {
+	*x = ImportProfile{}
+	mi := &file_ledger_service_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProfile) ProtoMessage() {}
+
+func (x *ImportProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProfile.ProtoReflect.Descriptor instead.
+func (*ImportProfile) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ImportProfile) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetDecimalSeparator() string {
+	if x != nil {
+		return x.DecimalSeparator
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetDateLayout() string {
+	if x != nil {
+		return x.DateLayout
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetSkipRows() int32 {
+	if x != nil {
+		return x.SkipRows
+	}
+	return 0
+}
+
+func (x *ImportProfile) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetMapping() *ImportProfileMapping {
+	if x != nil {
+		return x.Mapping
+	}
+	return nil
+}
+
+func (x *ImportProfile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ImportProfile) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CategoryRuleConditions - все заданные условия должны выполниться, незаданное условие не проверяется
+type CategoryRuleConditions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// description_contains - подстрока описания без учета регистра
+	DescriptionContains *string `protobuf:"bytes,1,opt,name=description_contains,json=descriptionContains,proto3,oneof" json:"description_contains,omitempty"`
+	// description_regex - регулярное выражение RE2
+	DescriptionRegex *string `protobuf:"bytes,2,opt,name=description_regex,json=descriptionRegex,proto3,oneof" json:"description_regex,omitempty"`
+	// amount_min, amount_max - границы суммы по модулю включительно
+	AmountMin     *string `protobuf:"bytes,3,opt,name=amount_min,json=amountMin,proto3,oneof" json:"amount_min,omitempty"`
+	AmountMax     *string `protobuf:"bytes,4,opt,name=amount_max,json=amountMax,proto3,oneof" json:"amount_max,omitempty"`
+	IsIncome      *bool   `protobuf:"varint,5,opt,name=is_income,json=isIncome,proto3,oneof" json:"is_income,omitempty"`
+	WalletId      *string `protobuf:"bytes,6,opt,name=wallet_id,json=walletId,proto3,oneof" json:"wallet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryRuleConditions) Reset() {
+	*x = CategoryRuleConditions{}
+	mi := &file_ledger_service_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryRuleConditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryRuleConditions) ProtoMessage() {}
+
+func (x *CategoryRuleConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryRuleConditions.ProtoReflect.Descriptor instead.
+func (*CategoryRuleConditions) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CategoryRuleConditions) GetDescriptionContains() string {
+	if x != nil && x.DescriptionContains != nil {
+		return *x.DescriptionContains
+	}
+	return ""
+}
+
+func (x *CategoryRuleConditions) GetDescriptionRegex() string {
+	if x != nil && x.DescriptionRegex != nil {
+		return *x.DescriptionRegex
+	}
+	return ""
+}
+
+func (x *CategoryRuleConditions) GetAmountMin() string {
+	if x != nil && x.AmountMin != nil {
+		return *x.AmountMin
+	}
+	return ""
+}
+
+func (x *CategoryRuleConditions) GetAmountMax() string {
+	if x != nil && x.AmountMax != nil {
+		return *x.AmountMax
+	}
+	return ""
+}
+
+func (x *CategoryRuleConditions) GetIsIncome() bool {
+	if x != nil && x.IsIncome != nil {
+		return *x.IsIncome
+	}
+	return false
+}
+
+func (x *CategoryRuleConditions) GetWalletId() string {
+	if x != nil && x.WalletId != nil {
+		return *x.WalletId
+	}
+	return ""
+}
+
+type CategoryRule struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// правила проверяются по возрастанию приоритета, срабатывает первое подходящее
+	Priority      int32                   `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	CategoryId    int64                   `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Conditions    *CategoryRuleConditions `protobuf:"bytes,6,opt,name=conditions,proto3" json:"conditions,omitempty"`
+	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryRule) Reset() {
+	*x = CategoryRule{}
+	mi := &file_ledger_service_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryRule) ProtoMessage() {}
+
+func (x *CategoryRule) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryRule.ProtoReflect.Descriptor instead.
+func (*CategoryRule) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CategoryRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CategoryRule) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CategoryRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryRule) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CategoryRule) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryRule) GetConditions() *CategoryRuleConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *CategoryRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CategoryRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CategoryRuleChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	OldCategoryId int64                  `protobuf:"varint,3,opt,name=old_category_id,json=oldCategoryId,proto3" json:"old_category_id,omitempty"`
+	NewCategoryId int64                  `protobuf:"varint,4,opt,name=new_category_id,json=newCategoryId,proto3" json:"new_category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryRuleChange) Reset() {
+	*x = CategoryRuleChange{}
+	mi := &file_ledger_service_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryRuleChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryRuleChange) ProtoMessage() {}
+
+func (x *CategoryRuleChange) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryRuleChange.ProtoReflect.Descriptor instead.
+func (*CategoryRuleChange) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CategoryRuleChange) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *CategoryRuleChange) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *CategoryRuleChange) GetOldCategoryId() int64 {
+	if x != nil {
+		return x.OldCategoryId
+	}
+	return 0
+}
+
+func (x *CategoryRuleChange) GetNewCategoryId() int64 {
+	if x != nil {
+		return x.NewCategoryId
+	}
+	return 0
+}
+
+type WalletBalance struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Wallet          *Wallet                `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	TransactionsSum string                 `protobuf:"bytes,2,opt,name=transactions_sum,json=transactionsSum,proto3" json:"transactions_sum,omitempty"`
+	Balance         string                 `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WalletBalance) Reset() {
+	*x = WalletBalance{}
+	mi := &file_ledger_service_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletBalance) ProtoMessage() {}
+
+func (x *WalletBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletBalance.ProtoReflect.Descriptor instead.
+func (*WalletBalance) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WalletBalance) GetWallet() *Wallet {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+func (x *WalletBalance) GetTransactionsSum() string {
+	if x != nil {
+		return x.TransactionsSum
+	}
+	return ""
+}
+
+func (x *WalletBalance) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+type Transfer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	FromWalletId  string                 `protobuf:"bytes,3,opt,name=from_wallet_id,json=fromWalletId,proto3" json:"from_wallet_id,omitempty"`
+	ToWalletId    string                 `protobuf:"bytes,4,opt,name=to_wallet_id,json=toWalletId,proto3" json:"to_wallet_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	OccurredOn    *Date                  `protobuf:"bytes,6,opt,name=occurred_on,json=occurredOn,proto3" json:"occurred_on,omitempty"`
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Currency      string                 `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	mi := &file_ledger_service_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transfer) ProtoMessage() {}
+
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Transfer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Transfer) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Transfer) GetFromWalletId() string {
+	if x != nil {
+		return x.FromWalletId
+	}
+	return ""
+}
+
+func (x *Transfer) GetToWalletId() string {
+	if x != nil {
+		return x.ToWalletId
+	}
+	return ""
+}
+
+func (x *Transfer) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Transfer) GetOccurredOn() *Date {
+	if x != nil {
+		return x.OccurredOn
+	}
+	return nil
+}
+
+func (x *Transfer) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Transfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Transfer) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Transfer) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type Budget struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId  string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Period     *DateMonth             `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	CategoryId int64                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Amount     string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Currency   string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	CarryOver  bool                   `protobuf:"varint,9,opt,name=carry_over,json=carryOver,proto3" json:"carry_over,omitempty"`
+	// hard, soft, off
+	Enforcement string `protobuf:"bytes,10,opt,name=enforcement,proto3" json:"enforcement,omitempty"`
+	// пороги предупреждений в процентах от бюджета
+	WarningThresholds []int32 `protobuf:"varint,11,rep,packed,name=warning_thresholds,json=warningThresholds,proto3" json:"warning_thresholds,omitempty"`
+	// version - версия для оптимистичной блокировки, меняется при каждом изменении
+	Version       int64 `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Budget) Reset() {
+	*x = Budget{}
+	mi := &file_ledger_service_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Budget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Budget) ProtoMessage() {}
+
+func (x *Budget) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
+func (*Budget) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Budget) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Budget) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Budget) GetPeriod() *DateMonth {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *Budget) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Budget) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Budget) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Budget) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Budget) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Budget) GetCarryOver() bool {
+	if x != nil {
+		return x.CarryOver
+	}
+	return false
+}
+
+func (x *Budget) GetEnforcement() string {
+	if x != nil {
+		return x.Enforcement
+	}
+	return ""
+}
+
+func (x *Budget) GetWarningThresholds() []int32 {
+	if x != nil {
+		return x.WarningThresholds
+	}
+	return nil
+}
+
+func (x *Budget) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type BudgetWarningThresholds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []int32                `protobuf:"varint,1,rep,packed,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetWarningThresholds) Reset() {
+	*x = BudgetWarningThresholds{}
+	mi := &file_ledger_service_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetWarningThresholds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetWarningThresholds) ProtoMessage() {}
+
+func (x *BudgetWarningThresholds) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetWarningThresholds.ProtoReflect.Descriptor instead.
+func (*BudgetWarningThresholds) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BudgetWarningThresholds) GetItems() []int32 {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BudgetWarning struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	BudgetId    string                 `protobuf:"bytes,1,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	CategoryId  int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Period      *DateMonth             `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	Enforcement string                 `protobuf:"bytes,4,opt,name=enforcement,proto3" json:"enforcement,omitempty"`
+	// наибольший достигнутый порог, 0 - пороги не достигнуты
+	Threshold     int32   `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	SpentPercent  *string `protobuf:"bytes,6,opt,name=spent_percent,json=spentPercent,proto3,oneof" json:"spent_percent,omitempty"`
+	Exceeded      bool    `protobuf:"varint,7,opt,name=exceeded,proto3" json:"exceeded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetWarning) Reset() {
+	*x = BudgetWarning{}
+	mi := &file_ledger_service_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetWarning) ProtoMessage() {}
+
+func (x *BudgetWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetWarning.ProtoReflect.Descriptor instead.
+func (*BudgetWarning) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BudgetWarning) GetBudgetId() string {
+	if x != nil {
+		return x.BudgetId
+	}
+	return ""
+}
+
+func (x *BudgetWarning) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *BudgetWarning) GetPeriod() *DateMonth {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *BudgetWarning) GetEnforcement() string {
+	if x != nil {
+		return x.Enforcement
+	}
+	return ""
+}
+
+func (x *BudgetWarning) GetThreshold() int32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *BudgetWarning) GetSpentPercent() string {
+	if x != nil && x.SpentPercent != nil {
+		return *x.SpentPercent
+	}
+	return ""
+}
+
+func (x *BudgetWarning) GetExceeded() bool {
+	if x != nil {
+		return x.Exceeded
+	}
+	return false
+}
+
+type ExchangeRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyFrom  string                 `protobuf:"bytes,1,opt,name=currency_from,json=currencyFrom,proto3" json:"currency_from,omitempty"`
+	CurrencyTo    string                 `protobuf:"bytes,2,opt,name=currency_to,json=currencyTo,proto3" json:"currency_to,omitempty"`
+	RateDate      *Date                  `protobuf:"bytes,3,opt,name=rate_date,json=rateDate,proto3" json:"rate_date,omitempty"`
+	Rate          string                 `protobuf:"bytes,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_ledger_service_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExchangeRate) GetCurrencyFrom() string {
+	if x != nil {
+		return x.CurrencyFrom
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetCurrencyTo() string {
+	if x != nil {
+		return x.CurrencyTo
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetRateDate() *Date {
+	if x != nil {
+		return x.RateDate
+	}
+	return nil
+}
+
+func (x *ExchangeRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExchangeRate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type RecurringRule struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId   string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	IsIncome    bool                   `protobuf:"varint,3,opt,name=is_income,json=isIncome,proto3" json:"is_income,omitempty"`
+	Amount      string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency    string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	CategoryId  int64                  `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	WalletId    *string                `protobuf:"bytes,7,opt,name=wallet_id,json=walletId,proto3,oneof" json:"wallet_id,omitempty"`
+	Description string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	// weekly, monthly, yearly
+	Frequency        string                 `protobuf:"bytes,9,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Interval         int32                  `protobuf:"varint,10,opt,name=interval,proto3" json:"interval,omitempty"`
+	DayOfMonth       int32                  `protobuf:"varint,11,opt,name=day_of_month,json=dayOfMonth,proto3" json:"day_of_month,omitempty"`
+	StartDate        *Date                  `protobuf:"bytes,12,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate          *Date                  `protobuf:"bytes,13,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	Count            *int32                 `protobuf:"varint,14,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	OccurrencesCount int32                  `protobuf:"varint,15,opt,name=occurrences_count,json=occurrencesCount,proto3" json:"occurrences_count,omitempty"`
+	NextOccurrenceOn *Date                  `protobuf:"bytes,16,opt,name=next_occurrence_on,json=nextOccurrenceOn,proto3,oneof" json:"next_occurrence_on,omitempty"`
+	IsPaused         bool                   `protobuf:"varint,17,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"`
+	LastError        *string                `protobuf:"bytes,18,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RecurringRule) Reset() {
+	*x = RecurringRule{}
+	mi := &file_ledger_service_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurringRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringRule) ProtoMessage() {}
+
+func (x *RecurringRule) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringRule.ProtoReflect.Descriptor instead.
+func (*RecurringRule) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RecurringRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RecurringRule) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *RecurringRule) GetIsIncome() bool {
+	if x != nil {
+		return x.IsIncome
+	}
+	return false
+}
+
+func (x *RecurringRule) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RecurringRule) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RecurringRule) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *RecurringRule) GetWalletId() string {
+	if x != nil && x.WalletId != nil {
+		return *x.WalletId
+	}
+	return ""
+}
+
+func (x *RecurringRule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RecurringRule) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+func (x *RecurringRule) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *RecurringRule) GetDayOfMonth() int32 {
+	if x != nil {
+		return x.DayOfMonth
+	}
+	return 0
+}
+
+func (x *RecurringRule) GetStartDate() *Date {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *RecurringRule) GetEndDate() *Date {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *RecurringRule) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *RecurringRule) GetOccurrencesCount() int32 {
+	if x != nil {
+		return x.OccurrencesCount
+	}
+	return 0
+}
+
+func (x *RecurringRule) GetNextOccurrenceOn() *Date {
+	if x != nil {
+		return x.NextOccurrenceOn
+	}
+	return nil
+}
+
+func (x *RecurringRule) GetIsPaused() bool {
+	if x != nil {
+		return x.IsPaused
+	}
+	return false
+}
+
+func (x *RecurringRule) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *RecurringRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RecurringRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ReportItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId       int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Sum              *string                `protobuf:"bytes,2,opt,name=sum,proto3,oneof" json:"sum,omitempty"`
+	SpentBudget      *string                `protobuf:"bytes,3,opt,name=spent_budget,json=spentBudget,proto3,oneof" json:"spent_budget,omitempty"`
+	ItemBudget       *string                `protobuf:"bytes,4,opt,name=item_budget,json=itemBudget,proto3,oneof" json:"item_budget,omitempty"`
+	ParentCategoryId *int64                 `protobuf:"varint,5,opt,name=parent_category_id,json=parentCategoryId,proto3,oneof" json:"parent_category_id,omitempty"`
+	TotalSum         *string                `protobuf:"bytes,6,opt,name=total_sum,json=totalSum,proto3,oneof" json:"total_sum,omitempty"`
+	TotalSpentBudget *string                `protobuf:"bytes,7,opt,name=total_spent_budget,json=totalSpentBudget,proto3,oneof" json:"total_spent_budget,omitempty"`
+	TotalBudget      *string                `protobuf:"bytes,8,opt,name=total_budget,json=totalBudget,proto3,oneof" json:"total_budget,omitempty"`
+	// остаток (или перерасход) прошлого месяца, перенесенный на бюджет
+	CarriedOver *string `protobuf:"bytes,9,opt,name=carried_over,json=carriedOver,proto3,oneof" json:"carried_over,omitempty"`
+	// бюджет с учетом переноса
+	EffectiveBudget *string `protobuf:"bytes,10,opt,name=effective_budget,json=effectiveBudget,proto3,oneof" json:"effective_budget,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReportItem) Reset() {
+	*x = ReportItem{}
+	mi := &file_ledger_service_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportItem) ProtoMessage() {}
+
+func (x *ReportItem) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportItem.ProtoReflect.Descriptor instead.
+func (*ReportItem) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReportItem) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ReportItem) GetSum() string {
+	if x != nil && x.Sum != nil {
+		return *x.Sum
+	}
+	return ""
+}
+
+func (x *ReportItem) GetSpentBudget() string {
+	if x != nil && x.SpentBudget != nil {
+		return *x.SpentBudget
+	}
+	return ""
+}
+
+func (x *ReportItem) GetItemBudget() string {
+	if x != nil && x.ItemBudget != nil {
+		return *x.ItemBudget
+	}
+	return ""
+}
+
+func (x *ReportItem) GetParentCategoryId() int64 {
+	if x != nil && x.ParentCategoryId != nil {
+		return *x.ParentCategoryId
+	}
+	return 0
+}
+
+func (x *ReportItem) GetTotalSum() string {
+	if x != nil && x.TotalSum != nil {
+		return *x.TotalSum
+	}
+	return ""
+}
+
+func (x *ReportItem) GetTotalSpentBudget() string {
+	if x != nil && x.TotalSpentBudget != nil {
+		return *x.TotalSpentBudget
+	}
+	return ""
+}
+
+func (x *ReportItem) GetTotalBudget() string {
+	if x != nil && x.TotalBudget != nil {
+		return *x.TotalBudget
+	}
+	return ""
+}
+
+func (x *ReportItem) GetCarriedOver() string {
+	if x != nil && x.CarriedOver != nil {
+		return *x.CarriedOver
+	}
+	return ""
+}
+
+func (x *ReportItem) GetEffectiveBudget() string {
+	if x != nil && x.EffectiveBudget != nil {
+		return *x.EffectiveBudget
+	}
+	return ""
+}
+
+type PeriodReport struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart *Date                  `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd   *Date                  `protobuf:"bytes,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	Items       []*ReportItem          `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	// missing_rates - недостающие курсы, суммы и бюджеты в этих валютах не вошли в отчет
+	MissingRates  []string `protobuf:"bytes,4,rep,name=missing_rates,json=missingRates,proto3" json:"missing_rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeriodReport) Reset() {
+	*x = PeriodReport{}
+	mi := &file_ledger_service_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodReport) ProtoMessage() {}
+
+func (x *PeriodReport) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodReport.ProtoReflect.Descriptor instead.
+func (*PeriodReport) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PeriodReport) GetPeriodStart() *Date {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *PeriodReport) GetPeriodEnd() *Date {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *PeriodReport) GetItems() []*ReportItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *PeriodReport) GetMissingRates() []string {
+	if x != nil {
+		return x.MissingRates
+	}
+	return nil
+}
+
+type ListCategoriesRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	FilterIsArchived *bool                  `protobuf:"varint,1,opt,name=filter_is_archived,json=filterIsArchived,proto3,oneof" json:"filter_is_archived,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListCategoriesRequest) GetFilterIsArchived() bool {
+	if x != nil && x.FilterIsArchived != nil {
+		return *x.FilterIsArchived
+	}
+	return false
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Category            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListCategoriesResponse) GetItems() []*Category {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AddCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	ParentId      *int64                 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCategoryRequest) Reset() {
+	*x = AddCategoryRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCategoryRequest) ProtoMessage() {}
+
+func (x *AddCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCategoryRequest.ProtoReflect.Descriptor instead.
+func (*AddCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AddCategoryRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AddCategoryRequest) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+type AddCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Category              `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCategoryResponse) Reset() {
+	*x = AddCategoryResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCategoryResponse) ProtoMessage() {}
+
+func (x *AddCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCategoryResponse.ProtoReflect.Descriptor instead.
+func (*AddCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AddCategoryResponse) GetItem() *Category {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type PatchCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	IsArchived    *bool                  `protobuf:"varint,3,opt,name=is_archived,json=isArchived,proto3,oneof" json:"is_archived,omitempty"`
+	ParentId      *int64                 `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchCategoryRequest) Reset() {
+	*x = PatchCategoryRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchCategoryRequest) ProtoMessage() {}
+
+func (x *PatchCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchCategoryRequest.ProtoReflect.Descriptor instead.
+func (*PatchCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PatchCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PatchCategoryRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *PatchCategoryRequest) GetIsArchived() bool {
+	if x != nil && x.IsArchived != nil {
+		return *x.IsArchived
+	}
+	return false
+}
+
+func (x *PatchCategoryRequest) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+type PatchCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Category              `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchCategoryResponse) Reset() {
+	*x = PatchCategoryResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchCategoryResponse) ProtoMessage() {}
+
+func (x *PatchCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchCategoryResponse.ProtoReflect.Descriptor instead.
+func (*PatchCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PatchCategoryResponse) GetItem() *Category {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReassignToId  *int64                 `protobuf:"varint,2,opt,name=reassign_to_id,json=reassignToId,proto3,oneof" json:"reassign_to_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteCategoryRequest) GetReassignToId() int64 {
+	if x != nil && x.ReassignToId != nil {
+		return *x.ReassignToId
+	}
+	return 0
+}
+
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{28}
+}
+
+type ListTransactionsRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Limit                int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	FilterOccurredOnFrom *Date                  `protobuf:"bytes,3,opt,name=filter_occurred_on_from,json=filterOccurredOnFrom,proto3,oneof" json:"filter_occurred_on_from,omitempty"`
+	FilterOccurredOnTo   *Date                  `protobuf:"bytes,4,opt,name=filter_occurred_on_to,json=filterOccurredOnTo,proto3,oneof" json:"filter_occurred_on_to,omitempty"`
+	FilterWalletId       *string                `protobuf:"bytes,5,opt,name=filter_wallet_id,json=filterWalletId,proto3,oneof" json:"filter_wallet_id,omitempty"`
+	FilterCategoryIds    []int64                `protobuf:"varint,6,rep,packed,name=filter_category_ids,json=filterCategoryIds,proto3" json:"filter_category_ids,omitempty"`
+	FilterIsIncome       *bool                  `protobuf:"varint,7,opt,name=filter_is_income,json=filterIsIncome,proto3,oneof" json:"filter_is_income,omitempty"`
+	// границы суммы по модулю включительно
+	FilterAmountMin *string `protobuf:"bytes,8,opt,name=filter_amount_min,json=filterAmountMin,proto3,oneof" json:"filter_amount_min,omitempty"`
+	FilterAmountMax *string `protobuf:"bytes,9,opt,name=filter_amount_max,json=filterAmountMax,proto3,oneof" json:"filter_amount_max,omitempty"`
+	// полнотекстовый поиск по описанию, синтаксис websearch_to_tsquery
+	FilterSearch *string `protobuf:"bytes,10,opt,name=filter_search,json=filterSearch,proto3,oneof" json:"filter_search,omitempty"`
+	// транзакции, у которых есть все перечисленные теги
+	FilterTags []string            `protobuf:"bytes,11,rep,name=filter_tags,json=filterTags,proto3" json:"filter_tags,omitempty"`
+	Sort       TransactionListSort `protobuf:"varint,12,opt,name=sort,proto3,enum=ledger_service.v1.TransactionListSort" json:"sort,omitempty"`
+	// keyset-пагинация по (occurred_on, created_at, id): пустая строка - первая страница,
+	// offset не используется, поддерживается только сортировка по дате операции по убыванию
+	Cursor *string `protobuf:"bytes,13,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// общее количество в режиме cursor считается только по запросу
+	WithTotal     bool `protobuf:"varint,14,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsRequest) Reset() {
+	*x = ListTransactionsRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsRequest) ProtoMessage() {}
+
+func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListTransactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetFilterOccurredOnFrom() *Date {
+	if x != nil {
+		return x.FilterOccurredOnFrom
+	}
+	return nil
+}
+
+func (x *ListTransactionsRequest) GetFilterOccurredOnTo() *Date {
+	if x != nil {
+		return x.FilterOccurredOnTo
+	}
+	return nil
+}
+
+func (x *ListTransactionsRequest) GetFilterWalletId() string {
+	if x != nil && x.FilterWalletId != nil {
+		return *x.FilterWalletId
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetFilterCategoryIds() []int64 {
+	if x != nil {
+		return x.FilterCategoryIds
+	}
+	return nil
+}
+
+func (x *ListTransactionsRequest) GetFilterIsIncome() bool {
+	if x != nil && x.FilterIsIncome != nil {
+		return *x.FilterIsIncome
+	}
+	return false
+}
+
+func (x *ListTransactionsRequest) GetFilterAmountMin() string {
+	if x != nil && x.FilterAmountMin != nil {
+		return *x.FilterAmountMin
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetFilterAmountMax() string {
+	if x != nil && x.FilterAmountMax != nil {
+		return *x.FilterAmountMax
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetFilterSearch() string {
+	if x != nil && x.FilterSearch != nil {
+		return *x.FilterSearch
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetFilterTags() []string {
+	if x != nil {
+		return x.FilterTags
+	}
+	return nil
+}
+
+func (x *ListTransactionsRequest) GetSort() TransactionListSort {
+	if x != nil {
+		return x.Sort
+	}
+	return TransactionListSort_TRANSACTION_LIST_SORT_UNSPECIFIED
+}
+
+func (x *ListTransactionsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
+type ListTransactionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*Transaction         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// в режиме cursor заполняется при with_total
+	Total *int64 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	// пустая строка - последняя страница
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsResponse) Reset() {
+	*x = ListTransactionsResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsResponse) ProtoMessage() {}
+
+func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListTransactionsResponse) GetItems() []*Transaction {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListTransactionsResponse) GetTotal() int64 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *ListTransactionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionRequest) ProtoMessage() {}
+
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetTransactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Transaction           `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionResponse) Reset() {
+	*x = GetTransactionResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionResponse) ProtoMessage() {}
+
+func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetTransactionResponse) GetItem() *Transaction {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type AddTransactionRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	IsIncome    bool                   `protobuf:"varint,1,opt,name=is_income,json=isIncome,proto3" json:"is_income,omitempty"`
+	Amount      string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	OccurredOn  *Date                  `protobuf:"bytes,3,opt,name=occurred_on,json=occurredOn,proto3" json:"occurred_on,omitempty"`
+	CategoryId  int64                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	WalletId    *string                `protobuf:"bytes,6,opt,name=wallet_id,json=walletId,proto3,oneof" json:"wallet_id,omitempty"`
+	// по умолчанию - валюта кошелька, либо базовая валюта аккаунта
+	Currency      *string  `protobuf:"bytes,7,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	Tags          []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTransactionRequest) Reset() {
+	*x = AddTransactionRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTransactionRequest) ProtoMessage() {}
+
+func (x *AddTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTransactionRequest.ProtoReflect.Descriptor instead.
+func (*AddTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AddTransactionRequest) GetIsIncome() bool {
+	if x != nil {
+		return x.IsIncome
+	}
+	return false
+}
+
+func (x *AddTransactionRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AddTransactionRequest) GetOccurredOn() *Date {
+	if x != nil {
+		return x.OccurredOn
+	}
+	return nil
+}
+
+func (x *AddTransactionRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *AddTransactionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AddTransactionRequest) GetWalletId() string {
+	if x != nil && x.WalletId != nil {
+		return *x.WalletId
+	}
+	return ""
+}
+
+func (x *AddTransactionRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *AddTransactionRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type AddTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Transaction           `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Warnings      []*BudgetWarning       `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTransactionResponse) Reset() {
+	*x = AddTransactionResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTransactionResponse) ProtoMessage() {}
+
+func (x *AddTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTransactionResponse.ProtoReflect.Descriptor instead.
+func (*AddTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AddTransactionResponse) GetItem() *Transaction {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *AddTransactionResponse) GetWarnings() []*BudgetWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type AddTransactionsBatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// items - не более 50000, создаются атомарно: ошибка в любом элементе отменяет весь пакет,
+	// индекс элемента возвращается в hints как items[N]
+	Items         []*AddTransactionRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTransactionsBatchRequest) Reset() {
+	*x = AddTransactionsBatchRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTransactionsBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTransactionsBatchRequest) ProtoMessage() {}
+
+func (x *AddTransactionsBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTransactionsBatchRequest.ProtoReflect.Descriptor instead.
+func (*AddTransactionsBatchRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AddTransactionsBatchRequest) GetItems() []*AddTransactionRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AddTransactionsBatchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ids - идентификаторы созданных транзакций в порядке items
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// warnings - предупреждения по бюджетам с учетом всего пакета
+	Warnings      []*BudgetWarning `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTransactionsBatchResponse) Reset() {
+	*x = AddTransactionsBatchResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTransactionsBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTransactionsBatchResponse) ProtoMessage() {}
+
+func (x *AddTransactionsBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTransactionsBatchResponse.ProtoReflect.Descriptor instead.
+func (*AddTransactionsBatchResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AddTransactionsBatchResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *AddTransactionsBatchResponse) GetWarnings() []*BudgetWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type PatchTransactionRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount      *string                `protobuf:"bytes,2,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	OccurredOn  *Date                  `protobuf:"bytes,3,opt,name=occurred_on,json=occurredOn,proto3,oneof" json:"occurred_on,omitempty"`
+	CategoryId  *int64                 `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Description *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// пустая строка - отвязать от кошелька
+	WalletId *string          `protobuf:"bytes,6,opt,name=wallet_id,json=walletId,proto3,oneof" json:"wallet_id,omitempty"`
+	Currency *string          `protobuf:"bytes,7,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	Tags     *TransactionTags `protobuf:"bytes,8,opt,name=tags,proto3,oneof" json:"tags,omitempty"`
+	// version - ожидаемая версия, при несовпадении возвращается VERSION_CONFLICT, не задана - без проверки
+	Version       *int64 `protobuf:"varint,9,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchTransactionRequest) Reset() {
+	*x = PatchTransactionRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchTransactionRequest) ProtoMessage() {}
+
+func (x *PatchTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchTransactionRequest.ProtoReflect.Descriptor instead.
+func (*PatchTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PatchTransactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PatchTransactionRequest) GetAmount() string {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return ""
+}
+
+func (x *PatchTransactionRequest) GetOccurredOn() *Date {
+	if x != nil {
+		return x.OccurredOn
+	}
+	return nil
+}
+
+func (x *PatchTransactionRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *PatchTransactionRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *PatchTransactionRequest) GetWalletId() string {
+	if x != nil && x.WalletId != nil {
+		return *x.WalletId
+	}
+	return ""
+}
+
+func (x *PatchTransactionRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *PatchTransactionRequest) GetTags() *TransactionTags {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PatchTransactionRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type PatchTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Transaction           `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Warnings      []*BudgetWarning       `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchTransactionResponse) Reset() {
+	*x = PatchTransactionResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchTransactionResponse) ProtoMessage() {}
+
+func (x *PatchTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchTransactionResponse.ProtoReflect.Descriptor instead.
+func (*PatchTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PatchTransactionResponse) GetItem() *Transaction {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *PatchTransactionResponse) GetWarnings() []*BudgetWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type DeleteTransactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// version - ожидаемая версия, при несовпадении возвращается VERSION_CONFLICT, не задана - без проверки
+	Version       *int64 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTransactionRequest) Reset() {
+	*x = DeleteTransactionRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransactionRequest) ProtoMessage() {}
+
+func (x *DeleteTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteTransactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteTransactionRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type DeleteTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTransactionResponse) Reset() {
+	*x = DeleteTransactionResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransactionResponse) ProtoMessage() {}
+
+func (x *DeleteTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransactionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{40}
+}
+
+type ListBudgetsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Limit            int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset           int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	FilterPeriodFrom *DateMonth             `protobuf:"bytes,3,opt,name=filter_period_from,json=filterPeriodFrom,proto3,oneof" json:"filter_period_from,omitempty"`
+	FilterPeriodTo   *DateMonth             `protobuf:"bytes,4,opt,name=filter_period_to,json=filterPeriodTo,proto3,oneof" json:"filter_period_to,omitempty"`
+	// keyset-пагинация по (period, id): пустая строка - первая страница, offset не используется
+	Cursor *string `protobuf:"bytes,5,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// общее количество в режиме cursor считается только по запросу
+	WithTotal     bool `protobuf:"varint,6,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBudgetsRequest) Reset() {
+	*x = ListBudgetsRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetsRequest) ProtoMessage() {}
+
+func (x *ListBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*ListBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListBudgetsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListBudgetsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListBudgetsRequest) GetFilterPeriodFrom() *DateMonth {
+	if x != nil {
+		return x.FilterPeriodFrom
+	}
+	return nil
+}
+
+func (x *ListBudgetsRequest) GetFilterPeriodTo() *DateMonth {
+	if x != nil {
+		return x.FilterPeriodTo
+	}
+	return nil
+}
+
+func (x *ListBudgetsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListBudgetsRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
+type ListBudgetsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*Budget              `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// в режиме cursor заполняется при with_total
+	Total    *int64 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	HitCache bool   `protobuf:"varint,3,opt,name=hit_cache,json=hitCache,proto3" json:"hit_cache,omitempty"`
+	// пустая строка - последняя страница
+	NextCursor    string `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBudgetsResponse) Reset() {
+	*x = ListBudgetsResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBudgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetsResponse) ProtoMessage() {}
+
+func (x *ListBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*ListBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListBudgetsResponse) GetItems() []*Budget {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListBudgetsResponse) GetTotal() int64 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *ListBudgetsResponse) GetHitCache() bool {
+	if x != nil {
+		return x.HitCache
+	}
+	return false
+}
+
+func (x *ListBudgetsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetRequest) Reset() {
+	*x = GetBudgetRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetRequest) ProtoMessage() {}
+
+func (x *GetBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetRequest.ProtoReflect.Descriptor instead.
+func (*GetBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetBudgetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Budget                `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	HitCache      bool                   `protobuf:"varint,2,opt,name=hit_cache,json=hitCache,proto3" json:"hit_cache,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetResponse) Reset() {
+	*x = GetBudgetResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetResponse) ProtoMessage() {}
+
+func (x *GetBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetResponse.ProtoReflect.Descriptor instead.
+func (*GetBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetBudgetResponse) GetItem() *Budget {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *GetBudgetResponse) GetHitCache() bool {
+	if x != nil {
+		return x.HitCache
+	}
+	return false
+}
+
+type AddBudgetRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Period     *DateMonth             `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	CategoryId int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Amount     string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// по умолчанию - базовая валюта аккаунта
+	Currency *string `protobuf:"bytes,4,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	// перенос остатка бюджета прошлого месяца
+	CarryOver bool `protobuf:"varint,5,opt,name=carry_over,json=carryOver,proto3" json:"carry_over,omitempty"`
+	// hard, soft, off; по умолчанию - hard
+	Enforcement       *string `protobuf:"bytes,6,opt,name=enforcement,proto3,oneof" json:"enforcement,omitempty"`
+	WarningThresholds []int32 `protobuf:"varint,7,rep,packed,name=warning_thresholds,json=warningThresholds,proto3" json:"warning_thresholds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AddBudgetRequest) Reset() {
+	*x = AddBudgetRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBudgetRequest) ProtoMessage() {}
+
+func (x *AddBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBudgetRequest.ProtoReflect.Descriptor instead.
+func (*AddBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AddBudgetRequest) GetPeriod() *DateMonth {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *AddBudgetRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *AddBudgetRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AddBudgetRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *AddBudgetRequest) GetCarryOver() bool {
+	if x != nil {
+		return x.CarryOver
+	}
+	return false
+}
+
+func (x *AddBudgetRequest) GetEnforcement() string {
+	if x != nil && x.Enforcement != nil {
+		return *x.Enforcement
+	}
+	return ""
+}
+
+func (x *AddBudgetRequest) GetWarningThresholds() []int32 {
+	if x != nil {
+		return x.WarningThresholds
+	}
+	return nil
+}
+
+type AddBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Budget                `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBudgetResponse) Reset() {
+	*x = AddBudgetResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBudgetResponse) ProtoMessage() {}
+
+func (x *AddBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBudgetResponse.ProtoReflect.Descriptor instead.
+func (*AddBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AddBudgetResponse) GetItem() *Budget {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type PatchBudgetRequest struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	Id                string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Period            *DateMonth               `protobuf:"bytes,2,opt,name=period,proto3,oneof" json:"period,omitempty"`
+	CategoryId        *int64                   `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Amount            *string                  `protobuf:"bytes,4,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	Currency          *string                  `protobuf:"bytes,5,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	CarryOver         *bool                    `protobuf:"varint,6,opt,name=carry_over,json=carryOver,proto3,oneof" json:"carry_over,omitempty"`
+	Enforcement       *string                  `protobuf:"bytes,7,opt,name=enforcement,proto3,oneof" json:"enforcement,omitempty"`
+	WarningThresholds *BudgetWarningThresholds `protobuf:"bytes,8,opt,name=warning_thresholds,json=warningThresholds,proto3,oneof" json:"warning_thresholds,omitempty"`
+	// version - ожидаемая версия, при несовпадении возвращается VERSION_CONFLICT, не задана - без проверки
+	Version       *int64 `protobuf:"varint,9,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchBudgetRequest) Reset() {
+	*x = PatchBudgetRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchBudgetRequest) ProtoMessage() {}
+
+func (x *PatchBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchBudgetRequest.ProtoReflect.Descriptor instead.
+func (*PatchBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PatchBudgetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PatchBudgetRequest) GetPeriod() *DateMonth {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *PatchBudgetRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *PatchBudgetRequest) GetAmount() string {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return ""
+}
+
+func (x *PatchBudgetRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *PatchBudgetRequest) GetCarryOver() bool {
+	if x != nil && x.CarryOver != nil {
+		return *x.CarryOver
+	}
+	return false
+}
+
+func (x *PatchBudgetRequest) GetEnforcement() string {
+	if x != nil && x.Enforcement != nil {
+		return *x.Enforcement
+	}
+	return ""
+}
+
+func (x *PatchBudgetRequest) GetWarningThresholds() *BudgetWarningThresholds {
+	if x != nil {
+		return x.WarningThresholds
+	}
+	return nil
+}
+
+func (x *PatchBudgetRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type PatchBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Budget                `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchBudgetResponse) Reset() {
+	*x = PatchBudgetResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchBudgetResponse) ProtoMessage() {}
+
+func (x *PatchBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchBudgetResponse.ProtoReflect.Descriptor instead.
+func (*PatchBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PatchBudgetResponse) GetItem() *Budget {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type DeleteBudgetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// version - ожидаемая версия, при несовпадении возвращается VERSION_CONFLICT, не задана - без проверки
+	Version       *int64 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetRequest) Reset() {
+	*x = DeleteBudgetRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetRequest) ProtoMessage() {}
+
+func (x *DeleteBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteBudgetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteBudgetRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type DeleteBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetResponse) Reset() {
+	*x = DeleteBudgetResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetResponse) ProtoMessage() {}
+
+func (x *DeleteBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{50}
+}
+
+type CopyBudgetsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SourcePeriod     *DateMonth             `protobuf:"bytes,1,opt,name=source_period,json=sourcePeriod,proto3" json:"source_period,omitempty"`
+	TargetPeriodFrom *DateMonth             `protobuf:"bytes,2,opt,name=target_period_from,json=targetPeriodFrom,proto3" json:"target_period_from,omitempty"`
+	TargetPeriodTo   *DateMonth             `protobuf:"bytes,3,opt,name=target_period_to,json=targetPeriodTo,proto3" json:"target_period_to,omitempty"`
+	// skip - существующие бюджеты не меняются, overwrite - перезаписываются
+	Mode          string `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyBudgetsRequest) Reset() {
+	*x = CopyBudgetsRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyBudgetsRequest) ProtoMessage() {}
+
+func (x *CopyBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*CopyBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CopyBudgetsRequest) GetSourcePeriod() *DateMonth {
+	if x != nil {
+		return x.SourcePeriod
+	}
+	return nil
+}
+
+func (x *CopyBudgetsRequest) GetTargetPeriodFrom() *DateMonth {
+	if x != nil {
+		return x.TargetPeriodFrom
+	}
+	return nil
+}
+
+func (x *CopyBudgetsRequest) GetTargetPeriodTo() *DateMonth {
+	if x != nil {
+		return x.TargetPeriodTo
+	}
+	return nil
+}
+
+func (x *CopyBudgetsRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type CopyBudgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Budget              `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Overwritten   int32                  `protobuf:"varint,3,opt,name=overwritten,proto3" json:"overwritten,omitempty"`
+	Skipped       int32                  `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyBudgetsResponse) Reset() {
+	*x = CopyBudgetsResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyBudgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyBudgetsResponse) ProtoMessage() {}
+
+func (x *CopyBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*CopyBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CopyBudgetsResponse) GetItems() []*Budget {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CopyBudgetsResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *CopyBudgetsResponse) GetOverwritten() int32 {
+	if x != nil {
+		return x.Overwritten
+	}
+	return 0
+}
+
+func (x *CopyBudgetsResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type GetBudgetAutoRolloverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetAutoRolloverRequest) Reset() {
+	*x = GetBudgetAutoRolloverRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetAutoRolloverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetAutoRolloverRequest) ProtoMessage() {}
+
+func (x *GetBudgetAutoRolloverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetAutoRolloverRequest.ProtoReflect.Descriptor instead.
+func (*GetBudgetAutoRolloverRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{53}
+}
+
+type GetBudgetAutoRolloverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetAutoRolloverResponse) Reset() {
+	*x = GetBudgetAutoRolloverResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetAutoRolloverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetAutoRolloverResponse) ProtoMessage() {}
+
+func (x *GetBudgetAutoRolloverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetAutoRolloverResponse.ProtoReflect.Descriptor instead.
+func (*GetBudgetAutoRolloverResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetBudgetAutoRolloverResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetBudgetAutoRolloverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBudgetAutoRolloverRequest) Reset() {
+	*x = SetBudgetAutoRolloverRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBudgetAutoRolloverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBudgetAutoRolloverRequest) ProtoMessage() {}
+
+func (x *SetBudgetAutoRolloverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBudgetAutoRolloverRequest.ProtoReflect.Descriptor instead.
+func (*SetBudgetAutoRolloverRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SetBudgetAutoRolloverRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetBudgetAutoRolloverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBudgetAutoRolloverResponse) Reset() {
+	*x = SetBudgetAutoRolloverResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBudgetAutoRolloverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBudgetAutoRolloverResponse) ProtoMessage() {}
+
+func (x *SetBudgetAutoRolloverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBudgetAutoRolloverResponse.ProtoReflect.Descriptor instead.
+func (*SetBudgetAutoRolloverResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SetBudgetAutoRolloverResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type ListReportsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	DateFrom *Date                  `protobuf:"bytes,1,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo   *Date                  `protobuf:"bytes,2,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	// по умолчанию - базовая валюта аккаунта
+	Currency      *string `protobuf:"bytes,3,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportsRequest) Reset() {
+	*x = ListReportsRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsRequest) ProtoMessage() {}
+
+func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListReportsRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListReportsRequest) GetDateFrom() *Date {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *ListReportsRequest) GetDateTo() *Date {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *ListReportsRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+type ListReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*PeriodReport        `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	HitCache      bool                   `protobuf:"varint,2,opt,name=hit_cache,json=hitCache,proto3" json:"hit_cache,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportsResponse) Reset() {
+	*x = ListReportsResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsResponse) ProtoMessage() {}
+
+func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListReportsResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListReportsResponse) GetReports() []*PeriodReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *ListReportsResponse) GetHitCache() bool {
+	if x != nil {
+		return x.HitCache
+	}
+	return false
+}
+
+func (x *ListReportsResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type CSVExportTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	HitCache      bool                   `protobuf:"varint,3,opt,name=hit_cache,json=hitCache,proto3" json:"hit_cache,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CSVExportTransactionsResponse) Reset() {
+	*x = CSVExportTransactionsResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CSVExportTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CSVExportTransactionsResponse) ProtoMessage() {}
+
+func (x *CSVExportTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CSVExportTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*CSVExportTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CSVExportTransactionsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CSVExportTransactionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CSVExportTransactionsResponse) GetHitCache() bool {
+	if x != nil {
+		return x.HitCache
+	}
+	return false
+}
+
+type StreamExportTransactionsRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	FilterOccurredOnFrom *Date                  `protobuf:"bytes,1,opt,name=filter_occurred_on_from,json=filterOccurredOnFrom,proto3,oneof" json:"filter_occurred_on_from,omitempty"`
+	FilterOccurredOnTo   *Date                  `protobuf:"bytes,2,opt,name=filter_occurred_on_to,json=filterOccurredOnTo,proto3,oneof" json:"filter_occurred_on_to,omitempty"`
+	FilterWalletId       *string                `protobuf:"bytes,3,opt,name=filter_wallet_id,json=filterWalletId,proto3,oneof" json:"filter_wallet_id,omitempty"`
+	// batch_size - число транзакций в одной части, по умолчанию 500
+	BatchSize     int32 `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamExportTransactionsRequest) Reset() {
+	*x = StreamExportTransactionsRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamExportTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamExportTransactionsRequest) ProtoMessage() {}
+
+func (x *StreamExportTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamExportTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*StreamExportTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *StreamExportTransactionsRequest) GetFilterOccurredOnFrom() *Date {
+	if x != nil {
+		return x.FilterOccurredOnFrom
+	}
+	return nil
+}
+
+func (x *StreamExportTransactionsRequest) GetFilterOccurredOnTo() *Date {
+	if x != nil {
+		return x.FilterOccurredOnTo
+	}
+	return nil
+}
+
+func (x *StreamExportTransactionsRequest) GetFilterWalletId() string {
+	if x != nil && x.FilterWalletId != nil {
+		return *x.FilterWalletId
+	}
+	return ""
+}
+
+func (x *StreamExportTransactionsRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type StreamExportTransactionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// chunk - часть CSV, первая часть содержит BOM и заголовок
+	Chunk         []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamExportTransactionsResponse) Reset() {
+	*x = StreamExportTransactionsResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamExportTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamExportTransactionsResponse) ProtoMessage() {}
+
+func (x *StreamExportTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamExportTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*StreamExportTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *StreamExportTransactionsResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type ExportTransactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// по умолчанию - csv
+	Format               ExportFormat `protobuf:"varint,1,opt,name=format,proto3,enum=ledger_service.v1.ExportFormat" json:"format,omitempty"`
+	FilterOccurredOnFrom *Date        `protobuf:"bytes,2,opt,name=filter_occurred_on_from,json=filterOccurredOnFrom,proto3,oneof" json:"filter_occurred_on_from,omitempty"`
+	FilterOccurredOnTo   *Date        `protobuf:"bytes,3,opt,name=filter_occurred_on_to,json=filterOccurredOnTo,proto3,oneof" json:"filter_occurred_on_to,omitempty"`
+	FilterWalletId       *string      `protobuf:"bytes,4,opt,name=filter_wallet_id,json=filterWalletId,proto3,oneof" json:"filter_wallet_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ExportTransactionsRequest) Reset() {
+	*x = ExportTransactionsRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTransactionsRequest) ProtoMessage() {}
+
+func (x *ExportTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ExportTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ExportTransactionsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportTransactionsRequest) GetFilterOccurredOnFrom() *Date {
+	if x != nil {
+		return x.FilterOccurredOnFrom
+	}
+	return nil
+}
+
+func (x *ExportTransactionsRequest) GetFilterOccurredOnTo() *Date {
+	if x != nil {
+		return x.FilterOccurredOnTo
+	}
+	return nil
+}
+
+func (x *ExportTransactionsRequest) GetFilterWalletId() string {
+	if x != nil && x.FilterWalletId != nil {
+		return *x.FilterWalletId
+	}
+	return ""
+}
+
+type ExportTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	FileExtension string                 `protobuf:"bytes,3,opt,name=file_extension,json=fileExtension,proto3" json:"file_extension,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTransactionsResponse) Reset() {
+	*x = ExportTransactionsResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTransactionsResponse) ProtoMessage() {}
+
+func (x *ExportTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ExportTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ExportTransactionsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportTransactionsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportTransactionsResponse) GetFileExtension() string {
+	if x != nil {
+		return x.FileExtension
+	}
+	return ""
+}
+
+func (x *ExportTransactionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ExportReportsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// по умолчанию - csv
+	Format   ExportFormat `protobuf:"varint,1,opt,name=format,proto3,enum=ledger_service.v1.ExportFormat" json:"format,omitempty"`
+	DateFrom *Date        `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo   *Date        `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	// по умолчанию - базовая валюта аккаунта
+	Currency      *string `protobuf:"bytes,4,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportReportsRequest) Reset() {
+	*x = ExportReportsRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReportsRequest) ProtoMessage() {}
+
+func (x *ExportReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReportsRequest.ProtoReflect.Descriptor instead.
+func (*ExportReportsRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ExportReportsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportReportsRequest) GetDateFrom() *Date {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *ExportReportsRequest) GetDateTo() *Date {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *ExportReportsRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+type ExportReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	FileExtension string                 `protobuf:"bytes,3,opt,name=file_extension,json=fileExtension,proto3" json:"file_extension,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportReportsResponse) Reset() {
+	*x = ExportReportsResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReportsResponse) ProtoMessage() {}
+
+func (x *ExportReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReportsResponse.ProtoReflect.Descriptor instead.
+func (*ExportReportsResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ExportReportsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportReportsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportReportsResponse) GetFileExtension() string {
+	if x != nil {
+		return x.FileExtension
+	}
+	return ""
+}
+
+type CSVImportTransactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Data  []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// import_profile_id - профиль разбора файла, без него используется стандартный формат
+	ImportProfileId *string `protobuf:"bytes,2,opt,name=import_profile_id,json=importProfileId,proto3,oneof" json:"import_profile_id,omitempty"`
+	// dry_run - проверить строки без сохранения
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// mode - strict (по умолчанию): импорт отменяется при любой отклоненной строке,
+	// partial: импортируются корректные строки
+	Mode string `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	// duplicate_policy - skip (по умолчанию), update или flag
+	DuplicatePolicy string `protobuf:"bytes,5,opt,name=duplicate_policy,json=duplicatePolicy,proto3" json:"duplicate_policy,omitempty"`
+	// match_tolerance_days - допустимое расхождение дат при поиске дубликатов
+	MatchToleranceDays int32 `protobuf:"varint,6,opt,name=match_tolerance_days,json=matchToleranceDays,proto3" json:"match_tolerance_days,omitempty"`
+	// format - csv (по умолчанию), ofx, qif или camt053
+	Format string `protobuf:"bytes,7,opt,name=format,proto3" json:"format,omitempty"`
+	// default_category_id - категория операций ofx, qif и camt053, без нее берется категория профиля
+	DefaultCategoryId *uint64 `protobuf:"varint,8,opt,name=default_category_id,json=defaultCategoryId,proto3,oneof" json:"default_category_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CSVImportTransactionsRequest) Reset() {
+	*x = CSVImportTransactionsRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CSVImportTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CSVImportTransactionsRequest) ProtoMessage() {}
+
+func (x *CSVImportTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CSVImportTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*CSVImportTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CSVImportTransactionsRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CSVImportTransactionsRequest) GetImportProfileId() string {
+	if x != nil && x.ImportProfileId != nil {
+		return *x.ImportProfileId
+	}
+	return ""
+}
+
+func (x *CSVImportTransactionsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *CSVImportTransactionsRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *CSVImportTransactionsRequest) GetDuplicatePolicy() string {
+	if x != nil {
+		return x.DuplicatePolicy
+	}
+	return ""
+}
+
+func (x *CSVImportTransactionsRequest) GetMatchToleranceDays() int32 {
+	if x != nil {
+		return x.MatchToleranceDays
+	}
+	return 0
+}
+
+func (x *CSVImportTransactionsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *CSVImportTransactionsRequest) GetDefaultCategoryId() uint64 {
+	if x != nil && x.DefaultCategoryId != nil {
+		return *x.DefaultCategoryId
+	}
+	return 0
+}
+
+type CSVImportRow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Line  int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	// status - ok, error, duplicate, updated, flagged или budget_exceeded
+	Status      string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	IsIncome    *bool    `protobuf:"varint,3,opt,name=is_income,json=isIncome,proto3,oneof" json:"is_income,omitempty"`
+	Amount      *string  `protobuf:"bytes,4,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	Currency    *string  `protobuf:"bytes,5,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	OccurredOn  *Date    `protobuf:"bytes,6,opt,name=occurred_on,json=occurredOn,proto3,oneof" json:"occurred_on,omitempty"`
+	CategoryId  *uint64  `protobuf:"varint,7,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Description *string  `protobuf:"bytes,8,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ErrorCodes  []string `protobuf:"bytes,9,rep,name=error_codes,json=errorCodes,proto3" json:"error_codes,omitempty"`
+	Hints       []string `protobuf:"bytes,10,rep,name=hints,proto3" json:"hints,omitempty"`
+	ExternalId  *string  `protobuf:"bytes,11,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
+	// matched_transaction_id - существующая транзакция, с которой совпала строка
+	MatchedTransactionId *string `protobuf:"bytes,12,opt,name=matched_transaction_id,json=matchedTransactionId,proto3,oneof" json:"matched_transaction_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CSVImportRow) Reset() {
+	*x = CSVImportRow{}
+	mi := &file_ledger_service_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CSVImportRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CSVImportRow) ProtoMessage() {}
+
+func (x *CSVImportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CSVImportRow.ProtoReflect.Descriptor instead.
+func (*CSVImportRow) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CSVImportRow) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *CSVImportRow) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CSVImportRow) GetIsIncome() bool {
+	if x != nil && x.IsIncome != nil {
+		return *x.IsIncome
+	}
+	return false
+}
+
+func (x *CSVImportRow) GetAmount() string {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return ""
+}
+
+func (x *CSVImportRow) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *CSVImportRow) GetOccurredOn() *Date {
+	if x != nil {
+		return x.OccurredOn
+	}
+	return nil
+}
+
+func (x *CSVImportRow) GetCategoryId() uint64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *CSVImportRow) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CSVImportRow) GetErrorCodes() []string {
+	if x != nil {
+		return x.ErrorCodes
+	}
+	return nil
+}
+
+func (x *CSVImportRow) GetHints() []string {
+	if x != nil {
+		return x.Hints
+	}
+	return nil
+}
+
+func (x *CSVImportRow) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+func (x *CSVImportRow) GetMatchedTransactionId() string {
+	if x != nil && x.MatchedTransactionId != nil {
+		return *x.MatchedTransactionId
+	}
+	return ""
+}
+
+type CSVImportTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*CSVImportRow        `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	Imported      int32                  `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"`
+	Rejected      int32                  `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	Skipped       int32                  `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Updated       int32                  `protobuf:"varint,6,opt,name=updated,proto3" json:"updated,omitempty"`
+	Flagged       int32                  `protobuf:"varint,7,opt,name=flagged,proto3" json:"flagged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CSVImportTransactionsResponse) Reset() {
+	*x = CSVImportTransactionsResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CSVImportTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CSVImportTransactionsResponse) ProtoMessage() {}
+
+func (x *CSVImportTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CSVImportTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*CSVImportTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CSVImportTransactionsResponse) GetRows() []*CSVImportRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *CSVImportTransactionsResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *CSVImportTransactionsResponse) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *CSVImportTransactionsResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *CSVImportTransactionsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *CSVImportTransactionsResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *CSVImportTransactionsResponse) GetFlagged() int32 {
+	if x != nil {
+		return x.Flagged
+	}
+	return 0
+}
+
+type ListWalletsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	FilterIsArchived *bool                  `protobuf:"varint,1,opt,name=filter_is_archived,json=filterIsArchived,proto3,oneof" json:"filter_is_archived,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListWalletsRequest) Reset() {
+	*x = ListWalletsRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWalletsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletsRequest) ProtoMessage() {}
+
+func (x *ListWalletsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletsRequest.ProtoReflect.Descriptor instead.
+func (*ListWalletsRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListWalletsRequest) GetFilterIsArchived() bool {
+	if x != nil && x.FilterIsArchived != nil {
+		return *x.FilterIsArchived
+	}
+	return false
+}
+
+type ListWalletsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Wallet              `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWalletsResponse) Reset() {
+	*x = ListWalletsResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWalletsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletsResponse) ProtoMessage() {}
+
+func (x *ListWalletsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletsResponse.ProtoReflect.Descriptor instead.
+func (*ListWalletsResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListWalletsResponse) GetItems() []*Wallet {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletRequest) Reset() {
+	*x = GetWalletRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletRequest) ProtoMessage() {}
+
+func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetWalletRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Wallet                `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletResponse) Reset() {
+	*x = GetWalletResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletResponse) ProtoMessage() {}
+
+func (x *GetWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletResponse.ProtoReflect.Descriptor instead.
+func (*GetWalletResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetWalletResponse) GetItem() *Wallet {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type AddWalletRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Currency       string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	OpeningBalance string                 `protobuf:"bytes,3,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddWalletRequest) Reset() {
+	*x = AddWalletRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWalletRequest) ProtoMessage() {}
+
+func (x *AddWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWalletRequest.ProtoReflect.Descriptor instead.
+func (*AddWalletRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AddWalletRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AddWalletRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AddWalletRequest) GetOpeningBalance() string {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return ""
+}
+
+type AddWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Wallet                `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWalletResponse) Reset() {
+	*x = AddWalletResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWalletResponse) ProtoMessage() {}
+
+func (x *AddWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWalletResponse.ProtoReflect.Descriptor instead.
+func (*AddWalletResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AddWalletResponse) GetItem() *Wallet {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type PatchWalletRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	OpeningBalance *string                `protobuf:"bytes,3,opt,name=opening_balance,json=openingBalance,proto3,oneof" json:"opening_balance,omitempty"`
+	IsArchived     *bool                  `protobuf:"varint,4,opt,name=is_archived,json=isArchived,proto3,oneof" json:"is_archived,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PatchWalletRequest) Reset() {
+	*x = PatchWalletRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchWalletRequest) ProtoMessage() {}
+
+func (x *PatchWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchWalletRequest.ProtoReflect.Descriptor instead.
+func (*PatchWalletRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PatchWalletRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PatchWalletRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *PatchWalletRequest) GetOpeningBalance() string {
+	if x != nil && x.OpeningBalance != nil {
+		return *x.OpeningBalance
+	}
+	return ""
+}
+
+func (x *PatchWalletRequest) GetIsArchived() bool {
+	if x != nil && x.IsArchived != nil {
+		return *x.IsArchived
+	}
+	return false
+}
+
+type PatchWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Wallet                `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchWalletResponse) Reset() {
+	*x = PatchWalletResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchWalletResponse) ProtoMessage() {}
+
+func (x *PatchWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchWalletResponse.ProtoReflect.Descriptor instead.
+func (*PatchWalletResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PatchWalletResponse) GetItem() *Wallet {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type DeleteWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWalletRequest) Reset() {
+	*x = DeleteWalletRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWalletRequest) ProtoMessage() {}
+
+func (x *DeleteWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWalletRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWalletRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DeleteWalletRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWalletResponse) Reset() {
+	*x = DeleteWalletResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWalletResponse) ProtoMessage() {}
+
+func (x *DeleteWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWalletResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWalletResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{78}
+}
+
+type GetWalletBalancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletIds     []string               `protobuf:"bytes,1,rep,name=wallet_ids,json=walletIds,proto3" json:"wallet_ids,omitempty"`
+	DateTo        *Date                  `protobuf:"bytes,2,opt,name=date_to,json=dateTo,proto3,oneof" json:"date_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletBalancesRequest) Reset() {
+	*x = GetWalletBalancesRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletBalancesRequest) ProtoMessage() {}
+
+func (x *GetWalletBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletBalancesRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetWalletBalancesRequest) GetWalletIds() []string {
+	if x != nil {
+		return x.WalletIds
+	}
+	return nil
+}
+
+func (x *GetWalletBalancesRequest) GetDateTo() *Date {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+type GetWalletBalancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*WalletBalance       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletBalancesResponse) Reset() {
+	*x = GetWalletBalancesResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletBalancesResponse) ProtoMessage() {}
+
+func (x *GetWalletBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletBalancesResponse.ProtoReflect.Descriptor instead.
+func (*GetWalletBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetWalletBalancesResponse) GetItems() []*WalletBalance {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ListImportProfilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListImportProfilesRequest) Reset() {
+	*x = ListImportProfilesRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListImportProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImportProfilesRequest) ProtoMessage() {}
+
+func (x *ListImportProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImportProfilesRequest.ProtoReflect.Descriptor instead.
+func (*ListImportProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{81}
+}
+
+type ListImportProfilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ImportProfile       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListImportProfilesResponse) Reset() {
+	*x = ListImportProfilesResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListImportProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImportProfilesResponse) ProtoMessage() {}
+
+func (x *ListImportProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImportProfilesResponse.ProtoReflect.Descriptor instead.
+func (*ListImportProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListImportProfilesResponse) GetItems() []*ImportProfile {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetImportProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImportProfileRequest) Reset() {
+	*x = GetImportProfileRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImportProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImportProfileRequest) ProtoMessage() {}
+
+func (x *GetImportProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImportProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetImportProfileRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetImportProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetImportProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *ImportProfile         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImportProfileResponse) Reset() {
+	*x = GetImportProfileResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImportProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImportProfileResponse) ProtoMessage() {}
+
+func (x *GetImportProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImportProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetImportProfileResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetImportProfileResponse) GetItem() *ImportProfile {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type AddImportProfileRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Delimiter        string                 `protobuf:"bytes,2,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	DecimalSeparator string                 `protobuf:"bytes,3,opt,name=decimal_separator,json=decimalSeparator,proto3" json:"decimal_separator,omitempty"`
+	DateLayout       string                 `protobuf:"bytes,4,opt,name=date_layout,json=dateLayout,proto3" json:"date_layout,omitempty"`
+	SkipRows         int32                  `protobuf:"varint,5,opt,name=skip_rows,json=skipRows,proto3" json:"skip_rows,omitempty"`
+	Encoding         string                 `protobuf:"bytes,6,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	Mapping          *ImportProfileMapping  `protobuf:"bytes,7,opt,name=mapping,proto3" json:"mapping,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AddImportProfileRequest) Reset() {
+	*x = AddImportProfileRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddImportProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddImportProfileRequest) ProtoMessage() {}
+
+func (x *AddImportProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddImportProfileRequest.ProtoReflect.Descriptor instead.
+func (*AddImportProfileRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AddImportProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddImportProfileRequest) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+func (x *AddImportProfileRequest) GetDecimalSeparator() string {
+	if x != nil {
+		return x.DecimalSeparator
+	}
+	return ""
+}
+
+func (x *AddImportProfileRequest) GetDateLayout() string {
+	if x != nil {
+		return x.DateLayout
+	}
+	return ""
+}
+
+func (x *AddImportProfileRequest) GetSkipRows() int32 {
+	if x != nil {
+		return x.SkipRows
+	}
+	return 0
+}
+
+func (x *AddImportProfileRequest) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *AddImportProfileRequest) GetMapping() *ImportProfileMapping {
+	if x != nil {
+		return x.Mapping
+	}
+	return nil
+}
+
+type AddImportProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *ImportProfile         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddImportProfileResponse) Reset() {
+	*x = AddImportProfileResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddImportProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddImportProfileResponse) ProtoMessage() {}
+
+func (x *AddImportProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddImportProfileResponse.ProtoReflect.Descriptor instead.
+func (*AddImportProfileResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *AddImportProfileResponse) GetItem() *ImportProfile {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type PatchImportProfileRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Delimiter        *string                `protobuf:"bytes,3,opt,name=delimiter,proto3,oneof" json:"delimiter,omitempty"`
+	DecimalSeparator *string                `protobuf:"bytes,4,opt,name=decimal_separator,json=decimalSeparator,proto3,oneof" json:"decimal_separator,omitempty"`
+	DateLayout       *string                `protobuf:"bytes,5,opt,name=date_layout,json=dateLayout,proto3,oneof" json:"date_layout,omitempty"`
+	SkipRows         *int32                 `protobuf:"varint,6,opt,name=skip_rows,json=skipRows,proto3,oneof" json:"skip_rows,omitempty"`
+	Encoding         *string                `protobuf:"bytes,7,opt,name=encoding,proto3,oneof" json:"encoding,omitempty"`
+	// mapping - заменяется целиком
+	Mapping       *ImportProfileMapping `protobuf:"bytes,8,opt,name=mapping,proto3,oneof" json:"mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchImportProfileRequest) Reset() {
+	*x = PatchImportProfileRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchImportProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchImportProfileRequest) ProtoMessage() {}
+
+func (x *PatchImportProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchImportProfileRequest.ProtoReflect.Descriptor instead.
+func (*PatchImportProfileRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *PatchImportProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PatchImportProfileRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *PatchImportProfileRequest) GetDelimiter() string {
+	if x != nil && x.Delimiter != nil {
+		return *x.Delimiter
+	}
+	return ""
+}
+
+func (x *PatchImportProfileRequest) GetDecimalSeparator() string {
+	if x != nil && x.DecimalSeparator != nil {
+		return *x.DecimalSeparator
+	}
+	return ""
+}
+
+func (x *PatchImportProfileRequest) GetDateLayout() string {
+	if x != nil && x.DateLayout != nil {
+		return *x.DateLayout
+	}
+	return ""
+}
+
+func (x *PatchImportProfileRequest) GetSkipRows() int32 {
+	if x != nil && x.SkipRows != nil {
+		return *x.SkipRows
+	}
+	return 0
+}
+
+func (x *PatchImportProfileRequest) GetEncoding() string {
+	if x != nil && x.Encoding != nil {
+		return *x.Encoding
+	}
+	return ""
+}
+
+func (x *PatchImportProfileRequest) GetMapping() *ImportProfileMapping {
+	if x != nil {
+		return x.Mapping
+	}
+	return nil
+}
+
+type PatchImportProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *ImportProfile         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchImportProfileResponse) Reset() {
+	*x = PatchImportProfileResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchImportProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchImportProfileResponse) ProtoMessage() {}
+
+func (x *PatchImportProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchImportProfileResponse.ProtoReflect.Descriptor instead.
+func (*PatchImportProfileResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *PatchImportProfileResponse) GetItem() *ImportProfile {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type DeleteImportProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteImportProfileRequest) Reset() {
+	*x = DeleteImportProfileRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteImportProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteImportProfileRequest) ProtoMessage() {}
+
+func (x *DeleteImportProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteImportProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteImportProfileRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DeleteImportProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteImportProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteImportProfileResponse) Reset() {
+	*x = DeleteImportProfileResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteImportProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteImportProfileResponse) ProtoMessage() {}
+
+func (x *DeleteImportProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteImportProfileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteImportProfileResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{90}
+}
+
+type ListCategoryRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryRulesRequest) Reset() {
+	*x = ListCategoryRulesRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryRulesRequest) ProtoMessage() {}
+
+func (x *ListCategoryRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoryRulesRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{91}
+}
+
+type ListCategoryRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*CategoryRule        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryRulesResponse) Reset() {
+	*x = ListCategoryRulesResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryRulesResponse) ProtoMessage() {}
+
+func (x *ListCategoryRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoryRulesResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListCategoryRulesResponse) GetItems() []*CategoryRule {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetCategoryRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRuleRequest) Reset() {
+	*x = GetCategoryRuleRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRuleRequest) ProtoMessage() {}
+
+func (x *GetCategoryRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRuleRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetCategoryRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCategoryRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *CategoryRule          `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRuleResponse) Reset() {
+	*x = GetCategoryRuleResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRuleResponse) ProtoMessage() {}
+
+func (x *GetCategoryRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRuleResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryRuleResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetCategoryRuleResponse) GetItem() *CategoryRule {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type AddCategoryRuleRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Name          string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Priority      int32                   `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	CategoryId    int64                   `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Conditions    *CategoryRuleConditions `protobuf:"bytes,4,opt,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCategoryRuleRequest) Reset() {
+	*x = AddCategoryRuleRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCategoryRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCategoryRuleRequest) ProtoMessage() {}
+
+func (x *AddCategoryRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *PeriodReport) GetPeriodEnd() *Date {
+// Deprecated: Use AddCategoryRuleRequest.ProtoReflect.Descriptor instead.
+func (*AddCategoryRuleRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *AddCategoryRuleRequest) GetName() string {
 	if x != nil {
-		return x.PeriodEnd
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *PeriodReport) GetItems() []*ReportItem {
+func (x *AddCategoryRuleRequest) GetPriority() int32 {
 	if x != nil {
-		return x.Items
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *AddCategoryRuleRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *AddCategoryRuleRequest) GetConditions() *CategoryRuleConditions {
+	if x != nil {
+		return x.Conditions
 	}
 	return nil
 }
 
-type ListCategoriesRequest struct {
+type AddCategoryRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *CategoryRule          `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCategoriesRequest) Reset() {
-	*x = ListCategoriesRequest{}
-	mi := &file_ledger_service_service_proto_msgTypes[7]
+func (x *AddCategoryRuleResponse) Reset() {
+	*x = AddCategoryRuleResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCategoriesRequest) String() string {
+func (x *AddCategoryRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCategoriesRequest) ProtoMessage() {}
+func (*AddCategoryRuleResponse) ProtoMessage() {}
 
-func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[7]
+func (x *AddCategoryRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,33 +6539,117 @@ func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
-func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use AddCategoryRuleResponse.ProtoReflect.Descriptor instead.
+func (*AddCategoryRuleResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{96}
 }
 
-type ListCategoriesResponse struct {
+func (x *AddCategoryRuleResponse) GetItem() *CategoryRule {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type PatchCategoryRuleRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Priority   *int32                 `protobuf:"varint,3,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	CategoryId *int64                 `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	// conditions - заменяются целиком
+	Conditions    *CategoryRuleConditions `protobuf:"bytes,5,opt,name=conditions,proto3,oneof" json:"conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchCategoryRuleRequest) Reset() {
+	*x = PatchCategoryRuleRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchCategoryRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchCategoryRuleRequest) ProtoMessage() {}
+
+func (x *PatchCategoryRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchCategoryRuleRequest.ProtoReflect.Descriptor instead.
+func (*PatchCategoryRuleRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *PatchCategoryRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PatchCategoryRuleRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *PatchCategoryRuleRequest) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return 0
+}
+
+func (x *PatchCategoryRuleRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *PatchCategoryRuleRequest) GetConditions() *CategoryRuleConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type PatchCategoryRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Category            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Item          *CategoryRule          `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCategoriesResponse) Reset() {
-	*x = ListCategoriesResponse{}
-	mi := &file_ledger_service_service_proto_msgTypes[8]
+func (x *PatchCategoryRuleResponse) Reset() {
+	*x = PatchCategoryRuleResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCategoriesResponse) String() string {
+func (x *PatchCategoryRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCategoriesResponse) ProtoMessage() {}
+func (*PatchCategoryRuleResponse) ProtoMessage() {}
 
-func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[8]
+func (x *PatchCategoryRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,43 +6660,40 @@ func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
-func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use PatchCategoryRuleResponse.ProtoReflect.Descriptor instead.
+func (*PatchCategoryRuleResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *ListCategoriesResponse) GetItems() []*Category {
+func (x *PatchCategoryRuleResponse) GetItem() *CategoryRule {
 	if x != nil {
-		return x.Items
+		return x.Item
 	}
 	return nil
 }
 
-type ListTransactionsRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Limit                int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset               int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	FilterOccurredOnFrom *Date                  `protobuf:"bytes,3,opt,name=filter_occurred_on_from,json=filterOccurredOnFrom,proto3,oneof" json:"filter_occurred_on_from,omitempty"`
-	FilterOccurredOnTo   *Date                  `protobuf:"bytes,4,opt,name=filter_occurred_on_to,json=filterOccurredOnTo,proto3,oneof" json:"filter_occurred_on_to,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type DeleteCategoryRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTransactionsRequest) Reset() {
-	*x = ListTransactionsRequest{}
-	mi := &file_ledger_service_service_proto_msgTypes[9]
+func (x *DeleteCategoryRuleRequest) Reset() {
+	*x = DeleteCategoryRuleRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTransactionsRequest) String() string {
+func (x *DeleteCategoryRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransactionsRequest) ProtoMessage() {}
+func (*DeleteCategoryRuleRequest) ProtoMessage() {}
 
-func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[9]
+func (x *DeleteCategoryRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,62 +6704,242 @@ func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DeleteCategoryRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRuleRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *ListTransactionsRequest) GetLimit() int32 {
+func (x *DeleteCategoryRuleRequest) GetId() string {
 	if x != nil {
-		return x.Limit
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ListTransactionsRequest) GetOffset() int64 {
+type DeleteCategoryRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRuleResponse) Reset() {
+	*x = DeleteCategoryRuleResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRuleResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[100]
 	if x != nil {
-		return x.Offset
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRuleResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{100}
+}
+
+type ReapplyRulesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	DateFrom *Date                  `protobuf:"bytes,1,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo   *Date                  `protobuf:"bytes,2,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	// dry_run - изменения рассчитываются без сохранения
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReapplyRulesRequest) Reset() {
+	*x = ReapplyRulesRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReapplyRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReapplyRulesRequest) ProtoMessage() {}
+
+func (x *ReapplyRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReapplyRulesRequest.ProtoReflect.Descriptor instead.
+func (*ReapplyRulesRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ReapplyRulesRequest) GetDateFrom() *Date {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *ReapplyRulesRequest) GetDateTo() *Date {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *ReapplyRulesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ReapplyRulesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// checked - количество проверенных транзакций без учета переводов
+	Checked       int32                 `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	Changes       []*CategoryRuleChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	DryRun        bool                  `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReapplyRulesResponse) Reset() {
+	*x = ReapplyRulesResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReapplyRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReapplyRulesResponse) ProtoMessage() {}
+
+func (x *ReapplyRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReapplyRulesResponse.ProtoReflect.Descriptor instead.
+func (*ReapplyRulesResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ReapplyRulesResponse) GetChecked() int32 {
+	if x != nil {
+		return x.Checked
 	}
 	return 0
 }
 
-func (x *ListTransactionsRequest) GetFilterOccurredOnFrom() *Date {
+func (x *ReapplyRulesResponse) GetChanges() []*CategoryRuleChange {
 	if x != nil {
-		return x.FilterOccurredOnFrom
+		return x.Changes
 	}
 	return nil
 }
 
-func (x *ListTransactionsRequest) GetFilterOccurredOnTo() *Date {
+func (x *ReapplyRulesResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type GetTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferRequest) Reset() {
+	*x = GetTransferRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferRequest) ProtoMessage() {}
+
+func (x *GetTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferRequest.ProtoReflect.Descriptor instead.
+func (*GetTransferRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetTransferRequest) GetId() string {
 	if x != nil {
-		return x.FilterOccurredOnTo
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ListTransactionsResponse struct {
+type GetTransferResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Transaction         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Item          *Transfer              `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTransactionsResponse) Reset() {
-	*x = ListTransactionsResponse{}
-	mi := &file_ledger_service_service_proto_msgTypes[10]
+func (x *GetTransferResponse) Reset() {
+	*x = GetTransferResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTransactionsResponse) String() string {
+func (x *GetTransferResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransactionsResponse) ProtoMessage() {}
+func (*GetTransferResponse) ProtoMessage() {}
 
-func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[10]
+func (x *GetTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -695,47 +6950,44 @@ func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetTransferResponse.ProtoReflect.Descriptor instead.
+func (*GetTransferResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *ListTransactionsResponse) GetItems() []*Transaction {
+func (x *GetTransferResponse) GetItem() *Transfer {
 	if x != nil {
-		return x.Items
+		return x.Item
 	}
 	return nil
 }
 
-func (x *ListTransactionsResponse) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-type GetTransactionRequest struct {
+type AddTransferRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromWalletId  string                 `protobuf:"bytes,1,opt,name=from_wallet_id,json=fromWalletId,proto3" json:"from_wallet_id,omitempty"`
+	ToWalletId    string                 `protobuf:"bytes,2,opt,name=to_wallet_id,json=toWalletId,proto3" json:"to_wallet_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	OccurredOn    *Date                  `protobuf:"bytes,4,opt,name=occurred_on,json=occurredOn,proto3" json:"occurred_on,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionRequest) Reset() {
-	*x = GetTransactionRequest{}
-	mi := &file_ledger_service_service_proto_msgTypes[11]
+func (x *AddTransferRequest) Reset() {
+	*x = AddTransferRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionRequest) String() string {
+func (x *AddTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionRequest) ProtoMessage() {}
+func (*AddTransferRequest) ProtoMessage() {}
 
-func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[11]
+func (x *AddTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -746,40 +6998,68 @@ func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use AddTransferRequest.ProtoReflect.Descriptor instead.
+func (*AddTransferRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *GetTransactionRequest) GetId() string {
+func (x *AddTransferRequest) GetFromWalletId() string {
 	if x != nil {
-		return x.Id
+		return x.FromWalletId
 	}
 	return ""
 }
 
-type GetTransactionResponse struct {
+func (x *AddTransferRequest) GetToWalletId() string {
+	if x != nil {
+		return x.ToWalletId
+	}
+	return ""
+}
+
+func (x *AddTransferRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AddTransferRequest) GetOccurredOn() *Date {
+	if x != nil {
+		return x.OccurredOn
+	}
+	return nil
+}
+
+func (x *AddTransferRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type AddTransferResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *Transaction           `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Item          *Transfer              `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionResponse) Reset() {
-	*x = GetTransactionResponse{}
-	mi := &file_ledger_service_service_proto_msgTypes[12]
+func (x *AddTransferResponse) Reset() {
+	*x = AddTransferResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionResponse) String() string {
+func (x *AddTransferResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionResponse) ProtoMessage() {}
+func (*AddTransferResponse) ProtoMessage() {}
 
-func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[12]
+func (x *AddTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,44 +7070,45 @@ func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
-func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use AddTransferResponse.ProtoReflect.Descriptor instead.
+func (*AddTransferResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *GetTransactionResponse) GetItem() *Transaction {
+func (x *AddTransferResponse) GetItem() *Transfer {
 	if x != nil {
 		return x.Item
 	}
 	return nil
 }
 
-type AddTransactionRequest struct {
+type PatchTransferRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsIncome      bool                   `protobuf:"varint,1,opt,name=is_income,json=isIncome,proto3" json:"is_income,omitempty"`
-	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	OccurredOn    *Date                  `protobuf:"bytes,3,opt,name=occurred_on,json=occurredOn,proto3" json:"occurred_on,omitempty"`
-	CategoryId    int64                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromWalletId  *string                `protobuf:"bytes,2,opt,name=from_wallet_id,json=fromWalletId,proto3,oneof" json:"from_wallet_id,omitempty"`
+	ToWalletId    *string                `protobuf:"bytes,3,opt,name=to_wallet_id,json=toWalletId,proto3,oneof" json:"to_wallet_id,omitempty"`
+	Amount        *string                `protobuf:"bytes,4,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	OccurredOn    *Date                  `protobuf:"bytes,5,opt,name=occurred_on,json=occurredOn,proto3,oneof" json:"occurred_on,omitempty"`
+	Description   *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddTransactionRequest) Reset() {
-	*x = AddTransactionRequest{}
-	mi := &file_ledger_service_service_proto_msgTypes[13]
+func (x *PatchTransferRequest) Reset() {
+	*x = PatchTransferRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTransactionRequest) String() string {
+func (x *PatchTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTransactionRequest) ProtoMessage() {}
+func (*PatchTransferRequest) ProtoMessage() {}
 
-func (x *AddTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[13]
+func (x *PatchTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -838,68 +7119,75 @@ func (x *AddTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTransactionRequest.ProtoReflect.Descriptor instead.
-func (*AddTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use PatchTransferRequest.ProtoReflect.Descriptor instead.
+func (*PatchTransferRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *AddTransactionRequest) GetIsIncome() bool {
+func (x *PatchTransferRequest) GetId() string {
 	if x != nil {
-		return x.IsIncome
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-func (x *AddTransactionRequest) GetAmount() string {
-	if x != nil {
-		return x.Amount
+func (x *PatchTransferRequest) GetFromWalletId() string {
+	if x != nil && x.FromWalletId != nil {
+		return *x.FromWalletId
 	}
 	return ""
 }
 
-func (x *AddTransactionRequest) GetOccurredOn() *Date {
-	if x != nil {
-		return x.OccurredOn
+func (x *PatchTransferRequest) GetToWalletId() string {
+	if x != nil && x.ToWalletId != nil {
+		return *x.ToWalletId
 	}
-	return nil
+	return ""
 }
 
-func (x *AddTransactionRequest) GetCategoryId() int64 {
-	if x != nil {
-		return x.CategoryId
+func (x *PatchTransferRequest) GetAmount() string {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
 	}
-	return 0
+	return ""
 }
 
-func (x *AddTransactionRequest) GetDescription() string {
+func (x *PatchTransferRequest) GetOccurredOn() *Date {
 	if x != nil {
-		return x.Description
+		return x.OccurredOn
+	}
+	return nil
+}
+
+func (x *PatchTransferRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
 	return ""
 }
 
-type AddTransactionResponse struct {
+type PatchTransferResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *Transaction           `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Item          *Transfer              `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddTransactionResponse) Reset() {
-	*x = AddTransactionResponse{}
-	mi := &file_ledger_service_service_proto_msgTypes[14]
+func (x *PatchTransferResponse) Reset() {
+	*x = PatchTransferResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTransactionResponse) String() string {
+func (x *PatchTransferResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTransactionResponse) ProtoMessage() {}
+func (*PatchTransferResponse) ProtoMessage() {}
 
-func (x *AddTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[14]
+func (x *PatchTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -910,44 +7198,40 @@ func (x *AddTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTransactionResponse.ProtoReflect.Descriptor instead.
-func (*AddTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use PatchTransferResponse.ProtoReflect.Descriptor instead.
+func (*PatchTransferResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *AddTransactionResponse) GetItem() *Transaction {
+func (x *PatchTransferResponse) GetItem() *Transfer {
 	if x != nil {
 		return x.Item
 	}
 	return nil
 }
 
-type PatchTransactionRequest struct {
+type DeleteTransferRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Amount        *string                `protobuf:"bytes,2,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
-	OccurredOn    *Date                  `protobuf:"bytes,3,opt,name=occurred_on,json=occurredOn,proto3,oneof" json:"occurred_on,omitempty"`
-	CategoryId    *int64                 `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatchTransactionRequest) Reset() {
-	*x = PatchTransactionRequest{}
-	mi := &file_ledger_service_service_proto_msgTypes[15]
+func (x *DeleteTransferRequest) Reset() {
+	*x = DeleteTransferRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PatchTransactionRequest) String() string {
+func (x *DeleteTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchTransactionRequest) ProtoMessage() {}
+func (*DeleteTransferRequest) ProtoMessage() {}
 
-func (x *PatchTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[15]
+func (x *DeleteTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,68 +7242,112 @@ func (x *PatchTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchTransactionRequest.ProtoReflect.Descriptor instead.
-func (*PatchTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use DeleteTransferRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTransferRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *PatchTransactionRequest) GetId() string {
+func (x *DeleteTransferRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *PatchTransactionRequest) GetAmount() string {
-	if x != nil && x.Amount != nil {
-		return *x.Amount
-	}
-	return ""
+type DeleteTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatchTransactionRequest) GetOccurredOn() *Date {
+func (x *DeleteTransferResponse) Reset() {
+	*x = DeleteTransferResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransferResponse) ProtoMessage() {}
+
+func (x *DeleteTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[110]
 	if x != nil {
-		return x.OccurredOn
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *PatchTransactionRequest) GetCategoryId() int64 {
-	if x != nil && x.CategoryId != nil {
-		return *x.CategoryId
-	}
-	return 0
+// Deprecated: Use DeleteTransferResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTransferResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *PatchTransactionRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+type GetBaseCurrencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBaseCurrencyRequest) Reset() {
+	*x = GetBaseCurrencyRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBaseCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBaseCurrencyRequest) ProtoMessage() {}
+
+func (x *GetBaseCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type PatchTransactionResponse struct {
+// Deprecated: Use GetBaseCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*GetBaseCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{111}
+}
+
+type GetBaseCurrencyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *Transaction           `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatchTransactionResponse) Reset() {
-	*x = PatchTransactionResponse{}
-	mi := &file_ledger_service_service_proto_msgTypes[16]
+func (x *GetBaseCurrencyResponse) Reset() {
+	*x = GetBaseCurrencyResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PatchTransactionResponse) String() string {
+func (x *GetBaseCurrencyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchTransactionResponse) ProtoMessage() {}
+func (*GetBaseCurrencyResponse) ProtoMessage() {}
 
-func (x *PatchTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[16]
+func (x *GetBaseCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1030,40 +7358,40 @@ func (x *PatchTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchTransactionResponse.ProtoReflect.Descriptor instead.
-func (*PatchTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetBaseCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*GetBaseCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *PatchTransactionResponse) GetItem() *Transaction {
+func (x *GetBaseCurrencyResponse) GetCurrency() string {
 	if x != nil {
-		return x.Item
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-type DeleteTransactionRequest struct {
+type SetBaseCurrencyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTransactionRequest) Reset() {
-	*x = DeleteTransactionRequest{}
-	mi := &file_ledger_service_service_proto_msgTypes[17]
+func (x *SetBaseCurrencyRequest) Reset() {
+	*x = SetBaseCurrencyRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTransactionRequest) String() string {
+func (x *SetBaseCurrencyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTransactionRequest) ProtoMessage() {}
+func (*SetBaseCurrencyRequest) ProtoMessage() {}
 
-func (x *DeleteTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[17]
+func (x *SetBaseCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1074,39 +7402,40 @@ func (x *DeleteTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTransactionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SetBaseCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*SetBaseCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *DeleteTransactionRequest) GetId() string {
+func (x *SetBaseCurrencyRequest) GetCurrency() string {
 	if x != nil {
-		return x.Id
+		return x.Currency
 	}
 	return ""
 }
 
-type DeleteTransactionResponse struct {
+type SetBaseCurrencyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTransactionResponse) Reset() {
-	*x = DeleteTransactionResponse{}
-	mi := &file_ledger_service_service_proto_msgTypes[18]
+func (x *SetBaseCurrencyResponse) Reset() {
+	*x = SetBaseCurrencyResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTransactionResponse) String() string {
+func (x *SetBaseCurrencyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTransactionResponse) ProtoMessage() {}
+func (*SetBaseCurrencyResponse) ProtoMessage() {}
 
-func (x *DeleteTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[18]
+func (x *SetBaseCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1117,36 +7446,42 @@ func (x *DeleteTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTransactionResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{18}
-}
-
-type ListBudgetsRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Limit            int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset           int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	FilterPeriodFrom *DateMonth             `protobuf:"bytes,3,opt,name=filter_period_from,json=filterPeriodFrom,proto3,oneof" json:"filter_period_from,omitempty"`
-	FilterPeriodTo   *DateMonth             `protobuf:"bytes,4,opt,name=filter_period_to,json=filterPeriodTo,proto3,oneof" json:"filter_period_to,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// Deprecated: Use SetBaseCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*SetBaseCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *ListBudgetsRequest) Reset() {
-	*x = ListBudgetsRequest{}
-	mi := &file_ledger_service_service_proto_msgTypes[19]
+func (x *SetBaseCurrencyResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ListExchangeRatesRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	FilterCurrency     *string                `protobuf:"bytes,1,opt,name=filter_currency,json=filterCurrency,proto3,oneof" json:"filter_currency,omitempty"`
+	FilterRateDateFrom *Date                  `protobuf:"bytes,2,opt,name=filter_rate_date_from,json=filterRateDateFrom,proto3,oneof" json:"filter_rate_date_from,omitempty"`
+	FilterRateDateTo   *Date                  `protobuf:"bytes,3,opt,name=filter_rate_date_to,json=filterRateDateTo,proto3,oneof" json:"filter_rate_date_to,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesRequest) Reset() {
+	*x = ListExchangeRatesRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBudgetsRequest) String() string {
+func (x *ListExchangeRatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBudgetsRequest) ProtoMessage() {}
+func (*ListExchangeRatesRequest) ProtoMessage() {}
 
-func (x *ListBudgetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[19]
+func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1157,63 +7492,54 @@ func (x *ListBudgetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBudgetsRequest.ProtoReflect.Descriptor instead.
-func (*ListBudgetsRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *ListBudgetsRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+// Deprecated: Use ListExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *ListBudgetsRequest) GetOffset() int64 {
-	if x != nil {
-		return x.Offset
+func (x *ListExchangeRatesRequest) GetFilterCurrency() string {
+	if x != nil && x.FilterCurrency != nil {
+		return *x.FilterCurrency
 	}
-	return 0
+	return ""
 }
 
-func (x *ListBudgetsRequest) GetFilterPeriodFrom() *DateMonth {
+func (x *ListExchangeRatesRequest) GetFilterRateDateFrom() *Date {
 	if x != nil {
-		return x.FilterPeriodFrom
+		return x.FilterRateDateFrom
 	}
 	return nil
 }
 
-func (x *ListBudgetsRequest) GetFilterPeriodTo() *DateMonth {
+func (x *ListExchangeRatesRequest) GetFilterRateDateTo() *Date {
 	if x != nil {
-		return x.FilterPeriodTo
+		return x.FilterRateDateTo
 	}
 	return nil
 }
 
-type ListBudgetsResponse struct {
+type ListExchangeRatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Budget              `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	HitCache      bool                   `protobuf:"varint,3,opt,name=hit_cache,json=hitCache,proto3" json:"hit_cache,omitempty"`
+	Items         []*ExchangeRate        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBudgetsResponse) Reset() {
-	*x = ListBudgetsResponse{}
-	mi := &file_ledger_service_service_proto_msgTypes[20]
+func (x *ListExchangeRatesResponse) Reset() {
+	*x = ListExchangeRatesResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBudgetsResponse) String() string {
+func (x *ListExchangeRatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBudgetsResponse) ProtoMessage() {}
+func (*ListExchangeRatesResponse) ProtoMessage() {}
 
-func (x *ListBudgetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[20]
+func (x *ListExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1224,54 +7550,40 @@ func (x *ListBudgetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBudgetsResponse.ProtoReflect.Descriptor instead.
-func (*ListBudgetsResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *ListBudgetsResponse) GetItems() []*Budget {
+func (x *ListExchangeRatesResponse) GetItems() []*ExchangeRate {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-func (x *ListBudgetsResponse) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *ListBudgetsResponse) GetHitCache() bool {
-	if x != nil {
-		return x.HitCache
-	}
-	return false
-}
-
-type GetBudgetRequest struct {
+type UpsertExchangeRatesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Items         []*ExchangeRate        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBudgetRequest) Reset() {
-	*x = GetBudgetRequest{}
-	mi := &file_ledger_service_service_proto_msgTypes[21]
+func (x *UpsertExchangeRatesRequest) Reset() {
+	*x = UpsertExchangeRatesRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBudgetRequest) String() string {
+func (x *UpsertExchangeRatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBudgetRequest) ProtoMessage() {}
+func (*UpsertExchangeRatesRequest) ProtoMessage() {}
 
-func (x *GetBudgetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[21]
+func (x *UpsertExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1282,41 +7594,40 @@ func (x *GetBudgetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBudgetRequest.ProtoReflect.Descriptor instead.
-func (*GetBudgetRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use UpsertExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*UpsertExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *GetBudgetRequest) GetId() string {
+func (x *UpsertExchangeRatesRequest) GetItems() []*ExchangeRate {
 	if x != nil {
-		return x.Id
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-type GetBudgetResponse struct {
+type UpsertExchangeRatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *Budget                `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
-	HitCache      bool                   `protobuf:"varint,2,opt,name=hit_cache,json=hitCache,proto3" json:"hit_cache,omitempty"`
+	Items         []*ExchangeRate        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBudgetResponse) Reset() {
-	*x = GetBudgetResponse{}
-	mi := &file_ledger_service_service_proto_msgTypes[22]
+func (x *UpsertExchangeRatesResponse) Reset() {
+	*x = UpsertExchangeRatesResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBudgetResponse) String() string {
+func (x *UpsertExchangeRatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBudgetResponse) ProtoMessage() {}
+func (*UpsertExchangeRatesResponse) ProtoMessage() {}
 
-func (x *GetBudgetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[22]
+func (x *UpsertExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1327,49 +7638,40 @@ func (x *GetBudgetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBudgetResponse.ProtoReflect.Descriptor instead.
-func (*GetBudgetResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UpsertExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*UpsertExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *GetBudgetResponse) GetItem() *Budget {
+func (x *UpsertExchangeRatesResponse) GetItems() []*ExchangeRate {
 	if x != nil {
-		return x.Item
+		return x.Items
 	}
 	return nil
 }
 
-func (x *GetBudgetResponse) GetHitCache() bool {
-	if x != nil {
-		return x.HitCache
-	}
-	return false
-}
-
-type AddBudgetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Period        *DateMonth             `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
-	CategoryId    int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListRecurringRulesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FilterIsPaused *bool                  `protobuf:"varint,1,opt,name=filter_is_paused,json=filterIsPaused,proto3,oneof" json:"filter_is_paused,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *AddBudgetRequest) Reset() {
-	*x = AddBudgetRequest{}
-	mi := &file_ledger_service_service_proto_msgTypes[23]
+func (x *ListRecurringRulesRequest) Reset() {
+	*x = ListRecurringRulesRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddBudgetRequest) String() string {
+func (x *ListRecurringRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddBudgetRequest) ProtoMessage() {}
+func (*ListRecurringRulesRequest) ProtoMessage() {}
 
-func (x *AddBudgetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[23]
+func (x *ListRecurringRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1380,54 +7682,40 @@ func (x *AddBudgetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddBudgetRequest.ProtoReflect.Descriptor instead.
-func (*AddBudgetRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *AddBudgetRequest) GetPeriod() *DateMonth {
-	if x != nil {
-		return x.Period
-	}
-	return nil
-}
-
-func (x *AddBudgetRequest) GetCategoryId() int64 {
-	if x != nil {
-		return x.CategoryId
-	}
-	return 0
+// Deprecated: Use ListRecurringRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListRecurringRulesRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *AddBudgetRequest) GetAmount() string {
-	if x != nil {
-		return x.Amount
+func (x *ListRecurringRulesRequest) GetFilterIsPaused() bool {
+	if x != nil && x.FilterIsPaused != nil {
+		return *x.FilterIsPaused
 	}
-	return ""
+	return false
 }
 
-type AddBudgetResponse struct {
+type ListRecurringRulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *Budget                `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Items         []*RecurringRule       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddBudgetResponse) Reset() {
-	*x = AddBudgetResponse{}
-	mi := &file_ledger_service_service_proto_msgTypes[24]
+func (x *ListRecurringRulesResponse) Reset() {
+	*x = ListRecurringRulesResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddBudgetResponse) String() string {
+func (x *ListRecurringRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddBudgetResponse) ProtoMessage() {}
+func (*ListRecurringRulesResponse) ProtoMessage() {}
 
-func (x *AddBudgetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[24]
+func (x *ListRecurringRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1438,43 +7726,40 @@ func (x *AddBudgetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddBudgetResponse.ProtoReflect.Descriptor instead.
-func (*AddBudgetResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ListRecurringRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListRecurringRulesResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *AddBudgetResponse) GetItem() *Budget {
+func (x *ListRecurringRulesResponse) GetItems() []*RecurringRule {
 	if x != nil {
-		return x.Item
+		return x.Items
 	}
 	return nil
 }
 
-type PatchBudgetRequest struct {
+type GetRecurringRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Period        *DateMonth             `protobuf:"bytes,2,opt,name=period,proto3,oneof" json:"period,omitempty"`
-	CategoryId    *int64                 `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	Amount        *string                `protobuf:"bytes,4,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatchBudgetRequest) Reset() {
-	*x = PatchBudgetRequest{}
-	mi := &file_ledger_service_service_proto_msgTypes[25]
+func (x *GetRecurringRuleRequest) Reset() {
+	*x = GetRecurringRuleRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PatchBudgetRequest) String() string {
+func (x *GetRecurringRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchBudgetRequest) ProtoMessage() {}
+func (*GetRecurringRuleRequest) ProtoMessage() {}
 
-func (x *PatchBudgetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[25]
+func (x *GetRecurringRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1485,61 +7770,40 @@ func (x *PatchBudgetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchBudgetRequest.ProtoReflect.Descriptor instead.
-func (*PatchBudgetRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetRecurringRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetRecurringRuleRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *PatchBudgetRequest) GetId() string {
+func (x *GetRecurringRuleRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *PatchBudgetRequest) GetPeriod() *DateMonth {
-	if x != nil {
-		return x.Period
-	}
-	return nil
-}
-
-func (x *PatchBudgetRequest) GetCategoryId() int64 {
-	if x != nil && x.CategoryId != nil {
-		return *x.CategoryId
-	}
-	return 0
-}
-
-func (x *PatchBudgetRequest) GetAmount() string {
-	if x != nil && x.Amount != nil {
-		return *x.Amount
-	}
-	return ""
-}
-
-type PatchBudgetResponse struct {
+type GetRecurringRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *Budget                `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Item          *RecurringRule         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatchBudgetResponse) Reset() {
-	*x = PatchBudgetResponse{}
-	mi := &file_ledger_service_service_proto_msgTypes[26]
+func (x *GetRecurringRuleResponse) Reset() {
+	*x = GetRecurringRuleResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PatchBudgetResponse) String() string {
+func (x *GetRecurringRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchBudgetResponse) ProtoMessage() {}
+func (*GetRecurringRuleResponse) ProtoMessage() {}
 
-func (x *PatchBudgetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[26]
+func (x *GetRecurringRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1550,40 +7814,53 @@ func (x *PatchBudgetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchBudgetResponse.ProtoReflect.Descriptor instead.
-func (*PatchBudgetResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GetRecurringRuleResponse.ProtoReflect.Descriptor instead.
+func (*GetRecurringRuleResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *PatchBudgetResponse) GetItem() *Budget {
+func (x *GetRecurringRuleResponse) GetItem() *RecurringRule {
 	if x != nil {
 		return x.Item
 	}
 	return nil
 }
 
-type DeleteBudgetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type AddRecurringRuleRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	IsIncome    bool                   `protobuf:"varint,1,opt,name=is_income,json=isIncome,proto3" json:"is_income,omitempty"`
+	Amount      string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	CategoryId  int64                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	WalletId    *string                `protobuf:"bytes,5,opt,name=wallet_id,json=walletId,proto3,oneof" json:"wallet_id,omitempty"`
+	// по умолчанию - валюта кошелька, либо базовая валюта аккаунта
+	Currency  *string `protobuf:"bytes,6,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	Frequency string  `protobuf:"bytes,7,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Interval  int32   `protobuf:"varint,8,opt,name=interval,proto3" json:"interval,omitempty"`
+	// только для monthly, по умолчанию - день даты начала
+	DayOfMonth    int32  `protobuf:"varint,9,opt,name=day_of_month,json=dayOfMonth,proto3" json:"day_of_month,omitempty"`
+	StartDate     *Date  `protobuf:"bytes,10,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *Date  `protobuf:"bytes,11,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	Count         *int32 `protobuf:"varint,12,opt,name=count,proto3,oneof" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteBudgetRequest) Reset() {
-	*x = DeleteBudgetRequest{}
-	mi := &file_ledger_service_service_proto_msgTypes[27]
+func (x *AddRecurringRuleRequest) Reset() {
+	*x = AddRecurringRuleRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBudgetRequest) String() string {
+func (x *AddRecurringRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBudgetRequest) ProtoMessage() {}
+func (*AddRecurringRuleRequest) ProtoMessage() {}
 
-func (x *DeleteBudgetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[27]
+func (x *AddRecurringRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1591,42 +7868,120 @@ func (x *DeleteBudgetRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRecurringRuleRequest.ProtoReflect.Descriptor instead.
+func (*AddRecurringRuleRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *AddRecurringRuleRequest) GetIsIncome() bool {
+	if x != nil {
+		return x.IsIncome
+	}
+	return false
+}
+
+func (x *AddRecurringRuleRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AddRecurringRuleRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *AddRecurringRuleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AddRecurringRuleRequest) GetWalletId() string {
+	if x != nil && x.WalletId != nil {
+		return *x.WalletId
+	}
+	return ""
+}
+
+func (x *AddRecurringRuleRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *AddRecurringRuleRequest) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+func (x *AddRecurringRuleRequest) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *AddRecurringRuleRequest) GetDayOfMonth() int32 {
+	if x != nil {
+		return x.DayOfMonth
+	}
+	return 0
 }
 
-// Deprecated: Use DeleteBudgetRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBudgetRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{27}
+func (x *AddRecurringRuleRequest) GetStartDate() *Date {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
 }
 
-func (x *DeleteBudgetRequest) GetId() string {
+func (x *AddRecurringRuleRequest) GetEndDate() *Date {
 	if x != nil {
-		return x.Id
+		return x.EndDate
 	}
-	return ""
+	return nil
 }
 
-type DeleteBudgetResponse struct {
+func (x *AddRecurringRuleRequest) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+type AddRecurringRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *RecurringRule         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteBudgetResponse) Reset() {
-	*x = DeleteBudgetResponse{}
-	mi := &file_ledger_service_service_proto_msgTypes[28]
+func (x *AddRecurringRuleResponse) Reset() {
+	*x = AddRecurringRuleResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBudgetResponse) String() string {
+func (x *AddRecurringRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBudgetResponse) ProtoMessage() {}
+func (*AddRecurringRuleResponse) ProtoMessage() {}
 
-func (x *DeleteBudgetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[28]
+func (x *AddRecurringRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1637,34 +7992,51 @@ func (x *DeleteBudgetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBudgetResponse.ProtoReflect.Descriptor instead.
-func (*DeleteBudgetResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use AddRecurringRuleResponse.ProtoReflect.Descriptor instead.
+func (*AddRecurringRuleResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{124}
 }
 
-type ListReportsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DateFrom      *Date                  `protobuf:"bytes,1,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
-	DateTo        *Date                  `protobuf:"bytes,2,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+func (x *AddRecurringRuleResponse) GetItem() *RecurringRule {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type PatchRecurringRuleRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount     *string                `protobuf:"bytes,2,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	Currency   *string                `protobuf:"bytes,3,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	CategoryId *int64                 `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	// пустая строка - отвязать от кошелька
+	WalletId    *string `protobuf:"bytes,5,opt,name=wallet_id,json=walletId,proto3,oneof" json:"wallet_id,omitempty"`
+	Description *string `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// нулевая дата снимает ограничение
+	EndDate *Date `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	// 0 снимает ограничение
+	Count         *int32 `protobuf:"varint,8,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	IsPaused      *bool  `protobuf:"varint,9,opt,name=is_paused,json=isPaused,proto3,oneof" json:"is_paused,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListReportsRequest) Reset() {
-	*x = ListReportsRequest{}
-	mi := &file_ledger_service_service_proto_msgTypes[29]
+func (x *PatchRecurringRuleRequest) Reset() {
+	*x = PatchRecurringRuleRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListReportsRequest) String() string {
+func (x *PatchRecurringRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReportsRequest) ProtoMessage() {}
+func (*PatchRecurringRuleRequest) ProtoMessage() {}
 
-func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[29]
+func (x *PatchRecurringRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1675,101 +8047,96 @@ func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReportsRequest.ProtoReflect.Descriptor instead.
-func (*ListReportsRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use PatchRecurringRuleRequest.ProtoReflect.Descriptor instead.
+func (*PatchRecurringRuleRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *ListReportsRequest) GetDateFrom() *Date {
+func (x *PatchRecurringRuleRequest) GetId() string {
 	if x != nil {
-		return x.DateFrom
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListReportsRequest) GetDateTo() *Date {
-	if x != nil {
-		return x.DateTo
+func (x *PatchRecurringRuleRequest) GetAmount() string {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
 	}
-	return nil
-}
-
-type ListReportsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reports       []*PeriodReport        `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
-	HitCache      bool                   `protobuf:"varint,2,opt,name=hit_cache,json=hitCache,proto3" json:"hit_cache,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *ListReportsResponse) Reset() {
-	*x = ListReportsResponse{}
-	mi := &file_ledger_service_service_proto_msgTypes[30]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PatchRecurringRuleRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
 }
 
-func (x *ListReportsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PatchRecurringRuleRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
 }
 
-func (*ListReportsResponse) ProtoMessage() {}
-
-func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[30]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PatchRecurringRuleRequest) GetWalletId() string {
+	if x != nil && x.WalletId != nil {
+		return *x.WalletId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListReportsResponse.ProtoReflect.Descriptor instead.
-func (*ListReportsResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{30}
+func (x *PatchRecurringRuleRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
 }
 
-func (x *ListReportsResponse) GetReports() []*PeriodReport {
+func (x *PatchRecurringRuleRequest) GetEndDate() *Date {
 	if x != nil {
-		return x.Reports
+		return x.EndDate
 	}
 	return nil
 }
 
-func (x *ListReportsResponse) GetHitCache() bool {
-	if x != nil {
-		return x.HitCache
+func (x *PatchRecurringRuleRequest) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *PatchRecurringRuleRequest) GetIsPaused() bool {
+	if x != nil && x.IsPaused != nil {
+		return *x.IsPaused
 	}
 	return false
 }
 
-type CSVExportTransactionsResponse struct {
+type PatchRecurringRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	HitCache      bool                   `protobuf:"varint,3,opt,name=hit_cache,json=hitCache,proto3" json:"hit_cache,omitempty"`
+	Item          *RecurringRule         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CSVExportTransactionsResponse) Reset() {
-	*x = CSVExportTransactionsResponse{}
-	mi := &file_ledger_service_service_proto_msgTypes[31]
+func (x *PatchRecurringRuleResponse) Reset() {
+	*x = PatchRecurringRuleResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CSVExportTransactionsResponse) String() string {
+func (x *PatchRecurringRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CSVExportTransactionsResponse) ProtoMessage() {}
+func (*PatchRecurringRuleResponse) ProtoMessage() {}
 
-func (x *CSVExportTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[31]
+func (x *PatchRecurringRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1780,54 +8147,40 @@ func (x *CSVExportTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CSVExportTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*CSVExportTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use PatchRecurringRuleResponse.ProtoReflect.Descriptor instead.
+func (*PatchRecurringRuleResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *CSVExportTransactionsResponse) GetData() []byte {
+func (x *PatchRecurringRuleResponse) GetItem() *RecurringRule {
 	if x != nil {
-		return x.Data
+		return x.Item
 	}
 	return nil
 }
 
-func (x *CSVExportTransactionsResponse) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *CSVExportTransactionsResponse) GetHitCache() bool {
-	if x != nil {
-		return x.HitCache
-	}
-	return false
-}
-
-type CSVImportTransactionsRequest struct {
+type DeleteRecurringRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CSVImportTransactionsRequest) Reset() {
-	*x = CSVImportTransactionsRequest{}
-	mi := &file_ledger_service_service_proto_msgTypes[32]
+func (x *DeleteRecurringRuleRequest) Reset() {
+	*x = DeleteRecurringRuleRequest{}
+	mi := &file_ledger_service_service_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CSVImportTransactionsRequest) String() string {
+func (x *DeleteRecurringRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CSVImportTransactionsRequest) ProtoMessage() {}
+func (*DeleteRecurringRuleRequest) ProtoMessage() {}
 
-func (x *CSVImportTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[32]
+func (x *DeleteRecurringRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1838,39 +8191,39 @@ func (x *CSVImportTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CSVImportTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*CSVImportTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use DeleteRecurringRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecurringRuleRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *CSVImportTransactionsRequest) GetData() []byte {
+func (x *DeleteRecurringRuleRequest) GetId() string {
 	if x != nil {
-		return x.Data
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type CSVImportTransactionsResponse struct {
+type DeleteRecurringRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CSVImportTransactionsResponse) Reset() {
-	*x = CSVImportTransactionsResponse{}
-	mi := &file_ledger_service_service_proto_msgTypes[33]
+func (x *DeleteRecurringRuleResponse) Reset() {
+	*x = DeleteRecurringRuleResponse{}
+	mi := &file_ledger_service_service_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CSVImportTransactionsResponse) String() string {
+func (x *DeleteRecurringRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CSVImportTransactionsResponse) ProtoMessage() {}
+func (*DeleteRecurringRuleResponse) ProtoMessage() {}
 
-func (x *CSVImportTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_service_service_proto_msgTypes[33]
+func (x *DeleteRecurringRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_service_service_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1881,26 +8234,39 @@ func (x *CSVImportTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CSVImportTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*CSVImportTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_service_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use DeleteRecurringRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRecurringRuleResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_service_service_proto_rawDescGZIP(), []int{128}
 }
 
 var File_ledger_service_service_proto protoreflect.FileDescriptor
 
 const file_ledger_service_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1cledger_service/service.proto\x12\x11ledger_service.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"0\n" +
+	"\x1cledger_service/service.proto\x12\x11ledger_service.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe3\x02\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\"B\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\"\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tH\x00R\taccountId\x88\x01\x01\x12\x1f\n" +
+	"\vis_archived\x18\x04 \x01(\bR\n" +
+	"isArchived\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12 \n" +
+	"\tparent_id\x18\a \x01(\x03H\x01R\bparentId\x88\x01\x01\x127\n" +
+	"\bchildren\x18\b \x03(\v2\x1b.ledger_service.v1.CategoryR\bchildrenB\r\n" +
+	"\v_account_idB\f\n" +
+	"\n" +
+	"_parent_id\"B\n" +
 	"\x04Date\x12\x12\n" +
 	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
 	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x10\n" +
 	"\x03day\x18\x03 \x01(\x05R\x03day\"5\n" +
 	"\tDateMonth\x12\x12\n" +
 	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
-	"\x05month\x18\x02 \x01(\x05R\x05month\"\xe4\x02\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\"\xd2\x05\n" +
 	"\vTransaction\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -1915,7 +8281,134 @@ const file_ledger_service_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9c\x02\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12 \n" +
+	"\twallet_id\x18\n" +
+	" \x01(\tH\x00R\bwalletId\x88\x01\x01\x12$\n" +
+	"\vtransfer_id\x18\v \x01(\tH\x01R\n" +
+	"transferId\x88\x01\x01\x12\x1a\n" +
+	"\bcurrency\x18\f \x01(\tR\bcurrency\x12/\n" +
+	"\x11recurring_rule_id\x18\r \x01(\tH\x02R\x0frecurringRuleId\x88\x01\x01\x12$\n" +
+	"\vexternal_id\x18\x0e \x01(\tH\x03R\n" +
+	"externalId\x88\x01\x01\x12+\n" +
+	"\x0fduplicate_of_id\x18\x0f \x01(\tH\x04R\rduplicateOfId\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x10 \x03(\tR\x04tags\x12\x18\n" +
+	"\aversion\x18\x11 \x01(\x03R\aversionB\f\n" +
+	"\n" +
+	"_wallet_idB\x0e\n" +
+	"\f_transfer_idB\x14\n" +
+	"\x12_recurring_rule_idB\x0e\n" +
+	"\f_external_idB\x12\n" +
+	"\x10_duplicate_of_id\")\n" +
+	"\x0fTransactionTags\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\xa9\x02\n" +
+	"\x06Wallet\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12'\n" +
+	"\x0fopening_balance\x18\x05 \x01(\tR\x0eopeningBalance\x12\x1f\n" +
+	"\vis_archived\x18\x06 \x01(\bR\n" +
+	"isArchived\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8d\x02\n" +
+	"\x14ImportProfileColumns\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x14\n" +
+	"\x05debit\x18\x03 \x01(\tR\x05debit\x12\x16\n" +
+	"\x06credit\x18\x04 \x01(\tR\x06credit\x12\x1b\n" +
+	"\tis_income\x18\x05 \x01(\tR\bisIncome\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vcategory_id\x18\a \x01(\tR\n" +
+	"categoryId\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vexternal_id\x18\t \x01(\tR\n" +
+	"externalId\"\x93\x02\n" +
+	"\x14ImportProfileMapping\x12\x1d\n" +
+	"\n" +
+	"has_header\x18\x01 \x01(\bR\thasHeader\x12'\n" +
+	"\x0fsign_convention\x18\x02 \x01(\tR\x0esignConvention\x12#\n" +
+	"\rincome_values\x18\x03 \x03(\tR\fincomeValues\x12A\n" +
+	"\acolumns\x18\x04 \x01(\v2'.ledger_service.v1.ImportProfileColumnsR\acolumns\x123\n" +
+	"\x13default_category_id\x18\x05 \x01(\x04H\x00R\x11defaultCategoryId\x88\x01\x01B\x16\n" +
+	"\x14_default_category_id\"\xb0\x03\n" +
+	"\rImportProfile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tdelimiter\x18\x04 \x01(\tR\tdelimiter\x12+\n" +
+	"\x11decimal_separator\x18\x05 \x01(\tR\x10decimalSeparator\x12\x1f\n" +
+	"\vdate_layout\x18\x06 \x01(\tR\n" +
+	"dateLayout\x12\x1b\n" +
+	"\tskip_rows\x18\a \x01(\x05R\bskipRows\x12\x1a\n" +
+	"\bencoding\x18\b \x01(\tR\bencoding\x12A\n" +
+	"\amapping\x18\t \x01(\v2'.ledger_service.v1.ImportProfileMappingR\amapping\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf7\x02\n" +
+	"\x16CategoryRuleConditions\x126\n" +
+	"\x14description_contains\x18\x01 \x01(\tH\x00R\x13descriptionContains\x88\x01\x01\x120\n" +
+	"\x11description_regex\x18\x02 \x01(\tH\x01R\x10descriptionRegex\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"amount_min\x18\x03 \x01(\tH\x02R\tamountMin\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"amount_max\x18\x04 \x01(\tH\x03R\tamountMax\x88\x01\x01\x12 \n" +
+	"\tis_income\x18\x05 \x01(\bH\x04R\bisIncome\x88\x01\x01\x12 \n" +
+	"\twallet_id\x18\x06 \x01(\tH\x05R\bwalletId\x88\x01\x01B\x17\n" +
+	"\x15_description_containsB\x14\n" +
+	"\x12_description_regexB\r\n" +
+	"\v_amount_minB\r\n" +
+	"\v_amount_maxB\f\n" +
+	"\n" +
+	"_is_incomeB\f\n" +
+	"\n" +
+	"_wallet_id\"\xcf\x02\n" +
+	"\fCategoryRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\x05R\bpriority\x12\x1f\n" +
+	"\vcategory_id\x18\x05 \x01(\x03R\n" +
+	"categoryId\x12I\n" +
+	"\n" +
+	"conditions\x18\x06 \x01(\v2).ledger_service.v1.CategoryRuleConditionsR\n" +
+	"conditions\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa4\x01\n" +
+	"\x12CategoryRuleChange\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\x12&\n" +
+	"\x0fold_category_id\x18\x03 \x01(\x03R\roldCategoryId\x12&\n" +
+	"\x0fnew_category_id\x18\x04 \x01(\x03R\rnewCategoryId\"\x87\x01\n" +
+	"\rWalletBalance\x121\n" +
+	"\x06wallet\x18\x01 \x01(\v2\x19.ledger_service.v1.WalletR\x06wallet\x12)\n" +
+	"\x10transactions_sum\x18\x02 \x01(\tR\x0ftransactionsSum\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\tR\abalance\"\x87\x03\n" +
+	"\bTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12$\n" +
+	"\x0efrom_wallet_id\x18\x03 \x01(\tR\ffromWalletId\x12 \n" +
+	"\fto_wallet_id\x18\x04 \x01(\tR\n" +
+	"toWalletId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x128\n" +
+	"\voccurred_on\x18\x06 \x01(\v2\x17.ledger_service.v1.DateR\n" +
+	"occurredOn\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tR\bcurrency\"\xc2\x03\n" +
 	"\x06Budget\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -1927,7 +8420,71 @@ const file_ledger_service_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xbb\x01\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"carry_over\x18\t \x01(\bR\tcarryOver\x12 \n" +
+	"\venforcement\x18\n" +
+	" \x01(\tR\venforcement\x12-\n" +
+	"\x12warning_thresholds\x18\v \x03(\x05R\x11warningThresholds\x12\x18\n" +
+	"\aversion\x18\f \x01(\x03R\aversion\"/\n" +
+	"\x17BudgetWarningThresholds\x12\x14\n" +
+	"\x05items\x18\x01 \x03(\x05R\x05items\"\x9b\x02\n" +
+	"\rBudgetWarning\x12\x1b\n" +
+	"\tbudget_id\x18\x01 \x01(\tR\bbudgetId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x03R\n" +
+	"categoryId\x124\n" +
+	"\x06period\x18\x03 \x01(\v2\x1c.ledger_service.v1.DateMonthR\x06period\x12 \n" +
+	"\venforcement\x18\x04 \x01(\tR\venforcement\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\x05R\tthreshold\x12(\n" +
+	"\rspent_percent\x18\x06 \x01(\tH\x00R\fspentPercent\x88\x01\x01\x12\x1a\n" +
+	"\bexceeded\x18\a \x01(\bR\bexceededB\x10\n" +
+	"\x0e_spent_percent\"\x94\x02\n" +
+	"\fExchangeRate\x12#\n" +
+	"\rcurrency_from\x18\x01 \x01(\tR\fcurrencyFrom\x12\x1f\n" +
+	"\vcurrency_to\x18\x02 \x01(\tR\n" +
+	"currencyTo\x124\n" +
+	"\trate_date\x18\x03 \x01(\v2\x17.ledger_service.v1.DateR\brateDate\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\tR\x04rate\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd7\x06\n" +
+	"\rRecurringRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x1b\n" +
+	"\tis_income\x18\x03 \x01(\bR\bisIncome\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\x03R\n" +
+	"categoryId\x12 \n" +
+	"\twallet_id\x18\a \x01(\tH\x00R\bwalletId\x88\x01\x01\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12\x1c\n" +
+	"\tfrequency\x18\t \x01(\tR\tfrequency\x12\x1a\n" +
+	"\binterval\x18\n" +
+	" \x01(\x05R\binterval\x12 \n" +
+	"\fday_of_month\x18\v \x01(\x05R\n" +
+	"dayOfMonth\x126\n" +
+	"\n" +
+	"start_date\x18\f \x01(\v2\x17.ledger_service.v1.DateR\tstartDate\x127\n" +
+	"\bend_date\x18\r \x01(\v2\x17.ledger_service.v1.DateH\x01R\aendDate\x88\x01\x01\x12\x19\n" +
+	"\x05count\x18\x0e \x01(\x05H\x02R\x05count\x88\x01\x01\x12+\n" +
+	"\x11occurrences_count\x18\x0f \x01(\x05R\x10occurrencesCount\x12J\n" +
+	"\x12next_occurrence_on\x18\x10 \x01(\v2\x17.ledger_service.v1.DateH\x03R\x10nextOccurrenceOn\x88\x01\x01\x12\x1b\n" +
+	"\tis_paused\x18\x11 \x01(\bR\bisPaused\x12\"\n" +
+	"\n" +
+	"last_error\x18\x12 \x01(\tH\x04R\tlastError\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\f\n" +
+	"\n" +
+	"_wallet_idB\v\n" +
+	"\t_end_dateB\b\n" +
+	"\x06_countB\x15\n" +
+	"\x13_next_occurrence_onB\r\n" +
+	"\v_last_error\"\xb6\x04\n" +
 	"\n" +
 	"ReportItem\x12\x1f\n" +
 	"\vcategory_id\x18\x01 \x01(\x03R\n" +
@@ -1935,32 +8492,95 @@ const file_ledger_service_service_proto_rawDesc = "" +
 	"\x03sum\x18\x02 \x01(\tH\x00R\x03sum\x88\x01\x01\x12&\n" +
 	"\fspent_budget\x18\x03 \x01(\tH\x01R\vspentBudget\x88\x01\x01\x12$\n" +
 	"\vitem_budget\x18\x04 \x01(\tH\x02R\n" +
-	"itemBudget\x88\x01\x01B\x06\n" +
+	"itemBudget\x88\x01\x01\x121\n" +
+	"\x12parent_category_id\x18\x05 \x01(\x03H\x03R\x10parentCategoryId\x88\x01\x01\x12 \n" +
+	"\ttotal_sum\x18\x06 \x01(\tH\x04R\btotalSum\x88\x01\x01\x121\n" +
+	"\x12total_spent_budget\x18\a \x01(\tH\x05R\x10totalSpentBudget\x88\x01\x01\x12&\n" +
+	"\ftotal_budget\x18\b \x01(\tH\x06R\vtotalBudget\x88\x01\x01\x12&\n" +
+	"\fcarried_over\x18\t \x01(\tH\aR\vcarriedOver\x88\x01\x01\x12.\n" +
+	"\x10effective_budget\x18\n" +
+	" \x01(\tH\bR\x0feffectiveBudget\x88\x01\x01B\x06\n" +
 	"\x04_sumB\x0f\n" +
 	"\r_spent_budgetB\x0e\n" +
-	"\f_item_budget\"\xb7\x01\n" +
+	"\f_item_budgetB\x15\n" +
+	"\x13_parent_category_idB\f\n" +
+	"\n" +
+	"_total_sumB\x15\n" +
+	"\x13_total_spent_budgetB\x0f\n" +
+	"\r_total_budgetB\x0f\n" +
+	"\r_carried_overB\x13\n" +
+	"\x11_effective_budget\"\xdc\x01\n" +
 	"\fPeriodReport\x12:\n" +
 	"\fperiod_start\x18\x01 \x01(\v2\x17.ledger_service.v1.DateR\vperiodStart\x126\n" +
 	"\n" +
 	"period_end\x18\x02 \x01(\v2\x17.ledger_service.v1.DateR\tperiodEnd\x123\n" +
-	"\x05items\x18\x03 \x03(\v2\x1d.ledger_service.v1.ReportItemR\x05items\"\x17\n" +
-	"\x15ListCategoriesRequest\"K\n" +
+	"\x05items\x18\x03 \x03(\v2\x1d.ledger_service.v1.ReportItemR\x05items\x12#\n" +
+	"\rmissing_rates\x18\x04 \x03(\tR\fmissingRates\"a\n" +
+	"\x15ListCategoriesRequest\x121\n" +
+	"\x12filter_is_archived\x18\x01 \x01(\bH\x00R\x10filterIsArchived\x88\x01\x01B\x15\n" +
+	"\x13_filter_is_archived\"K\n" +
 	"\x16ListCategoriesResponse\x121\n" +
-	"\x05items\x18\x01 \x03(\v2\x1b.ledger_service.v1.CategoryR\x05items\"\xa3\x02\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.ledger_service.v1.CategoryR\x05items\"Z\n" +
+	"\x12AddCategoryRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\tparent_id\x18\x02 \x01(\x03H\x00R\bparentId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_parent_id\"F\n" +
+	"\x13AddCategoryResponse\x12/\n" +
+	"\x04item\x18\x01 \x01(\v2\x1b.ledger_service.v1.CategoryR\x04item\"\xb1\x01\n" +
+	"\x14PatchCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12$\n" +
+	"\vis_archived\x18\x03 \x01(\bH\x01R\n" +
+	"isArchived\x88\x01\x01\x12 \n" +
+	"\tparent_id\x18\x04 \x01(\x03H\x02R\bparentId\x88\x01\x01B\b\n" +
+	"\x06_titleB\x0e\n" +
+	"\f_is_archivedB\f\n" +
+	"\n" +
+	"_parent_id\"H\n" +
+	"\x15PatchCategoryResponse\x12/\n" +
+	"\x04item\x18\x01 \x01(\v2\x1b.ledger_service.v1.CategoryR\x04item\"e\n" +
+	"\x15DeleteCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12)\n" +
+	"\x0ereassign_to_id\x18\x02 \x01(\x03H\x00R\freassignToId\x88\x01\x01B\x11\n" +
+	"\x0f_reassign_to_id\"\x18\n" +
+	"\x16DeleteCategoryResponse\"\xc9\x06\n" +
 	"\x17ListTransactionsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12S\n" +
 	"\x17filter_occurred_on_from\x18\x03 \x01(\v2\x17.ledger_service.v1.DateH\x00R\x14filterOccurredOnFrom\x88\x01\x01\x12O\n" +
-	"\x15filter_occurred_on_to\x18\x04 \x01(\v2\x17.ledger_service.v1.DateH\x01R\x12filterOccurredOnTo\x88\x01\x01B\x1a\n" +
+	"\x15filter_occurred_on_to\x18\x04 \x01(\v2\x17.ledger_service.v1.DateH\x01R\x12filterOccurredOnTo\x88\x01\x01\x12-\n" +
+	"\x10filter_wallet_id\x18\x05 \x01(\tH\x02R\x0efilterWalletId\x88\x01\x01\x12.\n" +
+	"\x13filter_category_ids\x18\x06 \x03(\x03R\x11filterCategoryIds\x12-\n" +
+	"\x10filter_is_income\x18\a \x01(\bH\x03R\x0efilterIsIncome\x88\x01\x01\x12/\n" +
+	"\x11filter_amount_min\x18\b \x01(\tH\x04R\x0ffilterAmountMin\x88\x01\x01\x12/\n" +
+	"\x11filter_amount_max\x18\t \x01(\tH\x05R\x0ffilterAmountMax\x88\x01\x01\x12(\n" +
+	"\rfilter_search\x18\n" +
+	" \x01(\tH\x06R\ffilterSearch\x88\x01\x01\x12\x1f\n" +
+	"\vfilter_tags\x18\v \x03(\tR\n" +
+	"filterTags\x12:\n" +
+	"\x04sort\x18\f \x01(\x0e2&.ledger_service.v1.TransactionListSortR\x04sort\x12\x1b\n" +
+	"\x06cursor\x18\r \x01(\tH\aR\x06cursor\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"with_total\x18\x0e \x01(\bR\twithTotalB\x1a\n" +
 	"\x18_filter_occurred_on_fromB\x18\n" +
-	"\x16_filter_occurred_on_to\"f\n" +
+	"\x16_filter_occurred_on_toB\x13\n" +
+	"\x11_filter_wallet_idB\x13\n" +
+	"\x11_filter_is_incomeB\x14\n" +
+	"\x12_filter_amount_minB\x14\n" +
+	"\x12_filter_amount_maxB\x10\n" +
+	"\x0e_filter_searchB\t\n" +
+	"\a_cursor\"\x96\x01\n" +
 	"\x18ListTransactionsResponse\x124\n" +
-	"\x05items\x18\x01 \x03(\v2\x1e.ledger_service.v1.TransactionR\x05items\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\"'\n" +
+	"\x05items\x18\x01 \x03(\v2\x1e.ledger_service.v1.TransactionR\x05items\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x03H\x00R\x05total\x88\x01\x01\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursorB\b\n" +
+	"\x06_total\"'\n" +
 	"\x15GetTransactionRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"L\n" +
 	"\x16GetTransactionResponse\x122\n" +
-	"\x04item\x18\x01 \x01(\v2\x1e.ledger_service.v1.TransactionR\x04item\"\xc9\x01\n" +
+	"\x04item\x18\x01 \x01(\v2\x1e.ledger_service.v1.TransactionR\x04item\"\xbb\x02\n" +
 	"\x15AddTransactionRequest\x12\x1b\n" +
 	"\tis_income\x18\x01 \x01(\bR\bisIncome\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\tR\x06amount\x128\n" +
@@ -1968,9 +8588,21 @@ const file_ledger_service_service_proto_rawDesc = "" +
 	"occurredOn\x12\x1f\n" +
 	"\vcategory_id\x18\x04 \x01(\x03R\n" +
 	"categoryId\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\"L\n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12 \n" +
+	"\twallet_id\x18\x06 \x01(\tH\x00R\bwalletId\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\a \x01(\tH\x01R\bcurrency\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tagsB\f\n" +
+	"\n" +
+	"_wallet_idB\v\n" +
+	"\t_currency\"\x8a\x01\n" +
 	"\x16AddTransactionResponse\x122\n" +
-	"\x04item\x18\x01 \x01(\v2\x1e.ledger_service.v1.TransactionR\x04item\"\x8d\x02\n" +
+	"\x04item\x18\x01 \x01(\v2\x1e.ledger_service.v1.TransactionR\x04item\x12<\n" +
+	"\bwarnings\x18\x02 \x03(\v2 .ledger_service.v1.BudgetWarningR\bwarnings\"]\n" +
+	"\x1bAddTransactionsBatchRequest\x12>\n" +
+	"\x05items\x18\x01 \x03(\v2(.ledger_service.v1.AddTransactionRequestR\x05items\"n\n" +
+	"\x1cAddTransactionsBatchResponse\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12<\n" +
+	"\bwarnings\x18\x02 \x03(\v2 .ledger_service.v1.BudgetWarningR\bwarnings\"\xdc\x03\n" +
 	"\x17PatchTransactionRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\x06amount\x18\x02 \x01(\tH\x00R\x06amount\x88\x01\x01\x12=\n" +
@@ -1978,71 +8610,461 @@ const file_ledger_service_service_proto_rawDesc = "" +
 	"occurredOn\x88\x01\x01\x12$\n" +
 	"\vcategory_id\x18\x04 \x01(\x03H\x02R\n" +
 	"categoryId\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x05 \x01(\tH\x03R\vdescription\x88\x01\x01B\t\n" +
+	"\vdescription\x18\x05 \x01(\tH\x03R\vdescription\x88\x01\x01\x12 \n" +
+	"\twallet_id\x18\x06 \x01(\tH\x04R\bwalletId\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\a \x01(\tH\x05R\bcurrency\x88\x01\x01\x12;\n" +
+	"\x04tags\x18\b \x01(\v2\".ledger_service.v1.TransactionTagsH\x06R\x04tags\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\t \x01(\x03H\aR\aversion\x88\x01\x01B\t\n" +
 	"\a_amountB\x0e\n" +
 	"\f_occurred_onB\x0e\n" +
 	"\f_category_idB\x0e\n" +
-	"\f_description\"N\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_wallet_idB\v\n" +
+	"\t_currencyB\a\n" +
+	"\x05_tagsB\n" +
+	"\n" +
+	"\b_version\"\x8c\x01\n" +
 	"\x18PatchTransactionResponse\x122\n" +
-	"\x04item\x18\x01 \x01(\v2\x1e.ledger_service.v1.TransactionR\x04item\"*\n" +
+	"\x04item\x18\x01 \x01(\v2\x1e.ledger_service.v1.TransactionR\x04item\x12<\n" +
+	"\bwarnings\x18\x02 \x03(\v2 .ledger_service.v1.BudgetWarningR\bwarnings\"U\n" +
 	"\x18DeleteTransactionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x1b\n" +
-	"\x19DeleteTransactionResponse\"\x8c\x02\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\x03H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"\x1b\n" +
+	"\x19DeleteTransactionResponse\"\xd3\x02\n" +
 	"\x12ListBudgetsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12O\n" +
 	"\x12filter_period_from\x18\x03 \x01(\v2\x1c.ledger_service.v1.DateMonthH\x00R\x10filterPeriodFrom\x88\x01\x01\x12K\n" +
-	"\x10filter_period_to\x18\x04 \x01(\v2\x1c.ledger_service.v1.DateMonthH\x01R\x0efilterPeriodTo\x88\x01\x01B\x15\n" +
+	"\x10filter_period_to\x18\x04 \x01(\v2\x1c.ledger_service.v1.DateMonthH\x01R\x0efilterPeriodTo\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x05 \x01(\tH\x02R\x06cursor\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"with_total\x18\x06 \x01(\bR\twithTotalB\x15\n" +
 	"\x13_filter_period_fromB\x13\n" +
-	"\x11_filter_period_to\"y\n" +
+	"\x11_filter_period_toB\t\n" +
+	"\a_cursor\"\xa9\x01\n" +
 	"\x13ListBudgetsResponse\x12/\n" +
-	"\x05items\x18\x01 \x03(\v2\x19.ledger_service.v1.BudgetR\x05items\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x1b\n" +
-	"\thit_cache\x18\x03 \x01(\bR\bhitCache\"\"\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.ledger_service.v1.BudgetR\x05items\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x03H\x00R\x05total\x88\x01\x01\x12\x1b\n" +
+	"\thit_cache\x18\x03 \x01(\bR\bhitCache\x12\x1f\n" +
+	"\vnext_cursor\x18\x04 \x01(\tR\n" +
+	"nextCursorB\b\n" +
+	"\x06_total\"\"\n" +
 	"\x10GetBudgetRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"_\n" +
 	"\x11GetBudgetResponse\x12-\n" +
 	"\x04item\x18\x01 \x01(\v2\x19.ledger_service.v1.BudgetR\x04item\x12\x1b\n" +
-	"\thit_cache\x18\x02 \x01(\bR\bhitCache\"\x81\x01\n" +
+	"\thit_cache\x18\x02 \x01(\bR\bhitCache\"\xb4\x02\n" +
 	"\x10AddBudgetRequest\x124\n" +
 	"\x06period\x18\x01 \x01(\v2\x1c.ledger_service.v1.DateMonthR\x06period\x12\x1f\n" +
 	"\vcategory_id\x18\x02 \x01(\x03R\n" +
 	"categoryId\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\tR\x06amount\"B\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1f\n" +
+	"\bcurrency\x18\x04 \x01(\tH\x00R\bcurrency\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"carry_over\x18\x05 \x01(\bR\tcarryOver\x12%\n" +
+	"\venforcement\x18\x06 \x01(\tH\x01R\venforcement\x88\x01\x01\x12-\n" +
+	"\x12warning_thresholds\x18\a \x03(\x05R\x11warningThresholdsB\v\n" +
+	"\t_currencyB\x0e\n" +
+	"\f_enforcement\"B\n" +
 	"\x11AddBudgetResponse\x12-\n" +
-	"\x04item\x18\x01 \x01(\v2\x19.ledger_service.v1.BudgetR\x04item\"\xc8\x01\n" +
+	"\x04item\x18\x01 \x01(\v2\x19.ledger_service.v1.BudgetR\x04item\"\x82\x04\n" +
 	"\x12PatchBudgetRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
 	"\x06period\x18\x02 \x01(\v2\x1c.ledger_service.v1.DateMonthH\x00R\x06period\x88\x01\x01\x12$\n" +
 	"\vcategory_id\x18\x03 \x01(\x03H\x01R\n" +
 	"categoryId\x88\x01\x01\x12\x1b\n" +
-	"\x06amount\x18\x04 \x01(\tH\x02R\x06amount\x88\x01\x01B\t\n" +
+	"\x06amount\x18\x04 \x01(\tH\x02R\x06amount\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x05 \x01(\tH\x03R\bcurrency\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"carry_over\x18\x06 \x01(\bH\x04R\tcarryOver\x88\x01\x01\x12%\n" +
+	"\venforcement\x18\a \x01(\tH\x05R\venforcement\x88\x01\x01\x12^\n" +
+	"\x12warning_thresholds\x18\b \x01(\v2*.ledger_service.v1.BudgetWarningThresholdsH\x06R\x11warningThresholds\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\t \x01(\x03H\aR\aversion\x88\x01\x01B\t\n" +
 	"\a_periodB\x0e\n" +
 	"\f_category_idB\t\n" +
-	"\a_amount\"D\n" +
+	"\a_amountB\v\n" +
+	"\t_currencyB\r\n" +
+	"\v_carry_overB\x0e\n" +
+	"\f_enforcementB\x15\n" +
+	"\x13_warning_thresholdsB\n" +
+	"\n" +
+	"\b_version\"D\n" +
 	"\x13PatchBudgetResponse\x12-\n" +
-	"\x04item\x18\x01 \x01(\v2\x19.ledger_service.v1.BudgetR\x04item\"%\n" +
+	"\x04item\x18\x01 \x01(\v2\x19.ledger_service.v1.BudgetR\x04item\"P\n" +
 	"\x13DeleteBudgetRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x16\n" +
-	"\x14DeleteBudgetResponse\"|\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\x03H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"\x16\n" +
+	"\x14DeleteBudgetResponse\"\xff\x01\n" +
+	"\x12CopyBudgetsRequest\x12A\n" +
+	"\rsource_period\x18\x01 \x01(\v2\x1c.ledger_service.v1.DateMonthR\fsourcePeriod\x12J\n" +
+	"\x12target_period_from\x18\x02 \x01(\v2\x1c.ledger_service.v1.DateMonthR\x10targetPeriodFrom\x12F\n" +
+	"\x10target_period_to\x18\x03 \x01(\v2\x1c.ledger_service.v1.DateMonthR\x0etargetPeriodTo\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\tR\x04mode\"\x9c\x01\n" +
+	"\x13CopyBudgetsResponse\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.ledger_service.v1.BudgetR\x05items\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12 \n" +
+	"\voverwritten\x18\x03 \x01(\x05R\voverwritten\x12\x18\n" +
+	"\askipped\x18\x04 \x01(\x05R\askipped\"\x1e\n" +
+	"\x1cGetBudgetAutoRolloverRequest\"9\n" +
+	"\x1dGetBudgetAutoRolloverResponse\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\"8\n" +
+	"\x1cSetBudgetAutoRolloverRequest\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\"9\n" +
+	"\x1dSetBudgetAutoRolloverResponse\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\"\xaa\x01\n" +
 	"\x12ListReportsRequest\x124\n" +
 	"\tdate_from\x18\x01 \x01(\v2\x17.ledger_service.v1.DateR\bdateFrom\x120\n" +
-	"\adate_to\x18\x02 \x01(\v2\x17.ledger_service.v1.DateR\x06dateTo\"m\n" +
+	"\adate_to\x18\x02 \x01(\v2\x17.ledger_service.v1.DateR\x06dateTo\x12\x1f\n" +
+	"\bcurrency\x18\x03 \x01(\tH\x00R\bcurrency\x88\x01\x01B\v\n" +
+	"\t_currency\"\x89\x01\n" +
 	"\x13ListReportsResponse\x129\n" +
 	"\areports\x18\x01 \x03(\v2\x1f.ledger_service.v1.PeriodReportR\areports\x12\x1b\n" +
-	"\thit_cache\x18\x02 \x01(\bR\bhitCache\"f\n" +
+	"\thit_cache\x18\x02 \x01(\bR\bhitCache\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"f\n" +
 	"\x1dCSVExportTransactionsResponse\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\fR\x04data\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x1b\n" +
-	"\thit_cache\x18\x03 \x01(\bR\bhitCache\"2\n" +
+	"\thit_cache\x18\x03 \x01(\bR\bhitCache\"\xe0\x02\n" +
+	"\x1fStreamExportTransactionsRequest\x12S\n" +
+	"\x17filter_occurred_on_from\x18\x01 \x01(\v2\x17.ledger_service.v1.DateH\x00R\x14filterOccurredOnFrom\x88\x01\x01\x12O\n" +
+	"\x15filter_occurred_on_to\x18\x02 \x01(\v2\x17.ledger_service.v1.DateH\x01R\x12filterOccurredOnTo\x88\x01\x01\x12-\n" +
+	"\x10filter_wallet_id\x18\x03 \x01(\tH\x02R\x0efilterWalletId\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSizeB\x1a\n" +
+	"\x18_filter_occurred_on_fromB\x18\n" +
+	"\x16_filter_occurred_on_toB\x13\n" +
+	"\x11_filter_wallet_id\"8\n" +
+	" StreamExportTransactionsResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"\xf4\x02\n" +
+	"\x19ExportTransactionsRequest\x127\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x1f.ledger_service.v1.ExportFormatR\x06format\x12S\n" +
+	"\x17filter_occurred_on_from\x18\x02 \x01(\v2\x17.ledger_service.v1.DateH\x00R\x14filterOccurredOnFrom\x88\x01\x01\x12O\n" +
+	"\x15filter_occurred_on_to\x18\x03 \x01(\v2\x17.ledger_service.v1.DateH\x01R\x12filterOccurredOnTo\x88\x01\x01\x12-\n" +
+	"\x10filter_wallet_id\x18\x04 \x01(\tH\x02R\x0efilterWalletId\x88\x01\x01B\x1a\n" +
+	"\x18_filter_occurred_on_fromB\x18\n" +
+	"\x16_filter_occurred_on_toB\x13\n" +
+	"\x11_filter_wallet_id\"\x90\x01\n" +
+	"\x1aExportTransactionsResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12%\n" +
+	"\x0efile_extension\x18\x03 \x01(\tR\rfileExtension\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\"\xe5\x01\n" +
+	"\x14ExportReportsRequest\x127\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x1f.ledger_service.v1.ExportFormatR\x06format\x124\n" +
+	"\tdate_from\x18\x02 \x01(\v2\x17.ledger_service.v1.DateR\bdateFrom\x120\n" +
+	"\adate_to\x18\x03 \x01(\v2\x17.ledger_service.v1.DateR\x06dateTo\x12\x1f\n" +
+	"\bcurrency\x18\x04 \x01(\tH\x00R\bcurrency\x88\x01\x01B\v\n" +
+	"\t_currency\"u\n" +
+	"\x15ExportReportsResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12%\n" +
+	"\x0efile_extension\x18\x03 \x01(\tR\rfileExtension\"\xe8\x02\n" +
 	"\x1cCSVImportTransactionsRequest\x12\x12\n" +
-	"\x04data\x18\x01 \x01(\fR\x04data\"\x1f\n" +
-	"\x1dCSVImportTransactionsResponse2\xa5\v\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12/\n" +
+	"\x11import_profile_id\x18\x02 \x01(\tH\x00R\x0fimportProfileId\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\tR\x04mode\x12)\n" +
+	"\x10duplicate_policy\x18\x05 \x01(\tR\x0fduplicatePolicy\x120\n" +
+	"\x14match_tolerance_days\x18\x06 \x01(\x05R\x12matchToleranceDays\x12\x16\n" +
+	"\x06format\x18\a \x01(\tR\x06format\x123\n" +
+	"\x13default_category_id\x18\b \x01(\x04H\x01R\x11defaultCategoryId\x88\x01\x01B\x14\n" +
+	"\x12_import_profile_idB\x16\n" +
+	"\x14_default_category_id\"\xbf\x04\n" +
+	"\fCSVImportRow\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12 \n" +
+	"\tis_income\x18\x03 \x01(\bH\x00R\bisIncome\x88\x01\x01\x12\x1b\n" +
+	"\x06amount\x18\x04 \x01(\tH\x01R\x06amount\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x05 \x01(\tH\x02R\bcurrency\x88\x01\x01\x12=\n" +
+	"\voccurred_on\x18\x06 \x01(\v2\x17.ledger_service.v1.DateH\x03R\n" +
+	"occurredOn\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\a \x01(\x04H\x04R\n" +
+	"categoryId\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\b \x01(\tH\x05R\vdescription\x88\x01\x01\x12\x1f\n" +
+	"\verror_codes\x18\t \x03(\tR\n" +
+	"errorCodes\x12\x14\n" +
+	"\x05hints\x18\n" +
+	" \x03(\tR\x05hints\x12$\n" +
+	"\vexternal_id\x18\v \x01(\tH\x06R\n" +
+	"externalId\x88\x01\x01\x129\n" +
+	"\x16matched_transaction_id\x18\f \x01(\tH\aR\x14matchedTransactionId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_is_incomeB\t\n" +
+	"\a_amountB\v\n" +
+	"\t_currencyB\x0e\n" +
+	"\f_occurred_onB\x0e\n" +
+	"\f_category_idB\x0e\n" +
+	"\f_descriptionB\x0e\n" +
+	"\f_external_idB\x19\n" +
+	"\x17_matched_transaction_id\"\xf3\x01\n" +
+	"\x1dCSVImportTransactionsResponse\x123\n" +
+	"\x04rows\x18\x01 \x03(\v2\x1f.ledger_service.v1.CSVImportRowR\x04rows\x12\x1a\n" +
+	"\bimported\x18\x02 \x01(\x05R\bimported\x12\x1a\n" +
+	"\brejected\x18\x03 \x01(\x05R\brejected\x12\x18\n" +
+	"\askipped\x18\x04 \x01(\x05R\askipped\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\aupdated\x18\x06 \x01(\x05R\aupdated\x12\x18\n" +
+	"\aflagged\x18\a \x01(\x05R\aflagged\"^\n" +
+	"\x12ListWalletsRequest\x121\n" +
+	"\x12filter_is_archived\x18\x01 \x01(\bH\x00R\x10filterIsArchived\x88\x01\x01B\x15\n" +
+	"\x13_filter_is_archived\"F\n" +
+	"\x13ListWalletsResponse\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.ledger_service.v1.WalletR\x05items\"\"\n" +
+	"\x10GetWalletRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"B\n" +
+	"\x11GetWalletResponse\x12-\n" +
+	"\x04item\x18\x01 \x01(\v2\x19.ledger_service.v1.WalletR\x04item\"m\n" +
+	"\x10AddWalletRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12'\n" +
+	"\x0fopening_balance\x18\x03 \x01(\tR\x0eopeningBalance\"B\n" +
+	"\x11AddWalletResponse\x12-\n" +
+	"\x04item\x18\x01 \x01(\v2\x19.ledger_service.v1.WalletR\x04item\"\xc1\x01\n" +
+	"\x12PatchWalletRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12,\n" +
+	"\x0fopening_balance\x18\x03 \x01(\tH\x01R\x0eopeningBalance\x88\x01\x01\x12$\n" +
+	"\vis_archived\x18\x04 \x01(\bH\x02R\n" +
+	"isArchived\x88\x01\x01B\b\n" +
+	"\x06_titleB\x12\n" +
+	"\x10_opening_balanceB\x0e\n" +
+	"\f_is_archived\"D\n" +
+	"\x13PatchWalletResponse\x12-\n" +
+	"\x04item\x18\x01 \x01(\v2\x19.ledger_service.v1.WalletR\x04item\"%\n" +
+	"\x13DeleteWalletRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x16\n" +
+	"\x14DeleteWalletResponse\"|\n" +
+	"\x18GetWalletBalancesRequest\x12\x1d\n" +
+	"\n" +
+	"wallet_ids\x18\x01 \x03(\tR\twalletIds\x125\n" +
+	"\adate_to\x18\x02 \x01(\v2\x17.ledger_service.v1.DateH\x00R\x06dateTo\x88\x01\x01B\n" +
+	"\n" +
+	"\b_date_to\"S\n" +
+	"\x19GetWalletBalancesResponse\x126\n" +
+	"\x05items\x18\x01 \x03(\v2 .ledger_service.v1.WalletBalanceR\x05items\"\x1b\n" +
+	"\x19ListImportProfilesRequest\"T\n" +
+	"\x1aListImportProfilesResponse\x126\n" +
+	"\x05items\x18\x01 \x03(\v2 .ledger_service.v1.ImportProfileR\x05items\")\n" +
+	"\x17GetImportProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"P\n" +
+	"\x18GetImportProfileResponse\x124\n" +
+	"\x04item\x18\x01 \x01(\v2 .ledger_service.v1.ImportProfileR\x04item\"\x95\x02\n" +
+	"\x17AddImportProfileRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tdelimiter\x18\x02 \x01(\tR\tdelimiter\x12+\n" +
+	"\x11decimal_separator\x18\x03 \x01(\tR\x10decimalSeparator\x12\x1f\n" +
+	"\vdate_layout\x18\x04 \x01(\tR\n" +
+	"dateLayout\x12\x1b\n" +
+	"\tskip_rows\x18\x05 \x01(\x05R\bskipRows\x12\x1a\n" +
+	"\bencoding\x18\x06 \x01(\tR\bencoding\x12A\n" +
+	"\amapping\x18\a \x01(\v2'.ledger_service.v1.ImportProfileMappingR\amapping\"P\n" +
+	"\x18AddImportProfileResponse\x124\n" +
+	"\x04item\x18\x01 \x01(\v2 .ledger_service.v1.ImportProfileR\x04item\"\xae\x03\n" +
+	"\x19PatchImportProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12!\n" +
+	"\tdelimiter\x18\x03 \x01(\tH\x01R\tdelimiter\x88\x01\x01\x120\n" +
+	"\x11decimal_separator\x18\x04 \x01(\tH\x02R\x10decimalSeparator\x88\x01\x01\x12$\n" +
+	"\vdate_layout\x18\x05 \x01(\tH\x03R\n" +
+	"dateLayout\x88\x01\x01\x12 \n" +
+	"\tskip_rows\x18\x06 \x01(\x05H\x04R\bskipRows\x88\x01\x01\x12\x1f\n" +
+	"\bencoding\x18\a \x01(\tH\x05R\bencoding\x88\x01\x01\x12F\n" +
+	"\amapping\x18\b \x01(\v2'.ledger_service.v1.ImportProfileMappingH\x06R\amapping\x88\x01\x01B\a\n" +
+	"\x05_nameB\f\n" +
+	"\n" +
+	"_delimiterB\x14\n" +
+	"\x12_decimal_separatorB\x0e\n" +
+	"\f_date_layoutB\f\n" +
+	"\n" +
+	"_skip_rowsB\v\n" +
+	"\t_encodingB\n" +
+	"\n" +
+	"\b_mapping\"R\n" +
+	"\x1aPatchImportProfileResponse\x124\n" +
+	"\x04item\x18\x01 \x01(\v2 .ledger_service.v1.ImportProfileR\x04item\",\n" +
+	"\x1aDeleteImportProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1d\n" +
+	"\x1bDeleteImportProfileResponse\"\x1a\n" +
+	"\x18ListCategoryRulesRequest\"R\n" +
+	"\x19ListCategoryRulesResponse\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.ledger_service.v1.CategoryRuleR\x05items\"(\n" +
+	"\x16GetCategoryRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"N\n" +
+	"\x17GetCategoryRuleResponse\x123\n" +
+	"\x04item\x18\x01 \x01(\v2\x1f.ledger_service.v1.CategoryRuleR\x04item\"\xb4\x01\n" +
+	"\x16AddCategoryRuleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpriority\x18\x02 \x01(\x05R\bpriority\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x03R\n" +
+	"categoryId\x12I\n" +
+	"\n" +
+	"conditions\x18\x04 \x01(\v2).ledger_service.v1.CategoryRuleConditionsR\n" +
+	"conditions\"N\n" +
+	"\x17AddCategoryRuleResponse\x123\n" +
+	"\x04item\x18\x01 \x01(\v2\x1f.ledger_service.v1.CategoryRuleR\x04item\"\x8f\x02\n" +
+	"\x18PatchCategoryRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1f\n" +
+	"\bpriority\x18\x03 \x01(\x05H\x01R\bpriority\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\x04 \x01(\x03H\x02R\n" +
+	"categoryId\x88\x01\x01\x12N\n" +
+	"\n" +
+	"conditions\x18\x05 \x01(\v2).ledger_service.v1.CategoryRuleConditionsH\x03R\n" +
+	"conditions\x88\x01\x01B\a\n" +
+	"\x05_nameB\v\n" +
+	"\t_priorityB\x0e\n" +
+	"\f_category_idB\r\n" +
+	"\v_conditions\"P\n" +
+	"\x19PatchCategoryRuleResponse\x123\n" +
+	"\x04item\x18\x01 \x01(\v2\x1f.ledger_service.v1.CategoryRuleR\x04item\"+\n" +
+	"\x19DeleteCategoryRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1c\n" +
+	"\x1aDeleteCategoryRuleResponse\"\x96\x01\n" +
+	"\x13ReapplyRulesRequest\x124\n" +
+	"\tdate_from\x18\x01 \x01(\v2\x17.ledger_service.v1.DateR\bdateFrom\x120\n" +
+	"\adate_to\x18\x02 \x01(\v2\x17.ledger_service.v1.DateR\x06dateTo\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\x8a\x01\n" +
+	"\x14ReapplyRulesResponse\x12\x18\n" +
+	"\achecked\x18\x01 \x01(\x05R\achecked\x12?\n" +
+	"\achanges\x18\x02 \x03(\v2%.ledger_service.v1.CategoryRuleChangeR\achanges\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"$\n" +
+	"\x12GetTransferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"F\n" +
+	"\x13GetTransferResponse\x12/\n" +
+	"\x04item\x18\x01 \x01(\v2\x1b.ledger_service.v1.TransferR\x04item\"\xd0\x01\n" +
+	"\x12AddTransferRequest\x12$\n" +
+	"\x0efrom_wallet_id\x18\x01 \x01(\tR\ffromWalletId\x12 \n" +
+	"\fto_wallet_id\x18\x02 \x01(\tR\n" +
+	"toWalletId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x128\n" +
+	"\voccurred_on\x18\x04 \x01(\v2\x17.ledger_service.v1.DateR\n" +
+	"occurredOn\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"F\n" +
+	"\x13AddTransferResponse\x12/\n" +
+	"\x04item\x18\x01 \x01(\v2\x1b.ledger_service.v1.TransferR\x04item\"\xca\x02\n" +
+	"\x14PatchTransferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x0efrom_wallet_id\x18\x02 \x01(\tH\x00R\ffromWalletId\x88\x01\x01\x12%\n" +
+	"\fto_wallet_id\x18\x03 \x01(\tH\x01R\n" +
+	"toWalletId\x88\x01\x01\x12\x1b\n" +
+	"\x06amount\x18\x04 \x01(\tH\x02R\x06amount\x88\x01\x01\x12=\n" +
+	"\voccurred_on\x18\x05 \x01(\v2\x17.ledger_service.v1.DateH\x03R\n" +
+	"occurredOn\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x06 \x01(\tH\x04R\vdescription\x88\x01\x01B\x11\n" +
+	"\x0f_from_wallet_idB\x0f\n" +
+	"\r_to_wallet_idB\t\n" +
+	"\a_amountB\x0e\n" +
+	"\f_occurred_onB\x0e\n" +
+	"\f_description\"H\n" +
+	"\x15PatchTransferResponse\x12/\n" +
+	"\x04item\x18\x01 \x01(\v2\x1b.ledger_service.v1.TransferR\x04item\"'\n" +
+	"\x15DeleteTransferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16DeleteTransferResponse\"\x18\n" +
+	"\x16GetBaseCurrencyRequest\"5\n" +
+	"\x17GetBaseCurrencyResponse\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\"4\n" +
+	"\x16SetBaseCurrencyRequest\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\"5\n" +
+	"\x17SetBaseCurrencyResponse\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\"\xac\x02\n" +
+	"\x18ListExchangeRatesRequest\x12,\n" +
+	"\x0ffilter_currency\x18\x01 \x01(\tH\x00R\x0efilterCurrency\x88\x01\x01\x12O\n" +
+	"\x15filter_rate_date_from\x18\x02 \x01(\v2\x17.ledger_service.v1.DateH\x01R\x12filterRateDateFrom\x88\x01\x01\x12K\n" +
+	"\x13filter_rate_date_to\x18\x03 \x01(\v2\x17.ledger_service.v1.DateH\x02R\x10filterRateDateTo\x88\x01\x01B\x12\n" +
+	"\x10_filter_currencyB\x18\n" +
+	"\x16_filter_rate_date_fromB\x16\n" +
+	"\x14_filter_rate_date_to\"R\n" +
+	"\x19ListExchangeRatesResponse\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.ledger_service.v1.ExchangeRateR\x05items\"S\n" +
+	"\x1aUpsertExchangeRatesRequest\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.ledger_service.v1.ExchangeRateR\x05items\"T\n" +
+	"\x1bUpsertExchangeRatesResponse\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.ledger_service.v1.ExchangeRateR\x05items\"_\n" +
+	"\x19ListRecurringRulesRequest\x12-\n" +
+	"\x10filter_is_paused\x18\x01 \x01(\bH\x00R\x0efilterIsPaused\x88\x01\x01B\x13\n" +
+	"\x11_filter_is_paused\"T\n" +
+	"\x1aListRecurringRulesResponse\x126\n" +
+	"\x05items\x18\x01 \x03(\v2 .ledger_service.v1.RecurringRuleR\x05items\")\n" +
+	"\x17GetRecurringRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"P\n" +
+	"\x18GetRecurringRuleResponse\x124\n" +
+	"\x04item\x18\x01 \x01(\v2 .ledger_service.v1.RecurringRuleR\x04item\"\xee\x03\n" +
+	"\x17AddRecurringRuleRequest\x12\x1b\n" +
+	"\tis_income\x18\x01 \x01(\bR\bisIncome\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x03R\n" +
+	"categoryId\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12 \n" +
+	"\twallet_id\x18\x05 \x01(\tH\x00R\bwalletId\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x06 \x01(\tH\x01R\bcurrency\x88\x01\x01\x12\x1c\n" +
+	"\tfrequency\x18\a \x01(\tR\tfrequency\x12\x1a\n" +
+	"\binterval\x18\b \x01(\x05R\binterval\x12 \n" +
+	"\fday_of_month\x18\t \x01(\x05R\n" +
+	"dayOfMonth\x126\n" +
+	"\n" +
+	"start_date\x18\n" +
+	" \x01(\v2\x17.ledger_service.v1.DateR\tstartDate\x127\n" +
+	"\bend_date\x18\v \x01(\v2\x17.ledger_service.v1.DateH\x02R\aendDate\x88\x01\x01\x12\x19\n" +
+	"\x05count\x18\f \x01(\x05H\x03R\x05count\x88\x01\x01B\f\n" +
+	"\n" +
+	"_wallet_idB\v\n" +
+	"\t_currencyB\v\n" +
+	"\t_end_dateB\b\n" +
+	"\x06_count\"P\n" +
+	"\x18AddRecurringRuleResponse\x124\n" +
+	"\x04item\x18\x01 \x01(\v2 .ledger_service.v1.RecurringRuleR\x04item\"\xb9\x03\n" +
+	"\x19PatchRecurringRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\x06amount\x18\x02 \x01(\tH\x00R\x06amount\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x03 \x01(\tH\x01R\bcurrency\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\x04 \x01(\x03H\x02R\n" +
+	"categoryId\x88\x01\x01\x12 \n" +
+	"\twallet_id\x18\x05 \x01(\tH\x03R\bwalletId\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x06 \x01(\tH\x04R\vdescription\x88\x01\x01\x127\n" +
+	"\bend_date\x18\a \x01(\v2\x17.ledger_service.v1.DateH\x05R\aendDate\x88\x01\x01\x12\x19\n" +
+	"\x05count\x18\b \x01(\x05H\x06R\x05count\x88\x01\x01\x12 \n" +
+	"\tis_paused\x18\t \x01(\bH\aR\bisPaused\x88\x01\x01B\t\n" +
+	"\a_amountB\v\n" +
+	"\t_currencyB\x0e\n" +
+	"\f_category_idB\f\n" +
+	"\n" +
+	"_wallet_idB\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_end_dateB\b\n" +
+	"\x06_countB\f\n" +
+	"\n" +
+	"_is_paused\"R\n" +
+	"\x1aPatchRecurringRuleResponse\x124\n" +
+	"\x04item\x18\x01 \x01(\v2 .ledger_service.v1.RecurringRuleR\x04item\",\n" +
+	"\x1aDeleteRecurringRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1d\n" +
+	"\x1bDeleteRecurringRuleResponse*\xe0\x01\n" +
+	"\x13TransactionListSort\x12%\n" +
+	"!TRANSACTION_LIST_SORT_UNSPECIFIED\x10\x00\x12*\n" +
+	"&TRANSACTION_LIST_SORT_OCCURRED_ON_DESC\x10\x01\x12)\n" +
+	"%TRANSACTION_LIST_SORT_OCCURRED_ON_ASC\x10\x02\x12%\n" +
+	"!TRANSACTION_LIST_SORT_AMOUNT_DESC\x10\x03\x12$\n" +
+	" TRANSACTION_LIST_SORT_AMOUNT_ASC\x10\x04*u\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11EXPORT_FORMAT_CSV\x10\x01\x12\x16\n" +
+	"\x12EXPORT_FORMAT_XLSX\x10\x02\x12\x17\n" +
+	"\x13EXPORT_FORMAT_JSONL\x10\x032\xee,\n" +
 	"\x06Ledger\x12e\n" +
-	"\x0eListCategories\x12(.ledger_service.v1.ListCategoriesRequest\x1a).ledger_service.v1.ListCategoriesResponse\x12k\n" +
+	"\x0eListCategories\x12(.ledger_service.v1.ListCategoriesRequest\x1a).ledger_service.v1.ListCategoriesResponse\x12\\\n" +
+	"\vAddCategory\x12%.ledger_service.v1.AddCategoryRequest\x1a&.ledger_service.v1.AddCategoryResponse\x12b\n" +
+	"\rPatchCategory\x12'.ledger_service.v1.PatchCategoryRequest\x1a(.ledger_service.v1.PatchCategoryResponse\x12e\n" +
+	"\x0eDeleteCategory\x12(.ledger_service.v1.DeleteCategoryRequest\x1a).ledger_service.v1.DeleteCategoryResponse\x12k\n" +
 	"\x10ListTransactions\x12*.ledger_service.v1.ListTransactionsRequest\x1a+.ledger_service.v1.ListTransactionsResponse\x12e\n" +
 	"\x0eGetTransaction\x12(.ledger_service.v1.GetTransactionRequest\x1a).ledger_service.v1.GetTransactionResponse\x12e\n" +
-	"\x0eAddTransaction\x12(.ledger_service.v1.AddTransactionRequest\x1a).ledger_service.v1.AddTransactionResponse\x12k\n" +
+	"\x0eAddTransaction\x12(.ledger_service.v1.AddTransactionRequest\x1a).ledger_service.v1.AddTransactionResponse\x12w\n" +
+	"\x14AddTransactionsBatch\x12..ledger_service.v1.AddTransactionsBatchRequest\x1a/.ledger_service.v1.AddTransactionsBatchResponse\x12k\n" +
 	"\x10PatchTransaction\x12*.ledger_service.v1.PatchTransactionRequest\x1a+.ledger_service.v1.PatchTransactionResponse\x12n\n" +
 	"\x11DeleteTransaction\x12+.ledger_service.v1.DeleteTransactionRequest\x1a,.ledger_service.v1.DeleteTransactionResponse\x12\\\n" +
 	"\vListBudgets\x12%.ledger_service.v1.ListBudgetsRequest\x1a&.ledger_service.v1.ListBudgetsResponse\x12V\n" +
@@ -2050,9 +9072,45 @@ const file_ledger_service_service_proto_rawDesc = "" +
 	"\tAddBudget\x12#.ledger_service.v1.AddBudgetRequest\x1a$.ledger_service.v1.AddBudgetResponse\x12\\\n" +
 	"\vPatchBudget\x12%.ledger_service.v1.PatchBudgetRequest\x1a&.ledger_service.v1.PatchBudgetResponse\x12_\n" +
 	"\fDeleteBudget\x12&.ledger_service.v1.DeleteBudgetRequest\x1a'.ledger_service.v1.DeleteBudgetResponse\x12\\\n" +
+	"\vCopyBudgets\x12%.ledger_service.v1.CopyBudgetsRequest\x1a&.ledger_service.v1.CopyBudgetsResponse\x12z\n" +
+	"\x15GetBudgetAutoRollover\x12/.ledger_service.v1.GetBudgetAutoRolloverRequest\x1a0.ledger_service.v1.GetBudgetAutoRolloverResponse\x12z\n" +
+	"\x15SetBudgetAutoRollover\x12/.ledger_service.v1.SetBudgetAutoRolloverRequest\x1a0.ledger_service.v1.SetBudgetAutoRolloverResponse\x12\\\n" +
 	"\vListReports\x12%.ledger_service.v1.ListReportsRequest\x1a&.ledger_service.v1.ListReportsResponse\x12u\n" +
-	"\x15CSVExportTransactions\x12*.ledger_service.v1.ListTransactionsRequest\x1a0.ledger_service.v1.CSVExportTransactionsResponse\x12z\n" +
-	"\x15CSVImportTransactions\x12/.ledger_service.v1.CSVImportTransactionsRequest\x1a0.ledger_service.v1.CSVImportTransactionsResponseB\xe5\x01\n" +
+	"\x15CSVExportTransactions\x12*.ledger_service.v1.ListTransactionsRequest\x1a0.ledger_service.v1.CSVExportTransactionsResponse\x12\x85\x01\n" +
+	"\x18StreamExportTransactions\x122.ledger_service.v1.StreamExportTransactionsRequest\x1a3.ledger_service.v1.StreamExportTransactionsResponse0\x01\x12q\n" +
+	"\x12ExportTransactions\x12,.ledger_service.v1.ExportTransactionsRequest\x1a-.ledger_service.v1.ExportTransactionsResponse\x12b\n" +
+	"\rExportReports\x12'.ledger_service.v1.ExportReportsRequest\x1a(.ledger_service.v1.ExportReportsResponse\x12z\n" +
+	"\x15CSVImportTransactions\x12/.ledger_service.v1.CSVImportTransactionsRequest\x1a0.ledger_service.v1.CSVImportTransactionsResponse\x12\\\n" +
+	"\vListWallets\x12%.ledger_service.v1.ListWalletsRequest\x1a&.ledger_service.v1.ListWalletsResponse\x12V\n" +
+	"\tGetWallet\x12#.ledger_service.v1.GetWalletRequest\x1a$.ledger_service.v1.GetWalletResponse\x12V\n" +
+	"\tAddWallet\x12#.ledger_service.v1.AddWalletRequest\x1a$.ledger_service.v1.AddWalletResponse\x12\\\n" +
+	"\vPatchWallet\x12%.ledger_service.v1.PatchWalletRequest\x1a&.ledger_service.v1.PatchWalletResponse\x12_\n" +
+	"\fDeleteWallet\x12&.ledger_service.v1.DeleteWalletRequest\x1a'.ledger_service.v1.DeleteWalletResponse\x12n\n" +
+	"\x11GetWalletBalances\x12+.ledger_service.v1.GetWalletBalancesRequest\x1a,.ledger_service.v1.GetWalletBalancesResponse\x12q\n" +
+	"\x12ListImportProfiles\x12,.ledger_service.v1.ListImportProfilesRequest\x1a-.ledger_service.v1.ListImportProfilesResponse\x12k\n" +
+	"\x10GetImportProfile\x12*.ledger_service.v1.GetImportProfileRequest\x1a+.ledger_service.v1.GetImportProfileResponse\x12k\n" +
+	"\x10AddImportProfile\x12*.ledger_service.v1.AddImportProfileRequest\x1a+.ledger_service.v1.AddImportProfileResponse\x12q\n" +
+	"\x12PatchImportProfile\x12,.ledger_service.v1.PatchImportProfileRequest\x1a-.ledger_service.v1.PatchImportProfileResponse\x12t\n" +
+	"\x13DeleteImportProfile\x12-.ledger_service.v1.DeleteImportProfileRequest\x1a..ledger_service.v1.DeleteImportProfileResponse\x12n\n" +
+	"\x11ListCategoryRules\x12+.ledger_service.v1.ListCategoryRulesRequest\x1a,.ledger_service.v1.ListCategoryRulesResponse\x12h\n" +
+	"\x0fGetCategoryRule\x12).ledger_service.v1.GetCategoryRuleRequest\x1a*.ledger_service.v1.GetCategoryRuleResponse\x12h\n" +
+	"\x0fAddCategoryRule\x12).ledger_service.v1.AddCategoryRuleRequest\x1a*.ledger_service.v1.AddCategoryRuleResponse\x12n\n" +
+	"\x11PatchCategoryRule\x12+.ledger_service.v1.PatchCategoryRuleRequest\x1a,.ledger_service.v1.PatchCategoryRuleResponse\x12q\n" +
+	"\x12DeleteCategoryRule\x12,.ledger_service.v1.DeleteCategoryRuleRequest\x1a-.ledger_service.v1.DeleteCategoryRuleResponse\x12_\n" +
+	"\fReapplyRules\x12&.ledger_service.v1.ReapplyRulesRequest\x1a'.ledger_service.v1.ReapplyRulesResponse\x12\\\n" +
+	"\vGetTransfer\x12%.ledger_service.v1.GetTransferRequest\x1a&.ledger_service.v1.GetTransferResponse\x12\\\n" +
+	"\vAddTransfer\x12%.ledger_service.v1.AddTransferRequest\x1a&.ledger_service.v1.AddTransferResponse\x12b\n" +
+	"\rPatchTransfer\x12'.ledger_service.v1.PatchTransferRequest\x1a(.ledger_service.v1.PatchTransferResponse\x12e\n" +
+	"\x0eDeleteTransfer\x12(.ledger_service.v1.DeleteTransferRequest\x1a).ledger_service.v1.DeleteTransferResponse\x12h\n" +
+	"\x0fGetBaseCurrency\x12).ledger_service.v1.GetBaseCurrencyRequest\x1a*.ledger_service.v1.GetBaseCurrencyResponse\x12h\n" +
+	"\x0fSetBaseCurrency\x12).ledger_service.v1.SetBaseCurrencyRequest\x1a*.ledger_service.v1.SetBaseCurrencyResponse\x12n\n" +
+	"\x11ListExchangeRates\x12+.ledger_service.v1.ListExchangeRatesRequest\x1a,.ledger_service.v1.ListExchangeRatesResponse\x12t\n" +
+	"\x13UpsertExchangeRates\x12-.ledger_service.v1.UpsertExchangeRatesRequest\x1a..ledger_service.v1.UpsertExchangeRatesResponse\x12q\n" +
+	"\x12ListRecurringRules\x12,.ledger_service.v1.ListRecurringRulesRequest\x1a-.ledger_service.v1.ListRecurringRulesResponse\x12k\n" +
+	"\x10GetRecurringRule\x12*.ledger_service.v1.GetRecurringRuleRequest\x1a+.ledger_service.v1.GetRecurringRuleResponse\x12k\n" +
+	"\x10AddRecurringRule\x12*.ledger_service.v1.AddRecurringRuleRequest\x1a+.ledger_service.v1.AddRecurringRuleResponse\x12q\n" +
+	"\x12PatchRecurringRule\x12,.ledger_service.v1.PatchRecurringRuleRequest\x1a-.ledger_service.v1.PatchRecurringRuleResponse\x12t\n" +
+	"\x13DeleteRecurringRule\x12-.ledger_service.v1.DeleteRecurringRuleRequest\x1a..ledger_service.v1.DeleteRecurringRuleResponseB\xe5\x01\n" +
 	"\x15com.ledger_service.v1B\fServiceProtoP\x01Z]github.com/m11ano/budget_planner/backend/gateway/pkg/proto_pb/ledger_service;ledger_servicev1\xa2\x02\x03LXX\xaa\x02\x10LedgerService.V1\xca\x02\x10LedgerService\\V1\xe2\x02\x1cLedgerService\\V1\\GPBMetadata\xea\x02\x11LedgerService::V1b\x06proto3"
 
 var (
@@ -2067,107 +9125,371 @@ func file_ledger_service_service_proto_rawDescGZIP() []byte {
 	return file_ledger_service_service_proto_rawDescData
 }
 
-var file_ledger_service_service_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_ledger_service_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_ledger_service_service_proto_msgTypes = make([]protoimpl.MessageInfo, 129)
 var file_ledger_service_service_proto_goTypes = []any{
-	(*Category)(nil),                      // 0: ledger_service.v1.Category
-	(*Date)(nil),                          // 1: ledger_service.v1.Date
-	(*DateMonth)(nil),                     // 2: ledger_service.v1.DateMonth
-	(*Transaction)(nil),                   // 3: ledger_service.v1.Transaction
-	(*Budget)(nil),                        // 4: ledger_service.v1.Budget
-	(*ReportItem)(nil),                    // 5: ledger_service.v1.ReportItem
-	(*PeriodReport)(nil),                  // 6: ledger_service.v1.PeriodReport
-	(*ListCategoriesRequest)(nil),         // 7: ledger_service.v1.ListCategoriesRequest
-	(*ListCategoriesResponse)(nil),        // 8: ledger_service.v1.ListCategoriesResponse
-	(*ListTransactionsRequest)(nil),       // 9: ledger_service.v1.ListTransactionsRequest
-	(*ListTransactionsResponse)(nil),      // 10: ledger_service.v1.ListTransactionsResponse
-	(*GetTransactionRequest)(nil),         // 11: ledger_service.v1.GetTransactionRequest
-	(*GetTransactionResponse)(nil),        // 12: ledger_service.v1.GetTransactionResponse
-	(*AddTransactionRequest)(nil),         // 13: ledger_service.v1.AddTransactionRequest
-	(*AddTransactionResponse)(nil),        // 14: ledger_service.v1.AddTransactionResponse
-	(*PatchTransactionRequest)(nil),       // 15: ledger_service.v1.PatchTransactionRequest
-	(*PatchTransactionResponse)(nil),      // 16: ledger_service.v1.PatchTransactionResponse
-	(*DeleteTransactionRequest)(nil),      // 17: ledger_service.v1.DeleteTransactionRequest
-	(*DeleteTransactionResponse)(nil),     // 18: ledger_service.v1.DeleteTransactionResponse
-	(*ListBudgetsRequest)(nil),            // 19: ledger_service.v1.ListBudgetsRequest
-	(*ListBudgetsResponse)(nil),           // 20: ledger_service.v1.ListBudgetsResponse
-	(*GetBudgetRequest)(nil),              // 21: ledger_service.v1.GetBudgetRequest
-	(*GetBudgetResponse)(nil),             // 22: ledger_service.v1.GetBudgetResponse
-	(*AddBudgetRequest)(nil),              // 23: ledger_service.v1.AddBudgetRequest
-	(*AddBudgetResponse)(nil),             // 24: ledger_service.v1.AddBudgetResponse
-	(*PatchBudgetRequest)(nil),            // 25: ledger_service.v1.PatchBudgetRequest
-	(*PatchBudgetResponse)(nil),           // 26: ledger_service.v1.PatchBudgetResponse
-	(*DeleteBudgetRequest)(nil),           // 27: ledger_service.v1.DeleteBudgetRequest
-	(*DeleteBudgetResponse)(nil),          // 28: ledger_service.v1.DeleteBudgetResponse
-	(*ListReportsRequest)(nil),            // 29: ledger_service.v1.ListReportsRequest
-	(*ListReportsResponse)(nil),           // 30: ledger_service.v1.ListReportsResponse
-	(*CSVExportTransactionsResponse)(nil), // 31: ledger_service.v1.CSVExportTransactionsResponse
-	(*CSVImportTransactionsRequest)(nil),  // 32: ledger_service.v1.CSVImportTransactionsRequest
-	(*CSVImportTransactionsResponse)(nil), // 33: ledger_service.v1.CSVImportTransactionsResponse
-	(*timestamppb.Timestamp)(nil),         // 34: google.protobuf.Timestamp
+	(TransactionListSort)(0),                 // 0: ledger_service.v1.TransactionListSort
+	(ExportFormat)(0),                        // 1: ledger_service.v1.ExportFormat
+	(*Category)(nil),                         // 2: ledger_service.v1.Category
+	(*Date)(nil),                             // 3: ledger_service.v1.Date
+	(*DateMonth)(nil),                        // 4: ledger_service.v1.DateMonth
+	(*Transaction)(nil),                      // 5: ledger_service.v1.Transaction
+	(*TransactionTags)(nil),                  // 6: ledger_service.v1.TransactionTags
+	(*Wallet)(nil),                           // 7: ledger_service.v1.Wallet
+	(*ImportProfileColumns)(nil),             // 8: ledger_service.v1.ImportProfileColumns
+	(*ImportProfileMapping)(nil),             // 9: ledger_service.v1.ImportProfileMapping
+	(*ImportProfile)(nil),                    // 10: ledger_service.v1.ImportProfile
+	(*CategoryRuleConditions)(nil),           // 11: ledger_service.v1.CategoryRuleConditions
+	(*CategoryRule)(nil),                     // 12: ledger_service.v1.CategoryRule
+	(*CategoryRuleChange)(nil),               // 13: ledger_service.v1.CategoryRuleChange
+	(*WalletBalance)(nil),                    // 14: ledger_service.v1.WalletBalance
+	(*Transfer)(nil),                         // 15: ledger_service.v1.Transfer
+	(*Budget)(nil),                           // 16: ledger_service.v1.Budget
+	(*BudgetWarningThresholds)(nil),          // 17: ledger_service.v1.BudgetWarningThresholds
+	(*BudgetWarning)(nil),                    // 18: ledger_service.v1.BudgetWarning
+	(*ExchangeRate)(nil),                     // 19: ledger_service.v1.ExchangeRate
+	(*RecurringRule)(nil),                    // 20: ledger_service.v1.RecurringRule
+	(*ReportItem)(nil),                       // 21: ledger_service.v1.ReportItem
+	(*PeriodReport)(nil),                     // 22: ledger_service.v1.PeriodReport
+	(*ListCategoriesRequest)(nil),            // 23: ledger_service.v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),           // 24: ledger_service.v1.ListCategoriesResponse
+	(*AddCategoryRequest)(nil),               // 25: ledger_service.v1.AddCategoryRequest
+	(*AddCategoryResponse)(nil),              // 26: ledger_service.v1.AddCategoryResponse
+	(*PatchCategoryRequest)(nil),             // 27: ledger_service.v1.PatchCategoryRequest
+	(*PatchCategoryResponse)(nil),            // 28: ledger_service.v1.PatchCategoryResponse
+	(*DeleteCategoryRequest)(nil),            // 29: ledger_service.v1.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),           // 30: ledger_service.v1.DeleteCategoryResponse
+	(*ListTransactionsRequest)(nil),          // 31: ledger_service.v1.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),         // 32: ledger_service.v1.ListTransactionsResponse
+	(*GetTransactionRequest)(nil),            // 33: ledger_service.v1.GetTransactionRequest
+	(*GetTransactionResponse)(nil),           // 34: ledger_service.v1.GetTransactionResponse
+	(*AddTransactionRequest)(nil),            // 35: ledger_service.v1.AddTransactionRequest
+	(*AddTransactionResponse)(nil),           // 36: ledger_service.v1.AddTransactionResponse
+	(*AddTransactionsBatchRequest)(nil),      // 37: ledger_service.v1.AddTransactionsBatchRequest
+	(*AddTransactionsBatchResponse)(nil),     // 38: ledger_service.v1.AddTransactionsBatchResponse
+	(*PatchTransactionRequest)(nil),          // 39: ledger_service.v1.PatchTransactionRequest
+	(*PatchTransactionResponse)(nil),         // 40: ledger_service.v1.PatchTransactionResponse
+	(*DeleteTransactionRequest)(nil),         // 41: ledger_service.v1.DeleteTransactionRequest
+	(*DeleteTransactionResponse)(nil),        // 42: ledger_service.v1.DeleteTransactionResponse
+	(*ListBudgetsRequest)(nil),               // 43: ledger_service.v1.ListBudgetsRequest
+	(*ListBudgetsResponse)(nil),              // 44: ledger_service.v1.ListBudgetsResponse
+	(*GetBudgetRequest)(nil),                 // 45: ledger_service.v1.GetBudgetRequest
+	(*GetBudgetResponse)(nil),                // 46: ledger_service.v1.GetBudgetResponse
+	(*AddBudgetRequest)(nil),                 // 47: ledger_service.v1.AddBudgetRequest
+	(*AddBudgetResponse)(nil),                // 48: ledger_service.v1.AddBudgetResponse
+	(*PatchBudgetRequest)(nil),               // 49: ledger_service.v1.PatchBudgetRequest
+	(*PatchBudgetResponse)(nil),              // 50: ledger_service.v1.PatchBudgetResponse
+	(*DeleteBudgetRequest)(nil),              // 51: ledger_service.v1.DeleteBudgetRequest
+	(*DeleteBudgetResponse)(nil),             // 52: ledger_service.v1.DeleteBudgetResponse
+	(*CopyBudgetsRequest)(nil),               // 53: ledger_service.v1.CopyBudgetsRequest
+	(*CopyBudgetsResponse)(nil),              // 54: ledger_service.v1.CopyBudgetsResponse
+	(*GetBudgetAutoRolloverRequest)(nil),     // 55: ledger_service.v1.GetBudgetAutoRolloverRequest
+	(*GetBudgetAutoRolloverResponse)(nil),    // 56: ledger_service.v1.GetBudgetAutoRolloverResponse
+	(*SetBudgetAutoRolloverRequest)(nil),     // 57: ledger_service.v1.SetBudgetAutoRolloverRequest
+	(*SetBudgetAutoRolloverResponse)(nil),    // 58: ledger_service.v1.SetBudgetAutoRolloverResponse
+	(*ListReportsRequest)(nil),               // 59: ledger_service.v1.ListReportsRequest
+	(*ListReportsResponse)(nil),              // 60: ledger_service.v1.ListReportsResponse
+	(*CSVExportTransactionsResponse)(nil),    // 61: ledger_service.v1.CSVExportTransactionsResponse
+	(*StreamExportTransactionsRequest)(nil),  // 62: ledger_service.v1.StreamExportTransactionsRequest
+	(*StreamExportTransactionsResponse)(nil), // 63: ledger_service.v1.StreamExportTransactionsResponse
+	(*ExportTransactionsRequest)(nil),        // 64: ledger_service.v1.ExportTransactionsRequest
+	(*ExportTransactionsResponse)(nil),       // 65: ledger_service.v1.ExportTransactionsResponse
+	(*ExportReportsRequest)(nil),             // 66: ledger_service.v1.ExportReportsRequest
+	(*ExportReportsResponse)(nil),            // 67: ledger_service.v1.ExportReportsResponse
+	(*CSVImportTransactionsRequest)(nil),     // 68: ledger_service.v1.CSVImportTransactionsRequest
+	(*CSVImportRow)(nil),                     // 69: ledger_service.v1.CSVImportRow
+	(*CSVImportTransactionsResponse)(nil),    // 70: ledger_service.v1.CSVImportTransactionsResponse
+	(*ListWalletsRequest)(nil),               // 71: ledger_service.v1.ListWalletsRequest
+	(*ListWalletsResponse)(nil),              // 72: ledger_service.v1.ListWalletsResponse
+	(*GetWalletRequest)(nil),                 // 73: ledger_service.v1.GetWalletRequest
+	(*GetWalletResponse)(nil),                // 74: ledger_service.v1.GetWalletResponse
+	(*AddWalletRequest)(nil),                 // 75: ledger_service.v1.AddWalletRequest
+	(*AddWalletResponse)(nil),                // 76: ledger_service.v1.AddWalletResponse
+	(*PatchWalletRequest)(nil),               // 77: ledger_service.v1.PatchWalletRequest
+	(*PatchWalletResponse)(nil),              // 78: ledger_service.v1.PatchWalletResponse
+	(*DeleteWalletRequest)(nil),              // 79: ledger_service.v1.DeleteWalletRequest
+	(*DeleteWalletResponse)(nil),             // 80: ledger_service.v1.DeleteWalletResponse
+	(*GetWalletBalancesRequest)(nil),         // 81: ledger_service.v1.GetWalletBalancesRequest
+	(*GetWalletBalancesResponse)(nil),        // 82: ledger_service.v1.GetWalletBalancesResponse
+	(*ListImportProfilesRequest)(nil),        // 83: ledger_service.v1.ListImportProfilesRequest
+	(*ListImportProfilesResponse)(nil),       // 84: ledger_service.v1.ListImportProfilesResponse
+	(*GetImportProfileRequest)(nil),          // 85: ledger_service.v1.GetImportProfileRequest
+	(*GetImportProfileResponse)(nil),         // 86: ledger_service.v1.GetImportProfileResponse
+	(*AddImportProfileRequest)(nil),          // 87: ledger_service.v1.AddImportProfileRequest
+	(*AddImportProfileResponse)(nil),         // 88: ledger_service.v1.AddImportProfileResponse
+	(*PatchImportProfileRequest)(nil),        // 89: ledger_service.v1.PatchImportProfileRequest
+	(*PatchImportProfileResponse)(nil),       // 90: ledger_service.v1.PatchImportProfileResponse
+	(*DeleteImportProfileRequest)(nil),       // 91: ledger_service.v1.DeleteImportProfileRequest
+	(*DeleteImportProfileResponse)(nil),      // 92: ledger_service.v1.DeleteImportProfileResponse
+	(*ListCategoryRulesRequest)(nil),         // 93: ledger_service.v1.ListCategoryRulesRequest
+	(*ListCategoryRulesResponse)(nil),        // 94: ledger_service.v1.ListCategoryRulesResponse
+	(*GetCategoryRuleRequest)(nil),           // 95: ledger_service.v1.GetCategoryRuleRequest
+	(*GetCategoryRuleResponse)(nil),          // 96: ledger_service.v1.GetCategoryRuleResponse
+	(*AddCategoryRuleRequest)(nil),           // 97: ledger_service.v1.AddCategoryRuleRequest
+	(*AddCategoryRuleResponse)(nil),          // 98: ledger_service.v1.AddCategoryRuleResponse
+	(*PatchCategoryRuleRequest)(nil),         // 99: ledger_service.v1.PatchCategoryRuleRequest
+	(*PatchCategoryRuleResponse)(nil),        // 100: ledger_service.v1.PatchCategoryRuleResponse
+	(*DeleteCategoryRuleRequest)(nil),        // 101: ledger_service.v1.DeleteCategoryRuleRequest
+	(*DeleteCategoryRuleResponse)(nil),       // 102: ledger_service.v1.DeleteCategoryRuleResponse
+	(*ReapplyRulesRequest)(nil),              // 103: ledger_service.v1.ReapplyRulesRequest
+	(*ReapplyRulesResponse)(nil),             // 104: ledger_service.v1.ReapplyRulesResponse
+	(*GetTransferRequest)(nil),               // 105: ledger_service.v1.GetTransferRequest
+	(*GetTransferResponse)(nil),              // 106: ledger_service.v1.GetTransferResponse
+	(*AddTransferRequest)(nil),               // 107: ledger_service.v1.AddTransferRequest
+	(*AddTransferResponse)(nil),              // 108: ledger_service.v1.AddTransferResponse
+	(*PatchTransferRequest)(nil),             // 109: ledger_service.v1.PatchTransferRequest
+	(*PatchTransferResponse)(nil),            // 110: ledger_service.v1.PatchTransferResponse
+	(*DeleteTransferRequest)(nil),            // 111: ledger_service.v1.DeleteTransferRequest
+	(*DeleteTransferResponse)(nil),           // 112: ledger_service.v1.DeleteTransferResponse
+	(*GetBaseCurrencyRequest)(nil),           // 113: ledger_service.v1.GetBaseCurrencyRequest
+	(*GetBaseCurrencyResponse)(nil),          // 114: ledger_service.v1.GetBaseCurrencyResponse
+	(*SetBaseCurrencyRequest)(nil),           // 115: ledger_service.v1.SetBaseCurrencyRequest
+	(*SetBaseCurrencyResponse)(nil),          // 116: ledger_service.v1.SetBaseCurrencyResponse
+	(*ListExchangeRatesRequest)(nil),         // 117: ledger_service.v1.ListExchangeRatesRequest
+	(*ListExchangeRatesResponse)(nil),        // 118: ledger_service.v1.ListExchangeRatesResponse
+	(*UpsertExchangeRatesRequest)(nil),       // 119: ledger_service.v1.UpsertExchangeRatesRequest
+	(*UpsertExchangeRatesResponse)(nil),      // 120: ledger_service.v1.UpsertExchangeRatesResponse
+	(*ListRecurringRulesRequest)(nil),        // 121: ledger_service.v1.ListRecurringRulesRequest
+	(*ListRecurringRulesResponse)(nil),       // 122: ledger_service.v1.ListRecurringRulesResponse
+	(*GetRecurringRuleRequest)(nil),          // 123: ledger_service.v1.GetRecurringRuleRequest
+	(*GetRecurringRuleResponse)(nil),         // 124: ledger_service.v1.GetRecurringRuleResponse
+	(*AddRecurringRuleRequest)(nil),          // 125: ledger_service.v1.AddRecurringRuleRequest
+	(*AddRecurringRuleResponse)(nil),         // 126: ledger_service.v1.AddRecurringRuleResponse
+	(*PatchRecurringRuleRequest)(nil),        // 127: ledger_service.v1.PatchRecurringRuleRequest
+	(*PatchRecurringRuleResponse)(nil),       // 128: ledger_service.v1.PatchRecurringRuleResponse
+	(*DeleteRecurringRuleRequest)(nil),       // 129: ledger_service.v1.DeleteRecurringRuleRequest
+	(*DeleteRecurringRuleResponse)(nil),      // 130: ledger_service.v1.DeleteRecurringRuleResponse
+	(*timestamppb.Timestamp)(nil),            // 131: google.protobuf.Timestamp
 }
 var file_ledger_service_service_proto_depIdxs = []int32{
-	1,  // 0: ledger_service.v1.Transaction.occurred_on:type_name -> ledger_service.v1.Date
-	34, // 1: ledger_service.v1.Transaction.created_at:type_name -> google.protobuf.Timestamp
-	34, // 2: ledger_service.v1.Transaction.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 3: ledger_service.v1.Budget.period:type_name -> ledger_service.v1.DateMonth
-	34, // 4: ledger_service.v1.Budget.created_at:type_name -> google.protobuf.Timestamp
-	34, // 5: ledger_service.v1.Budget.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 6: ledger_service.v1.PeriodReport.period_start:type_name -> ledger_service.v1.Date
-	1,  // 7: ledger_service.v1.PeriodReport.period_end:type_name -> ledger_service.v1.Date
-	5,  // 8: ledger_service.v1.PeriodReport.items:type_name -> ledger_service.v1.ReportItem
-	0,  // 9: ledger_service.v1.ListCategoriesResponse.items:type_name -> ledger_service.v1.Category
-	1,  // 10: ledger_service.v1.ListTransactionsRequest.filter_occurred_on_from:type_name -> ledger_service.v1.Date
-	1,  // 11: ledger_service.v1.ListTransactionsRequest.filter_occurred_on_to:type_name -> ledger_service.v1.Date
-	3,  // 12: ledger_service.v1.ListTransactionsResponse.items:type_name -> ledger_service.v1.Transaction
-	3,  // 13: ledger_service.v1.GetTransactionResponse.item:type_name -> ledger_service.v1.Transaction
-	1,  // 14: ledger_service.v1.AddTransactionRequest.occurred_on:type_name -> ledger_service.v1.Date
-	3,  // 15: ledger_service.v1.AddTransactionResponse.item:type_name -> ledger_service.v1.Transaction
-	1,  // 16: ledger_service.v1.PatchTransactionRequest.occurred_on:type_name -> ledger_service.v1.Date
-	3,  // 17: ledger_service.v1.PatchTransactionResponse.item:type_name -> ledger_service.v1.Transaction
-	2,  // 18: ledger_service.v1.ListBudgetsRequest.filter_period_from:type_name -> ledger_service.v1.DateMonth
-	2,  // 19: ledger_service.v1.ListBudgetsRequest.filter_period_to:type_name -> ledger_service.v1.DateMonth
-	4,  // 20: ledger_service.v1.ListBudgetsResponse.items:type_name -> ledger_service.v1.Budget
-	4,  // 21: ledger_service.v1.GetBudgetResponse.item:type_name -> ledger_service.v1.Budget
-	2,  // 22: ledger_service.v1.AddBudgetRequest.period:type_name -> ledger_service.v1.DateMonth
-	4,  // 23: ledger_service.v1.AddBudgetResponse.item:type_name -> ledger_service.v1.Budget
-	2,  // 24: ledger_service.v1.PatchBudgetRequest.period:type_name -> ledger_service.v1.DateMonth
-	4,  // 25: ledger_service.v1.PatchBudgetResponse.item:type_name -> ledger_service.v1.Budget
-	1,  // 26: ledger_service.v1.ListReportsRequest.date_from:type_name -> ledger_service.v1.Date
-	1,  // 27: ledger_service.v1.ListReportsRequest.date_to:type_name -> ledger_service.v1.Date
-	6,  // 28: ledger_service.v1.ListReportsResponse.reports:type_name -> ledger_service.v1.PeriodReport
-	7,  // 29: ledger_service.v1.Ledger.ListCategories:input_type -> ledger_service.v1.ListCategoriesRequest
-	9,  // 30: ledger_service.v1.Ledger.ListTransactions:input_type -> ledger_service.v1.ListTransactionsRequest
-	11, // 31: ledger_service.v1.Ledger.GetTransaction:input_type -> ledger_service.v1.GetTransactionRequest
-	13, // 32: ledger_service.v1.Ledger.AddTransaction:input_type -> ledger_service.v1.AddTransactionRequest
-	15, // 33: ledger_service.v1.Ledger.PatchTransaction:input_type -> ledger_service.v1.PatchTransactionRequest
-	17, // 34: ledger_service.v1.Ledger.DeleteTransaction:input_type -> ledger_service.v1.DeleteTransactionRequest
-	19, // 35: ledger_service.v1.Ledger.ListBudgets:input_type -> ledger_service.v1.ListBudgetsRequest
-	21, // 36: ledger_service.v1.Ledger.GetBudget:input_type -> ledger_service.v1.GetBudgetRequest
-	23, // 37: ledger_service.v1.Ledger.AddBudget:input_type -> ledger_service.v1.AddBudgetRequest
-	25, // 38: ledger_service.v1.Ledger.PatchBudget:input_type -> ledger_service.v1.PatchBudgetRequest
-	27, // 39: ledger_service.v1.Ledger.DeleteBudget:input_type -> ledger_service.v1.DeleteBudgetRequest
-	29, // 40: ledger_service.v1.Ledger.ListReports:input_type -> ledger_service.v1.ListReportsRequest
-	9,  // 41: ledger_service.v1.Ledger.CSVExportTransactions:input_type -> ledger_service.v1.ListTransactionsRequest
-	32, // 42: ledger_service.v1.Ledger.CSVImportTransactions:input_type -> ledger_service.v1.CSVImportTransactionsRequest
-	8,  // 43: ledger_service.v1.Ledger.ListCategories:output_type -> ledger_service.v1.ListCategoriesResponse
-	10, // 44: ledger_service.v1.Ledger.ListTransactions:output_type -> ledger_service.v1.ListTransactionsResponse
-	12, // 45: ledger_service.v1.Ledger.GetTransaction:output_type -> ledger_service.v1.GetTransactionResponse
-	14, // 46: ledger_service.v1.Ledger.AddTransaction:output_type -> ledger_service.v1.AddTransactionResponse
-	16, // 47: ledger_service.v1.Ledger.PatchTransaction:output_type -> ledger_service.v1.PatchTransactionResponse
-	18, // 48: ledger_service.v1.Ledger.DeleteTransaction:output_type -> ledger_service.v1.DeleteTransactionResponse
-	20, // 49: ledger_service.v1.Ledger.ListBudgets:output_type -> ledger_service.v1.ListBudgetsResponse
-	22, // 50: ledger_service.v1.Ledger.GetBudget:output_type -> ledger_service.v1.GetBudgetResponse
-	24, // 51: ledger_service.v1.Ledger.AddBudget:output_type -> ledger_service.v1.AddBudgetResponse
-	26, // 52: ledger_service.v1.Ledger.PatchBudget:output_type -> ledger_service.v1.PatchBudgetResponse
-	28, // 53: ledger_service.v1.Ledger.DeleteBudget:output_type -> ledger_service.v1.DeleteBudgetResponse
-	30, // 54: ledger_service.v1.Ledger.ListReports:output_type -> ledger_service.v1.ListReportsResponse
-	31, // 55: ledger_service.v1.Ledger.CSVExportTransactions:output_type -> ledger_service.v1.CSVExportTransactionsResponse
-	33, // 56: ledger_service.v1.Ledger.CSVImportTransactions:output_type -> ledger_service.v1.CSVImportTransactionsResponse
-	43, // [43:57] is the sub-list for method output_type
-	29, // [29:43] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	131, // 0: ledger_service.v1.Category.created_at:type_name -> google.protobuf.Timestamp
+	131, // 1: ledger_service.v1.Category.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 2: ledger_service.v1.Category.children:type_name -> ledger_service.v1.Category
+	3,   // 3: ledger_service.v1.Transaction.occurred_on:type_name -> ledger_service.v1.Date
+	131, // 4: ledger_service.v1.Transaction.created_at:type_name -> google.protobuf.Timestamp
+	131, // 5: ledger_service.v1.Transaction.updated_at:type_name -> google.protobuf.Timestamp
+	131, // 6: ledger_service.v1.Wallet.created_at:type_name -> google.protobuf.Timestamp
+	131, // 7: ledger_service.v1.Wallet.updated_at:type_name -> google.protobuf.Timestamp
+	8,   // 8: ledger_service.v1.ImportProfileMapping.columns:type_name -> ledger_service.v1.ImportProfileColumns
+	9,   // 9: ledger_service.v1.ImportProfile.mapping:type_name -> ledger_service.v1.ImportProfileMapping
+	131, // 10: ledger_service.v1.ImportProfile.created_at:type_name -> google.protobuf.Timestamp
+	131, // 11: ledger_service.v1.ImportProfile.updated_at:type_name -> google.protobuf.Timestamp
+	11,  // 12: ledger_service.v1.CategoryRule.conditions:type_name -> ledger_service.v1.CategoryRuleConditions
+	131, // 13: ledger_service.v1.CategoryRule.created_at:type_name -> google.protobuf.Timestamp
+	131, // 14: ledger_service.v1.CategoryRule.updated_at:type_name -> google.protobuf.Timestamp
+	7,   // 15: ledger_service.v1.WalletBalance.wallet:type_name -> ledger_service.v1.Wallet
+	3,   // 16: ledger_service.v1.Transfer.occurred_on:type_name -> ledger_service.v1.Date
+	131, // 17: ledger_service.v1.Transfer.created_at:type_name -> google.protobuf.Timestamp
+	131, // 18: ledger_service.v1.Transfer.updated_at:type_name -> google.protobuf.Timestamp
+	4,   // 19: ledger_service.v1.Budget.period:type_name -> ledger_service.v1.DateMonth
+	131, // 20: ledger_service.v1.Budget.created_at:type_name -> google.protobuf.Timestamp
+	131, // 21: ledger_service.v1.Budget.updated_at:type_name -> google.protobuf.Timestamp
+	4,   // 22: ledger_service.v1.BudgetWarning.period:type_name -> ledger_service.v1.DateMonth
+	3,   // 23: ledger_service.v1.ExchangeRate.rate_date:type_name -> ledger_service.v1.Date
+	131, // 24: ledger_service.v1.ExchangeRate.created_at:type_name -> google.protobuf.Timestamp
+	131, // 25: ledger_service.v1.ExchangeRate.updated_at:type_name -> google.protobuf.Timestamp
+	3,   // 26: ledger_service.v1.RecurringRule.start_date:type_name -> ledger_service.v1.Date
+	3,   // 27: ledger_service.v1.RecurringRule.end_date:type_name -> ledger_service.v1.Date
+	3,   // 28: ledger_service.v1.RecurringRule.next_occurrence_on:type_name -> ledger_service.v1.Date
+	131, // 29: ledger_service.v1.RecurringRule.created_at:type_name -> google.protobuf.Timestamp
+	131, // 30: ledger_service.v1.RecurringRule.updated_at:type_name -> google.protobuf.Timestamp
+	3,   // 31: ledger_service.v1.PeriodReport.period_start:type_name -> ledger_service.v1.Date
+	3,   // 32: ledger_service.v1.PeriodReport.period_end:type_name -> ledger_service.v1.Date
+	21,  // 33: ledger_service.v1.PeriodReport.items:type_name -> ledger_service.v1.ReportItem
+	2,   // 34: ledger_service.v1.ListCategoriesResponse.items:type_name -> ledger_service.v1.Category
+	2,   // 35: ledger_service.v1.AddCategoryResponse.item:type_name -> ledger_service.v1.Category
+	2,   // 36: ledger_service.v1.PatchCategoryResponse.item:type_name -> ledger_service.v1.Category
+	3,   // 37: ledger_service.v1.ListTransactionsRequest.filter_occurred_on_from:type_name -> ledger_service.v1.Date
+	3,   // 38: ledger_service.v1.ListTransactionsRequest.filter_occurred_on_to:type_name -> ledger_service.v1.Date
+	0,   // 39: ledger_service.v1.ListTransactionsRequest.sort:type_name -> ledger_service.v1.TransactionListSort
+	5,   // 40: ledger_service.v1.ListTransactionsResponse.items:type_name -> ledger_service.v1.Transaction
+	5,   // 41: ledger_service.v1.GetTransactionResponse.item:type_name -> ledger_service.v1.Transaction
+	3,   // 42: ledger_service.v1.AddTransactionRequest.occurred_on:type_name -> ledger_service.v1.Date
+	5,   // 43: ledger_service.v1.AddTransactionResponse.item:type_name -> ledger_service.v1.Transaction
+	18,  // 44: ledger_service.v1.AddTransactionResponse.warnings:type_name -> ledger_service.v1.BudgetWarning
+	35,  // 45: ledger_service.v1.AddTransactionsBatchRequest.items:type_name -> ledger_service.v1.AddTransactionRequest
+	18,  // 46: ledger_service.v1.AddTransactionsBatchResponse.warnings:type_name -> ledger_service.v1.BudgetWarning
+	3,   // 47: ledger_service.v1.PatchTransactionRequest.occurred_on:type_name -> ledger_service.v1.Date
+	6,   // 48: ledger_service.v1.PatchTransactionRequest.tags:type_name -> ledger_service.v1.TransactionTags
+	5,   // 49: ledger_service.v1.PatchTransactionResponse.item:type_name -> ledger_service.v1.Transaction
+	18,  // 50: ledger_service.v1.PatchTransactionResponse.warnings:type_name -> ledger_service.v1.BudgetWarning
+	4,   // 51: ledger_service.v1.ListBudgetsRequest.filter_period_from:type_name -> ledger_service.v1.DateMonth
+	4,   // 52: ledger_service.v1.ListBudgetsRequest.filter_period_to:type_name -> ledger_service.v1.DateMonth
+	16,  // 53: ledger_service.v1.ListBudgetsResponse.items:type_name -> ledger_service.v1.Budget
+	16,  // 54: ledger_service.v1.GetBudgetResponse.item:type_name -> ledger_service.v1.Budget
+	4,   // 55: ledger_service.v1.AddBudgetRequest.period:type_name -> ledger_service.v1.DateMonth
+	16,  // 56: ledger_service.v1.AddBudgetResponse.item:type_name -> ledger_service.v1.Budget
+	4,   // 57: ledger_service.v1.PatchBudgetRequest.period:type_name -> ledger_service.v1.DateMonth
+	17,  // 58: ledger_service.v1.PatchBudgetRequest.warning_thresholds:type_name -> ledger_service.v1.BudgetWarningThresholds
+	16,  // 59: ledger_service.v1.PatchBudgetResponse.item:type_name -> ledger_service.v1.Budget
+	4,   // 60: ledger_service.v1.CopyBudgetsRequest.source_period:type_name -> ledger_service.v1.DateMonth
+	4,   // 61: ledger_service.v1.CopyBudgetsRequest.target_period_from:type_name -> ledger_service.v1.DateMonth
+	4,   // 62: ledger_service.v1.CopyBudgetsRequest.target_period_to:type_name -> ledger_service.v1.DateMonth
+	16,  // 63: ledger_service.v1.CopyBudgetsResponse.items:type_name -> ledger_service.v1.Budget
+	3,   // 64: ledger_service.v1.ListReportsRequest.date_from:type_name -> ledger_service.v1.Date
+	3,   // 65: ledger_service.v1.ListReportsRequest.date_to:type_name -> ledger_service.v1.Date
+	22,  // 66: ledger_service.v1.ListReportsResponse.reports:type_name -> ledger_service.v1.PeriodReport
+	3,   // 67: ledger_service.v1.StreamExportTransactionsRequest.filter_occurred_on_from:type_name -> ledger_service.v1.Date
+	3,   // 68: ledger_service.v1.StreamExportTransactionsRequest.filter_occurred_on_to:type_name -> ledger_service.v1.Date
+	1,   // 69: ledger_service.v1.ExportTransactionsRequest.format:type_name -> ledger_service.v1.ExportFormat
+	3,   // 70: ledger_service.v1.ExportTransactionsRequest.filter_occurred_on_from:type_name -> ledger_service.v1.Date
+	3,   // 71: ledger_service.v1.ExportTransactionsRequest.filter_occurred_on_to:type_name -> ledger_service.v1.Date
+	1,   // 72: ledger_service.v1.ExportReportsRequest.format:type_name -> ledger_service.v1.ExportFormat
+	3,   // 73: ledger_service.v1.ExportReportsRequest.date_from:type_name -> ledger_service.v1.Date
+	3,   // 74: ledger_service.v1.ExportReportsRequest.date_to:type_name -> ledger_service.v1.Date
+	3,   // 75: ledger_service.v1.CSVImportRow.occurred_on:type_name -> ledger_service.v1.Date
+	69,  // 76: ledger_service.v1.CSVImportTransactionsResponse.rows:type_name -> ledger_service.v1.CSVImportRow
+	7,   // 77: ledger_service.v1.ListWalletsResponse.items:type_name -> ledger_service.v1.Wallet
+	7,   // 78: ledger_service.v1.GetWalletResponse.item:type_name -> ledger_service.v1.Wallet
+	7,   // 79: ledger_service.v1.AddWalletResponse.item:type_name -> ledger_service.v1.Wallet
+	7,   // 80: ledger_service.v1.PatchWalletResponse.item:type_name -> ledger_service.v1.Wallet
+	3,   // 81: ledger_service.v1.GetWalletBalancesRequest.date_to:type_name -> ledger_service.v1.Date
+	14,  // 82: ledger_service.v1.GetWalletBalancesResponse.items:type_name -> ledger_service.v1.WalletBalance
+	10,  // 83: ledger_service.v1.ListImportProfilesResponse.items:type_name -> ledger_service.v1.ImportProfile
+	10,  // 84: ledger_service.v1.GetImportProfileResponse.item:type_name -> ledger_service.v1.ImportProfile
+	9,   // 85: ledger_service.v1.AddImportProfileRequest.mapping:type_name -> ledger_service.v1.ImportProfileMapping
+	10,  // 86: ledger_service.v1.AddImportProfileResponse.item:type_name -> ledger_service.v1.ImportProfile
+	9,   // 87: ledger_service.v1.PatchImportProfileRequest.mapping:type_name -> ledger_service.v1.ImportProfileMapping
+	10,  // 88: ledger_service.v1.PatchImportProfileResponse.item:type_name -> ledger_service.v1.ImportProfile
+	12,  // 89: ledger_service.v1.ListCategoryRulesResponse.items:type_name -> ledger_service.v1.CategoryRule
+	12,  // 90: ledger_service.v1.GetCategoryRuleResponse.item:type_name -> ledger_service.v1.CategoryRule
+	11,  // 91: ledger_service.v1.AddCategoryRuleRequest.conditions:type_name -> ledger_service.v1.CategoryRuleConditions
+	12,  // 92: ledger_service.v1.AddCategoryRuleResponse.item:type_name -> ledger_service.v1.CategoryRule
+	11,  // 93: ledger_service.v1.PatchCategoryRuleRequest.conditions:type_name -> ledger_service.v1.CategoryRuleConditions
+	12,  // 94: ledger_service.v1.PatchCategoryRuleResponse.item:type_name -> ledger_service.v1.CategoryRule
+	3,   // 95: ledger_service.v1.ReapplyRulesRequest.date_from:type_name -> ledger_service.v1.Date
+	3,   // 96: ledger_service.v1.ReapplyRulesRequest.date_to:type_name -> ledger_service.v1.Date
+	13,  // 97: ledger_service.v1.ReapplyRulesResponse.changes:type_name -> ledger_service.v1.CategoryRuleChange
+	15,  // 98: ledger_service.v1.GetTransferResponse.item:type_name -> ledger_service.v1.Transfer
+	3,   // 99: ledger_service.v1.AddTransferRequest.occurred_on:type_name -> ledger_service.v1.Date
+	15,  // 100: ledger_service.v1.AddTransferResponse.item:type_name -> ledger_service.v1.Transfer
+	3,   // 101: ledger_service.v1.PatchTransferRequest.occurred_on:type_name -> ledger_service.v1.Date
+	15,  // 102: ledger_service.v1.PatchTransferResponse.item:type_name -> ledger_service.v1.Transfer
+	3,   // 103: ledger_service.v1.ListExchangeRatesRequest.filter_rate_date_from:type_name -> ledger_service.v1.Date
+	3,   // 104: ledger_service.v1.ListExchangeRatesRequest.filter_rate_date_to:type_name -> ledger_service.v1.Date
+	19,  // 105: ledger_service.v1.ListExchangeRatesResponse.items:type_name -> ledger_service.v1.ExchangeRate
+	19,  // 106: ledger_service.v1.UpsertExchangeRatesRequest.items:type_name -> ledger_service.v1.ExchangeRate
+	19,  // 107: ledger_service.v1.UpsertExchangeRatesResponse.items:type_name -> ledger_service.v1.ExchangeRate
+	20,  // 108: ledger_service.v1.ListRecurringRulesResponse.items:type_name -> ledger_service.v1.RecurringRule
+	20,  // 109: ledger_service.v1.GetRecurringRuleResponse.item:type_name -> ledger_service.v1.RecurringRule
+	3,   // 110: ledger_service.v1.AddRecurringRuleRequest.start_date:type_name -> ledger_service.v1.Date
+	3,   // 111: ledger_service.v1.AddRecurringRuleRequest.end_date:type_name -> ledger_service.v1.Date
+	20,  // 112: ledger_service.v1.AddRecurringRuleResponse.item:type_name -> ledger_service.v1.RecurringRule
+	3,   // 113: ledger_service.v1.PatchRecurringRuleRequest.end_date:type_name -> ledger_service.v1.Date
+	20,  // 114: ledger_service.v1.PatchRecurringRuleResponse.item:type_name -> ledger_service.v1.RecurringRule
+	23,  // 115: ledger_service.v1.Ledger.ListCategories:input_type -> ledger_service.v1.ListCategoriesRequest
+	25,  // 116: ledger_service.v1.Ledger.AddCategory:input_type -> ledger_service.v1.AddCategoryRequest
+	27,  // 117: ledger_service.v1.Ledger.PatchCategory:input_type -> ledger_service.v1.PatchCategoryRequest
+	29,  // 118: ledger_service.v1.Ledger.DeleteCategory:input_type -> ledger_service.v1.DeleteCategoryRequest
+	31,  // 119: ledger_service.v1.Ledger.ListTransactions:input_type -> ledger_service.v1.ListTransactionsRequest
+	33,  // 120: ledger_service.v1.Ledger.GetTransaction:input_type -> ledger_service.v1.GetTransactionRequest
+	35,  // 121: ledger_service.v1.Ledger.AddTransaction:input_type -> ledger_service.v1.AddTransactionRequest
+	37,  // 122: ledger_service.v1.Ledger.AddTransactionsBatch:input_type -> ledger_service.v1.AddTransactionsBatchRequest
+	39,  // 123: ledger_service.v1.Ledger.PatchTransaction:input_type -> ledger_service.v1.PatchTransactionRequest
+	41,  // 124: ledger_service.v1.Ledger.DeleteTransaction:input_type -> ledger_service.v1.DeleteTransactionRequest
+	43,  // 125: ledger_service.v1.Ledger.ListBudgets:input_type -> ledger_service.v1.ListBudgetsRequest
+	45,  // 126: ledger_service.v1.Ledger.GetBudget:input_type -> ledger_service.v1.GetBudgetRequest
+	47,  // 127: ledger_service.v1.Ledger.AddBudget:input_type -> ledger_service.v1.AddBudgetRequest
+	49,  // 128: ledger_service.v1.Ledger.PatchBudget:input_type -> ledger_service.v1.PatchBudgetRequest
+	51,  // 129: ledger_service.v1.Ledger.DeleteBudget:input_type -> ledger_service.v1.DeleteBudgetRequest
+	53,  // 130: ledger_service.v1.Ledger.CopyBudgets:input_type -> ledger_service.v1.CopyBudgetsRequest
+	55,  // 131: ledger_service.v1.Ledger.GetBudgetAutoRollover:input_type -> ledger_service.v1.GetBudgetAutoRolloverRequest
+	57,  // 132: ledger_service.v1.Ledger.SetBudgetAutoRollover:input_type -> ledger_service.v1.SetBudgetAutoRolloverRequest
+	59,  // 133: ledger_service.v1.Ledger.ListReports:input_type -> ledger_service.v1.ListReportsRequest
+	31,  // 134: ledger_service.v1.Ledger.CSVExportTransactions:input_type -> ledger_service.v1.ListTransactionsRequest
+	62,  // 135: ledger_service.v1.Ledger.StreamExportTransactions:input_type -> ledger_service.v1.StreamExportTransactionsRequest
+	64,  // 136: ledger_service.v1.Ledger.ExportTransactions:input_type -> ledger_service.v1.ExportTransactionsRequest
+	66,  // 137: ledger_service.v1.Ledger.ExportReports:input_type -> ledger_service.v1.ExportReportsRequest
+	68,  // 138: ledger_service.v1.Ledger.CSVImportTransactions:input_type -> ledger_service.v1.CSVImportTransactionsRequest
+	71,  // 139: ledger_service.v1.Ledger.ListWallets:input_type -> ledger_service.v1.ListWalletsRequest
+	73,  // 140: ledger_service.v1.Ledger.GetWallet:input_type -> ledger_service.v1.GetWalletRequest
+	75,  // 141: ledger_service.v1.Ledger.AddWallet:input_type -> ledger_service.v1.AddWalletRequest
+	77,  // 142: ledger_service.v1.Ledger.PatchWallet:input_type -> ledger_service.v1.PatchWalletRequest
+	79,  // 143: ledger_service.v1.Ledger.DeleteWallet:input_type -> ledger_service.v1.DeleteWalletRequest
+	81,  // 144: ledger_service.v1.Ledger.GetWalletBalances:input_type -> ledger_service.v1.GetWalletBalancesRequest
+	83,  // 145: ledger_service.v1.Ledger.ListImportProfiles:input_type -> ledger_service.v1.ListImportProfilesRequest
+	85,  // 146: ledger_service.v1.Ledger.GetImportProfile:input_type -> ledger_service.v1.GetImportProfileRequest
+	87,  // 147: ledger_service.v1.Ledger.AddImportProfile:input_type -> ledger_service.v1.AddImportProfileRequest
+	89,  // 148: ledger_service.v1.Ledger.PatchImportProfile:input_type -> ledger_service.v1.PatchImportProfileRequest
+	91,  // 149: ledger_service.v1.Ledger.DeleteImportProfile:input_type -> ledger_service.v1.DeleteImportProfileRequest
+	93,  // 150: ledger_service.v1.Ledger.ListCategoryRules:input_type -> ledger_service.v1.ListCategoryRulesRequest
+	95,  // 151: ledger_service.v1.Ledger.GetCategoryRule:input_type -> ledger_service.v1.GetCategoryRuleRequest
+	97,  // 152: ledger_service.v1.Ledger.AddCategoryRule:input_type -> ledger_service.v1.AddCategoryRuleRequest
+	99,  // 153: ledger_service.v1.Ledger.PatchCategoryRule:input_type -> ledger_service.v1.PatchCategoryRuleRequest
+	101, // 154: ledger_service.v1.Ledger.DeleteCategoryRule:input_type -> ledger_service.v1.DeleteCategoryRuleRequest
+	103, // 155: ledger_service.v1.Ledger.ReapplyRules:input_type -> ledger_service.v1.ReapplyRulesRequest
+	105, // 156: ledger_service.v1.Ledger.GetTransfer:input_type -> ledger_service.v1.GetTransferRequest
+	107, // 157: ledger_service.v1.Ledger.AddTransfer:input_type -> ledger_service.v1.AddTransferRequest
+	109, // 158: ledger_service.v1.Ledger.PatchTransfer:input_type -> ledger_service.v1.PatchTransferRequest
+	111, // 159: ledger_service.v1.Ledger.DeleteTransfer:input_type -> ledger_service.v1.DeleteTransferRequest
+	113, // 160: ledger_service.v1.Ledger.GetBaseCurrency:input_type -> ledger_service.v1.GetBaseCurrencyRequest
+	115, // 161: ledger_service.v1.Ledger.SetBaseCurrency:input_type -> ledger_service.v1.SetBaseCurrencyRequest
+	117, // 162: ledger_service.v1.Ledger.ListExchangeRates:input_type -> ledger_service.v1.ListExchangeRatesRequest
+	119, // 163: ledger_service.v1.Ledger.UpsertExchangeRates:input_type -> ledger_service.v1.UpsertExchangeRatesRequest
+	121, // 164: ledger_service.v1.Ledger.ListRecurringRules:input_type -> ledger_service.v1.ListRecurringRulesRequest
+	123, // 165: ledger_service.v1.Ledger.GetRecurringRule:input_type -> ledger_service.v1.GetRecurringRuleRequest
+	125, // 166: ledger_service.v1.Ledger.AddRecurringRule:input_type -> ledger_service.v1.AddRecurringRuleRequest
+	127, // 167: ledger_service.v1.Ledger.PatchRecurringRule:input_type -> ledger_service.v1.PatchRecurringRuleRequest
+	129, // 168: ledger_service.v1.Ledger.DeleteRecurringRule:input_type -> ledger_service.v1.DeleteRecurringRuleRequest
+	24,  // 169: ledger_service.v1.Ledger.ListCategories:output_type -> ledger_service.v1.ListCategoriesResponse
+	26,  // 170: ledger_service.v1.Ledger.AddCategory:output_type -> ledger_service.v1.AddCategoryResponse
+	28,  // 171: ledger_service.v1.Ledger.PatchCategory:output_type -> ledger_service.v1.PatchCategoryResponse
+	30,  // 172: ledger_service.v1.Ledger.DeleteCategory:output_type -> ledger_service.v1.DeleteCategoryResponse
+	32,  // 173: ledger_service.v1.Ledger.ListTransactions:output_type -> ledger_service.v1.ListTransactionsResponse
+	34,  // 174: ledger_service.v1.Ledger.GetTransaction:output_type -> ledger_service.v1.GetTransactionResponse
+	36,  // 175: ledger_service.v1.Ledger.AddTransaction:output_type -> ledger_service.v1.AddTransactionResponse
+	38,  // 176: ledger_service.v1.Ledger.AddTransactionsBatch:output_type -> ledger_service.v1.AddTransactionsBatchResponse
+	40,  // 177: ledger_service.v1.Ledger.PatchTransaction:output_type -> ledger_service.v1.PatchTransactionResponse
+	42,  // 178: ledger_service.v1.Ledger.DeleteTransaction:output_type -> ledger_service.v1.DeleteTransactionResponse
+	44,  // 179: ledger_service.v1.Ledger.ListBudgets:output_type -> ledger_service.v1.ListBudgetsResponse
+	46,  // 180: ledger_service.v1.Ledger.GetBudget:output_type -> ledger_service.v1.GetBudgetResponse
+	48,  // 181: ledger_service.v1.Ledger.AddBudget:output_type -> ledger_service.v1.AddBudgetResponse
+	50,  // 182: ledger_service.v1.Ledger.PatchBudget:output_type -> ledger_service.v1.PatchBudgetResponse
+	52,  // 183: ledger_service.v1.Ledger.DeleteBudget:output_type -> ledger_service.v1.DeleteBudgetResponse
+	54,  // 184: ledger_service.v1.Ledger.CopyBudgets:output_type -> ledger_service.v1.CopyBudgetsResponse
+	56,  // 185: ledger_service.v1.Ledger.GetBudgetAutoRollover:output_type -> ledger_service.v1.GetBudgetAutoRolloverResponse
+	58,  // 186: ledger_service.v1.Ledger.SetBudgetAutoRollover:output_type -> ledger_service.v1.SetBudgetAutoRolloverResponse
+	60,  // 187: ledger_service.v1.Ledger.ListReports:output_type -> ledger_service.v1.ListReportsResponse
+	61,  // 188: ledger_service.v1.Ledger.CSVExportTransactions:output_type -> ledger_service.v1.CSVExportTransactionsResponse
+	63,  // 189: ledger_service.v1.Ledger.StreamExportTransactions:output_type -> ledger_service.v1.StreamExportTransactionsResponse
+	65,  // 190: ledger_service.v1.Ledger.ExportTransactions:output_type -> ledger_service.v1.ExportTransactionsResponse
+	67,  // 191: ledger_service.v1.Ledger.ExportReports:output_type -> ledger_service.v1.ExportReportsResponse
+	70,  // 192: ledger_service.v1.Ledger.CSVImportTransactions:output_type -> ledger_service.v1.CSVImportTransactionsResponse
+	72,  // 193: ledger_service.v1.Ledger.ListWallets:output_type -> ledger_service.v1.ListWalletsResponse
+	74,  // 194: ledger_service.v1.Ledger.GetWallet:output_type -> ledger_service.v1.GetWalletResponse
+	76,  // 195: ledger_service.v1.Ledger.AddWallet:output_type -> ledger_service.v1.AddWalletResponse
+	78,  // 196: ledger_service.v1.Ledger.PatchWallet:output_type -> ledger_service.v1.PatchWalletResponse
+	80,  // 197: ledger_service.v1.Ledger.DeleteWallet:output_type -> ledger_service.v1.DeleteWalletResponse
+	82,  // 198: ledger_service.v1.Ledger.GetWalletBalances:output_type -> ledger_service.v1.GetWalletBalancesResponse
+	84,  // 199: ledger_service.v1.Ledger.ListImportProfiles:output_type -> ledger_service.v1.ListImportProfilesResponse
+	86,  // 200: ledger_service.v1.Ledger.GetImportProfile:output_type -> ledger_service.v1.GetImportProfileResponse
+	88,  // 201: ledger_service.v1.Ledger.AddImportProfile:output_type -> ledger_service.v1.AddImportProfileResponse
+	90,  // 202: ledger_service.v1.Ledger.PatchImportProfile:output_type -> ledger_service.v1.PatchImportProfileResponse
+	92,  // 203: ledger_service.v1.Ledger.DeleteImportProfile:output_type -> ledger_service.v1.DeleteImportProfileResponse
+	94,  // 204: ledger_service.v1.Ledger.ListCategoryRules:output_type -> ledger_service.v1.ListCategoryRulesResponse
+	96,  // 205: ledger_service.v1.Ledger.GetCategoryRule:output_type -> ledger_service.v1.GetCategoryRuleResponse
+	98,  // 206: ledger_service.v1.Ledger.AddCategoryRule:output_type -> ledger_service.v1.AddCategoryRuleResponse
+	100, // 207: ledger_service.v1.Ledger.PatchCategoryRule:output_type -> ledger_service.v1.PatchCategoryRuleResponse
+	102, // 208: ledger_service.v1.Ledger.DeleteCategoryRule:output_type -> ledger_service.v1.DeleteCategoryRuleResponse
+	104, // 209: ledger_service.v1.Ledger.ReapplyRules:output_type -> ledger_service.v1.ReapplyRulesResponse
+	106, // 210: ledger_service.v1.Ledger.GetTransfer:output_type -> ledger_service.v1.GetTransferResponse
+	108, // 211: ledger_service.v1.Ledger.AddTransfer:output_type -> ledger_service.v1.AddTransferResponse
+	110, // 212: ledger_service.v1.Ledger.PatchTransfer:output_type -> ledger_service.v1.PatchTransferResponse
+	112, // 213: ledger_service.v1.Ledger.DeleteTransfer:output_type -> ledger_service.v1.DeleteTransferResponse
+	114, // 214: ledger_service.v1.Ledger.GetBaseCurrency:output_type -> ledger_service.v1.GetBaseCurrencyResponse
+	116, // 215: ledger_service.v1.Ledger.SetBaseCurrency:output_type -> ledger_service.v1.SetBaseCurrencyResponse
+	118, // 216: ledger_service.v1.Ledger.ListExchangeRates:output_type -> ledger_service.v1.ListExchangeRatesResponse
+	120, // 217: ledger_service.v1.Ledger.UpsertExchangeRates:output_type -> ledger_service.v1.UpsertExchangeRatesResponse
+	122, // 218: ledger_service.v1.Ledger.ListRecurringRules:output_type -> ledger_service.v1.ListRecurringRulesResponse
+	124, // 219: ledger_service.v1.Ledger.GetRecurringRule:output_type -> ledger_service.v1.GetRecurringRuleResponse
+	126, // 220: ledger_service.v1.Ledger.AddRecurringRule:output_type -> ledger_service.v1.AddRecurringRuleResponse
+	128, // 221: ledger_service.v1.Ledger.PatchRecurringRule:output_type -> ledger_service.v1.PatchRecurringRuleResponse
+	130, // 222: ledger_service.v1.Ledger.DeleteRecurringRule:output_type -> ledger_service.v1.DeleteRecurringRuleResponse
+	169, // [169:223] is the sub-list for method output_type
+	115, // [115:169] is the sub-list for method input_type
+	115, // [115:115] is the sub-list for extension type_name
+	115, // [115:115] is the sub-list for extension extendee
+	0,   // [0:115] is the sub-list for field type_name
 }
 
 func init() { file_ledger_service_service_proto_init() }
@@ -2175,23 +9497,56 @@ func file_ledger_service_service_proto_init() {
 	if File_ledger_service_service_proto != nil {
 		return
 	}
-	file_ledger_service_service_proto_msgTypes[5].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[0].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[3].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[7].OneofWrappers = []any{}
 	file_ledger_service_service_proto_msgTypes[9].OneofWrappers = []any{}
-	file_ledger_service_service_proto_msgTypes[15].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[16].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[18].OneofWrappers = []any{}
 	file_ledger_service_service_proto_msgTypes[19].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[21].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[23].OneofWrappers = []any{}
 	file_ledger_service_service_proto_msgTypes[25].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[27].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[29].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[30].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[33].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[37].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[39].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[41].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[42].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[45].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[47].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[49].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[57].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[60].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[62].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[64].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[66].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[67].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[69].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[75].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[79].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[87].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[97].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[107].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[115].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[119].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[123].OneofWrappers = []any{}
+	file_ledger_service_service_proto_msgTypes[125].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ledger_service_service_proto_rawDesc), len(file_ledger_service_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   34,
+			NumEnums:      2,
+			NumMessages:   129,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_ledger_service_service_proto_goTypes,
 		DependencyIndexes: file_ledger_service_service_proto_depIdxs,
+		EnumInfos:         file_ledger_service_service_proto_enumTypes,
 		MessageInfos:      file_ledger_service_service_proto_msgTypes,
 	}.Build()
 	File_ledger_service_service_proto = out.File
@@ -32,6 +32,20 @@ type Config struct {
 		Master              struct {
 			DSN string `yaml:"dsn" env:"POSTGRES_MASTER_DSN"`
 		} `yaml:"master"`
+		// Replicas - реплики для тяжелых чтений, без DSN все запросы идут в мастер
+		Replicas struct {
+			DSNs            []string `yaml:"dsns" env:"POSTGRES_REPLICA_DSNS" env-separator:","`
+			MaxLagMs        int      `yaml:"max_lag_ms" env:"POSTGRES_REPLICA_MAX_LAG_MS" env-default:"1000"`
+			CheckIntervalMs int      `yaml:"check_interval_ms" env:"POSTGRES_REPLICA_CHECK_INTERVAL_MS" env-default:"1000"`
+		} `yaml:"replicas"`
+		// TxRetry - повтор транзакций при serialization failure и deadlock
+		TxRetry struct {
+			MaxAttempts int `yaml:"max_attempts" env:"POSTGRES_TX_RETRY_MAX_ATTEMPTS" env-default:"3"`
+			BaseDelayMs int `yaml:"base_delay_ms" env:"POSTGRES_TX_RETRY_BASE_DELAY_MS" env-default:"10"`
+			MaxDelayMs  int `yaml:"max_delay_ms" env:"POSTGRES_TX_RETRY_MAX_DELAY_MS" env-default:"200"`
+			// MetricsLogIntervalSec - период записи счетчиков повторов в лог, 0 - не писать
+			MetricsLogIntervalSec int `yaml:"metrics_log_interval_sec" env:"POSTGRES_TX_RETRY_METRICS_LOG_INTERVAL_SEC" env-default:"60"`
+		} `yaml:"tx_retry"`
 	} `yaml:"postgres"`
 	Auth struct {
 		AccessTokenLifetimeSec int `yaml:"access_token_lifetime_sec" env:"AUTH_ACCESS_TOKEN_LIFETIME_SEC" env-default:"300"`
@@ -44,6 +58,49 @@ type Config struct {
 		Addr     string `yaml:"addr" env:"REDIS_ADDR"`
 		Password string `yaml:"password" env:"REDIS_PASSWORD"`
 	}
+	Budget struct {
+		CategoryMaxDepth int `yaml:"category_max_depth" env:"BUDGET_CATEGORY_MAX_DEPTH" env-default:"3"`
+		// DefaultCurrency - базовая валюта аккаунта, если она не задана в настройках
+		DefaultCurrency string `yaml:"default_currency" env:"BUDGET_DEFAULT_CURRENCY" env-default:"RUB"`
+		// Recurring - фоновая материализация регулярных транзакций
+		Recurring struct {
+			Enabled     bool `yaml:"enabled" env:"BUDGET_RECURRING_ENABLED" env-default:"true"`
+			IntervalSec int  `yaml:"interval_sec" env:"BUDGET_RECURRING_INTERVAL_SEC" env-default:"60"`
+			BatchSize   int  `yaml:"batch_size" env:"BUDGET_RECURRING_BATCH_SIZE" env-default:"100"`
+		} `yaml:"recurring"`
+		// Rollover - автоматический перенос бюджетов на новый месяц
+		Rollover struct {
+			Enabled     bool `yaml:"enabled" env:"BUDGET_ROLLOVER_ENABLED" env-default:"true"`
+			IntervalSec int  `yaml:"interval_sec" env:"BUDGET_ROLLOVER_INTERVAL_SEC" env-default:"300"`
+			BatchSize   int  `yaml:"batch_size" env:"BUDGET_ROLLOVER_BATCH_SIZE" env-default:"100"`
+		} `yaml:"rollover"`
+		// Outbox - доставка событий бюджета внешним получателям
+		Outbox struct {
+			Enabled           bool `yaml:"enabled" env:"BUDGET_OUTBOX_ENABLED" env-default:"true"`
+			IntervalSec       int  `yaml:"interval_sec" env:"BUDGET_OUTBOX_INTERVAL_SEC" env-default:"10"`
+			BatchSize         int  `yaml:"batch_size" env:"BUDGET_OUTBOX_BATCH_SIZE" env-default:"50"`
+			MaxAttempts       int  `yaml:"max_attempts" env:"BUDGET_OUTBOX_MAX_ATTEMPTS" env-default:"10"`
+			BackoffInitialSec int  `yaml:"backoff_initial_sec" env:"BUDGET_OUTBOX_BACKOFF_INITIAL_SEC" env-default:"10"`
+			BackoffMaxSec     int  `yaml:"backoff_max_sec" env:"BUDGET_OUTBOX_BACKOFF_MAX_SEC" env-default:"3600"`
+			// LeaseSec - на это время выбранное событие скрывается от других реплик, должно превышать время отправки пачки
+			LeaseSec int `yaml:"lease_sec" env:"BUDGET_OUTBOX_LEASE_SEC" env-default:"300"`
+		} `yaml:"outbox"`
+		// Notify - получатели событий, пустой адрес отключает получателя
+		Notify struct {
+			Webhook struct {
+				URL        string `yaml:"url" env:"BUDGET_NOTIFY_WEBHOOK_URL"`
+				Secret     string `yaml:"secret" env:"BUDGET_NOTIFY_WEBHOOK_SECRET"`
+				TimeoutSec int    `yaml:"timeout_sec" env:"BUDGET_NOTIFY_WEBHOOK_TIMEOUT_SEC" env-default:"10"`
+			} `yaml:"webhook"`
+			SMTP struct {
+				Addr     string   `yaml:"addr" env:"BUDGET_NOTIFY_SMTP_ADDR"`
+				Username string   `yaml:"username" env:"BUDGET_NOTIFY_SMTP_USERNAME"`
+				Password string   `yaml:"password" env:"BUDGET_NOTIFY_SMTP_PASSWORD"`
+				From     string   `yaml:"from" env:"BUDGET_NOTIFY_SMTP_FROM"`
+				To       []string `yaml:"to" env:"BUDGET_NOTIFY_SMTP_TO" env-separator:","`
+			} `yaml:"smtp"`
+		} `yaml:"notify"`
+	} `yaml:"budget"`
 }
 
 func LoadConfig(files ...string) Config {
@@ -35,6 +35,9 @@ var (
 	// ErrTxСoncurrentExec - ошибка транзакции
 	ErrTxСoncurrentExec = ErrConflict.Extend("store transaction concurrent execution")
 
+	// ErrTxSerializationFailure - serialization failure или deadlock, транзакцию можно повторить целиком
+	ErrTxSerializationFailure = ErrTxСoncurrentExec.Extend("store transaction serialization failure")
+
 	// ErrStoreNoRows - no rows
 	ErrStoreNoRows = ErrNotFound.Extend("store no rows")
 
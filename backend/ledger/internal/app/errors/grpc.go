@@ -6,6 +6,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
@@ -63,6 +64,8 @@ func ToGrpcStatus(err error) error {
 			value = fmt.Sprintf("%d", v)
 		case float32, float64:
 			value = fmt.Sprintf("%d", v)
+		case time.Time:
+			value = v.Format(time.RFC3339Nano)
 		default:
 			value = fmt.Sprintf("%v", v)
 		}
@@ -123,6 +123,15 @@ func CheckIsTxСoncurrentExec(err error) bool {
 	return errors.Is(err, ErrTxСoncurrentExec)
 }
 
+// CheckIsTxRetryable - проверяет, можно ли повторить транзакцию целиком после ошибки
+func CheckIsTxRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && (pgErr.Code == "40001" || pgErr.Code == "40P01") {
+		return true
+	}
+	return errors.Is(err, ErrTxSerializationFailure)
+}
+
 // ConvertPgxToAppErr - конвертирует ошибку pgx в ошибку приложения
 func ConvertPgxToAppErr(err error) (error, bool) {
 	if errors.Is(err, pgx.ErrNoRows) {
@@ -131,8 +140,8 @@ func ConvertPgxToAppErr(err error) (error, bool) {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
 		switch pgErr.Code {
-		case "40001":
-			return ErrTxСoncurrentExec.WithWrap(err), true
+		case "40001", "40P01":
+			return ErrTxSerializationFailure.WithWrap(err), true
 		case "25P02":
 			return ErrTxСoncurrentExec.WithWrap(err), true
 		case "23505":
@@ -7,6 +7,7 @@ import (
 
 	"github.com/m11ano/budget_planner/backend/ledger/internal/app"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/app/config"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/app/fxboot/invoking"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/app/fxboot/providing"
 	deliveryGRPC "github.com/m11ano/budget_planner/backend/ledger/internal/delivery/grpc"
@@ -49,6 +50,17 @@ func BackendAppGetOptionsMap(appID app.ID, cfg config.Config) OptionsMap {
 					return providing.NewDBClients(
 						cfg.Postgres.Master.DSN,
 						cfg.BackendApp.Base.LogSQLQueries,
+						pgclient.RetryPolicy{
+							MaxAttempts: cfg.Postgres.TxRetry.MaxAttempts,
+							BaseDelay:   time.Millisecond * time.Duration(cfg.Postgres.TxRetry.BaseDelayMs),
+							MaxDelay:    time.Millisecond * time.Duration(cfg.Postgres.TxRetry.MaxDelayMs),
+							IsRetryable: appErrors.CheckIsTxRetryable,
+						},
+						pgclient.ReplicaOpts{
+							DSNs:          cfg.Postgres.Replicas.DSNs,
+							MaxLag:        time.Millisecond * time.Duration(cfg.Postgres.Replicas.MaxLagMs),
+							CheckInterval: time.Millisecond * time.Duration(cfg.Postgres.Replicas.CheckIntervalMs),
+						},
 						logger,
 						shutdown,
 					)
@@ -95,6 +107,8 @@ func BackendAppInitInvoke(
 		}
 	}()
 
+	stopRetryMetrics := func() {}
+
 	in.LC.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
 			// Тестирование соединения с мастером postgress
@@ -116,6 +130,27 @@ func BackendAppInitInvoke(
 				slog.String("serverID", in.DBMasterClient.ServerID()),
 			)
 
+			// Периодическая запись счетчиков повторов транзакций
+			if in.Cfg.Postgres.TxRetry.MetricsLogIntervalSec > 0 {
+				metricsCtx, metricsCancel := context.WithCancel(context.Background())
+				metricsDone := make(chan struct{})
+
+				go func() {
+					defer close(metricsDone)
+					pgclient.LogRetryMetrics(
+						metricsCtx,
+						in.DBMasterClient,
+						in.Logger,
+						time.Second*time.Duration(in.Cfg.Postgres.TxRetry.MetricsLogIntervalSec),
+					)
+				}()
+
+				stopRetryMetrics = func() {
+					metricsCancel()
+					<-metricsDone
+				}
+			}
+
 			// Миграции goose
 			err = db.UpMigrations(in.Cfg.Postgres.Master.DSN, in.Cfg.Postgres.MigrationsPath, in.Logger)
 			if err != nil {
@@ -183,6 +218,9 @@ func BackendAppInitInvoke(
 				in.GRPCServer.GracefulStop()
 			}
 
+			// Останавливаем запись счетчиков повторов, итоговые значения пишутся при остановке
+			stopRetryMetrics()
+
 			// Закрываем postgress
 			in.DBMasterClient.Close()
 			in.Logger.InfoContext(ctx, "closing db clients")
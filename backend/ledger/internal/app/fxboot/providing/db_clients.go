@@ -9,14 +9,23 @@ import (
 	"go.uber.org/fx"
 )
 
-func NewDBClients(masterDSN string, logQueries bool, logger *slog.Logger, shutdown fx.Shutdowner) db.MasterClient {
+func NewDBClients(
+	masterDSN string,
+	logQueries bool,
+	retryPolicy pgclient.RetryPolicy,
+	replicas pgclient.ReplicaOpts,
+	logger *slog.Logger,
+	shutdown fx.Shutdowner,
+) db.MasterClient {
 	master, err := pgclient.NewClient(
 		context.Background(),
 		"master",
 		masterDSN,
 		pgclient.NewClientOpts{
-			Logger:     logger,
-			LogQueries: logQueries,
+			Logger:      logger,
+			LogQueries:  logQueries,
+			RetryPolicy: retryPolicy,
+			Replicas:    replicas,
 		},
 	)
 	if err != nil {
@@ -8,8 +8,10 @@ import (
 	"github.com/govalues/decimal"
 	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
 	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetEntity "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
 	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+	"github.com/samber/lo"
 )
 
 func (c *controller) AddBudget(ctx context.Context, req *desc.AddBudgetRequest) (*desc.AddBudgetResponse, error) {
@@ -37,8 +39,15 @@ func (c *controller) AddBudget(ctx context.Context, req *desc.AddBudgetRequest)
 		budgetUC.CreateBudgetDataInput{
 			AccountID:  authData.AccountID,
 			Amount:     amount,
+			Currency:   lo.FromPtr(req.Currency),
 			Period:     period,
 			CategoryID: uint64(req.CategoryId),
+			CarryOver:  req.CarryOver,
+
+			Enforcement: budgetEntity.BudgetEnforcement(lo.FromPtr(req.Enforcement)),
+			WarningThresholds: lo.Map(req.WarningThresholds, func(item int32, _ int) int {
+				return int(item)
+			}),
 		},
 	)
 	if err != nil {
@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+	"github.com/samber/lo"
+)
+
+func (c *controller) AddCategory(ctx context.Context, req *desc.AddCategoryRequest) (*desc.AddCategoryResponse, error) {
+	const op = "AddCategory"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	var parentID *uint64
+	if req.ParentId != nil {
+		if *req.ParentId <= 0 {
+			return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("invalid parent_id"), "%s.%s", c.pkg, op)
+		}
+
+		parentID = lo.ToPtr(uint64(*req.ParentId))
+	}
+
+	itemDTO, err := c.budgetFacade.Category.CreateCategoryByDTO(
+		ctx,
+		budgetUC.CreateCategoryDataInput{
+			AccountID: authData.AccountID,
+			ParentID:  parentID,
+			Title:     req.Title,
+		},
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.AddCategoryResponse{
+		Item: CategoryToProto(itemDTO),
+	}
+
+	return out, nil
+}
@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetEntity "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) AddCategoryRule(
+	ctx context.Context,
+	req *desc.AddCategoryRuleRequest,
+) (*desc.AddCategoryRuleResponse, error) {
+	const op = "AddCategoryRule"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	conditions, err := categoryRuleConditionsFromProto(req.Conditions)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	itemDTO, err := c.budgetFacade.CategoryRule.CreateCategoryRuleByDTO(
+		ctx,
+		budgetUC.CreateCategoryRuleDataInput{
+			AccountID: authData.AccountID,
+			NewCategoryRuleInput: budgetEntity.NewCategoryRuleInput{
+				Name:       req.Name,
+				Priority:   int(req.Priority),
+				CategoryID: uint64(req.CategoryId),
+				Conditions: conditions,
+			},
+		},
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.AddCategoryRuleResponse{
+		Item: CategoryRuleToProto(itemDTO),
+	}
+
+	return out, nil
+}
@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetEntity "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) AddImportProfile(
+	ctx context.Context,
+	req *desc.AddImportProfileRequest,
+) (*desc.AddImportProfileResponse, error) {
+	const op = "AddImportProfile"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	mapping := importProfileMappingFromProto(req.Mapping)
+
+	itemDTO, err := c.budgetFacade.ImportProfile.CreateImportProfileByDTO(
+		ctx,
+		budgetUC.CreateImportProfileDataInput{
+			AccountID: authData.AccountID,
+			NewImportProfileInput: budgetEntity.NewImportProfileInput{
+				Name:              req.Name,
+				Delimiter:         req.Delimiter,
+				DecimalSeparator:  req.DecimalSeparator,
+				DateLayout:        req.DateLayout,
+				HasHeader:         mapping.HasHeader,
+				SkipRows:          int(req.SkipRows),
+				SignConvention:    mapping.SignConvention,
+				IncomeValues:      mapping.IncomeValues,
+				Encoding:          budgetEntity.ImportEncoding(req.Encoding),
+				Columns:           mapping.Columns,
+				DefaultCategoryID: mapping.DefaultCategoryID,
+			},
+		},
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.AddImportProfileResponse{
+		Item: ImportProfileToProto(itemDTO),
+	}
+
+	return out, nil
+}
@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"context"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+	"github.com/samber/lo"
+)
+
+func (c *controller) AddRecurringRule(
+	ctx context.Context,
+	req *desc.AddRecurringRuleRequest,
+) (*desc.AddRecurringRuleResponse, error) {
+	const op = "AddRecurringRule"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	amount, err := decimal.Parse(req.Amount)
+	if err != nil {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid amount"), "%s.%s", c.pkg, op)
+	}
+
+	if req.StartDate == nil {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("start_date is required"), "%s.%s", c.pkg, op)
+	}
+
+	startDate, err := dateFromProto(req.StartDate)
+	if err != nil {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid start_date"), "%s.%s", c.pkg, op)
+	}
+
+	var endDate *civil.Date
+	if req.EndDate != nil {
+		date, err := dateFromProto(req.EndDate)
+		if err != nil {
+			return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid end_date"), "%s.%s", c.pkg, op)
+		}
+
+		endDate = &date
+	}
+
+	var walletID *uuid.UUID
+	if req.WalletId != nil {
+		id, err := uuid.Parse(*req.WalletId)
+		if err != nil {
+			return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid wallet_id"), "%s.%s", c.pkg, op)
+		}
+
+		walletID = &id
+	}
+
+	var count *int
+	if req.Count != nil {
+		count = lo.ToPtr(int(*req.Count))
+	}
+
+	itemDTO, err := c.budgetFacade.RecurringRule.CreateRecurringRuleByDTO(
+		ctx,
+		budgetUC.CreateRecurringRuleDataInput{
+			AccountID:   authData.AccountID,
+			IsIncome:    req.IsIncome,
+			Amount:      amount,
+			Currency:    lo.FromPtr(req.Currency),
+			CategoryID:  uint64(req.CategoryId),
+			WalletID:    walletID,
+			Description: req.Description,
+			Frequency:   entity.RecurringFrequency(req.Frequency),
+			Interval:    int(req.Interval),
+			DayOfMonth:  int(req.DayOfMonth),
+			StartDate:   startDate,
+			EndDate:     endDate,
+			Count:       count,
+		},
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.AddRecurringRuleResponse{
+		Item: RecurringRuleToProto(itemDTO),
+	}
+
+	return out, nil
+}
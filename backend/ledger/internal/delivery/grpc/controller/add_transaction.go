@@ -5,11 +5,14 @@ import (
 	"fmt"
 
 	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
 	"github.com/govalues/decimal"
 	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
 	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetEntity "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
 	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+	"github.com/samber/lo"
 )
 
 func (c *controller) AddTransaction(ctx context.Context, req *desc.AddTransactionRequest) (*desc.AddTransactionResponse, error) {
@@ -20,36 +23,119 @@ func (c *controller) AddTransaction(ctx context.Context, req *desc.AddTransactio
 		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
 	}
 
-	amount, err := decimal.Parse(req.Amount)
+	in, err := createTransactionInputFromProto(authData.AccountID, req)
 	if err != nil {
-		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid amount"), "%s.%s", c.pkg, op)
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
 	}
 
-	occuredOn, err := civil.ParseDate(
-		fmt.Sprintf("%04d-%02d-%02d", req.OccurredOn.Year, req.OccurredOn.Month, req.OccurredOn.Day),
-	)
-	if err != nil {
-		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid occured_on"), "%s.%s", c.pkg, op)
-	}
-
-	itemDTO, err := c.budgetFacade.Transaction.CreateTransactionByDTO(
-		ctx,
-		budgetUC.CreateTransactionDataInput{
-			AccountID:   authData.AccountID,
-			IsIncome:    req.IsIncome,
-			Amount:      amount,
-			OccurredOn:  occuredOn,
-			CategoryID:  uint64(req.CategoryId),
-			Description: req.Description,
-		},
-	)
+	itemDTO, err := c.budgetFacade.Transaction.CreateTransactionByDTO(ctx, in)
 	if err != nil {
 		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
 	}
 
 	out := &desc.AddTransactionResponse{
 		Item: TransactionToProto(itemDTO),
+		Warnings: lo.Map(itemDTO.Warnings, func(item *budgetEntity.BudgetWarning, _ int) *desc.BudgetWarning {
+			return BudgetWarningToProto(item)
+		}),
 	}
 
 	return out, nil
 }
+
+func (c *controller) AddTransactionsBatch(
+	ctx context.Context,
+	req *desc.AddTransactionsBatchRequest,
+) (*desc.AddTransactionsBatchResponse, error) {
+	const op = "AddTransactionsBatch"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	if len(req.Items) > budgetUC.TransactionsBatchMaxItems {
+		return nil, appErrors.Chainf(
+			appErrors.ErrBadRequest.WithHints(fmt.Sprintf("items must be <= %d", budgetUC.TransactionsBatchMaxItems)),
+			"%s.%s", c.pkg, op,
+		)
+	}
+
+	items := make([]budgetUC.CreateTransactionDataInput, 0, len(req.Items))
+	for i, item := range req.Items {
+		in, err := createTransactionInputFromProto(authData.AccountID, item)
+		if err != nil {
+			if appErr, ok := appErrors.ExtractError(err); ok {
+				hints, _ := appErrors.NearestHints(err)
+				err = appErr.WithHints(append(append([]string{}, hints...), fmt.Sprintf("items[%d]", i))...)
+			}
+
+			return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+		}
+
+		items = append(items, in)
+	}
+
+	result, err := c.budgetFacade.Transaction.CreateTransactionsBatch(ctx, budgetUC.CreateTransactionsBatchInput{
+		AccountID: authData.AccountID,
+		Items:     items,
+	})
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.AddTransactionsBatchResponse{
+		Ids: lo.Map(result.IDs, func(id uuid.UUID, _ int) string {
+			return id.String()
+		}),
+		Warnings: lo.Map(result.Warnings, func(item *budgetEntity.BudgetWarning, _ int) *desc.BudgetWarning {
+			return BudgetWarningToProto(item)
+		}),
+	}
+
+	return out, nil
+}
+
+// createTransactionInputFromProto - ошибки разбора возвращаются как ErrBadRequest с подсказкой
+func createTransactionInputFromProto(
+	accountID uuid.UUID,
+	req *desc.AddTransactionRequest,
+) (budgetUC.CreateTransactionDataInput, error) {
+	amount, err := decimal.Parse(req.Amount)
+	if err != nil {
+		return budgetUC.CreateTransactionDataInput{}, appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid amount")
+	}
+
+	if req.OccurredOn == nil {
+		return budgetUC.CreateTransactionDataInput{}, appErrors.ErrBadRequest.WithHints("invalid occured_on")
+	}
+
+	occuredOn, err := civil.ParseDate(
+		fmt.Sprintf("%04d-%02d-%02d", req.OccurredOn.Year, req.OccurredOn.Month, req.OccurredOn.Day),
+	)
+	if err != nil {
+		return budgetUC.CreateTransactionDataInput{}, appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid occured_on")
+	}
+
+	var walletID *uuid.UUID
+	if req.WalletId != nil {
+		id, err := uuid.Parse(*req.WalletId)
+		if err != nil {
+			return budgetUC.CreateTransactionDataInput{}, appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid wallet_id")
+		}
+
+		walletID = &id
+	}
+
+	return budgetUC.CreateTransactionDataInput{
+		AccountID:   accountID,
+		IsIncome:    req.IsIncome,
+		Amount:      amount,
+		Currency:    lo.FromPtr(req.Currency),
+		OccurredOn:  occuredOn,
+		CategoryID:  uint64(req.CategoryId),
+		WalletID:    walletID,
+		Description: req.Description,
+		Tags:        req.Tags,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) AddTransfer(ctx context.Context, req *desc.AddTransferRequest) (*desc.AddTransferResponse, error) {
+	const op = "AddTransfer"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	fromWalletID, err := uuid.Parse(req.FromWalletId)
+	if err != nil {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid from_wallet_id"), "%s.%s", c.pkg, op)
+	}
+
+	toWalletID, err := uuid.Parse(req.ToWalletId)
+	if err != nil {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid to_wallet_id"), "%s.%s", c.pkg, op)
+	}
+
+	amount, err := decimal.Parse(req.Amount)
+	if err != nil {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid amount"), "%s.%s", c.pkg, op)
+	}
+
+	occuredOn, err := civil.ParseDate(
+		fmt.Sprintf("%04d-%02d-%02d", req.OccurredOn.Year, req.OccurredOn.Month, req.OccurredOn.Day),
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid occured_on"), "%s.%s", c.pkg, op)
+	}
+
+	itemDTO, err := c.budgetFacade.Transaction.CreateTransferByDTO(
+		ctx,
+		budgetUC.CreateTransferDataInput{
+			AccountID:    authData.AccountID,
+			FromWalletID: fromWalletID,
+			ToWalletID:   toWalletID,
+			Amount:       amount,
+			OccurredOn:   occuredOn,
+			Description:  req.Description,
+		},
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.AddTransferResponse{
+		Item: TransferToProto(itemDTO),
+	}
+
+	return out, nil
+}
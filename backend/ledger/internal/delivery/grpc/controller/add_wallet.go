@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/govalues/decimal"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) AddWallet(ctx context.Context, req *desc.AddWalletRequest) (*desc.AddWalletResponse, error) {
+	const op = "AddWallet"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	openingBalance := decimal.Zero
+	if req.OpeningBalance != "" {
+		value, err := decimal.Parse(req.OpeningBalance)
+		if err != nil {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid opening_balance"), "%s.%s", c.pkg, op)
+		}
+
+		openingBalance = value
+	}
+
+	itemDTO, err := c.budgetFacade.Wallet.CreateWalletByDTO(
+		ctx,
+		budgetUC.CreateWalletDataInput{
+			AccountID:      authData.AccountID,
+			Title:          req.Title,
+			Currency:       req.Currency,
+			OpeningBalance: openingBalance,
+		},
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.AddWalletResponse{
+		Item: WalletToProto(itemDTO),
+	}
+
+	return out, nil
+}
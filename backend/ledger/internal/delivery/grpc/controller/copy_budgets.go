@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/civil"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) CopyBudgets(ctx context.Context, req *desc.CopyBudgetsRequest) (*desc.CopyBudgetsResponse, error) {
+	const op = "CopyBudgets"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	periods := make([]civil.Date, 0, 3)
+
+	for _, item := range []struct {
+		name  string
+		value *desc.DateMonth
+	}{
+		{name: "source_period", value: req.SourcePeriod},
+		{name: "target_period_from", value: req.TargetPeriodFrom},
+		{name: "target_period_to", value: req.TargetPeriodTo},
+	} {
+		if item.value == nil {
+			return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints(item.name+" is required"), "%s.%s", c.pkg, op)
+		}
+
+		period, err := civil.ParseDate(
+			fmt.Sprintf("%04d-%02d-%02d", item.value.Year, item.value.Month, 1),
+		)
+		if err != nil {
+			return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid "+item.name), "%s.%s", c.pkg, op)
+		}
+
+		periods = append(periods, period)
+	}
+
+	result, err := c.budgetFacade.Budget.CopyBudgets(
+		ctx,
+		budgetUC.CopyBudgetsDataInput{
+			AccountID:        authData.AccountID,
+			SourcePeriod:     periods[0],
+			TargetPeriodFrom: periods[1],
+			TargetPeriodTo:   periods[2],
+			Mode:             budgetUC.BudgetCopyMode(req.Mode),
+		},
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.CopyBudgetsResponse{
+		Items:       make([]*desc.Budget, 0, len(result.Items)),
+		Created:     int32(result.Created),
+		Overwritten: int32(result.Overwritten),
+		Skipped:     int32(result.Skipped),
+	}
+
+	for _, item := range result.Items {
+		out.Items = append(out.Items, BudgetToProto(item))
+	}
+
+	return out, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
 	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
 	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
@@ -79,6 +80,16 @@ func (c *controller) CSVExportTransactions(
 		listOptions.FilterOccurredOnTo = &occuredOn
 	}
 
+	if req.FilterWalletId != nil {
+		walletID, err := uuid.Parse(*req.FilterWalletId)
+		if err != nil {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid filter_wallet_id"), "%s.%s", c.pkg, op)
+		}
+
+		listOptions.FilterWalletID = &walletID
+	}
+
 	data, total, err := c.budgetFacade.Transaction.FindPagedListAsCSV(
 		ctx,
 		listOptions,
@@ -3,8 +3,10 @@ package controller
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
 	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
 	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
 )
 
@@ -19,14 +21,34 @@ func (c *controller) CSVImportTransactions(
 		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
 	}
 
-	err := c.budgetFacade.Transaction.ImportTransactionsFromCSV(
+	var importProfileID *uuid.UUID
+	if req.ImportProfileId != nil {
+		value, err := uuid.Parse(*req.ImportProfileId)
+		if err != nil {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid import_profile_id"), "%s.%s", c.pkg, op)
+		}
+
+		importProfileID = &value
+	}
+
+	report, err := c.budgetFacade.Transaction.ImportTransactionsFromCSV(
 		ctx,
-		req.Data,
-		authData.AccountID,
+		budgetUC.ImportTransactionsFromCSVInput{
+			Data:               req.Data,
+			AccountID:          authData.AccountID,
+			Format:             budgetUC.TransactionImportFormat(req.Format),
+			ImportProfileID:    importProfileID,
+			DefaultCategoryID:  req.DefaultCategoryId,
+			Mode:               budgetUC.TransactionImportMode(req.Mode),
+			DryRun:             req.DryRun,
+			DuplicatePolicy:    budgetUC.TransactionImportDuplicatePolicy(req.DuplicatePolicy),
+			MatchToleranceDays: int(req.MatchToleranceDays),
+		},
 	)
 	if err != nil {
 		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
 	}
 
-	return &desc.CSVImportTransactionsResponse{}, nil
+	return TransactionImportReportToProto(report), nil
 }
@@ -25,6 +25,8 @@ func (c *controller) DeleteBudget(ctx context.Context, req *desc.DeleteBudgetReq
 	err = c.budgetFacade.Budget.DeleteBudgetByID(
 		ctx,
 		budgetID,
+		req.GetVersion(),
+		req.Version == nil,
 	)
 	if err != nil {
 		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+	"github.com/samber/lo"
+)
+
+func (c *controller) DeleteCategory(ctx context.Context, req *desc.DeleteCategoryRequest) (*desc.DeleteCategoryResponse, error) {
+	const op = "DeleteCategory"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	if req.Id <= 0 {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("invalid id"), "%s.%s", c.pkg, op)
+	}
+
+	var reassignToID *uint64
+	if req.ReassignToId != nil {
+		if *req.ReassignToId <= 0 {
+			return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("invalid reassign_to_id"), "%s.%s", c.pkg, op)
+		}
+		reassignToID = lo.ToPtr(uint64(*req.ReassignToId))
+	}
+
+	err := c.budgetFacade.Category.DeleteCategoryByID(
+		ctx,
+		uint64(req.Id),
+		reassignToID,
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	return &desc.DeleteCategoryResponse{}, nil
+}
@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) DeleteImportProfile(
+	ctx context.Context,
+	req *desc.DeleteImportProfileRequest,
+) (*desc.DeleteImportProfileResponse, error) {
+	const op = "DeleteImportProfile"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	importProfileID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid id"), "%s.%s", c.pkg, op)
+	}
+
+	err = c.budgetFacade.ImportProfile.DeleteImportProfileByID(
+		ctx,
+		importProfileID,
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	return &desc.DeleteImportProfileResponse{}, nil
+}
@@ -28,6 +28,8 @@ func (c *controller) DeleteTransaction(
 	err = c.budgetFacade.Transaction.DeleteTransactionByID(
 		ctx,
 		transactionID,
+		req.GetVersion(),
+		req.Version == nil,
 	)
 	if err != nil {
 		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
@@ -7,12 +7,46 @@ import (
 	"github.com/samber/lo"
 )
 
+func CategoryToProto(itemDTO *budgetUC.CategoryDTO) *desc.Category {
+	out := &desc.Category{
+		Id:         int64(itemDTO.Category.ID),
+		Title:      itemDTO.Category.Title,
+		IsArchived: itemDTO.Category.IsArchived,
+		CreatedAt:  toProtoTimestamp(&itemDTO.Category.CreatedAt),
+		UpdatedAt:  toProtoTimestamp(&itemDTO.Category.UpdatedAt),
+	}
+
+	if itemDTO.Category.AccountID != nil {
+		out.AccountId = lo.ToPtr(itemDTO.Category.AccountID.String())
+	}
+
+	if itemDTO.Category.ParentID != nil {
+		out.ParentId = lo.ToPtr(int64(*itemDTO.Category.ParentID))
+	}
+
+	return out
+}
+
+func CategoryTreeToProto(tree *budgetEntity.CategoryTree, item *budgetEntity.Category) *desc.Category {
+	out := CategoryToProto(&budgetUC.CategoryDTO{Category: item})
+
+	children := tree.Children(item.ID)
+	out.Children = make([]*desc.Category, 0, len(children))
+
+	for _, child := range children {
+		out.Children = append(out.Children, CategoryTreeToProto(tree, child))
+	}
+
+	return out
+}
+
 func TransactionToProto(itemDTO *budgetUC.TransactionDTO) *desc.Transaction {
-	return &desc.Transaction{
+	out := &desc.Transaction{
 		Id:        itemDTO.Transaction.ID.String(),
 		AccountId: itemDTO.Transaction.AccountID.String(),
 		IsIncome:  itemDTO.Transaction.IsIncome,
 		Amount:    itemDTO.Transaction.Amount.String(),
+		Currency:  itemDTO.Transaction.Currency,
 		OccurredOn: &desc.Date{
 			Year:  int32(itemDTO.Transaction.OccurredOn.Year),
 			Month: int32(itemDTO.Transaction.OccurredOn.Month),
@@ -20,9 +54,31 @@ func TransactionToProto(itemDTO *budgetUC.TransactionDTO) *desc.Transaction {
 		},
 		CategoryId:  int64(itemDTO.Transaction.CategoryID),
 		Description: itemDTO.Transaction.Description,
+		Tags:        itemDTO.Transaction.Tags,
 		CreatedAt:   toProtoTimestamp(&itemDTO.Transaction.CreatedAt),
 		UpdatedAt:   toProtoTimestamp(&itemDTO.Transaction.UpdatedAt),
+		Version:     itemDTO.Transaction.Version(),
 	}
+
+	if itemDTO.Transaction.WalletID != nil {
+		out.WalletId = lo.ToPtr(itemDTO.Transaction.WalletID.String())
+	}
+
+	if itemDTO.Transaction.TransferID != nil {
+		out.TransferId = lo.ToPtr(itemDTO.Transaction.TransferID.String())
+	}
+
+	if itemDTO.Transaction.RecurringRuleID != nil {
+		out.RecurringRuleId = lo.ToPtr(itemDTO.Transaction.RecurringRuleID.String())
+	}
+
+	out.ExternalId = itemDTO.Transaction.ExternalID
+
+	if itemDTO.Transaction.DuplicateOfID != nil {
+		out.DuplicateOfId = lo.ToPtr(itemDTO.Transaction.DuplicateOfID.String())
+	}
+
+	return out
 }
 
 func BudgetToProto(itemDTO *budgetUC.BudgetDTO) *desc.Budget {
@@ -30,16 +86,44 @@ func BudgetToProto(itemDTO *budgetUC.BudgetDTO) *desc.Budget {
 		Id:        itemDTO.Budget.ID.String(),
 		AccountId: itemDTO.Budget.AccountID.String(),
 		Amount:    itemDTO.Budget.Amount.String(),
+		Currency:  itemDTO.Budget.Currency,
 		Period: &desc.DateMonth{
 			Year:  int32(itemDTO.Budget.Period.Year),
 			Month: int32(itemDTO.Budget.Period.Month),
 		},
 		CategoryId: int64(itemDTO.Budget.CategoryID),
+		CarryOver:  itemDTO.Budget.CarryOver,
 		CreatedAt:  toProtoTimestamp(&itemDTO.Budget.CreatedAt),
 		UpdatedAt:  toProtoTimestamp(&itemDTO.Budget.UpdatedAt),
+		Version:    itemDTO.Budget.Version(),
+
+		Enforcement: string(itemDTO.Budget.Enforcement),
+		WarningThresholds: lo.Map(itemDTO.Budget.WarningThresholds, func(item int, _ int) int32 {
+			return int32(item)
+		}),
 	}
 }
 
+func BudgetWarningToProto(warning *budgetEntity.BudgetWarning) *desc.BudgetWarning {
+	out := &desc.BudgetWarning{
+		BudgetId:   warning.BudgetID.String(),
+		CategoryId: int64(warning.CategoryID),
+		Period: &desc.DateMonth{
+			Year:  int32(warning.Period.Year),
+			Month: int32(warning.Period.Month),
+		},
+		Enforcement: string(warning.Enforcement),
+		Threshold:   int32(warning.Threshold),
+		Exceeded:    warning.Exceeded,
+	}
+
+	if warning.SpentPercent != nil {
+		out.SpentPercent = lo.ToPtr(warning.SpentPercent.String())
+	}
+
+	return out
+}
+
 func ReportToProto(itemDTO *budgetEntity.ReportItem) *desc.PeriodReport {
 	periodReport := &desc.PeriodReport{
 		PeriodStart: &desc.Date{
@@ -52,7 +136,8 @@ func ReportToProto(itemDTO *budgetEntity.ReportItem) *desc.PeriodReport {
 			Month: int32(itemDTO.DateTo.Month),
 			Day:   int32(itemDTO.DateTo.Day),
 		},
-		Items: make([]*desc.ReportItem, 0, len(itemDTO.Items)),
+		Items:        make([]*desc.ReportItem, 0, len(itemDTO.Items)),
+		MissingRates: itemDTO.MissingRates,
 	}
 
 	for _, item := range itemDTO.Items {
@@ -73,8 +158,256 @@ func ReportToProto(itemDTO *budgetEntity.ReportItem) *desc.PeriodReport {
 			repItem.ItemBudget = lo.ToPtr(item.BudgetAmount.String())
 		}
 
+		if item.CarriedOver != nil {
+			repItem.CarriedOver = lo.ToPtr(item.CarriedOver.String())
+		}
+
+		if item.EffectiveBudget != nil {
+			repItem.EffectiveBudget = lo.ToPtr(item.EffectiveBudget.String())
+		}
+
+		if item.ParentCategoryID != nil {
+			repItem.ParentCategoryId = lo.ToPtr(int64(*item.ParentCategoryID))
+		}
+
+		if item.TotalSum != nil {
+			repItem.TotalSum = lo.ToPtr(item.TotalSum.String())
+		}
+
+		totalSpentBudget, err := item.TotalSpentBudget()
+		if err == nil && totalSpentBudget != nil {
+			repItem.TotalSpentBudget = lo.ToPtr(totalSpentBudget.String())
+		}
+
+		if item.TotalBudgetAmount != nil {
+			repItem.TotalBudget = lo.ToPtr(item.TotalBudgetAmount.String())
+		}
+
 		periodReport.Items = append(periodReport.Items, repItem)
 	}
 
 	return periodReport
 }
+
+func WalletToProto(itemDTO *budgetUC.WalletDTO) *desc.Wallet {
+	return &desc.Wallet{
+		Id:             itemDTO.Wallet.ID.String(),
+		AccountId:      itemDTO.Wallet.AccountID.String(),
+		Title:          itemDTO.Wallet.Title,
+		Currency:       itemDTO.Wallet.Currency,
+		OpeningBalance: itemDTO.Wallet.OpeningBalance.String(),
+		IsArchived:     itemDTO.Wallet.IsArchived,
+		CreatedAt:      toProtoTimestamp(&itemDTO.Wallet.CreatedAt),
+		UpdatedAt:      toProtoTimestamp(&itemDTO.Wallet.UpdatedAt),
+	}
+}
+
+func WalletBalanceToProto(itemDTO *budgetUC.WalletBalanceDTO) *desc.WalletBalance {
+	return &desc.WalletBalance{
+		Wallet:          WalletToProto(&budgetUC.WalletDTO{Wallet: itemDTO.Wallet}),
+		TransactionsSum: itemDTO.Balance.TransactionsSum.String(),
+		Balance:         itemDTO.Balance.Balance.String(),
+	}
+}
+
+func TransferToProto(itemDTO *budgetUC.TransferDTO) *desc.Transfer {
+	transfer := itemDTO.Transfer
+
+	return &desc.Transfer{
+		Id:           transfer.ID.String(),
+		AccountId:    transfer.AccountID().String(),
+		FromWalletId: transfer.FromWalletID().String(),
+		ToWalletId:   transfer.ToWalletID().String(),
+		Amount:       transfer.Amount().String(),
+		Currency:     transfer.Currency(),
+		OccurredOn: &desc.Date{
+			Year:  int32(transfer.Income.OccurredOn.Year),
+			Month: int32(transfer.Income.OccurredOn.Month),
+			Day:   int32(transfer.Income.OccurredOn.Day),
+		},
+		Description: transfer.Income.Description,
+		CreatedAt:   toProtoTimestamp(&transfer.Income.CreatedAt),
+		UpdatedAt:   toProtoTimestamp(&transfer.Income.UpdatedAt),
+	}
+}
+
+func ExchangeRateToProto(item *budgetEntity.ExchangeRate) *desc.ExchangeRate {
+	return &desc.ExchangeRate{
+		CurrencyFrom: item.CurrencyFrom,
+		CurrencyTo:   item.CurrencyTo,
+		RateDate: &desc.Date{
+			Year:  int32(item.RateDate.Year),
+			Month: int32(item.RateDate.Month),
+			Day:   int32(item.RateDate.Day),
+		},
+		Rate:      item.Rate.String(),
+		CreatedAt: toProtoTimestamp(&item.CreatedAt),
+		UpdatedAt: toProtoTimestamp(&item.UpdatedAt),
+	}
+}
+
+func RecurringRuleToProto(itemDTO *budgetUC.RecurringRuleDTO) *desc.RecurringRule {
+	rule := itemDTO.RecurringRule
+
+	out := &desc.RecurringRule{
+		Id:               rule.ID.String(),
+		AccountId:        rule.AccountID.String(),
+		IsIncome:         rule.IsIncome,
+		Amount:           rule.Amount.String(),
+		Currency:         rule.Currency,
+		CategoryId:       int64(rule.CategoryID),
+		Description:      rule.Description,
+		Frequency:        string(rule.Frequency),
+		Interval:         int32(rule.Interval),
+		DayOfMonth:       int32(rule.DayOfMonth),
+		StartDate:        dateToProto(rule.StartDate),
+		OccurrencesCount: int32(rule.OccurrencesCount),
+		IsPaused:         rule.IsPaused,
+		LastError:        rule.LastError,
+		CreatedAt:        toProtoTimestamp(&rule.CreatedAt),
+		UpdatedAt:        toProtoTimestamp(&rule.UpdatedAt),
+	}
+
+	if rule.WalletID != nil {
+		out.WalletId = lo.ToPtr(rule.WalletID.String())
+	}
+
+	if rule.EndDate != nil {
+		out.EndDate = dateToProto(*rule.EndDate)
+	}
+
+	if rule.Count != nil {
+		out.Count = lo.ToPtr(int32(*rule.Count))
+	}
+
+	if rule.NextOccurrenceOn != nil {
+		out.NextOccurrenceOn = dateToProto(*rule.NextOccurrenceOn)
+	}
+
+	return out
+}
+
+func ImportProfileToProto(itemDTO *budgetUC.ImportProfileDTO) *desc.ImportProfile {
+	item := itemDTO.ImportProfile
+
+	return &desc.ImportProfile{
+		Id:               item.ID.String(),
+		AccountId:        item.AccountID.String(),
+		Name:             item.Name,
+		Delimiter:        item.Delimiter,
+		DecimalSeparator: item.DecimalSeparator,
+		DateLayout:       item.DateLayout,
+		SkipRows:         int32(item.SkipRows),
+		Encoding:         string(item.Encoding),
+		Mapping: &desc.ImportProfileMapping{
+			HasHeader:      item.HasHeader,
+			SignConvention: string(item.SignConvention),
+			IncomeValues:   item.IncomeValues,
+			Columns: &desc.ImportProfileColumns{
+				Date:        item.Columns.Date,
+				Amount:      item.Columns.Amount,
+				Debit:       item.Columns.Debit,
+				Credit:      item.Columns.Credit,
+				IsIncome:    item.Columns.IsIncome,
+				Description: item.Columns.Description,
+				CategoryId:  item.Columns.CategoryID,
+				Currency:    item.Columns.Currency,
+				ExternalId:  item.Columns.ExternalID,
+			},
+			DefaultCategoryId: item.DefaultCategoryID,
+		},
+		CreatedAt: toProtoTimestamp(&item.CreatedAt),
+		UpdatedAt: toProtoTimestamp(&item.UpdatedAt),
+	}
+}
+
+func CategoryRuleToProto(itemDTO *budgetUC.CategoryRuleDTO) *desc.CategoryRule {
+	item := itemDTO.CategoryRule
+
+	conditions := &desc.CategoryRuleConditions{
+		DescriptionContains: item.Conditions.DescriptionContains,
+		DescriptionRegex:    item.Conditions.DescriptionRegex,
+		IsIncome:            item.Conditions.IsIncome,
+	}
+
+	if item.Conditions.AmountMin != nil {
+		conditions.AmountMin = lo.ToPtr(item.Conditions.AmountMin.String())
+	}
+
+	if item.Conditions.AmountMax != nil {
+		conditions.AmountMax = lo.ToPtr(item.Conditions.AmountMax.String())
+	}
+
+	if item.Conditions.WalletID != nil {
+		conditions.WalletId = lo.ToPtr(item.Conditions.WalletID.String())
+	}
+
+	return &desc.CategoryRule{
+		Id:         item.ID.String(),
+		AccountId:  item.AccountID.String(),
+		Name:       item.Name,
+		Priority:   int32(item.Priority),
+		CategoryId: int64(item.CategoryID),
+		Conditions: conditions,
+		CreatedAt:  toProtoTimestamp(&item.CreatedAt),
+		UpdatedAt:  toProtoTimestamp(&item.UpdatedAt),
+	}
+}
+
+func ReapplyCategoryRulesReportToProto(report *budgetUC.ReapplyCategoryRulesReport) *desc.ReapplyRulesResponse {
+	out := &desc.ReapplyRulesResponse{
+		Checked: int32(report.Checked),
+		Changes: make([]*desc.CategoryRuleChange, 0, len(report.Changes)),
+		DryRun:  report.DryRun,
+	}
+
+	for _, change := range report.Changes {
+		out.Changes = append(out.Changes, &desc.CategoryRuleChange{
+			TransactionId: change.TransactionID.String(),
+			RuleId:        change.RuleID.String(),
+			OldCategoryId: int64(change.OldCategoryID),
+			NewCategoryId: int64(change.NewCategoryID),
+		})
+	}
+
+	return out
+}
+
+func TransactionImportReportToProto(report *budgetUC.TransactionImportReport) *desc.CSVImportTransactionsResponse {
+	out := &desc.CSVImportTransactionsResponse{
+		Rows:     make([]*desc.CSVImportRow, 0, len(report.Rows)),
+		Imported: int32(report.Imported),
+		Rejected: int32(report.Rejected),
+		Skipped:  int32(report.Skipped),
+		Updated:  int32(report.Updated),
+		Flagged:  int32(report.Flagged),
+		DryRun:   report.DryRun,
+	}
+
+	for _, row := range report.Rows {
+		item := &desc.CSVImportRow{
+			Line:       int32(row.Line),
+			Status:     string(row.Status),
+			ErrorCodes: row.ErrorCodes,
+			Hints:      row.Hints,
+		}
+
+		if row.MatchedID != nil {
+			item.MatchedTransactionId = lo.ToPtr(row.MatchedID.String())
+		}
+
+		if transaction := row.Transaction; transaction != nil {
+			item.IsIncome = &transaction.IsIncome
+			item.Amount = lo.ToPtr(transaction.Amount.String())
+			item.Currency = &transaction.Currency
+			item.OccurredOn = dateToProto(transaction.OccurredOn)
+			item.CategoryId = &transaction.CategoryID
+			item.Description = &transaction.Description
+			item.ExternalId = transaction.ExternalID
+		}
+
+		out.Rows = append(out.Rows, item)
+	}
+
+	return out
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) ExportReports(
+	ctx context.Context,
+	req *desc.ExportReportsRequest,
+) (*desc.ExportReportsResponse, error) {
+	const op = "ExportReports"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	// пустая валюта - базовая валюта аккаунта, ее определяет usecase
+	queryFilter := budgetUC.CountReportItemsQueryFilter{
+		AccountID: authData.AccountID,
+	}
+
+	if req.Currency != nil {
+		queryFilter.BaseCurrency = *req.Currency
+	}
+
+	if req.DateFrom != nil {
+		date, err := dateFromProto(req.DateFrom)
+		if err != nil {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid date_from"), "%s.%s", c.pkg, op)
+		}
+
+		queryFilter.DateFrom = &date
+	}
+
+	if req.DateTo != nil {
+		date, err := dateFromProto(req.DateTo)
+		if err != nil {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid date_to"), "%s.%s", c.pkg, op)
+		}
+
+		queryFilter.DateTo = &date
+	}
+
+	file, err := c.budgetFacade.Transaction.ExportReports(
+		ctx,
+		exportFormatFromProto(req.Format),
+		queryFilter,
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	return &desc.ExportReportsResponse{
+		Data:          file.Data,
+		ContentType:   file.ContentType,
+		FileExtension: file.FileExtension,
+	}, nil
+}
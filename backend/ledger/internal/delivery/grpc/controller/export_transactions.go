@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) ExportTransactions(
+	ctx context.Context,
+	req *desc.ExportTransactionsRequest,
+) (*desc.ExportTransactionsResponse, error) {
+	const op = "ExportTransactions"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	listOptions := &budgetUC.TransactionListOptions{
+		FilterAccountID: &authData.AccountID,
+	}
+
+	if req.FilterOccurredOnFrom != nil {
+		occuredOn, err := dateFromProto(req.FilterOccurredOnFrom)
+		if err != nil {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid filter_occurred_on_from"), "%s.%s", c.pkg, op)
+		}
+
+		listOptions.FilterOccurredOnFrom = &occuredOn
+	}
+
+	if req.FilterOccurredOnTo != nil {
+		occuredOn, err := dateFromProto(req.FilterOccurredOnTo)
+		if err != nil {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid filter_occurred_on_to"), "%s.%s", c.pkg, op)
+		}
+
+		listOptions.FilterOccurredOnTo = &occuredOn
+	}
+
+	if req.FilterWalletId != nil {
+		walletID, err := uuid.Parse(*req.FilterWalletId)
+		if err != nil {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid filter_wallet_id"), "%s.%s", c.pkg, op)
+		}
+
+		listOptions.FilterWalletID = &walletID
+	}
+
+	file, total, err := c.budgetFacade.Transaction.ExportTransactions(
+		ctx,
+		exportFormatFromProto(req.Format),
+		listOptions,
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	return &desc.ExportTransactionsResponse{
+		Data:          file.Data,
+		ContentType:   file.ContentType,
+		FileExtension: file.FileExtension,
+		Total:         int64(total),
+	}, nil
+}
@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) GetBaseCurrency(
+	ctx context.Context,
+	req *desc.GetBaseCurrencyRequest,
+) (*desc.GetBaseCurrencyResponse, error) {
+	const op = "GetBaseCurrency"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	currency, err := c.budgetFacade.Currency.GetBaseCurrency(ctx, authData.AccountID)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.GetBaseCurrencyResponse{
+		Currency: currency,
+	}
+
+	return out, nil
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) GetWalletBalances(
+	ctx context.Context,
+	req *desc.GetWalletBalancesRequest,
+) (*desc.GetWalletBalancesResponse, error) {
+	const op = "GetWalletBalances"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	queryFilter := budgetUC.CountWalletBalancesQueryFilter{
+		AccountID: authData.AccountID,
+		WalletIDs: make([]uuid.UUID, 0, len(req.WalletIds)),
+	}
+
+	for _, id := range req.WalletIds {
+		walletID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid wallet_ids"), "%s.%s", c.pkg, op)
+		}
+
+		queryFilter.WalletIDs = append(queryFilter.WalletIDs, walletID)
+	}
+
+	if req.DateTo != nil {
+		date, err := civil.ParseDate(
+			fmt.Sprintf(
+				"%04d-%02d-%02d",
+				req.DateTo.Year,
+				req.DateTo.Month,
+				req.DateTo.Day,
+			),
+		)
+		if err != nil {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid date_to"), "%s.%s", c.pkg, op)
+		}
+
+		queryFilter.DateTo = &date
+	}
+
+	items, err := c.budgetFacade.Wallet.GetWalletBalances(ctx, queryFilter)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.GetWalletBalancesResponse{
+		Items: make([]*desc.WalletBalance, 0, len(items)),
+	}
+
+	for _, item := range items {
+		out.Items = append(out.Items, WalletBalanceToProto(item))
+	}
+
+	return out, nil
+}
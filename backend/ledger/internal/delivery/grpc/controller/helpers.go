@@ -1,8 +1,17 @@
 package controller
 
 import (
+	"fmt"
 	"time"
 
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	budgetEntity "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -12,3 +21,156 @@ func toProtoTimestamp(t *time.Time) *timestamppb.Timestamp {
 	}
 	return timestamppb.New(*t)
 }
+
+func dateToProto(d civil.Date) *desc.Date {
+	return &desc.Date{
+		Year:  int32(d.Year),
+		Month: int32(d.Month),
+		Day:   int32(d.Day),
+	}
+}
+
+func dateFromProto(d *desc.Date) (civil.Date, error) {
+	return civil.ParseDate(fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day))
+}
+
+func importProfileMappingFromProto(mapping *desc.ImportProfileMapping) *budgetUC.ImportProfileMappingInput {
+	if mapping == nil {
+		return &budgetUC.ImportProfileMappingInput{}
+	}
+
+	out := &budgetUC.ImportProfileMappingInput{
+		HasHeader:         mapping.HasHeader,
+		SignConvention:    budgetEntity.ImportSignConvention(mapping.SignConvention),
+		IncomeValues:      mapping.IncomeValues,
+		DefaultCategoryID: mapping.DefaultCategoryId,
+	}
+
+	if columns := mapping.GetColumns(); columns != nil {
+		out.Columns = budgetEntity.ImportColumns{
+			Date:        columns.Date,
+			Amount:      columns.Amount,
+			Debit:       columns.Debit,
+			Credit:      columns.Credit,
+			IsIncome:    columns.IsIncome,
+			Description: columns.Description,
+			CategoryID:  columns.CategoryId,
+			Currency:    columns.Currency,
+			ExternalID:  columns.ExternalId,
+		}
+	}
+
+	return out
+}
+
+// categoryRuleConditionsFromProto - пустые значения означают незаданное условие
+func categoryRuleConditionsFromProto(conditions *desc.CategoryRuleConditions) (budgetEntity.CategoryRuleConditions, error) {
+	out := budgetEntity.CategoryRuleConditions{}
+
+	if conditions == nil {
+		return out, nil
+	}
+
+	out.DescriptionContains = conditions.DescriptionContains
+	out.DescriptionRegex = conditions.DescriptionRegex
+	out.IsIncome = conditions.IsIncome
+
+	if conditions.AmountMin != nil && *conditions.AmountMin != "" {
+		amount, err := decimal.Parse(*conditions.AmountMin)
+		if err != nil {
+			return out, appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid amount_min")
+		}
+
+		out.AmountMin = &amount
+	}
+
+	if conditions.AmountMax != nil && *conditions.AmountMax != "" {
+		amount, err := decimal.Parse(*conditions.AmountMax)
+		if err != nil {
+			return out, appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid amount_max")
+		}
+
+		out.AmountMax = &amount
+	}
+
+	if conditions.WalletId != nil && *conditions.WalletId != "" {
+		walletID, err := uuid.Parse(*conditions.WalletId)
+		if err != nil {
+			return out, appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid wallet_id")
+		}
+
+		out.WalletID = &walletID
+	}
+
+	return out, nil
+}
+
+// exportFormatFromProto - неизвестное значение enum возвращается как есть и отклоняется usecase
+func exportFormatFromProto(format desc.ExportFormat) budgetUC.ExportFormat {
+	switch format {
+	case desc.ExportFormat_EXPORT_FORMAT_UNSPECIFIED, desc.ExportFormat_EXPORT_FORMAT_CSV:
+		return budgetUC.ExportFormatCSV
+	case desc.ExportFormat_EXPORT_FORMAT_XLSX:
+		return budgetUC.ExportFormatXLSX
+	case desc.ExportFormat_EXPORT_FORMAT_JSONL:
+		return budgetUC.ExportFormatJSONL
+	}
+
+	return budgetUC.ExportFormat(format.String())
+}
+
+// transactionListSortFromProto - при равенстве основного поля порядок стабилизируется датой, временем создания и id
+func transactionListSortFromProto(
+	sort desc.TransactionListSort,
+) ([]uctypes.SortOption[budgetUC.TransactionListOptionsSortField], error) {
+	var first uctypes.SortOption[budgetUC.TransactionListOptionsSortField]
+
+	switch sort {
+	case desc.TransactionListSort_TRANSACTION_LIST_SORT_UNSPECIFIED, desc.TransactionListSort_TRANSACTION_LIST_SORT_OCCURRED_ON_DESC:
+		first = uctypes.SortOption[budgetUC.TransactionListOptionsSortField]{
+			Field:  budgetUC.TransactionListOptionsSortFieldOccurredOn,
+			IsDesc: true,
+		}
+	case desc.TransactionListSort_TRANSACTION_LIST_SORT_OCCURRED_ON_ASC:
+		first = uctypes.SortOption[budgetUC.TransactionListOptionsSortField]{
+			Field: budgetUC.TransactionListOptionsSortFieldOccurredOn,
+		}
+	case desc.TransactionListSort_TRANSACTION_LIST_SORT_AMOUNT_DESC:
+		first = uctypes.SortOption[budgetUC.TransactionListOptionsSortField]{
+			Field:  budgetUC.TransactionListOptionsSortFieldAmount,
+			IsDesc: true,
+		}
+	case desc.TransactionListSort_TRANSACTION_LIST_SORT_AMOUNT_ASC:
+		first = uctypes.SortOption[budgetUC.TransactionListOptionsSortField]{
+			Field: budgetUC.TransactionListOptionsSortFieldAmount,
+		}
+	default:
+		return nil, appErrors.ErrBadRequest.WithHints("invalid sort")
+	}
+
+	out := []uctypes.SortOption[budgetUC.TransactionListOptionsSortField]{first}
+
+	// при сортировке по сумме равные суммы идут от новых к старым
+	tieIsDesc := first.IsDesc
+
+	if first.Field != budgetUC.TransactionListOptionsSortFieldOccurredOn {
+		tieIsDesc = true
+		out = append(out, uctypes.SortOption[budgetUC.TransactionListOptionsSortField]{
+			Field:  budgetUC.TransactionListOptionsSortFieldOccurredOn,
+			IsDesc: true,
+		})
+	}
+
+	out = append(out,
+		uctypes.SortOption[budgetUC.TransactionListOptionsSortField]{
+			Field:  budgetUC.TransactionListOptionsSortFieldCreatedAt,
+			IsDesc: tieIsDesc,
+		},
+		uctypes.SortOption[budgetUC.TransactionListOptionsSortField]{
+			Field:  budgetUC.TransactionListOptionsSortFieldID,
+			IsDesc: tieIsDesc,
+		},
+	)
+
+	return out, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
 	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
 	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+	"github.com/samber/lo"
 )
 
 func (c *controller) ListBudgets(
@@ -75,6 +76,10 @@ func (c *controller) ListBudgets(
 		listOptions.FilterPeriodTo = &occuredOn
 	}
 
+	if req.Cursor != nil {
+		return c.listBudgetsByCursor(ctx, req, listOptions)
+	}
+
 	items, total, hitCache, err := c.budgetFacade.Budget.FindPagedList(
 		ctx,
 		listOptions,
@@ -89,7 +94,7 @@ func (c *controller) ListBudgets(
 
 	out := &desc.ListBudgetsResponse{
 		Items:    make([]*desc.Budget, 0, len(items)),
-		Total:    int64(total),
+		Total:    lo.ToPtr(int64(total)),
 		HitCache: hitCache,
 	}
 
@@ -99,3 +104,45 @@ func (c *controller) ListBudgets(
 
 	return out, nil
 }
+
+// listBudgetsByCursor - keyset-пагинация в порядке (period, id) по убыванию
+func (c *controller) listBudgetsByCursor(
+	ctx context.Context,
+	req *desc.ListBudgetsRequest,
+	listOptions *budgetUC.BudgetListOptions,
+) (*desc.ListBudgetsResponse, error) {
+	const op = "listBudgetsByCursor"
+
+	if *req.Cursor != "" {
+		cursor, err := budgetUC.ParseBudgetListCursor(*req.Cursor)
+		if err != nil {
+			return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+		}
+
+		listOptions.FilterBefore = cursor
+	}
+
+	page, err := c.budgetFacade.Budget.FindCursorList(ctx, listOptions, uint64(req.Limit), req.WithTotal)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.ListBudgetsResponse{
+		Items:    make([]*desc.Budget, 0, len(page.Items)),
+		HitCache: page.HitCache,
+	}
+
+	if page.Total != nil {
+		out.Total = lo.ToPtr(int64(*page.Total))
+	}
+
+	if page.NextCursor != nil {
+		out.NextCursor = page.NextCursor.Token()
+	}
+
+	for _, item := range page.Items {
+		out.Items = append(out.Items, BudgetToProto(item))
+	}
+
+	return out, nil
+}
@@ -3,8 +3,10 @@ package controller
 import (
 	"context"
 
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
 	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	budgetEntity "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
 	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
 )
@@ -12,9 +14,16 @@ import (
 func (c *controller) ListCategories(ctx context.Context, req *desc.ListCategoriesRequest) (*desc.ListCategoriesResponse, error) {
 	const op = "ListCategories"
 
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
 	items, err := c.budgetFacade.Category.FindList(
 		ctx,
 		&budgetUC.CategoryListOptions{
+			FilterAvailableForAccountID: &authData.AccountID,
+			FilterIsArchived:            req.FilterIsArchived,
 			Sort: []uctypes.SortOption[budgetUC.CategoryListOptionsSortField]{
 				{
 					Field:  budgetUC.CategoryListOptionsSortFieldID,
@@ -28,15 +37,20 @@ func (c *controller) ListCategories(ctx context.Context, req *desc.ListCategorie
 		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
 	}
 
+	categories := make([]*budgetEntity.Category, 0, len(items))
+	for _, item := range items {
+		categories = append(categories, item.Category)
+	}
+
+	tree := budgetEntity.NewCategoryTree(categories)
+	roots := tree.Roots()
+
 	out := &desc.ListCategoriesResponse{
-		Items: make([]*desc.Category, 0, len(items)),
+		Items: make([]*desc.Category, 0, len(roots)),
 	}
 
-	for _, item := range items {
-		out.Items = append(out.Items, &desc.Category{
-			Id:    int64(item.Category.ID),
-			Title: item.Category.Title,
-		})
+	for _, item := range roots {
+		out.Items = append(out.Items, CategoryTreeToProto(tree, item))
 	}
 
 	return out, nil
@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) ListCategoryRules(
+	ctx context.Context,
+	_ *desc.ListCategoryRulesRequest,
+) (*desc.ListCategoryRulesResponse, error) {
+	const op = "ListCategoryRules"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	items, err := c.budgetFacade.CategoryRule.FindList(
+		ctx,
+		&budgetUC.CategoryRuleListOptions{
+			FilterAccountID: &authData.AccountID,
+			Sort: []uctypes.SortOption[budgetUC.CategoryRuleListOptionsSortField]{
+				{
+					Field:  budgetUC.CategoryRuleListOptionsSortFieldPriority,
+					IsDesc: false,
+				},
+				{
+					Field:  budgetUC.CategoryRuleListOptionsSortFieldCreatedAt,
+					IsDesc: false,
+				},
+			},
+		},
+		nil,
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.ListCategoryRulesResponse{
+		Items: make([]*desc.CategoryRule, 0, len(items)),
+	}
+
+	for _, item := range items {
+		out.Items = append(out.Items, CategoryRuleToProto(item))
+	}
+
+	return out, nil
+}
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/civil"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+	"github.com/samber/lo"
+)
+
+func (c *controller) ListExchangeRates(
+	ctx context.Context,
+	req *desc.ListExchangeRatesRequest,
+) (*desc.ListExchangeRatesResponse, error) {
+	const op = "ListExchangeRates"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	listOptions := &budgetUC.ExchangeRateListOptions{
+		FilterAccountID: &authData.AccountID,
+		Sort: []uctypes.SortOption[budgetUC.ExchangeRateListOptionsSortField]{
+			{
+				Field:  budgetUC.ExchangeRateListOptionsSortFieldRateDate,
+				IsDesc: true,
+			},
+		},
+	}
+
+	if req.FilterCurrency != nil {
+		listOptions.FilterCurrency = lo.ToPtr(*req.FilterCurrency)
+	}
+
+	if req.FilterRateDateFrom != nil {
+		date, err := civil.ParseDate(
+			fmt.Sprintf(
+				"%04d-%02d-%02d",
+				req.FilterRateDateFrom.Year,
+				req.FilterRateDateFrom.Month,
+				req.FilterRateDateFrom.Day,
+			),
+		)
+		if err != nil {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid filter_rate_date_from"), "%s.%s", c.pkg, op)
+		}
+
+		listOptions.FilterRateDateFrom = &date
+	}
+
+	if req.FilterRateDateTo != nil {
+		date, err := civil.ParseDate(
+			fmt.Sprintf(
+				"%04d-%02d-%02d",
+				req.FilterRateDateTo.Year,
+				req.FilterRateDateTo.Month,
+				req.FilterRateDateTo.Day,
+			),
+		)
+		if err != nil {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid filter_rate_date_to"), "%s.%s", c.pkg, op)
+		}
+
+		listOptions.FilterRateDateTo = &date
+	}
+
+	items, err := c.budgetFacade.Currency.FindExchangeRates(ctx, listOptions, nil)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.ListExchangeRatesResponse{
+		Items: make([]*desc.ExchangeRate, 0, len(items)),
+	}
+
+	for _, item := range items {
+		out.Items = append(out.Items, ExchangeRateToProto(item))
+	}
+
+	return out, nil
+}
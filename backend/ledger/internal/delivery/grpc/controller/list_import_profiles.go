@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) ListImportProfiles(
+	ctx context.Context,
+	_ *desc.ListImportProfilesRequest,
+) (*desc.ListImportProfilesResponse, error) {
+	const op = "ListImportProfiles"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	items, err := c.budgetFacade.ImportProfile.FindList(
+		ctx,
+		&budgetUC.ImportProfileListOptions{
+			FilterAccountID: &authData.AccountID,
+			Sort: []uctypes.SortOption[budgetUC.ImportProfileListOptionsSortField]{
+				{
+					Field:  budgetUC.ImportProfileListOptionsSortFieldName,
+					IsDesc: false,
+				},
+			},
+		},
+		nil,
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.ListImportProfilesResponse{
+		Items: make([]*desc.ImportProfile, 0, len(items)),
+	}
+
+	for _, item := range items {
+		out.Items = append(out.Items, ImportProfileToProto(item))
+	}
+
+	return out, nil
+}
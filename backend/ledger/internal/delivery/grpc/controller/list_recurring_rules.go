@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) ListRecurringRules(
+	ctx context.Context,
+	req *desc.ListRecurringRulesRequest,
+) (*desc.ListRecurringRulesResponse, error) {
+	const op = "ListRecurringRules"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	items, err := c.budgetFacade.RecurringRule.FindList(
+		ctx,
+		&budgetUC.RecurringRuleListOptions{
+			FilterAccountID: &authData.AccountID,
+			FilterIsPaused:  req.FilterIsPaused,
+			Sort: []uctypes.SortOption[budgetUC.RecurringRuleListOptionsSortField]{
+				{
+					Field:  budgetUC.RecurringRuleListOptionsSortFieldCreatedAt,
+					IsDesc: false,
+				},
+			},
+		},
+		nil,
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.ListRecurringRulesResponse{
+		Items: make([]*desc.RecurringRule, 0, len(items)),
+	}
+
+	for _, item := range items {
+		out.Items = append(out.Items, RecurringRuleToProto(item))
+	}
+
+	return out, nil
+}
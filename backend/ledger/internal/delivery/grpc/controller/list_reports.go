@@ -26,6 +26,17 @@ func (c *controller) ListReports(
 		AccountID: authData.AccountID,
 	}
 
+	if req.Currency != nil {
+		queryFilter.BaseCurrency = *req.Currency
+	} else {
+		baseCurrency, err := c.budgetFacade.Currency.GetBaseCurrency(ctx, authData.AccountID)
+		if err != nil {
+			return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+		}
+
+		queryFilter.BaseCurrency = baseCurrency
+	}
+
 	if req.DateFrom != nil {
 		date, err := civil.ParseDate(
 			fmt.Sprintf(
@@ -71,6 +82,11 @@ func (c *controller) ListReports(
 	out := &desc.ListReportsResponse{
 		Reports:  make([]*desc.PeriodReport, 0, len(items)),
 		HitCache: hitCache,
+		Currency: queryFilter.BaseCurrency,
+	}
+
+	if len(items) > 0 {
+		out.Currency = items[0].Currency
 	}
 
 	for _, item := range items {
@@ -3,13 +3,18 @@ package controller
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
 	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
 	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	budgetEntity "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
 	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+	"github.com/samber/lo"
 )
 
 func (c *controller) ListTransactions(
@@ -31,17 +36,13 @@ func (c *controller) ListTransactions(
 		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("limit must be <= 100"), "%s.%s", c.pkg, op)
 	}
 
+	sort, err := transactionListSortFromProto(req.Sort)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
 	listOptions := &budgetUC.TransactionListOptions{
-		Sort: []uctypes.SortOption[budgetUC.TransactionListOptionsSortField]{
-			{
-				Field:  budgetUC.TransactionListOptionsSortFieldOccurredOn,
-				IsDesc: true,
-			},
-			{
-				Field:  budgetUC.TransactionListOptionsSortFieldCreatedAt,
-				IsDesc: true,
-			},
-		},
+		Sort:            sort,
 		FilterAccountID: &authData.AccountID,
 	}
 
@@ -79,6 +80,68 @@ func (c *controller) ListTransactions(
 		listOptions.FilterOccurredOnTo = &occuredOn
 	}
 
+	if req.FilterWalletId != nil {
+		walletID, err := uuid.Parse(*req.FilterWalletId)
+		if err != nil {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid filter_wallet_id"), "%s.%s", c.pkg, op)
+		}
+
+		listOptions.FilterWalletID = &walletID
+	}
+
+	if len(req.FilterCategoryIds) > 0 {
+		listOptions.FilterCategoryIDs = make([]uint64, 0, len(req.FilterCategoryIds))
+		for _, categoryID := range req.FilterCategoryIds {
+			listOptions.FilterCategoryIDs = append(listOptions.FilterCategoryIDs, uint64(categoryID))
+		}
+	}
+
+	listOptions.FilterIsIncome = req.FilterIsIncome
+
+	if req.FilterAmountMin != nil {
+		amountMin, err := decimal.Parse(*req.FilterAmountMin)
+		if err != nil || amountMin.Sign() < 0 {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithHints("invalid filter_amount_min"), "%s.%s", c.pkg, op)
+		}
+
+		listOptions.FilterAmountMin = &amountMin
+	}
+
+	if req.FilterAmountMax != nil {
+		amountMax, err := decimal.Parse(*req.FilterAmountMax)
+		if err != nil || amountMax.Sign() < 0 {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithHints("invalid filter_amount_max"), "%s.%s", c.pkg, op)
+		}
+
+		listOptions.FilterAmountMax = &amountMax
+	}
+
+	if listOptions.FilterAmountMin != nil && listOptions.FilterAmountMax != nil &&
+		listOptions.FilterAmountMin.Cmp(*listOptions.FilterAmountMax) > 0 {
+		return nil, appErrors.Chainf(
+			appErrors.ErrBadRequest.WithHints("filter_amount_min is greater than filter_amount_max"), "%s.%s", c.pkg, op)
+	}
+
+	if req.FilterSearch != nil && strings.TrimSpace(*req.FilterSearch) != "" {
+		listOptions.FilterSearch = lo.ToPtr(strings.TrimSpace(*req.FilterSearch))
+	}
+
+	if len(req.FilterTags) > 0 {
+		tags, err := budgetEntity.NormalizeTransactionTags(req.FilterTags)
+		if err != nil {
+			return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+		}
+
+		listOptions.FilterTags = tags
+	}
+
+	if req.Cursor != nil {
+		return c.listTransactionsByCursor(ctx, req, listOptions)
+	}
+
 	items, total, err := c.budgetFacade.Transaction.FindPagedList(
 		ctx,
 		listOptions,
@@ -93,7 +156,7 @@ func (c *controller) ListTransactions(
 
 	out := &desc.ListTransactionsResponse{
 		Items: make([]*desc.Transaction, 0, len(items)),
-		Total: int64(total),
+		Total: lo.ToPtr(int64(total)),
 	}
 
 	for _, item := range items {
@@ -102,3 +165,50 @@ func (c *controller) ListTransactions(
 
 	return out, nil
 }
+
+// listTransactionsByCursor - keyset-пагинация, порядок страниц фиксирован курсором
+func (c *controller) listTransactionsByCursor(
+	ctx context.Context,
+	req *desc.ListTransactionsRequest,
+	listOptions *budgetUC.TransactionListOptions,
+) (*desc.ListTransactionsResponse, error) {
+	const op = "listTransactionsByCursor"
+
+	if req.Sort != desc.TransactionListSort_TRANSACTION_LIST_SORT_UNSPECIFIED &&
+		req.Sort != desc.TransactionListSort_TRANSACTION_LIST_SORT_OCCURRED_ON_DESC {
+		return nil, appErrors.Chainf(
+			appErrors.ErrBadRequest.WithHints("cursor pagination supports only occurred_on desc sort"), "%s.%s", c.pkg, op)
+	}
+
+	if *req.Cursor != "" {
+		cursor, err := budgetUC.ParseTransactionListCursor(*req.Cursor)
+		if err != nil {
+			return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+		}
+
+		listOptions.FilterBefore = cursor
+	}
+
+	page, err := c.budgetFacade.Transaction.FindCursorList(ctx, listOptions, uint64(req.Limit), req.WithTotal)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.ListTransactionsResponse{
+		Items: make([]*desc.Transaction, 0, len(page.Items)),
+	}
+
+	if page.Total != nil {
+		out.Total = lo.ToPtr(int64(*page.Total))
+	}
+
+	if page.NextCursor != nil {
+		out.NextCursor = page.NextCursor.Token()
+	}
+
+	for _, item := range page.Items {
+		out.Items = append(out.Items, TransactionToProto(item))
+	}
+
+	return out, nil
+}
@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) ListWallets(ctx context.Context, req *desc.ListWalletsRequest) (*desc.ListWalletsResponse, error) {
+	const op = "ListWallets"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	items, err := c.budgetFacade.Wallet.FindList(
+		ctx,
+		&budgetUC.WalletListOptions{
+			FilterAccountID:  &authData.AccountID,
+			FilterIsArchived: req.FilterIsArchived,
+			Sort: []uctypes.SortOption[budgetUC.WalletListOptionsSortField]{
+				{
+					Field:  budgetUC.WalletListOptionsSortFieldCreatedAt,
+					IsDesc: false,
+				},
+			},
+		},
+		nil,
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.ListWalletsResponse{
+		Items: make([]*desc.Wallet, 0, len(items)),
+	}
+
+	for _, item := range items {
+		out.Items = append(out.Items, WalletToProto(item))
+	}
+
+	return out, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
 	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	budgetEntity "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
 	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
 	"github.com/samber/lo"
@@ -28,7 +29,9 @@ func (c *controller) PatchBudget(ctx context.Context, req *desc.PatchBudgetReque
 		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid id"), "%s.%s", c.pkg, op)
 	}
 
-	patch := budgetUC.PatchBudgetDataInput{}
+	patch := budgetUC.PatchBudgetDataInput{
+		Version: req.GetVersion(),
+	}
 
 	if req.Amount != nil {
 		amount, err := decimal.Parse(*req.Amount)
@@ -51,15 +54,33 @@ func (c *controller) PatchBudget(ctx context.Context, req *desc.PatchBudgetReque
 		patch.Period = &period
 	}
 
+	if req.Currency != nil {
+		patch.Currency = lo.ToPtr(*req.Currency)
+	}
+
 	if req.CategoryId != nil {
 		patch.CategoryID = lo.ToPtr(uint64(*req.CategoryId))
 	}
 
+	if req.CarryOver != nil {
+		patch.CarryOver = lo.ToPtr(*req.CarryOver)
+	}
+
+	if req.Enforcement != nil {
+		patch.Enforcement = lo.ToPtr(budgetEntity.BudgetEnforcement(*req.Enforcement))
+	}
+
+	if req.WarningThresholds != nil {
+		patch.WarningThresholds = lo.ToPtr(lo.Map(req.WarningThresholds.Items, func(item int32, _ int) int {
+			return int(item)
+		}))
+	}
+
 	err = c.budgetFacade.Budget.PatchBudgetByDTO(
 		ctx,
 		budgetID,
 		patch,
-		true,
+		req.Version == nil,
 	)
 	if err != nil {
 		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
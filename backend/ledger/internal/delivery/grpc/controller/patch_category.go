@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+	"github.com/samber/lo"
+)
+
+func (c *controller) PatchCategory(ctx context.Context, req *desc.PatchCategoryRequest) (*desc.PatchCategoryResponse, error) {
+	const op = "PatchCategory"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	if req.Id <= 0 {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("invalid id"), "%s.%s", c.pkg, op)
+	}
+
+	categoryID := uint64(req.Id)
+
+	var parentID *uint64
+	if req.ParentId != nil {
+		if *req.ParentId < 0 {
+			return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("invalid parent_id"), "%s.%s", c.pkg, op)
+		}
+
+		parentID = lo.ToPtr(uint64(*req.ParentId))
+	}
+
+	err := c.budgetFacade.Category.PatchCategoryByDTO(
+		ctx,
+		categoryID,
+		budgetUC.PatchCategoryDataInput{
+			Title:      req.Title,
+			IsArchived: req.IsArchived,
+			ParentID:   parentID,
+		},
+		true,
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	itemDTO, err := c.budgetFacade.Category.FindOneByID(ctx, categoryID, nil)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.PatchCategoryResponse{
+		Item: CategoryToProto(itemDTO),
+	}
+
+	return out, nil
+}
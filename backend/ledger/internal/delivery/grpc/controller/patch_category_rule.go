@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+	"github.com/samber/lo"
+)
+
+func (c *controller) PatchCategoryRule(
+	ctx context.Context,
+	req *desc.PatchCategoryRuleRequest,
+) (*desc.PatchCategoryRuleResponse, error) {
+	const op = "PatchCategoryRule"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	categoryRuleID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid id"), "%s.%s", c.pkg, op)
+	}
+
+	patch := budgetUC.PatchCategoryRuleDataInput{
+		Name: req.Name,
+	}
+
+	if req.Priority != nil {
+		patch.Priority = lo.ToPtr(int(*req.Priority))
+	}
+
+	if req.CategoryId != nil {
+		patch.CategoryID = lo.ToPtr(uint64(*req.CategoryId))
+	}
+
+	if req.Conditions != nil {
+		conditions, err := categoryRuleConditionsFromProto(req.Conditions)
+		if err != nil {
+			return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+		}
+
+		patch.Conditions = &conditions
+	}
+
+	err = c.budgetFacade.CategoryRule.PatchCategoryRuleByDTO(
+		ctx,
+		categoryRuleID,
+		patch,
+		true,
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	itemDTO, err := c.budgetFacade.CategoryRule.FindOneByID(ctx, categoryRuleID, nil)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.PatchCategoryRuleResponse{
+		Item: CategoryRuleToProto(itemDTO),
+	}
+
+	return out, nil
+}
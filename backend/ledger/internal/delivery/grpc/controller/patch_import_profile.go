@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetEntity "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) PatchImportProfile(
+	ctx context.Context,
+	req *desc.PatchImportProfileRequest,
+) (*desc.PatchImportProfileResponse, error) {
+	const op = "PatchImportProfile"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	importProfileID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid id"), "%s.%s", c.pkg, op)
+	}
+
+	patch := budgetUC.PatchImportProfileDataInput{
+		Name:             req.Name,
+		Delimiter:        req.Delimiter,
+		DecimalSeparator: req.DecimalSeparator,
+		DateLayout:       req.DateLayout,
+	}
+
+	if req.SkipRows != nil {
+		skipRows := int(*req.SkipRows)
+		patch.SkipRows = &skipRows
+	}
+
+	if req.Encoding != nil {
+		encoding := budgetEntity.ImportEncoding(*req.Encoding)
+		patch.Encoding = &encoding
+	}
+
+	if req.Mapping != nil {
+		patch.Mapping = importProfileMappingFromProto(req.Mapping)
+	}
+
+	err = c.budgetFacade.ImportProfile.PatchImportProfileByDTO(
+		ctx,
+		importProfileID,
+		patch,
+		true,
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	itemDTO, err := c.budgetFacade.ImportProfile.FindOneByID(ctx, importProfileID, nil)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.PatchImportProfileResponse{
+		Item: ImportProfileToProto(itemDTO),
+	}
+
+	return out, nil
+}
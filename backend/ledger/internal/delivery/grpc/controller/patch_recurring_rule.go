@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"context"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+	"github.com/samber/lo"
+)
+
+func (c *controller) PatchRecurringRule(
+	ctx context.Context,
+	req *desc.PatchRecurringRuleRequest,
+) (*desc.PatchRecurringRuleResponse, error) {
+	const op = "PatchRecurringRule"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	ruleID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid id"), "%s.%s", c.pkg, op)
+	}
+
+	patch := budgetUC.PatchRecurringRuleDataInput{
+		Currency:    req.Currency,
+		Description: req.Description,
+		IsPaused:    req.IsPaused,
+	}
+
+	if req.Amount != nil {
+		amount, err := decimal.Parse(*req.Amount)
+		if err != nil {
+			return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid amount"), "%s.%s", c.pkg, op)
+		}
+
+		patch.Amount = &amount
+	}
+
+	if req.CategoryId != nil {
+		patch.CategoryID = lo.ToPtr(uint64(*req.CategoryId))
+	}
+
+	if req.WalletId != nil {
+		walletID := uuid.Nil
+		if *req.WalletId != "" {
+			walletID, err = uuid.Parse(*req.WalletId)
+			if err != nil {
+				return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid wallet_id"), "%s.%s", c.pkg, op)
+			}
+		}
+
+		patch.WalletID = &walletID
+	}
+
+	if req.EndDate != nil {
+		endDate := civil.Date{}
+		if req.EndDate.Year != 0 || req.EndDate.Month != 0 || req.EndDate.Day != 0 {
+			endDate, err = dateFromProto(req.EndDate)
+			if err != nil {
+				return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid end_date"), "%s.%s", c.pkg, op)
+			}
+		}
+
+		patch.EndDate = &endDate
+	}
+
+	if req.Count != nil {
+		patch.Count = lo.ToPtr(int(*req.Count))
+	}
+
+	err = c.budgetFacade.RecurringRule.PatchRecurringRuleByDTO(
+		ctx,
+		ruleID,
+		patch,
+		true,
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	itemDTO, err := c.budgetFacade.RecurringRule.FindOneByID(ctx, ruleID, nil)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.PatchRecurringRuleResponse{
+		Item: RecurringRuleToProto(itemDTO),
+	}
+
+	return out, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/govalues/decimal"
 	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
 	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetEntity "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
 	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
 	"github.com/samber/lo"
@@ -30,7 +31,9 @@ func (c *controller) PatchTransaction(
 		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid id"), "%s.%s", c.pkg, op)
 	}
 
-	patch := budgetUC.PatchTransactionDataInput{}
+	patch := budgetUC.PatchTransactionDataInput{
+		Version: req.GetVersion(),
+	}
 
 	if req.Amount != nil {
 		amount, err := decimal.Parse(*req.Amount)
@@ -41,6 +44,10 @@ func (c *controller) PatchTransaction(
 		patch.Amount = &amount
 	}
 
+	if req.Currency != nil {
+		patch.Currency = lo.ToPtr(*req.Currency)
+	}
+
 	if req.OccurredOn != nil {
 		occuredOn, err := civil.ParseDate(
 			fmt.Sprintf("%04d-%02d-%02d", req.OccurredOn.Year, req.OccurredOn.Month, req.OccurredOn.Day),
@@ -61,11 +68,28 @@ func (c *controller) PatchTransaction(
 		patch.Description = lo.ToPtr(*req.Description)
 	}
 
-	err = c.budgetFacade.Transaction.PatchTransactionByDTO(
+	if req.Tags != nil {
+		patch.Tags = &req.Tags.Values
+	}
+
+	if req.WalletId != nil {
+		if *req.WalletId == "" {
+			patch.WalletID = lo.ToPtr(uuid.Nil)
+		} else {
+			walletID, err := uuid.Parse(*req.WalletId)
+			if err != nil {
+				return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid wallet_id"), "%s.%s", c.pkg, op)
+			}
+
+			patch.WalletID = &walletID
+		}
+	}
+
+	warnings, err := c.budgetFacade.Transaction.PatchTransactionByDTO(
 		ctx,
 		transactionID,
 		patch,
-		true,
+		req.Version == nil,
 	)
 	if err != nil {
 		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
@@ -78,6 +102,9 @@ func (c *controller) PatchTransaction(
 
 	out := &desc.PatchTransactionResponse{
 		Item: TransactionToProto(itemDTO),
+		Warnings: lo.Map(warnings, func(item *budgetEntity.BudgetWarning, _ int) *desc.BudgetWarning {
+			return BudgetWarningToProto(item)
+		}),
 	}
 
 	return out, nil
@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) PatchWallet(ctx context.Context, req *desc.PatchWalletRequest) (*desc.PatchWalletResponse, error) {
+	const op = "PatchWallet"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	walletID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid id"), "%s.%s", c.pkg, op)
+	}
+
+	patch := budgetUC.PatchWalletDataInput{
+		Title:      req.Title,
+		IsArchived: req.IsArchived,
+	}
+
+	if req.OpeningBalance != nil {
+		openingBalance, err := decimal.Parse(*req.OpeningBalance)
+		if err != nil {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid opening_balance"), "%s.%s", c.pkg, op)
+		}
+
+		patch.OpeningBalance = &openingBalance
+	}
+
+	err = c.budgetFacade.Wallet.PatchWalletByDTO(
+		ctx,
+		walletID,
+		patch,
+		true,
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	itemDTO, err := c.budgetFacade.Wallet.FindOneByID(ctx, walletID, nil)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.PatchWalletResponse{
+		Item: WalletToProto(itemDTO),
+	}
+
+	return out, nil
+}
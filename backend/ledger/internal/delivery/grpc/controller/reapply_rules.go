@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) ReapplyRules(
+	ctx context.Context,
+	req *desc.ReapplyRulesRequest,
+) (*desc.ReapplyRulesResponse, error) {
+	const op = "ReapplyRules"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	if req.DateFrom == nil || req.DateTo == nil {
+		return nil, appErrors.Chainf(
+			appErrors.ErrBadRequest.WithHints("date_from and date_to are required"), "%s.%s", c.pkg, op)
+	}
+
+	dateFrom, err := dateFromProto(req.DateFrom)
+	if err != nil {
+		return nil, appErrors.Chainf(
+			appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid date_from"), "%s.%s", c.pkg, op)
+	}
+
+	dateTo, err := dateFromProto(req.DateTo)
+	if err != nil {
+		return nil, appErrors.Chainf(
+			appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid date_to"), "%s.%s", c.pkg, op)
+	}
+
+	report, err := c.budgetFacade.Transaction.ReapplyCategoryRules(
+		ctx,
+		budgetUC.ReapplyCategoryRulesInput{
+			AccountID: authData.AccountID,
+			DateFrom:  dateFrom,
+			DateTo:    dateTo,
+			DryRun:    req.DryRun,
+		},
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	return ReapplyCategoryRulesReportToProto(report), nil
+}
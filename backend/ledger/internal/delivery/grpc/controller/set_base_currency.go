@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) SetBaseCurrency(
+	ctx context.Context,
+	req *desc.SetBaseCurrencyRequest,
+) (*desc.SetBaseCurrencyResponse, error) {
+	const op = "SetBaseCurrency"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	err := c.budgetFacade.Currency.SetBaseCurrency(ctx, authData.AccountID, req.Currency)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	currency, err := c.budgetFacade.Currency.GetBaseCurrency(ctx, authData.AccountID)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.SetBaseCurrencyResponse{
+		Currency: currency,
+	}
+
+	return out, nil
+}
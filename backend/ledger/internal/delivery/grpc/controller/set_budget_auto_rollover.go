@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) SetBudgetAutoRollover(
+	ctx context.Context,
+	req *desc.SetBudgetAutoRolloverRequest,
+) (*desc.SetBudgetAutoRolloverResponse, error) {
+	const op = "SetBudgetAutoRollover"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	err := c.budgetFacade.Budget.SetBudgetAutoRollover(ctx, authData.AccountID, req.Enabled)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	enabled, err := c.budgetFacade.Budget.GetBudgetAutoRollover(ctx, authData.AccountID)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.SetBudgetAutoRolloverResponse{
+		Enabled: enabled,
+	}
+
+	return out, nil
+}
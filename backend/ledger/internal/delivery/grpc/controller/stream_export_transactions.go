@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+	"google.golang.org/grpc"
+)
+
+const (
+	streamExportDefaultBatchSize = 500
+	streamExportMaxBatchSize     = 1000
+)
+
+func (c *controller) StreamExportTransactions(
+	req *desc.StreamExportTransactionsRequest,
+	stream grpc.ServerStreamingServer[desc.StreamExportTransactionsResponse],
+) error {
+	const op = "StreamExportTransactions"
+
+	ctx := stream.Context()
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	if req.BatchSize < 1 {
+		req.BatchSize = streamExportDefaultBatchSize
+	}
+
+	if req.BatchSize > streamExportMaxBatchSize {
+		return appErrors.Chainf(appErrors.ErrBadRequest.WithHints("batch_size must be <= 1000"), "%s.%s", c.pkg, op)
+	}
+
+	listOptions := &budgetUC.TransactionListOptions{
+		FilterAccountID: &authData.AccountID,
+	}
+
+	if req.FilterOccurredOnFrom != nil {
+		occuredOn, err := dateFromProto(req.FilterOccurredOnFrom)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid filter_occurred_on_from"), "%s.%s", c.pkg, op)
+		}
+
+		listOptions.FilterOccurredOnFrom = &occuredOn
+	}
+
+	if req.FilterOccurredOnTo != nil {
+		occuredOn, err := dateFromProto(req.FilterOccurredOnTo)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid filter_occurred_on_to"), "%s.%s", c.pkg, op)
+		}
+
+		listOptions.FilterOccurredOnTo = &occuredOn
+	}
+
+	if req.FilterWalletId != nil {
+		walletID, err := uuid.Parse(*req.FilterWalletId)
+		if err != nil {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid filter_wallet_id"), "%s.%s", c.pkg, op)
+		}
+
+		listOptions.FilterWalletID = &walletID
+	}
+
+	err := c.budgetFacade.Transaction.StreamListAsCSV(
+		ctx,
+		listOptions,
+		uint64(req.BatchSize),
+		func(chunk []byte) error {
+			return stream.Send(&desc.StreamExportTransactionsResponse{
+				Chunk: chunk,
+			})
+		},
+	)
+	if err != nil {
+		return appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/civil"
+	"github.com/govalues/decimal"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	desc "github.com/m11ano/budget_planner/backend/ledger/pkg/proto_pb/ledger_service"
+)
+
+func (c *controller) UpsertExchangeRates(
+	ctx context.Context,
+	req *desc.UpsertExchangeRatesRequest,
+) (*desc.UpsertExchangeRatesResponse, error) {
+	const op = "UpsertExchangeRates"
+
+	authData := auth.GetAuthData(ctx)
+	if authData == nil {
+		return nil, appErrors.Chainf(appErrors.ErrUnauthorized, "%s.%s", c.pkg, op)
+	}
+
+	in := make([]budgetUC.UpsertExchangeRateDataInput, 0, len(req.Items))
+
+	for idx, item := range req.Items {
+		rate, err := decimal.Parse(item.Rate)
+		if err != nil {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid rate", fmt.Sprintf("item %d", idx+1)),
+				"%s.%s", c.pkg, op)
+		}
+
+		if item.RateDate == nil {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithHints("invalid rate_date", fmt.Sprintf("item %d", idx+1)),
+				"%s.%s", c.pkg, op)
+		}
+
+		rateDate, err := civil.ParseDate(
+			fmt.Sprintf("%04d-%02d-%02d", item.RateDate.Year, item.RateDate.Month, item.RateDate.Day),
+		)
+		if err != nil {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid rate_date", fmt.Sprintf("item %d", idx+1)),
+				"%s.%s", c.pkg, op)
+		}
+
+		in = append(in, budgetUC.UpsertExchangeRateDataInput{
+			CurrencyFrom: item.CurrencyFrom,
+			CurrencyTo:   item.CurrencyTo,
+			RateDate:     rateDate,
+			Rate:         rate,
+		})
+	}
+
+	items, err := c.budgetFacade.Currency.UpsertExchangeRates(ctx, authData.AccountID, in)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", c.pkg, op)
+	}
+
+	out := &desc.UpsertExchangeRatesResponse{
+		Items: make([]*desc.ExchangeRate, 0, len(items)),
+	}
+
+	for _, item := range items {
+		out.Items = append(out.Items, ExchangeRateToProto(item))
+	}
+
+	return out, nil
+}
@@ -19,40 +19,67 @@ func interceptorAuth(cfg config.Config) grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (any, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return handler(ctx, req)
+		ctx, err := withAuthData(ctx, cfg)
+		if err != nil {
+			return nil, err
 		}
 
-		authHeaders := md.Get("authorization")
-		if len(authHeaders) == 0 {
-			return handler(ctx, req)
+		return handler(ctx, req)
+	}
+}
+
+func streamInterceptorAuth(cfg config.Config) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := withAuthData(ss.Context(), cfg)
+		if err != nil {
+			return err
 		}
 
-		authHeader := authHeaders[0]
-		accessToken := strings.TrimPrefix(authHeader, "Bearer ")
+		return handler(srv, &serverStreamWithContext{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		if accessToken == "" {
-			return handler(ctx, req)
-		}
+// withAuthData - кладет в контекст данные авторизации из access-токена, если он передан и валиден
+func withAuthData(ctx context.Context, cfg config.Config) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
 
-		claims, err := auth.ParseAccessToken(accessToken, true, []byte(cfg.Auth.JwtAccessSecret))
-		if err != nil {
-			if errors.Is(err, auth.ErrInvalidToken) {
-				return handler(ctx, req)
-			}
-			return nil, err
-		}
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 {
+		return ctx, nil
+	}
 
-		authData, err := auth.ClaimsToAuthData(claims)
-		if err != nil {
-			return nil, err
-		}
+	authHeader := authHeaders[0]
+	accessToken := strings.TrimPrefix(authHeader, "Bearer ")
 
-		ctx = auth.SetAuthData(ctx, authData)
+	if accessToken == "" {
+		return ctx, nil
+	}
 
-		ctx = loghandler.SetContextData(ctx, "request.account.id", claims.AccountId)
+	claims, err := auth.ParseAccessToken(accessToken, true, []byte(cfg.Auth.JwtAccessSecret))
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidToken) {
+			return ctx, nil
+		}
+		return nil, err
+	}
 
-		return handler(ctx, req)
+	authData, err := auth.ClaimsToAuthData(claims)
+	if err != nil {
+		return nil, err
 	}
+
+	ctx = auth.SetAuthData(ctx, authData)
+	ctx = auth.WithCheckRight(ctx)
+
+	ctx = loghandler.SetContextData(ctx, "request.account.id", claims.AccountId)
+
+	return ctx, nil
 }
@@ -19,3 +19,14 @@ func interceptorErrors() grpc.UnaryServerInterceptor {
 		return data, appErrors.ToGrpcStatus(err)
 	}
 }
+
+func streamInterceptorErrors() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return appErrors.ToGrpcStatus(handler(srv, ss))
+	}
+}
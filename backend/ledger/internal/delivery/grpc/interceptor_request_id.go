@@ -23,22 +23,37 @@ func interceptorRequestID() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (any, error) {
-		var reqID string
-		if md, ok := metadata.FromIncomingContext(ctx); ok {
-			if vals := md.Get(metadataRequestIDKey); len(vals) > 0 {
-				reqID = vals[0]
-			}
-		}
+		return handler(withRequestID(ctx), req)
+	}
+}
 
-		if reqID == "" {
-			reqID = uuid.New().String()
-		}
+func streamInterceptorRequestID() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &serverStreamWithContext{ServerStream: ss, ctx: withRequestID(ss.Context())})
+	}
+}
 
-		ctx = context.WithValue(ctx, requestIDKey, reqID)
-		ctx = loghandler.SetContextData(ctx, "request.id", reqID)
+func withRequestID(ctx context.Context) context.Context {
+	var reqID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(metadataRequestIDKey); len(vals) > 0 {
+			reqID = vals[0]
+		}
+	}
 
-		return handler(ctx, req)
+	if reqID == "" {
+		reqID = uuid.New().String()
 	}
+
+	ctx = context.WithValue(ctx, requestIDKey, reqID)
+	ctx = loghandler.SetContextData(ctx, "request.id", reqID)
+
+	return ctx
 }
 
 func GetRequestID(ctx context.Context) string {
@@ -53,15 +53,30 @@ func interceptorRequestIP() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (any, error) {
-		requestIP := getClientIPFromGRPCRequest(ctx)
-
-		ctx = context.WithValue(ctx, contextReqIPKeyValue, requestIP)
-		ctx = loghandler.SetContextData(ctx, "request.ip", requestIP)
+		return handler(withRequestIP(ctx), req)
+	}
+}
 
-		return handler(ctx, req)
+func streamInterceptorRequestIP() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &serverStreamWithContext{ServerStream: ss, ctx: withRequestIP(ss.Context())})
 	}
 }
 
+func withRequestIP(ctx context.Context) context.Context {
+	requestIP := getClientIPFromGRPCRequest(ctx)
+
+	ctx = context.WithValue(ctx, contextReqIPKeyValue, requestIP)
+	ctx = loghandler.SetContextData(ctx, "request.ip", requestIP)
+
+	return ctx
+}
+
 func GetClientIP(ctx context.Context) string {
 	if v := ctx.Value(contextReqIPKeyValue); v != nil {
 		if id, ok := v.(string); ok {
@@ -13,10 +13,39 @@ func interceptorValidate(
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
 ) (resp any, err error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func streamInterceptorValidate(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &validatingServerStream{ServerStream: ss})
+}
+
+// validatingServerStream - проверяет каждое входящее сообщение потока
+type validatingServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return validateRequest(m)
+}
+
+func validateRequest(req any) error {
 	if reqV, ok := req.(interface{ ValidateAll() error }); ok {
 		if err := reqV.ValidateAll(); err != nil {
-			return nil, appErrors.ErrBadRequest.WithWrap(err).WithHints(err.Error())
+			return appErrors.ErrBadRequest.WithWrap(err).WithHints(err.Error())
 		}
 	}
-	return handler(ctx, req)
+	return nil
 }
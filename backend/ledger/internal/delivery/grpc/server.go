@@ -14,6 +14,9 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// maxRecvMsgSize - пакет AddTransactionsBatch на TransactionsBatchMaxItems элементов больше 4 МБ по умолчанию
+const maxRecvMsgSize = 16 * 1024 * 1024
+
 func New(logger *slog.Logger, cfg config.Config) *grpc.Server {
 	loggingOpts := []logging.Option{
 		logging.WithLogOnEvents(
@@ -40,9 +43,26 @@ func New(logger *slog.Logger, cfg config.Config) *grpc.Server {
 		logging.UnaryServerInterceptor(interceptorLogger(logger), loggingOpts...),
 	}
 
-	streamInterceptors := []grpc.StreamServerInterceptor{}
+	// в потоках не логируются исходящие сообщения: это части выгрузки, а не ответы
+	streamLoggingOpts := []logging.Option{
+		logging.WithLogOnEvents(
+			logging.FinishCall,
+			logging.PayloadReceived,
+		),
+	}
+
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		streamInterceptorErrors(),
+		recovery.StreamServerInterceptor(recoveryOpts...),
+		streamInterceptorRequestIP(),
+		streamInterceptorRequestID(),
+		streamInterceptorAuth(cfg),
+		streamInterceptorValidate,
+		logging.StreamServerInterceptor(interceptorLogger(logger), streamLoggingOpts...),
+	}
 
 	gRPCServer := grpc.NewServer(
+		grpc.MaxRecvMsgSize(maxRecvMsgSize),
 		grpc.ChainUnaryInterceptor(unaryInterceptors...),
 		grpc.ChainStreamInterceptor(streamInterceptors...),
 	)
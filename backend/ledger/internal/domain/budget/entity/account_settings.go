@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+)
+
+// AccountSettings - настройки аккаунта в бюджете
+type AccountSettings struct {
+	AccountID uuid.UUID
+	// BaseCurrency - валюта, в которую пересчитываются отчеты и бюджеты
+	BaseCurrency string
+	// BudgetAutoRollover - в начале месяца бюджеты прошлого месяца копируются в новый
+	BudgetAutoRollover bool
+	// BudgetRolledOverPeriod - последний месяц, для которого выполнялся перенос бюджетов
+	BudgetRolledOverPeriod *civil.Date
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (item *AccountSettings) Version() int64 {
+	return item.UpdatedAt.UnixMicro()
+}
+
+func (item *AccountSettings) SetBaseCurrency(value string) error {
+	value, err := NormalizeCurrency(value)
+	if err != nil {
+		return err
+	}
+
+	item.BaseCurrency = value
+
+	return nil
+}
+
+// SetBudgetAutoRollover - при включении перенос начинается со следующего месяца после period
+func (item *AccountSettings) SetBudgetAutoRollover(value bool, period civil.Date) {
+	if value && !item.BudgetAutoRollover {
+		period.Day = 1
+		item.BudgetRolledOverPeriod = &period
+	}
+
+	item.BudgetAutoRollover = value
+}
+
+func NewAccountSettings(accountID uuid.UUID, baseCurrency string) (*AccountSettings, error) {
+	timeNow := time.Now().Truncate(time.Microsecond)
+
+	item := &AccountSettings{
+		AccountID: accountID,
+		CreatedAt: timeNow,
+		UpdatedAt: timeNow,
+	}
+
+	err := item.SetBaseCurrency(baseCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
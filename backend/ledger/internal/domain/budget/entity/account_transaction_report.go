@@ -8,23 +8,53 @@ import (
 )
 
 type AccountTransactionReportItem struct {
-	Sum          *decimal.Decimal
-	Period       civil.Date
-	CategoryID   uint64
-	BudgetID     *uuid.UUID
-	BudgetAmount *decimal.Decimal
+	Sum              *decimal.Decimal
+	Period           civil.Date
+	CategoryID       uint64
+	ParentCategoryID *uint64
+	BudgetID         *uuid.UUID
+	BudgetAmount     *decimal.Decimal
+	// CarriedOver - остаток (или перерасход) прошлого месяца, перенесенный на бюджет
+	CarriedOver *decimal.Decimal
+	// EffectiveBudget - бюджет с учетом переноса
+	EffectiveBudget *decimal.Decimal
+
+	// TotalSum - сумма по категории вместе со всеми вложенными категориями
+	TotalSum *decimal.Decimal
+	// TotalBudgetAmount - бюджет категории, либо сумма бюджетов вложенных категорий, если своего нет
+	TotalBudgetAmount *decimal.Decimal
+
+	// MissingCurrencies - валюты транзакций без курса к базовой валюте, их суммы не вошли в Sum
+	MissingCurrencies []string
 }
 
 func (item *AccountTransactionReportItem) SpentBudget() (*decimal.Decimal, error) {
-	if item.Sum == nil || item.BudgetAmount == nil || item.BudgetAmount.IsZero() {
+	return spentBudget(item.Sum, item.EffectiveBudgetAmount())
+}
+
+// EffectiveBudgetAmount - бюджет с учетом переноса, либо исходный бюджет
+func (item *AccountTransactionReportItem) EffectiveBudgetAmount() *decimal.Decimal {
+	if item.EffectiveBudget != nil {
+		return item.EffectiveBudget
+	}
+
+	return item.BudgetAmount
+}
+
+func (item *AccountTransactionReportItem) TotalSpentBudget() (*decimal.Decimal, error) {
+	return spentBudget(item.TotalSum, item.TotalBudgetAmount)
+}
+
+func spentBudget(sum *decimal.Decimal, budgetAmount *decimal.Decimal) (*decimal.Decimal, error) {
+	if sum == nil || budgetAmount == nil || budgetAmount.Sign() <= 0 {
 		return nil, nil
 	}
 
-	if item.Sum.Cmp(decimal.Zero) >= 0 {
+	if sum.Cmp(decimal.Zero) >= 0 {
 		return lo.ToPtr(decimal.Zero), nil
 	}
 
-	spentBudget, err := item.Sum.Neg().Quo(*item.BudgetAmount)
+	spentBudget, err := sum.Neg().Quo(*budgetAmount)
 	if err != nil {
 		return nil, err
 	}
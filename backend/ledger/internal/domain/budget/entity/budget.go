@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"slices"
 	"time"
 
 	"cloud.google.com/go/civil"
@@ -9,8 +10,47 @@ import (
 	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
 )
 
-var ErrBudgetAmountInvalid = appErrors.ErrBadRequest.Extend("budget amount is invalid").
-	WithTextCode("BUDGET_AMOUNT_INVALID").WithHints("budget amount is invalid")
+var (
+	ErrBudgetAmountInvalid = appErrors.ErrBadRequest.Extend("budget amount is invalid").
+				WithTextCode("BUDGET_AMOUNT_INVALID").WithHints("budget amount is invalid")
+
+	ErrBudgetEnforcementInvalid = appErrors.ErrBadRequest.Extend("budget enforcement is invalid").
+					WithTextCode("BUDGET_ENFORCEMENT_INVALID").WithHints("budget enforcement must be hard, soft or off")
+
+	ErrBudgetWarningThresholdsInvalid = appErrors.ErrBadRequest.Extend("budget warning thresholds are invalid").
+						WithTextCode("BUDGET_WARNING_THRESHOLDS_INVALID").WithHints("budget warning thresholds are invalid")
+
+	ErrBudgetLimitExceeded = appErrors.ErrBadRequest.Extend("budget limit exceeded").
+				WithTextCode("BUDGET_LIMIT_EXCEEDED").WithHints("budget limit exceeded")
+)
+
+const (
+	// BudgetMaxWarningThreshold - максимальный порог предупреждения в процентах
+	BudgetMaxWarningThreshold = 1000
+	// BudgetMaxWarningThresholds - максимальное количество порогов у бюджета
+	BudgetMaxWarningThresholds = 10
+)
+
+// BudgetEnforcement - режим контроля лимита бюджета
+type BudgetEnforcement string
+
+const (
+	// BudgetEnforcementHard - транзакция сверх лимита отклоняется
+	BudgetEnforcementHard BudgetEnforcement = "hard"
+	// BudgetEnforcementSoft - транзакция сверх лимита сохраняется с предупреждением
+	BudgetEnforcementSoft BudgetEnforcement = "soft"
+	// BudgetEnforcementOff - лимит не проверяется
+	BudgetEnforcementOff BudgetEnforcement = "off"
+)
+
+func (e BudgetEnforcement) IsValid() bool {
+	switch e {
+	case BudgetEnforcementHard, BudgetEnforcementSoft, BudgetEnforcementOff:
+		return true
+	}
+
+	return false
+}
 
 type Budget struct {
 	ID         uuid.UUID
@@ -18,6 +58,13 @@ type Budget struct {
 	Period     civil.Date
 	CategoryID uint64
 	Amount     decimal.Decimal
+	Currency   string
+	// CarryOver - остаток (или перерасход) бюджета прошлого месяца по категории переносится на этот бюджет
+	CarryOver bool
+	// Enforcement - режим контроля лимита
+	Enforcement BudgetEnforcement
+	// WarningThresholds - пороги предупреждений в процентах от бюджета, по возрастанию
+	WarningThresholds []int
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -33,13 +80,72 @@ func (item *Budget) SetAmount(value decimal.Decimal) error {
 		return ErrIncomeAmountInvalid
 	}
 
-	value = value.Trunc(2)
+	value = value.Trunc(CurrencyScale(item.Currency))
 
 	item.Amount = value
 
 	return nil
 }
 
+// SetCurrency - код валюты в формате ISO 4217, сумма приводится к точности валюты
+func (item *Budget) SetCurrency(value string) error {
+	value, err := NormalizeCurrency(value)
+	if err != nil {
+		return err
+	}
+
+	item.Currency = value
+
+	return item.SetAmount(item.Amount)
+}
+
+func (item *Budget) SetEnforcement(value BudgetEnforcement) error {
+	if !value.IsValid() {
+		return ErrBudgetEnforcementInvalid
+	}
+
+	item.Enforcement = value
+
+	return nil
+}
+
+// SetWarningThresholds - пороги сортируются, повторы удаляются
+func (item *Budget) SetWarningThresholds(value []int) error {
+	if len(value) > BudgetMaxWarningThresholds {
+		return ErrBudgetWarningThresholdsInvalid
+	}
+
+	thresholds := make([]int, 0, len(value))
+	for _, threshold := range value {
+		if threshold <= 0 || threshold > BudgetMaxWarningThreshold {
+			return ErrBudgetWarningThresholdsInvalid
+		}
+
+		if !slices.Contains(thresholds, threshold) {
+			thresholds = append(thresholds, threshold)
+		}
+	}
+
+	slices.Sort(thresholds)
+
+	item.WarningThresholds = thresholds
+
+	return nil
+}
+
+// ReachedWarningThreshold - наибольший достигнутый порог, 0 - пороги не достигнуты
+func (item *Budget) ReachedWarningThreshold(spentPercent decimal.Decimal) int {
+	reached := 0
+
+	for _, threshold := range item.WarningThresholds {
+		if spentPercent.Cmp(decimal.MustNew(int64(threshold), 0)) >= 0 {
+			reached = threshold
+		}
+	}
+
+	return reached
+}
+
 func (item *Budget) SetPeriod(value civil.Date) error {
 	value.Day = 1
 	item.Period = value
@@ -50,20 +156,28 @@ func (item *Budget) SetPeriod(value civil.Date) error {
 func NewBudget(
 	accountID uuid.UUID,
 	amount decimal.Decimal,
+	currency string,
 	period civil.Date,
 	categoryID uint64,
 ) (*Budget, error) {
 	timeNow := time.Now().Truncate(time.Microsecond)
 
 	item := &Budget{
-		ID:         uuid.New(),
-		AccountID:  accountID,
-		CategoryID: categoryID,
-		CreatedAt:  timeNow,
-		UpdatedAt:  timeNow,
+		ID:                uuid.New(),
+		AccountID:         accountID,
+		CategoryID:        categoryID,
+		Enforcement:       BudgetEnforcementHard,
+		WarningThresholds: []int{},
+		CreatedAt:         timeNow,
+		UpdatedAt:         timeNow,
+	}
+
+	err := item.SetCurrency(currency)
+	if err != nil {
+		return nil, err
 	}
 
-	err := item.SetAmount(amount)
+	err = item.SetAmount(amount)
 	if err != nil {
 		return nil, err
 	}
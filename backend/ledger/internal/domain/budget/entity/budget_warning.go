@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+)
+
+// BudgetWarning - предупреждение о расходовании бюджета после сохранения транзакции
+type BudgetWarning struct {
+	BudgetID    uuid.UUID
+	CategoryID  uint64
+	Period      civil.Date
+	Enforcement BudgetEnforcement
+	// Threshold - наибольший достигнутый порог в процентах, 0 - пороги не достигнуты
+	Threshold int
+	// SpentPercent - процент расходования бюджета с учетом транзакции, nil - бюджет не положительный
+	SpentPercent *decimal.Decimal
+	// Exceeded - лимит бюджета превышен
+	Exceeded bool
+	// Crossed - порог или лимит достигнут именно этой транзакцией
+	Crossed bool
+}
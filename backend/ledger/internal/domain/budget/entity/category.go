@@ -4,14 +4,18 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
 )
 
 var ErrTitleInvalid = appErrors.ErrBadRequest.Extend("title is invalid")
 
 type Category struct {
-	ID    uint64
-	Title string
+	ID         uint64
+	AccountID  *uuid.UUID
+	ParentID   *uint64
+	Title      string
+	IsArchived bool
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -22,6 +26,16 @@ func (item *Category) Version() int64 {
 	return item.UpdatedAt.UnixMicro()
 }
 
+// IsSystem - системная категория, общая для всех аккаунтов
+func (item *Category) IsSystem() bool {
+	return item.AccountID == nil
+}
+
+// IsAvailableForAccount - категория системная или принадлежит аккаунту
+func (item *Category) IsAvailableForAccount(accountID uuid.UUID) bool {
+	return item.AccountID == nil || *item.AccountID == accountID
+}
+
 func (item *Category) SetTitle(value string) error {
 	value = strings.TrimSpace(value)
 
@@ -35,11 +49,15 @@ func (item *Category) SetTitle(value string) error {
 }
 
 func NewCategory(
+	accountID *uuid.UUID,
+	parentID *uint64,
 	title string,
 ) (*Category, error) {
 	timeNow := time.Now().Truncate(time.Microsecond)
 
 	item := &Category{
+		AccountID: accountID,
+		ParentID:  parentID,
 		CreatedAt: timeNow,
 		UpdatedAt: timeNow,
 	}
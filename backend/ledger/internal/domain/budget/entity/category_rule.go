@@ -0,0 +1,243 @@
+package entity
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+)
+
+var (
+	ErrCategoryRuleNameInvalid = appErrors.ErrBadRequest.Extend("category rule name is invalid").
+					WithTextCode("CATEGORY_RULE_NAME_INVALID").WithHints("category rule name is invalid")
+
+	ErrCategoryRulePriorityInvalid = appErrors.ErrBadRequest.Extend("category rule priority is invalid").
+					WithTextCode("CATEGORY_RULE_PRIORITY_INVALID").WithHints("priority must be >= 0")
+
+	ErrCategoryRuleContainsInvalid = appErrors.ErrBadRequest.Extend("category rule description substring is invalid").
+					WithTextCode("CATEGORY_RULE_CONTAINS_INVALID").WithHints("description substring is too long")
+
+	ErrCategoryRuleRegexInvalid = appErrors.ErrBadRequest.Extend("category rule regex is invalid").
+					WithTextCode("CATEGORY_RULE_REGEX_INVALID").WithHints("description regex is invalid")
+
+	ErrCategoryRuleAmountRangeInvalid = appErrors.ErrBadRequest.Extend("category rule amount range is invalid").
+						WithTextCode("CATEGORY_RULE_AMOUNT_RANGE_INVALID").WithHints("amount range is invalid")
+)
+
+// CategoryRuleMaxPatternLen - максимальная длина подстроки и регулярного выражения описания
+const CategoryRuleMaxPatternLen = 500
+
+// CategoryRuleConditions - условия правила, все заданные условия должны выполниться.
+// Пустое значение - условие не проверяется
+type CategoryRuleConditions struct {
+	// DescriptionContains - подстрока описания без учета регистра
+	DescriptionContains *string
+	// DescriptionRegex - регулярное выражение RE2 для описания
+	DescriptionRegex *string
+	// AmountMin, AmountMax - границы суммы по модулю включительно
+	AmountMin *decimal.Decimal
+	AmountMax *decimal.Decimal
+	IsIncome  *bool
+	WalletID  *uuid.UUID
+}
+
+// CategoryRule - правило автоматического выбора категории транзакции без категории
+type CategoryRule struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	Name      string
+	// Priority - правила проверяются по возрастанию приоритета, срабатывает первое подходящее
+	Priority   int
+	CategoryID uint64
+	Conditions CategoryRuleConditions
+
+	descriptionRegexp *regexp.Regexp
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+func (item *CategoryRule) Version() int64 {
+	return item.UpdatedAt.UnixMicro()
+}
+
+func (item *CategoryRule) SetName(value string) error {
+	value = strings.TrimSpace(value)
+
+	if value == "" {
+		return ErrCategoryRuleNameInvalid
+	}
+
+	item.Name = value
+
+	return nil
+}
+
+func (item *CategoryRule) SetPriority(value int) error {
+	if value < 0 {
+		return ErrCategoryRulePriorityInvalid
+	}
+
+	item.Priority = value
+
+	return nil
+}
+
+// SetConditions - условия заменяются целиком, пустые строки считаются незаданным условием
+func (item *CategoryRule) SetConditions(value CategoryRuleConditions) error {
+	value.DescriptionContains = normalizeRulePattern(value.DescriptionContains)
+	value.DescriptionRegex = normalizeRulePattern(value.DescriptionRegex)
+
+	if value.DescriptionContains != nil && len(*value.DescriptionContains) > CategoryRuleMaxPatternLen {
+		return ErrCategoryRuleContainsInvalid
+	}
+
+	var descriptionRegexp *regexp.Regexp
+
+	if value.DescriptionRegex != nil {
+		if len(*value.DescriptionRegex) > CategoryRuleMaxPatternLen {
+			return ErrCategoryRuleRegexInvalid.WithHints("description regex is too long")
+		}
+
+		compiled, err := regexp.Compile(*value.DescriptionRegex)
+		if err != nil {
+			return ErrCategoryRuleRegexInvalid.WithParent(err)
+		}
+
+		descriptionRegexp = compiled
+	}
+
+	if value.AmountMin != nil && value.AmountMin.Sign() < 0 {
+		return ErrCategoryRuleAmountRangeInvalid.WithHints("amount min must be >= 0")
+	}
+
+	if value.AmountMax != nil && value.AmountMax.Sign() < 0 {
+		return ErrCategoryRuleAmountRangeInvalid.WithHints("amount max must be >= 0")
+	}
+
+	if value.AmountMin != nil && value.AmountMax != nil && value.AmountMin.Cmp(*value.AmountMax) > 0 {
+		return ErrCategoryRuleAmountRangeInvalid.WithHints("amount min is greater than amount max")
+	}
+
+	if value.WalletID != nil && *value.WalletID == uuid.Nil {
+		value.WalletID = nil
+	}
+
+	item.Conditions = value
+	item.descriptionRegexp = descriptionRegexp
+
+	return nil
+}
+
+func normalizeRulePattern(value *string) *string {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return nil
+	}
+
+	return value
+}
+
+// Matches - транзакция подходит под все условия правила. Сумма сравнивается по модулю
+func (item *CategoryRule) Matches(transaction *Transaction) bool {
+	conditions := item.Conditions
+
+	if conditions.IsIncome != nil && *conditions.IsIncome != transaction.IsIncome {
+		return false
+	}
+
+	if conditions.WalletID != nil && (transaction.WalletID == nil || *transaction.WalletID != *conditions.WalletID) {
+		return false
+	}
+
+	amount := transaction.Amount.Abs()
+
+	if conditions.AmountMin != nil && amount.Cmp(*conditions.AmountMin) < 0 {
+		return false
+	}
+
+	if conditions.AmountMax != nil && amount.Cmp(*conditions.AmountMax) > 0 {
+		return false
+	}
+
+	if conditions.DescriptionContains != nil &&
+		!strings.Contains(strings.ToLower(transaction.Description), strings.ToLower(*conditions.DescriptionContains)) {
+		return false
+	}
+
+	if conditions.DescriptionRegex != nil {
+		// правило из БД компилируется при первой проверке
+		if item.descriptionRegexp == nil {
+			compiled, err := regexp.Compile(*conditions.DescriptionRegex)
+			if err != nil {
+				return false
+			}
+
+			item.descriptionRegexp = compiled
+		}
+
+		if !item.descriptionRegexp.MatchString(transaction.Description) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MatchCategoryRule - подходящее правило с наименьшим приоритетом, при равенстве - созданное раньше
+func MatchCategoryRule(rules []*CategoryRule, transaction *Transaction) *CategoryRule {
+	var out *CategoryRule
+
+	for _, rule := range rules {
+		if !rule.Matches(transaction) {
+			continue
+		}
+
+		if out == nil ||
+			rule.Priority < out.Priority ||
+			(rule.Priority == out.Priority && rule.CreatedAt.Before(out.CreatedAt)) {
+			out = rule
+		}
+	}
+
+	return out
+}
+
+type NewCategoryRuleInput struct {
+	Name       string
+	Priority   int
+	CategoryID uint64
+	Conditions CategoryRuleConditions
+}
+
+func NewCategoryRule(accountID uuid.UUID, in NewCategoryRuleInput) (*CategoryRule, error) {
+	timeNow := time.Now().Truncate(time.Microsecond)
+
+	item := &CategoryRule{
+		ID:         uuid.New(),
+		AccountID:  accountID,
+		CategoryID: in.CategoryID,
+		CreatedAt:  timeNow,
+		UpdatedAt:  timeNow,
+	}
+
+	err := item.SetName(in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = item.SetPriority(in.Priority)
+	if err != nil {
+		return nil, err
+	}
+
+	err = item.SetConditions(in.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
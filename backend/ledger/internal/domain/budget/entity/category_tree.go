@@ -0,0 +1,127 @@
+package entity
+
+// CategoryTree - дерево категорий, построенное из плоского списка.
+// Категории, родитель которых отсутствует в списке, считаются корневыми
+type CategoryTree struct {
+	items    map[uint64]*Category
+	roots    []*Category
+	children map[uint64][]*Category
+}
+
+func NewCategoryTree(items []*Category) *CategoryTree {
+	tree := &CategoryTree{
+		items:    make(map[uint64]*Category, len(items)),
+		roots:    make([]*Category, 0),
+		children: make(map[uint64][]*Category),
+	}
+
+	for _, item := range items {
+		tree.items[item.ID] = item
+	}
+
+	for _, item := range items {
+		if item.ParentID == nil {
+			tree.roots = append(tree.roots, item)
+			continue
+		}
+
+		if _, ok := tree.items[*item.ParentID]; !ok {
+			tree.roots = append(tree.roots, item)
+			continue
+		}
+
+		tree.children[*item.ParentID] = append(tree.children[*item.ParentID], item)
+	}
+
+	return tree
+}
+
+func (t *CategoryTree) Get(id uint64) (*Category, bool) {
+	item, ok := t.items[id]
+	return item, ok
+}
+
+func (t *CategoryTree) Roots() []*Category {
+	return t.roots
+}
+
+func (t *CategoryTree) Children(id uint64) []*Category {
+	return t.children[id]
+}
+
+// AncestorIDs - id родителей категории, начиная с ближайшего
+func (t *CategoryTree) AncestorIDs(id uint64) []uint64 {
+	out := make([]uint64, 0)
+
+	visited := map[uint64]struct{}{id: {}}
+
+	item, ok := t.items[id]
+	for ok && item.ParentID != nil {
+		if _, seen := visited[*item.ParentID]; seen {
+			break
+		}
+
+		parent, exists := t.items[*item.ParentID]
+		if !exists {
+			break
+		}
+
+		visited[parent.ID] = struct{}{}
+		out = append(out, parent.ID)
+		item = parent
+	}
+
+	return out
+}
+
+// DescendantIDs - id всех вложенных категорий (без самой категории)
+func (t *CategoryTree) DescendantIDs(id uint64) []uint64 {
+	out := make([]uint64, 0)
+
+	visited := map[uint64]struct{}{id: {}}
+	queue := []uint64{id}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, child := range t.children[current] {
+			if _, seen := visited[child.ID]; seen {
+				continue
+			}
+
+			visited[child.ID] = struct{}{}
+			out = append(out, child.ID)
+			queue = append(queue, child.ID)
+		}
+	}
+
+	return out
+}
+
+// Depth - уровень вложенности категории, у корневой категории - 1
+func (t *CategoryTree) Depth(id uint64) int {
+	return len(t.AncestorIDs(id)) + 1
+}
+
+// Height - высота поддерева категории, у категории без детей - 1
+func (t *CategoryTree) Height(id uint64) int {
+	return t.height(id, map[uint64]struct{}{})
+}
+
+func (t *CategoryTree) height(id uint64, visited map[uint64]struct{}) int {
+	visited[id] = struct{}{}
+
+	maxChildHeight := 0
+	for _, child := range t.children[id] {
+		if _, seen := visited[child.ID]; seen {
+			continue
+		}
+
+		if h := t.height(child.ID, visited); h > maxChildHeight {
+			maxChildHeight = h
+		}
+	}
+
+	return maxChildHeight + 1
+}
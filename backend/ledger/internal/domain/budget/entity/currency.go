@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"strings"
+
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+)
+
+var ErrCurrencyInvalid = appErrors.ErrBadRequest.Extend("currency is invalid").
+	WithTextCode("CURRENCY_INVALID").WithHints("currency must be ISO 4217 code")
+
+// DefaultCurrencyScale - количество знаков после запятой для большинства валют
+const DefaultCurrencyScale = 2
+
+// currencyScales - валюты, у которых количество знаков после запятой отличается от DefaultCurrencyScale
+var currencyScales = map[string]int{
+	"BIF": 0,
+	"CLP": 0,
+	"DJF": 0,
+	"GNF": 0,
+	"ISK": 0,
+	"JPY": 0,
+	"KMF": 0,
+	"KRW": 0,
+	"PYG": 0,
+	"RWF": 0,
+	"UGX": 0,
+	"VND": 0,
+	"VUV": 0,
+	"XAF": 0,
+	"XOF": 0,
+	"XPF": 0,
+	"BHD": 3,
+	"IQD": 3,
+	"JOD": 3,
+	"KWD": 3,
+	"LYD": 3,
+	"OMR": 3,
+	"TND": 3,
+}
+
+// NormalizeCurrency - приводит код валюты к формату ISO 4217
+func NormalizeCurrency(value string) (string, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+
+	if len(value) != 3 {
+		return "", ErrCurrencyInvalid
+	}
+
+	for _, r := range value {
+		if r < 'A' || r > 'Z' {
+			return "", ErrCurrencyInvalid
+		}
+	}
+
+	return value, nil
+}
+
+// CurrencyScale - количество знаков после запятой в сумме валюты
+func CurrencyScale(currency string) int {
+	if scale, ok := currencyScales[currency]; ok {
+		return scale
+	}
+
+	return DefaultCurrencyScale
+}
@@ -0,0 +1,120 @@
+package entity
+
+import (
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+)
+
+var (
+	ErrExchangeRateInvalid = appErrors.ErrBadRequest.Extend("exchange rate is invalid").
+				WithTextCode("EXCHANGE_RATE_INVALID").WithHints("exchange rate must be positive")
+
+	ErrExchangeRateCurrenciesInvalid = appErrors.ErrBadRequest.Extend("exchange rate currencies are invalid").
+						WithTextCode("EXCHANGE_RATE_CURRENCIES_INVALID").WithHints("exchange rate currencies must differ")
+
+	ErrExchangeRateNotFound = appErrors.ErrBadRequest.Extend("exchange rate not found").
+				WithTextCode("EXCHANGE_RATE_NOT_FOUND")
+)
+
+// ExchangeRateScale - количество знаков после запятой в курсе валюты
+const ExchangeRateScale = 10
+
+// ExchangeRate - курс валюты аккаунта на дату: 1 CurrencyFrom = Rate CurrencyTo
+type ExchangeRate struct {
+	AccountID    uuid.UUID
+	CurrencyFrom string
+	CurrencyTo   string
+	RateDate     civil.Date
+	Rate         decimal.Decimal
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (item *ExchangeRate) SetCurrencies(currencyFrom string, currencyTo string) error {
+	currencyFrom, err := NormalizeCurrency(currencyFrom)
+	if err != nil {
+		return err
+	}
+
+	currencyTo, err = NormalizeCurrency(currencyTo)
+	if err != nil {
+		return err
+	}
+
+	if currencyFrom == currencyTo {
+		return ErrExchangeRateCurrenciesInvalid
+	}
+
+	item.CurrencyFrom = currencyFrom
+	item.CurrencyTo = currencyTo
+
+	return nil
+}
+
+func (item *ExchangeRate) SetRate(value decimal.Decimal) error {
+	value = value.Trunc(ExchangeRateScale)
+
+	if value.Cmp(decimal.Zero) != 1 {
+		return ErrExchangeRateInvalid
+	}
+
+	item.Rate = value
+
+	return nil
+}
+
+// Convert - переводит сумму из валюты CurrencyFrom в CurrencyTo или обратно
+func (item *ExchangeRate) Convert(amount decimal.Decimal, currencyFrom string) (decimal.Decimal, error) {
+	var (
+		out decimal.Decimal
+		err error
+	)
+
+	switch currencyFrom {
+	case item.CurrencyFrom:
+		out, err = amount.Mul(item.Rate)
+	case item.CurrencyTo:
+		out, err = amount.Quo(item.Rate)
+	default:
+		return decimal.Zero, ErrExchangeRateCurrenciesInvalid
+	}
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return out, nil
+}
+
+func NewExchangeRate(
+	accountID uuid.UUID,
+	currencyFrom string,
+	currencyTo string,
+	rateDate civil.Date,
+	rate decimal.Decimal,
+) (*ExchangeRate, error) {
+	timeNow := time.Now().Truncate(time.Microsecond)
+
+	item := &ExchangeRate{
+		AccountID: accountID,
+		RateDate:  rateDate,
+		CreatedAt: timeNow,
+		UpdatedAt: timeNow,
+	}
+
+	err := item.SetCurrencies(currencyFrom, currencyTo)
+	if err != nil {
+		return nil, err
+	}
+
+	err = item.SetRate(rate)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
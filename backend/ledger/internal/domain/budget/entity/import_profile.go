@@ -0,0 +1,371 @@
+package entity
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+)
+
+var (
+	ErrImportProfileNameInvalid = appErrors.ErrBadRequest.Extend("import profile name is invalid").
+					WithTextCode("IMPORT_PROFILE_NAME_INVALID").WithHints("import profile name is invalid")
+
+	ErrImportProfileDelimiterInvalid = appErrors.ErrBadRequest.Extend("import profile delimiter is invalid").
+						WithTextCode("IMPORT_PROFILE_DELIMITER_INVALID").WithHints("delimiter must be a single character")
+
+	ErrImportProfileDecimalSeparatorInvalid = appErrors.ErrBadRequest.Extend("import profile decimal separator is invalid").
+						WithTextCode("IMPORT_PROFILE_DECIMAL_SEPARATOR_INVALID").WithHints("decimal separator must be `.` or `,`")
+
+	ErrImportProfileDateLayoutInvalid = appErrors.ErrBadRequest.Extend("import profile date layout is invalid").
+						WithTextCode("IMPORT_PROFILE_DATE_LAYOUT_INVALID").WithHints("date layout must contain YYYY (or YY), MM and DD")
+
+	ErrImportProfileSignConventionInvalid = appErrors.ErrBadRequest.Extend("import profile sign convention is invalid").
+						WithTextCode("IMPORT_PROFILE_SIGN_CONVENTION_INVALID").WithHints("sign convention is invalid")
+
+	ErrImportProfileEncodingInvalid = appErrors.ErrBadRequest.Extend("import profile encoding is invalid").
+					WithTextCode("IMPORT_PROFILE_ENCODING_INVALID").WithHints("encoding is not supported")
+
+	ErrImportProfileColumnsInvalid = appErrors.ErrBadRequest.Extend("import profile columns are invalid").
+					WithTextCode("IMPORT_PROFILE_COLUMNS_INVALID").WithHints("import profile columns are invalid")
+
+	ErrImportProfileSkipRowsInvalid = appErrors.ErrBadRequest.Extend("import profile skip rows is invalid").
+					WithTextCode("IMPORT_PROFILE_SKIP_ROWS_INVALID").WithHints("skip rows must be >= 0")
+)
+
+// ImportSignConvention - способ определения дохода и расхода в выписке
+type ImportSignConvention string
+
+const (
+	// ImportSignConventionSigned - одна колонка суммы, отрицательная сумма - расход
+	ImportSignConventionSigned ImportSignConvention = "signed"
+	// ImportSignConventionInverted - одна колонка суммы, положительная сумма - расход (выписки кредитных карт)
+	ImportSignConventionInverted ImportSignConvention = "inverted"
+	// ImportSignConventionSplit - отдельные колонки прихода (credit) и расхода (debit)
+	ImportSignConventionSplit ImportSignConvention = "split"
+	// ImportSignConventionFlag - сумма по модулю и колонка-признак дохода
+	ImportSignConventionFlag ImportSignConvention = "flag"
+)
+
+func (c ImportSignConvention) IsValid() bool {
+	switch c {
+	case ImportSignConventionSigned,
+		ImportSignConventionInverted,
+		ImportSignConventionSplit,
+		ImportSignConventionFlag:
+		return true
+	}
+
+	return false
+}
+
+// ImportEncoding - кодировка файла выписки
+type ImportEncoding string
+
+const (
+	ImportEncodingUTF8        ImportEncoding = "utf-8"
+	ImportEncodingWindows1251 ImportEncoding = "windows-1251"
+	ImportEncodingKOI8R       ImportEncoding = "koi8-r"
+	ImportEncodingCP866       ImportEncoding = "cp866"
+)
+
+func (e ImportEncoding) IsValid() bool {
+	switch e {
+	case ImportEncodingUTF8,
+		ImportEncodingWindows1251,
+		ImportEncodingKOI8R,
+		ImportEncodingCP866:
+		return true
+	}
+
+	return false
+}
+
+// ImportColumns - колонки выписки: название колонки из заголовка, либо ее номер начиная с 1.
+// Пустое значение - колонки нет
+type ImportColumns struct {
+	Date        string
+	Amount      string
+	Debit       string
+	Credit      string
+	IsIncome    string
+	Description string
+	CategoryID  string
+	Currency    string
+	// ExternalID - идентификатор операции в выписке банка, используется для поиска дубликатов
+	ExternalID string
+}
+
+// ImportColumnIndex - номер колонки с нуля, если она задана числом
+func ImportColumnIndex(column string) (int, bool) {
+	idx, err := strconv.Atoi(strings.TrimSpace(column))
+	if err != nil || idx < 1 {
+		return 0, false
+	}
+
+	return idx - 1, true
+}
+
+type ImportProfile struct {
+	ID               uuid.UUID
+	AccountID        uuid.UUID
+	Name             string
+	Delimiter        string
+	DecimalSeparator string
+	// DateLayout - формат даты из токенов YYYY, YY, MM, DD, например DD.MM.YYYY
+	DateLayout     string
+	HasHeader      bool
+	SkipRows       int
+	SignConvention ImportSignConvention
+	// IncomeValues - значения колонки-признака, означающие доход (без учета регистра)
+	IncomeValues []string
+	Encoding     ImportEncoding
+	Columns      ImportColumns
+	// DefaultCategoryID - категория для строк без колонки категории, nil - категорию подберут правила аккаунта
+	DefaultCategoryID *uint64
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+func (item *ImportProfile) Version() int64 {
+	return item.UpdatedAt.UnixMicro()
+}
+
+func (item *ImportProfile) SetName(value string) error {
+	value = strings.TrimSpace(value)
+
+	if value == "" {
+		return ErrImportProfileNameInvalid
+	}
+
+	item.Name = value
+
+	return nil
+}
+
+// SetDelimiter - один символ, `\t` - табуляция
+func (item *ImportProfile) SetDelimiter(value string) error {
+	if value == `\t` {
+		value = "\t"
+	}
+
+	if len([]rune(value)) != 1 || value == "\n" || value == "\r" || value == `"` {
+		return ErrImportProfileDelimiterInvalid
+	}
+
+	item.Delimiter = value
+
+	return nil
+}
+
+func (item *ImportProfile) SetDecimalSeparator(value string) error {
+	if value != "." && value != "," {
+		return ErrImportProfileDecimalSeparatorInvalid
+	}
+
+	item.DecimalSeparator = value
+
+	return nil
+}
+
+func (item *ImportProfile) SetDateLayout(value string) error {
+	value = strings.TrimSpace(value)
+
+	hasYear := strings.Contains(value, "YYYY") || strings.Contains(value, "YY")
+	if !hasYear || !strings.Contains(value, "MM") || !strings.Contains(value, "DD") {
+		return ErrImportProfileDateLayoutInvalid
+	}
+
+	item.DateLayout = value
+
+	return nil
+}
+
+// GoDateLayout - формат даты для time.Parse
+func (item *ImportProfile) GoDateLayout() string {
+	return strings.NewReplacer(
+		"YYYY", "2006",
+		"YY", "06",
+		"MM", "01",
+		"DD", "02",
+	).Replace(item.DateLayout)
+}
+
+func (item *ImportProfile) SetSkipRows(value int) error {
+	if value < 0 {
+		return ErrImportProfileSkipRowsInvalid
+	}
+
+	item.SkipRows = value
+
+	return nil
+}
+
+func (item *ImportProfile) SetEncoding(value ImportEncoding) error {
+	value = ImportEncoding(strings.ToLower(strings.TrimSpace(string(value))))
+	if value == "" {
+		value = ImportEncodingUTF8
+	}
+
+	if !value.IsValid() {
+		return ErrImportProfileEncodingInvalid
+	}
+
+	item.Encoding = value
+
+	return nil
+}
+
+// SetMapping - колонки, способ определения знака и значения признака дохода проверяются вместе
+func (item *ImportProfile) SetMapping(
+	signConvention ImportSignConvention,
+	columns ImportColumns,
+	incomeValues []string,
+	hasHeader bool,
+	defaultCategoryID *uint64,
+) error {
+	if !signConvention.IsValid() {
+		return ErrImportProfileSignConventionInvalid
+	}
+
+	if columns.Date == "" {
+		return ErrImportProfileColumnsInvalid.WithHints("date column is required")
+	}
+
+	switch signConvention {
+	case ImportSignConventionSigned, ImportSignConventionInverted:
+		if columns.Amount == "" {
+			return ErrImportProfileColumnsInvalid.WithHints("amount column is required")
+		}
+	case ImportSignConventionSplit:
+		if columns.Debit == "" || columns.Credit == "" {
+			return ErrImportProfileColumnsInvalid.WithHints("debit and credit columns are required")
+		}
+	case ImportSignConventionFlag:
+		if columns.Amount == "" || columns.IsIncome == "" {
+			return ErrImportProfileColumnsInvalid.WithHints("amount and is_income columns are required")
+		}
+	}
+
+	// без заголовка колонки можно указать только номером
+	if !hasHeader {
+		for _, column := range []string{
+			columns.Date,
+			columns.Amount,
+			columns.Debit,
+			columns.Credit,
+			columns.IsIncome,
+			columns.Description,
+			columns.CategoryID,
+			columns.Currency,
+			columns.ExternalID,
+		} {
+			if column == "" {
+				continue
+			}
+
+			if _, ok := ImportColumnIndex(column); !ok {
+				return ErrImportProfileColumnsInvalid.WithHints("columns must be numbers when file has no header")
+			}
+		}
+	}
+
+	values := make([]string, 0, len(incomeValues))
+	for _, value := range incomeValues {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+
+	if signConvention == ImportSignConventionFlag && len(values) == 0 {
+		values = []string{"true"}
+	}
+
+	item.SignConvention = signConvention
+	item.Columns = columns
+	item.IncomeValues = values
+	item.HasHeader = hasHeader
+	item.DefaultCategoryID = defaultCategoryID
+
+	return nil
+}
+
+// IsIncomeValue - значение колонки-признака означает доход
+func (item *ImportProfile) IsIncomeValue(value string) bool {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	for _, incomeValue := range item.IncomeValues {
+		if value == incomeValue {
+			return true
+		}
+	}
+
+	return false
+}
+
+type NewImportProfileInput struct {
+	Name              string
+	Delimiter         string
+	DecimalSeparator  string
+	DateLayout        string
+	HasHeader         bool
+	SkipRows          int
+	SignConvention    ImportSignConvention
+	IncomeValues      []string
+	Encoding          ImportEncoding
+	Columns           ImportColumns
+	DefaultCategoryID *uint64
+}
+
+func NewImportProfile(accountID uuid.UUID, in NewImportProfileInput) (*ImportProfile, error) {
+	timeNow := time.Now().Truncate(time.Microsecond)
+
+	item := &ImportProfile{
+		ID:        uuid.New(),
+		AccountID: accountID,
+		CreatedAt: timeNow,
+		UpdatedAt: timeNow,
+	}
+
+	err := item.SetName(in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = item.SetDelimiter(in.Delimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = item.SetDecimalSeparator(in.DecimalSeparator)
+	if err != nil {
+		return nil, err
+	}
+
+	err = item.SetDateLayout(in.DateLayout)
+	if err != nil {
+		return nil, err
+	}
+
+	err = item.SetSkipRows(in.SkipRows)
+	if err != nil {
+		return nil, err
+	}
+
+	err = item.SetEncoding(in.Encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	err = item.SetMapping(in.SignConvention, in.Columns, in.IncomeValues, in.HasHeader, in.DefaultCategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
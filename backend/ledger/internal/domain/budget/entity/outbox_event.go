@@ -0,0 +1,107 @@
+package entity
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+)
+
+// OutboxEventMaxErrorLen - максимальная длина сохраняемой ошибки доставки
+const OutboxEventMaxErrorLen = 1000
+
+// OutboxEventType - тип события для внешних получателей
+type OutboxEventType string
+
+const (
+	OutboxEventTypeBudgetThresholdCrossed OutboxEventType = "BudgetThresholdCrossed"
+)
+
+// OutboxEvent - событие транзакционного outbox, записывается в одной транзакции с изменением данных
+type OutboxEvent struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	EventType OutboxEventType
+	Payload   json.RawMessage
+
+	Attempts int
+	// DeliveredSinks - получатели, которым событие уже доставлено, при повторе пропускаются
+	DeliveredSinks []string
+	LastError      *string
+	NextAttemptAt  time.Time
+	ProcessedAt    *time.Time
+	// FailedAt - доставка прекращена после исчерпания попыток
+	FailedAt *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// BudgetThresholdCrossedPayload - данные события пересечения порога бюджета
+type BudgetThresholdCrossedPayload struct {
+	BudgetID      uuid.UUID         `json:"budgetID"`
+	AccountID     uuid.UUID         `json:"accountID"`
+	CategoryID    uint64            `json:"categoryID"`
+	Period        civil.Date        `json:"period"`
+	TransactionID uuid.UUID         `json:"transactionID"`
+	Enforcement   BudgetEnforcement `json:"enforcement"`
+	Threshold     int               `json:"threshold"`
+	SpentPercent  *decimal.Decimal  `json:"spentPercent"`
+	Exceeded      bool              `json:"exceeded"`
+}
+
+func (item *OutboxEvent) IsDelivered(sink string) bool {
+	return slices.Contains(item.DeliveredSinks, sink)
+}
+
+func (item *OutboxEvent) MarkDelivered(sink string) {
+	if !item.IsDelivered(sink) {
+		item.DeliveredSinks = append(item.DeliveredSinks, sink)
+	}
+}
+
+func (item *OutboxEvent) SetLastError(value string) {
+	runes := []rune(value)
+	if len(runes) > OutboxEventMaxErrorLen {
+		value = string(runes[:OutboxEventMaxErrorLen])
+	}
+
+	item.LastError = &value
+}
+
+func NewOutboxEvent(accountID uuid.UUID, eventType OutboxEventType, payload any) (*OutboxEvent, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	timeNow := time.Now().Truncate(time.Microsecond)
+
+	return &OutboxEvent{
+		ID:             uuid.New(),
+		AccountID:      accountID,
+		EventType:      eventType,
+		Payload:        data,
+		DeliveredSinks: []string{},
+		NextAttemptAt:  timeNow,
+		CreatedAt:      timeNow,
+		UpdatedAt:      timeNow,
+	}, nil
+}
+
+func NewBudgetThresholdCrossedEvent(transaction *Transaction, warning *BudgetWarning) (*OutboxEvent, error) {
+	return NewOutboxEvent(transaction.AccountID, OutboxEventTypeBudgetThresholdCrossed, &BudgetThresholdCrossedPayload{
+		BudgetID:      warning.BudgetID,
+		AccountID:     transaction.AccountID,
+		CategoryID:    warning.CategoryID,
+		Period:        warning.Period,
+		TransactionID: transaction.ID,
+		Enforcement:   warning.Enforcement,
+		Threshold:     warning.Threshold,
+		SpentPercent:  warning.SpentPercent,
+		Exceeded:      warning.Exceeded,
+	})
+}
@@ -0,0 +1,314 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+)
+
+var (
+	ErrRecurringRuleFrequencyInvalid = appErrors.ErrBadRequest.Extend("recurring rule frequency is invalid").
+						WithTextCode("RECURRING_RULE_FREQUENCY_INVALID").
+						WithHints("recurring rule frequency is invalid")
+
+	ErrRecurringRuleIntervalInvalid = appErrors.ErrBadRequest.Extend("recurring rule interval is invalid").
+					WithTextCode("RECURRING_RULE_INTERVAL_INVALID").
+					WithHints("recurring rule interval is invalid")
+
+	ErrRecurringRuleDayOfMonthInvalid = appErrors.ErrBadRequest.Extend("recurring rule day of month is invalid").
+						WithTextCode("RECURRING_RULE_DAY_OF_MONTH_INVALID").
+						WithHints("recurring rule day of month is invalid")
+
+	ErrRecurringRuleEndDateInvalid = appErrors.ErrBadRequest.Extend("recurring rule end date is invalid").
+					WithTextCode("RECURRING_RULE_END_DATE_INVALID").
+					WithHints("recurring rule end date is before start date")
+
+	ErrRecurringRuleCountInvalid = appErrors.ErrBadRequest.Extend("recurring rule count is invalid").
+					WithTextCode("RECURRING_RULE_COUNT_INVALID").
+					WithHints("recurring rule count is invalid")
+)
+
+const (
+	RecurringRuleMaxInterval = 365
+	// RecurringRuleMaxErrorLen - максимальная длина сохраняемой ошибки материализации
+	RecurringRuleMaxErrorLen = 1000
+)
+
+// RecurringFrequency - периодичность повторения
+type RecurringFrequency string
+
+const (
+	RecurringFrequencyWeekly  RecurringFrequency = "weekly"
+	RecurringFrequencyMonthly RecurringFrequency = "monthly"
+	RecurringFrequencyYearly  RecurringFrequency = "yearly"
+)
+
+func (f RecurringFrequency) IsValid() bool {
+	switch f {
+	case RecurringFrequencyWeekly, RecurringFrequencyMonthly, RecurringFrequencyYearly:
+		return true
+	}
+
+	return false
+}
+
+// RecurringRule - шаблон регулярной транзакции с расписанием (аналог RRULE)
+type RecurringRule struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+
+	IsIncome    bool
+	Amount      decimal.Decimal
+	Currency    string
+	CategoryID  uint64
+	WalletID    *uuid.UUID
+	Description string
+
+	Frequency RecurringFrequency
+	Interval  int
+	// DayOfMonth - день месяца для monthly, если в месяце меньше дней - последний день месяца
+	DayOfMonth int
+	StartDate  civil.Date
+	EndDate    *civil.Date
+	// Count - максимальное количество повторений
+	Count *int
+
+	// OccurrencesCount - количество уже пройденных повторений
+	OccurrencesCount int
+	// NextOccurrenceOn - дата следующего повторения, nil - расписание завершено
+	NextOccurrenceOn *civil.Date
+	IsPaused         bool
+	// LastError - ошибка последней материализации, из-за которой правило было приостановлено
+	LastError *string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+func (item *RecurringRule) Version() int64 {
+	return item.UpdatedAt.UnixMicro()
+}
+
+func (item *RecurringRule) SetDescription(value string) error {
+	item.Description = strings.TrimSpace(value)
+
+	return nil
+}
+
+func (item *RecurringRule) SetAmount(value decimal.Decimal) error {
+	if item.IsIncome && value.Cmp(decimal.Zero) == -1 {
+		return ErrIncomeAmountInvalid
+	} else if !item.IsIncome && value.Cmp(decimal.Zero) == 1 {
+		return ErrOutcomeAmountInvalid
+	}
+
+	item.Amount = value.Trunc(CurrencyScale(item.Currency))
+
+	return nil
+}
+
+// SetCurrency - код валюты в формате ISO 4217, сумма приводится к точности валюты
+func (item *RecurringRule) SetCurrency(value string) error {
+	value, err := NormalizeCurrency(value)
+	if err != nil {
+		return err
+	}
+
+	item.Currency = value
+
+	return item.SetAmount(item.Amount)
+}
+
+// SetSchedule - задает расписание, счетчик повторений сбрасывается
+func (item *RecurringRule) SetSchedule(
+	frequency RecurringFrequency,
+	interval int,
+	dayOfMonth int,
+	startDate civil.Date,
+) error {
+	if !frequency.IsValid() {
+		return ErrRecurringRuleFrequencyInvalid
+	}
+
+	if interval < 1 || interval > RecurringRuleMaxInterval {
+		return ErrRecurringRuleIntervalInvalid
+	}
+
+	if !startDate.IsValid() {
+		return appErrors.ErrBadRequest.WithHints("invalid start_date")
+	}
+
+	if frequency == RecurringFrequencyMonthly {
+		if dayOfMonth == 0 {
+			dayOfMonth = startDate.Day
+		}
+
+		if dayOfMonth < 1 || dayOfMonth > 31 {
+			return ErrRecurringRuleDayOfMonthInvalid
+		}
+	} else {
+		dayOfMonth = 0
+	}
+
+	item.Frequency = frequency
+	item.Interval = interval
+	item.DayOfMonth = dayOfMonth
+	item.StartDate = startDate
+	item.OccurrencesCount = 0
+
+	return item.checkEnd()
+}
+
+// SetEnd - задает условия окончания расписания: дату и/или количество повторений
+func (item *RecurringRule) SetEnd(endDate *civil.Date, count *int) error {
+	item.EndDate = endDate
+	item.Count = count
+
+	return item.checkEnd()
+}
+
+func (item *RecurringRule) checkEnd() error {
+	if item.EndDate != nil && item.EndDate.Before(item.StartDate) {
+		return ErrRecurringRuleEndDateInvalid
+	}
+
+	if item.Count != nil && *item.Count < 1 {
+		return ErrRecurringRuleCountInvalid
+	}
+
+	item.refreshNextOccurrence()
+
+	return nil
+}
+
+// SetIsPaused - при возобновлении пропущенные за время паузы повторения не создаются
+func (item *RecurringRule) SetIsPaused(value bool, today civil.Date) {
+	if item.IsPaused && !value {
+		item.LastError = nil
+
+		for item.NextOccurrenceOn != nil && item.NextOccurrenceOn.Before(today) {
+			item.Advance()
+		}
+	}
+
+	item.IsPaused = value
+}
+
+// PauseWithError - приостанавливает правило, если повторение не удалось создать
+func (item *RecurringRule) PauseWithError(reason string) {
+	if len(reason) > RecurringRuleMaxErrorLen {
+		reason = reason[:RecurringRuleMaxErrorLen]
+	}
+
+	item.IsPaused = true
+	item.LastError = &reason
+}
+
+// Advance - переход к следующему повторению
+func (item *RecurringRule) Advance() {
+	item.OccurrencesCount++
+	item.refreshNextOccurrence()
+}
+
+// IsDue - есть повторение, которое нужно создать на дату today
+func (item *RecurringRule) IsDue(today civil.Date) bool {
+	return !item.IsPaused && item.NextOccurrenceOn != nil && !item.NextOccurrenceOn.After(today)
+}
+
+func (item *RecurringRule) refreshNextOccurrence() {
+	if item.Count != nil && item.OccurrencesCount >= *item.Count {
+		item.NextOccurrenceOn = nil
+		return
+	}
+
+	next := item.Occurrence(item.OccurrencesCount)
+
+	if item.EndDate != nil && next.After(*item.EndDate) {
+		item.NextOccurrenceOn = nil
+		return
+	}
+
+	item.NextOccurrenceOn = &next
+}
+
+// Occurrence - дата n-го повторения (с нуля), считается от даты начала, чтобы не накапливать сдвиг
+func (item *RecurringRule) Occurrence(n int) civil.Date {
+	switch item.Frequency {
+	case RecurringFrequencyWeekly:
+		return item.StartDate.AddDays(7 * item.Interval * n)
+	case RecurringFrequencyYearly:
+		year := item.StartDate.Year + item.Interval*n
+		return clampDate(year, item.StartDate.Month, item.StartDate.Day)
+	default:
+		year, month := item.StartDate.Year, item.StartDate.Month
+
+		// первое повторение не раньше даты начала
+		if clampDate(year, month, item.DayOfMonth).Before(item.StartDate) {
+			year, month = addMonths(year, month, 1)
+		}
+
+		year, month = addMonths(year, month, item.Interval*n)
+
+		return clampDate(year, month, item.DayOfMonth)
+	}
+}
+
+func addMonths(year int, month time.Month, months int) (int, time.Month) {
+	total := year*12 + int(month) - 1 + months
+
+	return total / 12, time.Month(total%12 + 1)
+}
+
+func clampDate(year int, month time.Month, day int) civil.Date {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return civil.Date{Year: year, Month: month, Day: day}
+}
+
+func NewRecurringRule(
+	accountID uuid.UUID,
+	isIncome bool,
+	amount decimal.Decimal,
+	currency string,
+	categoryID uint64,
+	frequency RecurringFrequency,
+	interval int,
+	dayOfMonth int,
+	startDate civil.Date,
+) (*RecurringRule, error) {
+	timeNow := time.Now().Truncate(time.Microsecond)
+
+	item := &RecurringRule{
+		ID:         uuid.New(),
+		AccountID:  accountID,
+		IsIncome:   isIncome,
+		CategoryID: categoryID,
+		CreatedAt:  timeNow,
+		UpdatedAt:  timeNow,
+	}
+
+	err := item.SetCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	err = item.SetAmount(amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = item.SetSchedule(frequency, interval, dayOfMonth, startDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
@@ -7,7 +7,11 @@ import (
 
 type ReportItem struct {
 	AccountID uuid.UUID
-	DateFrom  civil.Date
-	DateTo    civil.Date
-	Items     []*AccountTransactionReportItem
+	// Currency - базовая валюта, в которой посчитаны суммы отчета
+	Currency string
+	DateFrom civil.Date
+	DateTo   civil.Date
+	Items    []*AccountTransactionReportItem
+	// MissingRates - подсказки о недостающих курсах, суммы и бюджеты в этих валютах не вошли в отчет
+	MissingRates []string
 }
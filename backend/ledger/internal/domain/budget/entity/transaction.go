@@ -1,13 +1,18 @@
 package entity
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"cloud.google.com/go/civil"
 	"github.com/google/uuid"
 	"github.com/govalues/decimal"
 	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/samber/lo"
 )
 
 var (
@@ -16,16 +21,42 @@ var (
 
 	ErrOutcomeAmountInvalid = appErrors.ErrBadRequest.Extend("outcome amount is invalid").
 				WithTextCode("OUTCOME_AMOUNT_INVALID").WithHints("outcome amount is invalid")
+
+	ErrTransactionCategoryRequired = appErrors.ErrBadRequest.Extend("transaction category is required").
+					WithTextCode("TRANSACTION_CATEGORY_REQUIRED").WithHints("category_id is empty and no category rule matched")
+
+	ErrTransactionTagsInvalid = appErrors.ErrBadRequest.Extend("transaction tags are invalid").
+					WithTextCode("TRANSACTION_TAGS_INVALID").WithHints("transaction tags are invalid")
+)
+
+const (
+	// TransactionMaxTags - максимальное количество тегов транзакции
+	TransactionMaxTags = 20
+	// TransactionMaxTagLen - максимальная длина тега в символах
+	TransactionMaxTagLen = 50
 )
 
 type Transaction struct {
-	ID          uuid.UUID
-	AccountID   uuid.UUID
-	IsIncome    bool
-	Amount      decimal.Decimal
-	OccurredOn  civil.Date
-	CategoryID  uint64
-	Description string
+	ID         uuid.UUID
+	AccountID  uuid.UUID
+	IsIncome   bool
+	Amount     decimal.Decimal
+	Currency   string
+	OccurredOn civil.Date
+	CategoryID uint64
+	WalletID   *uuid.UUID
+	TransferID *uuid.UUID
+	// RecurringRuleID - правило, по которому транзакция была создана автоматически
+	RecurringRuleID *uuid.UUID
+	Description     string
+	// ExternalID - идентификатор операции в выписке банка
+	ExternalID *string
+	// ImportFingerprint - отпечаток импортированной строки, уникален в пределах аккаунта
+	ImportFingerprint *string
+	// DuplicateOfID - транзакция, с которой строка совпала при импорте с пометкой дубликатов
+	DuplicateOfID *uuid.UUID
+	// Tags - теги в нижнем регистре без повторов
+	Tags []string
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -36,6 +67,11 @@ func (item *Transaction) Version() int64 {
 	return item.UpdatedAt.UnixMicro()
 }
 
+// IsTransfer - транзакция является частью перевода между кошельками
+func (item *Transaction) IsTransfer() bool {
+	return item.TransferID != nil
+}
+
 func (item *Transaction) SetDescription(value string) error {
 	value = strings.TrimSpace(value)
 
@@ -44,6 +80,100 @@ func (item *Transaction) SetDescription(value string) error {
 	return nil
 }
 
+func (item *Transaction) SetTags(values []string) error {
+	tags, err := NormalizeTransactionTags(values)
+	if err != nil {
+		return err
+	}
+
+	item.Tags = tags
+
+	return nil
+}
+
+// NormalizeTransactionTags - теги без пробелов по краям в нижнем регистре, пустые и повторы отбрасываются
+func NormalizeTransactionTags(values []string) ([]string, error) {
+	out := make([]string, 0, len(values))
+
+	for _, value := range values {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" || lo.Contains(out, value) {
+			continue
+		}
+
+		if utf8.RuneCountInString(value) > TransactionMaxTagLen {
+			return nil, ErrTransactionTagsInvalid.WithHints(fmt.Sprintf("tag must be at most %d characters", TransactionMaxTagLen))
+		}
+
+		out = append(out, value)
+	}
+
+	if len(out) > TransactionMaxTags {
+		return nil, ErrTransactionTagsInvalid.WithHints(fmt.Sprintf("transaction must have at most %d tags", TransactionMaxTags))
+	}
+
+	return out, nil
+}
+
+func (item *Transaction) SetExternalID(value string) error {
+	value = strings.TrimSpace(value)
+
+	if value == "" {
+		item.ExternalID = nil
+		return nil
+	}
+
+	item.ExternalID = &value
+
+	return nil
+}
+
+// ComputeImportFingerprint - отпечаток строки импорта по аккаунту, дате, сумме, нормализованному описанию
+// и внешнему идентификатору. ordinal - номер одинаковой строки внутри файла, чтобы повторяющиеся
+// операции одного дня получали разные отпечатки, а повторный импорт файла - те же самые
+func (item *Transaction) ComputeImportFingerprint(ordinal int) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf(
+		"%s|%s|%s|%s|%s|%s|%d",
+		item.AccountID,
+		item.OccurredOn,
+		item.Amount.Trim(0),
+		item.Currency,
+		NormalizeImportDescription(item.Description),
+		lo.FromPtr(item.ExternalID),
+		ordinal,
+	)))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// IsImportMatch - транзакция совпадает со строкой импорта: по внешнему идентификатору, если он есть у обеих,
+// иначе по сумме, валюте и нормализованному описанию с расхождением дат не более toleranceDays дней
+func (item *Transaction) IsImportMatch(other *Transaction, toleranceDays int) bool {
+	if item.ExternalID != nil && other.ExternalID != nil {
+		return *item.ExternalID == *other.ExternalID
+	}
+
+	if item.Amount.Cmp(other.Amount) != 0 || item.Currency != other.Currency {
+		return false
+	}
+
+	if NormalizeImportDescription(item.Description) != NormalizeImportDescription(other.Description) {
+		return false
+	}
+
+	days := item.OccurredOn.DaysSince(other.OccurredOn)
+	if days < 0 {
+		days = -days
+	}
+
+	return days <= toleranceDays
+}
+
+// NormalizeImportDescription - описание в нижнем регистре с одиночными пробелами
+func NormalizeImportDescription(value string) string {
+	return strings.Join(strings.Fields(strings.ToLower(value)), " ")
+}
+
 func (item *Transaction) SetAmount(value decimal.Decimal) error {
 	if item.IsIncome && value.Cmp(decimal.Zero) == -1 {
 		return ErrIncomeAmountInvalid
@@ -51,13 +181,25 @@ func (item *Transaction) SetAmount(value decimal.Decimal) error {
 		return ErrOutcomeAmountInvalid
 	}
 
-	value = value.Trunc(2)
+	value = value.Trunc(CurrencyScale(item.Currency))
 
 	item.Amount = value
 
 	return nil
 }
 
+// SetCurrency - код валюты в формате ISO 4217, сумма приводится к точности валюты
+func (item *Transaction) SetCurrency(value string) error {
+	value, err := NormalizeCurrency(value)
+	if err != nil {
+		return err
+	}
+
+	item.Currency = value
+
+	return item.SetAmount(item.Amount)
+}
+
 func (item *Transaction) SetOccuredOn(value civil.Date) error {
 	item.OccurredOn = value
 
@@ -68,6 +210,7 @@ func NewTransaction(
 	accountID uuid.UUID,
 	isIncome bool,
 	amount decimal.Decimal,
+	currency string,
 	occurredOn civil.Date,
 	categoryID uint64,
 ) (*Transaction, error) {
@@ -78,11 +221,17 @@ func NewTransaction(
 		AccountID:  accountID,
 		IsIncome:   isIncome,
 		CategoryID: categoryID,
+		Tags:       []string{},
 		CreatedAt:  timeNow,
 		UpdatedAt:  timeNow,
 	}
 
-	err := item.SetAmount(amount)
+	err := item.SetCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	err = item.SetAmount(amount)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,206 @@
+package entity
+
+import (
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+)
+
+var (
+	ErrTransferAmountInvalid = appErrors.ErrBadRequest.Extend("transfer amount is invalid").
+					WithTextCode("TRANSFER_AMOUNT_INVALID").WithHints("transfer amount must be positive")
+
+	ErrTransferWalletsInvalid = appErrors.ErrBadRequest.Extend("transfer wallets are invalid").
+					WithTextCode("TRANSFER_WALLETS_INVALID").WithHints("transfer wallets must differ")
+
+	ErrTransferLegsInvalid = appErrors.ErrInternal.Extend("transfer legs are invalid")
+)
+
+// Transfer - перевод между кошельками, состоит из двух связанных транзакций:
+// списания с исходного кошелька и зачисления на целевой
+type Transfer struct {
+	ID      uuid.UUID
+	Outcome *Transaction
+	Income  *Transaction
+}
+
+func (item *Transfer) Version() int64 {
+	return item.Outcome.Version()
+}
+
+func (item *Transfer) AccountID() uuid.UUID {
+	return item.Outcome.AccountID
+}
+
+// Amount - сумма перевода, всегда положительная
+func (item *Transfer) Amount() decimal.Decimal {
+	return item.Income.Amount
+}
+
+func (item *Transfer) FromWalletID() uuid.UUID {
+	return *item.Outcome.WalletID
+}
+
+func (item *Transfer) ToWalletID() uuid.UUID {
+	return *item.Income.WalletID
+}
+
+func (item *Transfer) Legs() []*Transaction {
+	return []*Transaction{item.Outcome, item.Income}
+}
+
+func (item *Transfer) Currency() string {
+	return item.Income.Currency
+}
+
+func (item *Transfer) SetAmount(value decimal.Decimal) error {
+	value = value.Trunc(CurrencyScale(item.Currency()))
+
+	if value.Cmp(decimal.Zero) != 1 {
+		return ErrTransferAmountInvalid
+	}
+
+	err := item.Income.SetAmount(value)
+	if err != nil {
+		return err
+	}
+
+	return item.Outcome.SetAmount(value.Neg())
+}
+
+func (item *Transfer) SetWallets(fromWalletID uuid.UUID, toWalletID uuid.UUID) error {
+	if fromWalletID == toWalletID {
+		return ErrTransferWalletsInvalid
+	}
+
+	item.Outcome.WalletID = &fromWalletID
+	item.Income.WalletID = &toWalletID
+
+	return nil
+}
+
+// SetCurrency - валюта перевода, совпадает с валютой обоих кошельков
+func (item *Transfer) SetCurrency(value string) error {
+	for _, leg := range item.Legs() {
+		err := leg.SetCurrency(value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (item *Transfer) SetOccuredOn(value civil.Date) error {
+	for _, leg := range item.Legs() {
+		err := leg.SetOccuredOn(value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (item *Transfer) SetDescription(value string) error {
+	for _, leg := range item.Legs() {
+		err := leg.SetDescription(value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (item *Transfer) MarkDeleted() {
+	timeNow := time.Now()
+
+	for _, leg := range item.Legs() {
+		leg.DeletedAt = &timeNow
+	}
+}
+
+func NewTransfer(
+	accountID uuid.UUID,
+	fromWalletID uuid.UUID,
+	toWalletID uuid.UUID,
+	amount decimal.Decimal,
+	occurredOn civil.Date,
+	description string,
+) (*Transfer, error) {
+	timeNow := time.Now().Truncate(time.Microsecond)
+
+	item := &Transfer{
+		ID: uuid.New(),
+	}
+
+	newLeg := func(isIncome bool) *Transaction {
+		return &Transaction{
+			ID:         uuid.New(),
+			AccountID:  accountID,
+			IsIncome:   isIncome,
+			TransferID: &item.ID,
+			Tags:       []string{},
+			CreatedAt:  timeNow,
+			UpdatedAt:  timeNow,
+		}
+	}
+
+	item.Outcome = newLeg(false)
+	item.Income = newLeg(true)
+
+	err := item.SetWallets(fromWalletID, toWalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = item.SetAmount(amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = item.SetOccuredOn(occurredOn)
+	if err != nil {
+		return nil, err
+	}
+
+	err = item.SetDescription(description)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// NewTransferFromLegs - собирает перевод из загруженных транзакций
+func NewTransferFromLegs(legs []*Transaction) (*Transfer, error) {
+	if len(legs) != 2 {
+		return nil, ErrTransferLegsInvalid
+	}
+
+	item := &Transfer{}
+
+	for _, leg := range legs {
+		if leg.TransferID == nil || leg.WalletID == nil {
+			return nil, ErrTransferLegsInvalid
+		}
+
+		if leg.IsIncome {
+			item.Income = leg
+		} else {
+			item.Outcome = leg
+		}
+	}
+
+	if item.Income == nil || item.Outcome == nil || *item.Income.TransferID != *item.Outcome.TransferID {
+		return nil, ErrTransferLegsInvalid
+	}
+
+	item.ID = *item.Income.TransferID
+
+	return item, nil
+}
@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+)
+
+var ErrWalletCurrencyInvalid = appErrors.ErrBadRequest.Extend("wallet currency is invalid").
+	WithTextCode("WALLET_CURRENCY_INVALID").WithHints("wallet currency is invalid")
+
+type Wallet struct {
+	ID             uuid.UUID
+	AccountID      uuid.UUID
+	Title          string
+	Currency       string
+	OpeningBalance decimal.Decimal
+	IsArchived     bool
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+func (item *Wallet) Version() int64 {
+	return item.UpdatedAt.UnixMicro()
+}
+
+func (item *Wallet) SetTitle(value string) error {
+	value = strings.TrimSpace(value)
+
+	if value == "" {
+		return ErrTitleInvalid
+	}
+
+	item.Title = value
+
+	return nil
+}
+
+// SetCurrency - код валюты в формате ISO 4217
+func (item *Wallet) SetCurrency(value string) error {
+	value, err := NormalizeCurrency(value)
+	if err != nil {
+		return ErrWalletCurrencyInvalid
+	}
+
+	item.Currency = value
+
+	return item.SetOpeningBalance(item.OpeningBalance)
+}
+
+func (item *Wallet) SetOpeningBalance(value decimal.Decimal) error {
+	item.OpeningBalance = value.Trunc(CurrencyScale(item.Currency))
+
+	return nil
+}
+
+func NewWallet(
+	accountID uuid.UUID,
+	title string,
+	currency string,
+	openingBalance decimal.Decimal,
+) (*Wallet, error) {
+	timeNow := time.Now().Truncate(time.Microsecond)
+
+	item := &Wallet{
+		ID:        uuid.New(),
+		AccountID: accountID,
+		CreatedAt: timeNow,
+		UpdatedAt: timeNow,
+	}
+
+	err := item.SetTitle(title)
+	if err != nil {
+		return nil, err
+	}
+
+	err = item.SetCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	err = item.SetOpeningBalance(openingBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// WalletBalance - остаток кошелька на дату
+type WalletBalance struct {
+	WalletID        uuid.UUID
+	OpeningBalance  decimal.Decimal
+	TransactionsSum decimal.Decimal
+	Balance         decimal.Decimal
+}
@@ -3,16 +3,38 @@ package budget
 import (
 	"go.uber.org/fx"
 
+	transactionCAMTRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/camt/transaction"
+	csvExportRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/csv/export"
 	transactionCSVRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/csv/transaction"
+	jsonlExportRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/jsonl/export"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/notify"
+	transactionOFXRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/ofx/transaction"
+	accountSettingsRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg/accountsettings"
 	budgetRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg/budget"
 	categoryRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg/category"
+	categoryRuleRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg/categoryrule"
+	exchangeRateRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg/exchangerate"
+	importProfileRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg/importprofile"
+	outboxEventRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg/outboxevent"
+	recurringRuleRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg/recurringrule"
 	transactionRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg/transaction"
+	walletRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg/wallet"
+	transactionQIFRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/qif/transaction"
 	budgetRedisRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/redis/budget"
+	cacheGenerationRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/redis/cachegeneration"
 	transactionRedisRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/redis/transaction"
+	xlsxExportRepo "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/xlsx/export"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
 	budgetUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase/budget"
 	categoryUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase/category"
+	categoryRuleUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase/categoryrule"
+	currencyUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase/currency"
+	importProfileUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase/importprofile"
+	outboxUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase/outbox"
+	recurringRuleUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase/recurringrule"
 	transactionUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase/transaction"
+	walletUC "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase/wallet"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/worker"
 )
 
 var FxModule = fx.Module(
@@ -31,6 +53,30 @@ var FxModule = fx.Module(
 		fx.Private,
 		fx.Annotate(budgetRepo.NewRepository, fx.As(new(usecase.BudgetRepository))),
 	),
+	fx.Provide(
+		fx.Private,
+		fx.Annotate(walletRepo.NewRepository, fx.As(new(usecase.WalletRepository))),
+	),
+	fx.Provide(
+		fx.Private,
+		fx.Annotate(accountSettingsRepo.NewRepository, fx.As(new(usecase.AccountSettingsRepository))),
+	),
+	fx.Provide(
+		fx.Private,
+		fx.Annotate(exchangeRateRepo.NewRepository, fx.As(new(usecase.ExchangeRateRepository))),
+	),
+	fx.Provide(
+		fx.Private,
+		fx.Annotate(recurringRuleRepo.NewRepository, fx.As(new(usecase.RecurringRuleRepository))),
+	),
+	fx.Provide(
+		fx.Private,
+		fx.Annotate(importProfileRepo.NewRepository, fx.As(new(usecase.ImportProfileRepository))),
+	),
+	fx.Provide(
+		fx.Private,
+		fx.Annotate(categoryRuleRepo.NewRepository, fx.As(new(usecase.CategoryRuleRepository))),
+	),
 	fx.Provide(
 		fx.Private,
 		fx.Annotate(transactionRedisRepo.NewRepository, fx.As(new(usecase.TransactionCacheRepository))),
@@ -39,10 +85,72 @@ var FxModule = fx.Module(
 		fx.Private,
 		fx.Annotate(budgetRedisRepo.NewRepository, fx.As(new(usecase.BudgetCacheRepository))),
 	),
+	fx.Provide(
+		fx.Private,
+		fx.Annotate(cacheGenerationRepo.NewRepository, fx.As(new(usecase.CacheGenerationRepository))),
+	),
 	fx.Provide(
 		fx.Private,
 		fx.Annotate(transactionCSVRepo.NewRepository, fx.As(new(usecase.TransactionCSVRepository))),
 	),
+	fx.Provide(
+		fx.Private,
+		fx.Annotate(
+			transactionOFXRepo.NewRepository,
+			fx.As(new(usecase.TransactionStatementRepository)),
+			fx.ResultTags(`group:"TransactionStatementRepository"`),
+		),
+		fx.Annotate(
+			transactionQIFRepo.NewRepository,
+			fx.As(new(usecase.TransactionStatementRepository)),
+			fx.ResultTags(`group:"TransactionStatementRepository"`),
+		),
+		fx.Annotate(
+			transactionCAMTRepo.NewRepository,
+			fx.As(new(usecase.TransactionStatementRepository)),
+			fx.ResultTags(`group:"TransactionStatementRepository"`),
+		),
+	),
+	fx.Provide(
+		fx.Private,
+		fx.Annotate(
+			usecase.NewTransactionStatementRepositories,
+			fx.ParamTags(`group:"TransactionStatementRepository"`),
+		),
+	),
+	fx.Provide(
+		fx.Private,
+		fx.Annotate(
+			csvExportRepo.NewRepository,
+			fx.As(new(usecase.ExportRepository)),
+			fx.ResultTags(`group:"ExportRepository"`),
+		),
+		fx.Annotate(
+			xlsxExportRepo.NewRepository,
+			fx.As(new(usecase.ExportRepository)),
+			fx.ResultTags(`group:"ExportRepository"`),
+		),
+		fx.Annotate(
+			jsonlExportRepo.NewRepository,
+			fx.As(new(usecase.ExportRepository)),
+			fx.ResultTags(`group:"ExportRepository"`),
+		),
+	),
+	fx.Provide(
+		fx.Private,
+		fx.Annotate(
+			usecase.NewExportRepositories,
+			fx.ParamTags(`group:"ExportRepository"`),
+		),
+	),
+	fx.Provide(
+		fx.Private,
+		fx.Annotate(outboxEventRepo.NewRepository, fx.As(new(usecase.OutboxEventRepository))),
+	),
+	fx.Provide(
+		fx.Private,
+		notify.NewSinks,
+	),
 
 	// usecases
 	fx.Provide(
@@ -54,6 +162,40 @@ var FxModule = fx.Module(
 	fx.Provide(
 		fx.Annotate(budgetUC.NewUsecaseImpl, fx.As(new(usecase.BudgetUsecase))),
 	),
+	fx.Provide(
+		fx.Annotate(walletUC.NewUsecaseImpl, fx.As(new(usecase.WalletUsecase))),
+	),
+	fx.Provide(
+		fx.Annotate(currencyUC.NewUsecaseImpl, fx.As(new(usecase.CurrencyUsecase))),
+	),
+	fx.Provide(
+		fx.Annotate(importProfileUC.NewUsecaseImpl, fx.As(new(usecase.ImportProfileUsecase))),
+	),
+	fx.Provide(
+		fx.Annotate(categoryRuleUC.NewUsecaseImpl, fx.As(new(usecase.CategoryRuleUsecase))),
+	),
+
+	fx.Provide(
+		fx.Annotate(recurringRuleUC.NewUsecaseImpl, fx.As(new(usecase.RecurringRuleUsecase))),
+	),
+	fx.Provide(
+		fx.Private,
+		fx.Annotate(outboxUC.NewUsecaseImpl, fx.As(new(usecase.OutboxUsecase))),
+	),
+
+	// workers
+	fx.Provide(
+		fx.Private,
+		worker.NewRecurringWorker,
+	),
+	fx.Provide(
+		fx.Private,
+		worker.NewBudgetRolloverWorker,
+	),
+	fx.Provide(
+		fx.Private,
+		worker.NewOutboxWorker,
+	),
 
 	// facade
 	fx.Provide(
@@ -1,12 +1,47 @@
 package budget
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/m11ano/budget_planner/backend/ledger/internal/app"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/app/fxboot/invoking"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/worker"
 )
 
-func Init(_ app.ID, logger *slog.Logger) invoking.InvokeInit {
-	return invoking.InvokeInit{}
+func Init(
+	_ app.ID,
+	logger *slog.Logger,
+	recurringWorker *worker.RecurringWorker,
+	budgetRolloverWorker *worker.BudgetRolloverWorker,
+	outboxWorker *worker.OutboxWorker,
+) invoking.InvokeInit {
+	return invoking.InvokeInit{
+		StartAfterOpen: func(ctx context.Context) error {
+			err := recurringWorker.Start(ctx)
+			if err != nil {
+				return err
+			}
+
+			err = budgetRolloverWorker.Start(ctx)
+			if err != nil {
+				return err
+			}
+
+			return outboxWorker.Start(ctx)
+		},
+		Stop: func(ctx context.Context) error {
+			err := recurringWorker.Stop(ctx)
+			if err != nil {
+				return err
+			}
+
+			err = budgetRolloverWorker.Stop(ctx)
+			if err != nil {
+				return err
+			}
+
+			return outboxWorker.Stop(ctx)
+		},
+	}
 }
@@ -0,0 +1,273 @@
+package transaction
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/samber/lo"
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// notProvided - значение обязательных ссылок ISO 20022, когда ссылки нет
+const notProvided = "NOTPROVIDED"
+
+// document - выписка camt.053, теги без пространства имен подходят для всех версий схемы
+type document struct {
+	XMLName    xml.Name `xml:"Document"`
+	Statements []struct {
+		Acct struct {
+			Ccy string `xml:"Ccy"`
+		} `xml:"Acct"`
+		Entries []*entry `xml:"Ntry"`
+	} `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type entry struct {
+	NtryRef string `xml:"NtryRef"`
+	Amt     struct {
+		Value string `xml:",chardata"`
+		Ccy   string `xml:"Ccy,attr"`
+	} `xml:"Amt"`
+	// CdtDbtInd - направление самой записи, в том числе для сторно
+	CdtDbtInd string `xml:"CdtDbtInd"`
+	// Sts - до версии 08 код статуса, с версии 08 - вложенный Cd
+	Sts struct {
+		Value string `xml:",chardata"`
+		Cd    string `xml:"Cd"`
+	} `xml:"Sts"`
+	BookgDt     dateAndDateTime `xml:"BookgDt"`
+	ValDt       dateAndDateTime `xml:"ValDt"`
+	AcctSvcrRef string          `xml:"AcctSvcrRef"`
+	AddtlInf    string          `xml:"AddtlNtryInf"`
+	TxDtls      []struct {
+		Refs struct {
+			AcctSvcrRef string `xml:"AcctSvcrRef"`
+			EndToEndID  string `xml:"EndToEndId"`
+		} `xml:"Refs"`
+		RltdPties struct {
+			Dbtr string `xml:"Dbtr>Nm"`
+			Cdtr string `xml:"Cdtr>Nm"`
+			// с версии 08 стороны вложены в Pty
+			DbtrPty string `xml:"Dbtr>Pty>Nm"`
+			CdtrPty string `xml:"Cdtr>Pty>Nm"`
+		} `xml:"RltdPties"`
+		Ustrd []string `xml:"RmtInf>Ustrd"`
+	} `xml:"NtryDtls>TxDtls"`
+}
+
+type dateAndDateTime struct {
+	Dt   string `xml:"Dt"`
+	DtTm string `xml:"DtTm"`
+}
+
+func (d dateAndDateTime) isEmpty() bool {
+	return d.Dt == "" && d.DtTm == ""
+}
+
+func (d dateAndDateTime) date() (civil.Date, error) {
+	if value := strings.TrimSpace(d.Dt); value != "" {
+		return civil.ParseDate(value)
+	}
+
+	parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(d.DtTm))
+	if err != nil {
+		// ISODateTime допускает время без зоны
+		parsed, err = time.Parse("2006-01-02T15:04:05", strings.TrimSpace(d.DtTm))
+		if err != nil {
+			return civil.Date{}, err
+		}
+	}
+
+	return civil.DateOf(parsed), nil
+}
+
+func (e *entry) status() string {
+	if e.Sts.Cd != "" {
+		return strings.ToUpper(strings.TrimSpace(e.Sts.Cd))
+	}
+
+	return strings.ToUpper(strings.TrimSpace(e.Sts.Value))
+}
+
+// externalID - ссылка банка на операцию, NtryRef уникален только внутри выписки и используется последним
+func (e *entry) externalID() string {
+	refs := []string{e.AcctSvcrRef}
+	for _, details := range e.TxDtls {
+		refs = append(refs, details.Refs.AcctSvcrRef, details.Refs.EndToEndID)
+	}
+	refs = append(refs, e.NtryRef)
+
+	for _, ref := range refs {
+		ref = strings.TrimSpace(ref)
+		if ref != "" && ref != notProvided {
+			return ref
+		}
+	}
+
+	return ""
+}
+
+func (e *entry) description(isIncome bool) string {
+	parts := make([]string, 0, 2)
+
+	for _, details := range e.TxDtls {
+		counterparty := details.RltdPties.Cdtr + details.RltdPties.CdtrPty
+		if isIncome {
+			counterparty = details.RltdPties.Dbtr + details.RltdPties.DbtrPty
+		}
+
+		if counterparty = strings.TrimSpace(counterparty); counterparty != "" {
+			parts = append(parts, counterparty)
+		}
+
+		for _, value := range details.Ustrd {
+			if value = strings.TrimSpace(value); value != "" {
+				parts = append(parts, value)
+			}
+		}
+	}
+
+	if len(parts) == 0 {
+		return strings.TrimSpace(e.AddtlInf)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func (r *Repository) Format() usecase.TransactionImportFormat {
+	return usecase.TransactionImportFormatCAMT053
+}
+
+func (r *Repository) ItemsFromStatement(
+	_ context.Context,
+	data []byte,
+	accountID uuid.UUID,
+	defaultCurrency string,
+	defaultCategoryID *uint64,
+) ([]*usecase.TransactionImportRow, error) {
+	const op = "ItemsFromStatement"
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.CharsetReader = charsetReader
+
+	doc := &document{}
+	if err := decoder.Decode(doc); err != nil {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid camt.053"), "%s.%s", r.pkg, op)
+	}
+
+	if len(doc.Statements) == 0 {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("invalid camt.053: Stmt not found"), "%s.%s", r.pkg, op)
+	}
+
+	var out []*usecase.TransactionImportRow
+
+	for _, statement := range doc.Statements {
+		currency := strings.TrimSpace(statement.Acct.Ccy)
+		if currency == "" {
+			currency = defaultCurrency
+		}
+
+		for _, item := range statement.Entries {
+			// ожидающие и информационные записи еще не проведены по счету
+			if status := item.status(); status != "" && status != "BOOK" {
+				continue
+			}
+
+			transaction, err := transactionFromEntry(item, accountID, currency, defaultCategoryID)
+
+			out = append(out, &usecase.TransactionImportRow{
+				Line:        len(out) + 1,
+				Transaction: transaction,
+				Err:         err,
+			})
+		}
+	}
+
+	return out, nil
+}
+
+func charsetReader(label string, input io.Reader) (io.Reader, error) {
+	enc, err := htmlindex.Get(label)
+	if err != nil {
+		return nil, err
+	}
+
+	return enc.NewDecoder().Reader(input), nil
+}
+
+func transactionFromEntry(
+	item *entry,
+	accountID uuid.UUID,
+	currency string,
+	defaultCategoryID *uint64,
+) (*entity.Transaction, error) {
+	dates := item.BookgDt
+	if dates.isEmpty() {
+		dates = item.ValDt
+	}
+
+	occurredOn, err := dates.date()
+	if err != nil {
+		return nil, appErrors.ErrBadRequest.WithParent(err).WithHints("invalid BookgDt")
+	}
+
+	amount, err := decimal.Parse(strings.TrimSpace(item.Amt.Value))
+	if err != nil {
+		return nil, appErrors.ErrBadRequest.WithParent(err).WithHints("invalid Amt")
+	}
+
+	var isIncome bool
+
+	switch strings.ToUpper(strings.TrimSpace(item.CdtDbtInd)) {
+	case "CRDT":
+		isIncome = true
+	case "DBIT":
+		isIncome = false
+	default:
+		return nil, appErrors.ErrBadRequest.WithHints("invalid CdtDbtInd")
+	}
+
+	amount = amount.Abs()
+	if !isIncome {
+		amount = amount.Neg()
+	}
+
+	if value := strings.TrimSpace(item.Amt.Ccy); value != "" {
+		currency = value
+	}
+
+	transaction, err := entity.NewTransaction(
+		accountID,
+		isIncome,
+		amount,
+		currency,
+		occurredOn,
+		// без категории по умолчанию категорию подберут правила аккаунта
+		lo.FromPtr(defaultCategoryID),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = transaction.SetDescription(item.description(isIncome))
+	if err != nil {
+		return nil, err
+	}
+
+	err = transaction.SetExternalID(item.externalID())
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
@@ -0,0 +1,183 @@
+package transaction
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRepository() *Repository {
+	return NewRepository(slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{})))
+}
+
+func camtStatement(acctCcy string, entries string) []byte {
+	return []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08">
+  <BkToCstmrStmt>
+    <Stmt>
+      <Acct><Ccy>` + acctCcy + `</Ccy></Acct>
+      ` + entries + `
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>`)
+}
+
+func TestCAMTRepository_ItemsFromStatement_Table(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		acctCcy string
+		entry   string
+
+		wantErr         bool
+		wantAmount      string
+		wantIsIncome    bool
+		wantCurrency    string
+		wantOccurredOn  civil.Date
+		wantDescription string
+		wantExternalID  string
+	}{
+		{
+			name:    "OK_debit_booking_date",
+			acctCcy: "EUR",
+			entry: `<Ntry><NtryRef>1</NtryRef><Amt Ccy="EUR">12.50</Amt><CdtDbtInd>DBIT</CdtDbtInd>
+				<Sts><Cd>BOOK</Cd></Sts><BookgDt><Dt>2025-01-15</Dt></BookgDt>
+				<NtryDtls><TxDtls><Refs><EndToEndId>E2E-1</EndToEndId></Refs>
+				<RltdPties><Cdtr><Pty><Nm>Coffee Shop</Nm></Pty></Cdtr></RltdPties>
+				<RmtInf><Ustrd>Invoice 7</Ustrd></RmtInf></TxDtls></NtryDtls></Ntry>`,
+			wantAmount:      "-12.50",
+			wantCurrency:    "EUR",
+			wantOccurredOn:  civil.Date{Year: 2025, Month: 1, Day: 15},
+			wantDescription: "Coffee Shop Invoice 7",
+			wantExternalID:  "E2E-1",
+		},
+		{
+			name:    "OK_credit_datetime_with_zone_and_old_status",
+			acctCcy: "EUR",
+			entry: `<Ntry><NtryRef>2</NtryRef><Amt Ccy="USD">100</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts>BOOK</Sts>
+				<BookgDt><DtTm>2025-02-01T23:30:00+03:00</DtTm></BookgDt><AcctSvcrRef>NOTPROVIDED</AcctSvcrRef>
+				<NtryDtls><TxDtls><RltdPties><Dbtr><Nm>Employer</Nm></Dbtr></RltdPties></TxDtls></NtryDtls></Ntry>`,
+			wantAmount:      "100",
+			wantIsIncome:    true,
+			wantCurrency:    "USD",
+			wantOccurredOn:  civil.Date{Year: 2025, Month: 2, Day: 1},
+			wantDescription: "Employer",
+			wantExternalID:  "2",
+		},
+		{
+			name: "OK_value_date_without_zone_and_additional_info",
+			entry: `<Ntry><AcctSvcrRef>BANK-3</AcctSvcrRef><Amt>-5.00</Amt><CdtDbtInd>DBIT</CdtDbtInd>
+				<ValDt><DtTm>2025-03-01T10:00:00</DtTm></ValDt><AddtlNtryInf>Card fee</AddtlNtryInf></Ntry>`,
+			wantAmount:      "-5.00",
+			wantCurrency:    "RUB",
+			wantOccurredOn:  civil.Date{Year: 2025, Month: 3, Day: 1},
+			wantDescription: "Card fee",
+			wantExternalID:  "BANK-3",
+		},
+		{
+			name:    "row_error_invalid_date",
+			acctCcy: "EUR",
+			entry:   `<Ntry><Amt>1</Amt><CdtDbtInd>DBIT</CdtDbtInd><BookgDt><Dt>15.01.2025</Dt></BookgDt></Ntry>`,
+			wantErr: true,
+		},
+		{
+			name:    "row_error_comma_amount",
+			acctCcy: "EUR",
+			entry:   `<Ntry><Amt>1,5</Amt><CdtDbtInd>DBIT</CdtDbtInd><BookgDt><Dt>2025-01-15</Dt></BookgDt></Ntry>`,
+			wantErr: true,
+		},
+		{
+			name:    "row_error_invalid_direction",
+			acctCcy: "EUR",
+			entry:   `<Ntry><Amt>1</Amt><CdtDbtInd>X</CdtDbtInd><BookgDt><Dt>2025-01-15</Dt></BookgDt></Ntry>`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			accID := uuid.New()
+
+			rows, err := newTestRepository().ItemsFromStatement(context.Background(), camtStatement(tt.acctCcy, tt.entry), accID, "RUB", nil)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+			require.Equal(t, 1, rows[0].Line)
+
+			if tt.wantErr {
+				require.ErrorIs(t, rows[0].Err, appErrors.ErrBadRequest)
+				require.Nil(t, rows[0].Transaction)
+				return
+			}
+
+			require.NoError(t, rows[0].Err)
+
+			item := rows[0].Transaction
+			require.Equal(t, accID, item.AccountID)
+			require.Equal(t, tt.wantAmount, item.Amount.String())
+			require.Equal(t, tt.wantIsIncome, item.IsIncome)
+			require.Equal(t, tt.wantCurrency, item.Currency)
+			require.Equal(t, tt.wantOccurredOn, item.OccurredOn)
+			require.Equal(t, tt.wantDescription, item.Description)
+			require.NotNil(t, item.ExternalID)
+			require.Equal(t, tt.wantExternalID, *item.ExternalID)
+		})
+	}
+}
+
+func TestCAMTRepository_ItemsFromStatement_SkipsNotBooked(t *testing.T) {
+	t.Parallel()
+
+	entries := `<Ntry><Amt>1</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts><Cd>PDNG</Cd></Sts><BookgDt><Dt>2025-01-15</Dt></BookgDt></Ntry>
+		<Ntry><Amt>2</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts>INFO</Sts><BookgDt><Dt>2025-01-15</Dt></BookgDt></Ntry>
+		<Ntry><Amt>3</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts><Cd>BOOK</Cd></Sts><BookgDt><Dt>2025-01-15</Dt></BookgDt></Ntry>`
+
+	rows, err := newTestRepository().ItemsFromStatement(context.Background(), camtStatement("EUR", entries), uuid.New(), "RUB", nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, "-3", rows[0].Transaction.Amount.String())
+}
+
+func TestCAMTRepository_ItemsFromStatement_Invalid_Table(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "not_xml",
+			data: "date,amount\n",
+		},
+		{
+			name: "broken_xml",
+			data: "<Document><BkToCstmrStmt><Stmt></Document>",
+		},
+		{
+			name: "without_statements",
+			data: "<Document><BkToCstmrStmt></BkToCstmrStmt></Document>",
+		},
+		{
+			name: "other_root",
+			data: "<OFX><BkToCstmrStmt><Stmt></Stmt></BkToCstmrStmt></OFX>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rows, err := newTestRepository().ItemsFromStatement(context.Background(), []byte(tt.data), uuid.New(), "RUB", nil)
+			require.ErrorIs(t, err, appErrors.ErrBadRequest)
+			require.Nil(t, rows)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+
+	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+func (r *Repository) Format() usecase.ExportFormat {
+	return usecase.ExportFormatCSV
+}
+
+func (r *Repository) ContentType() string {
+	return "text/csv; charset=utf-8"
+}
+
+func (r *Repository) FileExtension() string {
+	return "csv"
+}
+
+func (r *Repository) TransactionsToFile(_ context.Context, items []*usecase.TransactionDTO) ([]byte, error) {
+	const op = "TransactionsToFile"
+
+	rows := make([][]string, 0, len(items)+1)
+	rows = append(rows, []string{
+		"ID",
+		"AccountID",
+		"IsIncome",
+		"Amount",
+		"Currency",
+		"OccurredOn",
+		"Description",
+		"CategoryID",
+		"CategoryTitle",
+	})
+
+	for _, item := range items {
+		categoryTitle := ""
+		if item.Category != nil {
+			categoryTitle = item.Category.Title
+		}
+
+		rows = append(rows, []string{
+			item.Transaction.ID.String(),
+			item.Transaction.AccountID.String(),
+			fmt.Sprintf("%t", item.Transaction.IsIncome),
+			item.Transaction.Amount.String(),
+			item.Transaction.Currency,
+			item.Transaction.OccurredOn.String(),
+			item.Transaction.Description,
+			fmt.Sprintf("%d", item.Transaction.CategoryID),
+			categoryTitle,
+		})
+	}
+
+	data, err := writeCSV(rows)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", r.pkg, op)
+	}
+
+	return data, nil
+}
+
+func (r *Repository) ReportsToFile(_ context.Context, items []*usecase.ReportExportRow) ([]byte, error) {
+	const op = "ReportsToFile"
+
+	rows := make([][]string, 0, len(items)+1)
+	rows = append(rows, []string{
+		"PeriodStart",
+		"PeriodEnd",
+		"Currency",
+		"CategoryID",
+		"CategoryTitle",
+		"ParentCategoryID",
+		"Sum",
+		"TotalSum",
+		"Budget",
+		"CarriedOver",
+		"EffectiveBudget",
+		"SpentBudget",
+		"TotalBudget",
+		"TotalSpentBudget",
+	})
+
+	for _, item := range items {
+		parentCategoryID := ""
+		if item.ParentCategoryID != nil {
+			parentCategoryID = fmt.Sprintf("%d", *item.ParentCategoryID)
+		}
+
+		rows = append(rows, []string{
+			item.PeriodStart.String(),
+			item.PeriodEnd.String(),
+			item.Currency,
+			fmt.Sprintf("%d", item.CategoryID),
+			item.CategoryTitle,
+			parentCategoryID,
+			decimalString(item.Sum),
+			decimalString(item.TotalSum),
+			decimalString(item.Budget),
+			decimalString(item.CarriedOver),
+			decimalString(item.EffectiveBudget),
+			decimalString(item.SpentBudget),
+			decimalString(item.TotalBudget),
+			decimalString(item.TotalSpentBudget),
+		})
+	}
+
+	data, err := writeCSV(rows)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", r.pkg, op)
+	}
+
+	return data, nil
+}
+
+func writeCSV(rows [][]string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.Write(utf8BOM)
+
+	writer := csv.NewWriter(buf)
+
+	err := writer.WriteAll(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decimalString(value *decimal.Decimal) string {
+	if value == nil {
+		return ""
+	}
+
+	return value.String()
+}
@@ -19,36 +19,60 @@ import (
 func (r *Repository) ItemsToCSV(ctx context.Context, items []*usecase.TransactionDTO) ([]byte, error) {
 	const op = "ItemsToCSV"
 
+	data, err := r.ItemsToCSVChunk(ctx, items, true)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", r.pkg, op)
+	}
+
+	return data, nil
+}
+
+func (r *Repository) ItemsToCSVChunk(
+	ctx context.Context,
+	items []*usecase.TransactionDTO,
+	withHeader bool,
+) ([]byte, error) {
+	const op = "ItemsToCSVChunk"
+
 	buf := &bytes.Buffer{}
 	writer := csv.NewWriter(buf)
 
-	buf.Write([]byte{0xEF, 0xBB, 0xBF})
-
-	headers := []string{
-		"ID",
-		"AccountID",
-		"IsIncome",
-		"Amount",
-		"OccurredOn",
-		"Description",
-		"CategoryID",
-		"CategoryTitle",
-	}
+	if withHeader {
+		buf.Write([]byte{0xEF, 0xBB, 0xBF})
+
+		headers := []string{
+			"ID",
+			"AccountID",
+			"IsIncome",
+			"Amount",
+			"Currency",
+			"OccurredOn",
+			"Description",
+			"CategoryID",
+			"CategoryTitle",
+		}
 
-	if err := writer.Write(headers); err != nil {
-		return nil, appErrors.Chainf(err, "%s.%s", r.pkg, op)
+		if err := writer.Write(headers); err != nil {
+			return nil, appErrors.Chainf(err, "%s.%s", r.pkg, op)
+		}
 	}
 
 	for _, row := range items {
+		categoryTitle := ""
+		if row.Category != nil {
+			categoryTitle = row.Category.Title
+		}
+
 		row := []string{
 			row.Transaction.ID.String(),
 			row.Transaction.AccountID.String(),
 			fmt.Sprintf("%t", row.Transaction.IsIncome),
 			row.Transaction.Amount.String(),
+			row.Transaction.Currency,
 			row.Transaction.OccurredOn.String(),
 			row.Transaction.Description,
 			fmt.Sprintf("%d", row.Transaction.CategoryID),
-			row.Category.Title,
+			categoryTitle,
 		}
 
 		if err := writer.Write(row); err != nil {
@@ -64,107 +88,107 @@ func (r *Repository) ItemsToCSV(ctx context.Context, items []*usecase.Transactio
 	return buf.Bytes(), nil
 }
 
-func (r *Repository) ItemsFromCSV(ctx context.Context, data []byte, accountID uuid.UUID) ([]*entity.Transaction, error) {
+func (r *Repository) ItemsFromCSV(
+	ctx context.Context,
+	data []byte,
+	accountID uuid.UUID,
+	defaultCurrency string,
+	profile *entity.ImportProfile,
+) ([]*usecase.TransactionImportRow, error) {
 	const op = "ItemsFromCSV"
 
+	if profile != nil {
+		rows, err := r.itemsFromCSVWithProfile(data, accountID, defaultCurrency, profile)
+		if err != nil {
+			return nil, appErrors.Chainf(err, "%s.%s", r.pkg, op)
+		}
+
+		return rows, nil
+	}
+
 	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
 
 	records, err := reader.ReadAll()
 	if err != nil {
-		return nil, appErrors.Chainf(err, "%s.%s", r.pkg, op)
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid csv"), "%s.%s", r.pkg, op)
 	}
 
-	out := make([]*entity.Transaction, 0, len(records))
+	out := make([]*usecase.TransactionImportRow, 0, len(records))
 
 	for idx, row := range records {
-		if len(row) < 5 {
-			return nil, appErrors.Chainf(
-				appErrors.ErrBadRequest.WithHints("invalid number of columns", fmt.Sprintf("line %d", idx+1)),
-				"%s.%s",
-				r.pkg,
-				op,
-			)
-		}
+		transaction, err := transactionFromRow(row, accountID, defaultCurrency)
 
-		isIncome := false
-		if strings.ToLower(row[0]) == "true" {
-			isIncome = true
-		}
+		out = append(out, &usecase.TransactionImportRow{
+			Line:        idx + 1,
+			Transaction: transaction,
+			Err:         err,
+		})
+	}
 
-		amount, err := decimal.Parse(row[1])
-		if err != nil {
-			return nil, appErrors.Chainf(
-				appErrors.ErrBadRequest.WithParent(err).WithHints("invalid amount", fmt.Sprintf("line %d", idx)),
-				"%s.%s",
-				r.pkg,
-				op,
-			)
-		}
+	return out, nil
+}
 
-		occurredOn, err := civil.ParseDate(row[2])
-		if err != nil {
-			return nil, appErrors.Chainf(
-				appErrors.ErrBadRequest.WithParent(err).WithHints("invalid occurred_on", fmt.Sprintf("line %d", idx+1)),
-				"%s.%s",
-				r.pkg,
-				op,
-			)
-		}
+// transactionFromRow - строка позиционного формата:
+// is_income, amount, occurred_on, description, category_id[, currency[, external_id]]
+func transactionFromRow(row []string, accountID uuid.UUID, defaultCurrency string) (*entity.Transaction, error) {
+	if len(row) < 5 {
+		return nil, appErrors.ErrBadRequest.WithHints("invalid number of columns")
+	}
 
-		categoryID, err := strconv.ParseUint(row[4], 10, 64)
-		if err != nil {
-			return nil, appErrors.Chainf(
-				appErrors.ErrBadRequest.WithParent(err).WithHints("invalid category_id", fmt.Sprintf("line %d", idx+1)),
-				"%s.%s",
-				r.pkg,
-				op,
-			)
-		}
+	isIncome := false
+	if strings.ToLower(row[0]) == "true" {
+		isIncome = true
+	}
+
+	amount, err := decimal.Parse(row[1])
+	if err != nil {
+		return nil, appErrors.ErrBadRequest.WithParent(err).WithHints("invalid amount")
+	}
+
+	occurredOn, err := civil.ParseDate(row[2])
+	if err != nil {
+		return nil, appErrors.ErrBadRequest.WithParent(err).WithHints("invalid occurred_on")
+	}
+
+	// пустая категория - категорию подберут правила аккаунта
+	var categoryID uint64
 
-		transaction, err := entity.NewTransaction(
-			accountID,
-			isIncome,
-			amount,
-			occurredOn,
-			categoryID,
-		)
+	if value := strings.TrimSpace(row[4]); value != "" {
+		categoryID, err = strconv.ParseUint(value, 10, 64)
 		if err != nil {
-			appErr, ok := appErrors.ExtractError(err)
-			if ok {
-				hints := appErr.Hints()
-				hints = append(hints, fmt.Sprintf("line %d", idx+1))
-
-				return nil, appErrors.Chainf(
-					appErr.WithHints(hints...),
-					"%s.%s: line %d",
-					r.pkg,
-					op,
-					idx+1,
-				)
-			}
-			return nil, appErrors.Chainf(err, "%s.%s: line %d", r.pkg, op, idx+1)
+			return nil, appErrors.ErrBadRequest.WithParent(err).WithHints("invalid category_id")
 		}
+	}
 
-		err = transaction.SetDescription(row[3])
+	currency := defaultCurrency
+	if len(row) > 5 && strings.TrimSpace(row[5]) != "" {
+		currency = row[5]
+	}
+
+	transaction, err := entity.NewTransaction(
+		accountID,
+		isIncome,
+		amount,
+		currency,
+		occurredOn,
+		categoryID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = transaction.SetDescription(row[3])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(row) > 6 {
+		err = transaction.SetExternalID(row[6])
 		if err != nil {
-			appErr, ok := appErrors.ExtractError(err)
-			if ok {
-				hints := appErr.Hints()
-				hints = append(hints, fmt.Sprintf("line %d", idx+1))
-
-				return nil, appErrors.Chainf(
-					appErr.WithHints(hints...),
-					"%s.%s: line %d",
-					r.pkg,
-					op,
-					idx+1,
-				)
-			}
-			return nil, appErrors.Chainf(err, "%s.%s: line %d", r.pkg, op, idx+1)
+			return nil, err
 		}
-
-		out = append(out, transaction)
 	}
 
-	return out, nil
+	return transaction, nil
 }
@@ -0,0 +1,352 @@
+package transaction
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+)
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// profileColumns - номера колонок профиля в файле, -1 - колонки нет
+type profileColumns struct {
+	date        int
+	amount      int
+	debit       int
+	credit      int
+	isIncome    int
+	description int
+	categoryID  int
+	currency    int
+	externalID  int
+}
+
+func decodeImportData(data []byte, enc entity.ImportEncoding) ([]byte, error) {
+	var decoder *encoding.Decoder
+
+	switch enc {
+	case entity.ImportEncodingWindows1251:
+		decoder = charmap.Windows1251.NewDecoder()
+	case entity.ImportEncodingKOI8R:
+		decoder = charmap.KOI8R.NewDecoder()
+	case entity.ImportEncodingCP866:
+		decoder = charmap.CodePage866.NewDecoder()
+	default:
+		return bytes.TrimPrefix(data, utf8BOM), nil
+	}
+
+	decoded, err := decoder.Bytes(data)
+	if err != nil {
+		return nil, appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid file encoding")
+	}
+
+	return decoded, nil
+}
+
+func resolveProfileColumns(profile *entity.ImportProfile, header []string) (*profileColumns, error) {
+	headerIdx := make(map[string]int, len(header))
+	for idx, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := headerIdx[name]; !ok {
+			headerIdx[name] = idx
+		}
+	}
+
+	resolve := func(column string) (int, error) {
+		if column == "" {
+			return -1, nil
+		}
+
+		if idx, ok := entity.ImportColumnIndex(column); ok {
+			return idx, nil
+		}
+
+		idx, ok := headerIdx[strings.ToLower(strings.TrimSpace(column))]
+		if !ok {
+			return -1, appErrors.ErrBadRequest.WithHints(fmt.Sprintf("column %q not found in header", column))
+		}
+
+		return idx, nil
+	}
+
+	out := &profileColumns{}
+
+	for _, it := range []struct {
+		column string
+		target *int
+	}{
+		{profile.Columns.Date, &out.date},
+		{profile.Columns.Amount, &out.amount},
+		{profile.Columns.Debit, &out.debit},
+		{profile.Columns.Credit, &out.credit},
+		{profile.Columns.IsIncome, &out.isIncome},
+		{profile.Columns.Description, &out.description},
+		{profile.Columns.CategoryID, &out.categoryID},
+		{profile.Columns.Currency, &out.currency},
+		{profile.Columns.ExternalID, &out.externalID},
+	} {
+		idx, err := resolve(it.column)
+		if err != nil {
+			return nil, err
+		}
+
+		*it.target = idx
+	}
+
+	return out, nil
+}
+
+func cellValue(row []string, idx int) string {
+	if idx < 0 || idx >= len(row) {
+		return ""
+	}
+
+	return strings.TrimSpace(row[idx])
+}
+
+// parseImportAmount - разбирает сумму с учетом десятичного разделителя профиля,
+// пробелы и разделители разрядов отбрасываются
+func parseImportAmount(value string, decimalSeparator string) (decimal.Decimal, error) {
+	value = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\u00a0', '\u202f', '\'':
+			return -1
+		}
+		return r
+	}, value)
+
+	if decimalSeparator == "," {
+		value = strings.ReplaceAll(value, ".", "")
+		value = strings.ReplaceAll(value, ",", ".")
+	} else {
+		value = strings.ReplaceAll(value, ",", "")
+	}
+
+	value = strings.TrimPrefix(value, "+")
+
+	return decimal.Parse(value)
+}
+
+// signedAmount - сумма со знаком по правилу профиля: доход положительный, расход отрицательный
+func signedAmount(
+	profile *entity.ImportProfile,
+	columns *profileColumns,
+	row []string,
+) (bool, decimal.Decimal, error) {
+	parse := func(idx int, name string) (decimal.Decimal, bool, error) {
+		value := cellValue(row, idx)
+		if value == "" {
+			return decimal.Zero, false, nil
+		}
+
+		amount, err := parseImportAmount(value, profile.DecimalSeparator)
+		if err != nil {
+			return decimal.Zero, false, appErrors.ErrBadRequest.WithParent(err).WithHints("invalid " + name)
+		}
+
+		return amount, true, nil
+	}
+
+	switch profile.SignConvention {
+	case entity.ImportSignConventionSplit:
+		debit, hasDebit, err := parse(columns.debit, "debit")
+		if err != nil {
+			return false, decimal.Zero, err
+		}
+
+		if hasDebit && !debit.IsZero() {
+			return false, debit.Abs().Neg(), nil
+		}
+
+		credit, hasCredit, err := parse(columns.credit, "credit")
+		if err != nil {
+			return false, decimal.Zero, err
+		}
+
+		if !hasDebit && !hasCredit {
+			return false, decimal.Zero, appErrors.ErrBadRequest.WithHints("amount is empty")
+		}
+
+		return true, credit.Abs(), nil
+	case entity.ImportSignConventionFlag:
+		amount, ok, err := parse(columns.amount, "amount")
+		if err != nil {
+			return false, decimal.Zero, err
+		}
+
+		if !ok {
+			return false, decimal.Zero, appErrors.ErrBadRequest.WithHints("amount is empty")
+		}
+
+		if profile.IsIncomeValue(cellValue(row, columns.isIncome)) {
+			return true, amount.Abs(), nil
+		}
+
+		return false, amount.Abs().Neg(), nil
+	default:
+		amount, ok, err := parse(columns.amount, "amount")
+		if err != nil {
+			return false, decimal.Zero, err
+		}
+
+		if !ok {
+			return false, decimal.Zero, appErrors.ErrBadRequest.WithHints("amount is empty")
+		}
+
+		if profile.SignConvention == entity.ImportSignConventionInverted {
+			amount = amount.Neg()
+		}
+
+		return amount.Sign() > 0, amount, nil
+	}
+}
+
+func isEmptyRow(row []string) bool {
+	for _, value := range row {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *Repository) itemsFromCSVWithProfile(
+	data []byte,
+	accountID uuid.UUID,
+	defaultCurrency string,
+	profile *entity.ImportProfile,
+) ([]*usecase.TransactionImportRow, error) {
+	const op = "itemsFromCSVWithProfile"
+
+	data, err := decodeImportData(data, profile.Encoding)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", r.pkg, op)
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = []rune(profile.Delimiter)[0]
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid csv"), "%s.%s", r.pkg, op)
+	}
+
+	// номер строки файла для подсказок, с учетом пропущенных строк и заголовка
+	lineOffset := min(profile.SkipRows, len(records))
+	records = records[lineOffset:]
+
+	var header []string
+	if profile.HasHeader {
+		if len(records) == 0 {
+			return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("header not found"), "%s.%s", r.pkg, op)
+		}
+
+		header = records[0]
+		records = records[1:]
+		lineOffset++
+	}
+
+	columns, err := resolveProfileColumns(profile, header)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", r.pkg, op)
+	}
+
+	dateLayout := profile.GoDateLayout()
+
+	out := make([]*usecase.TransactionImportRow, 0, len(records))
+
+	for idx, row := range records {
+		if isEmptyRow(row) {
+			continue
+		}
+
+		transaction, err := r.transactionFromProfileRow(
+			row, accountID, defaultCurrency, profile, columns, dateLayout,
+		)
+
+		out = append(out, &usecase.TransactionImportRow{
+			Line:        lineOffset + idx + 1,
+			Transaction: transaction,
+			Err:         err,
+		})
+	}
+
+	return out, nil
+}
+
+func (r *Repository) transactionFromProfileRow(
+	row []string,
+	accountID uuid.UUID,
+	defaultCurrency string,
+	profile *entity.ImportProfile,
+	columns *profileColumns,
+	dateLayout string,
+) (*entity.Transaction, error) {
+	occurredOnTime, err := time.Parse(dateLayout, cellValue(row, columns.date))
+	if err != nil {
+		return nil, appErrors.ErrBadRequest.WithParent(err).WithHints("invalid occurred_on")
+	}
+
+	isIncome, amount, err := signedAmount(profile, columns, row)
+	if err != nil {
+		return nil, err
+	}
+
+	// 0 - категорию подберут правила аккаунта
+	var categoryID uint64
+
+	categoryValue := cellValue(row, columns.categoryID)
+	switch {
+	case categoryValue != "":
+		categoryID, err = strconv.ParseUint(categoryValue, 10, 64)
+		if err != nil {
+			return nil, appErrors.ErrBadRequest.WithParent(err).WithHints("invalid category_id")
+		}
+	case profile.DefaultCategoryID != nil:
+		categoryID = *profile.DefaultCategoryID
+	}
+
+	currency := defaultCurrency
+	if value := cellValue(row, columns.currency); value != "" {
+		currency = value
+	}
+
+	transaction, err := entity.NewTransaction(
+		accountID,
+		isIncome,
+		amount,
+		currency,
+		civil.DateOf(occurredOnTime),
+		categoryID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = transaction.SetDescription(cellValue(row, columns.description))
+	if err != nil {
+		return nil, err
+	}
+
+	err = transaction.SetExternalID(cellValue(row, columns.externalID))
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
@@ -0,0 +1,132 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"cloud.google.com/go/civil"
+	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// transactionLine - суммы выгружаются строками, чтобы не терять точность при разборе JSON как float
+type transactionLine struct {
+	ID            string     `json:"id"`
+	AccountID     string     `json:"accountID"`
+	IsIncome      bool       `json:"isIncome"`
+	Amount        string     `json:"amount"`
+	Currency      string     `json:"currency"`
+	OccurredOn    civil.Date `json:"occurredOn"`
+	Description   string     `json:"description"`
+	CategoryID    uint64     `json:"categoryID"`
+	CategoryTitle *string    `json:"categoryTitle"`
+	WalletID      *string    `json:"walletID"`
+}
+
+type reportLine struct {
+	PeriodStart      civil.Date `json:"periodStart"`
+	PeriodEnd        civil.Date `json:"periodEnd"`
+	Currency         string     `json:"currency"`
+	CategoryID       uint64     `json:"categoryID"`
+	CategoryTitle    string     `json:"categoryTitle"`
+	ParentCategoryID *uint64    `json:"parentCategoryID"`
+	Sum              *string    `json:"sum"`
+	TotalSum         *string    `json:"totalSum"`
+	Budget           *string    `json:"budget"`
+	CarriedOver      *string    `json:"carriedOver"`
+	EffectiveBudget  *string    `json:"effectiveBudget"`
+	SpentBudget      *string    `json:"spentBudget"`
+	TotalBudget      *string    `json:"totalBudget"`
+	TotalSpentBudget *string    `json:"totalSpentBudget"`
+}
+
+func (r *Repository) Format() usecase.ExportFormat {
+	return usecase.ExportFormatJSONL
+}
+
+func (r *Repository) ContentType() string {
+	return "application/jsonl; charset=utf-8"
+}
+
+func (r *Repository) FileExtension() string {
+	return "jsonl"
+}
+
+func (r *Repository) TransactionsToFile(_ context.Context, items []*usecase.TransactionDTO) ([]byte, error) {
+	const op = "TransactionsToFile"
+
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+
+	for _, item := range items {
+		line := transactionLine{
+			ID:          item.Transaction.ID.String(),
+			AccountID:   item.Transaction.AccountID.String(),
+			IsIncome:    item.Transaction.IsIncome,
+			Amount:      item.Transaction.Amount.String(),
+			Currency:    item.Transaction.Currency,
+			OccurredOn:  item.Transaction.OccurredOn,
+			Description: item.Transaction.Description,
+			CategoryID:  item.Transaction.CategoryID,
+		}
+
+		if item.Category != nil {
+			line.CategoryTitle = &item.Category.Title
+		}
+
+		if item.Transaction.WalletID != nil {
+			walletID := item.Transaction.WalletID.String()
+			line.WalletID = &walletID
+		}
+
+		if err := encoder.Encode(line); err != nil {
+			return nil, appErrors.Chainf(err, "%s.%s", r.pkg, op)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (r *Repository) ReportsToFile(_ context.Context, items []*usecase.ReportExportRow) ([]byte, error) {
+	const op = "ReportsToFile"
+
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+
+	for _, item := range items {
+		line := reportLine{
+			PeriodStart:      item.PeriodStart,
+			PeriodEnd:        item.PeriodEnd,
+			Currency:         item.Currency,
+			CategoryID:       item.CategoryID,
+			CategoryTitle:    item.CategoryTitle,
+			ParentCategoryID: item.ParentCategoryID,
+			Sum:              decimalString(item.Sum),
+			TotalSum:         decimalString(item.TotalSum),
+			Budget:           decimalString(item.Budget),
+			CarriedOver:      decimalString(item.CarriedOver),
+			EffectiveBudget:  decimalString(item.EffectiveBudget),
+			SpentBudget:      decimalString(item.SpentBudget),
+			TotalBudget:      decimalString(item.TotalBudget),
+			TotalSpentBudget: decimalString(item.TotalSpentBudget),
+		}
+
+		if err := encoder.Encode(line); err != nil {
+			return nil, appErrors.Chainf(err, "%s.%s", r.pkg, op)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decimalString(value *decimal.Decimal) *string {
+	if value == nil {
+		return nil
+	}
+
+	out := value.String()
+
+	return &out
+}
@@ -0,0 +1,42 @@
+package notify
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/m11ano/budget_planner/backend/ledger/internal/app/config"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/notify/smtp"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/notify/webhook"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// NewSinks - получатели событий outbox, включенные в конфигурации
+func NewSinks(logger *slog.Logger, cfg config.Config) []usecase.NotificationSink {
+	sinks := []usecase.NotificationSink{}
+
+	webhookCfg := cfg.Budget.Notify.Webhook
+	if webhookCfg.URL != "" {
+		if webhookCfg.Secret == "" {
+			logger.Warn("webhook sink configured without secret, signatures are not secure")
+		}
+
+		sinks = append(sinks, webhook.NewSink(webhook.Config{
+			URL:     webhookCfg.URL,
+			Secret:  webhookCfg.Secret,
+			Timeout: time.Duration(webhookCfg.TimeoutSec) * time.Second,
+		}))
+	}
+
+	smtpCfg := cfg.Budget.Notify.SMTP
+	if smtpCfg.Addr != "" && smtpCfg.From != "" && len(smtpCfg.To) > 0 {
+		sinks = append(sinks, smtp.NewSink(smtp.Config{
+			Addr:     smtpCfg.Addr,
+			Username: smtpCfg.Username,
+			Password: smtpCfg.Password,
+			From:     smtpCfg.From,
+			To:       smtpCfg.To,
+		}))
+	}
+
+	return sinks
+}
@@ -0,0 +1,125 @@
+package smtp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net"
+	netsmtp "net/smtp"
+	"strings"
+	"time"
+
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+)
+
+const SinkName = "smtp"
+
+type Config struct {
+	// Addr - адрес relay в формате host:port
+	Addr     string
+	Username string
+	Password string
+	From     string
+	To       []string
+}
+
+// Sink - доставка событий письмом через SMTP relay
+type Sink struct {
+	cfg      Config
+	sendMail func(addr string, a netsmtp.Auth, from string, to []string, msg []byte) error
+}
+
+func NewSink(cfg Config) *Sink {
+	return &Sink{
+		cfg:      cfg,
+		sendMail: netsmtp.SendMail,
+	}
+}
+
+func (s *Sink) Name() string {
+	return SinkName
+}
+
+func (s *Sink) Send(ctx context.Context, event *entity.OutboxEvent) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	msg, err := s.buildMessage(event)
+	if err != nil {
+		return err
+	}
+
+	var auth netsmtp.Auth
+	if s.cfg.Username != "" {
+		host, _, err := net.SplitHostPort(s.cfg.Addr)
+		if err != nil {
+			return err
+		}
+
+		auth = netsmtp.PlainAuth("", s.cfg.Username, s.cfg.Password, host)
+	}
+
+	return s.sendMail(s.cfg.Addr, auth, s.cfg.From, s.cfg.To, msg)
+}
+
+func (s *Sink) buildMessage(event *entity.OutboxEvent) ([]byte, error) {
+	subject, text, err := describeEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+
+	fmt.Fprintf(buf, "From: %s\r\n", s.cfg.From)
+	fmt.Fprintf(buf, "To: %s\r\n", strings.Join(s.cfg.To, ", "))
+	fmt.Fprintf(buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(buf, "Message-ID: <%s@budget-planner>\r\n", event.ID)
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(text, "\n", "\r\n"))
+
+	return buf.Bytes(), nil
+}
+
+func describeEvent(event *entity.OutboxEvent) (subject string, text string, err error) {
+	switch event.EventType {
+	case entity.OutboxEventTypeBudgetThresholdCrossed:
+		payload := &entity.BudgetThresholdCrossedPayload{}
+
+		err = json.Unmarshal(event.Payload, payload)
+		if err != nil {
+			return "", "", err
+		}
+
+		spent := "-"
+		if payload.SpentPercent != nil {
+			spent = payload.SpentPercent.String() + "%"
+		}
+
+		if payload.Exceeded {
+			subject = fmt.Sprintf("Budget exceeded for %s", payload.Period.String()[:7])
+		} else {
+			subject = fmt.Sprintf("Budget reached %d%% for %s", payload.Threshold, payload.Period.String()[:7])
+		}
+
+		text = fmt.Sprintf(
+			"Budget: %s\nCategory: %d\nPeriod: %s\nSpent: %s\nThreshold: %d%%\nExceeded: %t\nTransaction: %s\n",
+			payload.BudgetID,
+			payload.CategoryID,
+			payload.Period.String()[:7],
+			spent,
+			payload.Threshold,
+			payload.Exceeded,
+			payload.TransactionID,
+		)
+
+		return subject, text, nil
+	default:
+		return string(event.EventType), string(event.Payload) + "\n", nil
+	}
+}
@@ -0,0 +1,116 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+)
+
+const (
+	SinkName = "webhook"
+
+	HeaderEventID   = "X-Budget-Event-ID"
+	HeaderEventType = "X-Budget-Event-Type"
+	HeaderTimestamp = "X-Budget-Timestamp"
+	// HeaderSignature - "sha256=" + hex(HMAC-SHA256(secret, timestamp + "." + body))
+	HeaderSignature = "X-Budget-Signature"
+)
+
+type Config struct {
+	URL     string
+	Secret  string
+	Timeout time.Duration
+}
+
+// Sink - доставка событий HTTP POST запросом с HMAC подписью тела
+type Sink struct {
+	cfg    Config
+	client *http.Client
+}
+
+func NewSink(cfg Config) *Sink {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	return &Sink{
+		cfg: cfg,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+type eventBody struct {
+	ID        uuid.UUID              `json:"id"`
+	Type      entity.OutboxEventType `json:"type"`
+	AccountID uuid.UUID              `json:"accountID"`
+	CreatedAt time.Time              `json:"createdAt"`
+	Payload   json.RawMessage        `json:"payload"`
+}
+
+func (s *Sink) Name() string {
+	return SinkName
+}
+
+func (s *Sink) Send(ctx context.Context, event *entity.OutboxEvent) error {
+	body, err := json.Marshal(&eventBody{
+		ID:        event.ID,
+		Type:      event.EventType,
+		AccountID: event.AccountID,
+		CreatedAt: event.CreatedAt,
+		Payload:   event.Payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEventID, event.ID.String())
+	req.Header.Set(HeaderEventType, string(event.EventType))
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(s.cfg.Secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Sign - подпись тела запроса, получатель проверяет ее тем же секретом
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
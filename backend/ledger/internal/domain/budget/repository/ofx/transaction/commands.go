@@ -0,0 +1,276 @@
+package transaction
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/samber/lo"
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// sgmlLeafRe - открывающий тег и текст до следующего тега
+var sgmlLeafRe = regexp.MustCompile(`<([A-Za-z0-9.]+)>([^<]*)`)
+
+// stmtTrn - операция выписки OFX (STMTTRN)
+type stmtTrn struct {
+	DtPosted string `xml:"DTPOSTED"`
+	TrnAmt   string `xml:"TRNAMT"`
+	FitID    string `xml:"FITID"`
+	Name     string `xml:"NAME"`
+	Memo     string `xml:"MEMO"`
+	Currency struct {
+		CurSym string `xml:"CURSYM"`
+	} `xml:"CURRENCY"`
+}
+
+func (r *Repository) Format() usecase.TransactionImportFormat {
+	return usecase.TransactionImportFormatOFX
+}
+
+func (r *Repository) ItemsFromStatement(
+	_ context.Context,
+	data []byte,
+	accountID uuid.UUID,
+	defaultCurrency string,
+	defaultCategoryID *uint64,
+) ([]*usecase.TransactionImportRow, error) {
+	const op = "ItemsFromStatement"
+
+	invalidOFX := func(err error) error {
+		return appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid ofx"), "%s.%s", r.pkg, op)
+	}
+
+	data = bytes.TrimPrefix(data, utf8BOM)
+
+	isSGML := bytes.HasPrefix(bytes.ToUpper(bytes.TrimSpace(data)), []byte("OFXHEADER:"))
+	if isSGML {
+		var err error
+
+		data, err = sgmlToXML(data)
+		if err != nil {
+			return nil, invalidOFX(err)
+		}
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.CharsetReader = charsetReader
+	// в SGML не экранируются амперсанды в названиях
+	decoder.Strict = !isSGML
+
+	var (
+		out      []*usecase.TransactionImportRow
+		hasRoot  bool
+		currency = defaultCurrency
+	)
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, invalidOFX(err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "OFX":
+			hasRoot = true
+		case "CURDEF":
+			// валюта текущей выписки, в файле может быть несколько счетов
+			var value string
+			if err := decoder.DecodeElement(&value, &start); err != nil {
+				return nil, invalidOFX(err)
+			}
+
+			currency = strings.TrimSpace(value)
+			if currency == "" {
+				currency = defaultCurrency
+			}
+		case "STMTTRN":
+			item := &stmtTrn{}
+			if err := decoder.DecodeElement(item, &start); err != nil {
+				return nil, invalidOFX(err)
+			}
+
+			transaction, err := transactionFromStmtTrn(item, accountID, currency, defaultCategoryID)
+
+			out = append(out, &usecase.TransactionImportRow{
+				Line:        len(out) + 1,
+				Transaction: transaction,
+				Err:         err,
+			})
+		}
+	}
+
+	if !hasRoot {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("invalid ofx: OFX element not found"), "%s.%s", r.pkg, op)
+	}
+
+	return out, nil
+}
+
+// sgmlToXML - приводит OFX 1.x к XML: отбрасывает заголовок KEY:VALUE, перекодирует тело по CHARSET
+// и закрывает теги со значениями, которые в SGML остаются открытыми
+func sgmlToXML(data []byte) ([]byte, error) {
+	bodyStart := bytes.IndexByte(data, '<')
+	if bodyStart < 0 {
+		return nil, errors.New("OFX element not found")
+	}
+
+	header, body := data[:bodyStart], data[bodyStart:]
+
+	for _, line := range strings.Split(string(header), "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), ":")
+		if !ok || !strings.EqualFold(key, "CHARSET") {
+			continue
+		}
+
+		value = strings.TrimSpace(value)
+		if value == "" || strings.EqualFold(value, "NONE") {
+			continue
+		}
+
+		// кодовые страницы windows указываются номером
+		if _, err := strconv.Atoi(value); err == nil {
+			value = "windows-" + value
+		}
+
+		enc, err := htmlindex.Get(value)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err = enc.NewDecoder().Bytes(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	out := make([]byte, 0, len(body)+len(body)/4)
+	last := 0
+
+	for _, match := range sgmlLeafRe.FindAllSubmatchIndex(body, -1) {
+		value := bytes.TrimSpace(body[match[4]:match[5]])
+		closing := []byte("</" + string(body[match[2]:match[3]]) + ">")
+
+		if len(value) == 0 || bytes.HasPrefix(bytes.TrimSpace(body[match[5]:]), closing) {
+			continue
+		}
+
+		out = append(out, body[last:match[4]]...)
+		out = append(out, value...)
+		out = append(out, closing...)
+		last = match[5]
+	}
+
+	return append(out, body[last:]...), nil
+}
+
+func charsetReader(label string, input io.Reader) (io.Reader, error) {
+	enc, err := htmlindex.Get(label)
+	if err != nil {
+		return nil, err
+	}
+
+	return enc.NewDecoder().Reader(input), nil
+}
+
+// parseOFXDate - дата вида YYYYMMDD[HHMMSS[.XXX]][[gmt offset[:tz name]]], время и зона отбрасываются
+func parseOFXDate(value string) (civil.Date, error) {
+	value = strings.TrimSpace(value)
+	if len(value) < 8 {
+		return civil.Date{}, appErrors.ErrBadRequest.WithHints("invalid DTPOSTED")
+	}
+
+	date, err := civil.ParseDate(value[0:4] + "-" + value[4:6] + "-" + value[6:8])
+	if err != nil {
+		return civil.Date{}, appErrors.ErrBadRequest.WithParent(err).WithHints("invalid DTPOSTED")
+	}
+
+	return date, nil
+}
+
+// parseOFXAmount - сумма со знаком, часть банков использует запятую как десятичный разделитель
+func parseOFXAmount(value string) (decimal.Decimal, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "+")
+	if !strings.Contains(value, ".") {
+		value = strings.ReplaceAll(value, ",", ".")
+	}
+
+	amount, err := decimal.Parse(value)
+	if err != nil {
+		return decimal.Zero, appErrors.ErrBadRequest.WithParent(err).WithHints("invalid TRNAMT")
+	}
+
+	return amount, nil
+}
+
+func transactionFromStmtTrn(
+	item *stmtTrn,
+	accountID uuid.UUID,
+	currency string,
+	defaultCategoryID *uint64,
+) (*entity.Transaction, error) {
+	occurredOn, err := parseOFXDate(item.DtPosted)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := parseOFXAmount(item.TrnAmt)
+	if err != nil {
+		return nil, err
+	}
+
+	if value := strings.TrimSpace(item.Currency.CurSym); value != "" {
+		currency = value
+	}
+
+	transaction, err := entity.NewTransaction(
+		accountID,
+		amount.Sign() > 0,
+		amount,
+		currency,
+		occurredOn,
+		// без категории по умолчанию категорию подберут правила аккаунта
+		lo.FromPtr(defaultCategoryID),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	description := strings.TrimSpace(item.Name)
+	if memo := strings.TrimSpace(item.Memo); memo != "" && memo != description {
+		description = strings.TrimSpace(description + " " + memo)
+	}
+
+	err = transaction.SetDescription(description)
+	if err != nil {
+		return nil, err
+	}
+
+	err = transaction.SetExternalID(item.FitID)
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
@@ -0,0 +1,268 @@
+package transaction
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/charmap"
+)
+
+func newTestRepository() *Repository {
+	return NewRepository(slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{})))
+}
+
+func ofxStatement(curDef string, trns string) []byte {
+	return []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220"?>
+<OFX>
+  <BANKMSGSRSV1>
+    <STMTTRNRS>
+      <STMTRS>
+        <CURDEF>` + curDef + `</CURDEF>
+        <BANKTRANLIST>` + trns + `</BANKTRANLIST>
+      </STMTRS>
+    </STMTTRNRS>
+  </BANKMSGSRSV1>
+</OFX>`)
+}
+
+func TestOFXRepository_ItemsFromStatement_Table(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		curDef string
+		trn    string
+
+		wantErr         bool
+		wantAmount      string
+		wantIsIncome    bool
+		wantCurrency    string
+		wantOccurredOn  civil.Date
+		wantDescription string
+		wantExternalID  string
+	}{
+		{
+			name:   "OK_debit_date_with_time_and_zone",
+			curDef: "USD",
+			trn: `<STMTTRN><DTPOSTED>20250115120000.000[-5:EST]</DTPOSTED><TRNAMT>-12.50</TRNAMT>
+				<FITID>F1</FITID><NAME>Coffee</NAME><MEMO>Morning</MEMO></STMTTRN>`,
+			wantAmount:      "-12.50",
+			wantCurrency:    "USD",
+			wantOccurredOn:  civil.Date{Year: 2025, Month: 1, Day: 15},
+			wantDescription: "Coffee Morning",
+			wantExternalID:  "F1",
+		},
+		{
+			name:            "OK_credit_with_plus_sign_and_date_only",
+			curDef:          "USD",
+			trn:             `<STMTTRN><DTPOSTED>20250201</DTPOSTED><TRNAMT>+100</TRNAMT><FITID>F2</FITID><NAME>Salary</NAME></STMTTRN>`,
+			wantAmount:      "100",
+			wantIsIncome:    true,
+			wantCurrency:    "USD",
+			wantOccurredOn:  civil.Date{Year: 2025, Month: 2, Day: 1},
+			wantDescription: "Salary",
+			wantExternalID:  "F2",
+		},
+		{
+			name:            "OK_comma_decimal_separator_and_transaction_currency",
+			curDef:          "USD",
+			trn:             `<STMTTRN><DTPOSTED>20250301</DTPOSTED><TRNAMT>-1234,56</TRNAMT><FITID>F3</FITID><CURRENCY><CURSYM>EUR</CURSYM></CURRENCY></STMTTRN>`,
+			wantAmount:      "-1234.56",
+			wantCurrency:    "EUR",
+			wantOccurredOn:  civil.Date{Year: 2025, Month: 3, Day: 1},
+			wantDescription: "",
+			wantExternalID:  "F3",
+		},
+		{
+			name:            "OK_default_currency_without_curdef",
+			trn:             `<STMTTRN><DTPOSTED>20250301</DTPOSTED><TRNAMT>-1</TRNAMT><FITID>F4</FITID><NAME>Bus</NAME><MEMO>Bus</MEMO></STMTTRN>`,
+			wantAmount:      "-1",
+			wantCurrency:    "RUB",
+			wantOccurredOn:  civil.Date{Year: 2025, Month: 3, Day: 1},
+			wantDescription: "Bus",
+			wantExternalID:  "F4",
+		},
+		{
+			name:    "row_error_short_date",
+			curDef:  "USD",
+			trn:     `<STMTTRN><DTPOSTED>202503</DTPOSTED><TRNAMT>-1</TRNAMT></STMTTRN>`,
+			wantErr: true,
+		},
+		{
+			name:    "row_error_invalid_date",
+			curDef:  "USD",
+			trn:     `<STMTTRN><DTPOSTED>20251341</DTPOSTED><TRNAMT>-1</TRNAMT></STMTTRN>`,
+			wantErr: true,
+		},
+		{
+			name:    "row_error_invalid_amount",
+			curDef:  "USD",
+			trn:     `<STMTTRN><DTPOSTED>20250301</DTPOSTED><TRNAMT>abc</TRNAMT></STMTTRN>`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			accID := uuid.New()
+
+			rows, err := newTestRepository().ItemsFromStatement(context.Background(), ofxStatement(tt.curDef, tt.trn), accID, "RUB", nil)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+			require.Equal(t, 1, rows[0].Line)
+
+			if tt.wantErr {
+				require.ErrorIs(t, rows[0].Err, appErrors.ErrBadRequest)
+				require.Nil(t, rows[0].Transaction)
+				return
+			}
+
+			require.NoError(t, rows[0].Err)
+
+			item := rows[0].Transaction
+			require.Equal(t, accID, item.AccountID)
+			require.Equal(t, tt.wantAmount, item.Amount.String())
+			require.Equal(t, tt.wantIsIncome, item.IsIncome)
+			require.Equal(t, tt.wantCurrency, item.Currency)
+			require.Equal(t, tt.wantOccurredOn, item.OccurredOn)
+			require.Equal(t, tt.wantDescription, item.Description)
+			require.NotNil(t, item.ExternalID)
+			require.Equal(t, tt.wantExternalID, *item.ExternalID)
+		})
+	}
+}
+
+func TestOFXRepository_ItemsFromStatement_Invalid_Table(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "not_xml",
+			data: "<OFX><STMTTRN></OFX>",
+		},
+		{
+			name: "without_ofx_root",
+			data: "<DOC><STMTTRN><TRNAMT>1</TRNAMT></STMTTRN></DOC>",
+		},
+		{
+			name: "empty",
+			data: "",
+		},
+		{
+			name: "sgml_header_only",
+			data: "OFXHEADER:100\r\nDATA:OFXSGML\r\n",
+		},
+		{
+			name: "sgml_unknown_charset",
+			data: "OFXHEADER:100\r\nCHARSET:UNKNOWN\r\n\r\n<OFX></OFX>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rows, err := newTestRepository().ItemsFromStatement(context.Background(), []byte(tt.data), uuid.New(), "RUB", nil)
+			require.ErrorIs(t, err, appErrors.ErrBadRequest)
+			require.Nil(t, rows)
+		})
+	}
+}
+
+func TestOFXRepository_ItemsFromStatement_MultipleAccounts(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`<OFX>
+		<STMTRS><CURDEF>USD</CURDEF>
+			<STMTTRN><DTPOSTED>20250101</DTPOSTED><TRNAMT>-1</TRNAMT></STMTTRN>
+		</STMTRS>
+		<STMTRS><CURDEF>EUR</CURDEF>
+			<STMTTRN><DTPOSTED>20250102</DTPOSTED><TRNAMT>-2</TRNAMT></STMTTRN>
+		</STMTRS>
+	</OFX>`)
+
+	rows, err := newTestRepository().ItemsFromStatement(context.Background(), data, uuid.New(), "RUB", nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, "USD", rows[0].Transaction.Currency)
+	require.Equal(t, "EUR", rows[1].Transaction.Currency)
+	require.Equal(t, 2, rows[1].Line)
+}
+
+func TestOFXRepository_ItemsFromStatement_SGML_Table(t *testing.T) {
+	t.Parallel()
+
+	const header = "OFXHEADER:100\r\nDATA:OFXSGML\r\nVERSION:102\r\nENCODING:USASCII\r\n"
+
+	tests := []struct {
+		name    string
+		charset string
+		trn     string
+
+		wantAmount      string
+		wantDescription string
+	}{
+		{
+			name:            "OK_unclosed_tags",
+			charset:         "NONE",
+			trn:             "<TRNAMT>-12.50\r\n<FITID>F1\r\n<NAME>Coffee\r\n<MEMO>Morning\r\n",
+			wantAmount:      "-12.50",
+			wantDescription: "Coffee Morning",
+		},
+		{
+			name:            "OK_closed_and_unclosed_tags",
+			charset:         "NONE",
+			trn:             "<TRNAMT>+7,5</TRNAMT>\r\n<FITID>F1\r\n<NAME>AT&T</NAME>\r\n",
+			wantAmount:      "7.5",
+			wantDescription: "AT&T",
+		},
+		{
+			name:            "OK_windows_charset",
+			charset:         "1251",
+			trn:             "<TRNAMT>-1\r\n<FITID>F1\r\n<NAME>Кофе\r\n",
+			wantAmount:      "-1",
+			wantDescription: "Кофе",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			body := "<OFX>\r\n<BANKMSGSRSV1><STMTTRNRS><STMTRS>\r\n<CURDEF>USD\r\n<BANKTRANLIST>\r\n" +
+				"<STMTTRN>\r\n<TRNTYPE>DEBIT\r\n<DTPOSTED>20250115120000[+3:MSK]\r\n" + tt.trn + "</STMTTRN>\r\n" +
+				"</BANKTRANLIST></STMTRS></STMTTRNRS></BANKMSGSRSV1>\r\n</OFX>\r\n"
+
+			data := []byte(header + "CHARSET:" + tt.charset + "\r\n\r\n" + body)
+			if tt.charset == "1251" {
+				var err error
+				data, err = charmap.Windows1251.NewEncoder().Bytes(data)
+				require.NoError(t, err)
+			}
+
+			rows, err := newTestRepository().ItemsFromStatement(context.Background(), data, uuid.New(), "RUB", nil)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+			require.NoError(t, rows[0].Err)
+
+			item := rows[0].Transaction
+			require.Equal(t, tt.wantAmount, item.Amount.String())
+			require.Equal(t, "USD", item.Currency)
+			require.Equal(t, civil.Date{Year: 2025, Month: 1, Day: 15}, item.OccurredOn)
+			require.Equal(t, tt.wantDescription, item.Description)
+			require.Equal(t, "F1", *item.ExternalID)
+		})
+	}
+}
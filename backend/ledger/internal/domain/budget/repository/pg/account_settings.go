@@ -0,0 +1,57 @@
+package pg
+
+import (
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/pkg/dbhelper"
+)
+
+const (
+	AccountSettingsTable = "account_settings"
+)
+
+var AccountSettingsTableFields = []string{}
+
+func init() {
+	AccountSettingsTableFields = dbhelper.ExtractDBFields(&AccountSettingsDBModel{})
+}
+
+type AccountSettingsDBModel struct {
+	AccountID    uuid.UUID `db:"account_id"`
+	BaseCurrency string    `db:"base_currency"`
+
+	BudgetAutoRollover     bool        `db:"budget_auto_rollover"`
+	BudgetRolledOverPeriod *civil.Date `db:"budget_rolled_over_period"`
+
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (db *AccountSettingsDBModel) ToEntity() *entity.AccountSettings {
+	return &entity.AccountSettings{
+		AccountID:    db.AccountID,
+		BaseCurrency: db.BaseCurrency,
+
+		BudgetAutoRollover:     db.BudgetAutoRollover,
+		BudgetRolledOverPeriod: db.BudgetRolledOverPeriod,
+
+		CreatedAt: db.CreatedAt,
+		UpdatedAt: db.UpdatedAt,
+	}
+}
+
+func MapAccountSettingsEntityToDBModel(entity *entity.AccountSettings) *AccountSettingsDBModel {
+	return &AccountSettingsDBModel{
+		AccountID:    entity.AccountID,
+		BaseCurrency: entity.BaseCurrency,
+
+		BudgetAutoRollover:     entity.BudgetAutoRollover,
+		BudgetRolledOverPeriod: entity.BudgetRolledOverPeriod,
+
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}
+}
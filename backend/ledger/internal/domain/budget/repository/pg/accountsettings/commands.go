@@ -0,0 +1,62 @@
+package accountsettings
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/loghandler"
+)
+
+func (r *Repository) Upsert(ctx context.Context, item *entity.AccountSettings) error {
+	const op = "Upsert"
+
+	timeNow := time.Now().Truncate(time.Microsecond)
+
+	dbModel := pg.MapAccountSettingsEntityToDBModel(item)
+	dbModel.UpdatedAt = timeNow
+
+	query, args, err := r.qb.Insert(pg.AccountSettingsTable).
+		Columns(
+			"account_id",
+			"base_currency",
+			"budget_auto_rollover",
+			"budget_rolled_over_period",
+			"created_at",
+			"updated_at",
+		).
+		Values(
+			dbModel.AccountID,
+			dbModel.BaseCurrency,
+			dbModel.BudgetAutoRollover,
+			dbModel.BudgetRolledOverPeriod,
+			dbModel.CreatedAt,
+			dbModel.UpdatedAt,
+		).
+		Suffix(`ON CONFLICT (account_id) DO UPDATE SET
+			base_currency = EXCLUDED.base_currency,
+			budget_auto_rollover = EXCLUDED.budget_auto_rollover,
+			budget_rolled_over_period = EXCLUDED.budget_rolled_over_period,
+			updated_at = EXCLUDED.updated_at`).
+		ToSql()
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "building query", slog.Any("error", err))
+		return appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	_, err = r.pgClient.GetConn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "executing query", slog.Any("error", err))
+		}
+		return appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	item.UpdatedAt = timeNow
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package accountsettings
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/loghandler"
+)
+
+func (r *Repository) FindOneByAccountID(
+	ctx context.Context,
+	accountID uuid.UUID,
+	queryParams *uctypes.QueryGetOneParams,
+) (*entity.AccountSettings, error) {
+	const op = "FindOneByAccountID"
+
+	q := r.qb.Select(pg.AccountSettingsTableFields...).
+		From(pg.AccountSettingsTable).
+		Where(squirrel.Eq{"account_id": accountID})
+
+	if queryParams != nil {
+		if queryParams.ForUpdateSkipLocked {
+			q = q.Suffix("FOR UPDATE SKIP LOCKED")
+		} else if queryParams.ForUpdate {
+			q = q.Suffix("FOR UPDATE")
+		} else if queryParams.ForShare {
+			q = q.Suffix("FOR SHARE")
+		}
+	}
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "building query", slog.Any("error", err))
+		return nil, appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	rows, err := r.pgClient.GetConn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "query row error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	defer rows.Close()
+
+	dbData := &pg.AccountSettingsDBModel{}
+
+	if err := pgxscan.ScanOne(dbData, rows); err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "scan row error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	return dbData.ToEntity(), nil
+}
+
+func (r *Repository) buildWhereForList(listOptions *usecase.AccountSettingsListOptions) squirrel.And {
+	where := squirrel.And{}
+
+	if listOptions == nil {
+		return where
+	}
+
+	if listOptions.FilterBudgetAutoRollover != nil {
+		where = append(where, squirrel.Eq{"budget_auto_rollover": *listOptions.FilterBudgetAutoRollover})
+	}
+
+	if listOptions.FilterBudgetRolledOverBefore != nil {
+		where = append(where, squirrel.Or{
+			squirrel.Expr("budget_rolled_over_period IS NULL"),
+			squirrel.Lt{"budget_rolled_over_period": *listOptions.FilterBudgetRolledOverBefore},
+		})
+	}
+
+	return where
+}
+
+func (r *Repository) FindList(
+	ctx context.Context,
+	listOptions *usecase.AccountSettingsListOptions,
+	queryParams *uctypes.QueryGetListParams,
+) ([]*entity.AccountSettings, error) {
+	const op = "FindList"
+
+	q := r.qb.Select(pg.AccountSettingsTableFields...).
+		From(pg.AccountSettingsTable).
+		Where(r.buildWhereForList(listOptions)).
+		OrderBy("account_id ASC")
+
+	if queryParams != nil {
+		if queryParams.ForUpdateSkipLocked {
+			q = q.Suffix("FOR UPDATE SKIP LOCKED")
+		} else if queryParams.ForUpdate {
+			q = q.Suffix("FOR UPDATE")
+		} else if queryParams.ForShare {
+			q = q.Suffix("FOR SHARE")
+		}
+
+		if queryParams.Limit > 0 {
+			q = q.Limit(queryParams.Limit)
+		}
+
+		if queryParams.Offset > 0 {
+			q = q.Offset(queryParams.Offset)
+		}
+	}
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "building query", slog.Any("error", err))
+		return nil, appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	rows, err := r.pgClient.GetConn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "query row error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	defer rows.Close()
+
+	dbData := []*pg.AccountSettingsDBModel{}
+
+	if err := pgxscan.ScanAll(&dbData, rows); err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "scan row error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	result := make([]*entity.AccountSettings, 0, len(dbData))
+	for _, dbItem := range dbData {
+		result = append(result, dbItem.ToEntity())
+	}
+
+	return result, nil
+}
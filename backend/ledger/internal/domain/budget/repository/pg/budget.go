@@ -8,6 +8,7 @@ import (
 	"github.com/govalues/decimal"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	"github.com/m11ano/budget_planner/backend/ledger/pkg/dbhelper"
+	"github.com/samber/lo"
 )
 
 const (
@@ -26,6 +27,11 @@ type BudgetDBModel struct {
 	Period     civil.Date      `db:"period"`
 	CategoryID uint64          `db:"category_id"`
 	Amount     decimal.Decimal `db:"amount"`
+	Currency   string          `db:"currency"`
+	CarryOver  bool            `db:"carry_over"`
+
+	Enforcement       string  `db:"enforcement"`
+	WarningThresholds []int32 `db:"warning_thresholds"`
 
 	CreatedAt time.Time  `db:"created_at"`
 	UpdatedAt time.Time  `db:"updated_at"`
@@ -37,8 +43,15 @@ func (db *BudgetDBModel) ToEntity() *entity.Budget {
 		ID:         db.ID,
 		AccountID:  db.AccountID,
 		Amount:     db.Amount,
+		Currency:   db.Currency,
 		CategoryID: db.CategoryID,
 		Period:     db.Period,
+		CarryOver:  db.CarryOver,
+
+		Enforcement: entity.BudgetEnforcement(db.Enforcement),
+		WarningThresholds: lo.Map(db.WarningThresholds, func(item int32, _ int) int {
+			return int(item)
+		}),
 
 		CreatedAt: db.CreatedAt,
 		UpdatedAt: db.UpdatedAt,
@@ -51,8 +64,15 @@ func MapBudgetEntityToDBModel(entity *entity.Budget) *BudgetDBModel {
 		ID:         entity.ID,
 		AccountID:  entity.AccountID,
 		Amount:     entity.Amount,
+		Currency:   entity.Currency,
 		CategoryID: entity.CategoryID,
 		Period:     entity.Period,
+		CarryOver:  entity.CarryOver,
+
+		Enforcement: string(entity.Enforcement),
+		WarningThresholds: lo.Map(entity.WarningThresholds, func(item int, _ int) int32 {
+			return int32(item)
+		}),
 
 		CreatedAt: entity.CreatedAt,
 		UpdatedAt: entity.UpdatedAt,
@@ -102,3 +102,31 @@ func (r *Repository) Update(ctx context.Context, item *entity.Budget) error {
 
 	return nil
 }
+
+func (r *Repository) ReassignCategory(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) error {
+	const op = "ReassignCategory"
+
+	query, args, err := r.qb.Update(pg.BudgetTable).
+		Set("category_id", toCategoryID).
+		Set("updated_at", time.Now().Truncate(time.Microsecond)).
+		Where(squirrel.And{
+			squirrel.Eq{"category_id": fromCategoryID},
+			squirrel.Expr("deleted_at IS NULL"),
+		}).
+		ToSql()
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "building query", slog.Any("error", err))
+		return appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	_, err = r.pgClient.GetConn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "executing query", slog.Any("error", err))
+		}
+		return appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	return nil
+}
@@ -46,6 +46,14 @@ func (r *Repository) buildWhereForList(listOptions *usecase.BudgetListOptions, w
 		where = append(where, squirrel.LtOrEq{"period": *listOptions.FilterPeriodTo})
 	}
 
+	if listOptions.FilterBefore != nil {
+		where = append(where, squirrel.Expr(
+			"(period, id) < (?, ?)",
+			listOptions.FilterBefore.Period,
+			listOptions.FilterBefore.ID,
+		))
+	}
+
 	return where
 }
 
@@ -70,6 +78,12 @@ func (r *Repository) buildSortForList(listOptions *usecase.BudgetListOptions) []
 			} else {
 				sort = append(sort, "created_at ASC")
 			}
+		case usecase.BudgetListOptionsSortFieldID:
+			if sortOption.IsDesc {
+				sort = append(sort, "id DESC")
+			} else {
+				sort = append(sort, "id ASC")
+			}
 		}
 	}
 
@@ -248,6 +262,34 @@ func (r *Repository) FindPagedList(
 	return result, total, nil
 }
 
+func (r *Repository) Count(
+	ctx context.Context,
+	listOptions *usecase.BudgetListOptions,
+) (uint64, error) {
+	const op = "Count"
+
+	where := r.buildWhereForList(listOptions, false)
+
+	query, args, err := r.qb.Select("COUNT(*) as total").From(pg.BudgetTable).Where(where).ToSql()
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "building query", slog.Any("error", err))
+		return 0, appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	var total uint64
+
+	row := r.pgClient.GetConn(ctx).QueryRow(ctx, query, args...)
+	if err := row.Scan(&total); err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "scan total error", slog.Any("error", err))
+		}
+		return 0, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	return total, nil
+}
+
 func (r *Repository) FindOneByID(
 	ctx context.Context,
 	id uuid.UUID,
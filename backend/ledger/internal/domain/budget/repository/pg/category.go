@@ -3,6 +3,7 @@ package pg
 import (
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	"github.com/m11ano/budget_planner/backend/ledger/pkg/dbhelper"
 )
@@ -18,8 +19,11 @@ func init() {
 }
 
 type CategoryDBModel struct {
-	ID    uint64 `db:"id"`
-	Title string `db:"title"`
+	ID         uint64     `db:"id"`
+	AccountID  *uuid.UUID `db:"account_id"`
+	ParentID   *uint64    `db:"parent_id"`
+	Title      string     `db:"title"`
+	IsArchived bool       `db:"is_archived"`
 
 	CreatedAt time.Time  `db:"created_at"`
 	UpdatedAt time.Time  `db:"updated_at"`
@@ -28,8 +32,11 @@ type CategoryDBModel struct {
 
 func (db *CategoryDBModel) ToEntity() *entity.Category {
 	return &entity.Category{
-		ID:    db.ID,
-		Title: db.Title,
+		ID:         db.ID,
+		AccountID:  db.AccountID,
+		ParentID:   db.ParentID,
+		Title:      db.Title,
+		IsArchived: db.IsArchived,
 
 		CreatedAt: db.CreatedAt,
 		UpdatedAt: db.UpdatedAt,
@@ -39,8 +46,11 @@ func (db *CategoryDBModel) ToEntity() *entity.Category {
 
 func MapCategoryEntityToDBModel(entity *entity.Category) *CategoryDBModel {
 	return &CategoryDBModel{
-		ID:    entity.ID,
-		Title: entity.Title,
+		ID:         entity.ID,
+		AccountID:  entity.AccountID,
+		ParentID:   entity.ParentID,
+		Title:      entity.Title,
+		IsArchived: entity.IsArchived,
 
 		CreatedAt: entity.CreatedAt,
 		UpdatedAt: entity.UpdatedAt,
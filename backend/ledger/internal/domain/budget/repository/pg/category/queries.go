@@ -29,6 +29,21 @@ func (r *Repository) buildWhereForList(listOptions *usecase.CategoryListOptions,
 		where = append(where, squirrel.Eq{"id": *listOptions.FilterIDs})
 	}
 
+	if listOptions.FilterAccountID != nil {
+		where = append(where, squirrel.Eq{"account_id": *listOptions.FilterAccountID})
+	}
+
+	if listOptions.FilterAvailableForAccountID != nil {
+		where = append(where, squirrel.Or{
+			squirrel.Eq{"account_id": nil},
+			squirrel.Eq{"account_id": *listOptions.FilterAvailableForAccountID},
+		})
+	}
+
+	if listOptions.FilterIsArchived != nil {
+		where = append(where, squirrel.Eq{"is_archived": *listOptions.FilterIsArchived})
+	}
+
 	return where
 }
 
@@ -133,10 +148,16 @@ func (r *Repository) FindOneByID(
 ) (*entity.Category, error) {
 	const op = "FindOneByID"
 
+	withDeleted := queryParams != nil && queryParams.WithDeleted
+
 	where := squirrel.And{
 		squirrel.Eq{"id": id},
 	}
 
+	if !withDeleted {
+		where = append(where, squirrel.Expr("deleted_at IS NULL"))
+	}
+
 	q := r.qb.Select(pg.CategoryTableFields...).From(pg.CategoryTable).Where(where)
 
 	if queryParams != nil {
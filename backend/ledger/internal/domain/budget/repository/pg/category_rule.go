@@ -0,0 +1,80 @@
+package pg
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/pkg/dbhelper"
+)
+
+const (
+	CategoryRuleTable = "category_rule"
+)
+
+var CategoryRuleTableFields = []string{}
+
+func init() {
+	CategoryRuleTableFields = dbhelper.ExtractDBFields(&CategoryRuleDBModel{})
+}
+
+type CategoryRuleDBModel struct {
+	ID                  uuid.UUID        `db:"id"`
+	AccountID           uuid.UUID        `db:"account_id"`
+	Name                string           `db:"name"`
+	Priority            int              `db:"priority"`
+	CategoryID          uint64           `db:"category_id"`
+	DescriptionContains *string          `db:"description_contains"`
+	DescriptionRegex    *string          `db:"description_regex"`
+	AmountMin           *decimal.Decimal `db:"amount_min"`
+	AmountMax           *decimal.Decimal `db:"amount_max"`
+	IsIncome            *bool            `db:"is_income"`
+	WalletID            *uuid.UUID       `db:"wallet_id"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+}
+
+func (db *CategoryRuleDBModel) ToEntity() *entity.CategoryRule {
+	return &entity.CategoryRule{
+		ID:         db.ID,
+		AccountID:  db.AccountID,
+		Name:       db.Name,
+		Priority:   db.Priority,
+		CategoryID: db.CategoryID,
+		Conditions: entity.CategoryRuleConditions{
+			DescriptionContains: db.DescriptionContains,
+			DescriptionRegex:    db.DescriptionRegex,
+			AmountMin:           db.AmountMin,
+			AmountMax:           db.AmountMax,
+			IsIncome:            db.IsIncome,
+			WalletID:            db.WalletID,
+		},
+
+		CreatedAt: db.CreatedAt,
+		UpdatedAt: db.UpdatedAt,
+		DeletedAt: db.DeletedAt,
+	}
+}
+
+func MapCategoryRuleEntityToDBModel(entity *entity.CategoryRule) *CategoryRuleDBModel {
+	return &CategoryRuleDBModel{
+		ID:                  entity.ID,
+		AccountID:           entity.AccountID,
+		Name:                entity.Name,
+		Priority:            entity.Priority,
+		CategoryID:          entity.CategoryID,
+		DescriptionContains: entity.Conditions.DescriptionContains,
+		DescriptionRegex:    entity.Conditions.DescriptionRegex,
+		AmountMin:           entity.Conditions.AmountMin,
+		AmountMax:           entity.Conditions.AmountMax,
+		IsIncome:            entity.Conditions.IsIncome,
+		WalletID:            entity.Conditions.WalletID,
+
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+		DeletedAt: entity.DeletedAt,
+	}
+}
@@ -0,0 +1,197 @@
+package categoryrule
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/loghandler"
+)
+
+func (r *Repository) buildWhereForList(listOptions *usecase.CategoryRuleListOptions, withDeleted bool) (where squirrel.And) {
+	defer func() {
+		if !withDeleted {
+			where = append(where, squirrel.Expr("deleted_at IS NULL"))
+		}
+	}()
+
+	if listOptions == nil {
+		return where
+	}
+
+	if listOptions.FilterIDs != nil {
+		where = append(where, squirrel.Eq{"id": *listOptions.FilterIDs})
+	}
+
+	if listOptions.FilterAccountID != nil {
+		where = append(where, squirrel.Eq{"account_id": *listOptions.FilterAccountID})
+	}
+
+	return where
+}
+
+func (r *Repository) buildSortForList(listOptions *usecase.CategoryRuleListOptions) []string {
+	if listOptions == nil || len(listOptions.Sort) == 0 {
+		return []string{"priority ASC", "created_at ASC"}
+	}
+
+	sort := make([]string, 0, len(listOptions.Sort))
+
+	for _, sortOption := range listOptions.Sort {
+		switch sortOption.Field {
+		case usecase.CategoryRuleListOptionsSortFieldPriority:
+			if sortOption.IsDesc {
+				sort = append(sort, "priority DESC")
+			} else {
+				sort = append(sort, "priority ASC")
+			}
+		case usecase.CategoryRuleListOptionsSortFieldCreatedAt:
+			if sortOption.IsDesc {
+				sort = append(sort, "created_at DESC")
+			} else {
+				sort = append(sort, "created_at ASC")
+			}
+		}
+	}
+
+	return sort
+}
+
+func (r *Repository) FindList(
+	ctx context.Context,
+	listOptions *usecase.CategoryRuleListOptions,
+	queryParams *uctypes.QueryGetListParams,
+) ([]*entity.CategoryRule, error) {
+	const op = "FindList"
+
+	withDeleted := queryParams != nil && queryParams.WithDeleted
+
+	where := r.buildWhereForList(listOptions, withDeleted)
+
+	fields := pg.CategoryRuleTableFields
+
+	q := r.qb.Select(fields...).From(pg.CategoryRuleTable).Where(where)
+
+	sort := r.buildSortForList(listOptions)
+	if len(sort) > 0 {
+		q = q.OrderBy(sort...)
+	}
+
+	if queryParams != nil {
+		if queryParams.ForUpdateSkipLocked {
+			q = q.Suffix("FOR UPDATE SKIP LOCKED")
+		} else if queryParams.ForUpdate {
+			q = q.Suffix("FOR UPDATE")
+		} else if queryParams.ForShare {
+			q = q.Suffix("FOR SHARE")
+		}
+
+		if queryParams.Limit > 0 {
+			q = q.Limit(queryParams.Limit)
+		}
+
+		if queryParams.Offset > 0 {
+			q = q.Offset(queryParams.Offset)
+		}
+	}
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "building query", slog.Any("error", err))
+		return nil, appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	rows, err := r.pgClient.GetConn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "query row error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	defer rows.Close()
+
+	dbData := []*pg.CategoryRuleDBModel{}
+
+	if err := pgxscan.ScanAll(&dbData, rows); err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "scan row error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	result := make([]*entity.CategoryRule, 0, len(dbData))
+	for _, dbItem := range dbData {
+		result = append(result, dbItem.ToEntity())
+	}
+
+	return result, nil
+}
+
+func (r *Repository) FindOneByID(
+	ctx context.Context,
+	id uuid.UUID,
+	queryParams *uctypes.QueryGetOneParams,
+) (*entity.CategoryRule, error) {
+	const op = "FindOneByID"
+
+	withDeleted := queryParams != nil && queryParams.WithDeleted
+
+	where := squirrel.And{
+		squirrel.Eq{"id": id},
+	}
+
+	if !withDeleted {
+		where = append(where, squirrel.Expr("deleted_at IS NULL"))
+	}
+
+	q := r.qb.Select(pg.CategoryRuleTableFields...).From(pg.CategoryRuleTable).Where(where)
+
+	if queryParams != nil {
+		if queryParams.ForUpdateSkipLocked {
+			q = q.Suffix("FOR UPDATE SKIP LOCKED")
+		} else if queryParams.ForUpdate {
+			q = q.Suffix("FOR UPDATE")
+		} else if queryParams.ForShare {
+			q = q.Suffix("FOR SHARE")
+		}
+	}
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "building query", slog.Any("error", err))
+		return nil, appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	rows, err := r.pgClient.GetConn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "query row error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	defer rows.Close()
+
+	dbData := &pg.CategoryRuleDBModel{}
+
+	if err := pgxscan.ScanOne(dbData, rows); err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "scan row error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	return dbData.ToEntity(), nil
+}
@@ -0,0 +1,58 @@
+package pg
+
+import (
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/pkg/dbhelper"
+)
+
+const (
+	ExchangeRateTable = "exchange_rate"
+)
+
+var ExchangeRateTableFields = []string{}
+
+func init() {
+	ExchangeRateTableFields = dbhelper.ExtractDBFields(&ExchangeRateDBModel{})
+}
+
+type ExchangeRateDBModel struct {
+	AccountID    uuid.UUID       `db:"account_id"`
+	CurrencyFrom string          `db:"currency_from"`
+	CurrencyTo   string          `db:"currency_to"`
+	RateDate     civil.Date      `db:"rate_date"`
+	Rate         decimal.Decimal `db:"rate"`
+
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (db *ExchangeRateDBModel) ToEntity() *entity.ExchangeRate {
+	return &entity.ExchangeRate{
+		AccountID:    db.AccountID,
+		CurrencyFrom: db.CurrencyFrom,
+		CurrencyTo:   db.CurrencyTo,
+		RateDate:     db.RateDate,
+		Rate:         db.Rate,
+
+		CreatedAt: db.CreatedAt,
+		UpdatedAt: db.UpdatedAt,
+	}
+}
+
+func MapExchangeRateEntityToDBModel(entity *entity.ExchangeRate) *ExchangeRateDBModel {
+	return &ExchangeRateDBModel{
+		AccountID:    entity.AccountID,
+		CurrencyFrom: entity.CurrencyFrom,
+		CurrencyTo:   entity.CurrencyTo,
+		RateDate:     entity.RateDate,
+		Rate:         entity.Rate,
+
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}
+}
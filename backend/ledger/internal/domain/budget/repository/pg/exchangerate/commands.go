@@ -0,0 +1,62 @@
+package exchangerate
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/loghandler"
+)
+
+func (r *Repository) Upsert(ctx context.Context, items []*entity.ExchangeRate) error {
+	const op = "Upsert"
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	timeNow := time.Now().Truncate(time.Microsecond)
+
+	q := r.qb.Insert(pg.ExchangeRateTable).
+		Columns("account_id", "currency_from", "currency_to", "rate_date", "rate", "created_at", "updated_at")
+
+	for _, item := range items {
+		dbModel := pg.MapExchangeRateEntityToDBModel(item)
+		q = q.Values(
+			dbModel.AccountID,
+			dbModel.CurrencyFrom,
+			dbModel.CurrencyTo,
+			dbModel.RateDate,
+			dbModel.Rate,
+			dbModel.CreatedAt,
+			timeNow,
+		)
+	}
+
+	query, args, err := q.Suffix(`ON CONFLICT (account_id, currency_from, currency_to, rate_date) DO UPDATE SET
+			rate = EXCLUDED.rate,
+			updated_at = EXCLUDED.updated_at`).
+		ToSql()
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "building query", slog.Any("error", err))
+		return appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	_, err = r.pgClient.GetConn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "executing query", slog.Any("error", err))
+		}
+		return appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	for _, item := range items {
+		item.UpdatedAt = timeNow
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package exchangerate
+
+import (
+	"context"
+	"log/slog"
+
+	"cloud.google.com/go/civil"
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/loghandler"
+)
+
+func (r *Repository) buildWhereForList(listOptions *usecase.ExchangeRateListOptions) (where squirrel.And) {
+	if listOptions == nil {
+		return where
+	}
+
+	if listOptions.FilterAccountID != nil {
+		where = append(where, squirrel.Eq{"account_id": *listOptions.FilterAccountID})
+	}
+
+	if listOptions.FilterCurrency != nil {
+		where = append(where, squirrel.Or{
+			squirrel.Eq{"currency_from": *listOptions.FilterCurrency},
+			squirrel.Eq{"currency_to": *listOptions.FilterCurrency},
+		})
+	}
+
+	if listOptions.FilterRateDateFrom != nil {
+		where = append(where, squirrel.GtOrEq{"rate_date": *listOptions.FilterRateDateFrom})
+	}
+
+	if listOptions.FilterRateDateTo != nil {
+		where = append(where, squirrel.LtOrEq{"rate_date": *listOptions.FilterRateDateTo})
+	}
+
+	return where
+}
+
+func (r *Repository) buildSortForList(listOptions *usecase.ExchangeRateListOptions) []string {
+	if listOptions == nil || len(listOptions.Sort) == 0 {
+		return []string{"rate_date DESC", "currency_from ASC", "currency_to ASC"}
+	}
+
+	sort := make([]string, 0, len(listOptions.Sort))
+
+	for _, sortOption := range listOptions.Sort {
+		switch sortOption.Field {
+		case usecase.ExchangeRateListOptionsSortFieldRateDate:
+			if sortOption.IsDesc {
+				sort = append(sort, "rate_date DESC")
+			} else {
+				sort = append(sort, "rate_date ASC")
+			}
+		}
+	}
+
+	return sort
+}
+
+func (r *Repository) FindList(
+	ctx context.Context,
+	listOptions *usecase.ExchangeRateListOptions,
+	queryParams *uctypes.QueryGetListParams,
+) ([]*entity.ExchangeRate, error) {
+	const op = "FindList"
+
+	where := r.buildWhereForList(listOptions)
+
+	q := r.qb.Select(pg.ExchangeRateTableFields...).From(pg.ExchangeRateTable).Where(where)
+
+	sort := r.buildSortForList(listOptions)
+	if len(sort) > 0 {
+		q = q.OrderBy(sort...)
+	}
+
+	if queryParams != nil {
+		if queryParams.Limit > 0 {
+			q = q.Limit(queryParams.Limit)
+		}
+
+		if queryParams.Offset > 0 {
+			q = q.Offset(queryParams.Offset)
+		}
+	}
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "building query", slog.Any("error", err))
+		return nil, appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	rows, err := r.pgClient.GetConn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "query row error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	defer rows.Close()
+
+	dbData := []*pg.ExchangeRateDBModel{}
+
+	if err := pgxscan.ScanAll(&dbData, rows); err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "scan row error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	result := make([]*entity.ExchangeRate, 0, len(dbData))
+	for _, dbItem := range dbData {
+		result = append(result, dbItem.ToEntity())
+	}
+
+	return result, nil
+}
+
+func (r *Repository) FindActual(
+	ctx context.Context,
+	accountID uuid.UUID,
+	currencyFrom string,
+	currencyTo string,
+	date civil.Date,
+) (*entity.ExchangeRate, error) {
+	const op = "FindActual"
+
+	q := r.qb.Select(pg.ExchangeRateTableFields...).
+		From(pg.ExchangeRateTable).
+		Where(squirrel.And{
+			squirrel.Eq{"account_id": accountID},
+			squirrel.LtOrEq{"rate_date": date},
+			squirrel.Or{
+				squirrel.Eq{"currency_from": currencyFrom, "currency_to": currencyTo},
+				squirrel.Eq{"currency_from": currencyTo, "currency_to": currencyFrom},
+			},
+		}).
+		OrderBy("rate_date DESC").
+		Limit(1)
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "building query", slog.Any("error", err))
+		return nil, appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	rows, err := r.pgClient.GetConn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "query row error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	defer rows.Close()
+
+	dbData := &pg.ExchangeRateDBModel{}
+
+	if err := pgxscan.ScanOne(dbData, rows); err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "scan row error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	return dbData.ToEntity(), nil
+}
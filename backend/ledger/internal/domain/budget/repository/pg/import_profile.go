@@ -0,0 +1,114 @@
+package pg
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/pkg/dbhelper"
+)
+
+const (
+	ImportProfileTable = "import_profile"
+)
+
+var ImportProfileTableFields = []string{}
+
+func init() {
+	ImportProfileTableFields = dbhelper.ExtractDBFields(&ImportProfileDBModel{})
+}
+
+type ImportProfileDBModel struct {
+	ID                uuid.UUID `db:"id"`
+	AccountID         uuid.UUID `db:"account_id"`
+	Name              string    `db:"name"`
+	Delimiter         string    `db:"delimiter"`
+	DecimalSeparator  string    `db:"decimal_separator"`
+	DateLayout        string    `db:"date_layout"`
+	HasHeader         bool      `db:"has_header"`
+	SkipRows          int       `db:"skip_rows"`
+	SignConvention    string    `db:"sign_convention"`
+	IncomeValues      []string  `db:"income_values"`
+	Encoding          string    `db:"encoding"`
+	ColumnDate        string    `db:"column_date"`
+	ColumnAmount      string    `db:"column_amount"`
+	ColumnDebit       string    `db:"column_debit"`
+	ColumnCredit      string    `db:"column_credit"`
+	ColumnIsIncome    string    `db:"column_is_income"`
+	ColumnDescription string    `db:"column_description"`
+	ColumnCategoryID  string    `db:"column_category_id"`
+	ColumnCurrency    string    `db:"column_currency"`
+	ColumnExternalID  string    `db:"column_external_id"`
+	DefaultCategoryID *uint64   `db:"default_category_id"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+}
+
+func (db *ImportProfileDBModel) ToEntity() *entity.ImportProfile {
+	return &entity.ImportProfile{
+		ID:               db.ID,
+		AccountID:        db.AccountID,
+		Name:             db.Name,
+		Delimiter:        db.Delimiter,
+		DecimalSeparator: db.DecimalSeparator,
+		DateLayout:       db.DateLayout,
+		HasHeader:        db.HasHeader,
+		SkipRows:         db.SkipRows,
+		SignConvention:   entity.ImportSignConvention(db.SignConvention),
+		IncomeValues:     db.IncomeValues,
+		Encoding:         entity.ImportEncoding(db.Encoding),
+		Columns: entity.ImportColumns{
+			Date:        db.ColumnDate,
+			Amount:      db.ColumnAmount,
+			Debit:       db.ColumnDebit,
+			Credit:      db.ColumnCredit,
+			IsIncome:    db.ColumnIsIncome,
+			Description: db.ColumnDescription,
+			CategoryID:  db.ColumnCategoryID,
+			Currency:    db.ColumnCurrency,
+			ExternalID:  db.ColumnExternalID,
+		},
+		DefaultCategoryID: db.DefaultCategoryID,
+
+		CreatedAt: db.CreatedAt,
+		UpdatedAt: db.UpdatedAt,
+		DeletedAt: db.DeletedAt,
+	}
+}
+
+func MapImportProfileEntityToDBModel(entity *entity.ImportProfile) *ImportProfileDBModel {
+	incomeValues := entity.IncomeValues
+	if incomeValues == nil {
+		incomeValues = []string{}
+	}
+
+	return &ImportProfileDBModel{
+		ID:                entity.ID,
+		AccountID:         entity.AccountID,
+		Name:              entity.Name,
+		Delimiter:         entity.Delimiter,
+		DecimalSeparator:  entity.DecimalSeparator,
+		DateLayout:        entity.DateLayout,
+		HasHeader:         entity.HasHeader,
+		SkipRows:          entity.SkipRows,
+		SignConvention:    string(entity.SignConvention),
+		IncomeValues:      incomeValues,
+		Encoding:          string(entity.Encoding),
+		ColumnDate:        entity.Columns.Date,
+		ColumnAmount:      entity.Columns.Amount,
+		ColumnDebit:       entity.Columns.Debit,
+		ColumnCredit:      entity.Columns.Credit,
+		ColumnIsIncome:    entity.Columns.IsIncome,
+		ColumnDescription: entity.Columns.Description,
+		ColumnCategoryID:  entity.Columns.CategoryID,
+		ColumnCurrency:    entity.Columns.Currency,
+		ColumnExternalID:  entity.Columns.ExternalID,
+		DefaultCategoryID: entity.DefaultCategoryID,
+
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+		DeletedAt: entity.DeletedAt,
+	}
+}
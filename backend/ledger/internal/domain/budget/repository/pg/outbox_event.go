@@ -0,0 +1,76 @@
+package pg
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/pkg/dbhelper"
+)
+
+const (
+	OutboxEventTable = "outbox_event"
+)
+
+var OutboxEventTableFields = []string{}
+
+func init() {
+	OutboxEventTableFields = dbhelper.ExtractDBFields(&OutboxEventDBModel{})
+}
+
+type OutboxEventDBModel struct {
+	ID             uuid.UUID  `db:"id"`
+	AccountID      uuid.UUID  `db:"account_id"`
+	EventType      string     `db:"event_type"`
+	Payload        []byte     `db:"payload"`
+	Attempts       int        `db:"attempts"`
+	DeliveredSinks []string   `db:"delivered_sinks"`
+	LastError      *string    `db:"last_error"`
+	NextAttemptAt  time.Time  `db:"next_attempt_at"`
+	ProcessedAt    *time.Time `db:"processed_at"`
+	FailedAt       *time.Time `db:"failed_at"`
+
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (db *OutboxEventDBModel) ToEntity() *entity.OutboxEvent {
+	return &entity.OutboxEvent{
+		ID:             db.ID,
+		AccountID:      db.AccountID,
+		EventType:      entity.OutboxEventType(db.EventType),
+		Payload:        db.Payload,
+		Attempts:       db.Attempts,
+		DeliveredSinks: db.DeliveredSinks,
+		LastError:      db.LastError,
+		NextAttemptAt:  db.NextAttemptAt,
+		ProcessedAt:    db.ProcessedAt,
+		FailedAt:       db.FailedAt,
+
+		CreatedAt: db.CreatedAt,
+		UpdatedAt: db.UpdatedAt,
+	}
+}
+
+func MapOutboxEventEntityToDBModel(entity *entity.OutboxEvent) *OutboxEventDBModel {
+	deliveredSinks := entity.DeliveredSinks
+	if deliveredSinks == nil {
+		deliveredSinks = []string{}
+	}
+
+	return &OutboxEventDBModel{
+		ID:             entity.ID,
+		AccountID:      entity.AccountID,
+		EventType:      string(entity.EventType),
+		Payload:        entity.Payload,
+		Attempts:       entity.Attempts,
+		DeliveredSinks: deliveredSinks,
+		LastError:      entity.LastError,
+		NextAttemptAt:  entity.NextAttemptAt,
+		ProcessedAt:    entity.ProcessedAt,
+		FailedAt:       entity.FailedAt,
+
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}
+}
@@ -0,0 +1,78 @@
+package outboxevent
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/loghandler"
+	"github.com/m11ano/budget_planner/backend/ledger/pkg/dbhelper"
+)
+
+func (r *Repository) Create(ctx context.Context, item *entity.OutboxEvent) error {
+	const op = "Create"
+
+	dataMap, err := dbhelper.DBModelToMap(pg.MapOutboxEventEntityToDBModel(item))
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "convert struct to db map", slog.Any("error", err))
+		return appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	query, args, err := r.qb.Insert(pg.OutboxEventTable).SetMap(dataMap).ToSql()
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "building query", slog.Any("error", err))
+		return appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	_, err = r.pgClient.GetConn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "executing query", slog.Any("error", err))
+		}
+		return appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	return nil
+}
+
+func (r *Repository) Update(ctx context.Context, item *entity.OutboxEvent) error {
+	const op = "Update"
+
+	timeNow := time.Now().Truncate(time.Microsecond)
+
+	dataMap, err := dbhelper.DBModelToMap(pg.MapOutboxEventEntityToDBModel(item))
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "convert struct to db map", slog.Any("error", err))
+		return appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+	delete(dataMap, "id")
+	dataMap["updated_at"] = timeNow
+
+	query, args, err := r.qb.Update(pg.OutboxEventTable).Where(squirrel.Eq{"id": item.ID}).SetMap(dataMap).ToSql()
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "building query", slog.Any("error", err))
+		return appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	tag, err := r.pgClient.GetConn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "executing query", slog.Any("error", err))
+		}
+		return appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return appErrors.Chainf(appErrors.ErrNotFound, "%s.%s", r.pkg, op)
+	}
+
+	item.UpdatedAt = timeNow
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package outboxevent
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/loghandler"
+)
+
+func (r *Repository) buildWhereForList(listOptions *usecase.OutboxEventListOptions) squirrel.And {
+	where := squirrel.And{}
+
+	if listOptions == nil {
+		return where
+	}
+
+	if listOptions.FilterPendingAt != nil {
+		where = append(where,
+			squirrel.Expr("processed_at IS NULL"),
+			squirrel.Expr("failed_at IS NULL"),
+			squirrel.LtOrEq{"next_attempt_at": *listOptions.FilterPendingAt},
+		)
+	}
+
+	return where
+}
+
+func (r *Repository) buildSortForList(listOptions *usecase.OutboxEventListOptions) []string {
+	if listOptions == nil || len(listOptions.Sort) == 0 {
+		return []string{"created_at ASC"}
+	}
+
+	sort := make([]string, 0, len(listOptions.Sort))
+
+	for _, sortOption := range listOptions.Sort {
+		switch sortOption.Field {
+		case usecase.OutboxEventListOptionsSortFieldCreatedAt:
+			if sortOption.IsDesc {
+				sort = append(sort, "created_at DESC")
+			} else {
+				sort = append(sort, "created_at ASC")
+			}
+		}
+	}
+
+	return sort
+}
+
+func (r *Repository) FindList(
+	ctx context.Context,
+	listOptions *usecase.OutboxEventListOptions,
+	queryParams *uctypes.QueryGetListParams,
+) ([]*entity.OutboxEvent, error) {
+	const op = "FindList"
+
+	where := r.buildWhereForList(listOptions)
+
+	q := r.qb.Select(pg.OutboxEventTableFields...).From(pg.OutboxEventTable).Where(where)
+
+	sort := r.buildSortForList(listOptions)
+	if len(sort) > 0 {
+		q = q.OrderBy(sort...)
+	}
+
+	if queryParams != nil {
+		if queryParams.ForUpdateSkipLocked {
+			q = q.Suffix("FOR UPDATE SKIP LOCKED")
+		} else if queryParams.ForUpdate {
+			q = q.Suffix("FOR UPDATE")
+		} else if queryParams.ForShare {
+			q = q.Suffix("FOR SHARE")
+		}
+
+		if queryParams.Limit > 0 {
+			q = q.Limit(queryParams.Limit)
+		}
+
+		if queryParams.Offset > 0 {
+			q = q.Offset(queryParams.Offset)
+		}
+	}
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "building query", slog.Any("error", err))
+		return nil, appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	rows, err := r.pgClient.GetConn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "query row error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	defer rows.Close()
+
+	dbData := []*pg.OutboxEventDBModel{}
+
+	if err := pgxscan.ScanAll(&dbData, rows); err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "scan row error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	result := make([]*entity.OutboxEvent, 0, len(dbData))
+	for _, dbItem := range dbData {
+		result = append(result, dbItem.ToEntity())
+	}
+
+	return result, nil
+}
@@ -0,0 +1,100 @@
+package pg
+
+import (
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/pkg/dbhelper"
+)
+
+const (
+	RecurringRuleTable = "recurring_rule"
+)
+
+var RecurringRuleTableFields = []string{}
+
+func init() {
+	RecurringRuleTableFields = dbhelper.ExtractDBFields(&RecurringRuleDBModel{})
+}
+
+type RecurringRuleDBModel struct {
+	ID               uuid.UUID       `db:"id"`
+	AccountID        uuid.UUID       `db:"account_id"`
+	IsIncome         bool            `db:"is_income"`
+	Amount           decimal.Decimal `db:"amount"`
+	Currency         string          `db:"currency"`
+	CategoryID       uint64          `db:"category_id"`
+	WalletID         *uuid.UUID      `db:"wallet_id"`
+	Description      string          `db:"description"`
+	Frequency        string          `db:"frequency"`
+	Interval         int             `db:"repeat_interval"`
+	DayOfMonth       int             `db:"day_of_month"`
+	StartDate        civil.Date      `db:"start_date"`
+	EndDate          *civil.Date     `db:"end_date"`
+	Count            *int            `db:"max_count"`
+	OccurrencesCount int             `db:"occurrences_count"`
+	NextOccurrenceOn *civil.Date     `db:"next_occurrence_on"`
+	IsPaused         bool            `db:"is_paused"`
+	LastError        *string         `db:"last_error"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+}
+
+func (db *RecurringRuleDBModel) ToEntity() *entity.RecurringRule {
+	return &entity.RecurringRule{
+		ID:               db.ID,
+		AccountID:        db.AccountID,
+		IsIncome:         db.IsIncome,
+		Amount:           db.Amount,
+		Currency:         db.Currency,
+		CategoryID:       db.CategoryID,
+		WalletID:         db.WalletID,
+		Description:      db.Description,
+		Frequency:        entity.RecurringFrequency(db.Frequency),
+		Interval:         db.Interval,
+		DayOfMonth:       db.DayOfMonth,
+		StartDate:        db.StartDate,
+		EndDate:          db.EndDate,
+		Count:            db.Count,
+		OccurrencesCount: db.OccurrencesCount,
+		NextOccurrenceOn: db.NextOccurrenceOn,
+		IsPaused:         db.IsPaused,
+		LastError:        db.LastError,
+
+		CreatedAt: db.CreatedAt,
+		UpdatedAt: db.UpdatedAt,
+		DeletedAt: db.DeletedAt,
+	}
+}
+
+func MapRecurringRuleEntityToDBModel(entity *entity.RecurringRule) *RecurringRuleDBModel {
+	return &RecurringRuleDBModel{
+		ID:               entity.ID,
+		AccountID:        entity.AccountID,
+		IsIncome:         entity.IsIncome,
+		Amount:           entity.Amount,
+		Currency:         entity.Currency,
+		CategoryID:       entity.CategoryID,
+		WalletID:         entity.WalletID,
+		Description:      entity.Description,
+		Frequency:        string(entity.Frequency),
+		Interval:         entity.Interval,
+		DayOfMonth:       entity.DayOfMonth,
+		StartDate:        entity.StartDate,
+		EndDate:          entity.EndDate,
+		Count:            entity.Count,
+		OccurrencesCount: entity.OccurrencesCount,
+		NextOccurrenceOn: entity.NextOccurrenceOn,
+		IsPaused:         entity.IsPaused,
+		LastError:        entity.LastError,
+
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+		DeletedAt: entity.DeletedAt,
+	}
+}
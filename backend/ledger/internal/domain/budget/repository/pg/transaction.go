@@ -8,6 +8,7 @@ import (
 	"github.com/govalues/decimal"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	"github.com/m11ano/budget_planner/backend/ledger/pkg/dbhelper"
+	"github.com/samber/lo"
 )
 
 const (
@@ -21,13 +22,21 @@ func init() {
 }
 
 type TransactionDBModel struct {
-	ID          uuid.UUID       `db:"id"`
-	AccountID   uuid.UUID       `db:"account_id"`
-	IsIncome    bool            `db:"is_income"`
-	Amount      decimal.Decimal `db:"amount"`
-	OccurredOn  civil.Date      `db:"occurred_on"`
-	CategoryID  uint64          `db:"category_id"`
-	Description string          `db:"description"`
+	ID                uuid.UUID       `db:"id"`
+	AccountID         uuid.UUID       `db:"account_id"`
+	IsIncome          bool            `db:"is_income"`
+	Amount            decimal.Decimal `db:"amount"`
+	Currency          string          `db:"currency"`
+	OccurredOn        civil.Date      `db:"occurred_on"`
+	CategoryID        *uint64         `db:"category_id"`
+	WalletID          *uuid.UUID      `db:"wallet_id"`
+	TransferID        *uuid.UUID      `db:"transfer_id"`
+	RecurringRuleID   *uuid.UUID      `db:"recurring_rule_id"`
+	Description       string          `db:"description"`
+	ExternalID        *string         `db:"external_id"`
+	ImportFingerprint *string         `db:"import_fingerprint"`
+	DuplicateOfID     *uuid.UUID      `db:"duplicate_of_id"`
+	Tags              []string        `db:"tags"`
 
 	CreatedAt time.Time  `db:"created_at"`
 	UpdatedAt time.Time  `db:"updated_at"`
@@ -36,13 +45,21 @@ type TransactionDBModel struct {
 
 func (db *TransactionDBModel) ToEntity() *entity.Transaction {
 	return &entity.Transaction{
-		ID:          db.ID,
-		AccountID:   db.AccountID,
-		IsIncome:    db.IsIncome,
-		Amount:      db.Amount,
-		OccurredOn:  db.OccurredOn,
-		CategoryID:  db.CategoryID,
-		Description: db.Description,
+		ID:                db.ID,
+		AccountID:         db.AccountID,
+		IsIncome:          db.IsIncome,
+		Amount:            db.Amount,
+		Currency:          db.Currency,
+		OccurredOn:        db.OccurredOn,
+		CategoryID:        lo.FromPtr(db.CategoryID),
+		WalletID:          db.WalletID,
+		TransferID:        db.TransferID,
+		RecurringRuleID:   db.RecurringRuleID,
+		Description:       db.Description,
+		ExternalID:        db.ExternalID,
+		ImportFingerprint: db.ImportFingerprint,
+		DuplicateOfID:     db.DuplicateOfID,
+		Tags:              db.Tags,
 
 		CreatedAt: db.CreatedAt,
 		UpdatedAt: db.UpdatedAt,
@@ -52,13 +69,22 @@ func (db *TransactionDBModel) ToEntity() *entity.Transaction {
 
 func MapTransactionEntityToDBModel(entity *entity.Transaction) *TransactionDBModel {
 	return &TransactionDBModel{
-		ID:          entity.ID,
-		AccountID:   entity.AccountID,
-		IsIncome:    entity.IsIncome,
-		Amount:      entity.Amount,
-		OccurredOn:  entity.OccurredOn,
-		CategoryID:  entity.CategoryID,
-		Description: entity.Description,
+		ID:                entity.ID,
+		AccountID:         entity.AccountID,
+		IsIncome:          entity.IsIncome,
+		Amount:            entity.Amount,
+		Currency:          entity.Currency,
+		OccurredOn:        entity.OccurredOn,
+		CategoryID:        lo.EmptyableToPtr(entity.CategoryID),
+		WalletID:          entity.WalletID,
+		TransferID:        entity.TransferID,
+		RecurringRuleID:   entity.RecurringRuleID,
+		Description:       entity.Description,
+		ExternalID:        entity.ExternalID,
+		ImportFingerprint: entity.ImportFingerprint,
+		DuplicateOfID:     entity.DuplicateOfID,
+		// колонка NOT NULL, пустой список тегов записывается как '{}'
+		Tags: lo.Ternary(entity.Tags != nil, entity.Tags, []string{}),
 
 		CreatedAt: entity.CreatedAt,
 		UpdatedAt: entity.UpdatedAt,
@@ -7,6 +7,7 @@ import (
 
 	"github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg"
@@ -41,6 +42,47 @@ func (r *Repository) Create(ctx context.Context, item *entity.Transaction) error
 	return nil
 }
 
+// CreateBatch - вставка пакета одной командой COPY, при ошибке в любой строке не вставляется ничего
+func (r *Repository) CreateBatch(ctx context.Context, items []*entity.Transaction) error {
+	const op = "CreateBatch"
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	rows := make([][]any, 0, len(items))
+	for _, item := range items {
+		dataMap, err := dbhelper.DBModelToMap(pg.MapTransactionEntityToDBModel(item))
+		if err != nil {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "convert struct to db map", slog.Any("error", err))
+			return appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+		}
+
+		row := make([]any, 0, len(pg.TransactionTableFields))
+		for _, field := range pg.TransactionTableFields {
+			row = append(row, dataMap[field])
+		}
+
+		rows = append(rows, row)
+	}
+
+	_, err := r.pgClient.GetConn(ctx).CopyFrom(
+		ctx,
+		pgx.Identifier{pg.TransactionTable},
+		pg.TransactionTableFields,
+		pgx.CopyFromRows(rows),
+	)
+	if err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "executing copy", slog.Any("error", err))
+		}
+		return appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	return nil
+}
+
 func (r *Repository) Update(ctx context.Context, item *entity.Transaction) error {
 	const op = "Update"
 
@@ -102,3 +144,31 @@ func (r *Repository) Update(ctx context.Context, item *entity.Transaction) error
 
 	return nil
 }
+
+func (r *Repository) ReassignCategory(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) error {
+	const op = "ReassignCategory"
+
+	query, args, err := r.qb.Update(pg.TransactionTable).
+		Set("category_id", toCategoryID).
+		Set("updated_at", time.Now().Truncate(time.Microsecond)).
+		Where(squirrel.And{
+			squirrel.Eq{"category_id": fromCategoryID},
+			squirrel.Expr("deleted_at IS NULL"),
+		}).
+		ToSql()
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "building query", slog.Any("error", err))
+		return appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	_, err = r.pgClient.GetConn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "executing query", slog.Any("error", err))
+		}
+		return appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	return nil
+}
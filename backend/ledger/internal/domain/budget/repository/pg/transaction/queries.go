@@ -3,6 +3,7 @@ package transaction
 import (
 	"context"
 	"log/slog"
+	"strings"
 
 	"cloud.google.com/go/civil"
 	"github.com/Masterminds/squirrel"
@@ -40,6 +41,60 @@ func (r *Repository) buildWhereForList(listOptions *usecase.TransactionListOptio
 		where = append(where, squirrel.LtOrEq{"occurred_on": *listOptions.FilterOccurredOnTo})
 	}
 
+	if listOptions.FilterCategoryID != nil {
+		where = append(where, squirrel.Eq{"category_id": *listOptions.FilterCategoryID})
+	}
+
+	if len(listOptions.FilterCategoryIDs) > 0 {
+		where = append(where, squirrel.Eq{"category_id": listOptions.FilterCategoryIDs})
+	}
+
+	if listOptions.FilterIsIncome != nil {
+		where = append(where, squirrel.Eq{"is_income": *listOptions.FilterIsIncome})
+	}
+
+	if listOptions.FilterAmountMin != nil {
+		where = append(where, squirrel.Expr("ABS(amount) >= ?", *listOptions.FilterAmountMin))
+	}
+
+	if listOptions.FilterAmountMax != nil {
+		where = append(where, squirrel.Expr("ABS(amount) <= ?", *listOptions.FilterAmountMax))
+	}
+
+	if listOptions.FilterSearch != nil {
+		// запрос разбирается в обеих конфигурациях, как и search_vector
+		where = append(where, squirrel.Expr(
+			"search_vector @@ (websearch_to_tsquery('russian', ?) || websearch_to_tsquery('english', ?))",
+			*listOptions.FilterSearch,
+			*listOptions.FilterSearch,
+		))
+	}
+
+	if len(listOptions.FilterTags) > 0 {
+		where = append(where, squirrel.Expr("tags @> ?", listOptions.FilterTags))
+	}
+
+	if listOptions.FilterWalletID != nil {
+		where = append(where, squirrel.Eq{"wallet_id": *listOptions.FilterWalletID})
+	}
+
+	if listOptions.FilterTransferID != nil {
+		where = append(where, squirrel.Eq{"transfer_id": *listOptions.FilterTransferID})
+	}
+
+	if listOptions.FilterRecurringRuleID != nil {
+		where = append(where, squirrel.Eq{"recurring_rule_id": *listOptions.FilterRecurringRuleID})
+	}
+
+	if listOptions.FilterBefore != nil {
+		where = append(where, squirrel.Expr(
+			"(occurred_on, created_at, id) < (?, ?, ?)",
+			listOptions.FilterBefore.OccurredOn,
+			listOptions.FilterBefore.CreatedAt,
+			listOptions.FilterBefore.ID,
+		))
+	}
+
 	return where
 }
 
@@ -64,6 +119,18 @@ func (r *Repository) buildSortForList(listOptions *usecase.TransactionListOption
 			} else {
 				sort = append(sort, "created_at ASC")
 			}
+		case usecase.TransactionListOptionsSortFieldID:
+			if sortOption.IsDesc {
+				sort = append(sort, "id DESC")
+			} else {
+				sort = append(sort, "id ASC")
+			}
+		case usecase.TransactionListOptionsSortFieldAmount:
+			if sortOption.IsDesc {
+				sort = append(sort, "ABS(amount) DESC")
+			} else {
+				sort = append(sort, "ABS(amount) ASC")
+			}
 		}
 	}
 
@@ -242,6 +309,34 @@ func (r *Repository) FindPagedList(
 	return result, total, nil
 }
 
+func (r *Repository) Count(
+	ctx context.Context,
+	listOptions *usecase.TransactionListOptions,
+) (uint64, error) {
+	const op = "Count"
+
+	where := r.buildWhereForList(listOptions, false)
+
+	query, args, err := r.qb.Select("COUNT(*) as total").From(pg.TransactionTable).Where(where).ToSql()
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "building query", slog.Any("error", err))
+		return 0, appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	var total uint64
+
+	row := r.pgClient.GetConn(ctx).QueryRow(ctx, query, args...)
+	if err := row.Scan(&total); err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "scan total error", slog.Any("error", err))
+		}
+		return 0, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	return total, nil
+}
+
 func (r *Repository) FindOneByID(
 	ctx context.Context,
 	id uuid.UUID,
@@ -308,17 +403,19 @@ func (r *Repository) CountReportItems(
 	const op = "CountReportItems"
 
 	type reportRow struct {
-		AccountID    uuid.UUID        `db:"account_id"`
-		Sum          decimal.Decimal  `db:"sum"`
-		Period       civil.Date       `db:"period"`
-		CategoryID   uint64           `db:"category_id"`
-		BudgetID     *uuid.UUID       `db:"budget_id"`
-		BudgetAmount *decimal.Decimal `db:"budget_amount"`
+		AccountID         uuid.UUID        `db:"account_id"`
+		Sum               decimal.Decimal  `db:"sum"`
+		Period            civil.Date       `db:"period"`
+		CategoryID        uint64           `db:"category_id"`
+		BudgetID          *uuid.UUID       `db:"budget_id"`
+		BudgetAmount      *decimal.Decimal `db:"budget_amount"`
+		MissingCurrencies *string          `db:"missing_currencies"`
 	}
 
 	where := squirrel.And{
 		squirrel.Eq{"account_id": queryFilter.AccountID},
 		squirrel.Expr("deleted_at IS NULL"),
+		squirrel.Expr("transfer_id IS NULL"),
 	}
 
 	if queryFilter.DateFrom != nil {
@@ -330,21 +427,51 @@ func (r *Repository) CountReportItems(
 	if queryFilter.CategoryID != nil {
 		where = append(where, squirrel.Eq{"category_id": *queryFilter.CategoryID})
 	}
+	if len(queryFilter.CategoryIDs) > 0 {
+		where = append(where, squirrel.Eq{"category_id": queryFilter.CategoryIDs})
+	}
 	if len(queryFilter.ExcludeIDs) > 0 {
 		where = append(where, squirrel.NotEq{"id": queryFilter.ExcludeIDs})
 	}
 
+	baseCurrency := queryFilter.BaseCurrency
+
 	periodExpr := "date_trunc('month', occurred_on::timestamp)::date AS period"
-	sumExpr := "SUM(amount) AS sum"
+
+	// Сумма в базовой валюте по последнему курсу на дату транзакции, транзакции без курса в сумму не входят
+	sumExpr := squirrel.Expr(
+		"COALESCE(SUM(CASE WHEN tr.currency = ? THEN tr.amount ELSE tr.amount * er.rate END), 0) AS sum",
+		baseCurrency,
+	)
+
+	missingExpr := squirrel.Expr(
+		"string_agg(DISTINCT tr.currency, ',') FILTER (WHERE tr.currency <> ? AND er.rate IS NULL) AS missing_currencies",
+		baseCurrency,
+	)
+
+	rateJoin := `LATERAL (
+			SELECT CASE WHEN r.currency_from = tr.currency THEN r.rate ELSE 1 / r.rate END AS rate
+			FROM exchange_rate r
+			WHERE r.account_id = tr.account_id
+				AND r.rate_date <= tr.occurred_on
+				AND (
+					(r.currency_from = tr.currency AND r.currency_to = ?)
+					OR (r.currency_from = ? AND r.currency_to = tr.currency)
+				)
+			ORDER BY r.rate_date DESC
+			LIMIT 1
+		) er ON tr.currency <> ?`
 
 	inner := r.qb.
 		Select(
 			"account_id",
 			periodExpr,
 			"category_id",
-			sumExpr,
 		).
-		From(pg.TransactionTable).
+		Column(sumExpr).
+		Column(missingExpr).
+		From(pg.TransactionTable+" tr").
+		LeftJoin(rateJoin, baseCurrency, baseCurrency, baseCurrency).
 		Where(where).
 		GroupBy("account_id", "period", "category_id")
 
@@ -354,6 +481,7 @@ func (r *Repository) CountReportItems(
 			"t.period",
 			"t.category_id",
 			"t.sum",
+			"t.missing_currencies",
 			"b.id AS budget_id",
 			"b.amount AS budget_amount",
 		).
@@ -393,13 +521,21 @@ func (r *Repository) CountReportItems(
 
 	result := make([]*entity.AccountTransactionReportItem, 0, len(dbData))
 	for _, it := range dbData {
-		result = append(result, &entity.AccountTransactionReportItem{
-			Sum:          &it.Sum,
+		sum := it.Sum.Round(entity.CurrencyScale(baseCurrency))
+
+		item := &entity.AccountTransactionReportItem{
+			Sum:          &sum,
 			Period:       it.Period,
 			CategoryID:   it.CategoryID,
 			BudgetID:     it.BudgetID,
 			BudgetAmount: it.BudgetAmount,
-		})
+		}
+
+		if it.MissingCurrencies != nil {
+			item.MissingCurrencies = strings.Split(*it.MissingCurrencies, ",")
+		}
+
+		result = append(result, item)
 	}
 
 	return result, nil
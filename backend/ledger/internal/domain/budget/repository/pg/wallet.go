@@ -0,0 +1,63 @@
+package pg
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/pkg/dbhelper"
+)
+
+const (
+	WalletTable = "wallet"
+)
+
+var WalletTableFields = []string{}
+
+func init() {
+	WalletTableFields = dbhelper.ExtractDBFields(&WalletDBModel{})
+}
+
+type WalletDBModel struct {
+	ID             uuid.UUID       `db:"id"`
+	AccountID      uuid.UUID       `db:"account_id"`
+	Title          string          `db:"title"`
+	Currency       string          `db:"currency"`
+	OpeningBalance decimal.Decimal `db:"opening_balance"`
+	IsArchived     bool            `db:"is_archived"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+}
+
+func (db *WalletDBModel) ToEntity() *entity.Wallet {
+	return &entity.Wallet{
+		ID:             db.ID,
+		AccountID:      db.AccountID,
+		Title:          db.Title,
+		Currency:       db.Currency,
+		OpeningBalance: db.OpeningBalance,
+		IsArchived:     db.IsArchived,
+
+		CreatedAt: db.CreatedAt,
+		UpdatedAt: db.UpdatedAt,
+		DeletedAt: db.DeletedAt,
+	}
+}
+
+func MapWalletEntityToDBModel(entity *entity.Wallet) *WalletDBModel {
+	return &WalletDBModel{
+		ID:             entity.ID,
+		AccountID:      entity.AccountID,
+		Title:          entity.Title,
+		Currency:       entity.Currency,
+		OpeningBalance: entity.OpeningBalance,
+		IsArchived:     entity.IsArchived,
+
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+		DeletedAt: entity.DeletedAt,
+	}
+}
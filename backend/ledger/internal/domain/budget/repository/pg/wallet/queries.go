@@ -0,0 +1,290 @@
+package wallet
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/repository/pg"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/loghandler"
+)
+
+func (r *Repository) buildWhereForList(listOptions *usecase.WalletListOptions, withDeleted bool) (where squirrel.And) {
+	defer func() {
+		if !withDeleted {
+			where = append(where, squirrel.Expr("deleted_at IS NULL"))
+		}
+	}()
+
+	if listOptions == nil {
+		return where
+	}
+
+	if listOptions.FilterIDs != nil {
+		where = append(where, squirrel.Eq{"id": *listOptions.FilterIDs})
+	}
+
+	if listOptions.FilterAccountID != nil {
+		where = append(where, squirrel.Eq{"account_id": *listOptions.FilterAccountID})
+	}
+
+	if listOptions.FilterIsArchived != nil {
+		where = append(where, squirrel.Eq{"is_archived": *listOptions.FilterIsArchived})
+	}
+
+	return where
+}
+
+func (r *Repository) buildSortForList(listOptions *usecase.WalletListOptions) []string {
+	if listOptions == nil || len(listOptions.Sort) == 0 {
+		return []string{"created_at ASC"}
+	}
+
+	sort := make([]string, 0, len(listOptions.Sort))
+
+	for _, sortOption := range listOptions.Sort {
+		switch sortOption.Field {
+		case usecase.WalletListOptionsSortFieldTitle:
+			if sortOption.IsDesc {
+				sort = append(sort, "title DESC")
+			} else {
+				sort = append(sort, "title ASC")
+			}
+		case usecase.WalletListOptionsSortFieldCreatedAt:
+			if sortOption.IsDesc {
+				sort = append(sort, "created_at DESC")
+			} else {
+				sort = append(sort, "created_at ASC")
+			}
+		}
+	}
+
+	return sort
+}
+
+func (r *Repository) FindList(
+	ctx context.Context,
+	listOptions *usecase.WalletListOptions,
+	queryParams *uctypes.QueryGetListParams,
+) ([]*entity.Wallet, error) {
+	const op = "FindList"
+
+	withDeleted := queryParams != nil && queryParams.WithDeleted
+
+	where := r.buildWhereForList(listOptions, withDeleted)
+
+	fields := pg.WalletTableFields
+
+	q := r.qb.Select(fields...).From(pg.WalletTable).Where(where)
+
+	sort := r.buildSortForList(listOptions)
+	if len(sort) > 0 {
+		q = q.OrderBy(sort...)
+	}
+
+	if queryParams != nil {
+		if queryParams.ForUpdateSkipLocked {
+			q = q.Suffix("FOR UPDATE SKIP LOCKED")
+		} else if queryParams.ForUpdate {
+			q = q.Suffix("FOR UPDATE")
+		} else if queryParams.ForShare {
+			q = q.Suffix("FOR SHARE")
+		}
+
+		if queryParams.Limit > 0 {
+			q = q.Limit(queryParams.Limit)
+		}
+
+		if queryParams.Offset > 0 {
+			q = q.Offset(queryParams.Offset)
+		}
+	}
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "building query", slog.Any("error", err))
+		return nil, appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	rows, err := r.pgClient.GetConn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "query row error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	defer rows.Close()
+
+	dbData := []*pg.WalletDBModel{}
+
+	if err := pgxscan.ScanAll(&dbData, rows); err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "scan row error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	result := make([]*entity.Wallet, 0, len(dbData))
+	for _, dbItem := range dbData {
+		result = append(result, dbItem.ToEntity())
+	}
+
+	return result, nil
+}
+
+func (r *Repository) FindOneByID(
+	ctx context.Context,
+	id uuid.UUID,
+	queryParams *uctypes.QueryGetOneParams,
+) (*entity.Wallet, error) {
+	const op = "FindOneByID"
+
+	withDeleted := queryParams != nil && queryParams.WithDeleted
+
+	where := squirrel.And{
+		squirrel.Eq{"id": id},
+	}
+
+	if !withDeleted {
+		where = append(where, squirrel.Expr("deleted_at IS NULL"))
+	}
+
+	q := r.qb.Select(pg.WalletTableFields...).From(pg.WalletTable).Where(where)
+
+	if queryParams != nil {
+		if queryParams.ForUpdateSkipLocked {
+			q = q.Suffix("FOR UPDATE SKIP LOCKED")
+		} else if queryParams.ForUpdate {
+			q = q.Suffix("FOR UPDATE")
+		} else if queryParams.ForShare {
+			q = q.Suffix("FOR SHARE")
+		}
+	}
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "building query", slog.Any("error", err))
+		return nil, appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	rows, err := r.pgClient.GetConn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "query row error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	defer rows.Close()
+
+	dbData := &pg.WalletDBModel{}
+
+	if err := pgxscan.ScanOne(dbData, rows); err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "scan row error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	return dbData.ToEntity(), nil
+}
+
+func (r *Repository) CountBalances(
+	ctx context.Context,
+	queryFilter usecase.CountWalletBalancesQueryFilter,
+) ([]*entity.WalletBalance, error) {
+	const op = "CountBalances"
+
+	type balanceRow struct {
+		WalletID        uuid.UUID       `db:"wallet_id"`
+		OpeningBalance  decimal.Decimal `db:"opening_balance"`
+		TransactionsSum decimal.Decimal `db:"transactions_sum"`
+	}
+
+	joinOn := `"transaction" t
+		ON t.wallet_id = w.id
+		AND t.deleted_at IS NULL`
+	joinArgs := []any{}
+
+	if queryFilter.DateTo != nil {
+		joinOn += `
+		AND t.occurred_on <= ?`
+		joinArgs = append(joinArgs, *queryFilter.DateTo)
+	}
+
+	where := squirrel.And{
+		squirrel.Eq{"w.account_id": queryFilter.AccountID},
+		squirrel.Expr("w.deleted_at IS NULL"),
+	}
+
+	if len(queryFilter.WalletIDs) > 0 {
+		where = append(where, squirrel.Eq{"w.id": queryFilter.WalletIDs})
+	}
+
+	q := r.qb.
+		Select(
+			"w.id AS wallet_id",
+			"w.opening_balance",
+			"COALESCE(SUM(t.amount), 0) AS transactions_sum",
+		).
+		From(pg.WalletTable+" w").
+		LeftJoin(joinOn, joinArgs...).
+		Where(where).
+		GroupBy("w.id", "w.opening_balance").
+		OrderBy("w.created_at ASC")
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		r.logger.ErrorContext(loghandler.WithSource(ctx), "building query", slog.Any("error", err))
+		return nil, appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	rows, err := r.pgClient.GetConn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "query error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+	defer rows.Close()
+
+	var dbData []*balanceRow
+	if err := pgxscan.ScanAll(&dbData, rows); err != nil {
+		convErr, ok := appErrors.ConvertPgxToAppErr(err)
+		if !ok {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "scan error", slog.Any("error", err))
+		}
+		return nil, appErrors.Chainf(convErr, "%s.%s", r.pkg, op)
+	}
+
+	result := make([]*entity.WalletBalance, 0, len(dbData))
+	for _, it := range dbData {
+		balance, err := it.OpeningBalance.Add(it.TransactionsSum)
+		if err != nil {
+			r.logger.ErrorContext(loghandler.WithSource(ctx), "balance overflow", slog.Any("error", err))
+			return nil, appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+		}
+
+		result = append(result, &entity.WalletBalance{
+			WalletID:        it.WalletID,
+			OpeningBalance:  it.OpeningBalance,
+			TransactionsSum: it.TransactionsSum,
+			Balance:         balance,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,229 @@
+package transaction
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/samber/lo"
+	"golang.org/x/text/encoding/charmap"
+)
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// cashTypes - секции QIF с операциями по счету, остальные (категории, инвестиции, шаблоны) пропускаются
+var cashTypes = map[string]struct{}{
+	"bank":  {},
+	"cash":  {},
+	"ccard": {},
+	"oth a": {},
+	"oth l": {},
+}
+
+// dateLayouts - форматы поля D после замены апострофа на слеш и пробелов на нули
+var dateLayouts = []string{
+	"1/2/2006",
+	"1/2/06",
+	"2.1.2006",
+	"2.1.06",
+	"2006-01-02",
+}
+
+// qifRecord - поля операции QIF до разделителя ^
+type qifRecord struct {
+	date   string
+	amount string
+	payee  string
+	memo   string
+}
+
+func (r *Repository) Format() usecase.TransactionImportFormat {
+	return usecase.TransactionImportFormatQIF
+}
+
+func (r *Repository) ItemsFromStatement(
+	_ context.Context,
+	data []byte,
+	accountID uuid.UUID,
+	defaultCurrency string,
+	defaultCategoryID *uint64,
+) ([]*usecase.TransactionImportRow, error) {
+	const op = "ItemsFromStatement"
+
+	data = bytes.TrimPrefix(data, utf8BOM)
+
+	// QIF не содержит кодировку, файлы не в UTF-8 выгружаются банками в windows-1251
+	if !utf8.Valid(data) {
+		decoded, err := charmap.Windows1251.NewDecoder().Bytes(data)
+		if err != nil {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid file encoding"),
+				"%s.%s", r.pkg, op,
+			)
+		}
+
+		data = decoded
+	}
+
+	var (
+		out        []*usecase.TransactionImportRow
+		hasType    bool
+		inCashType bool
+		record     = &qifRecord{}
+		hasFields  bool
+	)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "!") {
+			header := strings.ToLower(strings.TrimSpace(line))
+			if value, ok := strings.CutPrefix(header, "!type:"); ok {
+				_, inCashType = cashTypes[strings.TrimSpace(value)]
+				hasType = true
+			} else if header == "!account" {
+				// блок описания счета, за ним следует новая секция !Type
+				inCashType = false
+			}
+
+			record, hasFields = &qifRecord{}, false
+			continue
+		}
+
+		code, value := line[0], strings.TrimSpace(line[1:])
+
+		if code == '^' {
+			if inCashType && hasFields {
+				transaction, err := transactionFromRecord(record, accountID, defaultCurrency, defaultCategoryID)
+
+				out = append(out, &usecase.TransactionImportRow{
+					Line:        len(out) + 1,
+					Transaction: transaction,
+					Err:         err,
+				})
+			}
+
+			record, hasFields = &qifRecord{}, false
+			continue
+		}
+
+		switch code {
+		case 'D':
+			record.date = value
+		case 'T', 'U':
+			record.amount = value
+		case 'P':
+			record.payee = value
+		case 'M':
+			record.memo = value
+		default:
+			// номер чека, категория, статус сверки и разбиение не используются
+			continue
+		}
+
+		hasFields = true
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithWrap(err).WithHints("invalid qif"), "%s.%s", r.pkg, op)
+	}
+
+	if !hasType {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("invalid qif: !Type header not found"), "%s.%s", r.pkg, op)
+	}
+
+	return out, nil
+}
+
+func parseQIFDate(value string) (civil.Date, error) {
+	value = strings.ReplaceAll(value, "'", "/")
+	value = strings.ReplaceAll(value, " ", "0")
+
+	for _, layout := range dateLayouts {
+		parsed, err := time.Parse(layout, value)
+		if err == nil {
+			return civil.DateOf(parsed), nil
+		}
+	}
+
+	return civil.Date{}, appErrors.ErrBadRequest.WithHints("invalid date")
+}
+
+// parseQIFAmount - сумма со знаком, запятая считается разделителем разрядов, если в сумме есть точка
+func parseQIFAmount(value string) (decimal.Decimal, error) {
+	value = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\u00a0', '\u202f':
+			return -1
+		}
+		return r
+	}, value)
+
+	if strings.Contains(value, ".") {
+		value = strings.ReplaceAll(value, ",", "")
+	} else {
+		value = strings.ReplaceAll(value, ",", ".")
+	}
+
+	amount, err := decimal.Parse(strings.TrimPrefix(value, "+"))
+	if err != nil {
+		return decimal.Zero, appErrors.ErrBadRequest.WithParent(err).WithHints("invalid amount")
+	}
+
+	return amount, nil
+}
+
+func transactionFromRecord(
+	record *qifRecord,
+	accountID uuid.UUID,
+	currency string,
+	defaultCategoryID *uint64,
+) (*entity.Transaction, error) {
+	occurredOn, err := parseQIFDate(record.date)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := parseQIFAmount(record.amount)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := entity.NewTransaction(
+		accountID,
+		amount.Sign() > 0,
+		amount,
+		currency,
+		occurredOn,
+		// без категории по умолчанию категорию подберут правила аккаунта
+		lo.FromPtr(defaultCategoryID),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	description := record.payee
+	if record.memo != "" && record.memo != description {
+		description = strings.TrimSpace(description + " " + record.memo)
+	}
+
+	err = transaction.SetDescription(description)
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
@@ -0,0 +1,170 @@
+package transaction
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/charmap"
+)
+
+func newTestRepository() *Repository {
+	return NewRepository(slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{})))
+}
+
+func TestQIFRepository_ItemsFromStatement_Table(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		record string
+
+		wantErr         bool
+		wantAmount      string
+		wantIsIncome    bool
+		wantOccurredOn  civil.Date
+		wantDescription string
+	}{
+		{
+			name:            "OK_us_date",
+			record:          "D12/31/2024\nT-10.50\nPCoffee\nMMorning\n",
+			wantAmount:      "-10.50",
+			wantOccurredOn:  civil.Date{Year: 2024, Month: 12, Day: 31},
+			wantDescription: "Coffee Morning",
+		},
+		{
+			name:            "OK_us_date_with_apostrophe_and_spaces",
+			record:          "D 1/ 2' 5\nT-1\n",
+			wantAmount:      "-1",
+			wantOccurredOn:  civil.Date{Year: 2005, Month: 1, Day: 2},
+			wantDescription: "",
+		},
+		{
+			name:            "OK_european_date_and_comma_separator",
+			record:          "D31.12.2024\nT-1 234,56\nPShop\n",
+			wantAmount:      "-1234.56",
+			wantOccurredOn:  civil.Date{Year: 2024, Month: 12, Day: 31},
+			wantDescription: "Shop",
+		},
+		{
+			name:            "OK_iso_date_thousands_comma_and_plus_sign",
+			record:          "D2025-02-01\nU+1,234.00\nPSalary\nMSalary\n",
+			wantAmount:      "1234.00",
+			wantIsIncome:    true,
+			wantOccurredOn:  civil.Date{Year: 2025, Month: 2, Day: 1},
+			wantDescription: "Salary",
+		},
+		{
+			name:    "row_error_invalid_date",
+			record:  "D2025/31/12\nT-1\n",
+			wantErr: true,
+		},
+		{
+			name:    "row_error_invalid_amount",
+			record:  "D12/31/2024\nTabc\n",
+			wantErr: true,
+		},
+		{
+			name:    "row_error_missing_amount",
+			record:  "D12/31/2024\nPShop\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			accID := uuid.New()
+			data := []byte("!Type:Bank\n" + tt.record + "^\n")
+
+			rows, err := newTestRepository().ItemsFromStatement(context.Background(), data, accID, "RUB", nil)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+			require.Equal(t, 1, rows[0].Line)
+
+			if tt.wantErr {
+				require.ErrorIs(t, rows[0].Err, appErrors.ErrBadRequest)
+				require.Nil(t, rows[0].Transaction)
+				return
+			}
+
+			require.NoError(t, rows[0].Err)
+
+			item := rows[0].Transaction
+			require.Equal(t, accID, item.AccountID)
+			require.Equal(t, tt.wantAmount, item.Amount.String())
+			require.Equal(t, tt.wantIsIncome, item.IsIncome)
+			require.Equal(t, "RUB", item.Currency)
+			require.Equal(t, tt.wantOccurredOn, item.OccurredOn)
+			require.Equal(t, tt.wantDescription, item.Description)
+		})
+	}
+}
+
+func TestQIFRepository_ItemsFromStatement_Sections(t *testing.T) {
+	t.Parallel()
+
+	// категории и инвестиции пропускаются, секции счетов после !Account разбираются
+	data := []byte("\xEF\xBB\xBF!Type:Cat\r\nNFood\r\n^\r\n" +
+		"!Account\r\nNCard\r\nTCCard\r\n^\r\n" +
+		"!Type:CCard\r\nD01/15/2025\r\nT-5\r\n^\r\n" +
+		"!Type:Invst\r\nD01/16/2025\r\nT-7\r\n^\r\n" +
+		"!Type:Cash\r\nD01/17/2025\r\nT-9\r\n^\r\n")
+
+	rows, err := newTestRepository().ItemsFromStatement(context.Background(), data, uuid.New(), "RUB", nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, "-5", rows[0].Transaction.Amount.String())
+	require.Equal(t, "-9", rows[1].Transaction.Amount.String())
+	require.Equal(t, 2, rows[1].Line)
+}
+
+func TestQIFRepository_ItemsFromStatement_Windows1251(t *testing.T) {
+	t.Parallel()
+
+	data, err := charmap.Windows1251.NewEncoder().Bytes([]byte("!Type:Bank\nD01/15/2025\nT-5\nPКофе\n^\n"))
+	require.NoError(t, err)
+
+	rows, err := newTestRepository().ItemsFromStatement(context.Background(), data, uuid.New(), "RUB", nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, "Кофе", rows[0].Transaction.Description)
+}
+
+func TestQIFRepository_ItemsFromStatement_Invalid_Table(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "without_type_header",
+			data: "D01/15/2025\nT-5\n^\n",
+		},
+		{
+			name: "empty",
+			data: "",
+		},
+		{
+			name: "csv",
+			data: "date,amount\n2025-01-15,-5\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rows, err := newTestRepository().ItemsFromStatement(context.Background(), []byte(tt.data), uuid.New(), "RUB", nil)
+			require.ErrorIs(t, err, appErrors.ErrBadRequest)
+			require.Nil(t, rows)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package transaction
+
+import (
+	"log/slog"
+)
+
+type Repository struct {
+	pkg    string
+	logger *slog.Logger
+}
+
+func NewRepository(logger *slog.Logger) *Repository {
+	return &Repository{
+		pkg:    "Budget.repository.QIFTransaction",
+		logger: logger,
+	}
+}
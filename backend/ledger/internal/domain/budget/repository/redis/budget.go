@@ -20,6 +20,11 @@ type BudgetModel struct {
 	Period     civil.Date      `json:"period"`
 	CategoryID uint64          `json:"categoryID"`
 	Amount     decimal.Decimal `json:"amount"`
+	Currency   string          `json:"currency"`
+	CarryOver  bool            `json:"carryOver"`
+
+	Enforcement       entity.BudgetEnforcement `json:"enforcement"`
+	WarningThresholds []int                    `json:"warningThresholds"`
 
 	CreatedAt time.Time  `json:"createdAt"`
 	UpdatedAt time.Time  `json:"updatedAt"`
@@ -37,9 +42,14 @@ func (db *BudgetModel) ToEntity() *entity.Budget {
 		Period:     db.Period,
 		CategoryID: db.CategoryID,
 		Amount:     db.Amount,
-		CreatedAt:  db.CreatedAt,
-		UpdatedAt:  db.UpdatedAt,
-		DeletedAt:  db.DeletedAt,
+		Currency:   db.Currency,
+		CarryOver:  db.CarryOver,
+
+		Enforcement:       db.Enforcement,
+		WarningThresholds: db.WarningThresholds,
+		CreatedAt:         db.CreatedAt,
+		UpdatedAt:         db.UpdatedAt,
+		DeletedAt:         db.DeletedAt,
 	}
 }
 
@@ -54,8 +64,13 @@ func MapBudgetEntityToDBModel(e *entity.Budget) *BudgetModel {
 		Period:     e.Period,
 		CategoryID: e.CategoryID,
 		Amount:     e.Amount,
-		CreatedAt:  e.CreatedAt,
-		UpdatedAt:  e.UpdatedAt,
-		DeletedAt:  e.DeletedAt,
+		Currency:   e.Currency,
+		CarryOver:  e.CarryOver,
+
+		Enforcement:       e.Enforcement,
+		WarningThresholds: e.WarningThresholds,
+		CreatedAt:         e.CreatedAt,
+		UpdatedAt:         e.UpdatedAt,
+		DeletedAt:         e.DeletedAt,
 	}
 }
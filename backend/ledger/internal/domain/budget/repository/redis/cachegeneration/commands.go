@@ -0,0 +1,30 @@
+package cachegeneration
+
+import (
+	"context"
+
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+func (r *Repository) BumpGenerations(ctx context.Context, scopes ...string) error {
+	const op = "BumpGenerations"
+
+	if len(scopes) == 0 {
+		return nil
+	}
+
+	_, err := r.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, scope := range scopes {
+			key := buildKey(scope)
+			pipe.Incr(ctx, key)
+			pipe.Expire(ctx, key, generationTTL)
+		}
+		return nil
+	})
+	if err != nil {
+		return appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package cachegeneration
+
+import (
+	"context"
+	"strconv"
+
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+)
+
+func (r *Repository) GetGenerations(ctx context.Context, scopes ...string) ([]uint64, error) {
+	const op = "GetGenerations"
+
+	if len(scopes) == 0 {
+		return []uint64{}, nil
+	}
+
+	keys := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		keys = append(keys, buildKey(scope))
+	}
+
+	values, err := r.redisClient.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+	}
+
+	generations := make([]uint64, len(scopes))
+	for i, value := range values {
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		generation, err := strconv.ParseUint(str, 10, 64)
+		if err != nil {
+			return nil, appErrors.Chainf(appErrors.ErrInternal.WithWrap(err), "%s.%s", r.pkg, op)
+		}
+
+		generations[i] = generation
+	}
+
+	return generations, nil
+}
@@ -0,0 +1,30 @@
+package cachegeneration
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// generationTTL - должен быть намного больше TTL кешируемых данных,
+// чтобы после истечения поколения не прочитать записи с тем же номером
+const generationTTL = 24 * time.Hour
+
+type Repository struct {
+	pkg         string
+	logger      *slog.Logger
+	redisClient *redis.Client
+}
+
+func NewRepository(logger *slog.Logger, redisClient *redis.Client) *Repository {
+	return &Repository{
+		pkg:         "Budget.repository.RedisCacheGeneration",
+		logger:      logger,
+		redisClient: redisClient,
+	}
+}
+
+func buildKey(scope string) string {
+	return "CacheGeneration::" + scope
+}
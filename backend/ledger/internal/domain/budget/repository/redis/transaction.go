@@ -8,18 +8,26 @@ import (
 )
 
 type ReportItemModelItem struct {
-	Sum          *decimal.Decimal `json:"sum"`
-	Period       civil.Date       `json:"period"`
-	CategoryID   uint64           `json:"categoryID"`
-	BudgetID     *uuid.UUID       `json:"budgetID"`
-	BudgetAmount *decimal.Decimal `json:"budgetAmount"`
+	Sum               *decimal.Decimal `json:"sum"`
+	Period            civil.Date       `json:"period"`
+	CategoryID        uint64           `json:"categoryID"`
+	ParentCategoryID  *uint64          `json:"parentCategoryID"`
+	BudgetID          *uuid.UUID       `json:"budgetID"`
+	BudgetAmount      *decimal.Decimal `json:"budgetAmount"`
+	CarriedOver       *decimal.Decimal `json:"carriedOver"`
+	EffectiveBudget   *decimal.Decimal `json:"effectiveBudget"`
+	TotalSum          *decimal.Decimal `json:"totalSum"`
+	TotalBudgetAmount *decimal.Decimal `json:"totalBudgetAmount"`
 }
 
 type ReportItemModel struct {
 	AccountID uuid.UUID              `json:"accountID"`
+	Currency  string                 `json:"currency"`
 	DateFrom  civil.Date             `json:"dateFrom"`
 	DateTo    civil.Date             `json:"dateTo"`
 	Items     []*ReportItemModelItem `json:"items"`
+
+	MissingRates []string `json:"missingRates"`
 }
 
 func (db *ReportItemModel) ToEntity() *entity.ReportItem {
@@ -34,19 +42,27 @@ func (db *ReportItemModel) ToEntity() *entity.ReportItem {
 		}
 
 		items = append(items, &entity.AccountTransactionReportItem{
-			Sum:          it.Sum,
-			Period:       it.Period,
-			CategoryID:   it.CategoryID,
-			BudgetID:     it.BudgetID,
-			BudgetAmount: it.BudgetAmount,
+			Sum:               it.Sum,
+			Period:            it.Period,
+			CategoryID:        it.CategoryID,
+			ParentCategoryID:  it.ParentCategoryID,
+			BudgetID:          it.BudgetID,
+			BudgetAmount:      it.BudgetAmount,
+			CarriedOver:       it.CarriedOver,
+			EffectiveBudget:   it.EffectiveBudget,
+			TotalSum:          it.TotalSum,
+			TotalBudgetAmount: it.TotalBudgetAmount,
 		})
 	}
 
 	return &entity.ReportItem{
 		AccountID: db.AccountID,
+		Currency:  db.Currency,
 		DateFrom:  db.DateFrom,
 		DateTo:    db.DateTo,
 		Items:     items,
+
+		MissingRates: db.MissingRates,
 	}
 }
 
@@ -62,18 +78,26 @@ func MapTransactionEntityToDBModel(e *entity.ReportItem) *ReportItemModel {
 		}
 
 		items = append(items, &ReportItemModelItem{
-			Sum:          it.Sum,
-			Period:       it.Period,
-			CategoryID:   it.CategoryID,
-			BudgetID:     it.BudgetID,
-			BudgetAmount: it.BudgetAmount,
+			Sum:               it.Sum,
+			Period:            it.Period,
+			CategoryID:        it.CategoryID,
+			ParentCategoryID:  it.ParentCategoryID,
+			BudgetID:          it.BudgetID,
+			BudgetAmount:      it.BudgetAmount,
+			CarriedOver:       it.CarriedOver,
+			EffectiveBudget:   it.EffectiveBudget,
+			TotalSum:          it.TotalSum,
+			TotalBudgetAmount: it.TotalBudgetAmount,
 		})
 	}
 
 	return &ReportItemModel{
 		AccountID: e.AccountID,
+		Currency:  e.Currency,
 		DateFrom:  e.DateFrom,
 		DateTo:    e.DateTo,
 		Items:     items,
+
+		MissingRates: e.MissingRates,
 	}
 }
@@ -0,0 +1,271 @@
+package export
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	// numFmtDate - встроенный формат Excel m/d/yyyy, отображается в локали пользователя
+	numFmtDate = 14
+	// numFmtAmount - встроенный формат Excel #,##0.00
+	numFmtAmount = 4
+)
+
+// workbook - книга с одним листом и стилями заголовка, дат и сумм
+type workbook struct {
+	file        *excelize.File
+	writer      *excelize.StreamWriter
+	headerStyle int
+	dateStyle   int
+	amountStyle int
+	row         int
+}
+
+// newWorkbook - книгу нужно закрыть через close, в том числе при ошибке записи строк
+func newWorkbook(sheet string, header []string, widths map[int]float64) (*workbook, error) {
+	book := &workbook{
+		file: excelize.NewFile(),
+	}
+
+	err := book.init(sheet, header, widths)
+	if err != nil {
+		book.close()
+		return nil, err
+	}
+
+	return book, nil
+}
+
+func (b *workbook) init(sheet string, header []string, widths map[int]float64) error {
+	file := b.file
+
+	err := file.SetSheetName("Sheet1", sheet)
+	if err != nil {
+		return err
+	}
+
+	b.writer, err = file.NewStreamWriter(sheet)
+	if err != nil {
+		return err
+	}
+
+	for col, width := range widths {
+		if err := b.writer.SetColWidth(col, col, width); err != nil {
+			return err
+		}
+	}
+
+	b.headerStyle, err = file.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		return err
+	}
+
+	b.dateStyle, err = file.NewStyle(&excelize.Style{NumFmt: numFmtDate})
+	if err != nil {
+		return err
+	}
+
+	b.amountStyle, err = file.NewStyle(&excelize.Style{NumFmt: numFmtAmount})
+	if err != nil {
+		return err
+	}
+
+	values := make([]any, 0, len(header))
+	for _, title := range header {
+		values = append(values, excelize.Cell{StyleID: b.headerStyle, Value: title})
+	}
+
+	return b.addRow(values)
+}
+
+func (b *workbook) addRow(values []any) error {
+	b.row++
+
+	cell, err := excelize.CoordinatesToCellName(1, b.row)
+	if err != nil {
+		return err
+	}
+
+	return b.writer.SetRow(cell, values)
+}
+
+func (b *workbook) date(value civil.Date) excelize.Cell {
+	return excelize.Cell{
+		StyleID: b.dateStyle,
+		Value:   time.Date(value.Year, value.Month, value.Day, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// amount - числовая ячейка, пустое значение остается пустой ячейкой
+func (b *workbook) amount(value *decimal.Decimal) any {
+	if value == nil {
+		return nil
+	}
+
+	number, _ := value.Float64()
+
+	return excelize.Cell{StyleID: b.amountStyle, Value: number}
+}
+
+// close - удаляет временные файлы потоковой записи
+func (b *workbook) close() {
+	// nolint
+	b.file.Close()
+}
+
+func (b *workbook) bytes() ([]byte, error) {
+	if err := b.writer.Flush(); err != nil {
+		return nil, err
+	}
+
+	buf, err := b.file.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (r *Repository) Format() usecase.ExportFormat {
+	return usecase.ExportFormatXLSX
+}
+
+func (r *Repository) ContentType() string {
+	return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+}
+
+func (r *Repository) FileExtension() string {
+	return "xlsx"
+}
+
+func (r *Repository) TransactionsToFile(_ context.Context, items []*usecase.TransactionDTO) ([]byte, error) {
+	const op = "TransactionsToFile"
+
+	book, err := newWorkbook(
+		"Transactions",
+		[]string{
+			"ID",
+			"AccountID",
+			"IsIncome",
+			"Amount",
+			"Currency",
+			"OccurredOn",
+			"Description",
+			"CategoryID",
+			"CategoryTitle",
+		},
+		map[int]float64{1: 38, 2: 38, 4: 14, 6: 12, 7: 40, 9: 24},
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", r.pkg, op)
+	}
+	defer book.close()
+
+	for _, item := range items {
+		var categoryTitle any
+		if item.Category != nil {
+			categoryTitle = item.Category.Title
+		}
+
+		err = book.addRow([]any{
+			item.Transaction.ID.String(),
+			item.Transaction.AccountID.String(),
+			item.Transaction.IsIncome,
+			book.amount(&item.Transaction.Amount),
+			item.Transaction.Currency,
+			book.date(item.Transaction.OccurredOn),
+			item.Transaction.Description,
+			item.Transaction.CategoryID,
+			categoryTitle,
+		})
+		if err != nil {
+			return nil, appErrors.Chainf(err, "%s.%s", r.pkg, op)
+		}
+	}
+
+	data, err := book.bytes()
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", r.pkg, op)
+	}
+
+	return data, nil
+}
+
+func (r *Repository) ReportsToFile(_ context.Context, items []*usecase.ReportExportRow) ([]byte, error) {
+	const op = "ReportsToFile"
+
+	book, err := newWorkbook(
+		"Reports",
+		[]string{
+			"PeriodStart",
+			"PeriodEnd",
+			"Currency",
+			"CategoryID",
+			"CategoryTitle",
+			"ParentCategoryID",
+			"Sum",
+			"TotalSum",
+			"Budget",
+			"CarriedOver",
+			"EffectiveBudget",
+			"SpentBudget",
+			"TotalBudget",
+			"TotalSpentBudget",
+		},
+		map[int]float64{1: 12, 2: 12, 5: 24, 7: 14, 8: 14, 9: 14, 10: 14, 11: 14, 13: 14},
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", r.pkg, op)
+	}
+	defer book.close()
+
+	for _, item := range items {
+		var parentCategoryID any
+		if item.ParentCategoryID != nil {
+			parentCategoryID = *item.ParentCategoryID
+		}
+
+		// проценты использования бюджета записываются числом без формата суммы
+		var spentBudget, totalSpentBudget any
+		if item.SpentBudget != nil {
+			spentBudget, _ = item.SpentBudget.Float64()
+		}
+		if item.TotalSpentBudget != nil {
+			totalSpentBudget, _ = item.TotalSpentBudget.Float64()
+		}
+
+		err = book.addRow([]any{
+			book.date(item.PeriodStart),
+			book.date(item.PeriodEnd),
+			item.Currency,
+			item.CategoryID,
+			item.CategoryTitle,
+			parentCategoryID,
+			book.amount(item.Sum),
+			book.amount(item.TotalSum),
+			book.amount(item.Budget),
+			book.amount(item.CarriedOver),
+			book.amount(item.EffectiveBudget),
+			spentBudget,
+			book.amount(item.TotalBudget),
+			totalSpentBudget,
+		})
+		if err != nil {
+			return nil, appErrors.Chainf(err, "%s.%s", r.pkg, op)
+		}
+	}
+
+	data, err := book.bytes()
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", r.pkg, op)
+	}
+
+	return data, nil
+}
@@ -17,7 +17,41 @@ type BudgetListOptions struct {
 	FilterPeriodFrom *civil.Date
 	FilterPeriodTo   *civil.Date
 	FilterCategoryID *uint64
-	Sort             []uctypes.SortOption[BudgetListOptionsSortField]
+	// FilterBefore - бюджеты строго после курсора в порядке (period, id) по убыванию
+	FilterBefore *BudgetListCursor
+	Sort         []uctypes.SortOption[BudgetListOptionsSortField]
+}
+
+// BudgetListCursor - позиция для keyset-пагинации списка бюджетов
+type BudgetListCursor struct {
+	Period civil.Date `json:"p"`
+	ID     uuid.UUID  `json:"i"`
+}
+
+// Token - непрозрачный токен курсора для API
+func (c *BudgetListCursor) Token() string {
+	return encodeCursor(c)
+}
+
+func ParseBudgetListCursor(token string) (*BudgetListCursor, error) {
+	out := &BudgetListCursor{}
+
+	err := decodeCursor(token, out)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// BudgetCursorPage - страница keyset-пагинации в порядке (period, id) по убыванию
+type BudgetCursorPage struct {
+	Items []*BudgetDTO
+	// NextCursor - nil на последней странице
+	NextCursor *BudgetListCursor
+	// Total - заполняется по запросу, без учета курсора
+	Total    *uint64
+	HitCache bool
 }
 
 type BudgetListOptionsSortField string
@@ -25,6 +59,7 @@ type BudgetListOptionsSortField string
 const (
 	BudgetListOptionsSortFieldPeriod    BudgetListOptionsSortField = "period"
 	BudgetListOptionsSortFieldCreatedAt BudgetListOptionsSortField = "created_at"
+	BudgetListOptionsSortFieldID        BudgetListOptionsSortField = "id"
 )
 
 type BudgetDTO struct {
@@ -36,14 +71,60 @@ type CreateBudgetDataInput struct {
 	Period     civil.Date
 	CategoryID uint64
 	Amount     decimal.Decimal
+	// Currency - пустая строка: базовая валюта аккаунта
+	Currency string
+	// CarryOver - перенос остатка бюджета прошлого месяца по категории
+	CarryOver bool
+	// Enforcement - пустая строка: hard
+	Enforcement       entity.BudgetEnforcement
+	WarningThresholds []int
+}
+
+// BudgetCopyMode - поведение при копировании, если бюджет на месяц и категорию уже существует
+type BudgetCopyMode string
+
+const (
+	BudgetCopyModeSkip      BudgetCopyMode = "skip"
+	BudgetCopyModeOverwrite BudgetCopyMode = "overwrite"
+)
+
+func (m BudgetCopyMode) IsValid() bool {
+	switch m {
+	case BudgetCopyModeSkip, BudgetCopyModeOverwrite:
+		return true
+	}
+
+	return false
+}
+
+type CopyBudgetsDataInput struct {
+	AccountID    uuid.UUID
+	SourcePeriod civil.Date
+	// TargetPeriodFrom, TargetPeriodTo - диапазон месяцев включительно, исходный месяц пропускается
+	TargetPeriodFrom civil.Date
+	TargetPeriodTo   civil.Date
+	Mode             BudgetCopyMode
+}
+
+type CopyBudgetsResult struct {
+	// Items - созданные и перезаписанные бюджеты
+	Items       []*BudgetDTO
+	Created     int
+	Overwritten int
+	Skipped     int
 }
 
 type PatchBudgetDataInput struct {
 	Version int64
 
 	Amount     *decimal.Decimal
+	Currency   *string
 	Period     *civil.Date
 	CategoryID *uint64
+	CarryOver  *bool
+
+	Enforcement       *entity.BudgetEnforcement
+	WarningThresholds *[]int
 }
 
 //go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.BudgetUsecase -o mocks/budget_usecase.go
@@ -66,6 +147,14 @@ type BudgetUsecase interface {
 		queryParams *uctypes.QueryGetListParams,
 	) (resItems []*BudgetDTO, total uint64, cacheHit bool, resErr error)
 
+	// FindCursorList - сортировка listOptions игнорируется, позиция задается FilterBefore
+	FindCursorList(
+		ctx context.Context,
+		listOptions *BudgetListOptions,
+		limit uint64,
+		withTotal bool,
+	) (resPage *BudgetCursorPage, resErr error)
+
 	CreateBudgetByDTO(
 		ctx context.Context,
 		in CreateBudgetDataInput,
@@ -81,7 +170,33 @@ type BudgetUsecase interface {
 	DeleteBudgetByID(
 		ctx context.Context,
 		id uuid.UUID,
+		version int64,
+		skipVersionCheck bool,
+	) (resErr error)
+
+	CopyBudgets(
+		ctx context.Context,
+		in CopyBudgetsDataInput,
+	) (resResult *CopyBudgetsResult, resErr error)
+
+	GetBudgetAutoRollover(
+		ctx context.Context,
+		accountID uuid.UUID,
+	) (resEnabled bool, resErr error)
+
+	SetBudgetAutoRollover(
+		ctx context.Context,
+		accountID uuid.UUID,
+		enabled bool,
 	) (resErr error)
+
+	// RolloverBudgets - копирует бюджеты прошлого месяца в period для аккаунтов с автопереносом,
+	// возвращает количество обработанных аккаунтов
+	RolloverBudgets(
+		ctx context.Context,
+		period civil.Date,
+		limit uint64,
+	) (processed int, resErr error)
 }
 
 //go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.BudgetRepository -o mocks/budget_repository.go
@@ -104,9 +219,13 @@ type BudgetRepository interface {
 		queryParams *uctypes.QueryGetListParams,
 	) (items []*entity.Budget, total uint64, err error)
 
+	Count(ctx context.Context, listOptions *BudgetListOptions) (total uint64, err error)
+
 	Create(ctx context.Context, item *entity.Budget) (err error)
 
 	Update(ctx context.Context, item *entity.Budget) (err error)
+
+	ReassignCategory(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) (err error)
 }
 
 //go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.BudgetCacheRepository -o mocks/budget_cache_repository.go
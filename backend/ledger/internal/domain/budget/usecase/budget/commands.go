@@ -3,9 +3,9 @@ package budget
 import (
 	"context"
 	"errors"
-	"log/slog"
 	"time"
 
+	"cloud.google.com/go/civil"
 	"github.com/google/uuid"
 	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
 	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
@@ -30,9 +30,20 @@ func (uc *UsecaseImpl) CreateBudgetByDTO(
 		}
 	}
 
+	currency := in.Currency
+	if currency == "" {
+		baseCurrency, err := uc.baseCurrency(ctx, in.AccountID)
+		if err != nil {
+			return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+		}
+
+		currency = baseCurrency
+	}
+
 	budget, err := entity.NewBudget(
 		in.AccountID,
 		in.Amount,
+		currency,
 		in.Period,
 		in.CategoryID,
 	)
@@ -40,13 +51,24 @@ func (uc *UsecaseImpl) CreateBudgetByDTO(
 		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
 	}
 
+	budget.CarryOver = in.CarryOver
+
+	if in.Enforcement != "" {
+		err = budget.SetEnforcement(in.Enforcement)
+		if err != nil {
+			return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+		}
+	}
+
+	err = budget.SetWarningThresholds(in.WarningThresholds)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
 	err = uc.dbMasterClient.DoWithIsoLvl(ctx, pgclient.Serializable, func(ctx context.Context) error {
-		_, err := uc.categoryRepo.FindOneByID(ctx, budget.CategoryID, nil)
+		err := uc.categoryUC.CheckCategoryAccess(ctx, budget.CategoryID, budget.AccountID)
 		if err != nil {
-			if errors.Is(err, appErrors.ErrNotFound) {
-				return appErrors.Chainf(appErrors.ErrBadRequest.WithHints("category not found"), "%s.%s", uc.pkg, op)
-			}
-			return err
+			return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
 		}
 
 		check, err := uc.budgetRepo.FindList(ctx, &usecase.BudgetListOptions{
@@ -87,15 +109,7 @@ func (uc *UsecaseImpl) CreateBudgetByDTO(
 		return nil, appErrors.Chainf(appErrors.ErrInternal, "%s.%s", uc.pkg, op)
 	}
 
-	base := context.WithoutCancel(ctx)
-	clrCtx, cancel := context.WithTimeout(base, time.Second*10)
-	go func(ctx context.Context) {
-		defer cancel()
-		err := uc.budgetCacheRepo.ClearForPrefixes(ctx, "Budget::FindList::AccountID:"+budget.AccountID.String())
-		if err != nil {
-			uc.logger.ErrorContext(loghandler.WithSource(ctx), "redis clear err", slog.Any("error", err))
-		}
-	}(clrCtx)
+	uc.invalidateBudgetsCache(ctx, budget.AccountID)
 
 	return budgetDTO[0], nil
 }
@@ -133,6 +147,13 @@ func (uc *UsecaseImpl) PatchBudgetByDTO(
 				WithDetail("last_updated_at", false, budget.UpdatedAt)
 		}
 
+		if in.Currency != nil {
+			err = budget.SetCurrency(*in.Currency)
+			if err != nil {
+				return err
+			}
+		}
+
 		if in.Amount != nil {
 			err = budget.SetAmount(*in.Amount)
 			if err != nil {
@@ -140,6 +161,24 @@ func (uc *UsecaseImpl) PatchBudgetByDTO(
 			}
 		}
 
+		if in.CarryOver != nil {
+			budget.CarryOver = *in.CarryOver
+		}
+
+		if in.Enforcement != nil {
+			err = budget.SetEnforcement(*in.Enforcement)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.WarningThresholds != nil {
+			err = budget.SetWarningThresholds(*in.WarningThresholds)
+			if err != nil {
+				return err
+			}
+		}
+
 		checkFilter := &usecase.BudgetListOptions{
 			FilterAccountID:  &budget.AccountID,
 			FilterPeriod:     &budget.Period,
@@ -148,6 +187,11 @@ func (uc *UsecaseImpl) PatchBudgetByDTO(
 		needCheck := false
 
 		if in.CategoryID != nil && *in.CategoryID != budget.CategoryID {
+			err := uc.categoryUC.CheckCategoryAccess(ctx, *in.CategoryID, budget.AccountID)
+			if err != nil {
+				return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+			}
+
 			budget.CategoryID = *in.CategoryID
 
 			needCheck = true
@@ -194,24 +238,17 @@ func (uc *UsecaseImpl) PatchBudgetByDTO(
 		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
 	}
 
-	base := context.WithoutCancel(ctx)
-	clrCtx, cancel := context.WithTimeout(base, time.Second*10)
-	go func(ctx context.Context) {
-		defer cancel()
-		err := uc.budgetCacheRepo.ClearForPrefixes(
-			ctx,
-			buildKeyForFindOneByID(id),
-			"Budget::FindList::AccountID:"+accountID.String(),
-		)
-		if err != nil {
-			uc.logger.ErrorContext(loghandler.WithSource(ctx), "redis clear err", slog.Any("error", err))
-		}
-	}(clrCtx)
+	uc.invalidateBudgetsCache(ctx, accountID, id)
 
 	return nil
 }
 
-func (uc *UsecaseImpl) DeleteBudgetByID(ctx context.Context, id uuid.UUID) error {
+func (uc *UsecaseImpl) DeleteBudgetByID(
+	ctx context.Context,
+	id uuid.UUID,
+	version int64,
+	skipVersionCheck bool,
+) error {
 	const op = "DeleteBudgetByID"
 
 	var accountID uuid.UUID
@@ -233,6 +270,12 @@ func (uc *UsecaseImpl) DeleteBudgetByID(ctx context.Context, id uuid.UUID) error
 			}
 		}
 
+		if !skipVersionCheck && budget.Version() != version {
+			return appErrors.ErrVersionConflict.
+				WithDetail("last_version", false, budget.Version()).
+				WithDetail("last_updated_at", false, budget.UpdatedAt)
+		}
+
 		budget.DeletedAt = lo.ToPtr(time.Now())
 
 		err = uc.budgetRepo.Update(ctx, budget)
@@ -246,15 +289,146 @@ func (uc *UsecaseImpl) DeleteBudgetByID(ctx context.Context, id uuid.UUID) error
 		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
 	}
 
-	base := context.WithoutCancel(ctx)
-	clrCtx, cancel := context.WithTimeout(base, time.Second*10)
-	go func(ctx context.Context) {
-		defer cancel()
-		err := uc.budgetCacheRepo.ClearForPrefixes(ctx, "Budget::FindList::AccountID:"+accountID.String())
+	uc.invalidateBudgetsCache(ctx, accountID, id)
+
+	return nil
+}
+
+func (uc *UsecaseImpl) CopyBudgets(
+	ctx context.Context,
+	in usecase.CopyBudgetsDataInput,
+) (*usecase.CopyBudgetsResult, error) {
+	const op = "CopyBudgets"
+
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != in.AccountID {
+			return nil, appErrors.ErrForbidden
+		}
+	}
+
+	if !in.Mode.IsValid() {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("invalid copy mode"), "%s.%s", uc.pkg, op)
+	}
+
+	targets, err := buildCopyPeriods(in.SourcePeriod, in.TargetPeriodFrom, in.TargetPeriodTo)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	var result *usecase.CopyBudgetsResult
+
+	err = uc.dbMasterClient.DoWithIsoLvl(ctx, pgclient.Serializable, func(ctx context.Context) error {
+		var err error
+
+		result, err = uc.copyBudgets(ctx, in.AccountID, in.SourcePeriod, targets, in.Mode)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	uc.invalidateBudgetsCache(ctx, in.AccountID, budgetDTOsIDs(result.Items)...)
+
+	return result, nil
+}
+
+func (uc *UsecaseImpl) SetBudgetAutoRollover(ctx context.Context, accountID uuid.UUID, enabled bool) error {
+	const op = "SetBudgetAutoRollover"
+
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != accountID {
+			return appErrors.ErrForbidden
+		}
+	}
+
+	err := uc.dbMasterClient.Do(ctx, func(ctx context.Context) error {
+		settings, err := uc.accountSettingsRepo.FindOneByAccountID(ctx, accountID, &uctypes.QueryGetOneParams{
+			ForUpdate: true,
+		})
 		if err != nil {
-			uc.logger.ErrorContext(loghandler.WithSource(ctx), "redis clear err", slog.Any("error", err))
+			if !errors.Is(err, appErrors.ErrNotFound) {
+				return err
+			}
+
+			settings, err = entity.NewAccountSettings(accountID, uc.cfg.Budget.DefaultCurrency)
+			if err != nil {
+				return err
+			}
 		}
-	}(clrCtx)
+
+		// бюджеты текущего месяца пользователь заводит сам, автоперенос начнется со следующего
+		settings.SetBudgetAutoRollover(enabled, civil.DateOf(time.Now()))
+
+		return uc.accountSettingsRepo.Upsert(ctx, settings)
+	})
+	if err != nil {
+		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
 
 	return nil
 }
+
+func (uc *UsecaseImpl) RolloverBudgets(ctx context.Context, period civil.Date, limit uint64) (int, error) {
+	const op = "RolloverBudgets"
+
+	ctx = auth.WithoutCheckRight(ctx)
+
+	period.Day = 1
+	source := addMonths(period, -1)
+
+	processed := 0
+	items := make(map[uuid.UUID][]*usecase.BudgetDTO)
+
+	// аккаунты, заблокированные другой репликой, пропускаются (SKIP LOCKED)
+	err := uc.dbMasterClient.Do(ctx, func(ctx context.Context) error {
+		processed = 0
+		clear(items)
+
+		settingsList, err := uc.accountSettingsRepo.FindList(ctx, &usecase.AccountSettingsListOptions{
+			FilterBudgetAutoRollover:     lo.ToPtr(true),
+			FilterBudgetRolledOverBefore: &period,
+		}, &uctypes.QueryGetListParams{
+			ForUpdateSkipLocked: true,
+			Limit:               limit,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, settings := range settingsList {
+			result, err := uc.copyBudgets(ctx, settings.AccountID, source, []civil.Date{period}, usecase.BudgetCopyModeSkip)
+			if err != nil {
+				return err
+			}
+
+			settings.BudgetRolledOverPeriod = &period
+
+			err = uc.accountSettingsRepo.Upsert(ctx, settings)
+			if err != nil {
+				return err
+			}
+
+			items[settings.AccountID] = result.Items
+			processed++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	for accountID, accountItems := range items {
+		if len(accountItems) > 0 {
+			uc.invalidateBudgetsCache(ctx, accountID, budgetDTOsIDs(accountItems)...)
+		}
+	}
+
+	return processed, nil
+}
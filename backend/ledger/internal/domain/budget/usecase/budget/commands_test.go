@@ -8,6 +8,7 @@ import (
 	"cloud.google.com/go/civil"
 	"github.com/google/uuid"
 	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
@@ -25,7 +26,12 @@ func TestBudgetUsecase_CreateBudgetByDTO_Table(t *testing.T) {
 
 		categoryErr error
 
-		wantErr bool
+		wantErr      bool
+		wantAmount   decimal.Decimal
+		wantCurrency string
+
+		wantEnforcement entity.BudgetEnforcement
+		wantThresholds  []int
 	}{
 		{
 			name: "OK",
@@ -35,8 +41,48 @@ func TestBudgetUsecase_CreateBudgetByDTO_Table(t *testing.T) {
 				CategoryID: 10,
 				Amount:     decimal.MustParse("100.129"),
 			},
-			categoryErr: nil,
-			wantErr:     false,
+			categoryErr:  nil,
+			wantErr:      false,
+			wantAmount:   decimal.MustParse("100.12"),
+			wantCurrency: "RUB",
+
+			wantEnforcement: entity.BudgetEnforcementHard,
+			wantThresholds:  []int{},
+		},
+		{
+			name: "OK_currency_without_minor_units",
+			in: usecase.CreateBudgetDataInput{
+				AccountID:  accountID,
+				Period:     civil.Date{Year: 2025, Month: 12, Day: 15},
+				CategoryID: 10,
+				Amount:     decimal.MustParse("100.129"),
+				Currency:   "jpy",
+			},
+			categoryErr:  nil,
+			wantErr:      false,
+			wantAmount:   decimal.MustParse("100"),
+			wantCurrency: "JPY",
+
+			wantEnforcement: entity.BudgetEnforcementHard,
+			wantThresholds:  []int{},
+		},
+		{
+			name: "OK_soft_with_thresholds",
+			in: usecase.CreateBudgetDataInput{
+				AccountID:         accountID,
+				Period:            civil.Date{Year: 2025, Month: 12, Day: 15},
+				CategoryID:        10,
+				Amount:            decimal.MustParse("100"),
+				Enforcement:       entity.BudgetEnforcementSoft,
+				WarningThresholds: []int{100, 80, 80},
+			},
+			categoryErr:  nil,
+			wantErr:      false,
+			wantAmount:   decimal.MustParse("100"),
+			wantCurrency: "RUB",
+
+			wantEnforcement: entity.BudgetEnforcementSoft,
+			wantThresholds:  []int{80, 100},
 		},
 	}
 
@@ -48,12 +94,12 @@ func TestBudgetUsecase_CreateBudgetByDTO_Table(t *testing.T) {
 			s := newDependencies(t)
 			defer finishDependencies(s)
 
-			s.categoryRepo.FindOneByIDMock.Set(func(ctx context.Context, id uint64, _ *uctypes.QueryGetOneParams) (*entity.Category, error) {
+			s.accountSettingsRepo.FindOneByAccountIDMock.Optional().Return(nil, appErrors.ErrNotFound)
+
+			s.categoryUC.CheckCategoryAccessMock.Set(func(ctx context.Context, id uint64, accountID uuid.UUID) error {
 				require.Equal(t, tt.in.CategoryID, id)
-				if tt.categoryErr != nil {
-					return nil, tt.categoryErr
-				}
-				return &entity.Category{ID: id}, nil
+				require.Equal(t, tt.in.AccountID, accountID)
+				return tt.categoryErr
 			})
 
 			if !tt.wantErr {
@@ -82,18 +128,19 @@ func TestBudgetUsecase_CreateBudgetByDTO_Table(t *testing.T) {
 
 					require.Equal(t, 1, b.Period.Day)
 
-					require.Equal(t, decimal.MustParse("100.12"), b.Amount)
+					require.Equal(t, tt.wantAmount, b.Amount)
+					require.Equal(t, tt.wantCurrency, b.Currency)
+
+					require.Equal(t, tt.wantEnforcement, b.Enforcement)
+					require.Equal(t, tt.wantThresholds, b.WarningThresholds)
 
 					return nil
 				})
 			}
 
-			called := make(chan struct{}, 1)
-			s.budgetCacheRepo.ClearForPrefixesMock.Set(func(ctx context.Context, prefixes ...string) error {
-				select {
-				case called <- struct{}{}:
-				default:
-				}
+			var bumped []string
+			s.cacheGenerationRepo.BumpGenerationsMock.Set(func(ctx context.Context, scopes ...string) error {
+				bumped = append(bumped, scopes...)
 				return nil
 			})
 
@@ -109,11 +156,53 @@ func TestBudgetUsecase_CreateBudgetByDTO_Table(t *testing.T) {
 			require.NotNil(t, got)
 			require.NotNil(t, got.Budget)
 
-			select {
-			case <-called:
-			case <-time.After(250 * time.Millisecond):
-				t.Fatalf("expected budgetCacheRepo.ClearForPrefixes to be called")
-			}
+			require.Contains(t, bumped, usecase.CacheScopeBudgets(accountID))
+			require.Contains(t, bumped, usecase.CacheScopeReports(accountID))
+		})
+	}
+}
+
+func TestBudgetUsecase_CreateBudgetByDTO_Negative_enforcement(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   usecase.CreateBudgetDataInput
+		want error
+	}{
+		{
+			name: "unknown enforcement",
+			in: usecase.CreateBudgetDataInput{
+				Enforcement: "strict",
+			},
+			want: entity.ErrBudgetEnforcementInvalid,
+		},
+		{
+			name: "threshold out of range",
+			in: usecase.CreateBudgetDataInput{
+				WarningThresholds: []int{80, 0},
+			},
+			want: entity.ErrBudgetWarningThresholdsInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			tt.in.AccountID = uuid.New()
+			tt.in.Period = civil.Date{Year: 2025, Month: 12, Day: 1}
+			tt.in.CategoryID = 10
+			tt.in.Amount = decimal.MustParse("100")
+			tt.in.Currency = "RUB"
+
+			got, err := s.uc.CreateBudgetByDTO(testCtx(), tt.in)
+			require.ErrorIs(t, err, tt.want)
+			require.Nil(t, got)
 		})
 	}
 }
@@ -152,12 +241,9 @@ func TestBudgetUsecase_PatchBudgetByDTO_OK(t *testing.T) {
 		return nil
 	})
 
-	called := make(chan struct{}, 1)
-	s.budgetCacheRepo.ClearForPrefixesMock.Set(func(ctx context.Context, prefixes ...string) error {
-		select {
-		case called <- struct{}{}:
-		default:
-		}
+	var bumped []string
+	s.cacheGenerationRepo.BumpGenerationsMock.Set(func(ctx context.Context, scopes ...string) error {
+		bumped = append(bumped, scopes...)
 		return nil
 	})
 
@@ -167,11 +253,9 @@ func TestBudgetUsecase_PatchBudgetByDTO_OK(t *testing.T) {
 	}, true)
 	require.NoError(t, err)
 
-	select {
-	case <-called:
-	case <-time.After(250 * time.Millisecond):
-		t.Fatalf("expected budgetCacheRepo.ClearForPrefixes to be called")
-	}
+	require.Contains(t, bumped, usecase.CacheScopeBudgets(accountID))
+	require.Contains(t, bumped, usecase.CacheScopeReports(accountID))
+	require.Contains(t, bumped, usecase.CacheScopeBudget(id))
 }
 
 func TestBudgetUsecase_DeleteBudgetByID_OK(t *testing.T) {
@@ -203,21 +287,278 @@ func TestBudgetUsecase_DeleteBudgetByID_OK(t *testing.T) {
 		return nil
 	})
 
-	called := make(chan struct{}, 1)
-	s.budgetCacheRepo.ClearForPrefixesMock.Set(func(ctx context.Context, prefixes ...string) error {
-		select {
-		case called <- struct{}{}:
-		default:
+	var bumped []string
+	s.cacheGenerationRepo.BumpGenerationsMock.Set(func(ctx context.Context, scopes ...string) error {
+		bumped = append(bumped, scopes...)
+		return nil
+	})
+
+	err := s.uc.DeleteBudgetByID(testCtx(), id, 0, true)
+	require.NoError(t, err)
+
+	require.Contains(t, bumped, usecase.CacheScopeBudgets(accountID))
+	require.Contains(t, bumped, usecase.CacheScopeReports(accountID))
+	require.Contains(t, bumped, usecase.CacheScopeBudget(id))
+}
+
+func TestBudgetUsecase_CopyBudgets_Table(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+
+	source := civil.Date{Year: 2025, Month: 1, Day: 1}
+	feb := civil.Date{Year: 2025, Month: 2, Day: 1}
+	mar := civil.Date{Year: 2025, Month: 3, Day: 1}
+
+	sourceBudgets := func() []*entity.Budget {
+		return []*entity.Budget{
+			{ID: uuid.New(), AccountID: accountID, Period: source, CategoryID: 10, Amount: decimal.MustParse("100"), Currency: "RUB"},
+			{ID: uuid.New(), AccountID: accountID, Period: source, CategoryID: 11, Amount: decimal.MustParse("50"), Currency: "USD"},
+			{ID: uuid.New(), AccountID: accountID, Period: source, CategoryID: 12, Amount: decimal.MustParse("70"), Currency: "RUB"},
 		}
+	}
+
+	tests := []struct {
+		name string
+		in   usecase.CopyBudgetsDataInput
+
+		wantCreated     int
+		wantOverwritten int
+		wantSkipped     int
+		wantErr         bool
+	}{
+		{
+			name: "OK_skip",
+			in: usecase.CopyBudgetsDataInput{
+				AccountID:        accountID,
+				SourcePeriod:     source,
+				TargetPeriodFrom: source,
+				TargetPeriodTo:   mar,
+				Mode:             usecase.BudgetCopyModeSkip,
+			},
+			// категория 12 в архиве, в феврале уже есть бюджет по категории 10
+			wantCreated: 3,
+			wantSkipped: 3,
+		},
+		{
+			name: "OK_overwrite",
+			in: usecase.CopyBudgetsDataInput{
+				AccountID:        accountID,
+				SourcePeriod:     source,
+				TargetPeriodFrom: feb,
+				TargetPeriodTo:   mar,
+				Mode:             usecase.BudgetCopyModeOverwrite,
+			},
+			wantCreated:     3,
+			wantOverwritten: 1,
+			wantSkipped:     2,
+		},
+		{
+			name: "Negative_invalid_mode",
+			in: usecase.CopyBudgetsDataInput{
+				AccountID:        accountID,
+				SourcePeriod:     source,
+				TargetPeriodFrom: feb,
+				TargetPeriodTo:   mar,
+				Mode:             "merge",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Negative_only_source_period",
+			in: usecase.CopyBudgetsDataInput{
+				AccountID:        accountID,
+				SourcePeriod:     source,
+				TargetPeriodFrom: source,
+				TargetPeriodTo:   source,
+				Mode:             usecase.BudgetCopyModeSkip,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Negative_too_many_periods",
+			in: usecase.CopyBudgetsDataInput{
+				AccountID:        accountID,
+				SourcePeriod:     source,
+				TargetPeriodFrom: feb,
+				TargetPeriodTo:   civil.Date{Year: 2028, Month: 1, Day: 1},
+				Mode:             usecase.BudgetCopyModeSkip,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			existing := &entity.Budget{
+				ID:         uuid.New(),
+				AccountID:  accountID,
+				Period:     feb,
+				CategoryID: 10,
+				Amount:     decimal.MustParse("1"),
+				Currency:   "EUR",
+			}
+
+			if !tt.wantErr {
+				s.budgetRepo.FindListMock.Set(func(
+					ctx context.Context,
+					lo *usecase.BudgetListOptions,
+					qp *uctypes.QueryGetListParams,
+				) ([]*entity.Budget, error) {
+					require.Equal(t, accountID, *lo.FilterAccountID)
+
+					if lo.FilterPeriod != nil {
+						require.Equal(t, source, *lo.FilterPeriod)
+						return sourceBudgets(), nil
+					}
+
+					require.Equal(t, feb, *lo.FilterPeriodFrom)
+					require.Equal(t, mar, *lo.FilterPeriodTo)
+					require.True(t, qp.ForUpdate)
+
+					return []*entity.Budget{existing}, nil
+				})
+
+				s.categoryUC.CheckCategoryAccessMock.Set(func(ctx context.Context, id uint64, _ uuid.UUID) error {
+					if id == 12 {
+						return appErrors.ErrBadRequest.WithHints("category is archived")
+					}
+					return nil
+				})
+
+				s.budgetRepo.CreateMock.Set(func(ctx context.Context, b *entity.Budget) error {
+					require.NotEqual(t, source, b.Period)
+					require.NotEqual(t, uint64(12), b.CategoryID)
+					return nil
+				})
+
+				if tt.wantOverwritten > 0 {
+					s.budgetRepo.UpdateMock.Set(func(ctx context.Context, b *entity.Budget) error {
+						require.Equal(t, existing.ID, b.ID)
+						require.Equal(t, "RUB", b.Currency)
+						require.Equal(t, decimal.MustParse("100"), b.Amount)
+						return nil
+					})
+				}
+			}
+
+			var bumped []string
+			s.cacheGenerationRepo.BumpGenerationsMock.Optional().Set(func(ctx context.Context, scopes ...string) error {
+				bumped = append(bumped, scopes...)
+				return nil
+			})
+
+			got, err := s.uc.CopyBudgets(testCtx(), tt.in)
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, appErrors.ErrBadRequest)
+				require.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCreated, got.Created)
+			require.Equal(t, tt.wantOverwritten, got.Overwritten)
+			require.Equal(t, tt.wantSkipped, got.Skipped)
+			require.Len(t, got.Items, tt.wantCreated+tt.wantOverwritten)
+
+			require.Contains(t, bumped, usecase.CacheScopeBudgets(accountID))
+			require.Contains(t, bumped, usecase.CacheScopeReports(accountID))
+		})
+	}
+}
+
+func TestBudgetUsecase_RolloverBudgets_OK(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	accountID := uuid.New()
+	period := civil.Date{Year: 2025, Month: 3, Day: 1}
+	prevPeriod := civil.Date{Year: 2025, Month: 2, Day: 1}
+
+	settings := &entity.AccountSettings{
+		AccountID:              accountID,
+		BaseCurrency:           "RUB",
+		BudgetAutoRollover:     true,
+		BudgetRolledOverPeriod: &prevPeriod,
+	}
+
+	s.accountSettingsRepo.FindListMock.Set(func(
+		ctx context.Context,
+		lo *usecase.AccountSettingsListOptions,
+		qp *uctypes.QueryGetListParams,
+	) ([]*entity.AccountSettings, error) {
+		require.True(t, *lo.FilterBudgetAutoRollover)
+		require.Equal(t, period, *lo.FilterBudgetRolledOverBefore)
+		require.True(t, qp.ForUpdateSkipLocked)
+		return []*entity.AccountSettings{settings}, nil
+	})
+
+	s.budgetRepo.FindListMock.Set(func(
+		ctx context.Context,
+		lo *usecase.BudgetListOptions,
+		qp *uctypes.QueryGetListParams,
+	) ([]*entity.Budget, error) {
+		if lo.FilterPeriod != nil {
+			require.Equal(t, prevPeriod, *lo.FilterPeriod)
+			return []*entity.Budget{
+				{ID: uuid.New(), AccountID: accountID, Period: prevPeriod, CategoryID: 10, Amount: decimal.MustParse("100"), Currency: "RUB"},
+			}, nil
+		}
+
+		return nil, nil
+	})
+
+	s.categoryUC.CheckCategoryAccessMock.Return(nil)
+
+	s.budgetRepo.CreateMock.Set(func(ctx context.Context, b *entity.Budget) error {
+		require.Equal(t, period, b.Period)
+		return nil
+	})
+
+	s.accountSettingsRepo.UpsertMock.Set(func(ctx context.Context, item *entity.AccountSettings) error {
+		require.Equal(t, period, *item.BudgetRolledOverPeriod)
 		return nil
 	})
 
-	err := s.uc.DeleteBudgetByID(testCtx(), id)
+	var bumped []string
+	s.cacheGenerationRepo.BumpGenerationsMock.Set(func(ctx context.Context, scopes ...string) error {
+		bumped = append(bumped, scopes...)
+		return nil
+	})
+
+	processed, err := s.uc.RolloverBudgets(testCtx(), civil.Date{Year: 2025, Month: 3, Day: 2}, 10)
 	require.NoError(t, err)
+	require.Equal(t, 1, processed)
+
+	require.Contains(t, bumped, usecase.CacheScopeBudgets(accountID))
+	require.Contains(t, bumped, usecase.CacheScopeReports(accountID))
+}
 
-	select {
-	case <-called:
-	case <-time.After(250 * time.Millisecond):
-		t.Fatalf("expected budgetCacheRepo.ClearForPrefixes to be called")
+func TestBudgetUsecase_DeleteBudgetByID_VersionConflict(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	id := uuid.New()
+
+	b := &entity.Budget{
+		ID:        id,
+		AccountID: uuid.New(),
+		UpdatedAt: time.Now().Truncate(time.Microsecond),
 	}
+
+	s.budgetRepo.FindOneByIDMock.Return(b, nil)
+
+	err := s.uc.DeleteBudgetByID(testCtx(), id, b.Version()-1, false)
+	require.ErrorIs(t, err, appErrors.ErrVersionConflict)
 }
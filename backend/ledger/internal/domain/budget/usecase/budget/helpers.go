@@ -2,13 +2,21 @@ package budget
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"strconv"
 	"strings"
+	"time"
 
+	"cloud.google.com/go/civil"
 	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/loghandler"
+	"github.com/samber/lo"
 )
 
 func (uc *UsecaseImpl) entitiesToDTO(
@@ -28,13 +36,14 @@ func (uc *UsecaseImpl) entitiesToDTO(
 	return out, nil
 }
 
-func buildKeyForFindOneByID(id uuid.UUID) string {
-	return fmt.Sprintf("Budget::FindOneByID::%s", id.String())
+func buildKeyForFindOneByID(id uuid.UUID, generation uint64) string {
+	return fmt.Sprintf("Budget::FindOneByID::%s::gen:%d", id.String(), generation)
 }
 
 func buildKeyForFindList(
 	listOptions *usecase.BudgetListOptions,
 	queryParams *uctypes.QueryGetListParams,
+	generation uint64,
 ) string {
 	var strBuilder strings.Builder
 
@@ -45,6 +54,9 @@ func buildKeyForFindList(
 		strBuilder.WriteString(listOptions.FilterAccountID.String())
 	}
 
+	strBuilder.WriteString("::gen:")
+	strBuilder.WriteString(strconv.FormatUint(generation, 10))
+
 	if listOptions.FilterPeriod != nil {
 		strBuilder.WriteString("::Period:")
 		strBuilder.WriteString(listOptions.FilterPeriod.String())
@@ -65,6 +77,13 @@ func buildKeyForFindList(
 		strBuilder.WriteString(fmt.Sprintf("%d", *listOptions.FilterCategoryID))
 	}
 
+	if listOptions.FilterBefore != nil {
+		strBuilder.WriteString("::Before:")
+		strBuilder.WriteString(listOptions.FilterBefore.Period.String())
+		strBuilder.WriteString(":")
+		strBuilder.WriteString(listOptions.FilterBefore.ID.String())
+	}
+
 	if listOptions.Sort != nil {
 		strBuilder.WriteString("::Sort:")
 		for _, sort := range listOptions.Sort {
@@ -85,6 +104,219 @@ func buildKeyForFindList(
 func buildKeyForFindPagedList(
 	listOptions *usecase.BudgetListOptions,
 	queryParams *uctypes.QueryGetListParams,
+	generation uint64,
 ) string {
-	return fmt.Sprintf("%s::Paged", buildKeyForFindList(listOptions, queryParams))
+	return fmt.Sprintf("%s::Paged", buildKeyForFindList(listOptions, queryParams, generation))
+}
+
+func (uc *UsecaseImpl) baseCurrency(ctx context.Context, accountID uuid.UUID) (string, error) {
+	settings, err := uc.accountSettingsRepo.FindOneByAccountID(ctx, accountID, nil)
+	if err != nil {
+		if errors.Is(err, appErrors.ErrNotFound) {
+			return uc.cfg.Budget.DefaultCurrency, nil
+		}
+		return "", err
+	}
+
+	return settings.BaseCurrency, nil
+}
+
+// copyMaxPeriods - максимальное количество месяцев, в которые можно скопировать бюджеты за раз
+const copyMaxPeriods = 24
+
+func addMonths(period civil.Date, months int) civil.Date {
+	return civil.DateOf(period.In(time.UTC).AddDate(0, months, 0))
+}
+
+// buildCopyPeriods - месяцы диапазона [from, to] без исходного месяца
+func buildCopyPeriods(source, from, to civil.Date) ([]civil.Date, error) {
+	source.Day, from.Day, to.Day = 1, 1, 1
+
+	if !source.IsValid() || !from.IsValid() || !to.IsValid() {
+		return nil, appErrors.ErrBadRequest.WithHints("invalid period")
+	}
+
+	if to.Before(from) {
+		return nil, appErrors.ErrBadRequest.WithHints("target period to is before target period from")
+	}
+
+	out := make([]civil.Date, 0)
+
+	for period := from; !period.After(to); period = addMonths(period, 1) {
+		if len(out) >= copyMaxPeriods {
+			return nil, appErrors.ErrBadRequest.WithHints(
+				fmt.Sprintf("budgets can be copied to at most %d months at once", copyMaxPeriods),
+			)
+		}
+
+		if period == source {
+			continue
+		}
+
+		out = append(out, period)
+	}
+
+	if len(out) == 0 {
+		return nil, appErrors.ErrBadRequest.WithHints("no target periods to copy to")
+	}
+
+	return out, nil
+}
+
+// copyBudgets - копирует бюджеты месяца source в месяцы targets, вызывается внутри транзакции
+func (uc *UsecaseImpl) copyBudgets(
+	ctx context.Context,
+	accountID uuid.UUID,
+	source civil.Date,
+	targets []civil.Date,
+	mode usecase.BudgetCopyMode,
+) (*usecase.CopyBudgetsResult, error) {
+	result := &usecase.CopyBudgetsResult{}
+
+	sourceBudgets, err := uc.budgetRepo.FindList(ctx, &usecase.BudgetListOptions{
+		FilterAccountID: &accountID,
+		FilterPeriod:    &source,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sourceBudgets) == 0 {
+		return result, nil
+	}
+
+	// бюджеты по удаленным и архивным категориям не копируются
+	unavailable := make(map[uint64]bool)
+	for _, budget := range sourceBudgets {
+		if _, ok := unavailable[budget.CategoryID]; ok {
+			continue
+		}
+
+		err := uc.categoryUC.CheckCategoryAccess(ctx, budget.CategoryID, accountID)
+		if err != nil && !errors.Is(err, appErrors.ErrBadRequest) {
+			return nil, err
+		}
+
+		unavailable[budget.CategoryID] = err != nil
+	}
+
+	existingBudgets, err := uc.budgetRepo.FindList(ctx, &usecase.BudgetListOptions{
+		FilterAccountID:  &accountID,
+		FilterPeriodFrom: lo.ToPtr(targets[0]),
+		FilterPeriodTo:   lo.ToPtr(targets[len(targets)-1]),
+	}, &uctypes.QueryGetListParams{
+		ForUpdate: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]*entity.Budget, len(existingBudgets))
+	for _, budget := range existingBudgets {
+		existing[fmt.Sprintf("%s/%d", budget.Period, budget.CategoryID)] = budget
+	}
+
+	for _, target := range targets {
+		for _, sourceBudget := range sourceBudgets {
+			if unavailable[sourceBudget.CategoryID] {
+				result.Skipped++
+				continue
+			}
+
+			budget, ok := existing[fmt.Sprintf("%s/%d", target, sourceBudget.CategoryID)]
+			if ok {
+				if mode != usecase.BudgetCopyModeOverwrite {
+					result.Skipped++
+					continue
+				}
+
+				err = budget.SetCurrency(sourceBudget.Currency)
+				if err != nil {
+					return nil, err
+				}
+
+				err = budget.SetAmount(sourceBudget.Amount)
+				if err != nil {
+					return nil, err
+				}
+
+				budget.CarryOver = sourceBudget.CarryOver
+				budget.Enforcement = sourceBudget.Enforcement
+				budget.WarningThresholds = sourceBudget.WarningThresholds
+
+				err = uc.budgetRepo.Update(ctx, budget)
+				if err != nil {
+					return nil, err
+				}
+
+				result.Overwritten++
+			} else {
+				budget, err = entity.NewBudget(
+					accountID,
+					sourceBudget.Amount,
+					sourceBudget.Currency,
+					target,
+					sourceBudget.CategoryID,
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				budget.CarryOver = sourceBudget.CarryOver
+				budget.Enforcement = sourceBudget.Enforcement
+				budget.WarningThresholds = sourceBudget.WarningThresholds
+
+				err = uc.budgetRepo.Create(ctx, budget)
+				if err != nil {
+					return nil, err
+				}
+
+				result.Created++
+			}
+
+			result.Items = append(result.Items, &usecase.BudgetDTO{Budget: budget})
+		}
+	}
+
+	return result, nil
+}
+
+// cacheGeneration - текущее поколение кеша, при ошибке redis кеш не используется
+func (uc *UsecaseImpl) cacheGeneration(ctx context.Context, scope string) (uint64, bool) {
+	generations, err := uc.cacheGenerationRepo.GetGenerations(ctx, scope)
+	if err != nil || len(generations) != 1 {
+		uc.logger.ErrorContext(loghandler.WithSource(ctx), "redis get cache generation err", slog.Any("error", err))
+		return 0, false
+	}
+
+	return generations[0], true
+}
+
+// listCacheGeneration - списки без фильтра по аккаунту не кешируются: их нельзя инвалидировать поколением аккаунта
+func (uc *UsecaseImpl) listCacheGeneration(ctx context.Context, listOptions *usecase.BudgetListOptions) (uint64, bool) {
+	if listOptions == nil || listOptions.FilterAccountID == nil {
+		return 0, false
+	}
+
+	return uc.cacheGeneration(ctx, usecase.CacheScopeBudgets(*listOptions.FilterAccountID))
+}
+
+// invalidateBudgetsCache - сдвигает поколения кеша бюджетов и отчетов аккаунта после фиксации транзакции БД
+func (uc *UsecaseImpl) invalidateBudgetsCache(ctx context.Context, accountID uuid.UUID, budgetIDs ...uuid.UUID) {
+	scopes := make([]string, 0, len(budgetIDs)+2)
+	scopes = append(scopes, usecase.CacheScopeBudgets(accountID), usecase.CacheScopeReports(accountID))
+	for _, budgetID := range budgetIDs {
+		scopes = append(scopes, usecase.CacheScopeBudget(budgetID))
+	}
+
+	err := uc.cacheGenerationRepo.BumpGenerations(context.WithoutCancel(ctx), scopes...)
+	if err != nil {
+		uc.logger.ErrorContext(loghandler.WithSource(ctx), "redis bump cache generation err", slog.Any("error", err))
+	}
+}
+
+func budgetDTOsIDs(items []*usecase.BudgetDTO) []uuid.UUID {
+	return lo.Map(items, func(item *usecase.BudgetDTO, _ int) uuid.UUID {
+		return item.Budget.ID
+	})
 }
@@ -10,14 +10,16 @@ import (
 )
 
 type UsecaseImpl struct {
-	pkg             string
-	logger          *slog.Logger
-	cfg             config.Config
-	dbMasterClient  db.MasterClient
-	sfGroup         singleflight.Group
-	budgetRepo      usecase.BudgetRepository
-	budgetCacheRepo usecase.BudgetCacheRepository
-	categoryRepo    usecase.CategoryRepository
+	pkg                 string
+	logger              *slog.Logger
+	cfg                 config.Config
+	dbMasterClient      db.MasterClient
+	sfGroup             singleflight.Group
+	budgetRepo          usecase.BudgetRepository
+	budgetCacheRepo     usecase.BudgetCacheRepository
+	categoryUC          usecase.CategoryUsecase
+	accountSettingsRepo usecase.AccountSettingsRepository
+	cacheGenerationRepo usecase.CacheGenerationRepository
 }
 
 func NewUsecaseImpl(
@@ -26,16 +28,20 @@ func NewUsecaseImpl(
 	dbMasterClient db.MasterClient,
 	budgetRepo usecase.BudgetRepository,
 	budgetCacheRepo usecase.BudgetCacheRepository,
-	categoryRepo usecase.CategoryRepository,
+	categoryUC usecase.CategoryUsecase,
+	accountSettingsRepo usecase.AccountSettingsRepository,
+	cacheGenerationRepo usecase.CacheGenerationRepository,
 ) *UsecaseImpl {
 	uc := &UsecaseImpl{
-		pkg:             "Budget.Usecase.Budget",
-		logger:          logger,
-		cfg:             cfg,
-		dbMasterClient:  dbMasterClient,
-		budgetRepo:      budgetRepo,
-		budgetCacheRepo: budgetCacheRepo,
-		categoryRepo:    categoryRepo,
+		pkg:                 "Budget.Usecase.Budget",
+		logger:              logger,
+		cfg:                 cfg,
+		dbMasterClient:      dbMasterClient,
+		budgetRepo:          budgetRepo,
+		budgetCacheRepo:     budgetCacheRepo,
+		categoryUC:          categoryUC,
+		accountSettingsRepo: accountSettingsRepo,
+		cacheGenerationRepo: cacheGenerationRepo,
 	}
 	return uc
 }
@@ -30,10 +30,12 @@ func (uc *UsecaseImpl) FindOneByID(
 ) (*usecase.BudgetDTO, bool, error) {
 	const op = "FindOneByID"
 
-	key := buildKeyForFindOneByID(id)
+	generation, genOK := uc.cacheGeneration(ctx, usecase.CacheScopeBudget(id))
+
+	key := buildKeyForFindOneByID(id, generation)
 
 	result, err, _ := uc.sfGroup.Do(key, func() (any, error) {
-		if queryParams == nil || !queryParams.SkipCache {
+		if genOK && (queryParams == nil || !queryParams.SkipCache) {
 			cacheItem, err := uc.budgetCacheRepo.GetBudget(ctx, key)
 			if err == nil {
 				uc.logger.InfoContext(ctx, "Budget::FindOneByID cache hit", slog.Any("key", key))
@@ -54,9 +56,11 @@ func (uc *UsecaseImpl) FindOneByID(
 			return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
 		}
 
-		err = uc.budgetCacheRepo.SaveBudget(ctx, key, item, &budgetCacheTTL)
-		if err != nil {
-			uc.logger.ErrorContext(loghandler.WithSource(ctx), "redis save err", slog.Any("error", err))
+		if genOK {
+			err = uc.budgetCacheRepo.SaveBudget(ctx, key, item, &budgetCacheTTL)
+			if err != nil {
+				uc.logger.ErrorContext(loghandler.WithSource(ctx), "redis save err", slog.Any("error", err))
+			}
 		}
 
 		return FindOneByIDSFResult{
@@ -104,10 +108,12 @@ func (uc *UsecaseImpl) FindList(
 ) ([]*usecase.BudgetDTO, bool, error) {
 	const op = "FindList"
 
-	key := buildKeyForFindList(listOptions, queryParams)
+	generation, genOK := uc.listCacheGeneration(ctx, listOptions)
+
+	key := buildKeyForFindList(listOptions, queryParams, generation)
 
 	result, err, _ := uc.sfGroup.Do(key, func() (any, error) {
-		if queryParams == nil || !queryParams.SkipCache {
+		if genOK && (queryParams == nil || !queryParams.SkipCache) {
 			cacheItems, err := uc.budgetCacheRepo.GetBudgetsList(ctx, key)
 			if err == nil {
 				uc.logger.InfoContext(ctx, "Budget::GetBudgetsList cache hit", slog.Any("key", key))
@@ -128,9 +134,11 @@ func (uc *UsecaseImpl) FindList(
 			return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
 		}
 
-		err = uc.budgetCacheRepo.SaveBudgetsList(ctx, key, items, &budgetCacheTTL)
-		if err != nil {
-			uc.logger.ErrorContext(loghandler.WithSource(ctx), "redis save err", slog.Any("error", err))
+		if genOK {
+			err = uc.budgetCacheRepo.SaveBudgetsList(ctx, key, items, &budgetCacheTTL)
+			if err != nil {
+				uc.logger.ErrorContext(loghandler.WithSource(ctx), "redis save err", slog.Any("error", err))
+			}
 		}
 
 		return FindListSFResult{
@@ -168,10 +176,12 @@ func (uc *UsecaseImpl) FindPagedList(
 ) ([]*usecase.BudgetDTO, uint64, bool, error) {
 	const op = "FindPagedList"
 
-	key := buildKeyForFindPagedList(listOptions, queryParams)
+	generation, genOK := uc.listCacheGeneration(ctx, listOptions)
+
+	key := buildKeyForFindPagedList(listOptions, queryParams, generation)
 
 	result, err, _ := uc.sfGroup.Do(key, func() (any, error) {
-		if queryParams == nil || !queryParams.SkipCache {
+		if genOK && (queryParams == nil || !queryParams.SkipCache) {
 			cacheItems, total, err := uc.budgetCacheRepo.GetBudgetsPagedList(ctx, key)
 			if err == nil {
 				uc.logger.InfoContext(ctx, "Budget::GetBudgetsPagedList cache hit", slog.Any("key", key))
@@ -193,9 +203,11 @@ func (uc *UsecaseImpl) FindPagedList(
 			return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
 		}
 
-		err = uc.budgetCacheRepo.SaveBudgetsPagedList(ctx, key, items, total, &budgetCacheTTL)
-		if err != nil {
-			uc.logger.ErrorContext(loghandler.WithSource(ctx), "redis save err", slog.Any("error", err))
+		if genOK {
+			err = uc.budgetCacheRepo.SaveBudgetsPagedList(ctx, key, items, total, &budgetCacheTTL)
+			if err != nil {
+				uc.logger.ErrorContext(loghandler.WithSource(ctx), "redis save err", slog.Any("error", err))
+			}
 		}
 
 		return FindPagedListSFResult{
@@ -220,3 +232,94 @@ func (uc *UsecaseImpl) FindPagedList(
 
 	return out, sfResult.Total, sfResult.HitCache, nil
 }
+
+// budgetKeysetSort - порядок должен совпадать с курсором: (period, id) по убыванию
+var budgetKeysetSort = []uctypes.SortOption[usecase.BudgetListOptionsSortField]{
+	{
+		Field:  usecase.BudgetListOptionsSortFieldPeriod,
+		IsDesc: true,
+	},
+	{
+		Field:  usecase.BudgetListOptionsSortFieldID,
+		IsDesc: true,
+	},
+}
+
+func (uc *UsecaseImpl) FindCursorList(
+	ctx context.Context,
+	listOptions *usecase.BudgetListOptions,
+	limit uint64,
+	withTotal bool,
+) (*usecase.BudgetCursorPage, error) {
+	const op = "FindCursorList"
+
+	if limit < 1 {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("limit must be > 0"), "%s.%s", uc.pkg, op)
+	}
+
+	pageOptions := usecase.BudgetListOptions{}
+	if listOptions != nil {
+		pageOptions = *listOptions
+	}
+
+	pageOptions.Sort = budgetKeysetSort
+
+	// лишняя запись показывает, что за страницей есть продолжение
+	items, hitCache, err := uc.FindList(ctx, &pageOptions, &uctypes.QueryGetListParams{
+		Limit: limit + 1,
+	})
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	page := &usecase.BudgetCursorPage{
+		HitCache: hitCache,
+	}
+
+	if uint64(len(items)) > limit {
+		items = items[:limit]
+
+		last := items[len(items)-1].Budget
+		page.NextCursor = &usecase.BudgetListCursor{
+			Period: last.Period,
+			ID:     last.ID,
+		}
+	}
+
+	page.Items = items
+
+	if withTotal {
+		totalOptions := pageOptions
+		totalOptions.FilterBefore = nil
+
+		total, err := uc.budgetRepo.Count(ctx, &totalOptions)
+		if err != nil {
+			return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+		}
+
+		page.Total = &total
+	}
+
+	return page, nil
+}
+
+func (uc *UsecaseImpl) GetBudgetAutoRollover(ctx context.Context, accountID uuid.UUID) (bool, error) {
+	const op = "GetBudgetAutoRollover"
+
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != accountID {
+			return false, appErrors.ErrForbidden
+		}
+	}
+
+	settings, err := uc.accountSettingsRepo.FindOneByAccountID(ctx, accountID, nil)
+	if err != nil {
+		if errors.Is(err, appErrors.ErrNotFound) {
+			return false, nil
+		}
+		return false, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return settings.BudgetAutoRollover, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 )
 
@@ -90,7 +91,12 @@ func TestBudgetUsecase_FindOneByID_Table(t *testing.T) {
 			s := newDependencies(t)
 			defer finishDependencies(s)
 
-			key := buildKeyForFindOneByID(id)
+			s.cacheGenerationRepo.GetGenerationsMock.Set(func(ctx context.Context, scopes ...string) ([]uint64, error) {
+				require.Equal(t, []string{usecase.CacheScopeBudget(id)}, scopes)
+				return []uint64{2}, nil
+			})
+
+			key := buildKeyForFindOneByID(id, 2)
 
 			if tt.queryParams == nil || !tt.queryParams.SkipCache {
 				if tt.cacheHas {
@@ -183,7 +189,12 @@ func TestBudgetUsecase_FindList_Table(t *testing.T) {
 			s := newDependencies(t)
 			defer finishDependencies(s)
 
-			key := buildKeyForFindList(listOptions, tt.qp)
+			s.cacheGenerationRepo.GetGenerationsMock.Set(func(ctx context.Context, scopes ...string) ([]uint64, error) {
+				require.Equal(t, []string{usecase.CacheScopeBudgets(accID)}, scopes)
+				return []uint64{5}, nil
+			})
+
+			key := buildKeyForFindList(listOptions, tt.qp, 5)
 
 			// cache
 			if !tt.qp.SkipCache {
@@ -257,7 +268,12 @@ func TestBudgetUsecase_FindPagedList_Table(t *testing.T) {
 			s := newDependencies(t)
 			defer finishDependencies(s)
 
-			key := buildKeyForFindPagedList(listOptions, tt.qp)
+			s.cacheGenerationRepo.GetGenerationsMock.Set(func(ctx context.Context, scopes ...string) ([]uint64, error) {
+				require.Equal(t, []string{usecase.CacheScopeBudgets(accID)}, scopes)
+				return []uint64{5}, nil
+			})
+
+			key := buildKeyForFindPagedList(listOptions, tt.qp, 5)
 
 			if !tt.qp.SkipCache {
 				if tt.cacheHas {
@@ -298,3 +314,198 @@ func TestBudgetUsecase_FindPagedList_Table(t *testing.T) {
 		})
 	}
 }
+
+func TestBudgetUsecase_FindPagedList_NoAccountFilter_SkipsCache(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	s.budgetRepo.FindPagedListMock.Return([]*entity.Budget{}, 0, nil)
+
+	_, _, hit, err := s.uc.FindPagedList(testCtx(), &usecase.BudgetListOptions{}, &uctypes.QueryGetListParams{Limit: 10})
+	require.NoError(t, err)
+	require.False(t, hit)
+	require.Equal(t, uint64(0), s.budgetCacheRepo.GetBudgetsPagedListAfterCounter())
+	require.Equal(t, uint64(0), s.budgetCacheRepo.SaveBudgetsPagedListAfterCounter())
+}
+
+func TestBudgetUsecase_FindCursorList_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+	period := civil.Date{Year: 2025, Month: 12, Day: 1}
+
+	items := []*entity.Budget{
+		{ID: uuid.New(), AccountID: accID, CategoryID: 1, Period: period, Amount: decimal.MustParse("10.00")},
+		{ID: uuid.New(), AccountID: accID, CategoryID: 2, Period: period, Amount: decimal.MustParse("20.00")},
+		{ID: uuid.New(), AccountID: accID, CategoryID: 3, Period: period.AddMonths(-1), Amount: decimal.MustParse("30.00")},
+	}
+
+	cursor := &usecase.BudgetListCursor{Period: period.AddMonths(1), ID: uuid.New()}
+
+	tests := []struct {
+		name      string
+		limit     uint64
+		withTotal bool
+
+		wantItems      int
+		wantNextCursor *usecase.BudgetListCursor
+		wantTotal      *uint64
+	}{
+		{
+			name:           "OK_has_next_page",
+			limit:          2,
+			wantItems:      2,
+			wantNextCursor: &usecase.BudgetListCursor{Period: items[1].Period, ID: items[1].ID},
+		},
+		{
+			name:      "OK_last_page_with_total",
+			limit:     3,
+			withTotal: true,
+			wantItems: 3,
+			wantTotal: lo.ToPtr(uint64(7)),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.cacheGenerationRepo.GetGenerationsMock.Return([]uint64{1}, nil)
+			s.budgetCacheRepo.GetBudgetsListMock.Return(nil, appErrors.ErrNotFound)
+			s.budgetCacheRepo.SaveBudgetsListMock.Return(nil)
+
+			s.budgetRepo.FindListMock.Set(func(ctx context.Context, gotOpt *usecase.BudgetListOptions, gotQP *uctypes.QueryGetListParams) ([]*entity.Budget, error) {
+				require.Equal(t, cursor, gotOpt.FilterBefore)
+				require.Equal(t, budgetKeysetSort, gotOpt.Sort)
+				require.Equal(t, tt.limit+1, gotQP.Limit)
+				return items[:min(len(items), int(gotQP.Limit))], nil
+			})
+
+			if tt.withTotal {
+				s.budgetRepo.CountMock.Set(func(ctx context.Context, gotOpt *usecase.BudgetListOptions) (uint64, error) {
+					require.Nil(t, gotOpt.FilterBefore)
+					return 7, nil
+				})
+			}
+
+			page, err := s.uc.FindCursorList(testCtx(), &usecase.BudgetListOptions{
+				FilterAccountID: &accID,
+				FilterBefore:    cursor,
+			}, tt.limit, tt.withTotal)
+			require.NoError(t, err)
+			require.Len(t, page.Items, tt.wantItems)
+			require.Equal(t, tt.wantNextCursor, page.NextCursor)
+			require.Equal(t, tt.wantTotal, page.Total)
+		})
+	}
+}
+
+func TestBudgetListCursor_Token(t *testing.T) {
+	t.Parallel()
+
+	cursor := &usecase.BudgetListCursor{Period: civil.Date{Year: 2025, Month: 12, Day: 1}, ID: uuid.New()}
+
+	got, err := usecase.ParseBudgetListCursor(cursor.Token())
+	require.NoError(t, err)
+	require.Equal(t, cursor, got)
+
+	_, err = usecase.ParseBudgetListCursor("not a cursor")
+	require.ErrorIs(t, err, usecase.ErrCursorInvalid)
+}
+
+func TestBudgetUsecase_NeverStaleAfterWrite(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	attachFakeCache(s)
+
+	accountID := uuid.New()
+	now := time.Now().Truncate(time.Microsecond)
+
+	// stored - состояние БД
+	budget := &entity.Budget{
+		ID:         uuid.New(),
+		AccountID:  accountID,
+		Period:     civil.Date{Year: 2025, Month: 12, Day: 1},
+		CategoryID: 1,
+		Amount:     decimal.MustParse("100"),
+		Currency:   "RUB",
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	stored := map[uuid.UUID]*entity.Budget{budget.ID: budget}
+
+	s.budgetRepo.FindOneByIDMock.Set(func(ctx context.Context, id uuid.UUID, _ *uctypes.QueryGetOneParams) (*entity.Budget, error) {
+		item := *stored[id]
+		return &item, nil
+	})
+	s.budgetRepo.UpdateMock.Set(func(ctx context.Context, item *entity.Budget) error {
+		stored[item.ID] = item
+		return nil
+	})
+	s.budgetRepo.FindPagedListMock.Set(func(
+		ctx context.Context,
+		_ *usecase.BudgetListOptions,
+		_ *uctypes.QueryGetListParams,
+	) ([]*entity.Budget, uint64, error) {
+		out := make([]*entity.Budget, 0)
+		for _, item := range stored {
+			if item.DeletedAt == nil {
+				copied := *item
+				out = append(out, &copied)
+			}
+		}
+		return out, uint64(len(out)), nil
+	})
+
+	listOptions := &usecase.BudgetListOptions{FilterAccountID: &accountID}
+	queryParams := &uctypes.QueryGetListParams{Limit: 10}
+
+	// прогрев кеша
+	got, hit, err := s.uc.FindOneByID(testCtx(), budget.ID, nil)
+	require.NoError(t, err)
+	require.False(t, hit)
+	require.Equal(t, "100", got.Budget.Amount.String())
+
+	_, hit, err = s.uc.FindOneByID(testCtx(), budget.ID, nil)
+	require.NoError(t, err)
+	require.True(t, hit)
+
+	items, _, hit, err := s.uc.FindPagedList(testCtx(), listOptions, queryParams)
+	require.NoError(t, err)
+	require.False(t, hit)
+	require.Len(t, items, 1)
+
+	// patch -> чтение по ID возвращает новую сумму
+	err = s.uc.PatchBudgetByDTO(testCtx(), budget.ID, usecase.PatchBudgetDataInput{
+		Amount: lo.ToPtr(decimal.MustParse("250")),
+	}, true)
+	require.NoError(t, err)
+
+	got, hit, err = s.uc.FindOneByID(testCtx(), budget.ID, nil)
+	require.NoError(t, err)
+	require.False(t, hit)
+	require.Zero(t, got.Budget.Amount.Cmp(decimal.MustParse("250")))
+
+	items, _, hit, err = s.uc.FindPagedList(testCtx(), listOptions, queryParams)
+	require.NoError(t, err)
+	require.False(t, hit)
+	require.Zero(t, items[0].Budget.Amount.Cmp(decimal.MustParse("250")))
+
+	// delete -> список пуст
+	err = s.uc.DeleteBudgetByID(testCtx(), budget.ID, 0, true)
+	require.NoError(t, err)
+
+	items, _, hit, err = s.uc.FindPagedList(testCtx(), listOptions, queryParams)
+	require.NoError(t, err)
+	require.False(t, hit)
+	require.Empty(t, items)
+}
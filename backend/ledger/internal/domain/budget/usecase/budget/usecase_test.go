@@ -4,10 +4,14 @@ import (
 	"context"
 	"io"
 	"log/slog"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/app/config"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	usecasemocks "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase/mocks"
 	"github.com/m11ano/budget_planner/backend/ledger/pkg/pgclient"
 )
@@ -20,10 +24,12 @@ type dependencies struct {
 	logger *slog.Logger
 	cfg    config.Config
 
-	dbMasterClient  any
-	budgetRepo      *usecasemocks.BudgetRepositoryMock
-	budgetCacheRepo *usecasemocks.BudgetCacheRepositoryMock
-	categoryRepo    *usecasemocks.CategoryRepositoryMock
+	dbMasterClient      any
+	budgetRepo          *usecasemocks.BudgetRepositoryMock
+	budgetCacheRepo     *usecasemocks.BudgetCacheRepositoryMock
+	categoryUC          *usecasemocks.CategoryUsecaseMock
+	accountSettingsRepo *usecasemocks.AccountSettingsRepositoryMock
+	cacheGenerationRepo *usecasemocks.CacheGenerationRepositoryMock
 }
 
 func newDependencies(t *testing.T) *dependencies {
@@ -33,30 +39,39 @@ func newDependencies(t *testing.T) *dependencies {
 
 	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
 
+	cfg := config.Config{}
+	cfg.Budget.DefaultCurrency = "RUB"
+
 	budgetRepo := usecasemocks.NewBudgetRepositoryMock(mc)
 	budgetCacheRepo := usecasemocks.NewBudgetCacheRepositoryMock(mc)
-	categoryRepo := usecasemocks.NewCategoryRepositoryMock(mc)
+	categoryUC := usecasemocks.NewCategoryUsecaseMock(mc)
+	accountSettingsRepo := usecasemocks.NewAccountSettingsRepositoryMock(mc)
+	cacheGenerationRepo := usecasemocks.NewCacheGenerationRepositoryMock(mc)
 
 	dbMasterClient := pgclient.NewMock()
 
 	uc := NewUsecaseImpl(
 		logger,
-		config.Config{},
+		cfg,
 		dbMasterClient,
 		budgetRepo,
 		budgetCacheRepo,
-		categoryRepo,
+		categoryUC,
+		accountSettingsRepo,
+		cacheGenerationRepo,
 	)
 
 	return &dependencies{
-		mc:              mc,
-		uc:              uc,
-		logger:          logger,
-		cfg:             config.Config{},
-		dbMasterClient:  dbMasterClient,
-		budgetRepo:      budgetRepo,
-		budgetCacheRepo: budgetCacheRepo,
-		categoryRepo:    categoryRepo,
+		mc:                  mc,
+		uc:                  uc,
+		logger:              logger,
+		cfg:                 cfg,
+		dbMasterClient:      dbMasterClient,
+		budgetRepo:          budgetRepo,
+		budgetCacheRepo:     budgetCacheRepo,
+		categoryUC:          categoryUC,
+		accountSettingsRepo: accountSettingsRepo,
+		cacheGenerationRepo: cacheGenerationRepo,
 	}
 }
 
@@ -67,3 +82,87 @@ func finishDependencies(s *dependencies) {
 func testCtx() context.Context {
 	return context.Background()
 }
+
+// fakeCache - redis в памяти для проверки того, что после записи бюджеты не читаются из устаревшего кеша
+type fakeCache struct {
+	mu          sync.Mutex
+	generations map[string]uint64
+	budgets     map[string]*entity.Budget
+	pagedLists  map[string][]*entity.Budget
+}
+
+func attachFakeCache(s *dependencies) *fakeCache {
+	f := &fakeCache{
+		generations: map[string]uint64{},
+		budgets:     map[string]*entity.Budget{},
+		pagedLists:  map[string][]*entity.Budget{},
+	}
+
+	s.cacheGenerationRepo.GetGenerationsMock.Set(func(ctx context.Context, scopes ...string) ([]uint64, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		out := make([]uint64, 0, len(scopes))
+		for _, scope := range scopes {
+			out = append(out, f.generations[scope])
+		}
+		return out, nil
+	})
+
+	s.cacheGenerationRepo.BumpGenerationsMock.Set(func(ctx context.Context, scopes ...string) error {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		for _, scope := range scopes {
+			f.generations[scope]++
+		}
+		return nil
+	})
+
+	s.budgetCacheRepo.GetBudgetMock.Optional().Set(func(ctx context.Context, key string) (*entity.Budget, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		item, ok := f.budgets[key]
+		if !ok {
+			return nil, appErrors.ErrNotFound
+		}
+		return item, nil
+	})
+
+	s.budgetCacheRepo.SaveBudgetMock.Optional().Set(func(ctx context.Context, key string, item *entity.Budget, _ *time.Duration) error {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		copied := *item
+		f.budgets[key] = &copied
+		return nil
+	})
+
+	s.budgetCacheRepo.GetBudgetsPagedListMock.Optional().Set(func(ctx context.Context, key string) ([]*entity.Budget, uint64, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		items, ok := f.pagedLists[key]
+		if !ok {
+			return nil, 0, appErrors.ErrNotFound
+		}
+		return items, uint64(len(items)), nil
+	})
+
+	s.budgetCacheRepo.SaveBudgetsPagedListMock.Optional().Set(func(
+		ctx context.Context,
+		key string,
+		items []*entity.Budget,
+		_ uint64,
+		_ *time.Duration,
+	) error {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		f.pagedLists[key] = items
+		return nil
+	})
+
+	return f
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// CacheScopeReports - поколение кеша отчетов аккаунта, включая пересчет по курсам валют аккаунта
+func CacheScopeReports(accountID uuid.UUID) string {
+	return "Reports:" + accountID.String()
+}
+
+// CacheScopeBudgets - поколение кеша списков бюджетов аккаунта
+func CacheScopeBudgets(accountID uuid.UUID) string {
+	return "Budgets:" + accountID.String()
+}
+
+// CacheScopeBudget - поколение кеша отдельного бюджета
+func CacheScopeBudget(id uuid.UUID) string {
+	return "Budget:" + id.String()
+}
+
+// CacheGenerationRepository - поколения кеша. Номер поколения входит в ключ кеша,
+// поэтому после увеличения поколения прежние записи больше не читаются и истекают по TTL
+//
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.CacheGenerationRepository -o mocks/cache_generation_repository.go
+type CacheGenerationRepository interface {
+	// GetGenerations - текущие поколения в порядке scopes, отсутствующее поколение равно 0
+	GetGenerations(ctx context.Context, scopes ...string) (generations []uint64, err error)
+
+	BumpGenerations(ctx context.Context, scopes ...string) (err error)
+}
@@ -3,13 +3,17 @@ package usecase
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 )
 
 type CategoryListOptions struct {
-	FilterIDs *[]uint64
-	Sort      []uctypes.SortOption[CategoryListOptionsSortField]
+	FilterIDs                   *[]uint64
+	FilterAccountID             *uuid.UUID
+	FilterAvailableForAccountID *uuid.UUID
+	FilterIsArchived            *bool
+	Sort                        []uctypes.SortOption[CategoryListOptionsSortField]
 }
 
 type CategoryListOptionsSortField string
@@ -22,6 +26,21 @@ type CategoryDTO struct {
 	Category *entity.Category
 }
 
+type CreateCategoryDataInput struct {
+	AccountID uuid.UUID
+	ParentID  *uint64
+	Title     string
+}
+
+type PatchCategoryDataInput struct {
+	Version int64
+
+	Title      *string
+	IsArchived *bool
+	// ParentID - новый родитель, 0 - перенести в корень
+	ParentID *uint64
+}
+
 //go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.CategoryUsecase -o mocks/category_usecase.go
 type CategoryUsecase interface {
 	FindOneByID(
@@ -35,6 +54,31 @@ type CategoryUsecase interface {
 		listOptions *CategoryListOptions,
 		queryParams *uctypes.QueryGetListParams,
 	) (resItems []*CategoryDTO, resErr error)
+
+	CreateCategoryByDTO(
+		ctx context.Context,
+		in CreateCategoryDataInput,
+	) (resCategoryDTO *CategoryDTO, resErr error)
+
+	PatchCategoryByDTO(
+		ctx context.Context,
+		id uint64,
+		in PatchCategoryDataInput,
+		skipVersionCheck bool,
+	) (resErr error)
+
+	DeleteCategoryByID(
+		ctx context.Context,
+		id uint64,
+		reassignToID *uint64,
+	) (resErr error)
+
+	// CheckCategoryAccess - категория должна быть доступна аккаунту и не находиться в архиве, иначе ErrBadRequest
+	CheckCategoryAccess(
+		ctx context.Context,
+		id uint64,
+		accountID uuid.UUID,
+	) (resErr error)
 }
 
 //go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.CategoryRepository -o mocks/category_repository.go
@@ -50,4 +94,8 @@ type CategoryRepository interface {
 		listOptions *CategoryListOptions,
 		queryParams *uctypes.QueryGetListParams,
 	) (items []*entity.Category, err error)
+
+	Create(ctx context.Context, item *entity.Category) (err error)
+
+	Update(ctx context.Context, item *entity.Category) (err error)
 }
@@ -0,0 +1,278 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/loghandler"
+	"github.com/m11ano/budget_planner/backend/ledger/pkg/pgclient"
+	"github.com/samber/lo"
+)
+
+func (uc *UsecaseImpl) CreateCategoryByDTO(
+	ctx context.Context,
+	in usecase.CreateCategoryDataInput,
+) (*usecase.CategoryDTO, error) {
+	const op = "CreateCategoryByDTO"
+
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != in.AccountID {
+			return nil, appErrors.ErrForbidden
+		}
+	}
+
+	category, err := entity.NewCategory(&in.AccountID, in.ParentID, in.Title)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	err = uc.dbMasterClient.DoWithIsoLvl(ctx, pgclient.Serializable, func(ctx context.Context) error {
+		if category.ParentID != nil {
+			tree, err := uc.loadCategoryTree(ctx, in.AccountID)
+			if err != nil {
+				return err
+			}
+
+			err = uc.checkParent(tree, category, *category.ParentID)
+			if err != nil {
+				return err
+			}
+		}
+
+		return uc.categoryRepo.Create(ctx, category)
+	})
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	categoryDTO, err := uc.entitiesToDTO(ctx, []*entity.Category{category})
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	if len(categoryDTO) == 0 {
+		uc.logger.ErrorContext(loghandler.WithSource(ctx), "unpredicted empty category dto")
+		return nil, appErrors.Chainf(appErrors.ErrInternal, "%s.%s", uc.pkg, op)
+	}
+
+	return categoryDTO[0], nil
+}
+
+func (uc *UsecaseImpl) PatchCategoryByDTO(
+	ctx context.Context,
+	id uint64,
+	in usecase.PatchCategoryDataInput,
+	skipVersionCheck bool,
+) error {
+	const op = "PatchCategoryByDTO"
+
+	var accountID *uuid.UUID
+	var parentChanged bool
+
+	err := uc.dbMasterClient.DoWithIsoLvl(ctx, pgclient.Serializable, func(ctx context.Context) error {
+		category, err := uc.categoryRepo.FindOneByID(ctx, id, &uctypes.QueryGetOneParams{
+			ForUpdate: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		accountID = category.AccountID
+		parentChanged = false
+
+		err = uc.checkCategoryOwner(ctx, category)
+		if err != nil {
+			return err
+		}
+
+		if !skipVersionCheck && category.Version() != in.Version {
+			return appErrors.ErrVersionConflict.
+				WithDetail("last_version", false, category.Version()).
+				WithDetail("last_updated_at", false, category.UpdatedAt)
+		}
+
+		if in.Title != nil {
+			err = category.SetTitle(*in.Title)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.IsArchived != nil {
+			category.IsArchived = *in.IsArchived
+		}
+
+		if in.ParentID != nil {
+			parentChanged = lo.FromPtr(category.ParentID) != *in.ParentID
+			if *in.ParentID == 0 {
+				category.ParentID = nil
+			} else if category.ParentID == nil || *category.ParentID != *in.ParentID {
+				tree, err := uc.loadCategoryTree(ctx, *category.AccountID)
+				if err != nil {
+					return err
+				}
+
+				err = uc.checkParent(tree, category, *in.ParentID)
+				if err != nil {
+					return err
+				}
+
+				category.ParentID = lo.ToPtr(*in.ParentID)
+			}
+		}
+
+		err = uc.categoryRepo.Update(ctx, category)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	// перенос категории меняет свертку отчетов по родителям
+	if parentChanged && accountID != nil {
+		uc.invalidateAccountCache(ctx, *accountID)
+	}
+
+	return nil
+}
+
+func (uc *UsecaseImpl) DeleteCategoryByID(
+	ctx context.Context,
+	id uint64,
+	reassignToID *uint64,
+) error {
+	const op = "DeleteCategoryByID"
+
+	var accountID uuid.UUID
+	var reassignedBudgetIDs []uuid.UUID
+
+	err := uc.dbMasterClient.DoWithIsoLvl(ctx, pgclient.Serializable, func(ctx context.Context) error {
+		reassignedBudgetIDs = reassignedBudgetIDs[:0]
+
+		category, err := uc.categoryRepo.FindOneByID(ctx, id, &uctypes.QueryGetOneParams{
+			ForUpdate: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = uc.checkCategoryOwner(ctx, category)
+		if err != nil {
+			return err
+		}
+
+		accountID = *category.AccountID
+
+		tree, err := uc.loadCategoryTree(ctx, accountID)
+		if err != nil {
+			return err
+		}
+
+		if len(tree.Children(category.ID)) > 0 {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithHints("category has subcategories"),
+				"%s.%s", uc.pkg, op)
+		}
+
+		budgets, err := uc.budgetRepo.FindList(ctx, &usecase.BudgetListOptions{
+			FilterCategoryID: &category.ID,
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, budget := range budgets {
+			reassignedBudgetIDs = append(reassignedBudgetIDs, budget.ID)
+		}
+
+		if reassignToID == nil {
+			hasTransactions, err := uc.hasCategoryTransactions(ctx, category.ID)
+			if err != nil {
+				return err
+			}
+
+			hasRecurringRules, err := uc.hasCategoryRecurringRules(ctx, category.ID)
+			if err != nil {
+				return err
+			}
+
+			if hasTransactions || hasRecurringRules || len(budgets) > 0 {
+				return appErrors.Chainf(
+					appErrors.ErrBadRequest.WithHints("category is in use, reassignment target is required"),
+					"%s.%s", uc.pkg, op)
+			}
+		} else {
+			if *reassignToID == category.ID {
+				return appErrors.Chainf(
+					appErrors.ErrBadRequest.WithHints("reassignment target must differ from deleted category"),
+					"%s.%s", uc.pkg, op)
+			}
+
+			target, err := uc.categoryRepo.FindOneByID(ctx, *reassignToID, &uctypes.QueryGetOneParams{
+				ForShare: true,
+			})
+			if err != nil {
+				if errors.Is(err, appErrors.ErrNotFound) {
+					return appErrors.Chainf(appErrors.ErrBadRequest.WithHints("reassignment target not found"), "%s.%s", uc.pkg, op)
+				}
+				return err
+			}
+
+			if !target.IsAvailableForAccount(accountID) {
+				return appErrors.Chainf(appErrors.ErrBadRequest.WithHints("reassignment target not found"), "%s.%s", uc.pkg, op)
+			}
+
+			if target.IsArchived {
+				return appErrors.Chainf(appErrors.ErrBadRequest.WithHints("reassignment target is archived"), "%s.%s", uc.pkg, op)
+			}
+
+			err = uc.transactionRepo.ReassignCategory(ctx, category.ID, target.ID)
+			if err != nil {
+				return err
+			}
+
+			err = uc.recurringRuleRepo.ReassignCategory(ctx, category.ID, target.ID)
+			if err != nil {
+				return err
+			}
+
+			err = uc.budgetRepo.ReassignCategory(ctx, category.ID, target.ID)
+			if err != nil {
+				if errors.Is(err, appErrors.ErrStoreUniqueViolation) {
+					return appErrors.Chainf(
+						appErrors.ErrBadRequest.WithHints("reassignment target already has budget for the same period"),
+						"%s.%s", uc.pkg, op)
+				}
+				return err
+			}
+		}
+
+		category.DeletedAt = lo.ToPtr(time.Now())
+
+		err = uc.categoryRepo.Update(ctx, category)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	uc.invalidateAccountCache(ctx, accountID, reassignedBudgetIDs...)
+
+	return nil
+}
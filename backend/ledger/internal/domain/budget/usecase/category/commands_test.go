@@ -0,0 +1,516 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+func TestUsecase_Category_CreateCategoryByDTO_Table(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+
+	type args struct {
+		ctx context.Context
+		in  usecase.CreateCategoryDataInput
+	}
+
+	tests := []struct {
+		name string
+		args args
+
+		setup func(t *testing.T, d *dependencies, a args)
+
+		wantErr error
+	}{
+		{
+			name: "Positive_ok",
+			args: args{
+				ctx: context.Background(),
+				in: usecase.CreateCategoryDataInput{
+					AccountID: accountID,
+					Title:     "  Кафе ",
+				},
+			},
+			setup: func(t *testing.T, d *dependencies, a args) {
+				t.Helper()
+
+				d.categoryRepo.CreateMock.Set(func(ctx context.Context, item *entity.Category) error {
+					require.NotNil(t, item.AccountID)
+					require.Equal(t, a.in.AccountID, *item.AccountID)
+					require.Equal(t, "Кафе", item.Title)
+					require.False(t, item.IsArchived)
+					item.ID = 100
+					return nil
+				})
+			},
+		},
+		{
+			name: "Negative_forbidden",
+			args: args{
+				ctx: auth.WithCheckRight(auth.SetAuthData(context.Background(), &auth.AuthData{AccountID: uuid.New()})),
+				in: usecase.CreateCategoryDataInput{
+					AccountID: accountID,
+					Title:     "Кафе",
+				},
+			},
+			wantErr: appErrors.ErrForbidden,
+		},
+		{
+			name: "Negative_empty_title",
+			args: args{
+				ctx: context.Background(),
+				in: usecase.CreateCategoryDataInput{
+					AccountID: accountID,
+					Title:     "   ",
+				},
+			},
+			wantErr: entity.ErrTitleInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newDependencies(t)
+			t.Cleanup(func() { finishDependencies(t, d) })
+
+			if tt.setup != nil {
+				tt.setup(t, d, tt.args)
+			}
+
+			out, err := d.uc.CreateCategoryByDTO(tt.args.ctx, tt.args.in)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, out)
+			require.Equal(t, uint64(100), out.Category.ID)
+		})
+	}
+}
+
+func TestUsecase_Category_PatchCategoryByDTO_Table(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+	now := time.Now().Truncate(time.Microsecond)
+
+	newTitle := "Кафе и рестораны"
+	archived := true
+
+	tests := []struct {
+		name string
+
+		category         *entity.Category
+		in               usecase.PatchCategoryDataInput
+		skipVersionCheck bool
+
+		wantUpdate bool
+		wantErr    error
+	}{
+		{
+			name: "Positive_ok",
+			category: &entity.Category{
+				ID:        10,
+				AccountID: &accountID,
+				Title:     "Кафе",
+				UpdatedAt: now,
+			},
+			in: usecase.PatchCategoryDataInput{
+				Version:    now.UnixMicro(),
+				Title:      &newTitle,
+				IsArchived: &archived,
+			},
+			wantUpdate: true,
+		},
+		{
+			name: "Negative_system_category",
+			category: &entity.Category{
+				ID:        1,
+				Title:     "Продукты",
+				UpdatedAt: now,
+			},
+			in: usecase.PatchCategoryDataInput{
+				Title: &newTitle,
+			},
+			skipVersionCheck: true,
+			wantErr:          appErrors.ErrForbidden,
+		},
+		{
+			name: "Negative_version_conflict",
+			category: &entity.Category{
+				ID:        10,
+				AccountID: &accountID,
+				Title:     "Кафе",
+				UpdatedAt: now,
+			},
+			in: usecase.PatchCategoryDataInput{
+				Version: now.Add(-time.Second).UnixMicro(),
+				Title:   &newTitle,
+			},
+			wantErr: appErrors.ErrVersionConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newDependencies(t)
+			t.Cleanup(func() { finishDependencies(t, d) })
+
+			d.categoryRepo.FindOneByIDMock.Set(func(ctx context.Context, id uint64, qp *uctypes.QueryGetOneParams) (*entity.Category, error) {
+				require.Equal(t, tt.category.ID, id)
+				require.NotNil(t, qp)
+				require.True(t, qp.ForUpdate)
+				return tt.category, nil
+			})
+
+			if tt.wantUpdate {
+				d.categoryRepo.UpdateMock.Set(func(ctx context.Context, item *entity.Category) error {
+					require.Equal(t, newTitle, item.Title)
+					require.True(t, item.IsArchived)
+					return nil
+				})
+			}
+
+			err := d.uc.PatchCategoryByDTO(context.Background(), tt.category.ID, tt.in, tt.skipVersionCheck)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUsecase_Category_DeleteCategoryByID_Table(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+	otherAccountID := uuid.New()
+
+	const categoryID uint64 = 10
+
+	tests := []struct {
+		name string
+
+		reassignToID *uint64
+		target       *entity.Category
+		budgets      []*entity.Budget
+		transactions []*entity.Transaction
+		rules        []*entity.RecurringRule
+		children     []*entity.Category
+
+		wantReassign bool
+		wantErr      error
+	}{
+		{
+			name: "Positive_not_in_use",
+		},
+		{
+			name:     "Negative_has_subcategories",
+			children: []*entity.Category{{ID: 20, AccountID: &accountID, ParentID: func() *uint64 { v := categoryID; return &v }()}},
+			wantErr:  appErrors.ErrBadRequest,
+		},
+		{
+			name:         "Negative_in_use_without_target",
+			transactions: []*entity.Transaction{{ID: uuid.New()}},
+			wantErr:      appErrors.ErrBadRequest,
+		},
+		{
+			name:    "Negative_recurring_rules_without_target",
+			rules:   []*entity.RecurringRule{{ID: uuid.New()}},
+			wantErr: appErrors.ErrBadRequest,
+		},
+		{
+			name:    "Negative_budgets_without_target",
+			budgets: []*entity.Budget{{ID: uuid.New()}},
+			wantErr: appErrors.ErrBadRequest,
+		},
+		{
+			name:         "Positive_reassign_to_system",
+			reassignToID: func() *uint64 { v := uint64(1); return &v }(),
+			target:       &entity.Category{ID: 1},
+			budgets:      []*entity.Budget{{ID: uuid.New()}},
+			wantReassign: true,
+		},
+		{
+			name:         "Negative_target_of_other_account",
+			reassignToID: func() *uint64 { v := uint64(11); return &v }(),
+			target:       &entity.Category{ID: 11, AccountID: &otherAccountID},
+			wantErr:      appErrors.ErrBadRequest,
+		},
+		{
+			name:         "Negative_target_archived",
+			reassignToID: func() *uint64 { v := uint64(12); return &v }(),
+			target:       &entity.Category{ID: 12, AccountID: &accountID, IsArchived: true},
+			wantErr:      appErrors.ErrBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newDependencies(t)
+			t.Cleanup(func() { finishDependencies(t, d) })
+
+			category := &entity.Category{
+				ID:        categoryID,
+				AccountID: &accountID,
+				Title:     "Кафе",
+			}
+
+			d.categoryRepo.FindOneByIDMock.Set(func(ctx context.Context, id uint64, qp *uctypes.QueryGetOneParams) (*entity.Category, error) {
+				switch {
+				case id == categoryID:
+					return category, nil
+				case tt.target != nil && id == tt.target.ID:
+					return tt.target, nil
+				}
+				return nil, appErrors.ErrNotFound
+			})
+
+			d.categoryRepo.FindListMock.Set(func(ctx context.Context, lo *usecase.CategoryListOptions, qp *uctypes.QueryGetListParams) ([]*entity.Category, error) {
+				require.NotNil(t, lo.FilterAvailableForAccountID)
+				require.Equal(t, accountID, *lo.FilterAvailableForAccountID)
+				return append([]*entity.Category{category}, tt.children...), nil
+			})
+
+			if len(tt.children) == 0 {
+				d.budgetRepo.FindListMock.Set(func(ctx context.Context, lo *usecase.BudgetListOptions, qp *uctypes.QueryGetListParams) ([]*entity.Budget, error) {
+					require.NotNil(t, lo.FilterCategoryID)
+					require.Equal(t, categoryID, *lo.FilterCategoryID)
+					return tt.budgets, nil
+				})
+			}
+
+			if tt.reassignToID == nil && len(tt.children) == 0 {
+				d.transactionRepo.FindListMock.Set(func(ctx context.Context, lo *usecase.TransactionListOptions, qp *uctypes.QueryGetListParams) ([]*entity.Transaction, error) {
+					require.NotNil(t, lo.FilterCategoryID)
+					require.Equal(t, categoryID, *lo.FilterCategoryID)
+					return tt.transactions, nil
+				})
+
+				d.recurringRuleRepo.FindListMock.Set(func(ctx context.Context, lo *usecase.RecurringRuleListOptions, qp *uctypes.QueryGetListParams) ([]*entity.RecurringRule, error) {
+					require.NotNil(t, lo.FilterCategoryID)
+					require.Equal(t, categoryID, *lo.FilterCategoryID)
+					return tt.rules, nil
+				})
+			}
+
+			if tt.wantReassign {
+				d.transactionRepo.ReassignCategoryMock.Expect(context.Background(), categoryID, *tt.reassignToID).Return(nil)
+				d.recurringRuleRepo.ReassignCategoryMock.Expect(context.Background(), categoryID, *tt.reassignToID).Return(nil)
+				d.budgetRepo.ReassignCategoryMock.Expect(context.Background(), categoryID, *tt.reassignToID).Return(nil)
+			}
+
+			if tt.wantErr == nil {
+				d.cacheGenerationRepo.BumpGenerationsMock.Set(func(ctx context.Context, scopes ...string) error {
+					require.Len(t, scopes, len(tt.budgets)+2)
+					return nil
+				})
+			}
+
+			if tt.wantErr == nil {
+				d.categoryRepo.UpdateMock.Set(func(ctx context.Context, item *entity.Category) error {
+					require.NotNil(t, item.DeletedAt)
+					return nil
+				})
+			}
+
+			err := d.uc.DeleteCategoryByID(context.Background(), categoryID, tt.reassignToID)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			require.Equal(t, uint64(1), d.cacheGenerationRepo.BumpGenerationsAfterCounter())
+		})
+	}
+}
+
+func TestUsecase_Category_DeleteCategoryByID_SystemForbidden(t *testing.T) {
+	t.Parallel()
+
+	d := newDependencies(t)
+	t.Cleanup(func() { finishDependencies(t, d) })
+
+	d.categoryRepo.FindOneByIDMock.Return(&entity.Category{ID: 1, Title: "Продукты"}, nil)
+
+	err := d.uc.DeleteCategoryByID(context.Background(), 1, nil)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, appErrors.ErrForbidden))
+}
+
+func TestUsecase_Category_Hierarchy_Table(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+
+	ptr := func(v uint64) *uint64 { return &v }
+
+	// 1 (system) -> 10 -> 11
+	tree := []*entity.Category{
+		{ID: 1, Title: "Транспорт"},
+		{ID: 10, AccountID: &accountID, ParentID: ptr(1), Title: "Такси"},
+		{ID: 11, AccountID: &accountID, ParentID: ptr(10), Title: "Комфорт"},
+		{ID: 12, AccountID: &accountID, Title: "Хобби"},
+		{ID: 13, AccountID: &accountID, Title: "Архив", IsArchived: true},
+	}
+
+	tests := []struct {
+		name string
+
+		createParentID *uint64
+		patchID        uint64
+		patchParentID  *uint64
+
+		wantParentID *uint64
+		wantErr      error
+	}{
+		{
+			name:           "Positive_create_under_system",
+			createParentID: ptr(1),
+			wantParentID:   ptr(1),
+		},
+		{
+			name:           "Negative_create_too_deep",
+			createParentID: ptr(11),
+			wantErr:        appErrors.ErrBadRequest,
+		},
+		{
+			name:           "Negative_create_under_archived",
+			createParentID: ptr(13),
+			wantErr:        appErrors.ErrBadRequest,
+		},
+		{
+			name:           "Negative_create_under_unknown",
+			createParentID: ptr(999),
+			wantErr:        appErrors.ErrBadRequest,
+		},
+		{
+			name:          "Positive_move_subtree",
+			patchID:       10,
+			patchParentID: ptr(12),
+			wantParentID:  ptr(12),
+		},
+		{
+			name:          "Positive_move_to_root",
+			patchID:       11,
+			patchParentID: ptr(0),
+			wantParentID:  nil,
+		},
+		{
+			name:          "Negative_move_into_descendant",
+			patchID:       10,
+			patchParentID: ptr(11),
+			wantErr:       appErrors.ErrBadRequest,
+		},
+		{
+			name:          "Negative_move_too_deep",
+			patchID:       12,
+			patchParentID: ptr(11),
+			wantErr:       appErrors.ErrBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newDependencies(t)
+			t.Cleanup(func() { finishDependencies(t, d) })
+
+			d.uc.cfg.Budget.CategoryMaxDepth = 3
+
+			items := make([]*entity.Category, 0, len(tree))
+			for _, item := range tree {
+				clone := *item
+				items = append(items, &clone)
+			}
+
+			d.categoryRepo.FindListMock.Optional().Return(items, nil)
+
+			if tt.patchID == 0 {
+				if tt.wantErr == nil {
+					d.categoryRepo.CreateMock.Set(func(ctx context.Context, item *entity.Category) error {
+						require.Equal(t, tt.wantParentID, item.ParentID)
+						return nil
+					})
+				}
+
+				_, err := d.uc.CreateCategoryByDTO(context.Background(), usecase.CreateCategoryDataInput{
+					AccountID: accountID,
+					ParentID:  tt.createParentID,
+					Title:     "Новая",
+				})
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+					return
+				}
+
+				require.NoError(t, err)
+				return
+			}
+
+			var patched *entity.Category
+			for _, item := range items {
+				if item.ID == tt.patchID {
+					patched = item
+				}
+			}
+
+			d.categoryRepo.FindOneByIDMock.Return(patched, nil)
+
+			if tt.wantErr == nil {
+				d.categoryRepo.UpdateMock.Set(func(ctx context.Context, item *entity.Category) error {
+					require.Equal(t, tt.wantParentID, item.ParentID)
+					return nil
+				})
+
+				d.cacheGenerationRepo.BumpGenerationsMock.Set(func(ctx context.Context, scopes ...string) error {
+					require.Contains(t, scopes, usecase.CacheScopeReports(accountID))
+					return nil
+				})
+			}
+
+			err := d.uc.PatchCategoryByDTO(context.Background(), tt.patchID, usecase.PatchCategoryDataInput{
+				ParentID: tt.patchParentID,
+			}, true)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), d.cacheGenerationRepo.BumpGenerationsAfterCounter())
+		})
+	}
+}
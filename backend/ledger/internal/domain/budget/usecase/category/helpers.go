@@ -2,9 +2,17 @@ package category
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
 
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/loghandler"
+	"github.com/samber/lo"
 )
 
 func (uc *UsecaseImpl) entitiesToDTO(
@@ -23,3 +31,97 @@ func (uc *UsecaseImpl) entitiesToDTO(
 
 	return out, nil
 }
+
+func (uc *UsecaseImpl) checkCategoryOwner(ctx context.Context, category *entity.Category) error {
+	if category.IsSystem() {
+		return appErrors.ErrForbidden.WithHints("system category can't be modified")
+	}
+
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != *category.AccountID {
+			return appErrors.ErrForbidden
+		}
+	}
+
+	return nil
+}
+
+func (uc *UsecaseImpl) hasCategoryTransactions(ctx context.Context, categoryID uint64) (bool, error) {
+	transactions, err := uc.transactionRepo.FindList(ctx, &usecase.TransactionListOptions{
+		FilterCategoryID: &categoryID,
+	}, &uctypes.QueryGetListParams{
+		Limit: 1,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return len(transactions) > 0, nil
+}
+
+func (uc *UsecaseImpl) hasCategoryRecurringRules(ctx context.Context, categoryID uint64) (bool, error) {
+	rules, err := uc.recurringRuleRepo.FindList(ctx, &usecase.RecurringRuleListOptions{
+		FilterCategoryID: &categoryID,
+	}, &uctypes.QueryGetListParams{
+		Limit: 1,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return len(rules) > 0, nil
+}
+
+func (uc *UsecaseImpl) loadCategoryTree(ctx context.Context, accountID uuid.UUID) (*entity.CategoryTree, error) {
+	categories, err := uc.categoryRepo.FindList(ctx, &usecase.CategoryListOptions{
+		FilterAvailableForAccountID: &accountID,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.NewCategoryTree(categories), nil
+}
+
+// checkParent - проверка, что parentID может стать родителем категории
+func (uc *UsecaseImpl) checkParent(tree *entity.CategoryTree, category *entity.Category, parentID uint64) error {
+	parent, ok := tree.Get(parentID)
+	if !ok {
+		return appErrors.ErrBadRequest.WithHints("parent category not found")
+	}
+
+	if parent.IsArchived {
+		return appErrors.ErrBadRequest.WithHints("parent category is archived")
+	}
+
+	height := 1
+	if category.ID != 0 {
+		if parentID == category.ID || lo.Contains(tree.DescendantIDs(category.ID), parentID) {
+			return appErrors.ErrBadRequest.WithHints("category can't be moved into itself")
+		}
+
+		height = tree.Height(category.ID)
+	}
+
+	maxDepth := uc.cfg.Budget.CategoryMaxDepth
+	if maxDepth > 0 && tree.Depth(parentID)+height > maxDepth {
+		return appErrors.ErrBadRequest.WithHints(fmt.Sprintf("max category depth is %d", maxDepth))
+	}
+
+	return nil
+}
+
+// invalidateAccountCache - сдвигает поколения кеша бюджетов и отчетов аккаунта после фиксации транзакции БД
+func (uc *UsecaseImpl) invalidateAccountCache(ctx context.Context, accountID uuid.UUID, budgetIDs ...uuid.UUID) {
+	scopes := make([]string, 0, len(budgetIDs)+2)
+	scopes = append(scopes, usecase.CacheScopeBudgets(accountID), usecase.CacheScopeReports(accountID))
+	for _, budgetID := range budgetIDs {
+		scopes = append(scopes, usecase.CacheScopeBudget(budgetID))
+	}
+
+	err := uc.cacheGenerationRepo.BumpGenerations(context.WithoutCancel(ctx), scopes...)
+	if err != nil {
+		uc.logger.ErrorContext(loghandler.WithSource(ctx), "redis bump cache generation err", slog.Any("error", err))
+	}
+}
@@ -9,11 +9,15 @@ import (
 )
 
 type UsecaseImpl struct {
-	pkg            string
-	logger         *slog.Logger
-	cfg            config.Config
-	dbMasterClient db.MasterClient
-	categoryRepo   usecase.CategoryRepository
+	pkg                 string
+	logger              *slog.Logger
+	cfg                 config.Config
+	dbMasterClient      db.MasterClient
+	categoryRepo        usecase.CategoryRepository
+	transactionRepo     usecase.TransactionRepository
+	budgetRepo          usecase.BudgetRepository
+	cacheGenerationRepo usecase.CacheGenerationRepository
+	recurringRuleRepo   usecase.RecurringRuleRepository
 }
 
 func NewUsecaseImpl(
@@ -21,13 +25,21 @@ func NewUsecaseImpl(
 	cfg config.Config,
 	dbMasterClient db.MasterClient,
 	categoryRepo usecase.CategoryRepository,
+	transactionRepo usecase.TransactionRepository,
+	budgetRepo usecase.BudgetRepository,
+	cacheGenerationRepo usecase.CacheGenerationRepository,
+	recurringRuleRepo usecase.RecurringRuleRepository,
 ) *UsecaseImpl {
 	uc := &UsecaseImpl{
-		pkg:            "Budget.Usecase.Category",
-		logger:         logger,
-		cfg:            cfg,
-		dbMasterClient: dbMasterClient,
-		categoryRepo:   categoryRepo,
+		pkg:                 "Budget.Usecase.Category",
+		logger:              logger,
+		cfg:                 cfg,
+		dbMasterClient:      dbMasterClient,
+		categoryRepo:        categoryRepo,
+		transactionRepo:     transactionRepo,
+		budgetRepo:          budgetRepo,
+		cacheGenerationRepo: cacheGenerationRepo,
+		recurringRuleRepo:   recurringRuleRepo,
 	}
 	return uc
 }
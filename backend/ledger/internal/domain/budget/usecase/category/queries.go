@@ -2,7 +2,9 @@ package category
 
 import (
 	"context"
+	"errors"
 
+	"github.com/google/uuid"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
@@ -53,3 +55,29 @@ func (uc *UsecaseImpl) FindList(
 
 	return out, nil
 }
+
+func (uc *UsecaseImpl) CheckCategoryAccess(
+	ctx context.Context,
+	id uint64,
+	accountID uuid.UUID,
+) error {
+	const op = "CheckCategoryAccess"
+
+	category, err := uc.categoryRepo.FindOneByID(ctx, id, nil)
+	if err != nil {
+		if errors.Is(err, appErrors.ErrNotFound) {
+			return appErrors.Chainf(appErrors.ErrBadRequest.WithHints("category not found"), "%s.%s", uc.pkg, op)
+		}
+		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	if !category.IsAvailableForAccount(accountID) {
+		return appErrors.Chainf(appErrors.ErrBadRequest.WithHints("category not found"), "%s.%s", uc.pkg, op)
+	}
+
+	if category.IsArchived {
+		return appErrors.Chainf(appErrors.ErrBadRequest.WithHints("category is archived"), "%s.%s", uc.pkg, op)
+	}
+
+	return nil
+}
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
@@ -209,3 +211,83 @@ func TestUsecase_Category_FindList_Table(t *testing.T) {
 		})
 	}
 }
+
+func TestUsecase_Category_CheckCategoryAccess_Table(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+	foreignAccountID := uuid.New()
+
+	tests := []struct {
+		name string
+
+		category *entity.Category
+		repoErr  error
+
+		wantErr  error
+		wantHint string
+	}{
+		{
+			name:     "Positive_own_category",
+			category: &entity.Category{ID: 10, AccountID: &accountID},
+		},
+		{
+			name:     "Positive_common_category",
+			category: &entity.Category{ID: 10},
+		},
+		{
+			name:     "Negative_not_found",
+			repoErr:  appErrors.ErrNotFound,
+			wantErr:  appErrors.ErrBadRequest,
+			wantHint: "category not found",
+		},
+		{
+			name:     "Negative_foreign_category",
+			category: &entity.Category{ID: 10, AccountID: &foreignAccountID},
+			wantErr:  appErrors.ErrBadRequest,
+			wantHint: "category not found",
+		},
+		{
+			name:     "Negative_archived",
+			category: &entity.Category{ID: 10, AccountID: &accountID, IsArchived: true},
+			wantErr:  appErrors.ErrBadRequest,
+			wantHint: "category is archived",
+		},
+		{
+			name:    "Negative_repo_error",
+			repoErr: appErrors.ErrInternal,
+			wantErr: appErrors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newDependencies(t)
+			t.Cleanup(func() { finishDependencies(t, d) })
+
+			d.categoryRepo.FindOneByIDMock.Set(func(ctx context.Context, id uint64, _ *uctypes.QueryGetOneParams) (*entity.Category, error) {
+				require.Equal(t, uint64(10), id)
+				return tt.category, tt.repoErr
+			})
+
+			err := d.uc.CheckCategoryAccess(context.Background(), 10, accountID)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				if tt.wantHint != "" {
+					hints, ok := appErrors.NearestHints(err)
+					require.True(t, ok)
+					require.Equal(t, []string{tt.wantHint}, hints)
+				}
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
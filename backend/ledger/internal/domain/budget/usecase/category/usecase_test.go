@@ -22,7 +22,11 @@ type dependencies struct {
 
 	dbMasterClient db.MasterClient
 
-	categoryRepo *mocks.CategoryRepositoryMock
+	categoryRepo        *mocks.CategoryRepositoryMock
+	transactionRepo     *mocks.TransactionRepositoryMock
+	budgetRepo          *mocks.BudgetRepositoryMock
+	cacheGenerationRepo *mocks.CacheGenerationRepositoryMock
+	recurringRuleRepo   *mocks.RecurringRuleRepositoryMock
 
 	uc *UsecaseImpl
 }
@@ -38,21 +42,33 @@ func newDependencies(t *testing.T) *dependencies {
 	dbMasterClient := pgclient.NewMock()
 
 	categoryRepo := mocks.NewCategoryRepositoryMock(mc)
+	transactionRepo := mocks.NewTransactionRepositoryMock(mc)
+	budgetRepo := mocks.NewBudgetRepositoryMock(mc)
+	cacheGenerationRepo := mocks.NewCacheGenerationRepositoryMock(mc)
+	recurringRuleRepo := mocks.NewRecurringRuleRepositoryMock(mc)
 
 	uc := NewUsecaseImpl(
 		logger,
 		cfg,
 		dbMasterClient,
 		categoryRepo,
+		transactionRepo,
+		budgetRepo,
+		cacheGenerationRepo,
+		recurringRuleRepo,
 	)
 
 	return &dependencies{
-		mc:             mc,
-		logger:         logger,
-		cfg:            cfg,
-		dbMasterClient: dbMasterClient,
-		categoryRepo:   categoryRepo,
-		uc:             uc,
+		mc:                  mc,
+		logger:              logger,
+		cfg:                 cfg,
+		dbMasterClient:      dbMasterClient,
+		categoryRepo:        categoryRepo,
+		transactionRepo:     transactionRepo,
+		budgetRepo:          budgetRepo,
+		cacheGenerationRepo: cacheGenerationRepo,
+		recurringRuleRepo:   recurringRuleRepo,
+		uc:                  uc,
 	}
 }
 
@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+)
+
+// CategoryRulesReapplyMaxDays - максимальный период пересчета категорий по правилам
+const CategoryRulesReapplyMaxDays = 366
+
+type CategoryRuleListOptions struct {
+	FilterIDs       *[]uuid.UUID
+	FilterAccountID *uuid.UUID
+	Sort            []uctypes.SortOption[CategoryRuleListOptionsSortField]
+}
+
+type CategoryRuleListOptionsSortField string
+
+const (
+	CategoryRuleListOptionsSortFieldPriority  CategoryRuleListOptionsSortField = "priority"
+	CategoryRuleListOptionsSortFieldCreatedAt CategoryRuleListOptionsSortField = "created_at"
+)
+
+type CategoryRuleDTO struct {
+	CategoryRule *entity.CategoryRule
+}
+
+type CreateCategoryRuleDataInput struct {
+	AccountID uuid.UUID
+	entity.NewCategoryRuleInput
+}
+
+type PatchCategoryRuleDataInput struct {
+	Version int64
+
+	Name       *string
+	Priority   *int
+	CategoryID *uint64
+	// Conditions - условия заменяются целиком
+	Conditions *entity.CategoryRuleConditions
+}
+
+type ReapplyCategoryRulesInput struct {
+	AccountID uuid.UUID
+	DateFrom  civil.Date
+	DateTo    civil.Date
+	// DryRun - изменения рассчитываются без сохранения
+	DryRun bool
+}
+
+// CategoryRuleChange - транзакция, категория которой изменена правилом
+type CategoryRuleChange struct {
+	TransactionID uuid.UUID
+	RuleID        uuid.UUID
+	OldCategoryID uint64
+	NewCategoryID uint64
+}
+
+type ReapplyCategoryRulesReport struct {
+	// Checked - количество проверенных транзакций периода без учета переводов
+	Checked int
+	Changes []*CategoryRuleChange
+	DryRun  bool
+}
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.CategoryRuleUsecase -o mocks/category_rule_usecase.go
+type CategoryRuleUsecase interface {
+	FindOneByID(
+		ctx context.Context,
+		id uuid.UUID,
+		queryParams *uctypes.QueryGetOneParams,
+	) (resItem *CategoryRuleDTO, resErr error)
+
+	FindList(
+		ctx context.Context,
+		listOptions *CategoryRuleListOptions,
+		queryParams *uctypes.QueryGetListParams,
+	) (resItems []*CategoryRuleDTO, resErr error)
+
+	CreateCategoryRuleByDTO(
+		ctx context.Context,
+		in CreateCategoryRuleDataInput,
+	) (resCategoryRuleDTO *CategoryRuleDTO, resErr error)
+
+	PatchCategoryRuleByDTO(
+		ctx context.Context,
+		id uuid.UUID,
+		in PatchCategoryRuleDataInput,
+		skipVersionCheck bool,
+	) (resErr error)
+
+	DeleteCategoryRuleByID(
+		ctx context.Context,
+		id uuid.UUID,
+	) (resErr error)
+}
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.CategoryRuleRepository -o mocks/category_rule_repository.go
+type CategoryRuleRepository interface {
+	FindOneByID(
+		ctx context.Context,
+		id uuid.UUID,
+		queryParams *uctypes.QueryGetOneParams,
+	) (categoryRule *entity.CategoryRule, err error)
+
+	FindList(
+		ctx context.Context,
+		listOptions *CategoryRuleListOptions,
+		queryParams *uctypes.QueryGetListParams,
+	) (items []*entity.CategoryRule, err error)
+
+	Create(ctx context.Context, item *entity.CategoryRule) (err error)
+
+	Update(ctx context.Context, item *entity.CategoryRule) (err error)
+}
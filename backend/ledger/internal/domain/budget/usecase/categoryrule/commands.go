@@ -0,0 +1,169 @@
+package categoryrule
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/loghandler"
+	"github.com/samber/lo"
+)
+
+func (uc *UsecaseImpl) CreateCategoryRuleByDTO(
+	ctx context.Context,
+	in usecase.CreateCategoryRuleDataInput,
+) (*usecase.CategoryRuleDTO, error) {
+	const op = "CreateCategoryRuleByDTO"
+
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != in.AccountID {
+			return nil, appErrors.ErrForbidden
+		}
+	}
+
+	categoryRule, err := entity.NewCategoryRule(in.AccountID, in.NewCategoryRuleInput)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	err = uc.categoryUC.CheckCategoryAccess(ctx, categoryRule.CategoryID, categoryRule.AccountID)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	err = uc.checkWallet(ctx, categoryRule)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	err = uc.categoryRuleRepo.Create(ctx, categoryRule)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	categoryRuleDTO, err := uc.entitiesToDTO(ctx, []*entity.CategoryRule{categoryRule})
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	if len(categoryRuleDTO) == 0 {
+		uc.logger.ErrorContext(loghandler.WithSource(ctx), "unpredicted empty category rule dto")
+		return nil, appErrors.Chainf(appErrors.ErrInternal, "%s.%s", uc.pkg, op)
+	}
+
+	return categoryRuleDTO[0], nil
+}
+
+func (uc *UsecaseImpl) PatchCategoryRuleByDTO(
+	ctx context.Context,
+	id uuid.UUID,
+	in usecase.PatchCategoryRuleDataInput,
+	skipVersionCheck bool,
+) error {
+	const op = "PatchCategoryRuleByDTO"
+
+	err := uc.dbMasterClient.Do(ctx, func(ctx context.Context) error {
+		categoryRule, err := uc.categoryRuleRepo.FindOneByID(ctx, id, &uctypes.QueryGetOneParams{
+			ForUpdate: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = uc.checkCategoryRuleOwner(ctx, categoryRule)
+		if err != nil {
+			return err
+		}
+
+		if !skipVersionCheck && categoryRule.Version() != in.Version {
+			return appErrors.ErrVersionConflict.
+				WithDetail("last_version", false, categoryRule.Version()).
+				WithDetail("last_updated_at", false, categoryRule.UpdatedAt)
+		}
+
+		if in.Name != nil {
+			err = categoryRule.SetName(*in.Name)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.Priority != nil {
+			err = categoryRule.SetPriority(*in.Priority)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.CategoryID != nil {
+			categoryRule.CategoryID = *in.CategoryID
+
+			err = uc.categoryUC.CheckCategoryAccess(ctx, categoryRule.CategoryID, categoryRule.AccountID)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.Conditions != nil {
+			err = categoryRule.SetConditions(*in.Conditions)
+			if err != nil {
+				return err
+			}
+
+			err = uc.checkWallet(ctx, categoryRule)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = uc.categoryRuleRepo.Update(ctx, categoryRule)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return nil
+}
+
+func (uc *UsecaseImpl) DeleteCategoryRuleByID(ctx context.Context, id uuid.UUID) error {
+	const op = "DeleteCategoryRuleByID"
+
+	err := uc.dbMasterClient.Do(ctx, func(ctx context.Context) error {
+		categoryRule, err := uc.categoryRuleRepo.FindOneByID(ctx, id, &uctypes.QueryGetOneParams{
+			ForUpdate: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = uc.checkCategoryRuleOwner(ctx, categoryRule)
+		if err != nil {
+			return err
+		}
+
+		categoryRule.DeletedAt = lo.ToPtr(time.Now())
+
+		err = uc.categoryRuleRepo.Update(ctx, categoryRule)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return nil
+}
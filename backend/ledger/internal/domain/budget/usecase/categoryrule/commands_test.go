@@ -0,0 +1,250 @@
+package categoryrule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryRuleUsecase_CreateCategoryRuleByDTO_Table(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+	categoryID := uint64(7)
+	walletID := uuid.New()
+
+	validInput := func() entity.NewCategoryRuleInput {
+		return entity.NewCategoryRuleInput{
+			Name:       " Taxi ",
+			Priority:   10,
+			CategoryID: categoryID,
+			Conditions: entity.CategoryRuleConditions{
+				DescriptionContains: lo.ToPtr("uber"),
+				DescriptionRegex:    lo.ToPtr(""),
+				AmountMax:           lo.ToPtr(decimal.MustNew(5000, 0)),
+				IsIncome:            lo.ToPtr(false),
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(in *entity.NewCategoryRuleInput)
+
+		categoryErr     error
+		walletAccountID *uuid.UUID
+		wantErr         bool
+	}{
+		{
+			name:   "OK",
+			modify: func(in *entity.NewCategoryRuleInput) {},
+		},
+		{
+			name: "OK_wallet_condition",
+			modify: func(in *entity.NewCategoryRuleInput) {
+				in.Conditions.WalletID = &walletID
+			},
+		},
+		{
+			name: "Negative_empty_name",
+			modify: func(in *entity.NewCategoryRuleInput) {
+				in.Name = " "
+			},
+			wantErr: true,
+		},
+		{
+			name: "Negative_priority",
+			modify: func(in *entity.NewCategoryRuleInput) {
+				in.Priority = -1
+			},
+			wantErr: true,
+		},
+		{
+			name: "Negative_invalid_regex",
+			modify: func(in *entity.NewCategoryRuleInput) {
+				in.Conditions.DescriptionRegex = lo.ToPtr("(taxi")
+			},
+			wantErr: true,
+		},
+		{
+			name: "Negative_amount_range",
+			modify: func(in *entity.NewCategoryRuleInput) {
+				in.Conditions.AmountMin = lo.ToPtr(decimal.MustNew(6000, 0))
+			},
+			wantErr: true,
+		},
+		{
+			name:        "Negative_foreign_category",
+			modify:      func(in *entity.NewCategoryRuleInput) {},
+			categoryErr: appErrors.ErrBadRequest.WithHints("category not found"),
+			wantErr:     true,
+		},
+		{
+			name:        "Negative_archived_category",
+			modify:      func(in *entity.NewCategoryRuleInput) {},
+			categoryErr: appErrors.ErrBadRequest.WithHints("category is archived"),
+			wantErr:     true,
+		},
+		{
+			name: "Negative_foreign_wallet",
+			modify: func(in *entity.NewCategoryRuleInput) {
+				in.Conditions.WalletID = &walletID
+			},
+			walletAccountID: lo.ToPtr(uuid.New()),
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			in := validInput()
+			tt.modify(&in)
+
+			s.categoryUC.CheckCategoryAccessMock.Optional().Set(func(ctx context.Context, id uint64, gotAccountID uuid.UUID) error {
+				require.Equal(t, categoryID, id)
+				require.Equal(t, accountID, gotAccountID)
+				return tt.categoryErr
+			})
+
+			s.walletRepo.FindOneByIDMock.Optional().Return(&entity.Wallet{
+				ID:        walletID,
+				AccountID: lo.FromPtrOr(tt.walletAccountID, accountID),
+			}, nil)
+
+			if !tt.wantErr {
+				s.categoryRuleRepo.CreateMock.Set(func(ctx context.Context, item *entity.CategoryRule) error {
+					require.Equal(t, accountID, item.AccountID)
+					require.Equal(t, "Taxi", item.Name)
+					require.Equal(t, categoryID, item.CategoryID)
+					require.Nil(t, item.Conditions.DescriptionRegex)
+
+					return nil
+				})
+			}
+
+			got, err := s.uc.CreateCategoryRuleByDTO(testCtx(), usecase.CreateCategoryRuleDataInput{
+				AccountID:            accountID,
+				NewCategoryRuleInput: in,
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			require.NotNil(t, got.CategoryRule)
+		})
+	}
+}
+
+func TestCategoryRuleUsecase_PatchCategoryRuleByDTO_OK(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	id := uuid.New()
+	categoryID := uint64(3)
+
+	now := time.Now().Truncate(time.Microsecond)
+	item := &entity.CategoryRule{
+		ID:         id,
+		AccountID:  uuid.New(),
+		Name:       "Taxi",
+		CategoryID: 1,
+		Conditions: entity.CategoryRuleConditions{
+			DescriptionContains: lo.ToPtr("uber"),
+			IsIncome:            lo.ToPtr(false),
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	s.categoryRuleRepo.FindOneByIDMock.Set(func(ctx context.Context, gotID uuid.UUID, qp *uctypes.QueryGetOneParams) (*entity.CategoryRule, error) {
+		require.Equal(t, id, gotID)
+		require.NotNil(t, qp)
+		require.True(t, qp.ForUpdate)
+		return item, nil
+	})
+
+	s.categoryUC.CheckCategoryAccessMock.Return(nil)
+
+	s.categoryRuleRepo.UpdateMock.Set(func(ctx context.Context, got *entity.CategoryRule) error {
+		require.Equal(t, 5, got.Priority)
+		require.Equal(t, categoryID, got.CategoryID)
+		// условия заменяются целиком
+		require.Nil(t, got.Conditions.DescriptionContains)
+		require.Nil(t, got.Conditions.IsIncome)
+		require.Equal(t, "^yandex", *got.Conditions.DescriptionRegex)
+		return nil
+	})
+
+	err := s.uc.PatchCategoryRuleByDTO(testCtx(), id, usecase.PatchCategoryRuleDataInput{
+		Version:    item.Version(),
+		Priority:   lo.ToPtr(5),
+		CategoryID: &categoryID,
+		Conditions: &entity.CategoryRuleConditions{
+			DescriptionRegex: lo.ToPtr("^yandex"),
+		},
+	}, false)
+	require.NoError(t, err)
+}
+
+func TestCategoryRuleUsecase_PatchCategoryRuleByDTO_VersionConflict(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	id := uuid.New()
+
+	item := &entity.CategoryRule{
+		ID:        id,
+		AccountID: uuid.New(),
+		UpdatedAt: time.Now().Truncate(time.Microsecond),
+	}
+
+	s.categoryRuleRepo.FindOneByIDMock.Return(item, nil)
+
+	err := s.uc.PatchCategoryRuleByDTO(testCtx(), id, usecase.PatchCategoryRuleDataInput{
+		Version: item.Version() - 1,
+	}, false)
+	require.Error(t, err)
+}
+
+func TestCategoryRuleUsecase_DeleteCategoryRuleByID_OK(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	id := uuid.New()
+
+	s.categoryRuleRepo.FindOneByIDMock.Return(&entity.CategoryRule{ID: id, AccountID: uuid.New()}, nil)
+	s.categoryRuleRepo.UpdateMock.Set(func(ctx context.Context, item *entity.CategoryRule) error {
+		require.Equal(t, id, item.ID)
+		require.NotNil(t, item.DeletedAt)
+		return nil
+	})
+
+	err := s.uc.DeleteCategoryRuleByID(testCtx(), id)
+	require.NoError(t, err)
+}
@@ -0,0 +1,58 @@
+package categoryrule
+
+import (
+	"context"
+	"errors"
+
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+func (uc *UsecaseImpl) entitiesToDTO(
+	_ context.Context,
+	items []*entity.CategoryRule,
+) ([]*usecase.CategoryRuleDTO, error) {
+	out := make([]*usecase.CategoryRuleDTO, 0, len(items))
+
+	for _, item := range items {
+		out = append(out, &usecase.CategoryRuleDTO{
+			CategoryRule: item,
+		})
+	}
+
+	return out, nil
+}
+
+func (uc *UsecaseImpl) checkCategoryRuleOwner(ctx context.Context, categoryRule *entity.CategoryRule) error {
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != categoryRule.AccountID {
+			return appErrors.ErrForbidden
+		}
+	}
+
+	return nil
+}
+
+// checkWallet - кошелек из условия правила должен принадлежать аккаунту
+func (uc *UsecaseImpl) checkWallet(ctx context.Context, categoryRule *entity.CategoryRule) error {
+	if categoryRule.Conditions.WalletID == nil {
+		return nil
+	}
+
+	wallet, err := uc.walletRepo.FindOneByID(ctx, *categoryRule.Conditions.WalletID, nil)
+	if err != nil {
+		if errors.Is(err, appErrors.ErrNotFound) {
+			return appErrors.ErrBadRequest.WithHints("wallet not found")
+		}
+		return err
+	}
+
+	if wallet.AccountID != categoryRule.AccountID {
+		return appErrors.ErrBadRequest.WithHints("wallet not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package categoryrule
+
+import (
+	"log/slog"
+
+	"github.com/m11ano/budget_planner/backend/ledger/internal/app/config"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/db"
+)
+
+type UsecaseImpl struct {
+	pkg              string
+	logger           *slog.Logger
+	cfg              config.Config
+	dbMasterClient   db.MasterClient
+	categoryRuleRepo usecase.CategoryRuleRepository
+	categoryUC       usecase.CategoryUsecase
+	walletRepo       usecase.WalletRepository
+}
+
+func NewUsecaseImpl(
+	logger *slog.Logger,
+	cfg config.Config,
+	dbMasterClient db.MasterClient,
+	categoryRuleRepo usecase.CategoryRuleRepository,
+	categoryUC usecase.CategoryUsecase,
+	walletRepo usecase.WalletRepository,
+) *UsecaseImpl {
+	uc := &UsecaseImpl{
+		pkg:              "Budget.Usecase.CategoryRule",
+		logger:           logger,
+		cfg:              cfg,
+		dbMasterClient:   dbMasterClient,
+		categoryRuleRepo: categoryRuleRepo,
+		categoryUC:       categoryUC,
+		walletRepo:       walletRepo,
+	}
+	return uc
+}
@@ -0,0 +1,60 @@
+package categoryrule
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+func (uc *UsecaseImpl) FindOneByID(
+	ctx context.Context,
+	id uuid.UUID,
+	queryParams *uctypes.QueryGetOneParams,
+) (*usecase.CategoryRuleDTO, error) {
+	const op = "FindOneByID"
+
+	item, err := uc.categoryRuleRepo.FindOneByID(ctx, id, queryParams)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	err = uc.checkCategoryRuleOwner(ctx, item)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	dto, err := uc.entitiesToDTO(ctx, []*entity.CategoryRule{item})
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	if len(dto) == 0 {
+		return nil, appErrors.Chainf(appErrors.ErrInternal, "%s.%s", uc.pkg, op)
+	}
+
+	return dto[0], nil
+}
+
+func (uc *UsecaseImpl) FindList(
+	ctx context.Context,
+	listOptions *usecase.CategoryRuleListOptions,
+	queryParams *uctypes.QueryGetListParams,
+) ([]*usecase.CategoryRuleDTO, error) {
+	const op = "FindList"
+
+	items, err := uc.categoryRuleRepo.FindList(ctx, listOptions, queryParams)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	out, err := uc.entitiesToDTO(ctx, items)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return out, nil
+}
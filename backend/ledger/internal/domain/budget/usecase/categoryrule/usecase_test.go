@@ -0,0 +1,69 @@
+package categoryrule
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/app/config"
+	usecasemocks "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase/mocks"
+	"github.com/m11ano/budget_planner/backend/ledger/pkg/pgclient"
+)
+
+type dependencies struct {
+	mc *minimock.Controller
+
+	uc *UsecaseImpl
+
+	logger *slog.Logger
+	cfg    config.Config
+
+	dbMasterClient   any
+	categoryRuleRepo *usecasemocks.CategoryRuleRepositoryMock
+	categoryUC       *usecasemocks.CategoryUsecaseMock
+	walletRepo       *usecasemocks.WalletRepositoryMock
+}
+
+func newDependencies(t *testing.T) *dependencies {
+	t.Helper()
+
+	mc := minimock.NewController(t)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+
+	categoryRuleRepo := usecasemocks.NewCategoryRuleRepositoryMock(mc)
+	categoryUC := usecasemocks.NewCategoryUsecaseMock(mc)
+	walletRepo := usecasemocks.NewWalletRepositoryMock(mc)
+
+	dbMasterClient := pgclient.NewMock()
+
+	uc := NewUsecaseImpl(
+		logger,
+		config.Config{},
+		dbMasterClient,
+		categoryRuleRepo,
+		categoryUC,
+		walletRepo,
+	)
+
+	return &dependencies{
+		mc:               mc,
+		uc:               uc,
+		logger:           logger,
+		cfg:              config.Config{},
+		dbMasterClient:   dbMasterClient,
+		categoryRuleRepo: categoryRuleRepo,
+		categoryUC:       categoryUC,
+		walletRepo:       walletRepo,
+	}
+}
+
+func finishDependencies(s *dependencies) {
+	s.mc.Finish()
+}
+
+func testCtx() context.Context {
+	return context.Background()
+}
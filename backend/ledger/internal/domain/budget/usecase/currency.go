@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+)
+
+type ExchangeRateListOptions struct {
+	FilterAccountID    *uuid.UUID
+	FilterCurrency     *string
+	FilterRateDateFrom *civil.Date
+	FilterRateDateTo   *civil.Date
+	Sort               []uctypes.SortOption[ExchangeRateListOptionsSortField]
+}
+
+type ExchangeRateListOptionsSortField string
+
+const (
+	ExchangeRateListOptionsSortFieldRateDate ExchangeRateListOptionsSortField = "rate_date"
+)
+
+type AccountSettingsListOptions struct {
+	FilterBudgetAutoRollover *bool
+	// FilterBudgetRolledOverBefore - перенос бюджетов выполнялся раньше указанного месяца или не выполнялся
+	FilterBudgetRolledOverBefore *civil.Date
+}
+
+type UpsertExchangeRateDataInput struct {
+	CurrencyFrom string
+	CurrencyTo   string
+	RateDate     civil.Date
+	Rate         decimal.Decimal
+}
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.CurrencyUsecase -o mocks/currency_usecase.go
+type CurrencyUsecase interface {
+	GetBaseCurrency(
+		ctx context.Context,
+		accountID uuid.UUID,
+	) (resCurrency string, resErr error)
+
+	SetBaseCurrency(
+		ctx context.Context,
+		accountID uuid.UUID,
+		currency string,
+	) (resErr error)
+
+	FindExchangeRates(
+		ctx context.Context,
+		listOptions *ExchangeRateListOptions,
+		queryParams *uctypes.QueryGetListParams,
+	) (resItems []*entity.ExchangeRate, resErr error)
+
+	UpsertExchangeRates(
+		ctx context.Context,
+		accountID uuid.UUID,
+		in []UpsertExchangeRateDataInput,
+	) (resItems []*entity.ExchangeRate, resErr error)
+}
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.AccountSettingsRepository -o mocks/account_settings_repository.go
+type AccountSettingsRepository interface {
+	FindOneByAccountID(
+		ctx context.Context,
+		accountID uuid.UUID,
+		queryParams *uctypes.QueryGetOneParams,
+	) (item *entity.AccountSettings, err error)
+
+	FindList(
+		ctx context.Context,
+		listOptions *AccountSettingsListOptions,
+		queryParams *uctypes.QueryGetListParams,
+	) (items []*entity.AccountSettings, err error)
+
+	Upsert(ctx context.Context, item *entity.AccountSettings) (err error)
+}
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.ExchangeRateRepository -o mocks/exchange_rate_repository.go
+type ExchangeRateRepository interface {
+	FindList(
+		ctx context.Context,
+		listOptions *ExchangeRateListOptions,
+		queryParams *uctypes.QueryGetListParams,
+	) (items []*entity.ExchangeRate, err error)
+
+	// FindActual - последний курс пары валют (в любом направлении) на дату
+	FindActual(
+		ctx context.Context,
+		accountID uuid.UUID,
+		currencyFrom string,
+		currencyTo string,
+		date civil.Date,
+	) (item *entity.ExchangeRate, err error)
+
+	Upsert(ctx context.Context, items []*entity.ExchangeRate) (err error)
+}
@@ -0,0 +1,118 @@
+package currency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/loghandler"
+)
+
+func (uc *UsecaseImpl) SetBaseCurrency(ctx context.Context, accountID uuid.UUID, currency string) error {
+	const op = "SetBaseCurrency"
+
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != accountID {
+			return appErrors.ErrForbidden
+		}
+	}
+
+	err := uc.dbMasterClient.Do(ctx, func(ctx context.Context) error {
+		settings, err := uc.accountSettingsRepo.FindOneByAccountID(ctx, accountID, &uctypes.QueryGetOneParams{
+			ForUpdate: true,
+		})
+		if err != nil {
+			if !errors.Is(err, appErrors.ErrNotFound) {
+				return err
+			}
+
+			settings, err = entity.NewAccountSettings(accountID, currency)
+			if err != nil {
+				return err
+			}
+		} else {
+			err = settings.SetBaseCurrency(currency)
+			if err != nil {
+				return err
+			}
+		}
+
+		return uc.accountSettingsRepo.Upsert(ctx, settings)
+	})
+	if err != nil {
+		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return nil
+}
+
+func (uc *UsecaseImpl) UpsertExchangeRates(
+	ctx context.Context,
+	accountID uuid.UUID,
+	in []usecase.UpsertExchangeRateDataInput,
+) ([]*entity.ExchangeRate, error) {
+	const op = "UpsertExchangeRates"
+
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != accountID {
+			return nil, appErrors.ErrForbidden
+		}
+	}
+
+	items := make([]*entity.ExchangeRate, 0, len(in))
+	uniq := make(map[string]struct{}, len(in))
+
+	for idx, inItem := range in {
+		item, err := entity.NewExchangeRate(
+			accountID,
+			inItem.CurrencyFrom,
+			inItem.CurrencyTo,
+			inItem.RateDate,
+			inItem.Rate,
+		)
+		if err != nil {
+			appErr, ok := appErrors.ExtractError(err)
+			if ok {
+				hints := appErr.Hints()
+				hints = append(hints, fmt.Sprintf("item %d", idx+1))
+
+				return nil, appErrors.Chainf(appErr.WithHints(hints...), "%s.%s: item %d", uc.pkg, op, idx+1)
+			}
+			return nil, appErrors.Chainf(err, "%s.%s: item %d", uc.pkg, op, idx+1)
+		}
+
+		// в одном INSERT ... ON CONFLICT строка не может обновляться дважды
+		key := fmt.Sprintf("%s/%s/%s", item.CurrencyFrom, item.CurrencyTo, item.RateDate)
+		if _, ok := uniq[key]; ok {
+			return nil, appErrors.Chainf(
+				appErrors.ErrBadRequest.WithHints("duplicate exchange rate", fmt.Sprintf("item %d", idx+1)),
+				"%s.%s: item %d", uc.pkg, op, idx+1,
+			)
+		}
+		uniq[key] = struct{}{}
+
+		items = append(items, item)
+	}
+
+	err := uc.exchangeRateRepo.Upsert(ctx, items)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	// отчеты аккаунта пересчитываются по его курсам
+	err = uc.cacheGenerationRepo.BumpGenerations(context.WithoutCancel(ctx), usecase.CacheScopeReports(accountID))
+	if err != nil {
+		uc.logger.ErrorContext(loghandler.WithSource(ctx), "redis bump cache generation err", slog.Any("error", err))
+	}
+
+	return items, nil
+}
@@ -0,0 +1,161 @@
+package currency
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencyUsecase_SetBaseCurrency_Table(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+
+	tests := []struct {
+		name        string
+		currency    string
+		settings    *entity.AccountSettings
+		settingsErr error
+
+		wantErr bool
+	}{
+		{
+			name:        "OK_new_settings",
+			currency:    "usd",
+			settingsErr: appErrors.ErrNotFound,
+		},
+		{
+			name:     "OK_existing_settings",
+			currency: "USD",
+			settings: &entity.AccountSettings{AccountID: accountID, BaseCurrency: "RUB"},
+		},
+		{
+			name:        "Negative_invalid_currency",
+			currency:    "US",
+			settingsErr: appErrors.ErrNotFound,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.accountSettingsRepo.FindOneByAccountIDMock.Set(func(ctx context.Context, id uuid.UUID, params *uctypes.QueryGetOneParams) (*entity.AccountSettings, error) {
+				require.Equal(t, accountID, id)
+				require.True(t, params.ForUpdate)
+
+				return tt.settings, tt.settingsErr
+			})
+
+			if !tt.wantErr {
+				s.accountSettingsRepo.UpsertMock.Set(func(ctx context.Context, item *entity.AccountSettings) error {
+					require.Equal(t, accountID, item.AccountID)
+					require.Equal(t, "USD", item.BaseCurrency)
+
+					return nil
+				})
+			}
+
+			err := s.uc.SetBaseCurrency(testCtx(), accountID, tt.currency)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCurrencyUsecase_UpsertExchangeRates_Table(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+	rateDate := civil.Date{Year: 2025, Month: 12, Day: 1}
+
+	tests := []struct {
+		name string
+		in   []usecase.UpsertExchangeRateDataInput
+
+		wantErr bool
+	}{
+		{
+			name: "OK",
+			in: []usecase.UpsertExchangeRateDataInput{
+				{CurrencyFrom: "usd", CurrencyTo: "rub", RateDate: rateDate, Rate: decimal.MustParse("90.5")},
+				{CurrencyFrom: "EUR", CurrencyTo: "RUB", RateDate: rateDate, Rate: decimal.MustParse("100")},
+			},
+		},
+		{
+			name: "Negative_duplicate",
+			in: []usecase.UpsertExchangeRateDataInput{
+				{CurrencyFrom: "USD", CurrencyTo: "RUB", RateDate: rateDate, Rate: decimal.MustParse("90.5")},
+				{CurrencyFrom: "usd", CurrencyTo: "rub", RateDate: rateDate, Rate: decimal.MustParse("91")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Negative_same_currencies",
+			in: []usecase.UpsertExchangeRateDataInput{
+				{CurrencyFrom: "USD", CurrencyTo: "USD", RateDate: rateDate, Rate: decimal.MustParse("1")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Negative_zero_rate",
+			in: []usecase.UpsertExchangeRateDataInput{
+				{CurrencyFrom: "USD", CurrencyTo: "RUB", RateDate: rateDate, Rate: decimal.Zero},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			if !tt.wantErr {
+				s.exchangeRateRepo.UpsertMock.Set(func(ctx context.Context, items []*entity.ExchangeRate) error {
+					require.Len(t, items, len(tt.in))
+					require.Equal(t, "USD", items[0].CurrencyFrom)
+					require.Equal(t, "RUB", items[0].CurrencyTo)
+
+					return nil
+				})
+				s.cacheGenerationRepo.BumpGenerationsMock.Set(func(ctx context.Context, scopes ...string) error {
+					require.Equal(t, []string{usecase.CacheScopeReports(accountID)}, scopes)
+
+					return nil
+				})
+			}
+
+			got, err := s.uc.UpsertExchangeRates(testCtx(), accountID, tt.in)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, got, len(tt.in))
+		})
+	}
+}
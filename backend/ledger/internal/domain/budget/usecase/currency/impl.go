@@ -0,0 +1,39 @@
+package currency
+
+import (
+	"log/slog"
+
+	"github.com/m11ano/budget_planner/backend/ledger/internal/app/config"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/db"
+)
+
+type UsecaseImpl struct {
+	pkg                 string
+	logger              *slog.Logger
+	cfg                 config.Config
+	dbMasterClient      db.MasterClient
+	accountSettingsRepo usecase.AccountSettingsRepository
+	exchangeRateRepo    usecase.ExchangeRateRepository
+	cacheGenerationRepo usecase.CacheGenerationRepository
+}
+
+func NewUsecaseImpl(
+	logger *slog.Logger,
+	cfg config.Config,
+	dbMasterClient db.MasterClient,
+	accountSettingsRepo usecase.AccountSettingsRepository,
+	exchangeRateRepo usecase.ExchangeRateRepository,
+	cacheGenerationRepo usecase.CacheGenerationRepository,
+) *UsecaseImpl {
+	uc := &UsecaseImpl{
+		pkg:                 "Budget.Usecase.Currency",
+		logger:              logger,
+		cfg:                 cfg,
+		dbMasterClient:      dbMasterClient,
+		accountSettingsRepo: accountSettingsRepo,
+		exchangeRateRepo:    exchangeRateRepo,
+		cacheGenerationRepo: cacheGenerationRepo,
+	}
+	return uc
+}
@@ -0,0 +1,49 @@
+package currency
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+func (uc *UsecaseImpl) GetBaseCurrency(ctx context.Context, accountID uuid.UUID) (string, error) {
+	const op = "GetBaseCurrency"
+
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != accountID {
+			return "", appErrors.ErrForbidden
+		}
+	}
+
+	settings, err := uc.accountSettingsRepo.FindOneByAccountID(ctx, accountID, nil)
+	if err != nil {
+		if errors.Is(err, appErrors.ErrNotFound) {
+			return uc.cfg.Budget.DefaultCurrency, nil
+		}
+		return "", appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return settings.BaseCurrency, nil
+}
+
+func (uc *UsecaseImpl) FindExchangeRates(
+	ctx context.Context,
+	listOptions *usecase.ExchangeRateListOptions,
+	queryParams *uctypes.QueryGetListParams,
+) ([]*entity.ExchangeRate, error) {
+	const op = "FindExchangeRates"
+
+	items, err := uc.exchangeRateRepo.FindList(ctx, listOptions, queryParams)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,63 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencyUsecase_GetBaseCurrency_Table(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+
+	tests := []struct {
+		name        string
+		settings    *entity.AccountSettings
+		settingsErr error
+
+		want    string
+		wantErr bool
+	}{
+		{
+			name:     "OK_from_settings",
+			settings: &entity.AccountSettings{AccountID: accountID, BaseCurrency: "USD"},
+			want:     "USD",
+		},
+		{
+			name:        "OK_default",
+			settingsErr: appErrors.ErrNotFound,
+			want:        "RUB",
+		},
+		{
+			name:        "Negative_repo_error",
+			settingsErr: appErrors.ErrInternal,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.accountSettingsRepo.FindOneByAccountIDMock.Return(tt.settings, tt.settingsErr)
+
+			got, err := s.uc.GetBaseCurrency(testCtx(), accountID)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package currency
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/app/config"
+	usecasemocks "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase/mocks"
+	"github.com/m11ano/budget_planner/backend/ledger/pkg/pgclient"
+)
+
+type dependencies struct {
+	mc *minimock.Controller
+
+	uc *UsecaseImpl
+
+	logger *slog.Logger
+	cfg    config.Config
+
+	dbMasterClient      any
+	accountSettingsRepo *usecasemocks.AccountSettingsRepositoryMock
+	exchangeRateRepo    *usecasemocks.ExchangeRateRepositoryMock
+	cacheGenerationRepo *usecasemocks.CacheGenerationRepositoryMock
+}
+
+func newDependencies(t *testing.T) *dependencies {
+	t.Helper()
+
+	mc := minimock.NewController(t)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+
+	cfg := config.Config{}
+	cfg.Budget.DefaultCurrency = "RUB"
+
+	accountSettingsRepo := usecasemocks.NewAccountSettingsRepositoryMock(mc)
+	exchangeRateRepo := usecasemocks.NewExchangeRateRepositoryMock(mc)
+	cacheGenerationRepo := usecasemocks.NewCacheGenerationRepositoryMock(mc)
+
+	dbMasterClient := pgclient.NewMock()
+
+	uc := NewUsecaseImpl(
+		logger,
+		cfg,
+		dbMasterClient,
+		accountSettingsRepo,
+		exchangeRateRepo,
+		cacheGenerationRepo,
+	)
+
+	return &dependencies{
+		mc:                  mc,
+		uc:                  uc,
+		logger:              logger,
+		cfg:                 cfg,
+		dbMasterClient:      dbMasterClient,
+		accountSettingsRepo: accountSettingsRepo,
+		exchangeRateRepo:    exchangeRateRepo,
+		cacheGenerationRepo: cacheGenerationRepo,
+	}
+}
+
+func finishDependencies(s *dependencies) {
+	s.mc.Finish()
+}
+
+func testCtx() context.Context {
+	return context.Background()
+}
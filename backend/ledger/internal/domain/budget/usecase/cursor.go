@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+)
+
+var ErrCursorInvalid = appErrors.ErrBadRequest.Extend("cursor is invalid").
+	WithTextCode("CURSOR_INVALID").WithHints("cursor is invalid")
+
+// encodeCursor - непрозрачный токен курсора: JSON в base64 без дополнения, безопасный для URL
+func encodeCursor(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		// курсоры состоят из дат, времени и uuid, ошибка сериализации невозможна
+		panic(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(token string, value any) error {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return ErrCursorInvalid.WithParent(err)
+	}
+
+	err = json.Unmarshal(data, value)
+	if err != nil {
+		return ErrCursorInvalid.WithParent(err)
+	}
+
+	return nil
+}
@@ -1,19 +1,34 @@
 package usecase
 
 type Facade struct {
-	Category    CategoryUsecase
-	Transaction TransactionUsecase
-	Budget      BudgetUsecase
+	Category      CategoryUsecase
+	Transaction   TransactionUsecase
+	Budget        BudgetUsecase
+	Wallet        WalletUsecase
+	Currency      CurrencyUsecase
+	RecurringRule RecurringRuleUsecase
+	ImportProfile ImportProfileUsecase
+	CategoryRule  CategoryRuleUsecase
 }
 
 func NewFacade(
 	category CategoryUsecase,
 	transactionUC TransactionUsecase,
 	budgetUC BudgetUsecase,
+	walletUC WalletUsecase,
+	currencyUC CurrencyUsecase,
+	recurringRuleUC RecurringRuleUsecase,
+	importProfileUC ImportProfileUsecase,
+	categoryRuleUC CategoryRuleUsecase,
 ) *Facade {
 	return &Facade{
-		Category:    category,
-		Transaction: transactionUC,
-		Budget:      budgetUC,
+		Category:      category,
+		Transaction:   transactionUC,
+		Budget:        budgetUC,
+		Wallet:        walletUC,
+		Currency:      currencyUC,
+		RecurringRule: recurringRuleUC,
+		ImportProfile: importProfileUC,
+		CategoryRule:  categoryRuleUC,
 	}
 }
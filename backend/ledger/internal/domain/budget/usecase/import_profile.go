@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+)
+
+type ImportProfileListOptions struct {
+	FilterIDs       *[]uuid.UUID
+	FilterAccountID *uuid.UUID
+	Sort            []uctypes.SortOption[ImportProfileListOptionsSortField]
+}
+
+type ImportProfileListOptionsSortField string
+
+const (
+	ImportProfileListOptionsSortFieldName      ImportProfileListOptionsSortField = "name"
+	ImportProfileListOptionsSortFieldCreatedAt ImportProfileListOptionsSortField = "created_at"
+)
+
+type ImportProfileDTO struct {
+	ImportProfile *entity.ImportProfile
+}
+
+type CreateImportProfileDataInput struct {
+	AccountID uuid.UUID
+	entity.NewImportProfileInput
+}
+
+type PatchImportProfileDataInput struct {
+	Version int64
+
+	Name             *string
+	Delimiter        *string
+	DecimalSeparator *string
+	DateLayout       *string
+	SkipRows         *int
+	Encoding         *entity.ImportEncoding
+	// Mapping - колонки, знак, признак дохода, заголовок и категория по умолчанию заменяются целиком
+	Mapping *ImportProfileMappingInput
+}
+
+type ImportProfileMappingInput struct {
+	HasHeader         bool
+	SignConvention    entity.ImportSignConvention
+	IncomeValues      []string
+	Columns           entity.ImportColumns
+	DefaultCategoryID *uint64
+}
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.ImportProfileUsecase -o mocks/import_profile_usecase.go
+type ImportProfileUsecase interface {
+	FindOneByID(
+		ctx context.Context,
+		id uuid.UUID,
+		queryParams *uctypes.QueryGetOneParams,
+	) (resItem *ImportProfileDTO, resErr error)
+
+	FindList(
+		ctx context.Context,
+		listOptions *ImportProfileListOptions,
+		queryParams *uctypes.QueryGetListParams,
+	) (resItems []*ImportProfileDTO, resErr error)
+
+	CreateImportProfileByDTO(
+		ctx context.Context,
+		in CreateImportProfileDataInput,
+	) (resImportProfileDTO *ImportProfileDTO, resErr error)
+
+	PatchImportProfileByDTO(
+		ctx context.Context,
+		id uuid.UUID,
+		in PatchImportProfileDataInput,
+		skipVersionCheck bool,
+	) (resErr error)
+
+	DeleteImportProfileByID(
+		ctx context.Context,
+		id uuid.UUID,
+	) (resErr error)
+}
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.ImportProfileRepository -o mocks/import_profile_repository.go
+type ImportProfileRepository interface {
+	FindOneByID(
+		ctx context.Context,
+		id uuid.UUID,
+		queryParams *uctypes.QueryGetOneParams,
+	) (importProfile *entity.ImportProfile, err error)
+
+	FindList(
+		ctx context.Context,
+		listOptions *ImportProfileListOptions,
+		queryParams *uctypes.QueryGetListParams,
+	) (items []*entity.ImportProfile, err error)
+
+	Create(ctx context.Context, item *entity.ImportProfile) (err error)
+
+	Update(ctx context.Context, item *entity.ImportProfile) (err error)
+}
@@ -0,0 +1,189 @@
+package importprofile
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/loghandler"
+	"github.com/samber/lo"
+)
+
+func (uc *UsecaseImpl) CreateImportProfileByDTO(
+	ctx context.Context,
+	in usecase.CreateImportProfileDataInput,
+) (*usecase.ImportProfileDTO, error) {
+	const op = "CreateImportProfileByDTO"
+
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != in.AccountID {
+			return nil, appErrors.ErrForbidden
+		}
+	}
+
+	importProfile, err := entity.NewImportProfile(in.AccountID, in.NewImportProfileInput)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	err = uc.checkDefaultCategory(ctx, importProfile.DefaultCategoryID, importProfile.AccountID)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	err = uc.importProfileRepo.Create(ctx, importProfile)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	importProfileDTO, err := uc.entitiesToDTO(ctx, []*entity.ImportProfile{importProfile})
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	if len(importProfileDTO) == 0 {
+		uc.logger.ErrorContext(loghandler.WithSource(ctx), "unpredicted empty import profile dto")
+		return nil, appErrors.Chainf(appErrors.ErrInternal, "%s.%s", uc.pkg, op)
+	}
+
+	return importProfileDTO[0], nil
+}
+
+func (uc *UsecaseImpl) PatchImportProfileByDTO(
+	ctx context.Context,
+	id uuid.UUID,
+	in usecase.PatchImportProfileDataInput,
+	skipVersionCheck bool,
+) error {
+	const op = "PatchImportProfileByDTO"
+
+	err := uc.dbMasterClient.Do(ctx, func(ctx context.Context) error {
+		importProfile, err := uc.importProfileRepo.FindOneByID(ctx, id, &uctypes.QueryGetOneParams{
+			ForUpdate: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = uc.checkImportProfileOwner(ctx, importProfile)
+		if err != nil {
+			return err
+		}
+
+		if !skipVersionCheck && importProfile.Version() != in.Version {
+			return appErrors.ErrVersionConflict.
+				WithDetail("last_version", false, importProfile.Version()).
+				WithDetail("last_updated_at", false, importProfile.UpdatedAt)
+		}
+
+		if in.Name != nil {
+			err = importProfile.SetName(*in.Name)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.Delimiter != nil {
+			err = importProfile.SetDelimiter(*in.Delimiter)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.DecimalSeparator != nil {
+			err = importProfile.SetDecimalSeparator(*in.DecimalSeparator)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.DateLayout != nil {
+			err = importProfile.SetDateLayout(*in.DateLayout)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.SkipRows != nil {
+			err = importProfile.SetSkipRows(*in.SkipRows)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.Encoding != nil {
+			err = importProfile.SetEncoding(*in.Encoding)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.Mapping != nil {
+			err = importProfile.SetMapping(
+				in.Mapping.SignConvention,
+				in.Mapping.Columns,
+				in.Mapping.IncomeValues,
+				in.Mapping.HasHeader,
+				in.Mapping.DefaultCategoryID,
+			)
+			if err != nil {
+				return err
+			}
+
+			err = uc.checkDefaultCategory(ctx, importProfile.DefaultCategoryID, importProfile.AccountID)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = uc.importProfileRepo.Update(ctx, importProfile)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return nil
+}
+
+func (uc *UsecaseImpl) DeleteImportProfileByID(ctx context.Context, id uuid.UUID) error {
+	const op = "DeleteImportProfileByID"
+
+	err := uc.dbMasterClient.Do(ctx, func(ctx context.Context) error {
+		importProfile, err := uc.importProfileRepo.FindOneByID(ctx, id, &uctypes.QueryGetOneParams{
+			ForUpdate: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = uc.checkImportProfileOwner(ctx, importProfile)
+		if err != nil {
+			return err
+		}
+
+		importProfile.DeletedAt = lo.ToPtr(time.Now())
+
+		err = uc.importProfileRepo.Update(ctx, importProfile)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return nil
+}
@@ -0,0 +1,242 @@
+package importprofile
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportProfileUsecase_CreateImportProfileByDTO_Table(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+	categoryID := uint64(7)
+
+	validInput := func() entity.NewImportProfileInput {
+		return entity.NewImportProfileInput{
+			Name:             " Sber ",
+			Delimiter:        ";",
+			DecimalSeparator: ",",
+			DateLayout:       "DD.MM.YYYY",
+			HasHeader:        true,
+			SignConvention:   entity.ImportSignConventionSigned,
+			Encoding:         "Windows-1251",
+			Columns: entity.ImportColumns{
+				Date:        "Дата операции",
+				Amount:      "Сумма",
+				Description: "Описание",
+			},
+			DefaultCategoryID: &categoryID,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(in *entity.NewImportProfileInput)
+
+		categoryAccountID *uuid.UUID
+		wantErr           bool
+	}{
+		{
+			name:   "OK",
+			modify: func(in *entity.NewImportProfileInput) {},
+		},
+		{
+			name: "OK_split_columns_by_index",
+			modify: func(in *entity.NewImportProfileInput) {
+				in.HasHeader = false
+				in.SignConvention = entity.ImportSignConventionSplit
+				in.Columns = entity.ImportColumns{Date: "1", Debit: "3", Credit: "4"}
+			},
+		},
+		{
+			name: "Negative_unsupported_encoding",
+			modify: func(in *entity.NewImportProfileInput) {
+				in.Encoding = "utf-16"
+			},
+			wantErr: true,
+		},
+		{
+			name: "Negative_date_layout_without_day",
+			modify: func(in *entity.NewImportProfileInput) {
+				in.DateLayout = "MM.YYYY"
+			},
+			wantErr: true,
+		},
+		{
+			name: "Negative_split_without_credit",
+			modify: func(in *entity.NewImportProfileInput) {
+				in.SignConvention = entity.ImportSignConventionSplit
+				in.Columns = entity.ImportColumns{Date: "Дата", Debit: "Расход"}
+			},
+			wantErr: true,
+		},
+		{
+			name: "Negative_named_columns_without_header",
+			modify: func(in *entity.NewImportProfileInput) {
+				in.HasHeader = false
+			},
+			wantErr: true,
+		},
+		{
+			name: "OK_no_category_resolved_by_rules",
+			modify: func(in *entity.NewImportProfileInput) {
+				in.DefaultCategoryID = nil
+			},
+		},
+		{
+			name:              "Negative_foreign_default_category",
+			modify:            func(in *entity.NewImportProfileInput) {},
+			categoryAccountID: lo.ToPtr(uuid.New()),
+			wantErr:           true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			in := validInput()
+			tt.modify(&in)
+
+			categoryAccountID := lo.FromPtrOr(tt.categoryAccountID, accountID)
+			s.categoryRepo.FindOneByIDMock.Optional().Return(&entity.Category{
+				ID:        categoryID,
+				AccountID: &categoryAccountID,
+			}, nil)
+
+			if !tt.wantErr {
+				s.importProfileRepo.CreateMock.Set(func(ctx context.Context, item *entity.ImportProfile) error {
+					require.Equal(t, accountID, item.AccountID)
+					require.Equal(t, "Sber", item.Name)
+					require.Equal(t, entity.ImportEncodingWindows1251, item.Encoding)
+					require.Equal(t, "02.01.2006", item.GoDateLayout())
+
+					return nil
+				})
+			}
+
+			got, err := s.uc.CreateImportProfileByDTO(testCtx(), usecase.CreateImportProfileDataInput{
+				AccountID:             accountID,
+				NewImportProfileInput: in,
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			require.NotNil(t, got.ImportProfile)
+		})
+	}
+}
+
+func TestImportProfileUsecase_PatchImportProfileByDTO_OK(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	id := uuid.New()
+	categoryID := uint64(3)
+
+	now := time.Now().Truncate(time.Microsecond)
+	item := &entity.ImportProfile{
+		ID:               id,
+		AccountID:        uuid.New(),
+		Name:             "Bank",
+		Delimiter:        ",",
+		DecimalSeparator: ".",
+		DateLayout:       "YYYY-MM-DD",
+		SignConvention:   entity.ImportSignConventionSigned,
+		Encoding:         entity.ImportEncodingUTF8,
+		Columns:          entity.ImportColumns{Date: "1", Amount: "2", CategoryID: "3"},
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+
+	s.importProfileRepo.FindOneByIDMock.Set(func(ctx context.Context, gotID uuid.UUID, qp *uctypes.QueryGetOneParams) (*entity.ImportProfile, error) {
+		require.Equal(t, id, gotID)
+		require.NotNil(t, qp)
+		require.True(t, qp.ForUpdate)
+		return item, nil
+	})
+
+	s.categoryRepo.FindOneByIDMock.Return(&entity.Category{ID: categoryID}, nil)
+
+	s.importProfileRepo.UpdateMock.Set(func(ctx context.Context, got *entity.ImportProfile) error {
+		require.Equal(t, "\t", got.Delimiter)
+		require.Equal(t, entity.ImportSignConventionFlag, got.SignConvention)
+		require.Equal(t, []string{"income", "доход"}, got.IncomeValues)
+		require.Equal(t, &categoryID, got.DefaultCategoryID)
+		return nil
+	})
+
+	err := s.uc.PatchImportProfileByDTO(testCtx(), id, usecase.PatchImportProfileDataInput{
+		Version:   item.Version(),
+		Delimiter: lo.ToPtr(`\t`),
+		Mapping: &usecase.ImportProfileMappingInput{
+			HasHeader:         true,
+			SignConvention:    entity.ImportSignConventionFlag,
+			IncomeValues:      []string{" Income ", "Доход"},
+			Columns:           entity.ImportColumns{Date: "Date", Amount: "Amount", IsIncome: "Type"},
+			DefaultCategoryID: &categoryID,
+		},
+	}, false)
+	require.NoError(t, err)
+}
+
+func TestImportProfileUsecase_PatchImportProfileByDTO_VersionConflict(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	id := uuid.New()
+
+	item := &entity.ImportProfile{
+		ID:        id,
+		AccountID: uuid.New(),
+		UpdatedAt: time.Now().Truncate(time.Microsecond),
+	}
+
+	s.importProfileRepo.FindOneByIDMock.Return(item, nil)
+
+	err := s.uc.PatchImportProfileByDTO(testCtx(), id, usecase.PatchImportProfileDataInput{
+		Version: item.Version() - 1,
+	}, false)
+	require.Error(t, err)
+}
+
+func TestImportProfileUsecase_DeleteImportProfileByID_OK(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	id := uuid.New()
+
+	s.importProfileRepo.FindOneByIDMock.Return(&entity.ImportProfile{ID: id, AccountID: uuid.New()}, nil)
+	s.importProfileRepo.UpdateMock.Set(func(ctx context.Context, item *entity.ImportProfile) error {
+		require.Equal(t, id, item.ID)
+		require.NotNil(t, item.DeletedAt)
+		return nil
+	})
+
+	err := s.uc.DeleteImportProfileByID(testCtx(), id)
+	require.NoError(t, err)
+}
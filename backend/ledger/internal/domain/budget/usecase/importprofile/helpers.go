@@ -0,0 +1,59 @@
+package importprofile
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+func (uc *UsecaseImpl) entitiesToDTO(
+	_ context.Context,
+	items []*entity.ImportProfile,
+) ([]*usecase.ImportProfileDTO, error) {
+	out := make([]*usecase.ImportProfileDTO, 0, len(items))
+
+	for _, item := range items {
+		out = append(out, &usecase.ImportProfileDTO{
+			ImportProfile: item,
+		})
+	}
+
+	return out, nil
+}
+
+func (uc *UsecaseImpl) checkImportProfileOwner(ctx context.Context, importProfile *entity.ImportProfile) error {
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != importProfile.AccountID {
+			return appErrors.ErrForbidden
+		}
+	}
+
+	return nil
+}
+
+// checkDefaultCategory - категория по умолчанию должна быть доступна аккаунту
+func (uc *UsecaseImpl) checkDefaultCategory(ctx context.Context, categoryID *uint64, accountID uuid.UUID) error {
+	if categoryID == nil {
+		return nil
+	}
+
+	category, err := uc.categoryRepo.FindOneByID(ctx, *categoryID, nil)
+	if err != nil {
+		if errors.Is(err, appErrors.ErrNotFound) {
+			return appErrors.ErrBadRequest.WithHints("default category not found")
+		}
+		return err
+	}
+
+	if !category.IsAvailableForAccount(accountID) {
+		return appErrors.ErrBadRequest.WithHints("default category not found")
+	}
+
+	return nil
+}
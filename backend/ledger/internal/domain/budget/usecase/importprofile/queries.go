@@ -0,0 +1,60 @@
+package importprofile
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+func (uc *UsecaseImpl) FindOneByID(
+	ctx context.Context,
+	id uuid.UUID,
+	queryParams *uctypes.QueryGetOneParams,
+) (*usecase.ImportProfileDTO, error) {
+	const op = "FindOneByID"
+
+	item, err := uc.importProfileRepo.FindOneByID(ctx, id, queryParams)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	err = uc.checkImportProfileOwner(ctx, item)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	dto, err := uc.entitiesToDTO(ctx, []*entity.ImportProfile{item})
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	if len(dto) == 0 {
+		return nil, appErrors.Chainf(appErrors.ErrInternal, "%s.%s", uc.pkg, op)
+	}
+
+	return dto[0], nil
+}
+
+func (uc *UsecaseImpl) FindList(
+	ctx context.Context,
+	listOptions *usecase.ImportProfileListOptions,
+	queryParams *uctypes.QueryGetListParams,
+) ([]*usecase.ImportProfileDTO, error) {
+	const op = "FindList"
+
+	items, err := uc.importProfileRepo.FindList(ctx, listOptions, queryParams)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	out, err := uc.entitiesToDTO(ctx, items)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,1194 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.AccountSettingsRepository -o account_settings_repository.go -n AccountSettingsRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	mm_usecase "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// AccountSettingsRepositoryMock implements mm_usecase.AccountSettingsRepository
+type AccountSettingsRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcFindList          func(ctx context.Context, listOptions *mm_usecase.AccountSettingsListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.AccountSettings, err error)
+	funcFindListOrigin    string
+	inspectFuncFindList   func(ctx context.Context, listOptions *mm_usecase.AccountSettingsListOptions, queryParams *uctypes.QueryGetListParams)
+	afterFindListCounter  uint64
+	beforeFindListCounter uint64
+	FindListMock          mAccountSettingsRepositoryMockFindList
+
+	funcFindOneByAccountID          func(ctx context.Context, accountID uuid.UUID, queryParams *uctypes.QueryGetOneParams) (item *entity.AccountSettings, err error)
+	funcFindOneByAccountIDOrigin    string
+	inspectFuncFindOneByAccountID   func(ctx context.Context, accountID uuid.UUID, queryParams *uctypes.QueryGetOneParams)
+	afterFindOneByAccountIDCounter  uint64
+	beforeFindOneByAccountIDCounter uint64
+	FindOneByAccountIDMock          mAccountSettingsRepositoryMockFindOneByAccountID
+
+	funcUpsert          func(ctx context.Context, item *entity.AccountSettings) (err error)
+	funcUpsertOrigin    string
+	inspectFuncUpsert   func(ctx context.Context, item *entity.AccountSettings)
+	afterUpsertCounter  uint64
+	beforeUpsertCounter uint64
+	UpsertMock          mAccountSettingsRepositoryMockUpsert
+}
+
+// NewAccountSettingsRepositoryMock returns a mock for mm_usecase.AccountSettingsRepository
+func NewAccountSettingsRepositoryMock(t minimock.Tester) *AccountSettingsRepositoryMock {
+	m := &AccountSettingsRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.FindListMock = mAccountSettingsRepositoryMockFindList{mock: m}
+	m.FindListMock.callArgs = []*AccountSettingsRepositoryMockFindListParams{}
+
+	m.FindOneByAccountIDMock = mAccountSettingsRepositoryMockFindOneByAccountID{mock: m}
+	m.FindOneByAccountIDMock.callArgs = []*AccountSettingsRepositoryMockFindOneByAccountIDParams{}
+
+	m.UpsertMock = mAccountSettingsRepositoryMockUpsert{mock: m}
+	m.UpsertMock.callArgs = []*AccountSettingsRepositoryMockUpsertParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAccountSettingsRepositoryMockFindList struct {
+	optional           bool
+	mock               *AccountSettingsRepositoryMock
+	defaultExpectation *AccountSettingsRepositoryMockFindListExpectation
+	expectations       []*AccountSettingsRepositoryMockFindListExpectation
+
+	callArgs []*AccountSettingsRepositoryMockFindListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccountSettingsRepositoryMockFindListExpectation specifies expectation struct of the AccountSettingsRepository.FindList
+type AccountSettingsRepositoryMockFindListExpectation struct {
+	mock               *AccountSettingsRepositoryMock
+	params             *AccountSettingsRepositoryMockFindListParams
+	paramPtrs          *AccountSettingsRepositoryMockFindListParamPtrs
+	expectationOrigins AccountSettingsRepositoryMockFindListExpectationOrigins
+	results            *AccountSettingsRepositoryMockFindListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccountSettingsRepositoryMockFindListParams contains parameters of the AccountSettingsRepository.FindList
+type AccountSettingsRepositoryMockFindListParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.AccountSettingsListOptions
+	queryParams *uctypes.QueryGetListParams
+}
+
+// AccountSettingsRepositoryMockFindListParamPtrs contains pointers to parameters of the AccountSettingsRepository.FindList
+type AccountSettingsRepositoryMockFindListParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.AccountSettingsListOptions
+	queryParams **uctypes.QueryGetListParams
+}
+
+// AccountSettingsRepositoryMockFindListResults contains results of the AccountSettingsRepository.FindList
+type AccountSettingsRepositoryMockFindListResults struct {
+	items []*entity.AccountSettings
+	err   error
+}
+
+// AccountSettingsRepositoryMockFindListOrigins contains origins of expectations of the AccountSettingsRepository.FindList
+type AccountSettingsRepositoryMockFindListExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindList *mAccountSettingsRepositoryMockFindList) Optional() *mAccountSettingsRepositoryMockFindList {
+	mmFindList.optional = true
+	return mmFindList
+}
+
+// Expect sets up expected params for AccountSettingsRepository.FindList
+func (mmFindList *mAccountSettingsRepositoryMockFindList) Expect(ctx context.Context, listOptions *mm_usecase.AccountSettingsListOptions, queryParams *uctypes.QueryGetListParams) *mAccountSettingsRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("AccountSettingsRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &AccountSettingsRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs != nil {
+		mmFindList.mock.t.Fatalf("AccountSettingsRepositoryMock.FindList mock is already set by ExpectParams functions")
+	}
+
+	mmFindList.defaultExpectation.params = &AccountSettingsRepositoryMockFindListParams{ctx, listOptions, queryParams}
+	mmFindList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindList.expectations {
+		if minimock.Equal(e.params, mmFindList.defaultExpectation.params) {
+			mmFindList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindList.defaultExpectation.params)
+		}
+	}
+
+	return mmFindList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccountSettingsRepository.FindList
+func (mmFindList *mAccountSettingsRepositoryMockFindList) ExpectCtxParam1(ctx context.Context) *mAccountSettingsRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("AccountSettingsRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &AccountSettingsRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("AccountSettingsRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &AccountSettingsRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for AccountSettingsRepository.FindList
+func (mmFindList *mAccountSettingsRepositoryMockFindList) ExpectListOptionsParam2(listOptions *mm_usecase.AccountSettingsListOptions) *mAccountSettingsRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("AccountSettingsRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &AccountSettingsRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("AccountSettingsRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &AccountSettingsRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmFindList.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for AccountSettingsRepository.FindList
+func (mmFindList *mAccountSettingsRepositoryMockFindList) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mAccountSettingsRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("AccountSettingsRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &AccountSettingsRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("AccountSettingsRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &AccountSettingsRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindList.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccountSettingsRepository.FindList
+func (mmFindList *mAccountSettingsRepositoryMockFindList) Inspect(f func(ctx context.Context, listOptions *mm_usecase.AccountSettingsListOptions, queryParams *uctypes.QueryGetListParams)) *mAccountSettingsRepositoryMockFindList {
+	if mmFindList.mock.inspectFuncFindList != nil {
+		mmFindList.mock.t.Fatalf("Inspect function is already set for AccountSettingsRepositoryMock.FindList")
+	}
+
+	mmFindList.mock.inspectFuncFindList = f
+
+	return mmFindList
+}
+
+// Return sets up results that will be returned by AccountSettingsRepository.FindList
+func (mmFindList *mAccountSettingsRepositoryMockFindList) Return(items []*entity.AccountSettings, err error) *AccountSettingsRepositoryMock {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("AccountSettingsRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &AccountSettingsRepositoryMockFindListExpectation{mock: mmFindList.mock}
+	}
+	mmFindList.defaultExpectation.results = &AccountSettingsRepositoryMockFindListResults{items, err}
+	mmFindList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// Set uses given function f to mock the AccountSettingsRepository.FindList method
+func (mmFindList *mAccountSettingsRepositoryMockFindList) Set(f func(ctx context.Context, listOptions *mm_usecase.AccountSettingsListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.AccountSettings, err error)) *AccountSettingsRepositoryMock {
+	if mmFindList.defaultExpectation != nil {
+		mmFindList.mock.t.Fatalf("Default expectation is already set for the AccountSettingsRepository.FindList method")
+	}
+
+	if len(mmFindList.expectations) > 0 {
+		mmFindList.mock.t.Fatalf("Some expectations are already set for the AccountSettingsRepository.FindList method")
+	}
+
+	mmFindList.mock.funcFindList = f
+	mmFindList.mock.funcFindListOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// When sets expectation for the AccountSettingsRepository.FindList which will trigger the result defined by the following
+// Then helper
+func (mmFindList *mAccountSettingsRepositoryMockFindList) When(ctx context.Context, listOptions *mm_usecase.AccountSettingsListOptions, queryParams *uctypes.QueryGetListParams) *AccountSettingsRepositoryMockFindListExpectation {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("AccountSettingsRepositoryMock.FindList mock is already set by Set")
+	}
+
+	expectation := &AccountSettingsRepositoryMockFindListExpectation{
+		mock:               mmFindList.mock,
+		params:             &AccountSettingsRepositoryMockFindListParams{ctx, listOptions, queryParams},
+		expectationOrigins: AccountSettingsRepositoryMockFindListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindList.expectations = append(mmFindList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccountSettingsRepository.FindList return parameters for the expectation previously defined by the When method
+func (e *AccountSettingsRepositoryMockFindListExpectation) Then(items []*entity.AccountSettings, err error) *AccountSettingsRepositoryMock {
+	e.results = &AccountSettingsRepositoryMockFindListResults{items, err}
+	return e.mock
+}
+
+// Times sets number of times AccountSettingsRepository.FindList should be invoked
+func (mmFindList *mAccountSettingsRepositoryMockFindList) Times(n uint64) *mAccountSettingsRepositoryMockFindList {
+	if n == 0 {
+		mmFindList.mock.t.Fatalf("Times of AccountSettingsRepositoryMock.FindList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindList.expectedInvocations, n)
+	mmFindList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindList
+}
+
+func (mmFindList *mAccountSettingsRepositoryMockFindList) invocationsDone() bool {
+	if len(mmFindList.expectations) == 0 && mmFindList.defaultExpectation == nil && mmFindList.mock.funcFindList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindList.mock.afterFindListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindList implements mm_usecase.AccountSettingsRepository
+func (mmFindList *AccountSettingsRepositoryMock) FindList(ctx context.Context, listOptions *mm_usecase.AccountSettingsListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.AccountSettings, err error) {
+	mm_atomic.AddUint64(&mmFindList.beforeFindListCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindList.afterFindListCounter, 1)
+
+	mmFindList.t.Helper()
+
+	if mmFindList.inspectFuncFindList != nil {
+		mmFindList.inspectFuncFindList(ctx, listOptions, queryParams)
+	}
+
+	mm_params := AccountSettingsRepositoryMockFindListParams{ctx, listOptions, queryParams}
+
+	// Record call args
+	mmFindList.FindListMock.mutex.Lock()
+	mmFindList.FindListMock.callArgs = append(mmFindList.FindListMock.callArgs, &mm_params)
+	mmFindList.FindListMock.mutex.Unlock()
+
+	for _, e := range mmFindList.FindListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.items, e.results.err
+		}
+	}
+
+	if mmFindList.FindListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindList.FindListMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindList.FindListMock.defaultExpectation.params
+		mm_want_ptrs := mmFindList.FindListMock.defaultExpectation.paramPtrs
+
+		mm_got := AccountSettingsRepositoryMockFindListParams{ctx, listOptions, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindList.t.Errorf("AccountSettingsRepositoryMock.FindList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmFindList.t.Errorf("AccountSettingsRepositoryMock.FindList got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindList.t.Errorf("AccountSettingsRepositoryMock.FindList got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindList.t.Errorf("AccountSettingsRepositoryMock.FindList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindList.FindListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindList.FindListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindList.t.Fatal("No results are set for the AccountSettingsRepositoryMock.FindList")
+		}
+		return (*mm_results).items, (*mm_results).err
+	}
+	if mmFindList.funcFindList != nil {
+		return mmFindList.funcFindList(ctx, listOptions, queryParams)
+	}
+	mmFindList.t.Fatalf("Unexpected call to AccountSettingsRepositoryMock.FindList. %v %v %v", ctx, listOptions, queryParams)
+	return
+}
+
+// FindListAfterCounter returns a count of finished AccountSettingsRepositoryMock.FindList invocations
+func (mmFindList *AccountSettingsRepositoryMock) FindListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.afterFindListCounter)
+}
+
+// FindListBeforeCounter returns a count of AccountSettingsRepositoryMock.FindList invocations
+func (mmFindList *AccountSettingsRepositoryMock) FindListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.beforeFindListCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccountSettingsRepositoryMock.FindList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindList *mAccountSettingsRepositoryMockFindList) Calls() []*AccountSettingsRepositoryMockFindListParams {
+	mmFindList.mutex.RLock()
+
+	argCopy := make([]*AccountSettingsRepositoryMockFindListParams, len(mmFindList.callArgs))
+	copy(argCopy, mmFindList.callArgs)
+
+	mmFindList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindListDone returns true if the count of the FindList invocations corresponds
+// the number of defined expectations
+func (m *AccountSettingsRepositoryMock) MinimockFindListDone() bool {
+	if m.FindListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindListMock.invocationsDone()
+}
+
+// MinimockFindListInspect logs each unmet expectation
+func (m *AccountSettingsRepositoryMock) MinimockFindListInspect() {
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccountSettingsRepositoryMock.FindList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindListCounter := mm_atomic.LoadUint64(&m.afterFindListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindListMock.defaultExpectation != nil && afterFindListCounter < 1 {
+		if m.FindListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccountSettingsRepositoryMock.FindList at\n%s", m.FindListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccountSettingsRepositoryMock.FindList at\n%s with params: %#v", m.FindListMock.defaultExpectation.expectationOrigins.origin, *m.FindListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindList != nil && afterFindListCounter < 1 {
+		m.t.Errorf("Expected call to AccountSettingsRepositoryMock.FindList at\n%s", m.funcFindListOrigin)
+	}
+
+	if !m.FindListMock.invocationsDone() && afterFindListCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccountSettingsRepositoryMock.FindList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindListMock.expectedInvocations), m.FindListMock.expectedInvocationsOrigin, afterFindListCounter)
+	}
+}
+
+type mAccountSettingsRepositoryMockFindOneByAccountID struct {
+	optional           bool
+	mock               *AccountSettingsRepositoryMock
+	defaultExpectation *AccountSettingsRepositoryMockFindOneByAccountIDExpectation
+	expectations       []*AccountSettingsRepositoryMockFindOneByAccountIDExpectation
+
+	callArgs []*AccountSettingsRepositoryMockFindOneByAccountIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccountSettingsRepositoryMockFindOneByAccountIDExpectation specifies expectation struct of the AccountSettingsRepository.FindOneByAccountID
+type AccountSettingsRepositoryMockFindOneByAccountIDExpectation struct {
+	mock               *AccountSettingsRepositoryMock
+	params             *AccountSettingsRepositoryMockFindOneByAccountIDParams
+	paramPtrs          *AccountSettingsRepositoryMockFindOneByAccountIDParamPtrs
+	expectationOrigins AccountSettingsRepositoryMockFindOneByAccountIDExpectationOrigins
+	results            *AccountSettingsRepositoryMockFindOneByAccountIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccountSettingsRepositoryMockFindOneByAccountIDParams contains parameters of the AccountSettingsRepository.FindOneByAccountID
+type AccountSettingsRepositoryMockFindOneByAccountIDParams struct {
+	ctx         context.Context
+	accountID   uuid.UUID
+	queryParams *uctypes.QueryGetOneParams
+}
+
+// AccountSettingsRepositoryMockFindOneByAccountIDParamPtrs contains pointers to parameters of the AccountSettingsRepository.FindOneByAccountID
+type AccountSettingsRepositoryMockFindOneByAccountIDParamPtrs struct {
+	ctx         *context.Context
+	accountID   *uuid.UUID
+	queryParams **uctypes.QueryGetOneParams
+}
+
+// AccountSettingsRepositoryMockFindOneByAccountIDResults contains results of the AccountSettingsRepository.FindOneByAccountID
+type AccountSettingsRepositoryMockFindOneByAccountIDResults struct {
+	item *entity.AccountSettings
+	err  error
+}
+
+// AccountSettingsRepositoryMockFindOneByAccountIDOrigins contains origins of expectations of the AccountSettingsRepository.FindOneByAccountID
+type AccountSettingsRepositoryMockFindOneByAccountIDExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccountID   string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindOneByAccountID *mAccountSettingsRepositoryMockFindOneByAccountID) Optional() *mAccountSettingsRepositoryMockFindOneByAccountID {
+	mmFindOneByAccountID.optional = true
+	return mmFindOneByAccountID
+}
+
+// Expect sets up expected params for AccountSettingsRepository.FindOneByAccountID
+func (mmFindOneByAccountID *mAccountSettingsRepositoryMockFindOneByAccountID) Expect(ctx context.Context, accountID uuid.UUID, queryParams *uctypes.QueryGetOneParams) *mAccountSettingsRepositoryMockFindOneByAccountID {
+	if mmFindOneByAccountID.mock.funcFindOneByAccountID != nil {
+		mmFindOneByAccountID.mock.t.Fatalf("AccountSettingsRepositoryMock.FindOneByAccountID mock is already set by Set")
+	}
+
+	if mmFindOneByAccountID.defaultExpectation == nil {
+		mmFindOneByAccountID.defaultExpectation = &AccountSettingsRepositoryMockFindOneByAccountIDExpectation{}
+	}
+
+	if mmFindOneByAccountID.defaultExpectation.paramPtrs != nil {
+		mmFindOneByAccountID.mock.t.Fatalf("AccountSettingsRepositoryMock.FindOneByAccountID mock is already set by ExpectParams functions")
+	}
+
+	mmFindOneByAccountID.defaultExpectation.params = &AccountSettingsRepositoryMockFindOneByAccountIDParams{ctx, accountID, queryParams}
+	mmFindOneByAccountID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindOneByAccountID.expectations {
+		if minimock.Equal(e.params, mmFindOneByAccountID.defaultExpectation.params) {
+			mmFindOneByAccountID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindOneByAccountID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindOneByAccountID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccountSettingsRepository.FindOneByAccountID
+func (mmFindOneByAccountID *mAccountSettingsRepositoryMockFindOneByAccountID) ExpectCtxParam1(ctx context.Context) *mAccountSettingsRepositoryMockFindOneByAccountID {
+	if mmFindOneByAccountID.mock.funcFindOneByAccountID != nil {
+		mmFindOneByAccountID.mock.t.Fatalf("AccountSettingsRepositoryMock.FindOneByAccountID mock is already set by Set")
+	}
+
+	if mmFindOneByAccountID.defaultExpectation == nil {
+		mmFindOneByAccountID.defaultExpectation = &AccountSettingsRepositoryMockFindOneByAccountIDExpectation{}
+	}
+
+	if mmFindOneByAccountID.defaultExpectation.params != nil {
+		mmFindOneByAccountID.mock.t.Fatalf("AccountSettingsRepositoryMock.FindOneByAccountID mock is already set by Expect")
+	}
+
+	if mmFindOneByAccountID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByAccountID.defaultExpectation.paramPtrs = &AccountSettingsRepositoryMockFindOneByAccountIDParamPtrs{}
+	}
+	mmFindOneByAccountID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindOneByAccountID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindOneByAccountID
+}
+
+// ExpectAccountIDParam2 sets up expected param accountID for AccountSettingsRepository.FindOneByAccountID
+func (mmFindOneByAccountID *mAccountSettingsRepositoryMockFindOneByAccountID) ExpectAccountIDParam2(accountID uuid.UUID) *mAccountSettingsRepositoryMockFindOneByAccountID {
+	if mmFindOneByAccountID.mock.funcFindOneByAccountID != nil {
+		mmFindOneByAccountID.mock.t.Fatalf("AccountSettingsRepositoryMock.FindOneByAccountID mock is already set by Set")
+	}
+
+	if mmFindOneByAccountID.defaultExpectation == nil {
+		mmFindOneByAccountID.defaultExpectation = &AccountSettingsRepositoryMockFindOneByAccountIDExpectation{}
+	}
+
+	if mmFindOneByAccountID.defaultExpectation.params != nil {
+		mmFindOneByAccountID.mock.t.Fatalf("AccountSettingsRepositoryMock.FindOneByAccountID mock is already set by Expect")
+	}
+
+	if mmFindOneByAccountID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByAccountID.defaultExpectation.paramPtrs = &AccountSettingsRepositoryMockFindOneByAccountIDParamPtrs{}
+	}
+	mmFindOneByAccountID.defaultExpectation.paramPtrs.accountID = &accountID
+	mmFindOneByAccountID.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+
+	return mmFindOneByAccountID
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for AccountSettingsRepository.FindOneByAccountID
+func (mmFindOneByAccountID *mAccountSettingsRepositoryMockFindOneByAccountID) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetOneParams) *mAccountSettingsRepositoryMockFindOneByAccountID {
+	if mmFindOneByAccountID.mock.funcFindOneByAccountID != nil {
+		mmFindOneByAccountID.mock.t.Fatalf("AccountSettingsRepositoryMock.FindOneByAccountID mock is already set by Set")
+	}
+
+	if mmFindOneByAccountID.defaultExpectation == nil {
+		mmFindOneByAccountID.defaultExpectation = &AccountSettingsRepositoryMockFindOneByAccountIDExpectation{}
+	}
+
+	if mmFindOneByAccountID.defaultExpectation.params != nil {
+		mmFindOneByAccountID.mock.t.Fatalf("AccountSettingsRepositoryMock.FindOneByAccountID mock is already set by Expect")
+	}
+
+	if mmFindOneByAccountID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByAccountID.defaultExpectation.paramPtrs = &AccountSettingsRepositoryMockFindOneByAccountIDParamPtrs{}
+	}
+	mmFindOneByAccountID.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindOneByAccountID.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindOneByAccountID
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccountSettingsRepository.FindOneByAccountID
+func (mmFindOneByAccountID *mAccountSettingsRepositoryMockFindOneByAccountID) Inspect(f func(ctx context.Context, accountID uuid.UUID, queryParams *uctypes.QueryGetOneParams)) *mAccountSettingsRepositoryMockFindOneByAccountID {
+	if mmFindOneByAccountID.mock.inspectFuncFindOneByAccountID != nil {
+		mmFindOneByAccountID.mock.t.Fatalf("Inspect function is already set for AccountSettingsRepositoryMock.FindOneByAccountID")
+	}
+
+	mmFindOneByAccountID.mock.inspectFuncFindOneByAccountID = f
+
+	return mmFindOneByAccountID
+}
+
+// Return sets up results that will be returned by AccountSettingsRepository.FindOneByAccountID
+func (mmFindOneByAccountID *mAccountSettingsRepositoryMockFindOneByAccountID) Return(item *entity.AccountSettings, err error) *AccountSettingsRepositoryMock {
+	if mmFindOneByAccountID.mock.funcFindOneByAccountID != nil {
+		mmFindOneByAccountID.mock.t.Fatalf("AccountSettingsRepositoryMock.FindOneByAccountID mock is already set by Set")
+	}
+
+	if mmFindOneByAccountID.defaultExpectation == nil {
+		mmFindOneByAccountID.defaultExpectation = &AccountSettingsRepositoryMockFindOneByAccountIDExpectation{mock: mmFindOneByAccountID.mock}
+	}
+	mmFindOneByAccountID.defaultExpectation.results = &AccountSettingsRepositoryMockFindOneByAccountIDResults{item, err}
+	mmFindOneByAccountID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindOneByAccountID.mock
+}
+
+// Set uses given function f to mock the AccountSettingsRepository.FindOneByAccountID method
+func (mmFindOneByAccountID *mAccountSettingsRepositoryMockFindOneByAccountID) Set(f func(ctx context.Context, accountID uuid.UUID, queryParams *uctypes.QueryGetOneParams) (item *entity.AccountSettings, err error)) *AccountSettingsRepositoryMock {
+	if mmFindOneByAccountID.defaultExpectation != nil {
+		mmFindOneByAccountID.mock.t.Fatalf("Default expectation is already set for the AccountSettingsRepository.FindOneByAccountID method")
+	}
+
+	if len(mmFindOneByAccountID.expectations) > 0 {
+		mmFindOneByAccountID.mock.t.Fatalf("Some expectations are already set for the AccountSettingsRepository.FindOneByAccountID method")
+	}
+
+	mmFindOneByAccountID.mock.funcFindOneByAccountID = f
+	mmFindOneByAccountID.mock.funcFindOneByAccountIDOrigin = minimock.CallerInfo(1)
+	return mmFindOneByAccountID.mock
+}
+
+// When sets expectation for the AccountSettingsRepository.FindOneByAccountID which will trigger the result defined by the following
+// Then helper
+func (mmFindOneByAccountID *mAccountSettingsRepositoryMockFindOneByAccountID) When(ctx context.Context, accountID uuid.UUID, queryParams *uctypes.QueryGetOneParams) *AccountSettingsRepositoryMockFindOneByAccountIDExpectation {
+	if mmFindOneByAccountID.mock.funcFindOneByAccountID != nil {
+		mmFindOneByAccountID.mock.t.Fatalf("AccountSettingsRepositoryMock.FindOneByAccountID mock is already set by Set")
+	}
+
+	expectation := &AccountSettingsRepositoryMockFindOneByAccountIDExpectation{
+		mock:               mmFindOneByAccountID.mock,
+		params:             &AccountSettingsRepositoryMockFindOneByAccountIDParams{ctx, accountID, queryParams},
+		expectationOrigins: AccountSettingsRepositoryMockFindOneByAccountIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindOneByAccountID.expectations = append(mmFindOneByAccountID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccountSettingsRepository.FindOneByAccountID return parameters for the expectation previously defined by the When method
+func (e *AccountSettingsRepositoryMockFindOneByAccountIDExpectation) Then(item *entity.AccountSettings, err error) *AccountSettingsRepositoryMock {
+	e.results = &AccountSettingsRepositoryMockFindOneByAccountIDResults{item, err}
+	return e.mock
+}
+
+// Times sets number of times AccountSettingsRepository.FindOneByAccountID should be invoked
+func (mmFindOneByAccountID *mAccountSettingsRepositoryMockFindOneByAccountID) Times(n uint64) *mAccountSettingsRepositoryMockFindOneByAccountID {
+	if n == 0 {
+		mmFindOneByAccountID.mock.t.Fatalf("Times of AccountSettingsRepositoryMock.FindOneByAccountID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindOneByAccountID.expectedInvocations, n)
+	mmFindOneByAccountID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindOneByAccountID
+}
+
+func (mmFindOneByAccountID *mAccountSettingsRepositoryMockFindOneByAccountID) invocationsDone() bool {
+	if len(mmFindOneByAccountID.expectations) == 0 && mmFindOneByAccountID.defaultExpectation == nil && mmFindOneByAccountID.mock.funcFindOneByAccountID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindOneByAccountID.mock.afterFindOneByAccountIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindOneByAccountID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindOneByAccountID implements mm_usecase.AccountSettingsRepository
+func (mmFindOneByAccountID *AccountSettingsRepositoryMock) FindOneByAccountID(ctx context.Context, accountID uuid.UUID, queryParams *uctypes.QueryGetOneParams) (item *entity.AccountSettings, err error) {
+	mm_atomic.AddUint64(&mmFindOneByAccountID.beforeFindOneByAccountIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindOneByAccountID.afterFindOneByAccountIDCounter, 1)
+
+	mmFindOneByAccountID.t.Helper()
+
+	if mmFindOneByAccountID.inspectFuncFindOneByAccountID != nil {
+		mmFindOneByAccountID.inspectFuncFindOneByAccountID(ctx, accountID, queryParams)
+	}
+
+	mm_params := AccountSettingsRepositoryMockFindOneByAccountIDParams{ctx, accountID, queryParams}
+
+	// Record call args
+	mmFindOneByAccountID.FindOneByAccountIDMock.mutex.Lock()
+	mmFindOneByAccountID.FindOneByAccountIDMock.callArgs = append(mmFindOneByAccountID.FindOneByAccountIDMock.callArgs, &mm_params)
+	mmFindOneByAccountID.FindOneByAccountIDMock.mutex.Unlock()
+
+	for _, e := range mmFindOneByAccountID.FindOneByAccountIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.item, e.results.err
+		}
+	}
+
+	if mmFindOneByAccountID.FindOneByAccountIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindOneByAccountID.FindOneByAccountIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindOneByAccountID.FindOneByAccountIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindOneByAccountID.FindOneByAccountIDMock.defaultExpectation.paramPtrs
+
+		mm_got := AccountSettingsRepositoryMockFindOneByAccountIDParams{ctx, accountID, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindOneByAccountID.t.Errorf("AccountSettingsRepositoryMock.FindOneByAccountID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByAccountID.FindOneByAccountIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmFindOneByAccountID.t.Errorf("AccountSettingsRepositoryMock.FindOneByAccountID got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByAccountID.FindOneByAccountIDMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindOneByAccountID.t.Errorf("AccountSettingsRepositoryMock.FindOneByAccountID got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByAccountID.FindOneByAccountIDMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindOneByAccountID.t.Errorf("AccountSettingsRepositoryMock.FindOneByAccountID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindOneByAccountID.FindOneByAccountIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindOneByAccountID.FindOneByAccountIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindOneByAccountID.t.Fatal("No results are set for the AccountSettingsRepositoryMock.FindOneByAccountID")
+		}
+		return (*mm_results).item, (*mm_results).err
+	}
+	if mmFindOneByAccountID.funcFindOneByAccountID != nil {
+		return mmFindOneByAccountID.funcFindOneByAccountID(ctx, accountID, queryParams)
+	}
+	mmFindOneByAccountID.t.Fatalf("Unexpected call to AccountSettingsRepositoryMock.FindOneByAccountID. %v %v %v", ctx, accountID, queryParams)
+	return
+}
+
+// FindOneByAccountIDAfterCounter returns a count of finished AccountSettingsRepositoryMock.FindOneByAccountID invocations
+func (mmFindOneByAccountID *AccountSettingsRepositoryMock) FindOneByAccountIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByAccountID.afterFindOneByAccountIDCounter)
+}
+
+// FindOneByAccountIDBeforeCounter returns a count of AccountSettingsRepositoryMock.FindOneByAccountID invocations
+func (mmFindOneByAccountID *AccountSettingsRepositoryMock) FindOneByAccountIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByAccountID.beforeFindOneByAccountIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccountSettingsRepositoryMock.FindOneByAccountID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindOneByAccountID *mAccountSettingsRepositoryMockFindOneByAccountID) Calls() []*AccountSettingsRepositoryMockFindOneByAccountIDParams {
+	mmFindOneByAccountID.mutex.RLock()
+
+	argCopy := make([]*AccountSettingsRepositoryMockFindOneByAccountIDParams, len(mmFindOneByAccountID.callArgs))
+	copy(argCopy, mmFindOneByAccountID.callArgs)
+
+	mmFindOneByAccountID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindOneByAccountIDDone returns true if the count of the FindOneByAccountID invocations corresponds
+// the number of defined expectations
+func (m *AccountSettingsRepositoryMock) MinimockFindOneByAccountIDDone() bool {
+	if m.FindOneByAccountIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindOneByAccountIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindOneByAccountIDMock.invocationsDone()
+}
+
+// MinimockFindOneByAccountIDInspect logs each unmet expectation
+func (m *AccountSettingsRepositoryMock) MinimockFindOneByAccountIDInspect() {
+	for _, e := range m.FindOneByAccountIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccountSettingsRepositoryMock.FindOneByAccountID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindOneByAccountIDCounter := mm_atomic.LoadUint64(&m.afterFindOneByAccountIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindOneByAccountIDMock.defaultExpectation != nil && afterFindOneByAccountIDCounter < 1 {
+		if m.FindOneByAccountIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccountSettingsRepositoryMock.FindOneByAccountID at\n%s", m.FindOneByAccountIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccountSettingsRepositoryMock.FindOneByAccountID at\n%s with params: %#v", m.FindOneByAccountIDMock.defaultExpectation.expectationOrigins.origin, *m.FindOneByAccountIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindOneByAccountID != nil && afterFindOneByAccountIDCounter < 1 {
+		m.t.Errorf("Expected call to AccountSettingsRepositoryMock.FindOneByAccountID at\n%s", m.funcFindOneByAccountIDOrigin)
+	}
+
+	if !m.FindOneByAccountIDMock.invocationsDone() && afterFindOneByAccountIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccountSettingsRepositoryMock.FindOneByAccountID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindOneByAccountIDMock.expectedInvocations), m.FindOneByAccountIDMock.expectedInvocationsOrigin, afterFindOneByAccountIDCounter)
+	}
+}
+
+type mAccountSettingsRepositoryMockUpsert struct {
+	optional           bool
+	mock               *AccountSettingsRepositoryMock
+	defaultExpectation *AccountSettingsRepositoryMockUpsertExpectation
+	expectations       []*AccountSettingsRepositoryMockUpsertExpectation
+
+	callArgs []*AccountSettingsRepositoryMockUpsertParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccountSettingsRepositoryMockUpsertExpectation specifies expectation struct of the AccountSettingsRepository.Upsert
+type AccountSettingsRepositoryMockUpsertExpectation struct {
+	mock               *AccountSettingsRepositoryMock
+	params             *AccountSettingsRepositoryMockUpsertParams
+	paramPtrs          *AccountSettingsRepositoryMockUpsertParamPtrs
+	expectationOrigins AccountSettingsRepositoryMockUpsertExpectationOrigins
+	results            *AccountSettingsRepositoryMockUpsertResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccountSettingsRepositoryMockUpsertParams contains parameters of the AccountSettingsRepository.Upsert
+type AccountSettingsRepositoryMockUpsertParams struct {
+	ctx  context.Context
+	item *entity.AccountSettings
+}
+
+// AccountSettingsRepositoryMockUpsertParamPtrs contains pointers to parameters of the AccountSettingsRepository.Upsert
+type AccountSettingsRepositoryMockUpsertParamPtrs struct {
+	ctx  *context.Context
+	item **entity.AccountSettings
+}
+
+// AccountSettingsRepositoryMockUpsertResults contains results of the AccountSettingsRepository.Upsert
+type AccountSettingsRepositoryMockUpsertResults struct {
+	err error
+}
+
+// AccountSettingsRepositoryMockUpsertOrigins contains origins of expectations of the AccountSettingsRepository.Upsert
+type AccountSettingsRepositoryMockUpsertExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originItem string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpsert *mAccountSettingsRepositoryMockUpsert) Optional() *mAccountSettingsRepositoryMockUpsert {
+	mmUpsert.optional = true
+	return mmUpsert
+}
+
+// Expect sets up expected params for AccountSettingsRepository.Upsert
+func (mmUpsert *mAccountSettingsRepositoryMockUpsert) Expect(ctx context.Context, item *entity.AccountSettings) *mAccountSettingsRepositoryMockUpsert {
+	if mmUpsert.mock.funcUpsert != nil {
+		mmUpsert.mock.t.Fatalf("AccountSettingsRepositoryMock.Upsert mock is already set by Set")
+	}
+
+	if mmUpsert.defaultExpectation == nil {
+		mmUpsert.defaultExpectation = &AccountSettingsRepositoryMockUpsertExpectation{}
+	}
+
+	if mmUpsert.defaultExpectation.paramPtrs != nil {
+		mmUpsert.mock.t.Fatalf("AccountSettingsRepositoryMock.Upsert mock is already set by ExpectParams functions")
+	}
+
+	mmUpsert.defaultExpectation.params = &AccountSettingsRepositoryMockUpsertParams{ctx, item}
+	mmUpsert.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpsert.expectations {
+		if minimock.Equal(e.params, mmUpsert.defaultExpectation.params) {
+			mmUpsert.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpsert.defaultExpectation.params)
+		}
+	}
+
+	return mmUpsert
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccountSettingsRepository.Upsert
+func (mmUpsert *mAccountSettingsRepositoryMockUpsert) ExpectCtxParam1(ctx context.Context) *mAccountSettingsRepositoryMockUpsert {
+	if mmUpsert.mock.funcUpsert != nil {
+		mmUpsert.mock.t.Fatalf("AccountSettingsRepositoryMock.Upsert mock is already set by Set")
+	}
+
+	if mmUpsert.defaultExpectation == nil {
+		mmUpsert.defaultExpectation = &AccountSettingsRepositoryMockUpsertExpectation{}
+	}
+
+	if mmUpsert.defaultExpectation.params != nil {
+		mmUpsert.mock.t.Fatalf("AccountSettingsRepositoryMock.Upsert mock is already set by Expect")
+	}
+
+	if mmUpsert.defaultExpectation.paramPtrs == nil {
+		mmUpsert.defaultExpectation.paramPtrs = &AccountSettingsRepositoryMockUpsertParamPtrs{}
+	}
+	mmUpsert.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpsert.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpsert
+}
+
+// ExpectItemParam2 sets up expected param item for AccountSettingsRepository.Upsert
+func (mmUpsert *mAccountSettingsRepositoryMockUpsert) ExpectItemParam2(item *entity.AccountSettings) *mAccountSettingsRepositoryMockUpsert {
+	if mmUpsert.mock.funcUpsert != nil {
+		mmUpsert.mock.t.Fatalf("AccountSettingsRepositoryMock.Upsert mock is already set by Set")
+	}
+
+	if mmUpsert.defaultExpectation == nil {
+		mmUpsert.defaultExpectation = &AccountSettingsRepositoryMockUpsertExpectation{}
+	}
+
+	if mmUpsert.defaultExpectation.params != nil {
+		mmUpsert.mock.t.Fatalf("AccountSettingsRepositoryMock.Upsert mock is already set by Expect")
+	}
+
+	if mmUpsert.defaultExpectation.paramPtrs == nil {
+		mmUpsert.defaultExpectation.paramPtrs = &AccountSettingsRepositoryMockUpsertParamPtrs{}
+	}
+	mmUpsert.defaultExpectation.paramPtrs.item = &item
+	mmUpsert.defaultExpectation.expectationOrigins.originItem = minimock.CallerInfo(1)
+
+	return mmUpsert
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccountSettingsRepository.Upsert
+func (mmUpsert *mAccountSettingsRepositoryMockUpsert) Inspect(f func(ctx context.Context, item *entity.AccountSettings)) *mAccountSettingsRepositoryMockUpsert {
+	if mmUpsert.mock.inspectFuncUpsert != nil {
+		mmUpsert.mock.t.Fatalf("Inspect function is already set for AccountSettingsRepositoryMock.Upsert")
+	}
+
+	mmUpsert.mock.inspectFuncUpsert = f
+
+	return mmUpsert
+}
+
+// Return sets up results that will be returned by AccountSettingsRepository.Upsert
+func (mmUpsert *mAccountSettingsRepositoryMockUpsert) Return(err error) *AccountSettingsRepositoryMock {
+	if mmUpsert.mock.funcUpsert != nil {
+		mmUpsert.mock.t.Fatalf("AccountSettingsRepositoryMock.Upsert mock is already set by Set")
+	}
+
+	if mmUpsert.defaultExpectation == nil {
+		mmUpsert.defaultExpectation = &AccountSettingsRepositoryMockUpsertExpectation{mock: mmUpsert.mock}
+	}
+	mmUpsert.defaultExpectation.results = &AccountSettingsRepositoryMockUpsertResults{err}
+	mmUpsert.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpsert.mock
+}
+
+// Set uses given function f to mock the AccountSettingsRepository.Upsert method
+func (mmUpsert *mAccountSettingsRepositoryMockUpsert) Set(f func(ctx context.Context, item *entity.AccountSettings) (err error)) *AccountSettingsRepositoryMock {
+	if mmUpsert.defaultExpectation != nil {
+		mmUpsert.mock.t.Fatalf("Default expectation is already set for the AccountSettingsRepository.Upsert method")
+	}
+
+	if len(mmUpsert.expectations) > 0 {
+		mmUpsert.mock.t.Fatalf("Some expectations are already set for the AccountSettingsRepository.Upsert method")
+	}
+
+	mmUpsert.mock.funcUpsert = f
+	mmUpsert.mock.funcUpsertOrigin = minimock.CallerInfo(1)
+	return mmUpsert.mock
+}
+
+// When sets expectation for the AccountSettingsRepository.Upsert which will trigger the result defined by the following
+// Then helper
+func (mmUpsert *mAccountSettingsRepositoryMockUpsert) When(ctx context.Context, item *entity.AccountSettings) *AccountSettingsRepositoryMockUpsertExpectation {
+	if mmUpsert.mock.funcUpsert != nil {
+		mmUpsert.mock.t.Fatalf("AccountSettingsRepositoryMock.Upsert mock is already set by Set")
+	}
+
+	expectation := &AccountSettingsRepositoryMockUpsertExpectation{
+		mock:               mmUpsert.mock,
+		params:             &AccountSettingsRepositoryMockUpsertParams{ctx, item},
+		expectationOrigins: AccountSettingsRepositoryMockUpsertExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpsert.expectations = append(mmUpsert.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccountSettingsRepository.Upsert return parameters for the expectation previously defined by the When method
+func (e *AccountSettingsRepositoryMockUpsertExpectation) Then(err error) *AccountSettingsRepositoryMock {
+	e.results = &AccountSettingsRepositoryMockUpsertResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccountSettingsRepository.Upsert should be invoked
+func (mmUpsert *mAccountSettingsRepositoryMockUpsert) Times(n uint64) *mAccountSettingsRepositoryMockUpsert {
+	if n == 0 {
+		mmUpsert.mock.t.Fatalf("Times of AccountSettingsRepositoryMock.Upsert mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpsert.expectedInvocations, n)
+	mmUpsert.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpsert
+}
+
+func (mmUpsert *mAccountSettingsRepositoryMockUpsert) invocationsDone() bool {
+	if len(mmUpsert.expectations) == 0 && mmUpsert.defaultExpectation == nil && mmUpsert.mock.funcUpsert == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpsert.mock.afterUpsertCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpsert.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Upsert implements mm_usecase.AccountSettingsRepository
+func (mmUpsert *AccountSettingsRepositoryMock) Upsert(ctx context.Context, item *entity.AccountSettings) (err error) {
+	mm_atomic.AddUint64(&mmUpsert.beforeUpsertCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpsert.afterUpsertCounter, 1)
+
+	mmUpsert.t.Helper()
+
+	if mmUpsert.inspectFuncUpsert != nil {
+		mmUpsert.inspectFuncUpsert(ctx, item)
+	}
+
+	mm_params := AccountSettingsRepositoryMockUpsertParams{ctx, item}
+
+	// Record call args
+	mmUpsert.UpsertMock.mutex.Lock()
+	mmUpsert.UpsertMock.callArgs = append(mmUpsert.UpsertMock.callArgs, &mm_params)
+	mmUpsert.UpsertMock.mutex.Unlock()
+
+	for _, e := range mmUpsert.UpsertMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpsert.UpsertMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpsert.UpsertMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpsert.UpsertMock.defaultExpectation.params
+		mm_want_ptrs := mmUpsert.UpsertMock.defaultExpectation.paramPtrs
+
+		mm_got := AccountSettingsRepositoryMockUpsertParams{ctx, item}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpsert.t.Errorf("AccountSettingsRepositoryMock.Upsert got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpsert.UpsertMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
+				mmUpsert.t.Errorf("AccountSettingsRepositoryMock.Upsert got unexpected parameter item, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpsert.UpsertMock.defaultExpectation.expectationOrigins.originItem, *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpsert.t.Errorf("AccountSettingsRepositoryMock.Upsert got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpsert.UpsertMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpsert.UpsertMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpsert.t.Fatal("No results are set for the AccountSettingsRepositoryMock.Upsert")
+		}
+		return (*mm_results).err
+	}
+	if mmUpsert.funcUpsert != nil {
+		return mmUpsert.funcUpsert(ctx, item)
+	}
+	mmUpsert.t.Fatalf("Unexpected call to AccountSettingsRepositoryMock.Upsert. %v %v", ctx, item)
+	return
+}
+
+// UpsertAfterCounter returns a count of finished AccountSettingsRepositoryMock.Upsert invocations
+func (mmUpsert *AccountSettingsRepositoryMock) UpsertAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsert.afterUpsertCounter)
+}
+
+// UpsertBeforeCounter returns a count of AccountSettingsRepositoryMock.Upsert invocations
+func (mmUpsert *AccountSettingsRepositoryMock) UpsertBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsert.beforeUpsertCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccountSettingsRepositoryMock.Upsert.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpsert *mAccountSettingsRepositoryMockUpsert) Calls() []*AccountSettingsRepositoryMockUpsertParams {
+	mmUpsert.mutex.RLock()
+
+	argCopy := make([]*AccountSettingsRepositoryMockUpsertParams, len(mmUpsert.callArgs))
+	copy(argCopy, mmUpsert.callArgs)
+
+	mmUpsert.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpsertDone returns true if the count of the Upsert invocations corresponds
+// the number of defined expectations
+func (m *AccountSettingsRepositoryMock) MinimockUpsertDone() bool {
+	if m.UpsertMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpsertMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpsertMock.invocationsDone()
+}
+
+// MinimockUpsertInspect logs each unmet expectation
+func (m *AccountSettingsRepositoryMock) MinimockUpsertInspect() {
+	for _, e := range m.UpsertMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccountSettingsRepositoryMock.Upsert at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpsertCounter := mm_atomic.LoadUint64(&m.afterUpsertCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpsertMock.defaultExpectation != nil && afterUpsertCounter < 1 {
+		if m.UpsertMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccountSettingsRepositoryMock.Upsert at\n%s", m.UpsertMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccountSettingsRepositoryMock.Upsert at\n%s with params: %#v", m.UpsertMock.defaultExpectation.expectationOrigins.origin, *m.UpsertMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpsert != nil && afterUpsertCounter < 1 {
+		m.t.Errorf("Expected call to AccountSettingsRepositoryMock.Upsert at\n%s", m.funcUpsertOrigin)
+	}
+
+	if !m.UpsertMock.invocationsDone() && afterUpsertCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccountSettingsRepositoryMock.Upsert at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpsertMock.expectedInvocations), m.UpsertMock.expectedInvocationsOrigin, afterUpsertCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AccountSettingsRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockFindListInspect()
+
+			m.MinimockFindOneByAccountIDInspect()
+
+			m.MinimockUpsertInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AccountSettingsRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AccountSettingsRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockFindListDone() &&
+		m.MinimockFindOneByAccountIDDone() &&
+		m.MinimockUpsertDone()
+}
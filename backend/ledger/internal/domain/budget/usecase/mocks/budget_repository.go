@@ -22,6 +22,13 @@ type BudgetRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcCount          func(ctx context.Context, listOptions *mm_usecase.BudgetListOptions) (total uint64, err error)
+	funcCountOrigin    string
+	inspectFuncCount   func(ctx context.Context, listOptions *mm_usecase.BudgetListOptions)
+	afterCountCounter  uint64
+	beforeCountCounter uint64
+	CountMock          mBudgetRepositoryMockCount
+
 	funcCreate          func(ctx context.Context, item *entity.Budget) (err error)
 	funcCreateOrigin    string
 	inspectFuncCreate   func(ctx context.Context, item *entity.Budget)
@@ -50,6 +57,13 @@ type BudgetRepositoryMock struct {
 	beforeFindPagedListCounter uint64
 	FindPagedListMock          mBudgetRepositoryMockFindPagedList
 
+	funcReassignCategory          func(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) (err error)
+	funcReassignCategoryOrigin    string
+	inspectFuncReassignCategory   func(ctx context.Context, fromCategoryID uint64, toCategoryID uint64)
+	afterReassignCategoryCounter  uint64
+	beforeReassignCategoryCounter uint64
+	ReassignCategoryMock          mBudgetRepositoryMockReassignCategory
+
 	funcUpdate          func(ctx context.Context, item *entity.Budget) (err error)
 	funcUpdateOrigin    string
 	inspectFuncUpdate   func(ctx context.Context, item *entity.Budget)
@@ -66,6 +80,9 @@ func NewBudgetRepositoryMock(t minimock.Tester) *BudgetRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.CountMock = mBudgetRepositoryMockCount{mock: m}
+	m.CountMock.callArgs = []*BudgetRepositoryMockCountParams{}
+
 	m.CreateMock = mBudgetRepositoryMockCreate{mock: m}
 	m.CreateMock.callArgs = []*BudgetRepositoryMockCreateParams{}
 
@@ -78,6 +95,9 @@ func NewBudgetRepositoryMock(t minimock.Tester) *BudgetRepositoryMock {
 	m.FindPagedListMock = mBudgetRepositoryMockFindPagedList{mock: m}
 	m.FindPagedListMock.callArgs = []*BudgetRepositoryMockFindPagedListParams{}
 
+	m.ReassignCategoryMock = mBudgetRepositoryMockReassignCategory{mock: m}
+	m.ReassignCategoryMock.callArgs = []*BudgetRepositoryMockReassignCategoryParams{}
+
 	m.UpdateMock = mBudgetRepositoryMockUpdate{mock: m}
 	m.UpdateMock.callArgs = []*BudgetRepositoryMockUpdateParams{}
 
@@ -86,6 +106,349 @@ func NewBudgetRepositoryMock(t minimock.Tester) *BudgetRepositoryMock {
 	return m
 }
 
+type mBudgetRepositoryMockCount struct {
+	optional           bool
+	mock               *BudgetRepositoryMock
+	defaultExpectation *BudgetRepositoryMockCountExpectation
+	expectations       []*BudgetRepositoryMockCountExpectation
+
+	callArgs []*BudgetRepositoryMockCountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BudgetRepositoryMockCountExpectation specifies expectation struct of the BudgetRepository.Count
+type BudgetRepositoryMockCountExpectation struct {
+	mock               *BudgetRepositoryMock
+	params             *BudgetRepositoryMockCountParams
+	paramPtrs          *BudgetRepositoryMockCountParamPtrs
+	expectationOrigins BudgetRepositoryMockCountExpectationOrigins
+	results            *BudgetRepositoryMockCountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BudgetRepositoryMockCountParams contains parameters of the BudgetRepository.Count
+type BudgetRepositoryMockCountParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.BudgetListOptions
+}
+
+// BudgetRepositoryMockCountParamPtrs contains pointers to parameters of the BudgetRepository.Count
+type BudgetRepositoryMockCountParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.BudgetListOptions
+}
+
+// BudgetRepositoryMockCountResults contains results of the BudgetRepository.Count
+type BudgetRepositoryMockCountResults struct {
+	total uint64
+	err   error
+}
+
+// BudgetRepositoryMockCountOrigins contains origins of expectations of the BudgetRepository.Count
+type BudgetRepositoryMockCountExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCount *mBudgetRepositoryMockCount) Optional() *mBudgetRepositoryMockCount {
+	mmCount.optional = true
+	return mmCount
+}
+
+// Expect sets up expected params for BudgetRepository.Count
+func (mmCount *mBudgetRepositoryMockCount) Expect(ctx context.Context, listOptions *mm_usecase.BudgetListOptions) *mBudgetRepositoryMockCount {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("BudgetRepositoryMock.Count mock is already set by Set")
+	}
+
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &BudgetRepositoryMockCountExpectation{}
+	}
+
+	if mmCount.defaultExpectation.paramPtrs != nil {
+		mmCount.mock.t.Fatalf("BudgetRepositoryMock.Count mock is already set by ExpectParams functions")
+	}
+
+	mmCount.defaultExpectation.params = &BudgetRepositoryMockCountParams{ctx, listOptions}
+	mmCount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCount.expectations {
+		if minimock.Equal(e.params, mmCount.defaultExpectation.params) {
+			mmCount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCount.defaultExpectation.params)
+		}
+	}
+
+	return mmCount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BudgetRepository.Count
+func (mmCount *mBudgetRepositoryMockCount) ExpectCtxParam1(ctx context.Context) *mBudgetRepositoryMockCount {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("BudgetRepositoryMock.Count mock is already set by Set")
+	}
+
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &BudgetRepositoryMockCountExpectation{}
+	}
+
+	if mmCount.defaultExpectation.params != nil {
+		mmCount.mock.t.Fatalf("BudgetRepositoryMock.Count mock is already set by Expect")
+	}
+
+	if mmCount.defaultExpectation.paramPtrs == nil {
+		mmCount.defaultExpectation.paramPtrs = &BudgetRepositoryMockCountParamPtrs{}
+	}
+	mmCount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCount
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for BudgetRepository.Count
+func (mmCount *mBudgetRepositoryMockCount) ExpectListOptionsParam2(listOptions *mm_usecase.BudgetListOptions) *mBudgetRepositoryMockCount {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("BudgetRepositoryMock.Count mock is already set by Set")
+	}
+
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &BudgetRepositoryMockCountExpectation{}
+	}
+
+	if mmCount.defaultExpectation.params != nil {
+		mmCount.mock.t.Fatalf("BudgetRepositoryMock.Count mock is already set by Expect")
+	}
+
+	if mmCount.defaultExpectation.paramPtrs == nil {
+		mmCount.defaultExpectation.paramPtrs = &BudgetRepositoryMockCountParamPtrs{}
+	}
+	mmCount.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmCount.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmCount
+}
+
+// Inspect accepts an inspector function that has same arguments as the BudgetRepository.Count
+func (mmCount *mBudgetRepositoryMockCount) Inspect(f func(ctx context.Context, listOptions *mm_usecase.BudgetListOptions)) *mBudgetRepositoryMockCount {
+	if mmCount.mock.inspectFuncCount != nil {
+		mmCount.mock.t.Fatalf("Inspect function is already set for BudgetRepositoryMock.Count")
+	}
+
+	mmCount.mock.inspectFuncCount = f
+
+	return mmCount
+}
+
+// Return sets up results that will be returned by BudgetRepository.Count
+func (mmCount *mBudgetRepositoryMockCount) Return(total uint64, err error) *BudgetRepositoryMock {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("BudgetRepositoryMock.Count mock is already set by Set")
+	}
+
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &BudgetRepositoryMockCountExpectation{mock: mmCount.mock}
+	}
+	mmCount.defaultExpectation.results = &BudgetRepositoryMockCountResults{total, err}
+	mmCount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCount.mock
+}
+
+// Set uses given function f to mock the BudgetRepository.Count method
+func (mmCount *mBudgetRepositoryMockCount) Set(f func(ctx context.Context, listOptions *mm_usecase.BudgetListOptions) (total uint64, err error)) *BudgetRepositoryMock {
+	if mmCount.defaultExpectation != nil {
+		mmCount.mock.t.Fatalf("Default expectation is already set for the BudgetRepository.Count method")
+	}
+
+	if len(mmCount.expectations) > 0 {
+		mmCount.mock.t.Fatalf("Some expectations are already set for the BudgetRepository.Count method")
+	}
+
+	mmCount.mock.funcCount = f
+	mmCount.mock.funcCountOrigin = minimock.CallerInfo(1)
+	return mmCount.mock
+}
+
+// When sets expectation for the BudgetRepository.Count which will trigger the result defined by the following
+// Then helper
+func (mmCount *mBudgetRepositoryMockCount) When(ctx context.Context, listOptions *mm_usecase.BudgetListOptions) *BudgetRepositoryMockCountExpectation {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("BudgetRepositoryMock.Count mock is already set by Set")
+	}
+
+	expectation := &BudgetRepositoryMockCountExpectation{
+		mock:               mmCount.mock,
+		params:             &BudgetRepositoryMockCountParams{ctx, listOptions},
+		expectationOrigins: BudgetRepositoryMockCountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCount.expectations = append(mmCount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BudgetRepository.Count return parameters for the expectation previously defined by the When method
+func (e *BudgetRepositoryMockCountExpectation) Then(total uint64, err error) *BudgetRepositoryMock {
+	e.results = &BudgetRepositoryMockCountResults{total, err}
+	return e.mock
+}
+
+// Times sets number of times BudgetRepository.Count should be invoked
+func (mmCount *mBudgetRepositoryMockCount) Times(n uint64) *mBudgetRepositoryMockCount {
+	if n == 0 {
+		mmCount.mock.t.Fatalf("Times of BudgetRepositoryMock.Count mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCount.expectedInvocations, n)
+	mmCount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCount
+}
+
+func (mmCount *mBudgetRepositoryMockCount) invocationsDone() bool {
+	if len(mmCount.expectations) == 0 && mmCount.defaultExpectation == nil && mmCount.mock.funcCount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCount.mock.afterCountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Count implements mm_usecase.BudgetRepository
+func (mmCount *BudgetRepositoryMock) Count(ctx context.Context, listOptions *mm_usecase.BudgetListOptions) (total uint64, err error) {
+	mm_atomic.AddUint64(&mmCount.beforeCountCounter, 1)
+	defer mm_atomic.AddUint64(&mmCount.afterCountCounter, 1)
+
+	mmCount.t.Helper()
+
+	if mmCount.inspectFuncCount != nil {
+		mmCount.inspectFuncCount(ctx, listOptions)
+	}
+
+	mm_params := BudgetRepositoryMockCountParams{ctx, listOptions}
+
+	// Record call args
+	mmCount.CountMock.mutex.Lock()
+	mmCount.CountMock.callArgs = append(mmCount.CountMock.callArgs, &mm_params)
+	mmCount.CountMock.mutex.Unlock()
+
+	for _, e := range mmCount.CountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.total, e.results.err
+		}
+	}
+
+	if mmCount.CountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCount.CountMock.defaultExpectation.Counter, 1)
+		mm_want := mmCount.CountMock.defaultExpectation.params
+		mm_want_ptrs := mmCount.CountMock.defaultExpectation.paramPtrs
+
+		mm_got := BudgetRepositoryMockCountParams{ctx, listOptions}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCount.t.Errorf("BudgetRepositoryMock.Count got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCount.CountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmCount.t.Errorf("BudgetRepositoryMock.Count got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCount.CountMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCount.t.Errorf("BudgetRepositoryMock.Count got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCount.CountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCount.CountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCount.t.Fatal("No results are set for the BudgetRepositoryMock.Count")
+		}
+		return (*mm_results).total, (*mm_results).err
+	}
+	if mmCount.funcCount != nil {
+		return mmCount.funcCount(ctx, listOptions)
+	}
+	mmCount.t.Fatalf("Unexpected call to BudgetRepositoryMock.Count. %v %v", ctx, listOptions)
+	return
+}
+
+// CountAfterCounter returns a count of finished BudgetRepositoryMock.Count invocations
+func (mmCount *BudgetRepositoryMock) CountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCount.afterCountCounter)
+}
+
+// CountBeforeCounter returns a count of BudgetRepositoryMock.Count invocations
+func (mmCount *BudgetRepositoryMock) CountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCount.beforeCountCounter)
+}
+
+// Calls returns a list of arguments used in each call to BudgetRepositoryMock.Count.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCount *mBudgetRepositoryMockCount) Calls() []*BudgetRepositoryMockCountParams {
+	mmCount.mutex.RLock()
+
+	argCopy := make([]*BudgetRepositoryMockCountParams, len(mmCount.callArgs))
+	copy(argCopy, mmCount.callArgs)
+
+	mmCount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountDone returns true if the count of the Count invocations corresponds
+// the number of defined expectations
+func (m *BudgetRepositoryMock) MinimockCountDone() bool {
+	if m.CountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountMock.invocationsDone()
+}
+
+// MinimockCountInspect logs each unmet expectation
+func (m *BudgetRepositoryMock) MinimockCountInspect() {
+	for _, e := range m.CountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BudgetRepositoryMock.Count at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountCounter := mm_atomic.LoadUint64(&m.afterCountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountMock.defaultExpectation != nil && afterCountCounter < 1 {
+		if m.CountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BudgetRepositoryMock.Count at\n%s", m.CountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BudgetRepositoryMock.Count at\n%s with params: %#v", m.CountMock.defaultExpectation.expectationOrigins.origin, *m.CountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCount != nil && afterCountCounter < 1 {
+		m.t.Errorf("Expected call to BudgetRepositoryMock.Count at\n%s", m.funcCountOrigin)
+	}
+
+	if !m.CountMock.invocationsDone() && afterCountCounter > 0 {
+		m.t.Errorf("Expected %d calls to BudgetRepositoryMock.Count at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountMock.expectedInvocations), m.CountMock.expectedInvocationsOrigin, afterCountCounter)
+	}
+}
+
 type mBudgetRepositoryMockCreate struct {
 	optional           bool
 	mock               *BudgetRepositoryMock
@@ -1551,6 +1914,379 @@ func (m *BudgetRepositoryMock) MinimockFindPagedListInspect() {
 	}
 }
 
+type mBudgetRepositoryMockReassignCategory struct {
+	optional           bool
+	mock               *BudgetRepositoryMock
+	defaultExpectation *BudgetRepositoryMockReassignCategoryExpectation
+	expectations       []*BudgetRepositoryMockReassignCategoryExpectation
+
+	callArgs []*BudgetRepositoryMockReassignCategoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BudgetRepositoryMockReassignCategoryExpectation specifies expectation struct of the BudgetRepository.ReassignCategory
+type BudgetRepositoryMockReassignCategoryExpectation struct {
+	mock               *BudgetRepositoryMock
+	params             *BudgetRepositoryMockReassignCategoryParams
+	paramPtrs          *BudgetRepositoryMockReassignCategoryParamPtrs
+	expectationOrigins BudgetRepositoryMockReassignCategoryExpectationOrigins
+	results            *BudgetRepositoryMockReassignCategoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BudgetRepositoryMockReassignCategoryParams contains parameters of the BudgetRepository.ReassignCategory
+type BudgetRepositoryMockReassignCategoryParams struct {
+	ctx            context.Context
+	fromCategoryID uint64
+	toCategoryID   uint64
+}
+
+// BudgetRepositoryMockReassignCategoryParamPtrs contains pointers to parameters of the BudgetRepository.ReassignCategory
+type BudgetRepositoryMockReassignCategoryParamPtrs struct {
+	ctx            *context.Context
+	fromCategoryID *uint64
+	toCategoryID   *uint64
+}
+
+// BudgetRepositoryMockReassignCategoryResults contains results of the BudgetRepository.ReassignCategory
+type BudgetRepositoryMockReassignCategoryResults struct {
+	err error
+}
+
+// BudgetRepositoryMockReassignCategoryOrigins contains origins of expectations of the BudgetRepository.ReassignCategory
+type BudgetRepositoryMockReassignCategoryExpectationOrigins struct {
+	origin               string
+	originCtx            string
+	originFromCategoryID string
+	originToCategoryID   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReassignCategory *mBudgetRepositoryMockReassignCategory) Optional() *mBudgetRepositoryMockReassignCategory {
+	mmReassignCategory.optional = true
+	return mmReassignCategory
+}
+
+// Expect sets up expected params for BudgetRepository.ReassignCategory
+func (mmReassignCategory *mBudgetRepositoryMockReassignCategory) Expect(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) *mBudgetRepositoryMockReassignCategory {
+	if mmReassignCategory.mock.funcReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("BudgetRepositoryMock.ReassignCategory mock is already set by Set")
+	}
+
+	if mmReassignCategory.defaultExpectation == nil {
+		mmReassignCategory.defaultExpectation = &BudgetRepositoryMockReassignCategoryExpectation{}
+	}
+
+	if mmReassignCategory.defaultExpectation.paramPtrs != nil {
+		mmReassignCategory.mock.t.Fatalf("BudgetRepositoryMock.ReassignCategory mock is already set by ExpectParams functions")
+	}
+
+	mmReassignCategory.defaultExpectation.params = &BudgetRepositoryMockReassignCategoryParams{ctx, fromCategoryID, toCategoryID}
+	mmReassignCategory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReassignCategory.expectations {
+		if minimock.Equal(e.params, mmReassignCategory.defaultExpectation.params) {
+			mmReassignCategory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReassignCategory.defaultExpectation.params)
+		}
+	}
+
+	return mmReassignCategory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BudgetRepository.ReassignCategory
+func (mmReassignCategory *mBudgetRepositoryMockReassignCategory) ExpectCtxParam1(ctx context.Context) *mBudgetRepositoryMockReassignCategory {
+	if mmReassignCategory.mock.funcReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("BudgetRepositoryMock.ReassignCategory mock is already set by Set")
+	}
+
+	if mmReassignCategory.defaultExpectation == nil {
+		mmReassignCategory.defaultExpectation = &BudgetRepositoryMockReassignCategoryExpectation{}
+	}
+
+	if mmReassignCategory.defaultExpectation.params != nil {
+		mmReassignCategory.mock.t.Fatalf("BudgetRepositoryMock.ReassignCategory mock is already set by Expect")
+	}
+
+	if mmReassignCategory.defaultExpectation.paramPtrs == nil {
+		mmReassignCategory.defaultExpectation.paramPtrs = &BudgetRepositoryMockReassignCategoryParamPtrs{}
+	}
+	mmReassignCategory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReassignCategory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReassignCategory
+}
+
+// ExpectFromCategoryIDParam2 sets up expected param fromCategoryID for BudgetRepository.ReassignCategory
+func (mmReassignCategory *mBudgetRepositoryMockReassignCategory) ExpectFromCategoryIDParam2(fromCategoryID uint64) *mBudgetRepositoryMockReassignCategory {
+	if mmReassignCategory.mock.funcReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("BudgetRepositoryMock.ReassignCategory mock is already set by Set")
+	}
+
+	if mmReassignCategory.defaultExpectation == nil {
+		mmReassignCategory.defaultExpectation = &BudgetRepositoryMockReassignCategoryExpectation{}
+	}
+
+	if mmReassignCategory.defaultExpectation.params != nil {
+		mmReassignCategory.mock.t.Fatalf("BudgetRepositoryMock.ReassignCategory mock is already set by Expect")
+	}
+
+	if mmReassignCategory.defaultExpectation.paramPtrs == nil {
+		mmReassignCategory.defaultExpectation.paramPtrs = &BudgetRepositoryMockReassignCategoryParamPtrs{}
+	}
+	mmReassignCategory.defaultExpectation.paramPtrs.fromCategoryID = &fromCategoryID
+	mmReassignCategory.defaultExpectation.expectationOrigins.originFromCategoryID = minimock.CallerInfo(1)
+
+	return mmReassignCategory
+}
+
+// ExpectToCategoryIDParam3 sets up expected param toCategoryID for BudgetRepository.ReassignCategory
+func (mmReassignCategory *mBudgetRepositoryMockReassignCategory) ExpectToCategoryIDParam3(toCategoryID uint64) *mBudgetRepositoryMockReassignCategory {
+	if mmReassignCategory.mock.funcReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("BudgetRepositoryMock.ReassignCategory mock is already set by Set")
+	}
+
+	if mmReassignCategory.defaultExpectation == nil {
+		mmReassignCategory.defaultExpectation = &BudgetRepositoryMockReassignCategoryExpectation{}
+	}
+
+	if mmReassignCategory.defaultExpectation.params != nil {
+		mmReassignCategory.mock.t.Fatalf("BudgetRepositoryMock.ReassignCategory mock is already set by Expect")
+	}
+
+	if mmReassignCategory.defaultExpectation.paramPtrs == nil {
+		mmReassignCategory.defaultExpectation.paramPtrs = &BudgetRepositoryMockReassignCategoryParamPtrs{}
+	}
+	mmReassignCategory.defaultExpectation.paramPtrs.toCategoryID = &toCategoryID
+	mmReassignCategory.defaultExpectation.expectationOrigins.originToCategoryID = minimock.CallerInfo(1)
+
+	return mmReassignCategory
+}
+
+// Inspect accepts an inspector function that has same arguments as the BudgetRepository.ReassignCategory
+func (mmReassignCategory *mBudgetRepositoryMockReassignCategory) Inspect(f func(ctx context.Context, fromCategoryID uint64, toCategoryID uint64)) *mBudgetRepositoryMockReassignCategory {
+	if mmReassignCategory.mock.inspectFuncReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("Inspect function is already set for BudgetRepositoryMock.ReassignCategory")
+	}
+
+	mmReassignCategory.mock.inspectFuncReassignCategory = f
+
+	return mmReassignCategory
+}
+
+// Return sets up results that will be returned by BudgetRepository.ReassignCategory
+func (mmReassignCategory *mBudgetRepositoryMockReassignCategory) Return(err error) *BudgetRepositoryMock {
+	if mmReassignCategory.mock.funcReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("BudgetRepositoryMock.ReassignCategory mock is already set by Set")
+	}
+
+	if mmReassignCategory.defaultExpectation == nil {
+		mmReassignCategory.defaultExpectation = &BudgetRepositoryMockReassignCategoryExpectation{mock: mmReassignCategory.mock}
+	}
+	mmReassignCategory.defaultExpectation.results = &BudgetRepositoryMockReassignCategoryResults{err}
+	mmReassignCategory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReassignCategory.mock
+}
+
+// Set uses given function f to mock the BudgetRepository.ReassignCategory method
+func (mmReassignCategory *mBudgetRepositoryMockReassignCategory) Set(f func(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) (err error)) *BudgetRepositoryMock {
+	if mmReassignCategory.defaultExpectation != nil {
+		mmReassignCategory.mock.t.Fatalf("Default expectation is already set for the BudgetRepository.ReassignCategory method")
+	}
+
+	if len(mmReassignCategory.expectations) > 0 {
+		mmReassignCategory.mock.t.Fatalf("Some expectations are already set for the BudgetRepository.ReassignCategory method")
+	}
+
+	mmReassignCategory.mock.funcReassignCategory = f
+	mmReassignCategory.mock.funcReassignCategoryOrigin = minimock.CallerInfo(1)
+	return mmReassignCategory.mock
+}
+
+// When sets expectation for the BudgetRepository.ReassignCategory which will trigger the result defined by the following
+// Then helper
+func (mmReassignCategory *mBudgetRepositoryMockReassignCategory) When(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) *BudgetRepositoryMockReassignCategoryExpectation {
+	if mmReassignCategory.mock.funcReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("BudgetRepositoryMock.ReassignCategory mock is already set by Set")
+	}
+
+	expectation := &BudgetRepositoryMockReassignCategoryExpectation{
+		mock:               mmReassignCategory.mock,
+		params:             &BudgetRepositoryMockReassignCategoryParams{ctx, fromCategoryID, toCategoryID},
+		expectationOrigins: BudgetRepositoryMockReassignCategoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReassignCategory.expectations = append(mmReassignCategory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BudgetRepository.ReassignCategory return parameters for the expectation previously defined by the When method
+func (e *BudgetRepositoryMockReassignCategoryExpectation) Then(err error) *BudgetRepositoryMock {
+	e.results = &BudgetRepositoryMockReassignCategoryResults{err}
+	return e.mock
+}
+
+// Times sets number of times BudgetRepository.ReassignCategory should be invoked
+func (mmReassignCategory *mBudgetRepositoryMockReassignCategory) Times(n uint64) *mBudgetRepositoryMockReassignCategory {
+	if n == 0 {
+		mmReassignCategory.mock.t.Fatalf("Times of BudgetRepositoryMock.ReassignCategory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReassignCategory.expectedInvocations, n)
+	mmReassignCategory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReassignCategory
+}
+
+func (mmReassignCategory *mBudgetRepositoryMockReassignCategory) invocationsDone() bool {
+	if len(mmReassignCategory.expectations) == 0 && mmReassignCategory.defaultExpectation == nil && mmReassignCategory.mock.funcReassignCategory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReassignCategory.mock.afterReassignCategoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReassignCategory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReassignCategory implements mm_usecase.BudgetRepository
+func (mmReassignCategory *BudgetRepositoryMock) ReassignCategory(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) (err error) {
+	mm_atomic.AddUint64(&mmReassignCategory.beforeReassignCategoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmReassignCategory.afterReassignCategoryCounter, 1)
+
+	mmReassignCategory.t.Helper()
+
+	if mmReassignCategory.inspectFuncReassignCategory != nil {
+		mmReassignCategory.inspectFuncReassignCategory(ctx, fromCategoryID, toCategoryID)
+	}
+
+	mm_params := BudgetRepositoryMockReassignCategoryParams{ctx, fromCategoryID, toCategoryID}
+
+	// Record call args
+	mmReassignCategory.ReassignCategoryMock.mutex.Lock()
+	mmReassignCategory.ReassignCategoryMock.callArgs = append(mmReassignCategory.ReassignCategoryMock.callArgs, &mm_params)
+	mmReassignCategory.ReassignCategoryMock.mutex.Unlock()
+
+	for _, e := range mmReassignCategory.ReassignCategoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReassignCategory.ReassignCategoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReassignCategory.ReassignCategoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmReassignCategory.ReassignCategoryMock.defaultExpectation.params
+		mm_want_ptrs := mmReassignCategory.ReassignCategoryMock.defaultExpectation.paramPtrs
+
+		mm_got := BudgetRepositoryMockReassignCategoryParams{ctx, fromCategoryID, toCategoryID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReassignCategory.t.Errorf("BudgetRepositoryMock.ReassignCategory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReassignCategory.ReassignCategoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fromCategoryID != nil && !minimock.Equal(*mm_want_ptrs.fromCategoryID, mm_got.fromCategoryID) {
+				mmReassignCategory.t.Errorf("BudgetRepositoryMock.ReassignCategory got unexpected parameter fromCategoryID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReassignCategory.ReassignCategoryMock.defaultExpectation.expectationOrigins.originFromCategoryID, *mm_want_ptrs.fromCategoryID, mm_got.fromCategoryID, minimock.Diff(*mm_want_ptrs.fromCategoryID, mm_got.fromCategoryID))
+			}
+
+			if mm_want_ptrs.toCategoryID != nil && !minimock.Equal(*mm_want_ptrs.toCategoryID, mm_got.toCategoryID) {
+				mmReassignCategory.t.Errorf("BudgetRepositoryMock.ReassignCategory got unexpected parameter toCategoryID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReassignCategory.ReassignCategoryMock.defaultExpectation.expectationOrigins.originToCategoryID, *mm_want_ptrs.toCategoryID, mm_got.toCategoryID, minimock.Diff(*mm_want_ptrs.toCategoryID, mm_got.toCategoryID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReassignCategory.t.Errorf("BudgetRepositoryMock.ReassignCategory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReassignCategory.ReassignCategoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReassignCategory.ReassignCategoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReassignCategory.t.Fatal("No results are set for the BudgetRepositoryMock.ReassignCategory")
+		}
+		return (*mm_results).err
+	}
+	if mmReassignCategory.funcReassignCategory != nil {
+		return mmReassignCategory.funcReassignCategory(ctx, fromCategoryID, toCategoryID)
+	}
+	mmReassignCategory.t.Fatalf("Unexpected call to BudgetRepositoryMock.ReassignCategory. %v %v %v", ctx, fromCategoryID, toCategoryID)
+	return
+}
+
+// ReassignCategoryAfterCounter returns a count of finished BudgetRepositoryMock.ReassignCategory invocations
+func (mmReassignCategory *BudgetRepositoryMock) ReassignCategoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReassignCategory.afterReassignCategoryCounter)
+}
+
+// ReassignCategoryBeforeCounter returns a count of BudgetRepositoryMock.ReassignCategory invocations
+func (mmReassignCategory *BudgetRepositoryMock) ReassignCategoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReassignCategory.beforeReassignCategoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to BudgetRepositoryMock.ReassignCategory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReassignCategory *mBudgetRepositoryMockReassignCategory) Calls() []*BudgetRepositoryMockReassignCategoryParams {
+	mmReassignCategory.mutex.RLock()
+
+	argCopy := make([]*BudgetRepositoryMockReassignCategoryParams, len(mmReassignCategory.callArgs))
+	copy(argCopy, mmReassignCategory.callArgs)
+
+	mmReassignCategory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReassignCategoryDone returns true if the count of the ReassignCategory invocations corresponds
+// the number of defined expectations
+func (m *BudgetRepositoryMock) MinimockReassignCategoryDone() bool {
+	if m.ReassignCategoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReassignCategoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReassignCategoryMock.invocationsDone()
+}
+
+// MinimockReassignCategoryInspect logs each unmet expectation
+func (m *BudgetRepositoryMock) MinimockReassignCategoryInspect() {
+	for _, e := range m.ReassignCategoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BudgetRepositoryMock.ReassignCategory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReassignCategoryCounter := mm_atomic.LoadUint64(&m.afterReassignCategoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReassignCategoryMock.defaultExpectation != nil && afterReassignCategoryCounter < 1 {
+		if m.ReassignCategoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BudgetRepositoryMock.ReassignCategory at\n%s", m.ReassignCategoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BudgetRepositoryMock.ReassignCategory at\n%s with params: %#v", m.ReassignCategoryMock.defaultExpectation.expectationOrigins.origin, *m.ReassignCategoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReassignCategory != nil && afterReassignCategoryCounter < 1 {
+		m.t.Errorf("Expected call to BudgetRepositoryMock.ReassignCategory at\n%s", m.funcReassignCategoryOrigin)
+	}
+
+	if !m.ReassignCategoryMock.invocationsDone() && afterReassignCategoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to BudgetRepositoryMock.ReassignCategory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReassignCategoryMock.expectedInvocations), m.ReassignCategoryMock.expectedInvocationsOrigin, afterReassignCategoryCounter)
+	}
+}
+
 type mBudgetRepositoryMockUpdate struct {
 	optional           bool
 	mock               *BudgetRepositoryMock
@@ -1897,6 +2633,8 @@ func (m *BudgetRepositoryMock) MinimockUpdateInspect() {
 func (m *BudgetRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockCountInspect()
+
 			m.MinimockCreateInspect()
 
 			m.MinimockFindListInspect()
@@ -1905,6 +2643,8 @@ func (m *BudgetRepositoryMock) MinimockFinish() {
 
 			m.MinimockFindPagedListInspect()
 
+			m.MinimockReassignCategoryInspect()
+
 			m.MinimockUpdateInspect()
 		}
 	})
@@ -1929,9 +2669,11 @@ func (m *BudgetRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *BudgetRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockCountDone() &&
 		m.MinimockCreateDone() &&
 		m.MinimockFindListDone() &&
 		m.MinimockFindOneByIDDone() &&
 		m.MinimockFindPagedListDone() &&
+		m.MinimockReassignCategoryDone() &&
 		m.MinimockUpdateDone()
 }
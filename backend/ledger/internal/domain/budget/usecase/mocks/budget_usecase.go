@@ -10,6 +10,7 @@ import (
 	mm_atomic "sync/atomic"
 	mm_time "time"
 
+	"cloud.google.com/go/civil"
 	"github.com/gojuno/minimock/v3"
 	"github.com/google/uuid"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
@@ -21,6 +22,13 @@ type BudgetUsecaseMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcCopyBudgets          func(ctx context.Context, in mm_usecase.CopyBudgetsDataInput) (resResult *mm_usecase.CopyBudgetsResult, err error)
+	funcCopyBudgetsOrigin    string
+	inspectFuncCopyBudgets   func(ctx context.Context, in mm_usecase.CopyBudgetsDataInput)
+	afterCopyBudgetsCounter  uint64
+	beforeCopyBudgetsCounter uint64
+	CopyBudgetsMock          mBudgetUsecaseMockCopyBudgets
+
 	funcCreateBudgetByDTO          func(ctx context.Context, in mm_usecase.CreateBudgetDataInput) (resBudgetDTO *mm_usecase.BudgetDTO, err error)
 	funcCreateBudgetByDTOOrigin    string
 	inspectFuncCreateBudgetByDTO   func(ctx context.Context, in mm_usecase.CreateBudgetDataInput)
@@ -28,13 +36,20 @@ type BudgetUsecaseMock struct {
 	beforeCreateBudgetByDTOCounter uint64
 	CreateBudgetByDTOMock          mBudgetUsecaseMockCreateBudgetByDTO
 
-	funcDeleteBudgetByID          func(ctx context.Context, id uuid.UUID) (err error)
+	funcDeleteBudgetByID          func(ctx context.Context, id uuid.UUID, version int64, skipVersionCheck bool) (err error)
 	funcDeleteBudgetByIDOrigin    string
-	inspectFuncDeleteBudgetByID   func(ctx context.Context, id uuid.UUID)
+	inspectFuncDeleteBudgetByID   func(ctx context.Context, id uuid.UUID, version int64, skipVersionCheck bool)
 	afterDeleteBudgetByIDCounter  uint64
 	beforeDeleteBudgetByIDCounter uint64
 	DeleteBudgetByIDMock          mBudgetUsecaseMockDeleteBudgetByID
 
+	funcFindCursorList          func(ctx context.Context, listOptions *mm_usecase.BudgetListOptions, limit uint64, withTotal bool) (resPage *mm_usecase.BudgetCursorPage, err error)
+	funcFindCursorListOrigin    string
+	inspectFuncFindCursorList   func(ctx context.Context, listOptions *mm_usecase.BudgetListOptions, limit uint64, withTotal bool)
+	afterFindCursorListCounter  uint64
+	beforeFindCursorListCounter uint64
+	FindCursorListMock          mBudgetUsecaseMockFindCursorList
+
 	funcFindList          func(ctx context.Context, listOptions *mm_usecase.BudgetListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.BudgetDTO, cacheHit bool, err error)
 	funcFindListOrigin    string
 	inspectFuncFindList   func(ctx context.Context, listOptions *mm_usecase.BudgetListOptions, queryParams *uctypes.QueryGetListParams)
@@ -56,12 +71,33 @@ type BudgetUsecaseMock struct {
 	beforeFindPagedListCounter uint64
 	FindPagedListMock          mBudgetUsecaseMockFindPagedList
 
+	funcGetBudgetAutoRollover          func(ctx context.Context, accountID uuid.UUID) (resEnabled bool, err error)
+	funcGetBudgetAutoRolloverOrigin    string
+	inspectFuncGetBudgetAutoRollover   func(ctx context.Context, accountID uuid.UUID)
+	afterGetBudgetAutoRolloverCounter  uint64
+	beforeGetBudgetAutoRolloverCounter uint64
+	GetBudgetAutoRolloverMock          mBudgetUsecaseMockGetBudgetAutoRollover
+
 	funcPatchBudgetByDTO          func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchBudgetDataInput, skipVersionCheck bool) (err error)
 	funcPatchBudgetByDTOOrigin    string
 	inspectFuncPatchBudgetByDTO   func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchBudgetDataInput, skipVersionCheck bool)
 	afterPatchBudgetByDTOCounter  uint64
 	beforePatchBudgetByDTOCounter uint64
 	PatchBudgetByDTOMock          mBudgetUsecaseMockPatchBudgetByDTO
+
+	funcRolloverBudgets          func(ctx context.Context, period civil.Date, limit uint64) (processed int, err error)
+	funcRolloverBudgetsOrigin    string
+	inspectFuncRolloverBudgets   func(ctx context.Context, period civil.Date, limit uint64)
+	afterRolloverBudgetsCounter  uint64
+	beforeRolloverBudgetsCounter uint64
+	RolloverBudgetsMock          mBudgetUsecaseMockRolloverBudgets
+
+	funcSetBudgetAutoRollover          func(ctx context.Context, accountID uuid.UUID, enabled bool) (err error)
+	funcSetBudgetAutoRolloverOrigin    string
+	inspectFuncSetBudgetAutoRollover   func(ctx context.Context, accountID uuid.UUID, enabled bool)
+	afterSetBudgetAutoRolloverCounter  uint64
+	beforeSetBudgetAutoRolloverCounter uint64
+	SetBudgetAutoRolloverMock          mBudgetUsecaseMockSetBudgetAutoRollover
 }
 
 // NewBudgetUsecaseMock returns a mock for mm_usecase.BudgetUsecase
@@ -72,12 +108,18 @@ func NewBudgetUsecaseMock(t minimock.Tester) *BudgetUsecaseMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.CopyBudgetsMock = mBudgetUsecaseMockCopyBudgets{mock: m}
+	m.CopyBudgetsMock.callArgs = []*BudgetUsecaseMockCopyBudgetsParams{}
+
 	m.CreateBudgetByDTOMock = mBudgetUsecaseMockCreateBudgetByDTO{mock: m}
 	m.CreateBudgetByDTOMock.callArgs = []*BudgetUsecaseMockCreateBudgetByDTOParams{}
 
 	m.DeleteBudgetByIDMock = mBudgetUsecaseMockDeleteBudgetByID{mock: m}
 	m.DeleteBudgetByIDMock.callArgs = []*BudgetUsecaseMockDeleteBudgetByIDParams{}
 
+	m.FindCursorListMock = mBudgetUsecaseMockFindCursorList{mock: m}
+	m.FindCursorListMock.callArgs = []*BudgetUsecaseMockFindCursorListParams{}
+
 	m.FindListMock = mBudgetUsecaseMockFindList{mock: m}
 	m.FindListMock.callArgs = []*BudgetUsecaseMockFindListParams{}
 
@@ -87,14 +129,366 @@ func NewBudgetUsecaseMock(t minimock.Tester) *BudgetUsecaseMock {
 	m.FindPagedListMock = mBudgetUsecaseMockFindPagedList{mock: m}
 	m.FindPagedListMock.callArgs = []*BudgetUsecaseMockFindPagedListParams{}
 
+	m.GetBudgetAutoRolloverMock = mBudgetUsecaseMockGetBudgetAutoRollover{mock: m}
+	m.GetBudgetAutoRolloverMock.callArgs = []*BudgetUsecaseMockGetBudgetAutoRolloverParams{}
+
 	m.PatchBudgetByDTOMock = mBudgetUsecaseMockPatchBudgetByDTO{mock: m}
 	m.PatchBudgetByDTOMock.callArgs = []*BudgetUsecaseMockPatchBudgetByDTOParams{}
 
+	m.RolloverBudgetsMock = mBudgetUsecaseMockRolloverBudgets{mock: m}
+	m.RolloverBudgetsMock.callArgs = []*BudgetUsecaseMockRolloverBudgetsParams{}
+
+	m.SetBudgetAutoRolloverMock = mBudgetUsecaseMockSetBudgetAutoRollover{mock: m}
+	m.SetBudgetAutoRolloverMock.callArgs = []*BudgetUsecaseMockSetBudgetAutoRolloverParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
+type mBudgetUsecaseMockCopyBudgets struct {
+	optional           bool
+	mock               *BudgetUsecaseMock
+	defaultExpectation *BudgetUsecaseMockCopyBudgetsExpectation
+	expectations       []*BudgetUsecaseMockCopyBudgetsExpectation
+
+	callArgs []*BudgetUsecaseMockCopyBudgetsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BudgetUsecaseMockCopyBudgetsExpectation specifies expectation struct of the BudgetUsecase.CopyBudgets
+type BudgetUsecaseMockCopyBudgetsExpectation struct {
+	mock               *BudgetUsecaseMock
+	params             *BudgetUsecaseMockCopyBudgetsParams
+	paramPtrs          *BudgetUsecaseMockCopyBudgetsParamPtrs
+	expectationOrigins BudgetUsecaseMockCopyBudgetsExpectationOrigins
+	results            *BudgetUsecaseMockCopyBudgetsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BudgetUsecaseMockCopyBudgetsParams contains parameters of the BudgetUsecase.CopyBudgets
+type BudgetUsecaseMockCopyBudgetsParams struct {
+	ctx context.Context
+	in  mm_usecase.CopyBudgetsDataInput
+}
+
+// BudgetUsecaseMockCopyBudgetsParamPtrs contains pointers to parameters of the BudgetUsecase.CopyBudgets
+type BudgetUsecaseMockCopyBudgetsParamPtrs struct {
+	ctx *context.Context
+	in  *mm_usecase.CopyBudgetsDataInput
+}
+
+// BudgetUsecaseMockCopyBudgetsResults contains results of the BudgetUsecase.CopyBudgets
+type BudgetUsecaseMockCopyBudgetsResults struct {
+	resResult *mm_usecase.CopyBudgetsResult
+	err       error
+}
+
+// BudgetUsecaseMockCopyBudgetsOrigins contains origins of expectations of the BudgetUsecase.CopyBudgets
+type BudgetUsecaseMockCopyBudgetsExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCopyBudgets *mBudgetUsecaseMockCopyBudgets) Optional() *mBudgetUsecaseMockCopyBudgets {
+	mmCopyBudgets.optional = true
+	return mmCopyBudgets
+}
+
+// Expect sets up expected params for BudgetUsecase.CopyBudgets
+func (mmCopyBudgets *mBudgetUsecaseMockCopyBudgets) Expect(ctx context.Context, in mm_usecase.CopyBudgetsDataInput) *mBudgetUsecaseMockCopyBudgets {
+	if mmCopyBudgets.mock.funcCopyBudgets != nil {
+		mmCopyBudgets.mock.t.Fatalf("BudgetUsecaseMock.CopyBudgets mock is already set by Set")
+	}
+
+	if mmCopyBudgets.defaultExpectation == nil {
+		mmCopyBudgets.defaultExpectation = &BudgetUsecaseMockCopyBudgetsExpectation{}
+	}
+
+	if mmCopyBudgets.defaultExpectation.paramPtrs != nil {
+		mmCopyBudgets.mock.t.Fatalf("BudgetUsecaseMock.CopyBudgets mock is already set by ExpectParams functions")
+	}
+
+	mmCopyBudgets.defaultExpectation.params = &BudgetUsecaseMockCopyBudgetsParams{ctx, in}
+	mmCopyBudgets.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCopyBudgets.expectations {
+		if minimock.Equal(e.params, mmCopyBudgets.defaultExpectation.params) {
+			mmCopyBudgets.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCopyBudgets.defaultExpectation.params)
+		}
+	}
+
+	return mmCopyBudgets
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BudgetUsecase.CopyBudgets
+func (mmCopyBudgets *mBudgetUsecaseMockCopyBudgets) ExpectCtxParam1(ctx context.Context) *mBudgetUsecaseMockCopyBudgets {
+	if mmCopyBudgets.mock.funcCopyBudgets != nil {
+		mmCopyBudgets.mock.t.Fatalf("BudgetUsecaseMock.CopyBudgets mock is already set by Set")
+	}
+
+	if mmCopyBudgets.defaultExpectation == nil {
+		mmCopyBudgets.defaultExpectation = &BudgetUsecaseMockCopyBudgetsExpectation{}
+	}
+
+	if mmCopyBudgets.defaultExpectation.params != nil {
+		mmCopyBudgets.mock.t.Fatalf("BudgetUsecaseMock.CopyBudgets mock is already set by Expect")
+	}
+
+	if mmCopyBudgets.defaultExpectation.paramPtrs == nil {
+		mmCopyBudgets.defaultExpectation.paramPtrs = &BudgetUsecaseMockCopyBudgetsParamPtrs{}
+	}
+	mmCopyBudgets.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCopyBudgets.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCopyBudgets
+}
+
+// ExpectInParam2 sets up expected param in for BudgetUsecase.CopyBudgets
+func (mmCopyBudgets *mBudgetUsecaseMockCopyBudgets) ExpectInParam2(in mm_usecase.CopyBudgetsDataInput) *mBudgetUsecaseMockCopyBudgets {
+	if mmCopyBudgets.mock.funcCopyBudgets != nil {
+		mmCopyBudgets.mock.t.Fatalf("BudgetUsecaseMock.CopyBudgets mock is already set by Set")
+	}
+
+	if mmCopyBudgets.defaultExpectation == nil {
+		mmCopyBudgets.defaultExpectation = &BudgetUsecaseMockCopyBudgetsExpectation{}
+	}
+
+	if mmCopyBudgets.defaultExpectation.params != nil {
+		mmCopyBudgets.mock.t.Fatalf("BudgetUsecaseMock.CopyBudgets mock is already set by Expect")
+	}
+
+	if mmCopyBudgets.defaultExpectation.paramPtrs == nil {
+		mmCopyBudgets.defaultExpectation.paramPtrs = &BudgetUsecaseMockCopyBudgetsParamPtrs{}
+	}
+	mmCopyBudgets.defaultExpectation.paramPtrs.in = &in
+	mmCopyBudgets.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmCopyBudgets
+}
+
+// Inspect accepts an inspector function that has same arguments as the BudgetUsecase.CopyBudgets
+func (mmCopyBudgets *mBudgetUsecaseMockCopyBudgets) Inspect(f func(ctx context.Context, in mm_usecase.CopyBudgetsDataInput)) *mBudgetUsecaseMockCopyBudgets {
+	if mmCopyBudgets.mock.inspectFuncCopyBudgets != nil {
+		mmCopyBudgets.mock.t.Fatalf("Inspect function is already set for BudgetUsecaseMock.CopyBudgets")
+	}
+
+	mmCopyBudgets.mock.inspectFuncCopyBudgets = f
+
+	return mmCopyBudgets
+}
+
+// Return sets up results that will be returned by BudgetUsecase.CopyBudgets
+func (mmCopyBudgets *mBudgetUsecaseMockCopyBudgets) Return(resResult *mm_usecase.CopyBudgetsResult, err error) *BudgetUsecaseMock {
+	if mmCopyBudgets.mock.funcCopyBudgets != nil {
+		mmCopyBudgets.mock.t.Fatalf("BudgetUsecaseMock.CopyBudgets mock is already set by Set")
+	}
+
+	if mmCopyBudgets.defaultExpectation == nil {
+		mmCopyBudgets.defaultExpectation = &BudgetUsecaseMockCopyBudgetsExpectation{mock: mmCopyBudgets.mock}
+	}
+	mmCopyBudgets.defaultExpectation.results = &BudgetUsecaseMockCopyBudgetsResults{resResult, err}
+	mmCopyBudgets.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCopyBudgets.mock
+}
+
+// Set uses given function f to mock the BudgetUsecase.CopyBudgets method
+func (mmCopyBudgets *mBudgetUsecaseMockCopyBudgets) Set(f func(ctx context.Context, in mm_usecase.CopyBudgetsDataInput) (resResult *mm_usecase.CopyBudgetsResult, err error)) *BudgetUsecaseMock {
+	if mmCopyBudgets.defaultExpectation != nil {
+		mmCopyBudgets.mock.t.Fatalf("Default expectation is already set for the BudgetUsecase.CopyBudgets method")
+	}
+
+	if len(mmCopyBudgets.expectations) > 0 {
+		mmCopyBudgets.mock.t.Fatalf("Some expectations are already set for the BudgetUsecase.CopyBudgets method")
+	}
+
+	mmCopyBudgets.mock.funcCopyBudgets = f
+	mmCopyBudgets.mock.funcCopyBudgetsOrigin = minimock.CallerInfo(1)
+	return mmCopyBudgets.mock
+}
+
+// When sets expectation for the BudgetUsecase.CopyBudgets which will trigger the result defined by the following
+// Then helper
+func (mmCopyBudgets *mBudgetUsecaseMockCopyBudgets) When(ctx context.Context, in mm_usecase.CopyBudgetsDataInput) *BudgetUsecaseMockCopyBudgetsExpectation {
+	if mmCopyBudgets.mock.funcCopyBudgets != nil {
+		mmCopyBudgets.mock.t.Fatalf("BudgetUsecaseMock.CopyBudgets mock is already set by Set")
+	}
+
+	expectation := &BudgetUsecaseMockCopyBudgetsExpectation{
+		mock:               mmCopyBudgets.mock,
+		params:             &BudgetUsecaseMockCopyBudgetsParams{ctx, in},
+		expectationOrigins: BudgetUsecaseMockCopyBudgetsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCopyBudgets.expectations = append(mmCopyBudgets.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BudgetUsecase.CopyBudgets return parameters for the expectation previously defined by the When method
+func (e *BudgetUsecaseMockCopyBudgetsExpectation) Then(resResult *mm_usecase.CopyBudgetsResult, err error) *BudgetUsecaseMock {
+	e.results = &BudgetUsecaseMockCopyBudgetsResults{resResult, err}
+	return e.mock
+}
+
+// Times sets number of times BudgetUsecase.CopyBudgets should be invoked
+func (mmCopyBudgets *mBudgetUsecaseMockCopyBudgets) Times(n uint64) *mBudgetUsecaseMockCopyBudgets {
+	if n == 0 {
+		mmCopyBudgets.mock.t.Fatalf("Times of BudgetUsecaseMock.CopyBudgets mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCopyBudgets.expectedInvocations, n)
+	mmCopyBudgets.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCopyBudgets
+}
+
+func (mmCopyBudgets *mBudgetUsecaseMockCopyBudgets) invocationsDone() bool {
+	if len(mmCopyBudgets.expectations) == 0 && mmCopyBudgets.defaultExpectation == nil && mmCopyBudgets.mock.funcCopyBudgets == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCopyBudgets.mock.afterCopyBudgetsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCopyBudgets.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CopyBudgets implements mm_usecase.BudgetUsecase
+func (mmCopyBudgets *BudgetUsecaseMock) CopyBudgets(ctx context.Context, in mm_usecase.CopyBudgetsDataInput) (resResult *mm_usecase.CopyBudgetsResult, err error) {
+	mm_atomic.AddUint64(&mmCopyBudgets.beforeCopyBudgetsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCopyBudgets.afterCopyBudgetsCounter, 1)
+
+	mmCopyBudgets.t.Helper()
+
+	if mmCopyBudgets.inspectFuncCopyBudgets != nil {
+		mmCopyBudgets.inspectFuncCopyBudgets(ctx, in)
+	}
+
+	mm_params := BudgetUsecaseMockCopyBudgetsParams{ctx, in}
+
+	// Record call args
+	mmCopyBudgets.CopyBudgetsMock.mutex.Lock()
+	mmCopyBudgets.CopyBudgetsMock.callArgs = append(mmCopyBudgets.CopyBudgetsMock.callArgs, &mm_params)
+	mmCopyBudgets.CopyBudgetsMock.mutex.Unlock()
+
+	for _, e := range mmCopyBudgets.CopyBudgetsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resResult, e.results.err
+		}
+	}
+
+	if mmCopyBudgets.CopyBudgetsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCopyBudgets.CopyBudgetsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCopyBudgets.CopyBudgetsMock.defaultExpectation.params
+		mm_want_ptrs := mmCopyBudgets.CopyBudgetsMock.defaultExpectation.paramPtrs
+
+		mm_got := BudgetUsecaseMockCopyBudgetsParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCopyBudgets.t.Errorf("BudgetUsecaseMock.CopyBudgets got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCopyBudgets.CopyBudgetsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmCopyBudgets.t.Errorf("BudgetUsecaseMock.CopyBudgets got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCopyBudgets.CopyBudgetsMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCopyBudgets.t.Errorf("BudgetUsecaseMock.CopyBudgets got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCopyBudgets.CopyBudgetsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCopyBudgets.CopyBudgetsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCopyBudgets.t.Fatal("No results are set for the BudgetUsecaseMock.CopyBudgets")
+		}
+		return (*mm_results).resResult, (*mm_results).err
+	}
+	if mmCopyBudgets.funcCopyBudgets != nil {
+		return mmCopyBudgets.funcCopyBudgets(ctx, in)
+	}
+	mmCopyBudgets.t.Fatalf("Unexpected call to BudgetUsecaseMock.CopyBudgets. %v %v", ctx, in)
+	return
+}
+
+// CopyBudgetsAfterCounter returns a count of finished BudgetUsecaseMock.CopyBudgets invocations
+func (mmCopyBudgets *BudgetUsecaseMock) CopyBudgetsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCopyBudgets.afterCopyBudgetsCounter)
+}
+
+// CopyBudgetsBeforeCounter returns a count of BudgetUsecaseMock.CopyBudgets invocations
+func (mmCopyBudgets *BudgetUsecaseMock) CopyBudgetsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCopyBudgets.beforeCopyBudgetsCounter)
+}
+
+// Calls returns a list of arguments used in each call to BudgetUsecaseMock.CopyBudgets.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCopyBudgets *mBudgetUsecaseMockCopyBudgets) Calls() []*BudgetUsecaseMockCopyBudgetsParams {
+	mmCopyBudgets.mutex.RLock()
+
+	argCopy := make([]*BudgetUsecaseMockCopyBudgetsParams, len(mmCopyBudgets.callArgs))
+	copy(argCopy, mmCopyBudgets.callArgs)
+
+	mmCopyBudgets.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCopyBudgetsDone returns true if the count of the CopyBudgets invocations corresponds
+// the number of defined expectations
+func (m *BudgetUsecaseMock) MinimockCopyBudgetsDone() bool {
+	if m.CopyBudgetsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CopyBudgetsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CopyBudgetsMock.invocationsDone()
+}
+
+// MinimockCopyBudgetsInspect logs each unmet expectation
+func (m *BudgetUsecaseMock) MinimockCopyBudgetsInspect() {
+	for _, e := range m.CopyBudgetsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BudgetUsecaseMock.CopyBudgets at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCopyBudgetsCounter := mm_atomic.LoadUint64(&m.afterCopyBudgetsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CopyBudgetsMock.defaultExpectation != nil && afterCopyBudgetsCounter < 1 {
+		if m.CopyBudgetsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BudgetUsecaseMock.CopyBudgets at\n%s", m.CopyBudgetsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BudgetUsecaseMock.CopyBudgets at\n%s with params: %#v", m.CopyBudgetsMock.defaultExpectation.expectationOrigins.origin, *m.CopyBudgetsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCopyBudgets != nil && afterCopyBudgetsCounter < 1 {
+		m.t.Errorf("Expected call to BudgetUsecaseMock.CopyBudgets at\n%s", m.funcCopyBudgetsOrigin)
+	}
+
+	if !m.CopyBudgetsMock.invocationsDone() && afterCopyBudgetsCounter > 0 {
+		m.t.Errorf("Expected %d calls to BudgetUsecaseMock.CopyBudgets at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CopyBudgetsMock.expectedInvocations), m.CopyBudgetsMock.expectedInvocationsOrigin, afterCopyBudgetsCounter)
+	}
+}
+
 type mBudgetUsecaseMockCreateBudgetByDTO struct {
 	optional           bool
 	mock               *BudgetUsecaseMock
@@ -464,14 +858,18 @@ type BudgetUsecaseMockDeleteBudgetByIDExpectation struct {
 
 // BudgetUsecaseMockDeleteBudgetByIDParams contains parameters of the BudgetUsecase.DeleteBudgetByID
 type BudgetUsecaseMockDeleteBudgetByIDParams struct {
-	ctx context.Context
-	id  uuid.UUID
+	ctx              context.Context
+	id               uuid.UUID
+	version          int64
+	skipVersionCheck bool
 }
 
 // BudgetUsecaseMockDeleteBudgetByIDParamPtrs contains pointers to parameters of the BudgetUsecase.DeleteBudgetByID
 type BudgetUsecaseMockDeleteBudgetByIDParamPtrs struct {
-	ctx *context.Context
-	id  *uuid.UUID
+	ctx              *context.Context
+	id               *uuid.UUID
+	version          *int64
+	skipVersionCheck *bool
 }
 
 // BudgetUsecaseMockDeleteBudgetByIDResults contains results of the BudgetUsecase.DeleteBudgetByID
@@ -481,9 +879,11 @@ type BudgetUsecaseMockDeleteBudgetByIDResults struct {
 
 // BudgetUsecaseMockDeleteBudgetByIDOrigins contains origins of expectations of the BudgetUsecase.DeleteBudgetByID
 type BudgetUsecaseMockDeleteBudgetByIDExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+	origin                 string
+	originCtx              string
+	originId               string
+	originVersion          string
+	originSkipVersionCheck string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -497,7 +897,7 @@ func (mmDeleteBudgetByID *mBudgetUsecaseMockDeleteBudgetByID) Optional() *mBudge
 }
 
 // Expect sets up expected params for BudgetUsecase.DeleteBudgetByID
-func (mmDeleteBudgetByID *mBudgetUsecaseMockDeleteBudgetByID) Expect(ctx context.Context, id uuid.UUID) *mBudgetUsecaseMockDeleteBudgetByID {
+func (mmDeleteBudgetByID *mBudgetUsecaseMockDeleteBudgetByID) Expect(ctx context.Context, id uuid.UUID, version int64, skipVersionCheck bool) *mBudgetUsecaseMockDeleteBudgetByID {
 	if mmDeleteBudgetByID.mock.funcDeleteBudgetByID != nil {
 		mmDeleteBudgetByID.mock.t.Fatalf("BudgetUsecaseMock.DeleteBudgetByID mock is already set by Set")
 	}
@@ -510,7 +910,7 @@ func (mmDeleteBudgetByID *mBudgetUsecaseMockDeleteBudgetByID) Expect(ctx context
 		mmDeleteBudgetByID.mock.t.Fatalf("BudgetUsecaseMock.DeleteBudgetByID mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteBudgetByID.defaultExpectation.params = &BudgetUsecaseMockDeleteBudgetByIDParams{ctx, id}
+	mmDeleteBudgetByID.defaultExpectation.params = &BudgetUsecaseMockDeleteBudgetByIDParams{ctx, id, version, skipVersionCheck}
 	mmDeleteBudgetByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmDeleteBudgetByID.expectations {
 		if minimock.Equal(e.params, mmDeleteBudgetByID.defaultExpectation.params) {
@@ -567,8 +967,54 @@ func (mmDeleteBudgetByID *mBudgetUsecaseMockDeleteBudgetByID) ExpectIdParam2(id
 	return mmDeleteBudgetByID
 }
 
+// ExpectVersionParam3 sets up expected param version for BudgetUsecase.DeleteBudgetByID
+func (mmDeleteBudgetByID *mBudgetUsecaseMockDeleteBudgetByID) ExpectVersionParam3(version int64) *mBudgetUsecaseMockDeleteBudgetByID {
+	if mmDeleteBudgetByID.mock.funcDeleteBudgetByID != nil {
+		mmDeleteBudgetByID.mock.t.Fatalf("BudgetUsecaseMock.DeleteBudgetByID mock is already set by Set")
+	}
+
+	if mmDeleteBudgetByID.defaultExpectation == nil {
+		mmDeleteBudgetByID.defaultExpectation = &BudgetUsecaseMockDeleteBudgetByIDExpectation{}
+	}
+
+	if mmDeleteBudgetByID.defaultExpectation.params != nil {
+		mmDeleteBudgetByID.mock.t.Fatalf("BudgetUsecaseMock.DeleteBudgetByID mock is already set by Expect")
+	}
+
+	if mmDeleteBudgetByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteBudgetByID.defaultExpectation.paramPtrs = &BudgetUsecaseMockDeleteBudgetByIDParamPtrs{}
+	}
+	mmDeleteBudgetByID.defaultExpectation.paramPtrs.version = &version
+	mmDeleteBudgetByID.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmDeleteBudgetByID
+}
+
+// ExpectSkipVersionCheckParam4 sets up expected param skipVersionCheck for BudgetUsecase.DeleteBudgetByID
+func (mmDeleteBudgetByID *mBudgetUsecaseMockDeleteBudgetByID) ExpectSkipVersionCheckParam4(skipVersionCheck bool) *mBudgetUsecaseMockDeleteBudgetByID {
+	if mmDeleteBudgetByID.mock.funcDeleteBudgetByID != nil {
+		mmDeleteBudgetByID.mock.t.Fatalf("BudgetUsecaseMock.DeleteBudgetByID mock is already set by Set")
+	}
+
+	if mmDeleteBudgetByID.defaultExpectation == nil {
+		mmDeleteBudgetByID.defaultExpectation = &BudgetUsecaseMockDeleteBudgetByIDExpectation{}
+	}
+
+	if mmDeleteBudgetByID.defaultExpectation.params != nil {
+		mmDeleteBudgetByID.mock.t.Fatalf("BudgetUsecaseMock.DeleteBudgetByID mock is already set by Expect")
+	}
+
+	if mmDeleteBudgetByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteBudgetByID.defaultExpectation.paramPtrs = &BudgetUsecaseMockDeleteBudgetByIDParamPtrs{}
+	}
+	mmDeleteBudgetByID.defaultExpectation.paramPtrs.skipVersionCheck = &skipVersionCheck
+	mmDeleteBudgetByID.defaultExpectation.expectationOrigins.originSkipVersionCheck = minimock.CallerInfo(1)
+
+	return mmDeleteBudgetByID
+}
+
 // Inspect accepts an inspector function that has same arguments as the BudgetUsecase.DeleteBudgetByID
-func (mmDeleteBudgetByID *mBudgetUsecaseMockDeleteBudgetByID) Inspect(f func(ctx context.Context, id uuid.UUID)) *mBudgetUsecaseMockDeleteBudgetByID {
+func (mmDeleteBudgetByID *mBudgetUsecaseMockDeleteBudgetByID) Inspect(f func(ctx context.Context, id uuid.UUID, version int64, skipVersionCheck bool)) *mBudgetUsecaseMockDeleteBudgetByID {
 	if mmDeleteBudgetByID.mock.inspectFuncDeleteBudgetByID != nil {
 		mmDeleteBudgetByID.mock.t.Fatalf("Inspect function is already set for BudgetUsecaseMock.DeleteBudgetByID")
 	}
@@ -593,7 +1039,7 @@ func (mmDeleteBudgetByID *mBudgetUsecaseMockDeleteBudgetByID) Return(err error)
 }
 
 // Set uses given function f to mock the BudgetUsecase.DeleteBudgetByID method
-func (mmDeleteBudgetByID *mBudgetUsecaseMockDeleteBudgetByID) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *BudgetUsecaseMock {
+func (mmDeleteBudgetByID *mBudgetUsecaseMockDeleteBudgetByID) Set(f func(ctx context.Context, id uuid.UUID, version int64, skipVersionCheck bool) (err error)) *BudgetUsecaseMock {
 	if mmDeleteBudgetByID.defaultExpectation != nil {
 		mmDeleteBudgetByID.mock.t.Fatalf("Default expectation is already set for the BudgetUsecase.DeleteBudgetByID method")
 	}
@@ -609,14 +1055,14 @@ func (mmDeleteBudgetByID *mBudgetUsecaseMockDeleteBudgetByID) Set(f func(ctx con
 
 // When sets expectation for the BudgetUsecase.DeleteBudgetByID which will trigger the result defined by the following
 // Then helper
-func (mmDeleteBudgetByID *mBudgetUsecaseMockDeleteBudgetByID) When(ctx context.Context, id uuid.UUID) *BudgetUsecaseMockDeleteBudgetByIDExpectation {
+func (mmDeleteBudgetByID *mBudgetUsecaseMockDeleteBudgetByID) When(ctx context.Context, id uuid.UUID, version int64, skipVersionCheck bool) *BudgetUsecaseMockDeleteBudgetByIDExpectation {
 	if mmDeleteBudgetByID.mock.funcDeleteBudgetByID != nil {
 		mmDeleteBudgetByID.mock.t.Fatalf("BudgetUsecaseMock.DeleteBudgetByID mock is already set by Set")
 	}
 
 	expectation := &BudgetUsecaseMockDeleteBudgetByIDExpectation{
 		mock:               mmDeleteBudgetByID.mock,
-		params:             &BudgetUsecaseMockDeleteBudgetByIDParams{ctx, id},
+		params:             &BudgetUsecaseMockDeleteBudgetByIDParams{ctx, id, version, skipVersionCheck},
 		expectationOrigins: BudgetUsecaseMockDeleteBudgetByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmDeleteBudgetByID.expectations = append(mmDeleteBudgetByID.expectations, expectation)
@@ -651,17 +1097,17 @@ func (mmDeleteBudgetByID *mBudgetUsecaseMockDeleteBudgetByID) invocationsDone()
 }
 
 // DeleteBudgetByID implements mm_usecase.BudgetUsecase
-func (mmDeleteBudgetByID *BudgetUsecaseMock) DeleteBudgetByID(ctx context.Context, id uuid.UUID) (err error) {
+func (mmDeleteBudgetByID *BudgetUsecaseMock) DeleteBudgetByID(ctx context.Context, id uuid.UUID, version int64, skipVersionCheck bool) (err error) {
 	mm_atomic.AddUint64(&mmDeleteBudgetByID.beforeDeleteBudgetByIDCounter, 1)
 	defer mm_atomic.AddUint64(&mmDeleteBudgetByID.afterDeleteBudgetByIDCounter, 1)
 
 	mmDeleteBudgetByID.t.Helper()
 
 	if mmDeleteBudgetByID.inspectFuncDeleteBudgetByID != nil {
-		mmDeleteBudgetByID.inspectFuncDeleteBudgetByID(ctx, id)
+		mmDeleteBudgetByID.inspectFuncDeleteBudgetByID(ctx, id, version, skipVersionCheck)
 	}
 
-	mm_params := BudgetUsecaseMockDeleteBudgetByIDParams{ctx, id}
+	mm_params := BudgetUsecaseMockDeleteBudgetByIDParams{ctx, id, version, skipVersionCheck}
 
 	// Record call args
 	mmDeleteBudgetByID.DeleteBudgetByIDMock.mutex.Lock()
@@ -680,7 +1126,7 @@ func (mmDeleteBudgetByID *BudgetUsecaseMock) DeleteBudgetByID(ctx context.Contex
 		mm_want := mmDeleteBudgetByID.DeleteBudgetByIDMock.defaultExpectation.params
 		mm_want_ptrs := mmDeleteBudgetByID.DeleteBudgetByIDMock.defaultExpectation.paramPtrs
 
-		mm_got := BudgetUsecaseMockDeleteBudgetByIDParams{ctx, id}
+		mm_got := BudgetUsecaseMockDeleteBudgetByIDParams{ctx, id, version, skipVersionCheck}
 
 		if mm_want_ptrs != nil {
 
@@ -694,6 +1140,16 @@ func (mmDeleteBudgetByID *BudgetUsecaseMock) DeleteBudgetByID(ctx context.Contex
 					mmDeleteBudgetByID.DeleteBudgetByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmDeleteBudgetByID.t.Errorf("BudgetUsecaseMock.DeleteBudgetByID got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteBudgetByID.DeleteBudgetByIDMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+			if mm_want_ptrs.skipVersionCheck != nil && !minimock.Equal(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck) {
+				mmDeleteBudgetByID.t.Errorf("BudgetUsecaseMock.DeleteBudgetByID got unexpected parameter skipVersionCheck, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteBudgetByID.DeleteBudgetByIDMock.defaultExpectation.expectationOrigins.originSkipVersionCheck, *mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck, minimock.Diff(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmDeleteBudgetByID.t.Errorf("BudgetUsecaseMock.DeleteBudgetByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmDeleteBudgetByID.DeleteBudgetByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -706,9 +1162,9 @@ func (mmDeleteBudgetByID *BudgetUsecaseMock) DeleteBudgetByID(ctx context.Contex
 		return (*mm_results).err
 	}
 	if mmDeleteBudgetByID.funcDeleteBudgetByID != nil {
-		return mmDeleteBudgetByID.funcDeleteBudgetByID(ctx, id)
+		return mmDeleteBudgetByID.funcDeleteBudgetByID(ctx, id, version, skipVersionCheck)
 	}
-	mmDeleteBudgetByID.t.Fatalf("Unexpected call to BudgetUsecaseMock.DeleteBudgetByID. %v %v", ctx, id)
+	mmDeleteBudgetByID.t.Fatalf("Unexpected call to BudgetUsecaseMock.DeleteBudgetByID. %v %v %v %v", ctx, id, version, skipVersionCheck)
 	return
 }
 
@@ -780,46 +1236,451 @@ func (m *BudgetUsecaseMock) MinimockDeleteBudgetByIDInspect() {
 	}
 }
 
-type mBudgetUsecaseMockFindList struct {
+type mBudgetUsecaseMockFindCursorList struct {
 	optional           bool
 	mock               *BudgetUsecaseMock
-	defaultExpectation *BudgetUsecaseMockFindListExpectation
-	expectations       []*BudgetUsecaseMockFindListExpectation
+	defaultExpectation *BudgetUsecaseMockFindCursorListExpectation
+	expectations       []*BudgetUsecaseMockFindCursorListExpectation
 
-	callArgs []*BudgetUsecaseMockFindListParams
+	callArgs []*BudgetUsecaseMockFindCursorListParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// BudgetUsecaseMockFindListExpectation specifies expectation struct of the BudgetUsecase.FindList
-type BudgetUsecaseMockFindListExpectation struct {
+// BudgetUsecaseMockFindCursorListExpectation specifies expectation struct of the BudgetUsecase.FindCursorList
+type BudgetUsecaseMockFindCursorListExpectation struct {
 	mock               *BudgetUsecaseMock
-	params             *BudgetUsecaseMockFindListParams
-	paramPtrs          *BudgetUsecaseMockFindListParamPtrs
-	expectationOrigins BudgetUsecaseMockFindListExpectationOrigins
-	results            *BudgetUsecaseMockFindListResults
+	params             *BudgetUsecaseMockFindCursorListParams
+	paramPtrs          *BudgetUsecaseMockFindCursorListParamPtrs
+	expectationOrigins BudgetUsecaseMockFindCursorListExpectationOrigins
+	results            *BudgetUsecaseMockFindCursorListResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// BudgetUsecaseMockFindListParams contains parameters of the BudgetUsecase.FindList
-type BudgetUsecaseMockFindListParams struct {
+// BudgetUsecaseMockFindCursorListParams contains parameters of the BudgetUsecase.FindCursorList
+type BudgetUsecaseMockFindCursorListParams struct {
 	ctx         context.Context
 	listOptions *mm_usecase.BudgetListOptions
-	queryParams *uctypes.QueryGetListParams
+	limit       uint64
+	withTotal   bool
 }
 
-// BudgetUsecaseMockFindListParamPtrs contains pointers to parameters of the BudgetUsecase.FindList
-type BudgetUsecaseMockFindListParamPtrs struct {
+// BudgetUsecaseMockFindCursorListParamPtrs contains pointers to parameters of the BudgetUsecase.FindCursorList
+type BudgetUsecaseMockFindCursorListParamPtrs struct {
 	ctx         *context.Context
 	listOptions **mm_usecase.BudgetListOptions
-	queryParams **uctypes.QueryGetListParams
+	limit       *uint64
+	withTotal   *bool
 }
 
-// BudgetUsecaseMockFindListResults contains results of the BudgetUsecase.FindList
-type BudgetUsecaseMockFindListResults struct {
+// BudgetUsecaseMockFindCursorListResults contains results of the BudgetUsecase.FindCursorList
+type BudgetUsecaseMockFindCursorListResults struct {
+	resPage *mm_usecase.BudgetCursorPage
+	err     error
+}
+
+// BudgetUsecaseMockFindCursorListOrigins contains origins of expectations of the BudgetUsecase.FindCursorList
+type BudgetUsecaseMockFindCursorListExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originLimit       string
+	originWithTotal   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindCursorList *mBudgetUsecaseMockFindCursorList) Optional() *mBudgetUsecaseMockFindCursorList {
+	mmFindCursorList.optional = true
+	return mmFindCursorList
+}
+
+// Expect sets up expected params for BudgetUsecase.FindCursorList
+func (mmFindCursorList *mBudgetUsecaseMockFindCursorList) Expect(ctx context.Context, listOptions *mm_usecase.BudgetListOptions, limit uint64, withTotal bool) *mBudgetUsecaseMockFindCursorList {
+	if mmFindCursorList.mock.funcFindCursorList != nil {
+		mmFindCursorList.mock.t.Fatalf("BudgetUsecaseMock.FindCursorList mock is already set by Set")
+	}
+
+	if mmFindCursorList.defaultExpectation == nil {
+		mmFindCursorList.defaultExpectation = &BudgetUsecaseMockFindCursorListExpectation{}
+	}
+
+	if mmFindCursorList.defaultExpectation.paramPtrs != nil {
+		mmFindCursorList.mock.t.Fatalf("BudgetUsecaseMock.FindCursorList mock is already set by ExpectParams functions")
+	}
+
+	mmFindCursorList.defaultExpectation.params = &BudgetUsecaseMockFindCursorListParams{ctx, listOptions, limit, withTotal}
+	mmFindCursorList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindCursorList.expectations {
+		if minimock.Equal(e.params, mmFindCursorList.defaultExpectation.params) {
+			mmFindCursorList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindCursorList.defaultExpectation.params)
+		}
+	}
+
+	return mmFindCursorList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BudgetUsecase.FindCursorList
+func (mmFindCursorList *mBudgetUsecaseMockFindCursorList) ExpectCtxParam1(ctx context.Context) *mBudgetUsecaseMockFindCursorList {
+	if mmFindCursorList.mock.funcFindCursorList != nil {
+		mmFindCursorList.mock.t.Fatalf("BudgetUsecaseMock.FindCursorList mock is already set by Set")
+	}
+
+	if mmFindCursorList.defaultExpectation == nil {
+		mmFindCursorList.defaultExpectation = &BudgetUsecaseMockFindCursorListExpectation{}
+	}
+
+	if mmFindCursorList.defaultExpectation.params != nil {
+		mmFindCursorList.mock.t.Fatalf("BudgetUsecaseMock.FindCursorList mock is already set by Expect")
+	}
+
+	if mmFindCursorList.defaultExpectation.paramPtrs == nil {
+		mmFindCursorList.defaultExpectation.paramPtrs = &BudgetUsecaseMockFindCursorListParamPtrs{}
+	}
+	mmFindCursorList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindCursorList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindCursorList
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for BudgetUsecase.FindCursorList
+func (mmFindCursorList *mBudgetUsecaseMockFindCursorList) ExpectListOptionsParam2(listOptions *mm_usecase.BudgetListOptions) *mBudgetUsecaseMockFindCursorList {
+	if mmFindCursorList.mock.funcFindCursorList != nil {
+		mmFindCursorList.mock.t.Fatalf("BudgetUsecaseMock.FindCursorList mock is already set by Set")
+	}
+
+	if mmFindCursorList.defaultExpectation == nil {
+		mmFindCursorList.defaultExpectation = &BudgetUsecaseMockFindCursorListExpectation{}
+	}
+
+	if mmFindCursorList.defaultExpectation.params != nil {
+		mmFindCursorList.mock.t.Fatalf("BudgetUsecaseMock.FindCursorList mock is already set by Expect")
+	}
+
+	if mmFindCursorList.defaultExpectation.paramPtrs == nil {
+		mmFindCursorList.defaultExpectation.paramPtrs = &BudgetUsecaseMockFindCursorListParamPtrs{}
+	}
+	mmFindCursorList.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmFindCursorList.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmFindCursorList
+}
+
+// ExpectLimitParam3 sets up expected param limit for BudgetUsecase.FindCursorList
+func (mmFindCursorList *mBudgetUsecaseMockFindCursorList) ExpectLimitParam3(limit uint64) *mBudgetUsecaseMockFindCursorList {
+	if mmFindCursorList.mock.funcFindCursorList != nil {
+		mmFindCursorList.mock.t.Fatalf("BudgetUsecaseMock.FindCursorList mock is already set by Set")
+	}
+
+	if mmFindCursorList.defaultExpectation == nil {
+		mmFindCursorList.defaultExpectation = &BudgetUsecaseMockFindCursorListExpectation{}
+	}
+
+	if mmFindCursorList.defaultExpectation.params != nil {
+		mmFindCursorList.mock.t.Fatalf("BudgetUsecaseMock.FindCursorList mock is already set by Expect")
+	}
+
+	if mmFindCursorList.defaultExpectation.paramPtrs == nil {
+		mmFindCursorList.defaultExpectation.paramPtrs = &BudgetUsecaseMockFindCursorListParamPtrs{}
+	}
+	mmFindCursorList.defaultExpectation.paramPtrs.limit = &limit
+	mmFindCursorList.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmFindCursorList
+}
+
+// ExpectWithTotalParam4 sets up expected param withTotal for BudgetUsecase.FindCursorList
+func (mmFindCursorList *mBudgetUsecaseMockFindCursorList) ExpectWithTotalParam4(withTotal bool) *mBudgetUsecaseMockFindCursorList {
+	if mmFindCursorList.mock.funcFindCursorList != nil {
+		mmFindCursorList.mock.t.Fatalf("BudgetUsecaseMock.FindCursorList mock is already set by Set")
+	}
+
+	if mmFindCursorList.defaultExpectation == nil {
+		mmFindCursorList.defaultExpectation = &BudgetUsecaseMockFindCursorListExpectation{}
+	}
+
+	if mmFindCursorList.defaultExpectation.params != nil {
+		mmFindCursorList.mock.t.Fatalf("BudgetUsecaseMock.FindCursorList mock is already set by Expect")
+	}
+
+	if mmFindCursorList.defaultExpectation.paramPtrs == nil {
+		mmFindCursorList.defaultExpectation.paramPtrs = &BudgetUsecaseMockFindCursorListParamPtrs{}
+	}
+	mmFindCursorList.defaultExpectation.paramPtrs.withTotal = &withTotal
+	mmFindCursorList.defaultExpectation.expectationOrigins.originWithTotal = minimock.CallerInfo(1)
+
+	return mmFindCursorList
+}
+
+// Inspect accepts an inspector function that has same arguments as the BudgetUsecase.FindCursorList
+func (mmFindCursorList *mBudgetUsecaseMockFindCursorList) Inspect(f func(ctx context.Context, listOptions *mm_usecase.BudgetListOptions, limit uint64, withTotal bool)) *mBudgetUsecaseMockFindCursorList {
+	if mmFindCursorList.mock.inspectFuncFindCursorList != nil {
+		mmFindCursorList.mock.t.Fatalf("Inspect function is already set for BudgetUsecaseMock.FindCursorList")
+	}
+
+	mmFindCursorList.mock.inspectFuncFindCursorList = f
+
+	return mmFindCursorList
+}
+
+// Return sets up results that will be returned by BudgetUsecase.FindCursorList
+func (mmFindCursorList *mBudgetUsecaseMockFindCursorList) Return(resPage *mm_usecase.BudgetCursorPage, err error) *BudgetUsecaseMock {
+	if mmFindCursorList.mock.funcFindCursorList != nil {
+		mmFindCursorList.mock.t.Fatalf("BudgetUsecaseMock.FindCursorList mock is already set by Set")
+	}
+
+	if mmFindCursorList.defaultExpectation == nil {
+		mmFindCursorList.defaultExpectation = &BudgetUsecaseMockFindCursorListExpectation{mock: mmFindCursorList.mock}
+	}
+	mmFindCursorList.defaultExpectation.results = &BudgetUsecaseMockFindCursorListResults{resPage, err}
+	mmFindCursorList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindCursorList.mock
+}
+
+// Set uses given function f to mock the BudgetUsecase.FindCursorList method
+func (mmFindCursorList *mBudgetUsecaseMockFindCursorList) Set(f func(ctx context.Context, listOptions *mm_usecase.BudgetListOptions, limit uint64, withTotal bool) (resPage *mm_usecase.BudgetCursorPage, err error)) *BudgetUsecaseMock {
+	if mmFindCursorList.defaultExpectation != nil {
+		mmFindCursorList.mock.t.Fatalf("Default expectation is already set for the BudgetUsecase.FindCursorList method")
+	}
+
+	if len(mmFindCursorList.expectations) > 0 {
+		mmFindCursorList.mock.t.Fatalf("Some expectations are already set for the BudgetUsecase.FindCursorList method")
+	}
+
+	mmFindCursorList.mock.funcFindCursorList = f
+	mmFindCursorList.mock.funcFindCursorListOrigin = minimock.CallerInfo(1)
+	return mmFindCursorList.mock
+}
+
+// When sets expectation for the BudgetUsecase.FindCursorList which will trigger the result defined by the following
+// Then helper
+func (mmFindCursorList *mBudgetUsecaseMockFindCursorList) When(ctx context.Context, listOptions *mm_usecase.BudgetListOptions, limit uint64, withTotal bool) *BudgetUsecaseMockFindCursorListExpectation {
+	if mmFindCursorList.mock.funcFindCursorList != nil {
+		mmFindCursorList.mock.t.Fatalf("BudgetUsecaseMock.FindCursorList mock is already set by Set")
+	}
+
+	expectation := &BudgetUsecaseMockFindCursorListExpectation{
+		mock:               mmFindCursorList.mock,
+		params:             &BudgetUsecaseMockFindCursorListParams{ctx, listOptions, limit, withTotal},
+		expectationOrigins: BudgetUsecaseMockFindCursorListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindCursorList.expectations = append(mmFindCursorList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BudgetUsecase.FindCursorList return parameters for the expectation previously defined by the When method
+func (e *BudgetUsecaseMockFindCursorListExpectation) Then(resPage *mm_usecase.BudgetCursorPage, err error) *BudgetUsecaseMock {
+	e.results = &BudgetUsecaseMockFindCursorListResults{resPage, err}
+	return e.mock
+}
+
+// Times sets number of times BudgetUsecase.FindCursorList should be invoked
+func (mmFindCursorList *mBudgetUsecaseMockFindCursorList) Times(n uint64) *mBudgetUsecaseMockFindCursorList {
+	if n == 0 {
+		mmFindCursorList.mock.t.Fatalf("Times of BudgetUsecaseMock.FindCursorList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindCursorList.expectedInvocations, n)
+	mmFindCursorList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindCursorList
+}
+
+func (mmFindCursorList *mBudgetUsecaseMockFindCursorList) invocationsDone() bool {
+	if len(mmFindCursorList.expectations) == 0 && mmFindCursorList.defaultExpectation == nil && mmFindCursorList.mock.funcFindCursorList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindCursorList.mock.afterFindCursorListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindCursorList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindCursorList implements mm_usecase.BudgetUsecase
+func (mmFindCursorList *BudgetUsecaseMock) FindCursorList(ctx context.Context, listOptions *mm_usecase.BudgetListOptions, limit uint64, withTotal bool) (resPage *mm_usecase.BudgetCursorPage, err error) {
+	mm_atomic.AddUint64(&mmFindCursorList.beforeFindCursorListCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindCursorList.afterFindCursorListCounter, 1)
+
+	mmFindCursorList.t.Helper()
+
+	if mmFindCursorList.inspectFuncFindCursorList != nil {
+		mmFindCursorList.inspectFuncFindCursorList(ctx, listOptions, limit, withTotal)
+	}
+
+	mm_params := BudgetUsecaseMockFindCursorListParams{ctx, listOptions, limit, withTotal}
+
+	// Record call args
+	mmFindCursorList.FindCursorListMock.mutex.Lock()
+	mmFindCursorList.FindCursorListMock.callArgs = append(mmFindCursorList.FindCursorListMock.callArgs, &mm_params)
+	mmFindCursorList.FindCursorListMock.mutex.Unlock()
+
+	for _, e := range mmFindCursorList.FindCursorListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resPage, e.results.err
+		}
+	}
+
+	if mmFindCursorList.FindCursorListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindCursorList.FindCursorListMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindCursorList.FindCursorListMock.defaultExpectation.params
+		mm_want_ptrs := mmFindCursorList.FindCursorListMock.defaultExpectation.paramPtrs
+
+		mm_got := BudgetUsecaseMockFindCursorListParams{ctx, listOptions, limit, withTotal}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindCursorList.t.Errorf("BudgetUsecaseMock.FindCursorList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindCursorList.FindCursorListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmFindCursorList.t.Errorf("BudgetUsecaseMock.FindCursorList got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindCursorList.FindCursorListMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmFindCursorList.t.Errorf("BudgetUsecaseMock.FindCursorList got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindCursorList.FindCursorListMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+			if mm_want_ptrs.withTotal != nil && !minimock.Equal(*mm_want_ptrs.withTotal, mm_got.withTotal) {
+				mmFindCursorList.t.Errorf("BudgetUsecaseMock.FindCursorList got unexpected parameter withTotal, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindCursorList.FindCursorListMock.defaultExpectation.expectationOrigins.originWithTotal, *mm_want_ptrs.withTotal, mm_got.withTotal, minimock.Diff(*mm_want_ptrs.withTotal, mm_got.withTotal))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindCursorList.t.Errorf("BudgetUsecaseMock.FindCursorList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindCursorList.FindCursorListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindCursorList.FindCursorListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindCursorList.t.Fatal("No results are set for the BudgetUsecaseMock.FindCursorList")
+		}
+		return (*mm_results).resPage, (*mm_results).err
+	}
+	if mmFindCursorList.funcFindCursorList != nil {
+		return mmFindCursorList.funcFindCursorList(ctx, listOptions, limit, withTotal)
+	}
+	mmFindCursorList.t.Fatalf("Unexpected call to BudgetUsecaseMock.FindCursorList. %v %v %v %v", ctx, listOptions, limit, withTotal)
+	return
+}
+
+// FindCursorListAfterCounter returns a count of finished BudgetUsecaseMock.FindCursorList invocations
+func (mmFindCursorList *BudgetUsecaseMock) FindCursorListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindCursorList.afterFindCursorListCounter)
+}
+
+// FindCursorListBeforeCounter returns a count of BudgetUsecaseMock.FindCursorList invocations
+func (mmFindCursorList *BudgetUsecaseMock) FindCursorListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindCursorList.beforeFindCursorListCounter)
+}
+
+// Calls returns a list of arguments used in each call to BudgetUsecaseMock.FindCursorList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindCursorList *mBudgetUsecaseMockFindCursorList) Calls() []*BudgetUsecaseMockFindCursorListParams {
+	mmFindCursorList.mutex.RLock()
+
+	argCopy := make([]*BudgetUsecaseMockFindCursorListParams, len(mmFindCursorList.callArgs))
+	copy(argCopy, mmFindCursorList.callArgs)
+
+	mmFindCursorList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindCursorListDone returns true if the count of the FindCursorList invocations corresponds
+// the number of defined expectations
+func (m *BudgetUsecaseMock) MinimockFindCursorListDone() bool {
+	if m.FindCursorListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindCursorListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindCursorListMock.invocationsDone()
+}
+
+// MinimockFindCursorListInspect logs each unmet expectation
+func (m *BudgetUsecaseMock) MinimockFindCursorListInspect() {
+	for _, e := range m.FindCursorListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BudgetUsecaseMock.FindCursorList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindCursorListCounter := mm_atomic.LoadUint64(&m.afterFindCursorListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindCursorListMock.defaultExpectation != nil && afterFindCursorListCounter < 1 {
+		if m.FindCursorListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BudgetUsecaseMock.FindCursorList at\n%s", m.FindCursorListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BudgetUsecaseMock.FindCursorList at\n%s with params: %#v", m.FindCursorListMock.defaultExpectation.expectationOrigins.origin, *m.FindCursorListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindCursorList != nil && afterFindCursorListCounter < 1 {
+		m.t.Errorf("Expected call to BudgetUsecaseMock.FindCursorList at\n%s", m.funcFindCursorListOrigin)
+	}
+
+	if !m.FindCursorListMock.invocationsDone() && afterFindCursorListCounter > 0 {
+		m.t.Errorf("Expected %d calls to BudgetUsecaseMock.FindCursorList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindCursorListMock.expectedInvocations), m.FindCursorListMock.expectedInvocationsOrigin, afterFindCursorListCounter)
+	}
+}
+
+type mBudgetUsecaseMockFindList struct {
+	optional           bool
+	mock               *BudgetUsecaseMock
+	defaultExpectation *BudgetUsecaseMockFindListExpectation
+	expectations       []*BudgetUsecaseMockFindListExpectation
+
+	callArgs []*BudgetUsecaseMockFindListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BudgetUsecaseMockFindListExpectation specifies expectation struct of the BudgetUsecase.FindList
+type BudgetUsecaseMockFindListExpectation struct {
+	mock               *BudgetUsecaseMock
+	params             *BudgetUsecaseMockFindListParams
+	paramPtrs          *BudgetUsecaseMockFindListParamPtrs
+	expectationOrigins BudgetUsecaseMockFindListExpectationOrigins
+	results            *BudgetUsecaseMockFindListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BudgetUsecaseMockFindListParams contains parameters of the BudgetUsecase.FindList
+type BudgetUsecaseMockFindListParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.BudgetListOptions
+	queryParams *uctypes.QueryGetListParams
+}
+
+// BudgetUsecaseMockFindListParamPtrs contains pointers to parameters of the BudgetUsecase.FindList
+type BudgetUsecaseMockFindListParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.BudgetListOptions
+	queryParams **uctypes.QueryGetListParams
+}
+
+// BudgetUsecaseMockFindListResults contains results of the BudgetUsecase.FindList
+type BudgetUsecaseMockFindListResults struct {
 	resItems []*mm_usecase.BudgetDTO
 	cacheHit bool
 	err      error
@@ -1906,58 +2767,53 @@ func (m *BudgetUsecaseMock) MinimockFindPagedListInspect() {
 	}
 }
 
-type mBudgetUsecaseMockPatchBudgetByDTO struct {
+type mBudgetUsecaseMockGetBudgetAutoRollover struct {
 	optional           bool
 	mock               *BudgetUsecaseMock
-	defaultExpectation *BudgetUsecaseMockPatchBudgetByDTOExpectation
-	expectations       []*BudgetUsecaseMockPatchBudgetByDTOExpectation
+	defaultExpectation *BudgetUsecaseMockGetBudgetAutoRolloverExpectation
+	expectations       []*BudgetUsecaseMockGetBudgetAutoRolloverExpectation
 
-	callArgs []*BudgetUsecaseMockPatchBudgetByDTOParams
+	callArgs []*BudgetUsecaseMockGetBudgetAutoRolloverParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// BudgetUsecaseMockPatchBudgetByDTOExpectation specifies expectation struct of the BudgetUsecase.PatchBudgetByDTO
-type BudgetUsecaseMockPatchBudgetByDTOExpectation struct {
+// BudgetUsecaseMockGetBudgetAutoRolloverExpectation specifies expectation struct of the BudgetUsecase.GetBudgetAutoRollover
+type BudgetUsecaseMockGetBudgetAutoRolloverExpectation struct {
 	mock               *BudgetUsecaseMock
-	params             *BudgetUsecaseMockPatchBudgetByDTOParams
-	paramPtrs          *BudgetUsecaseMockPatchBudgetByDTOParamPtrs
-	expectationOrigins BudgetUsecaseMockPatchBudgetByDTOExpectationOrigins
-	results            *BudgetUsecaseMockPatchBudgetByDTOResults
+	params             *BudgetUsecaseMockGetBudgetAutoRolloverParams
+	paramPtrs          *BudgetUsecaseMockGetBudgetAutoRolloverParamPtrs
+	expectationOrigins BudgetUsecaseMockGetBudgetAutoRolloverExpectationOrigins
+	results            *BudgetUsecaseMockGetBudgetAutoRolloverResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// BudgetUsecaseMockPatchBudgetByDTOParams contains parameters of the BudgetUsecase.PatchBudgetByDTO
-type BudgetUsecaseMockPatchBudgetByDTOParams struct {
-	ctx              context.Context
-	id               uuid.UUID
-	in               mm_usecase.PatchBudgetDataInput
-	skipVersionCheck bool
+// BudgetUsecaseMockGetBudgetAutoRolloverParams contains parameters of the BudgetUsecase.GetBudgetAutoRollover
+type BudgetUsecaseMockGetBudgetAutoRolloverParams struct {
+	ctx       context.Context
+	accountID uuid.UUID
 }
 
-// BudgetUsecaseMockPatchBudgetByDTOParamPtrs contains pointers to parameters of the BudgetUsecase.PatchBudgetByDTO
-type BudgetUsecaseMockPatchBudgetByDTOParamPtrs struct {
-	ctx              *context.Context
-	id               *uuid.UUID
-	in               *mm_usecase.PatchBudgetDataInput
-	skipVersionCheck *bool
+// BudgetUsecaseMockGetBudgetAutoRolloverParamPtrs contains pointers to parameters of the BudgetUsecase.GetBudgetAutoRollover
+type BudgetUsecaseMockGetBudgetAutoRolloverParamPtrs struct {
+	ctx       *context.Context
+	accountID *uuid.UUID
 }
 
-// BudgetUsecaseMockPatchBudgetByDTOResults contains results of the BudgetUsecase.PatchBudgetByDTO
-type BudgetUsecaseMockPatchBudgetByDTOResults struct {
-	err error
+// BudgetUsecaseMockGetBudgetAutoRolloverResults contains results of the BudgetUsecase.GetBudgetAutoRollover
+type BudgetUsecaseMockGetBudgetAutoRolloverResults struct {
+	resEnabled bool
+	err        error
 }
 
-// BudgetUsecaseMockPatchBudgetByDTOOrigins contains origins of expectations of the BudgetUsecase.PatchBudgetByDTO
-type BudgetUsecaseMockPatchBudgetByDTOExpectationOrigins struct {
-	origin                 string
-	originCtx              string
-	originId               string
-	originIn               string
-	originSkipVersionCheck string
+// BudgetUsecaseMockGetBudgetAutoRolloverOrigins contains origins of expectations of the BudgetUsecase.GetBudgetAutoRollover
+type BudgetUsecaseMockGetBudgetAutoRolloverExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originAccountID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1965,19 +2821,367 @@ type BudgetUsecaseMockPatchBudgetByDTOExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmPatchBudgetByDTO *mBudgetUsecaseMockPatchBudgetByDTO) Optional() *mBudgetUsecaseMockPatchBudgetByDTO {
-	mmPatchBudgetByDTO.optional = true
-	return mmPatchBudgetByDTO
+func (mmGetBudgetAutoRollover *mBudgetUsecaseMockGetBudgetAutoRollover) Optional() *mBudgetUsecaseMockGetBudgetAutoRollover {
+	mmGetBudgetAutoRollover.optional = true
+	return mmGetBudgetAutoRollover
 }
 
-// Expect sets up expected params for BudgetUsecase.PatchBudgetByDTO
-func (mmPatchBudgetByDTO *mBudgetUsecaseMockPatchBudgetByDTO) Expect(ctx context.Context, id uuid.UUID, in mm_usecase.PatchBudgetDataInput, skipVersionCheck bool) *mBudgetUsecaseMockPatchBudgetByDTO {
-	if mmPatchBudgetByDTO.mock.funcPatchBudgetByDTO != nil {
-		mmPatchBudgetByDTO.mock.t.Fatalf("BudgetUsecaseMock.PatchBudgetByDTO mock is already set by Set")
+// Expect sets up expected params for BudgetUsecase.GetBudgetAutoRollover
+func (mmGetBudgetAutoRollover *mBudgetUsecaseMockGetBudgetAutoRollover) Expect(ctx context.Context, accountID uuid.UUID) *mBudgetUsecaseMockGetBudgetAutoRollover {
+	if mmGetBudgetAutoRollover.mock.funcGetBudgetAutoRollover != nil {
+		mmGetBudgetAutoRollover.mock.t.Fatalf("BudgetUsecaseMock.GetBudgetAutoRollover mock is already set by Set")
 	}
 
-	if mmPatchBudgetByDTO.defaultExpectation == nil {
-		mmPatchBudgetByDTO.defaultExpectation = &BudgetUsecaseMockPatchBudgetByDTOExpectation{}
+	if mmGetBudgetAutoRollover.defaultExpectation == nil {
+		mmGetBudgetAutoRollover.defaultExpectation = &BudgetUsecaseMockGetBudgetAutoRolloverExpectation{}
+	}
+
+	if mmGetBudgetAutoRollover.defaultExpectation.paramPtrs != nil {
+		mmGetBudgetAutoRollover.mock.t.Fatalf("BudgetUsecaseMock.GetBudgetAutoRollover mock is already set by ExpectParams functions")
+	}
+
+	mmGetBudgetAutoRollover.defaultExpectation.params = &BudgetUsecaseMockGetBudgetAutoRolloverParams{ctx, accountID}
+	mmGetBudgetAutoRollover.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetBudgetAutoRollover.expectations {
+		if minimock.Equal(e.params, mmGetBudgetAutoRollover.defaultExpectation.params) {
+			mmGetBudgetAutoRollover.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBudgetAutoRollover.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBudgetAutoRollover
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BudgetUsecase.GetBudgetAutoRollover
+func (mmGetBudgetAutoRollover *mBudgetUsecaseMockGetBudgetAutoRollover) ExpectCtxParam1(ctx context.Context) *mBudgetUsecaseMockGetBudgetAutoRollover {
+	if mmGetBudgetAutoRollover.mock.funcGetBudgetAutoRollover != nil {
+		mmGetBudgetAutoRollover.mock.t.Fatalf("BudgetUsecaseMock.GetBudgetAutoRollover mock is already set by Set")
+	}
+
+	if mmGetBudgetAutoRollover.defaultExpectation == nil {
+		mmGetBudgetAutoRollover.defaultExpectation = &BudgetUsecaseMockGetBudgetAutoRolloverExpectation{}
+	}
+
+	if mmGetBudgetAutoRollover.defaultExpectation.params != nil {
+		mmGetBudgetAutoRollover.mock.t.Fatalf("BudgetUsecaseMock.GetBudgetAutoRollover mock is already set by Expect")
+	}
+
+	if mmGetBudgetAutoRollover.defaultExpectation.paramPtrs == nil {
+		mmGetBudgetAutoRollover.defaultExpectation.paramPtrs = &BudgetUsecaseMockGetBudgetAutoRolloverParamPtrs{}
+	}
+	mmGetBudgetAutoRollover.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetBudgetAutoRollover.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetBudgetAutoRollover
+}
+
+// ExpectAccountIDParam2 sets up expected param accountID for BudgetUsecase.GetBudgetAutoRollover
+func (mmGetBudgetAutoRollover *mBudgetUsecaseMockGetBudgetAutoRollover) ExpectAccountIDParam2(accountID uuid.UUID) *mBudgetUsecaseMockGetBudgetAutoRollover {
+	if mmGetBudgetAutoRollover.mock.funcGetBudgetAutoRollover != nil {
+		mmGetBudgetAutoRollover.mock.t.Fatalf("BudgetUsecaseMock.GetBudgetAutoRollover mock is already set by Set")
+	}
+
+	if mmGetBudgetAutoRollover.defaultExpectation == nil {
+		mmGetBudgetAutoRollover.defaultExpectation = &BudgetUsecaseMockGetBudgetAutoRolloverExpectation{}
+	}
+
+	if mmGetBudgetAutoRollover.defaultExpectation.params != nil {
+		mmGetBudgetAutoRollover.mock.t.Fatalf("BudgetUsecaseMock.GetBudgetAutoRollover mock is already set by Expect")
+	}
+
+	if mmGetBudgetAutoRollover.defaultExpectation.paramPtrs == nil {
+		mmGetBudgetAutoRollover.defaultExpectation.paramPtrs = &BudgetUsecaseMockGetBudgetAutoRolloverParamPtrs{}
+	}
+	mmGetBudgetAutoRollover.defaultExpectation.paramPtrs.accountID = &accountID
+	mmGetBudgetAutoRollover.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+
+	return mmGetBudgetAutoRollover
+}
+
+// Inspect accepts an inspector function that has same arguments as the BudgetUsecase.GetBudgetAutoRollover
+func (mmGetBudgetAutoRollover *mBudgetUsecaseMockGetBudgetAutoRollover) Inspect(f func(ctx context.Context, accountID uuid.UUID)) *mBudgetUsecaseMockGetBudgetAutoRollover {
+	if mmGetBudgetAutoRollover.mock.inspectFuncGetBudgetAutoRollover != nil {
+		mmGetBudgetAutoRollover.mock.t.Fatalf("Inspect function is already set for BudgetUsecaseMock.GetBudgetAutoRollover")
+	}
+
+	mmGetBudgetAutoRollover.mock.inspectFuncGetBudgetAutoRollover = f
+
+	return mmGetBudgetAutoRollover
+}
+
+// Return sets up results that will be returned by BudgetUsecase.GetBudgetAutoRollover
+func (mmGetBudgetAutoRollover *mBudgetUsecaseMockGetBudgetAutoRollover) Return(resEnabled bool, err error) *BudgetUsecaseMock {
+	if mmGetBudgetAutoRollover.mock.funcGetBudgetAutoRollover != nil {
+		mmGetBudgetAutoRollover.mock.t.Fatalf("BudgetUsecaseMock.GetBudgetAutoRollover mock is already set by Set")
+	}
+
+	if mmGetBudgetAutoRollover.defaultExpectation == nil {
+		mmGetBudgetAutoRollover.defaultExpectation = &BudgetUsecaseMockGetBudgetAutoRolloverExpectation{mock: mmGetBudgetAutoRollover.mock}
+	}
+	mmGetBudgetAutoRollover.defaultExpectation.results = &BudgetUsecaseMockGetBudgetAutoRolloverResults{resEnabled, err}
+	mmGetBudgetAutoRollover.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetBudgetAutoRollover.mock
+}
+
+// Set uses given function f to mock the BudgetUsecase.GetBudgetAutoRollover method
+func (mmGetBudgetAutoRollover *mBudgetUsecaseMockGetBudgetAutoRollover) Set(f func(ctx context.Context, accountID uuid.UUID) (resEnabled bool, err error)) *BudgetUsecaseMock {
+	if mmGetBudgetAutoRollover.defaultExpectation != nil {
+		mmGetBudgetAutoRollover.mock.t.Fatalf("Default expectation is already set for the BudgetUsecase.GetBudgetAutoRollover method")
+	}
+
+	if len(mmGetBudgetAutoRollover.expectations) > 0 {
+		mmGetBudgetAutoRollover.mock.t.Fatalf("Some expectations are already set for the BudgetUsecase.GetBudgetAutoRollover method")
+	}
+
+	mmGetBudgetAutoRollover.mock.funcGetBudgetAutoRollover = f
+	mmGetBudgetAutoRollover.mock.funcGetBudgetAutoRolloverOrigin = minimock.CallerInfo(1)
+	return mmGetBudgetAutoRollover.mock
+}
+
+// When sets expectation for the BudgetUsecase.GetBudgetAutoRollover which will trigger the result defined by the following
+// Then helper
+func (mmGetBudgetAutoRollover *mBudgetUsecaseMockGetBudgetAutoRollover) When(ctx context.Context, accountID uuid.UUID) *BudgetUsecaseMockGetBudgetAutoRolloverExpectation {
+	if mmGetBudgetAutoRollover.mock.funcGetBudgetAutoRollover != nil {
+		mmGetBudgetAutoRollover.mock.t.Fatalf("BudgetUsecaseMock.GetBudgetAutoRollover mock is already set by Set")
+	}
+
+	expectation := &BudgetUsecaseMockGetBudgetAutoRolloverExpectation{
+		mock:               mmGetBudgetAutoRollover.mock,
+		params:             &BudgetUsecaseMockGetBudgetAutoRolloverParams{ctx, accountID},
+		expectationOrigins: BudgetUsecaseMockGetBudgetAutoRolloverExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetBudgetAutoRollover.expectations = append(mmGetBudgetAutoRollover.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BudgetUsecase.GetBudgetAutoRollover return parameters for the expectation previously defined by the When method
+func (e *BudgetUsecaseMockGetBudgetAutoRolloverExpectation) Then(resEnabled bool, err error) *BudgetUsecaseMock {
+	e.results = &BudgetUsecaseMockGetBudgetAutoRolloverResults{resEnabled, err}
+	return e.mock
+}
+
+// Times sets number of times BudgetUsecase.GetBudgetAutoRollover should be invoked
+func (mmGetBudgetAutoRollover *mBudgetUsecaseMockGetBudgetAutoRollover) Times(n uint64) *mBudgetUsecaseMockGetBudgetAutoRollover {
+	if n == 0 {
+		mmGetBudgetAutoRollover.mock.t.Fatalf("Times of BudgetUsecaseMock.GetBudgetAutoRollover mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetBudgetAutoRollover.expectedInvocations, n)
+	mmGetBudgetAutoRollover.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetBudgetAutoRollover
+}
+
+func (mmGetBudgetAutoRollover *mBudgetUsecaseMockGetBudgetAutoRollover) invocationsDone() bool {
+	if len(mmGetBudgetAutoRollover.expectations) == 0 && mmGetBudgetAutoRollover.defaultExpectation == nil && mmGetBudgetAutoRollover.mock.funcGetBudgetAutoRollover == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetBudgetAutoRollover.mock.afterGetBudgetAutoRolloverCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetBudgetAutoRollover.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetBudgetAutoRollover implements mm_usecase.BudgetUsecase
+func (mmGetBudgetAutoRollover *BudgetUsecaseMock) GetBudgetAutoRollover(ctx context.Context, accountID uuid.UUID) (resEnabled bool, err error) {
+	mm_atomic.AddUint64(&mmGetBudgetAutoRollover.beforeGetBudgetAutoRolloverCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBudgetAutoRollover.afterGetBudgetAutoRolloverCounter, 1)
+
+	mmGetBudgetAutoRollover.t.Helper()
+
+	if mmGetBudgetAutoRollover.inspectFuncGetBudgetAutoRollover != nil {
+		mmGetBudgetAutoRollover.inspectFuncGetBudgetAutoRollover(ctx, accountID)
+	}
+
+	mm_params := BudgetUsecaseMockGetBudgetAutoRolloverParams{ctx, accountID}
+
+	// Record call args
+	mmGetBudgetAutoRollover.GetBudgetAutoRolloverMock.mutex.Lock()
+	mmGetBudgetAutoRollover.GetBudgetAutoRolloverMock.callArgs = append(mmGetBudgetAutoRollover.GetBudgetAutoRolloverMock.callArgs, &mm_params)
+	mmGetBudgetAutoRollover.GetBudgetAutoRolloverMock.mutex.Unlock()
+
+	for _, e := range mmGetBudgetAutoRollover.GetBudgetAutoRolloverMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resEnabled, e.results.err
+		}
+	}
+
+	if mmGetBudgetAutoRollover.GetBudgetAutoRolloverMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBudgetAutoRollover.GetBudgetAutoRolloverMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBudgetAutoRollover.GetBudgetAutoRolloverMock.defaultExpectation.params
+		mm_want_ptrs := mmGetBudgetAutoRollover.GetBudgetAutoRolloverMock.defaultExpectation.paramPtrs
+
+		mm_got := BudgetUsecaseMockGetBudgetAutoRolloverParams{ctx, accountID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetBudgetAutoRollover.t.Errorf("BudgetUsecaseMock.GetBudgetAutoRollover got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetBudgetAutoRollover.GetBudgetAutoRolloverMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmGetBudgetAutoRollover.t.Errorf("BudgetUsecaseMock.GetBudgetAutoRollover got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetBudgetAutoRollover.GetBudgetAutoRolloverMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBudgetAutoRollover.t.Errorf("BudgetUsecaseMock.GetBudgetAutoRollover got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetBudgetAutoRollover.GetBudgetAutoRolloverMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBudgetAutoRollover.GetBudgetAutoRolloverMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBudgetAutoRollover.t.Fatal("No results are set for the BudgetUsecaseMock.GetBudgetAutoRollover")
+		}
+		return (*mm_results).resEnabled, (*mm_results).err
+	}
+	if mmGetBudgetAutoRollover.funcGetBudgetAutoRollover != nil {
+		return mmGetBudgetAutoRollover.funcGetBudgetAutoRollover(ctx, accountID)
+	}
+	mmGetBudgetAutoRollover.t.Fatalf("Unexpected call to BudgetUsecaseMock.GetBudgetAutoRollover. %v %v", ctx, accountID)
+	return
+}
+
+// GetBudgetAutoRolloverAfterCounter returns a count of finished BudgetUsecaseMock.GetBudgetAutoRollover invocations
+func (mmGetBudgetAutoRollover *BudgetUsecaseMock) GetBudgetAutoRolloverAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBudgetAutoRollover.afterGetBudgetAutoRolloverCounter)
+}
+
+// GetBudgetAutoRolloverBeforeCounter returns a count of BudgetUsecaseMock.GetBudgetAutoRollover invocations
+func (mmGetBudgetAutoRollover *BudgetUsecaseMock) GetBudgetAutoRolloverBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBudgetAutoRollover.beforeGetBudgetAutoRolloverCounter)
+}
+
+// Calls returns a list of arguments used in each call to BudgetUsecaseMock.GetBudgetAutoRollover.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBudgetAutoRollover *mBudgetUsecaseMockGetBudgetAutoRollover) Calls() []*BudgetUsecaseMockGetBudgetAutoRolloverParams {
+	mmGetBudgetAutoRollover.mutex.RLock()
+
+	argCopy := make([]*BudgetUsecaseMockGetBudgetAutoRolloverParams, len(mmGetBudgetAutoRollover.callArgs))
+	copy(argCopy, mmGetBudgetAutoRollover.callArgs)
+
+	mmGetBudgetAutoRollover.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetBudgetAutoRolloverDone returns true if the count of the GetBudgetAutoRollover invocations corresponds
+// the number of defined expectations
+func (m *BudgetUsecaseMock) MinimockGetBudgetAutoRolloverDone() bool {
+	if m.GetBudgetAutoRolloverMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetBudgetAutoRolloverMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetBudgetAutoRolloverMock.invocationsDone()
+}
+
+// MinimockGetBudgetAutoRolloverInspect logs each unmet expectation
+func (m *BudgetUsecaseMock) MinimockGetBudgetAutoRolloverInspect() {
+	for _, e := range m.GetBudgetAutoRolloverMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BudgetUsecaseMock.GetBudgetAutoRollover at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetBudgetAutoRolloverCounter := mm_atomic.LoadUint64(&m.afterGetBudgetAutoRolloverCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBudgetAutoRolloverMock.defaultExpectation != nil && afterGetBudgetAutoRolloverCounter < 1 {
+		if m.GetBudgetAutoRolloverMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BudgetUsecaseMock.GetBudgetAutoRollover at\n%s", m.GetBudgetAutoRolloverMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BudgetUsecaseMock.GetBudgetAutoRollover at\n%s with params: %#v", m.GetBudgetAutoRolloverMock.defaultExpectation.expectationOrigins.origin, *m.GetBudgetAutoRolloverMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBudgetAutoRollover != nil && afterGetBudgetAutoRolloverCounter < 1 {
+		m.t.Errorf("Expected call to BudgetUsecaseMock.GetBudgetAutoRollover at\n%s", m.funcGetBudgetAutoRolloverOrigin)
+	}
+
+	if !m.GetBudgetAutoRolloverMock.invocationsDone() && afterGetBudgetAutoRolloverCounter > 0 {
+		m.t.Errorf("Expected %d calls to BudgetUsecaseMock.GetBudgetAutoRollover at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetBudgetAutoRolloverMock.expectedInvocations), m.GetBudgetAutoRolloverMock.expectedInvocationsOrigin, afterGetBudgetAutoRolloverCounter)
+	}
+}
+
+type mBudgetUsecaseMockPatchBudgetByDTO struct {
+	optional           bool
+	mock               *BudgetUsecaseMock
+	defaultExpectation *BudgetUsecaseMockPatchBudgetByDTOExpectation
+	expectations       []*BudgetUsecaseMockPatchBudgetByDTOExpectation
+
+	callArgs []*BudgetUsecaseMockPatchBudgetByDTOParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BudgetUsecaseMockPatchBudgetByDTOExpectation specifies expectation struct of the BudgetUsecase.PatchBudgetByDTO
+type BudgetUsecaseMockPatchBudgetByDTOExpectation struct {
+	mock               *BudgetUsecaseMock
+	params             *BudgetUsecaseMockPatchBudgetByDTOParams
+	paramPtrs          *BudgetUsecaseMockPatchBudgetByDTOParamPtrs
+	expectationOrigins BudgetUsecaseMockPatchBudgetByDTOExpectationOrigins
+	results            *BudgetUsecaseMockPatchBudgetByDTOResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BudgetUsecaseMockPatchBudgetByDTOParams contains parameters of the BudgetUsecase.PatchBudgetByDTO
+type BudgetUsecaseMockPatchBudgetByDTOParams struct {
+	ctx              context.Context
+	id               uuid.UUID
+	in               mm_usecase.PatchBudgetDataInput
+	skipVersionCheck bool
+}
+
+// BudgetUsecaseMockPatchBudgetByDTOParamPtrs contains pointers to parameters of the BudgetUsecase.PatchBudgetByDTO
+type BudgetUsecaseMockPatchBudgetByDTOParamPtrs struct {
+	ctx              *context.Context
+	id               *uuid.UUID
+	in               *mm_usecase.PatchBudgetDataInput
+	skipVersionCheck *bool
+}
+
+// BudgetUsecaseMockPatchBudgetByDTOResults contains results of the BudgetUsecase.PatchBudgetByDTO
+type BudgetUsecaseMockPatchBudgetByDTOResults struct {
+	err error
+}
+
+// BudgetUsecaseMockPatchBudgetByDTOOrigins contains origins of expectations of the BudgetUsecase.PatchBudgetByDTO
+type BudgetUsecaseMockPatchBudgetByDTOExpectationOrigins struct {
+	origin                 string
+	originCtx              string
+	originId               string
+	originIn               string
+	originSkipVersionCheck string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPatchBudgetByDTO *mBudgetUsecaseMockPatchBudgetByDTO) Optional() *mBudgetUsecaseMockPatchBudgetByDTO {
+	mmPatchBudgetByDTO.optional = true
+	return mmPatchBudgetByDTO
+}
+
+// Expect sets up expected params for BudgetUsecase.PatchBudgetByDTO
+func (mmPatchBudgetByDTO *mBudgetUsecaseMockPatchBudgetByDTO) Expect(ctx context.Context, id uuid.UUID, in mm_usecase.PatchBudgetDataInput, skipVersionCheck bool) *mBudgetUsecaseMockPatchBudgetByDTO {
+	if mmPatchBudgetByDTO.mock.funcPatchBudgetByDTO != nil {
+		mmPatchBudgetByDTO.mock.t.Fatalf("BudgetUsecaseMock.PatchBudgetByDTO mock is already set by Set")
+	}
+
+	if mmPatchBudgetByDTO.defaultExpectation == nil {
+		mmPatchBudgetByDTO.defaultExpectation = &BudgetUsecaseMockPatchBudgetByDTOExpectation{}
 	}
 
 	if mmPatchBudgetByDTO.defaultExpectation.paramPtrs != nil {
@@ -2310,21 +3514,778 @@ func (m *BudgetUsecaseMock) MinimockPatchBudgetByDTOInspect() {
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *BudgetUsecaseMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockCreateBudgetByDTOInspect()
+type mBudgetUsecaseMockRolloverBudgets struct {
+	optional           bool
+	mock               *BudgetUsecaseMock
+	defaultExpectation *BudgetUsecaseMockRolloverBudgetsExpectation
+	expectations       []*BudgetUsecaseMockRolloverBudgetsExpectation
 
-			m.MinimockDeleteBudgetByIDInspect()
+	callArgs []*BudgetUsecaseMockRolloverBudgetsParams
+	mutex    sync.RWMutex
 
-			m.MinimockFindListInspect()
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
 
-			m.MinimockFindOneByIDInspect()
+// BudgetUsecaseMockRolloverBudgetsExpectation specifies expectation struct of the BudgetUsecase.RolloverBudgets
+type BudgetUsecaseMockRolloverBudgetsExpectation struct {
+	mock               *BudgetUsecaseMock
+	params             *BudgetUsecaseMockRolloverBudgetsParams
+	paramPtrs          *BudgetUsecaseMockRolloverBudgetsParamPtrs
+	expectationOrigins BudgetUsecaseMockRolloverBudgetsExpectationOrigins
+	results            *BudgetUsecaseMockRolloverBudgetsResults
+	returnOrigin       string
+	Counter            uint64
+}
 
-			m.MinimockFindPagedListInspect()
+// BudgetUsecaseMockRolloverBudgetsParams contains parameters of the BudgetUsecase.RolloverBudgets
+type BudgetUsecaseMockRolloverBudgetsParams struct {
+	ctx    context.Context
+	period civil.Date
+	limit  uint64
+}
 
-			m.MinimockPatchBudgetByDTOInspect()
+// BudgetUsecaseMockRolloverBudgetsParamPtrs contains pointers to parameters of the BudgetUsecase.RolloverBudgets
+type BudgetUsecaseMockRolloverBudgetsParamPtrs struct {
+	ctx    *context.Context
+	period *civil.Date
+	limit  *uint64
+}
+
+// BudgetUsecaseMockRolloverBudgetsResults contains results of the BudgetUsecase.RolloverBudgets
+type BudgetUsecaseMockRolloverBudgetsResults struct {
+	processed int
+	err       error
+}
+
+// BudgetUsecaseMockRolloverBudgetsOrigins contains origins of expectations of the BudgetUsecase.RolloverBudgets
+type BudgetUsecaseMockRolloverBudgetsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originPeriod string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRolloverBudgets *mBudgetUsecaseMockRolloverBudgets) Optional() *mBudgetUsecaseMockRolloverBudgets {
+	mmRolloverBudgets.optional = true
+	return mmRolloverBudgets
+}
+
+// Expect sets up expected params for BudgetUsecase.RolloverBudgets
+func (mmRolloverBudgets *mBudgetUsecaseMockRolloverBudgets) Expect(ctx context.Context, period civil.Date, limit uint64) *mBudgetUsecaseMockRolloverBudgets {
+	if mmRolloverBudgets.mock.funcRolloverBudgets != nil {
+		mmRolloverBudgets.mock.t.Fatalf("BudgetUsecaseMock.RolloverBudgets mock is already set by Set")
+	}
+
+	if mmRolloverBudgets.defaultExpectation == nil {
+		mmRolloverBudgets.defaultExpectation = &BudgetUsecaseMockRolloverBudgetsExpectation{}
+	}
+
+	if mmRolloverBudgets.defaultExpectation.paramPtrs != nil {
+		mmRolloverBudgets.mock.t.Fatalf("BudgetUsecaseMock.RolloverBudgets mock is already set by ExpectParams functions")
+	}
+
+	mmRolloverBudgets.defaultExpectation.params = &BudgetUsecaseMockRolloverBudgetsParams{ctx, period, limit}
+	mmRolloverBudgets.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRolloverBudgets.expectations {
+		if minimock.Equal(e.params, mmRolloverBudgets.defaultExpectation.params) {
+			mmRolloverBudgets.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRolloverBudgets.defaultExpectation.params)
+		}
+	}
+
+	return mmRolloverBudgets
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BudgetUsecase.RolloverBudgets
+func (mmRolloverBudgets *mBudgetUsecaseMockRolloverBudgets) ExpectCtxParam1(ctx context.Context) *mBudgetUsecaseMockRolloverBudgets {
+	if mmRolloverBudgets.mock.funcRolloverBudgets != nil {
+		mmRolloverBudgets.mock.t.Fatalf("BudgetUsecaseMock.RolloverBudgets mock is already set by Set")
+	}
+
+	if mmRolloverBudgets.defaultExpectation == nil {
+		mmRolloverBudgets.defaultExpectation = &BudgetUsecaseMockRolloverBudgetsExpectation{}
+	}
+
+	if mmRolloverBudgets.defaultExpectation.params != nil {
+		mmRolloverBudgets.mock.t.Fatalf("BudgetUsecaseMock.RolloverBudgets mock is already set by Expect")
+	}
+
+	if mmRolloverBudgets.defaultExpectation.paramPtrs == nil {
+		mmRolloverBudgets.defaultExpectation.paramPtrs = &BudgetUsecaseMockRolloverBudgetsParamPtrs{}
+	}
+	mmRolloverBudgets.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRolloverBudgets.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRolloverBudgets
+}
+
+// ExpectPeriodParam2 sets up expected param period for BudgetUsecase.RolloverBudgets
+func (mmRolloverBudgets *mBudgetUsecaseMockRolloverBudgets) ExpectPeriodParam2(period civil.Date) *mBudgetUsecaseMockRolloverBudgets {
+	if mmRolloverBudgets.mock.funcRolloverBudgets != nil {
+		mmRolloverBudgets.mock.t.Fatalf("BudgetUsecaseMock.RolloverBudgets mock is already set by Set")
+	}
+
+	if mmRolloverBudgets.defaultExpectation == nil {
+		mmRolloverBudgets.defaultExpectation = &BudgetUsecaseMockRolloverBudgetsExpectation{}
+	}
+
+	if mmRolloverBudgets.defaultExpectation.params != nil {
+		mmRolloverBudgets.mock.t.Fatalf("BudgetUsecaseMock.RolloverBudgets mock is already set by Expect")
+	}
+
+	if mmRolloverBudgets.defaultExpectation.paramPtrs == nil {
+		mmRolloverBudgets.defaultExpectation.paramPtrs = &BudgetUsecaseMockRolloverBudgetsParamPtrs{}
+	}
+	mmRolloverBudgets.defaultExpectation.paramPtrs.period = &period
+	mmRolloverBudgets.defaultExpectation.expectationOrigins.originPeriod = minimock.CallerInfo(1)
+
+	return mmRolloverBudgets
+}
+
+// ExpectLimitParam3 sets up expected param limit for BudgetUsecase.RolloverBudgets
+func (mmRolloverBudgets *mBudgetUsecaseMockRolloverBudgets) ExpectLimitParam3(limit uint64) *mBudgetUsecaseMockRolloverBudgets {
+	if mmRolloverBudgets.mock.funcRolloverBudgets != nil {
+		mmRolloverBudgets.mock.t.Fatalf("BudgetUsecaseMock.RolloverBudgets mock is already set by Set")
+	}
+
+	if mmRolloverBudgets.defaultExpectation == nil {
+		mmRolloverBudgets.defaultExpectation = &BudgetUsecaseMockRolloverBudgetsExpectation{}
+	}
+
+	if mmRolloverBudgets.defaultExpectation.params != nil {
+		mmRolloverBudgets.mock.t.Fatalf("BudgetUsecaseMock.RolloverBudgets mock is already set by Expect")
+	}
+
+	if mmRolloverBudgets.defaultExpectation.paramPtrs == nil {
+		mmRolloverBudgets.defaultExpectation.paramPtrs = &BudgetUsecaseMockRolloverBudgetsParamPtrs{}
+	}
+	mmRolloverBudgets.defaultExpectation.paramPtrs.limit = &limit
+	mmRolloverBudgets.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmRolloverBudgets
+}
+
+// Inspect accepts an inspector function that has same arguments as the BudgetUsecase.RolloverBudgets
+func (mmRolloverBudgets *mBudgetUsecaseMockRolloverBudgets) Inspect(f func(ctx context.Context, period civil.Date, limit uint64)) *mBudgetUsecaseMockRolloverBudgets {
+	if mmRolloverBudgets.mock.inspectFuncRolloverBudgets != nil {
+		mmRolloverBudgets.mock.t.Fatalf("Inspect function is already set for BudgetUsecaseMock.RolloverBudgets")
+	}
+
+	mmRolloverBudgets.mock.inspectFuncRolloverBudgets = f
+
+	return mmRolloverBudgets
+}
+
+// Return sets up results that will be returned by BudgetUsecase.RolloverBudgets
+func (mmRolloverBudgets *mBudgetUsecaseMockRolloverBudgets) Return(processed int, err error) *BudgetUsecaseMock {
+	if mmRolloverBudgets.mock.funcRolloverBudgets != nil {
+		mmRolloverBudgets.mock.t.Fatalf("BudgetUsecaseMock.RolloverBudgets mock is already set by Set")
+	}
+
+	if mmRolloverBudgets.defaultExpectation == nil {
+		mmRolloverBudgets.defaultExpectation = &BudgetUsecaseMockRolloverBudgetsExpectation{mock: mmRolloverBudgets.mock}
+	}
+	mmRolloverBudgets.defaultExpectation.results = &BudgetUsecaseMockRolloverBudgetsResults{processed, err}
+	mmRolloverBudgets.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRolloverBudgets.mock
+}
+
+// Set uses given function f to mock the BudgetUsecase.RolloverBudgets method
+func (mmRolloverBudgets *mBudgetUsecaseMockRolloverBudgets) Set(f func(ctx context.Context, period civil.Date, limit uint64) (processed int, err error)) *BudgetUsecaseMock {
+	if mmRolloverBudgets.defaultExpectation != nil {
+		mmRolloverBudgets.mock.t.Fatalf("Default expectation is already set for the BudgetUsecase.RolloverBudgets method")
+	}
+
+	if len(mmRolloverBudgets.expectations) > 0 {
+		mmRolloverBudgets.mock.t.Fatalf("Some expectations are already set for the BudgetUsecase.RolloverBudgets method")
+	}
+
+	mmRolloverBudgets.mock.funcRolloverBudgets = f
+	mmRolloverBudgets.mock.funcRolloverBudgetsOrigin = minimock.CallerInfo(1)
+	return mmRolloverBudgets.mock
+}
+
+// When sets expectation for the BudgetUsecase.RolloverBudgets which will trigger the result defined by the following
+// Then helper
+func (mmRolloverBudgets *mBudgetUsecaseMockRolloverBudgets) When(ctx context.Context, period civil.Date, limit uint64) *BudgetUsecaseMockRolloverBudgetsExpectation {
+	if mmRolloverBudgets.mock.funcRolloverBudgets != nil {
+		mmRolloverBudgets.mock.t.Fatalf("BudgetUsecaseMock.RolloverBudgets mock is already set by Set")
+	}
+
+	expectation := &BudgetUsecaseMockRolloverBudgetsExpectation{
+		mock:               mmRolloverBudgets.mock,
+		params:             &BudgetUsecaseMockRolloverBudgetsParams{ctx, period, limit},
+		expectationOrigins: BudgetUsecaseMockRolloverBudgetsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRolloverBudgets.expectations = append(mmRolloverBudgets.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BudgetUsecase.RolloverBudgets return parameters for the expectation previously defined by the When method
+func (e *BudgetUsecaseMockRolloverBudgetsExpectation) Then(processed int, err error) *BudgetUsecaseMock {
+	e.results = &BudgetUsecaseMockRolloverBudgetsResults{processed, err}
+	return e.mock
+}
+
+// Times sets number of times BudgetUsecase.RolloverBudgets should be invoked
+func (mmRolloverBudgets *mBudgetUsecaseMockRolloverBudgets) Times(n uint64) *mBudgetUsecaseMockRolloverBudgets {
+	if n == 0 {
+		mmRolloverBudgets.mock.t.Fatalf("Times of BudgetUsecaseMock.RolloverBudgets mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRolloverBudgets.expectedInvocations, n)
+	mmRolloverBudgets.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRolloverBudgets
+}
+
+func (mmRolloverBudgets *mBudgetUsecaseMockRolloverBudgets) invocationsDone() bool {
+	if len(mmRolloverBudgets.expectations) == 0 && mmRolloverBudgets.defaultExpectation == nil && mmRolloverBudgets.mock.funcRolloverBudgets == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRolloverBudgets.mock.afterRolloverBudgetsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRolloverBudgets.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RolloverBudgets implements mm_usecase.BudgetUsecase
+func (mmRolloverBudgets *BudgetUsecaseMock) RolloverBudgets(ctx context.Context, period civil.Date, limit uint64) (processed int, err error) {
+	mm_atomic.AddUint64(&mmRolloverBudgets.beforeRolloverBudgetsCounter, 1)
+	defer mm_atomic.AddUint64(&mmRolloverBudgets.afterRolloverBudgetsCounter, 1)
+
+	mmRolloverBudgets.t.Helper()
+
+	if mmRolloverBudgets.inspectFuncRolloverBudgets != nil {
+		mmRolloverBudgets.inspectFuncRolloverBudgets(ctx, period, limit)
+	}
+
+	mm_params := BudgetUsecaseMockRolloverBudgetsParams{ctx, period, limit}
+
+	// Record call args
+	mmRolloverBudgets.RolloverBudgetsMock.mutex.Lock()
+	mmRolloverBudgets.RolloverBudgetsMock.callArgs = append(mmRolloverBudgets.RolloverBudgetsMock.callArgs, &mm_params)
+	mmRolloverBudgets.RolloverBudgetsMock.mutex.Unlock()
+
+	for _, e := range mmRolloverBudgets.RolloverBudgetsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.processed, e.results.err
+		}
+	}
+
+	if mmRolloverBudgets.RolloverBudgetsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRolloverBudgets.RolloverBudgetsMock.defaultExpectation.Counter, 1)
+		mm_want := mmRolloverBudgets.RolloverBudgetsMock.defaultExpectation.params
+		mm_want_ptrs := mmRolloverBudgets.RolloverBudgetsMock.defaultExpectation.paramPtrs
+
+		mm_got := BudgetUsecaseMockRolloverBudgetsParams{ctx, period, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRolloverBudgets.t.Errorf("BudgetUsecaseMock.RolloverBudgets got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRolloverBudgets.RolloverBudgetsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.period != nil && !minimock.Equal(*mm_want_ptrs.period, mm_got.period) {
+				mmRolloverBudgets.t.Errorf("BudgetUsecaseMock.RolloverBudgets got unexpected parameter period, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRolloverBudgets.RolloverBudgetsMock.defaultExpectation.expectationOrigins.originPeriod, *mm_want_ptrs.period, mm_got.period, minimock.Diff(*mm_want_ptrs.period, mm_got.period))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmRolloverBudgets.t.Errorf("BudgetUsecaseMock.RolloverBudgets got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRolloverBudgets.RolloverBudgetsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRolloverBudgets.t.Errorf("BudgetUsecaseMock.RolloverBudgets got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRolloverBudgets.RolloverBudgetsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRolloverBudgets.RolloverBudgetsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRolloverBudgets.t.Fatal("No results are set for the BudgetUsecaseMock.RolloverBudgets")
+		}
+		return (*mm_results).processed, (*mm_results).err
+	}
+	if mmRolloverBudgets.funcRolloverBudgets != nil {
+		return mmRolloverBudgets.funcRolloverBudgets(ctx, period, limit)
+	}
+	mmRolloverBudgets.t.Fatalf("Unexpected call to BudgetUsecaseMock.RolloverBudgets. %v %v %v", ctx, period, limit)
+	return
+}
+
+// RolloverBudgetsAfterCounter returns a count of finished BudgetUsecaseMock.RolloverBudgets invocations
+func (mmRolloverBudgets *BudgetUsecaseMock) RolloverBudgetsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRolloverBudgets.afterRolloverBudgetsCounter)
+}
+
+// RolloverBudgetsBeforeCounter returns a count of BudgetUsecaseMock.RolloverBudgets invocations
+func (mmRolloverBudgets *BudgetUsecaseMock) RolloverBudgetsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRolloverBudgets.beforeRolloverBudgetsCounter)
+}
+
+// Calls returns a list of arguments used in each call to BudgetUsecaseMock.RolloverBudgets.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRolloverBudgets *mBudgetUsecaseMockRolloverBudgets) Calls() []*BudgetUsecaseMockRolloverBudgetsParams {
+	mmRolloverBudgets.mutex.RLock()
+
+	argCopy := make([]*BudgetUsecaseMockRolloverBudgetsParams, len(mmRolloverBudgets.callArgs))
+	copy(argCopy, mmRolloverBudgets.callArgs)
+
+	mmRolloverBudgets.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRolloverBudgetsDone returns true if the count of the RolloverBudgets invocations corresponds
+// the number of defined expectations
+func (m *BudgetUsecaseMock) MinimockRolloverBudgetsDone() bool {
+	if m.RolloverBudgetsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RolloverBudgetsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RolloverBudgetsMock.invocationsDone()
+}
+
+// MinimockRolloverBudgetsInspect logs each unmet expectation
+func (m *BudgetUsecaseMock) MinimockRolloverBudgetsInspect() {
+	for _, e := range m.RolloverBudgetsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BudgetUsecaseMock.RolloverBudgets at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRolloverBudgetsCounter := mm_atomic.LoadUint64(&m.afterRolloverBudgetsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RolloverBudgetsMock.defaultExpectation != nil && afterRolloverBudgetsCounter < 1 {
+		if m.RolloverBudgetsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BudgetUsecaseMock.RolloverBudgets at\n%s", m.RolloverBudgetsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BudgetUsecaseMock.RolloverBudgets at\n%s with params: %#v", m.RolloverBudgetsMock.defaultExpectation.expectationOrigins.origin, *m.RolloverBudgetsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRolloverBudgets != nil && afterRolloverBudgetsCounter < 1 {
+		m.t.Errorf("Expected call to BudgetUsecaseMock.RolloverBudgets at\n%s", m.funcRolloverBudgetsOrigin)
+	}
+
+	if !m.RolloverBudgetsMock.invocationsDone() && afterRolloverBudgetsCounter > 0 {
+		m.t.Errorf("Expected %d calls to BudgetUsecaseMock.RolloverBudgets at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RolloverBudgetsMock.expectedInvocations), m.RolloverBudgetsMock.expectedInvocationsOrigin, afterRolloverBudgetsCounter)
+	}
+}
+
+type mBudgetUsecaseMockSetBudgetAutoRollover struct {
+	optional           bool
+	mock               *BudgetUsecaseMock
+	defaultExpectation *BudgetUsecaseMockSetBudgetAutoRolloverExpectation
+	expectations       []*BudgetUsecaseMockSetBudgetAutoRolloverExpectation
+
+	callArgs []*BudgetUsecaseMockSetBudgetAutoRolloverParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BudgetUsecaseMockSetBudgetAutoRolloverExpectation specifies expectation struct of the BudgetUsecase.SetBudgetAutoRollover
+type BudgetUsecaseMockSetBudgetAutoRolloverExpectation struct {
+	mock               *BudgetUsecaseMock
+	params             *BudgetUsecaseMockSetBudgetAutoRolloverParams
+	paramPtrs          *BudgetUsecaseMockSetBudgetAutoRolloverParamPtrs
+	expectationOrigins BudgetUsecaseMockSetBudgetAutoRolloverExpectationOrigins
+	results            *BudgetUsecaseMockSetBudgetAutoRolloverResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BudgetUsecaseMockSetBudgetAutoRolloverParams contains parameters of the BudgetUsecase.SetBudgetAutoRollover
+type BudgetUsecaseMockSetBudgetAutoRolloverParams struct {
+	ctx       context.Context
+	accountID uuid.UUID
+	enabled   bool
+}
+
+// BudgetUsecaseMockSetBudgetAutoRolloverParamPtrs contains pointers to parameters of the BudgetUsecase.SetBudgetAutoRollover
+type BudgetUsecaseMockSetBudgetAutoRolloverParamPtrs struct {
+	ctx       *context.Context
+	accountID *uuid.UUID
+	enabled   *bool
+}
+
+// BudgetUsecaseMockSetBudgetAutoRolloverResults contains results of the BudgetUsecase.SetBudgetAutoRollover
+type BudgetUsecaseMockSetBudgetAutoRolloverResults struct {
+	err error
+}
+
+// BudgetUsecaseMockSetBudgetAutoRolloverOrigins contains origins of expectations of the BudgetUsecase.SetBudgetAutoRollover
+type BudgetUsecaseMockSetBudgetAutoRolloverExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originAccountID string
+	originEnabled   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetBudgetAutoRollover *mBudgetUsecaseMockSetBudgetAutoRollover) Optional() *mBudgetUsecaseMockSetBudgetAutoRollover {
+	mmSetBudgetAutoRollover.optional = true
+	return mmSetBudgetAutoRollover
+}
+
+// Expect sets up expected params for BudgetUsecase.SetBudgetAutoRollover
+func (mmSetBudgetAutoRollover *mBudgetUsecaseMockSetBudgetAutoRollover) Expect(ctx context.Context, accountID uuid.UUID, enabled bool) *mBudgetUsecaseMockSetBudgetAutoRollover {
+	if mmSetBudgetAutoRollover.mock.funcSetBudgetAutoRollover != nil {
+		mmSetBudgetAutoRollover.mock.t.Fatalf("BudgetUsecaseMock.SetBudgetAutoRollover mock is already set by Set")
+	}
+
+	if mmSetBudgetAutoRollover.defaultExpectation == nil {
+		mmSetBudgetAutoRollover.defaultExpectation = &BudgetUsecaseMockSetBudgetAutoRolloverExpectation{}
+	}
+
+	if mmSetBudgetAutoRollover.defaultExpectation.paramPtrs != nil {
+		mmSetBudgetAutoRollover.mock.t.Fatalf("BudgetUsecaseMock.SetBudgetAutoRollover mock is already set by ExpectParams functions")
+	}
+
+	mmSetBudgetAutoRollover.defaultExpectation.params = &BudgetUsecaseMockSetBudgetAutoRolloverParams{ctx, accountID, enabled}
+	mmSetBudgetAutoRollover.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetBudgetAutoRollover.expectations {
+		if minimock.Equal(e.params, mmSetBudgetAutoRollover.defaultExpectation.params) {
+			mmSetBudgetAutoRollover.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetBudgetAutoRollover.defaultExpectation.params)
+		}
+	}
+
+	return mmSetBudgetAutoRollover
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BudgetUsecase.SetBudgetAutoRollover
+func (mmSetBudgetAutoRollover *mBudgetUsecaseMockSetBudgetAutoRollover) ExpectCtxParam1(ctx context.Context) *mBudgetUsecaseMockSetBudgetAutoRollover {
+	if mmSetBudgetAutoRollover.mock.funcSetBudgetAutoRollover != nil {
+		mmSetBudgetAutoRollover.mock.t.Fatalf("BudgetUsecaseMock.SetBudgetAutoRollover mock is already set by Set")
+	}
+
+	if mmSetBudgetAutoRollover.defaultExpectation == nil {
+		mmSetBudgetAutoRollover.defaultExpectation = &BudgetUsecaseMockSetBudgetAutoRolloverExpectation{}
+	}
+
+	if mmSetBudgetAutoRollover.defaultExpectation.params != nil {
+		mmSetBudgetAutoRollover.mock.t.Fatalf("BudgetUsecaseMock.SetBudgetAutoRollover mock is already set by Expect")
+	}
+
+	if mmSetBudgetAutoRollover.defaultExpectation.paramPtrs == nil {
+		mmSetBudgetAutoRollover.defaultExpectation.paramPtrs = &BudgetUsecaseMockSetBudgetAutoRolloverParamPtrs{}
+	}
+	mmSetBudgetAutoRollover.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetBudgetAutoRollover.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetBudgetAutoRollover
+}
+
+// ExpectAccountIDParam2 sets up expected param accountID for BudgetUsecase.SetBudgetAutoRollover
+func (mmSetBudgetAutoRollover *mBudgetUsecaseMockSetBudgetAutoRollover) ExpectAccountIDParam2(accountID uuid.UUID) *mBudgetUsecaseMockSetBudgetAutoRollover {
+	if mmSetBudgetAutoRollover.mock.funcSetBudgetAutoRollover != nil {
+		mmSetBudgetAutoRollover.mock.t.Fatalf("BudgetUsecaseMock.SetBudgetAutoRollover mock is already set by Set")
+	}
+
+	if mmSetBudgetAutoRollover.defaultExpectation == nil {
+		mmSetBudgetAutoRollover.defaultExpectation = &BudgetUsecaseMockSetBudgetAutoRolloverExpectation{}
+	}
+
+	if mmSetBudgetAutoRollover.defaultExpectation.params != nil {
+		mmSetBudgetAutoRollover.mock.t.Fatalf("BudgetUsecaseMock.SetBudgetAutoRollover mock is already set by Expect")
+	}
+
+	if mmSetBudgetAutoRollover.defaultExpectation.paramPtrs == nil {
+		mmSetBudgetAutoRollover.defaultExpectation.paramPtrs = &BudgetUsecaseMockSetBudgetAutoRolloverParamPtrs{}
+	}
+	mmSetBudgetAutoRollover.defaultExpectation.paramPtrs.accountID = &accountID
+	mmSetBudgetAutoRollover.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+
+	return mmSetBudgetAutoRollover
+}
+
+// ExpectEnabledParam3 sets up expected param enabled for BudgetUsecase.SetBudgetAutoRollover
+func (mmSetBudgetAutoRollover *mBudgetUsecaseMockSetBudgetAutoRollover) ExpectEnabledParam3(enabled bool) *mBudgetUsecaseMockSetBudgetAutoRollover {
+	if mmSetBudgetAutoRollover.mock.funcSetBudgetAutoRollover != nil {
+		mmSetBudgetAutoRollover.mock.t.Fatalf("BudgetUsecaseMock.SetBudgetAutoRollover mock is already set by Set")
+	}
+
+	if mmSetBudgetAutoRollover.defaultExpectation == nil {
+		mmSetBudgetAutoRollover.defaultExpectation = &BudgetUsecaseMockSetBudgetAutoRolloverExpectation{}
+	}
+
+	if mmSetBudgetAutoRollover.defaultExpectation.params != nil {
+		mmSetBudgetAutoRollover.mock.t.Fatalf("BudgetUsecaseMock.SetBudgetAutoRollover mock is already set by Expect")
+	}
+
+	if mmSetBudgetAutoRollover.defaultExpectation.paramPtrs == nil {
+		mmSetBudgetAutoRollover.defaultExpectation.paramPtrs = &BudgetUsecaseMockSetBudgetAutoRolloverParamPtrs{}
+	}
+	mmSetBudgetAutoRollover.defaultExpectation.paramPtrs.enabled = &enabled
+	mmSetBudgetAutoRollover.defaultExpectation.expectationOrigins.originEnabled = minimock.CallerInfo(1)
+
+	return mmSetBudgetAutoRollover
+}
+
+// Inspect accepts an inspector function that has same arguments as the BudgetUsecase.SetBudgetAutoRollover
+func (mmSetBudgetAutoRollover *mBudgetUsecaseMockSetBudgetAutoRollover) Inspect(f func(ctx context.Context, accountID uuid.UUID, enabled bool)) *mBudgetUsecaseMockSetBudgetAutoRollover {
+	if mmSetBudgetAutoRollover.mock.inspectFuncSetBudgetAutoRollover != nil {
+		mmSetBudgetAutoRollover.mock.t.Fatalf("Inspect function is already set for BudgetUsecaseMock.SetBudgetAutoRollover")
+	}
+
+	mmSetBudgetAutoRollover.mock.inspectFuncSetBudgetAutoRollover = f
+
+	return mmSetBudgetAutoRollover
+}
+
+// Return sets up results that will be returned by BudgetUsecase.SetBudgetAutoRollover
+func (mmSetBudgetAutoRollover *mBudgetUsecaseMockSetBudgetAutoRollover) Return(err error) *BudgetUsecaseMock {
+	if mmSetBudgetAutoRollover.mock.funcSetBudgetAutoRollover != nil {
+		mmSetBudgetAutoRollover.mock.t.Fatalf("BudgetUsecaseMock.SetBudgetAutoRollover mock is already set by Set")
+	}
+
+	if mmSetBudgetAutoRollover.defaultExpectation == nil {
+		mmSetBudgetAutoRollover.defaultExpectation = &BudgetUsecaseMockSetBudgetAutoRolloverExpectation{mock: mmSetBudgetAutoRollover.mock}
+	}
+	mmSetBudgetAutoRollover.defaultExpectation.results = &BudgetUsecaseMockSetBudgetAutoRolloverResults{err}
+	mmSetBudgetAutoRollover.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetBudgetAutoRollover.mock
+}
+
+// Set uses given function f to mock the BudgetUsecase.SetBudgetAutoRollover method
+func (mmSetBudgetAutoRollover *mBudgetUsecaseMockSetBudgetAutoRollover) Set(f func(ctx context.Context, accountID uuid.UUID, enabled bool) (err error)) *BudgetUsecaseMock {
+	if mmSetBudgetAutoRollover.defaultExpectation != nil {
+		mmSetBudgetAutoRollover.mock.t.Fatalf("Default expectation is already set for the BudgetUsecase.SetBudgetAutoRollover method")
+	}
+
+	if len(mmSetBudgetAutoRollover.expectations) > 0 {
+		mmSetBudgetAutoRollover.mock.t.Fatalf("Some expectations are already set for the BudgetUsecase.SetBudgetAutoRollover method")
+	}
+
+	mmSetBudgetAutoRollover.mock.funcSetBudgetAutoRollover = f
+	mmSetBudgetAutoRollover.mock.funcSetBudgetAutoRolloverOrigin = minimock.CallerInfo(1)
+	return mmSetBudgetAutoRollover.mock
+}
+
+// When sets expectation for the BudgetUsecase.SetBudgetAutoRollover which will trigger the result defined by the following
+// Then helper
+func (mmSetBudgetAutoRollover *mBudgetUsecaseMockSetBudgetAutoRollover) When(ctx context.Context, accountID uuid.UUID, enabled bool) *BudgetUsecaseMockSetBudgetAutoRolloverExpectation {
+	if mmSetBudgetAutoRollover.mock.funcSetBudgetAutoRollover != nil {
+		mmSetBudgetAutoRollover.mock.t.Fatalf("BudgetUsecaseMock.SetBudgetAutoRollover mock is already set by Set")
+	}
+
+	expectation := &BudgetUsecaseMockSetBudgetAutoRolloverExpectation{
+		mock:               mmSetBudgetAutoRollover.mock,
+		params:             &BudgetUsecaseMockSetBudgetAutoRolloverParams{ctx, accountID, enabled},
+		expectationOrigins: BudgetUsecaseMockSetBudgetAutoRolloverExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetBudgetAutoRollover.expectations = append(mmSetBudgetAutoRollover.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BudgetUsecase.SetBudgetAutoRollover return parameters for the expectation previously defined by the When method
+func (e *BudgetUsecaseMockSetBudgetAutoRolloverExpectation) Then(err error) *BudgetUsecaseMock {
+	e.results = &BudgetUsecaseMockSetBudgetAutoRolloverResults{err}
+	return e.mock
+}
+
+// Times sets number of times BudgetUsecase.SetBudgetAutoRollover should be invoked
+func (mmSetBudgetAutoRollover *mBudgetUsecaseMockSetBudgetAutoRollover) Times(n uint64) *mBudgetUsecaseMockSetBudgetAutoRollover {
+	if n == 0 {
+		mmSetBudgetAutoRollover.mock.t.Fatalf("Times of BudgetUsecaseMock.SetBudgetAutoRollover mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetBudgetAutoRollover.expectedInvocations, n)
+	mmSetBudgetAutoRollover.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetBudgetAutoRollover
+}
+
+func (mmSetBudgetAutoRollover *mBudgetUsecaseMockSetBudgetAutoRollover) invocationsDone() bool {
+	if len(mmSetBudgetAutoRollover.expectations) == 0 && mmSetBudgetAutoRollover.defaultExpectation == nil && mmSetBudgetAutoRollover.mock.funcSetBudgetAutoRollover == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetBudgetAutoRollover.mock.afterSetBudgetAutoRolloverCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetBudgetAutoRollover.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetBudgetAutoRollover implements mm_usecase.BudgetUsecase
+func (mmSetBudgetAutoRollover *BudgetUsecaseMock) SetBudgetAutoRollover(ctx context.Context, accountID uuid.UUID, enabled bool) (err error) {
+	mm_atomic.AddUint64(&mmSetBudgetAutoRollover.beforeSetBudgetAutoRolloverCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetBudgetAutoRollover.afterSetBudgetAutoRolloverCounter, 1)
+
+	mmSetBudgetAutoRollover.t.Helper()
+
+	if mmSetBudgetAutoRollover.inspectFuncSetBudgetAutoRollover != nil {
+		mmSetBudgetAutoRollover.inspectFuncSetBudgetAutoRollover(ctx, accountID, enabled)
+	}
+
+	mm_params := BudgetUsecaseMockSetBudgetAutoRolloverParams{ctx, accountID, enabled}
+
+	// Record call args
+	mmSetBudgetAutoRollover.SetBudgetAutoRolloverMock.mutex.Lock()
+	mmSetBudgetAutoRollover.SetBudgetAutoRolloverMock.callArgs = append(mmSetBudgetAutoRollover.SetBudgetAutoRolloverMock.callArgs, &mm_params)
+	mmSetBudgetAutoRollover.SetBudgetAutoRolloverMock.mutex.Unlock()
+
+	for _, e := range mmSetBudgetAutoRollover.SetBudgetAutoRolloverMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetBudgetAutoRollover.SetBudgetAutoRolloverMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetBudgetAutoRollover.SetBudgetAutoRolloverMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetBudgetAutoRollover.SetBudgetAutoRolloverMock.defaultExpectation.params
+		mm_want_ptrs := mmSetBudgetAutoRollover.SetBudgetAutoRolloverMock.defaultExpectation.paramPtrs
+
+		mm_got := BudgetUsecaseMockSetBudgetAutoRolloverParams{ctx, accountID, enabled}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetBudgetAutoRollover.t.Errorf("BudgetUsecaseMock.SetBudgetAutoRollover got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetBudgetAutoRollover.SetBudgetAutoRolloverMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmSetBudgetAutoRollover.t.Errorf("BudgetUsecaseMock.SetBudgetAutoRollover got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetBudgetAutoRollover.SetBudgetAutoRolloverMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			}
+
+			if mm_want_ptrs.enabled != nil && !minimock.Equal(*mm_want_ptrs.enabled, mm_got.enabled) {
+				mmSetBudgetAutoRollover.t.Errorf("BudgetUsecaseMock.SetBudgetAutoRollover got unexpected parameter enabled, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetBudgetAutoRollover.SetBudgetAutoRolloverMock.defaultExpectation.expectationOrigins.originEnabled, *mm_want_ptrs.enabled, mm_got.enabled, minimock.Diff(*mm_want_ptrs.enabled, mm_got.enabled))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetBudgetAutoRollover.t.Errorf("BudgetUsecaseMock.SetBudgetAutoRollover got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetBudgetAutoRollover.SetBudgetAutoRolloverMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetBudgetAutoRollover.SetBudgetAutoRolloverMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetBudgetAutoRollover.t.Fatal("No results are set for the BudgetUsecaseMock.SetBudgetAutoRollover")
+		}
+		return (*mm_results).err
+	}
+	if mmSetBudgetAutoRollover.funcSetBudgetAutoRollover != nil {
+		return mmSetBudgetAutoRollover.funcSetBudgetAutoRollover(ctx, accountID, enabled)
+	}
+	mmSetBudgetAutoRollover.t.Fatalf("Unexpected call to BudgetUsecaseMock.SetBudgetAutoRollover. %v %v %v", ctx, accountID, enabled)
+	return
+}
+
+// SetBudgetAutoRolloverAfterCounter returns a count of finished BudgetUsecaseMock.SetBudgetAutoRollover invocations
+func (mmSetBudgetAutoRollover *BudgetUsecaseMock) SetBudgetAutoRolloverAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetBudgetAutoRollover.afterSetBudgetAutoRolloverCounter)
+}
+
+// SetBudgetAutoRolloverBeforeCounter returns a count of BudgetUsecaseMock.SetBudgetAutoRollover invocations
+func (mmSetBudgetAutoRollover *BudgetUsecaseMock) SetBudgetAutoRolloverBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetBudgetAutoRollover.beforeSetBudgetAutoRolloverCounter)
+}
+
+// Calls returns a list of arguments used in each call to BudgetUsecaseMock.SetBudgetAutoRollover.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetBudgetAutoRollover *mBudgetUsecaseMockSetBudgetAutoRollover) Calls() []*BudgetUsecaseMockSetBudgetAutoRolloverParams {
+	mmSetBudgetAutoRollover.mutex.RLock()
+
+	argCopy := make([]*BudgetUsecaseMockSetBudgetAutoRolloverParams, len(mmSetBudgetAutoRollover.callArgs))
+	copy(argCopy, mmSetBudgetAutoRollover.callArgs)
+
+	mmSetBudgetAutoRollover.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetBudgetAutoRolloverDone returns true if the count of the SetBudgetAutoRollover invocations corresponds
+// the number of defined expectations
+func (m *BudgetUsecaseMock) MinimockSetBudgetAutoRolloverDone() bool {
+	if m.SetBudgetAutoRolloverMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetBudgetAutoRolloverMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetBudgetAutoRolloverMock.invocationsDone()
+}
+
+// MinimockSetBudgetAutoRolloverInspect logs each unmet expectation
+func (m *BudgetUsecaseMock) MinimockSetBudgetAutoRolloverInspect() {
+	for _, e := range m.SetBudgetAutoRolloverMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BudgetUsecaseMock.SetBudgetAutoRollover at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetBudgetAutoRolloverCounter := mm_atomic.LoadUint64(&m.afterSetBudgetAutoRolloverCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetBudgetAutoRolloverMock.defaultExpectation != nil && afterSetBudgetAutoRolloverCounter < 1 {
+		if m.SetBudgetAutoRolloverMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BudgetUsecaseMock.SetBudgetAutoRollover at\n%s", m.SetBudgetAutoRolloverMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BudgetUsecaseMock.SetBudgetAutoRollover at\n%s with params: %#v", m.SetBudgetAutoRolloverMock.defaultExpectation.expectationOrigins.origin, *m.SetBudgetAutoRolloverMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetBudgetAutoRollover != nil && afterSetBudgetAutoRolloverCounter < 1 {
+		m.t.Errorf("Expected call to BudgetUsecaseMock.SetBudgetAutoRollover at\n%s", m.funcSetBudgetAutoRolloverOrigin)
+	}
+
+	if !m.SetBudgetAutoRolloverMock.invocationsDone() && afterSetBudgetAutoRolloverCounter > 0 {
+		m.t.Errorf("Expected %d calls to BudgetUsecaseMock.SetBudgetAutoRollover at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetBudgetAutoRolloverMock.expectedInvocations), m.SetBudgetAutoRolloverMock.expectedInvocationsOrigin, afterSetBudgetAutoRolloverCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *BudgetUsecaseMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCopyBudgetsInspect()
+
+			m.MinimockCreateBudgetByDTOInspect()
+
+			m.MinimockDeleteBudgetByIDInspect()
+
+			m.MinimockFindCursorListInspect()
+
+			m.MinimockFindListInspect()
+
+			m.MinimockFindOneByIDInspect()
+
+			m.MinimockFindPagedListInspect()
+
+			m.MinimockGetBudgetAutoRolloverInspect()
+
+			m.MinimockPatchBudgetByDTOInspect()
+
+			m.MinimockRolloverBudgetsInspect()
+
+			m.MinimockSetBudgetAutoRolloverInspect()
 		}
 	})
 }
@@ -2348,10 +4309,15 @@ func (m *BudgetUsecaseMock) MinimockWait(timeout mm_time.Duration) {
 func (m *BudgetUsecaseMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockCopyBudgetsDone() &&
 		m.MinimockCreateBudgetByDTODone() &&
 		m.MinimockDeleteBudgetByIDDone() &&
+		m.MinimockFindCursorListDone() &&
 		m.MinimockFindListDone() &&
 		m.MinimockFindOneByIDDone() &&
 		m.MinimockFindPagedListDone() &&
-		m.MinimockPatchBudgetByDTODone()
+		m.MinimockGetBudgetAutoRolloverDone() &&
+		m.MinimockPatchBudgetByDTODone() &&
+		m.MinimockRolloverBudgetsDone() &&
+		m.MinimockSetBudgetAutoRolloverDone()
 }
@@ -0,0 +1,772 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.CacheGenerationRepository -o cache_generation_repository.go -n CacheGenerationRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// CacheGenerationRepositoryMock implements mm_usecase.CacheGenerationRepository
+type CacheGenerationRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcBumpGenerations          func(ctx context.Context, scopes ...string) (err error)
+	funcBumpGenerationsOrigin    string
+	inspectFuncBumpGenerations   func(ctx context.Context, scopes ...string)
+	afterBumpGenerationsCounter  uint64
+	beforeBumpGenerationsCounter uint64
+	BumpGenerationsMock          mCacheGenerationRepositoryMockBumpGenerations
+
+	funcGetGenerations          func(ctx context.Context, scopes ...string) (generations []uint64, err error)
+	funcGetGenerationsOrigin    string
+	inspectFuncGetGenerations   func(ctx context.Context, scopes ...string)
+	afterGetGenerationsCounter  uint64
+	beforeGetGenerationsCounter uint64
+	GetGenerationsMock          mCacheGenerationRepositoryMockGetGenerations
+}
+
+// NewCacheGenerationRepositoryMock returns a mock for mm_usecase.CacheGenerationRepository
+func NewCacheGenerationRepositoryMock(t minimock.Tester) *CacheGenerationRepositoryMock {
+	m := &CacheGenerationRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.BumpGenerationsMock = mCacheGenerationRepositoryMockBumpGenerations{mock: m}
+	m.BumpGenerationsMock.callArgs = []*CacheGenerationRepositoryMockBumpGenerationsParams{}
+
+	m.GetGenerationsMock = mCacheGenerationRepositoryMockGetGenerations{mock: m}
+	m.GetGenerationsMock.callArgs = []*CacheGenerationRepositoryMockGetGenerationsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCacheGenerationRepositoryMockBumpGenerations struct {
+	optional           bool
+	mock               *CacheGenerationRepositoryMock
+	defaultExpectation *CacheGenerationRepositoryMockBumpGenerationsExpectation
+	expectations       []*CacheGenerationRepositoryMockBumpGenerationsExpectation
+
+	callArgs []*CacheGenerationRepositoryMockBumpGenerationsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CacheGenerationRepositoryMockBumpGenerationsExpectation specifies expectation struct of the CacheGenerationRepository.BumpGenerations
+type CacheGenerationRepositoryMockBumpGenerationsExpectation struct {
+	mock               *CacheGenerationRepositoryMock
+	params             *CacheGenerationRepositoryMockBumpGenerationsParams
+	paramPtrs          *CacheGenerationRepositoryMockBumpGenerationsParamPtrs
+	expectationOrigins CacheGenerationRepositoryMockBumpGenerationsExpectationOrigins
+	results            *CacheGenerationRepositoryMockBumpGenerationsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CacheGenerationRepositoryMockBumpGenerationsParams contains parameters of the CacheGenerationRepository.BumpGenerations
+type CacheGenerationRepositoryMockBumpGenerationsParams struct {
+	ctx    context.Context
+	scopes []string
+}
+
+// CacheGenerationRepositoryMockBumpGenerationsParamPtrs contains pointers to parameters of the CacheGenerationRepository.BumpGenerations
+type CacheGenerationRepositoryMockBumpGenerationsParamPtrs struct {
+	ctx    *context.Context
+	scopes *[]string
+}
+
+// CacheGenerationRepositoryMockBumpGenerationsResults contains results of the CacheGenerationRepository.BumpGenerations
+type CacheGenerationRepositoryMockBumpGenerationsResults struct {
+	err error
+}
+
+// CacheGenerationRepositoryMockBumpGenerationsOrigins contains origins of expectations of the CacheGenerationRepository.BumpGenerations
+type CacheGenerationRepositoryMockBumpGenerationsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originScopes string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmBumpGenerations *mCacheGenerationRepositoryMockBumpGenerations) Optional() *mCacheGenerationRepositoryMockBumpGenerations {
+	mmBumpGenerations.optional = true
+	return mmBumpGenerations
+}
+
+// Expect sets up expected params for CacheGenerationRepository.BumpGenerations
+func (mmBumpGenerations *mCacheGenerationRepositoryMockBumpGenerations) Expect(ctx context.Context, scopes ...string) *mCacheGenerationRepositoryMockBumpGenerations {
+	if mmBumpGenerations.mock.funcBumpGenerations != nil {
+		mmBumpGenerations.mock.t.Fatalf("CacheGenerationRepositoryMock.BumpGenerations mock is already set by Set")
+	}
+
+	if mmBumpGenerations.defaultExpectation == nil {
+		mmBumpGenerations.defaultExpectation = &CacheGenerationRepositoryMockBumpGenerationsExpectation{}
+	}
+
+	if mmBumpGenerations.defaultExpectation.paramPtrs != nil {
+		mmBumpGenerations.mock.t.Fatalf("CacheGenerationRepositoryMock.BumpGenerations mock is already set by ExpectParams functions")
+	}
+
+	mmBumpGenerations.defaultExpectation.params = &CacheGenerationRepositoryMockBumpGenerationsParams{ctx, scopes}
+	mmBumpGenerations.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmBumpGenerations.expectations {
+		if minimock.Equal(e.params, mmBumpGenerations.defaultExpectation.params) {
+			mmBumpGenerations.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBumpGenerations.defaultExpectation.params)
+		}
+	}
+
+	return mmBumpGenerations
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CacheGenerationRepository.BumpGenerations
+func (mmBumpGenerations *mCacheGenerationRepositoryMockBumpGenerations) ExpectCtxParam1(ctx context.Context) *mCacheGenerationRepositoryMockBumpGenerations {
+	if mmBumpGenerations.mock.funcBumpGenerations != nil {
+		mmBumpGenerations.mock.t.Fatalf("CacheGenerationRepositoryMock.BumpGenerations mock is already set by Set")
+	}
+
+	if mmBumpGenerations.defaultExpectation == nil {
+		mmBumpGenerations.defaultExpectation = &CacheGenerationRepositoryMockBumpGenerationsExpectation{}
+	}
+
+	if mmBumpGenerations.defaultExpectation.params != nil {
+		mmBumpGenerations.mock.t.Fatalf("CacheGenerationRepositoryMock.BumpGenerations mock is already set by Expect")
+	}
+
+	if mmBumpGenerations.defaultExpectation.paramPtrs == nil {
+		mmBumpGenerations.defaultExpectation.paramPtrs = &CacheGenerationRepositoryMockBumpGenerationsParamPtrs{}
+	}
+	mmBumpGenerations.defaultExpectation.paramPtrs.ctx = &ctx
+	mmBumpGenerations.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmBumpGenerations
+}
+
+// ExpectScopesParam2 sets up expected param scopes for CacheGenerationRepository.BumpGenerations
+func (mmBumpGenerations *mCacheGenerationRepositoryMockBumpGenerations) ExpectScopesParam2(scopes ...string) *mCacheGenerationRepositoryMockBumpGenerations {
+	if mmBumpGenerations.mock.funcBumpGenerations != nil {
+		mmBumpGenerations.mock.t.Fatalf("CacheGenerationRepositoryMock.BumpGenerations mock is already set by Set")
+	}
+
+	if mmBumpGenerations.defaultExpectation == nil {
+		mmBumpGenerations.defaultExpectation = &CacheGenerationRepositoryMockBumpGenerationsExpectation{}
+	}
+
+	if mmBumpGenerations.defaultExpectation.params != nil {
+		mmBumpGenerations.mock.t.Fatalf("CacheGenerationRepositoryMock.BumpGenerations mock is already set by Expect")
+	}
+
+	if mmBumpGenerations.defaultExpectation.paramPtrs == nil {
+		mmBumpGenerations.defaultExpectation.paramPtrs = &CacheGenerationRepositoryMockBumpGenerationsParamPtrs{}
+	}
+	mmBumpGenerations.defaultExpectation.paramPtrs.scopes = &scopes
+	mmBumpGenerations.defaultExpectation.expectationOrigins.originScopes = minimock.CallerInfo(1)
+
+	return mmBumpGenerations
+}
+
+// Inspect accepts an inspector function that has same arguments as the CacheGenerationRepository.BumpGenerations
+func (mmBumpGenerations *mCacheGenerationRepositoryMockBumpGenerations) Inspect(f func(ctx context.Context, scopes ...string)) *mCacheGenerationRepositoryMockBumpGenerations {
+	if mmBumpGenerations.mock.inspectFuncBumpGenerations != nil {
+		mmBumpGenerations.mock.t.Fatalf("Inspect function is already set for CacheGenerationRepositoryMock.BumpGenerations")
+	}
+
+	mmBumpGenerations.mock.inspectFuncBumpGenerations = f
+
+	return mmBumpGenerations
+}
+
+// Return sets up results that will be returned by CacheGenerationRepository.BumpGenerations
+func (mmBumpGenerations *mCacheGenerationRepositoryMockBumpGenerations) Return(err error) *CacheGenerationRepositoryMock {
+	if mmBumpGenerations.mock.funcBumpGenerations != nil {
+		mmBumpGenerations.mock.t.Fatalf("CacheGenerationRepositoryMock.BumpGenerations mock is already set by Set")
+	}
+
+	if mmBumpGenerations.defaultExpectation == nil {
+		mmBumpGenerations.defaultExpectation = &CacheGenerationRepositoryMockBumpGenerationsExpectation{mock: mmBumpGenerations.mock}
+	}
+	mmBumpGenerations.defaultExpectation.results = &CacheGenerationRepositoryMockBumpGenerationsResults{err}
+	mmBumpGenerations.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmBumpGenerations.mock
+}
+
+// Set uses given function f to mock the CacheGenerationRepository.BumpGenerations method
+func (mmBumpGenerations *mCacheGenerationRepositoryMockBumpGenerations) Set(f func(ctx context.Context, scopes ...string) (err error)) *CacheGenerationRepositoryMock {
+	if mmBumpGenerations.defaultExpectation != nil {
+		mmBumpGenerations.mock.t.Fatalf("Default expectation is already set for the CacheGenerationRepository.BumpGenerations method")
+	}
+
+	if len(mmBumpGenerations.expectations) > 0 {
+		mmBumpGenerations.mock.t.Fatalf("Some expectations are already set for the CacheGenerationRepository.BumpGenerations method")
+	}
+
+	mmBumpGenerations.mock.funcBumpGenerations = f
+	mmBumpGenerations.mock.funcBumpGenerationsOrigin = minimock.CallerInfo(1)
+	return mmBumpGenerations.mock
+}
+
+// When sets expectation for the CacheGenerationRepository.BumpGenerations which will trigger the result defined by the following
+// Then helper
+func (mmBumpGenerations *mCacheGenerationRepositoryMockBumpGenerations) When(ctx context.Context, scopes ...string) *CacheGenerationRepositoryMockBumpGenerationsExpectation {
+	if mmBumpGenerations.mock.funcBumpGenerations != nil {
+		mmBumpGenerations.mock.t.Fatalf("CacheGenerationRepositoryMock.BumpGenerations mock is already set by Set")
+	}
+
+	expectation := &CacheGenerationRepositoryMockBumpGenerationsExpectation{
+		mock:               mmBumpGenerations.mock,
+		params:             &CacheGenerationRepositoryMockBumpGenerationsParams{ctx, scopes},
+		expectationOrigins: CacheGenerationRepositoryMockBumpGenerationsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmBumpGenerations.expectations = append(mmBumpGenerations.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CacheGenerationRepository.BumpGenerations return parameters for the expectation previously defined by the When method
+func (e *CacheGenerationRepositoryMockBumpGenerationsExpectation) Then(err error) *CacheGenerationRepositoryMock {
+	e.results = &CacheGenerationRepositoryMockBumpGenerationsResults{err}
+	return e.mock
+}
+
+// Times sets number of times CacheGenerationRepository.BumpGenerations should be invoked
+func (mmBumpGenerations *mCacheGenerationRepositoryMockBumpGenerations) Times(n uint64) *mCacheGenerationRepositoryMockBumpGenerations {
+	if n == 0 {
+		mmBumpGenerations.mock.t.Fatalf("Times of CacheGenerationRepositoryMock.BumpGenerations mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmBumpGenerations.expectedInvocations, n)
+	mmBumpGenerations.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmBumpGenerations
+}
+
+func (mmBumpGenerations *mCacheGenerationRepositoryMockBumpGenerations) invocationsDone() bool {
+	if len(mmBumpGenerations.expectations) == 0 && mmBumpGenerations.defaultExpectation == nil && mmBumpGenerations.mock.funcBumpGenerations == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmBumpGenerations.mock.afterBumpGenerationsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBumpGenerations.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// BumpGenerations implements mm_usecase.CacheGenerationRepository
+func (mmBumpGenerations *CacheGenerationRepositoryMock) BumpGenerations(ctx context.Context, scopes ...string) (err error) {
+	mm_atomic.AddUint64(&mmBumpGenerations.beforeBumpGenerationsCounter, 1)
+	defer mm_atomic.AddUint64(&mmBumpGenerations.afterBumpGenerationsCounter, 1)
+
+	mmBumpGenerations.t.Helper()
+
+	if mmBumpGenerations.inspectFuncBumpGenerations != nil {
+		mmBumpGenerations.inspectFuncBumpGenerations(ctx, scopes...)
+	}
+
+	mm_params := CacheGenerationRepositoryMockBumpGenerationsParams{ctx, scopes}
+
+	// Record call args
+	mmBumpGenerations.BumpGenerationsMock.mutex.Lock()
+	mmBumpGenerations.BumpGenerationsMock.callArgs = append(mmBumpGenerations.BumpGenerationsMock.callArgs, &mm_params)
+	mmBumpGenerations.BumpGenerationsMock.mutex.Unlock()
+
+	for _, e := range mmBumpGenerations.BumpGenerationsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmBumpGenerations.BumpGenerationsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBumpGenerations.BumpGenerationsMock.defaultExpectation.Counter, 1)
+		mm_want := mmBumpGenerations.BumpGenerationsMock.defaultExpectation.params
+		mm_want_ptrs := mmBumpGenerations.BumpGenerationsMock.defaultExpectation.paramPtrs
+
+		mm_got := CacheGenerationRepositoryMockBumpGenerationsParams{ctx, scopes}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmBumpGenerations.t.Errorf("CacheGenerationRepositoryMock.BumpGenerations got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBumpGenerations.BumpGenerationsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.scopes != nil && !minimock.Equal(*mm_want_ptrs.scopes, mm_got.scopes) {
+				mmBumpGenerations.t.Errorf("CacheGenerationRepositoryMock.BumpGenerations got unexpected parameter scopes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBumpGenerations.BumpGenerationsMock.defaultExpectation.expectationOrigins.originScopes, *mm_want_ptrs.scopes, mm_got.scopes, minimock.Diff(*mm_want_ptrs.scopes, mm_got.scopes))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBumpGenerations.t.Errorf("CacheGenerationRepositoryMock.BumpGenerations got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmBumpGenerations.BumpGenerationsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBumpGenerations.BumpGenerationsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBumpGenerations.t.Fatal("No results are set for the CacheGenerationRepositoryMock.BumpGenerations")
+		}
+		return (*mm_results).err
+	}
+	if mmBumpGenerations.funcBumpGenerations != nil {
+		return mmBumpGenerations.funcBumpGenerations(ctx, scopes...)
+	}
+	mmBumpGenerations.t.Fatalf("Unexpected call to CacheGenerationRepositoryMock.BumpGenerations. %v %v", ctx, scopes)
+	return
+}
+
+// BumpGenerationsAfterCounter returns a count of finished CacheGenerationRepositoryMock.BumpGenerations invocations
+func (mmBumpGenerations *CacheGenerationRepositoryMock) BumpGenerationsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBumpGenerations.afterBumpGenerationsCounter)
+}
+
+// BumpGenerationsBeforeCounter returns a count of CacheGenerationRepositoryMock.BumpGenerations invocations
+func (mmBumpGenerations *CacheGenerationRepositoryMock) BumpGenerationsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBumpGenerations.beforeBumpGenerationsCounter)
+}
+
+// Calls returns a list of arguments used in each call to CacheGenerationRepositoryMock.BumpGenerations.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBumpGenerations *mCacheGenerationRepositoryMockBumpGenerations) Calls() []*CacheGenerationRepositoryMockBumpGenerationsParams {
+	mmBumpGenerations.mutex.RLock()
+
+	argCopy := make([]*CacheGenerationRepositoryMockBumpGenerationsParams, len(mmBumpGenerations.callArgs))
+	copy(argCopy, mmBumpGenerations.callArgs)
+
+	mmBumpGenerations.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBumpGenerationsDone returns true if the count of the BumpGenerations invocations corresponds
+// the number of defined expectations
+func (m *CacheGenerationRepositoryMock) MinimockBumpGenerationsDone() bool {
+	if m.BumpGenerationsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.BumpGenerationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.BumpGenerationsMock.invocationsDone()
+}
+
+// MinimockBumpGenerationsInspect logs each unmet expectation
+func (m *CacheGenerationRepositoryMock) MinimockBumpGenerationsInspect() {
+	for _, e := range m.BumpGenerationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CacheGenerationRepositoryMock.BumpGenerations at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterBumpGenerationsCounter := mm_atomic.LoadUint64(&m.afterBumpGenerationsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BumpGenerationsMock.defaultExpectation != nil && afterBumpGenerationsCounter < 1 {
+		if m.BumpGenerationsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheGenerationRepositoryMock.BumpGenerations at\n%s", m.BumpGenerationsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CacheGenerationRepositoryMock.BumpGenerations at\n%s with params: %#v", m.BumpGenerationsMock.defaultExpectation.expectationOrigins.origin, *m.BumpGenerationsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBumpGenerations != nil && afterBumpGenerationsCounter < 1 {
+		m.t.Errorf("Expected call to CacheGenerationRepositoryMock.BumpGenerations at\n%s", m.funcBumpGenerationsOrigin)
+	}
+
+	if !m.BumpGenerationsMock.invocationsDone() && afterBumpGenerationsCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheGenerationRepositoryMock.BumpGenerations at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.BumpGenerationsMock.expectedInvocations), m.BumpGenerationsMock.expectedInvocationsOrigin, afterBumpGenerationsCounter)
+	}
+}
+
+type mCacheGenerationRepositoryMockGetGenerations struct {
+	optional           bool
+	mock               *CacheGenerationRepositoryMock
+	defaultExpectation *CacheGenerationRepositoryMockGetGenerationsExpectation
+	expectations       []*CacheGenerationRepositoryMockGetGenerationsExpectation
+
+	callArgs []*CacheGenerationRepositoryMockGetGenerationsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CacheGenerationRepositoryMockGetGenerationsExpectation specifies expectation struct of the CacheGenerationRepository.GetGenerations
+type CacheGenerationRepositoryMockGetGenerationsExpectation struct {
+	mock               *CacheGenerationRepositoryMock
+	params             *CacheGenerationRepositoryMockGetGenerationsParams
+	paramPtrs          *CacheGenerationRepositoryMockGetGenerationsParamPtrs
+	expectationOrigins CacheGenerationRepositoryMockGetGenerationsExpectationOrigins
+	results            *CacheGenerationRepositoryMockGetGenerationsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CacheGenerationRepositoryMockGetGenerationsParams contains parameters of the CacheGenerationRepository.GetGenerations
+type CacheGenerationRepositoryMockGetGenerationsParams struct {
+	ctx    context.Context
+	scopes []string
+}
+
+// CacheGenerationRepositoryMockGetGenerationsParamPtrs contains pointers to parameters of the CacheGenerationRepository.GetGenerations
+type CacheGenerationRepositoryMockGetGenerationsParamPtrs struct {
+	ctx    *context.Context
+	scopes *[]string
+}
+
+// CacheGenerationRepositoryMockGetGenerationsResults contains results of the CacheGenerationRepository.GetGenerations
+type CacheGenerationRepositoryMockGetGenerationsResults struct {
+	generations []uint64
+	err         error
+}
+
+// CacheGenerationRepositoryMockGetGenerationsOrigins contains origins of expectations of the CacheGenerationRepository.GetGenerations
+type CacheGenerationRepositoryMockGetGenerationsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originScopes string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetGenerations *mCacheGenerationRepositoryMockGetGenerations) Optional() *mCacheGenerationRepositoryMockGetGenerations {
+	mmGetGenerations.optional = true
+	return mmGetGenerations
+}
+
+// Expect sets up expected params for CacheGenerationRepository.GetGenerations
+func (mmGetGenerations *mCacheGenerationRepositoryMockGetGenerations) Expect(ctx context.Context, scopes ...string) *mCacheGenerationRepositoryMockGetGenerations {
+	if mmGetGenerations.mock.funcGetGenerations != nil {
+		mmGetGenerations.mock.t.Fatalf("CacheGenerationRepositoryMock.GetGenerations mock is already set by Set")
+	}
+
+	if mmGetGenerations.defaultExpectation == nil {
+		mmGetGenerations.defaultExpectation = &CacheGenerationRepositoryMockGetGenerationsExpectation{}
+	}
+
+	if mmGetGenerations.defaultExpectation.paramPtrs != nil {
+		mmGetGenerations.mock.t.Fatalf("CacheGenerationRepositoryMock.GetGenerations mock is already set by ExpectParams functions")
+	}
+
+	mmGetGenerations.defaultExpectation.params = &CacheGenerationRepositoryMockGetGenerationsParams{ctx, scopes}
+	mmGetGenerations.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetGenerations.expectations {
+		if minimock.Equal(e.params, mmGetGenerations.defaultExpectation.params) {
+			mmGetGenerations.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetGenerations.defaultExpectation.params)
+		}
+	}
+
+	return mmGetGenerations
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CacheGenerationRepository.GetGenerations
+func (mmGetGenerations *mCacheGenerationRepositoryMockGetGenerations) ExpectCtxParam1(ctx context.Context) *mCacheGenerationRepositoryMockGetGenerations {
+	if mmGetGenerations.mock.funcGetGenerations != nil {
+		mmGetGenerations.mock.t.Fatalf("CacheGenerationRepositoryMock.GetGenerations mock is already set by Set")
+	}
+
+	if mmGetGenerations.defaultExpectation == nil {
+		mmGetGenerations.defaultExpectation = &CacheGenerationRepositoryMockGetGenerationsExpectation{}
+	}
+
+	if mmGetGenerations.defaultExpectation.params != nil {
+		mmGetGenerations.mock.t.Fatalf("CacheGenerationRepositoryMock.GetGenerations mock is already set by Expect")
+	}
+
+	if mmGetGenerations.defaultExpectation.paramPtrs == nil {
+		mmGetGenerations.defaultExpectation.paramPtrs = &CacheGenerationRepositoryMockGetGenerationsParamPtrs{}
+	}
+	mmGetGenerations.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetGenerations.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetGenerations
+}
+
+// ExpectScopesParam2 sets up expected param scopes for CacheGenerationRepository.GetGenerations
+func (mmGetGenerations *mCacheGenerationRepositoryMockGetGenerations) ExpectScopesParam2(scopes ...string) *mCacheGenerationRepositoryMockGetGenerations {
+	if mmGetGenerations.mock.funcGetGenerations != nil {
+		mmGetGenerations.mock.t.Fatalf("CacheGenerationRepositoryMock.GetGenerations mock is already set by Set")
+	}
+
+	if mmGetGenerations.defaultExpectation == nil {
+		mmGetGenerations.defaultExpectation = &CacheGenerationRepositoryMockGetGenerationsExpectation{}
+	}
+
+	if mmGetGenerations.defaultExpectation.params != nil {
+		mmGetGenerations.mock.t.Fatalf("CacheGenerationRepositoryMock.GetGenerations mock is already set by Expect")
+	}
+
+	if mmGetGenerations.defaultExpectation.paramPtrs == nil {
+		mmGetGenerations.defaultExpectation.paramPtrs = &CacheGenerationRepositoryMockGetGenerationsParamPtrs{}
+	}
+	mmGetGenerations.defaultExpectation.paramPtrs.scopes = &scopes
+	mmGetGenerations.defaultExpectation.expectationOrigins.originScopes = minimock.CallerInfo(1)
+
+	return mmGetGenerations
+}
+
+// Inspect accepts an inspector function that has same arguments as the CacheGenerationRepository.GetGenerations
+func (mmGetGenerations *mCacheGenerationRepositoryMockGetGenerations) Inspect(f func(ctx context.Context, scopes ...string)) *mCacheGenerationRepositoryMockGetGenerations {
+	if mmGetGenerations.mock.inspectFuncGetGenerations != nil {
+		mmGetGenerations.mock.t.Fatalf("Inspect function is already set for CacheGenerationRepositoryMock.GetGenerations")
+	}
+
+	mmGetGenerations.mock.inspectFuncGetGenerations = f
+
+	return mmGetGenerations
+}
+
+// Return sets up results that will be returned by CacheGenerationRepository.GetGenerations
+func (mmGetGenerations *mCacheGenerationRepositoryMockGetGenerations) Return(generations []uint64, err error) *CacheGenerationRepositoryMock {
+	if mmGetGenerations.mock.funcGetGenerations != nil {
+		mmGetGenerations.mock.t.Fatalf("CacheGenerationRepositoryMock.GetGenerations mock is already set by Set")
+	}
+
+	if mmGetGenerations.defaultExpectation == nil {
+		mmGetGenerations.defaultExpectation = &CacheGenerationRepositoryMockGetGenerationsExpectation{mock: mmGetGenerations.mock}
+	}
+	mmGetGenerations.defaultExpectation.results = &CacheGenerationRepositoryMockGetGenerationsResults{generations, err}
+	mmGetGenerations.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetGenerations.mock
+}
+
+// Set uses given function f to mock the CacheGenerationRepository.GetGenerations method
+func (mmGetGenerations *mCacheGenerationRepositoryMockGetGenerations) Set(f func(ctx context.Context, scopes ...string) (generations []uint64, err error)) *CacheGenerationRepositoryMock {
+	if mmGetGenerations.defaultExpectation != nil {
+		mmGetGenerations.mock.t.Fatalf("Default expectation is already set for the CacheGenerationRepository.GetGenerations method")
+	}
+
+	if len(mmGetGenerations.expectations) > 0 {
+		mmGetGenerations.mock.t.Fatalf("Some expectations are already set for the CacheGenerationRepository.GetGenerations method")
+	}
+
+	mmGetGenerations.mock.funcGetGenerations = f
+	mmGetGenerations.mock.funcGetGenerationsOrigin = minimock.CallerInfo(1)
+	return mmGetGenerations.mock
+}
+
+// When sets expectation for the CacheGenerationRepository.GetGenerations which will trigger the result defined by the following
+// Then helper
+func (mmGetGenerations *mCacheGenerationRepositoryMockGetGenerations) When(ctx context.Context, scopes ...string) *CacheGenerationRepositoryMockGetGenerationsExpectation {
+	if mmGetGenerations.mock.funcGetGenerations != nil {
+		mmGetGenerations.mock.t.Fatalf("CacheGenerationRepositoryMock.GetGenerations mock is already set by Set")
+	}
+
+	expectation := &CacheGenerationRepositoryMockGetGenerationsExpectation{
+		mock:               mmGetGenerations.mock,
+		params:             &CacheGenerationRepositoryMockGetGenerationsParams{ctx, scopes},
+		expectationOrigins: CacheGenerationRepositoryMockGetGenerationsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetGenerations.expectations = append(mmGetGenerations.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CacheGenerationRepository.GetGenerations return parameters for the expectation previously defined by the When method
+func (e *CacheGenerationRepositoryMockGetGenerationsExpectation) Then(generations []uint64, err error) *CacheGenerationRepositoryMock {
+	e.results = &CacheGenerationRepositoryMockGetGenerationsResults{generations, err}
+	return e.mock
+}
+
+// Times sets number of times CacheGenerationRepository.GetGenerations should be invoked
+func (mmGetGenerations *mCacheGenerationRepositoryMockGetGenerations) Times(n uint64) *mCacheGenerationRepositoryMockGetGenerations {
+	if n == 0 {
+		mmGetGenerations.mock.t.Fatalf("Times of CacheGenerationRepositoryMock.GetGenerations mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetGenerations.expectedInvocations, n)
+	mmGetGenerations.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetGenerations
+}
+
+func (mmGetGenerations *mCacheGenerationRepositoryMockGetGenerations) invocationsDone() bool {
+	if len(mmGetGenerations.expectations) == 0 && mmGetGenerations.defaultExpectation == nil && mmGetGenerations.mock.funcGetGenerations == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetGenerations.mock.afterGetGenerationsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetGenerations.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetGenerations implements mm_usecase.CacheGenerationRepository
+func (mmGetGenerations *CacheGenerationRepositoryMock) GetGenerations(ctx context.Context, scopes ...string) (generations []uint64, err error) {
+	mm_atomic.AddUint64(&mmGetGenerations.beforeGetGenerationsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetGenerations.afterGetGenerationsCounter, 1)
+
+	mmGetGenerations.t.Helper()
+
+	if mmGetGenerations.inspectFuncGetGenerations != nil {
+		mmGetGenerations.inspectFuncGetGenerations(ctx, scopes...)
+	}
+
+	mm_params := CacheGenerationRepositoryMockGetGenerationsParams{ctx, scopes}
+
+	// Record call args
+	mmGetGenerations.GetGenerationsMock.mutex.Lock()
+	mmGetGenerations.GetGenerationsMock.callArgs = append(mmGetGenerations.GetGenerationsMock.callArgs, &mm_params)
+	mmGetGenerations.GetGenerationsMock.mutex.Unlock()
+
+	for _, e := range mmGetGenerations.GetGenerationsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.generations, e.results.err
+		}
+	}
+
+	if mmGetGenerations.GetGenerationsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetGenerations.GetGenerationsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetGenerations.GetGenerationsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetGenerations.GetGenerationsMock.defaultExpectation.paramPtrs
+
+		mm_got := CacheGenerationRepositoryMockGetGenerationsParams{ctx, scopes}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetGenerations.t.Errorf("CacheGenerationRepositoryMock.GetGenerations got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetGenerations.GetGenerationsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.scopes != nil && !minimock.Equal(*mm_want_ptrs.scopes, mm_got.scopes) {
+				mmGetGenerations.t.Errorf("CacheGenerationRepositoryMock.GetGenerations got unexpected parameter scopes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetGenerations.GetGenerationsMock.defaultExpectation.expectationOrigins.originScopes, *mm_want_ptrs.scopes, mm_got.scopes, minimock.Diff(*mm_want_ptrs.scopes, mm_got.scopes))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetGenerations.t.Errorf("CacheGenerationRepositoryMock.GetGenerations got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetGenerations.GetGenerationsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetGenerations.GetGenerationsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetGenerations.t.Fatal("No results are set for the CacheGenerationRepositoryMock.GetGenerations")
+		}
+		return (*mm_results).generations, (*mm_results).err
+	}
+	if mmGetGenerations.funcGetGenerations != nil {
+		return mmGetGenerations.funcGetGenerations(ctx, scopes...)
+	}
+	mmGetGenerations.t.Fatalf("Unexpected call to CacheGenerationRepositoryMock.GetGenerations. %v %v", ctx, scopes)
+	return
+}
+
+// GetGenerationsAfterCounter returns a count of finished CacheGenerationRepositoryMock.GetGenerations invocations
+func (mmGetGenerations *CacheGenerationRepositoryMock) GetGenerationsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetGenerations.afterGetGenerationsCounter)
+}
+
+// GetGenerationsBeforeCounter returns a count of CacheGenerationRepositoryMock.GetGenerations invocations
+func (mmGetGenerations *CacheGenerationRepositoryMock) GetGenerationsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetGenerations.beforeGetGenerationsCounter)
+}
+
+// Calls returns a list of arguments used in each call to CacheGenerationRepositoryMock.GetGenerations.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetGenerations *mCacheGenerationRepositoryMockGetGenerations) Calls() []*CacheGenerationRepositoryMockGetGenerationsParams {
+	mmGetGenerations.mutex.RLock()
+
+	argCopy := make([]*CacheGenerationRepositoryMockGetGenerationsParams, len(mmGetGenerations.callArgs))
+	copy(argCopy, mmGetGenerations.callArgs)
+
+	mmGetGenerations.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetGenerationsDone returns true if the count of the GetGenerations invocations corresponds
+// the number of defined expectations
+func (m *CacheGenerationRepositoryMock) MinimockGetGenerationsDone() bool {
+	if m.GetGenerationsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetGenerationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetGenerationsMock.invocationsDone()
+}
+
+// MinimockGetGenerationsInspect logs each unmet expectation
+func (m *CacheGenerationRepositoryMock) MinimockGetGenerationsInspect() {
+	for _, e := range m.GetGenerationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CacheGenerationRepositoryMock.GetGenerations at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetGenerationsCounter := mm_atomic.LoadUint64(&m.afterGetGenerationsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetGenerationsMock.defaultExpectation != nil && afterGetGenerationsCounter < 1 {
+		if m.GetGenerationsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheGenerationRepositoryMock.GetGenerations at\n%s", m.GetGenerationsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CacheGenerationRepositoryMock.GetGenerations at\n%s with params: %#v", m.GetGenerationsMock.defaultExpectation.expectationOrigins.origin, *m.GetGenerationsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetGenerations != nil && afterGetGenerationsCounter < 1 {
+		m.t.Errorf("Expected call to CacheGenerationRepositoryMock.GetGenerations at\n%s", m.funcGetGenerationsOrigin)
+	}
+
+	if !m.GetGenerationsMock.invocationsDone() && afterGetGenerationsCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheGenerationRepositoryMock.GetGenerations at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetGenerationsMock.expectedInvocations), m.GetGenerationsMock.expectedInvocationsOrigin, afterGetGenerationsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CacheGenerationRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockBumpGenerationsInspect()
+
+			m.MinimockGetGenerationsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CacheGenerationRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CacheGenerationRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockBumpGenerationsDone() &&
+		m.MinimockGetGenerationsDone()
+}
@@ -21,6 +21,13 @@ type CategoryRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcCreate          func(ctx context.Context, item *entity.Category) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, item *entity.Category)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mCategoryRepositoryMockCreate
+
 	funcFindList          func(ctx context.Context, listOptions *mm_usecase.CategoryListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.Category, err error)
 	funcFindListOrigin    string
 	inspectFuncFindList   func(ctx context.Context, listOptions *mm_usecase.CategoryListOptions, queryParams *uctypes.QueryGetListParams)
@@ -34,6 +41,13 @@ type CategoryRepositoryMock struct {
 	afterFindOneByIDCounter  uint64
 	beforeFindOneByIDCounter uint64
 	FindOneByIDMock          mCategoryRepositoryMockFindOneByID
+
+	funcUpdate          func(ctx context.Context, item *entity.Category) (err error)
+	funcUpdateOrigin    string
+	inspectFuncUpdate   func(ctx context.Context, item *entity.Category)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mCategoryRepositoryMockUpdate
 }
 
 // NewCategoryRepositoryMock returns a mock for mm_usecase.CategoryRepository
@@ -44,17 +58,365 @@ func NewCategoryRepositoryMock(t minimock.Tester) *CategoryRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.CreateMock = mCategoryRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*CategoryRepositoryMockCreateParams{}
+
 	m.FindListMock = mCategoryRepositoryMockFindList{mock: m}
 	m.FindListMock.callArgs = []*CategoryRepositoryMockFindListParams{}
 
 	m.FindOneByIDMock = mCategoryRepositoryMockFindOneByID{mock: m}
 	m.FindOneByIDMock.callArgs = []*CategoryRepositoryMockFindOneByIDParams{}
 
+	m.UpdateMock = mCategoryRepositoryMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*CategoryRepositoryMockUpdateParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
+type mCategoryRepositoryMockCreate struct {
+	optional           bool
+	mock               *CategoryRepositoryMock
+	defaultExpectation *CategoryRepositoryMockCreateExpectation
+	expectations       []*CategoryRepositoryMockCreateExpectation
+
+	callArgs []*CategoryRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CategoryRepositoryMockCreateExpectation specifies expectation struct of the CategoryRepository.Create
+type CategoryRepositoryMockCreateExpectation struct {
+	mock               *CategoryRepositoryMock
+	params             *CategoryRepositoryMockCreateParams
+	paramPtrs          *CategoryRepositoryMockCreateParamPtrs
+	expectationOrigins CategoryRepositoryMockCreateExpectationOrigins
+	results            *CategoryRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CategoryRepositoryMockCreateParams contains parameters of the CategoryRepository.Create
+type CategoryRepositoryMockCreateParams struct {
+	ctx  context.Context
+	item *entity.Category
+}
+
+// CategoryRepositoryMockCreateParamPtrs contains pointers to parameters of the CategoryRepository.Create
+type CategoryRepositoryMockCreateParamPtrs struct {
+	ctx  *context.Context
+	item **entity.Category
+}
+
+// CategoryRepositoryMockCreateResults contains results of the CategoryRepository.Create
+type CategoryRepositoryMockCreateResults struct {
+	err error
+}
+
+// CategoryRepositoryMockCreateOrigins contains origins of expectations of the CategoryRepository.Create
+type CategoryRepositoryMockCreateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originItem string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mCategoryRepositoryMockCreate) Optional() *mCategoryRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for CategoryRepository.Create
+func (mmCreate *mCategoryRepositoryMockCreate) Expect(ctx context.Context, item *entity.Category) *mCategoryRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("CategoryRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &CategoryRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("CategoryRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &CategoryRepositoryMockCreateParams{ctx, item}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CategoryRepository.Create
+func (mmCreate *mCategoryRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mCategoryRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("CategoryRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &CategoryRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("CategoryRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &CategoryRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectItemParam2 sets up expected param item for CategoryRepository.Create
+func (mmCreate *mCategoryRepositoryMockCreate) ExpectItemParam2(item *entity.Category) *mCategoryRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("CategoryRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &CategoryRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("CategoryRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &CategoryRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.item = &item
+	mmCreate.defaultExpectation.expectationOrigins.originItem = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the CategoryRepository.Create
+func (mmCreate *mCategoryRepositoryMockCreate) Inspect(f func(ctx context.Context, item *entity.Category)) *mCategoryRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for CategoryRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by CategoryRepository.Create
+func (mmCreate *mCategoryRepositoryMockCreate) Return(err error) *CategoryRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("CategoryRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &CategoryRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &CategoryRepositoryMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the CategoryRepository.Create method
+func (mmCreate *mCategoryRepositoryMockCreate) Set(f func(ctx context.Context, item *entity.Category) (err error)) *CategoryRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the CategoryRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the CategoryRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the CategoryRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mCategoryRepositoryMockCreate) When(ctx context.Context, item *entity.Category) *CategoryRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("CategoryRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &CategoryRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &CategoryRepositoryMockCreateParams{ctx, item},
+		expectationOrigins: CategoryRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CategoryRepository.Create return parameters for the expectation previously defined by the When method
+func (e *CategoryRepositoryMockCreateExpectation) Then(err error) *CategoryRepositoryMock {
+	e.results = &CategoryRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times CategoryRepository.Create should be invoked
+func (mmCreate *mCategoryRepositoryMockCreate) Times(n uint64) *mCategoryRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of CategoryRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mCategoryRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_usecase.CategoryRepository
+func (mmCreate *CategoryRepositoryMock) Create(ctx context.Context, item *entity.Category) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, item)
+	}
+
+	mm_params := CategoryRepositoryMockCreateParams{ctx, item}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := CategoryRepositoryMockCreateParams{ctx, item}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("CategoryRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
+				mmCreate.t.Errorf("CategoryRepositoryMock.Create got unexpected parameter item, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originItem, *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("CategoryRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the CategoryRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, item)
+	}
+	mmCreate.t.Fatalf("Unexpected call to CategoryRepositoryMock.Create. %v %v", ctx, item)
+	return
+}
+
+// CreateAfterCounter returns a count of finished CategoryRepositoryMock.Create invocations
+func (mmCreate *CategoryRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of CategoryRepositoryMock.Create invocations
+func (mmCreate *CategoryRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to CategoryRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mCategoryRepositoryMockCreate) Calls() []*CategoryRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*CategoryRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *CategoryRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *CategoryRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CategoryRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CategoryRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CategoryRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to CategoryRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to CategoryRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
 type mCategoryRepositoryMockFindList struct {
 	optional           bool
 	mock               *CategoryRepositoryMock
@@ -803,13 +1165,359 @@ func (m *CategoryRepositoryMock) MinimockFindOneByIDInspect() {
 	}
 }
 
+type mCategoryRepositoryMockUpdate struct {
+	optional           bool
+	mock               *CategoryRepositoryMock
+	defaultExpectation *CategoryRepositoryMockUpdateExpectation
+	expectations       []*CategoryRepositoryMockUpdateExpectation
+
+	callArgs []*CategoryRepositoryMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CategoryRepositoryMockUpdateExpectation specifies expectation struct of the CategoryRepository.Update
+type CategoryRepositoryMockUpdateExpectation struct {
+	mock               *CategoryRepositoryMock
+	params             *CategoryRepositoryMockUpdateParams
+	paramPtrs          *CategoryRepositoryMockUpdateParamPtrs
+	expectationOrigins CategoryRepositoryMockUpdateExpectationOrigins
+	results            *CategoryRepositoryMockUpdateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CategoryRepositoryMockUpdateParams contains parameters of the CategoryRepository.Update
+type CategoryRepositoryMockUpdateParams struct {
+	ctx  context.Context
+	item *entity.Category
+}
+
+// CategoryRepositoryMockUpdateParamPtrs contains pointers to parameters of the CategoryRepository.Update
+type CategoryRepositoryMockUpdateParamPtrs struct {
+	ctx  *context.Context
+	item **entity.Category
+}
+
+// CategoryRepositoryMockUpdateResults contains results of the CategoryRepository.Update
+type CategoryRepositoryMockUpdateResults struct {
+	err error
+}
+
+// CategoryRepositoryMockUpdateOrigins contains origins of expectations of the CategoryRepository.Update
+type CategoryRepositoryMockUpdateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originItem string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mCategoryRepositoryMockUpdate) Optional() *mCategoryRepositoryMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for CategoryRepository.Update
+func (mmUpdate *mCategoryRepositoryMockUpdate) Expect(ctx context.Context, item *entity.Category) *mCategoryRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("CategoryRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &CategoryRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("CategoryRepositoryMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &CategoryRepositoryMockUpdateParams{ctx, item}
+	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CategoryRepository.Update
+func (mmUpdate *mCategoryRepositoryMockUpdate) ExpectCtxParam1(ctx context.Context) *mCategoryRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("CategoryRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &CategoryRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("CategoryRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &CategoryRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectItemParam2 sets up expected param item for CategoryRepository.Update
+func (mmUpdate *mCategoryRepositoryMockUpdate) ExpectItemParam2(item *entity.Category) *mCategoryRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("CategoryRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &CategoryRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("CategoryRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &CategoryRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.item = &item
+	mmUpdate.defaultExpectation.expectationOrigins.originItem = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the CategoryRepository.Update
+func (mmUpdate *mCategoryRepositoryMockUpdate) Inspect(f func(ctx context.Context, item *entity.Category)) *mCategoryRepositoryMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for CategoryRepositoryMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by CategoryRepository.Update
+func (mmUpdate *mCategoryRepositoryMockUpdate) Return(err error) *CategoryRepositoryMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("CategoryRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &CategoryRepositoryMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &CategoryRepositoryMockUpdateResults{err}
+	mmUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the CategoryRepository.Update method
+func (mmUpdate *mCategoryRepositoryMockUpdate) Set(f func(ctx context.Context, item *entity.Category) (err error)) *CategoryRepositoryMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the CategoryRepository.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the CategoryRepository.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	mmUpdate.mock.funcUpdateOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// When sets expectation for the CategoryRepository.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mCategoryRepositoryMockUpdate) When(ctx context.Context, item *entity.Category) *CategoryRepositoryMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("CategoryRepositoryMock.Update mock is already set by Set")
+	}
+
+	expectation := &CategoryRepositoryMockUpdateExpectation{
+		mock:               mmUpdate.mock,
+		params:             &CategoryRepositoryMockUpdateParams{ctx, item},
+		expectationOrigins: CategoryRepositoryMockUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CategoryRepository.Update return parameters for the expectation previously defined by the When method
+func (e *CategoryRepositoryMockUpdateExpectation) Then(err error) *CategoryRepositoryMock {
+	e.results = &CategoryRepositoryMockUpdateResults{err}
+	return e.mock
+}
+
+// Times sets number of times CategoryRepository.Update should be invoked
+func (mmUpdate *mCategoryRepositoryMockUpdate) Times(n uint64) *mCategoryRepositoryMockUpdate {
+	if n == 0 {
+		mmUpdate.mock.t.Fatalf("Times of CategoryRepositoryMock.Update mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	mmUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdate
+}
+
+func (mmUpdate *mCategoryRepositoryMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Update implements mm_usecase.CategoryRepository
+func (mmUpdate *CategoryRepositoryMock) Update(ctx context.Context, item *entity.Category) (err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	mmUpdate.t.Helper()
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, item)
+	}
+
+	mm_params := CategoryRepositoryMockUpdateParams{ctx, item}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := CategoryRepositoryMockUpdateParams{ctx, item}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("CategoryRepositoryMock.Update got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
+				mmUpdate.t.Errorf("CategoryRepositoryMock.Update got unexpected parameter item, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originItem, *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("CategoryRepositoryMock.Update got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the CategoryRepositoryMock.Update")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, item)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to CategoryRepositoryMock.Update. %v %v", ctx, item)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished CategoryRepositoryMock.Update invocations
+func (mmUpdate *CategoryRepositoryMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of CategoryRepositoryMock.Update invocations
+func (mmUpdate *CategoryRepositoryMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to CategoryRepositoryMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mCategoryRepositoryMockUpdate) Calls() []*CategoryRepositoryMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*CategoryRepositoryMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *CategoryRepositoryMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMock.invocationsDone()
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *CategoryRepositoryMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CategoryRepositoryMock.Update at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CategoryRepositoryMock.Update at\n%s", m.UpdateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CategoryRepositoryMock.Update at\n%s with params: %#v", m.UpdateMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Errorf("Expected call to CategoryRepositoryMock.Update at\n%s", m.funcUpdateOrigin)
+	}
+
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to CategoryRepositoryMock.Update at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), m.UpdateMock.expectedInvocationsOrigin, afterUpdateCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *CategoryRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
 			m.MinimockFindListInspect()
 
 			m.MinimockFindOneByIDInspect()
+
+			m.MinimockUpdateInspect()
 		}
 	})
 }
@@ -833,6 +1541,8 @@ func (m *CategoryRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *CategoryRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockCreateDone() &&
 		m.MinimockFindListDone() &&
-		m.MinimockFindOneByIDDone()
+		m.MinimockFindOneByIDDone() &&
+		m.MinimockUpdateDone()
 }
@@ -0,0 +1,1549 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.CategoryRuleRepository -o category_rule_repository.go -n CategoryRuleRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	mm_usecase "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// CategoryRuleRepositoryMock implements mm_usecase.CategoryRuleRepository
+type CategoryRuleRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, item *entity.CategoryRule) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, item *entity.CategoryRule)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mCategoryRuleRepositoryMockCreate
+
+	funcFindList          func(ctx context.Context, listOptions *mm_usecase.CategoryRuleListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.CategoryRule, err error)
+	funcFindListOrigin    string
+	inspectFuncFindList   func(ctx context.Context, listOptions *mm_usecase.CategoryRuleListOptions, queryParams *uctypes.QueryGetListParams)
+	afterFindListCounter  uint64
+	beforeFindListCounter uint64
+	FindListMock          mCategoryRuleRepositoryMockFindList
+
+	funcFindOneByID          func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (categoryRule *entity.CategoryRule, err error)
+	funcFindOneByIDOrigin    string
+	inspectFuncFindOneByID   func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)
+	afterFindOneByIDCounter  uint64
+	beforeFindOneByIDCounter uint64
+	FindOneByIDMock          mCategoryRuleRepositoryMockFindOneByID
+
+	funcUpdate          func(ctx context.Context, item *entity.CategoryRule) (err error)
+	funcUpdateOrigin    string
+	inspectFuncUpdate   func(ctx context.Context, item *entity.CategoryRule)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mCategoryRuleRepositoryMockUpdate
+}
+
+// NewCategoryRuleRepositoryMock returns a mock for mm_usecase.CategoryRuleRepository
+func NewCategoryRuleRepositoryMock(t minimock.Tester) *CategoryRuleRepositoryMock {
+	m := &CategoryRuleRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mCategoryRuleRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*CategoryRuleRepositoryMockCreateParams{}
+
+	m.FindListMock = mCategoryRuleRepositoryMockFindList{mock: m}
+	m.FindListMock.callArgs = []*CategoryRuleRepositoryMockFindListParams{}
+
+	m.FindOneByIDMock = mCategoryRuleRepositoryMockFindOneByID{mock: m}
+	m.FindOneByIDMock.callArgs = []*CategoryRuleRepositoryMockFindOneByIDParams{}
+
+	m.UpdateMock = mCategoryRuleRepositoryMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*CategoryRuleRepositoryMockUpdateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCategoryRuleRepositoryMockCreate struct {
+	optional           bool
+	mock               *CategoryRuleRepositoryMock
+	defaultExpectation *CategoryRuleRepositoryMockCreateExpectation
+	expectations       []*CategoryRuleRepositoryMockCreateExpectation
+
+	callArgs []*CategoryRuleRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CategoryRuleRepositoryMockCreateExpectation specifies expectation struct of the CategoryRuleRepository.Create
+type CategoryRuleRepositoryMockCreateExpectation struct {
+	mock               *CategoryRuleRepositoryMock
+	params             *CategoryRuleRepositoryMockCreateParams
+	paramPtrs          *CategoryRuleRepositoryMockCreateParamPtrs
+	expectationOrigins CategoryRuleRepositoryMockCreateExpectationOrigins
+	results            *CategoryRuleRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CategoryRuleRepositoryMockCreateParams contains parameters of the CategoryRuleRepository.Create
+type CategoryRuleRepositoryMockCreateParams struct {
+	ctx  context.Context
+	item *entity.CategoryRule
+}
+
+// CategoryRuleRepositoryMockCreateParamPtrs contains pointers to parameters of the CategoryRuleRepository.Create
+type CategoryRuleRepositoryMockCreateParamPtrs struct {
+	ctx  *context.Context
+	item **entity.CategoryRule
+}
+
+// CategoryRuleRepositoryMockCreateResults contains results of the CategoryRuleRepository.Create
+type CategoryRuleRepositoryMockCreateResults struct {
+	err error
+}
+
+// CategoryRuleRepositoryMockCreateOrigins contains origins of expectations of the CategoryRuleRepository.Create
+type CategoryRuleRepositoryMockCreateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originItem string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mCategoryRuleRepositoryMockCreate) Optional() *mCategoryRuleRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for CategoryRuleRepository.Create
+func (mmCreate *mCategoryRuleRepositoryMockCreate) Expect(ctx context.Context, item *entity.CategoryRule) *mCategoryRuleRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("CategoryRuleRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &CategoryRuleRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("CategoryRuleRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &CategoryRuleRepositoryMockCreateParams{ctx, item}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CategoryRuleRepository.Create
+func (mmCreate *mCategoryRuleRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mCategoryRuleRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("CategoryRuleRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &CategoryRuleRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("CategoryRuleRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &CategoryRuleRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectItemParam2 sets up expected param item for CategoryRuleRepository.Create
+func (mmCreate *mCategoryRuleRepositoryMockCreate) ExpectItemParam2(item *entity.CategoryRule) *mCategoryRuleRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("CategoryRuleRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &CategoryRuleRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("CategoryRuleRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &CategoryRuleRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.item = &item
+	mmCreate.defaultExpectation.expectationOrigins.originItem = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the CategoryRuleRepository.Create
+func (mmCreate *mCategoryRuleRepositoryMockCreate) Inspect(f func(ctx context.Context, item *entity.CategoryRule)) *mCategoryRuleRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for CategoryRuleRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by CategoryRuleRepository.Create
+func (mmCreate *mCategoryRuleRepositoryMockCreate) Return(err error) *CategoryRuleRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("CategoryRuleRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &CategoryRuleRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &CategoryRuleRepositoryMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the CategoryRuleRepository.Create method
+func (mmCreate *mCategoryRuleRepositoryMockCreate) Set(f func(ctx context.Context, item *entity.CategoryRule) (err error)) *CategoryRuleRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the CategoryRuleRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the CategoryRuleRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the CategoryRuleRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mCategoryRuleRepositoryMockCreate) When(ctx context.Context, item *entity.CategoryRule) *CategoryRuleRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("CategoryRuleRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &CategoryRuleRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &CategoryRuleRepositoryMockCreateParams{ctx, item},
+		expectationOrigins: CategoryRuleRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CategoryRuleRepository.Create return parameters for the expectation previously defined by the When method
+func (e *CategoryRuleRepositoryMockCreateExpectation) Then(err error) *CategoryRuleRepositoryMock {
+	e.results = &CategoryRuleRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times CategoryRuleRepository.Create should be invoked
+func (mmCreate *mCategoryRuleRepositoryMockCreate) Times(n uint64) *mCategoryRuleRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of CategoryRuleRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mCategoryRuleRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_usecase.CategoryRuleRepository
+func (mmCreate *CategoryRuleRepositoryMock) Create(ctx context.Context, item *entity.CategoryRule) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, item)
+	}
+
+	mm_params := CategoryRuleRepositoryMockCreateParams{ctx, item}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := CategoryRuleRepositoryMockCreateParams{ctx, item}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("CategoryRuleRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
+				mmCreate.t.Errorf("CategoryRuleRepositoryMock.Create got unexpected parameter item, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originItem, *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("CategoryRuleRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the CategoryRuleRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, item)
+	}
+	mmCreate.t.Fatalf("Unexpected call to CategoryRuleRepositoryMock.Create. %v %v", ctx, item)
+	return
+}
+
+// CreateAfterCounter returns a count of finished CategoryRuleRepositoryMock.Create invocations
+func (mmCreate *CategoryRuleRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of CategoryRuleRepositoryMock.Create invocations
+func (mmCreate *CategoryRuleRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to CategoryRuleRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mCategoryRuleRepositoryMockCreate) Calls() []*CategoryRuleRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*CategoryRuleRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *CategoryRuleRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *CategoryRuleRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CategoryRuleRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CategoryRuleRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CategoryRuleRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to CategoryRuleRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to CategoryRuleRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mCategoryRuleRepositoryMockFindList struct {
+	optional           bool
+	mock               *CategoryRuleRepositoryMock
+	defaultExpectation *CategoryRuleRepositoryMockFindListExpectation
+	expectations       []*CategoryRuleRepositoryMockFindListExpectation
+
+	callArgs []*CategoryRuleRepositoryMockFindListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CategoryRuleRepositoryMockFindListExpectation specifies expectation struct of the CategoryRuleRepository.FindList
+type CategoryRuleRepositoryMockFindListExpectation struct {
+	mock               *CategoryRuleRepositoryMock
+	params             *CategoryRuleRepositoryMockFindListParams
+	paramPtrs          *CategoryRuleRepositoryMockFindListParamPtrs
+	expectationOrigins CategoryRuleRepositoryMockFindListExpectationOrigins
+	results            *CategoryRuleRepositoryMockFindListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CategoryRuleRepositoryMockFindListParams contains parameters of the CategoryRuleRepository.FindList
+type CategoryRuleRepositoryMockFindListParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.CategoryRuleListOptions
+	queryParams *uctypes.QueryGetListParams
+}
+
+// CategoryRuleRepositoryMockFindListParamPtrs contains pointers to parameters of the CategoryRuleRepository.FindList
+type CategoryRuleRepositoryMockFindListParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.CategoryRuleListOptions
+	queryParams **uctypes.QueryGetListParams
+}
+
+// CategoryRuleRepositoryMockFindListResults contains results of the CategoryRuleRepository.FindList
+type CategoryRuleRepositoryMockFindListResults struct {
+	items []*entity.CategoryRule
+	err   error
+}
+
+// CategoryRuleRepositoryMockFindListOrigins contains origins of expectations of the CategoryRuleRepository.FindList
+type CategoryRuleRepositoryMockFindListExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindList *mCategoryRuleRepositoryMockFindList) Optional() *mCategoryRuleRepositoryMockFindList {
+	mmFindList.optional = true
+	return mmFindList
+}
+
+// Expect sets up expected params for CategoryRuleRepository.FindList
+func (mmFindList *mCategoryRuleRepositoryMockFindList) Expect(ctx context.Context, listOptions *mm_usecase.CategoryRuleListOptions, queryParams *uctypes.QueryGetListParams) *mCategoryRuleRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &CategoryRuleRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleRepositoryMock.FindList mock is already set by ExpectParams functions")
+	}
+
+	mmFindList.defaultExpectation.params = &CategoryRuleRepositoryMockFindListParams{ctx, listOptions, queryParams}
+	mmFindList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindList.expectations {
+		if minimock.Equal(e.params, mmFindList.defaultExpectation.params) {
+			mmFindList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindList.defaultExpectation.params)
+		}
+	}
+
+	return mmFindList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CategoryRuleRepository.FindList
+func (mmFindList *mCategoryRuleRepositoryMockFindList) ExpectCtxParam1(ctx context.Context) *mCategoryRuleRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &CategoryRuleRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &CategoryRuleRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for CategoryRuleRepository.FindList
+func (mmFindList *mCategoryRuleRepositoryMockFindList) ExpectListOptionsParam2(listOptions *mm_usecase.CategoryRuleListOptions) *mCategoryRuleRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &CategoryRuleRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &CategoryRuleRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmFindList.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for CategoryRuleRepository.FindList
+func (mmFindList *mCategoryRuleRepositoryMockFindList) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mCategoryRuleRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &CategoryRuleRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &CategoryRuleRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindList.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// Inspect accepts an inspector function that has same arguments as the CategoryRuleRepository.FindList
+func (mmFindList *mCategoryRuleRepositoryMockFindList) Inspect(f func(ctx context.Context, listOptions *mm_usecase.CategoryRuleListOptions, queryParams *uctypes.QueryGetListParams)) *mCategoryRuleRepositoryMockFindList {
+	if mmFindList.mock.inspectFuncFindList != nil {
+		mmFindList.mock.t.Fatalf("Inspect function is already set for CategoryRuleRepositoryMock.FindList")
+	}
+
+	mmFindList.mock.inspectFuncFindList = f
+
+	return mmFindList
+}
+
+// Return sets up results that will be returned by CategoryRuleRepository.FindList
+func (mmFindList *mCategoryRuleRepositoryMockFindList) Return(items []*entity.CategoryRule, err error) *CategoryRuleRepositoryMock {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &CategoryRuleRepositoryMockFindListExpectation{mock: mmFindList.mock}
+	}
+	mmFindList.defaultExpectation.results = &CategoryRuleRepositoryMockFindListResults{items, err}
+	mmFindList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// Set uses given function f to mock the CategoryRuleRepository.FindList method
+func (mmFindList *mCategoryRuleRepositoryMockFindList) Set(f func(ctx context.Context, listOptions *mm_usecase.CategoryRuleListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.CategoryRule, err error)) *CategoryRuleRepositoryMock {
+	if mmFindList.defaultExpectation != nil {
+		mmFindList.mock.t.Fatalf("Default expectation is already set for the CategoryRuleRepository.FindList method")
+	}
+
+	if len(mmFindList.expectations) > 0 {
+		mmFindList.mock.t.Fatalf("Some expectations are already set for the CategoryRuleRepository.FindList method")
+	}
+
+	mmFindList.mock.funcFindList = f
+	mmFindList.mock.funcFindListOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// When sets expectation for the CategoryRuleRepository.FindList which will trigger the result defined by the following
+// Then helper
+func (mmFindList *mCategoryRuleRepositoryMockFindList) When(ctx context.Context, listOptions *mm_usecase.CategoryRuleListOptions, queryParams *uctypes.QueryGetListParams) *CategoryRuleRepositoryMockFindListExpectation {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleRepositoryMock.FindList mock is already set by Set")
+	}
+
+	expectation := &CategoryRuleRepositoryMockFindListExpectation{
+		mock:               mmFindList.mock,
+		params:             &CategoryRuleRepositoryMockFindListParams{ctx, listOptions, queryParams},
+		expectationOrigins: CategoryRuleRepositoryMockFindListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindList.expectations = append(mmFindList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CategoryRuleRepository.FindList return parameters for the expectation previously defined by the When method
+func (e *CategoryRuleRepositoryMockFindListExpectation) Then(items []*entity.CategoryRule, err error) *CategoryRuleRepositoryMock {
+	e.results = &CategoryRuleRepositoryMockFindListResults{items, err}
+	return e.mock
+}
+
+// Times sets number of times CategoryRuleRepository.FindList should be invoked
+func (mmFindList *mCategoryRuleRepositoryMockFindList) Times(n uint64) *mCategoryRuleRepositoryMockFindList {
+	if n == 0 {
+		mmFindList.mock.t.Fatalf("Times of CategoryRuleRepositoryMock.FindList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindList.expectedInvocations, n)
+	mmFindList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindList
+}
+
+func (mmFindList *mCategoryRuleRepositoryMockFindList) invocationsDone() bool {
+	if len(mmFindList.expectations) == 0 && mmFindList.defaultExpectation == nil && mmFindList.mock.funcFindList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindList.mock.afterFindListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindList implements mm_usecase.CategoryRuleRepository
+func (mmFindList *CategoryRuleRepositoryMock) FindList(ctx context.Context, listOptions *mm_usecase.CategoryRuleListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.CategoryRule, err error) {
+	mm_atomic.AddUint64(&mmFindList.beforeFindListCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindList.afterFindListCounter, 1)
+
+	mmFindList.t.Helper()
+
+	if mmFindList.inspectFuncFindList != nil {
+		mmFindList.inspectFuncFindList(ctx, listOptions, queryParams)
+	}
+
+	mm_params := CategoryRuleRepositoryMockFindListParams{ctx, listOptions, queryParams}
+
+	// Record call args
+	mmFindList.FindListMock.mutex.Lock()
+	mmFindList.FindListMock.callArgs = append(mmFindList.FindListMock.callArgs, &mm_params)
+	mmFindList.FindListMock.mutex.Unlock()
+
+	for _, e := range mmFindList.FindListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.items, e.results.err
+		}
+	}
+
+	if mmFindList.FindListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindList.FindListMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindList.FindListMock.defaultExpectation.params
+		mm_want_ptrs := mmFindList.FindListMock.defaultExpectation.paramPtrs
+
+		mm_got := CategoryRuleRepositoryMockFindListParams{ctx, listOptions, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindList.t.Errorf("CategoryRuleRepositoryMock.FindList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmFindList.t.Errorf("CategoryRuleRepositoryMock.FindList got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindList.t.Errorf("CategoryRuleRepositoryMock.FindList got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindList.t.Errorf("CategoryRuleRepositoryMock.FindList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindList.FindListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindList.FindListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindList.t.Fatal("No results are set for the CategoryRuleRepositoryMock.FindList")
+		}
+		return (*mm_results).items, (*mm_results).err
+	}
+	if mmFindList.funcFindList != nil {
+		return mmFindList.funcFindList(ctx, listOptions, queryParams)
+	}
+	mmFindList.t.Fatalf("Unexpected call to CategoryRuleRepositoryMock.FindList. %v %v %v", ctx, listOptions, queryParams)
+	return
+}
+
+// FindListAfterCounter returns a count of finished CategoryRuleRepositoryMock.FindList invocations
+func (mmFindList *CategoryRuleRepositoryMock) FindListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.afterFindListCounter)
+}
+
+// FindListBeforeCounter returns a count of CategoryRuleRepositoryMock.FindList invocations
+func (mmFindList *CategoryRuleRepositoryMock) FindListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.beforeFindListCounter)
+}
+
+// Calls returns a list of arguments used in each call to CategoryRuleRepositoryMock.FindList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindList *mCategoryRuleRepositoryMockFindList) Calls() []*CategoryRuleRepositoryMockFindListParams {
+	mmFindList.mutex.RLock()
+
+	argCopy := make([]*CategoryRuleRepositoryMockFindListParams, len(mmFindList.callArgs))
+	copy(argCopy, mmFindList.callArgs)
+
+	mmFindList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindListDone returns true if the count of the FindList invocations corresponds
+// the number of defined expectations
+func (m *CategoryRuleRepositoryMock) MinimockFindListDone() bool {
+	if m.FindListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindListMock.invocationsDone()
+}
+
+// MinimockFindListInspect logs each unmet expectation
+func (m *CategoryRuleRepositoryMock) MinimockFindListInspect() {
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CategoryRuleRepositoryMock.FindList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindListCounter := mm_atomic.LoadUint64(&m.afterFindListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindListMock.defaultExpectation != nil && afterFindListCounter < 1 {
+		if m.FindListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CategoryRuleRepositoryMock.FindList at\n%s", m.FindListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CategoryRuleRepositoryMock.FindList at\n%s with params: %#v", m.FindListMock.defaultExpectation.expectationOrigins.origin, *m.FindListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindList != nil && afterFindListCounter < 1 {
+		m.t.Errorf("Expected call to CategoryRuleRepositoryMock.FindList at\n%s", m.funcFindListOrigin)
+	}
+
+	if !m.FindListMock.invocationsDone() && afterFindListCounter > 0 {
+		m.t.Errorf("Expected %d calls to CategoryRuleRepositoryMock.FindList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindListMock.expectedInvocations), m.FindListMock.expectedInvocationsOrigin, afterFindListCounter)
+	}
+}
+
+type mCategoryRuleRepositoryMockFindOneByID struct {
+	optional           bool
+	mock               *CategoryRuleRepositoryMock
+	defaultExpectation *CategoryRuleRepositoryMockFindOneByIDExpectation
+	expectations       []*CategoryRuleRepositoryMockFindOneByIDExpectation
+
+	callArgs []*CategoryRuleRepositoryMockFindOneByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CategoryRuleRepositoryMockFindOneByIDExpectation specifies expectation struct of the CategoryRuleRepository.FindOneByID
+type CategoryRuleRepositoryMockFindOneByIDExpectation struct {
+	mock               *CategoryRuleRepositoryMock
+	params             *CategoryRuleRepositoryMockFindOneByIDParams
+	paramPtrs          *CategoryRuleRepositoryMockFindOneByIDParamPtrs
+	expectationOrigins CategoryRuleRepositoryMockFindOneByIDExpectationOrigins
+	results            *CategoryRuleRepositoryMockFindOneByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CategoryRuleRepositoryMockFindOneByIDParams contains parameters of the CategoryRuleRepository.FindOneByID
+type CategoryRuleRepositoryMockFindOneByIDParams struct {
+	ctx         context.Context
+	id          uuid.UUID
+	queryParams *uctypes.QueryGetOneParams
+}
+
+// CategoryRuleRepositoryMockFindOneByIDParamPtrs contains pointers to parameters of the CategoryRuleRepository.FindOneByID
+type CategoryRuleRepositoryMockFindOneByIDParamPtrs struct {
+	ctx         *context.Context
+	id          *uuid.UUID
+	queryParams **uctypes.QueryGetOneParams
+}
+
+// CategoryRuleRepositoryMockFindOneByIDResults contains results of the CategoryRuleRepository.FindOneByID
+type CategoryRuleRepositoryMockFindOneByIDResults struct {
+	categoryRule *entity.CategoryRule
+	err          error
+}
+
+// CategoryRuleRepositoryMockFindOneByIDOrigins contains origins of expectations of the CategoryRuleRepository.FindOneByID
+type CategoryRuleRepositoryMockFindOneByIDExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originId          string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindOneByID *mCategoryRuleRepositoryMockFindOneByID) Optional() *mCategoryRuleRepositoryMockFindOneByID {
+	mmFindOneByID.optional = true
+	return mmFindOneByID
+}
+
+// Expect sets up expected params for CategoryRuleRepository.FindOneByID
+func (mmFindOneByID *mCategoryRuleRepositoryMockFindOneByID) Expect(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *mCategoryRuleRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &CategoryRuleRepositoryMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleRepositoryMock.FindOneByID mock is already set by ExpectParams functions")
+	}
+
+	mmFindOneByID.defaultExpectation.params = &CategoryRuleRepositoryMockFindOneByIDParams{ctx, id, queryParams}
+	mmFindOneByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindOneByID.expectations {
+		if minimock.Equal(e.params, mmFindOneByID.defaultExpectation.params) {
+			mmFindOneByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindOneByID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindOneByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CategoryRuleRepository.FindOneByID
+func (mmFindOneByID *mCategoryRuleRepositoryMockFindOneByID) ExpectCtxParam1(ctx context.Context) *mCategoryRuleRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &CategoryRuleRepositoryMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleRepositoryMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &CategoryRuleRepositoryMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindOneByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// ExpectIdParam2 sets up expected param id for CategoryRuleRepository.FindOneByID
+func (mmFindOneByID *mCategoryRuleRepositoryMockFindOneByID) ExpectIdParam2(id uuid.UUID) *mCategoryRuleRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &CategoryRuleRepositoryMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleRepositoryMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &CategoryRuleRepositoryMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.id = &id
+	mmFindOneByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for CategoryRuleRepository.FindOneByID
+func (mmFindOneByID *mCategoryRuleRepositoryMockFindOneByID) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetOneParams) *mCategoryRuleRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &CategoryRuleRepositoryMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleRepositoryMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &CategoryRuleRepositoryMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindOneByID.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the CategoryRuleRepository.FindOneByID
+func (mmFindOneByID *mCategoryRuleRepositoryMockFindOneByID) Inspect(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)) *mCategoryRuleRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.inspectFuncFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("Inspect function is already set for CategoryRuleRepositoryMock.FindOneByID")
+	}
+
+	mmFindOneByID.mock.inspectFuncFindOneByID = f
+
+	return mmFindOneByID
+}
+
+// Return sets up results that will be returned by CategoryRuleRepository.FindOneByID
+func (mmFindOneByID *mCategoryRuleRepositoryMockFindOneByID) Return(categoryRule *entity.CategoryRule, err error) *CategoryRuleRepositoryMock {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &CategoryRuleRepositoryMockFindOneByIDExpectation{mock: mmFindOneByID.mock}
+	}
+	mmFindOneByID.defaultExpectation.results = &CategoryRuleRepositoryMockFindOneByIDResults{categoryRule, err}
+	mmFindOneByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID.mock
+}
+
+// Set uses given function f to mock the CategoryRuleRepository.FindOneByID method
+func (mmFindOneByID *mCategoryRuleRepositoryMockFindOneByID) Set(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (categoryRule *entity.CategoryRule, err error)) *CategoryRuleRepositoryMock {
+	if mmFindOneByID.defaultExpectation != nil {
+		mmFindOneByID.mock.t.Fatalf("Default expectation is already set for the CategoryRuleRepository.FindOneByID method")
+	}
+
+	if len(mmFindOneByID.expectations) > 0 {
+		mmFindOneByID.mock.t.Fatalf("Some expectations are already set for the CategoryRuleRepository.FindOneByID method")
+	}
+
+	mmFindOneByID.mock.funcFindOneByID = f
+	mmFindOneByID.mock.funcFindOneByIDOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID.mock
+}
+
+// When sets expectation for the CategoryRuleRepository.FindOneByID which will trigger the result defined by the following
+// Then helper
+func (mmFindOneByID *mCategoryRuleRepositoryMockFindOneByID) When(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *CategoryRuleRepositoryMockFindOneByIDExpectation {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	expectation := &CategoryRuleRepositoryMockFindOneByIDExpectation{
+		mock:               mmFindOneByID.mock,
+		params:             &CategoryRuleRepositoryMockFindOneByIDParams{ctx, id, queryParams},
+		expectationOrigins: CategoryRuleRepositoryMockFindOneByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindOneByID.expectations = append(mmFindOneByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CategoryRuleRepository.FindOneByID return parameters for the expectation previously defined by the When method
+func (e *CategoryRuleRepositoryMockFindOneByIDExpectation) Then(categoryRule *entity.CategoryRule, err error) *CategoryRuleRepositoryMock {
+	e.results = &CategoryRuleRepositoryMockFindOneByIDResults{categoryRule, err}
+	return e.mock
+}
+
+// Times sets number of times CategoryRuleRepository.FindOneByID should be invoked
+func (mmFindOneByID *mCategoryRuleRepositoryMockFindOneByID) Times(n uint64) *mCategoryRuleRepositoryMockFindOneByID {
+	if n == 0 {
+		mmFindOneByID.mock.t.Fatalf("Times of CategoryRuleRepositoryMock.FindOneByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindOneByID.expectedInvocations, n)
+	mmFindOneByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID
+}
+
+func (mmFindOneByID *mCategoryRuleRepositoryMockFindOneByID) invocationsDone() bool {
+	if len(mmFindOneByID.expectations) == 0 && mmFindOneByID.defaultExpectation == nil && mmFindOneByID.mock.funcFindOneByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindOneByID.mock.afterFindOneByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindOneByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindOneByID implements mm_usecase.CategoryRuleRepository
+func (mmFindOneByID *CategoryRuleRepositoryMock) FindOneByID(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (categoryRule *entity.CategoryRule, err error) {
+	mm_atomic.AddUint64(&mmFindOneByID.beforeFindOneByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindOneByID.afterFindOneByIDCounter, 1)
+
+	mmFindOneByID.t.Helper()
+
+	if mmFindOneByID.inspectFuncFindOneByID != nil {
+		mmFindOneByID.inspectFuncFindOneByID(ctx, id, queryParams)
+	}
+
+	mm_params := CategoryRuleRepositoryMockFindOneByIDParams{ctx, id, queryParams}
+
+	// Record call args
+	mmFindOneByID.FindOneByIDMock.mutex.Lock()
+	mmFindOneByID.FindOneByIDMock.callArgs = append(mmFindOneByID.FindOneByIDMock.callArgs, &mm_params)
+	mmFindOneByID.FindOneByIDMock.mutex.Unlock()
+
+	for _, e := range mmFindOneByID.FindOneByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.categoryRule, e.results.err
+		}
+	}
+
+	if mmFindOneByID.FindOneByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindOneByID.FindOneByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindOneByID.FindOneByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindOneByID.FindOneByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := CategoryRuleRepositoryMockFindOneByIDParams{ctx, id, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindOneByID.t.Errorf("CategoryRuleRepositoryMock.FindOneByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmFindOneByID.t.Errorf("CategoryRuleRepositoryMock.FindOneByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindOneByID.t.Errorf("CategoryRuleRepositoryMock.FindOneByID got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindOneByID.t.Errorf("CategoryRuleRepositoryMock.FindOneByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindOneByID.FindOneByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindOneByID.t.Fatal("No results are set for the CategoryRuleRepositoryMock.FindOneByID")
+		}
+		return (*mm_results).categoryRule, (*mm_results).err
+	}
+	if mmFindOneByID.funcFindOneByID != nil {
+		return mmFindOneByID.funcFindOneByID(ctx, id, queryParams)
+	}
+	mmFindOneByID.t.Fatalf("Unexpected call to CategoryRuleRepositoryMock.FindOneByID. %v %v %v", ctx, id, queryParams)
+	return
+}
+
+// FindOneByIDAfterCounter returns a count of finished CategoryRuleRepositoryMock.FindOneByID invocations
+func (mmFindOneByID *CategoryRuleRepositoryMock) FindOneByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByID.afterFindOneByIDCounter)
+}
+
+// FindOneByIDBeforeCounter returns a count of CategoryRuleRepositoryMock.FindOneByID invocations
+func (mmFindOneByID *CategoryRuleRepositoryMock) FindOneByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByID.beforeFindOneByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to CategoryRuleRepositoryMock.FindOneByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindOneByID *mCategoryRuleRepositoryMockFindOneByID) Calls() []*CategoryRuleRepositoryMockFindOneByIDParams {
+	mmFindOneByID.mutex.RLock()
+
+	argCopy := make([]*CategoryRuleRepositoryMockFindOneByIDParams, len(mmFindOneByID.callArgs))
+	copy(argCopy, mmFindOneByID.callArgs)
+
+	mmFindOneByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindOneByIDDone returns true if the count of the FindOneByID invocations corresponds
+// the number of defined expectations
+func (m *CategoryRuleRepositoryMock) MinimockFindOneByIDDone() bool {
+	if m.FindOneByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindOneByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindOneByIDMock.invocationsDone()
+}
+
+// MinimockFindOneByIDInspect logs each unmet expectation
+func (m *CategoryRuleRepositoryMock) MinimockFindOneByIDInspect() {
+	for _, e := range m.FindOneByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CategoryRuleRepositoryMock.FindOneByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindOneByIDCounter := mm_atomic.LoadUint64(&m.afterFindOneByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindOneByIDMock.defaultExpectation != nil && afterFindOneByIDCounter < 1 {
+		if m.FindOneByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CategoryRuleRepositoryMock.FindOneByID at\n%s", m.FindOneByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CategoryRuleRepositoryMock.FindOneByID at\n%s with params: %#v", m.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindOneByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindOneByID != nil && afterFindOneByIDCounter < 1 {
+		m.t.Errorf("Expected call to CategoryRuleRepositoryMock.FindOneByID at\n%s", m.funcFindOneByIDOrigin)
+	}
+
+	if !m.FindOneByIDMock.invocationsDone() && afterFindOneByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to CategoryRuleRepositoryMock.FindOneByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindOneByIDMock.expectedInvocations), m.FindOneByIDMock.expectedInvocationsOrigin, afterFindOneByIDCounter)
+	}
+}
+
+type mCategoryRuleRepositoryMockUpdate struct {
+	optional           bool
+	mock               *CategoryRuleRepositoryMock
+	defaultExpectation *CategoryRuleRepositoryMockUpdateExpectation
+	expectations       []*CategoryRuleRepositoryMockUpdateExpectation
+
+	callArgs []*CategoryRuleRepositoryMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CategoryRuleRepositoryMockUpdateExpectation specifies expectation struct of the CategoryRuleRepository.Update
+type CategoryRuleRepositoryMockUpdateExpectation struct {
+	mock               *CategoryRuleRepositoryMock
+	params             *CategoryRuleRepositoryMockUpdateParams
+	paramPtrs          *CategoryRuleRepositoryMockUpdateParamPtrs
+	expectationOrigins CategoryRuleRepositoryMockUpdateExpectationOrigins
+	results            *CategoryRuleRepositoryMockUpdateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CategoryRuleRepositoryMockUpdateParams contains parameters of the CategoryRuleRepository.Update
+type CategoryRuleRepositoryMockUpdateParams struct {
+	ctx  context.Context
+	item *entity.CategoryRule
+}
+
+// CategoryRuleRepositoryMockUpdateParamPtrs contains pointers to parameters of the CategoryRuleRepository.Update
+type CategoryRuleRepositoryMockUpdateParamPtrs struct {
+	ctx  *context.Context
+	item **entity.CategoryRule
+}
+
+// CategoryRuleRepositoryMockUpdateResults contains results of the CategoryRuleRepository.Update
+type CategoryRuleRepositoryMockUpdateResults struct {
+	err error
+}
+
+// CategoryRuleRepositoryMockUpdateOrigins contains origins of expectations of the CategoryRuleRepository.Update
+type CategoryRuleRepositoryMockUpdateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originItem string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mCategoryRuleRepositoryMockUpdate) Optional() *mCategoryRuleRepositoryMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for CategoryRuleRepository.Update
+func (mmUpdate *mCategoryRuleRepositoryMockUpdate) Expect(ctx context.Context, item *entity.CategoryRule) *mCategoryRuleRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("CategoryRuleRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &CategoryRuleRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("CategoryRuleRepositoryMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &CategoryRuleRepositoryMockUpdateParams{ctx, item}
+	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CategoryRuleRepository.Update
+func (mmUpdate *mCategoryRuleRepositoryMockUpdate) ExpectCtxParam1(ctx context.Context) *mCategoryRuleRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("CategoryRuleRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &CategoryRuleRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("CategoryRuleRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &CategoryRuleRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectItemParam2 sets up expected param item for CategoryRuleRepository.Update
+func (mmUpdate *mCategoryRuleRepositoryMockUpdate) ExpectItemParam2(item *entity.CategoryRule) *mCategoryRuleRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("CategoryRuleRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &CategoryRuleRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("CategoryRuleRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &CategoryRuleRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.item = &item
+	mmUpdate.defaultExpectation.expectationOrigins.originItem = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the CategoryRuleRepository.Update
+func (mmUpdate *mCategoryRuleRepositoryMockUpdate) Inspect(f func(ctx context.Context, item *entity.CategoryRule)) *mCategoryRuleRepositoryMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for CategoryRuleRepositoryMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by CategoryRuleRepository.Update
+func (mmUpdate *mCategoryRuleRepositoryMockUpdate) Return(err error) *CategoryRuleRepositoryMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("CategoryRuleRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &CategoryRuleRepositoryMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &CategoryRuleRepositoryMockUpdateResults{err}
+	mmUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the CategoryRuleRepository.Update method
+func (mmUpdate *mCategoryRuleRepositoryMockUpdate) Set(f func(ctx context.Context, item *entity.CategoryRule) (err error)) *CategoryRuleRepositoryMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the CategoryRuleRepository.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the CategoryRuleRepository.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	mmUpdate.mock.funcUpdateOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// When sets expectation for the CategoryRuleRepository.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mCategoryRuleRepositoryMockUpdate) When(ctx context.Context, item *entity.CategoryRule) *CategoryRuleRepositoryMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("CategoryRuleRepositoryMock.Update mock is already set by Set")
+	}
+
+	expectation := &CategoryRuleRepositoryMockUpdateExpectation{
+		mock:               mmUpdate.mock,
+		params:             &CategoryRuleRepositoryMockUpdateParams{ctx, item},
+		expectationOrigins: CategoryRuleRepositoryMockUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CategoryRuleRepository.Update return parameters for the expectation previously defined by the When method
+func (e *CategoryRuleRepositoryMockUpdateExpectation) Then(err error) *CategoryRuleRepositoryMock {
+	e.results = &CategoryRuleRepositoryMockUpdateResults{err}
+	return e.mock
+}
+
+// Times sets number of times CategoryRuleRepository.Update should be invoked
+func (mmUpdate *mCategoryRuleRepositoryMockUpdate) Times(n uint64) *mCategoryRuleRepositoryMockUpdate {
+	if n == 0 {
+		mmUpdate.mock.t.Fatalf("Times of CategoryRuleRepositoryMock.Update mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	mmUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdate
+}
+
+func (mmUpdate *mCategoryRuleRepositoryMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Update implements mm_usecase.CategoryRuleRepository
+func (mmUpdate *CategoryRuleRepositoryMock) Update(ctx context.Context, item *entity.CategoryRule) (err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	mmUpdate.t.Helper()
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, item)
+	}
+
+	mm_params := CategoryRuleRepositoryMockUpdateParams{ctx, item}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := CategoryRuleRepositoryMockUpdateParams{ctx, item}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("CategoryRuleRepositoryMock.Update got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
+				mmUpdate.t.Errorf("CategoryRuleRepositoryMock.Update got unexpected parameter item, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originItem, *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("CategoryRuleRepositoryMock.Update got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the CategoryRuleRepositoryMock.Update")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, item)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to CategoryRuleRepositoryMock.Update. %v %v", ctx, item)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished CategoryRuleRepositoryMock.Update invocations
+func (mmUpdate *CategoryRuleRepositoryMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of CategoryRuleRepositoryMock.Update invocations
+func (mmUpdate *CategoryRuleRepositoryMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to CategoryRuleRepositoryMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mCategoryRuleRepositoryMockUpdate) Calls() []*CategoryRuleRepositoryMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*CategoryRuleRepositoryMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *CategoryRuleRepositoryMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMock.invocationsDone()
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *CategoryRuleRepositoryMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CategoryRuleRepositoryMock.Update at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CategoryRuleRepositoryMock.Update at\n%s", m.UpdateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CategoryRuleRepositoryMock.Update at\n%s with params: %#v", m.UpdateMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Errorf("Expected call to CategoryRuleRepositoryMock.Update at\n%s", m.funcUpdateOrigin)
+	}
+
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to CategoryRuleRepositoryMock.Update at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), m.UpdateMock.expectedInvocationsOrigin, afterUpdateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CategoryRuleRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockFindListInspect()
+
+			m.MinimockFindOneByIDInspect()
+
+			m.MinimockUpdateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CategoryRuleRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CategoryRuleRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockFindListDone() &&
+		m.MinimockFindOneByIDDone() &&
+		m.MinimockUpdateDone()
+}
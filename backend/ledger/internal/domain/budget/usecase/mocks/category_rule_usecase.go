@@ -0,0 +1,1966 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.CategoryRuleUsecase -o category_rule_usecase.go -n CategoryRuleUsecaseMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	mm_usecase "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// CategoryRuleUsecaseMock implements mm_usecase.CategoryRuleUsecase
+type CategoryRuleUsecaseMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateCategoryRuleByDTO          func(ctx context.Context, in mm_usecase.CreateCategoryRuleDataInput) (resCategoryRuleDTO *mm_usecase.CategoryRuleDTO, err error)
+	funcCreateCategoryRuleByDTOOrigin    string
+	inspectFuncCreateCategoryRuleByDTO   func(ctx context.Context, in mm_usecase.CreateCategoryRuleDataInput)
+	afterCreateCategoryRuleByDTOCounter  uint64
+	beforeCreateCategoryRuleByDTOCounter uint64
+	CreateCategoryRuleByDTOMock          mCategoryRuleUsecaseMockCreateCategoryRuleByDTO
+
+	funcDeleteCategoryRuleByID          func(ctx context.Context, id uuid.UUID) (err error)
+	funcDeleteCategoryRuleByIDOrigin    string
+	inspectFuncDeleteCategoryRuleByID   func(ctx context.Context, id uuid.UUID)
+	afterDeleteCategoryRuleByIDCounter  uint64
+	beforeDeleteCategoryRuleByIDCounter uint64
+	DeleteCategoryRuleByIDMock          mCategoryRuleUsecaseMockDeleteCategoryRuleByID
+
+	funcFindList          func(ctx context.Context, listOptions *mm_usecase.CategoryRuleListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.CategoryRuleDTO, err error)
+	funcFindListOrigin    string
+	inspectFuncFindList   func(ctx context.Context, listOptions *mm_usecase.CategoryRuleListOptions, queryParams *uctypes.QueryGetListParams)
+	afterFindListCounter  uint64
+	beforeFindListCounter uint64
+	FindListMock          mCategoryRuleUsecaseMockFindList
+
+	funcFindOneByID          func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.CategoryRuleDTO, err error)
+	funcFindOneByIDOrigin    string
+	inspectFuncFindOneByID   func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)
+	afterFindOneByIDCounter  uint64
+	beforeFindOneByIDCounter uint64
+	FindOneByIDMock          mCategoryRuleUsecaseMockFindOneByID
+
+	funcPatchCategoryRuleByDTO          func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchCategoryRuleDataInput, skipVersionCheck bool) (err error)
+	funcPatchCategoryRuleByDTOOrigin    string
+	inspectFuncPatchCategoryRuleByDTO   func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchCategoryRuleDataInput, skipVersionCheck bool)
+	afterPatchCategoryRuleByDTOCounter  uint64
+	beforePatchCategoryRuleByDTOCounter uint64
+	PatchCategoryRuleByDTOMock          mCategoryRuleUsecaseMockPatchCategoryRuleByDTO
+}
+
+// NewCategoryRuleUsecaseMock returns a mock for mm_usecase.CategoryRuleUsecase
+func NewCategoryRuleUsecaseMock(t minimock.Tester) *CategoryRuleUsecaseMock {
+	m := &CategoryRuleUsecaseMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateCategoryRuleByDTOMock = mCategoryRuleUsecaseMockCreateCategoryRuleByDTO{mock: m}
+	m.CreateCategoryRuleByDTOMock.callArgs = []*CategoryRuleUsecaseMockCreateCategoryRuleByDTOParams{}
+
+	m.DeleteCategoryRuleByIDMock = mCategoryRuleUsecaseMockDeleteCategoryRuleByID{mock: m}
+	m.DeleteCategoryRuleByIDMock.callArgs = []*CategoryRuleUsecaseMockDeleteCategoryRuleByIDParams{}
+
+	m.FindListMock = mCategoryRuleUsecaseMockFindList{mock: m}
+	m.FindListMock.callArgs = []*CategoryRuleUsecaseMockFindListParams{}
+
+	m.FindOneByIDMock = mCategoryRuleUsecaseMockFindOneByID{mock: m}
+	m.FindOneByIDMock.callArgs = []*CategoryRuleUsecaseMockFindOneByIDParams{}
+
+	m.PatchCategoryRuleByDTOMock = mCategoryRuleUsecaseMockPatchCategoryRuleByDTO{mock: m}
+	m.PatchCategoryRuleByDTOMock.callArgs = []*CategoryRuleUsecaseMockPatchCategoryRuleByDTOParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCategoryRuleUsecaseMockCreateCategoryRuleByDTO struct {
+	optional           bool
+	mock               *CategoryRuleUsecaseMock
+	defaultExpectation *CategoryRuleUsecaseMockCreateCategoryRuleByDTOExpectation
+	expectations       []*CategoryRuleUsecaseMockCreateCategoryRuleByDTOExpectation
+
+	callArgs []*CategoryRuleUsecaseMockCreateCategoryRuleByDTOParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CategoryRuleUsecaseMockCreateCategoryRuleByDTOExpectation specifies expectation struct of the CategoryRuleUsecase.CreateCategoryRuleByDTO
+type CategoryRuleUsecaseMockCreateCategoryRuleByDTOExpectation struct {
+	mock               *CategoryRuleUsecaseMock
+	params             *CategoryRuleUsecaseMockCreateCategoryRuleByDTOParams
+	paramPtrs          *CategoryRuleUsecaseMockCreateCategoryRuleByDTOParamPtrs
+	expectationOrigins CategoryRuleUsecaseMockCreateCategoryRuleByDTOExpectationOrigins
+	results            *CategoryRuleUsecaseMockCreateCategoryRuleByDTOResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CategoryRuleUsecaseMockCreateCategoryRuleByDTOParams contains parameters of the CategoryRuleUsecase.CreateCategoryRuleByDTO
+type CategoryRuleUsecaseMockCreateCategoryRuleByDTOParams struct {
+	ctx context.Context
+	in  mm_usecase.CreateCategoryRuleDataInput
+}
+
+// CategoryRuleUsecaseMockCreateCategoryRuleByDTOParamPtrs contains pointers to parameters of the CategoryRuleUsecase.CreateCategoryRuleByDTO
+type CategoryRuleUsecaseMockCreateCategoryRuleByDTOParamPtrs struct {
+	ctx *context.Context
+	in  *mm_usecase.CreateCategoryRuleDataInput
+}
+
+// CategoryRuleUsecaseMockCreateCategoryRuleByDTOResults contains results of the CategoryRuleUsecase.CreateCategoryRuleByDTO
+type CategoryRuleUsecaseMockCreateCategoryRuleByDTOResults struct {
+	resCategoryRuleDTO *mm_usecase.CategoryRuleDTO
+	err                error
+}
+
+// CategoryRuleUsecaseMockCreateCategoryRuleByDTOOrigins contains origins of expectations of the CategoryRuleUsecase.CreateCategoryRuleByDTO
+type CategoryRuleUsecaseMockCreateCategoryRuleByDTOExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateCategoryRuleByDTO *mCategoryRuleUsecaseMockCreateCategoryRuleByDTO) Optional() *mCategoryRuleUsecaseMockCreateCategoryRuleByDTO {
+	mmCreateCategoryRuleByDTO.optional = true
+	return mmCreateCategoryRuleByDTO
+}
+
+// Expect sets up expected params for CategoryRuleUsecase.CreateCategoryRuleByDTO
+func (mmCreateCategoryRuleByDTO *mCategoryRuleUsecaseMockCreateCategoryRuleByDTO) Expect(ctx context.Context, in mm_usecase.CreateCategoryRuleDataInput) *mCategoryRuleUsecaseMockCreateCategoryRuleByDTO {
+	if mmCreateCategoryRuleByDTO.mock.funcCreateCategoryRuleByDTO != nil {
+		mmCreateCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.CreateCategoryRuleByDTO mock is already set by Set")
+	}
+
+	if mmCreateCategoryRuleByDTO.defaultExpectation == nil {
+		mmCreateCategoryRuleByDTO.defaultExpectation = &CategoryRuleUsecaseMockCreateCategoryRuleByDTOExpectation{}
+	}
+
+	if mmCreateCategoryRuleByDTO.defaultExpectation.paramPtrs != nil {
+		mmCreateCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.CreateCategoryRuleByDTO mock is already set by ExpectParams functions")
+	}
+
+	mmCreateCategoryRuleByDTO.defaultExpectation.params = &CategoryRuleUsecaseMockCreateCategoryRuleByDTOParams{ctx, in}
+	mmCreateCategoryRuleByDTO.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateCategoryRuleByDTO.expectations {
+		if minimock.Equal(e.params, mmCreateCategoryRuleByDTO.defaultExpectation.params) {
+			mmCreateCategoryRuleByDTO.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateCategoryRuleByDTO.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateCategoryRuleByDTO
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CategoryRuleUsecase.CreateCategoryRuleByDTO
+func (mmCreateCategoryRuleByDTO *mCategoryRuleUsecaseMockCreateCategoryRuleByDTO) ExpectCtxParam1(ctx context.Context) *mCategoryRuleUsecaseMockCreateCategoryRuleByDTO {
+	if mmCreateCategoryRuleByDTO.mock.funcCreateCategoryRuleByDTO != nil {
+		mmCreateCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.CreateCategoryRuleByDTO mock is already set by Set")
+	}
+
+	if mmCreateCategoryRuleByDTO.defaultExpectation == nil {
+		mmCreateCategoryRuleByDTO.defaultExpectation = &CategoryRuleUsecaseMockCreateCategoryRuleByDTOExpectation{}
+	}
+
+	if mmCreateCategoryRuleByDTO.defaultExpectation.params != nil {
+		mmCreateCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.CreateCategoryRuleByDTO mock is already set by Expect")
+	}
+
+	if mmCreateCategoryRuleByDTO.defaultExpectation.paramPtrs == nil {
+		mmCreateCategoryRuleByDTO.defaultExpectation.paramPtrs = &CategoryRuleUsecaseMockCreateCategoryRuleByDTOParamPtrs{}
+	}
+	mmCreateCategoryRuleByDTO.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateCategoryRuleByDTO.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateCategoryRuleByDTO
+}
+
+// ExpectInParam2 sets up expected param in for CategoryRuleUsecase.CreateCategoryRuleByDTO
+func (mmCreateCategoryRuleByDTO *mCategoryRuleUsecaseMockCreateCategoryRuleByDTO) ExpectInParam2(in mm_usecase.CreateCategoryRuleDataInput) *mCategoryRuleUsecaseMockCreateCategoryRuleByDTO {
+	if mmCreateCategoryRuleByDTO.mock.funcCreateCategoryRuleByDTO != nil {
+		mmCreateCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.CreateCategoryRuleByDTO mock is already set by Set")
+	}
+
+	if mmCreateCategoryRuleByDTO.defaultExpectation == nil {
+		mmCreateCategoryRuleByDTO.defaultExpectation = &CategoryRuleUsecaseMockCreateCategoryRuleByDTOExpectation{}
+	}
+
+	if mmCreateCategoryRuleByDTO.defaultExpectation.params != nil {
+		mmCreateCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.CreateCategoryRuleByDTO mock is already set by Expect")
+	}
+
+	if mmCreateCategoryRuleByDTO.defaultExpectation.paramPtrs == nil {
+		mmCreateCategoryRuleByDTO.defaultExpectation.paramPtrs = &CategoryRuleUsecaseMockCreateCategoryRuleByDTOParamPtrs{}
+	}
+	mmCreateCategoryRuleByDTO.defaultExpectation.paramPtrs.in = &in
+	mmCreateCategoryRuleByDTO.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmCreateCategoryRuleByDTO
+}
+
+// Inspect accepts an inspector function that has same arguments as the CategoryRuleUsecase.CreateCategoryRuleByDTO
+func (mmCreateCategoryRuleByDTO *mCategoryRuleUsecaseMockCreateCategoryRuleByDTO) Inspect(f func(ctx context.Context, in mm_usecase.CreateCategoryRuleDataInput)) *mCategoryRuleUsecaseMockCreateCategoryRuleByDTO {
+	if mmCreateCategoryRuleByDTO.mock.inspectFuncCreateCategoryRuleByDTO != nil {
+		mmCreateCategoryRuleByDTO.mock.t.Fatalf("Inspect function is already set for CategoryRuleUsecaseMock.CreateCategoryRuleByDTO")
+	}
+
+	mmCreateCategoryRuleByDTO.mock.inspectFuncCreateCategoryRuleByDTO = f
+
+	return mmCreateCategoryRuleByDTO
+}
+
+// Return sets up results that will be returned by CategoryRuleUsecase.CreateCategoryRuleByDTO
+func (mmCreateCategoryRuleByDTO *mCategoryRuleUsecaseMockCreateCategoryRuleByDTO) Return(resCategoryRuleDTO *mm_usecase.CategoryRuleDTO, err error) *CategoryRuleUsecaseMock {
+	if mmCreateCategoryRuleByDTO.mock.funcCreateCategoryRuleByDTO != nil {
+		mmCreateCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.CreateCategoryRuleByDTO mock is already set by Set")
+	}
+
+	if mmCreateCategoryRuleByDTO.defaultExpectation == nil {
+		mmCreateCategoryRuleByDTO.defaultExpectation = &CategoryRuleUsecaseMockCreateCategoryRuleByDTOExpectation{mock: mmCreateCategoryRuleByDTO.mock}
+	}
+	mmCreateCategoryRuleByDTO.defaultExpectation.results = &CategoryRuleUsecaseMockCreateCategoryRuleByDTOResults{resCategoryRuleDTO, err}
+	mmCreateCategoryRuleByDTO.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateCategoryRuleByDTO.mock
+}
+
+// Set uses given function f to mock the CategoryRuleUsecase.CreateCategoryRuleByDTO method
+func (mmCreateCategoryRuleByDTO *mCategoryRuleUsecaseMockCreateCategoryRuleByDTO) Set(f func(ctx context.Context, in mm_usecase.CreateCategoryRuleDataInput) (resCategoryRuleDTO *mm_usecase.CategoryRuleDTO, err error)) *CategoryRuleUsecaseMock {
+	if mmCreateCategoryRuleByDTO.defaultExpectation != nil {
+		mmCreateCategoryRuleByDTO.mock.t.Fatalf("Default expectation is already set for the CategoryRuleUsecase.CreateCategoryRuleByDTO method")
+	}
+
+	if len(mmCreateCategoryRuleByDTO.expectations) > 0 {
+		mmCreateCategoryRuleByDTO.mock.t.Fatalf("Some expectations are already set for the CategoryRuleUsecase.CreateCategoryRuleByDTO method")
+	}
+
+	mmCreateCategoryRuleByDTO.mock.funcCreateCategoryRuleByDTO = f
+	mmCreateCategoryRuleByDTO.mock.funcCreateCategoryRuleByDTOOrigin = minimock.CallerInfo(1)
+	return mmCreateCategoryRuleByDTO.mock
+}
+
+// When sets expectation for the CategoryRuleUsecase.CreateCategoryRuleByDTO which will trigger the result defined by the following
+// Then helper
+func (mmCreateCategoryRuleByDTO *mCategoryRuleUsecaseMockCreateCategoryRuleByDTO) When(ctx context.Context, in mm_usecase.CreateCategoryRuleDataInput) *CategoryRuleUsecaseMockCreateCategoryRuleByDTOExpectation {
+	if mmCreateCategoryRuleByDTO.mock.funcCreateCategoryRuleByDTO != nil {
+		mmCreateCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.CreateCategoryRuleByDTO mock is already set by Set")
+	}
+
+	expectation := &CategoryRuleUsecaseMockCreateCategoryRuleByDTOExpectation{
+		mock:               mmCreateCategoryRuleByDTO.mock,
+		params:             &CategoryRuleUsecaseMockCreateCategoryRuleByDTOParams{ctx, in},
+		expectationOrigins: CategoryRuleUsecaseMockCreateCategoryRuleByDTOExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateCategoryRuleByDTO.expectations = append(mmCreateCategoryRuleByDTO.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CategoryRuleUsecase.CreateCategoryRuleByDTO return parameters for the expectation previously defined by the When method
+func (e *CategoryRuleUsecaseMockCreateCategoryRuleByDTOExpectation) Then(resCategoryRuleDTO *mm_usecase.CategoryRuleDTO, err error) *CategoryRuleUsecaseMock {
+	e.results = &CategoryRuleUsecaseMockCreateCategoryRuleByDTOResults{resCategoryRuleDTO, err}
+	return e.mock
+}
+
+// Times sets number of times CategoryRuleUsecase.CreateCategoryRuleByDTO should be invoked
+func (mmCreateCategoryRuleByDTO *mCategoryRuleUsecaseMockCreateCategoryRuleByDTO) Times(n uint64) *mCategoryRuleUsecaseMockCreateCategoryRuleByDTO {
+	if n == 0 {
+		mmCreateCategoryRuleByDTO.mock.t.Fatalf("Times of CategoryRuleUsecaseMock.CreateCategoryRuleByDTO mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateCategoryRuleByDTO.expectedInvocations, n)
+	mmCreateCategoryRuleByDTO.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateCategoryRuleByDTO
+}
+
+func (mmCreateCategoryRuleByDTO *mCategoryRuleUsecaseMockCreateCategoryRuleByDTO) invocationsDone() bool {
+	if len(mmCreateCategoryRuleByDTO.expectations) == 0 && mmCreateCategoryRuleByDTO.defaultExpectation == nil && mmCreateCategoryRuleByDTO.mock.funcCreateCategoryRuleByDTO == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateCategoryRuleByDTO.mock.afterCreateCategoryRuleByDTOCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateCategoryRuleByDTO.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateCategoryRuleByDTO implements mm_usecase.CategoryRuleUsecase
+func (mmCreateCategoryRuleByDTO *CategoryRuleUsecaseMock) CreateCategoryRuleByDTO(ctx context.Context, in mm_usecase.CreateCategoryRuleDataInput) (resCategoryRuleDTO *mm_usecase.CategoryRuleDTO, err error) {
+	mm_atomic.AddUint64(&mmCreateCategoryRuleByDTO.beforeCreateCategoryRuleByDTOCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateCategoryRuleByDTO.afterCreateCategoryRuleByDTOCounter, 1)
+
+	mmCreateCategoryRuleByDTO.t.Helper()
+
+	if mmCreateCategoryRuleByDTO.inspectFuncCreateCategoryRuleByDTO != nil {
+		mmCreateCategoryRuleByDTO.inspectFuncCreateCategoryRuleByDTO(ctx, in)
+	}
+
+	mm_params := CategoryRuleUsecaseMockCreateCategoryRuleByDTOParams{ctx, in}
+
+	// Record call args
+	mmCreateCategoryRuleByDTO.CreateCategoryRuleByDTOMock.mutex.Lock()
+	mmCreateCategoryRuleByDTO.CreateCategoryRuleByDTOMock.callArgs = append(mmCreateCategoryRuleByDTO.CreateCategoryRuleByDTOMock.callArgs, &mm_params)
+	mmCreateCategoryRuleByDTO.CreateCategoryRuleByDTOMock.mutex.Unlock()
+
+	for _, e := range mmCreateCategoryRuleByDTO.CreateCategoryRuleByDTOMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resCategoryRuleDTO, e.results.err
+		}
+	}
+
+	if mmCreateCategoryRuleByDTO.CreateCategoryRuleByDTOMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateCategoryRuleByDTO.CreateCategoryRuleByDTOMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateCategoryRuleByDTO.CreateCategoryRuleByDTOMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateCategoryRuleByDTO.CreateCategoryRuleByDTOMock.defaultExpectation.paramPtrs
+
+		mm_got := CategoryRuleUsecaseMockCreateCategoryRuleByDTOParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateCategoryRuleByDTO.t.Errorf("CategoryRuleUsecaseMock.CreateCategoryRuleByDTO got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateCategoryRuleByDTO.CreateCategoryRuleByDTOMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmCreateCategoryRuleByDTO.t.Errorf("CategoryRuleUsecaseMock.CreateCategoryRuleByDTO got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateCategoryRuleByDTO.CreateCategoryRuleByDTOMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateCategoryRuleByDTO.t.Errorf("CategoryRuleUsecaseMock.CreateCategoryRuleByDTO got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateCategoryRuleByDTO.CreateCategoryRuleByDTOMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateCategoryRuleByDTO.CreateCategoryRuleByDTOMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateCategoryRuleByDTO.t.Fatal("No results are set for the CategoryRuleUsecaseMock.CreateCategoryRuleByDTO")
+		}
+		return (*mm_results).resCategoryRuleDTO, (*mm_results).err
+	}
+	if mmCreateCategoryRuleByDTO.funcCreateCategoryRuleByDTO != nil {
+		return mmCreateCategoryRuleByDTO.funcCreateCategoryRuleByDTO(ctx, in)
+	}
+	mmCreateCategoryRuleByDTO.t.Fatalf("Unexpected call to CategoryRuleUsecaseMock.CreateCategoryRuleByDTO. %v %v", ctx, in)
+	return
+}
+
+// CreateCategoryRuleByDTOAfterCounter returns a count of finished CategoryRuleUsecaseMock.CreateCategoryRuleByDTO invocations
+func (mmCreateCategoryRuleByDTO *CategoryRuleUsecaseMock) CreateCategoryRuleByDTOAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateCategoryRuleByDTO.afterCreateCategoryRuleByDTOCounter)
+}
+
+// CreateCategoryRuleByDTOBeforeCounter returns a count of CategoryRuleUsecaseMock.CreateCategoryRuleByDTO invocations
+func (mmCreateCategoryRuleByDTO *CategoryRuleUsecaseMock) CreateCategoryRuleByDTOBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateCategoryRuleByDTO.beforeCreateCategoryRuleByDTOCounter)
+}
+
+// Calls returns a list of arguments used in each call to CategoryRuleUsecaseMock.CreateCategoryRuleByDTO.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateCategoryRuleByDTO *mCategoryRuleUsecaseMockCreateCategoryRuleByDTO) Calls() []*CategoryRuleUsecaseMockCreateCategoryRuleByDTOParams {
+	mmCreateCategoryRuleByDTO.mutex.RLock()
+
+	argCopy := make([]*CategoryRuleUsecaseMockCreateCategoryRuleByDTOParams, len(mmCreateCategoryRuleByDTO.callArgs))
+	copy(argCopy, mmCreateCategoryRuleByDTO.callArgs)
+
+	mmCreateCategoryRuleByDTO.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateCategoryRuleByDTODone returns true if the count of the CreateCategoryRuleByDTO invocations corresponds
+// the number of defined expectations
+func (m *CategoryRuleUsecaseMock) MinimockCreateCategoryRuleByDTODone() bool {
+	if m.CreateCategoryRuleByDTOMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateCategoryRuleByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateCategoryRuleByDTOMock.invocationsDone()
+}
+
+// MinimockCreateCategoryRuleByDTOInspect logs each unmet expectation
+func (m *CategoryRuleUsecaseMock) MinimockCreateCategoryRuleByDTOInspect() {
+	for _, e := range m.CreateCategoryRuleByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CategoryRuleUsecaseMock.CreateCategoryRuleByDTO at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCategoryRuleByDTOCounter := mm_atomic.LoadUint64(&m.afterCreateCategoryRuleByDTOCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateCategoryRuleByDTOMock.defaultExpectation != nil && afterCreateCategoryRuleByDTOCounter < 1 {
+		if m.CreateCategoryRuleByDTOMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CategoryRuleUsecaseMock.CreateCategoryRuleByDTO at\n%s", m.CreateCategoryRuleByDTOMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CategoryRuleUsecaseMock.CreateCategoryRuleByDTO at\n%s with params: %#v", m.CreateCategoryRuleByDTOMock.defaultExpectation.expectationOrigins.origin, *m.CreateCategoryRuleByDTOMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateCategoryRuleByDTO != nil && afterCreateCategoryRuleByDTOCounter < 1 {
+		m.t.Errorf("Expected call to CategoryRuleUsecaseMock.CreateCategoryRuleByDTO at\n%s", m.funcCreateCategoryRuleByDTOOrigin)
+	}
+
+	if !m.CreateCategoryRuleByDTOMock.invocationsDone() && afterCreateCategoryRuleByDTOCounter > 0 {
+		m.t.Errorf("Expected %d calls to CategoryRuleUsecaseMock.CreateCategoryRuleByDTO at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateCategoryRuleByDTOMock.expectedInvocations), m.CreateCategoryRuleByDTOMock.expectedInvocationsOrigin, afterCreateCategoryRuleByDTOCounter)
+	}
+}
+
+type mCategoryRuleUsecaseMockDeleteCategoryRuleByID struct {
+	optional           bool
+	mock               *CategoryRuleUsecaseMock
+	defaultExpectation *CategoryRuleUsecaseMockDeleteCategoryRuleByIDExpectation
+	expectations       []*CategoryRuleUsecaseMockDeleteCategoryRuleByIDExpectation
+
+	callArgs []*CategoryRuleUsecaseMockDeleteCategoryRuleByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CategoryRuleUsecaseMockDeleteCategoryRuleByIDExpectation specifies expectation struct of the CategoryRuleUsecase.DeleteCategoryRuleByID
+type CategoryRuleUsecaseMockDeleteCategoryRuleByIDExpectation struct {
+	mock               *CategoryRuleUsecaseMock
+	params             *CategoryRuleUsecaseMockDeleteCategoryRuleByIDParams
+	paramPtrs          *CategoryRuleUsecaseMockDeleteCategoryRuleByIDParamPtrs
+	expectationOrigins CategoryRuleUsecaseMockDeleteCategoryRuleByIDExpectationOrigins
+	results            *CategoryRuleUsecaseMockDeleteCategoryRuleByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CategoryRuleUsecaseMockDeleteCategoryRuleByIDParams contains parameters of the CategoryRuleUsecase.DeleteCategoryRuleByID
+type CategoryRuleUsecaseMockDeleteCategoryRuleByIDParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// CategoryRuleUsecaseMockDeleteCategoryRuleByIDParamPtrs contains pointers to parameters of the CategoryRuleUsecase.DeleteCategoryRuleByID
+type CategoryRuleUsecaseMockDeleteCategoryRuleByIDParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// CategoryRuleUsecaseMockDeleteCategoryRuleByIDResults contains results of the CategoryRuleUsecase.DeleteCategoryRuleByID
+type CategoryRuleUsecaseMockDeleteCategoryRuleByIDResults struct {
+	err error
+}
+
+// CategoryRuleUsecaseMockDeleteCategoryRuleByIDOrigins contains origins of expectations of the CategoryRuleUsecase.DeleteCategoryRuleByID
+type CategoryRuleUsecaseMockDeleteCategoryRuleByIDExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteCategoryRuleByID *mCategoryRuleUsecaseMockDeleteCategoryRuleByID) Optional() *mCategoryRuleUsecaseMockDeleteCategoryRuleByID {
+	mmDeleteCategoryRuleByID.optional = true
+	return mmDeleteCategoryRuleByID
+}
+
+// Expect sets up expected params for CategoryRuleUsecase.DeleteCategoryRuleByID
+func (mmDeleteCategoryRuleByID *mCategoryRuleUsecaseMockDeleteCategoryRuleByID) Expect(ctx context.Context, id uuid.UUID) *mCategoryRuleUsecaseMockDeleteCategoryRuleByID {
+	if mmDeleteCategoryRuleByID.mock.funcDeleteCategoryRuleByID != nil {
+		mmDeleteCategoryRuleByID.mock.t.Fatalf("CategoryRuleUsecaseMock.DeleteCategoryRuleByID mock is already set by Set")
+	}
+
+	if mmDeleteCategoryRuleByID.defaultExpectation == nil {
+		mmDeleteCategoryRuleByID.defaultExpectation = &CategoryRuleUsecaseMockDeleteCategoryRuleByIDExpectation{}
+	}
+
+	if mmDeleteCategoryRuleByID.defaultExpectation.paramPtrs != nil {
+		mmDeleteCategoryRuleByID.mock.t.Fatalf("CategoryRuleUsecaseMock.DeleteCategoryRuleByID mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteCategoryRuleByID.defaultExpectation.params = &CategoryRuleUsecaseMockDeleteCategoryRuleByIDParams{ctx, id}
+	mmDeleteCategoryRuleByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteCategoryRuleByID.expectations {
+		if minimock.Equal(e.params, mmDeleteCategoryRuleByID.defaultExpectation.params) {
+			mmDeleteCategoryRuleByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteCategoryRuleByID.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteCategoryRuleByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CategoryRuleUsecase.DeleteCategoryRuleByID
+func (mmDeleteCategoryRuleByID *mCategoryRuleUsecaseMockDeleteCategoryRuleByID) ExpectCtxParam1(ctx context.Context) *mCategoryRuleUsecaseMockDeleteCategoryRuleByID {
+	if mmDeleteCategoryRuleByID.mock.funcDeleteCategoryRuleByID != nil {
+		mmDeleteCategoryRuleByID.mock.t.Fatalf("CategoryRuleUsecaseMock.DeleteCategoryRuleByID mock is already set by Set")
+	}
+
+	if mmDeleteCategoryRuleByID.defaultExpectation == nil {
+		mmDeleteCategoryRuleByID.defaultExpectation = &CategoryRuleUsecaseMockDeleteCategoryRuleByIDExpectation{}
+	}
+
+	if mmDeleteCategoryRuleByID.defaultExpectation.params != nil {
+		mmDeleteCategoryRuleByID.mock.t.Fatalf("CategoryRuleUsecaseMock.DeleteCategoryRuleByID mock is already set by Expect")
+	}
+
+	if mmDeleteCategoryRuleByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteCategoryRuleByID.defaultExpectation.paramPtrs = &CategoryRuleUsecaseMockDeleteCategoryRuleByIDParamPtrs{}
+	}
+	mmDeleteCategoryRuleByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteCategoryRuleByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteCategoryRuleByID
+}
+
+// ExpectIdParam2 sets up expected param id for CategoryRuleUsecase.DeleteCategoryRuleByID
+func (mmDeleteCategoryRuleByID *mCategoryRuleUsecaseMockDeleteCategoryRuleByID) ExpectIdParam2(id uuid.UUID) *mCategoryRuleUsecaseMockDeleteCategoryRuleByID {
+	if mmDeleteCategoryRuleByID.mock.funcDeleteCategoryRuleByID != nil {
+		mmDeleteCategoryRuleByID.mock.t.Fatalf("CategoryRuleUsecaseMock.DeleteCategoryRuleByID mock is already set by Set")
+	}
+
+	if mmDeleteCategoryRuleByID.defaultExpectation == nil {
+		mmDeleteCategoryRuleByID.defaultExpectation = &CategoryRuleUsecaseMockDeleteCategoryRuleByIDExpectation{}
+	}
+
+	if mmDeleteCategoryRuleByID.defaultExpectation.params != nil {
+		mmDeleteCategoryRuleByID.mock.t.Fatalf("CategoryRuleUsecaseMock.DeleteCategoryRuleByID mock is already set by Expect")
+	}
+
+	if mmDeleteCategoryRuleByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteCategoryRuleByID.defaultExpectation.paramPtrs = &CategoryRuleUsecaseMockDeleteCategoryRuleByIDParamPtrs{}
+	}
+	mmDeleteCategoryRuleByID.defaultExpectation.paramPtrs.id = &id
+	mmDeleteCategoryRuleByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteCategoryRuleByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the CategoryRuleUsecase.DeleteCategoryRuleByID
+func (mmDeleteCategoryRuleByID *mCategoryRuleUsecaseMockDeleteCategoryRuleByID) Inspect(f func(ctx context.Context, id uuid.UUID)) *mCategoryRuleUsecaseMockDeleteCategoryRuleByID {
+	if mmDeleteCategoryRuleByID.mock.inspectFuncDeleteCategoryRuleByID != nil {
+		mmDeleteCategoryRuleByID.mock.t.Fatalf("Inspect function is already set for CategoryRuleUsecaseMock.DeleteCategoryRuleByID")
+	}
+
+	mmDeleteCategoryRuleByID.mock.inspectFuncDeleteCategoryRuleByID = f
+
+	return mmDeleteCategoryRuleByID
+}
+
+// Return sets up results that will be returned by CategoryRuleUsecase.DeleteCategoryRuleByID
+func (mmDeleteCategoryRuleByID *mCategoryRuleUsecaseMockDeleteCategoryRuleByID) Return(err error) *CategoryRuleUsecaseMock {
+	if mmDeleteCategoryRuleByID.mock.funcDeleteCategoryRuleByID != nil {
+		mmDeleteCategoryRuleByID.mock.t.Fatalf("CategoryRuleUsecaseMock.DeleteCategoryRuleByID mock is already set by Set")
+	}
+
+	if mmDeleteCategoryRuleByID.defaultExpectation == nil {
+		mmDeleteCategoryRuleByID.defaultExpectation = &CategoryRuleUsecaseMockDeleteCategoryRuleByIDExpectation{mock: mmDeleteCategoryRuleByID.mock}
+	}
+	mmDeleteCategoryRuleByID.defaultExpectation.results = &CategoryRuleUsecaseMockDeleteCategoryRuleByIDResults{err}
+	mmDeleteCategoryRuleByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteCategoryRuleByID.mock
+}
+
+// Set uses given function f to mock the CategoryRuleUsecase.DeleteCategoryRuleByID method
+func (mmDeleteCategoryRuleByID *mCategoryRuleUsecaseMockDeleteCategoryRuleByID) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *CategoryRuleUsecaseMock {
+	if mmDeleteCategoryRuleByID.defaultExpectation != nil {
+		mmDeleteCategoryRuleByID.mock.t.Fatalf("Default expectation is already set for the CategoryRuleUsecase.DeleteCategoryRuleByID method")
+	}
+
+	if len(mmDeleteCategoryRuleByID.expectations) > 0 {
+		mmDeleteCategoryRuleByID.mock.t.Fatalf("Some expectations are already set for the CategoryRuleUsecase.DeleteCategoryRuleByID method")
+	}
+
+	mmDeleteCategoryRuleByID.mock.funcDeleteCategoryRuleByID = f
+	mmDeleteCategoryRuleByID.mock.funcDeleteCategoryRuleByIDOrigin = minimock.CallerInfo(1)
+	return mmDeleteCategoryRuleByID.mock
+}
+
+// When sets expectation for the CategoryRuleUsecase.DeleteCategoryRuleByID which will trigger the result defined by the following
+// Then helper
+func (mmDeleteCategoryRuleByID *mCategoryRuleUsecaseMockDeleteCategoryRuleByID) When(ctx context.Context, id uuid.UUID) *CategoryRuleUsecaseMockDeleteCategoryRuleByIDExpectation {
+	if mmDeleteCategoryRuleByID.mock.funcDeleteCategoryRuleByID != nil {
+		mmDeleteCategoryRuleByID.mock.t.Fatalf("CategoryRuleUsecaseMock.DeleteCategoryRuleByID mock is already set by Set")
+	}
+
+	expectation := &CategoryRuleUsecaseMockDeleteCategoryRuleByIDExpectation{
+		mock:               mmDeleteCategoryRuleByID.mock,
+		params:             &CategoryRuleUsecaseMockDeleteCategoryRuleByIDParams{ctx, id},
+		expectationOrigins: CategoryRuleUsecaseMockDeleteCategoryRuleByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteCategoryRuleByID.expectations = append(mmDeleteCategoryRuleByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CategoryRuleUsecase.DeleteCategoryRuleByID return parameters for the expectation previously defined by the When method
+func (e *CategoryRuleUsecaseMockDeleteCategoryRuleByIDExpectation) Then(err error) *CategoryRuleUsecaseMock {
+	e.results = &CategoryRuleUsecaseMockDeleteCategoryRuleByIDResults{err}
+	return e.mock
+}
+
+// Times sets number of times CategoryRuleUsecase.DeleteCategoryRuleByID should be invoked
+func (mmDeleteCategoryRuleByID *mCategoryRuleUsecaseMockDeleteCategoryRuleByID) Times(n uint64) *mCategoryRuleUsecaseMockDeleteCategoryRuleByID {
+	if n == 0 {
+		mmDeleteCategoryRuleByID.mock.t.Fatalf("Times of CategoryRuleUsecaseMock.DeleteCategoryRuleByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteCategoryRuleByID.expectedInvocations, n)
+	mmDeleteCategoryRuleByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteCategoryRuleByID
+}
+
+func (mmDeleteCategoryRuleByID *mCategoryRuleUsecaseMockDeleteCategoryRuleByID) invocationsDone() bool {
+	if len(mmDeleteCategoryRuleByID.expectations) == 0 && mmDeleteCategoryRuleByID.defaultExpectation == nil && mmDeleteCategoryRuleByID.mock.funcDeleteCategoryRuleByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteCategoryRuleByID.mock.afterDeleteCategoryRuleByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteCategoryRuleByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteCategoryRuleByID implements mm_usecase.CategoryRuleUsecase
+func (mmDeleteCategoryRuleByID *CategoryRuleUsecaseMock) DeleteCategoryRuleByID(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteCategoryRuleByID.beforeDeleteCategoryRuleByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteCategoryRuleByID.afterDeleteCategoryRuleByIDCounter, 1)
+
+	mmDeleteCategoryRuleByID.t.Helper()
+
+	if mmDeleteCategoryRuleByID.inspectFuncDeleteCategoryRuleByID != nil {
+		mmDeleteCategoryRuleByID.inspectFuncDeleteCategoryRuleByID(ctx, id)
+	}
+
+	mm_params := CategoryRuleUsecaseMockDeleteCategoryRuleByIDParams{ctx, id}
+
+	// Record call args
+	mmDeleteCategoryRuleByID.DeleteCategoryRuleByIDMock.mutex.Lock()
+	mmDeleteCategoryRuleByID.DeleteCategoryRuleByIDMock.callArgs = append(mmDeleteCategoryRuleByID.DeleteCategoryRuleByIDMock.callArgs, &mm_params)
+	mmDeleteCategoryRuleByID.DeleteCategoryRuleByIDMock.mutex.Unlock()
+
+	for _, e := range mmDeleteCategoryRuleByID.DeleteCategoryRuleByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteCategoryRuleByID.DeleteCategoryRuleByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteCategoryRuleByID.DeleteCategoryRuleByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteCategoryRuleByID.DeleteCategoryRuleByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteCategoryRuleByID.DeleteCategoryRuleByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := CategoryRuleUsecaseMockDeleteCategoryRuleByIDParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteCategoryRuleByID.t.Errorf("CategoryRuleUsecaseMock.DeleteCategoryRuleByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteCategoryRuleByID.DeleteCategoryRuleByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteCategoryRuleByID.t.Errorf("CategoryRuleUsecaseMock.DeleteCategoryRuleByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteCategoryRuleByID.DeleteCategoryRuleByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteCategoryRuleByID.t.Errorf("CategoryRuleUsecaseMock.DeleteCategoryRuleByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteCategoryRuleByID.DeleteCategoryRuleByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteCategoryRuleByID.DeleteCategoryRuleByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteCategoryRuleByID.t.Fatal("No results are set for the CategoryRuleUsecaseMock.DeleteCategoryRuleByID")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteCategoryRuleByID.funcDeleteCategoryRuleByID != nil {
+		return mmDeleteCategoryRuleByID.funcDeleteCategoryRuleByID(ctx, id)
+	}
+	mmDeleteCategoryRuleByID.t.Fatalf("Unexpected call to CategoryRuleUsecaseMock.DeleteCategoryRuleByID. %v %v", ctx, id)
+	return
+}
+
+// DeleteCategoryRuleByIDAfterCounter returns a count of finished CategoryRuleUsecaseMock.DeleteCategoryRuleByID invocations
+func (mmDeleteCategoryRuleByID *CategoryRuleUsecaseMock) DeleteCategoryRuleByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteCategoryRuleByID.afterDeleteCategoryRuleByIDCounter)
+}
+
+// DeleteCategoryRuleByIDBeforeCounter returns a count of CategoryRuleUsecaseMock.DeleteCategoryRuleByID invocations
+func (mmDeleteCategoryRuleByID *CategoryRuleUsecaseMock) DeleteCategoryRuleByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteCategoryRuleByID.beforeDeleteCategoryRuleByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to CategoryRuleUsecaseMock.DeleteCategoryRuleByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteCategoryRuleByID *mCategoryRuleUsecaseMockDeleteCategoryRuleByID) Calls() []*CategoryRuleUsecaseMockDeleteCategoryRuleByIDParams {
+	mmDeleteCategoryRuleByID.mutex.RLock()
+
+	argCopy := make([]*CategoryRuleUsecaseMockDeleteCategoryRuleByIDParams, len(mmDeleteCategoryRuleByID.callArgs))
+	copy(argCopy, mmDeleteCategoryRuleByID.callArgs)
+
+	mmDeleteCategoryRuleByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteCategoryRuleByIDDone returns true if the count of the DeleteCategoryRuleByID invocations corresponds
+// the number of defined expectations
+func (m *CategoryRuleUsecaseMock) MinimockDeleteCategoryRuleByIDDone() bool {
+	if m.DeleteCategoryRuleByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteCategoryRuleByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteCategoryRuleByIDMock.invocationsDone()
+}
+
+// MinimockDeleteCategoryRuleByIDInspect logs each unmet expectation
+func (m *CategoryRuleUsecaseMock) MinimockDeleteCategoryRuleByIDInspect() {
+	for _, e := range m.DeleteCategoryRuleByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CategoryRuleUsecaseMock.DeleteCategoryRuleByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCategoryRuleByIDCounter := mm_atomic.LoadUint64(&m.afterDeleteCategoryRuleByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteCategoryRuleByIDMock.defaultExpectation != nil && afterDeleteCategoryRuleByIDCounter < 1 {
+		if m.DeleteCategoryRuleByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CategoryRuleUsecaseMock.DeleteCategoryRuleByID at\n%s", m.DeleteCategoryRuleByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CategoryRuleUsecaseMock.DeleteCategoryRuleByID at\n%s with params: %#v", m.DeleteCategoryRuleByIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteCategoryRuleByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteCategoryRuleByID != nil && afterDeleteCategoryRuleByIDCounter < 1 {
+		m.t.Errorf("Expected call to CategoryRuleUsecaseMock.DeleteCategoryRuleByID at\n%s", m.funcDeleteCategoryRuleByIDOrigin)
+	}
+
+	if !m.DeleteCategoryRuleByIDMock.invocationsDone() && afterDeleteCategoryRuleByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to CategoryRuleUsecaseMock.DeleteCategoryRuleByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteCategoryRuleByIDMock.expectedInvocations), m.DeleteCategoryRuleByIDMock.expectedInvocationsOrigin, afterDeleteCategoryRuleByIDCounter)
+	}
+}
+
+type mCategoryRuleUsecaseMockFindList struct {
+	optional           bool
+	mock               *CategoryRuleUsecaseMock
+	defaultExpectation *CategoryRuleUsecaseMockFindListExpectation
+	expectations       []*CategoryRuleUsecaseMockFindListExpectation
+
+	callArgs []*CategoryRuleUsecaseMockFindListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CategoryRuleUsecaseMockFindListExpectation specifies expectation struct of the CategoryRuleUsecase.FindList
+type CategoryRuleUsecaseMockFindListExpectation struct {
+	mock               *CategoryRuleUsecaseMock
+	params             *CategoryRuleUsecaseMockFindListParams
+	paramPtrs          *CategoryRuleUsecaseMockFindListParamPtrs
+	expectationOrigins CategoryRuleUsecaseMockFindListExpectationOrigins
+	results            *CategoryRuleUsecaseMockFindListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CategoryRuleUsecaseMockFindListParams contains parameters of the CategoryRuleUsecase.FindList
+type CategoryRuleUsecaseMockFindListParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.CategoryRuleListOptions
+	queryParams *uctypes.QueryGetListParams
+}
+
+// CategoryRuleUsecaseMockFindListParamPtrs contains pointers to parameters of the CategoryRuleUsecase.FindList
+type CategoryRuleUsecaseMockFindListParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.CategoryRuleListOptions
+	queryParams **uctypes.QueryGetListParams
+}
+
+// CategoryRuleUsecaseMockFindListResults contains results of the CategoryRuleUsecase.FindList
+type CategoryRuleUsecaseMockFindListResults struct {
+	resItems []*mm_usecase.CategoryRuleDTO
+	err      error
+}
+
+// CategoryRuleUsecaseMockFindListOrigins contains origins of expectations of the CategoryRuleUsecase.FindList
+type CategoryRuleUsecaseMockFindListExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindList *mCategoryRuleUsecaseMockFindList) Optional() *mCategoryRuleUsecaseMockFindList {
+	mmFindList.optional = true
+	return mmFindList
+}
+
+// Expect sets up expected params for CategoryRuleUsecase.FindList
+func (mmFindList *mCategoryRuleUsecaseMockFindList) Expect(ctx context.Context, listOptions *mm_usecase.CategoryRuleListOptions, queryParams *uctypes.QueryGetListParams) *mCategoryRuleUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &CategoryRuleUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleUsecaseMock.FindList mock is already set by ExpectParams functions")
+	}
+
+	mmFindList.defaultExpectation.params = &CategoryRuleUsecaseMockFindListParams{ctx, listOptions, queryParams}
+	mmFindList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindList.expectations {
+		if minimock.Equal(e.params, mmFindList.defaultExpectation.params) {
+			mmFindList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindList.defaultExpectation.params)
+		}
+	}
+
+	return mmFindList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CategoryRuleUsecase.FindList
+func (mmFindList *mCategoryRuleUsecaseMockFindList) ExpectCtxParam1(ctx context.Context) *mCategoryRuleUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &CategoryRuleUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleUsecaseMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &CategoryRuleUsecaseMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for CategoryRuleUsecase.FindList
+func (mmFindList *mCategoryRuleUsecaseMockFindList) ExpectListOptionsParam2(listOptions *mm_usecase.CategoryRuleListOptions) *mCategoryRuleUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &CategoryRuleUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleUsecaseMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &CategoryRuleUsecaseMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmFindList.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for CategoryRuleUsecase.FindList
+func (mmFindList *mCategoryRuleUsecaseMockFindList) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mCategoryRuleUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &CategoryRuleUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleUsecaseMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &CategoryRuleUsecaseMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindList.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// Inspect accepts an inspector function that has same arguments as the CategoryRuleUsecase.FindList
+func (mmFindList *mCategoryRuleUsecaseMockFindList) Inspect(f func(ctx context.Context, listOptions *mm_usecase.CategoryRuleListOptions, queryParams *uctypes.QueryGetListParams)) *mCategoryRuleUsecaseMockFindList {
+	if mmFindList.mock.inspectFuncFindList != nil {
+		mmFindList.mock.t.Fatalf("Inspect function is already set for CategoryRuleUsecaseMock.FindList")
+	}
+
+	mmFindList.mock.inspectFuncFindList = f
+
+	return mmFindList
+}
+
+// Return sets up results that will be returned by CategoryRuleUsecase.FindList
+func (mmFindList *mCategoryRuleUsecaseMockFindList) Return(resItems []*mm_usecase.CategoryRuleDTO, err error) *CategoryRuleUsecaseMock {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &CategoryRuleUsecaseMockFindListExpectation{mock: mmFindList.mock}
+	}
+	mmFindList.defaultExpectation.results = &CategoryRuleUsecaseMockFindListResults{resItems, err}
+	mmFindList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// Set uses given function f to mock the CategoryRuleUsecase.FindList method
+func (mmFindList *mCategoryRuleUsecaseMockFindList) Set(f func(ctx context.Context, listOptions *mm_usecase.CategoryRuleListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.CategoryRuleDTO, err error)) *CategoryRuleUsecaseMock {
+	if mmFindList.defaultExpectation != nil {
+		mmFindList.mock.t.Fatalf("Default expectation is already set for the CategoryRuleUsecase.FindList method")
+	}
+
+	if len(mmFindList.expectations) > 0 {
+		mmFindList.mock.t.Fatalf("Some expectations are already set for the CategoryRuleUsecase.FindList method")
+	}
+
+	mmFindList.mock.funcFindList = f
+	mmFindList.mock.funcFindListOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// When sets expectation for the CategoryRuleUsecase.FindList which will trigger the result defined by the following
+// Then helper
+func (mmFindList *mCategoryRuleUsecaseMockFindList) When(ctx context.Context, listOptions *mm_usecase.CategoryRuleListOptions, queryParams *uctypes.QueryGetListParams) *CategoryRuleUsecaseMockFindListExpectation {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("CategoryRuleUsecaseMock.FindList mock is already set by Set")
+	}
+
+	expectation := &CategoryRuleUsecaseMockFindListExpectation{
+		mock:               mmFindList.mock,
+		params:             &CategoryRuleUsecaseMockFindListParams{ctx, listOptions, queryParams},
+		expectationOrigins: CategoryRuleUsecaseMockFindListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindList.expectations = append(mmFindList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CategoryRuleUsecase.FindList return parameters for the expectation previously defined by the When method
+func (e *CategoryRuleUsecaseMockFindListExpectation) Then(resItems []*mm_usecase.CategoryRuleDTO, err error) *CategoryRuleUsecaseMock {
+	e.results = &CategoryRuleUsecaseMockFindListResults{resItems, err}
+	return e.mock
+}
+
+// Times sets number of times CategoryRuleUsecase.FindList should be invoked
+func (mmFindList *mCategoryRuleUsecaseMockFindList) Times(n uint64) *mCategoryRuleUsecaseMockFindList {
+	if n == 0 {
+		mmFindList.mock.t.Fatalf("Times of CategoryRuleUsecaseMock.FindList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindList.expectedInvocations, n)
+	mmFindList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindList
+}
+
+func (mmFindList *mCategoryRuleUsecaseMockFindList) invocationsDone() bool {
+	if len(mmFindList.expectations) == 0 && mmFindList.defaultExpectation == nil && mmFindList.mock.funcFindList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindList.mock.afterFindListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindList implements mm_usecase.CategoryRuleUsecase
+func (mmFindList *CategoryRuleUsecaseMock) FindList(ctx context.Context, listOptions *mm_usecase.CategoryRuleListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.CategoryRuleDTO, err error) {
+	mm_atomic.AddUint64(&mmFindList.beforeFindListCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindList.afterFindListCounter, 1)
+
+	mmFindList.t.Helper()
+
+	if mmFindList.inspectFuncFindList != nil {
+		mmFindList.inspectFuncFindList(ctx, listOptions, queryParams)
+	}
+
+	mm_params := CategoryRuleUsecaseMockFindListParams{ctx, listOptions, queryParams}
+
+	// Record call args
+	mmFindList.FindListMock.mutex.Lock()
+	mmFindList.FindListMock.callArgs = append(mmFindList.FindListMock.callArgs, &mm_params)
+	mmFindList.FindListMock.mutex.Unlock()
+
+	for _, e := range mmFindList.FindListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resItems, e.results.err
+		}
+	}
+
+	if mmFindList.FindListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindList.FindListMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindList.FindListMock.defaultExpectation.params
+		mm_want_ptrs := mmFindList.FindListMock.defaultExpectation.paramPtrs
+
+		mm_got := CategoryRuleUsecaseMockFindListParams{ctx, listOptions, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindList.t.Errorf("CategoryRuleUsecaseMock.FindList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmFindList.t.Errorf("CategoryRuleUsecaseMock.FindList got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindList.t.Errorf("CategoryRuleUsecaseMock.FindList got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindList.t.Errorf("CategoryRuleUsecaseMock.FindList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindList.FindListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindList.FindListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindList.t.Fatal("No results are set for the CategoryRuleUsecaseMock.FindList")
+		}
+		return (*mm_results).resItems, (*mm_results).err
+	}
+	if mmFindList.funcFindList != nil {
+		return mmFindList.funcFindList(ctx, listOptions, queryParams)
+	}
+	mmFindList.t.Fatalf("Unexpected call to CategoryRuleUsecaseMock.FindList. %v %v %v", ctx, listOptions, queryParams)
+	return
+}
+
+// FindListAfterCounter returns a count of finished CategoryRuleUsecaseMock.FindList invocations
+func (mmFindList *CategoryRuleUsecaseMock) FindListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.afterFindListCounter)
+}
+
+// FindListBeforeCounter returns a count of CategoryRuleUsecaseMock.FindList invocations
+func (mmFindList *CategoryRuleUsecaseMock) FindListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.beforeFindListCounter)
+}
+
+// Calls returns a list of arguments used in each call to CategoryRuleUsecaseMock.FindList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindList *mCategoryRuleUsecaseMockFindList) Calls() []*CategoryRuleUsecaseMockFindListParams {
+	mmFindList.mutex.RLock()
+
+	argCopy := make([]*CategoryRuleUsecaseMockFindListParams, len(mmFindList.callArgs))
+	copy(argCopy, mmFindList.callArgs)
+
+	mmFindList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindListDone returns true if the count of the FindList invocations corresponds
+// the number of defined expectations
+func (m *CategoryRuleUsecaseMock) MinimockFindListDone() bool {
+	if m.FindListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindListMock.invocationsDone()
+}
+
+// MinimockFindListInspect logs each unmet expectation
+func (m *CategoryRuleUsecaseMock) MinimockFindListInspect() {
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CategoryRuleUsecaseMock.FindList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindListCounter := mm_atomic.LoadUint64(&m.afterFindListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindListMock.defaultExpectation != nil && afterFindListCounter < 1 {
+		if m.FindListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CategoryRuleUsecaseMock.FindList at\n%s", m.FindListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CategoryRuleUsecaseMock.FindList at\n%s with params: %#v", m.FindListMock.defaultExpectation.expectationOrigins.origin, *m.FindListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindList != nil && afterFindListCounter < 1 {
+		m.t.Errorf("Expected call to CategoryRuleUsecaseMock.FindList at\n%s", m.funcFindListOrigin)
+	}
+
+	if !m.FindListMock.invocationsDone() && afterFindListCounter > 0 {
+		m.t.Errorf("Expected %d calls to CategoryRuleUsecaseMock.FindList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindListMock.expectedInvocations), m.FindListMock.expectedInvocationsOrigin, afterFindListCounter)
+	}
+}
+
+type mCategoryRuleUsecaseMockFindOneByID struct {
+	optional           bool
+	mock               *CategoryRuleUsecaseMock
+	defaultExpectation *CategoryRuleUsecaseMockFindOneByIDExpectation
+	expectations       []*CategoryRuleUsecaseMockFindOneByIDExpectation
+
+	callArgs []*CategoryRuleUsecaseMockFindOneByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CategoryRuleUsecaseMockFindOneByIDExpectation specifies expectation struct of the CategoryRuleUsecase.FindOneByID
+type CategoryRuleUsecaseMockFindOneByIDExpectation struct {
+	mock               *CategoryRuleUsecaseMock
+	params             *CategoryRuleUsecaseMockFindOneByIDParams
+	paramPtrs          *CategoryRuleUsecaseMockFindOneByIDParamPtrs
+	expectationOrigins CategoryRuleUsecaseMockFindOneByIDExpectationOrigins
+	results            *CategoryRuleUsecaseMockFindOneByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CategoryRuleUsecaseMockFindOneByIDParams contains parameters of the CategoryRuleUsecase.FindOneByID
+type CategoryRuleUsecaseMockFindOneByIDParams struct {
+	ctx         context.Context
+	id          uuid.UUID
+	queryParams *uctypes.QueryGetOneParams
+}
+
+// CategoryRuleUsecaseMockFindOneByIDParamPtrs contains pointers to parameters of the CategoryRuleUsecase.FindOneByID
+type CategoryRuleUsecaseMockFindOneByIDParamPtrs struct {
+	ctx         *context.Context
+	id          *uuid.UUID
+	queryParams **uctypes.QueryGetOneParams
+}
+
+// CategoryRuleUsecaseMockFindOneByIDResults contains results of the CategoryRuleUsecase.FindOneByID
+type CategoryRuleUsecaseMockFindOneByIDResults struct {
+	resItem *mm_usecase.CategoryRuleDTO
+	err     error
+}
+
+// CategoryRuleUsecaseMockFindOneByIDOrigins contains origins of expectations of the CategoryRuleUsecase.FindOneByID
+type CategoryRuleUsecaseMockFindOneByIDExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originId          string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindOneByID *mCategoryRuleUsecaseMockFindOneByID) Optional() *mCategoryRuleUsecaseMockFindOneByID {
+	mmFindOneByID.optional = true
+	return mmFindOneByID
+}
+
+// Expect sets up expected params for CategoryRuleUsecase.FindOneByID
+func (mmFindOneByID *mCategoryRuleUsecaseMockFindOneByID) Expect(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *mCategoryRuleUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &CategoryRuleUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleUsecaseMock.FindOneByID mock is already set by ExpectParams functions")
+	}
+
+	mmFindOneByID.defaultExpectation.params = &CategoryRuleUsecaseMockFindOneByIDParams{ctx, id, queryParams}
+	mmFindOneByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindOneByID.expectations {
+		if minimock.Equal(e.params, mmFindOneByID.defaultExpectation.params) {
+			mmFindOneByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindOneByID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindOneByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CategoryRuleUsecase.FindOneByID
+func (mmFindOneByID *mCategoryRuleUsecaseMockFindOneByID) ExpectCtxParam1(ctx context.Context) *mCategoryRuleUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &CategoryRuleUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleUsecaseMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &CategoryRuleUsecaseMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindOneByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// ExpectIdParam2 sets up expected param id for CategoryRuleUsecase.FindOneByID
+func (mmFindOneByID *mCategoryRuleUsecaseMockFindOneByID) ExpectIdParam2(id uuid.UUID) *mCategoryRuleUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &CategoryRuleUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleUsecaseMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &CategoryRuleUsecaseMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.id = &id
+	mmFindOneByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for CategoryRuleUsecase.FindOneByID
+func (mmFindOneByID *mCategoryRuleUsecaseMockFindOneByID) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetOneParams) *mCategoryRuleUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &CategoryRuleUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleUsecaseMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &CategoryRuleUsecaseMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindOneByID.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the CategoryRuleUsecase.FindOneByID
+func (mmFindOneByID *mCategoryRuleUsecaseMockFindOneByID) Inspect(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)) *mCategoryRuleUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.inspectFuncFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("Inspect function is already set for CategoryRuleUsecaseMock.FindOneByID")
+	}
+
+	mmFindOneByID.mock.inspectFuncFindOneByID = f
+
+	return mmFindOneByID
+}
+
+// Return sets up results that will be returned by CategoryRuleUsecase.FindOneByID
+func (mmFindOneByID *mCategoryRuleUsecaseMockFindOneByID) Return(resItem *mm_usecase.CategoryRuleDTO, err error) *CategoryRuleUsecaseMock {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &CategoryRuleUsecaseMockFindOneByIDExpectation{mock: mmFindOneByID.mock}
+	}
+	mmFindOneByID.defaultExpectation.results = &CategoryRuleUsecaseMockFindOneByIDResults{resItem, err}
+	mmFindOneByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID.mock
+}
+
+// Set uses given function f to mock the CategoryRuleUsecase.FindOneByID method
+func (mmFindOneByID *mCategoryRuleUsecaseMockFindOneByID) Set(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.CategoryRuleDTO, err error)) *CategoryRuleUsecaseMock {
+	if mmFindOneByID.defaultExpectation != nil {
+		mmFindOneByID.mock.t.Fatalf("Default expectation is already set for the CategoryRuleUsecase.FindOneByID method")
+	}
+
+	if len(mmFindOneByID.expectations) > 0 {
+		mmFindOneByID.mock.t.Fatalf("Some expectations are already set for the CategoryRuleUsecase.FindOneByID method")
+	}
+
+	mmFindOneByID.mock.funcFindOneByID = f
+	mmFindOneByID.mock.funcFindOneByIDOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID.mock
+}
+
+// When sets expectation for the CategoryRuleUsecase.FindOneByID which will trigger the result defined by the following
+// Then helper
+func (mmFindOneByID *mCategoryRuleUsecaseMockFindOneByID) When(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *CategoryRuleUsecaseMockFindOneByIDExpectation {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("CategoryRuleUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	expectation := &CategoryRuleUsecaseMockFindOneByIDExpectation{
+		mock:               mmFindOneByID.mock,
+		params:             &CategoryRuleUsecaseMockFindOneByIDParams{ctx, id, queryParams},
+		expectationOrigins: CategoryRuleUsecaseMockFindOneByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindOneByID.expectations = append(mmFindOneByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CategoryRuleUsecase.FindOneByID return parameters for the expectation previously defined by the When method
+func (e *CategoryRuleUsecaseMockFindOneByIDExpectation) Then(resItem *mm_usecase.CategoryRuleDTO, err error) *CategoryRuleUsecaseMock {
+	e.results = &CategoryRuleUsecaseMockFindOneByIDResults{resItem, err}
+	return e.mock
+}
+
+// Times sets number of times CategoryRuleUsecase.FindOneByID should be invoked
+func (mmFindOneByID *mCategoryRuleUsecaseMockFindOneByID) Times(n uint64) *mCategoryRuleUsecaseMockFindOneByID {
+	if n == 0 {
+		mmFindOneByID.mock.t.Fatalf("Times of CategoryRuleUsecaseMock.FindOneByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindOneByID.expectedInvocations, n)
+	mmFindOneByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID
+}
+
+func (mmFindOneByID *mCategoryRuleUsecaseMockFindOneByID) invocationsDone() bool {
+	if len(mmFindOneByID.expectations) == 0 && mmFindOneByID.defaultExpectation == nil && mmFindOneByID.mock.funcFindOneByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindOneByID.mock.afterFindOneByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindOneByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindOneByID implements mm_usecase.CategoryRuleUsecase
+func (mmFindOneByID *CategoryRuleUsecaseMock) FindOneByID(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.CategoryRuleDTO, err error) {
+	mm_atomic.AddUint64(&mmFindOneByID.beforeFindOneByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindOneByID.afterFindOneByIDCounter, 1)
+
+	mmFindOneByID.t.Helper()
+
+	if mmFindOneByID.inspectFuncFindOneByID != nil {
+		mmFindOneByID.inspectFuncFindOneByID(ctx, id, queryParams)
+	}
+
+	mm_params := CategoryRuleUsecaseMockFindOneByIDParams{ctx, id, queryParams}
+
+	// Record call args
+	mmFindOneByID.FindOneByIDMock.mutex.Lock()
+	mmFindOneByID.FindOneByIDMock.callArgs = append(mmFindOneByID.FindOneByIDMock.callArgs, &mm_params)
+	mmFindOneByID.FindOneByIDMock.mutex.Unlock()
+
+	for _, e := range mmFindOneByID.FindOneByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resItem, e.results.err
+		}
+	}
+
+	if mmFindOneByID.FindOneByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindOneByID.FindOneByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindOneByID.FindOneByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindOneByID.FindOneByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := CategoryRuleUsecaseMockFindOneByIDParams{ctx, id, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindOneByID.t.Errorf("CategoryRuleUsecaseMock.FindOneByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmFindOneByID.t.Errorf("CategoryRuleUsecaseMock.FindOneByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindOneByID.t.Errorf("CategoryRuleUsecaseMock.FindOneByID got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindOneByID.t.Errorf("CategoryRuleUsecaseMock.FindOneByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindOneByID.FindOneByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindOneByID.t.Fatal("No results are set for the CategoryRuleUsecaseMock.FindOneByID")
+		}
+		return (*mm_results).resItem, (*mm_results).err
+	}
+	if mmFindOneByID.funcFindOneByID != nil {
+		return mmFindOneByID.funcFindOneByID(ctx, id, queryParams)
+	}
+	mmFindOneByID.t.Fatalf("Unexpected call to CategoryRuleUsecaseMock.FindOneByID. %v %v %v", ctx, id, queryParams)
+	return
+}
+
+// FindOneByIDAfterCounter returns a count of finished CategoryRuleUsecaseMock.FindOneByID invocations
+func (mmFindOneByID *CategoryRuleUsecaseMock) FindOneByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByID.afterFindOneByIDCounter)
+}
+
+// FindOneByIDBeforeCounter returns a count of CategoryRuleUsecaseMock.FindOneByID invocations
+func (mmFindOneByID *CategoryRuleUsecaseMock) FindOneByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByID.beforeFindOneByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to CategoryRuleUsecaseMock.FindOneByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindOneByID *mCategoryRuleUsecaseMockFindOneByID) Calls() []*CategoryRuleUsecaseMockFindOneByIDParams {
+	mmFindOneByID.mutex.RLock()
+
+	argCopy := make([]*CategoryRuleUsecaseMockFindOneByIDParams, len(mmFindOneByID.callArgs))
+	copy(argCopy, mmFindOneByID.callArgs)
+
+	mmFindOneByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindOneByIDDone returns true if the count of the FindOneByID invocations corresponds
+// the number of defined expectations
+func (m *CategoryRuleUsecaseMock) MinimockFindOneByIDDone() bool {
+	if m.FindOneByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindOneByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindOneByIDMock.invocationsDone()
+}
+
+// MinimockFindOneByIDInspect logs each unmet expectation
+func (m *CategoryRuleUsecaseMock) MinimockFindOneByIDInspect() {
+	for _, e := range m.FindOneByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CategoryRuleUsecaseMock.FindOneByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindOneByIDCounter := mm_atomic.LoadUint64(&m.afterFindOneByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindOneByIDMock.defaultExpectation != nil && afterFindOneByIDCounter < 1 {
+		if m.FindOneByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CategoryRuleUsecaseMock.FindOneByID at\n%s", m.FindOneByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CategoryRuleUsecaseMock.FindOneByID at\n%s with params: %#v", m.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindOneByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindOneByID != nil && afterFindOneByIDCounter < 1 {
+		m.t.Errorf("Expected call to CategoryRuleUsecaseMock.FindOneByID at\n%s", m.funcFindOneByIDOrigin)
+	}
+
+	if !m.FindOneByIDMock.invocationsDone() && afterFindOneByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to CategoryRuleUsecaseMock.FindOneByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindOneByIDMock.expectedInvocations), m.FindOneByIDMock.expectedInvocationsOrigin, afterFindOneByIDCounter)
+	}
+}
+
+type mCategoryRuleUsecaseMockPatchCategoryRuleByDTO struct {
+	optional           bool
+	mock               *CategoryRuleUsecaseMock
+	defaultExpectation *CategoryRuleUsecaseMockPatchCategoryRuleByDTOExpectation
+	expectations       []*CategoryRuleUsecaseMockPatchCategoryRuleByDTOExpectation
+
+	callArgs []*CategoryRuleUsecaseMockPatchCategoryRuleByDTOParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CategoryRuleUsecaseMockPatchCategoryRuleByDTOExpectation specifies expectation struct of the CategoryRuleUsecase.PatchCategoryRuleByDTO
+type CategoryRuleUsecaseMockPatchCategoryRuleByDTOExpectation struct {
+	mock               *CategoryRuleUsecaseMock
+	params             *CategoryRuleUsecaseMockPatchCategoryRuleByDTOParams
+	paramPtrs          *CategoryRuleUsecaseMockPatchCategoryRuleByDTOParamPtrs
+	expectationOrigins CategoryRuleUsecaseMockPatchCategoryRuleByDTOExpectationOrigins
+	results            *CategoryRuleUsecaseMockPatchCategoryRuleByDTOResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CategoryRuleUsecaseMockPatchCategoryRuleByDTOParams contains parameters of the CategoryRuleUsecase.PatchCategoryRuleByDTO
+type CategoryRuleUsecaseMockPatchCategoryRuleByDTOParams struct {
+	ctx              context.Context
+	id               uuid.UUID
+	in               mm_usecase.PatchCategoryRuleDataInput
+	skipVersionCheck bool
+}
+
+// CategoryRuleUsecaseMockPatchCategoryRuleByDTOParamPtrs contains pointers to parameters of the CategoryRuleUsecase.PatchCategoryRuleByDTO
+type CategoryRuleUsecaseMockPatchCategoryRuleByDTOParamPtrs struct {
+	ctx              *context.Context
+	id               *uuid.UUID
+	in               *mm_usecase.PatchCategoryRuleDataInput
+	skipVersionCheck *bool
+}
+
+// CategoryRuleUsecaseMockPatchCategoryRuleByDTOResults contains results of the CategoryRuleUsecase.PatchCategoryRuleByDTO
+type CategoryRuleUsecaseMockPatchCategoryRuleByDTOResults struct {
+	err error
+}
+
+// CategoryRuleUsecaseMockPatchCategoryRuleByDTOOrigins contains origins of expectations of the CategoryRuleUsecase.PatchCategoryRuleByDTO
+type CategoryRuleUsecaseMockPatchCategoryRuleByDTOExpectationOrigins struct {
+	origin                 string
+	originCtx              string
+	originId               string
+	originIn               string
+	originSkipVersionCheck string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPatchCategoryRuleByDTO *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO) Optional() *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO {
+	mmPatchCategoryRuleByDTO.optional = true
+	return mmPatchCategoryRuleByDTO
+}
+
+// Expect sets up expected params for CategoryRuleUsecase.PatchCategoryRuleByDTO
+func (mmPatchCategoryRuleByDTO *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO) Expect(ctx context.Context, id uuid.UUID, in mm_usecase.PatchCategoryRuleDataInput, skipVersionCheck bool) *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO {
+	if mmPatchCategoryRuleByDTO.mock.funcPatchCategoryRuleByDTO != nil {
+		mmPatchCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.PatchCategoryRuleByDTO mock is already set by Set")
+	}
+
+	if mmPatchCategoryRuleByDTO.defaultExpectation == nil {
+		mmPatchCategoryRuleByDTO.defaultExpectation = &CategoryRuleUsecaseMockPatchCategoryRuleByDTOExpectation{}
+	}
+
+	if mmPatchCategoryRuleByDTO.defaultExpectation.paramPtrs != nil {
+		mmPatchCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.PatchCategoryRuleByDTO mock is already set by ExpectParams functions")
+	}
+
+	mmPatchCategoryRuleByDTO.defaultExpectation.params = &CategoryRuleUsecaseMockPatchCategoryRuleByDTOParams{ctx, id, in, skipVersionCheck}
+	mmPatchCategoryRuleByDTO.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPatchCategoryRuleByDTO.expectations {
+		if minimock.Equal(e.params, mmPatchCategoryRuleByDTO.defaultExpectation.params) {
+			mmPatchCategoryRuleByDTO.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPatchCategoryRuleByDTO.defaultExpectation.params)
+		}
+	}
+
+	return mmPatchCategoryRuleByDTO
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CategoryRuleUsecase.PatchCategoryRuleByDTO
+func (mmPatchCategoryRuleByDTO *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO) ExpectCtxParam1(ctx context.Context) *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO {
+	if mmPatchCategoryRuleByDTO.mock.funcPatchCategoryRuleByDTO != nil {
+		mmPatchCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.PatchCategoryRuleByDTO mock is already set by Set")
+	}
+
+	if mmPatchCategoryRuleByDTO.defaultExpectation == nil {
+		mmPatchCategoryRuleByDTO.defaultExpectation = &CategoryRuleUsecaseMockPatchCategoryRuleByDTOExpectation{}
+	}
+
+	if mmPatchCategoryRuleByDTO.defaultExpectation.params != nil {
+		mmPatchCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.PatchCategoryRuleByDTO mock is already set by Expect")
+	}
+
+	if mmPatchCategoryRuleByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchCategoryRuleByDTO.defaultExpectation.paramPtrs = &CategoryRuleUsecaseMockPatchCategoryRuleByDTOParamPtrs{}
+	}
+	mmPatchCategoryRuleByDTO.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPatchCategoryRuleByDTO.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPatchCategoryRuleByDTO
+}
+
+// ExpectIdParam2 sets up expected param id for CategoryRuleUsecase.PatchCategoryRuleByDTO
+func (mmPatchCategoryRuleByDTO *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO) ExpectIdParam2(id uuid.UUID) *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO {
+	if mmPatchCategoryRuleByDTO.mock.funcPatchCategoryRuleByDTO != nil {
+		mmPatchCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.PatchCategoryRuleByDTO mock is already set by Set")
+	}
+
+	if mmPatchCategoryRuleByDTO.defaultExpectation == nil {
+		mmPatchCategoryRuleByDTO.defaultExpectation = &CategoryRuleUsecaseMockPatchCategoryRuleByDTOExpectation{}
+	}
+
+	if mmPatchCategoryRuleByDTO.defaultExpectation.params != nil {
+		mmPatchCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.PatchCategoryRuleByDTO mock is already set by Expect")
+	}
+
+	if mmPatchCategoryRuleByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchCategoryRuleByDTO.defaultExpectation.paramPtrs = &CategoryRuleUsecaseMockPatchCategoryRuleByDTOParamPtrs{}
+	}
+	mmPatchCategoryRuleByDTO.defaultExpectation.paramPtrs.id = &id
+	mmPatchCategoryRuleByDTO.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmPatchCategoryRuleByDTO
+}
+
+// ExpectInParam3 sets up expected param in for CategoryRuleUsecase.PatchCategoryRuleByDTO
+func (mmPatchCategoryRuleByDTO *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO) ExpectInParam3(in mm_usecase.PatchCategoryRuleDataInput) *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO {
+	if mmPatchCategoryRuleByDTO.mock.funcPatchCategoryRuleByDTO != nil {
+		mmPatchCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.PatchCategoryRuleByDTO mock is already set by Set")
+	}
+
+	if mmPatchCategoryRuleByDTO.defaultExpectation == nil {
+		mmPatchCategoryRuleByDTO.defaultExpectation = &CategoryRuleUsecaseMockPatchCategoryRuleByDTOExpectation{}
+	}
+
+	if mmPatchCategoryRuleByDTO.defaultExpectation.params != nil {
+		mmPatchCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.PatchCategoryRuleByDTO mock is already set by Expect")
+	}
+
+	if mmPatchCategoryRuleByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchCategoryRuleByDTO.defaultExpectation.paramPtrs = &CategoryRuleUsecaseMockPatchCategoryRuleByDTOParamPtrs{}
+	}
+	mmPatchCategoryRuleByDTO.defaultExpectation.paramPtrs.in = &in
+	mmPatchCategoryRuleByDTO.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmPatchCategoryRuleByDTO
+}
+
+// ExpectSkipVersionCheckParam4 sets up expected param skipVersionCheck for CategoryRuleUsecase.PatchCategoryRuleByDTO
+func (mmPatchCategoryRuleByDTO *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO) ExpectSkipVersionCheckParam4(skipVersionCheck bool) *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO {
+	if mmPatchCategoryRuleByDTO.mock.funcPatchCategoryRuleByDTO != nil {
+		mmPatchCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.PatchCategoryRuleByDTO mock is already set by Set")
+	}
+
+	if mmPatchCategoryRuleByDTO.defaultExpectation == nil {
+		mmPatchCategoryRuleByDTO.defaultExpectation = &CategoryRuleUsecaseMockPatchCategoryRuleByDTOExpectation{}
+	}
+
+	if mmPatchCategoryRuleByDTO.defaultExpectation.params != nil {
+		mmPatchCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.PatchCategoryRuleByDTO mock is already set by Expect")
+	}
+
+	if mmPatchCategoryRuleByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchCategoryRuleByDTO.defaultExpectation.paramPtrs = &CategoryRuleUsecaseMockPatchCategoryRuleByDTOParamPtrs{}
+	}
+	mmPatchCategoryRuleByDTO.defaultExpectation.paramPtrs.skipVersionCheck = &skipVersionCheck
+	mmPatchCategoryRuleByDTO.defaultExpectation.expectationOrigins.originSkipVersionCheck = minimock.CallerInfo(1)
+
+	return mmPatchCategoryRuleByDTO
+}
+
+// Inspect accepts an inspector function that has same arguments as the CategoryRuleUsecase.PatchCategoryRuleByDTO
+func (mmPatchCategoryRuleByDTO *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO) Inspect(f func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchCategoryRuleDataInput, skipVersionCheck bool)) *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO {
+	if mmPatchCategoryRuleByDTO.mock.inspectFuncPatchCategoryRuleByDTO != nil {
+		mmPatchCategoryRuleByDTO.mock.t.Fatalf("Inspect function is already set for CategoryRuleUsecaseMock.PatchCategoryRuleByDTO")
+	}
+
+	mmPatchCategoryRuleByDTO.mock.inspectFuncPatchCategoryRuleByDTO = f
+
+	return mmPatchCategoryRuleByDTO
+}
+
+// Return sets up results that will be returned by CategoryRuleUsecase.PatchCategoryRuleByDTO
+func (mmPatchCategoryRuleByDTO *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO) Return(err error) *CategoryRuleUsecaseMock {
+	if mmPatchCategoryRuleByDTO.mock.funcPatchCategoryRuleByDTO != nil {
+		mmPatchCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.PatchCategoryRuleByDTO mock is already set by Set")
+	}
+
+	if mmPatchCategoryRuleByDTO.defaultExpectation == nil {
+		mmPatchCategoryRuleByDTO.defaultExpectation = &CategoryRuleUsecaseMockPatchCategoryRuleByDTOExpectation{mock: mmPatchCategoryRuleByDTO.mock}
+	}
+	mmPatchCategoryRuleByDTO.defaultExpectation.results = &CategoryRuleUsecaseMockPatchCategoryRuleByDTOResults{err}
+	mmPatchCategoryRuleByDTO.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPatchCategoryRuleByDTO.mock
+}
+
+// Set uses given function f to mock the CategoryRuleUsecase.PatchCategoryRuleByDTO method
+func (mmPatchCategoryRuleByDTO *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO) Set(f func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchCategoryRuleDataInput, skipVersionCheck bool) (err error)) *CategoryRuleUsecaseMock {
+	if mmPatchCategoryRuleByDTO.defaultExpectation != nil {
+		mmPatchCategoryRuleByDTO.mock.t.Fatalf("Default expectation is already set for the CategoryRuleUsecase.PatchCategoryRuleByDTO method")
+	}
+
+	if len(mmPatchCategoryRuleByDTO.expectations) > 0 {
+		mmPatchCategoryRuleByDTO.mock.t.Fatalf("Some expectations are already set for the CategoryRuleUsecase.PatchCategoryRuleByDTO method")
+	}
+
+	mmPatchCategoryRuleByDTO.mock.funcPatchCategoryRuleByDTO = f
+	mmPatchCategoryRuleByDTO.mock.funcPatchCategoryRuleByDTOOrigin = minimock.CallerInfo(1)
+	return mmPatchCategoryRuleByDTO.mock
+}
+
+// When sets expectation for the CategoryRuleUsecase.PatchCategoryRuleByDTO which will trigger the result defined by the following
+// Then helper
+func (mmPatchCategoryRuleByDTO *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO) When(ctx context.Context, id uuid.UUID, in mm_usecase.PatchCategoryRuleDataInput, skipVersionCheck bool) *CategoryRuleUsecaseMockPatchCategoryRuleByDTOExpectation {
+	if mmPatchCategoryRuleByDTO.mock.funcPatchCategoryRuleByDTO != nil {
+		mmPatchCategoryRuleByDTO.mock.t.Fatalf("CategoryRuleUsecaseMock.PatchCategoryRuleByDTO mock is already set by Set")
+	}
+
+	expectation := &CategoryRuleUsecaseMockPatchCategoryRuleByDTOExpectation{
+		mock:               mmPatchCategoryRuleByDTO.mock,
+		params:             &CategoryRuleUsecaseMockPatchCategoryRuleByDTOParams{ctx, id, in, skipVersionCheck},
+		expectationOrigins: CategoryRuleUsecaseMockPatchCategoryRuleByDTOExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPatchCategoryRuleByDTO.expectations = append(mmPatchCategoryRuleByDTO.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CategoryRuleUsecase.PatchCategoryRuleByDTO return parameters for the expectation previously defined by the When method
+func (e *CategoryRuleUsecaseMockPatchCategoryRuleByDTOExpectation) Then(err error) *CategoryRuleUsecaseMock {
+	e.results = &CategoryRuleUsecaseMockPatchCategoryRuleByDTOResults{err}
+	return e.mock
+}
+
+// Times sets number of times CategoryRuleUsecase.PatchCategoryRuleByDTO should be invoked
+func (mmPatchCategoryRuleByDTO *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO) Times(n uint64) *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO {
+	if n == 0 {
+		mmPatchCategoryRuleByDTO.mock.t.Fatalf("Times of CategoryRuleUsecaseMock.PatchCategoryRuleByDTO mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPatchCategoryRuleByDTO.expectedInvocations, n)
+	mmPatchCategoryRuleByDTO.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPatchCategoryRuleByDTO
+}
+
+func (mmPatchCategoryRuleByDTO *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO) invocationsDone() bool {
+	if len(mmPatchCategoryRuleByDTO.expectations) == 0 && mmPatchCategoryRuleByDTO.defaultExpectation == nil && mmPatchCategoryRuleByDTO.mock.funcPatchCategoryRuleByDTO == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPatchCategoryRuleByDTO.mock.afterPatchCategoryRuleByDTOCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPatchCategoryRuleByDTO.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PatchCategoryRuleByDTO implements mm_usecase.CategoryRuleUsecase
+func (mmPatchCategoryRuleByDTO *CategoryRuleUsecaseMock) PatchCategoryRuleByDTO(ctx context.Context, id uuid.UUID, in mm_usecase.PatchCategoryRuleDataInput, skipVersionCheck bool) (err error) {
+	mm_atomic.AddUint64(&mmPatchCategoryRuleByDTO.beforePatchCategoryRuleByDTOCounter, 1)
+	defer mm_atomic.AddUint64(&mmPatchCategoryRuleByDTO.afterPatchCategoryRuleByDTOCounter, 1)
+
+	mmPatchCategoryRuleByDTO.t.Helper()
+
+	if mmPatchCategoryRuleByDTO.inspectFuncPatchCategoryRuleByDTO != nil {
+		mmPatchCategoryRuleByDTO.inspectFuncPatchCategoryRuleByDTO(ctx, id, in, skipVersionCheck)
+	}
+
+	mm_params := CategoryRuleUsecaseMockPatchCategoryRuleByDTOParams{ctx, id, in, skipVersionCheck}
+
+	// Record call args
+	mmPatchCategoryRuleByDTO.PatchCategoryRuleByDTOMock.mutex.Lock()
+	mmPatchCategoryRuleByDTO.PatchCategoryRuleByDTOMock.callArgs = append(mmPatchCategoryRuleByDTO.PatchCategoryRuleByDTOMock.callArgs, &mm_params)
+	mmPatchCategoryRuleByDTO.PatchCategoryRuleByDTOMock.mutex.Unlock()
+
+	for _, e := range mmPatchCategoryRuleByDTO.PatchCategoryRuleByDTOMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPatchCategoryRuleByDTO.PatchCategoryRuleByDTOMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPatchCategoryRuleByDTO.PatchCategoryRuleByDTOMock.defaultExpectation.Counter, 1)
+		mm_want := mmPatchCategoryRuleByDTO.PatchCategoryRuleByDTOMock.defaultExpectation.params
+		mm_want_ptrs := mmPatchCategoryRuleByDTO.PatchCategoryRuleByDTOMock.defaultExpectation.paramPtrs
+
+		mm_got := CategoryRuleUsecaseMockPatchCategoryRuleByDTOParams{ctx, id, in, skipVersionCheck}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPatchCategoryRuleByDTO.t.Errorf("CategoryRuleUsecaseMock.PatchCategoryRuleByDTO got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchCategoryRuleByDTO.PatchCategoryRuleByDTOMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmPatchCategoryRuleByDTO.t.Errorf("CategoryRuleUsecaseMock.PatchCategoryRuleByDTO got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchCategoryRuleByDTO.PatchCategoryRuleByDTOMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmPatchCategoryRuleByDTO.t.Errorf("CategoryRuleUsecaseMock.PatchCategoryRuleByDTO got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchCategoryRuleByDTO.PatchCategoryRuleByDTOMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+			if mm_want_ptrs.skipVersionCheck != nil && !minimock.Equal(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck) {
+				mmPatchCategoryRuleByDTO.t.Errorf("CategoryRuleUsecaseMock.PatchCategoryRuleByDTO got unexpected parameter skipVersionCheck, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchCategoryRuleByDTO.PatchCategoryRuleByDTOMock.defaultExpectation.expectationOrigins.originSkipVersionCheck, *mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck, minimock.Diff(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPatchCategoryRuleByDTO.t.Errorf("CategoryRuleUsecaseMock.PatchCategoryRuleByDTO got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPatchCategoryRuleByDTO.PatchCategoryRuleByDTOMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPatchCategoryRuleByDTO.PatchCategoryRuleByDTOMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPatchCategoryRuleByDTO.t.Fatal("No results are set for the CategoryRuleUsecaseMock.PatchCategoryRuleByDTO")
+		}
+		return (*mm_results).err
+	}
+	if mmPatchCategoryRuleByDTO.funcPatchCategoryRuleByDTO != nil {
+		return mmPatchCategoryRuleByDTO.funcPatchCategoryRuleByDTO(ctx, id, in, skipVersionCheck)
+	}
+	mmPatchCategoryRuleByDTO.t.Fatalf("Unexpected call to CategoryRuleUsecaseMock.PatchCategoryRuleByDTO. %v %v %v %v", ctx, id, in, skipVersionCheck)
+	return
+}
+
+// PatchCategoryRuleByDTOAfterCounter returns a count of finished CategoryRuleUsecaseMock.PatchCategoryRuleByDTO invocations
+func (mmPatchCategoryRuleByDTO *CategoryRuleUsecaseMock) PatchCategoryRuleByDTOAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPatchCategoryRuleByDTO.afterPatchCategoryRuleByDTOCounter)
+}
+
+// PatchCategoryRuleByDTOBeforeCounter returns a count of CategoryRuleUsecaseMock.PatchCategoryRuleByDTO invocations
+func (mmPatchCategoryRuleByDTO *CategoryRuleUsecaseMock) PatchCategoryRuleByDTOBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPatchCategoryRuleByDTO.beforePatchCategoryRuleByDTOCounter)
+}
+
+// Calls returns a list of arguments used in each call to CategoryRuleUsecaseMock.PatchCategoryRuleByDTO.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPatchCategoryRuleByDTO *mCategoryRuleUsecaseMockPatchCategoryRuleByDTO) Calls() []*CategoryRuleUsecaseMockPatchCategoryRuleByDTOParams {
+	mmPatchCategoryRuleByDTO.mutex.RLock()
+
+	argCopy := make([]*CategoryRuleUsecaseMockPatchCategoryRuleByDTOParams, len(mmPatchCategoryRuleByDTO.callArgs))
+	copy(argCopy, mmPatchCategoryRuleByDTO.callArgs)
+
+	mmPatchCategoryRuleByDTO.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPatchCategoryRuleByDTODone returns true if the count of the PatchCategoryRuleByDTO invocations corresponds
+// the number of defined expectations
+func (m *CategoryRuleUsecaseMock) MinimockPatchCategoryRuleByDTODone() bool {
+	if m.PatchCategoryRuleByDTOMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PatchCategoryRuleByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PatchCategoryRuleByDTOMock.invocationsDone()
+}
+
+// MinimockPatchCategoryRuleByDTOInspect logs each unmet expectation
+func (m *CategoryRuleUsecaseMock) MinimockPatchCategoryRuleByDTOInspect() {
+	for _, e := range m.PatchCategoryRuleByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CategoryRuleUsecaseMock.PatchCategoryRuleByDTO at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPatchCategoryRuleByDTOCounter := mm_atomic.LoadUint64(&m.afterPatchCategoryRuleByDTOCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PatchCategoryRuleByDTOMock.defaultExpectation != nil && afterPatchCategoryRuleByDTOCounter < 1 {
+		if m.PatchCategoryRuleByDTOMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CategoryRuleUsecaseMock.PatchCategoryRuleByDTO at\n%s", m.PatchCategoryRuleByDTOMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CategoryRuleUsecaseMock.PatchCategoryRuleByDTO at\n%s with params: %#v", m.PatchCategoryRuleByDTOMock.defaultExpectation.expectationOrigins.origin, *m.PatchCategoryRuleByDTOMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPatchCategoryRuleByDTO != nil && afterPatchCategoryRuleByDTOCounter < 1 {
+		m.t.Errorf("Expected call to CategoryRuleUsecaseMock.PatchCategoryRuleByDTO at\n%s", m.funcPatchCategoryRuleByDTOOrigin)
+	}
+
+	if !m.PatchCategoryRuleByDTOMock.invocationsDone() && afterPatchCategoryRuleByDTOCounter > 0 {
+		m.t.Errorf("Expected %d calls to CategoryRuleUsecaseMock.PatchCategoryRuleByDTO at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PatchCategoryRuleByDTOMock.expectedInvocations), m.PatchCategoryRuleByDTOMock.expectedInvocationsOrigin, afterPatchCategoryRuleByDTOCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CategoryRuleUsecaseMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateCategoryRuleByDTOInspect()
+
+			m.MinimockDeleteCategoryRuleByIDInspect()
+
+			m.MinimockFindListInspect()
+
+			m.MinimockFindOneByIDInspect()
+
+			m.MinimockPatchCategoryRuleByDTOInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CategoryRuleUsecaseMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CategoryRuleUsecaseMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateCategoryRuleByDTODone() &&
+		m.MinimockDeleteCategoryRuleByIDDone() &&
+		m.MinimockFindListDone() &&
+		m.MinimockFindOneByIDDone() &&
+		m.MinimockPatchCategoryRuleByDTODone()
+}
@@ -11,6 +11,7 @@ import (
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
 	mm_usecase "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
 )
@@ -20,6 +21,27 @@ type CategoryUsecaseMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcCheckCategoryAccess          func(ctx context.Context, id uint64, accountID uuid.UUID) (err error)
+	funcCheckCategoryAccessOrigin    string
+	inspectFuncCheckCategoryAccess   func(ctx context.Context, id uint64, accountID uuid.UUID)
+	afterCheckCategoryAccessCounter  uint64
+	beforeCheckCategoryAccessCounter uint64
+	CheckCategoryAccessMock          mCategoryUsecaseMockCheckCategoryAccess
+
+	funcCreateCategoryByDTO          func(ctx context.Context, in mm_usecase.CreateCategoryDataInput) (resCategoryDTO *mm_usecase.CategoryDTO, err error)
+	funcCreateCategoryByDTOOrigin    string
+	inspectFuncCreateCategoryByDTO   func(ctx context.Context, in mm_usecase.CreateCategoryDataInput)
+	afterCreateCategoryByDTOCounter  uint64
+	beforeCreateCategoryByDTOCounter uint64
+	CreateCategoryByDTOMock          mCategoryUsecaseMockCreateCategoryByDTO
+
+	funcDeleteCategoryByID          func(ctx context.Context, id uint64, reassignToID *uint64) (err error)
+	funcDeleteCategoryByIDOrigin    string
+	inspectFuncDeleteCategoryByID   func(ctx context.Context, id uint64, reassignToID *uint64)
+	afterDeleteCategoryByIDCounter  uint64
+	beforeDeleteCategoryByIDCounter uint64
+	DeleteCategoryByIDMock          mCategoryUsecaseMockDeleteCategoryByID
+
 	funcFindList          func(ctx context.Context, listOptions *mm_usecase.CategoryListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.CategoryDTO, err error)
 	funcFindListOrigin    string
 	inspectFuncFindList   func(ctx context.Context, listOptions *mm_usecase.CategoryListOptions, queryParams *uctypes.QueryGetListParams)
@@ -27,31 +49,1139 @@ type CategoryUsecaseMock struct {
 	beforeFindListCounter uint64
 	FindListMock          mCategoryUsecaseMockFindList
 
-	funcFindOneByID          func(ctx context.Context, id uint64, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.CategoryDTO, err error)
-	funcFindOneByIDOrigin    string
-	inspectFuncFindOneByID   func(ctx context.Context, id uint64, queryParams *uctypes.QueryGetOneParams)
-	afterFindOneByIDCounter  uint64
-	beforeFindOneByIDCounter uint64
-	FindOneByIDMock          mCategoryUsecaseMockFindOneByID
+	funcFindOneByID          func(ctx context.Context, id uint64, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.CategoryDTO, err error)
+	funcFindOneByIDOrigin    string
+	inspectFuncFindOneByID   func(ctx context.Context, id uint64, queryParams *uctypes.QueryGetOneParams)
+	afterFindOneByIDCounter  uint64
+	beforeFindOneByIDCounter uint64
+	FindOneByIDMock          mCategoryUsecaseMockFindOneByID
+
+	funcPatchCategoryByDTO          func(ctx context.Context, id uint64, in mm_usecase.PatchCategoryDataInput, skipVersionCheck bool) (err error)
+	funcPatchCategoryByDTOOrigin    string
+	inspectFuncPatchCategoryByDTO   func(ctx context.Context, id uint64, in mm_usecase.PatchCategoryDataInput, skipVersionCheck bool)
+	afterPatchCategoryByDTOCounter  uint64
+	beforePatchCategoryByDTOCounter uint64
+	PatchCategoryByDTOMock          mCategoryUsecaseMockPatchCategoryByDTO
+}
+
+// NewCategoryUsecaseMock returns a mock for mm_usecase.CategoryUsecase
+func NewCategoryUsecaseMock(t minimock.Tester) *CategoryUsecaseMock {
+	m := &CategoryUsecaseMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CheckCategoryAccessMock = mCategoryUsecaseMockCheckCategoryAccess{mock: m}
+	m.CheckCategoryAccessMock.callArgs = []*CategoryUsecaseMockCheckCategoryAccessParams{}
+
+	m.CreateCategoryByDTOMock = mCategoryUsecaseMockCreateCategoryByDTO{mock: m}
+	m.CreateCategoryByDTOMock.callArgs = []*CategoryUsecaseMockCreateCategoryByDTOParams{}
+
+	m.DeleteCategoryByIDMock = mCategoryUsecaseMockDeleteCategoryByID{mock: m}
+	m.DeleteCategoryByIDMock.callArgs = []*CategoryUsecaseMockDeleteCategoryByIDParams{}
+
+	m.FindListMock = mCategoryUsecaseMockFindList{mock: m}
+	m.FindListMock.callArgs = []*CategoryUsecaseMockFindListParams{}
+
+	m.FindOneByIDMock = mCategoryUsecaseMockFindOneByID{mock: m}
+	m.FindOneByIDMock.callArgs = []*CategoryUsecaseMockFindOneByIDParams{}
+
+	m.PatchCategoryByDTOMock = mCategoryUsecaseMockPatchCategoryByDTO{mock: m}
+	m.PatchCategoryByDTOMock.callArgs = []*CategoryUsecaseMockPatchCategoryByDTOParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCategoryUsecaseMockCheckCategoryAccess struct {
+	optional           bool
+	mock               *CategoryUsecaseMock
+	defaultExpectation *CategoryUsecaseMockCheckCategoryAccessExpectation
+	expectations       []*CategoryUsecaseMockCheckCategoryAccessExpectation
+
+	callArgs []*CategoryUsecaseMockCheckCategoryAccessParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CategoryUsecaseMockCheckCategoryAccessExpectation specifies expectation struct of the CategoryUsecase.CheckCategoryAccess
+type CategoryUsecaseMockCheckCategoryAccessExpectation struct {
+	mock               *CategoryUsecaseMock
+	params             *CategoryUsecaseMockCheckCategoryAccessParams
+	paramPtrs          *CategoryUsecaseMockCheckCategoryAccessParamPtrs
+	expectationOrigins CategoryUsecaseMockCheckCategoryAccessExpectationOrigins
+	results            *CategoryUsecaseMockCheckCategoryAccessResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CategoryUsecaseMockCheckCategoryAccessParams contains parameters of the CategoryUsecase.CheckCategoryAccess
+type CategoryUsecaseMockCheckCategoryAccessParams struct {
+	ctx       context.Context
+	id        uint64
+	accountID uuid.UUID
+}
+
+// CategoryUsecaseMockCheckCategoryAccessParamPtrs contains pointers to parameters of the CategoryUsecase.CheckCategoryAccess
+type CategoryUsecaseMockCheckCategoryAccessParamPtrs struct {
+	ctx       *context.Context
+	id        *uint64
+	accountID *uuid.UUID
+}
+
+// CategoryUsecaseMockCheckCategoryAccessResults contains results of the CategoryUsecase.CheckCategoryAccess
+type CategoryUsecaseMockCheckCategoryAccessResults struct {
+	err error
+}
+
+// CategoryUsecaseMockCheckCategoryAccessOrigins contains origins of expectations of the CategoryUsecase.CheckCategoryAccess
+type CategoryUsecaseMockCheckCategoryAccessExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originId        string
+	originAccountID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckCategoryAccess *mCategoryUsecaseMockCheckCategoryAccess) Optional() *mCategoryUsecaseMockCheckCategoryAccess {
+	mmCheckCategoryAccess.optional = true
+	return mmCheckCategoryAccess
+}
+
+// Expect sets up expected params for CategoryUsecase.CheckCategoryAccess
+func (mmCheckCategoryAccess *mCategoryUsecaseMockCheckCategoryAccess) Expect(ctx context.Context, id uint64, accountID uuid.UUID) *mCategoryUsecaseMockCheckCategoryAccess {
+	if mmCheckCategoryAccess.mock.funcCheckCategoryAccess != nil {
+		mmCheckCategoryAccess.mock.t.Fatalf("CategoryUsecaseMock.CheckCategoryAccess mock is already set by Set")
+	}
+
+	if mmCheckCategoryAccess.defaultExpectation == nil {
+		mmCheckCategoryAccess.defaultExpectation = &CategoryUsecaseMockCheckCategoryAccessExpectation{}
+	}
+
+	if mmCheckCategoryAccess.defaultExpectation.paramPtrs != nil {
+		mmCheckCategoryAccess.mock.t.Fatalf("CategoryUsecaseMock.CheckCategoryAccess mock is already set by ExpectParams functions")
+	}
+
+	mmCheckCategoryAccess.defaultExpectation.params = &CategoryUsecaseMockCheckCategoryAccessParams{ctx, id, accountID}
+	mmCheckCategoryAccess.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheckCategoryAccess.expectations {
+		if minimock.Equal(e.params, mmCheckCategoryAccess.defaultExpectation.params) {
+			mmCheckCategoryAccess.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckCategoryAccess.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckCategoryAccess
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CategoryUsecase.CheckCategoryAccess
+func (mmCheckCategoryAccess *mCategoryUsecaseMockCheckCategoryAccess) ExpectCtxParam1(ctx context.Context) *mCategoryUsecaseMockCheckCategoryAccess {
+	if mmCheckCategoryAccess.mock.funcCheckCategoryAccess != nil {
+		mmCheckCategoryAccess.mock.t.Fatalf("CategoryUsecaseMock.CheckCategoryAccess mock is already set by Set")
+	}
+
+	if mmCheckCategoryAccess.defaultExpectation == nil {
+		mmCheckCategoryAccess.defaultExpectation = &CategoryUsecaseMockCheckCategoryAccessExpectation{}
+	}
+
+	if mmCheckCategoryAccess.defaultExpectation.params != nil {
+		mmCheckCategoryAccess.mock.t.Fatalf("CategoryUsecaseMock.CheckCategoryAccess mock is already set by Expect")
+	}
+
+	if mmCheckCategoryAccess.defaultExpectation.paramPtrs == nil {
+		mmCheckCategoryAccess.defaultExpectation.paramPtrs = &CategoryUsecaseMockCheckCategoryAccessParamPtrs{}
+	}
+	mmCheckCategoryAccess.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheckCategoryAccess.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheckCategoryAccess
+}
+
+// ExpectIdParam2 sets up expected param id for CategoryUsecase.CheckCategoryAccess
+func (mmCheckCategoryAccess *mCategoryUsecaseMockCheckCategoryAccess) ExpectIdParam2(id uint64) *mCategoryUsecaseMockCheckCategoryAccess {
+	if mmCheckCategoryAccess.mock.funcCheckCategoryAccess != nil {
+		mmCheckCategoryAccess.mock.t.Fatalf("CategoryUsecaseMock.CheckCategoryAccess mock is already set by Set")
+	}
+
+	if mmCheckCategoryAccess.defaultExpectation == nil {
+		mmCheckCategoryAccess.defaultExpectation = &CategoryUsecaseMockCheckCategoryAccessExpectation{}
+	}
+
+	if mmCheckCategoryAccess.defaultExpectation.params != nil {
+		mmCheckCategoryAccess.mock.t.Fatalf("CategoryUsecaseMock.CheckCategoryAccess mock is already set by Expect")
+	}
+
+	if mmCheckCategoryAccess.defaultExpectation.paramPtrs == nil {
+		mmCheckCategoryAccess.defaultExpectation.paramPtrs = &CategoryUsecaseMockCheckCategoryAccessParamPtrs{}
+	}
+	mmCheckCategoryAccess.defaultExpectation.paramPtrs.id = &id
+	mmCheckCategoryAccess.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmCheckCategoryAccess
+}
+
+// ExpectAccountIDParam3 sets up expected param accountID for CategoryUsecase.CheckCategoryAccess
+func (mmCheckCategoryAccess *mCategoryUsecaseMockCheckCategoryAccess) ExpectAccountIDParam3(accountID uuid.UUID) *mCategoryUsecaseMockCheckCategoryAccess {
+	if mmCheckCategoryAccess.mock.funcCheckCategoryAccess != nil {
+		mmCheckCategoryAccess.mock.t.Fatalf("CategoryUsecaseMock.CheckCategoryAccess mock is already set by Set")
+	}
+
+	if mmCheckCategoryAccess.defaultExpectation == nil {
+		mmCheckCategoryAccess.defaultExpectation = &CategoryUsecaseMockCheckCategoryAccessExpectation{}
+	}
+
+	if mmCheckCategoryAccess.defaultExpectation.params != nil {
+		mmCheckCategoryAccess.mock.t.Fatalf("CategoryUsecaseMock.CheckCategoryAccess mock is already set by Expect")
+	}
+
+	if mmCheckCategoryAccess.defaultExpectation.paramPtrs == nil {
+		mmCheckCategoryAccess.defaultExpectation.paramPtrs = &CategoryUsecaseMockCheckCategoryAccessParamPtrs{}
+	}
+	mmCheckCategoryAccess.defaultExpectation.paramPtrs.accountID = &accountID
+	mmCheckCategoryAccess.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+
+	return mmCheckCategoryAccess
+}
+
+// Inspect accepts an inspector function that has same arguments as the CategoryUsecase.CheckCategoryAccess
+func (mmCheckCategoryAccess *mCategoryUsecaseMockCheckCategoryAccess) Inspect(f func(ctx context.Context, id uint64, accountID uuid.UUID)) *mCategoryUsecaseMockCheckCategoryAccess {
+	if mmCheckCategoryAccess.mock.inspectFuncCheckCategoryAccess != nil {
+		mmCheckCategoryAccess.mock.t.Fatalf("Inspect function is already set for CategoryUsecaseMock.CheckCategoryAccess")
+	}
+
+	mmCheckCategoryAccess.mock.inspectFuncCheckCategoryAccess = f
+
+	return mmCheckCategoryAccess
+}
+
+// Return sets up results that will be returned by CategoryUsecase.CheckCategoryAccess
+func (mmCheckCategoryAccess *mCategoryUsecaseMockCheckCategoryAccess) Return(err error) *CategoryUsecaseMock {
+	if mmCheckCategoryAccess.mock.funcCheckCategoryAccess != nil {
+		mmCheckCategoryAccess.mock.t.Fatalf("CategoryUsecaseMock.CheckCategoryAccess mock is already set by Set")
+	}
+
+	if mmCheckCategoryAccess.defaultExpectation == nil {
+		mmCheckCategoryAccess.defaultExpectation = &CategoryUsecaseMockCheckCategoryAccessExpectation{mock: mmCheckCategoryAccess.mock}
+	}
+	mmCheckCategoryAccess.defaultExpectation.results = &CategoryUsecaseMockCheckCategoryAccessResults{err}
+	mmCheckCategoryAccess.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheckCategoryAccess.mock
+}
+
+// Set uses given function f to mock the CategoryUsecase.CheckCategoryAccess method
+func (mmCheckCategoryAccess *mCategoryUsecaseMockCheckCategoryAccess) Set(f func(ctx context.Context, id uint64, accountID uuid.UUID) (err error)) *CategoryUsecaseMock {
+	if mmCheckCategoryAccess.defaultExpectation != nil {
+		mmCheckCategoryAccess.mock.t.Fatalf("Default expectation is already set for the CategoryUsecase.CheckCategoryAccess method")
+	}
+
+	if len(mmCheckCategoryAccess.expectations) > 0 {
+		mmCheckCategoryAccess.mock.t.Fatalf("Some expectations are already set for the CategoryUsecase.CheckCategoryAccess method")
+	}
+
+	mmCheckCategoryAccess.mock.funcCheckCategoryAccess = f
+	mmCheckCategoryAccess.mock.funcCheckCategoryAccessOrigin = minimock.CallerInfo(1)
+	return mmCheckCategoryAccess.mock
+}
+
+// When sets expectation for the CategoryUsecase.CheckCategoryAccess which will trigger the result defined by the following
+// Then helper
+func (mmCheckCategoryAccess *mCategoryUsecaseMockCheckCategoryAccess) When(ctx context.Context, id uint64, accountID uuid.UUID) *CategoryUsecaseMockCheckCategoryAccessExpectation {
+	if mmCheckCategoryAccess.mock.funcCheckCategoryAccess != nil {
+		mmCheckCategoryAccess.mock.t.Fatalf("CategoryUsecaseMock.CheckCategoryAccess mock is already set by Set")
+	}
+
+	expectation := &CategoryUsecaseMockCheckCategoryAccessExpectation{
+		mock:               mmCheckCategoryAccess.mock,
+		params:             &CategoryUsecaseMockCheckCategoryAccessParams{ctx, id, accountID},
+		expectationOrigins: CategoryUsecaseMockCheckCategoryAccessExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheckCategoryAccess.expectations = append(mmCheckCategoryAccess.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CategoryUsecase.CheckCategoryAccess return parameters for the expectation previously defined by the When method
+func (e *CategoryUsecaseMockCheckCategoryAccessExpectation) Then(err error) *CategoryUsecaseMock {
+	e.results = &CategoryUsecaseMockCheckCategoryAccessResults{err}
+	return e.mock
+}
+
+// Times sets number of times CategoryUsecase.CheckCategoryAccess should be invoked
+func (mmCheckCategoryAccess *mCategoryUsecaseMockCheckCategoryAccess) Times(n uint64) *mCategoryUsecaseMockCheckCategoryAccess {
+	if n == 0 {
+		mmCheckCategoryAccess.mock.t.Fatalf("Times of CategoryUsecaseMock.CheckCategoryAccess mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckCategoryAccess.expectedInvocations, n)
+	mmCheckCategoryAccess.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheckCategoryAccess
+}
+
+func (mmCheckCategoryAccess *mCategoryUsecaseMockCheckCategoryAccess) invocationsDone() bool {
+	if len(mmCheckCategoryAccess.expectations) == 0 && mmCheckCategoryAccess.defaultExpectation == nil && mmCheckCategoryAccess.mock.funcCheckCategoryAccess == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckCategoryAccess.mock.afterCheckCategoryAccessCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckCategoryAccess.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckCategoryAccess implements mm_usecase.CategoryUsecase
+func (mmCheckCategoryAccess *CategoryUsecaseMock) CheckCategoryAccess(ctx context.Context, id uint64, accountID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmCheckCategoryAccess.beforeCheckCategoryAccessCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckCategoryAccess.afterCheckCategoryAccessCounter, 1)
+
+	mmCheckCategoryAccess.t.Helper()
+
+	if mmCheckCategoryAccess.inspectFuncCheckCategoryAccess != nil {
+		mmCheckCategoryAccess.inspectFuncCheckCategoryAccess(ctx, id, accountID)
+	}
+
+	mm_params := CategoryUsecaseMockCheckCategoryAccessParams{ctx, id, accountID}
+
+	// Record call args
+	mmCheckCategoryAccess.CheckCategoryAccessMock.mutex.Lock()
+	mmCheckCategoryAccess.CheckCategoryAccessMock.callArgs = append(mmCheckCategoryAccess.CheckCategoryAccessMock.callArgs, &mm_params)
+	mmCheckCategoryAccess.CheckCategoryAccessMock.mutex.Unlock()
+
+	for _, e := range mmCheckCategoryAccess.CheckCategoryAccessMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckCategoryAccess.CheckCategoryAccessMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckCategoryAccess.CheckCategoryAccessMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckCategoryAccess.CheckCategoryAccessMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckCategoryAccess.CheckCategoryAccessMock.defaultExpectation.paramPtrs
+
+		mm_got := CategoryUsecaseMockCheckCategoryAccessParams{ctx, id, accountID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckCategoryAccess.t.Errorf("CategoryUsecaseMock.CheckCategoryAccess got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckCategoryAccess.CheckCategoryAccessMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmCheckCategoryAccess.t.Errorf("CategoryUsecaseMock.CheckCategoryAccess got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckCategoryAccess.CheckCategoryAccessMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmCheckCategoryAccess.t.Errorf("CategoryUsecaseMock.CheckCategoryAccess got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckCategoryAccess.CheckCategoryAccessMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckCategoryAccess.t.Errorf("CategoryUsecaseMock.CheckCategoryAccess got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheckCategoryAccess.CheckCategoryAccessMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckCategoryAccess.CheckCategoryAccessMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckCategoryAccess.t.Fatal("No results are set for the CategoryUsecaseMock.CheckCategoryAccess")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckCategoryAccess.funcCheckCategoryAccess != nil {
+		return mmCheckCategoryAccess.funcCheckCategoryAccess(ctx, id, accountID)
+	}
+	mmCheckCategoryAccess.t.Fatalf("Unexpected call to CategoryUsecaseMock.CheckCategoryAccess. %v %v %v", ctx, id, accountID)
+	return
+}
+
+// CheckCategoryAccessAfterCounter returns a count of finished CategoryUsecaseMock.CheckCategoryAccess invocations
+func (mmCheckCategoryAccess *CategoryUsecaseMock) CheckCategoryAccessAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckCategoryAccess.afterCheckCategoryAccessCounter)
+}
+
+// CheckCategoryAccessBeforeCounter returns a count of CategoryUsecaseMock.CheckCategoryAccess invocations
+func (mmCheckCategoryAccess *CategoryUsecaseMock) CheckCategoryAccessBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckCategoryAccess.beforeCheckCategoryAccessCounter)
+}
+
+// Calls returns a list of arguments used in each call to CategoryUsecaseMock.CheckCategoryAccess.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckCategoryAccess *mCategoryUsecaseMockCheckCategoryAccess) Calls() []*CategoryUsecaseMockCheckCategoryAccessParams {
+	mmCheckCategoryAccess.mutex.RLock()
+
+	argCopy := make([]*CategoryUsecaseMockCheckCategoryAccessParams, len(mmCheckCategoryAccess.callArgs))
+	copy(argCopy, mmCheckCategoryAccess.callArgs)
+
+	mmCheckCategoryAccess.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckCategoryAccessDone returns true if the count of the CheckCategoryAccess invocations corresponds
+// the number of defined expectations
+func (m *CategoryUsecaseMock) MinimockCheckCategoryAccessDone() bool {
+	if m.CheckCategoryAccessMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckCategoryAccessMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckCategoryAccessMock.invocationsDone()
+}
+
+// MinimockCheckCategoryAccessInspect logs each unmet expectation
+func (m *CategoryUsecaseMock) MinimockCheckCategoryAccessInspect() {
+	for _, e := range m.CheckCategoryAccessMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CategoryUsecaseMock.CheckCategoryAccess at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckCategoryAccessCounter := mm_atomic.LoadUint64(&m.afterCheckCategoryAccessCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckCategoryAccessMock.defaultExpectation != nil && afterCheckCategoryAccessCounter < 1 {
+		if m.CheckCategoryAccessMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CategoryUsecaseMock.CheckCategoryAccess at\n%s", m.CheckCategoryAccessMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CategoryUsecaseMock.CheckCategoryAccess at\n%s with params: %#v", m.CheckCategoryAccessMock.defaultExpectation.expectationOrigins.origin, *m.CheckCategoryAccessMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckCategoryAccess != nil && afterCheckCategoryAccessCounter < 1 {
+		m.t.Errorf("Expected call to CategoryUsecaseMock.CheckCategoryAccess at\n%s", m.funcCheckCategoryAccessOrigin)
+	}
+
+	if !m.CheckCategoryAccessMock.invocationsDone() && afterCheckCategoryAccessCounter > 0 {
+		m.t.Errorf("Expected %d calls to CategoryUsecaseMock.CheckCategoryAccess at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckCategoryAccessMock.expectedInvocations), m.CheckCategoryAccessMock.expectedInvocationsOrigin, afterCheckCategoryAccessCounter)
+	}
+}
+
+type mCategoryUsecaseMockCreateCategoryByDTO struct {
+	optional           bool
+	mock               *CategoryUsecaseMock
+	defaultExpectation *CategoryUsecaseMockCreateCategoryByDTOExpectation
+	expectations       []*CategoryUsecaseMockCreateCategoryByDTOExpectation
+
+	callArgs []*CategoryUsecaseMockCreateCategoryByDTOParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CategoryUsecaseMockCreateCategoryByDTOExpectation specifies expectation struct of the CategoryUsecase.CreateCategoryByDTO
+type CategoryUsecaseMockCreateCategoryByDTOExpectation struct {
+	mock               *CategoryUsecaseMock
+	params             *CategoryUsecaseMockCreateCategoryByDTOParams
+	paramPtrs          *CategoryUsecaseMockCreateCategoryByDTOParamPtrs
+	expectationOrigins CategoryUsecaseMockCreateCategoryByDTOExpectationOrigins
+	results            *CategoryUsecaseMockCreateCategoryByDTOResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CategoryUsecaseMockCreateCategoryByDTOParams contains parameters of the CategoryUsecase.CreateCategoryByDTO
+type CategoryUsecaseMockCreateCategoryByDTOParams struct {
+	ctx context.Context
+	in  mm_usecase.CreateCategoryDataInput
+}
+
+// CategoryUsecaseMockCreateCategoryByDTOParamPtrs contains pointers to parameters of the CategoryUsecase.CreateCategoryByDTO
+type CategoryUsecaseMockCreateCategoryByDTOParamPtrs struct {
+	ctx *context.Context
+	in  *mm_usecase.CreateCategoryDataInput
+}
+
+// CategoryUsecaseMockCreateCategoryByDTOResults contains results of the CategoryUsecase.CreateCategoryByDTO
+type CategoryUsecaseMockCreateCategoryByDTOResults struct {
+	resCategoryDTO *mm_usecase.CategoryDTO
+	err            error
+}
+
+// CategoryUsecaseMockCreateCategoryByDTOOrigins contains origins of expectations of the CategoryUsecase.CreateCategoryByDTO
+type CategoryUsecaseMockCreateCategoryByDTOExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateCategoryByDTO *mCategoryUsecaseMockCreateCategoryByDTO) Optional() *mCategoryUsecaseMockCreateCategoryByDTO {
+	mmCreateCategoryByDTO.optional = true
+	return mmCreateCategoryByDTO
+}
+
+// Expect sets up expected params for CategoryUsecase.CreateCategoryByDTO
+func (mmCreateCategoryByDTO *mCategoryUsecaseMockCreateCategoryByDTO) Expect(ctx context.Context, in mm_usecase.CreateCategoryDataInput) *mCategoryUsecaseMockCreateCategoryByDTO {
+	if mmCreateCategoryByDTO.mock.funcCreateCategoryByDTO != nil {
+		mmCreateCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.CreateCategoryByDTO mock is already set by Set")
+	}
+
+	if mmCreateCategoryByDTO.defaultExpectation == nil {
+		mmCreateCategoryByDTO.defaultExpectation = &CategoryUsecaseMockCreateCategoryByDTOExpectation{}
+	}
+
+	if mmCreateCategoryByDTO.defaultExpectation.paramPtrs != nil {
+		mmCreateCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.CreateCategoryByDTO mock is already set by ExpectParams functions")
+	}
+
+	mmCreateCategoryByDTO.defaultExpectation.params = &CategoryUsecaseMockCreateCategoryByDTOParams{ctx, in}
+	mmCreateCategoryByDTO.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateCategoryByDTO.expectations {
+		if minimock.Equal(e.params, mmCreateCategoryByDTO.defaultExpectation.params) {
+			mmCreateCategoryByDTO.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateCategoryByDTO.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateCategoryByDTO
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CategoryUsecase.CreateCategoryByDTO
+func (mmCreateCategoryByDTO *mCategoryUsecaseMockCreateCategoryByDTO) ExpectCtxParam1(ctx context.Context) *mCategoryUsecaseMockCreateCategoryByDTO {
+	if mmCreateCategoryByDTO.mock.funcCreateCategoryByDTO != nil {
+		mmCreateCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.CreateCategoryByDTO mock is already set by Set")
+	}
+
+	if mmCreateCategoryByDTO.defaultExpectation == nil {
+		mmCreateCategoryByDTO.defaultExpectation = &CategoryUsecaseMockCreateCategoryByDTOExpectation{}
+	}
+
+	if mmCreateCategoryByDTO.defaultExpectation.params != nil {
+		mmCreateCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.CreateCategoryByDTO mock is already set by Expect")
+	}
+
+	if mmCreateCategoryByDTO.defaultExpectation.paramPtrs == nil {
+		mmCreateCategoryByDTO.defaultExpectation.paramPtrs = &CategoryUsecaseMockCreateCategoryByDTOParamPtrs{}
+	}
+	mmCreateCategoryByDTO.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateCategoryByDTO.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateCategoryByDTO
+}
+
+// ExpectInParam2 sets up expected param in for CategoryUsecase.CreateCategoryByDTO
+func (mmCreateCategoryByDTO *mCategoryUsecaseMockCreateCategoryByDTO) ExpectInParam2(in mm_usecase.CreateCategoryDataInput) *mCategoryUsecaseMockCreateCategoryByDTO {
+	if mmCreateCategoryByDTO.mock.funcCreateCategoryByDTO != nil {
+		mmCreateCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.CreateCategoryByDTO mock is already set by Set")
+	}
+
+	if mmCreateCategoryByDTO.defaultExpectation == nil {
+		mmCreateCategoryByDTO.defaultExpectation = &CategoryUsecaseMockCreateCategoryByDTOExpectation{}
+	}
+
+	if mmCreateCategoryByDTO.defaultExpectation.params != nil {
+		mmCreateCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.CreateCategoryByDTO mock is already set by Expect")
+	}
+
+	if mmCreateCategoryByDTO.defaultExpectation.paramPtrs == nil {
+		mmCreateCategoryByDTO.defaultExpectation.paramPtrs = &CategoryUsecaseMockCreateCategoryByDTOParamPtrs{}
+	}
+	mmCreateCategoryByDTO.defaultExpectation.paramPtrs.in = &in
+	mmCreateCategoryByDTO.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmCreateCategoryByDTO
+}
+
+// Inspect accepts an inspector function that has same arguments as the CategoryUsecase.CreateCategoryByDTO
+func (mmCreateCategoryByDTO *mCategoryUsecaseMockCreateCategoryByDTO) Inspect(f func(ctx context.Context, in mm_usecase.CreateCategoryDataInput)) *mCategoryUsecaseMockCreateCategoryByDTO {
+	if mmCreateCategoryByDTO.mock.inspectFuncCreateCategoryByDTO != nil {
+		mmCreateCategoryByDTO.mock.t.Fatalf("Inspect function is already set for CategoryUsecaseMock.CreateCategoryByDTO")
+	}
+
+	mmCreateCategoryByDTO.mock.inspectFuncCreateCategoryByDTO = f
+
+	return mmCreateCategoryByDTO
+}
+
+// Return sets up results that will be returned by CategoryUsecase.CreateCategoryByDTO
+func (mmCreateCategoryByDTO *mCategoryUsecaseMockCreateCategoryByDTO) Return(resCategoryDTO *mm_usecase.CategoryDTO, err error) *CategoryUsecaseMock {
+	if mmCreateCategoryByDTO.mock.funcCreateCategoryByDTO != nil {
+		mmCreateCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.CreateCategoryByDTO mock is already set by Set")
+	}
+
+	if mmCreateCategoryByDTO.defaultExpectation == nil {
+		mmCreateCategoryByDTO.defaultExpectation = &CategoryUsecaseMockCreateCategoryByDTOExpectation{mock: mmCreateCategoryByDTO.mock}
+	}
+	mmCreateCategoryByDTO.defaultExpectation.results = &CategoryUsecaseMockCreateCategoryByDTOResults{resCategoryDTO, err}
+	mmCreateCategoryByDTO.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateCategoryByDTO.mock
+}
+
+// Set uses given function f to mock the CategoryUsecase.CreateCategoryByDTO method
+func (mmCreateCategoryByDTO *mCategoryUsecaseMockCreateCategoryByDTO) Set(f func(ctx context.Context, in mm_usecase.CreateCategoryDataInput) (resCategoryDTO *mm_usecase.CategoryDTO, err error)) *CategoryUsecaseMock {
+	if mmCreateCategoryByDTO.defaultExpectation != nil {
+		mmCreateCategoryByDTO.mock.t.Fatalf("Default expectation is already set for the CategoryUsecase.CreateCategoryByDTO method")
+	}
+
+	if len(mmCreateCategoryByDTO.expectations) > 0 {
+		mmCreateCategoryByDTO.mock.t.Fatalf("Some expectations are already set for the CategoryUsecase.CreateCategoryByDTO method")
+	}
+
+	mmCreateCategoryByDTO.mock.funcCreateCategoryByDTO = f
+	mmCreateCategoryByDTO.mock.funcCreateCategoryByDTOOrigin = minimock.CallerInfo(1)
+	return mmCreateCategoryByDTO.mock
+}
+
+// When sets expectation for the CategoryUsecase.CreateCategoryByDTO which will trigger the result defined by the following
+// Then helper
+func (mmCreateCategoryByDTO *mCategoryUsecaseMockCreateCategoryByDTO) When(ctx context.Context, in mm_usecase.CreateCategoryDataInput) *CategoryUsecaseMockCreateCategoryByDTOExpectation {
+	if mmCreateCategoryByDTO.mock.funcCreateCategoryByDTO != nil {
+		mmCreateCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.CreateCategoryByDTO mock is already set by Set")
+	}
+
+	expectation := &CategoryUsecaseMockCreateCategoryByDTOExpectation{
+		mock:               mmCreateCategoryByDTO.mock,
+		params:             &CategoryUsecaseMockCreateCategoryByDTOParams{ctx, in},
+		expectationOrigins: CategoryUsecaseMockCreateCategoryByDTOExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateCategoryByDTO.expectations = append(mmCreateCategoryByDTO.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CategoryUsecase.CreateCategoryByDTO return parameters for the expectation previously defined by the When method
+func (e *CategoryUsecaseMockCreateCategoryByDTOExpectation) Then(resCategoryDTO *mm_usecase.CategoryDTO, err error) *CategoryUsecaseMock {
+	e.results = &CategoryUsecaseMockCreateCategoryByDTOResults{resCategoryDTO, err}
+	return e.mock
+}
+
+// Times sets number of times CategoryUsecase.CreateCategoryByDTO should be invoked
+func (mmCreateCategoryByDTO *mCategoryUsecaseMockCreateCategoryByDTO) Times(n uint64) *mCategoryUsecaseMockCreateCategoryByDTO {
+	if n == 0 {
+		mmCreateCategoryByDTO.mock.t.Fatalf("Times of CategoryUsecaseMock.CreateCategoryByDTO mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateCategoryByDTO.expectedInvocations, n)
+	mmCreateCategoryByDTO.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateCategoryByDTO
+}
+
+func (mmCreateCategoryByDTO *mCategoryUsecaseMockCreateCategoryByDTO) invocationsDone() bool {
+	if len(mmCreateCategoryByDTO.expectations) == 0 && mmCreateCategoryByDTO.defaultExpectation == nil && mmCreateCategoryByDTO.mock.funcCreateCategoryByDTO == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateCategoryByDTO.mock.afterCreateCategoryByDTOCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateCategoryByDTO.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateCategoryByDTO implements mm_usecase.CategoryUsecase
+func (mmCreateCategoryByDTO *CategoryUsecaseMock) CreateCategoryByDTO(ctx context.Context, in mm_usecase.CreateCategoryDataInput) (resCategoryDTO *mm_usecase.CategoryDTO, err error) {
+	mm_atomic.AddUint64(&mmCreateCategoryByDTO.beforeCreateCategoryByDTOCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateCategoryByDTO.afterCreateCategoryByDTOCounter, 1)
+
+	mmCreateCategoryByDTO.t.Helper()
+
+	if mmCreateCategoryByDTO.inspectFuncCreateCategoryByDTO != nil {
+		mmCreateCategoryByDTO.inspectFuncCreateCategoryByDTO(ctx, in)
+	}
+
+	mm_params := CategoryUsecaseMockCreateCategoryByDTOParams{ctx, in}
+
+	// Record call args
+	mmCreateCategoryByDTO.CreateCategoryByDTOMock.mutex.Lock()
+	mmCreateCategoryByDTO.CreateCategoryByDTOMock.callArgs = append(mmCreateCategoryByDTO.CreateCategoryByDTOMock.callArgs, &mm_params)
+	mmCreateCategoryByDTO.CreateCategoryByDTOMock.mutex.Unlock()
+
+	for _, e := range mmCreateCategoryByDTO.CreateCategoryByDTOMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resCategoryDTO, e.results.err
+		}
+	}
+
+	if mmCreateCategoryByDTO.CreateCategoryByDTOMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateCategoryByDTO.CreateCategoryByDTOMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateCategoryByDTO.CreateCategoryByDTOMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateCategoryByDTO.CreateCategoryByDTOMock.defaultExpectation.paramPtrs
+
+		mm_got := CategoryUsecaseMockCreateCategoryByDTOParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateCategoryByDTO.t.Errorf("CategoryUsecaseMock.CreateCategoryByDTO got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateCategoryByDTO.CreateCategoryByDTOMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmCreateCategoryByDTO.t.Errorf("CategoryUsecaseMock.CreateCategoryByDTO got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateCategoryByDTO.CreateCategoryByDTOMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateCategoryByDTO.t.Errorf("CategoryUsecaseMock.CreateCategoryByDTO got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateCategoryByDTO.CreateCategoryByDTOMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateCategoryByDTO.CreateCategoryByDTOMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateCategoryByDTO.t.Fatal("No results are set for the CategoryUsecaseMock.CreateCategoryByDTO")
+		}
+		return (*mm_results).resCategoryDTO, (*mm_results).err
+	}
+	if mmCreateCategoryByDTO.funcCreateCategoryByDTO != nil {
+		return mmCreateCategoryByDTO.funcCreateCategoryByDTO(ctx, in)
+	}
+	mmCreateCategoryByDTO.t.Fatalf("Unexpected call to CategoryUsecaseMock.CreateCategoryByDTO. %v %v", ctx, in)
+	return
+}
+
+// CreateCategoryByDTOAfterCounter returns a count of finished CategoryUsecaseMock.CreateCategoryByDTO invocations
+func (mmCreateCategoryByDTO *CategoryUsecaseMock) CreateCategoryByDTOAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateCategoryByDTO.afterCreateCategoryByDTOCounter)
+}
+
+// CreateCategoryByDTOBeforeCounter returns a count of CategoryUsecaseMock.CreateCategoryByDTO invocations
+func (mmCreateCategoryByDTO *CategoryUsecaseMock) CreateCategoryByDTOBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateCategoryByDTO.beforeCreateCategoryByDTOCounter)
+}
+
+// Calls returns a list of arguments used in each call to CategoryUsecaseMock.CreateCategoryByDTO.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateCategoryByDTO *mCategoryUsecaseMockCreateCategoryByDTO) Calls() []*CategoryUsecaseMockCreateCategoryByDTOParams {
+	mmCreateCategoryByDTO.mutex.RLock()
+
+	argCopy := make([]*CategoryUsecaseMockCreateCategoryByDTOParams, len(mmCreateCategoryByDTO.callArgs))
+	copy(argCopy, mmCreateCategoryByDTO.callArgs)
+
+	mmCreateCategoryByDTO.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateCategoryByDTODone returns true if the count of the CreateCategoryByDTO invocations corresponds
+// the number of defined expectations
+func (m *CategoryUsecaseMock) MinimockCreateCategoryByDTODone() bool {
+	if m.CreateCategoryByDTOMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateCategoryByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateCategoryByDTOMock.invocationsDone()
+}
+
+// MinimockCreateCategoryByDTOInspect logs each unmet expectation
+func (m *CategoryUsecaseMock) MinimockCreateCategoryByDTOInspect() {
+	for _, e := range m.CreateCategoryByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CategoryUsecaseMock.CreateCategoryByDTO at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCategoryByDTOCounter := mm_atomic.LoadUint64(&m.afterCreateCategoryByDTOCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateCategoryByDTOMock.defaultExpectation != nil && afterCreateCategoryByDTOCounter < 1 {
+		if m.CreateCategoryByDTOMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CategoryUsecaseMock.CreateCategoryByDTO at\n%s", m.CreateCategoryByDTOMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CategoryUsecaseMock.CreateCategoryByDTO at\n%s with params: %#v", m.CreateCategoryByDTOMock.defaultExpectation.expectationOrigins.origin, *m.CreateCategoryByDTOMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateCategoryByDTO != nil && afterCreateCategoryByDTOCounter < 1 {
+		m.t.Errorf("Expected call to CategoryUsecaseMock.CreateCategoryByDTO at\n%s", m.funcCreateCategoryByDTOOrigin)
+	}
+
+	if !m.CreateCategoryByDTOMock.invocationsDone() && afterCreateCategoryByDTOCounter > 0 {
+		m.t.Errorf("Expected %d calls to CategoryUsecaseMock.CreateCategoryByDTO at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateCategoryByDTOMock.expectedInvocations), m.CreateCategoryByDTOMock.expectedInvocationsOrigin, afterCreateCategoryByDTOCounter)
+	}
+}
+
+type mCategoryUsecaseMockDeleteCategoryByID struct {
+	optional           bool
+	mock               *CategoryUsecaseMock
+	defaultExpectation *CategoryUsecaseMockDeleteCategoryByIDExpectation
+	expectations       []*CategoryUsecaseMockDeleteCategoryByIDExpectation
+
+	callArgs []*CategoryUsecaseMockDeleteCategoryByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CategoryUsecaseMockDeleteCategoryByIDExpectation specifies expectation struct of the CategoryUsecase.DeleteCategoryByID
+type CategoryUsecaseMockDeleteCategoryByIDExpectation struct {
+	mock               *CategoryUsecaseMock
+	params             *CategoryUsecaseMockDeleteCategoryByIDParams
+	paramPtrs          *CategoryUsecaseMockDeleteCategoryByIDParamPtrs
+	expectationOrigins CategoryUsecaseMockDeleteCategoryByIDExpectationOrigins
+	results            *CategoryUsecaseMockDeleteCategoryByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CategoryUsecaseMockDeleteCategoryByIDParams contains parameters of the CategoryUsecase.DeleteCategoryByID
+type CategoryUsecaseMockDeleteCategoryByIDParams struct {
+	ctx          context.Context
+	id           uint64
+	reassignToID *uint64
+}
+
+// CategoryUsecaseMockDeleteCategoryByIDParamPtrs contains pointers to parameters of the CategoryUsecase.DeleteCategoryByID
+type CategoryUsecaseMockDeleteCategoryByIDParamPtrs struct {
+	ctx          *context.Context
+	id           *uint64
+	reassignToID **uint64
+}
+
+// CategoryUsecaseMockDeleteCategoryByIDResults contains results of the CategoryUsecase.DeleteCategoryByID
+type CategoryUsecaseMockDeleteCategoryByIDResults struct {
+	err error
+}
+
+// CategoryUsecaseMockDeleteCategoryByIDOrigins contains origins of expectations of the CategoryUsecase.DeleteCategoryByID
+type CategoryUsecaseMockDeleteCategoryByIDExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originId           string
+	originReassignToID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteCategoryByID *mCategoryUsecaseMockDeleteCategoryByID) Optional() *mCategoryUsecaseMockDeleteCategoryByID {
+	mmDeleteCategoryByID.optional = true
+	return mmDeleteCategoryByID
+}
+
+// Expect sets up expected params for CategoryUsecase.DeleteCategoryByID
+func (mmDeleteCategoryByID *mCategoryUsecaseMockDeleteCategoryByID) Expect(ctx context.Context, id uint64, reassignToID *uint64) *mCategoryUsecaseMockDeleteCategoryByID {
+	if mmDeleteCategoryByID.mock.funcDeleteCategoryByID != nil {
+		mmDeleteCategoryByID.mock.t.Fatalf("CategoryUsecaseMock.DeleteCategoryByID mock is already set by Set")
+	}
+
+	if mmDeleteCategoryByID.defaultExpectation == nil {
+		mmDeleteCategoryByID.defaultExpectation = &CategoryUsecaseMockDeleteCategoryByIDExpectation{}
+	}
+
+	if mmDeleteCategoryByID.defaultExpectation.paramPtrs != nil {
+		mmDeleteCategoryByID.mock.t.Fatalf("CategoryUsecaseMock.DeleteCategoryByID mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteCategoryByID.defaultExpectation.params = &CategoryUsecaseMockDeleteCategoryByIDParams{ctx, id, reassignToID}
+	mmDeleteCategoryByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteCategoryByID.expectations {
+		if minimock.Equal(e.params, mmDeleteCategoryByID.defaultExpectation.params) {
+			mmDeleteCategoryByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteCategoryByID.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteCategoryByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CategoryUsecase.DeleteCategoryByID
+func (mmDeleteCategoryByID *mCategoryUsecaseMockDeleteCategoryByID) ExpectCtxParam1(ctx context.Context) *mCategoryUsecaseMockDeleteCategoryByID {
+	if mmDeleteCategoryByID.mock.funcDeleteCategoryByID != nil {
+		mmDeleteCategoryByID.mock.t.Fatalf("CategoryUsecaseMock.DeleteCategoryByID mock is already set by Set")
+	}
+
+	if mmDeleteCategoryByID.defaultExpectation == nil {
+		mmDeleteCategoryByID.defaultExpectation = &CategoryUsecaseMockDeleteCategoryByIDExpectation{}
+	}
+
+	if mmDeleteCategoryByID.defaultExpectation.params != nil {
+		mmDeleteCategoryByID.mock.t.Fatalf("CategoryUsecaseMock.DeleteCategoryByID mock is already set by Expect")
+	}
+
+	if mmDeleteCategoryByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteCategoryByID.defaultExpectation.paramPtrs = &CategoryUsecaseMockDeleteCategoryByIDParamPtrs{}
+	}
+	mmDeleteCategoryByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteCategoryByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteCategoryByID
+}
+
+// ExpectIdParam2 sets up expected param id for CategoryUsecase.DeleteCategoryByID
+func (mmDeleteCategoryByID *mCategoryUsecaseMockDeleteCategoryByID) ExpectIdParam2(id uint64) *mCategoryUsecaseMockDeleteCategoryByID {
+	if mmDeleteCategoryByID.mock.funcDeleteCategoryByID != nil {
+		mmDeleteCategoryByID.mock.t.Fatalf("CategoryUsecaseMock.DeleteCategoryByID mock is already set by Set")
+	}
+
+	if mmDeleteCategoryByID.defaultExpectation == nil {
+		mmDeleteCategoryByID.defaultExpectation = &CategoryUsecaseMockDeleteCategoryByIDExpectation{}
+	}
+
+	if mmDeleteCategoryByID.defaultExpectation.params != nil {
+		mmDeleteCategoryByID.mock.t.Fatalf("CategoryUsecaseMock.DeleteCategoryByID mock is already set by Expect")
+	}
+
+	if mmDeleteCategoryByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteCategoryByID.defaultExpectation.paramPtrs = &CategoryUsecaseMockDeleteCategoryByIDParamPtrs{}
+	}
+	mmDeleteCategoryByID.defaultExpectation.paramPtrs.id = &id
+	mmDeleteCategoryByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteCategoryByID
+}
+
+// ExpectReassignToIDParam3 sets up expected param reassignToID for CategoryUsecase.DeleteCategoryByID
+func (mmDeleteCategoryByID *mCategoryUsecaseMockDeleteCategoryByID) ExpectReassignToIDParam3(reassignToID *uint64) *mCategoryUsecaseMockDeleteCategoryByID {
+	if mmDeleteCategoryByID.mock.funcDeleteCategoryByID != nil {
+		mmDeleteCategoryByID.mock.t.Fatalf("CategoryUsecaseMock.DeleteCategoryByID mock is already set by Set")
+	}
+
+	if mmDeleteCategoryByID.defaultExpectation == nil {
+		mmDeleteCategoryByID.defaultExpectation = &CategoryUsecaseMockDeleteCategoryByIDExpectation{}
+	}
+
+	if mmDeleteCategoryByID.defaultExpectation.params != nil {
+		mmDeleteCategoryByID.mock.t.Fatalf("CategoryUsecaseMock.DeleteCategoryByID mock is already set by Expect")
+	}
+
+	if mmDeleteCategoryByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteCategoryByID.defaultExpectation.paramPtrs = &CategoryUsecaseMockDeleteCategoryByIDParamPtrs{}
+	}
+	mmDeleteCategoryByID.defaultExpectation.paramPtrs.reassignToID = &reassignToID
+	mmDeleteCategoryByID.defaultExpectation.expectationOrigins.originReassignToID = minimock.CallerInfo(1)
+
+	return mmDeleteCategoryByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the CategoryUsecase.DeleteCategoryByID
+func (mmDeleteCategoryByID *mCategoryUsecaseMockDeleteCategoryByID) Inspect(f func(ctx context.Context, id uint64, reassignToID *uint64)) *mCategoryUsecaseMockDeleteCategoryByID {
+	if mmDeleteCategoryByID.mock.inspectFuncDeleteCategoryByID != nil {
+		mmDeleteCategoryByID.mock.t.Fatalf("Inspect function is already set for CategoryUsecaseMock.DeleteCategoryByID")
+	}
+
+	mmDeleteCategoryByID.mock.inspectFuncDeleteCategoryByID = f
+
+	return mmDeleteCategoryByID
+}
+
+// Return sets up results that will be returned by CategoryUsecase.DeleteCategoryByID
+func (mmDeleteCategoryByID *mCategoryUsecaseMockDeleteCategoryByID) Return(err error) *CategoryUsecaseMock {
+	if mmDeleteCategoryByID.mock.funcDeleteCategoryByID != nil {
+		mmDeleteCategoryByID.mock.t.Fatalf("CategoryUsecaseMock.DeleteCategoryByID mock is already set by Set")
+	}
+
+	if mmDeleteCategoryByID.defaultExpectation == nil {
+		mmDeleteCategoryByID.defaultExpectation = &CategoryUsecaseMockDeleteCategoryByIDExpectation{mock: mmDeleteCategoryByID.mock}
+	}
+	mmDeleteCategoryByID.defaultExpectation.results = &CategoryUsecaseMockDeleteCategoryByIDResults{err}
+	mmDeleteCategoryByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteCategoryByID.mock
+}
+
+// Set uses given function f to mock the CategoryUsecase.DeleteCategoryByID method
+func (mmDeleteCategoryByID *mCategoryUsecaseMockDeleteCategoryByID) Set(f func(ctx context.Context, id uint64, reassignToID *uint64) (err error)) *CategoryUsecaseMock {
+	if mmDeleteCategoryByID.defaultExpectation != nil {
+		mmDeleteCategoryByID.mock.t.Fatalf("Default expectation is already set for the CategoryUsecase.DeleteCategoryByID method")
+	}
+
+	if len(mmDeleteCategoryByID.expectations) > 0 {
+		mmDeleteCategoryByID.mock.t.Fatalf("Some expectations are already set for the CategoryUsecase.DeleteCategoryByID method")
+	}
+
+	mmDeleteCategoryByID.mock.funcDeleteCategoryByID = f
+	mmDeleteCategoryByID.mock.funcDeleteCategoryByIDOrigin = minimock.CallerInfo(1)
+	return mmDeleteCategoryByID.mock
+}
+
+// When sets expectation for the CategoryUsecase.DeleteCategoryByID which will trigger the result defined by the following
+// Then helper
+func (mmDeleteCategoryByID *mCategoryUsecaseMockDeleteCategoryByID) When(ctx context.Context, id uint64, reassignToID *uint64) *CategoryUsecaseMockDeleteCategoryByIDExpectation {
+	if mmDeleteCategoryByID.mock.funcDeleteCategoryByID != nil {
+		mmDeleteCategoryByID.mock.t.Fatalf("CategoryUsecaseMock.DeleteCategoryByID mock is already set by Set")
+	}
+
+	expectation := &CategoryUsecaseMockDeleteCategoryByIDExpectation{
+		mock:               mmDeleteCategoryByID.mock,
+		params:             &CategoryUsecaseMockDeleteCategoryByIDParams{ctx, id, reassignToID},
+		expectationOrigins: CategoryUsecaseMockDeleteCategoryByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteCategoryByID.expectations = append(mmDeleteCategoryByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CategoryUsecase.DeleteCategoryByID return parameters for the expectation previously defined by the When method
+func (e *CategoryUsecaseMockDeleteCategoryByIDExpectation) Then(err error) *CategoryUsecaseMock {
+	e.results = &CategoryUsecaseMockDeleteCategoryByIDResults{err}
+	return e.mock
+}
+
+// Times sets number of times CategoryUsecase.DeleteCategoryByID should be invoked
+func (mmDeleteCategoryByID *mCategoryUsecaseMockDeleteCategoryByID) Times(n uint64) *mCategoryUsecaseMockDeleteCategoryByID {
+	if n == 0 {
+		mmDeleteCategoryByID.mock.t.Fatalf("Times of CategoryUsecaseMock.DeleteCategoryByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteCategoryByID.expectedInvocations, n)
+	mmDeleteCategoryByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteCategoryByID
+}
+
+func (mmDeleteCategoryByID *mCategoryUsecaseMockDeleteCategoryByID) invocationsDone() bool {
+	if len(mmDeleteCategoryByID.expectations) == 0 && mmDeleteCategoryByID.defaultExpectation == nil && mmDeleteCategoryByID.mock.funcDeleteCategoryByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteCategoryByID.mock.afterDeleteCategoryByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteCategoryByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteCategoryByID implements mm_usecase.CategoryUsecase
+func (mmDeleteCategoryByID *CategoryUsecaseMock) DeleteCategoryByID(ctx context.Context, id uint64, reassignToID *uint64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteCategoryByID.beforeDeleteCategoryByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteCategoryByID.afterDeleteCategoryByIDCounter, 1)
+
+	mmDeleteCategoryByID.t.Helper()
+
+	if mmDeleteCategoryByID.inspectFuncDeleteCategoryByID != nil {
+		mmDeleteCategoryByID.inspectFuncDeleteCategoryByID(ctx, id, reassignToID)
+	}
+
+	mm_params := CategoryUsecaseMockDeleteCategoryByIDParams{ctx, id, reassignToID}
+
+	// Record call args
+	mmDeleteCategoryByID.DeleteCategoryByIDMock.mutex.Lock()
+	mmDeleteCategoryByID.DeleteCategoryByIDMock.callArgs = append(mmDeleteCategoryByID.DeleteCategoryByIDMock.callArgs, &mm_params)
+	mmDeleteCategoryByID.DeleteCategoryByIDMock.mutex.Unlock()
+
+	for _, e := range mmDeleteCategoryByID.DeleteCategoryByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteCategoryByID.DeleteCategoryByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteCategoryByID.DeleteCategoryByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteCategoryByID.DeleteCategoryByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteCategoryByID.DeleteCategoryByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := CategoryUsecaseMockDeleteCategoryByIDParams{ctx, id, reassignToID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteCategoryByID.t.Errorf("CategoryUsecaseMock.DeleteCategoryByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteCategoryByID.DeleteCategoryByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteCategoryByID.t.Errorf("CategoryUsecaseMock.DeleteCategoryByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteCategoryByID.DeleteCategoryByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.reassignToID != nil && !minimock.Equal(*mm_want_ptrs.reassignToID, mm_got.reassignToID) {
+				mmDeleteCategoryByID.t.Errorf("CategoryUsecaseMock.DeleteCategoryByID got unexpected parameter reassignToID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteCategoryByID.DeleteCategoryByIDMock.defaultExpectation.expectationOrigins.originReassignToID, *mm_want_ptrs.reassignToID, mm_got.reassignToID, minimock.Diff(*mm_want_ptrs.reassignToID, mm_got.reassignToID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteCategoryByID.t.Errorf("CategoryUsecaseMock.DeleteCategoryByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteCategoryByID.DeleteCategoryByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteCategoryByID.DeleteCategoryByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteCategoryByID.t.Fatal("No results are set for the CategoryUsecaseMock.DeleteCategoryByID")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteCategoryByID.funcDeleteCategoryByID != nil {
+		return mmDeleteCategoryByID.funcDeleteCategoryByID(ctx, id, reassignToID)
+	}
+	mmDeleteCategoryByID.t.Fatalf("Unexpected call to CategoryUsecaseMock.DeleteCategoryByID. %v %v %v", ctx, id, reassignToID)
+	return
 }
 
-// NewCategoryUsecaseMock returns a mock for mm_usecase.CategoryUsecase
-func NewCategoryUsecaseMock(t minimock.Tester) *CategoryUsecaseMock {
-	m := &CategoryUsecaseMock{t: t}
+// DeleteCategoryByIDAfterCounter returns a count of finished CategoryUsecaseMock.DeleteCategoryByID invocations
+func (mmDeleteCategoryByID *CategoryUsecaseMock) DeleteCategoryByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteCategoryByID.afterDeleteCategoryByIDCounter)
+}
 
-	if controller, ok := t.(minimock.MockController); ok {
-		controller.RegisterMocker(m)
+// DeleteCategoryByIDBeforeCounter returns a count of CategoryUsecaseMock.DeleteCategoryByID invocations
+func (mmDeleteCategoryByID *CategoryUsecaseMock) DeleteCategoryByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteCategoryByID.beforeDeleteCategoryByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to CategoryUsecaseMock.DeleteCategoryByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteCategoryByID *mCategoryUsecaseMockDeleteCategoryByID) Calls() []*CategoryUsecaseMockDeleteCategoryByIDParams {
+	mmDeleteCategoryByID.mutex.RLock()
+
+	argCopy := make([]*CategoryUsecaseMockDeleteCategoryByIDParams, len(mmDeleteCategoryByID.callArgs))
+	copy(argCopy, mmDeleteCategoryByID.callArgs)
+
+	mmDeleteCategoryByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteCategoryByIDDone returns true if the count of the DeleteCategoryByID invocations corresponds
+// the number of defined expectations
+func (m *CategoryUsecaseMock) MinimockDeleteCategoryByIDDone() bool {
+	if m.DeleteCategoryByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
 	}
 
-	m.FindListMock = mCategoryUsecaseMockFindList{mock: m}
-	m.FindListMock.callArgs = []*CategoryUsecaseMockFindListParams{}
+	for _, e := range m.DeleteCategoryByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
 
-	m.FindOneByIDMock = mCategoryUsecaseMockFindOneByID{mock: m}
-	m.FindOneByIDMock.callArgs = []*CategoryUsecaseMockFindOneByIDParams{}
+	return m.DeleteCategoryByIDMock.invocationsDone()
+}
 
-	t.Cleanup(m.MinimockFinish)
+// MinimockDeleteCategoryByIDInspect logs each unmet expectation
+func (m *CategoryUsecaseMock) MinimockDeleteCategoryByIDInspect() {
+	for _, e := range m.DeleteCategoryByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CategoryUsecaseMock.DeleteCategoryByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
 
-	return m
+	afterDeleteCategoryByIDCounter := mm_atomic.LoadUint64(&m.afterDeleteCategoryByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteCategoryByIDMock.defaultExpectation != nil && afterDeleteCategoryByIDCounter < 1 {
+		if m.DeleteCategoryByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CategoryUsecaseMock.DeleteCategoryByID at\n%s", m.DeleteCategoryByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CategoryUsecaseMock.DeleteCategoryByID at\n%s with params: %#v", m.DeleteCategoryByIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteCategoryByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteCategoryByID != nil && afterDeleteCategoryByIDCounter < 1 {
+		m.t.Errorf("Expected call to CategoryUsecaseMock.DeleteCategoryByID at\n%s", m.funcDeleteCategoryByIDOrigin)
+	}
+
+	if !m.DeleteCategoryByIDMock.invocationsDone() && afterDeleteCategoryByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to CategoryUsecaseMock.DeleteCategoryByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteCategoryByIDMock.expectedInvocations), m.DeleteCategoryByIDMock.expectedInvocationsOrigin, afterDeleteCategoryByIDCounter)
+	}
 }
 
 type mCategoryUsecaseMockFindList struct {
@@ -802,13 +1932,425 @@ func (m *CategoryUsecaseMock) MinimockFindOneByIDInspect() {
 	}
 }
 
+type mCategoryUsecaseMockPatchCategoryByDTO struct {
+	optional           bool
+	mock               *CategoryUsecaseMock
+	defaultExpectation *CategoryUsecaseMockPatchCategoryByDTOExpectation
+	expectations       []*CategoryUsecaseMockPatchCategoryByDTOExpectation
+
+	callArgs []*CategoryUsecaseMockPatchCategoryByDTOParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CategoryUsecaseMockPatchCategoryByDTOExpectation specifies expectation struct of the CategoryUsecase.PatchCategoryByDTO
+type CategoryUsecaseMockPatchCategoryByDTOExpectation struct {
+	mock               *CategoryUsecaseMock
+	params             *CategoryUsecaseMockPatchCategoryByDTOParams
+	paramPtrs          *CategoryUsecaseMockPatchCategoryByDTOParamPtrs
+	expectationOrigins CategoryUsecaseMockPatchCategoryByDTOExpectationOrigins
+	results            *CategoryUsecaseMockPatchCategoryByDTOResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CategoryUsecaseMockPatchCategoryByDTOParams contains parameters of the CategoryUsecase.PatchCategoryByDTO
+type CategoryUsecaseMockPatchCategoryByDTOParams struct {
+	ctx              context.Context
+	id               uint64
+	in               mm_usecase.PatchCategoryDataInput
+	skipVersionCheck bool
+}
+
+// CategoryUsecaseMockPatchCategoryByDTOParamPtrs contains pointers to parameters of the CategoryUsecase.PatchCategoryByDTO
+type CategoryUsecaseMockPatchCategoryByDTOParamPtrs struct {
+	ctx              *context.Context
+	id               *uint64
+	in               *mm_usecase.PatchCategoryDataInput
+	skipVersionCheck *bool
+}
+
+// CategoryUsecaseMockPatchCategoryByDTOResults contains results of the CategoryUsecase.PatchCategoryByDTO
+type CategoryUsecaseMockPatchCategoryByDTOResults struct {
+	err error
+}
+
+// CategoryUsecaseMockPatchCategoryByDTOOrigins contains origins of expectations of the CategoryUsecase.PatchCategoryByDTO
+type CategoryUsecaseMockPatchCategoryByDTOExpectationOrigins struct {
+	origin                 string
+	originCtx              string
+	originId               string
+	originIn               string
+	originSkipVersionCheck string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPatchCategoryByDTO *mCategoryUsecaseMockPatchCategoryByDTO) Optional() *mCategoryUsecaseMockPatchCategoryByDTO {
+	mmPatchCategoryByDTO.optional = true
+	return mmPatchCategoryByDTO
+}
+
+// Expect sets up expected params for CategoryUsecase.PatchCategoryByDTO
+func (mmPatchCategoryByDTO *mCategoryUsecaseMockPatchCategoryByDTO) Expect(ctx context.Context, id uint64, in mm_usecase.PatchCategoryDataInput, skipVersionCheck bool) *mCategoryUsecaseMockPatchCategoryByDTO {
+	if mmPatchCategoryByDTO.mock.funcPatchCategoryByDTO != nil {
+		mmPatchCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.PatchCategoryByDTO mock is already set by Set")
+	}
+
+	if mmPatchCategoryByDTO.defaultExpectation == nil {
+		mmPatchCategoryByDTO.defaultExpectation = &CategoryUsecaseMockPatchCategoryByDTOExpectation{}
+	}
+
+	if mmPatchCategoryByDTO.defaultExpectation.paramPtrs != nil {
+		mmPatchCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.PatchCategoryByDTO mock is already set by ExpectParams functions")
+	}
+
+	mmPatchCategoryByDTO.defaultExpectation.params = &CategoryUsecaseMockPatchCategoryByDTOParams{ctx, id, in, skipVersionCheck}
+	mmPatchCategoryByDTO.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPatchCategoryByDTO.expectations {
+		if minimock.Equal(e.params, mmPatchCategoryByDTO.defaultExpectation.params) {
+			mmPatchCategoryByDTO.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPatchCategoryByDTO.defaultExpectation.params)
+		}
+	}
+
+	return mmPatchCategoryByDTO
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CategoryUsecase.PatchCategoryByDTO
+func (mmPatchCategoryByDTO *mCategoryUsecaseMockPatchCategoryByDTO) ExpectCtxParam1(ctx context.Context) *mCategoryUsecaseMockPatchCategoryByDTO {
+	if mmPatchCategoryByDTO.mock.funcPatchCategoryByDTO != nil {
+		mmPatchCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.PatchCategoryByDTO mock is already set by Set")
+	}
+
+	if mmPatchCategoryByDTO.defaultExpectation == nil {
+		mmPatchCategoryByDTO.defaultExpectation = &CategoryUsecaseMockPatchCategoryByDTOExpectation{}
+	}
+
+	if mmPatchCategoryByDTO.defaultExpectation.params != nil {
+		mmPatchCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.PatchCategoryByDTO mock is already set by Expect")
+	}
+
+	if mmPatchCategoryByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchCategoryByDTO.defaultExpectation.paramPtrs = &CategoryUsecaseMockPatchCategoryByDTOParamPtrs{}
+	}
+	mmPatchCategoryByDTO.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPatchCategoryByDTO.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPatchCategoryByDTO
+}
+
+// ExpectIdParam2 sets up expected param id for CategoryUsecase.PatchCategoryByDTO
+func (mmPatchCategoryByDTO *mCategoryUsecaseMockPatchCategoryByDTO) ExpectIdParam2(id uint64) *mCategoryUsecaseMockPatchCategoryByDTO {
+	if mmPatchCategoryByDTO.mock.funcPatchCategoryByDTO != nil {
+		mmPatchCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.PatchCategoryByDTO mock is already set by Set")
+	}
+
+	if mmPatchCategoryByDTO.defaultExpectation == nil {
+		mmPatchCategoryByDTO.defaultExpectation = &CategoryUsecaseMockPatchCategoryByDTOExpectation{}
+	}
+
+	if mmPatchCategoryByDTO.defaultExpectation.params != nil {
+		mmPatchCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.PatchCategoryByDTO mock is already set by Expect")
+	}
+
+	if mmPatchCategoryByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchCategoryByDTO.defaultExpectation.paramPtrs = &CategoryUsecaseMockPatchCategoryByDTOParamPtrs{}
+	}
+	mmPatchCategoryByDTO.defaultExpectation.paramPtrs.id = &id
+	mmPatchCategoryByDTO.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmPatchCategoryByDTO
+}
+
+// ExpectInParam3 sets up expected param in for CategoryUsecase.PatchCategoryByDTO
+func (mmPatchCategoryByDTO *mCategoryUsecaseMockPatchCategoryByDTO) ExpectInParam3(in mm_usecase.PatchCategoryDataInput) *mCategoryUsecaseMockPatchCategoryByDTO {
+	if mmPatchCategoryByDTO.mock.funcPatchCategoryByDTO != nil {
+		mmPatchCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.PatchCategoryByDTO mock is already set by Set")
+	}
+
+	if mmPatchCategoryByDTO.defaultExpectation == nil {
+		mmPatchCategoryByDTO.defaultExpectation = &CategoryUsecaseMockPatchCategoryByDTOExpectation{}
+	}
+
+	if mmPatchCategoryByDTO.defaultExpectation.params != nil {
+		mmPatchCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.PatchCategoryByDTO mock is already set by Expect")
+	}
+
+	if mmPatchCategoryByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchCategoryByDTO.defaultExpectation.paramPtrs = &CategoryUsecaseMockPatchCategoryByDTOParamPtrs{}
+	}
+	mmPatchCategoryByDTO.defaultExpectation.paramPtrs.in = &in
+	mmPatchCategoryByDTO.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmPatchCategoryByDTO
+}
+
+// ExpectSkipVersionCheckParam4 sets up expected param skipVersionCheck for CategoryUsecase.PatchCategoryByDTO
+func (mmPatchCategoryByDTO *mCategoryUsecaseMockPatchCategoryByDTO) ExpectSkipVersionCheckParam4(skipVersionCheck bool) *mCategoryUsecaseMockPatchCategoryByDTO {
+	if mmPatchCategoryByDTO.mock.funcPatchCategoryByDTO != nil {
+		mmPatchCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.PatchCategoryByDTO mock is already set by Set")
+	}
+
+	if mmPatchCategoryByDTO.defaultExpectation == nil {
+		mmPatchCategoryByDTO.defaultExpectation = &CategoryUsecaseMockPatchCategoryByDTOExpectation{}
+	}
+
+	if mmPatchCategoryByDTO.defaultExpectation.params != nil {
+		mmPatchCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.PatchCategoryByDTO mock is already set by Expect")
+	}
+
+	if mmPatchCategoryByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchCategoryByDTO.defaultExpectation.paramPtrs = &CategoryUsecaseMockPatchCategoryByDTOParamPtrs{}
+	}
+	mmPatchCategoryByDTO.defaultExpectation.paramPtrs.skipVersionCheck = &skipVersionCheck
+	mmPatchCategoryByDTO.defaultExpectation.expectationOrigins.originSkipVersionCheck = minimock.CallerInfo(1)
+
+	return mmPatchCategoryByDTO
+}
+
+// Inspect accepts an inspector function that has same arguments as the CategoryUsecase.PatchCategoryByDTO
+func (mmPatchCategoryByDTO *mCategoryUsecaseMockPatchCategoryByDTO) Inspect(f func(ctx context.Context, id uint64, in mm_usecase.PatchCategoryDataInput, skipVersionCheck bool)) *mCategoryUsecaseMockPatchCategoryByDTO {
+	if mmPatchCategoryByDTO.mock.inspectFuncPatchCategoryByDTO != nil {
+		mmPatchCategoryByDTO.mock.t.Fatalf("Inspect function is already set for CategoryUsecaseMock.PatchCategoryByDTO")
+	}
+
+	mmPatchCategoryByDTO.mock.inspectFuncPatchCategoryByDTO = f
+
+	return mmPatchCategoryByDTO
+}
+
+// Return sets up results that will be returned by CategoryUsecase.PatchCategoryByDTO
+func (mmPatchCategoryByDTO *mCategoryUsecaseMockPatchCategoryByDTO) Return(err error) *CategoryUsecaseMock {
+	if mmPatchCategoryByDTO.mock.funcPatchCategoryByDTO != nil {
+		mmPatchCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.PatchCategoryByDTO mock is already set by Set")
+	}
+
+	if mmPatchCategoryByDTO.defaultExpectation == nil {
+		mmPatchCategoryByDTO.defaultExpectation = &CategoryUsecaseMockPatchCategoryByDTOExpectation{mock: mmPatchCategoryByDTO.mock}
+	}
+	mmPatchCategoryByDTO.defaultExpectation.results = &CategoryUsecaseMockPatchCategoryByDTOResults{err}
+	mmPatchCategoryByDTO.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPatchCategoryByDTO.mock
+}
+
+// Set uses given function f to mock the CategoryUsecase.PatchCategoryByDTO method
+func (mmPatchCategoryByDTO *mCategoryUsecaseMockPatchCategoryByDTO) Set(f func(ctx context.Context, id uint64, in mm_usecase.PatchCategoryDataInput, skipVersionCheck bool) (err error)) *CategoryUsecaseMock {
+	if mmPatchCategoryByDTO.defaultExpectation != nil {
+		mmPatchCategoryByDTO.mock.t.Fatalf("Default expectation is already set for the CategoryUsecase.PatchCategoryByDTO method")
+	}
+
+	if len(mmPatchCategoryByDTO.expectations) > 0 {
+		mmPatchCategoryByDTO.mock.t.Fatalf("Some expectations are already set for the CategoryUsecase.PatchCategoryByDTO method")
+	}
+
+	mmPatchCategoryByDTO.mock.funcPatchCategoryByDTO = f
+	mmPatchCategoryByDTO.mock.funcPatchCategoryByDTOOrigin = minimock.CallerInfo(1)
+	return mmPatchCategoryByDTO.mock
+}
+
+// When sets expectation for the CategoryUsecase.PatchCategoryByDTO which will trigger the result defined by the following
+// Then helper
+func (mmPatchCategoryByDTO *mCategoryUsecaseMockPatchCategoryByDTO) When(ctx context.Context, id uint64, in mm_usecase.PatchCategoryDataInput, skipVersionCheck bool) *CategoryUsecaseMockPatchCategoryByDTOExpectation {
+	if mmPatchCategoryByDTO.mock.funcPatchCategoryByDTO != nil {
+		mmPatchCategoryByDTO.mock.t.Fatalf("CategoryUsecaseMock.PatchCategoryByDTO mock is already set by Set")
+	}
+
+	expectation := &CategoryUsecaseMockPatchCategoryByDTOExpectation{
+		mock:               mmPatchCategoryByDTO.mock,
+		params:             &CategoryUsecaseMockPatchCategoryByDTOParams{ctx, id, in, skipVersionCheck},
+		expectationOrigins: CategoryUsecaseMockPatchCategoryByDTOExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPatchCategoryByDTO.expectations = append(mmPatchCategoryByDTO.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CategoryUsecase.PatchCategoryByDTO return parameters for the expectation previously defined by the When method
+func (e *CategoryUsecaseMockPatchCategoryByDTOExpectation) Then(err error) *CategoryUsecaseMock {
+	e.results = &CategoryUsecaseMockPatchCategoryByDTOResults{err}
+	return e.mock
+}
+
+// Times sets number of times CategoryUsecase.PatchCategoryByDTO should be invoked
+func (mmPatchCategoryByDTO *mCategoryUsecaseMockPatchCategoryByDTO) Times(n uint64) *mCategoryUsecaseMockPatchCategoryByDTO {
+	if n == 0 {
+		mmPatchCategoryByDTO.mock.t.Fatalf("Times of CategoryUsecaseMock.PatchCategoryByDTO mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPatchCategoryByDTO.expectedInvocations, n)
+	mmPatchCategoryByDTO.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPatchCategoryByDTO
+}
+
+func (mmPatchCategoryByDTO *mCategoryUsecaseMockPatchCategoryByDTO) invocationsDone() bool {
+	if len(mmPatchCategoryByDTO.expectations) == 0 && mmPatchCategoryByDTO.defaultExpectation == nil && mmPatchCategoryByDTO.mock.funcPatchCategoryByDTO == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPatchCategoryByDTO.mock.afterPatchCategoryByDTOCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPatchCategoryByDTO.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PatchCategoryByDTO implements mm_usecase.CategoryUsecase
+func (mmPatchCategoryByDTO *CategoryUsecaseMock) PatchCategoryByDTO(ctx context.Context, id uint64, in mm_usecase.PatchCategoryDataInput, skipVersionCheck bool) (err error) {
+	mm_atomic.AddUint64(&mmPatchCategoryByDTO.beforePatchCategoryByDTOCounter, 1)
+	defer mm_atomic.AddUint64(&mmPatchCategoryByDTO.afterPatchCategoryByDTOCounter, 1)
+
+	mmPatchCategoryByDTO.t.Helper()
+
+	if mmPatchCategoryByDTO.inspectFuncPatchCategoryByDTO != nil {
+		mmPatchCategoryByDTO.inspectFuncPatchCategoryByDTO(ctx, id, in, skipVersionCheck)
+	}
+
+	mm_params := CategoryUsecaseMockPatchCategoryByDTOParams{ctx, id, in, skipVersionCheck}
+
+	// Record call args
+	mmPatchCategoryByDTO.PatchCategoryByDTOMock.mutex.Lock()
+	mmPatchCategoryByDTO.PatchCategoryByDTOMock.callArgs = append(mmPatchCategoryByDTO.PatchCategoryByDTOMock.callArgs, &mm_params)
+	mmPatchCategoryByDTO.PatchCategoryByDTOMock.mutex.Unlock()
+
+	for _, e := range mmPatchCategoryByDTO.PatchCategoryByDTOMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPatchCategoryByDTO.PatchCategoryByDTOMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPatchCategoryByDTO.PatchCategoryByDTOMock.defaultExpectation.Counter, 1)
+		mm_want := mmPatchCategoryByDTO.PatchCategoryByDTOMock.defaultExpectation.params
+		mm_want_ptrs := mmPatchCategoryByDTO.PatchCategoryByDTOMock.defaultExpectation.paramPtrs
+
+		mm_got := CategoryUsecaseMockPatchCategoryByDTOParams{ctx, id, in, skipVersionCheck}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPatchCategoryByDTO.t.Errorf("CategoryUsecaseMock.PatchCategoryByDTO got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchCategoryByDTO.PatchCategoryByDTOMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmPatchCategoryByDTO.t.Errorf("CategoryUsecaseMock.PatchCategoryByDTO got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchCategoryByDTO.PatchCategoryByDTOMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmPatchCategoryByDTO.t.Errorf("CategoryUsecaseMock.PatchCategoryByDTO got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchCategoryByDTO.PatchCategoryByDTOMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+			if mm_want_ptrs.skipVersionCheck != nil && !minimock.Equal(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck) {
+				mmPatchCategoryByDTO.t.Errorf("CategoryUsecaseMock.PatchCategoryByDTO got unexpected parameter skipVersionCheck, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchCategoryByDTO.PatchCategoryByDTOMock.defaultExpectation.expectationOrigins.originSkipVersionCheck, *mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck, minimock.Diff(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPatchCategoryByDTO.t.Errorf("CategoryUsecaseMock.PatchCategoryByDTO got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPatchCategoryByDTO.PatchCategoryByDTOMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPatchCategoryByDTO.PatchCategoryByDTOMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPatchCategoryByDTO.t.Fatal("No results are set for the CategoryUsecaseMock.PatchCategoryByDTO")
+		}
+		return (*mm_results).err
+	}
+	if mmPatchCategoryByDTO.funcPatchCategoryByDTO != nil {
+		return mmPatchCategoryByDTO.funcPatchCategoryByDTO(ctx, id, in, skipVersionCheck)
+	}
+	mmPatchCategoryByDTO.t.Fatalf("Unexpected call to CategoryUsecaseMock.PatchCategoryByDTO. %v %v %v %v", ctx, id, in, skipVersionCheck)
+	return
+}
+
+// PatchCategoryByDTOAfterCounter returns a count of finished CategoryUsecaseMock.PatchCategoryByDTO invocations
+func (mmPatchCategoryByDTO *CategoryUsecaseMock) PatchCategoryByDTOAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPatchCategoryByDTO.afterPatchCategoryByDTOCounter)
+}
+
+// PatchCategoryByDTOBeforeCounter returns a count of CategoryUsecaseMock.PatchCategoryByDTO invocations
+func (mmPatchCategoryByDTO *CategoryUsecaseMock) PatchCategoryByDTOBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPatchCategoryByDTO.beforePatchCategoryByDTOCounter)
+}
+
+// Calls returns a list of arguments used in each call to CategoryUsecaseMock.PatchCategoryByDTO.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPatchCategoryByDTO *mCategoryUsecaseMockPatchCategoryByDTO) Calls() []*CategoryUsecaseMockPatchCategoryByDTOParams {
+	mmPatchCategoryByDTO.mutex.RLock()
+
+	argCopy := make([]*CategoryUsecaseMockPatchCategoryByDTOParams, len(mmPatchCategoryByDTO.callArgs))
+	copy(argCopy, mmPatchCategoryByDTO.callArgs)
+
+	mmPatchCategoryByDTO.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPatchCategoryByDTODone returns true if the count of the PatchCategoryByDTO invocations corresponds
+// the number of defined expectations
+func (m *CategoryUsecaseMock) MinimockPatchCategoryByDTODone() bool {
+	if m.PatchCategoryByDTOMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PatchCategoryByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PatchCategoryByDTOMock.invocationsDone()
+}
+
+// MinimockPatchCategoryByDTOInspect logs each unmet expectation
+func (m *CategoryUsecaseMock) MinimockPatchCategoryByDTOInspect() {
+	for _, e := range m.PatchCategoryByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CategoryUsecaseMock.PatchCategoryByDTO at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPatchCategoryByDTOCounter := mm_atomic.LoadUint64(&m.afterPatchCategoryByDTOCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PatchCategoryByDTOMock.defaultExpectation != nil && afterPatchCategoryByDTOCounter < 1 {
+		if m.PatchCategoryByDTOMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CategoryUsecaseMock.PatchCategoryByDTO at\n%s", m.PatchCategoryByDTOMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CategoryUsecaseMock.PatchCategoryByDTO at\n%s with params: %#v", m.PatchCategoryByDTOMock.defaultExpectation.expectationOrigins.origin, *m.PatchCategoryByDTOMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPatchCategoryByDTO != nil && afterPatchCategoryByDTOCounter < 1 {
+		m.t.Errorf("Expected call to CategoryUsecaseMock.PatchCategoryByDTO at\n%s", m.funcPatchCategoryByDTOOrigin)
+	}
+
+	if !m.PatchCategoryByDTOMock.invocationsDone() && afterPatchCategoryByDTOCounter > 0 {
+		m.t.Errorf("Expected %d calls to CategoryUsecaseMock.PatchCategoryByDTO at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PatchCategoryByDTOMock.expectedInvocations), m.PatchCategoryByDTOMock.expectedInvocationsOrigin, afterPatchCategoryByDTOCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *CategoryUsecaseMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockCheckCategoryAccessInspect()
+
+			m.MinimockCreateCategoryByDTOInspect()
+
+			m.MinimockDeleteCategoryByIDInspect()
+
 			m.MinimockFindListInspect()
 
 			m.MinimockFindOneByIDInspect()
+
+			m.MinimockPatchCategoryByDTOInspect()
 		}
 	})
 }
@@ -832,6 +2374,10 @@ func (m *CategoryUsecaseMock) MinimockWait(timeout mm_time.Duration) {
 func (m *CategoryUsecaseMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockCheckCategoryAccessDone() &&
+		m.MinimockCreateCategoryByDTODone() &&
+		m.MinimockDeleteCategoryByIDDone() &&
 		m.MinimockFindListDone() &&
-		m.MinimockFindOneByIDDone()
+		m.MinimockFindOneByIDDone() &&
+		m.MinimockPatchCategoryByDTODone()
 }
@@ -0,0 +1,1581 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.CurrencyUsecase -o currency_usecase.go -n CurrencyUsecaseMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	mm_usecase "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// CurrencyUsecaseMock implements mm_usecase.CurrencyUsecase
+type CurrencyUsecaseMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcFindExchangeRates          func(ctx context.Context, listOptions *mm_usecase.ExchangeRateListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*entity.ExchangeRate, err error)
+	funcFindExchangeRatesOrigin    string
+	inspectFuncFindExchangeRates   func(ctx context.Context, listOptions *mm_usecase.ExchangeRateListOptions, queryParams *uctypes.QueryGetListParams)
+	afterFindExchangeRatesCounter  uint64
+	beforeFindExchangeRatesCounter uint64
+	FindExchangeRatesMock          mCurrencyUsecaseMockFindExchangeRates
+
+	funcGetBaseCurrency          func(ctx context.Context, accountID uuid.UUID) (resCurrency string, err error)
+	funcGetBaseCurrencyOrigin    string
+	inspectFuncGetBaseCurrency   func(ctx context.Context, accountID uuid.UUID)
+	afterGetBaseCurrencyCounter  uint64
+	beforeGetBaseCurrencyCounter uint64
+	GetBaseCurrencyMock          mCurrencyUsecaseMockGetBaseCurrency
+
+	funcSetBaseCurrency          func(ctx context.Context, accountID uuid.UUID, currency string) (err error)
+	funcSetBaseCurrencyOrigin    string
+	inspectFuncSetBaseCurrency   func(ctx context.Context, accountID uuid.UUID, currency string)
+	afterSetBaseCurrencyCounter  uint64
+	beforeSetBaseCurrencyCounter uint64
+	SetBaseCurrencyMock          mCurrencyUsecaseMockSetBaseCurrency
+
+	funcUpsertExchangeRates          func(ctx context.Context, accountID uuid.UUID, in []mm_usecase.UpsertExchangeRateDataInput) (resItems []*entity.ExchangeRate, err error)
+	funcUpsertExchangeRatesOrigin    string
+	inspectFuncUpsertExchangeRates   func(ctx context.Context, accountID uuid.UUID, in []mm_usecase.UpsertExchangeRateDataInput)
+	afterUpsertExchangeRatesCounter  uint64
+	beforeUpsertExchangeRatesCounter uint64
+	UpsertExchangeRatesMock          mCurrencyUsecaseMockUpsertExchangeRates
+}
+
+// NewCurrencyUsecaseMock returns a mock for mm_usecase.CurrencyUsecase
+func NewCurrencyUsecaseMock(t minimock.Tester) *CurrencyUsecaseMock {
+	m := &CurrencyUsecaseMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.FindExchangeRatesMock = mCurrencyUsecaseMockFindExchangeRates{mock: m}
+	m.FindExchangeRatesMock.callArgs = []*CurrencyUsecaseMockFindExchangeRatesParams{}
+
+	m.GetBaseCurrencyMock = mCurrencyUsecaseMockGetBaseCurrency{mock: m}
+	m.GetBaseCurrencyMock.callArgs = []*CurrencyUsecaseMockGetBaseCurrencyParams{}
+
+	m.SetBaseCurrencyMock = mCurrencyUsecaseMockSetBaseCurrency{mock: m}
+	m.SetBaseCurrencyMock.callArgs = []*CurrencyUsecaseMockSetBaseCurrencyParams{}
+
+	m.UpsertExchangeRatesMock = mCurrencyUsecaseMockUpsertExchangeRates{mock: m}
+	m.UpsertExchangeRatesMock.callArgs = []*CurrencyUsecaseMockUpsertExchangeRatesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCurrencyUsecaseMockFindExchangeRates struct {
+	optional           bool
+	mock               *CurrencyUsecaseMock
+	defaultExpectation *CurrencyUsecaseMockFindExchangeRatesExpectation
+	expectations       []*CurrencyUsecaseMockFindExchangeRatesExpectation
+
+	callArgs []*CurrencyUsecaseMockFindExchangeRatesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CurrencyUsecaseMockFindExchangeRatesExpectation specifies expectation struct of the CurrencyUsecase.FindExchangeRates
+type CurrencyUsecaseMockFindExchangeRatesExpectation struct {
+	mock               *CurrencyUsecaseMock
+	params             *CurrencyUsecaseMockFindExchangeRatesParams
+	paramPtrs          *CurrencyUsecaseMockFindExchangeRatesParamPtrs
+	expectationOrigins CurrencyUsecaseMockFindExchangeRatesExpectationOrigins
+	results            *CurrencyUsecaseMockFindExchangeRatesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CurrencyUsecaseMockFindExchangeRatesParams contains parameters of the CurrencyUsecase.FindExchangeRates
+type CurrencyUsecaseMockFindExchangeRatesParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.ExchangeRateListOptions
+	queryParams *uctypes.QueryGetListParams
+}
+
+// CurrencyUsecaseMockFindExchangeRatesParamPtrs contains pointers to parameters of the CurrencyUsecase.FindExchangeRates
+type CurrencyUsecaseMockFindExchangeRatesParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.ExchangeRateListOptions
+	queryParams **uctypes.QueryGetListParams
+}
+
+// CurrencyUsecaseMockFindExchangeRatesResults contains results of the CurrencyUsecase.FindExchangeRates
+type CurrencyUsecaseMockFindExchangeRatesResults struct {
+	resItems []*entity.ExchangeRate
+	err      error
+}
+
+// CurrencyUsecaseMockFindExchangeRatesOrigins contains origins of expectations of the CurrencyUsecase.FindExchangeRates
+type CurrencyUsecaseMockFindExchangeRatesExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindExchangeRates *mCurrencyUsecaseMockFindExchangeRates) Optional() *mCurrencyUsecaseMockFindExchangeRates {
+	mmFindExchangeRates.optional = true
+	return mmFindExchangeRates
+}
+
+// Expect sets up expected params for CurrencyUsecase.FindExchangeRates
+func (mmFindExchangeRates *mCurrencyUsecaseMockFindExchangeRates) Expect(ctx context.Context, listOptions *mm_usecase.ExchangeRateListOptions, queryParams *uctypes.QueryGetListParams) *mCurrencyUsecaseMockFindExchangeRates {
+	if mmFindExchangeRates.mock.funcFindExchangeRates != nil {
+		mmFindExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.FindExchangeRates mock is already set by Set")
+	}
+
+	if mmFindExchangeRates.defaultExpectation == nil {
+		mmFindExchangeRates.defaultExpectation = &CurrencyUsecaseMockFindExchangeRatesExpectation{}
+	}
+
+	if mmFindExchangeRates.defaultExpectation.paramPtrs != nil {
+		mmFindExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.FindExchangeRates mock is already set by ExpectParams functions")
+	}
+
+	mmFindExchangeRates.defaultExpectation.params = &CurrencyUsecaseMockFindExchangeRatesParams{ctx, listOptions, queryParams}
+	mmFindExchangeRates.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindExchangeRates.expectations {
+		if minimock.Equal(e.params, mmFindExchangeRates.defaultExpectation.params) {
+			mmFindExchangeRates.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindExchangeRates.defaultExpectation.params)
+		}
+	}
+
+	return mmFindExchangeRates
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CurrencyUsecase.FindExchangeRates
+func (mmFindExchangeRates *mCurrencyUsecaseMockFindExchangeRates) ExpectCtxParam1(ctx context.Context) *mCurrencyUsecaseMockFindExchangeRates {
+	if mmFindExchangeRates.mock.funcFindExchangeRates != nil {
+		mmFindExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.FindExchangeRates mock is already set by Set")
+	}
+
+	if mmFindExchangeRates.defaultExpectation == nil {
+		mmFindExchangeRates.defaultExpectation = &CurrencyUsecaseMockFindExchangeRatesExpectation{}
+	}
+
+	if mmFindExchangeRates.defaultExpectation.params != nil {
+		mmFindExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.FindExchangeRates mock is already set by Expect")
+	}
+
+	if mmFindExchangeRates.defaultExpectation.paramPtrs == nil {
+		mmFindExchangeRates.defaultExpectation.paramPtrs = &CurrencyUsecaseMockFindExchangeRatesParamPtrs{}
+	}
+	mmFindExchangeRates.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindExchangeRates.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindExchangeRates
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for CurrencyUsecase.FindExchangeRates
+func (mmFindExchangeRates *mCurrencyUsecaseMockFindExchangeRates) ExpectListOptionsParam2(listOptions *mm_usecase.ExchangeRateListOptions) *mCurrencyUsecaseMockFindExchangeRates {
+	if mmFindExchangeRates.mock.funcFindExchangeRates != nil {
+		mmFindExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.FindExchangeRates mock is already set by Set")
+	}
+
+	if mmFindExchangeRates.defaultExpectation == nil {
+		mmFindExchangeRates.defaultExpectation = &CurrencyUsecaseMockFindExchangeRatesExpectation{}
+	}
+
+	if mmFindExchangeRates.defaultExpectation.params != nil {
+		mmFindExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.FindExchangeRates mock is already set by Expect")
+	}
+
+	if mmFindExchangeRates.defaultExpectation.paramPtrs == nil {
+		mmFindExchangeRates.defaultExpectation.paramPtrs = &CurrencyUsecaseMockFindExchangeRatesParamPtrs{}
+	}
+	mmFindExchangeRates.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmFindExchangeRates.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmFindExchangeRates
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for CurrencyUsecase.FindExchangeRates
+func (mmFindExchangeRates *mCurrencyUsecaseMockFindExchangeRates) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mCurrencyUsecaseMockFindExchangeRates {
+	if mmFindExchangeRates.mock.funcFindExchangeRates != nil {
+		mmFindExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.FindExchangeRates mock is already set by Set")
+	}
+
+	if mmFindExchangeRates.defaultExpectation == nil {
+		mmFindExchangeRates.defaultExpectation = &CurrencyUsecaseMockFindExchangeRatesExpectation{}
+	}
+
+	if mmFindExchangeRates.defaultExpectation.params != nil {
+		mmFindExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.FindExchangeRates mock is already set by Expect")
+	}
+
+	if mmFindExchangeRates.defaultExpectation.paramPtrs == nil {
+		mmFindExchangeRates.defaultExpectation.paramPtrs = &CurrencyUsecaseMockFindExchangeRatesParamPtrs{}
+	}
+	mmFindExchangeRates.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindExchangeRates.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindExchangeRates
+}
+
+// Inspect accepts an inspector function that has same arguments as the CurrencyUsecase.FindExchangeRates
+func (mmFindExchangeRates *mCurrencyUsecaseMockFindExchangeRates) Inspect(f func(ctx context.Context, listOptions *mm_usecase.ExchangeRateListOptions, queryParams *uctypes.QueryGetListParams)) *mCurrencyUsecaseMockFindExchangeRates {
+	if mmFindExchangeRates.mock.inspectFuncFindExchangeRates != nil {
+		mmFindExchangeRates.mock.t.Fatalf("Inspect function is already set for CurrencyUsecaseMock.FindExchangeRates")
+	}
+
+	mmFindExchangeRates.mock.inspectFuncFindExchangeRates = f
+
+	return mmFindExchangeRates
+}
+
+// Return sets up results that will be returned by CurrencyUsecase.FindExchangeRates
+func (mmFindExchangeRates *mCurrencyUsecaseMockFindExchangeRates) Return(resItems []*entity.ExchangeRate, err error) *CurrencyUsecaseMock {
+	if mmFindExchangeRates.mock.funcFindExchangeRates != nil {
+		mmFindExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.FindExchangeRates mock is already set by Set")
+	}
+
+	if mmFindExchangeRates.defaultExpectation == nil {
+		mmFindExchangeRates.defaultExpectation = &CurrencyUsecaseMockFindExchangeRatesExpectation{mock: mmFindExchangeRates.mock}
+	}
+	mmFindExchangeRates.defaultExpectation.results = &CurrencyUsecaseMockFindExchangeRatesResults{resItems, err}
+	mmFindExchangeRates.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindExchangeRates.mock
+}
+
+// Set uses given function f to mock the CurrencyUsecase.FindExchangeRates method
+func (mmFindExchangeRates *mCurrencyUsecaseMockFindExchangeRates) Set(f func(ctx context.Context, listOptions *mm_usecase.ExchangeRateListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*entity.ExchangeRate, err error)) *CurrencyUsecaseMock {
+	if mmFindExchangeRates.defaultExpectation != nil {
+		mmFindExchangeRates.mock.t.Fatalf("Default expectation is already set for the CurrencyUsecase.FindExchangeRates method")
+	}
+
+	if len(mmFindExchangeRates.expectations) > 0 {
+		mmFindExchangeRates.mock.t.Fatalf("Some expectations are already set for the CurrencyUsecase.FindExchangeRates method")
+	}
+
+	mmFindExchangeRates.mock.funcFindExchangeRates = f
+	mmFindExchangeRates.mock.funcFindExchangeRatesOrigin = minimock.CallerInfo(1)
+	return mmFindExchangeRates.mock
+}
+
+// When sets expectation for the CurrencyUsecase.FindExchangeRates which will trigger the result defined by the following
+// Then helper
+func (mmFindExchangeRates *mCurrencyUsecaseMockFindExchangeRates) When(ctx context.Context, listOptions *mm_usecase.ExchangeRateListOptions, queryParams *uctypes.QueryGetListParams) *CurrencyUsecaseMockFindExchangeRatesExpectation {
+	if mmFindExchangeRates.mock.funcFindExchangeRates != nil {
+		mmFindExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.FindExchangeRates mock is already set by Set")
+	}
+
+	expectation := &CurrencyUsecaseMockFindExchangeRatesExpectation{
+		mock:               mmFindExchangeRates.mock,
+		params:             &CurrencyUsecaseMockFindExchangeRatesParams{ctx, listOptions, queryParams},
+		expectationOrigins: CurrencyUsecaseMockFindExchangeRatesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindExchangeRates.expectations = append(mmFindExchangeRates.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CurrencyUsecase.FindExchangeRates return parameters for the expectation previously defined by the When method
+func (e *CurrencyUsecaseMockFindExchangeRatesExpectation) Then(resItems []*entity.ExchangeRate, err error) *CurrencyUsecaseMock {
+	e.results = &CurrencyUsecaseMockFindExchangeRatesResults{resItems, err}
+	return e.mock
+}
+
+// Times sets number of times CurrencyUsecase.FindExchangeRates should be invoked
+func (mmFindExchangeRates *mCurrencyUsecaseMockFindExchangeRates) Times(n uint64) *mCurrencyUsecaseMockFindExchangeRates {
+	if n == 0 {
+		mmFindExchangeRates.mock.t.Fatalf("Times of CurrencyUsecaseMock.FindExchangeRates mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindExchangeRates.expectedInvocations, n)
+	mmFindExchangeRates.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindExchangeRates
+}
+
+func (mmFindExchangeRates *mCurrencyUsecaseMockFindExchangeRates) invocationsDone() bool {
+	if len(mmFindExchangeRates.expectations) == 0 && mmFindExchangeRates.defaultExpectation == nil && mmFindExchangeRates.mock.funcFindExchangeRates == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindExchangeRates.mock.afterFindExchangeRatesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindExchangeRates.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindExchangeRates implements mm_usecase.CurrencyUsecase
+func (mmFindExchangeRates *CurrencyUsecaseMock) FindExchangeRates(ctx context.Context, listOptions *mm_usecase.ExchangeRateListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*entity.ExchangeRate, err error) {
+	mm_atomic.AddUint64(&mmFindExchangeRates.beforeFindExchangeRatesCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindExchangeRates.afterFindExchangeRatesCounter, 1)
+
+	mmFindExchangeRates.t.Helper()
+
+	if mmFindExchangeRates.inspectFuncFindExchangeRates != nil {
+		mmFindExchangeRates.inspectFuncFindExchangeRates(ctx, listOptions, queryParams)
+	}
+
+	mm_params := CurrencyUsecaseMockFindExchangeRatesParams{ctx, listOptions, queryParams}
+
+	// Record call args
+	mmFindExchangeRates.FindExchangeRatesMock.mutex.Lock()
+	mmFindExchangeRates.FindExchangeRatesMock.callArgs = append(mmFindExchangeRates.FindExchangeRatesMock.callArgs, &mm_params)
+	mmFindExchangeRates.FindExchangeRatesMock.mutex.Unlock()
+
+	for _, e := range mmFindExchangeRates.FindExchangeRatesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resItems, e.results.err
+		}
+	}
+
+	if mmFindExchangeRates.FindExchangeRatesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindExchangeRates.FindExchangeRatesMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindExchangeRates.FindExchangeRatesMock.defaultExpectation.params
+		mm_want_ptrs := mmFindExchangeRates.FindExchangeRatesMock.defaultExpectation.paramPtrs
+
+		mm_got := CurrencyUsecaseMockFindExchangeRatesParams{ctx, listOptions, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindExchangeRates.t.Errorf("CurrencyUsecaseMock.FindExchangeRates got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindExchangeRates.FindExchangeRatesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmFindExchangeRates.t.Errorf("CurrencyUsecaseMock.FindExchangeRates got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindExchangeRates.FindExchangeRatesMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindExchangeRates.t.Errorf("CurrencyUsecaseMock.FindExchangeRates got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindExchangeRates.FindExchangeRatesMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindExchangeRates.t.Errorf("CurrencyUsecaseMock.FindExchangeRates got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindExchangeRates.FindExchangeRatesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindExchangeRates.FindExchangeRatesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindExchangeRates.t.Fatal("No results are set for the CurrencyUsecaseMock.FindExchangeRates")
+		}
+		return (*mm_results).resItems, (*mm_results).err
+	}
+	if mmFindExchangeRates.funcFindExchangeRates != nil {
+		return mmFindExchangeRates.funcFindExchangeRates(ctx, listOptions, queryParams)
+	}
+	mmFindExchangeRates.t.Fatalf("Unexpected call to CurrencyUsecaseMock.FindExchangeRates. %v %v %v", ctx, listOptions, queryParams)
+	return
+}
+
+// FindExchangeRatesAfterCounter returns a count of finished CurrencyUsecaseMock.FindExchangeRates invocations
+func (mmFindExchangeRates *CurrencyUsecaseMock) FindExchangeRatesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindExchangeRates.afterFindExchangeRatesCounter)
+}
+
+// FindExchangeRatesBeforeCounter returns a count of CurrencyUsecaseMock.FindExchangeRates invocations
+func (mmFindExchangeRates *CurrencyUsecaseMock) FindExchangeRatesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindExchangeRates.beforeFindExchangeRatesCounter)
+}
+
+// Calls returns a list of arguments used in each call to CurrencyUsecaseMock.FindExchangeRates.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindExchangeRates *mCurrencyUsecaseMockFindExchangeRates) Calls() []*CurrencyUsecaseMockFindExchangeRatesParams {
+	mmFindExchangeRates.mutex.RLock()
+
+	argCopy := make([]*CurrencyUsecaseMockFindExchangeRatesParams, len(mmFindExchangeRates.callArgs))
+	copy(argCopy, mmFindExchangeRates.callArgs)
+
+	mmFindExchangeRates.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindExchangeRatesDone returns true if the count of the FindExchangeRates invocations corresponds
+// the number of defined expectations
+func (m *CurrencyUsecaseMock) MinimockFindExchangeRatesDone() bool {
+	if m.FindExchangeRatesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindExchangeRatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindExchangeRatesMock.invocationsDone()
+}
+
+// MinimockFindExchangeRatesInspect logs each unmet expectation
+func (m *CurrencyUsecaseMock) MinimockFindExchangeRatesInspect() {
+	for _, e := range m.FindExchangeRatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CurrencyUsecaseMock.FindExchangeRates at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindExchangeRatesCounter := mm_atomic.LoadUint64(&m.afterFindExchangeRatesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindExchangeRatesMock.defaultExpectation != nil && afterFindExchangeRatesCounter < 1 {
+		if m.FindExchangeRatesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CurrencyUsecaseMock.FindExchangeRates at\n%s", m.FindExchangeRatesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CurrencyUsecaseMock.FindExchangeRates at\n%s with params: %#v", m.FindExchangeRatesMock.defaultExpectation.expectationOrigins.origin, *m.FindExchangeRatesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindExchangeRates != nil && afterFindExchangeRatesCounter < 1 {
+		m.t.Errorf("Expected call to CurrencyUsecaseMock.FindExchangeRates at\n%s", m.funcFindExchangeRatesOrigin)
+	}
+
+	if !m.FindExchangeRatesMock.invocationsDone() && afterFindExchangeRatesCounter > 0 {
+		m.t.Errorf("Expected %d calls to CurrencyUsecaseMock.FindExchangeRates at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindExchangeRatesMock.expectedInvocations), m.FindExchangeRatesMock.expectedInvocationsOrigin, afterFindExchangeRatesCounter)
+	}
+}
+
+type mCurrencyUsecaseMockGetBaseCurrency struct {
+	optional           bool
+	mock               *CurrencyUsecaseMock
+	defaultExpectation *CurrencyUsecaseMockGetBaseCurrencyExpectation
+	expectations       []*CurrencyUsecaseMockGetBaseCurrencyExpectation
+
+	callArgs []*CurrencyUsecaseMockGetBaseCurrencyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CurrencyUsecaseMockGetBaseCurrencyExpectation specifies expectation struct of the CurrencyUsecase.GetBaseCurrency
+type CurrencyUsecaseMockGetBaseCurrencyExpectation struct {
+	mock               *CurrencyUsecaseMock
+	params             *CurrencyUsecaseMockGetBaseCurrencyParams
+	paramPtrs          *CurrencyUsecaseMockGetBaseCurrencyParamPtrs
+	expectationOrigins CurrencyUsecaseMockGetBaseCurrencyExpectationOrigins
+	results            *CurrencyUsecaseMockGetBaseCurrencyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CurrencyUsecaseMockGetBaseCurrencyParams contains parameters of the CurrencyUsecase.GetBaseCurrency
+type CurrencyUsecaseMockGetBaseCurrencyParams struct {
+	ctx       context.Context
+	accountID uuid.UUID
+}
+
+// CurrencyUsecaseMockGetBaseCurrencyParamPtrs contains pointers to parameters of the CurrencyUsecase.GetBaseCurrency
+type CurrencyUsecaseMockGetBaseCurrencyParamPtrs struct {
+	ctx       *context.Context
+	accountID *uuid.UUID
+}
+
+// CurrencyUsecaseMockGetBaseCurrencyResults contains results of the CurrencyUsecase.GetBaseCurrency
+type CurrencyUsecaseMockGetBaseCurrencyResults struct {
+	resCurrency string
+	err         error
+}
+
+// CurrencyUsecaseMockGetBaseCurrencyOrigins contains origins of expectations of the CurrencyUsecase.GetBaseCurrency
+type CurrencyUsecaseMockGetBaseCurrencyExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originAccountID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetBaseCurrency *mCurrencyUsecaseMockGetBaseCurrency) Optional() *mCurrencyUsecaseMockGetBaseCurrency {
+	mmGetBaseCurrency.optional = true
+	return mmGetBaseCurrency
+}
+
+// Expect sets up expected params for CurrencyUsecase.GetBaseCurrency
+func (mmGetBaseCurrency *mCurrencyUsecaseMockGetBaseCurrency) Expect(ctx context.Context, accountID uuid.UUID) *mCurrencyUsecaseMockGetBaseCurrency {
+	if mmGetBaseCurrency.mock.funcGetBaseCurrency != nil {
+		mmGetBaseCurrency.mock.t.Fatalf("CurrencyUsecaseMock.GetBaseCurrency mock is already set by Set")
+	}
+
+	if mmGetBaseCurrency.defaultExpectation == nil {
+		mmGetBaseCurrency.defaultExpectation = &CurrencyUsecaseMockGetBaseCurrencyExpectation{}
+	}
+
+	if mmGetBaseCurrency.defaultExpectation.paramPtrs != nil {
+		mmGetBaseCurrency.mock.t.Fatalf("CurrencyUsecaseMock.GetBaseCurrency mock is already set by ExpectParams functions")
+	}
+
+	mmGetBaseCurrency.defaultExpectation.params = &CurrencyUsecaseMockGetBaseCurrencyParams{ctx, accountID}
+	mmGetBaseCurrency.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetBaseCurrency.expectations {
+		if minimock.Equal(e.params, mmGetBaseCurrency.defaultExpectation.params) {
+			mmGetBaseCurrency.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBaseCurrency.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBaseCurrency
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CurrencyUsecase.GetBaseCurrency
+func (mmGetBaseCurrency *mCurrencyUsecaseMockGetBaseCurrency) ExpectCtxParam1(ctx context.Context) *mCurrencyUsecaseMockGetBaseCurrency {
+	if mmGetBaseCurrency.mock.funcGetBaseCurrency != nil {
+		mmGetBaseCurrency.mock.t.Fatalf("CurrencyUsecaseMock.GetBaseCurrency mock is already set by Set")
+	}
+
+	if mmGetBaseCurrency.defaultExpectation == nil {
+		mmGetBaseCurrency.defaultExpectation = &CurrencyUsecaseMockGetBaseCurrencyExpectation{}
+	}
+
+	if mmGetBaseCurrency.defaultExpectation.params != nil {
+		mmGetBaseCurrency.mock.t.Fatalf("CurrencyUsecaseMock.GetBaseCurrency mock is already set by Expect")
+	}
+
+	if mmGetBaseCurrency.defaultExpectation.paramPtrs == nil {
+		mmGetBaseCurrency.defaultExpectation.paramPtrs = &CurrencyUsecaseMockGetBaseCurrencyParamPtrs{}
+	}
+	mmGetBaseCurrency.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetBaseCurrency.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetBaseCurrency
+}
+
+// ExpectAccountIDParam2 sets up expected param accountID for CurrencyUsecase.GetBaseCurrency
+func (mmGetBaseCurrency *mCurrencyUsecaseMockGetBaseCurrency) ExpectAccountIDParam2(accountID uuid.UUID) *mCurrencyUsecaseMockGetBaseCurrency {
+	if mmGetBaseCurrency.mock.funcGetBaseCurrency != nil {
+		mmGetBaseCurrency.mock.t.Fatalf("CurrencyUsecaseMock.GetBaseCurrency mock is already set by Set")
+	}
+
+	if mmGetBaseCurrency.defaultExpectation == nil {
+		mmGetBaseCurrency.defaultExpectation = &CurrencyUsecaseMockGetBaseCurrencyExpectation{}
+	}
+
+	if mmGetBaseCurrency.defaultExpectation.params != nil {
+		mmGetBaseCurrency.mock.t.Fatalf("CurrencyUsecaseMock.GetBaseCurrency mock is already set by Expect")
+	}
+
+	if mmGetBaseCurrency.defaultExpectation.paramPtrs == nil {
+		mmGetBaseCurrency.defaultExpectation.paramPtrs = &CurrencyUsecaseMockGetBaseCurrencyParamPtrs{}
+	}
+	mmGetBaseCurrency.defaultExpectation.paramPtrs.accountID = &accountID
+	mmGetBaseCurrency.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+
+	return mmGetBaseCurrency
+}
+
+// Inspect accepts an inspector function that has same arguments as the CurrencyUsecase.GetBaseCurrency
+func (mmGetBaseCurrency *mCurrencyUsecaseMockGetBaseCurrency) Inspect(f func(ctx context.Context, accountID uuid.UUID)) *mCurrencyUsecaseMockGetBaseCurrency {
+	if mmGetBaseCurrency.mock.inspectFuncGetBaseCurrency != nil {
+		mmGetBaseCurrency.mock.t.Fatalf("Inspect function is already set for CurrencyUsecaseMock.GetBaseCurrency")
+	}
+
+	mmGetBaseCurrency.mock.inspectFuncGetBaseCurrency = f
+
+	return mmGetBaseCurrency
+}
+
+// Return sets up results that will be returned by CurrencyUsecase.GetBaseCurrency
+func (mmGetBaseCurrency *mCurrencyUsecaseMockGetBaseCurrency) Return(resCurrency string, err error) *CurrencyUsecaseMock {
+	if mmGetBaseCurrency.mock.funcGetBaseCurrency != nil {
+		mmGetBaseCurrency.mock.t.Fatalf("CurrencyUsecaseMock.GetBaseCurrency mock is already set by Set")
+	}
+
+	if mmGetBaseCurrency.defaultExpectation == nil {
+		mmGetBaseCurrency.defaultExpectation = &CurrencyUsecaseMockGetBaseCurrencyExpectation{mock: mmGetBaseCurrency.mock}
+	}
+	mmGetBaseCurrency.defaultExpectation.results = &CurrencyUsecaseMockGetBaseCurrencyResults{resCurrency, err}
+	mmGetBaseCurrency.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetBaseCurrency.mock
+}
+
+// Set uses given function f to mock the CurrencyUsecase.GetBaseCurrency method
+func (mmGetBaseCurrency *mCurrencyUsecaseMockGetBaseCurrency) Set(f func(ctx context.Context, accountID uuid.UUID) (resCurrency string, err error)) *CurrencyUsecaseMock {
+	if mmGetBaseCurrency.defaultExpectation != nil {
+		mmGetBaseCurrency.mock.t.Fatalf("Default expectation is already set for the CurrencyUsecase.GetBaseCurrency method")
+	}
+
+	if len(mmGetBaseCurrency.expectations) > 0 {
+		mmGetBaseCurrency.mock.t.Fatalf("Some expectations are already set for the CurrencyUsecase.GetBaseCurrency method")
+	}
+
+	mmGetBaseCurrency.mock.funcGetBaseCurrency = f
+	mmGetBaseCurrency.mock.funcGetBaseCurrencyOrigin = minimock.CallerInfo(1)
+	return mmGetBaseCurrency.mock
+}
+
+// When sets expectation for the CurrencyUsecase.GetBaseCurrency which will trigger the result defined by the following
+// Then helper
+func (mmGetBaseCurrency *mCurrencyUsecaseMockGetBaseCurrency) When(ctx context.Context, accountID uuid.UUID) *CurrencyUsecaseMockGetBaseCurrencyExpectation {
+	if mmGetBaseCurrency.mock.funcGetBaseCurrency != nil {
+		mmGetBaseCurrency.mock.t.Fatalf("CurrencyUsecaseMock.GetBaseCurrency mock is already set by Set")
+	}
+
+	expectation := &CurrencyUsecaseMockGetBaseCurrencyExpectation{
+		mock:               mmGetBaseCurrency.mock,
+		params:             &CurrencyUsecaseMockGetBaseCurrencyParams{ctx, accountID},
+		expectationOrigins: CurrencyUsecaseMockGetBaseCurrencyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetBaseCurrency.expectations = append(mmGetBaseCurrency.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CurrencyUsecase.GetBaseCurrency return parameters for the expectation previously defined by the When method
+func (e *CurrencyUsecaseMockGetBaseCurrencyExpectation) Then(resCurrency string, err error) *CurrencyUsecaseMock {
+	e.results = &CurrencyUsecaseMockGetBaseCurrencyResults{resCurrency, err}
+	return e.mock
+}
+
+// Times sets number of times CurrencyUsecase.GetBaseCurrency should be invoked
+func (mmGetBaseCurrency *mCurrencyUsecaseMockGetBaseCurrency) Times(n uint64) *mCurrencyUsecaseMockGetBaseCurrency {
+	if n == 0 {
+		mmGetBaseCurrency.mock.t.Fatalf("Times of CurrencyUsecaseMock.GetBaseCurrency mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetBaseCurrency.expectedInvocations, n)
+	mmGetBaseCurrency.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetBaseCurrency
+}
+
+func (mmGetBaseCurrency *mCurrencyUsecaseMockGetBaseCurrency) invocationsDone() bool {
+	if len(mmGetBaseCurrency.expectations) == 0 && mmGetBaseCurrency.defaultExpectation == nil && mmGetBaseCurrency.mock.funcGetBaseCurrency == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetBaseCurrency.mock.afterGetBaseCurrencyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetBaseCurrency.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetBaseCurrency implements mm_usecase.CurrencyUsecase
+func (mmGetBaseCurrency *CurrencyUsecaseMock) GetBaseCurrency(ctx context.Context, accountID uuid.UUID) (resCurrency string, err error) {
+	mm_atomic.AddUint64(&mmGetBaseCurrency.beforeGetBaseCurrencyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBaseCurrency.afterGetBaseCurrencyCounter, 1)
+
+	mmGetBaseCurrency.t.Helper()
+
+	if mmGetBaseCurrency.inspectFuncGetBaseCurrency != nil {
+		mmGetBaseCurrency.inspectFuncGetBaseCurrency(ctx, accountID)
+	}
+
+	mm_params := CurrencyUsecaseMockGetBaseCurrencyParams{ctx, accountID}
+
+	// Record call args
+	mmGetBaseCurrency.GetBaseCurrencyMock.mutex.Lock()
+	mmGetBaseCurrency.GetBaseCurrencyMock.callArgs = append(mmGetBaseCurrency.GetBaseCurrencyMock.callArgs, &mm_params)
+	mmGetBaseCurrency.GetBaseCurrencyMock.mutex.Unlock()
+
+	for _, e := range mmGetBaseCurrency.GetBaseCurrencyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resCurrency, e.results.err
+		}
+	}
+
+	if mmGetBaseCurrency.GetBaseCurrencyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBaseCurrency.GetBaseCurrencyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBaseCurrency.GetBaseCurrencyMock.defaultExpectation.params
+		mm_want_ptrs := mmGetBaseCurrency.GetBaseCurrencyMock.defaultExpectation.paramPtrs
+
+		mm_got := CurrencyUsecaseMockGetBaseCurrencyParams{ctx, accountID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetBaseCurrency.t.Errorf("CurrencyUsecaseMock.GetBaseCurrency got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetBaseCurrency.GetBaseCurrencyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmGetBaseCurrency.t.Errorf("CurrencyUsecaseMock.GetBaseCurrency got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetBaseCurrency.GetBaseCurrencyMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBaseCurrency.t.Errorf("CurrencyUsecaseMock.GetBaseCurrency got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetBaseCurrency.GetBaseCurrencyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBaseCurrency.GetBaseCurrencyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBaseCurrency.t.Fatal("No results are set for the CurrencyUsecaseMock.GetBaseCurrency")
+		}
+		return (*mm_results).resCurrency, (*mm_results).err
+	}
+	if mmGetBaseCurrency.funcGetBaseCurrency != nil {
+		return mmGetBaseCurrency.funcGetBaseCurrency(ctx, accountID)
+	}
+	mmGetBaseCurrency.t.Fatalf("Unexpected call to CurrencyUsecaseMock.GetBaseCurrency. %v %v", ctx, accountID)
+	return
+}
+
+// GetBaseCurrencyAfterCounter returns a count of finished CurrencyUsecaseMock.GetBaseCurrency invocations
+func (mmGetBaseCurrency *CurrencyUsecaseMock) GetBaseCurrencyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBaseCurrency.afterGetBaseCurrencyCounter)
+}
+
+// GetBaseCurrencyBeforeCounter returns a count of CurrencyUsecaseMock.GetBaseCurrency invocations
+func (mmGetBaseCurrency *CurrencyUsecaseMock) GetBaseCurrencyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBaseCurrency.beforeGetBaseCurrencyCounter)
+}
+
+// Calls returns a list of arguments used in each call to CurrencyUsecaseMock.GetBaseCurrency.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBaseCurrency *mCurrencyUsecaseMockGetBaseCurrency) Calls() []*CurrencyUsecaseMockGetBaseCurrencyParams {
+	mmGetBaseCurrency.mutex.RLock()
+
+	argCopy := make([]*CurrencyUsecaseMockGetBaseCurrencyParams, len(mmGetBaseCurrency.callArgs))
+	copy(argCopy, mmGetBaseCurrency.callArgs)
+
+	mmGetBaseCurrency.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetBaseCurrencyDone returns true if the count of the GetBaseCurrency invocations corresponds
+// the number of defined expectations
+func (m *CurrencyUsecaseMock) MinimockGetBaseCurrencyDone() bool {
+	if m.GetBaseCurrencyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetBaseCurrencyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetBaseCurrencyMock.invocationsDone()
+}
+
+// MinimockGetBaseCurrencyInspect logs each unmet expectation
+func (m *CurrencyUsecaseMock) MinimockGetBaseCurrencyInspect() {
+	for _, e := range m.GetBaseCurrencyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CurrencyUsecaseMock.GetBaseCurrency at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetBaseCurrencyCounter := mm_atomic.LoadUint64(&m.afterGetBaseCurrencyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBaseCurrencyMock.defaultExpectation != nil && afterGetBaseCurrencyCounter < 1 {
+		if m.GetBaseCurrencyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CurrencyUsecaseMock.GetBaseCurrency at\n%s", m.GetBaseCurrencyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CurrencyUsecaseMock.GetBaseCurrency at\n%s with params: %#v", m.GetBaseCurrencyMock.defaultExpectation.expectationOrigins.origin, *m.GetBaseCurrencyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBaseCurrency != nil && afterGetBaseCurrencyCounter < 1 {
+		m.t.Errorf("Expected call to CurrencyUsecaseMock.GetBaseCurrency at\n%s", m.funcGetBaseCurrencyOrigin)
+	}
+
+	if !m.GetBaseCurrencyMock.invocationsDone() && afterGetBaseCurrencyCounter > 0 {
+		m.t.Errorf("Expected %d calls to CurrencyUsecaseMock.GetBaseCurrency at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetBaseCurrencyMock.expectedInvocations), m.GetBaseCurrencyMock.expectedInvocationsOrigin, afterGetBaseCurrencyCounter)
+	}
+}
+
+type mCurrencyUsecaseMockSetBaseCurrency struct {
+	optional           bool
+	mock               *CurrencyUsecaseMock
+	defaultExpectation *CurrencyUsecaseMockSetBaseCurrencyExpectation
+	expectations       []*CurrencyUsecaseMockSetBaseCurrencyExpectation
+
+	callArgs []*CurrencyUsecaseMockSetBaseCurrencyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CurrencyUsecaseMockSetBaseCurrencyExpectation specifies expectation struct of the CurrencyUsecase.SetBaseCurrency
+type CurrencyUsecaseMockSetBaseCurrencyExpectation struct {
+	mock               *CurrencyUsecaseMock
+	params             *CurrencyUsecaseMockSetBaseCurrencyParams
+	paramPtrs          *CurrencyUsecaseMockSetBaseCurrencyParamPtrs
+	expectationOrigins CurrencyUsecaseMockSetBaseCurrencyExpectationOrigins
+	results            *CurrencyUsecaseMockSetBaseCurrencyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CurrencyUsecaseMockSetBaseCurrencyParams contains parameters of the CurrencyUsecase.SetBaseCurrency
+type CurrencyUsecaseMockSetBaseCurrencyParams struct {
+	ctx       context.Context
+	accountID uuid.UUID
+	currency  string
+}
+
+// CurrencyUsecaseMockSetBaseCurrencyParamPtrs contains pointers to parameters of the CurrencyUsecase.SetBaseCurrency
+type CurrencyUsecaseMockSetBaseCurrencyParamPtrs struct {
+	ctx       *context.Context
+	accountID *uuid.UUID
+	currency  *string
+}
+
+// CurrencyUsecaseMockSetBaseCurrencyResults contains results of the CurrencyUsecase.SetBaseCurrency
+type CurrencyUsecaseMockSetBaseCurrencyResults struct {
+	err error
+}
+
+// CurrencyUsecaseMockSetBaseCurrencyOrigins contains origins of expectations of the CurrencyUsecase.SetBaseCurrency
+type CurrencyUsecaseMockSetBaseCurrencyExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originAccountID string
+	originCurrency  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetBaseCurrency *mCurrencyUsecaseMockSetBaseCurrency) Optional() *mCurrencyUsecaseMockSetBaseCurrency {
+	mmSetBaseCurrency.optional = true
+	return mmSetBaseCurrency
+}
+
+// Expect sets up expected params for CurrencyUsecase.SetBaseCurrency
+func (mmSetBaseCurrency *mCurrencyUsecaseMockSetBaseCurrency) Expect(ctx context.Context, accountID uuid.UUID, currency string) *mCurrencyUsecaseMockSetBaseCurrency {
+	if mmSetBaseCurrency.mock.funcSetBaseCurrency != nil {
+		mmSetBaseCurrency.mock.t.Fatalf("CurrencyUsecaseMock.SetBaseCurrency mock is already set by Set")
+	}
+
+	if mmSetBaseCurrency.defaultExpectation == nil {
+		mmSetBaseCurrency.defaultExpectation = &CurrencyUsecaseMockSetBaseCurrencyExpectation{}
+	}
+
+	if mmSetBaseCurrency.defaultExpectation.paramPtrs != nil {
+		mmSetBaseCurrency.mock.t.Fatalf("CurrencyUsecaseMock.SetBaseCurrency mock is already set by ExpectParams functions")
+	}
+
+	mmSetBaseCurrency.defaultExpectation.params = &CurrencyUsecaseMockSetBaseCurrencyParams{ctx, accountID, currency}
+	mmSetBaseCurrency.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetBaseCurrency.expectations {
+		if minimock.Equal(e.params, mmSetBaseCurrency.defaultExpectation.params) {
+			mmSetBaseCurrency.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetBaseCurrency.defaultExpectation.params)
+		}
+	}
+
+	return mmSetBaseCurrency
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CurrencyUsecase.SetBaseCurrency
+func (mmSetBaseCurrency *mCurrencyUsecaseMockSetBaseCurrency) ExpectCtxParam1(ctx context.Context) *mCurrencyUsecaseMockSetBaseCurrency {
+	if mmSetBaseCurrency.mock.funcSetBaseCurrency != nil {
+		mmSetBaseCurrency.mock.t.Fatalf("CurrencyUsecaseMock.SetBaseCurrency mock is already set by Set")
+	}
+
+	if mmSetBaseCurrency.defaultExpectation == nil {
+		mmSetBaseCurrency.defaultExpectation = &CurrencyUsecaseMockSetBaseCurrencyExpectation{}
+	}
+
+	if mmSetBaseCurrency.defaultExpectation.params != nil {
+		mmSetBaseCurrency.mock.t.Fatalf("CurrencyUsecaseMock.SetBaseCurrency mock is already set by Expect")
+	}
+
+	if mmSetBaseCurrency.defaultExpectation.paramPtrs == nil {
+		mmSetBaseCurrency.defaultExpectation.paramPtrs = &CurrencyUsecaseMockSetBaseCurrencyParamPtrs{}
+	}
+	mmSetBaseCurrency.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetBaseCurrency.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetBaseCurrency
+}
+
+// ExpectAccountIDParam2 sets up expected param accountID for CurrencyUsecase.SetBaseCurrency
+func (mmSetBaseCurrency *mCurrencyUsecaseMockSetBaseCurrency) ExpectAccountIDParam2(accountID uuid.UUID) *mCurrencyUsecaseMockSetBaseCurrency {
+	if mmSetBaseCurrency.mock.funcSetBaseCurrency != nil {
+		mmSetBaseCurrency.mock.t.Fatalf("CurrencyUsecaseMock.SetBaseCurrency mock is already set by Set")
+	}
+
+	if mmSetBaseCurrency.defaultExpectation == nil {
+		mmSetBaseCurrency.defaultExpectation = &CurrencyUsecaseMockSetBaseCurrencyExpectation{}
+	}
+
+	if mmSetBaseCurrency.defaultExpectation.params != nil {
+		mmSetBaseCurrency.mock.t.Fatalf("CurrencyUsecaseMock.SetBaseCurrency mock is already set by Expect")
+	}
+
+	if mmSetBaseCurrency.defaultExpectation.paramPtrs == nil {
+		mmSetBaseCurrency.defaultExpectation.paramPtrs = &CurrencyUsecaseMockSetBaseCurrencyParamPtrs{}
+	}
+	mmSetBaseCurrency.defaultExpectation.paramPtrs.accountID = &accountID
+	mmSetBaseCurrency.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+
+	return mmSetBaseCurrency
+}
+
+// ExpectCurrencyParam3 sets up expected param currency for CurrencyUsecase.SetBaseCurrency
+func (mmSetBaseCurrency *mCurrencyUsecaseMockSetBaseCurrency) ExpectCurrencyParam3(currency string) *mCurrencyUsecaseMockSetBaseCurrency {
+	if mmSetBaseCurrency.mock.funcSetBaseCurrency != nil {
+		mmSetBaseCurrency.mock.t.Fatalf("CurrencyUsecaseMock.SetBaseCurrency mock is already set by Set")
+	}
+
+	if mmSetBaseCurrency.defaultExpectation == nil {
+		mmSetBaseCurrency.defaultExpectation = &CurrencyUsecaseMockSetBaseCurrencyExpectation{}
+	}
+
+	if mmSetBaseCurrency.defaultExpectation.params != nil {
+		mmSetBaseCurrency.mock.t.Fatalf("CurrencyUsecaseMock.SetBaseCurrency mock is already set by Expect")
+	}
+
+	if mmSetBaseCurrency.defaultExpectation.paramPtrs == nil {
+		mmSetBaseCurrency.defaultExpectation.paramPtrs = &CurrencyUsecaseMockSetBaseCurrencyParamPtrs{}
+	}
+	mmSetBaseCurrency.defaultExpectation.paramPtrs.currency = &currency
+	mmSetBaseCurrency.defaultExpectation.expectationOrigins.originCurrency = minimock.CallerInfo(1)
+
+	return mmSetBaseCurrency
+}
+
+// Inspect accepts an inspector function that has same arguments as the CurrencyUsecase.SetBaseCurrency
+func (mmSetBaseCurrency *mCurrencyUsecaseMockSetBaseCurrency) Inspect(f func(ctx context.Context, accountID uuid.UUID, currency string)) *mCurrencyUsecaseMockSetBaseCurrency {
+	if mmSetBaseCurrency.mock.inspectFuncSetBaseCurrency != nil {
+		mmSetBaseCurrency.mock.t.Fatalf("Inspect function is already set for CurrencyUsecaseMock.SetBaseCurrency")
+	}
+
+	mmSetBaseCurrency.mock.inspectFuncSetBaseCurrency = f
+
+	return mmSetBaseCurrency
+}
+
+// Return sets up results that will be returned by CurrencyUsecase.SetBaseCurrency
+func (mmSetBaseCurrency *mCurrencyUsecaseMockSetBaseCurrency) Return(err error) *CurrencyUsecaseMock {
+	if mmSetBaseCurrency.mock.funcSetBaseCurrency != nil {
+		mmSetBaseCurrency.mock.t.Fatalf("CurrencyUsecaseMock.SetBaseCurrency mock is already set by Set")
+	}
+
+	if mmSetBaseCurrency.defaultExpectation == nil {
+		mmSetBaseCurrency.defaultExpectation = &CurrencyUsecaseMockSetBaseCurrencyExpectation{mock: mmSetBaseCurrency.mock}
+	}
+	mmSetBaseCurrency.defaultExpectation.results = &CurrencyUsecaseMockSetBaseCurrencyResults{err}
+	mmSetBaseCurrency.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetBaseCurrency.mock
+}
+
+// Set uses given function f to mock the CurrencyUsecase.SetBaseCurrency method
+func (mmSetBaseCurrency *mCurrencyUsecaseMockSetBaseCurrency) Set(f func(ctx context.Context, accountID uuid.UUID, currency string) (err error)) *CurrencyUsecaseMock {
+	if mmSetBaseCurrency.defaultExpectation != nil {
+		mmSetBaseCurrency.mock.t.Fatalf("Default expectation is already set for the CurrencyUsecase.SetBaseCurrency method")
+	}
+
+	if len(mmSetBaseCurrency.expectations) > 0 {
+		mmSetBaseCurrency.mock.t.Fatalf("Some expectations are already set for the CurrencyUsecase.SetBaseCurrency method")
+	}
+
+	mmSetBaseCurrency.mock.funcSetBaseCurrency = f
+	mmSetBaseCurrency.mock.funcSetBaseCurrencyOrigin = minimock.CallerInfo(1)
+	return mmSetBaseCurrency.mock
+}
+
+// When sets expectation for the CurrencyUsecase.SetBaseCurrency which will trigger the result defined by the following
+// Then helper
+func (mmSetBaseCurrency *mCurrencyUsecaseMockSetBaseCurrency) When(ctx context.Context, accountID uuid.UUID, currency string) *CurrencyUsecaseMockSetBaseCurrencyExpectation {
+	if mmSetBaseCurrency.mock.funcSetBaseCurrency != nil {
+		mmSetBaseCurrency.mock.t.Fatalf("CurrencyUsecaseMock.SetBaseCurrency mock is already set by Set")
+	}
+
+	expectation := &CurrencyUsecaseMockSetBaseCurrencyExpectation{
+		mock:               mmSetBaseCurrency.mock,
+		params:             &CurrencyUsecaseMockSetBaseCurrencyParams{ctx, accountID, currency},
+		expectationOrigins: CurrencyUsecaseMockSetBaseCurrencyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetBaseCurrency.expectations = append(mmSetBaseCurrency.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CurrencyUsecase.SetBaseCurrency return parameters for the expectation previously defined by the When method
+func (e *CurrencyUsecaseMockSetBaseCurrencyExpectation) Then(err error) *CurrencyUsecaseMock {
+	e.results = &CurrencyUsecaseMockSetBaseCurrencyResults{err}
+	return e.mock
+}
+
+// Times sets number of times CurrencyUsecase.SetBaseCurrency should be invoked
+func (mmSetBaseCurrency *mCurrencyUsecaseMockSetBaseCurrency) Times(n uint64) *mCurrencyUsecaseMockSetBaseCurrency {
+	if n == 0 {
+		mmSetBaseCurrency.mock.t.Fatalf("Times of CurrencyUsecaseMock.SetBaseCurrency mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetBaseCurrency.expectedInvocations, n)
+	mmSetBaseCurrency.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetBaseCurrency
+}
+
+func (mmSetBaseCurrency *mCurrencyUsecaseMockSetBaseCurrency) invocationsDone() bool {
+	if len(mmSetBaseCurrency.expectations) == 0 && mmSetBaseCurrency.defaultExpectation == nil && mmSetBaseCurrency.mock.funcSetBaseCurrency == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetBaseCurrency.mock.afterSetBaseCurrencyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetBaseCurrency.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetBaseCurrency implements mm_usecase.CurrencyUsecase
+func (mmSetBaseCurrency *CurrencyUsecaseMock) SetBaseCurrency(ctx context.Context, accountID uuid.UUID, currency string) (err error) {
+	mm_atomic.AddUint64(&mmSetBaseCurrency.beforeSetBaseCurrencyCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetBaseCurrency.afterSetBaseCurrencyCounter, 1)
+
+	mmSetBaseCurrency.t.Helper()
+
+	if mmSetBaseCurrency.inspectFuncSetBaseCurrency != nil {
+		mmSetBaseCurrency.inspectFuncSetBaseCurrency(ctx, accountID, currency)
+	}
+
+	mm_params := CurrencyUsecaseMockSetBaseCurrencyParams{ctx, accountID, currency}
+
+	// Record call args
+	mmSetBaseCurrency.SetBaseCurrencyMock.mutex.Lock()
+	mmSetBaseCurrency.SetBaseCurrencyMock.callArgs = append(mmSetBaseCurrency.SetBaseCurrencyMock.callArgs, &mm_params)
+	mmSetBaseCurrency.SetBaseCurrencyMock.mutex.Unlock()
+
+	for _, e := range mmSetBaseCurrency.SetBaseCurrencyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetBaseCurrency.SetBaseCurrencyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetBaseCurrency.SetBaseCurrencyMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetBaseCurrency.SetBaseCurrencyMock.defaultExpectation.params
+		mm_want_ptrs := mmSetBaseCurrency.SetBaseCurrencyMock.defaultExpectation.paramPtrs
+
+		mm_got := CurrencyUsecaseMockSetBaseCurrencyParams{ctx, accountID, currency}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetBaseCurrency.t.Errorf("CurrencyUsecaseMock.SetBaseCurrency got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetBaseCurrency.SetBaseCurrencyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmSetBaseCurrency.t.Errorf("CurrencyUsecaseMock.SetBaseCurrency got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetBaseCurrency.SetBaseCurrencyMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			}
+
+			if mm_want_ptrs.currency != nil && !minimock.Equal(*mm_want_ptrs.currency, mm_got.currency) {
+				mmSetBaseCurrency.t.Errorf("CurrencyUsecaseMock.SetBaseCurrency got unexpected parameter currency, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetBaseCurrency.SetBaseCurrencyMock.defaultExpectation.expectationOrigins.originCurrency, *mm_want_ptrs.currency, mm_got.currency, minimock.Diff(*mm_want_ptrs.currency, mm_got.currency))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetBaseCurrency.t.Errorf("CurrencyUsecaseMock.SetBaseCurrency got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetBaseCurrency.SetBaseCurrencyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetBaseCurrency.SetBaseCurrencyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetBaseCurrency.t.Fatal("No results are set for the CurrencyUsecaseMock.SetBaseCurrency")
+		}
+		return (*mm_results).err
+	}
+	if mmSetBaseCurrency.funcSetBaseCurrency != nil {
+		return mmSetBaseCurrency.funcSetBaseCurrency(ctx, accountID, currency)
+	}
+	mmSetBaseCurrency.t.Fatalf("Unexpected call to CurrencyUsecaseMock.SetBaseCurrency. %v %v %v", ctx, accountID, currency)
+	return
+}
+
+// SetBaseCurrencyAfterCounter returns a count of finished CurrencyUsecaseMock.SetBaseCurrency invocations
+func (mmSetBaseCurrency *CurrencyUsecaseMock) SetBaseCurrencyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetBaseCurrency.afterSetBaseCurrencyCounter)
+}
+
+// SetBaseCurrencyBeforeCounter returns a count of CurrencyUsecaseMock.SetBaseCurrency invocations
+func (mmSetBaseCurrency *CurrencyUsecaseMock) SetBaseCurrencyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetBaseCurrency.beforeSetBaseCurrencyCounter)
+}
+
+// Calls returns a list of arguments used in each call to CurrencyUsecaseMock.SetBaseCurrency.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetBaseCurrency *mCurrencyUsecaseMockSetBaseCurrency) Calls() []*CurrencyUsecaseMockSetBaseCurrencyParams {
+	mmSetBaseCurrency.mutex.RLock()
+
+	argCopy := make([]*CurrencyUsecaseMockSetBaseCurrencyParams, len(mmSetBaseCurrency.callArgs))
+	copy(argCopy, mmSetBaseCurrency.callArgs)
+
+	mmSetBaseCurrency.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetBaseCurrencyDone returns true if the count of the SetBaseCurrency invocations corresponds
+// the number of defined expectations
+func (m *CurrencyUsecaseMock) MinimockSetBaseCurrencyDone() bool {
+	if m.SetBaseCurrencyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetBaseCurrencyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetBaseCurrencyMock.invocationsDone()
+}
+
+// MinimockSetBaseCurrencyInspect logs each unmet expectation
+func (m *CurrencyUsecaseMock) MinimockSetBaseCurrencyInspect() {
+	for _, e := range m.SetBaseCurrencyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CurrencyUsecaseMock.SetBaseCurrency at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetBaseCurrencyCounter := mm_atomic.LoadUint64(&m.afterSetBaseCurrencyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetBaseCurrencyMock.defaultExpectation != nil && afterSetBaseCurrencyCounter < 1 {
+		if m.SetBaseCurrencyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CurrencyUsecaseMock.SetBaseCurrency at\n%s", m.SetBaseCurrencyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CurrencyUsecaseMock.SetBaseCurrency at\n%s with params: %#v", m.SetBaseCurrencyMock.defaultExpectation.expectationOrigins.origin, *m.SetBaseCurrencyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetBaseCurrency != nil && afterSetBaseCurrencyCounter < 1 {
+		m.t.Errorf("Expected call to CurrencyUsecaseMock.SetBaseCurrency at\n%s", m.funcSetBaseCurrencyOrigin)
+	}
+
+	if !m.SetBaseCurrencyMock.invocationsDone() && afterSetBaseCurrencyCounter > 0 {
+		m.t.Errorf("Expected %d calls to CurrencyUsecaseMock.SetBaseCurrency at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetBaseCurrencyMock.expectedInvocations), m.SetBaseCurrencyMock.expectedInvocationsOrigin, afterSetBaseCurrencyCounter)
+	}
+}
+
+type mCurrencyUsecaseMockUpsertExchangeRates struct {
+	optional           bool
+	mock               *CurrencyUsecaseMock
+	defaultExpectation *CurrencyUsecaseMockUpsertExchangeRatesExpectation
+	expectations       []*CurrencyUsecaseMockUpsertExchangeRatesExpectation
+
+	callArgs []*CurrencyUsecaseMockUpsertExchangeRatesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CurrencyUsecaseMockUpsertExchangeRatesExpectation specifies expectation struct of the CurrencyUsecase.UpsertExchangeRates
+type CurrencyUsecaseMockUpsertExchangeRatesExpectation struct {
+	mock               *CurrencyUsecaseMock
+	params             *CurrencyUsecaseMockUpsertExchangeRatesParams
+	paramPtrs          *CurrencyUsecaseMockUpsertExchangeRatesParamPtrs
+	expectationOrigins CurrencyUsecaseMockUpsertExchangeRatesExpectationOrigins
+	results            *CurrencyUsecaseMockUpsertExchangeRatesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CurrencyUsecaseMockUpsertExchangeRatesParams contains parameters of the CurrencyUsecase.UpsertExchangeRates
+type CurrencyUsecaseMockUpsertExchangeRatesParams struct {
+	ctx       context.Context
+	accountID uuid.UUID
+	in        []mm_usecase.UpsertExchangeRateDataInput
+}
+
+// CurrencyUsecaseMockUpsertExchangeRatesParamPtrs contains pointers to parameters of the CurrencyUsecase.UpsertExchangeRates
+type CurrencyUsecaseMockUpsertExchangeRatesParamPtrs struct {
+	ctx       *context.Context
+	accountID *uuid.UUID
+	in        *[]mm_usecase.UpsertExchangeRateDataInput
+}
+
+// CurrencyUsecaseMockUpsertExchangeRatesResults contains results of the CurrencyUsecase.UpsertExchangeRates
+type CurrencyUsecaseMockUpsertExchangeRatesResults struct {
+	resItems []*entity.ExchangeRate
+	err      error
+}
+
+// CurrencyUsecaseMockUpsertExchangeRatesOrigins contains origins of expectations of the CurrencyUsecase.UpsertExchangeRates
+type CurrencyUsecaseMockUpsertExchangeRatesExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originAccountID string
+	originIn        string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpsertExchangeRates *mCurrencyUsecaseMockUpsertExchangeRates) Optional() *mCurrencyUsecaseMockUpsertExchangeRates {
+	mmUpsertExchangeRates.optional = true
+	return mmUpsertExchangeRates
+}
+
+// Expect sets up expected params for CurrencyUsecase.UpsertExchangeRates
+func (mmUpsertExchangeRates *mCurrencyUsecaseMockUpsertExchangeRates) Expect(ctx context.Context, accountID uuid.UUID, in []mm_usecase.UpsertExchangeRateDataInput) *mCurrencyUsecaseMockUpsertExchangeRates {
+	if mmUpsertExchangeRates.mock.funcUpsertExchangeRates != nil {
+		mmUpsertExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.UpsertExchangeRates mock is already set by Set")
+	}
+
+	if mmUpsertExchangeRates.defaultExpectation == nil {
+		mmUpsertExchangeRates.defaultExpectation = &CurrencyUsecaseMockUpsertExchangeRatesExpectation{}
+	}
+
+	if mmUpsertExchangeRates.defaultExpectation.paramPtrs != nil {
+		mmUpsertExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.UpsertExchangeRates mock is already set by ExpectParams functions")
+	}
+
+	mmUpsertExchangeRates.defaultExpectation.params = &CurrencyUsecaseMockUpsertExchangeRatesParams{ctx, accountID, in}
+	mmUpsertExchangeRates.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpsertExchangeRates.expectations {
+		if minimock.Equal(e.params, mmUpsertExchangeRates.defaultExpectation.params) {
+			mmUpsertExchangeRates.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpsertExchangeRates.defaultExpectation.params)
+		}
+	}
+
+	return mmUpsertExchangeRates
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CurrencyUsecase.UpsertExchangeRates
+func (mmUpsertExchangeRates *mCurrencyUsecaseMockUpsertExchangeRates) ExpectCtxParam1(ctx context.Context) *mCurrencyUsecaseMockUpsertExchangeRates {
+	if mmUpsertExchangeRates.mock.funcUpsertExchangeRates != nil {
+		mmUpsertExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.UpsertExchangeRates mock is already set by Set")
+	}
+
+	if mmUpsertExchangeRates.defaultExpectation == nil {
+		mmUpsertExchangeRates.defaultExpectation = &CurrencyUsecaseMockUpsertExchangeRatesExpectation{}
+	}
+
+	if mmUpsertExchangeRates.defaultExpectation.params != nil {
+		mmUpsertExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.UpsertExchangeRates mock is already set by Expect")
+	}
+
+	if mmUpsertExchangeRates.defaultExpectation.paramPtrs == nil {
+		mmUpsertExchangeRates.defaultExpectation.paramPtrs = &CurrencyUsecaseMockUpsertExchangeRatesParamPtrs{}
+	}
+	mmUpsertExchangeRates.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpsertExchangeRates.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpsertExchangeRates
+}
+
+// ExpectAccountIDParam2 sets up expected param accountID for CurrencyUsecase.UpsertExchangeRates
+func (mmUpsertExchangeRates *mCurrencyUsecaseMockUpsertExchangeRates) ExpectAccountIDParam2(accountID uuid.UUID) *mCurrencyUsecaseMockUpsertExchangeRates {
+	if mmUpsertExchangeRates.mock.funcUpsertExchangeRates != nil {
+		mmUpsertExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.UpsertExchangeRates mock is already set by Set")
+	}
+
+	if mmUpsertExchangeRates.defaultExpectation == nil {
+		mmUpsertExchangeRates.defaultExpectation = &CurrencyUsecaseMockUpsertExchangeRatesExpectation{}
+	}
+
+	if mmUpsertExchangeRates.defaultExpectation.params != nil {
+		mmUpsertExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.UpsertExchangeRates mock is already set by Expect")
+	}
+
+	if mmUpsertExchangeRates.defaultExpectation.paramPtrs == nil {
+		mmUpsertExchangeRates.defaultExpectation.paramPtrs = &CurrencyUsecaseMockUpsertExchangeRatesParamPtrs{}
+	}
+	mmUpsertExchangeRates.defaultExpectation.paramPtrs.accountID = &accountID
+	mmUpsertExchangeRates.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+
+	return mmUpsertExchangeRates
+}
+
+// ExpectInParam3 sets up expected param in for CurrencyUsecase.UpsertExchangeRates
+func (mmUpsertExchangeRates *mCurrencyUsecaseMockUpsertExchangeRates) ExpectInParam3(in []mm_usecase.UpsertExchangeRateDataInput) *mCurrencyUsecaseMockUpsertExchangeRates {
+	if mmUpsertExchangeRates.mock.funcUpsertExchangeRates != nil {
+		mmUpsertExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.UpsertExchangeRates mock is already set by Set")
+	}
+
+	if mmUpsertExchangeRates.defaultExpectation == nil {
+		mmUpsertExchangeRates.defaultExpectation = &CurrencyUsecaseMockUpsertExchangeRatesExpectation{}
+	}
+
+	if mmUpsertExchangeRates.defaultExpectation.params != nil {
+		mmUpsertExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.UpsertExchangeRates mock is already set by Expect")
+	}
+
+	if mmUpsertExchangeRates.defaultExpectation.paramPtrs == nil {
+		mmUpsertExchangeRates.defaultExpectation.paramPtrs = &CurrencyUsecaseMockUpsertExchangeRatesParamPtrs{}
+	}
+	mmUpsertExchangeRates.defaultExpectation.paramPtrs.in = &in
+	mmUpsertExchangeRates.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmUpsertExchangeRates
+}
+
+// Inspect accepts an inspector function that has same arguments as the CurrencyUsecase.UpsertExchangeRates
+func (mmUpsertExchangeRates *mCurrencyUsecaseMockUpsertExchangeRates) Inspect(f func(ctx context.Context, accountID uuid.UUID, in []mm_usecase.UpsertExchangeRateDataInput)) *mCurrencyUsecaseMockUpsertExchangeRates {
+	if mmUpsertExchangeRates.mock.inspectFuncUpsertExchangeRates != nil {
+		mmUpsertExchangeRates.mock.t.Fatalf("Inspect function is already set for CurrencyUsecaseMock.UpsertExchangeRates")
+	}
+
+	mmUpsertExchangeRates.mock.inspectFuncUpsertExchangeRates = f
+
+	return mmUpsertExchangeRates
+}
+
+// Return sets up results that will be returned by CurrencyUsecase.UpsertExchangeRates
+func (mmUpsertExchangeRates *mCurrencyUsecaseMockUpsertExchangeRates) Return(resItems []*entity.ExchangeRate, err error) *CurrencyUsecaseMock {
+	if mmUpsertExchangeRates.mock.funcUpsertExchangeRates != nil {
+		mmUpsertExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.UpsertExchangeRates mock is already set by Set")
+	}
+
+	if mmUpsertExchangeRates.defaultExpectation == nil {
+		mmUpsertExchangeRates.defaultExpectation = &CurrencyUsecaseMockUpsertExchangeRatesExpectation{mock: mmUpsertExchangeRates.mock}
+	}
+	mmUpsertExchangeRates.defaultExpectation.results = &CurrencyUsecaseMockUpsertExchangeRatesResults{resItems, err}
+	mmUpsertExchangeRates.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpsertExchangeRates.mock
+}
+
+// Set uses given function f to mock the CurrencyUsecase.UpsertExchangeRates method
+func (mmUpsertExchangeRates *mCurrencyUsecaseMockUpsertExchangeRates) Set(f func(ctx context.Context, accountID uuid.UUID, in []mm_usecase.UpsertExchangeRateDataInput) (resItems []*entity.ExchangeRate, err error)) *CurrencyUsecaseMock {
+	if mmUpsertExchangeRates.defaultExpectation != nil {
+		mmUpsertExchangeRates.mock.t.Fatalf("Default expectation is already set for the CurrencyUsecase.UpsertExchangeRates method")
+	}
+
+	if len(mmUpsertExchangeRates.expectations) > 0 {
+		mmUpsertExchangeRates.mock.t.Fatalf("Some expectations are already set for the CurrencyUsecase.UpsertExchangeRates method")
+	}
+
+	mmUpsertExchangeRates.mock.funcUpsertExchangeRates = f
+	mmUpsertExchangeRates.mock.funcUpsertExchangeRatesOrigin = minimock.CallerInfo(1)
+	return mmUpsertExchangeRates.mock
+}
+
+// When sets expectation for the CurrencyUsecase.UpsertExchangeRates which will trigger the result defined by the following
+// Then helper
+func (mmUpsertExchangeRates *mCurrencyUsecaseMockUpsertExchangeRates) When(ctx context.Context, accountID uuid.UUID, in []mm_usecase.UpsertExchangeRateDataInput) *CurrencyUsecaseMockUpsertExchangeRatesExpectation {
+	if mmUpsertExchangeRates.mock.funcUpsertExchangeRates != nil {
+		mmUpsertExchangeRates.mock.t.Fatalf("CurrencyUsecaseMock.UpsertExchangeRates mock is already set by Set")
+	}
+
+	expectation := &CurrencyUsecaseMockUpsertExchangeRatesExpectation{
+		mock:               mmUpsertExchangeRates.mock,
+		params:             &CurrencyUsecaseMockUpsertExchangeRatesParams{ctx, accountID, in},
+		expectationOrigins: CurrencyUsecaseMockUpsertExchangeRatesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpsertExchangeRates.expectations = append(mmUpsertExchangeRates.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CurrencyUsecase.UpsertExchangeRates return parameters for the expectation previously defined by the When method
+func (e *CurrencyUsecaseMockUpsertExchangeRatesExpectation) Then(resItems []*entity.ExchangeRate, err error) *CurrencyUsecaseMock {
+	e.results = &CurrencyUsecaseMockUpsertExchangeRatesResults{resItems, err}
+	return e.mock
+}
+
+// Times sets number of times CurrencyUsecase.UpsertExchangeRates should be invoked
+func (mmUpsertExchangeRates *mCurrencyUsecaseMockUpsertExchangeRates) Times(n uint64) *mCurrencyUsecaseMockUpsertExchangeRates {
+	if n == 0 {
+		mmUpsertExchangeRates.mock.t.Fatalf("Times of CurrencyUsecaseMock.UpsertExchangeRates mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpsertExchangeRates.expectedInvocations, n)
+	mmUpsertExchangeRates.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpsertExchangeRates
+}
+
+func (mmUpsertExchangeRates *mCurrencyUsecaseMockUpsertExchangeRates) invocationsDone() bool {
+	if len(mmUpsertExchangeRates.expectations) == 0 && mmUpsertExchangeRates.defaultExpectation == nil && mmUpsertExchangeRates.mock.funcUpsertExchangeRates == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpsertExchangeRates.mock.afterUpsertExchangeRatesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpsertExchangeRates.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpsertExchangeRates implements mm_usecase.CurrencyUsecase
+func (mmUpsertExchangeRates *CurrencyUsecaseMock) UpsertExchangeRates(ctx context.Context, accountID uuid.UUID, in []mm_usecase.UpsertExchangeRateDataInput) (resItems []*entity.ExchangeRate, err error) {
+	mm_atomic.AddUint64(&mmUpsertExchangeRates.beforeUpsertExchangeRatesCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpsertExchangeRates.afterUpsertExchangeRatesCounter, 1)
+
+	mmUpsertExchangeRates.t.Helper()
+
+	if mmUpsertExchangeRates.inspectFuncUpsertExchangeRates != nil {
+		mmUpsertExchangeRates.inspectFuncUpsertExchangeRates(ctx, accountID, in)
+	}
+
+	mm_params := CurrencyUsecaseMockUpsertExchangeRatesParams{ctx, accountID, in}
+
+	// Record call args
+	mmUpsertExchangeRates.UpsertExchangeRatesMock.mutex.Lock()
+	mmUpsertExchangeRates.UpsertExchangeRatesMock.callArgs = append(mmUpsertExchangeRates.UpsertExchangeRatesMock.callArgs, &mm_params)
+	mmUpsertExchangeRates.UpsertExchangeRatesMock.mutex.Unlock()
+
+	for _, e := range mmUpsertExchangeRates.UpsertExchangeRatesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resItems, e.results.err
+		}
+	}
+
+	if mmUpsertExchangeRates.UpsertExchangeRatesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpsertExchangeRates.UpsertExchangeRatesMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpsertExchangeRates.UpsertExchangeRatesMock.defaultExpectation.params
+		mm_want_ptrs := mmUpsertExchangeRates.UpsertExchangeRatesMock.defaultExpectation.paramPtrs
+
+		mm_got := CurrencyUsecaseMockUpsertExchangeRatesParams{ctx, accountID, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpsertExchangeRates.t.Errorf("CurrencyUsecaseMock.UpsertExchangeRates got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpsertExchangeRates.UpsertExchangeRatesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmUpsertExchangeRates.t.Errorf("CurrencyUsecaseMock.UpsertExchangeRates got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpsertExchangeRates.UpsertExchangeRatesMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmUpsertExchangeRates.t.Errorf("CurrencyUsecaseMock.UpsertExchangeRates got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpsertExchangeRates.UpsertExchangeRatesMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpsertExchangeRates.t.Errorf("CurrencyUsecaseMock.UpsertExchangeRates got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpsertExchangeRates.UpsertExchangeRatesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpsertExchangeRates.UpsertExchangeRatesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpsertExchangeRates.t.Fatal("No results are set for the CurrencyUsecaseMock.UpsertExchangeRates")
+		}
+		return (*mm_results).resItems, (*mm_results).err
+	}
+	if mmUpsertExchangeRates.funcUpsertExchangeRates != nil {
+		return mmUpsertExchangeRates.funcUpsertExchangeRates(ctx, accountID, in)
+	}
+	mmUpsertExchangeRates.t.Fatalf("Unexpected call to CurrencyUsecaseMock.UpsertExchangeRates. %v %v %v", ctx, accountID, in)
+	return
+}
+
+// UpsertExchangeRatesAfterCounter returns a count of finished CurrencyUsecaseMock.UpsertExchangeRates invocations
+func (mmUpsertExchangeRates *CurrencyUsecaseMock) UpsertExchangeRatesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsertExchangeRates.afterUpsertExchangeRatesCounter)
+}
+
+// UpsertExchangeRatesBeforeCounter returns a count of CurrencyUsecaseMock.UpsertExchangeRates invocations
+func (mmUpsertExchangeRates *CurrencyUsecaseMock) UpsertExchangeRatesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsertExchangeRates.beforeUpsertExchangeRatesCounter)
+}
+
+// Calls returns a list of arguments used in each call to CurrencyUsecaseMock.UpsertExchangeRates.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpsertExchangeRates *mCurrencyUsecaseMockUpsertExchangeRates) Calls() []*CurrencyUsecaseMockUpsertExchangeRatesParams {
+	mmUpsertExchangeRates.mutex.RLock()
+
+	argCopy := make([]*CurrencyUsecaseMockUpsertExchangeRatesParams, len(mmUpsertExchangeRates.callArgs))
+	copy(argCopy, mmUpsertExchangeRates.callArgs)
+
+	mmUpsertExchangeRates.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpsertExchangeRatesDone returns true if the count of the UpsertExchangeRates invocations corresponds
+// the number of defined expectations
+func (m *CurrencyUsecaseMock) MinimockUpsertExchangeRatesDone() bool {
+	if m.UpsertExchangeRatesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpsertExchangeRatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpsertExchangeRatesMock.invocationsDone()
+}
+
+// MinimockUpsertExchangeRatesInspect logs each unmet expectation
+func (m *CurrencyUsecaseMock) MinimockUpsertExchangeRatesInspect() {
+	for _, e := range m.UpsertExchangeRatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CurrencyUsecaseMock.UpsertExchangeRates at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpsertExchangeRatesCounter := mm_atomic.LoadUint64(&m.afterUpsertExchangeRatesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpsertExchangeRatesMock.defaultExpectation != nil && afterUpsertExchangeRatesCounter < 1 {
+		if m.UpsertExchangeRatesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CurrencyUsecaseMock.UpsertExchangeRates at\n%s", m.UpsertExchangeRatesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CurrencyUsecaseMock.UpsertExchangeRates at\n%s with params: %#v", m.UpsertExchangeRatesMock.defaultExpectation.expectationOrigins.origin, *m.UpsertExchangeRatesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpsertExchangeRates != nil && afterUpsertExchangeRatesCounter < 1 {
+		m.t.Errorf("Expected call to CurrencyUsecaseMock.UpsertExchangeRates at\n%s", m.funcUpsertExchangeRatesOrigin)
+	}
+
+	if !m.UpsertExchangeRatesMock.invocationsDone() && afterUpsertExchangeRatesCounter > 0 {
+		m.t.Errorf("Expected %d calls to CurrencyUsecaseMock.UpsertExchangeRates at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpsertExchangeRatesMock.expectedInvocations), m.UpsertExchangeRatesMock.expectedInvocationsOrigin, afterUpsertExchangeRatesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CurrencyUsecaseMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockFindExchangeRatesInspect()
+
+			m.MinimockGetBaseCurrencyInspect()
+
+			m.MinimockSetBaseCurrencyInspect()
+
+			m.MinimockUpsertExchangeRatesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CurrencyUsecaseMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CurrencyUsecaseMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockFindExchangeRatesDone() &&
+		m.MinimockGetBaseCurrencyDone() &&
+		m.MinimockSetBaseCurrencyDone() &&
+		m.MinimockUpsertExchangeRatesDone()
+}
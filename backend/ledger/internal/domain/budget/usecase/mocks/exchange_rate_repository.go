@@ -0,0 +1,1257 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.ExchangeRateRepository -o exchange_rate_repository.go -n ExchangeRateRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"cloud.google.com/go/civil"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	mm_usecase "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// ExchangeRateRepositoryMock implements mm_usecase.ExchangeRateRepository
+type ExchangeRateRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcFindActual          func(ctx context.Context, accountID uuid.UUID, currencyFrom string, currencyTo string, date civil.Date) (item *entity.ExchangeRate, err error)
+	funcFindActualOrigin    string
+	inspectFuncFindActual   func(ctx context.Context, accountID uuid.UUID, currencyFrom string, currencyTo string, date civil.Date)
+	afterFindActualCounter  uint64
+	beforeFindActualCounter uint64
+	FindActualMock          mExchangeRateRepositoryMockFindActual
+
+	funcFindList          func(ctx context.Context, listOptions *mm_usecase.ExchangeRateListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.ExchangeRate, err error)
+	funcFindListOrigin    string
+	inspectFuncFindList   func(ctx context.Context, listOptions *mm_usecase.ExchangeRateListOptions, queryParams *uctypes.QueryGetListParams)
+	afterFindListCounter  uint64
+	beforeFindListCounter uint64
+	FindListMock          mExchangeRateRepositoryMockFindList
+
+	funcUpsert          func(ctx context.Context, items []*entity.ExchangeRate) (err error)
+	funcUpsertOrigin    string
+	inspectFuncUpsert   func(ctx context.Context, items []*entity.ExchangeRate)
+	afterUpsertCounter  uint64
+	beforeUpsertCounter uint64
+	UpsertMock          mExchangeRateRepositoryMockUpsert
+}
+
+// NewExchangeRateRepositoryMock returns a mock for mm_usecase.ExchangeRateRepository
+func NewExchangeRateRepositoryMock(t minimock.Tester) *ExchangeRateRepositoryMock {
+	m := &ExchangeRateRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.FindActualMock = mExchangeRateRepositoryMockFindActual{mock: m}
+	m.FindActualMock.callArgs = []*ExchangeRateRepositoryMockFindActualParams{}
+
+	m.FindListMock = mExchangeRateRepositoryMockFindList{mock: m}
+	m.FindListMock.callArgs = []*ExchangeRateRepositoryMockFindListParams{}
+
+	m.UpsertMock = mExchangeRateRepositoryMockUpsert{mock: m}
+	m.UpsertMock.callArgs = []*ExchangeRateRepositoryMockUpsertParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mExchangeRateRepositoryMockFindActual struct {
+	optional           bool
+	mock               *ExchangeRateRepositoryMock
+	defaultExpectation *ExchangeRateRepositoryMockFindActualExpectation
+	expectations       []*ExchangeRateRepositoryMockFindActualExpectation
+
+	callArgs []*ExchangeRateRepositoryMockFindActualParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ExchangeRateRepositoryMockFindActualExpectation specifies expectation struct of the ExchangeRateRepository.FindActual
+type ExchangeRateRepositoryMockFindActualExpectation struct {
+	mock               *ExchangeRateRepositoryMock
+	params             *ExchangeRateRepositoryMockFindActualParams
+	paramPtrs          *ExchangeRateRepositoryMockFindActualParamPtrs
+	expectationOrigins ExchangeRateRepositoryMockFindActualExpectationOrigins
+	results            *ExchangeRateRepositoryMockFindActualResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ExchangeRateRepositoryMockFindActualParams contains parameters of the ExchangeRateRepository.FindActual
+type ExchangeRateRepositoryMockFindActualParams struct {
+	ctx          context.Context
+	accountID    uuid.UUID
+	currencyFrom string
+	currencyTo   string
+	date         civil.Date
+}
+
+// ExchangeRateRepositoryMockFindActualParamPtrs contains pointers to parameters of the ExchangeRateRepository.FindActual
+type ExchangeRateRepositoryMockFindActualParamPtrs struct {
+	ctx          *context.Context
+	accountID    *uuid.UUID
+	currencyFrom *string
+	currencyTo   *string
+	date         *civil.Date
+}
+
+// ExchangeRateRepositoryMockFindActualResults contains results of the ExchangeRateRepository.FindActual
+type ExchangeRateRepositoryMockFindActualResults struct {
+	item *entity.ExchangeRate
+	err  error
+}
+
+// ExchangeRateRepositoryMockFindActualOrigins contains origins of expectations of the ExchangeRateRepository.FindActual
+type ExchangeRateRepositoryMockFindActualExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originAccountID    string
+	originCurrencyFrom string
+	originCurrencyTo   string
+	originDate         string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindActual *mExchangeRateRepositoryMockFindActual) Optional() *mExchangeRateRepositoryMockFindActual {
+	mmFindActual.optional = true
+	return mmFindActual
+}
+
+// Expect sets up expected params for ExchangeRateRepository.FindActual
+func (mmFindActual *mExchangeRateRepositoryMockFindActual) Expect(ctx context.Context, accountID uuid.UUID, currencyFrom string, currencyTo string, date civil.Date) *mExchangeRateRepositoryMockFindActual {
+	if mmFindActual.mock.funcFindActual != nil {
+		mmFindActual.mock.t.Fatalf("ExchangeRateRepositoryMock.FindActual mock is already set by Set")
+	}
+
+	if mmFindActual.defaultExpectation == nil {
+		mmFindActual.defaultExpectation = &ExchangeRateRepositoryMockFindActualExpectation{}
+	}
+
+	if mmFindActual.defaultExpectation.paramPtrs != nil {
+		mmFindActual.mock.t.Fatalf("ExchangeRateRepositoryMock.FindActual mock is already set by ExpectParams functions")
+	}
+
+	mmFindActual.defaultExpectation.params = &ExchangeRateRepositoryMockFindActualParams{ctx, accountID, currencyFrom, currencyTo, date}
+	mmFindActual.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindActual.expectations {
+		if minimock.Equal(e.params, mmFindActual.defaultExpectation.params) {
+			mmFindActual.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindActual.defaultExpectation.params)
+		}
+	}
+
+	return mmFindActual
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ExchangeRateRepository.FindActual
+func (mmFindActual *mExchangeRateRepositoryMockFindActual) ExpectCtxParam1(ctx context.Context) *mExchangeRateRepositoryMockFindActual {
+	if mmFindActual.mock.funcFindActual != nil {
+		mmFindActual.mock.t.Fatalf("ExchangeRateRepositoryMock.FindActual mock is already set by Set")
+	}
+
+	if mmFindActual.defaultExpectation == nil {
+		mmFindActual.defaultExpectation = &ExchangeRateRepositoryMockFindActualExpectation{}
+	}
+
+	if mmFindActual.defaultExpectation.params != nil {
+		mmFindActual.mock.t.Fatalf("ExchangeRateRepositoryMock.FindActual mock is already set by Expect")
+	}
+
+	if mmFindActual.defaultExpectation.paramPtrs == nil {
+		mmFindActual.defaultExpectation.paramPtrs = &ExchangeRateRepositoryMockFindActualParamPtrs{}
+	}
+	mmFindActual.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindActual.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindActual
+}
+
+// ExpectAccountIDParam2 sets up expected param accountID for ExchangeRateRepository.FindActual
+func (mmFindActual *mExchangeRateRepositoryMockFindActual) ExpectAccountIDParam2(accountID uuid.UUID) *mExchangeRateRepositoryMockFindActual {
+	if mmFindActual.mock.funcFindActual != nil {
+		mmFindActual.mock.t.Fatalf("ExchangeRateRepositoryMock.FindActual mock is already set by Set")
+	}
+
+	if mmFindActual.defaultExpectation == nil {
+		mmFindActual.defaultExpectation = &ExchangeRateRepositoryMockFindActualExpectation{}
+	}
+
+	if mmFindActual.defaultExpectation.params != nil {
+		mmFindActual.mock.t.Fatalf("ExchangeRateRepositoryMock.FindActual mock is already set by Expect")
+	}
+
+	if mmFindActual.defaultExpectation.paramPtrs == nil {
+		mmFindActual.defaultExpectation.paramPtrs = &ExchangeRateRepositoryMockFindActualParamPtrs{}
+	}
+	mmFindActual.defaultExpectation.paramPtrs.accountID = &accountID
+	mmFindActual.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+
+	return mmFindActual
+}
+
+// ExpectCurrencyFromParam3 sets up expected param currencyFrom for ExchangeRateRepository.FindActual
+func (mmFindActual *mExchangeRateRepositoryMockFindActual) ExpectCurrencyFromParam3(currencyFrom string) *mExchangeRateRepositoryMockFindActual {
+	if mmFindActual.mock.funcFindActual != nil {
+		mmFindActual.mock.t.Fatalf("ExchangeRateRepositoryMock.FindActual mock is already set by Set")
+	}
+
+	if mmFindActual.defaultExpectation == nil {
+		mmFindActual.defaultExpectation = &ExchangeRateRepositoryMockFindActualExpectation{}
+	}
+
+	if mmFindActual.defaultExpectation.params != nil {
+		mmFindActual.mock.t.Fatalf("ExchangeRateRepositoryMock.FindActual mock is already set by Expect")
+	}
+
+	if mmFindActual.defaultExpectation.paramPtrs == nil {
+		mmFindActual.defaultExpectation.paramPtrs = &ExchangeRateRepositoryMockFindActualParamPtrs{}
+	}
+	mmFindActual.defaultExpectation.paramPtrs.currencyFrom = &currencyFrom
+	mmFindActual.defaultExpectation.expectationOrigins.originCurrencyFrom = minimock.CallerInfo(1)
+
+	return mmFindActual
+}
+
+// ExpectCurrencyToParam4 sets up expected param currencyTo for ExchangeRateRepository.FindActual
+func (mmFindActual *mExchangeRateRepositoryMockFindActual) ExpectCurrencyToParam4(currencyTo string) *mExchangeRateRepositoryMockFindActual {
+	if mmFindActual.mock.funcFindActual != nil {
+		mmFindActual.mock.t.Fatalf("ExchangeRateRepositoryMock.FindActual mock is already set by Set")
+	}
+
+	if mmFindActual.defaultExpectation == nil {
+		mmFindActual.defaultExpectation = &ExchangeRateRepositoryMockFindActualExpectation{}
+	}
+
+	if mmFindActual.defaultExpectation.params != nil {
+		mmFindActual.mock.t.Fatalf("ExchangeRateRepositoryMock.FindActual mock is already set by Expect")
+	}
+
+	if mmFindActual.defaultExpectation.paramPtrs == nil {
+		mmFindActual.defaultExpectation.paramPtrs = &ExchangeRateRepositoryMockFindActualParamPtrs{}
+	}
+	mmFindActual.defaultExpectation.paramPtrs.currencyTo = &currencyTo
+	mmFindActual.defaultExpectation.expectationOrigins.originCurrencyTo = minimock.CallerInfo(1)
+
+	return mmFindActual
+}
+
+// ExpectDateParam5 sets up expected param date for ExchangeRateRepository.FindActual
+func (mmFindActual *mExchangeRateRepositoryMockFindActual) ExpectDateParam5(date civil.Date) *mExchangeRateRepositoryMockFindActual {
+	if mmFindActual.mock.funcFindActual != nil {
+		mmFindActual.mock.t.Fatalf("ExchangeRateRepositoryMock.FindActual mock is already set by Set")
+	}
+
+	if mmFindActual.defaultExpectation == nil {
+		mmFindActual.defaultExpectation = &ExchangeRateRepositoryMockFindActualExpectation{}
+	}
+
+	if mmFindActual.defaultExpectation.params != nil {
+		mmFindActual.mock.t.Fatalf("ExchangeRateRepositoryMock.FindActual mock is already set by Expect")
+	}
+
+	if mmFindActual.defaultExpectation.paramPtrs == nil {
+		mmFindActual.defaultExpectation.paramPtrs = &ExchangeRateRepositoryMockFindActualParamPtrs{}
+	}
+	mmFindActual.defaultExpectation.paramPtrs.date = &date
+	mmFindActual.defaultExpectation.expectationOrigins.originDate = minimock.CallerInfo(1)
+
+	return mmFindActual
+}
+
+// Inspect accepts an inspector function that has same arguments as the ExchangeRateRepository.FindActual
+func (mmFindActual *mExchangeRateRepositoryMockFindActual) Inspect(f func(ctx context.Context, accountID uuid.UUID, currencyFrom string, currencyTo string, date civil.Date)) *mExchangeRateRepositoryMockFindActual {
+	if mmFindActual.mock.inspectFuncFindActual != nil {
+		mmFindActual.mock.t.Fatalf("Inspect function is already set for ExchangeRateRepositoryMock.FindActual")
+	}
+
+	mmFindActual.mock.inspectFuncFindActual = f
+
+	return mmFindActual
+}
+
+// Return sets up results that will be returned by ExchangeRateRepository.FindActual
+func (mmFindActual *mExchangeRateRepositoryMockFindActual) Return(item *entity.ExchangeRate, err error) *ExchangeRateRepositoryMock {
+	if mmFindActual.mock.funcFindActual != nil {
+		mmFindActual.mock.t.Fatalf("ExchangeRateRepositoryMock.FindActual mock is already set by Set")
+	}
+
+	if mmFindActual.defaultExpectation == nil {
+		mmFindActual.defaultExpectation = &ExchangeRateRepositoryMockFindActualExpectation{mock: mmFindActual.mock}
+	}
+	mmFindActual.defaultExpectation.results = &ExchangeRateRepositoryMockFindActualResults{item, err}
+	mmFindActual.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindActual.mock
+}
+
+// Set uses given function f to mock the ExchangeRateRepository.FindActual method
+func (mmFindActual *mExchangeRateRepositoryMockFindActual) Set(f func(ctx context.Context, accountID uuid.UUID, currencyFrom string, currencyTo string, date civil.Date) (item *entity.ExchangeRate, err error)) *ExchangeRateRepositoryMock {
+	if mmFindActual.defaultExpectation != nil {
+		mmFindActual.mock.t.Fatalf("Default expectation is already set for the ExchangeRateRepository.FindActual method")
+	}
+
+	if len(mmFindActual.expectations) > 0 {
+		mmFindActual.mock.t.Fatalf("Some expectations are already set for the ExchangeRateRepository.FindActual method")
+	}
+
+	mmFindActual.mock.funcFindActual = f
+	mmFindActual.mock.funcFindActualOrigin = minimock.CallerInfo(1)
+	return mmFindActual.mock
+}
+
+// When sets expectation for the ExchangeRateRepository.FindActual which will trigger the result defined by the following
+// Then helper
+func (mmFindActual *mExchangeRateRepositoryMockFindActual) When(ctx context.Context, accountID uuid.UUID, currencyFrom string, currencyTo string, date civil.Date) *ExchangeRateRepositoryMockFindActualExpectation {
+	if mmFindActual.mock.funcFindActual != nil {
+		mmFindActual.mock.t.Fatalf("ExchangeRateRepositoryMock.FindActual mock is already set by Set")
+	}
+
+	expectation := &ExchangeRateRepositoryMockFindActualExpectation{
+		mock:               mmFindActual.mock,
+		params:             &ExchangeRateRepositoryMockFindActualParams{ctx, accountID, currencyFrom, currencyTo, date},
+		expectationOrigins: ExchangeRateRepositoryMockFindActualExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindActual.expectations = append(mmFindActual.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ExchangeRateRepository.FindActual return parameters for the expectation previously defined by the When method
+func (e *ExchangeRateRepositoryMockFindActualExpectation) Then(item *entity.ExchangeRate, err error) *ExchangeRateRepositoryMock {
+	e.results = &ExchangeRateRepositoryMockFindActualResults{item, err}
+	return e.mock
+}
+
+// Times sets number of times ExchangeRateRepository.FindActual should be invoked
+func (mmFindActual *mExchangeRateRepositoryMockFindActual) Times(n uint64) *mExchangeRateRepositoryMockFindActual {
+	if n == 0 {
+		mmFindActual.mock.t.Fatalf("Times of ExchangeRateRepositoryMock.FindActual mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindActual.expectedInvocations, n)
+	mmFindActual.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindActual
+}
+
+func (mmFindActual *mExchangeRateRepositoryMockFindActual) invocationsDone() bool {
+	if len(mmFindActual.expectations) == 0 && mmFindActual.defaultExpectation == nil && mmFindActual.mock.funcFindActual == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindActual.mock.afterFindActualCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindActual.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindActual implements mm_usecase.ExchangeRateRepository
+func (mmFindActual *ExchangeRateRepositoryMock) FindActual(ctx context.Context, accountID uuid.UUID, currencyFrom string, currencyTo string, date civil.Date) (item *entity.ExchangeRate, err error) {
+	mm_atomic.AddUint64(&mmFindActual.beforeFindActualCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindActual.afterFindActualCounter, 1)
+
+	mmFindActual.t.Helper()
+
+	if mmFindActual.inspectFuncFindActual != nil {
+		mmFindActual.inspectFuncFindActual(ctx, accountID, currencyFrom, currencyTo, date)
+	}
+
+	mm_params := ExchangeRateRepositoryMockFindActualParams{ctx, accountID, currencyFrom, currencyTo, date}
+
+	// Record call args
+	mmFindActual.FindActualMock.mutex.Lock()
+	mmFindActual.FindActualMock.callArgs = append(mmFindActual.FindActualMock.callArgs, &mm_params)
+	mmFindActual.FindActualMock.mutex.Unlock()
+
+	for _, e := range mmFindActual.FindActualMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.item, e.results.err
+		}
+	}
+
+	if mmFindActual.FindActualMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindActual.FindActualMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindActual.FindActualMock.defaultExpectation.params
+		mm_want_ptrs := mmFindActual.FindActualMock.defaultExpectation.paramPtrs
+
+		mm_got := ExchangeRateRepositoryMockFindActualParams{ctx, accountID, currencyFrom, currencyTo, date}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindActual.t.Errorf("ExchangeRateRepositoryMock.FindActual got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindActual.FindActualMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmFindActual.t.Errorf("ExchangeRateRepositoryMock.FindActual got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindActual.FindActualMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			}
+
+			if mm_want_ptrs.currencyFrom != nil && !minimock.Equal(*mm_want_ptrs.currencyFrom, mm_got.currencyFrom) {
+				mmFindActual.t.Errorf("ExchangeRateRepositoryMock.FindActual got unexpected parameter currencyFrom, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindActual.FindActualMock.defaultExpectation.expectationOrigins.originCurrencyFrom, *mm_want_ptrs.currencyFrom, mm_got.currencyFrom, minimock.Diff(*mm_want_ptrs.currencyFrom, mm_got.currencyFrom))
+			}
+
+			if mm_want_ptrs.currencyTo != nil && !minimock.Equal(*mm_want_ptrs.currencyTo, mm_got.currencyTo) {
+				mmFindActual.t.Errorf("ExchangeRateRepositoryMock.FindActual got unexpected parameter currencyTo, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindActual.FindActualMock.defaultExpectation.expectationOrigins.originCurrencyTo, *mm_want_ptrs.currencyTo, mm_got.currencyTo, minimock.Diff(*mm_want_ptrs.currencyTo, mm_got.currencyTo))
+			}
+
+			if mm_want_ptrs.date != nil && !minimock.Equal(*mm_want_ptrs.date, mm_got.date) {
+				mmFindActual.t.Errorf("ExchangeRateRepositoryMock.FindActual got unexpected parameter date, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindActual.FindActualMock.defaultExpectation.expectationOrigins.originDate, *mm_want_ptrs.date, mm_got.date, minimock.Diff(*mm_want_ptrs.date, mm_got.date))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindActual.t.Errorf("ExchangeRateRepositoryMock.FindActual got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindActual.FindActualMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindActual.FindActualMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindActual.t.Fatal("No results are set for the ExchangeRateRepositoryMock.FindActual")
+		}
+		return (*mm_results).item, (*mm_results).err
+	}
+	if mmFindActual.funcFindActual != nil {
+		return mmFindActual.funcFindActual(ctx, accountID, currencyFrom, currencyTo, date)
+	}
+	mmFindActual.t.Fatalf("Unexpected call to ExchangeRateRepositoryMock.FindActual. %v %v %v %v %v", ctx, accountID, currencyFrom, currencyTo, date)
+	return
+}
+
+// FindActualAfterCounter returns a count of finished ExchangeRateRepositoryMock.FindActual invocations
+func (mmFindActual *ExchangeRateRepositoryMock) FindActualAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindActual.afterFindActualCounter)
+}
+
+// FindActualBeforeCounter returns a count of ExchangeRateRepositoryMock.FindActual invocations
+func (mmFindActual *ExchangeRateRepositoryMock) FindActualBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindActual.beforeFindActualCounter)
+}
+
+// Calls returns a list of arguments used in each call to ExchangeRateRepositoryMock.FindActual.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindActual *mExchangeRateRepositoryMockFindActual) Calls() []*ExchangeRateRepositoryMockFindActualParams {
+	mmFindActual.mutex.RLock()
+
+	argCopy := make([]*ExchangeRateRepositoryMockFindActualParams, len(mmFindActual.callArgs))
+	copy(argCopy, mmFindActual.callArgs)
+
+	mmFindActual.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindActualDone returns true if the count of the FindActual invocations corresponds
+// the number of defined expectations
+func (m *ExchangeRateRepositoryMock) MinimockFindActualDone() bool {
+	if m.FindActualMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindActualMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindActualMock.invocationsDone()
+}
+
+// MinimockFindActualInspect logs each unmet expectation
+func (m *ExchangeRateRepositoryMock) MinimockFindActualInspect() {
+	for _, e := range m.FindActualMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ExchangeRateRepositoryMock.FindActual at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindActualCounter := mm_atomic.LoadUint64(&m.afterFindActualCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindActualMock.defaultExpectation != nil && afterFindActualCounter < 1 {
+		if m.FindActualMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ExchangeRateRepositoryMock.FindActual at\n%s", m.FindActualMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ExchangeRateRepositoryMock.FindActual at\n%s with params: %#v", m.FindActualMock.defaultExpectation.expectationOrigins.origin, *m.FindActualMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindActual != nil && afterFindActualCounter < 1 {
+		m.t.Errorf("Expected call to ExchangeRateRepositoryMock.FindActual at\n%s", m.funcFindActualOrigin)
+	}
+
+	if !m.FindActualMock.invocationsDone() && afterFindActualCounter > 0 {
+		m.t.Errorf("Expected %d calls to ExchangeRateRepositoryMock.FindActual at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindActualMock.expectedInvocations), m.FindActualMock.expectedInvocationsOrigin, afterFindActualCounter)
+	}
+}
+
+type mExchangeRateRepositoryMockFindList struct {
+	optional           bool
+	mock               *ExchangeRateRepositoryMock
+	defaultExpectation *ExchangeRateRepositoryMockFindListExpectation
+	expectations       []*ExchangeRateRepositoryMockFindListExpectation
+
+	callArgs []*ExchangeRateRepositoryMockFindListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ExchangeRateRepositoryMockFindListExpectation specifies expectation struct of the ExchangeRateRepository.FindList
+type ExchangeRateRepositoryMockFindListExpectation struct {
+	mock               *ExchangeRateRepositoryMock
+	params             *ExchangeRateRepositoryMockFindListParams
+	paramPtrs          *ExchangeRateRepositoryMockFindListParamPtrs
+	expectationOrigins ExchangeRateRepositoryMockFindListExpectationOrigins
+	results            *ExchangeRateRepositoryMockFindListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ExchangeRateRepositoryMockFindListParams contains parameters of the ExchangeRateRepository.FindList
+type ExchangeRateRepositoryMockFindListParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.ExchangeRateListOptions
+	queryParams *uctypes.QueryGetListParams
+}
+
+// ExchangeRateRepositoryMockFindListParamPtrs contains pointers to parameters of the ExchangeRateRepository.FindList
+type ExchangeRateRepositoryMockFindListParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.ExchangeRateListOptions
+	queryParams **uctypes.QueryGetListParams
+}
+
+// ExchangeRateRepositoryMockFindListResults contains results of the ExchangeRateRepository.FindList
+type ExchangeRateRepositoryMockFindListResults struct {
+	items []*entity.ExchangeRate
+	err   error
+}
+
+// ExchangeRateRepositoryMockFindListOrigins contains origins of expectations of the ExchangeRateRepository.FindList
+type ExchangeRateRepositoryMockFindListExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindList *mExchangeRateRepositoryMockFindList) Optional() *mExchangeRateRepositoryMockFindList {
+	mmFindList.optional = true
+	return mmFindList
+}
+
+// Expect sets up expected params for ExchangeRateRepository.FindList
+func (mmFindList *mExchangeRateRepositoryMockFindList) Expect(ctx context.Context, listOptions *mm_usecase.ExchangeRateListOptions, queryParams *uctypes.QueryGetListParams) *mExchangeRateRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("ExchangeRateRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &ExchangeRateRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs != nil {
+		mmFindList.mock.t.Fatalf("ExchangeRateRepositoryMock.FindList mock is already set by ExpectParams functions")
+	}
+
+	mmFindList.defaultExpectation.params = &ExchangeRateRepositoryMockFindListParams{ctx, listOptions, queryParams}
+	mmFindList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindList.expectations {
+		if minimock.Equal(e.params, mmFindList.defaultExpectation.params) {
+			mmFindList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindList.defaultExpectation.params)
+		}
+	}
+
+	return mmFindList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ExchangeRateRepository.FindList
+func (mmFindList *mExchangeRateRepositoryMockFindList) ExpectCtxParam1(ctx context.Context) *mExchangeRateRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("ExchangeRateRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &ExchangeRateRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("ExchangeRateRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &ExchangeRateRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for ExchangeRateRepository.FindList
+func (mmFindList *mExchangeRateRepositoryMockFindList) ExpectListOptionsParam2(listOptions *mm_usecase.ExchangeRateListOptions) *mExchangeRateRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("ExchangeRateRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &ExchangeRateRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("ExchangeRateRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &ExchangeRateRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmFindList.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for ExchangeRateRepository.FindList
+func (mmFindList *mExchangeRateRepositoryMockFindList) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mExchangeRateRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("ExchangeRateRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &ExchangeRateRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("ExchangeRateRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &ExchangeRateRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindList.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// Inspect accepts an inspector function that has same arguments as the ExchangeRateRepository.FindList
+func (mmFindList *mExchangeRateRepositoryMockFindList) Inspect(f func(ctx context.Context, listOptions *mm_usecase.ExchangeRateListOptions, queryParams *uctypes.QueryGetListParams)) *mExchangeRateRepositoryMockFindList {
+	if mmFindList.mock.inspectFuncFindList != nil {
+		mmFindList.mock.t.Fatalf("Inspect function is already set for ExchangeRateRepositoryMock.FindList")
+	}
+
+	mmFindList.mock.inspectFuncFindList = f
+
+	return mmFindList
+}
+
+// Return sets up results that will be returned by ExchangeRateRepository.FindList
+func (mmFindList *mExchangeRateRepositoryMockFindList) Return(items []*entity.ExchangeRate, err error) *ExchangeRateRepositoryMock {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("ExchangeRateRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &ExchangeRateRepositoryMockFindListExpectation{mock: mmFindList.mock}
+	}
+	mmFindList.defaultExpectation.results = &ExchangeRateRepositoryMockFindListResults{items, err}
+	mmFindList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// Set uses given function f to mock the ExchangeRateRepository.FindList method
+func (mmFindList *mExchangeRateRepositoryMockFindList) Set(f func(ctx context.Context, listOptions *mm_usecase.ExchangeRateListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.ExchangeRate, err error)) *ExchangeRateRepositoryMock {
+	if mmFindList.defaultExpectation != nil {
+		mmFindList.mock.t.Fatalf("Default expectation is already set for the ExchangeRateRepository.FindList method")
+	}
+
+	if len(mmFindList.expectations) > 0 {
+		mmFindList.mock.t.Fatalf("Some expectations are already set for the ExchangeRateRepository.FindList method")
+	}
+
+	mmFindList.mock.funcFindList = f
+	mmFindList.mock.funcFindListOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// When sets expectation for the ExchangeRateRepository.FindList which will trigger the result defined by the following
+// Then helper
+func (mmFindList *mExchangeRateRepositoryMockFindList) When(ctx context.Context, listOptions *mm_usecase.ExchangeRateListOptions, queryParams *uctypes.QueryGetListParams) *ExchangeRateRepositoryMockFindListExpectation {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("ExchangeRateRepositoryMock.FindList mock is already set by Set")
+	}
+
+	expectation := &ExchangeRateRepositoryMockFindListExpectation{
+		mock:               mmFindList.mock,
+		params:             &ExchangeRateRepositoryMockFindListParams{ctx, listOptions, queryParams},
+		expectationOrigins: ExchangeRateRepositoryMockFindListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindList.expectations = append(mmFindList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ExchangeRateRepository.FindList return parameters for the expectation previously defined by the When method
+func (e *ExchangeRateRepositoryMockFindListExpectation) Then(items []*entity.ExchangeRate, err error) *ExchangeRateRepositoryMock {
+	e.results = &ExchangeRateRepositoryMockFindListResults{items, err}
+	return e.mock
+}
+
+// Times sets number of times ExchangeRateRepository.FindList should be invoked
+func (mmFindList *mExchangeRateRepositoryMockFindList) Times(n uint64) *mExchangeRateRepositoryMockFindList {
+	if n == 0 {
+		mmFindList.mock.t.Fatalf("Times of ExchangeRateRepositoryMock.FindList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindList.expectedInvocations, n)
+	mmFindList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindList
+}
+
+func (mmFindList *mExchangeRateRepositoryMockFindList) invocationsDone() bool {
+	if len(mmFindList.expectations) == 0 && mmFindList.defaultExpectation == nil && mmFindList.mock.funcFindList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindList.mock.afterFindListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindList implements mm_usecase.ExchangeRateRepository
+func (mmFindList *ExchangeRateRepositoryMock) FindList(ctx context.Context, listOptions *mm_usecase.ExchangeRateListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.ExchangeRate, err error) {
+	mm_atomic.AddUint64(&mmFindList.beforeFindListCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindList.afterFindListCounter, 1)
+
+	mmFindList.t.Helper()
+
+	if mmFindList.inspectFuncFindList != nil {
+		mmFindList.inspectFuncFindList(ctx, listOptions, queryParams)
+	}
+
+	mm_params := ExchangeRateRepositoryMockFindListParams{ctx, listOptions, queryParams}
+
+	// Record call args
+	mmFindList.FindListMock.mutex.Lock()
+	mmFindList.FindListMock.callArgs = append(mmFindList.FindListMock.callArgs, &mm_params)
+	mmFindList.FindListMock.mutex.Unlock()
+
+	for _, e := range mmFindList.FindListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.items, e.results.err
+		}
+	}
+
+	if mmFindList.FindListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindList.FindListMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindList.FindListMock.defaultExpectation.params
+		mm_want_ptrs := mmFindList.FindListMock.defaultExpectation.paramPtrs
+
+		mm_got := ExchangeRateRepositoryMockFindListParams{ctx, listOptions, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindList.t.Errorf("ExchangeRateRepositoryMock.FindList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmFindList.t.Errorf("ExchangeRateRepositoryMock.FindList got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindList.t.Errorf("ExchangeRateRepositoryMock.FindList got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindList.t.Errorf("ExchangeRateRepositoryMock.FindList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindList.FindListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindList.FindListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindList.t.Fatal("No results are set for the ExchangeRateRepositoryMock.FindList")
+		}
+		return (*mm_results).items, (*mm_results).err
+	}
+	if mmFindList.funcFindList != nil {
+		return mmFindList.funcFindList(ctx, listOptions, queryParams)
+	}
+	mmFindList.t.Fatalf("Unexpected call to ExchangeRateRepositoryMock.FindList. %v %v %v", ctx, listOptions, queryParams)
+	return
+}
+
+// FindListAfterCounter returns a count of finished ExchangeRateRepositoryMock.FindList invocations
+func (mmFindList *ExchangeRateRepositoryMock) FindListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.afterFindListCounter)
+}
+
+// FindListBeforeCounter returns a count of ExchangeRateRepositoryMock.FindList invocations
+func (mmFindList *ExchangeRateRepositoryMock) FindListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.beforeFindListCounter)
+}
+
+// Calls returns a list of arguments used in each call to ExchangeRateRepositoryMock.FindList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindList *mExchangeRateRepositoryMockFindList) Calls() []*ExchangeRateRepositoryMockFindListParams {
+	mmFindList.mutex.RLock()
+
+	argCopy := make([]*ExchangeRateRepositoryMockFindListParams, len(mmFindList.callArgs))
+	copy(argCopy, mmFindList.callArgs)
+
+	mmFindList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindListDone returns true if the count of the FindList invocations corresponds
+// the number of defined expectations
+func (m *ExchangeRateRepositoryMock) MinimockFindListDone() bool {
+	if m.FindListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindListMock.invocationsDone()
+}
+
+// MinimockFindListInspect logs each unmet expectation
+func (m *ExchangeRateRepositoryMock) MinimockFindListInspect() {
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ExchangeRateRepositoryMock.FindList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindListCounter := mm_atomic.LoadUint64(&m.afterFindListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindListMock.defaultExpectation != nil && afterFindListCounter < 1 {
+		if m.FindListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ExchangeRateRepositoryMock.FindList at\n%s", m.FindListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ExchangeRateRepositoryMock.FindList at\n%s with params: %#v", m.FindListMock.defaultExpectation.expectationOrigins.origin, *m.FindListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindList != nil && afterFindListCounter < 1 {
+		m.t.Errorf("Expected call to ExchangeRateRepositoryMock.FindList at\n%s", m.funcFindListOrigin)
+	}
+
+	if !m.FindListMock.invocationsDone() && afterFindListCounter > 0 {
+		m.t.Errorf("Expected %d calls to ExchangeRateRepositoryMock.FindList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindListMock.expectedInvocations), m.FindListMock.expectedInvocationsOrigin, afterFindListCounter)
+	}
+}
+
+type mExchangeRateRepositoryMockUpsert struct {
+	optional           bool
+	mock               *ExchangeRateRepositoryMock
+	defaultExpectation *ExchangeRateRepositoryMockUpsertExpectation
+	expectations       []*ExchangeRateRepositoryMockUpsertExpectation
+
+	callArgs []*ExchangeRateRepositoryMockUpsertParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ExchangeRateRepositoryMockUpsertExpectation specifies expectation struct of the ExchangeRateRepository.Upsert
+type ExchangeRateRepositoryMockUpsertExpectation struct {
+	mock               *ExchangeRateRepositoryMock
+	params             *ExchangeRateRepositoryMockUpsertParams
+	paramPtrs          *ExchangeRateRepositoryMockUpsertParamPtrs
+	expectationOrigins ExchangeRateRepositoryMockUpsertExpectationOrigins
+	results            *ExchangeRateRepositoryMockUpsertResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ExchangeRateRepositoryMockUpsertParams contains parameters of the ExchangeRateRepository.Upsert
+type ExchangeRateRepositoryMockUpsertParams struct {
+	ctx   context.Context
+	items []*entity.ExchangeRate
+}
+
+// ExchangeRateRepositoryMockUpsertParamPtrs contains pointers to parameters of the ExchangeRateRepository.Upsert
+type ExchangeRateRepositoryMockUpsertParamPtrs struct {
+	ctx   *context.Context
+	items *[]*entity.ExchangeRate
+}
+
+// ExchangeRateRepositoryMockUpsertResults contains results of the ExchangeRateRepository.Upsert
+type ExchangeRateRepositoryMockUpsertResults struct {
+	err error
+}
+
+// ExchangeRateRepositoryMockUpsertOrigins contains origins of expectations of the ExchangeRateRepository.Upsert
+type ExchangeRateRepositoryMockUpsertExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originItems string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpsert *mExchangeRateRepositoryMockUpsert) Optional() *mExchangeRateRepositoryMockUpsert {
+	mmUpsert.optional = true
+	return mmUpsert
+}
+
+// Expect sets up expected params for ExchangeRateRepository.Upsert
+func (mmUpsert *mExchangeRateRepositoryMockUpsert) Expect(ctx context.Context, items []*entity.ExchangeRate) *mExchangeRateRepositoryMockUpsert {
+	if mmUpsert.mock.funcUpsert != nil {
+		mmUpsert.mock.t.Fatalf("ExchangeRateRepositoryMock.Upsert mock is already set by Set")
+	}
+
+	if mmUpsert.defaultExpectation == nil {
+		mmUpsert.defaultExpectation = &ExchangeRateRepositoryMockUpsertExpectation{}
+	}
+
+	if mmUpsert.defaultExpectation.paramPtrs != nil {
+		mmUpsert.mock.t.Fatalf("ExchangeRateRepositoryMock.Upsert mock is already set by ExpectParams functions")
+	}
+
+	mmUpsert.defaultExpectation.params = &ExchangeRateRepositoryMockUpsertParams{ctx, items}
+	mmUpsert.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpsert.expectations {
+		if minimock.Equal(e.params, mmUpsert.defaultExpectation.params) {
+			mmUpsert.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpsert.defaultExpectation.params)
+		}
+	}
+
+	return mmUpsert
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ExchangeRateRepository.Upsert
+func (mmUpsert *mExchangeRateRepositoryMockUpsert) ExpectCtxParam1(ctx context.Context) *mExchangeRateRepositoryMockUpsert {
+	if mmUpsert.mock.funcUpsert != nil {
+		mmUpsert.mock.t.Fatalf("ExchangeRateRepositoryMock.Upsert mock is already set by Set")
+	}
+
+	if mmUpsert.defaultExpectation == nil {
+		mmUpsert.defaultExpectation = &ExchangeRateRepositoryMockUpsertExpectation{}
+	}
+
+	if mmUpsert.defaultExpectation.params != nil {
+		mmUpsert.mock.t.Fatalf("ExchangeRateRepositoryMock.Upsert mock is already set by Expect")
+	}
+
+	if mmUpsert.defaultExpectation.paramPtrs == nil {
+		mmUpsert.defaultExpectation.paramPtrs = &ExchangeRateRepositoryMockUpsertParamPtrs{}
+	}
+	mmUpsert.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpsert.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpsert
+}
+
+// ExpectItemsParam2 sets up expected param items for ExchangeRateRepository.Upsert
+func (mmUpsert *mExchangeRateRepositoryMockUpsert) ExpectItemsParam2(items []*entity.ExchangeRate) *mExchangeRateRepositoryMockUpsert {
+	if mmUpsert.mock.funcUpsert != nil {
+		mmUpsert.mock.t.Fatalf("ExchangeRateRepositoryMock.Upsert mock is already set by Set")
+	}
+
+	if mmUpsert.defaultExpectation == nil {
+		mmUpsert.defaultExpectation = &ExchangeRateRepositoryMockUpsertExpectation{}
+	}
+
+	if mmUpsert.defaultExpectation.params != nil {
+		mmUpsert.mock.t.Fatalf("ExchangeRateRepositoryMock.Upsert mock is already set by Expect")
+	}
+
+	if mmUpsert.defaultExpectation.paramPtrs == nil {
+		mmUpsert.defaultExpectation.paramPtrs = &ExchangeRateRepositoryMockUpsertParamPtrs{}
+	}
+	mmUpsert.defaultExpectation.paramPtrs.items = &items
+	mmUpsert.defaultExpectation.expectationOrigins.originItems = minimock.CallerInfo(1)
+
+	return mmUpsert
+}
+
+// Inspect accepts an inspector function that has same arguments as the ExchangeRateRepository.Upsert
+func (mmUpsert *mExchangeRateRepositoryMockUpsert) Inspect(f func(ctx context.Context, items []*entity.ExchangeRate)) *mExchangeRateRepositoryMockUpsert {
+	if mmUpsert.mock.inspectFuncUpsert != nil {
+		mmUpsert.mock.t.Fatalf("Inspect function is already set for ExchangeRateRepositoryMock.Upsert")
+	}
+
+	mmUpsert.mock.inspectFuncUpsert = f
+
+	return mmUpsert
+}
+
+// Return sets up results that will be returned by ExchangeRateRepository.Upsert
+func (mmUpsert *mExchangeRateRepositoryMockUpsert) Return(err error) *ExchangeRateRepositoryMock {
+	if mmUpsert.mock.funcUpsert != nil {
+		mmUpsert.mock.t.Fatalf("ExchangeRateRepositoryMock.Upsert mock is already set by Set")
+	}
+
+	if mmUpsert.defaultExpectation == nil {
+		mmUpsert.defaultExpectation = &ExchangeRateRepositoryMockUpsertExpectation{mock: mmUpsert.mock}
+	}
+	mmUpsert.defaultExpectation.results = &ExchangeRateRepositoryMockUpsertResults{err}
+	mmUpsert.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpsert.mock
+}
+
+// Set uses given function f to mock the ExchangeRateRepository.Upsert method
+func (mmUpsert *mExchangeRateRepositoryMockUpsert) Set(f func(ctx context.Context, items []*entity.ExchangeRate) (err error)) *ExchangeRateRepositoryMock {
+	if mmUpsert.defaultExpectation != nil {
+		mmUpsert.mock.t.Fatalf("Default expectation is already set for the ExchangeRateRepository.Upsert method")
+	}
+
+	if len(mmUpsert.expectations) > 0 {
+		mmUpsert.mock.t.Fatalf("Some expectations are already set for the ExchangeRateRepository.Upsert method")
+	}
+
+	mmUpsert.mock.funcUpsert = f
+	mmUpsert.mock.funcUpsertOrigin = minimock.CallerInfo(1)
+	return mmUpsert.mock
+}
+
+// When sets expectation for the ExchangeRateRepository.Upsert which will trigger the result defined by the following
+// Then helper
+func (mmUpsert *mExchangeRateRepositoryMockUpsert) When(ctx context.Context, items []*entity.ExchangeRate) *ExchangeRateRepositoryMockUpsertExpectation {
+	if mmUpsert.mock.funcUpsert != nil {
+		mmUpsert.mock.t.Fatalf("ExchangeRateRepositoryMock.Upsert mock is already set by Set")
+	}
+
+	expectation := &ExchangeRateRepositoryMockUpsertExpectation{
+		mock:               mmUpsert.mock,
+		params:             &ExchangeRateRepositoryMockUpsertParams{ctx, items},
+		expectationOrigins: ExchangeRateRepositoryMockUpsertExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpsert.expectations = append(mmUpsert.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ExchangeRateRepository.Upsert return parameters for the expectation previously defined by the When method
+func (e *ExchangeRateRepositoryMockUpsertExpectation) Then(err error) *ExchangeRateRepositoryMock {
+	e.results = &ExchangeRateRepositoryMockUpsertResults{err}
+	return e.mock
+}
+
+// Times sets number of times ExchangeRateRepository.Upsert should be invoked
+func (mmUpsert *mExchangeRateRepositoryMockUpsert) Times(n uint64) *mExchangeRateRepositoryMockUpsert {
+	if n == 0 {
+		mmUpsert.mock.t.Fatalf("Times of ExchangeRateRepositoryMock.Upsert mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpsert.expectedInvocations, n)
+	mmUpsert.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpsert
+}
+
+func (mmUpsert *mExchangeRateRepositoryMockUpsert) invocationsDone() bool {
+	if len(mmUpsert.expectations) == 0 && mmUpsert.defaultExpectation == nil && mmUpsert.mock.funcUpsert == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpsert.mock.afterUpsertCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpsert.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Upsert implements mm_usecase.ExchangeRateRepository
+func (mmUpsert *ExchangeRateRepositoryMock) Upsert(ctx context.Context, items []*entity.ExchangeRate) (err error) {
+	mm_atomic.AddUint64(&mmUpsert.beforeUpsertCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpsert.afterUpsertCounter, 1)
+
+	mmUpsert.t.Helper()
+
+	if mmUpsert.inspectFuncUpsert != nil {
+		mmUpsert.inspectFuncUpsert(ctx, items)
+	}
+
+	mm_params := ExchangeRateRepositoryMockUpsertParams{ctx, items}
+
+	// Record call args
+	mmUpsert.UpsertMock.mutex.Lock()
+	mmUpsert.UpsertMock.callArgs = append(mmUpsert.UpsertMock.callArgs, &mm_params)
+	mmUpsert.UpsertMock.mutex.Unlock()
+
+	for _, e := range mmUpsert.UpsertMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpsert.UpsertMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpsert.UpsertMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpsert.UpsertMock.defaultExpectation.params
+		mm_want_ptrs := mmUpsert.UpsertMock.defaultExpectation.paramPtrs
+
+		mm_got := ExchangeRateRepositoryMockUpsertParams{ctx, items}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpsert.t.Errorf("ExchangeRateRepositoryMock.Upsert got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpsert.UpsertMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.items != nil && !minimock.Equal(*mm_want_ptrs.items, mm_got.items) {
+				mmUpsert.t.Errorf("ExchangeRateRepositoryMock.Upsert got unexpected parameter items, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpsert.UpsertMock.defaultExpectation.expectationOrigins.originItems, *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpsert.t.Errorf("ExchangeRateRepositoryMock.Upsert got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpsert.UpsertMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpsert.UpsertMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpsert.t.Fatal("No results are set for the ExchangeRateRepositoryMock.Upsert")
+		}
+		return (*mm_results).err
+	}
+	if mmUpsert.funcUpsert != nil {
+		return mmUpsert.funcUpsert(ctx, items)
+	}
+	mmUpsert.t.Fatalf("Unexpected call to ExchangeRateRepositoryMock.Upsert. %v %v", ctx, items)
+	return
+}
+
+// UpsertAfterCounter returns a count of finished ExchangeRateRepositoryMock.Upsert invocations
+func (mmUpsert *ExchangeRateRepositoryMock) UpsertAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsert.afterUpsertCounter)
+}
+
+// UpsertBeforeCounter returns a count of ExchangeRateRepositoryMock.Upsert invocations
+func (mmUpsert *ExchangeRateRepositoryMock) UpsertBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsert.beforeUpsertCounter)
+}
+
+// Calls returns a list of arguments used in each call to ExchangeRateRepositoryMock.Upsert.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpsert *mExchangeRateRepositoryMockUpsert) Calls() []*ExchangeRateRepositoryMockUpsertParams {
+	mmUpsert.mutex.RLock()
+
+	argCopy := make([]*ExchangeRateRepositoryMockUpsertParams, len(mmUpsert.callArgs))
+	copy(argCopy, mmUpsert.callArgs)
+
+	mmUpsert.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpsertDone returns true if the count of the Upsert invocations corresponds
+// the number of defined expectations
+func (m *ExchangeRateRepositoryMock) MinimockUpsertDone() bool {
+	if m.UpsertMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpsertMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpsertMock.invocationsDone()
+}
+
+// MinimockUpsertInspect logs each unmet expectation
+func (m *ExchangeRateRepositoryMock) MinimockUpsertInspect() {
+	for _, e := range m.UpsertMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ExchangeRateRepositoryMock.Upsert at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpsertCounter := mm_atomic.LoadUint64(&m.afterUpsertCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpsertMock.defaultExpectation != nil && afterUpsertCounter < 1 {
+		if m.UpsertMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ExchangeRateRepositoryMock.Upsert at\n%s", m.UpsertMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ExchangeRateRepositoryMock.Upsert at\n%s with params: %#v", m.UpsertMock.defaultExpectation.expectationOrigins.origin, *m.UpsertMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpsert != nil && afterUpsertCounter < 1 {
+		m.t.Errorf("Expected call to ExchangeRateRepositoryMock.Upsert at\n%s", m.funcUpsertOrigin)
+	}
+
+	if !m.UpsertMock.invocationsDone() && afterUpsertCounter > 0 {
+		m.t.Errorf("Expected %d calls to ExchangeRateRepositoryMock.Upsert at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpsertMock.expectedInvocations), m.UpsertMock.expectedInvocationsOrigin, afterUpsertCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ExchangeRateRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockFindActualInspect()
+
+			m.MinimockFindListInspect()
+
+			m.MinimockUpsertInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ExchangeRateRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ExchangeRateRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockFindActualDone() &&
+		m.MinimockFindListDone() &&
+		m.MinimockUpsertDone()
+}
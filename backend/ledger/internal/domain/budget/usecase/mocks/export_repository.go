@@ -0,0 +1,1368 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.ExportRepository -o export_repository.go -n ExportRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_usecase "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// ExportRepositoryMock implements mm_usecase.ExportRepository
+type ExportRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcContentType          func() (s1 string)
+	funcContentTypeOrigin    string
+	inspectFuncContentType   func()
+	afterContentTypeCounter  uint64
+	beforeContentTypeCounter uint64
+	ContentTypeMock          mExportRepositoryMockContentType
+
+	funcFileExtension          func() (s1 string)
+	funcFileExtensionOrigin    string
+	inspectFuncFileExtension   func()
+	afterFileExtensionCounter  uint64
+	beforeFileExtensionCounter uint64
+	FileExtensionMock          mExportRepositoryMockFileExtension
+
+	funcFormat          func() (e1 mm_usecase.ExportFormat)
+	funcFormatOrigin    string
+	inspectFuncFormat   func()
+	afterFormatCounter  uint64
+	beforeFormatCounter uint64
+	FormatMock          mExportRepositoryMockFormat
+
+	funcReportsToFile          func(ctx context.Context, rows []*mm_usecase.ReportExportRow) (ba1 []byte, err error)
+	funcReportsToFileOrigin    string
+	inspectFuncReportsToFile   func(ctx context.Context, rows []*mm_usecase.ReportExportRow)
+	afterReportsToFileCounter  uint64
+	beforeReportsToFileCounter uint64
+	ReportsToFileMock          mExportRepositoryMockReportsToFile
+
+	funcTransactionsToFile          func(ctx context.Context, items []*mm_usecase.TransactionDTO) (ba1 []byte, err error)
+	funcTransactionsToFileOrigin    string
+	inspectFuncTransactionsToFile   func(ctx context.Context, items []*mm_usecase.TransactionDTO)
+	afterTransactionsToFileCounter  uint64
+	beforeTransactionsToFileCounter uint64
+	TransactionsToFileMock          mExportRepositoryMockTransactionsToFile
+}
+
+// NewExportRepositoryMock returns a mock for mm_usecase.ExportRepository
+func NewExportRepositoryMock(t minimock.Tester) *ExportRepositoryMock {
+	m := &ExportRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ContentTypeMock = mExportRepositoryMockContentType{mock: m}
+
+	m.FileExtensionMock = mExportRepositoryMockFileExtension{mock: m}
+
+	m.FormatMock = mExportRepositoryMockFormat{mock: m}
+
+	m.ReportsToFileMock = mExportRepositoryMockReportsToFile{mock: m}
+	m.ReportsToFileMock.callArgs = []*ExportRepositoryMockReportsToFileParams{}
+
+	m.TransactionsToFileMock = mExportRepositoryMockTransactionsToFile{mock: m}
+	m.TransactionsToFileMock.callArgs = []*ExportRepositoryMockTransactionsToFileParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mExportRepositoryMockContentType struct {
+	optional           bool
+	mock               *ExportRepositoryMock
+	defaultExpectation *ExportRepositoryMockContentTypeExpectation
+	expectations       []*ExportRepositoryMockContentTypeExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ExportRepositoryMockContentTypeExpectation specifies expectation struct of the ExportRepository.ContentType
+type ExportRepositoryMockContentTypeExpectation struct {
+	mock *ExportRepositoryMock
+
+	results      *ExportRepositoryMockContentTypeResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// ExportRepositoryMockContentTypeResults contains results of the ExportRepository.ContentType
+type ExportRepositoryMockContentTypeResults struct {
+	s1 string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmContentType *mExportRepositoryMockContentType) Optional() *mExportRepositoryMockContentType {
+	mmContentType.optional = true
+	return mmContentType
+}
+
+// Expect sets up expected params for ExportRepository.ContentType
+func (mmContentType *mExportRepositoryMockContentType) Expect() *mExportRepositoryMockContentType {
+	if mmContentType.mock.funcContentType != nil {
+		mmContentType.mock.t.Fatalf("ExportRepositoryMock.ContentType mock is already set by Set")
+	}
+
+	if mmContentType.defaultExpectation == nil {
+		mmContentType.defaultExpectation = &ExportRepositoryMockContentTypeExpectation{}
+	}
+
+	return mmContentType
+}
+
+// Inspect accepts an inspector function that has same arguments as the ExportRepository.ContentType
+func (mmContentType *mExportRepositoryMockContentType) Inspect(f func()) *mExportRepositoryMockContentType {
+	if mmContentType.mock.inspectFuncContentType != nil {
+		mmContentType.mock.t.Fatalf("Inspect function is already set for ExportRepositoryMock.ContentType")
+	}
+
+	mmContentType.mock.inspectFuncContentType = f
+
+	return mmContentType
+}
+
+// Return sets up results that will be returned by ExportRepository.ContentType
+func (mmContentType *mExportRepositoryMockContentType) Return(s1 string) *ExportRepositoryMock {
+	if mmContentType.mock.funcContentType != nil {
+		mmContentType.mock.t.Fatalf("ExportRepositoryMock.ContentType mock is already set by Set")
+	}
+
+	if mmContentType.defaultExpectation == nil {
+		mmContentType.defaultExpectation = &ExportRepositoryMockContentTypeExpectation{mock: mmContentType.mock}
+	}
+	mmContentType.defaultExpectation.results = &ExportRepositoryMockContentTypeResults{s1}
+	mmContentType.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmContentType.mock
+}
+
+// Set uses given function f to mock the ExportRepository.ContentType method
+func (mmContentType *mExportRepositoryMockContentType) Set(f func() (s1 string)) *ExportRepositoryMock {
+	if mmContentType.defaultExpectation != nil {
+		mmContentType.mock.t.Fatalf("Default expectation is already set for the ExportRepository.ContentType method")
+	}
+
+	if len(mmContentType.expectations) > 0 {
+		mmContentType.mock.t.Fatalf("Some expectations are already set for the ExportRepository.ContentType method")
+	}
+
+	mmContentType.mock.funcContentType = f
+	mmContentType.mock.funcContentTypeOrigin = minimock.CallerInfo(1)
+	return mmContentType.mock
+}
+
+// Times sets number of times ExportRepository.ContentType should be invoked
+func (mmContentType *mExportRepositoryMockContentType) Times(n uint64) *mExportRepositoryMockContentType {
+	if n == 0 {
+		mmContentType.mock.t.Fatalf("Times of ExportRepositoryMock.ContentType mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmContentType.expectedInvocations, n)
+	mmContentType.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmContentType
+}
+
+func (mmContentType *mExportRepositoryMockContentType) invocationsDone() bool {
+	if len(mmContentType.expectations) == 0 && mmContentType.defaultExpectation == nil && mmContentType.mock.funcContentType == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmContentType.mock.afterContentTypeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmContentType.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ContentType implements mm_usecase.ExportRepository
+func (mmContentType *ExportRepositoryMock) ContentType() (s1 string) {
+	mm_atomic.AddUint64(&mmContentType.beforeContentTypeCounter, 1)
+	defer mm_atomic.AddUint64(&mmContentType.afterContentTypeCounter, 1)
+
+	mmContentType.t.Helper()
+
+	if mmContentType.inspectFuncContentType != nil {
+		mmContentType.inspectFuncContentType()
+	}
+
+	if mmContentType.ContentTypeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmContentType.ContentTypeMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmContentType.ContentTypeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmContentType.t.Fatal("No results are set for the ExportRepositoryMock.ContentType")
+		}
+		return (*mm_results).s1
+	}
+	if mmContentType.funcContentType != nil {
+		return mmContentType.funcContentType()
+	}
+	mmContentType.t.Fatalf("Unexpected call to ExportRepositoryMock.ContentType.")
+	return
+}
+
+// ContentTypeAfterCounter returns a count of finished ExportRepositoryMock.ContentType invocations
+func (mmContentType *ExportRepositoryMock) ContentTypeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmContentType.afterContentTypeCounter)
+}
+
+// ContentTypeBeforeCounter returns a count of ExportRepositoryMock.ContentType invocations
+func (mmContentType *ExportRepositoryMock) ContentTypeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmContentType.beforeContentTypeCounter)
+}
+
+// MinimockContentTypeDone returns true if the count of the ContentType invocations corresponds
+// the number of defined expectations
+func (m *ExportRepositoryMock) MinimockContentTypeDone() bool {
+	if m.ContentTypeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ContentTypeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ContentTypeMock.invocationsDone()
+}
+
+// MinimockContentTypeInspect logs each unmet expectation
+func (m *ExportRepositoryMock) MinimockContentTypeInspect() {
+	for _, e := range m.ContentTypeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ExportRepositoryMock.ContentType")
+		}
+	}
+
+	afterContentTypeCounter := mm_atomic.LoadUint64(&m.afterContentTypeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ContentTypeMock.defaultExpectation != nil && afterContentTypeCounter < 1 {
+		m.t.Errorf("Expected call to ExportRepositoryMock.ContentType at\n%s", m.ContentTypeMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcContentType != nil && afterContentTypeCounter < 1 {
+		m.t.Errorf("Expected call to ExportRepositoryMock.ContentType at\n%s", m.funcContentTypeOrigin)
+	}
+
+	if !m.ContentTypeMock.invocationsDone() && afterContentTypeCounter > 0 {
+		m.t.Errorf("Expected %d calls to ExportRepositoryMock.ContentType at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ContentTypeMock.expectedInvocations), m.ContentTypeMock.expectedInvocationsOrigin, afterContentTypeCounter)
+	}
+}
+
+type mExportRepositoryMockFileExtension struct {
+	optional           bool
+	mock               *ExportRepositoryMock
+	defaultExpectation *ExportRepositoryMockFileExtensionExpectation
+	expectations       []*ExportRepositoryMockFileExtensionExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ExportRepositoryMockFileExtensionExpectation specifies expectation struct of the ExportRepository.FileExtension
+type ExportRepositoryMockFileExtensionExpectation struct {
+	mock *ExportRepositoryMock
+
+	results      *ExportRepositoryMockFileExtensionResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// ExportRepositoryMockFileExtensionResults contains results of the ExportRepository.FileExtension
+type ExportRepositoryMockFileExtensionResults struct {
+	s1 string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFileExtension *mExportRepositoryMockFileExtension) Optional() *mExportRepositoryMockFileExtension {
+	mmFileExtension.optional = true
+	return mmFileExtension
+}
+
+// Expect sets up expected params for ExportRepository.FileExtension
+func (mmFileExtension *mExportRepositoryMockFileExtension) Expect() *mExportRepositoryMockFileExtension {
+	if mmFileExtension.mock.funcFileExtension != nil {
+		mmFileExtension.mock.t.Fatalf("ExportRepositoryMock.FileExtension mock is already set by Set")
+	}
+
+	if mmFileExtension.defaultExpectation == nil {
+		mmFileExtension.defaultExpectation = &ExportRepositoryMockFileExtensionExpectation{}
+	}
+
+	return mmFileExtension
+}
+
+// Inspect accepts an inspector function that has same arguments as the ExportRepository.FileExtension
+func (mmFileExtension *mExportRepositoryMockFileExtension) Inspect(f func()) *mExportRepositoryMockFileExtension {
+	if mmFileExtension.mock.inspectFuncFileExtension != nil {
+		mmFileExtension.mock.t.Fatalf("Inspect function is already set for ExportRepositoryMock.FileExtension")
+	}
+
+	mmFileExtension.mock.inspectFuncFileExtension = f
+
+	return mmFileExtension
+}
+
+// Return sets up results that will be returned by ExportRepository.FileExtension
+func (mmFileExtension *mExportRepositoryMockFileExtension) Return(s1 string) *ExportRepositoryMock {
+	if mmFileExtension.mock.funcFileExtension != nil {
+		mmFileExtension.mock.t.Fatalf("ExportRepositoryMock.FileExtension mock is already set by Set")
+	}
+
+	if mmFileExtension.defaultExpectation == nil {
+		mmFileExtension.defaultExpectation = &ExportRepositoryMockFileExtensionExpectation{mock: mmFileExtension.mock}
+	}
+	mmFileExtension.defaultExpectation.results = &ExportRepositoryMockFileExtensionResults{s1}
+	mmFileExtension.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFileExtension.mock
+}
+
+// Set uses given function f to mock the ExportRepository.FileExtension method
+func (mmFileExtension *mExportRepositoryMockFileExtension) Set(f func() (s1 string)) *ExportRepositoryMock {
+	if mmFileExtension.defaultExpectation != nil {
+		mmFileExtension.mock.t.Fatalf("Default expectation is already set for the ExportRepository.FileExtension method")
+	}
+
+	if len(mmFileExtension.expectations) > 0 {
+		mmFileExtension.mock.t.Fatalf("Some expectations are already set for the ExportRepository.FileExtension method")
+	}
+
+	mmFileExtension.mock.funcFileExtension = f
+	mmFileExtension.mock.funcFileExtensionOrigin = minimock.CallerInfo(1)
+	return mmFileExtension.mock
+}
+
+// Times sets number of times ExportRepository.FileExtension should be invoked
+func (mmFileExtension *mExportRepositoryMockFileExtension) Times(n uint64) *mExportRepositoryMockFileExtension {
+	if n == 0 {
+		mmFileExtension.mock.t.Fatalf("Times of ExportRepositoryMock.FileExtension mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFileExtension.expectedInvocations, n)
+	mmFileExtension.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFileExtension
+}
+
+func (mmFileExtension *mExportRepositoryMockFileExtension) invocationsDone() bool {
+	if len(mmFileExtension.expectations) == 0 && mmFileExtension.defaultExpectation == nil && mmFileExtension.mock.funcFileExtension == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFileExtension.mock.afterFileExtensionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFileExtension.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FileExtension implements mm_usecase.ExportRepository
+func (mmFileExtension *ExportRepositoryMock) FileExtension() (s1 string) {
+	mm_atomic.AddUint64(&mmFileExtension.beforeFileExtensionCounter, 1)
+	defer mm_atomic.AddUint64(&mmFileExtension.afterFileExtensionCounter, 1)
+
+	mmFileExtension.t.Helper()
+
+	if mmFileExtension.inspectFuncFileExtension != nil {
+		mmFileExtension.inspectFuncFileExtension()
+	}
+
+	if mmFileExtension.FileExtensionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFileExtension.FileExtensionMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmFileExtension.FileExtensionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFileExtension.t.Fatal("No results are set for the ExportRepositoryMock.FileExtension")
+		}
+		return (*mm_results).s1
+	}
+	if mmFileExtension.funcFileExtension != nil {
+		return mmFileExtension.funcFileExtension()
+	}
+	mmFileExtension.t.Fatalf("Unexpected call to ExportRepositoryMock.FileExtension.")
+	return
+}
+
+// FileExtensionAfterCounter returns a count of finished ExportRepositoryMock.FileExtension invocations
+func (mmFileExtension *ExportRepositoryMock) FileExtensionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFileExtension.afterFileExtensionCounter)
+}
+
+// FileExtensionBeforeCounter returns a count of ExportRepositoryMock.FileExtension invocations
+func (mmFileExtension *ExportRepositoryMock) FileExtensionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFileExtension.beforeFileExtensionCounter)
+}
+
+// MinimockFileExtensionDone returns true if the count of the FileExtension invocations corresponds
+// the number of defined expectations
+func (m *ExportRepositoryMock) MinimockFileExtensionDone() bool {
+	if m.FileExtensionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FileExtensionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FileExtensionMock.invocationsDone()
+}
+
+// MinimockFileExtensionInspect logs each unmet expectation
+func (m *ExportRepositoryMock) MinimockFileExtensionInspect() {
+	for _, e := range m.FileExtensionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ExportRepositoryMock.FileExtension")
+		}
+	}
+
+	afterFileExtensionCounter := mm_atomic.LoadUint64(&m.afterFileExtensionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FileExtensionMock.defaultExpectation != nil && afterFileExtensionCounter < 1 {
+		m.t.Errorf("Expected call to ExportRepositoryMock.FileExtension at\n%s", m.FileExtensionMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFileExtension != nil && afterFileExtensionCounter < 1 {
+		m.t.Errorf("Expected call to ExportRepositoryMock.FileExtension at\n%s", m.funcFileExtensionOrigin)
+	}
+
+	if !m.FileExtensionMock.invocationsDone() && afterFileExtensionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ExportRepositoryMock.FileExtension at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FileExtensionMock.expectedInvocations), m.FileExtensionMock.expectedInvocationsOrigin, afterFileExtensionCounter)
+	}
+}
+
+type mExportRepositoryMockFormat struct {
+	optional           bool
+	mock               *ExportRepositoryMock
+	defaultExpectation *ExportRepositoryMockFormatExpectation
+	expectations       []*ExportRepositoryMockFormatExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ExportRepositoryMockFormatExpectation specifies expectation struct of the ExportRepository.Format
+type ExportRepositoryMockFormatExpectation struct {
+	mock *ExportRepositoryMock
+
+	results      *ExportRepositoryMockFormatResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// ExportRepositoryMockFormatResults contains results of the ExportRepository.Format
+type ExportRepositoryMockFormatResults struct {
+	e1 mm_usecase.ExportFormat
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFormat *mExportRepositoryMockFormat) Optional() *mExportRepositoryMockFormat {
+	mmFormat.optional = true
+	return mmFormat
+}
+
+// Expect sets up expected params for ExportRepository.Format
+func (mmFormat *mExportRepositoryMockFormat) Expect() *mExportRepositoryMockFormat {
+	if mmFormat.mock.funcFormat != nil {
+		mmFormat.mock.t.Fatalf("ExportRepositoryMock.Format mock is already set by Set")
+	}
+
+	if mmFormat.defaultExpectation == nil {
+		mmFormat.defaultExpectation = &ExportRepositoryMockFormatExpectation{}
+	}
+
+	return mmFormat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ExportRepository.Format
+func (mmFormat *mExportRepositoryMockFormat) Inspect(f func()) *mExportRepositoryMockFormat {
+	if mmFormat.mock.inspectFuncFormat != nil {
+		mmFormat.mock.t.Fatalf("Inspect function is already set for ExportRepositoryMock.Format")
+	}
+
+	mmFormat.mock.inspectFuncFormat = f
+
+	return mmFormat
+}
+
+// Return sets up results that will be returned by ExportRepository.Format
+func (mmFormat *mExportRepositoryMockFormat) Return(e1 mm_usecase.ExportFormat) *ExportRepositoryMock {
+	if mmFormat.mock.funcFormat != nil {
+		mmFormat.mock.t.Fatalf("ExportRepositoryMock.Format mock is already set by Set")
+	}
+
+	if mmFormat.defaultExpectation == nil {
+		mmFormat.defaultExpectation = &ExportRepositoryMockFormatExpectation{mock: mmFormat.mock}
+	}
+	mmFormat.defaultExpectation.results = &ExportRepositoryMockFormatResults{e1}
+	mmFormat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFormat.mock
+}
+
+// Set uses given function f to mock the ExportRepository.Format method
+func (mmFormat *mExportRepositoryMockFormat) Set(f func() (e1 mm_usecase.ExportFormat)) *ExportRepositoryMock {
+	if mmFormat.defaultExpectation != nil {
+		mmFormat.mock.t.Fatalf("Default expectation is already set for the ExportRepository.Format method")
+	}
+
+	if len(mmFormat.expectations) > 0 {
+		mmFormat.mock.t.Fatalf("Some expectations are already set for the ExportRepository.Format method")
+	}
+
+	mmFormat.mock.funcFormat = f
+	mmFormat.mock.funcFormatOrigin = minimock.CallerInfo(1)
+	return mmFormat.mock
+}
+
+// Times sets number of times ExportRepository.Format should be invoked
+func (mmFormat *mExportRepositoryMockFormat) Times(n uint64) *mExportRepositoryMockFormat {
+	if n == 0 {
+		mmFormat.mock.t.Fatalf("Times of ExportRepositoryMock.Format mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFormat.expectedInvocations, n)
+	mmFormat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFormat
+}
+
+func (mmFormat *mExportRepositoryMockFormat) invocationsDone() bool {
+	if len(mmFormat.expectations) == 0 && mmFormat.defaultExpectation == nil && mmFormat.mock.funcFormat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFormat.mock.afterFormatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFormat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Format implements mm_usecase.ExportRepository
+func (mmFormat *ExportRepositoryMock) Format() (e1 mm_usecase.ExportFormat) {
+	mm_atomic.AddUint64(&mmFormat.beforeFormatCounter, 1)
+	defer mm_atomic.AddUint64(&mmFormat.afterFormatCounter, 1)
+
+	mmFormat.t.Helper()
+
+	if mmFormat.inspectFuncFormat != nil {
+		mmFormat.inspectFuncFormat()
+	}
+
+	if mmFormat.FormatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFormat.FormatMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmFormat.FormatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFormat.t.Fatal("No results are set for the ExportRepositoryMock.Format")
+		}
+		return (*mm_results).e1
+	}
+	if mmFormat.funcFormat != nil {
+		return mmFormat.funcFormat()
+	}
+	mmFormat.t.Fatalf("Unexpected call to ExportRepositoryMock.Format.")
+	return
+}
+
+// FormatAfterCounter returns a count of finished ExportRepositoryMock.Format invocations
+func (mmFormat *ExportRepositoryMock) FormatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFormat.afterFormatCounter)
+}
+
+// FormatBeforeCounter returns a count of ExportRepositoryMock.Format invocations
+func (mmFormat *ExportRepositoryMock) FormatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFormat.beforeFormatCounter)
+}
+
+// MinimockFormatDone returns true if the count of the Format invocations corresponds
+// the number of defined expectations
+func (m *ExportRepositoryMock) MinimockFormatDone() bool {
+	if m.FormatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FormatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FormatMock.invocationsDone()
+}
+
+// MinimockFormatInspect logs each unmet expectation
+func (m *ExportRepositoryMock) MinimockFormatInspect() {
+	for _, e := range m.FormatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ExportRepositoryMock.Format")
+		}
+	}
+
+	afterFormatCounter := mm_atomic.LoadUint64(&m.afterFormatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FormatMock.defaultExpectation != nil && afterFormatCounter < 1 {
+		m.t.Errorf("Expected call to ExportRepositoryMock.Format at\n%s", m.FormatMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFormat != nil && afterFormatCounter < 1 {
+		m.t.Errorf("Expected call to ExportRepositoryMock.Format at\n%s", m.funcFormatOrigin)
+	}
+
+	if !m.FormatMock.invocationsDone() && afterFormatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ExportRepositoryMock.Format at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FormatMock.expectedInvocations), m.FormatMock.expectedInvocationsOrigin, afterFormatCounter)
+	}
+}
+
+type mExportRepositoryMockReportsToFile struct {
+	optional           bool
+	mock               *ExportRepositoryMock
+	defaultExpectation *ExportRepositoryMockReportsToFileExpectation
+	expectations       []*ExportRepositoryMockReportsToFileExpectation
+
+	callArgs []*ExportRepositoryMockReportsToFileParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ExportRepositoryMockReportsToFileExpectation specifies expectation struct of the ExportRepository.ReportsToFile
+type ExportRepositoryMockReportsToFileExpectation struct {
+	mock               *ExportRepositoryMock
+	params             *ExportRepositoryMockReportsToFileParams
+	paramPtrs          *ExportRepositoryMockReportsToFileParamPtrs
+	expectationOrigins ExportRepositoryMockReportsToFileExpectationOrigins
+	results            *ExportRepositoryMockReportsToFileResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ExportRepositoryMockReportsToFileParams contains parameters of the ExportRepository.ReportsToFile
+type ExportRepositoryMockReportsToFileParams struct {
+	ctx  context.Context
+	rows []*mm_usecase.ReportExportRow
+}
+
+// ExportRepositoryMockReportsToFileParamPtrs contains pointers to parameters of the ExportRepository.ReportsToFile
+type ExportRepositoryMockReportsToFileParamPtrs struct {
+	ctx  *context.Context
+	rows *[]*mm_usecase.ReportExportRow
+}
+
+// ExportRepositoryMockReportsToFileResults contains results of the ExportRepository.ReportsToFile
+type ExportRepositoryMockReportsToFileResults struct {
+	ba1 []byte
+	err error
+}
+
+// ExportRepositoryMockReportsToFileOrigins contains origins of expectations of the ExportRepository.ReportsToFile
+type ExportRepositoryMockReportsToFileExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRows string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReportsToFile *mExportRepositoryMockReportsToFile) Optional() *mExportRepositoryMockReportsToFile {
+	mmReportsToFile.optional = true
+	return mmReportsToFile
+}
+
+// Expect sets up expected params for ExportRepository.ReportsToFile
+func (mmReportsToFile *mExportRepositoryMockReportsToFile) Expect(ctx context.Context, rows []*mm_usecase.ReportExportRow) *mExportRepositoryMockReportsToFile {
+	if mmReportsToFile.mock.funcReportsToFile != nil {
+		mmReportsToFile.mock.t.Fatalf("ExportRepositoryMock.ReportsToFile mock is already set by Set")
+	}
+
+	if mmReportsToFile.defaultExpectation == nil {
+		mmReportsToFile.defaultExpectation = &ExportRepositoryMockReportsToFileExpectation{}
+	}
+
+	if mmReportsToFile.defaultExpectation.paramPtrs != nil {
+		mmReportsToFile.mock.t.Fatalf("ExportRepositoryMock.ReportsToFile mock is already set by ExpectParams functions")
+	}
+
+	mmReportsToFile.defaultExpectation.params = &ExportRepositoryMockReportsToFileParams{ctx, rows}
+	mmReportsToFile.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReportsToFile.expectations {
+		if minimock.Equal(e.params, mmReportsToFile.defaultExpectation.params) {
+			mmReportsToFile.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReportsToFile.defaultExpectation.params)
+		}
+	}
+
+	return mmReportsToFile
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ExportRepository.ReportsToFile
+func (mmReportsToFile *mExportRepositoryMockReportsToFile) ExpectCtxParam1(ctx context.Context) *mExportRepositoryMockReportsToFile {
+	if mmReportsToFile.mock.funcReportsToFile != nil {
+		mmReportsToFile.mock.t.Fatalf("ExportRepositoryMock.ReportsToFile mock is already set by Set")
+	}
+
+	if mmReportsToFile.defaultExpectation == nil {
+		mmReportsToFile.defaultExpectation = &ExportRepositoryMockReportsToFileExpectation{}
+	}
+
+	if mmReportsToFile.defaultExpectation.params != nil {
+		mmReportsToFile.mock.t.Fatalf("ExportRepositoryMock.ReportsToFile mock is already set by Expect")
+	}
+
+	if mmReportsToFile.defaultExpectation.paramPtrs == nil {
+		mmReportsToFile.defaultExpectation.paramPtrs = &ExportRepositoryMockReportsToFileParamPtrs{}
+	}
+	mmReportsToFile.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReportsToFile.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReportsToFile
+}
+
+// ExpectRowsParam2 sets up expected param rows for ExportRepository.ReportsToFile
+func (mmReportsToFile *mExportRepositoryMockReportsToFile) ExpectRowsParam2(rows []*mm_usecase.ReportExportRow) *mExportRepositoryMockReportsToFile {
+	if mmReportsToFile.mock.funcReportsToFile != nil {
+		mmReportsToFile.mock.t.Fatalf("ExportRepositoryMock.ReportsToFile mock is already set by Set")
+	}
+
+	if mmReportsToFile.defaultExpectation == nil {
+		mmReportsToFile.defaultExpectation = &ExportRepositoryMockReportsToFileExpectation{}
+	}
+
+	if mmReportsToFile.defaultExpectation.params != nil {
+		mmReportsToFile.mock.t.Fatalf("ExportRepositoryMock.ReportsToFile mock is already set by Expect")
+	}
+
+	if mmReportsToFile.defaultExpectation.paramPtrs == nil {
+		mmReportsToFile.defaultExpectation.paramPtrs = &ExportRepositoryMockReportsToFileParamPtrs{}
+	}
+	mmReportsToFile.defaultExpectation.paramPtrs.rows = &rows
+	mmReportsToFile.defaultExpectation.expectationOrigins.originRows = minimock.CallerInfo(1)
+
+	return mmReportsToFile
+}
+
+// Inspect accepts an inspector function that has same arguments as the ExportRepository.ReportsToFile
+func (mmReportsToFile *mExportRepositoryMockReportsToFile) Inspect(f func(ctx context.Context, rows []*mm_usecase.ReportExportRow)) *mExportRepositoryMockReportsToFile {
+	if mmReportsToFile.mock.inspectFuncReportsToFile != nil {
+		mmReportsToFile.mock.t.Fatalf("Inspect function is already set for ExportRepositoryMock.ReportsToFile")
+	}
+
+	mmReportsToFile.mock.inspectFuncReportsToFile = f
+
+	return mmReportsToFile
+}
+
+// Return sets up results that will be returned by ExportRepository.ReportsToFile
+func (mmReportsToFile *mExportRepositoryMockReportsToFile) Return(ba1 []byte, err error) *ExportRepositoryMock {
+	if mmReportsToFile.mock.funcReportsToFile != nil {
+		mmReportsToFile.mock.t.Fatalf("ExportRepositoryMock.ReportsToFile mock is already set by Set")
+	}
+
+	if mmReportsToFile.defaultExpectation == nil {
+		mmReportsToFile.defaultExpectation = &ExportRepositoryMockReportsToFileExpectation{mock: mmReportsToFile.mock}
+	}
+	mmReportsToFile.defaultExpectation.results = &ExportRepositoryMockReportsToFileResults{ba1, err}
+	mmReportsToFile.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReportsToFile.mock
+}
+
+// Set uses given function f to mock the ExportRepository.ReportsToFile method
+func (mmReportsToFile *mExportRepositoryMockReportsToFile) Set(f func(ctx context.Context, rows []*mm_usecase.ReportExportRow) (ba1 []byte, err error)) *ExportRepositoryMock {
+	if mmReportsToFile.defaultExpectation != nil {
+		mmReportsToFile.mock.t.Fatalf("Default expectation is already set for the ExportRepository.ReportsToFile method")
+	}
+
+	if len(mmReportsToFile.expectations) > 0 {
+		mmReportsToFile.mock.t.Fatalf("Some expectations are already set for the ExportRepository.ReportsToFile method")
+	}
+
+	mmReportsToFile.mock.funcReportsToFile = f
+	mmReportsToFile.mock.funcReportsToFileOrigin = minimock.CallerInfo(1)
+	return mmReportsToFile.mock
+}
+
+// When sets expectation for the ExportRepository.ReportsToFile which will trigger the result defined by the following
+// Then helper
+func (mmReportsToFile *mExportRepositoryMockReportsToFile) When(ctx context.Context, rows []*mm_usecase.ReportExportRow) *ExportRepositoryMockReportsToFileExpectation {
+	if mmReportsToFile.mock.funcReportsToFile != nil {
+		mmReportsToFile.mock.t.Fatalf("ExportRepositoryMock.ReportsToFile mock is already set by Set")
+	}
+
+	expectation := &ExportRepositoryMockReportsToFileExpectation{
+		mock:               mmReportsToFile.mock,
+		params:             &ExportRepositoryMockReportsToFileParams{ctx, rows},
+		expectationOrigins: ExportRepositoryMockReportsToFileExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReportsToFile.expectations = append(mmReportsToFile.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ExportRepository.ReportsToFile return parameters for the expectation previously defined by the When method
+func (e *ExportRepositoryMockReportsToFileExpectation) Then(ba1 []byte, err error) *ExportRepositoryMock {
+	e.results = &ExportRepositoryMockReportsToFileResults{ba1, err}
+	return e.mock
+}
+
+// Times sets number of times ExportRepository.ReportsToFile should be invoked
+func (mmReportsToFile *mExportRepositoryMockReportsToFile) Times(n uint64) *mExportRepositoryMockReportsToFile {
+	if n == 0 {
+		mmReportsToFile.mock.t.Fatalf("Times of ExportRepositoryMock.ReportsToFile mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReportsToFile.expectedInvocations, n)
+	mmReportsToFile.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReportsToFile
+}
+
+func (mmReportsToFile *mExportRepositoryMockReportsToFile) invocationsDone() bool {
+	if len(mmReportsToFile.expectations) == 0 && mmReportsToFile.defaultExpectation == nil && mmReportsToFile.mock.funcReportsToFile == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReportsToFile.mock.afterReportsToFileCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReportsToFile.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReportsToFile implements mm_usecase.ExportRepository
+func (mmReportsToFile *ExportRepositoryMock) ReportsToFile(ctx context.Context, rows []*mm_usecase.ReportExportRow) (ba1 []byte, err error) {
+	mm_atomic.AddUint64(&mmReportsToFile.beforeReportsToFileCounter, 1)
+	defer mm_atomic.AddUint64(&mmReportsToFile.afterReportsToFileCounter, 1)
+
+	mmReportsToFile.t.Helper()
+
+	if mmReportsToFile.inspectFuncReportsToFile != nil {
+		mmReportsToFile.inspectFuncReportsToFile(ctx, rows)
+	}
+
+	mm_params := ExportRepositoryMockReportsToFileParams{ctx, rows}
+
+	// Record call args
+	mmReportsToFile.ReportsToFileMock.mutex.Lock()
+	mmReportsToFile.ReportsToFileMock.callArgs = append(mmReportsToFile.ReportsToFileMock.callArgs, &mm_params)
+	mmReportsToFile.ReportsToFileMock.mutex.Unlock()
+
+	for _, e := range mmReportsToFile.ReportsToFileMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ba1, e.results.err
+		}
+	}
+
+	if mmReportsToFile.ReportsToFileMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReportsToFile.ReportsToFileMock.defaultExpectation.Counter, 1)
+		mm_want := mmReportsToFile.ReportsToFileMock.defaultExpectation.params
+		mm_want_ptrs := mmReportsToFile.ReportsToFileMock.defaultExpectation.paramPtrs
+
+		mm_got := ExportRepositoryMockReportsToFileParams{ctx, rows}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReportsToFile.t.Errorf("ExportRepositoryMock.ReportsToFile got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReportsToFile.ReportsToFileMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.rows != nil && !minimock.Equal(*mm_want_ptrs.rows, mm_got.rows) {
+				mmReportsToFile.t.Errorf("ExportRepositoryMock.ReportsToFile got unexpected parameter rows, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReportsToFile.ReportsToFileMock.defaultExpectation.expectationOrigins.originRows, *mm_want_ptrs.rows, mm_got.rows, minimock.Diff(*mm_want_ptrs.rows, mm_got.rows))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReportsToFile.t.Errorf("ExportRepositoryMock.ReportsToFile got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReportsToFile.ReportsToFileMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReportsToFile.ReportsToFileMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReportsToFile.t.Fatal("No results are set for the ExportRepositoryMock.ReportsToFile")
+		}
+		return (*mm_results).ba1, (*mm_results).err
+	}
+	if mmReportsToFile.funcReportsToFile != nil {
+		return mmReportsToFile.funcReportsToFile(ctx, rows)
+	}
+	mmReportsToFile.t.Fatalf("Unexpected call to ExportRepositoryMock.ReportsToFile. %v %v", ctx, rows)
+	return
+}
+
+// ReportsToFileAfterCounter returns a count of finished ExportRepositoryMock.ReportsToFile invocations
+func (mmReportsToFile *ExportRepositoryMock) ReportsToFileAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReportsToFile.afterReportsToFileCounter)
+}
+
+// ReportsToFileBeforeCounter returns a count of ExportRepositoryMock.ReportsToFile invocations
+func (mmReportsToFile *ExportRepositoryMock) ReportsToFileBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReportsToFile.beforeReportsToFileCounter)
+}
+
+// Calls returns a list of arguments used in each call to ExportRepositoryMock.ReportsToFile.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReportsToFile *mExportRepositoryMockReportsToFile) Calls() []*ExportRepositoryMockReportsToFileParams {
+	mmReportsToFile.mutex.RLock()
+
+	argCopy := make([]*ExportRepositoryMockReportsToFileParams, len(mmReportsToFile.callArgs))
+	copy(argCopy, mmReportsToFile.callArgs)
+
+	mmReportsToFile.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReportsToFileDone returns true if the count of the ReportsToFile invocations corresponds
+// the number of defined expectations
+func (m *ExportRepositoryMock) MinimockReportsToFileDone() bool {
+	if m.ReportsToFileMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReportsToFileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReportsToFileMock.invocationsDone()
+}
+
+// MinimockReportsToFileInspect logs each unmet expectation
+func (m *ExportRepositoryMock) MinimockReportsToFileInspect() {
+	for _, e := range m.ReportsToFileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ExportRepositoryMock.ReportsToFile at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReportsToFileCounter := mm_atomic.LoadUint64(&m.afterReportsToFileCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReportsToFileMock.defaultExpectation != nil && afterReportsToFileCounter < 1 {
+		if m.ReportsToFileMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ExportRepositoryMock.ReportsToFile at\n%s", m.ReportsToFileMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ExportRepositoryMock.ReportsToFile at\n%s with params: %#v", m.ReportsToFileMock.defaultExpectation.expectationOrigins.origin, *m.ReportsToFileMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReportsToFile != nil && afterReportsToFileCounter < 1 {
+		m.t.Errorf("Expected call to ExportRepositoryMock.ReportsToFile at\n%s", m.funcReportsToFileOrigin)
+	}
+
+	if !m.ReportsToFileMock.invocationsDone() && afterReportsToFileCounter > 0 {
+		m.t.Errorf("Expected %d calls to ExportRepositoryMock.ReportsToFile at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReportsToFileMock.expectedInvocations), m.ReportsToFileMock.expectedInvocationsOrigin, afterReportsToFileCounter)
+	}
+}
+
+type mExportRepositoryMockTransactionsToFile struct {
+	optional           bool
+	mock               *ExportRepositoryMock
+	defaultExpectation *ExportRepositoryMockTransactionsToFileExpectation
+	expectations       []*ExportRepositoryMockTransactionsToFileExpectation
+
+	callArgs []*ExportRepositoryMockTransactionsToFileParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ExportRepositoryMockTransactionsToFileExpectation specifies expectation struct of the ExportRepository.TransactionsToFile
+type ExportRepositoryMockTransactionsToFileExpectation struct {
+	mock               *ExportRepositoryMock
+	params             *ExportRepositoryMockTransactionsToFileParams
+	paramPtrs          *ExportRepositoryMockTransactionsToFileParamPtrs
+	expectationOrigins ExportRepositoryMockTransactionsToFileExpectationOrigins
+	results            *ExportRepositoryMockTransactionsToFileResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ExportRepositoryMockTransactionsToFileParams contains parameters of the ExportRepository.TransactionsToFile
+type ExportRepositoryMockTransactionsToFileParams struct {
+	ctx   context.Context
+	items []*mm_usecase.TransactionDTO
+}
+
+// ExportRepositoryMockTransactionsToFileParamPtrs contains pointers to parameters of the ExportRepository.TransactionsToFile
+type ExportRepositoryMockTransactionsToFileParamPtrs struct {
+	ctx   *context.Context
+	items *[]*mm_usecase.TransactionDTO
+}
+
+// ExportRepositoryMockTransactionsToFileResults contains results of the ExportRepository.TransactionsToFile
+type ExportRepositoryMockTransactionsToFileResults struct {
+	ba1 []byte
+	err error
+}
+
+// ExportRepositoryMockTransactionsToFileOrigins contains origins of expectations of the ExportRepository.TransactionsToFile
+type ExportRepositoryMockTransactionsToFileExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originItems string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTransactionsToFile *mExportRepositoryMockTransactionsToFile) Optional() *mExportRepositoryMockTransactionsToFile {
+	mmTransactionsToFile.optional = true
+	return mmTransactionsToFile
+}
+
+// Expect sets up expected params for ExportRepository.TransactionsToFile
+func (mmTransactionsToFile *mExportRepositoryMockTransactionsToFile) Expect(ctx context.Context, items []*mm_usecase.TransactionDTO) *mExportRepositoryMockTransactionsToFile {
+	if mmTransactionsToFile.mock.funcTransactionsToFile != nil {
+		mmTransactionsToFile.mock.t.Fatalf("ExportRepositoryMock.TransactionsToFile mock is already set by Set")
+	}
+
+	if mmTransactionsToFile.defaultExpectation == nil {
+		mmTransactionsToFile.defaultExpectation = &ExportRepositoryMockTransactionsToFileExpectation{}
+	}
+
+	if mmTransactionsToFile.defaultExpectation.paramPtrs != nil {
+		mmTransactionsToFile.mock.t.Fatalf("ExportRepositoryMock.TransactionsToFile mock is already set by ExpectParams functions")
+	}
+
+	mmTransactionsToFile.defaultExpectation.params = &ExportRepositoryMockTransactionsToFileParams{ctx, items}
+	mmTransactionsToFile.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTransactionsToFile.expectations {
+		if minimock.Equal(e.params, mmTransactionsToFile.defaultExpectation.params) {
+			mmTransactionsToFile.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTransactionsToFile.defaultExpectation.params)
+		}
+	}
+
+	return mmTransactionsToFile
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ExportRepository.TransactionsToFile
+func (mmTransactionsToFile *mExportRepositoryMockTransactionsToFile) ExpectCtxParam1(ctx context.Context) *mExportRepositoryMockTransactionsToFile {
+	if mmTransactionsToFile.mock.funcTransactionsToFile != nil {
+		mmTransactionsToFile.mock.t.Fatalf("ExportRepositoryMock.TransactionsToFile mock is already set by Set")
+	}
+
+	if mmTransactionsToFile.defaultExpectation == nil {
+		mmTransactionsToFile.defaultExpectation = &ExportRepositoryMockTransactionsToFileExpectation{}
+	}
+
+	if mmTransactionsToFile.defaultExpectation.params != nil {
+		mmTransactionsToFile.mock.t.Fatalf("ExportRepositoryMock.TransactionsToFile mock is already set by Expect")
+	}
+
+	if mmTransactionsToFile.defaultExpectation.paramPtrs == nil {
+		mmTransactionsToFile.defaultExpectation.paramPtrs = &ExportRepositoryMockTransactionsToFileParamPtrs{}
+	}
+	mmTransactionsToFile.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTransactionsToFile.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmTransactionsToFile
+}
+
+// ExpectItemsParam2 sets up expected param items for ExportRepository.TransactionsToFile
+func (mmTransactionsToFile *mExportRepositoryMockTransactionsToFile) ExpectItemsParam2(items []*mm_usecase.TransactionDTO) *mExportRepositoryMockTransactionsToFile {
+	if mmTransactionsToFile.mock.funcTransactionsToFile != nil {
+		mmTransactionsToFile.mock.t.Fatalf("ExportRepositoryMock.TransactionsToFile mock is already set by Set")
+	}
+
+	if mmTransactionsToFile.defaultExpectation == nil {
+		mmTransactionsToFile.defaultExpectation = &ExportRepositoryMockTransactionsToFileExpectation{}
+	}
+
+	if mmTransactionsToFile.defaultExpectation.params != nil {
+		mmTransactionsToFile.mock.t.Fatalf("ExportRepositoryMock.TransactionsToFile mock is already set by Expect")
+	}
+
+	if mmTransactionsToFile.defaultExpectation.paramPtrs == nil {
+		mmTransactionsToFile.defaultExpectation.paramPtrs = &ExportRepositoryMockTransactionsToFileParamPtrs{}
+	}
+	mmTransactionsToFile.defaultExpectation.paramPtrs.items = &items
+	mmTransactionsToFile.defaultExpectation.expectationOrigins.originItems = minimock.CallerInfo(1)
+
+	return mmTransactionsToFile
+}
+
+// Inspect accepts an inspector function that has same arguments as the ExportRepository.TransactionsToFile
+func (mmTransactionsToFile *mExportRepositoryMockTransactionsToFile) Inspect(f func(ctx context.Context, items []*mm_usecase.TransactionDTO)) *mExportRepositoryMockTransactionsToFile {
+	if mmTransactionsToFile.mock.inspectFuncTransactionsToFile != nil {
+		mmTransactionsToFile.mock.t.Fatalf("Inspect function is already set for ExportRepositoryMock.TransactionsToFile")
+	}
+
+	mmTransactionsToFile.mock.inspectFuncTransactionsToFile = f
+
+	return mmTransactionsToFile
+}
+
+// Return sets up results that will be returned by ExportRepository.TransactionsToFile
+func (mmTransactionsToFile *mExportRepositoryMockTransactionsToFile) Return(ba1 []byte, err error) *ExportRepositoryMock {
+	if mmTransactionsToFile.mock.funcTransactionsToFile != nil {
+		mmTransactionsToFile.mock.t.Fatalf("ExportRepositoryMock.TransactionsToFile mock is already set by Set")
+	}
+
+	if mmTransactionsToFile.defaultExpectation == nil {
+		mmTransactionsToFile.defaultExpectation = &ExportRepositoryMockTransactionsToFileExpectation{mock: mmTransactionsToFile.mock}
+	}
+	mmTransactionsToFile.defaultExpectation.results = &ExportRepositoryMockTransactionsToFileResults{ba1, err}
+	mmTransactionsToFile.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTransactionsToFile.mock
+}
+
+// Set uses given function f to mock the ExportRepository.TransactionsToFile method
+func (mmTransactionsToFile *mExportRepositoryMockTransactionsToFile) Set(f func(ctx context.Context, items []*mm_usecase.TransactionDTO) (ba1 []byte, err error)) *ExportRepositoryMock {
+	if mmTransactionsToFile.defaultExpectation != nil {
+		mmTransactionsToFile.mock.t.Fatalf("Default expectation is already set for the ExportRepository.TransactionsToFile method")
+	}
+
+	if len(mmTransactionsToFile.expectations) > 0 {
+		mmTransactionsToFile.mock.t.Fatalf("Some expectations are already set for the ExportRepository.TransactionsToFile method")
+	}
+
+	mmTransactionsToFile.mock.funcTransactionsToFile = f
+	mmTransactionsToFile.mock.funcTransactionsToFileOrigin = minimock.CallerInfo(1)
+	return mmTransactionsToFile.mock
+}
+
+// When sets expectation for the ExportRepository.TransactionsToFile which will trigger the result defined by the following
+// Then helper
+func (mmTransactionsToFile *mExportRepositoryMockTransactionsToFile) When(ctx context.Context, items []*mm_usecase.TransactionDTO) *ExportRepositoryMockTransactionsToFileExpectation {
+	if mmTransactionsToFile.mock.funcTransactionsToFile != nil {
+		mmTransactionsToFile.mock.t.Fatalf("ExportRepositoryMock.TransactionsToFile mock is already set by Set")
+	}
+
+	expectation := &ExportRepositoryMockTransactionsToFileExpectation{
+		mock:               mmTransactionsToFile.mock,
+		params:             &ExportRepositoryMockTransactionsToFileParams{ctx, items},
+		expectationOrigins: ExportRepositoryMockTransactionsToFileExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmTransactionsToFile.expectations = append(mmTransactionsToFile.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ExportRepository.TransactionsToFile return parameters for the expectation previously defined by the When method
+func (e *ExportRepositoryMockTransactionsToFileExpectation) Then(ba1 []byte, err error) *ExportRepositoryMock {
+	e.results = &ExportRepositoryMockTransactionsToFileResults{ba1, err}
+	return e.mock
+}
+
+// Times sets number of times ExportRepository.TransactionsToFile should be invoked
+func (mmTransactionsToFile *mExportRepositoryMockTransactionsToFile) Times(n uint64) *mExportRepositoryMockTransactionsToFile {
+	if n == 0 {
+		mmTransactionsToFile.mock.t.Fatalf("Times of ExportRepositoryMock.TransactionsToFile mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTransactionsToFile.expectedInvocations, n)
+	mmTransactionsToFile.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTransactionsToFile
+}
+
+func (mmTransactionsToFile *mExportRepositoryMockTransactionsToFile) invocationsDone() bool {
+	if len(mmTransactionsToFile.expectations) == 0 && mmTransactionsToFile.defaultExpectation == nil && mmTransactionsToFile.mock.funcTransactionsToFile == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTransactionsToFile.mock.afterTransactionsToFileCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTransactionsToFile.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// TransactionsToFile implements mm_usecase.ExportRepository
+func (mmTransactionsToFile *ExportRepositoryMock) TransactionsToFile(ctx context.Context, items []*mm_usecase.TransactionDTO) (ba1 []byte, err error) {
+	mm_atomic.AddUint64(&mmTransactionsToFile.beforeTransactionsToFileCounter, 1)
+	defer mm_atomic.AddUint64(&mmTransactionsToFile.afterTransactionsToFileCounter, 1)
+
+	mmTransactionsToFile.t.Helper()
+
+	if mmTransactionsToFile.inspectFuncTransactionsToFile != nil {
+		mmTransactionsToFile.inspectFuncTransactionsToFile(ctx, items)
+	}
+
+	mm_params := ExportRepositoryMockTransactionsToFileParams{ctx, items}
+
+	// Record call args
+	mmTransactionsToFile.TransactionsToFileMock.mutex.Lock()
+	mmTransactionsToFile.TransactionsToFileMock.callArgs = append(mmTransactionsToFile.TransactionsToFileMock.callArgs, &mm_params)
+	mmTransactionsToFile.TransactionsToFileMock.mutex.Unlock()
+
+	for _, e := range mmTransactionsToFile.TransactionsToFileMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ba1, e.results.err
+		}
+	}
+
+	if mmTransactionsToFile.TransactionsToFileMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTransactionsToFile.TransactionsToFileMock.defaultExpectation.Counter, 1)
+		mm_want := mmTransactionsToFile.TransactionsToFileMock.defaultExpectation.params
+		mm_want_ptrs := mmTransactionsToFile.TransactionsToFileMock.defaultExpectation.paramPtrs
+
+		mm_got := ExportRepositoryMockTransactionsToFileParams{ctx, items}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTransactionsToFile.t.Errorf("ExportRepositoryMock.TransactionsToFile got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransactionsToFile.TransactionsToFileMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.items != nil && !minimock.Equal(*mm_want_ptrs.items, mm_got.items) {
+				mmTransactionsToFile.t.Errorf("ExportRepositoryMock.TransactionsToFile got unexpected parameter items, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransactionsToFile.TransactionsToFileMock.defaultExpectation.expectationOrigins.originItems, *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTransactionsToFile.t.Errorf("ExportRepositoryMock.TransactionsToFile got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTransactionsToFile.TransactionsToFileMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTransactionsToFile.TransactionsToFileMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTransactionsToFile.t.Fatal("No results are set for the ExportRepositoryMock.TransactionsToFile")
+		}
+		return (*mm_results).ba1, (*mm_results).err
+	}
+	if mmTransactionsToFile.funcTransactionsToFile != nil {
+		return mmTransactionsToFile.funcTransactionsToFile(ctx, items)
+	}
+	mmTransactionsToFile.t.Fatalf("Unexpected call to ExportRepositoryMock.TransactionsToFile. %v %v", ctx, items)
+	return
+}
+
+// TransactionsToFileAfterCounter returns a count of finished ExportRepositoryMock.TransactionsToFile invocations
+func (mmTransactionsToFile *ExportRepositoryMock) TransactionsToFileAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransactionsToFile.afterTransactionsToFileCounter)
+}
+
+// TransactionsToFileBeforeCounter returns a count of ExportRepositoryMock.TransactionsToFile invocations
+func (mmTransactionsToFile *ExportRepositoryMock) TransactionsToFileBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransactionsToFile.beforeTransactionsToFileCounter)
+}
+
+// Calls returns a list of arguments used in each call to ExportRepositoryMock.TransactionsToFile.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTransactionsToFile *mExportRepositoryMockTransactionsToFile) Calls() []*ExportRepositoryMockTransactionsToFileParams {
+	mmTransactionsToFile.mutex.RLock()
+
+	argCopy := make([]*ExportRepositoryMockTransactionsToFileParams, len(mmTransactionsToFile.callArgs))
+	copy(argCopy, mmTransactionsToFile.callArgs)
+
+	mmTransactionsToFile.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTransactionsToFileDone returns true if the count of the TransactionsToFile invocations corresponds
+// the number of defined expectations
+func (m *ExportRepositoryMock) MinimockTransactionsToFileDone() bool {
+	if m.TransactionsToFileMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TransactionsToFileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TransactionsToFileMock.invocationsDone()
+}
+
+// MinimockTransactionsToFileInspect logs each unmet expectation
+func (m *ExportRepositoryMock) MinimockTransactionsToFileInspect() {
+	for _, e := range m.TransactionsToFileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ExportRepositoryMock.TransactionsToFile at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterTransactionsToFileCounter := mm_atomic.LoadUint64(&m.afterTransactionsToFileCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TransactionsToFileMock.defaultExpectation != nil && afterTransactionsToFileCounter < 1 {
+		if m.TransactionsToFileMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ExportRepositoryMock.TransactionsToFile at\n%s", m.TransactionsToFileMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ExportRepositoryMock.TransactionsToFile at\n%s with params: %#v", m.TransactionsToFileMock.defaultExpectation.expectationOrigins.origin, *m.TransactionsToFileMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTransactionsToFile != nil && afterTransactionsToFileCounter < 1 {
+		m.t.Errorf("Expected call to ExportRepositoryMock.TransactionsToFile at\n%s", m.funcTransactionsToFileOrigin)
+	}
+
+	if !m.TransactionsToFileMock.invocationsDone() && afterTransactionsToFileCounter > 0 {
+		m.t.Errorf("Expected %d calls to ExportRepositoryMock.TransactionsToFile at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TransactionsToFileMock.expectedInvocations), m.TransactionsToFileMock.expectedInvocationsOrigin, afterTransactionsToFileCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ExportRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockContentTypeInspect()
+
+			m.MinimockFileExtensionInspect()
+
+			m.MinimockFormatInspect()
+
+			m.MinimockReportsToFileInspect()
+
+			m.MinimockTransactionsToFileInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ExportRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ExportRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockContentTypeDone() &&
+		m.MinimockFileExtensionDone() &&
+		m.MinimockFormatDone() &&
+		m.MinimockReportsToFileDone() &&
+		m.MinimockTransactionsToFileDone()
+}
@@ -0,0 +1,1549 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.ImportProfileRepository -o import_profile_repository.go -n ImportProfileRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	mm_usecase "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// ImportProfileRepositoryMock implements mm_usecase.ImportProfileRepository
+type ImportProfileRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, item *entity.ImportProfile) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, item *entity.ImportProfile)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mImportProfileRepositoryMockCreate
+
+	funcFindList          func(ctx context.Context, listOptions *mm_usecase.ImportProfileListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.ImportProfile, err error)
+	funcFindListOrigin    string
+	inspectFuncFindList   func(ctx context.Context, listOptions *mm_usecase.ImportProfileListOptions, queryParams *uctypes.QueryGetListParams)
+	afterFindListCounter  uint64
+	beforeFindListCounter uint64
+	FindListMock          mImportProfileRepositoryMockFindList
+
+	funcFindOneByID          func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (importProfile *entity.ImportProfile, err error)
+	funcFindOneByIDOrigin    string
+	inspectFuncFindOneByID   func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)
+	afterFindOneByIDCounter  uint64
+	beforeFindOneByIDCounter uint64
+	FindOneByIDMock          mImportProfileRepositoryMockFindOneByID
+
+	funcUpdate          func(ctx context.Context, item *entity.ImportProfile) (err error)
+	funcUpdateOrigin    string
+	inspectFuncUpdate   func(ctx context.Context, item *entity.ImportProfile)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mImportProfileRepositoryMockUpdate
+}
+
+// NewImportProfileRepositoryMock returns a mock for mm_usecase.ImportProfileRepository
+func NewImportProfileRepositoryMock(t minimock.Tester) *ImportProfileRepositoryMock {
+	m := &ImportProfileRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mImportProfileRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*ImportProfileRepositoryMockCreateParams{}
+
+	m.FindListMock = mImportProfileRepositoryMockFindList{mock: m}
+	m.FindListMock.callArgs = []*ImportProfileRepositoryMockFindListParams{}
+
+	m.FindOneByIDMock = mImportProfileRepositoryMockFindOneByID{mock: m}
+	m.FindOneByIDMock.callArgs = []*ImportProfileRepositoryMockFindOneByIDParams{}
+
+	m.UpdateMock = mImportProfileRepositoryMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*ImportProfileRepositoryMockUpdateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mImportProfileRepositoryMockCreate struct {
+	optional           bool
+	mock               *ImportProfileRepositoryMock
+	defaultExpectation *ImportProfileRepositoryMockCreateExpectation
+	expectations       []*ImportProfileRepositoryMockCreateExpectation
+
+	callArgs []*ImportProfileRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportProfileRepositoryMockCreateExpectation specifies expectation struct of the ImportProfileRepository.Create
+type ImportProfileRepositoryMockCreateExpectation struct {
+	mock               *ImportProfileRepositoryMock
+	params             *ImportProfileRepositoryMockCreateParams
+	paramPtrs          *ImportProfileRepositoryMockCreateParamPtrs
+	expectationOrigins ImportProfileRepositoryMockCreateExpectationOrigins
+	results            *ImportProfileRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportProfileRepositoryMockCreateParams contains parameters of the ImportProfileRepository.Create
+type ImportProfileRepositoryMockCreateParams struct {
+	ctx  context.Context
+	item *entity.ImportProfile
+}
+
+// ImportProfileRepositoryMockCreateParamPtrs contains pointers to parameters of the ImportProfileRepository.Create
+type ImportProfileRepositoryMockCreateParamPtrs struct {
+	ctx  *context.Context
+	item **entity.ImportProfile
+}
+
+// ImportProfileRepositoryMockCreateResults contains results of the ImportProfileRepository.Create
+type ImportProfileRepositoryMockCreateResults struct {
+	err error
+}
+
+// ImportProfileRepositoryMockCreateOrigins contains origins of expectations of the ImportProfileRepository.Create
+type ImportProfileRepositoryMockCreateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originItem string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mImportProfileRepositoryMockCreate) Optional() *mImportProfileRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for ImportProfileRepository.Create
+func (mmCreate *mImportProfileRepositoryMockCreate) Expect(ctx context.Context, item *entity.ImportProfile) *mImportProfileRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ImportProfileRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ImportProfileRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("ImportProfileRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &ImportProfileRepositoryMockCreateParams{ctx, item}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportProfileRepository.Create
+func (mmCreate *mImportProfileRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mImportProfileRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ImportProfileRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ImportProfileRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("ImportProfileRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &ImportProfileRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectItemParam2 sets up expected param item for ImportProfileRepository.Create
+func (mmCreate *mImportProfileRepositoryMockCreate) ExpectItemParam2(item *entity.ImportProfile) *mImportProfileRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ImportProfileRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ImportProfileRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("ImportProfileRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &ImportProfileRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.item = &item
+	mmCreate.defaultExpectation.expectationOrigins.originItem = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportProfileRepository.Create
+func (mmCreate *mImportProfileRepositoryMockCreate) Inspect(f func(ctx context.Context, item *entity.ImportProfile)) *mImportProfileRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for ImportProfileRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by ImportProfileRepository.Create
+func (mmCreate *mImportProfileRepositoryMockCreate) Return(err error) *ImportProfileRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ImportProfileRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ImportProfileRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &ImportProfileRepositoryMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the ImportProfileRepository.Create method
+func (mmCreate *mImportProfileRepositoryMockCreate) Set(f func(ctx context.Context, item *entity.ImportProfile) (err error)) *ImportProfileRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the ImportProfileRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the ImportProfileRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the ImportProfileRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mImportProfileRepositoryMockCreate) When(ctx context.Context, item *entity.ImportProfile) *ImportProfileRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ImportProfileRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &ImportProfileRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &ImportProfileRepositoryMockCreateParams{ctx, item},
+		expectationOrigins: ImportProfileRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportProfileRepository.Create return parameters for the expectation previously defined by the When method
+func (e *ImportProfileRepositoryMockCreateExpectation) Then(err error) *ImportProfileRepositoryMock {
+	e.results = &ImportProfileRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times ImportProfileRepository.Create should be invoked
+func (mmCreate *mImportProfileRepositoryMockCreate) Times(n uint64) *mImportProfileRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of ImportProfileRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mImportProfileRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_usecase.ImportProfileRepository
+func (mmCreate *ImportProfileRepositoryMock) Create(ctx context.Context, item *entity.ImportProfile) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, item)
+	}
+
+	mm_params := ImportProfileRepositoryMockCreateParams{ctx, item}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportProfileRepositoryMockCreateParams{ctx, item}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("ImportProfileRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
+				mmCreate.t.Errorf("ImportProfileRepositoryMock.Create got unexpected parameter item, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originItem, *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("ImportProfileRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the ImportProfileRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, item)
+	}
+	mmCreate.t.Fatalf("Unexpected call to ImportProfileRepositoryMock.Create. %v %v", ctx, item)
+	return
+}
+
+// CreateAfterCounter returns a count of finished ImportProfileRepositoryMock.Create invocations
+func (mmCreate *ImportProfileRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of ImportProfileRepositoryMock.Create invocations
+func (mmCreate *ImportProfileRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportProfileRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mImportProfileRepositoryMockCreate) Calls() []*ImportProfileRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*ImportProfileRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *ImportProfileRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *ImportProfileRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportProfileRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportProfileRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportProfileRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to ImportProfileRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportProfileRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mImportProfileRepositoryMockFindList struct {
+	optional           bool
+	mock               *ImportProfileRepositoryMock
+	defaultExpectation *ImportProfileRepositoryMockFindListExpectation
+	expectations       []*ImportProfileRepositoryMockFindListExpectation
+
+	callArgs []*ImportProfileRepositoryMockFindListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportProfileRepositoryMockFindListExpectation specifies expectation struct of the ImportProfileRepository.FindList
+type ImportProfileRepositoryMockFindListExpectation struct {
+	mock               *ImportProfileRepositoryMock
+	params             *ImportProfileRepositoryMockFindListParams
+	paramPtrs          *ImportProfileRepositoryMockFindListParamPtrs
+	expectationOrigins ImportProfileRepositoryMockFindListExpectationOrigins
+	results            *ImportProfileRepositoryMockFindListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportProfileRepositoryMockFindListParams contains parameters of the ImportProfileRepository.FindList
+type ImportProfileRepositoryMockFindListParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.ImportProfileListOptions
+	queryParams *uctypes.QueryGetListParams
+}
+
+// ImportProfileRepositoryMockFindListParamPtrs contains pointers to parameters of the ImportProfileRepository.FindList
+type ImportProfileRepositoryMockFindListParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.ImportProfileListOptions
+	queryParams **uctypes.QueryGetListParams
+}
+
+// ImportProfileRepositoryMockFindListResults contains results of the ImportProfileRepository.FindList
+type ImportProfileRepositoryMockFindListResults struct {
+	items []*entity.ImportProfile
+	err   error
+}
+
+// ImportProfileRepositoryMockFindListOrigins contains origins of expectations of the ImportProfileRepository.FindList
+type ImportProfileRepositoryMockFindListExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindList *mImportProfileRepositoryMockFindList) Optional() *mImportProfileRepositoryMockFindList {
+	mmFindList.optional = true
+	return mmFindList
+}
+
+// Expect sets up expected params for ImportProfileRepository.FindList
+func (mmFindList *mImportProfileRepositoryMockFindList) Expect(ctx context.Context, listOptions *mm_usecase.ImportProfileListOptions, queryParams *uctypes.QueryGetListParams) *mImportProfileRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &ImportProfileRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileRepositoryMock.FindList mock is already set by ExpectParams functions")
+	}
+
+	mmFindList.defaultExpectation.params = &ImportProfileRepositoryMockFindListParams{ctx, listOptions, queryParams}
+	mmFindList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindList.expectations {
+		if minimock.Equal(e.params, mmFindList.defaultExpectation.params) {
+			mmFindList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindList.defaultExpectation.params)
+		}
+	}
+
+	return mmFindList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportProfileRepository.FindList
+func (mmFindList *mImportProfileRepositoryMockFindList) ExpectCtxParam1(ctx context.Context) *mImportProfileRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &ImportProfileRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &ImportProfileRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for ImportProfileRepository.FindList
+func (mmFindList *mImportProfileRepositoryMockFindList) ExpectListOptionsParam2(listOptions *mm_usecase.ImportProfileListOptions) *mImportProfileRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &ImportProfileRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &ImportProfileRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmFindList.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for ImportProfileRepository.FindList
+func (mmFindList *mImportProfileRepositoryMockFindList) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mImportProfileRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &ImportProfileRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &ImportProfileRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindList.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportProfileRepository.FindList
+func (mmFindList *mImportProfileRepositoryMockFindList) Inspect(f func(ctx context.Context, listOptions *mm_usecase.ImportProfileListOptions, queryParams *uctypes.QueryGetListParams)) *mImportProfileRepositoryMockFindList {
+	if mmFindList.mock.inspectFuncFindList != nil {
+		mmFindList.mock.t.Fatalf("Inspect function is already set for ImportProfileRepositoryMock.FindList")
+	}
+
+	mmFindList.mock.inspectFuncFindList = f
+
+	return mmFindList
+}
+
+// Return sets up results that will be returned by ImportProfileRepository.FindList
+func (mmFindList *mImportProfileRepositoryMockFindList) Return(items []*entity.ImportProfile, err error) *ImportProfileRepositoryMock {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &ImportProfileRepositoryMockFindListExpectation{mock: mmFindList.mock}
+	}
+	mmFindList.defaultExpectation.results = &ImportProfileRepositoryMockFindListResults{items, err}
+	mmFindList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// Set uses given function f to mock the ImportProfileRepository.FindList method
+func (mmFindList *mImportProfileRepositoryMockFindList) Set(f func(ctx context.Context, listOptions *mm_usecase.ImportProfileListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.ImportProfile, err error)) *ImportProfileRepositoryMock {
+	if mmFindList.defaultExpectation != nil {
+		mmFindList.mock.t.Fatalf("Default expectation is already set for the ImportProfileRepository.FindList method")
+	}
+
+	if len(mmFindList.expectations) > 0 {
+		mmFindList.mock.t.Fatalf("Some expectations are already set for the ImportProfileRepository.FindList method")
+	}
+
+	mmFindList.mock.funcFindList = f
+	mmFindList.mock.funcFindListOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// When sets expectation for the ImportProfileRepository.FindList which will trigger the result defined by the following
+// Then helper
+func (mmFindList *mImportProfileRepositoryMockFindList) When(ctx context.Context, listOptions *mm_usecase.ImportProfileListOptions, queryParams *uctypes.QueryGetListParams) *ImportProfileRepositoryMockFindListExpectation {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileRepositoryMock.FindList mock is already set by Set")
+	}
+
+	expectation := &ImportProfileRepositoryMockFindListExpectation{
+		mock:               mmFindList.mock,
+		params:             &ImportProfileRepositoryMockFindListParams{ctx, listOptions, queryParams},
+		expectationOrigins: ImportProfileRepositoryMockFindListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindList.expectations = append(mmFindList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportProfileRepository.FindList return parameters for the expectation previously defined by the When method
+func (e *ImportProfileRepositoryMockFindListExpectation) Then(items []*entity.ImportProfile, err error) *ImportProfileRepositoryMock {
+	e.results = &ImportProfileRepositoryMockFindListResults{items, err}
+	return e.mock
+}
+
+// Times sets number of times ImportProfileRepository.FindList should be invoked
+func (mmFindList *mImportProfileRepositoryMockFindList) Times(n uint64) *mImportProfileRepositoryMockFindList {
+	if n == 0 {
+		mmFindList.mock.t.Fatalf("Times of ImportProfileRepositoryMock.FindList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindList.expectedInvocations, n)
+	mmFindList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindList
+}
+
+func (mmFindList *mImportProfileRepositoryMockFindList) invocationsDone() bool {
+	if len(mmFindList.expectations) == 0 && mmFindList.defaultExpectation == nil && mmFindList.mock.funcFindList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindList.mock.afterFindListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindList implements mm_usecase.ImportProfileRepository
+func (mmFindList *ImportProfileRepositoryMock) FindList(ctx context.Context, listOptions *mm_usecase.ImportProfileListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.ImportProfile, err error) {
+	mm_atomic.AddUint64(&mmFindList.beforeFindListCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindList.afterFindListCounter, 1)
+
+	mmFindList.t.Helper()
+
+	if mmFindList.inspectFuncFindList != nil {
+		mmFindList.inspectFuncFindList(ctx, listOptions, queryParams)
+	}
+
+	mm_params := ImportProfileRepositoryMockFindListParams{ctx, listOptions, queryParams}
+
+	// Record call args
+	mmFindList.FindListMock.mutex.Lock()
+	mmFindList.FindListMock.callArgs = append(mmFindList.FindListMock.callArgs, &mm_params)
+	mmFindList.FindListMock.mutex.Unlock()
+
+	for _, e := range mmFindList.FindListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.items, e.results.err
+		}
+	}
+
+	if mmFindList.FindListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindList.FindListMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindList.FindListMock.defaultExpectation.params
+		mm_want_ptrs := mmFindList.FindListMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportProfileRepositoryMockFindListParams{ctx, listOptions, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindList.t.Errorf("ImportProfileRepositoryMock.FindList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmFindList.t.Errorf("ImportProfileRepositoryMock.FindList got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindList.t.Errorf("ImportProfileRepositoryMock.FindList got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindList.t.Errorf("ImportProfileRepositoryMock.FindList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindList.FindListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindList.FindListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindList.t.Fatal("No results are set for the ImportProfileRepositoryMock.FindList")
+		}
+		return (*mm_results).items, (*mm_results).err
+	}
+	if mmFindList.funcFindList != nil {
+		return mmFindList.funcFindList(ctx, listOptions, queryParams)
+	}
+	mmFindList.t.Fatalf("Unexpected call to ImportProfileRepositoryMock.FindList. %v %v %v", ctx, listOptions, queryParams)
+	return
+}
+
+// FindListAfterCounter returns a count of finished ImportProfileRepositoryMock.FindList invocations
+func (mmFindList *ImportProfileRepositoryMock) FindListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.afterFindListCounter)
+}
+
+// FindListBeforeCounter returns a count of ImportProfileRepositoryMock.FindList invocations
+func (mmFindList *ImportProfileRepositoryMock) FindListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.beforeFindListCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportProfileRepositoryMock.FindList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindList *mImportProfileRepositoryMockFindList) Calls() []*ImportProfileRepositoryMockFindListParams {
+	mmFindList.mutex.RLock()
+
+	argCopy := make([]*ImportProfileRepositoryMockFindListParams, len(mmFindList.callArgs))
+	copy(argCopy, mmFindList.callArgs)
+
+	mmFindList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindListDone returns true if the count of the FindList invocations corresponds
+// the number of defined expectations
+func (m *ImportProfileRepositoryMock) MinimockFindListDone() bool {
+	if m.FindListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindListMock.invocationsDone()
+}
+
+// MinimockFindListInspect logs each unmet expectation
+func (m *ImportProfileRepositoryMock) MinimockFindListInspect() {
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportProfileRepositoryMock.FindList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindListCounter := mm_atomic.LoadUint64(&m.afterFindListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindListMock.defaultExpectation != nil && afterFindListCounter < 1 {
+		if m.FindListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportProfileRepositoryMock.FindList at\n%s", m.FindListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportProfileRepositoryMock.FindList at\n%s with params: %#v", m.FindListMock.defaultExpectation.expectationOrigins.origin, *m.FindListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindList != nil && afterFindListCounter < 1 {
+		m.t.Errorf("Expected call to ImportProfileRepositoryMock.FindList at\n%s", m.funcFindListOrigin)
+	}
+
+	if !m.FindListMock.invocationsDone() && afterFindListCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportProfileRepositoryMock.FindList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindListMock.expectedInvocations), m.FindListMock.expectedInvocationsOrigin, afterFindListCounter)
+	}
+}
+
+type mImportProfileRepositoryMockFindOneByID struct {
+	optional           bool
+	mock               *ImportProfileRepositoryMock
+	defaultExpectation *ImportProfileRepositoryMockFindOneByIDExpectation
+	expectations       []*ImportProfileRepositoryMockFindOneByIDExpectation
+
+	callArgs []*ImportProfileRepositoryMockFindOneByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportProfileRepositoryMockFindOneByIDExpectation specifies expectation struct of the ImportProfileRepository.FindOneByID
+type ImportProfileRepositoryMockFindOneByIDExpectation struct {
+	mock               *ImportProfileRepositoryMock
+	params             *ImportProfileRepositoryMockFindOneByIDParams
+	paramPtrs          *ImportProfileRepositoryMockFindOneByIDParamPtrs
+	expectationOrigins ImportProfileRepositoryMockFindOneByIDExpectationOrigins
+	results            *ImportProfileRepositoryMockFindOneByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportProfileRepositoryMockFindOneByIDParams contains parameters of the ImportProfileRepository.FindOneByID
+type ImportProfileRepositoryMockFindOneByIDParams struct {
+	ctx         context.Context
+	id          uuid.UUID
+	queryParams *uctypes.QueryGetOneParams
+}
+
+// ImportProfileRepositoryMockFindOneByIDParamPtrs contains pointers to parameters of the ImportProfileRepository.FindOneByID
+type ImportProfileRepositoryMockFindOneByIDParamPtrs struct {
+	ctx         *context.Context
+	id          *uuid.UUID
+	queryParams **uctypes.QueryGetOneParams
+}
+
+// ImportProfileRepositoryMockFindOneByIDResults contains results of the ImportProfileRepository.FindOneByID
+type ImportProfileRepositoryMockFindOneByIDResults struct {
+	importProfile *entity.ImportProfile
+	err           error
+}
+
+// ImportProfileRepositoryMockFindOneByIDOrigins contains origins of expectations of the ImportProfileRepository.FindOneByID
+type ImportProfileRepositoryMockFindOneByIDExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originId          string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindOneByID *mImportProfileRepositoryMockFindOneByID) Optional() *mImportProfileRepositoryMockFindOneByID {
+	mmFindOneByID.optional = true
+	return mmFindOneByID
+}
+
+// Expect sets up expected params for ImportProfileRepository.FindOneByID
+func (mmFindOneByID *mImportProfileRepositoryMockFindOneByID) Expect(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *mImportProfileRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &ImportProfileRepositoryMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileRepositoryMock.FindOneByID mock is already set by ExpectParams functions")
+	}
+
+	mmFindOneByID.defaultExpectation.params = &ImportProfileRepositoryMockFindOneByIDParams{ctx, id, queryParams}
+	mmFindOneByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindOneByID.expectations {
+		if minimock.Equal(e.params, mmFindOneByID.defaultExpectation.params) {
+			mmFindOneByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindOneByID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindOneByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportProfileRepository.FindOneByID
+func (mmFindOneByID *mImportProfileRepositoryMockFindOneByID) ExpectCtxParam1(ctx context.Context) *mImportProfileRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &ImportProfileRepositoryMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileRepositoryMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &ImportProfileRepositoryMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindOneByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// ExpectIdParam2 sets up expected param id for ImportProfileRepository.FindOneByID
+func (mmFindOneByID *mImportProfileRepositoryMockFindOneByID) ExpectIdParam2(id uuid.UUID) *mImportProfileRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &ImportProfileRepositoryMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileRepositoryMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &ImportProfileRepositoryMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.id = &id
+	mmFindOneByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for ImportProfileRepository.FindOneByID
+func (mmFindOneByID *mImportProfileRepositoryMockFindOneByID) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetOneParams) *mImportProfileRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &ImportProfileRepositoryMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileRepositoryMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &ImportProfileRepositoryMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindOneByID.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportProfileRepository.FindOneByID
+func (mmFindOneByID *mImportProfileRepositoryMockFindOneByID) Inspect(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)) *mImportProfileRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.inspectFuncFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("Inspect function is already set for ImportProfileRepositoryMock.FindOneByID")
+	}
+
+	mmFindOneByID.mock.inspectFuncFindOneByID = f
+
+	return mmFindOneByID
+}
+
+// Return sets up results that will be returned by ImportProfileRepository.FindOneByID
+func (mmFindOneByID *mImportProfileRepositoryMockFindOneByID) Return(importProfile *entity.ImportProfile, err error) *ImportProfileRepositoryMock {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &ImportProfileRepositoryMockFindOneByIDExpectation{mock: mmFindOneByID.mock}
+	}
+	mmFindOneByID.defaultExpectation.results = &ImportProfileRepositoryMockFindOneByIDResults{importProfile, err}
+	mmFindOneByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID.mock
+}
+
+// Set uses given function f to mock the ImportProfileRepository.FindOneByID method
+func (mmFindOneByID *mImportProfileRepositoryMockFindOneByID) Set(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (importProfile *entity.ImportProfile, err error)) *ImportProfileRepositoryMock {
+	if mmFindOneByID.defaultExpectation != nil {
+		mmFindOneByID.mock.t.Fatalf("Default expectation is already set for the ImportProfileRepository.FindOneByID method")
+	}
+
+	if len(mmFindOneByID.expectations) > 0 {
+		mmFindOneByID.mock.t.Fatalf("Some expectations are already set for the ImportProfileRepository.FindOneByID method")
+	}
+
+	mmFindOneByID.mock.funcFindOneByID = f
+	mmFindOneByID.mock.funcFindOneByIDOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID.mock
+}
+
+// When sets expectation for the ImportProfileRepository.FindOneByID which will trigger the result defined by the following
+// Then helper
+func (mmFindOneByID *mImportProfileRepositoryMockFindOneByID) When(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *ImportProfileRepositoryMockFindOneByIDExpectation {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	expectation := &ImportProfileRepositoryMockFindOneByIDExpectation{
+		mock:               mmFindOneByID.mock,
+		params:             &ImportProfileRepositoryMockFindOneByIDParams{ctx, id, queryParams},
+		expectationOrigins: ImportProfileRepositoryMockFindOneByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindOneByID.expectations = append(mmFindOneByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportProfileRepository.FindOneByID return parameters for the expectation previously defined by the When method
+func (e *ImportProfileRepositoryMockFindOneByIDExpectation) Then(importProfile *entity.ImportProfile, err error) *ImportProfileRepositoryMock {
+	e.results = &ImportProfileRepositoryMockFindOneByIDResults{importProfile, err}
+	return e.mock
+}
+
+// Times sets number of times ImportProfileRepository.FindOneByID should be invoked
+func (mmFindOneByID *mImportProfileRepositoryMockFindOneByID) Times(n uint64) *mImportProfileRepositoryMockFindOneByID {
+	if n == 0 {
+		mmFindOneByID.mock.t.Fatalf("Times of ImportProfileRepositoryMock.FindOneByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindOneByID.expectedInvocations, n)
+	mmFindOneByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID
+}
+
+func (mmFindOneByID *mImportProfileRepositoryMockFindOneByID) invocationsDone() bool {
+	if len(mmFindOneByID.expectations) == 0 && mmFindOneByID.defaultExpectation == nil && mmFindOneByID.mock.funcFindOneByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindOneByID.mock.afterFindOneByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindOneByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindOneByID implements mm_usecase.ImportProfileRepository
+func (mmFindOneByID *ImportProfileRepositoryMock) FindOneByID(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (importProfile *entity.ImportProfile, err error) {
+	mm_atomic.AddUint64(&mmFindOneByID.beforeFindOneByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindOneByID.afterFindOneByIDCounter, 1)
+
+	mmFindOneByID.t.Helper()
+
+	if mmFindOneByID.inspectFuncFindOneByID != nil {
+		mmFindOneByID.inspectFuncFindOneByID(ctx, id, queryParams)
+	}
+
+	mm_params := ImportProfileRepositoryMockFindOneByIDParams{ctx, id, queryParams}
+
+	// Record call args
+	mmFindOneByID.FindOneByIDMock.mutex.Lock()
+	mmFindOneByID.FindOneByIDMock.callArgs = append(mmFindOneByID.FindOneByIDMock.callArgs, &mm_params)
+	mmFindOneByID.FindOneByIDMock.mutex.Unlock()
+
+	for _, e := range mmFindOneByID.FindOneByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.importProfile, e.results.err
+		}
+	}
+
+	if mmFindOneByID.FindOneByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindOneByID.FindOneByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindOneByID.FindOneByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindOneByID.FindOneByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportProfileRepositoryMockFindOneByIDParams{ctx, id, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindOneByID.t.Errorf("ImportProfileRepositoryMock.FindOneByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmFindOneByID.t.Errorf("ImportProfileRepositoryMock.FindOneByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindOneByID.t.Errorf("ImportProfileRepositoryMock.FindOneByID got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindOneByID.t.Errorf("ImportProfileRepositoryMock.FindOneByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindOneByID.FindOneByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindOneByID.t.Fatal("No results are set for the ImportProfileRepositoryMock.FindOneByID")
+		}
+		return (*mm_results).importProfile, (*mm_results).err
+	}
+	if mmFindOneByID.funcFindOneByID != nil {
+		return mmFindOneByID.funcFindOneByID(ctx, id, queryParams)
+	}
+	mmFindOneByID.t.Fatalf("Unexpected call to ImportProfileRepositoryMock.FindOneByID. %v %v %v", ctx, id, queryParams)
+	return
+}
+
+// FindOneByIDAfterCounter returns a count of finished ImportProfileRepositoryMock.FindOneByID invocations
+func (mmFindOneByID *ImportProfileRepositoryMock) FindOneByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByID.afterFindOneByIDCounter)
+}
+
+// FindOneByIDBeforeCounter returns a count of ImportProfileRepositoryMock.FindOneByID invocations
+func (mmFindOneByID *ImportProfileRepositoryMock) FindOneByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByID.beforeFindOneByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportProfileRepositoryMock.FindOneByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindOneByID *mImportProfileRepositoryMockFindOneByID) Calls() []*ImportProfileRepositoryMockFindOneByIDParams {
+	mmFindOneByID.mutex.RLock()
+
+	argCopy := make([]*ImportProfileRepositoryMockFindOneByIDParams, len(mmFindOneByID.callArgs))
+	copy(argCopy, mmFindOneByID.callArgs)
+
+	mmFindOneByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindOneByIDDone returns true if the count of the FindOneByID invocations corresponds
+// the number of defined expectations
+func (m *ImportProfileRepositoryMock) MinimockFindOneByIDDone() bool {
+	if m.FindOneByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindOneByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindOneByIDMock.invocationsDone()
+}
+
+// MinimockFindOneByIDInspect logs each unmet expectation
+func (m *ImportProfileRepositoryMock) MinimockFindOneByIDInspect() {
+	for _, e := range m.FindOneByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportProfileRepositoryMock.FindOneByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindOneByIDCounter := mm_atomic.LoadUint64(&m.afterFindOneByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindOneByIDMock.defaultExpectation != nil && afterFindOneByIDCounter < 1 {
+		if m.FindOneByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportProfileRepositoryMock.FindOneByID at\n%s", m.FindOneByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportProfileRepositoryMock.FindOneByID at\n%s with params: %#v", m.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindOneByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindOneByID != nil && afterFindOneByIDCounter < 1 {
+		m.t.Errorf("Expected call to ImportProfileRepositoryMock.FindOneByID at\n%s", m.funcFindOneByIDOrigin)
+	}
+
+	if !m.FindOneByIDMock.invocationsDone() && afterFindOneByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportProfileRepositoryMock.FindOneByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindOneByIDMock.expectedInvocations), m.FindOneByIDMock.expectedInvocationsOrigin, afterFindOneByIDCounter)
+	}
+}
+
+type mImportProfileRepositoryMockUpdate struct {
+	optional           bool
+	mock               *ImportProfileRepositoryMock
+	defaultExpectation *ImportProfileRepositoryMockUpdateExpectation
+	expectations       []*ImportProfileRepositoryMockUpdateExpectation
+
+	callArgs []*ImportProfileRepositoryMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportProfileRepositoryMockUpdateExpectation specifies expectation struct of the ImportProfileRepository.Update
+type ImportProfileRepositoryMockUpdateExpectation struct {
+	mock               *ImportProfileRepositoryMock
+	params             *ImportProfileRepositoryMockUpdateParams
+	paramPtrs          *ImportProfileRepositoryMockUpdateParamPtrs
+	expectationOrigins ImportProfileRepositoryMockUpdateExpectationOrigins
+	results            *ImportProfileRepositoryMockUpdateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportProfileRepositoryMockUpdateParams contains parameters of the ImportProfileRepository.Update
+type ImportProfileRepositoryMockUpdateParams struct {
+	ctx  context.Context
+	item *entity.ImportProfile
+}
+
+// ImportProfileRepositoryMockUpdateParamPtrs contains pointers to parameters of the ImportProfileRepository.Update
+type ImportProfileRepositoryMockUpdateParamPtrs struct {
+	ctx  *context.Context
+	item **entity.ImportProfile
+}
+
+// ImportProfileRepositoryMockUpdateResults contains results of the ImportProfileRepository.Update
+type ImportProfileRepositoryMockUpdateResults struct {
+	err error
+}
+
+// ImportProfileRepositoryMockUpdateOrigins contains origins of expectations of the ImportProfileRepository.Update
+type ImportProfileRepositoryMockUpdateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originItem string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mImportProfileRepositoryMockUpdate) Optional() *mImportProfileRepositoryMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for ImportProfileRepository.Update
+func (mmUpdate *mImportProfileRepositoryMockUpdate) Expect(ctx context.Context, item *entity.ImportProfile) *mImportProfileRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("ImportProfileRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &ImportProfileRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("ImportProfileRepositoryMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &ImportProfileRepositoryMockUpdateParams{ctx, item}
+	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportProfileRepository.Update
+func (mmUpdate *mImportProfileRepositoryMockUpdate) ExpectCtxParam1(ctx context.Context) *mImportProfileRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("ImportProfileRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &ImportProfileRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("ImportProfileRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &ImportProfileRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectItemParam2 sets up expected param item for ImportProfileRepository.Update
+func (mmUpdate *mImportProfileRepositoryMockUpdate) ExpectItemParam2(item *entity.ImportProfile) *mImportProfileRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("ImportProfileRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &ImportProfileRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("ImportProfileRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &ImportProfileRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.item = &item
+	mmUpdate.defaultExpectation.expectationOrigins.originItem = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportProfileRepository.Update
+func (mmUpdate *mImportProfileRepositoryMockUpdate) Inspect(f func(ctx context.Context, item *entity.ImportProfile)) *mImportProfileRepositoryMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for ImportProfileRepositoryMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by ImportProfileRepository.Update
+func (mmUpdate *mImportProfileRepositoryMockUpdate) Return(err error) *ImportProfileRepositoryMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("ImportProfileRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &ImportProfileRepositoryMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &ImportProfileRepositoryMockUpdateResults{err}
+	mmUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the ImportProfileRepository.Update method
+func (mmUpdate *mImportProfileRepositoryMockUpdate) Set(f func(ctx context.Context, item *entity.ImportProfile) (err error)) *ImportProfileRepositoryMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the ImportProfileRepository.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the ImportProfileRepository.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	mmUpdate.mock.funcUpdateOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// When sets expectation for the ImportProfileRepository.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mImportProfileRepositoryMockUpdate) When(ctx context.Context, item *entity.ImportProfile) *ImportProfileRepositoryMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("ImportProfileRepositoryMock.Update mock is already set by Set")
+	}
+
+	expectation := &ImportProfileRepositoryMockUpdateExpectation{
+		mock:               mmUpdate.mock,
+		params:             &ImportProfileRepositoryMockUpdateParams{ctx, item},
+		expectationOrigins: ImportProfileRepositoryMockUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportProfileRepository.Update return parameters for the expectation previously defined by the When method
+func (e *ImportProfileRepositoryMockUpdateExpectation) Then(err error) *ImportProfileRepositoryMock {
+	e.results = &ImportProfileRepositoryMockUpdateResults{err}
+	return e.mock
+}
+
+// Times sets number of times ImportProfileRepository.Update should be invoked
+func (mmUpdate *mImportProfileRepositoryMockUpdate) Times(n uint64) *mImportProfileRepositoryMockUpdate {
+	if n == 0 {
+		mmUpdate.mock.t.Fatalf("Times of ImportProfileRepositoryMock.Update mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	mmUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdate
+}
+
+func (mmUpdate *mImportProfileRepositoryMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Update implements mm_usecase.ImportProfileRepository
+func (mmUpdate *ImportProfileRepositoryMock) Update(ctx context.Context, item *entity.ImportProfile) (err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	mmUpdate.t.Helper()
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, item)
+	}
+
+	mm_params := ImportProfileRepositoryMockUpdateParams{ctx, item}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportProfileRepositoryMockUpdateParams{ctx, item}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("ImportProfileRepositoryMock.Update got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
+				mmUpdate.t.Errorf("ImportProfileRepositoryMock.Update got unexpected parameter item, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originItem, *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("ImportProfileRepositoryMock.Update got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the ImportProfileRepositoryMock.Update")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, item)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to ImportProfileRepositoryMock.Update. %v %v", ctx, item)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished ImportProfileRepositoryMock.Update invocations
+func (mmUpdate *ImportProfileRepositoryMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of ImportProfileRepositoryMock.Update invocations
+func (mmUpdate *ImportProfileRepositoryMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportProfileRepositoryMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mImportProfileRepositoryMockUpdate) Calls() []*ImportProfileRepositoryMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*ImportProfileRepositoryMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *ImportProfileRepositoryMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMock.invocationsDone()
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *ImportProfileRepositoryMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportProfileRepositoryMock.Update at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportProfileRepositoryMock.Update at\n%s", m.UpdateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportProfileRepositoryMock.Update at\n%s with params: %#v", m.UpdateMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Errorf("Expected call to ImportProfileRepositoryMock.Update at\n%s", m.funcUpdateOrigin)
+	}
+
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportProfileRepositoryMock.Update at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), m.UpdateMock.expectedInvocationsOrigin, afterUpdateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ImportProfileRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockFindListInspect()
+
+			m.MinimockFindOneByIDInspect()
+
+			m.MinimockUpdateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ImportProfileRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ImportProfileRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockFindListDone() &&
+		m.MinimockFindOneByIDDone() &&
+		m.MinimockUpdateDone()
+}
@@ -0,0 +1,1966 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.ImportProfileUsecase -o import_profile_usecase.go -n ImportProfileUsecaseMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	mm_usecase "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// ImportProfileUsecaseMock implements mm_usecase.ImportProfileUsecase
+type ImportProfileUsecaseMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateImportProfileByDTO          func(ctx context.Context, in mm_usecase.CreateImportProfileDataInput) (resImportProfileDTO *mm_usecase.ImportProfileDTO, err error)
+	funcCreateImportProfileByDTOOrigin    string
+	inspectFuncCreateImportProfileByDTO   func(ctx context.Context, in mm_usecase.CreateImportProfileDataInput)
+	afterCreateImportProfileByDTOCounter  uint64
+	beforeCreateImportProfileByDTOCounter uint64
+	CreateImportProfileByDTOMock          mImportProfileUsecaseMockCreateImportProfileByDTO
+
+	funcDeleteImportProfileByID          func(ctx context.Context, id uuid.UUID) (err error)
+	funcDeleteImportProfileByIDOrigin    string
+	inspectFuncDeleteImportProfileByID   func(ctx context.Context, id uuid.UUID)
+	afterDeleteImportProfileByIDCounter  uint64
+	beforeDeleteImportProfileByIDCounter uint64
+	DeleteImportProfileByIDMock          mImportProfileUsecaseMockDeleteImportProfileByID
+
+	funcFindList          func(ctx context.Context, listOptions *mm_usecase.ImportProfileListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.ImportProfileDTO, err error)
+	funcFindListOrigin    string
+	inspectFuncFindList   func(ctx context.Context, listOptions *mm_usecase.ImportProfileListOptions, queryParams *uctypes.QueryGetListParams)
+	afterFindListCounter  uint64
+	beforeFindListCounter uint64
+	FindListMock          mImportProfileUsecaseMockFindList
+
+	funcFindOneByID          func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.ImportProfileDTO, err error)
+	funcFindOneByIDOrigin    string
+	inspectFuncFindOneByID   func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)
+	afterFindOneByIDCounter  uint64
+	beforeFindOneByIDCounter uint64
+	FindOneByIDMock          mImportProfileUsecaseMockFindOneByID
+
+	funcPatchImportProfileByDTO          func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchImportProfileDataInput, skipVersionCheck bool) (err error)
+	funcPatchImportProfileByDTOOrigin    string
+	inspectFuncPatchImportProfileByDTO   func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchImportProfileDataInput, skipVersionCheck bool)
+	afterPatchImportProfileByDTOCounter  uint64
+	beforePatchImportProfileByDTOCounter uint64
+	PatchImportProfileByDTOMock          mImportProfileUsecaseMockPatchImportProfileByDTO
+}
+
+// NewImportProfileUsecaseMock returns a mock for mm_usecase.ImportProfileUsecase
+func NewImportProfileUsecaseMock(t minimock.Tester) *ImportProfileUsecaseMock {
+	m := &ImportProfileUsecaseMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateImportProfileByDTOMock = mImportProfileUsecaseMockCreateImportProfileByDTO{mock: m}
+	m.CreateImportProfileByDTOMock.callArgs = []*ImportProfileUsecaseMockCreateImportProfileByDTOParams{}
+
+	m.DeleteImportProfileByIDMock = mImportProfileUsecaseMockDeleteImportProfileByID{mock: m}
+	m.DeleteImportProfileByIDMock.callArgs = []*ImportProfileUsecaseMockDeleteImportProfileByIDParams{}
+
+	m.FindListMock = mImportProfileUsecaseMockFindList{mock: m}
+	m.FindListMock.callArgs = []*ImportProfileUsecaseMockFindListParams{}
+
+	m.FindOneByIDMock = mImportProfileUsecaseMockFindOneByID{mock: m}
+	m.FindOneByIDMock.callArgs = []*ImportProfileUsecaseMockFindOneByIDParams{}
+
+	m.PatchImportProfileByDTOMock = mImportProfileUsecaseMockPatchImportProfileByDTO{mock: m}
+	m.PatchImportProfileByDTOMock.callArgs = []*ImportProfileUsecaseMockPatchImportProfileByDTOParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mImportProfileUsecaseMockCreateImportProfileByDTO struct {
+	optional           bool
+	mock               *ImportProfileUsecaseMock
+	defaultExpectation *ImportProfileUsecaseMockCreateImportProfileByDTOExpectation
+	expectations       []*ImportProfileUsecaseMockCreateImportProfileByDTOExpectation
+
+	callArgs []*ImportProfileUsecaseMockCreateImportProfileByDTOParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportProfileUsecaseMockCreateImportProfileByDTOExpectation specifies expectation struct of the ImportProfileUsecase.CreateImportProfileByDTO
+type ImportProfileUsecaseMockCreateImportProfileByDTOExpectation struct {
+	mock               *ImportProfileUsecaseMock
+	params             *ImportProfileUsecaseMockCreateImportProfileByDTOParams
+	paramPtrs          *ImportProfileUsecaseMockCreateImportProfileByDTOParamPtrs
+	expectationOrigins ImportProfileUsecaseMockCreateImportProfileByDTOExpectationOrigins
+	results            *ImportProfileUsecaseMockCreateImportProfileByDTOResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportProfileUsecaseMockCreateImportProfileByDTOParams contains parameters of the ImportProfileUsecase.CreateImportProfileByDTO
+type ImportProfileUsecaseMockCreateImportProfileByDTOParams struct {
+	ctx context.Context
+	in  mm_usecase.CreateImportProfileDataInput
+}
+
+// ImportProfileUsecaseMockCreateImportProfileByDTOParamPtrs contains pointers to parameters of the ImportProfileUsecase.CreateImportProfileByDTO
+type ImportProfileUsecaseMockCreateImportProfileByDTOParamPtrs struct {
+	ctx *context.Context
+	in  *mm_usecase.CreateImportProfileDataInput
+}
+
+// ImportProfileUsecaseMockCreateImportProfileByDTOResults contains results of the ImportProfileUsecase.CreateImportProfileByDTO
+type ImportProfileUsecaseMockCreateImportProfileByDTOResults struct {
+	resImportProfileDTO *mm_usecase.ImportProfileDTO
+	err                 error
+}
+
+// ImportProfileUsecaseMockCreateImportProfileByDTOOrigins contains origins of expectations of the ImportProfileUsecase.CreateImportProfileByDTO
+type ImportProfileUsecaseMockCreateImportProfileByDTOExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateImportProfileByDTO *mImportProfileUsecaseMockCreateImportProfileByDTO) Optional() *mImportProfileUsecaseMockCreateImportProfileByDTO {
+	mmCreateImportProfileByDTO.optional = true
+	return mmCreateImportProfileByDTO
+}
+
+// Expect sets up expected params for ImportProfileUsecase.CreateImportProfileByDTO
+func (mmCreateImportProfileByDTO *mImportProfileUsecaseMockCreateImportProfileByDTO) Expect(ctx context.Context, in mm_usecase.CreateImportProfileDataInput) *mImportProfileUsecaseMockCreateImportProfileByDTO {
+	if mmCreateImportProfileByDTO.mock.funcCreateImportProfileByDTO != nil {
+		mmCreateImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.CreateImportProfileByDTO mock is already set by Set")
+	}
+
+	if mmCreateImportProfileByDTO.defaultExpectation == nil {
+		mmCreateImportProfileByDTO.defaultExpectation = &ImportProfileUsecaseMockCreateImportProfileByDTOExpectation{}
+	}
+
+	if mmCreateImportProfileByDTO.defaultExpectation.paramPtrs != nil {
+		mmCreateImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.CreateImportProfileByDTO mock is already set by ExpectParams functions")
+	}
+
+	mmCreateImportProfileByDTO.defaultExpectation.params = &ImportProfileUsecaseMockCreateImportProfileByDTOParams{ctx, in}
+	mmCreateImportProfileByDTO.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateImportProfileByDTO.expectations {
+		if minimock.Equal(e.params, mmCreateImportProfileByDTO.defaultExpectation.params) {
+			mmCreateImportProfileByDTO.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateImportProfileByDTO.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateImportProfileByDTO
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportProfileUsecase.CreateImportProfileByDTO
+func (mmCreateImportProfileByDTO *mImportProfileUsecaseMockCreateImportProfileByDTO) ExpectCtxParam1(ctx context.Context) *mImportProfileUsecaseMockCreateImportProfileByDTO {
+	if mmCreateImportProfileByDTO.mock.funcCreateImportProfileByDTO != nil {
+		mmCreateImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.CreateImportProfileByDTO mock is already set by Set")
+	}
+
+	if mmCreateImportProfileByDTO.defaultExpectation == nil {
+		mmCreateImportProfileByDTO.defaultExpectation = &ImportProfileUsecaseMockCreateImportProfileByDTOExpectation{}
+	}
+
+	if mmCreateImportProfileByDTO.defaultExpectation.params != nil {
+		mmCreateImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.CreateImportProfileByDTO mock is already set by Expect")
+	}
+
+	if mmCreateImportProfileByDTO.defaultExpectation.paramPtrs == nil {
+		mmCreateImportProfileByDTO.defaultExpectation.paramPtrs = &ImportProfileUsecaseMockCreateImportProfileByDTOParamPtrs{}
+	}
+	mmCreateImportProfileByDTO.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateImportProfileByDTO.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateImportProfileByDTO
+}
+
+// ExpectInParam2 sets up expected param in for ImportProfileUsecase.CreateImportProfileByDTO
+func (mmCreateImportProfileByDTO *mImportProfileUsecaseMockCreateImportProfileByDTO) ExpectInParam2(in mm_usecase.CreateImportProfileDataInput) *mImportProfileUsecaseMockCreateImportProfileByDTO {
+	if mmCreateImportProfileByDTO.mock.funcCreateImportProfileByDTO != nil {
+		mmCreateImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.CreateImportProfileByDTO mock is already set by Set")
+	}
+
+	if mmCreateImportProfileByDTO.defaultExpectation == nil {
+		mmCreateImportProfileByDTO.defaultExpectation = &ImportProfileUsecaseMockCreateImportProfileByDTOExpectation{}
+	}
+
+	if mmCreateImportProfileByDTO.defaultExpectation.params != nil {
+		mmCreateImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.CreateImportProfileByDTO mock is already set by Expect")
+	}
+
+	if mmCreateImportProfileByDTO.defaultExpectation.paramPtrs == nil {
+		mmCreateImportProfileByDTO.defaultExpectation.paramPtrs = &ImportProfileUsecaseMockCreateImportProfileByDTOParamPtrs{}
+	}
+	mmCreateImportProfileByDTO.defaultExpectation.paramPtrs.in = &in
+	mmCreateImportProfileByDTO.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmCreateImportProfileByDTO
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportProfileUsecase.CreateImportProfileByDTO
+func (mmCreateImportProfileByDTO *mImportProfileUsecaseMockCreateImportProfileByDTO) Inspect(f func(ctx context.Context, in mm_usecase.CreateImportProfileDataInput)) *mImportProfileUsecaseMockCreateImportProfileByDTO {
+	if mmCreateImportProfileByDTO.mock.inspectFuncCreateImportProfileByDTO != nil {
+		mmCreateImportProfileByDTO.mock.t.Fatalf("Inspect function is already set for ImportProfileUsecaseMock.CreateImportProfileByDTO")
+	}
+
+	mmCreateImportProfileByDTO.mock.inspectFuncCreateImportProfileByDTO = f
+
+	return mmCreateImportProfileByDTO
+}
+
+// Return sets up results that will be returned by ImportProfileUsecase.CreateImportProfileByDTO
+func (mmCreateImportProfileByDTO *mImportProfileUsecaseMockCreateImportProfileByDTO) Return(resImportProfileDTO *mm_usecase.ImportProfileDTO, err error) *ImportProfileUsecaseMock {
+	if mmCreateImportProfileByDTO.mock.funcCreateImportProfileByDTO != nil {
+		mmCreateImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.CreateImportProfileByDTO mock is already set by Set")
+	}
+
+	if mmCreateImportProfileByDTO.defaultExpectation == nil {
+		mmCreateImportProfileByDTO.defaultExpectation = &ImportProfileUsecaseMockCreateImportProfileByDTOExpectation{mock: mmCreateImportProfileByDTO.mock}
+	}
+	mmCreateImportProfileByDTO.defaultExpectation.results = &ImportProfileUsecaseMockCreateImportProfileByDTOResults{resImportProfileDTO, err}
+	mmCreateImportProfileByDTO.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateImportProfileByDTO.mock
+}
+
+// Set uses given function f to mock the ImportProfileUsecase.CreateImportProfileByDTO method
+func (mmCreateImportProfileByDTO *mImportProfileUsecaseMockCreateImportProfileByDTO) Set(f func(ctx context.Context, in mm_usecase.CreateImportProfileDataInput) (resImportProfileDTO *mm_usecase.ImportProfileDTO, err error)) *ImportProfileUsecaseMock {
+	if mmCreateImportProfileByDTO.defaultExpectation != nil {
+		mmCreateImportProfileByDTO.mock.t.Fatalf("Default expectation is already set for the ImportProfileUsecase.CreateImportProfileByDTO method")
+	}
+
+	if len(mmCreateImportProfileByDTO.expectations) > 0 {
+		mmCreateImportProfileByDTO.mock.t.Fatalf("Some expectations are already set for the ImportProfileUsecase.CreateImportProfileByDTO method")
+	}
+
+	mmCreateImportProfileByDTO.mock.funcCreateImportProfileByDTO = f
+	mmCreateImportProfileByDTO.mock.funcCreateImportProfileByDTOOrigin = minimock.CallerInfo(1)
+	return mmCreateImportProfileByDTO.mock
+}
+
+// When sets expectation for the ImportProfileUsecase.CreateImportProfileByDTO which will trigger the result defined by the following
+// Then helper
+func (mmCreateImportProfileByDTO *mImportProfileUsecaseMockCreateImportProfileByDTO) When(ctx context.Context, in mm_usecase.CreateImportProfileDataInput) *ImportProfileUsecaseMockCreateImportProfileByDTOExpectation {
+	if mmCreateImportProfileByDTO.mock.funcCreateImportProfileByDTO != nil {
+		mmCreateImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.CreateImportProfileByDTO mock is already set by Set")
+	}
+
+	expectation := &ImportProfileUsecaseMockCreateImportProfileByDTOExpectation{
+		mock:               mmCreateImportProfileByDTO.mock,
+		params:             &ImportProfileUsecaseMockCreateImportProfileByDTOParams{ctx, in},
+		expectationOrigins: ImportProfileUsecaseMockCreateImportProfileByDTOExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateImportProfileByDTO.expectations = append(mmCreateImportProfileByDTO.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportProfileUsecase.CreateImportProfileByDTO return parameters for the expectation previously defined by the When method
+func (e *ImportProfileUsecaseMockCreateImportProfileByDTOExpectation) Then(resImportProfileDTO *mm_usecase.ImportProfileDTO, err error) *ImportProfileUsecaseMock {
+	e.results = &ImportProfileUsecaseMockCreateImportProfileByDTOResults{resImportProfileDTO, err}
+	return e.mock
+}
+
+// Times sets number of times ImportProfileUsecase.CreateImportProfileByDTO should be invoked
+func (mmCreateImportProfileByDTO *mImportProfileUsecaseMockCreateImportProfileByDTO) Times(n uint64) *mImportProfileUsecaseMockCreateImportProfileByDTO {
+	if n == 0 {
+		mmCreateImportProfileByDTO.mock.t.Fatalf("Times of ImportProfileUsecaseMock.CreateImportProfileByDTO mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateImportProfileByDTO.expectedInvocations, n)
+	mmCreateImportProfileByDTO.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateImportProfileByDTO
+}
+
+func (mmCreateImportProfileByDTO *mImportProfileUsecaseMockCreateImportProfileByDTO) invocationsDone() bool {
+	if len(mmCreateImportProfileByDTO.expectations) == 0 && mmCreateImportProfileByDTO.defaultExpectation == nil && mmCreateImportProfileByDTO.mock.funcCreateImportProfileByDTO == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateImportProfileByDTO.mock.afterCreateImportProfileByDTOCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateImportProfileByDTO.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateImportProfileByDTO implements mm_usecase.ImportProfileUsecase
+func (mmCreateImportProfileByDTO *ImportProfileUsecaseMock) CreateImportProfileByDTO(ctx context.Context, in mm_usecase.CreateImportProfileDataInput) (resImportProfileDTO *mm_usecase.ImportProfileDTO, err error) {
+	mm_atomic.AddUint64(&mmCreateImportProfileByDTO.beforeCreateImportProfileByDTOCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateImportProfileByDTO.afterCreateImportProfileByDTOCounter, 1)
+
+	mmCreateImportProfileByDTO.t.Helper()
+
+	if mmCreateImportProfileByDTO.inspectFuncCreateImportProfileByDTO != nil {
+		mmCreateImportProfileByDTO.inspectFuncCreateImportProfileByDTO(ctx, in)
+	}
+
+	mm_params := ImportProfileUsecaseMockCreateImportProfileByDTOParams{ctx, in}
+
+	// Record call args
+	mmCreateImportProfileByDTO.CreateImportProfileByDTOMock.mutex.Lock()
+	mmCreateImportProfileByDTO.CreateImportProfileByDTOMock.callArgs = append(mmCreateImportProfileByDTO.CreateImportProfileByDTOMock.callArgs, &mm_params)
+	mmCreateImportProfileByDTO.CreateImportProfileByDTOMock.mutex.Unlock()
+
+	for _, e := range mmCreateImportProfileByDTO.CreateImportProfileByDTOMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resImportProfileDTO, e.results.err
+		}
+	}
+
+	if mmCreateImportProfileByDTO.CreateImportProfileByDTOMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateImportProfileByDTO.CreateImportProfileByDTOMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateImportProfileByDTO.CreateImportProfileByDTOMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateImportProfileByDTO.CreateImportProfileByDTOMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportProfileUsecaseMockCreateImportProfileByDTOParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateImportProfileByDTO.t.Errorf("ImportProfileUsecaseMock.CreateImportProfileByDTO got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateImportProfileByDTO.CreateImportProfileByDTOMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmCreateImportProfileByDTO.t.Errorf("ImportProfileUsecaseMock.CreateImportProfileByDTO got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateImportProfileByDTO.CreateImportProfileByDTOMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateImportProfileByDTO.t.Errorf("ImportProfileUsecaseMock.CreateImportProfileByDTO got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateImportProfileByDTO.CreateImportProfileByDTOMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateImportProfileByDTO.CreateImportProfileByDTOMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateImportProfileByDTO.t.Fatal("No results are set for the ImportProfileUsecaseMock.CreateImportProfileByDTO")
+		}
+		return (*mm_results).resImportProfileDTO, (*mm_results).err
+	}
+	if mmCreateImportProfileByDTO.funcCreateImportProfileByDTO != nil {
+		return mmCreateImportProfileByDTO.funcCreateImportProfileByDTO(ctx, in)
+	}
+	mmCreateImportProfileByDTO.t.Fatalf("Unexpected call to ImportProfileUsecaseMock.CreateImportProfileByDTO. %v %v", ctx, in)
+	return
+}
+
+// CreateImportProfileByDTOAfterCounter returns a count of finished ImportProfileUsecaseMock.CreateImportProfileByDTO invocations
+func (mmCreateImportProfileByDTO *ImportProfileUsecaseMock) CreateImportProfileByDTOAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateImportProfileByDTO.afterCreateImportProfileByDTOCounter)
+}
+
+// CreateImportProfileByDTOBeforeCounter returns a count of ImportProfileUsecaseMock.CreateImportProfileByDTO invocations
+func (mmCreateImportProfileByDTO *ImportProfileUsecaseMock) CreateImportProfileByDTOBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateImportProfileByDTO.beforeCreateImportProfileByDTOCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportProfileUsecaseMock.CreateImportProfileByDTO.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateImportProfileByDTO *mImportProfileUsecaseMockCreateImportProfileByDTO) Calls() []*ImportProfileUsecaseMockCreateImportProfileByDTOParams {
+	mmCreateImportProfileByDTO.mutex.RLock()
+
+	argCopy := make([]*ImportProfileUsecaseMockCreateImportProfileByDTOParams, len(mmCreateImportProfileByDTO.callArgs))
+	copy(argCopy, mmCreateImportProfileByDTO.callArgs)
+
+	mmCreateImportProfileByDTO.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateImportProfileByDTODone returns true if the count of the CreateImportProfileByDTO invocations corresponds
+// the number of defined expectations
+func (m *ImportProfileUsecaseMock) MinimockCreateImportProfileByDTODone() bool {
+	if m.CreateImportProfileByDTOMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateImportProfileByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateImportProfileByDTOMock.invocationsDone()
+}
+
+// MinimockCreateImportProfileByDTOInspect logs each unmet expectation
+func (m *ImportProfileUsecaseMock) MinimockCreateImportProfileByDTOInspect() {
+	for _, e := range m.CreateImportProfileByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportProfileUsecaseMock.CreateImportProfileByDTO at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateImportProfileByDTOCounter := mm_atomic.LoadUint64(&m.afterCreateImportProfileByDTOCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateImportProfileByDTOMock.defaultExpectation != nil && afterCreateImportProfileByDTOCounter < 1 {
+		if m.CreateImportProfileByDTOMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportProfileUsecaseMock.CreateImportProfileByDTO at\n%s", m.CreateImportProfileByDTOMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportProfileUsecaseMock.CreateImportProfileByDTO at\n%s with params: %#v", m.CreateImportProfileByDTOMock.defaultExpectation.expectationOrigins.origin, *m.CreateImportProfileByDTOMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateImportProfileByDTO != nil && afterCreateImportProfileByDTOCounter < 1 {
+		m.t.Errorf("Expected call to ImportProfileUsecaseMock.CreateImportProfileByDTO at\n%s", m.funcCreateImportProfileByDTOOrigin)
+	}
+
+	if !m.CreateImportProfileByDTOMock.invocationsDone() && afterCreateImportProfileByDTOCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportProfileUsecaseMock.CreateImportProfileByDTO at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateImportProfileByDTOMock.expectedInvocations), m.CreateImportProfileByDTOMock.expectedInvocationsOrigin, afterCreateImportProfileByDTOCounter)
+	}
+}
+
+type mImportProfileUsecaseMockDeleteImportProfileByID struct {
+	optional           bool
+	mock               *ImportProfileUsecaseMock
+	defaultExpectation *ImportProfileUsecaseMockDeleteImportProfileByIDExpectation
+	expectations       []*ImportProfileUsecaseMockDeleteImportProfileByIDExpectation
+
+	callArgs []*ImportProfileUsecaseMockDeleteImportProfileByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportProfileUsecaseMockDeleteImportProfileByIDExpectation specifies expectation struct of the ImportProfileUsecase.DeleteImportProfileByID
+type ImportProfileUsecaseMockDeleteImportProfileByIDExpectation struct {
+	mock               *ImportProfileUsecaseMock
+	params             *ImportProfileUsecaseMockDeleteImportProfileByIDParams
+	paramPtrs          *ImportProfileUsecaseMockDeleteImportProfileByIDParamPtrs
+	expectationOrigins ImportProfileUsecaseMockDeleteImportProfileByIDExpectationOrigins
+	results            *ImportProfileUsecaseMockDeleteImportProfileByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportProfileUsecaseMockDeleteImportProfileByIDParams contains parameters of the ImportProfileUsecase.DeleteImportProfileByID
+type ImportProfileUsecaseMockDeleteImportProfileByIDParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// ImportProfileUsecaseMockDeleteImportProfileByIDParamPtrs contains pointers to parameters of the ImportProfileUsecase.DeleteImportProfileByID
+type ImportProfileUsecaseMockDeleteImportProfileByIDParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// ImportProfileUsecaseMockDeleteImportProfileByIDResults contains results of the ImportProfileUsecase.DeleteImportProfileByID
+type ImportProfileUsecaseMockDeleteImportProfileByIDResults struct {
+	err error
+}
+
+// ImportProfileUsecaseMockDeleteImportProfileByIDOrigins contains origins of expectations of the ImportProfileUsecase.DeleteImportProfileByID
+type ImportProfileUsecaseMockDeleteImportProfileByIDExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteImportProfileByID *mImportProfileUsecaseMockDeleteImportProfileByID) Optional() *mImportProfileUsecaseMockDeleteImportProfileByID {
+	mmDeleteImportProfileByID.optional = true
+	return mmDeleteImportProfileByID
+}
+
+// Expect sets up expected params for ImportProfileUsecase.DeleteImportProfileByID
+func (mmDeleteImportProfileByID *mImportProfileUsecaseMockDeleteImportProfileByID) Expect(ctx context.Context, id uuid.UUID) *mImportProfileUsecaseMockDeleteImportProfileByID {
+	if mmDeleteImportProfileByID.mock.funcDeleteImportProfileByID != nil {
+		mmDeleteImportProfileByID.mock.t.Fatalf("ImportProfileUsecaseMock.DeleteImportProfileByID mock is already set by Set")
+	}
+
+	if mmDeleteImportProfileByID.defaultExpectation == nil {
+		mmDeleteImportProfileByID.defaultExpectation = &ImportProfileUsecaseMockDeleteImportProfileByIDExpectation{}
+	}
+
+	if mmDeleteImportProfileByID.defaultExpectation.paramPtrs != nil {
+		mmDeleteImportProfileByID.mock.t.Fatalf("ImportProfileUsecaseMock.DeleteImportProfileByID mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteImportProfileByID.defaultExpectation.params = &ImportProfileUsecaseMockDeleteImportProfileByIDParams{ctx, id}
+	mmDeleteImportProfileByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteImportProfileByID.expectations {
+		if minimock.Equal(e.params, mmDeleteImportProfileByID.defaultExpectation.params) {
+			mmDeleteImportProfileByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteImportProfileByID.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteImportProfileByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportProfileUsecase.DeleteImportProfileByID
+func (mmDeleteImportProfileByID *mImportProfileUsecaseMockDeleteImportProfileByID) ExpectCtxParam1(ctx context.Context) *mImportProfileUsecaseMockDeleteImportProfileByID {
+	if mmDeleteImportProfileByID.mock.funcDeleteImportProfileByID != nil {
+		mmDeleteImportProfileByID.mock.t.Fatalf("ImportProfileUsecaseMock.DeleteImportProfileByID mock is already set by Set")
+	}
+
+	if mmDeleteImportProfileByID.defaultExpectation == nil {
+		mmDeleteImportProfileByID.defaultExpectation = &ImportProfileUsecaseMockDeleteImportProfileByIDExpectation{}
+	}
+
+	if mmDeleteImportProfileByID.defaultExpectation.params != nil {
+		mmDeleteImportProfileByID.mock.t.Fatalf("ImportProfileUsecaseMock.DeleteImportProfileByID mock is already set by Expect")
+	}
+
+	if mmDeleteImportProfileByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteImportProfileByID.defaultExpectation.paramPtrs = &ImportProfileUsecaseMockDeleteImportProfileByIDParamPtrs{}
+	}
+	mmDeleteImportProfileByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteImportProfileByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteImportProfileByID
+}
+
+// ExpectIdParam2 sets up expected param id for ImportProfileUsecase.DeleteImportProfileByID
+func (mmDeleteImportProfileByID *mImportProfileUsecaseMockDeleteImportProfileByID) ExpectIdParam2(id uuid.UUID) *mImportProfileUsecaseMockDeleteImportProfileByID {
+	if mmDeleteImportProfileByID.mock.funcDeleteImportProfileByID != nil {
+		mmDeleteImportProfileByID.mock.t.Fatalf("ImportProfileUsecaseMock.DeleteImportProfileByID mock is already set by Set")
+	}
+
+	if mmDeleteImportProfileByID.defaultExpectation == nil {
+		mmDeleteImportProfileByID.defaultExpectation = &ImportProfileUsecaseMockDeleteImportProfileByIDExpectation{}
+	}
+
+	if mmDeleteImportProfileByID.defaultExpectation.params != nil {
+		mmDeleteImportProfileByID.mock.t.Fatalf("ImportProfileUsecaseMock.DeleteImportProfileByID mock is already set by Expect")
+	}
+
+	if mmDeleteImportProfileByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteImportProfileByID.defaultExpectation.paramPtrs = &ImportProfileUsecaseMockDeleteImportProfileByIDParamPtrs{}
+	}
+	mmDeleteImportProfileByID.defaultExpectation.paramPtrs.id = &id
+	mmDeleteImportProfileByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteImportProfileByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportProfileUsecase.DeleteImportProfileByID
+func (mmDeleteImportProfileByID *mImportProfileUsecaseMockDeleteImportProfileByID) Inspect(f func(ctx context.Context, id uuid.UUID)) *mImportProfileUsecaseMockDeleteImportProfileByID {
+	if mmDeleteImportProfileByID.mock.inspectFuncDeleteImportProfileByID != nil {
+		mmDeleteImportProfileByID.mock.t.Fatalf("Inspect function is already set for ImportProfileUsecaseMock.DeleteImportProfileByID")
+	}
+
+	mmDeleteImportProfileByID.mock.inspectFuncDeleteImportProfileByID = f
+
+	return mmDeleteImportProfileByID
+}
+
+// Return sets up results that will be returned by ImportProfileUsecase.DeleteImportProfileByID
+func (mmDeleteImportProfileByID *mImportProfileUsecaseMockDeleteImportProfileByID) Return(err error) *ImportProfileUsecaseMock {
+	if mmDeleteImportProfileByID.mock.funcDeleteImportProfileByID != nil {
+		mmDeleteImportProfileByID.mock.t.Fatalf("ImportProfileUsecaseMock.DeleteImportProfileByID mock is already set by Set")
+	}
+
+	if mmDeleteImportProfileByID.defaultExpectation == nil {
+		mmDeleteImportProfileByID.defaultExpectation = &ImportProfileUsecaseMockDeleteImportProfileByIDExpectation{mock: mmDeleteImportProfileByID.mock}
+	}
+	mmDeleteImportProfileByID.defaultExpectation.results = &ImportProfileUsecaseMockDeleteImportProfileByIDResults{err}
+	mmDeleteImportProfileByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteImportProfileByID.mock
+}
+
+// Set uses given function f to mock the ImportProfileUsecase.DeleteImportProfileByID method
+func (mmDeleteImportProfileByID *mImportProfileUsecaseMockDeleteImportProfileByID) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *ImportProfileUsecaseMock {
+	if mmDeleteImportProfileByID.defaultExpectation != nil {
+		mmDeleteImportProfileByID.mock.t.Fatalf("Default expectation is already set for the ImportProfileUsecase.DeleteImportProfileByID method")
+	}
+
+	if len(mmDeleteImportProfileByID.expectations) > 0 {
+		mmDeleteImportProfileByID.mock.t.Fatalf("Some expectations are already set for the ImportProfileUsecase.DeleteImportProfileByID method")
+	}
+
+	mmDeleteImportProfileByID.mock.funcDeleteImportProfileByID = f
+	mmDeleteImportProfileByID.mock.funcDeleteImportProfileByIDOrigin = minimock.CallerInfo(1)
+	return mmDeleteImportProfileByID.mock
+}
+
+// When sets expectation for the ImportProfileUsecase.DeleteImportProfileByID which will trigger the result defined by the following
+// Then helper
+func (mmDeleteImportProfileByID *mImportProfileUsecaseMockDeleteImportProfileByID) When(ctx context.Context, id uuid.UUID) *ImportProfileUsecaseMockDeleteImportProfileByIDExpectation {
+	if mmDeleteImportProfileByID.mock.funcDeleteImportProfileByID != nil {
+		mmDeleteImportProfileByID.mock.t.Fatalf("ImportProfileUsecaseMock.DeleteImportProfileByID mock is already set by Set")
+	}
+
+	expectation := &ImportProfileUsecaseMockDeleteImportProfileByIDExpectation{
+		mock:               mmDeleteImportProfileByID.mock,
+		params:             &ImportProfileUsecaseMockDeleteImportProfileByIDParams{ctx, id},
+		expectationOrigins: ImportProfileUsecaseMockDeleteImportProfileByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteImportProfileByID.expectations = append(mmDeleteImportProfileByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportProfileUsecase.DeleteImportProfileByID return parameters for the expectation previously defined by the When method
+func (e *ImportProfileUsecaseMockDeleteImportProfileByIDExpectation) Then(err error) *ImportProfileUsecaseMock {
+	e.results = &ImportProfileUsecaseMockDeleteImportProfileByIDResults{err}
+	return e.mock
+}
+
+// Times sets number of times ImportProfileUsecase.DeleteImportProfileByID should be invoked
+func (mmDeleteImportProfileByID *mImportProfileUsecaseMockDeleteImportProfileByID) Times(n uint64) *mImportProfileUsecaseMockDeleteImportProfileByID {
+	if n == 0 {
+		mmDeleteImportProfileByID.mock.t.Fatalf("Times of ImportProfileUsecaseMock.DeleteImportProfileByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteImportProfileByID.expectedInvocations, n)
+	mmDeleteImportProfileByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteImportProfileByID
+}
+
+func (mmDeleteImportProfileByID *mImportProfileUsecaseMockDeleteImportProfileByID) invocationsDone() bool {
+	if len(mmDeleteImportProfileByID.expectations) == 0 && mmDeleteImportProfileByID.defaultExpectation == nil && mmDeleteImportProfileByID.mock.funcDeleteImportProfileByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteImportProfileByID.mock.afterDeleteImportProfileByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteImportProfileByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteImportProfileByID implements mm_usecase.ImportProfileUsecase
+func (mmDeleteImportProfileByID *ImportProfileUsecaseMock) DeleteImportProfileByID(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteImportProfileByID.beforeDeleteImportProfileByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteImportProfileByID.afterDeleteImportProfileByIDCounter, 1)
+
+	mmDeleteImportProfileByID.t.Helper()
+
+	if mmDeleteImportProfileByID.inspectFuncDeleteImportProfileByID != nil {
+		mmDeleteImportProfileByID.inspectFuncDeleteImportProfileByID(ctx, id)
+	}
+
+	mm_params := ImportProfileUsecaseMockDeleteImportProfileByIDParams{ctx, id}
+
+	// Record call args
+	mmDeleteImportProfileByID.DeleteImportProfileByIDMock.mutex.Lock()
+	mmDeleteImportProfileByID.DeleteImportProfileByIDMock.callArgs = append(mmDeleteImportProfileByID.DeleteImportProfileByIDMock.callArgs, &mm_params)
+	mmDeleteImportProfileByID.DeleteImportProfileByIDMock.mutex.Unlock()
+
+	for _, e := range mmDeleteImportProfileByID.DeleteImportProfileByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteImportProfileByID.DeleteImportProfileByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteImportProfileByID.DeleteImportProfileByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteImportProfileByID.DeleteImportProfileByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteImportProfileByID.DeleteImportProfileByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportProfileUsecaseMockDeleteImportProfileByIDParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteImportProfileByID.t.Errorf("ImportProfileUsecaseMock.DeleteImportProfileByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteImportProfileByID.DeleteImportProfileByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteImportProfileByID.t.Errorf("ImportProfileUsecaseMock.DeleteImportProfileByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteImportProfileByID.DeleteImportProfileByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteImportProfileByID.t.Errorf("ImportProfileUsecaseMock.DeleteImportProfileByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteImportProfileByID.DeleteImportProfileByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteImportProfileByID.DeleteImportProfileByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteImportProfileByID.t.Fatal("No results are set for the ImportProfileUsecaseMock.DeleteImportProfileByID")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteImportProfileByID.funcDeleteImportProfileByID != nil {
+		return mmDeleteImportProfileByID.funcDeleteImportProfileByID(ctx, id)
+	}
+	mmDeleteImportProfileByID.t.Fatalf("Unexpected call to ImportProfileUsecaseMock.DeleteImportProfileByID. %v %v", ctx, id)
+	return
+}
+
+// DeleteImportProfileByIDAfterCounter returns a count of finished ImportProfileUsecaseMock.DeleteImportProfileByID invocations
+func (mmDeleteImportProfileByID *ImportProfileUsecaseMock) DeleteImportProfileByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteImportProfileByID.afterDeleteImportProfileByIDCounter)
+}
+
+// DeleteImportProfileByIDBeforeCounter returns a count of ImportProfileUsecaseMock.DeleteImportProfileByID invocations
+func (mmDeleteImportProfileByID *ImportProfileUsecaseMock) DeleteImportProfileByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteImportProfileByID.beforeDeleteImportProfileByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportProfileUsecaseMock.DeleteImportProfileByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteImportProfileByID *mImportProfileUsecaseMockDeleteImportProfileByID) Calls() []*ImportProfileUsecaseMockDeleteImportProfileByIDParams {
+	mmDeleteImportProfileByID.mutex.RLock()
+
+	argCopy := make([]*ImportProfileUsecaseMockDeleteImportProfileByIDParams, len(mmDeleteImportProfileByID.callArgs))
+	copy(argCopy, mmDeleteImportProfileByID.callArgs)
+
+	mmDeleteImportProfileByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteImportProfileByIDDone returns true if the count of the DeleteImportProfileByID invocations corresponds
+// the number of defined expectations
+func (m *ImportProfileUsecaseMock) MinimockDeleteImportProfileByIDDone() bool {
+	if m.DeleteImportProfileByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteImportProfileByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteImportProfileByIDMock.invocationsDone()
+}
+
+// MinimockDeleteImportProfileByIDInspect logs each unmet expectation
+func (m *ImportProfileUsecaseMock) MinimockDeleteImportProfileByIDInspect() {
+	for _, e := range m.DeleteImportProfileByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportProfileUsecaseMock.DeleteImportProfileByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteImportProfileByIDCounter := mm_atomic.LoadUint64(&m.afterDeleteImportProfileByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteImportProfileByIDMock.defaultExpectation != nil && afterDeleteImportProfileByIDCounter < 1 {
+		if m.DeleteImportProfileByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportProfileUsecaseMock.DeleteImportProfileByID at\n%s", m.DeleteImportProfileByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportProfileUsecaseMock.DeleteImportProfileByID at\n%s with params: %#v", m.DeleteImportProfileByIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteImportProfileByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteImportProfileByID != nil && afterDeleteImportProfileByIDCounter < 1 {
+		m.t.Errorf("Expected call to ImportProfileUsecaseMock.DeleteImportProfileByID at\n%s", m.funcDeleteImportProfileByIDOrigin)
+	}
+
+	if !m.DeleteImportProfileByIDMock.invocationsDone() && afterDeleteImportProfileByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportProfileUsecaseMock.DeleteImportProfileByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteImportProfileByIDMock.expectedInvocations), m.DeleteImportProfileByIDMock.expectedInvocationsOrigin, afterDeleteImportProfileByIDCounter)
+	}
+}
+
+type mImportProfileUsecaseMockFindList struct {
+	optional           bool
+	mock               *ImportProfileUsecaseMock
+	defaultExpectation *ImportProfileUsecaseMockFindListExpectation
+	expectations       []*ImportProfileUsecaseMockFindListExpectation
+
+	callArgs []*ImportProfileUsecaseMockFindListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportProfileUsecaseMockFindListExpectation specifies expectation struct of the ImportProfileUsecase.FindList
+type ImportProfileUsecaseMockFindListExpectation struct {
+	mock               *ImportProfileUsecaseMock
+	params             *ImportProfileUsecaseMockFindListParams
+	paramPtrs          *ImportProfileUsecaseMockFindListParamPtrs
+	expectationOrigins ImportProfileUsecaseMockFindListExpectationOrigins
+	results            *ImportProfileUsecaseMockFindListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportProfileUsecaseMockFindListParams contains parameters of the ImportProfileUsecase.FindList
+type ImportProfileUsecaseMockFindListParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.ImportProfileListOptions
+	queryParams *uctypes.QueryGetListParams
+}
+
+// ImportProfileUsecaseMockFindListParamPtrs contains pointers to parameters of the ImportProfileUsecase.FindList
+type ImportProfileUsecaseMockFindListParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.ImportProfileListOptions
+	queryParams **uctypes.QueryGetListParams
+}
+
+// ImportProfileUsecaseMockFindListResults contains results of the ImportProfileUsecase.FindList
+type ImportProfileUsecaseMockFindListResults struct {
+	resItems []*mm_usecase.ImportProfileDTO
+	err      error
+}
+
+// ImportProfileUsecaseMockFindListOrigins contains origins of expectations of the ImportProfileUsecase.FindList
+type ImportProfileUsecaseMockFindListExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindList *mImportProfileUsecaseMockFindList) Optional() *mImportProfileUsecaseMockFindList {
+	mmFindList.optional = true
+	return mmFindList
+}
+
+// Expect sets up expected params for ImportProfileUsecase.FindList
+func (mmFindList *mImportProfileUsecaseMockFindList) Expect(ctx context.Context, listOptions *mm_usecase.ImportProfileListOptions, queryParams *uctypes.QueryGetListParams) *mImportProfileUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &ImportProfileUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileUsecaseMock.FindList mock is already set by ExpectParams functions")
+	}
+
+	mmFindList.defaultExpectation.params = &ImportProfileUsecaseMockFindListParams{ctx, listOptions, queryParams}
+	mmFindList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindList.expectations {
+		if minimock.Equal(e.params, mmFindList.defaultExpectation.params) {
+			mmFindList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindList.defaultExpectation.params)
+		}
+	}
+
+	return mmFindList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportProfileUsecase.FindList
+func (mmFindList *mImportProfileUsecaseMockFindList) ExpectCtxParam1(ctx context.Context) *mImportProfileUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &ImportProfileUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileUsecaseMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &ImportProfileUsecaseMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for ImportProfileUsecase.FindList
+func (mmFindList *mImportProfileUsecaseMockFindList) ExpectListOptionsParam2(listOptions *mm_usecase.ImportProfileListOptions) *mImportProfileUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &ImportProfileUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileUsecaseMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &ImportProfileUsecaseMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmFindList.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for ImportProfileUsecase.FindList
+func (mmFindList *mImportProfileUsecaseMockFindList) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mImportProfileUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &ImportProfileUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileUsecaseMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &ImportProfileUsecaseMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindList.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportProfileUsecase.FindList
+func (mmFindList *mImportProfileUsecaseMockFindList) Inspect(f func(ctx context.Context, listOptions *mm_usecase.ImportProfileListOptions, queryParams *uctypes.QueryGetListParams)) *mImportProfileUsecaseMockFindList {
+	if mmFindList.mock.inspectFuncFindList != nil {
+		mmFindList.mock.t.Fatalf("Inspect function is already set for ImportProfileUsecaseMock.FindList")
+	}
+
+	mmFindList.mock.inspectFuncFindList = f
+
+	return mmFindList
+}
+
+// Return sets up results that will be returned by ImportProfileUsecase.FindList
+func (mmFindList *mImportProfileUsecaseMockFindList) Return(resItems []*mm_usecase.ImportProfileDTO, err error) *ImportProfileUsecaseMock {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &ImportProfileUsecaseMockFindListExpectation{mock: mmFindList.mock}
+	}
+	mmFindList.defaultExpectation.results = &ImportProfileUsecaseMockFindListResults{resItems, err}
+	mmFindList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// Set uses given function f to mock the ImportProfileUsecase.FindList method
+func (mmFindList *mImportProfileUsecaseMockFindList) Set(f func(ctx context.Context, listOptions *mm_usecase.ImportProfileListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.ImportProfileDTO, err error)) *ImportProfileUsecaseMock {
+	if mmFindList.defaultExpectation != nil {
+		mmFindList.mock.t.Fatalf("Default expectation is already set for the ImportProfileUsecase.FindList method")
+	}
+
+	if len(mmFindList.expectations) > 0 {
+		mmFindList.mock.t.Fatalf("Some expectations are already set for the ImportProfileUsecase.FindList method")
+	}
+
+	mmFindList.mock.funcFindList = f
+	mmFindList.mock.funcFindListOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// When sets expectation for the ImportProfileUsecase.FindList which will trigger the result defined by the following
+// Then helper
+func (mmFindList *mImportProfileUsecaseMockFindList) When(ctx context.Context, listOptions *mm_usecase.ImportProfileListOptions, queryParams *uctypes.QueryGetListParams) *ImportProfileUsecaseMockFindListExpectation {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("ImportProfileUsecaseMock.FindList mock is already set by Set")
+	}
+
+	expectation := &ImportProfileUsecaseMockFindListExpectation{
+		mock:               mmFindList.mock,
+		params:             &ImportProfileUsecaseMockFindListParams{ctx, listOptions, queryParams},
+		expectationOrigins: ImportProfileUsecaseMockFindListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindList.expectations = append(mmFindList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportProfileUsecase.FindList return parameters for the expectation previously defined by the When method
+func (e *ImportProfileUsecaseMockFindListExpectation) Then(resItems []*mm_usecase.ImportProfileDTO, err error) *ImportProfileUsecaseMock {
+	e.results = &ImportProfileUsecaseMockFindListResults{resItems, err}
+	return e.mock
+}
+
+// Times sets number of times ImportProfileUsecase.FindList should be invoked
+func (mmFindList *mImportProfileUsecaseMockFindList) Times(n uint64) *mImportProfileUsecaseMockFindList {
+	if n == 0 {
+		mmFindList.mock.t.Fatalf("Times of ImportProfileUsecaseMock.FindList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindList.expectedInvocations, n)
+	mmFindList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindList
+}
+
+func (mmFindList *mImportProfileUsecaseMockFindList) invocationsDone() bool {
+	if len(mmFindList.expectations) == 0 && mmFindList.defaultExpectation == nil && mmFindList.mock.funcFindList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindList.mock.afterFindListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindList implements mm_usecase.ImportProfileUsecase
+func (mmFindList *ImportProfileUsecaseMock) FindList(ctx context.Context, listOptions *mm_usecase.ImportProfileListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.ImportProfileDTO, err error) {
+	mm_atomic.AddUint64(&mmFindList.beforeFindListCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindList.afterFindListCounter, 1)
+
+	mmFindList.t.Helper()
+
+	if mmFindList.inspectFuncFindList != nil {
+		mmFindList.inspectFuncFindList(ctx, listOptions, queryParams)
+	}
+
+	mm_params := ImportProfileUsecaseMockFindListParams{ctx, listOptions, queryParams}
+
+	// Record call args
+	mmFindList.FindListMock.mutex.Lock()
+	mmFindList.FindListMock.callArgs = append(mmFindList.FindListMock.callArgs, &mm_params)
+	mmFindList.FindListMock.mutex.Unlock()
+
+	for _, e := range mmFindList.FindListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resItems, e.results.err
+		}
+	}
+
+	if mmFindList.FindListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindList.FindListMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindList.FindListMock.defaultExpectation.params
+		mm_want_ptrs := mmFindList.FindListMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportProfileUsecaseMockFindListParams{ctx, listOptions, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindList.t.Errorf("ImportProfileUsecaseMock.FindList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmFindList.t.Errorf("ImportProfileUsecaseMock.FindList got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindList.t.Errorf("ImportProfileUsecaseMock.FindList got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindList.t.Errorf("ImportProfileUsecaseMock.FindList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindList.FindListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindList.FindListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindList.t.Fatal("No results are set for the ImportProfileUsecaseMock.FindList")
+		}
+		return (*mm_results).resItems, (*mm_results).err
+	}
+	if mmFindList.funcFindList != nil {
+		return mmFindList.funcFindList(ctx, listOptions, queryParams)
+	}
+	mmFindList.t.Fatalf("Unexpected call to ImportProfileUsecaseMock.FindList. %v %v %v", ctx, listOptions, queryParams)
+	return
+}
+
+// FindListAfterCounter returns a count of finished ImportProfileUsecaseMock.FindList invocations
+func (mmFindList *ImportProfileUsecaseMock) FindListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.afterFindListCounter)
+}
+
+// FindListBeforeCounter returns a count of ImportProfileUsecaseMock.FindList invocations
+func (mmFindList *ImportProfileUsecaseMock) FindListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.beforeFindListCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportProfileUsecaseMock.FindList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindList *mImportProfileUsecaseMockFindList) Calls() []*ImportProfileUsecaseMockFindListParams {
+	mmFindList.mutex.RLock()
+
+	argCopy := make([]*ImportProfileUsecaseMockFindListParams, len(mmFindList.callArgs))
+	copy(argCopy, mmFindList.callArgs)
+
+	mmFindList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindListDone returns true if the count of the FindList invocations corresponds
+// the number of defined expectations
+func (m *ImportProfileUsecaseMock) MinimockFindListDone() bool {
+	if m.FindListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindListMock.invocationsDone()
+}
+
+// MinimockFindListInspect logs each unmet expectation
+func (m *ImportProfileUsecaseMock) MinimockFindListInspect() {
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportProfileUsecaseMock.FindList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindListCounter := mm_atomic.LoadUint64(&m.afterFindListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindListMock.defaultExpectation != nil && afterFindListCounter < 1 {
+		if m.FindListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportProfileUsecaseMock.FindList at\n%s", m.FindListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportProfileUsecaseMock.FindList at\n%s with params: %#v", m.FindListMock.defaultExpectation.expectationOrigins.origin, *m.FindListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindList != nil && afterFindListCounter < 1 {
+		m.t.Errorf("Expected call to ImportProfileUsecaseMock.FindList at\n%s", m.funcFindListOrigin)
+	}
+
+	if !m.FindListMock.invocationsDone() && afterFindListCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportProfileUsecaseMock.FindList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindListMock.expectedInvocations), m.FindListMock.expectedInvocationsOrigin, afterFindListCounter)
+	}
+}
+
+type mImportProfileUsecaseMockFindOneByID struct {
+	optional           bool
+	mock               *ImportProfileUsecaseMock
+	defaultExpectation *ImportProfileUsecaseMockFindOneByIDExpectation
+	expectations       []*ImportProfileUsecaseMockFindOneByIDExpectation
+
+	callArgs []*ImportProfileUsecaseMockFindOneByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportProfileUsecaseMockFindOneByIDExpectation specifies expectation struct of the ImportProfileUsecase.FindOneByID
+type ImportProfileUsecaseMockFindOneByIDExpectation struct {
+	mock               *ImportProfileUsecaseMock
+	params             *ImportProfileUsecaseMockFindOneByIDParams
+	paramPtrs          *ImportProfileUsecaseMockFindOneByIDParamPtrs
+	expectationOrigins ImportProfileUsecaseMockFindOneByIDExpectationOrigins
+	results            *ImportProfileUsecaseMockFindOneByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportProfileUsecaseMockFindOneByIDParams contains parameters of the ImportProfileUsecase.FindOneByID
+type ImportProfileUsecaseMockFindOneByIDParams struct {
+	ctx         context.Context
+	id          uuid.UUID
+	queryParams *uctypes.QueryGetOneParams
+}
+
+// ImportProfileUsecaseMockFindOneByIDParamPtrs contains pointers to parameters of the ImportProfileUsecase.FindOneByID
+type ImportProfileUsecaseMockFindOneByIDParamPtrs struct {
+	ctx         *context.Context
+	id          *uuid.UUID
+	queryParams **uctypes.QueryGetOneParams
+}
+
+// ImportProfileUsecaseMockFindOneByIDResults contains results of the ImportProfileUsecase.FindOneByID
+type ImportProfileUsecaseMockFindOneByIDResults struct {
+	resItem *mm_usecase.ImportProfileDTO
+	err     error
+}
+
+// ImportProfileUsecaseMockFindOneByIDOrigins contains origins of expectations of the ImportProfileUsecase.FindOneByID
+type ImportProfileUsecaseMockFindOneByIDExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originId          string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindOneByID *mImportProfileUsecaseMockFindOneByID) Optional() *mImportProfileUsecaseMockFindOneByID {
+	mmFindOneByID.optional = true
+	return mmFindOneByID
+}
+
+// Expect sets up expected params for ImportProfileUsecase.FindOneByID
+func (mmFindOneByID *mImportProfileUsecaseMockFindOneByID) Expect(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *mImportProfileUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &ImportProfileUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileUsecaseMock.FindOneByID mock is already set by ExpectParams functions")
+	}
+
+	mmFindOneByID.defaultExpectation.params = &ImportProfileUsecaseMockFindOneByIDParams{ctx, id, queryParams}
+	mmFindOneByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindOneByID.expectations {
+		if minimock.Equal(e.params, mmFindOneByID.defaultExpectation.params) {
+			mmFindOneByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindOneByID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindOneByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportProfileUsecase.FindOneByID
+func (mmFindOneByID *mImportProfileUsecaseMockFindOneByID) ExpectCtxParam1(ctx context.Context) *mImportProfileUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &ImportProfileUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileUsecaseMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &ImportProfileUsecaseMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindOneByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// ExpectIdParam2 sets up expected param id for ImportProfileUsecase.FindOneByID
+func (mmFindOneByID *mImportProfileUsecaseMockFindOneByID) ExpectIdParam2(id uuid.UUID) *mImportProfileUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &ImportProfileUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileUsecaseMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &ImportProfileUsecaseMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.id = &id
+	mmFindOneByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for ImportProfileUsecase.FindOneByID
+func (mmFindOneByID *mImportProfileUsecaseMockFindOneByID) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetOneParams) *mImportProfileUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &ImportProfileUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileUsecaseMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &ImportProfileUsecaseMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindOneByID.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportProfileUsecase.FindOneByID
+func (mmFindOneByID *mImportProfileUsecaseMockFindOneByID) Inspect(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)) *mImportProfileUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.inspectFuncFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("Inspect function is already set for ImportProfileUsecaseMock.FindOneByID")
+	}
+
+	mmFindOneByID.mock.inspectFuncFindOneByID = f
+
+	return mmFindOneByID
+}
+
+// Return sets up results that will be returned by ImportProfileUsecase.FindOneByID
+func (mmFindOneByID *mImportProfileUsecaseMockFindOneByID) Return(resItem *mm_usecase.ImportProfileDTO, err error) *ImportProfileUsecaseMock {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &ImportProfileUsecaseMockFindOneByIDExpectation{mock: mmFindOneByID.mock}
+	}
+	mmFindOneByID.defaultExpectation.results = &ImportProfileUsecaseMockFindOneByIDResults{resItem, err}
+	mmFindOneByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID.mock
+}
+
+// Set uses given function f to mock the ImportProfileUsecase.FindOneByID method
+func (mmFindOneByID *mImportProfileUsecaseMockFindOneByID) Set(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.ImportProfileDTO, err error)) *ImportProfileUsecaseMock {
+	if mmFindOneByID.defaultExpectation != nil {
+		mmFindOneByID.mock.t.Fatalf("Default expectation is already set for the ImportProfileUsecase.FindOneByID method")
+	}
+
+	if len(mmFindOneByID.expectations) > 0 {
+		mmFindOneByID.mock.t.Fatalf("Some expectations are already set for the ImportProfileUsecase.FindOneByID method")
+	}
+
+	mmFindOneByID.mock.funcFindOneByID = f
+	mmFindOneByID.mock.funcFindOneByIDOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID.mock
+}
+
+// When sets expectation for the ImportProfileUsecase.FindOneByID which will trigger the result defined by the following
+// Then helper
+func (mmFindOneByID *mImportProfileUsecaseMockFindOneByID) When(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *ImportProfileUsecaseMockFindOneByIDExpectation {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("ImportProfileUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	expectation := &ImportProfileUsecaseMockFindOneByIDExpectation{
+		mock:               mmFindOneByID.mock,
+		params:             &ImportProfileUsecaseMockFindOneByIDParams{ctx, id, queryParams},
+		expectationOrigins: ImportProfileUsecaseMockFindOneByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindOneByID.expectations = append(mmFindOneByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportProfileUsecase.FindOneByID return parameters for the expectation previously defined by the When method
+func (e *ImportProfileUsecaseMockFindOneByIDExpectation) Then(resItem *mm_usecase.ImportProfileDTO, err error) *ImportProfileUsecaseMock {
+	e.results = &ImportProfileUsecaseMockFindOneByIDResults{resItem, err}
+	return e.mock
+}
+
+// Times sets number of times ImportProfileUsecase.FindOneByID should be invoked
+func (mmFindOneByID *mImportProfileUsecaseMockFindOneByID) Times(n uint64) *mImportProfileUsecaseMockFindOneByID {
+	if n == 0 {
+		mmFindOneByID.mock.t.Fatalf("Times of ImportProfileUsecaseMock.FindOneByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindOneByID.expectedInvocations, n)
+	mmFindOneByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID
+}
+
+func (mmFindOneByID *mImportProfileUsecaseMockFindOneByID) invocationsDone() bool {
+	if len(mmFindOneByID.expectations) == 0 && mmFindOneByID.defaultExpectation == nil && mmFindOneByID.mock.funcFindOneByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindOneByID.mock.afterFindOneByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindOneByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindOneByID implements mm_usecase.ImportProfileUsecase
+func (mmFindOneByID *ImportProfileUsecaseMock) FindOneByID(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.ImportProfileDTO, err error) {
+	mm_atomic.AddUint64(&mmFindOneByID.beforeFindOneByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindOneByID.afterFindOneByIDCounter, 1)
+
+	mmFindOneByID.t.Helper()
+
+	if mmFindOneByID.inspectFuncFindOneByID != nil {
+		mmFindOneByID.inspectFuncFindOneByID(ctx, id, queryParams)
+	}
+
+	mm_params := ImportProfileUsecaseMockFindOneByIDParams{ctx, id, queryParams}
+
+	// Record call args
+	mmFindOneByID.FindOneByIDMock.mutex.Lock()
+	mmFindOneByID.FindOneByIDMock.callArgs = append(mmFindOneByID.FindOneByIDMock.callArgs, &mm_params)
+	mmFindOneByID.FindOneByIDMock.mutex.Unlock()
+
+	for _, e := range mmFindOneByID.FindOneByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resItem, e.results.err
+		}
+	}
+
+	if mmFindOneByID.FindOneByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindOneByID.FindOneByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindOneByID.FindOneByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindOneByID.FindOneByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportProfileUsecaseMockFindOneByIDParams{ctx, id, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindOneByID.t.Errorf("ImportProfileUsecaseMock.FindOneByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmFindOneByID.t.Errorf("ImportProfileUsecaseMock.FindOneByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindOneByID.t.Errorf("ImportProfileUsecaseMock.FindOneByID got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindOneByID.t.Errorf("ImportProfileUsecaseMock.FindOneByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindOneByID.FindOneByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindOneByID.t.Fatal("No results are set for the ImportProfileUsecaseMock.FindOneByID")
+		}
+		return (*mm_results).resItem, (*mm_results).err
+	}
+	if mmFindOneByID.funcFindOneByID != nil {
+		return mmFindOneByID.funcFindOneByID(ctx, id, queryParams)
+	}
+	mmFindOneByID.t.Fatalf("Unexpected call to ImportProfileUsecaseMock.FindOneByID. %v %v %v", ctx, id, queryParams)
+	return
+}
+
+// FindOneByIDAfterCounter returns a count of finished ImportProfileUsecaseMock.FindOneByID invocations
+func (mmFindOneByID *ImportProfileUsecaseMock) FindOneByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByID.afterFindOneByIDCounter)
+}
+
+// FindOneByIDBeforeCounter returns a count of ImportProfileUsecaseMock.FindOneByID invocations
+func (mmFindOneByID *ImportProfileUsecaseMock) FindOneByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByID.beforeFindOneByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportProfileUsecaseMock.FindOneByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindOneByID *mImportProfileUsecaseMockFindOneByID) Calls() []*ImportProfileUsecaseMockFindOneByIDParams {
+	mmFindOneByID.mutex.RLock()
+
+	argCopy := make([]*ImportProfileUsecaseMockFindOneByIDParams, len(mmFindOneByID.callArgs))
+	copy(argCopy, mmFindOneByID.callArgs)
+
+	mmFindOneByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindOneByIDDone returns true if the count of the FindOneByID invocations corresponds
+// the number of defined expectations
+func (m *ImportProfileUsecaseMock) MinimockFindOneByIDDone() bool {
+	if m.FindOneByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindOneByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindOneByIDMock.invocationsDone()
+}
+
+// MinimockFindOneByIDInspect logs each unmet expectation
+func (m *ImportProfileUsecaseMock) MinimockFindOneByIDInspect() {
+	for _, e := range m.FindOneByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportProfileUsecaseMock.FindOneByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindOneByIDCounter := mm_atomic.LoadUint64(&m.afterFindOneByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindOneByIDMock.defaultExpectation != nil && afterFindOneByIDCounter < 1 {
+		if m.FindOneByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportProfileUsecaseMock.FindOneByID at\n%s", m.FindOneByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportProfileUsecaseMock.FindOneByID at\n%s with params: %#v", m.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindOneByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindOneByID != nil && afterFindOneByIDCounter < 1 {
+		m.t.Errorf("Expected call to ImportProfileUsecaseMock.FindOneByID at\n%s", m.funcFindOneByIDOrigin)
+	}
+
+	if !m.FindOneByIDMock.invocationsDone() && afterFindOneByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportProfileUsecaseMock.FindOneByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindOneByIDMock.expectedInvocations), m.FindOneByIDMock.expectedInvocationsOrigin, afterFindOneByIDCounter)
+	}
+}
+
+type mImportProfileUsecaseMockPatchImportProfileByDTO struct {
+	optional           bool
+	mock               *ImportProfileUsecaseMock
+	defaultExpectation *ImportProfileUsecaseMockPatchImportProfileByDTOExpectation
+	expectations       []*ImportProfileUsecaseMockPatchImportProfileByDTOExpectation
+
+	callArgs []*ImportProfileUsecaseMockPatchImportProfileByDTOParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportProfileUsecaseMockPatchImportProfileByDTOExpectation specifies expectation struct of the ImportProfileUsecase.PatchImportProfileByDTO
+type ImportProfileUsecaseMockPatchImportProfileByDTOExpectation struct {
+	mock               *ImportProfileUsecaseMock
+	params             *ImportProfileUsecaseMockPatchImportProfileByDTOParams
+	paramPtrs          *ImportProfileUsecaseMockPatchImportProfileByDTOParamPtrs
+	expectationOrigins ImportProfileUsecaseMockPatchImportProfileByDTOExpectationOrigins
+	results            *ImportProfileUsecaseMockPatchImportProfileByDTOResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportProfileUsecaseMockPatchImportProfileByDTOParams contains parameters of the ImportProfileUsecase.PatchImportProfileByDTO
+type ImportProfileUsecaseMockPatchImportProfileByDTOParams struct {
+	ctx              context.Context
+	id               uuid.UUID
+	in               mm_usecase.PatchImportProfileDataInput
+	skipVersionCheck bool
+}
+
+// ImportProfileUsecaseMockPatchImportProfileByDTOParamPtrs contains pointers to parameters of the ImportProfileUsecase.PatchImportProfileByDTO
+type ImportProfileUsecaseMockPatchImportProfileByDTOParamPtrs struct {
+	ctx              *context.Context
+	id               *uuid.UUID
+	in               *mm_usecase.PatchImportProfileDataInput
+	skipVersionCheck *bool
+}
+
+// ImportProfileUsecaseMockPatchImportProfileByDTOResults contains results of the ImportProfileUsecase.PatchImportProfileByDTO
+type ImportProfileUsecaseMockPatchImportProfileByDTOResults struct {
+	err error
+}
+
+// ImportProfileUsecaseMockPatchImportProfileByDTOOrigins contains origins of expectations of the ImportProfileUsecase.PatchImportProfileByDTO
+type ImportProfileUsecaseMockPatchImportProfileByDTOExpectationOrigins struct {
+	origin                 string
+	originCtx              string
+	originId               string
+	originIn               string
+	originSkipVersionCheck string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPatchImportProfileByDTO *mImportProfileUsecaseMockPatchImportProfileByDTO) Optional() *mImportProfileUsecaseMockPatchImportProfileByDTO {
+	mmPatchImportProfileByDTO.optional = true
+	return mmPatchImportProfileByDTO
+}
+
+// Expect sets up expected params for ImportProfileUsecase.PatchImportProfileByDTO
+func (mmPatchImportProfileByDTO *mImportProfileUsecaseMockPatchImportProfileByDTO) Expect(ctx context.Context, id uuid.UUID, in mm_usecase.PatchImportProfileDataInput, skipVersionCheck bool) *mImportProfileUsecaseMockPatchImportProfileByDTO {
+	if mmPatchImportProfileByDTO.mock.funcPatchImportProfileByDTO != nil {
+		mmPatchImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.PatchImportProfileByDTO mock is already set by Set")
+	}
+
+	if mmPatchImportProfileByDTO.defaultExpectation == nil {
+		mmPatchImportProfileByDTO.defaultExpectation = &ImportProfileUsecaseMockPatchImportProfileByDTOExpectation{}
+	}
+
+	if mmPatchImportProfileByDTO.defaultExpectation.paramPtrs != nil {
+		mmPatchImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.PatchImportProfileByDTO mock is already set by ExpectParams functions")
+	}
+
+	mmPatchImportProfileByDTO.defaultExpectation.params = &ImportProfileUsecaseMockPatchImportProfileByDTOParams{ctx, id, in, skipVersionCheck}
+	mmPatchImportProfileByDTO.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPatchImportProfileByDTO.expectations {
+		if minimock.Equal(e.params, mmPatchImportProfileByDTO.defaultExpectation.params) {
+			mmPatchImportProfileByDTO.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPatchImportProfileByDTO.defaultExpectation.params)
+		}
+	}
+
+	return mmPatchImportProfileByDTO
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportProfileUsecase.PatchImportProfileByDTO
+func (mmPatchImportProfileByDTO *mImportProfileUsecaseMockPatchImportProfileByDTO) ExpectCtxParam1(ctx context.Context) *mImportProfileUsecaseMockPatchImportProfileByDTO {
+	if mmPatchImportProfileByDTO.mock.funcPatchImportProfileByDTO != nil {
+		mmPatchImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.PatchImportProfileByDTO mock is already set by Set")
+	}
+
+	if mmPatchImportProfileByDTO.defaultExpectation == nil {
+		mmPatchImportProfileByDTO.defaultExpectation = &ImportProfileUsecaseMockPatchImportProfileByDTOExpectation{}
+	}
+
+	if mmPatchImportProfileByDTO.defaultExpectation.params != nil {
+		mmPatchImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.PatchImportProfileByDTO mock is already set by Expect")
+	}
+
+	if mmPatchImportProfileByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchImportProfileByDTO.defaultExpectation.paramPtrs = &ImportProfileUsecaseMockPatchImportProfileByDTOParamPtrs{}
+	}
+	mmPatchImportProfileByDTO.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPatchImportProfileByDTO.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPatchImportProfileByDTO
+}
+
+// ExpectIdParam2 sets up expected param id for ImportProfileUsecase.PatchImportProfileByDTO
+func (mmPatchImportProfileByDTO *mImportProfileUsecaseMockPatchImportProfileByDTO) ExpectIdParam2(id uuid.UUID) *mImportProfileUsecaseMockPatchImportProfileByDTO {
+	if mmPatchImportProfileByDTO.mock.funcPatchImportProfileByDTO != nil {
+		mmPatchImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.PatchImportProfileByDTO mock is already set by Set")
+	}
+
+	if mmPatchImportProfileByDTO.defaultExpectation == nil {
+		mmPatchImportProfileByDTO.defaultExpectation = &ImportProfileUsecaseMockPatchImportProfileByDTOExpectation{}
+	}
+
+	if mmPatchImportProfileByDTO.defaultExpectation.params != nil {
+		mmPatchImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.PatchImportProfileByDTO mock is already set by Expect")
+	}
+
+	if mmPatchImportProfileByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchImportProfileByDTO.defaultExpectation.paramPtrs = &ImportProfileUsecaseMockPatchImportProfileByDTOParamPtrs{}
+	}
+	mmPatchImportProfileByDTO.defaultExpectation.paramPtrs.id = &id
+	mmPatchImportProfileByDTO.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmPatchImportProfileByDTO
+}
+
+// ExpectInParam3 sets up expected param in for ImportProfileUsecase.PatchImportProfileByDTO
+func (mmPatchImportProfileByDTO *mImportProfileUsecaseMockPatchImportProfileByDTO) ExpectInParam3(in mm_usecase.PatchImportProfileDataInput) *mImportProfileUsecaseMockPatchImportProfileByDTO {
+	if mmPatchImportProfileByDTO.mock.funcPatchImportProfileByDTO != nil {
+		mmPatchImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.PatchImportProfileByDTO mock is already set by Set")
+	}
+
+	if mmPatchImportProfileByDTO.defaultExpectation == nil {
+		mmPatchImportProfileByDTO.defaultExpectation = &ImportProfileUsecaseMockPatchImportProfileByDTOExpectation{}
+	}
+
+	if mmPatchImportProfileByDTO.defaultExpectation.params != nil {
+		mmPatchImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.PatchImportProfileByDTO mock is already set by Expect")
+	}
+
+	if mmPatchImportProfileByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchImportProfileByDTO.defaultExpectation.paramPtrs = &ImportProfileUsecaseMockPatchImportProfileByDTOParamPtrs{}
+	}
+	mmPatchImportProfileByDTO.defaultExpectation.paramPtrs.in = &in
+	mmPatchImportProfileByDTO.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmPatchImportProfileByDTO
+}
+
+// ExpectSkipVersionCheckParam4 sets up expected param skipVersionCheck for ImportProfileUsecase.PatchImportProfileByDTO
+func (mmPatchImportProfileByDTO *mImportProfileUsecaseMockPatchImportProfileByDTO) ExpectSkipVersionCheckParam4(skipVersionCheck bool) *mImportProfileUsecaseMockPatchImportProfileByDTO {
+	if mmPatchImportProfileByDTO.mock.funcPatchImportProfileByDTO != nil {
+		mmPatchImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.PatchImportProfileByDTO mock is already set by Set")
+	}
+
+	if mmPatchImportProfileByDTO.defaultExpectation == nil {
+		mmPatchImportProfileByDTO.defaultExpectation = &ImportProfileUsecaseMockPatchImportProfileByDTOExpectation{}
+	}
+
+	if mmPatchImportProfileByDTO.defaultExpectation.params != nil {
+		mmPatchImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.PatchImportProfileByDTO mock is already set by Expect")
+	}
+
+	if mmPatchImportProfileByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchImportProfileByDTO.defaultExpectation.paramPtrs = &ImportProfileUsecaseMockPatchImportProfileByDTOParamPtrs{}
+	}
+	mmPatchImportProfileByDTO.defaultExpectation.paramPtrs.skipVersionCheck = &skipVersionCheck
+	mmPatchImportProfileByDTO.defaultExpectation.expectationOrigins.originSkipVersionCheck = minimock.CallerInfo(1)
+
+	return mmPatchImportProfileByDTO
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportProfileUsecase.PatchImportProfileByDTO
+func (mmPatchImportProfileByDTO *mImportProfileUsecaseMockPatchImportProfileByDTO) Inspect(f func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchImportProfileDataInput, skipVersionCheck bool)) *mImportProfileUsecaseMockPatchImportProfileByDTO {
+	if mmPatchImportProfileByDTO.mock.inspectFuncPatchImportProfileByDTO != nil {
+		mmPatchImportProfileByDTO.mock.t.Fatalf("Inspect function is already set for ImportProfileUsecaseMock.PatchImportProfileByDTO")
+	}
+
+	mmPatchImportProfileByDTO.mock.inspectFuncPatchImportProfileByDTO = f
+
+	return mmPatchImportProfileByDTO
+}
+
+// Return sets up results that will be returned by ImportProfileUsecase.PatchImportProfileByDTO
+func (mmPatchImportProfileByDTO *mImportProfileUsecaseMockPatchImportProfileByDTO) Return(err error) *ImportProfileUsecaseMock {
+	if mmPatchImportProfileByDTO.mock.funcPatchImportProfileByDTO != nil {
+		mmPatchImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.PatchImportProfileByDTO mock is already set by Set")
+	}
+
+	if mmPatchImportProfileByDTO.defaultExpectation == nil {
+		mmPatchImportProfileByDTO.defaultExpectation = &ImportProfileUsecaseMockPatchImportProfileByDTOExpectation{mock: mmPatchImportProfileByDTO.mock}
+	}
+	mmPatchImportProfileByDTO.defaultExpectation.results = &ImportProfileUsecaseMockPatchImportProfileByDTOResults{err}
+	mmPatchImportProfileByDTO.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPatchImportProfileByDTO.mock
+}
+
+// Set uses given function f to mock the ImportProfileUsecase.PatchImportProfileByDTO method
+func (mmPatchImportProfileByDTO *mImportProfileUsecaseMockPatchImportProfileByDTO) Set(f func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchImportProfileDataInput, skipVersionCheck bool) (err error)) *ImportProfileUsecaseMock {
+	if mmPatchImportProfileByDTO.defaultExpectation != nil {
+		mmPatchImportProfileByDTO.mock.t.Fatalf("Default expectation is already set for the ImportProfileUsecase.PatchImportProfileByDTO method")
+	}
+
+	if len(mmPatchImportProfileByDTO.expectations) > 0 {
+		mmPatchImportProfileByDTO.mock.t.Fatalf("Some expectations are already set for the ImportProfileUsecase.PatchImportProfileByDTO method")
+	}
+
+	mmPatchImportProfileByDTO.mock.funcPatchImportProfileByDTO = f
+	mmPatchImportProfileByDTO.mock.funcPatchImportProfileByDTOOrigin = minimock.CallerInfo(1)
+	return mmPatchImportProfileByDTO.mock
+}
+
+// When sets expectation for the ImportProfileUsecase.PatchImportProfileByDTO which will trigger the result defined by the following
+// Then helper
+func (mmPatchImportProfileByDTO *mImportProfileUsecaseMockPatchImportProfileByDTO) When(ctx context.Context, id uuid.UUID, in mm_usecase.PatchImportProfileDataInput, skipVersionCheck bool) *ImportProfileUsecaseMockPatchImportProfileByDTOExpectation {
+	if mmPatchImportProfileByDTO.mock.funcPatchImportProfileByDTO != nil {
+		mmPatchImportProfileByDTO.mock.t.Fatalf("ImportProfileUsecaseMock.PatchImportProfileByDTO mock is already set by Set")
+	}
+
+	expectation := &ImportProfileUsecaseMockPatchImportProfileByDTOExpectation{
+		mock:               mmPatchImportProfileByDTO.mock,
+		params:             &ImportProfileUsecaseMockPatchImportProfileByDTOParams{ctx, id, in, skipVersionCheck},
+		expectationOrigins: ImportProfileUsecaseMockPatchImportProfileByDTOExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPatchImportProfileByDTO.expectations = append(mmPatchImportProfileByDTO.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportProfileUsecase.PatchImportProfileByDTO return parameters for the expectation previously defined by the When method
+func (e *ImportProfileUsecaseMockPatchImportProfileByDTOExpectation) Then(err error) *ImportProfileUsecaseMock {
+	e.results = &ImportProfileUsecaseMockPatchImportProfileByDTOResults{err}
+	return e.mock
+}
+
+// Times sets number of times ImportProfileUsecase.PatchImportProfileByDTO should be invoked
+func (mmPatchImportProfileByDTO *mImportProfileUsecaseMockPatchImportProfileByDTO) Times(n uint64) *mImportProfileUsecaseMockPatchImportProfileByDTO {
+	if n == 0 {
+		mmPatchImportProfileByDTO.mock.t.Fatalf("Times of ImportProfileUsecaseMock.PatchImportProfileByDTO mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPatchImportProfileByDTO.expectedInvocations, n)
+	mmPatchImportProfileByDTO.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPatchImportProfileByDTO
+}
+
+func (mmPatchImportProfileByDTO *mImportProfileUsecaseMockPatchImportProfileByDTO) invocationsDone() bool {
+	if len(mmPatchImportProfileByDTO.expectations) == 0 && mmPatchImportProfileByDTO.defaultExpectation == nil && mmPatchImportProfileByDTO.mock.funcPatchImportProfileByDTO == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPatchImportProfileByDTO.mock.afterPatchImportProfileByDTOCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPatchImportProfileByDTO.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PatchImportProfileByDTO implements mm_usecase.ImportProfileUsecase
+func (mmPatchImportProfileByDTO *ImportProfileUsecaseMock) PatchImportProfileByDTO(ctx context.Context, id uuid.UUID, in mm_usecase.PatchImportProfileDataInput, skipVersionCheck bool) (err error) {
+	mm_atomic.AddUint64(&mmPatchImportProfileByDTO.beforePatchImportProfileByDTOCounter, 1)
+	defer mm_atomic.AddUint64(&mmPatchImportProfileByDTO.afterPatchImportProfileByDTOCounter, 1)
+
+	mmPatchImportProfileByDTO.t.Helper()
+
+	if mmPatchImportProfileByDTO.inspectFuncPatchImportProfileByDTO != nil {
+		mmPatchImportProfileByDTO.inspectFuncPatchImportProfileByDTO(ctx, id, in, skipVersionCheck)
+	}
+
+	mm_params := ImportProfileUsecaseMockPatchImportProfileByDTOParams{ctx, id, in, skipVersionCheck}
+
+	// Record call args
+	mmPatchImportProfileByDTO.PatchImportProfileByDTOMock.mutex.Lock()
+	mmPatchImportProfileByDTO.PatchImportProfileByDTOMock.callArgs = append(mmPatchImportProfileByDTO.PatchImportProfileByDTOMock.callArgs, &mm_params)
+	mmPatchImportProfileByDTO.PatchImportProfileByDTOMock.mutex.Unlock()
+
+	for _, e := range mmPatchImportProfileByDTO.PatchImportProfileByDTOMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPatchImportProfileByDTO.PatchImportProfileByDTOMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPatchImportProfileByDTO.PatchImportProfileByDTOMock.defaultExpectation.Counter, 1)
+		mm_want := mmPatchImportProfileByDTO.PatchImportProfileByDTOMock.defaultExpectation.params
+		mm_want_ptrs := mmPatchImportProfileByDTO.PatchImportProfileByDTOMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportProfileUsecaseMockPatchImportProfileByDTOParams{ctx, id, in, skipVersionCheck}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPatchImportProfileByDTO.t.Errorf("ImportProfileUsecaseMock.PatchImportProfileByDTO got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchImportProfileByDTO.PatchImportProfileByDTOMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmPatchImportProfileByDTO.t.Errorf("ImportProfileUsecaseMock.PatchImportProfileByDTO got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchImportProfileByDTO.PatchImportProfileByDTOMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmPatchImportProfileByDTO.t.Errorf("ImportProfileUsecaseMock.PatchImportProfileByDTO got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchImportProfileByDTO.PatchImportProfileByDTOMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+			if mm_want_ptrs.skipVersionCheck != nil && !minimock.Equal(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck) {
+				mmPatchImportProfileByDTO.t.Errorf("ImportProfileUsecaseMock.PatchImportProfileByDTO got unexpected parameter skipVersionCheck, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchImportProfileByDTO.PatchImportProfileByDTOMock.defaultExpectation.expectationOrigins.originSkipVersionCheck, *mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck, minimock.Diff(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPatchImportProfileByDTO.t.Errorf("ImportProfileUsecaseMock.PatchImportProfileByDTO got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPatchImportProfileByDTO.PatchImportProfileByDTOMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPatchImportProfileByDTO.PatchImportProfileByDTOMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPatchImportProfileByDTO.t.Fatal("No results are set for the ImportProfileUsecaseMock.PatchImportProfileByDTO")
+		}
+		return (*mm_results).err
+	}
+	if mmPatchImportProfileByDTO.funcPatchImportProfileByDTO != nil {
+		return mmPatchImportProfileByDTO.funcPatchImportProfileByDTO(ctx, id, in, skipVersionCheck)
+	}
+	mmPatchImportProfileByDTO.t.Fatalf("Unexpected call to ImportProfileUsecaseMock.PatchImportProfileByDTO. %v %v %v %v", ctx, id, in, skipVersionCheck)
+	return
+}
+
+// PatchImportProfileByDTOAfterCounter returns a count of finished ImportProfileUsecaseMock.PatchImportProfileByDTO invocations
+func (mmPatchImportProfileByDTO *ImportProfileUsecaseMock) PatchImportProfileByDTOAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPatchImportProfileByDTO.afterPatchImportProfileByDTOCounter)
+}
+
+// PatchImportProfileByDTOBeforeCounter returns a count of ImportProfileUsecaseMock.PatchImportProfileByDTO invocations
+func (mmPatchImportProfileByDTO *ImportProfileUsecaseMock) PatchImportProfileByDTOBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPatchImportProfileByDTO.beforePatchImportProfileByDTOCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportProfileUsecaseMock.PatchImportProfileByDTO.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPatchImportProfileByDTO *mImportProfileUsecaseMockPatchImportProfileByDTO) Calls() []*ImportProfileUsecaseMockPatchImportProfileByDTOParams {
+	mmPatchImportProfileByDTO.mutex.RLock()
+
+	argCopy := make([]*ImportProfileUsecaseMockPatchImportProfileByDTOParams, len(mmPatchImportProfileByDTO.callArgs))
+	copy(argCopy, mmPatchImportProfileByDTO.callArgs)
+
+	mmPatchImportProfileByDTO.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPatchImportProfileByDTODone returns true if the count of the PatchImportProfileByDTO invocations corresponds
+// the number of defined expectations
+func (m *ImportProfileUsecaseMock) MinimockPatchImportProfileByDTODone() bool {
+	if m.PatchImportProfileByDTOMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PatchImportProfileByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PatchImportProfileByDTOMock.invocationsDone()
+}
+
+// MinimockPatchImportProfileByDTOInspect logs each unmet expectation
+func (m *ImportProfileUsecaseMock) MinimockPatchImportProfileByDTOInspect() {
+	for _, e := range m.PatchImportProfileByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportProfileUsecaseMock.PatchImportProfileByDTO at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPatchImportProfileByDTOCounter := mm_atomic.LoadUint64(&m.afterPatchImportProfileByDTOCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PatchImportProfileByDTOMock.defaultExpectation != nil && afterPatchImportProfileByDTOCounter < 1 {
+		if m.PatchImportProfileByDTOMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportProfileUsecaseMock.PatchImportProfileByDTO at\n%s", m.PatchImportProfileByDTOMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportProfileUsecaseMock.PatchImportProfileByDTO at\n%s with params: %#v", m.PatchImportProfileByDTOMock.defaultExpectation.expectationOrigins.origin, *m.PatchImportProfileByDTOMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPatchImportProfileByDTO != nil && afterPatchImportProfileByDTOCounter < 1 {
+		m.t.Errorf("Expected call to ImportProfileUsecaseMock.PatchImportProfileByDTO at\n%s", m.funcPatchImportProfileByDTOOrigin)
+	}
+
+	if !m.PatchImportProfileByDTOMock.invocationsDone() && afterPatchImportProfileByDTOCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportProfileUsecaseMock.PatchImportProfileByDTO at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PatchImportProfileByDTOMock.expectedInvocations), m.PatchImportProfileByDTOMock.expectedInvocationsOrigin, afterPatchImportProfileByDTOCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ImportProfileUsecaseMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateImportProfileByDTOInspect()
+
+			m.MinimockDeleteImportProfileByIDInspect()
+
+			m.MinimockFindListInspect()
+
+			m.MinimockFindOneByIDInspect()
+
+			m.MinimockPatchImportProfileByDTOInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ImportProfileUsecaseMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ImportProfileUsecaseMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateImportProfileByDTODone() &&
+		m.MinimockDeleteImportProfileByIDDone() &&
+		m.MinimockFindListDone() &&
+		m.MinimockFindOneByIDDone() &&
+		m.MinimockPatchImportProfileByDTODone()
+}
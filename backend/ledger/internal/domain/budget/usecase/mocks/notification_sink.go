@@ -0,0 +1,615 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.NotificationSink -o notification_sink.go -n NotificationSinkMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+)
+
+// NotificationSinkMock implements mm_usecase.NotificationSink
+type NotificationSinkMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcName          func() (s1 string)
+	funcNameOrigin    string
+	inspectFuncName   func()
+	afterNameCounter  uint64
+	beforeNameCounter uint64
+	NameMock          mNotificationSinkMockName
+
+	funcSend          func(ctx context.Context, event *entity.OutboxEvent) (err error)
+	funcSendOrigin    string
+	inspectFuncSend   func(ctx context.Context, event *entity.OutboxEvent)
+	afterSendCounter  uint64
+	beforeSendCounter uint64
+	SendMock          mNotificationSinkMockSend
+}
+
+// NewNotificationSinkMock returns a mock for mm_usecase.NotificationSink
+func NewNotificationSinkMock(t minimock.Tester) *NotificationSinkMock {
+	m := &NotificationSinkMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.NameMock = mNotificationSinkMockName{mock: m}
+
+	m.SendMock = mNotificationSinkMockSend{mock: m}
+	m.SendMock.callArgs = []*NotificationSinkMockSendParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mNotificationSinkMockName struct {
+	optional           bool
+	mock               *NotificationSinkMock
+	defaultExpectation *NotificationSinkMockNameExpectation
+	expectations       []*NotificationSinkMockNameExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// NotificationSinkMockNameExpectation specifies expectation struct of the NotificationSink.Name
+type NotificationSinkMockNameExpectation struct {
+	mock *NotificationSinkMock
+
+	results      *NotificationSinkMockNameResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// NotificationSinkMockNameResults contains results of the NotificationSink.Name
+type NotificationSinkMockNameResults struct {
+	s1 string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmName *mNotificationSinkMockName) Optional() *mNotificationSinkMockName {
+	mmName.optional = true
+	return mmName
+}
+
+// Expect sets up expected params for NotificationSink.Name
+func (mmName *mNotificationSinkMockName) Expect() *mNotificationSinkMockName {
+	if mmName.mock.funcName != nil {
+		mmName.mock.t.Fatalf("NotificationSinkMock.Name mock is already set by Set")
+	}
+
+	if mmName.defaultExpectation == nil {
+		mmName.defaultExpectation = &NotificationSinkMockNameExpectation{}
+	}
+
+	return mmName
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationSink.Name
+func (mmName *mNotificationSinkMockName) Inspect(f func()) *mNotificationSinkMockName {
+	if mmName.mock.inspectFuncName != nil {
+		mmName.mock.t.Fatalf("Inspect function is already set for NotificationSinkMock.Name")
+	}
+
+	mmName.mock.inspectFuncName = f
+
+	return mmName
+}
+
+// Return sets up results that will be returned by NotificationSink.Name
+func (mmName *mNotificationSinkMockName) Return(s1 string) *NotificationSinkMock {
+	if mmName.mock.funcName != nil {
+		mmName.mock.t.Fatalf("NotificationSinkMock.Name mock is already set by Set")
+	}
+
+	if mmName.defaultExpectation == nil {
+		mmName.defaultExpectation = &NotificationSinkMockNameExpectation{mock: mmName.mock}
+	}
+	mmName.defaultExpectation.results = &NotificationSinkMockNameResults{s1}
+	mmName.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmName.mock
+}
+
+// Set uses given function f to mock the NotificationSink.Name method
+func (mmName *mNotificationSinkMockName) Set(f func() (s1 string)) *NotificationSinkMock {
+	if mmName.defaultExpectation != nil {
+		mmName.mock.t.Fatalf("Default expectation is already set for the NotificationSink.Name method")
+	}
+
+	if len(mmName.expectations) > 0 {
+		mmName.mock.t.Fatalf("Some expectations are already set for the NotificationSink.Name method")
+	}
+
+	mmName.mock.funcName = f
+	mmName.mock.funcNameOrigin = minimock.CallerInfo(1)
+	return mmName.mock
+}
+
+// Times sets number of times NotificationSink.Name should be invoked
+func (mmName *mNotificationSinkMockName) Times(n uint64) *mNotificationSinkMockName {
+	if n == 0 {
+		mmName.mock.t.Fatalf("Times of NotificationSinkMock.Name mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmName.expectedInvocations, n)
+	mmName.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmName
+}
+
+func (mmName *mNotificationSinkMockName) invocationsDone() bool {
+	if len(mmName.expectations) == 0 && mmName.defaultExpectation == nil && mmName.mock.funcName == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmName.mock.afterNameCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmName.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Name implements mm_usecase.NotificationSink
+func (mmName *NotificationSinkMock) Name() (s1 string) {
+	mm_atomic.AddUint64(&mmName.beforeNameCounter, 1)
+	defer mm_atomic.AddUint64(&mmName.afterNameCounter, 1)
+
+	mmName.t.Helper()
+
+	if mmName.inspectFuncName != nil {
+		mmName.inspectFuncName()
+	}
+
+	if mmName.NameMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmName.NameMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmName.NameMock.defaultExpectation.results
+		if mm_results == nil {
+			mmName.t.Fatal("No results are set for the NotificationSinkMock.Name")
+		}
+		return (*mm_results).s1
+	}
+	if mmName.funcName != nil {
+		return mmName.funcName()
+	}
+	mmName.t.Fatalf("Unexpected call to NotificationSinkMock.Name.")
+	return
+}
+
+// NameAfterCounter returns a count of finished NotificationSinkMock.Name invocations
+func (mmName *NotificationSinkMock) NameAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmName.afterNameCounter)
+}
+
+// NameBeforeCounter returns a count of NotificationSinkMock.Name invocations
+func (mmName *NotificationSinkMock) NameBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmName.beforeNameCounter)
+}
+
+// MinimockNameDone returns true if the count of the Name invocations corresponds
+// the number of defined expectations
+func (m *NotificationSinkMock) MinimockNameDone() bool {
+	if m.NameMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.NameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.NameMock.invocationsDone()
+}
+
+// MinimockNameInspect logs each unmet expectation
+func (m *NotificationSinkMock) MinimockNameInspect() {
+	for _, e := range m.NameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to NotificationSinkMock.Name")
+		}
+	}
+
+	afterNameCounter := mm_atomic.LoadUint64(&m.afterNameCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.NameMock.defaultExpectation != nil && afterNameCounter < 1 {
+		m.t.Errorf("Expected call to NotificationSinkMock.Name at\n%s", m.NameMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcName != nil && afterNameCounter < 1 {
+		m.t.Errorf("Expected call to NotificationSinkMock.Name at\n%s", m.funcNameOrigin)
+	}
+
+	if !m.NameMock.invocationsDone() && afterNameCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationSinkMock.Name at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.NameMock.expectedInvocations), m.NameMock.expectedInvocationsOrigin, afterNameCounter)
+	}
+}
+
+type mNotificationSinkMockSend struct {
+	optional           bool
+	mock               *NotificationSinkMock
+	defaultExpectation *NotificationSinkMockSendExpectation
+	expectations       []*NotificationSinkMockSendExpectation
+
+	callArgs []*NotificationSinkMockSendParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// NotificationSinkMockSendExpectation specifies expectation struct of the NotificationSink.Send
+type NotificationSinkMockSendExpectation struct {
+	mock               *NotificationSinkMock
+	params             *NotificationSinkMockSendParams
+	paramPtrs          *NotificationSinkMockSendParamPtrs
+	expectationOrigins NotificationSinkMockSendExpectationOrigins
+	results            *NotificationSinkMockSendResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// NotificationSinkMockSendParams contains parameters of the NotificationSink.Send
+type NotificationSinkMockSendParams struct {
+	ctx   context.Context
+	event *entity.OutboxEvent
+}
+
+// NotificationSinkMockSendParamPtrs contains pointers to parameters of the NotificationSink.Send
+type NotificationSinkMockSendParamPtrs struct {
+	ctx   *context.Context
+	event **entity.OutboxEvent
+}
+
+// NotificationSinkMockSendResults contains results of the NotificationSink.Send
+type NotificationSinkMockSendResults struct {
+	err error
+}
+
+// NotificationSinkMockSendOrigins contains origins of expectations of the NotificationSink.Send
+type NotificationSinkMockSendExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEvent string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSend *mNotificationSinkMockSend) Optional() *mNotificationSinkMockSend {
+	mmSend.optional = true
+	return mmSend
+}
+
+// Expect sets up expected params for NotificationSink.Send
+func (mmSend *mNotificationSinkMockSend) Expect(ctx context.Context, event *entity.OutboxEvent) *mNotificationSinkMockSend {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("NotificationSinkMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &NotificationSinkMockSendExpectation{}
+	}
+
+	if mmSend.defaultExpectation.paramPtrs != nil {
+		mmSend.mock.t.Fatalf("NotificationSinkMock.Send mock is already set by ExpectParams functions")
+	}
+
+	mmSend.defaultExpectation.params = &NotificationSinkMockSendParams{ctx, event}
+	mmSend.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSend.expectations {
+		if minimock.Equal(e.params, mmSend.defaultExpectation.params) {
+			mmSend.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSend.defaultExpectation.params)
+		}
+	}
+
+	return mmSend
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationSink.Send
+func (mmSend *mNotificationSinkMockSend) ExpectCtxParam1(ctx context.Context) *mNotificationSinkMockSend {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("NotificationSinkMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &NotificationSinkMockSendExpectation{}
+	}
+
+	if mmSend.defaultExpectation.params != nil {
+		mmSend.mock.t.Fatalf("NotificationSinkMock.Send mock is already set by Expect")
+	}
+
+	if mmSend.defaultExpectation.paramPtrs == nil {
+		mmSend.defaultExpectation.paramPtrs = &NotificationSinkMockSendParamPtrs{}
+	}
+	mmSend.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSend.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSend
+}
+
+// ExpectEventParam2 sets up expected param event for NotificationSink.Send
+func (mmSend *mNotificationSinkMockSend) ExpectEventParam2(event *entity.OutboxEvent) *mNotificationSinkMockSend {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("NotificationSinkMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &NotificationSinkMockSendExpectation{}
+	}
+
+	if mmSend.defaultExpectation.params != nil {
+		mmSend.mock.t.Fatalf("NotificationSinkMock.Send mock is already set by Expect")
+	}
+
+	if mmSend.defaultExpectation.paramPtrs == nil {
+		mmSend.defaultExpectation.paramPtrs = &NotificationSinkMockSendParamPtrs{}
+	}
+	mmSend.defaultExpectation.paramPtrs.event = &event
+	mmSend.defaultExpectation.expectationOrigins.originEvent = minimock.CallerInfo(1)
+
+	return mmSend
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationSink.Send
+func (mmSend *mNotificationSinkMockSend) Inspect(f func(ctx context.Context, event *entity.OutboxEvent)) *mNotificationSinkMockSend {
+	if mmSend.mock.inspectFuncSend != nil {
+		mmSend.mock.t.Fatalf("Inspect function is already set for NotificationSinkMock.Send")
+	}
+
+	mmSend.mock.inspectFuncSend = f
+
+	return mmSend
+}
+
+// Return sets up results that will be returned by NotificationSink.Send
+func (mmSend *mNotificationSinkMockSend) Return(err error) *NotificationSinkMock {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("NotificationSinkMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &NotificationSinkMockSendExpectation{mock: mmSend.mock}
+	}
+	mmSend.defaultExpectation.results = &NotificationSinkMockSendResults{err}
+	mmSend.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSend.mock
+}
+
+// Set uses given function f to mock the NotificationSink.Send method
+func (mmSend *mNotificationSinkMockSend) Set(f func(ctx context.Context, event *entity.OutboxEvent) (err error)) *NotificationSinkMock {
+	if mmSend.defaultExpectation != nil {
+		mmSend.mock.t.Fatalf("Default expectation is already set for the NotificationSink.Send method")
+	}
+
+	if len(mmSend.expectations) > 0 {
+		mmSend.mock.t.Fatalf("Some expectations are already set for the NotificationSink.Send method")
+	}
+
+	mmSend.mock.funcSend = f
+	mmSend.mock.funcSendOrigin = minimock.CallerInfo(1)
+	return mmSend.mock
+}
+
+// When sets expectation for the NotificationSink.Send which will trigger the result defined by the following
+// Then helper
+func (mmSend *mNotificationSinkMockSend) When(ctx context.Context, event *entity.OutboxEvent) *NotificationSinkMockSendExpectation {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("NotificationSinkMock.Send mock is already set by Set")
+	}
+
+	expectation := &NotificationSinkMockSendExpectation{
+		mock:               mmSend.mock,
+		params:             &NotificationSinkMockSendParams{ctx, event},
+		expectationOrigins: NotificationSinkMockSendExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSend.expectations = append(mmSend.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationSink.Send return parameters for the expectation previously defined by the When method
+func (e *NotificationSinkMockSendExpectation) Then(err error) *NotificationSinkMock {
+	e.results = &NotificationSinkMockSendResults{err}
+	return e.mock
+}
+
+// Times sets number of times NotificationSink.Send should be invoked
+func (mmSend *mNotificationSinkMockSend) Times(n uint64) *mNotificationSinkMockSend {
+	if n == 0 {
+		mmSend.mock.t.Fatalf("Times of NotificationSinkMock.Send mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSend.expectedInvocations, n)
+	mmSend.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSend
+}
+
+func (mmSend *mNotificationSinkMockSend) invocationsDone() bool {
+	if len(mmSend.expectations) == 0 && mmSend.defaultExpectation == nil && mmSend.mock.funcSend == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSend.mock.afterSendCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSend.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Send implements mm_usecase.NotificationSink
+func (mmSend *NotificationSinkMock) Send(ctx context.Context, event *entity.OutboxEvent) (err error) {
+	mm_atomic.AddUint64(&mmSend.beforeSendCounter, 1)
+	defer mm_atomic.AddUint64(&mmSend.afterSendCounter, 1)
+
+	mmSend.t.Helper()
+
+	if mmSend.inspectFuncSend != nil {
+		mmSend.inspectFuncSend(ctx, event)
+	}
+
+	mm_params := NotificationSinkMockSendParams{ctx, event}
+
+	// Record call args
+	mmSend.SendMock.mutex.Lock()
+	mmSend.SendMock.callArgs = append(mmSend.SendMock.callArgs, &mm_params)
+	mmSend.SendMock.mutex.Unlock()
+
+	for _, e := range mmSend.SendMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSend.SendMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSend.SendMock.defaultExpectation.Counter, 1)
+		mm_want := mmSend.SendMock.defaultExpectation.params
+		mm_want_ptrs := mmSend.SendMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationSinkMockSendParams{ctx, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSend.t.Errorf("NotificationSinkMock.Send got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSend.SendMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmSend.t.Errorf("NotificationSinkMock.Send got unexpected parameter event, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSend.SendMock.defaultExpectation.expectationOrigins.originEvent, *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSend.t.Errorf("NotificationSinkMock.Send got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSend.SendMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSend.SendMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSend.t.Fatal("No results are set for the NotificationSinkMock.Send")
+		}
+		return (*mm_results).err
+	}
+	if mmSend.funcSend != nil {
+		return mmSend.funcSend(ctx, event)
+	}
+	mmSend.t.Fatalf("Unexpected call to NotificationSinkMock.Send. %v %v", ctx, event)
+	return
+}
+
+// SendAfterCounter returns a count of finished NotificationSinkMock.Send invocations
+func (mmSend *NotificationSinkMock) SendAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSend.afterSendCounter)
+}
+
+// SendBeforeCounter returns a count of NotificationSinkMock.Send invocations
+func (mmSend *NotificationSinkMock) SendBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSend.beforeSendCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationSinkMock.Send.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSend *mNotificationSinkMockSend) Calls() []*NotificationSinkMockSendParams {
+	mmSend.mutex.RLock()
+
+	argCopy := make([]*NotificationSinkMockSendParams, len(mmSend.callArgs))
+	copy(argCopy, mmSend.callArgs)
+
+	mmSend.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendDone returns true if the count of the Send invocations corresponds
+// the number of defined expectations
+func (m *NotificationSinkMock) MinimockSendDone() bool {
+	if m.SendMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendMock.invocationsDone()
+}
+
+// MinimockSendInspect logs each unmet expectation
+func (m *NotificationSinkMock) MinimockSendInspect() {
+	for _, e := range m.SendMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationSinkMock.Send at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendCounter := mm_atomic.LoadUint64(&m.afterSendCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendMock.defaultExpectation != nil && afterSendCounter < 1 {
+		if m.SendMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to NotificationSinkMock.Send at\n%s", m.SendMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to NotificationSinkMock.Send at\n%s with params: %#v", m.SendMock.defaultExpectation.expectationOrigins.origin, *m.SendMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSend != nil && afterSendCounter < 1 {
+		m.t.Errorf("Expected call to NotificationSinkMock.Send at\n%s", m.funcSendOrigin)
+	}
+
+	if !m.SendMock.invocationsDone() && afterSendCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationSinkMock.Send at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendMock.expectedInvocations), m.SendMock.expectedInvocationsOrigin, afterSendCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *NotificationSinkMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockNameInspect()
+
+			m.MinimockSendInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *NotificationSinkMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *NotificationSinkMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockNameDone() &&
+		m.MinimockSendDone()
+}
@@ -0,0 +1,1161 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.OutboxEventRepository -o outbox_event_repository.go -n OutboxEventRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	mm_usecase "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// OutboxEventRepositoryMock implements mm_usecase.OutboxEventRepository
+type OutboxEventRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, item *entity.OutboxEvent) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, item *entity.OutboxEvent)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mOutboxEventRepositoryMockCreate
+
+	funcFindList          func(ctx context.Context, listOptions *mm_usecase.OutboxEventListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.OutboxEvent, err error)
+	funcFindListOrigin    string
+	inspectFuncFindList   func(ctx context.Context, listOptions *mm_usecase.OutboxEventListOptions, queryParams *uctypes.QueryGetListParams)
+	afterFindListCounter  uint64
+	beforeFindListCounter uint64
+	FindListMock          mOutboxEventRepositoryMockFindList
+
+	funcUpdate          func(ctx context.Context, item *entity.OutboxEvent) (err error)
+	funcUpdateOrigin    string
+	inspectFuncUpdate   func(ctx context.Context, item *entity.OutboxEvent)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mOutboxEventRepositoryMockUpdate
+}
+
+// NewOutboxEventRepositoryMock returns a mock for mm_usecase.OutboxEventRepository
+func NewOutboxEventRepositoryMock(t minimock.Tester) *OutboxEventRepositoryMock {
+	m := &OutboxEventRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mOutboxEventRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*OutboxEventRepositoryMockCreateParams{}
+
+	m.FindListMock = mOutboxEventRepositoryMockFindList{mock: m}
+	m.FindListMock.callArgs = []*OutboxEventRepositoryMockFindListParams{}
+
+	m.UpdateMock = mOutboxEventRepositoryMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*OutboxEventRepositoryMockUpdateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOutboxEventRepositoryMockCreate struct {
+	optional           bool
+	mock               *OutboxEventRepositoryMock
+	defaultExpectation *OutboxEventRepositoryMockCreateExpectation
+	expectations       []*OutboxEventRepositoryMockCreateExpectation
+
+	callArgs []*OutboxEventRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxEventRepositoryMockCreateExpectation specifies expectation struct of the OutboxEventRepository.Create
+type OutboxEventRepositoryMockCreateExpectation struct {
+	mock               *OutboxEventRepositoryMock
+	params             *OutboxEventRepositoryMockCreateParams
+	paramPtrs          *OutboxEventRepositoryMockCreateParamPtrs
+	expectationOrigins OutboxEventRepositoryMockCreateExpectationOrigins
+	results            *OutboxEventRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxEventRepositoryMockCreateParams contains parameters of the OutboxEventRepository.Create
+type OutboxEventRepositoryMockCreateParams struct {
+	ctx  context.Context
+	item *entity.OutboxEvent
+}
+
+// OutboxEventRepositoryMockCreateParamPtrs contains pointers to parameters of the OutboxEventRepository.Create
+type OutboxEventRepositoryMockCreateParamPtrs struct {
+	ctx  *context.Context
+	item **entity.OutboxEvent
+}
+
+// OutboxEventRepositoryMockCreateResults contains results of the OutboxEventRepository.Create
+type OutboxEventRepositoryMockCreateResults struct {
+	err error
+}
+
+// OutboxEventRepositoryMockCreateOrigins contains origins of expectations of the OutboxEventRepository.Create
+type OutboxEventRepositoryMockCreateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originItem string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mOutboxEventRepositoryMockCreate) Optional() *mOutboxEventRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for OutboxEventRepository.Create
+func (mmCreate *mOutboxEventRepositoryMockCreate) Expect(ctx context.Context, item *entity.OutboxEvent) *mOutboxEventRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OutboxEventRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &OutboxEventRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("OutboxEventRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &OutboxEventRepositoryMockCreateParams{ctx, item}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxEventRepository.Create
+func (mmCreate *mOutboxEventRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mOutboxEventRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OutboxEventRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &OutboxEventRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("OutboxEventRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &OutboxEventRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectItemParam2 sets up expected param item for OutboxEventRepository.Create
+func (mmCreate *mOutboxEventRepositoryMockCreate) ExpectItemParam2(item *entity.OutboxEvent) *mOutboxEventRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OutboxEventRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &OutboxEventRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("OutboxEventRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &OutboxEventRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.item = &item
+	mmCreate.defaultExpectation.expectationOrigins.originItem = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxEventRepository.Create
+func (mmCreate *mOutboxEventRepositoryMockCreate) Inspect(f func(ctx context.Context, item *entity.OutboxEvent)) *mOutboxEventRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for OutboxEventRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by OutboxEventRepository.Create
+func (mmCreate *mOutboxEventRepositoryMockCreate) Return(err error) *OutboxEventRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OutboxEventRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &OutboxEventRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &OutboxEventRepositoryMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the OutboxEventRepository.Create method
+func (mmCreate *mOutboxEventRepositoryMockCreate) Set(f func(ctx context.Context, item *entity.OutboxEvent) (err error)) *OutboxEventRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the OutboxEventRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the OutboxEventRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the OutboxEventRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mOutboxEventRepositoryMockCreate) When(ctx context.Context, item *entity.OutboxEvent) *OutboxEventRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OutboxEventRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &OutboxEventRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &OutboxEventRepositoryMockCreateParams{ctx, item},
+		expectationOrigins: OutboxEventRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxEventRepository.Create return parameters for the expectation previously defined by the When method
+func (e *OutboxEventRepositoryMockCreateExpectation) Then(err error) *OutboxEventRepositoryMock {
+	e.results = &OutboxEventRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxEventRepository.Create should be invoked
+func (mmCreate *mOutboxEventRepositoryMockCreate) Times(n uint64) *mOutboxEventRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of OutboxEventRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mOutboxEventRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_usecase.OutboxEventRepository
+func (mmCreate *OutboxEventRepositoryMock) Create(ctx context.Context, item *entity.OutboxEvent) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, item)
+	}
+
+	mm_params := OutboxEventRepositoryMockCreateParams{ctx, item}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxEventRepositoryMockCreateParams{ctx, item}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("OutboxEventRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
+				mmCreate.t.Errorf("OutboxEventRepositoryMock.Create got unexpected parameter item, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originItem, *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("OutboxEventRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the OutboxEventRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, item)
+	}
+	mmCreate.t.Fatalf("Unexpected call to OutboxEventRepositoryMock.Create. %v %v", ctx, item)
+	return
+}
+
+// CreateAfterCounter returns a count of finished OutboxEventRepositoryMock.Create invocations
+func (mmCreate *OutboxEventRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of OutboxEventRepositoryMock.Create invocations
+func (mmCreate *OutboxEventRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxEventRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mOutboxEventRepositoryMockCreate) Calls() []*OutboxEventRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*OutboxEventRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *OutboxEventRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *OutboxEventRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxEventRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxEventRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxEventRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to OutboxEventRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxEventRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mOutboxEventRepositoryMockFindList struct {
+	optional           bool
+	mock               *OutboxEventRepositoryMock
+	defaultExpectation *OutboxEventRepositoryMockFindListExpectation
+	expectations       []*OutboxEventRepositoryMockFindListExpectation
+
+	callArgs []*OutboxEventRepositoryMockFindListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxEventRepositoryMockFindListExpectation specifies expectation struct of the OutboxEventRepository.FindList
+type OutboxEventRepositoryMockFindListExpectation struct {
+	mock               *OutboxEventRepositoryMock
+	params             *OutboxEventRepositoryMockFindListParams
+	paramPtrs          *OutboxEventRepositoryMockFindListParamPtrs
+	expectationOrigins OutboxEventRepositoryMockFindListExpectationOrigins
+	results            *OutboxEventRepositoryMockFindListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxEventRepositoryMockFindListParams contains parameters of the OutboxEventRepository.FindList
+type OutboxEventRepositoryMockFindListParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.OutboxEventListOptions
+	queryParams *uctypes.QueryGetListParams
+}
+
+// OutboxEventRepositoryMockFindListParamPtrs contains pointers to parameters of the OutboxEventRepository.FindList
+type OutboxEventRepositoryMockFindListParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.OutboxEventListOptions
+	queryParams **uctypes.QueryGetListParams
+}
+
+// OutboxEventRepositoryMockFindListResults contains results of the OutboxEventRepository.FindList
+type OutboxEventRepositoryMockFindListResults struct {
+	items []*entity.OutboxEvent
+	err   error
+}
+
+// OutboxEventRepositoryMockFindListOrigins contains origins of expectations of the OutboxEventRepository.FindList
+type OutboxEventRepositoryMockFindListExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindList *mOutboxEventRepositoryMockFindList) Optional() *mOutboxEventRepositoryMockFindList {
+	mmFindList.optional = true
+	return mmFindList
+}
+
+// Expect sets up expected params for OutboxEventRepository.FindList
+func (mmFindList *mOutboxEventRepositoryMockFindList) Expect(ctx context.Context, listOptions *mm_usecase.OutboxEventListOptions, queryParams *uctypes.QueryGetListParams) *mOutboxEventRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("OutboxEventRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &OutboxEventRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs != nil {
+		mmFindList.mock.t.Fatalf("OutboxEventRepositoryMock.FindList mock is already set by ExpectParams functions")
+	}
+
+	mmFindList.defaultExpectation.params = &OutboxEventRepositoryMockFindListParams{ctx, listOptions, queryParams}
+	mmFindList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindList.expectations {
+		if minimock.Equal(e.params, mmFindList.defaultExpectation.params) {
+			mmFindList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindList.defaultExpectation.params)
+		}
+	}
+
+	return mmFindList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxEventRepository.FindList
+func (mmFindList *mOutboxEventRepositoryMockFindList) ExpectCtxParam1(ctx context.Context) *mOutboxEventRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("OutboxEventRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &OutboxEventRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("OutboxEventRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &OutboxEventRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for OutboxEventRepository.FindList
+func (mmFindList *mOutboxEventRepositoryMockFindList) ExpectListOptionsParam2(listOptions *mm_usecase.OutboxEventListOptions) *mOutboxEventRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("OutboxEventRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &OutboxEventRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("OutboxEventRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &OutboxEventRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmFindList.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for OutboxEventRepository.FindList
+func (mmFindList *mOutboxEventRepositoryMockFindList) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mOutboxEventRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("OutboxEventRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &OutboxEventRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("OutboxEventRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &OutboxEventRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindList.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxEventRepository.FindList
+func (mmFindList *mOutboxEventRepositoryMockFindList) Inspect(f func(ctx context.Context, listOptions *mm_usecase.OutboxEventListOptions, queryParams *uctypes.QueryGetListParams)) *mOutboxEventRepositoryMockFindList {
+	if mmFindList.mock.inspectFuncFindList != nil {
+		mmFindList.mock.t.Fatalf("Inspect function is already set for OutboxEventRepositoryMock.FindList")
+	}
+
+	mmFindList.mock.inspectFuncFindList = f
+
+	return mmFindList
+}
+
+// Return sets up results that will be returned by OutboxEventRepository.FindList
+func (mmFindList *mOutboxEventRepositoryMockFindList) Return(items []*entity.OutboxEvent, err error) *OutboxEventRepositoryMock {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("OutboxEventRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &OutboxEventRepositoryMockFindListExpectation{mock: mmFindList.mock}
+	}
+	mmFindList.defaultExpectation.results = &OutboxEventRepositoryMockFindListResults{items, err}
+	mmFindList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// Set uses given function f to mock the OutboxEventRepository.FindList method
+func (mmFindList *mOutboxEventRepositoryMockFindList) Set(f func(ctx context.Context, listOptions *mm_usecase.OutboxEventListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.OutboxEvent, err error)) *OutboxEventRepositoryMock {
+	if mmFindList.defaultExpectation != nil {
+		mmFindList.mock.t.Fatalf("Default expectation is already set for the OutboxEventRepository.FindList method")
+	}
+
+	if len(mmFindList.expectations) > 0 {
+		mmFindList.mock.t.Fatalf("Some expectations are already set for the OutboxEventRepository.FindList method")
+	}
+
+	mmFindList.mock.funcFindList = f
+	mmFindList.mock.funcFindListOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// When sets expectation for the OutboxEventRepository.FindList which will trigger the result defined by the following
+// Then helper
+func (mmFindList *mOutboxEventRepositoryMockFindList) When(ctx context.Context, listOptions *mm_usecase.OutboxEventListOptions, queryParams *uctypes.QueryGetListParams) *OutboxEventRepositoryMockFindListExpectation {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("OutboxEventRepositoryMock.FindList mock is already set by Set")
+	}
+
+	expectation := &OutboxEventRepositoryMockFindListExpectation{
+		mock:               mmFindList.mock,
+		params:             &OutboxEventRepositoryMockFindListParams{ctx, listOptions, queryParams},
+		expectationOrigins: OutboxEventRepositoryMockFindListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindList.expectations = append(mmFindList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxEventRepository.FindList return parameters for the expectation previously defined by the When method
+func (e *OutboxEventRepositoryMockFindListExpectation) Then(items []*entity.OutboxEvent, err error) *OutboxEventRepositoryMock {
+	e.results = &OutboxEventRepositoryMockFindListResults{items, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxEventRepository.FindList should be invoked
+func (mmFindList *mOutboxEventRepositoryMockFindList) Times(n uint64) *mOutboxEventRepositoryMockFindList {
+	if n == 0 {
+		mmFindList.mock.t.Fatalf("Times of OutboxEventRepositoryMock.FindList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindList.expectedInvocations, n)
+	mmFindList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindList
+}
+
+func (mmFindList *mOutboxEventRepositoryMockFindList) invocationsDone() bool {
+	if len(mmFindList.expectations) == 0 && mmFindList.defaultExpectation == nil && mmFindList.mock.funcFindList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindList.mock.afterFindListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindList implements mm_usecase.OutboxEventRepository
+func (mmFindList *OutboxEventRepositoryMock) FindList(ctx context.Context, listOptions *mm_usecase.OutboxEventListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.OutboxEvent, err error) {
+	mm_atomic.AddUint64(&mmFindList.beforeFindListCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindList.afterFindListCounter, 1)
+
+	mmFindList.t.Helper()
+
+	if mmFindList.inspectFuncFindList != nil {
+		mmFindList.inspectFuncFindList(ctx, listOptions, queryParams)
+	}
+
+	mm_params := OutboxEventRepositoryMockFindListParams{ctx, listOptions, queryParams}
+
+	// Record call args
+	mmFindList.FindListMock.mutex.Lock()
+	mmFindList.FindListMock.callArgs = append(mmFindList.FindListMock.callArgs, &mm_params)
+	mmFindList.FindListMock.mutex.Unlock()
+
+	for _, e := range mmFindList.FindListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.items, e.results.err
+		}
+	}
+
+	if mmFindList.FindListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindList.FindListMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindList.FindListMock.defaultExpectation.params
+		mm_want_ptrs := mmFindList.FindListMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxEventRepositoryMockFindListParams{ctx, listOptions, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindList.t.Errorf("OutboxEventRepositoryMock.FindList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmFindList.t.Errorf("OutboxEventRepositoryMock.FindList got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindList.t.Errorf("OutboxEventRepositoryMock.FindList got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindList.t.Errorf("OutboxEventRepositoryMock.FindList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindList.FindListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindList.FindListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindList.t.Fatal("No results are set for the OutboxEventRepositoryMock.FindList")
+		}
+		return (*mm_results).items, (*mm_results).err
+	}
+	if mmFindList.funcFindList != nil {
+		return mmFindList.funcFindList(ctx, listOptions, queryParams)
+	}
+	mmFindList.t.Fatalf("Unexpected call to OutboxEventRepositoryMock.FindList. %v %v %v", ctx, listOptions, queryParams)
+	return
+}
+
+// FindListAfterCounter returns a count of finished OutboxEventRepositoryMock.FindList invocations
+func (mmFindList *OutboxEventRepositoryMock) FindListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.afterFindListCounter)
+}
+
+// FindListBeforeCounter returns a count of OutboxEventRepositoryMock.FindList invocations
+func (mmFindList *OutboxEventRepositoryMock) FindListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.beforeFindListCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxEventRepositoryMock.FindList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindList *mOutboxEventRepositoryMockFindList) Calls() []*OutboxEventRepositoryMockFindListParams {
+	mmFindList.mutex.RLock()
+
+	argCopy := make([]*OutboxEventRepositoryMockFindListParams, len(mmFindList.callArgs))
+	copy(argCopy, mmFindList.callArgs)
+
+	mmFindList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindListDone returns true if the count of the FindList invocations corresponds
+// the number of defined expectations
+func (m *OutboxEventRepositoryMock) MinimockFindListDone() bool {
+	if m.FindListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindListMock.invocationsDone()
+}
+
+// MinimockFindListInspect logs each unmet expectation
+func (m *OutboxEventRepositoryMock) MinimockFindListInspect() {
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxEventRepositoryMock.FindList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindListCounter := mm_atomic.LoadUint64(&m.afterFindListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindListMock.defaultExpectation != nil && afterFindListCounter < 1 {
+		if m.FindListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxEventRepositoryMock.FindList at\n%s", m.FindListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxEventRepositoryMock.FindList at\n%s with params: %#v", m.FindListMock.defaultExpectation.expectationOrigins.origin, *m.FindListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindList != nil && afterFindListCounter < 1 {
+		m.t.Errorf("Expected call to OutboxEventRepositoryMock.FindList at\n%s", m.funcFindListOrigin)
+	}
+
+	if !m.FindListMock.invocationsDone() && afterFindListCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxEventRepositoryMock.FindList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindListMock.expectedInvocations), m.FindListMock.expectedInvocationsOrigin, afterFindListCounter)
+	}
+}
+
+type mOutboxEventRepositoryMockUpdate struct {
+	optional           bool
+	mock               *OutboxEventRepositoryMock
+	defaultExpectation *OutboxEventRepositoryMockUpdateExpectation
+	expectations       []*OutboxEventRepositoryMockUpdateExpectation
+
+	callArgs []*OutboxEventRepositoryMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxEventRepositoryMockUpdateExpectation specifies expectation struct of the OutboxEventRepository.Update
+type OutboxEventRepositoryMockUpdateExpectation struct {
+	mock               *OutboxEventRepositoryMock
+	params             *OutboxEventRepositoryMockUpdateParams
+	paramPtrs          *OutboxEventRepositoryMockUpdateParamPtrs
+	expectationOrigins OutboxEventRepositoryMockUpdateExpectationOrigins
+	results            *OutboxEventRepositoryMockUpdateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxEventRepositoryMockUpdateParams contains parameters of the OutboxEventRepository.Update
+type OutboxEventRepositoryMockUpdateParams struct {
+	ctx  context.Context
+	item *entity.OutboxEvent
+}
+
+// OutboxEventRepositoryMockUpdateParamPtrs contains pointers to parameters of the OutboxEventRepository.Update
+type OutboxEventRepositoryMockUpdateParamPtrs struct {
+	ctx  *context.Context
+	item **entity.OutboxEvent
+}
+
+// OutboxEventRepositoryMockUpdateResults contains results of the OutboxEventRepository.Update
+type OutboxEventRepositoryMockUpdateResults struct {
+	err error
+}
+
+// OutboxEventRepositoryMockUpdateOrigins contains origins of expectations of the OutboxEventRepository.Update
+type OutboxEventRepositoryMockUpdateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originItem string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mOutboxEventRepositoryMockUpdate) Optional() *mOutboxEventRepositoryMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for OutboxEventRepository.Update
+func (mmUpdate *mOutboxEventRepositoryMockUpdate) Expect(ctx context.Context, item *entity.OutboxEvent) *mOutboxEventRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("OutboxEventRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &OutboxEventRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("OutboxEventRepositoryMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &OutboxEventRepositoryMockUpdateParams{ctx, item}
+	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxEventRepository.Update
+func (mmUpdate *mOutboxEventRepositoryMockUpdate) ExpectCtxParam1(ctx context.Context) *mOutboxEventRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("OutboxEventRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &OutboxEventRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("OutboxEventRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &OutboxEventRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectItemParam2 sets up expected param item for OutboxEventRepository.Update
+func (mmUpdate *mOutboxEventRepositoryMockUpdate) ExpectItemParam2(item *entity.OutboxEvent) *mOutboxEventRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("OutboxEventRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &OutboxEventRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("OutboxEventRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &OutboxEventRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.item = &item
+	mmUpdate.defaultExpectation.expectationOrigins.originItem = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxEventRepository.Update
+func (mmUpdate *mOutboxEventRepositoryMockUpdate) Inspect(f func(ctx context.Context, item *entity.OutboxEvent)) *mOutboxEventRepositoryMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for OutboxEventRepositoryMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by OutboxEventRepository.Update
+func (mmUpdate *mOutboxEventRepositoryMockUpdate) Return(err error) *OutboxEventRepositoryMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("OutboxEventRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &OutboxEventRepositoryMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &OutboxEventRepositoryMockUpdateResults{err}
+	mmUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the OutboxEventRepository.Update method
+func (mmUpdate *mOutboxEventRepositoryMockUpdate) Set(f func(ctx context.Context, item *entity.OutboxEvent) (err error)) *OutboxEventRepositoryMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the OutboxEventRepository.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the OutboxEventRepository.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	mmUpdate.mock.funcUpdateOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// When sets expectation for the OutboxEventRepository.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mOutboxEventRepositoryMockUpdate) When(ctx context.Context, item *entity.OutboxEvent) *OutboxEventRepositoryMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("OutboxEventRepositoryMock.Update mock is already set by Set")
+	}
+
+	expectation := &OutboxEventRepositoryMockUpdateExpectation{
+		mock:               mmUpdate.mock,
+		params:             &OutboxEventRepositoryMockUpdateParams{ctx, item},
+		expectationOrigins: OutboxEventRepositoryMockUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxEventRepository.Update return parameters for the expectation previously defined by the When method
+func (e *OutboxEventRepositoryMockUpdateExpectation) Then(err error) *OutboxEventRepositoryMock {
+	e.results = &OutboxEventRepositoryMockUpdateResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxEventRepository.Update should be invoked
+func (mmUpdate *mOutboxEventRepositoryMockUpdate) Times(n uint64) *mOutboxEventRepositoryMockUpdate {
+	if n == 0 {
+		mmUpdate.mock.t.Fatalf("Times of OutboxEventRepositoryMock.Update mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	mmUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdate
+}
+
+func (mmUpdate *mOutboxEventRepositoryMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Update implements mm_usecase.OutboxEventRepository
+func (mmUpdate *OutboxEventRepositoryMock) Update(ctx context.Context, item *entity.OutboxEvent) (err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	mmUpdate.t.Helper()
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, item)
+	}
+
+	mm_params := OutboxEventRepositoryMockUpdateParams{ctx, item}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxEventRepositoryMockUpdateParams{ctx, item}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("OutboxEventRepositoryMock.Update got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
+				mmUpdate.t.Errorf("OutboxEventRepositoryMock.Update got unexpected parameter item, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originItem, *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("OutboxEventRepositoryMock.Update got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the OutboxEventRepositoryMock.Update")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, item)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to OutboxEventRepositoryMock.Update. %v %v", ctx, item)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished OutboxEventRepositoryMock.Update invocations
+func (mmUpdate *OutboxEventRepositoryMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of OutboxEventRepositoryMock.Update invocations
+func (mmUpdate *OutboxEventRepositoryMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxEventRepositoryMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mOutboxEventRepositoryMockUpdate) Calls() []*OutboxEventRepositoryMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*OutboxEventRepositoryMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *OutboxEventRepositoryMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMock.invocationsDone()
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *OutboxEventRepositoryMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxEventRepositoryMock.Update at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxEventRepositoryMock.Update at\n%s", m.UpdateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxEventRepositoryMock.Update at\n%s with params: %#v", m.UpdateMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Errorf("Expected call to OutboxEventRepositoryMock.Update at\n%s", m.funcUpdateOrigin)
+	}
+
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxEventRepositoryMock.Update at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), m.UpdateMock.expectedInvocationsOrigin, afterUpdateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OutboxEventRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockFindListInspect()
+
+			m.MinimockUpdateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OutboxEventRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OutboxEventRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockFindListDone() &&
+		m.MinimockUpdateDone()
+}
@@ -0,0 +1,417 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.OutboxUsecase -o outbox_usecase.go -n OutboxUsecaseMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// OutboxUsecaseMock implements mm_usecase.OutboxUsecase
+type OutboxUsecaseMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDispatchOutboxEvents          func(ctx context.Context, limit uint64) (processed int, err error)
+	funcDispatchOutboxEventsOrigin    string
+	inspectFuncDispatchOutboxEvents   func(ctx context.Context, limit uint64)
+	afterDispatchOutboxEventsCounter  uint64
+	beforeDispatchOutboxEventsCounter uint64
+	DispatchOutboxEventsMock          mOutboxUsecaseMockDispatchOutboxEvents
+}
+
+// NewOutboxUsecaseMock returns a mock for mm_usecase.OutboxUsecase
+func NewOutboxUsecaseMock(t minimock.Tester) *OutboxUsecaseMock {
+	m := &OutboxUsecaseMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DispatchOutboxEventsMock = mOutboxUsecaseMockDispatchOutboxEvents{mock: m}
+	m.DispatchOutboxEventsMock.callArgs = []*OutboxUsecaseMockDispatchOutboxEventsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOutboxUsecaseMockDispatchOutboxEvents struct {
+	optional           bool
+	mock               *OutboxUsecaseMock
+	defaultExpectation *OutboxUsecaseMockDispatchOutboxEventsExpectation
+	expectations       []*OutboxUsecaseMockDispatchOutboxEventsExpectation
+
+	callArgs []*OutboxUsecaseMockDispatchOutboxEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxUsecaseMockDispatchOutboxEventsExpectation specifies expectation struct of the OutboxUsecase.DispatchOutboxEvents
+type OutboxUsecaseMockDispatchOutboxEventsExpectation struct {
+	mock               *OutboxUsecaseMock
+	params             *OutboxUsecaseMockDispatchOutboxEventsParams
+	paramPtrs          *OutboxUsecaseMockDispatchOutboxEventsParamPtrs
+	expectationOrigins OutboxUsecaseMockDispatchOutboxEventsExpectationOrigins
+	results            *OutboxUsecaseMockDispatchOutboxEventsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxUsecaseMockDispatchOutboxEventsParams contains parameters of the OutboxUsecase.DispatchOutboxEvents
+type OutboxUsecaseMockDispatchOutboxEventsParams struct {
+	ctx   context.Context
+	limit uint64
+}
+
+// OutboxUsecaseMockDispatchOutboxEventsParamPtrs contains pointers to parameters of the OutboxUsecase.DispatchOutboxEvents
+type OutboxUsecaseMockDispatchOutboxEventsParamPtrs struct {
+	ctx   *context.Context
+	limit *uint64
+}
+
+// OutboxUsecaseMockDispatchOutboxEventsResults contains results of the OutboxUsecase.DispatchOutboxEvents
+type OutboxUsecaseMockDispatchOutboxEventsResults struct {
+	processed int
+	err       error
+}
+
+// OutboxUsecaseMockDispatchOutboxEventsOrigins contains origins of expectations of the OutboxUsecase.DispatchOutboxEvents
+type OutboxUsecaseMockDispatchOutboxEventsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDispatchOutboxEvents *mOutboxUsecaseMockDispatchOutboxEvents) Optional() *mOutboxUsecaseMockDispatchOutboxEvents {
+	mmDispatchOutboxEvents.optional = true
+	return mmDispatchOutboxEvents
+}
+
+// Expect sets up expected params for OutboxUsecase.DispatchOutboxEvents
+func (mmDispatchOutboxEvents *mOutboxUsecaseMockDispatchOutboxEvents) Expect(ctx context.Context, limit uint64) *mOutboxUsecaseMockDispatchOutboxEvents {
+	if mmDispatchOutboxEvents.mock.funcDispatchOutboxEvents != nil {
+		mmDispatchOutboxEvents.mock.t.Fatalf("OutboxUsecaseMock.DispatchOutboxEvents mock is already set by Set")
+	}
+
+	if mmDispatchOutboxEvents.defaultExpectation == nil {
+		mmDispatchOutboxEvents.defaultExpectation = &OutboxUsecaseMockDispatchOutboxEventsExpectation{}
+	}
+
+	if mmDispatchOutboxEvents.defaultExpectation.paramPtrs != nil {
+		mmDispatchOutboxEvents.mock.t.Fatalf("OutboxUsecaseMock.DispatchOutboxEvents mock is already set by ExpectParams functions")
+	}
+
+	mmDispatchOutboxEvents.defaultExpectation.params = &OutboxUsecaseMockDispatchOutboxEventsParams{ctx, limit}
+	mmDispatchOutboxEvents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDispatchOutboxEvents.expectations {
+		if minimock.Equal(e.params, mmDispatchOutboxEvents.defaultExpectation.params) {
+			mmDispatchOutboxEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDispatchOutboxEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmDispatchOutboxEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxUsecase.DispatchOutboxEvents
+func (mmDispatchOutboxEvents *mOutboxUsecaseMockDispatchOutboxEvents) ExpectCtxParam1(ctx context.Context) *mOutboxUsecaseMockDispatchOutboxEvents {
+	if mmDispatchOutboxEvents.mock.funcDispatchOutboxEvents != nil {
+		mmDispatchOutboxEvents.mock.t.Fatalf("OutboxUsecaseMock.DispatchOutboxEvents mock is already set by Set")
+	}
+
+	if mmDispatchOutboxEvents.defaultExpectation == nil {
+		mmDispatchOutboxEvents.defaultExpectation = &OutboxUsecaseMockDispatchOutboxEventsExpectation{}
+	}
+
+	if mmDispatchOutboxEvents.defaultExpectation.params != nil {
+		mmDispatchOutboxEvents.mock.t.Fatalf("OutboxUsecaseMock.DispatchOutboxEvents mock is already set by Expect")
+	}
+
+	if mmDispatchOutboxEvents.defaultExpectation.paramPtrs == nil {
+		mmDispatchOutboxEvents.defaultExpectation.paramPtrs = &OutboxUsecaseMockDispatchOutboxEventsParamPtrs{}
+	}
+	mmDispatchOutboxEvents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDispatchOutboxEvents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDispatchOutboxEvents
+}
+
+// ExpectLimitParam2 sets up expected param limit for OutboxUsecase.DispatchOutboxEvents
+func (mmDispatchOutboxEvents *mOutboxUsecaseMockDispatchOutboxEvents) ExpectLimitParam2(limit uint64) *mOutboxUsecaseMockDispatchOutboxEvents {
+	if mmDispatchOutboxEvents.mock.funcDispatchOutboxEvents != nil {
+		mmDispatchOutboxEvents.mock.t.Fatalf("OutboxUsecaseMock.DispatchOutboxEvents mock is already set by Set")
+	}
+
+	if mmDispatchOutboxEvents.defaultExpectation == nil {
+		mmDispatchOutboxEvents.defaultExpectation = &OutboxUsecaseMockDispatchOutboxEventsExpectation{}
+	}
+
+	if mmDispatchOutboxEvents.defaultExpectation.params != nil {
+		mmDispatchOutboxEvents.mock.t.Fatalf("OutboxUsecaseMock.DispatchOutboxEvents mock is already set by Expect")
+	}
+
+	if mmDispatchOutboxEvents.defaultExpectation.paramPtrs == nil {
+		mmDispatchOutboxEvents.defaultExpectation.paramPtrs = &OutboxUsecaseMockDispatchOutboxEventsParamPtrs{}
+	}
+	mmDispatchOutboxEvents.defaultExpectation.paramPtrs.limit = &limit
+	mmDispatchOutboxEvents.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmDispatchOutboxEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxUsecase.DispatchOutboxEvents
+func (mmDispatchOutboxEvents *mOutboxUsecaseMockDispatchOutboxEvents) Inspect(f func(ctx context.Context, limit uint64)) *mOutboxUsecaseMockDispatchOutboxEvents {
+	if mmDispatchOutboxEvents.mock.inspectFuncDispatchOutboxEvents != nil {
+		mmDispatchOutboxEvents.mock.t.Fatalf("Inspect function is already set for OutboxUsecaseMock.DispatchOutboxEvents")
+	}
+
+	mmDispatchOutboxEvents.mock.inspectFuncDispatchOutboxEvents = f
+
+	return mmDispatchOutboxEvents
+}
+
+// Return sets up results that will be returned by OutboxUsecase.DispatchOutboxEvents
+func (mmDispatchOutboxEvents *mOutboxUsecaseMockDispatchOutboxEvents) Return(processed int, err error) *OutboxUsecaseMock {
+	if mmDispatchOutboxEvents.mock.funcDispatchOutboxEvents != nil {
+		mmDispatchOutboxEvents.mock.t.Fatalf("OutboxUsecaseMock.DispatchOutboxEvents mock is already set by Set")
+	}
+
+	if mmDispatchOutboxEvents.defaultExpectation == nil {
+		mmDispatchOutboxEvents.defaultExpectation = &OutboxUsecaseMockDispatchOutboxEventsExpectation{mock: mmDispatchOutboxEvents.mock}
+	}
+	mmDispatchOutboxEvents.defaultExpectation.results = &OutboxUsecaseMockDispatchOutboxEventsResults{processed, err}
+	mmDispatchOutboxEvents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDispatchOutboxEvents.mock
+}
+
+// Set uses given function f to mock the OutboxUsecase.DispatchOutboxEvents method
+func (mmDispatchOutboxEvents *mOutboxUsecaseMockDispatchOutboxEvents) Set(f func(ctx context.Context, limit uint64) (processed int, err error)) *OutboxUsecaseMock {
+	if mmDispatchOutboxEvents.defaultExpectation != nil {
+		mmDispatchOutboxEvents.mock.t.Fatalf("Default expectation is already set for the OutboxUsecase.DispatchOutboxEvents method")
+	}
+
+	if len(mmDispatchOutboxEvents.expectations) > 0 {
+		mmDispatchOutboxEvents.mock.t.Fatalf("Some expectations are already set for the OutboxUsecase.DispatchOutboxEvents method")
+	}
+
+	mmDispatchOutboxEvents.mock.funcDispatchOutboxEvents = f
+	mmDispatchOutboxEvents.mock.funcDispatchOutboxEventsOrigin = minimock.CallerInfo(1)
+	return mmDispatchOutboxEvents.mock
+}
+
+// When sets expectation for the OutboxUsecase.DispatchOutboxEvents which will trigger the result defined by the following
+// Then helper
+func (mmDispatchOutboxEvents *mOutboxUsecaseMockDispatchOutboxEvents) When(ctx context.Context, limit uint64) *OutboxUsecaseMockDispatchOutboxEventsExpectation {
+	if mmDispatchOutboxEvents.mock.funcDispatchOutboxEvents != nil {
+		mmDispatchOutboxEvents.mock.t.Fatalf("OutboxUsecaseMock.DispatchOutboxEvents mock is already set by Set")
+	}
+
+	expectation := &OutboxUsecaseMockDispatchOutboxEventsExpectation{
+		mock:               mmDispatchOutboxEvents.mock,
+		params:             &OutboxUsecaseMockDispatchOutboxEventsParams{ctx, limit},
+		expectationOrigins: OutboxUsecaseMockDispatchOutboxEventsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDispatchOutboxEvents.expectations = append(mmDispatchOutboxEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxUsecase.DispatchOutboxEvents return parameters for the expectation previously defined by the When method
+func (e *OutboxUsecaseMockDispatchOutboxEventsExpectation) Then(processed int, err error) *OutboxUsecaseMock {
+	e.results = &OutboxUsecaseMockDispatchOutboxEventsResults{processed, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxUsecase.DispatchOutboxEvents should be invoked
+func (mmDispatchOutboxEvents *mOutboxUsecaseMockDispatchOutboxEvents) Times(n uint64) *mOutboxUsecaseMockDispatchOutboxEvents {
+	if n == 0 {
+		mmDispatchOutboxEvents.mock.t.Fatalf("Times of OutboxUsecaseMock.DispatchOutboxEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDispatchOutboxEvents.expectedInvocations, n)
+	mmDispatchOutboxEvents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDispatchOutboxEvents
+}
+
+func (mmDispatchOutboxEvents *mOutboxUsecaseMockDispatchOutboxEvents) invocationsDone() bool {
+	if len(mmDispatchOutboxEvents.expectations) == 0 && mmDispatchOutboxEvents.defaultExpectation == nil && mmDispatchOutboxEvents.mock.funcDispatchOutboxEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDispatchOutboxEvents.mock.afterDispatchOutboxEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDispatchOutboxEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DispatchOutboxEvents implements mm_usecase.OutboxUsecase
+func (mmDispatchOutboxEvents *OutboxUsecaseMock) DispatchOutboxEvents(ctx context.Context, limit uint64) (processed int, err error) {
+	mm_atomic.AddUint64(&mmDispatchOutboxEvents.beforeDispatchOutboxEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDispatchOutboxEvents.afterDispatchOutboxEventsCounter, 1)
+
+	mmDispatchOutboxEvents.t.Helper()
+
+	if mmDispatchOutboxEvents.inspectFuncDispatchOutboxEvents != nil {
+		mmDispatchOutboxEvents.inspectFuncDispatchOutboxEvents(ctx, limit)
+	}
+
+	mm_params := OutboxUsecaseMockDispatchOutboxEventsParams{ctx, limit}
+
+	// Record call args
+	mmDispatchOutboxEvents.DispatchOutboxEventsMock.mutex.Lock()
+	mmDispatchOutboxEvents.DispatchOutboxEventsMock.callArgs = append(mmDispatchOutboxEvents.DispatchOutboxEventsMock.callArgs, &mm_params)
+	mmDispatchOutboxEvents.DispatchOutboxEventsMock.mutex.Unlock()
+
+	for _, e := range mmDispatchOutboxEvents.DispatchOutboxEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.processed, e.results.err
+		}
+	}
+
+	if mmDispatchOutboxEvents.DispatchOutboxEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDispatchOutboxEvents.DispatchOutboxEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDispatchOutboxEvents.DispatchOutboxEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmDispatchOutboxEvents.DispatchOutboxEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxUsecaseMockDispatchOutboxEventsParams{ctx, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDispatchOutboxEvents.t.Errorf("OutboxUsecaseMock.DispatchOutboxEvents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDispatchOutboxEvents.DispatchOutboxEventsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmDispatchOutboxEvents.t.Errorf("OutboxUsecaseMock.DispatchOutboxEvents got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDispatchOutboxEvents.DispatchOutboxEventsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDispatchOutboxEvents.t.Errorf("OutboxUsecaseMock.DispatchOutboxEvents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDispatchOutboxEvents.DispatchOutboxEventsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDispatchOutboxEvents.DispatchOutboxEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDispatchOutboxEvents.t.Fatal("No results are set for the OutboxUsecaseMock.DispatchOutboxEvents")
+		}
+		return (*mm_results).processed, (*mm_results).err
+	}
+	if mmDispatchOutboxEvents.funcDispatchOutboxEvents != nil {
+		return mmDispatchOutboxEvents.funcDispatchOutboxEvents(ctx, limit)
+	}
+	mmDispatchOutboxEvents.t.Fatalf("Unexpected call to OutboxUsecaseMock.DispatchOutboxEvents. %v %v", ctx, limit)
+	return
+}
+
+// DispatchOutboxEventsAfterCounter returns a count of finished OutboxUsecaseMock.DispatchOutboxEvents invocations
+func (mmDispatchOutboxEvents *OutboxUsecaseMock) DispatchOutboxEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDispatchOutboxEvents.afterDispatchOutboxEventsCounter)
+}
+
+// DispatchOutboxEventsBeforeCounter returns a count of OutboxUsecaseMock.DispatchOutboxEvents invocations
+func (mmDispatchOutboxEvents *OutboxUsecaseMock) DispatchOutboxEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDispatchOutboxEvents.beforeDispatchOutboxEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxUsecaseMock.DispatchOutboxEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDispatchOutboxEvents *mOutboxUsecaseMockDispatchOutboxEvents) Calls() []*OutboxUsecaseMockDispatchOutboxEventsParams {
+	mmDispatchOutboxEvents.mutex.RLock()
+
+	argCopy := make([]*OutboxUsecaseMockDispatchOutboxEventsParams, len(mmDispatchOutboxEvents.callArgs))
+	copy(argCopy, mmDispatchOutboxEvents.callArgs)
+
+	mmDispatchOutboxEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDispatchOutboxEventsDone returns true if the count of the DispatchOutboxEvents invocations corresponds
+// the number of defined expectations
+func (m *OutboxUsecaseMock) MinimockDispatchOutboxEventsDone() bool {
+	if m.DispatchOutboxEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DispatchOutboxEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DispatchOutboxEventsMock.invocationsDone()
+}
+
+// MinimockDispatchOutboxEventsInspect logs each unmet expectation
+func (m *OutboxUsecaseMock) MinimockDispatchOutboxEventsInspect() {
+	for _, e := range m.DispatchOutboxEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxUsecaseMock.DispatchOutboxEvents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDispatchOutboxEventsCounter := mm_atomic.LoadUint64(&m.afterDispatchOutboxEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DispatchOutboxEventsMock.defaultExpectation != nil && afterDispatchOutboxEventsCounter < 1 {
+		if m.DispatchOutboxEventsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxUsecaseMock.DispatchOutboxEvents at\n%s", m.DispatchOutboxEventsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxUsecaseMock.DispatchOutboxEvents at\n%s with params: %#v", m.DispatchOutboxEventsMock.defaultExpectation.expectationOrigins.origin, *m.DispatchOutboxEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDispatchOutboxEvents != nil && afterDispatchOutboxEventsCounter < 1 {
+		m.t.Errorf("Expected call to OutboxUsecaseMock.DispatchOutboxEvents at\n%s", m.funcDispatchOutboxEventsOrigin)
+	}
+
+	if !m.DispatchOutboxEventsMock.invocationsDone() && afterDispatchOutboxEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxUsecaseMock.DispatchOutboxEvents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DispatchOutboxEventsMock.expectedInvocations), m.DispatchOutboxEventsMock.expectedInvocationsOrigin, afterDispatchOutboxEventsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OutboxUsecaseMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDispatchOutboxEventsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OutboxUsecaseMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OutboxUsecaseMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDispatchOutboxEventsDone()
+}
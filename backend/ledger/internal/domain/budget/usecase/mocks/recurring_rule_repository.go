@@ -0,0 +1,1935 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.RecurringRuleRepository -o recurring_rule_repository.go -n RecurringRuleRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	mm_usecase "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// RecurringRuleRepositoryMock implements mm_usecase.RecurringRuleRepository
+type RecurringRuleRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, item *entity.RecurringRule) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, item *entity.RecurringRule)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mRecurringRuleRepositoryMockCreate
+
+	funcFindList          func(ctx context.Context, listOptions *mm_usecase.RecurringRuleListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.RecurringRule, err error)
+	funcFindListOrigin    string
+	inspectFuncFindList   func(ctx context.Context, listOptions *mm_usecase.RecurringRuleListOptions, queryParams *uctypes.QueryGetListParams)
+	afterFindListCounter  uint64
+	beforeFindListCounter uint64
+	FindListMock          mRecurringRuleRepositoryMockFindList
+
+	funcFindOneByID          func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (item *entity.RecurringRule, err error)
+	funcFindOneByIDOrigin    string
+	inspectFuncFindOneByID   func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)
+	afterFindOneByIDCounter  uint64
+	beforeFindOneByIDCounter uint64
+	FindOneByIDMock          mRecurringRuleRepositoryMockFindOneByID
+
+	funcReassignCategory          func(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) (err error)
+	funcReassignCategoryOrigin    string
+	inspectFuncReassignCategory   func(ctx context.Context, fromCategoryID uint64, toCategoryID uint64)
+	afterReassignCategoryCounter  uint64
+	beforeReassignCategoryCounter uint64
+	ReassignCategoryMock          mRecurringRuleRepositoryMockReassignCategory
+
+	funcUpdate          func(ctx context.Context, item *entity.RecurringRule) (err error)
+	funcUpdateOrigin    string
+	inspectFuncUpdate   func(ctx context.Context, item *entity.RecurringRule)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mRecurringRuleRepositoryMockUpdate
+}
+
+// NewRecurringRuleRepositoryMock returns a mock for mm_usecase.RecurringRuleRepository
+func NewRecurringRuleRepositoryMock(t minimock.Tester) *RecurringRuleRepositoryMock {
+	m := &RecurringRuleRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mRecurringRuleRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*RecurringRuleRepositoryMockCreateParams{}
+
+	m.FindListMock = mRecurringRuleRepositoryMockFindList{mock: m}
+	m.FindListMock.callArgs = []*RecurringRuleRepositoryMockFindListParams{}
+
+	m.FindOneByIDMock = mRecurringRuleRepositoryMockFindOneByID{mock: m}
+	m.FindOneByIDMock.callArgs = []*RecurringRuleRepositoryMockFindOneByIDParams{}
+
+	m.ReassignCategoryMock = mRecurringRuleRepositoryMockReassignCategory{mock: m}
+	m.ReassignCategoryMock.callArgs = []*RecurringRuleRepositoryMockReassignCategoryParams{}
+
+	m.UpdateMock = mRecurringRuleRepositoryMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*RecurringRuleRepositoryMockUpdateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRecurringRuleRepositoryMockCreate struct {
+	optional           bool
+	mock               *RecurringRuleRepositoryMock
+	defaultExpectation *RecurringRuleRepositoryMockCreateExpectation
+	expectations       []*RecurringRuleRepositoryMockCreateExpectation
+
+	callArgs []*RecurringRuleRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RecurringRuleRepositoryMockCreateExpectation specifies expectation struct of the RecurringRuleRepository.Create
+type RecurringRuleRepositoryMockCreateExpectation struct {
+	mock               *RecurringRuleRepositoryMock
+	params             *RecurringRuleRepositoryMockCreateParams
+	paramPtrs          *RecurringRuleRepositoryMockCreateParamPtrs
+	expectationOrigins RecurringRuleRepositoryMockCreateExpectationOrigins
+	results            *RecurringRuleRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RecurringRuleRepositoryMockCreateParams contains parameters of the RecurringRuleRepository.Create
+type RecurringRuleRepositoryMockCreateParams struct {
+	ctx  context.Context
+	item *entity.RecurringRule
+}
+
+// RecurringRuleRepositoryMockCreateParamPtrs contains pointers to parameters of the RecurringRuleRepository.Create
+type RecurringRuleRepositoryMockCreateParamPtrs struct {
+	ctx  *context.Context
+	item **entity.RecurringRule
+}
+
+// RecurringRuleRepositoryMockCreateResults contains results of the RecurringRuleRepository.Create
+type RecurringRuleRepositoryMockCreateResults struct {
+	err error
+}
+
+// RecurringRuleRepositoryMockCreateOrigins contains origins of expectations of the RecurringRuleRepository.Create
+type RecurringRuleRepositoryMockCreateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originItem string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mRecurringRuleRepositoryMockCreate) Optional() *mRecurringRuleRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for RecurringRuleRepository.Create
+func (mmCreate *mRecurringRuleRepositoryMockCreate) Expect(ctx context.Context, item *entity.RecurringRule) *mRecurringRuleRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RecurringRuleRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RecurringRuleRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("RecurringRuleRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &RecurringRuleRepositoryMockCreateParams{ctx, item}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RecurringRuleRepository.Create
+func (mmCreate *mRecurringRuleRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mRecurringRuleRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RecurringRuleRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RecurringRuleRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RecurringRuleRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RecurringRuleRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectItemParam2 sets up expected param item for RecurringRuleRepository.Create
+func (mmCreate *mRecurringRuleRepositoryMockCreate) ExpectItemParam2(item *entity.RecurringRule) *mRecurringRuleRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RecurringRuleRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RecurringRuleRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RecurringRuleRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RecurringRuleRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.item = &item
+	mmCreate.defaultExpectation.expectationOrigins.originItem = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the RecurringRuleRepository.Create
+func (mmCreate *mRecurringRuleRepositoryMockCreate) Inspect(f func(ctx context.Context, item *entity.RecurringRule)) *mRecurringRuleRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for RecurringRuleRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by RecurringRuleRepository.Create
+func (mmCreate *mRecurringRuleRepositoryMockCreate) Return(err error) *RecurringRuleRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RecurringRuleRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RecurringRuleRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &RecurringRuleRepositoryMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the RecurringRuleRepository.Create method
+func (mmCreate *mRecurringRuleRepositoryMockCreate) Set(f func(ctx context.Context, item *entity.RecurringRule) (err error)) *RecurringRuleRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the RecurringRuleRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the RecurringRuleRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the RecurringRuleRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mRecurringRuleRepositoryMockCreate) When(ctx context.Context, item *entity.RecurringRule) *RecurringRuleRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RecurringRuleRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &RecurringRuleRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &RecurringRuleRepositoryMockCreateParams{ctx, item},
+		expectationOrigins: RecurringRuleRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RecurringRuleRepository.Create return parameters for the expectation previously defined by the When method
+func (e *RecurringRuleRepositoryMockCreateExpectation) Then(err error) *RecurringRuleRepositoryMock {
+	e.results = &RecurringRuleRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times RecurringRuleRepository.Create should be invoked
+func (mmCreate *mRecurringRuleRepositoryMockCreate) Times(n uint64) *mRecurringRuleRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of RecurringRuleRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mRecurringRuleRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_usecase.RecurringRuleRepository
+func (mmCreate *RecurringRuleRepositoryMock) Create(ctx context.Context, item *entity.RecurringRule) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, item)
+	}
+
+	mm_params := RecurringRuleRepositoryMockCreateParams{ctx, item}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := RecurringRuleRepositoryMockCreateParams{ctx, item}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("RecurringRuleRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
+				mmCreate.t.Errorf("RecurringRuleRepositoryMock.Create got unexpected parameter item, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originItem, *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("RecurringRuleRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the RecurringRuleRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, item)
+	}
+	mmCreate.t.Fatalf("Unexpected call to RecurringRuleRepositoryMock.Create. %v %v", ctx, item)
+	return
+}
+
+// CreateAfterCounter returns a count of finished RecurringRuleRepositoryMock.Create invocations
+func (mmCreate *RecurringRuleRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of RecurringRuleRepositoryMock.Create invocations
+func (mmCreate *RecurringRuleRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to RecurringRuleRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mRecurringRuleRepositoryMockCreate) Calls() []*RecurringRuleRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*RecurringRuleRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *RecurringRuleRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *RecurringRuleRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RecurringRuleRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RecurringRuleRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RecurringRuleRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to RecurringRuleRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to RecurringRuleRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mRecurringRuleRepositoryMockFindList struct {
+	optional           bool
+	mock               *RecurringRuleRepositoryMock
+	defaultExpectation *RecurringRuleRepositoryMockFindListExpectation
+	expectations       []*RecurringRuleRepositoryMockFindListExpectation
+
+	callArgs []*RecurringRuleRepositoryMockFindListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RecurringRuleRepositoryMockFindListExpectation specifies expectation struct of the RecurringRuleRepository.FindList
+type RecurringRuleRepositoryMockFindListExpectation struct {
+	mock               *RecurringRuleRepositoryMock
+	params             *RecurringRuleRepositoryMockFindListParams
+	paramPtrs          *RecurringRuleRepositoryMockFindListParamPtrs
+	expectationOrigins RecurringRuleRepositoryMockFindListExpectationOrigins
+	results            *RecurringRuleRepositoryMockFindListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RecurringRuleRepositoryMockFindListParams contains parameters of the RecurringRuleRepository.FindList
+type RecurringRuleRepositoryMockFindListParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.RecurringRuleListOptions
+	queryParams *uctypes.QueryGetListParams
+}
+
+// RecurringRuleRepositoryMockFindListParamPtrs contains pointers to parameters of the RecurringRuleRepository.FindList
+type RecurringRuleRepositoryMockFindListParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.RecurringRuleListOptions
+	queryParams **uctypes.QueryGetListParams
+}
+
+// RecurringRuleRepositoryMockFindListResults contains results of the RecurringRuleRepository.FindList
+type RecurringRuleRepositoryMockFindListResults struct {
+	items []*entity.RecurringRule
+	err   error
+}
+
+// RecurringRuleRepositoryMockFindListOrigins contains origins of expectations of the RecurringRuleRepository.FindList
+type RecurringRuleRepositoryMockFindListExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindList *mRecurringRuleRepositoryMockFindList) Optional() *mRecurringRuleRepositoryMockFindList {
+	mmFindList.optional = true
+	return mmFindList
+}
+
+// Expect sets up expected params for RecurringRuleRepository.FindList
+func (mmFindList *mRecurringRuleRepositoryMockFindList) Expect(ctx context.Context, listOptions *mm_usecase.RecurringRuleListOptions, queryParams *uctypes.QueryGetListParams) *mRecurringRuleRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &RecurringRuleRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleRepositoryMock.FindList mock is already set by ExpectParams functions")
+	}
+
+	mmFindList.defaultExpectation.params = &RecurringRuleRepositoryMockFindListParams{ctx, listOptions, queryParams}
+	mmFindList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindList.expectations {
+		if minimock.Equal(e.params, mmFindList.defaultExpectation.params) {
+			mmFindList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindList.defaultExpectation.params)
+		}
+	}
+
+	return mmFindList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RecurringRuleRepository.FindList
+func (mmFindList *mRecurringRuleRepositoryMockFindList) ExpectCtxParam1(ctx context.Context) *mRecurringRuleRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &RecurringRuleRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &RecurringRuleRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for RecurringRuleRepository.FindList
+func (mmFindList *mRecurringRuleRepositoryMockFindList) ExpectListOptionsParam2(listOptions *mm_usecase.RecurringRuleListOptions) *mRecurringRuleRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &RecurringRuleRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &RecurringRuleRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmFindList.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for RecurringRuleRepository.FindList
+func (mmFindList *mRecurringRuleRepositoryMockFindList) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mRecurringRuleRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &RecurringRuleRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &RecurringRuleRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindList.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// Inspect accepts an inspector function that has same arguments as the RecurringRuleRepository.FindList
+func (mmFindList *mRecurringRuleRepositoryMockFindList) Inspect(f func(ctx context.Context, listOptions *mm_usecase.RecurringRuleListOptions, queryParams *uctypes.QueryGetListParams)) *mRecurringRuleRepositoryMockFindList {
+	if mmFindList.mock.inspectFuncFindList != nil {
+		mmFindList.mock.t.Fatalf("Inspect function is already set for RecurringRuleRepositoryMock.FindList")
+	}
+
+	mmFindList.mock.inspectFuncFindList = f
+
+	return mmFindList
+}
+
+// Return sets up results that will be returned by RecurringRuleRepository.FindList
+func (mmFindList *mRecurringRuleRepositoryMockFindList) Return(items []*entity.RecurringRule, err error) *RecurringRuleRepositoryMock {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &RecurringRuleRepositoryMockFindListExpectation{mock: mmFindList.mock}
+	}
+	mmFindList.defaultExpectation.results = &RecurringRuleRepositoryMockFindListResults{items, err}
+	mmFindList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// Set uses given function f to mock the RecurringRuleRepository.FindList method
+func (mmFindList *mRecurringRuleRepositoryMockFindList) Set(f func(ctx context.Context, listOptions *mm_usecase.RecurringRuleListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.RecurringRule, err error)) *RecurringRuleRepositoryMock {
+	if mmFindList.defaultExpectation != nil {
+		mmFindList.mock.t.Fatalf("Default expectation is already set for the RecurringRuleRepository.FindList method")
+	}
+
+	if len(mmFindList.expectations) > 0 {
+		mmFindList.mock.t.Fatalf("Some expectations are already set for the RecurringRuleRepository.FindList method")
+	}
+
+	mmFindList.mock.funcFindList = f
+	mmFindList.mock.funcFindListOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// When sets expectation for the RecurringRuleRepository.FindList which will trigger the result defined by the following
+// Then helper
+func (mmFindList *mRecurringRuleRepositoryMockFindList) When(ctx context.Context, listOptions *mm_usecase.RecurringRuleListOptions, queryParams *uctypes.QueryGetListParams) *RecurringRuleRepositoryMockFindListExpectation {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleRepositoryMock.FindList mock is already set by Set")
+	}
+
+	expectation := &RecurringRuleRepositoryMockFindListExpectation{
+		mock:               mmFindList.mock,
+		params:             &RecurringRuleRepositoryMockFindListParams{ctx, listOptions, queryParams},
+		expectationOrigins: RecurringRuleRepositoryMockFindListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindList.expectations = append(mmFindList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RecurringRuleRepository.FindList return parameters for the expectation previously defined by the When method
+func (e *RecurringRuleRepositoryMockFindListExpectation) Then(items []*entity.RecurringRule, err error) *RecurringRuleRepositoryMock {
+	e.results = &RecurringRuleRepositoryMockFindListResults{items, err}
+	return e.mock
+}
+
+// Times sets number of times RecurringRuleRepository.FindList should be invoked
+func (mmFindList *mRecurringRuleRepositoryMockFindList) Times(n uint64) *mRecurringRuleRepositoryMockFindList {
+	if n == 0 {
+		mmFindList.mock.t.Fatalf("Times of RecurringRuleRepositoryMock.FindList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindList.expectedInvocations, n)
+	mmFindList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindList
+}
+
+func (mmFindList *mRecurringRuleRepositoryMockFindList) invocationsDone() bool {
+	if len(mmFindList.expectations) == 0 && mmFindList.defaultExpectation == nil && mmFindList.mock.funcFindList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindList.mock.afterFindListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindList implements mm_usecase.RecurringRuleRepository
+func (mmFindList *RecurringRuleRepositoryMock) FindList(ctx context.Context, listOptions *mm_usecase.RecurringRuleListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.RecurringRule, err error) {
+	mm_atomic.AddUint64(&mmFindList.beforeFindListCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindList.afterFindListCounter, 1)
+
+	mmFindList.t.Helper()
+
+	if mmFindList.inspectFuncFindList != nil {
+		mmFindList.inspectFuncFindList(ctx, listOptions, queryParams)
+	}
+
+	mm_params := RecurringRuleRepositoryMockFindListParams{ctx, listOptions, queryParams}
+
+	// Record call args
+	mmFindList.FindListMock.mutex.Lock()
+	mmFindList.FindListMock.callArgs = append(mmFindList.FindListMock.callArgs, &mm_params)
+	mmFindList.FindListMock.mutex.Unlock()
+
+	for _, e := range mmFindList.FindListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.items, e.results.err
+		}
+	}
+
+	if mmFindList.FindListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindList.FindListMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindList.FindListMock.defaultExpectation.params
+		mm_want_ptrs := mmFindList.FindListMock.defaultExpectation.paramPtrs
+
+		mm_got := RecurringRuleRepositoryMockFindListParams{ctx, listOptions, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindList.t.Errorf("RecurringRuleRepositoryMock.FindList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmFindList.t.Errorf("RecurringRuleRepositoryMock.FindList got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindList.t.Errorf("RecurringRuleRepositoryMock.FindList got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindList.t.Errorf("RecurringRuleRepositoryMock.FindList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindList.FindListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindList.FindListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindList.t.Fatal("No results are set for the RecurringRuleRepositoryMock.FindList")
+		}
+		return (*mm_results).items, (*mm_results).err
+	}
+	if mmFindList.funcFindList != nil {
+		return mmFindList.funcFindList(ctx, listOptions, queryParams)
+	}
+	mmFindList.t.Fatalf("Unexpected call to RecurringRuleRepositoryMock.FindList. %v %v %v", ctx, listOptions, queryParams)
+	return
+}
+
+// FindListAfterCounter returns a count of finished RecurringRuleRepositoryMock.FindList invocations
+func (mmFindList *RecurringRuleRepositoryMock) FindListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.afterFindListCounter)
+}
+
+// FindListBeforeCounter returns a count of RecurringRuleRepositoryMock.FindList invocations
+func (mmFindList *RecurringRuleRepositoryMock) FindListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.beforeFindListCounter)
+}
+
+// Calls returns a list of arguments used in each call to RecurringRuleRepositoryMock.FindList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindList *mRecurringRuleRepositoryMockFindList) Calls() []*RecurringRuleRepositoryMockFindListParams {
+	mmFindList.mutex.RLock()
+
+	argCopy := make([]*RecurringRuleRepositoryMockFindListParams, len(mmFindList.callArgs))
+	copy(argCopy, mmFindList.callArgs)
+
+	mmFindList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindListDone returns true if the count of the FindList invocations corresponds
+// the number of defined expectations
+func (m *RecurringRuleRepositoryMock) MinimockFindListDone() bool {
+	if m.FindListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindListMock.invocationsDone()
+}
+
+// MinimockFindListInspect logs each unmet expectation
+func (m *RecurringRuleRepositoryMock) MinimockFindListInspect() {
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RecurringRuleRepositoryMock.FindList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindListCounter := mm_atomic.LoadUint64(&m.afterFindListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindListMock.defaultExpectation != nil && afterFindListCounter < 1 {
+		if m.FindListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RecurringRuleRepositoryMock.FindList at\n%s", m.FindListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RecurringRuleRepositoryMock.FindList at\n%s with params: %#v", m.FindListMock.defaultExpectation.expectationOrigins.origin, *m.FindListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindList != nil && afterFindListCounter < 1 {
+		m.t.Errorf("Expected call to RecurringRuleRepositoryMock.FindList at\n%s", m.funcFindListOrigin)
+	}
+
+	if !m.FindListMock.invocationsDone() && afterFindListCounter > 0 {
+		m.t.Errorf("Expected %d calls to RecurringRuleRepositoryMock.FindList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindListMock.expectedInvocations), m.FindListMock.expectedInvocationsOrigin, afterFindListCounter)
+	}
+}
+
+type mRecurringRuleRepositoryMockFindOneByID struct {
+	optional           bool
+	mock               *RecurringRuleRepositoryMock
+	defaultExpectation *RecurringRuleRepositoryMockFindOneByIDExpectation
+	expectations       []*RecurringRuleRepositoryMockFindOneByIDExpectation
+
+	callArgs []*RecurringRuleRepositoryMockFindOneByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RecurringRuleRepositoryMockFindOneByIDExpectation specifies expectation struct of the RecurringRuleRepository.FindOneByID
+type RecurringRuleRepositoryMockFindOneByIDExpectation struct {
+	mock               *RecurringRuleRepositoryMock
+	params             *RecurringRuleRepositoryMockFindOneByIDParams
+	paramPtrs          *RecurringRuleRepositoryMockFindOneByIDParamPtrs
+	expectationOrigins RecurringRuleRepositoryMockFindOneByIDExpectationOrigins
+	results            *RecurringRuleRepositoryMockFindOneByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RecurringRuleRepositoryMockFindOneByIDParams contains parameters of the RecurringRuleRepository.FindOneByID
+type RecurringRuleRepositoryMockFindOneByIDParams struct {
+	ctx         context.Context
+	id          uuid.UUID
+	queryParams *uctypes.QueryGetOneParams
+}
+
+// RecurringRuleRepositoryMockFindOneByIDParamPtrs contains pointers to parameters of the RecurringRuleRepository.FindOneByID
+type RecurringRuleRepositoryMockFindOneByIDParamPtrs struct {
+	ctx         *context.Context
+	id          *uuid.UUID
+	queryParams **uctypes.QueryGetOneParams
+}
+
+// RecurringRuleRepositoryMockFindOneByIDResults contains results of the RecurringRuleRepository.FindOneByID
+type RecurringRuleRepositoryMockFindOneByIDResults struct {
+	item *entity.RecurringRule
+	err  error
+}
+
+// RecurringRuleRepositoryMockFindOneByIDOrigins contains origins of expectations of the RecurringRuleRepository.FindOneByID
+type RecurringRuleRepositoryMockFindOneByIDExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originId          string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindOneByID *mRecurringRuleRepositoryMockFindOneByID) Optional() *mRecurringRuleRepositoryMockFindOneByID {
+	mmFindOneByID.optional = true
+	return mmFindOneByID
+}
+
+// Expect sets up expected params for RecurringRuleRepository.FindOneByID
+func (mmFindOneByID *mRecurringRuleRepositoryMockFindOneByID) Expect(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *mRecurringRuleRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &RecurringRuleRepositoryMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleRepositoryMock.FindOneByID mock is already set by ExpectParams functions")
+	}
+
+	mmFindOneByID.defaultExpectation.params = &RecurringRuleRepositoryMockFindOneByIDParams{ctx, id, queryParams}
+	mmFindOneByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindOneByID.expectations {
+		if minimock.Equal(e.params, mmFindOneByID.defaultExpectation.params) {
+			mmFindOneByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindOneByID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindOneByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RecurringRuleRepository.FindOneByID
+func (mmFindOneByID *mRecurringRuleRepositoryMockFindOneByID) ExpectCtxParam1(ctx context.Context) *mRecurringRuleRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &RecurringRuleRepositoryMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleRepositoryMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &RecurringRuleRepositoryMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindOneByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// ExpectIdParam2 sets up expected param id for RecurringRuleRepository.FindOneByID
+func (mmFindOneByID *mRecurringRuleRepositoryMockFindOneByID) ExpectIdParam2(id uuid.UUID) *mRecurringRuleRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &RecurringRuleRepositoryMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleRepositoryMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &RecurringRuleRepositoryMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.id = &id
+	mmFindOneByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for RecurringRuleRepository.FindOneByID
+func (mmFindOneByID *mRecurringRuleRepositoryMockFindOneByID) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetOneParams) *mRecurringRuleRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &RecurringRuleRepositoryMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleRepositoryMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &RecurringRuleRepositoryMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindOneByID.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the RecurringRuleRepository.FindOneByID
+func (mmFindOneByID *mRecurringRuleRepositoryMockFindOneByID) Inspect(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)) *mRecurringRuleRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.inspectFuncFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("Inspect function is already set for RecurringRuleRepositoryMock.FindOneByID")
+	}
+
+	mmFindOneByID.mock.inspectFuncFindOneByID = f
+
+	return mmFindOneByID
+}
+
+// Return sets up results that will be returned by RecurringRuleRepository.FindOneByID
+func (mmFindOneByID *mRecurringRuleRepositoryMockFindOneByID) Return(item *entity.RecurringRule, err error) *RecurringRuleRepositoryMock {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &RecurringRuleRepositoryMockFindOneByIDExpectation{mock: mmFindOneByID.mock}
+	}
+	mmFindOneByID.defaultExpectation.results = &RecurringRuleRepositoryMockFindOneByIDResults{item, err}
+	mmFindOneByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID.mock
+}
+
+// Set uses given function f to mock the RecurringRuleRepository.FindOneByID method
+func (mmFindOneByID *mRecurringRuleRepositoryMockFindOneByID) Set(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (item *entity.RecurringRule, err error)) *RecurringRuleRepositoryMock {
+	if mmFindOneByID.defaultExpectation != nil {
+		mmFindOneByID.mock.t.Fatalf("Default expectation is already set for the RecurringRuleRepository.FindOneByID method")
+	}
+
+	if len(mmFindOneByID.expectations) > 0 {
+		mmFindOneByID.mock.t.Fatalf("Some expectations are already set for the RecurringRuleRepository.FindOneByID method")
+	}
+
+	mmFindOneByID.mock.funcFindOneByID = f
+	mmFindOneByID.mock.funcFindOneByIDOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID.mock
+}
+
+// When sets expectation for the RecurringRuleRepository.FindOneByID which will trigger the result defined by the following
+// Then helper
+func (mmFindOneByID *mRecurringRuleRepositoryMockFindOneByID) When(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *RecurringRuleRepositoryMockFindOneByIDExpectation {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	expectation := &RecurringRuleRepositoryMockFindOneByIDExpectation{
+		mock:               mmFindOneByID.mock,
+		params:             &RecurringRuleRepositoryMockFindOneByIDParams{ctx, id, queryParams},
+		expectationOrigins: RecurringRuleRepositoryMockFindOneByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindOneByID.expectations = append(mmFindOneByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RecurringRuleRepository.FindOneByID return parameters for the expectation previously defined by the When method
+func (e *RecurringRuleRepositoryMockFindOneByIDExpectation) Then(item *entity.RecurringRule, err error) *RecurringRuleRepositoryMock {
+	e.results = &RecurringRuleRepositoryMockFindOneByIDResults{item, err}
+	return e.mock
+}
+
+// Times sets number of times RecurringRuleRepository.FindOneByID should be invoked
+func (mmFindOneByID *mRecurringRuleRepositoryMockFindOneByID) Times(n uint64) *mRecurringRuleRepositoryMockFindOneByID {
+	if n == 0 {
+		mmFindOneByID.mock.t.Fatalf("Times of RecurringRuleRepositoryMock.FindOneByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindOneByID.expectedInvocations, n)
+	mmFindOneByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID
+}
+
+func (mmFindOneByID *mRecurringRuleRepositoryMockFindOneByID) invocationsDone() bool {
+	if len(mmFindOneByID.expectations) == 0 && mmFindOneByID.defaultExpectation == nil && mmFindOneByID.mock.funcFindOneByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindOneByID.mock.afterFindOneByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindOneByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindOneByID implements mm_usecase.RecurringRuleRepository
+func (mmFindOneByID *RecurringRuleRepositoryMock) FindOneByID(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (item *entity.RecurringRule, err error) {
+	mm_atomic.AddUint64(&mmFindOneByID.beforeFindOneByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindOneByID.afterFindOneByIDCounter, 1)
+
+	mmFindOneByID.t.Helper()
+
+	if mmFindOneByID.inspectFuncFindOneByID != nil {
+		mmFindOneByID.inspectFuncFindOneByID(ctx, id, queryParams)
+	}
+
+	mm_params := RecurringRuleRepositoryMockFindOneByIDParams{ctx, id, queryParams}
+
+	// Record call args
+	mmFindOneByID.FindOneByIDMock.mutex.Lock()
+	mmFindOneByID.FindOneByIDMock.callArgs = append(mmFindOneByID.FindOneByIDMock.callArgs, &mm_params)
+	mmFindOneByID.FindOneByIDMock.mutex.Unlock()
+
+	for _, e := range mmFindOneByID.FindOneByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.item, e.results.err
+		}
+	}
+
+	if mmFindOneByID.FindOneByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindOneByID.FindOneByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindOneByID.FindOneByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindOneByID.FindOneByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := RecurringRuleRepositoryMockFindOneByIDParams{ctx, id, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindOneByID.t.Errorf("RecurringRuleRepositoryMock.FindOneByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmFindOneByID.t.Errorf("RecurringRuleRepositoryMock.FindOneByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindOneByID.t.Errorf("RecurringRuleRepositoryMock.FindOneByID got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindOneByID.t.Errorf("RecurringRuleRepositoryMock.FindOneByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindOneByID.FindOneByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindOneByID.t.Fatal("No results are set for the RecurringRuleRepositoryMock.FindOneByID")
+		}
+		return (*mm_results).item, (*mm_results).err
+	}
+	if mmFindOneByID.funcFindOneByID != nil {
+		return mmFindOneByID.funcFindOneByID(ctx, id, queryParams)
+	}
+	mmFindOneByID.t.Fatalf("Unexpected call to RecurringRuleRepositoryMock.FindOneByID. %v %v %v", ctx, id, queryParams)
+	return
+}
+
+// FindOneByIDAfterCounter returns a count of finished RecurringRuleRepositoryMock.FindOneByID invocations
+func (mmFindOneByID *RecurringRuleRepositoryMock) FindOneByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByID.afterFindOneByIDCounter)
+}
+
+// FindOneByIDBeforeCounter returns a count of RecurringRuleRepositoryMock.FindOneByID invocations
+func (mmFindOneByID *RecurringRuleRepositoryMock) FindOneByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByID.beforeFindOneByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to RecurringRuleRepositoryMock.FindOneByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindOneByID *mRecurringRuleRepositoryMockFindOneByID) Calls() []*RecurringRuleRepositoryMockFindOneByIDParams {
+	mmFindOneByID.mutex.RLock()
+
+	argCopy := make([]*RecurringRuleRepositoryMockFindOneByIDParams, len(mmFindOneByID.callArgs))
+	copy(argCopy, mmFindOneByID.callArgs)
+
+	mmFindOneByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindOneByIDDone returns true if the count of the FindOneByID invocations corresponds
+// the number of defined expectations
+func (m *RecurringRuleRepositoryMock) MinimockFindOneByIDDone() bool {
+	if m.FindOneByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindOneByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindOneByIDMock.invocationsDone()
+}
+
+// MinimockFindOneByIDInspect logs each unmet expectation
+func (m *RecurringRuleRepositoryMock) MinimockFindOneByIDInspect() {
+	for _, e := range m.FindOneByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RecurringRuleRepositoryMock.FindOneByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindOneByIDCounter := mm_atomic.LoadUint64(&m.afterFindOneByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindOneByIDMock.defaultExpectation != nil && afterFindOneByIDCounter < 1 {
+		if m.FindOneByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RecurringRuleRepositoryMock.FindOneByID at\n%s", m.FindOneByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RecurringRuleRepositoryMock.FindOneByID at\n%s with params: %#v", m.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindOneByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindOneByID != nil && afterFindOneByIDCounter < 1 {
+		m.t.Errorf("Expected call to RecurringRuleRepositoryMock.FindOneByID at\n%s", m.funcFindOneByIDOrigin)
+	}
+
+	if !m.FindOneByIDMock.invocationsDone() && afterFindOneByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RecurringRuleRepositoryMock.FindOneByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindOneByIDMock.expectedInvocations), m.FindOneByIDMock.expectedInvocationsOrigin, afterFindOneByIDCounter)
+	}
+}
+
+type mRecurringRuleRepositoryMockReassignCategory struct {
+	optional           bool
+	mock               *RecurringRuleRepositoryMock
+	defaultExpectation *RecurringRuleRepositoryMockReassignCategoryExpectation
+	expectations       []*RecurringRuleRepositoryMockReassignCategoryExpectation
+
+	callArgs []*RecurringRuleRepositoryMockReassignCategoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RecurringRuleRepositoryMockReassignCategoryExpectation specifies expectation struct of the RecurringRuleRepository.ReassignCategory
+type RecurringRuleRepositoryMockReassignCategoryExpectation struct {
+	mock               *RecurringRuleRepositoryMock
+	params             *RecurringRuleRepositoryMockReassignCategoryParams
+	paramPtrs          *RecurringRuleRepositoryMockReassignCategoryParamPtrs
+	expectationOrigins RecurringRuleRepositoryMockReassignCategoryExpectationOrigins
+	results            *RecurringRuleRepositoryMockReassignCategoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RecurringRuleRepositoryMockReassignCategoryParams contains parameters of the RecurringRuleRepository.ReassignCategory
+type RecurringRuleRepositoryMockReassignCategoryParams struct {
+	ctx            context.Context
+	fromCategoryID uint64
+	toCategoryID   uint64
+}
+
+// RecurringRuleRepositoryMockReassignCategoryParamPtrs contains pointers to parameters of the RecurringRuleRepository.ReassignCategory
+type RecurringRuleRepositoryMockReassignCategoryParamPtrs struct {
+	ctx            *context.Context
+	fromCategoryID *uint64
+	toCategoryID   *uint64
+}
+
+// RecurringRuleRepositoryMockReassignCategoryResults contains results of the RecurringRuleRepository.ReassignCategory
+type RecurringRuleRepositoryMockReassignCategoryResults struct {
+	err error
+}
+
+// RecurringRuleRepositoryMockReassignCategoryOrigins contains origins of expectations of the RecurringRuleRepository.ReassignCategory
+type RecurringRuleRepositoryMockReassignCategoryExpectationOrigins struct {
+	origin               string
+	originCtx            string
+	originFromCategoryID string
+	originToCategoryID   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReassignCategory *mRecurringRuleRepositoryMockReassignCategory) Optional() *mRecurringRuleRepositoryMockReassignCategory {
+	mmReassignCategory.optional = true
+	return mmReassignCategory
+}
+
+// Expect sets up expected params for RecurringRuleRepository.ReassignCategory
+func (mmReassignCategory *mRecurringRuleRepositoryMockReassignCategory) Expect(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) *mRecurringRuleRepositoryMockReassignCategory {
+	if mmReassignCategory.mock.funcReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("RecurringRuleRepositoryMock.ReassignCategory mock is already set by Set")
+	}
+
+	if mmReassignCategory.defaultExpectation == nil {
+		mmReassignCategory.defaultExpectation = &RecurringRuleRepositoryMockReassignCategoryExpectation{}
+	}
+
+	if mmReassignCategory.defaultExpectation.paramPtrs != nil {
+		mmReassignCategory.mock.t.Fatalf("RecurringRuleRepositoryMock.ReassignCategory mock is already set by ExpectParams functions")
+	}
+
+	mmReassignCategory.defaultExpectation.params = &RecurringRuleRepositoryMockReassignCategoryParams{ctx, fromCategoryID, toCategoryID}
+	mmReassignCategory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReassignCategory.expectations {
+		if minimock.Equal(e.params, mmReassignCategory.defaultExpectation.params) {
+			mmReassignCategory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReassignCategory.defaultExpectation.params)
+		}
+	}
+
+	return mmReassignCategory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RecurringRuleRepository.ReassignCategory
+func (mmReassignCategory *mRecurringRuleRepositoryMockReassignCategory) ExpectCtxParam1(ctx context.Context) *mRecurringRuleRepositoryMockReassignCategory {
+	if mmReassignCategory.mock.funcReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("RecurringRuleRepositoryMock.ReassignCategory mock is already set by Set")
+	}
+
+	if mmReassignCategory.defaultExpectation == nil {
+		mmReassignCategory.defaultExpectation = &RecurringRuleRepositoryMockReassignCategoryExpectation{}
+	}
+
+	if mmReassignCategory.defaultExpectation.params != nil {
+		mmReassignCategory.mock.t.Fatalf("RecurringRuleRepositoryMock.ReassignCategory mock is already set by Expect")
+	}
+
+	if mmReassignCategory.defaultExpectation.paramPtrs == nil {
+		mmReassignCategory.defaultExpectation.paramPtrs = &RecurringRuleRepositoryMockReassignCategoryParamPtrs{}
+	}
+	mmReassignCategory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReassignCategory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReassignCategory
+}
+
+// ExpectFromCategoryIDParam2 sets up expected param fromCategoryID for RecurringRuleRepository.ReassignCategory
+func (mmReassignCategory *mRecurringRuleRepositoryMockReassignCategory) ExpectFromCategoryIDParam2(fromCategoryID uint64) *mRecurringRuleRepositoryMockReassignCategory {
+	if mmReassignCategory.mock.funcReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("RecurringRuleRepositoryMock.ReassignCategory mock is already set by Set")
+	}
+
+	if mmReassignCategory.defaultExpectation == nil {
+		mmReassignCategory.defaultExpectation = &RecurringRuleRepositoryMockReassignCategoryExpectation{}
+	}
+
+	if mmReassignCategory.defaultExpectation.params != nil {
+		mmReassignCategory.mock.t.Fatalf("RecurringRuleRepositoryMock.ReassignCategory mock is already set by Expect")
+	}
+
+	if mmReassignCategory.defaultExpectation.paramPtrs == nil {
+		mmReassignCategory.defaultExpectation.paramPtrs = &RecurringRuleRepositoryMockReassignCategoryParamPtrs{}
+	}
+	mmReassignCategory.defaultExpectation.paramPtrs.fromCategoryID = &fromCategoryID
+	mmReassignCategory.defaultExpectation.expectationOrigins.originFromCategoryID = minimock.CallerInfo(1)
+
+	return mmReassignCategory
+}
+
+// ExpectToCategoryIDParam3 sets up expected param toCategoryID for RecurringRuleRepository.ReassignCategory
+func (mmReassignCategory *mRecurringRuleRepositoryMockReassignCategory) ExpectToCategoryIDParam3(toCategoryID uint64) *mRecurringRuleRepositoryMockReassignCategory {
+	if mmReassignCategory.mock.funcReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("RecurringRuleRepositoryMock.ReassignCategory mock is already set by Set")
+	}
+
+	if mmReassignCategory.defaultExpectation == nil {
+		mmReassignCategory.defaultExpectation = &RecurringRuleRepositoryMockReassignCategoryExpectation{}
+	}
+
+	if mmReassignCategory.defaultExpectation.params != nil {
+		mmReassignCategory.mock.t.Fatalf("RecurringRuleRepositoryMock.ReassignCategory mock is already set by Expect")
+	}
+
+	if mmReassignCategory.defaultExpectation.paramPtrs == nil {
+		mmReassignCategory.defaultExpectation.paramPtrs = &RecurringRuleRepositoryMockReassignCategoryParamPtrs{}
+	}
+	mmReassignCategory.defaultExpectation.paramPtrs.toCategoryID = &toCategoryID
+	mmReassignCategory.defaultExpectation.expectationOrigins.originToCategoryID = minimock.CallerInfo(1)
+
+	return mmReassignCategory
+}
+
+// Inspect accepts an inspector function that has same arguments as the RecurringRuleRepository.ReassignCategory
+func (mmReassignCategory *mRecurringRuleRepositoryMockReassignCategory) Inspect(f func(ctx context.Context, fromCategoryID uint64, toCategoryID uint64)) *mRecurringRuleRepositoryMockReassignCategory {
+	if mmReassignCategory.mock.inspectFuncReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("Inspect function is already set for RecurringRuleRepositoryMock.ReassignCategory")
+	}
+
+	mmReassignCategory.mock.inspectFuncReassignCategory = f
+
+	return mmReassignCategory
+}
+
+// Return sets up results that will be returned by RecurringRuleRepository.ReassignCategory
+func (mmReassignCategory *mRecurringRuleRepositoryMockReassignCategory) Return(err error) *RecurringRuleRepositoryMock {
+	if mmReassignCategory.mock.funcReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("RecurringRuleRepositoryMock.ReassignCategory mock is already set by Set")
+	}
+
+	if mmReassignCategory.defaultExpectation == nil {
+		mmReassignCategory.defaultExpectation = &RecurringRuleRepositoryMockReassignCategoryExpectation{mock: mmReassignCategory.mock}
+	}
+	mmReassignCategory.defaultExpectation.results = &RecurringRuleRepositoryMockReassignCategoryResults{err}
+	mmReassignCategory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReassignCategory.mock
+}
+
+// Set uses given function f to mock the RecurringRuleRepository.ReassignCategory method
+func (mmReassignCategory *mRecurringRuleRepositoryMockReassignCategory) Set(f func(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) (err error)) *RecurringRuleRepositoryMock {
+	if mmReassignCategory.defaultExpectation != nil {
+		mmReassignCategory.mock.t.Fatalf("Default expectation is already set for the RecurringRuleRepository.ReassignCategory method")
+	}
+
+	if len(mmReassignCategory.expectations) > 0 {
+		mmReassignCategory.mock.t.Fatalf("Some expectations are already set for the RecurringRuleRepository.ReassignCategory method")
+	}
+
+	mmReassignCategory.mock.funcReassignCategory = f
+	mmReassignCategory.mock.funcReassignCategoryOrigin = minimock.CallerInfo(1)
+	return mmReassignCategory.mock
+}
+
+// When sets expectation for the RecurringRuleRepository.ReassignCategory which will trigger the result defined by the following
+// Then helper
+func (mmReassignCategory *mRecurringRuleRepositoryMockReassignCategory) When(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) *RecurringRuleRepositoryMockReassignCategoryExpectation {
+	if mmReassignCategory.mock.funcReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("RecurringRuleRepositoryMock.ReassignCategory mock is already set by Set")
+	}
+
+	expectation := &RecurringRuleRepositoryMockReassignCategoryExpectation{
+		mock:               mmReassignCategory.mock,
+		params:             &RecurringRuleRepositoryMockReassignCategoryParams{ctx, fromCategoryID, toCategoryID},
+		expectationOrigins: RecurringRuleRepositoryMockReassignCategoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReassignCategory.expectations = append(mmReassignCategory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RecurringRuleRepository.ReassignCategory return parameters for the expectation previously defined by the When method
+func (e *RecurringRuleRepositoryMockReassignCategoryExpectation) Then(err error) *RecurringRuleRepositoryMock {
+	e.results = &RecurringRuleRepositoryMockReassignCategoryResults{err}
+	return e.mock
+}
+
+// Times sets number of times RecurringRuleRepository.ReassignCategory should be invoked
+func (mmReassignCategory *mRecurringRuleRepositoryMockReassignCategory) Times(n uint64) *mRecurringRuleRepositoryMockReassignCategory {
+	if n == 0 {
+		mmReassignCategory.mock.t.Fatalf("Times of RecurringRuleRepositoryMock.ReassignCategory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReassignCategory.expectedInvocations, n)
+	mmReassignCategory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReassignCategory
+}
+
+func (mmReassignCategory *mRecurringRuleRepositoryMockReassignCategory) invocationsDone() bool {
+	if len(mmReassignCategory.expectations) == 0 && mmReassignCategory.defaultExpectation == nil && mmReassignCategory.mock.funcReassignCategory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReassignCategory.mock.afterReassignCategoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReassignCategory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReassignCategory implements mm_usecase.RecurringRuleRepository
+func (mmReassignCategory *RecurringRuleRepositoryMock) ReassignCategory(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) (err error) {
+	mm_atomic.AddUint64(&mmReassignCategory.beforeReassignCategoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmReassignCategory.afterReassignCategoryCounter, 1)
+
+	mmReassignCategory.t.Helper()
+
+	if mmReassignCategory.inspectFuncReassignCategory != nil {
+		mmReassignCategory.inspectFuncReassignCategory(ctx, fromCategoryID, toCategoryID)
+	}
+
+	mm_params := RecurringRuleRepositoryMockReassignCategoryParams{ctx, fromCategoryID, toCategoryID}
+
+	// Record call args
+	mmReassignCategory.ReassignCategoryMock.mutex.Lock()
+	mmReassignCategory.ReassignCategoryMock.callArgs = append(mmReassignCategory.ReassignCategoryMock.callArgs, &mm_params)
+	mmReassignCategory.ReassignCategoryMock.mutex.Unlock()
+
+	for _, e := range mmReassignCategory.ReassignCategoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReassignCategory.ReassignCategoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReassignCategory.ReassignCategoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmReassignCategory.ReassignCategoryMock.defaultExpectation.params
+		mm_want_ptrs := mmReassignCategory.ReassignCategoryMock.defaultExpectation.paramPtrs
+
+		mm_got := RecurringRuleRepositoryMockReassignCategoryParams{ctx, fromCategoryID, toCategoryID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReassignCategory.t.Errorf("RecurringRuleRepositoryMock.ReassignCategory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReassignCategory.ReassignCategoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fromCategoryID != nil && !minimock.Equal(*mm_want_ptrs.fromCategoryID, mm_got.fromCategoryID) {
+				mmReassignCategory.t.Errorf("RecurringRuleRepositoryMock.ReassignCategory got unexpected parameter fromCategoryID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReassignCategory.ReassignCategoryMock.defaultExpectation.expectationOrigins.originFromCategoryID, *mm_want_ptrs.fromCategoryID, mm_got.fromCategoryID, minimock.Diff(*mm_want_ptrs.fromCategoryID, mm_got.fromCategoryID))
+			}
+
+			if mm_want_ptrs.toCategoryID != nil && !minimock.Equal(*mm_want_ptrs.toCategoryID, mm_got.toCategoryID) {
+				mmReassignCategory.t.Errorf("RecurringRuleRepositoryMock.ReassignCategory got unexpected parameter toCategoryID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReassignCategory.ReassignCategoryMock.defaultExpectation.expectationOrigins.originToCategoryID, *mm_want_ptrs.toCategoryID, mm_got.toCategoryID, minimock.Diff(*mm_want_ptrs.toCategoryID, mm_got.toCategoryID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReassignCategory.t.Errorf("RecurringRuleRepositoryMock.ReassignCategory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReassignCategory.ReassignCategoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReassignCategory.ReassignCategoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReassignCategory.t.Fatal("No results are set for the RecurringRuleRepositoryMock.ReassignCategory")
+		}
+		return (*mm_results).err
+	}
+	if mmReassignCategory.funcReassignCategory != nil {
+		return mmReassignCategory.funcReassignCategory(ctx, fromCategoryID, toCategoryID)
+	}
+	mmReassignCategory.t.Fatalf("Unexpected call to RecurringRuleRepositoryMock.ReassignCategory. %v %v %v", ctx, fromCategoryID, toCategoryID)
+	return
+}
+
+// ReassignCategoryAfterCounter returns a count of finished RecurringRuleRepositoryMock.ReassignCategory invocations
+func (mmReassignCategory *RecurringRuleRepositoryMock) ReassignCategoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReassignCategory.afterReassignCategoryCounter)
+}
+
+// ReassignCategoryBeforeCounter returns a count of RecurringRuleRepositoryMock.ReassignCategory invocations
+func (mmReassignCategory *RecurringRuleRepositoryMock) ReassignCategoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReassignCategory.beforeReassignCategoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to RecurringRuleRepositoryMock.ReassignCategory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReassignCategory *mRecurringRuleRepositoryMockReassignCategory) Calls() []*RecurringRuleRepositoryMockReassignCategoryParams {
+	mmReassignCategory.mutex.RLock()
+
+	argCopy := make([]*RecurringRuleRepositoryMockReassignCategoryParams, len(mmReassignCategory.callArgs))
+	copy(argCopy, mmReassignCategory.callArgs)
+
+	mmReassignCategory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReassignCategoryDone returns true if the count of the ReassignCategory invocations corresponds
+// the number of defined expectations
+func (m *RecurringRuleRepositoryMock) MinimockReassignCategoryDone() bool {
+	if m.ReassignCategoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReassignCategoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReassignCategoryMock.invocationsDone()
+}
+
+// MinimockReassignCategoryInspect logs each unmet expectation
+func (m *RecurringRuleRepositoryMock) MinimockReassignCategoryInspect() {
+	for _, e := range m.ReassignCategoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RecurringRuleRepositoryMock.ReassignCategory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReassignCategoryCounter := mm_atomic.LoadUint64(&m.afterReassignCategoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReassignCategoryMock.defaultExpectation != nil && afterReassignCategoryCounter < 1 {
+		if m.ReassignCategoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RecurringRuleRepositoryMock.ReassignCategory at\n%s", m.ReassignCategoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RecurringRuleRepositoryMock.ReassignCategory at\n%s with params: %#v", m.ReassignCategoryMock.defaultExpectation.expectationOrigins.origin, *m.ReassignCategoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReassignCategory != nil && afterReassignCategoryCounter < 1 {
+		m.t.Errorf("Expected call to RecurringRuleRepositoryMock.ReassignCategory at\n%s", m.funcReassignCategoryOrigin)
+	}
+
+	if !m.ReassignCategoryMock.invocationsDone() && afterReassignCategoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to RecurringRuleRepositoryMock.ReassignCategory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReassignCategoryMock.expectedInvocations), m.ReassignCategoryMock.expectedInvocationsOrigin, afterReassignCategoryCounter)
+	}
+}
+
+type mRecurringRuleRepositoryMockUpdate struct {
+	optional           bool
+	mock               *RecurringRuleRepositoryMock
+	defaultExpectation *RecurringRuleRepositoryMockUpdateExpectation
+	expectations       []*RecurringRuleRepositoryMockUpdateExpectation
+
+	callArgs []*RecurringRuleRepositoryMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RecurringRuleRepositoryMockUpdateExpectation specifies expectation struct of the RecurringRuleRepository.Update
+type RecurringRuleRepositoryMockUpdateExpectation struct {
+	mock               *RecurringRuleRepositoryMock
+	params             *RecurringRuleRepositoryMockUpdateParams
+	paramPtrs          *RecurringRuleRepositoryMockUpdateParamPtrs
+	expectationOrigins RecurringRuleRepositoryMockUpdateExpectationOrigins
+	results            *RecurringRuleRepositoryMockUpdateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RecurringRuleRepositoryMockUpdateParams contains parameters of the RecurringRuleRepository.Update
+type RecurringRuleRepositoryMockUpdateParams struct {
+	ctx  context.Context
+	item *entity.RecurringRule
+}
+
+// RecurringRuleRepositoryMockUpdateParamPtrs contains pointers to parameters of the RecurringRuleRepository.Update
+type RecurringRuleRepositoryMockUpdateParamPtrs struct {
+	ctx  *context.Context
+	item **entity.RecurringRule
+}
+
+// RecurringRuleRepositoryMockUpdateResults contains results of the RecurringRuleRepository.Update
+type RecurringRuleRepositoryMockUpdateResults struct {
+	err error
+}
+
+// RecurringRuleRepositoryMockUpdateOrigins contains origins of expectations of the RecurringRuleRepository.Update
+type RecurringRuleRepositoryMockUpdateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originItem string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mRecurringRuleRepositoryMockUpdate) Optional() *mRecurringRuleRepositoryMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for RecurringRuleRepository.Update
+func (mmUpdate *mRecurringRuleRepositoryMockUpdate) Expect(ctx context.Context, item *entity.RecurringRule) *mRecurringRuleRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RecurringRuleRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RecurringRuleRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("RecurringRuleRepositoryMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &RecurringRuleRepositoryMockUpdateParams{ctx, item}
+	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RecurringRuleRepository.Update
+func (mmUpdate *mRecurringRuleRepositoryMockUpdate) ExpectCtxParam1(ctx context.Context) *mRecurringRuleRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RecurringRuleRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RecurringRuleRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("RecurringRuleRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &RecurringRuleRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectItemParam2 sets up expected param item for RecurringRuleRepository.Update
+func (mmUpdate *mRecurringRuleRepositoryMockUpdate) ExpectItemParam2(item *entity.RecurringRule) *mRecurringRuleRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RecurringRuleRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RecurringRuleRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("RecurringRuleRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &RecurringRuleRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.item = &item
+	mmUpdate.defaultExpectation.expectationOrigins.originItem = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the RecurringRuleRepository.Update
+func (mmUpdate *mRecurringRuleRepositoryMockUpdate) Inspect(f func(ctx context.Context, item *entity.RecurringRule)) *mRecurringRuleRepositoryMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for RecurringRuleRepositoryMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by RecurringRuleRepository.Update
+func (mmUpdate *mRecurringRuleRepositoryMockUpdate) Return(err error) *RecurringRuleRepositoryMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RecurringRuleRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &RecurringRuleRepositoryMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &RecurringRuleRepositoryMockUpdateResults{err}
+	mmUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the RecurringRuleRepository.Update method
+func (mmUpdate *mRecurringRuleRepositoryMockUpdate) Set(f func(ctx context.Context, item *entity.RecurringRule) (err error)) *RecurringRuleRepositoryMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the RecurringRuleRepository.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the RecurringRuleRepository.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	mmUpdate.mock.funcUpdateOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// When sets expectation for the RecurringRuleRepository.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mRecurringRuleRepositoryMockUpdate) When(ctx context.Context, item *entity.RecurringRule) *RecurringRuleRepositoryMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("RecurringRuleRepositoryMock.Update mock is already set by Set")
+	}
+
+	expectation := &RecurringRuleRepositoryMockUpdateExpectation{
+		mock:               mmUpdate.mock,
+		params:             &RecurringRuleRepositoryMockUpdateParams{ctx, item},
+		expectationOrigins: RecurringRuleRepositoryMockUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RecurringRuleRepository.Update return parameters for the expectation previously defined by the When method
+func (e *RecurringRuleRepositoryMockUpdateExpectation) Then(err error) *RecurringRuleRepositoryMock {
+	e.results = &RecurringRuleRepositoryMockUpdateResults{err}
+	return e.mock
+}
+
+// Times sets number of times RecurringRuleRepository.Update should be invoked
+func (mmUpdate *mRecurringRuleRepositoryMockUpdate) Times(n uint64) *mRecurringRuleRepositoryMockUpdate {
+	if n == 0 {
+		mmUpdate.mock.t.Fatalf("Times of RecurringRuleRepositoryMock.Update mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	mmUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdate
+}
+
+func (mmUpdate *mRecurringRuleRepositoryMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Update implements mm_usecase.RecurringRuleRepository
+func (mmUpdate *RecurringRuleRepositoryMock) Update(ctx context.Context, item *entity.RecurringRule) (err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	mmUpdate.t.Helper()
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, item)
+	}
+
+	mm_params := RecurringRuleRepositoryMockUpdateParams{ctx, item}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := RecurringRuleRepositoryMockUpdateParams{ctx, item}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("RecurringRuleRepositoryMock.Update got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
+				mmUpdate.t.Errorf("RecurringRuleRepositoryMock.Update got unexpected parameter item, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originItem, *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("RecurringRuleRepositoryMock.Update got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the RecurringRuleRepositoryMock.Update")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, item)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to RecurringRuleRepositoryMock.Update. %v %v", ctx, item)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished RecurringRuleRepositoryMock.Update invocations
+func (mmUpdate *RecurringRuleRepositoryMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of RecurringRuleRepositoryMock.Update invocations
+func (mmUpdate *RecurringRuleRepositoryMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to RecurringRuleRepositoryMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mRecurringRuleRepositoryMockUpdate) Calls() []*RecurringRuleRepositoryMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*RecurringRuleRepositoryMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *RecurringRuleRepositoryMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMock.invocationsDone()
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *RecurringRuleRepositoryMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RecurringRuleRepositoryMock.Update at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RecurringRuleRepositoryMock.Update at\n%s", m.UpdateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RecurringRuleRepositoryMock.Update at\n%s with params: %#v", m.UpdateMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Errorf("Expected call to RecurringRuleRepositoryMock.Update at\n%s", m.funcUpdateOrigin)
+	}
+
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to RecurringRuleRepositoryMock.Update at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), m.UpdateMock.expectedInvocationsOrigin, afterUpdateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RecurringRuleRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockFindListInspect()
+
+			m.MinimockFindOneByIDInspect()
+
+			m.MinimockReassignCategoryInspect()
+
+			m.MinimockUpdateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RecurringRuleRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RecurringRuleRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockFindListDone() &&
+		m.MinimockFindOneByIDDone() &&
+		m.MinimockReassignCategoryDone() &&
+		m.MinimockUpdateDone()
+}
@@ -0,0 +1,2354 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.RecurringRuleUsecase -o recurring_rule_usecase.go -n RecurringRuleUsecaseMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"cloud.google.com/go/civil"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	mm_usecase "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// RecurringRuleUsecaseMock implements mm_usecase.RecurringRuleUsecase
+type RecurringRuleUsecaseMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateRecurringRuleByDTO          func(ctx context.Context, in mm_usecase.CreateRecurringRuleDataInput) (resRecurringRuleDTO *mm_usecase.RecurringRuleDTO, err error)
+	funcCreateRecurringRuleByDTOOrigin    string
+	inspectFuncCreateRecurringRuleByDTO   func(ctx context.Context, in mm_usecase.CreateRecurringRuleDataInput)
+	afterCreateRecurringRuleByDTOCounter  uint64
+	beforeCreateRecurringRuleByDTOCounter uint64
+	CreateRecurringRuleByDTOMock          mRecurringRuleUsecaseMockCreateRecurringRuleByDTO
+
+	funcDeleteRecurringRuleByID          func(ctx context.Context, id uuid.UUID) (err error)
+	funcDeleteRecurringRuleByIDOrigin    string
+	inspectFuncDeleteRecurringRuleByID   func(ctx context.Context, id uuid.UUID)
+	afterDeleteRecurringRuleByIDCounter  uint64
+	beforeDeleteRecurringRuleByIDCounter uint64
+	DeleteRecurringRuleByIDMock          mRecurringRuleUsecaseMockDeleteRecurringRuleByID
+
+	funcFindList          func(ctx context.Context, listOptions *mm_usecase.RecurringRuleListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.RecurringRuleDTO, err error)
+	funcFindListOrigin    string
+	inspectFuncFindList   func(ctx context.Context, listOptions *mm_usecase.RecurringRuleListOptions, queryParams *uctypes.QueryGetListParams)
+	afterFindListCounter  uint64
+	beforeFindListCounter uint64
+	FindListMock          mRecurringRuleUsecaseMockFindList
+
+	funcFindOneByID          func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.RecurringRuleDTO, err error)
+	funcFindOneByIDOrigin    string
+	inspectFuncFindOneByID   func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)
+	afterFindOneByIDCounter  uint64
+	beforeFindOneByIDCounter uint64
+	FindOneByIDMock          mRecurringRuleUsecaseMockFindOneByID
+
+	funcMaterializeDueRules          func(ctx context.Context, today civil.Date, limit uint64) (processed int, err error)
+	funcMaterializeDueRulesOrigin    string
+	inspectFuncMaterializeDueRules   func(ctx context.Context, today civil.Date, limit uint64)
+	afterMaterializeDueRulesCounter  uint64
+	beforeMaterializeDueRulesCounter uint64
+	MaterializeDueRulesMock          mRecurringRuleUsecaseMockMaterializeDueRules
+
+	funcPatchRecurringRuleByDTO          func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchRecurringRuleDataInput, skipVersionCheck bool) (err error)
+	funcPatchRecurringRuleByDTOOrigin    string
+	inspectFuncPatchRecurringRuleByDTO   func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchRecurringRuleDataInput, skipVersionCheck bool)
+	afterPatchRecurringRuleByDTOCounter  uint64
+	beforePatchRecurringRuleByDTOCounter uint64
+	PatchRecurringRuleByDTOMock          mRecurringRuleUsecaseMockPatchRecurringRuleByDTO
+}
+
+// NewRecurringRuleUsecaseMock returns a mock for mm_usecase.RecurringRuleUsecase
+func NewRecurringRuleUsecaseMock(t minimock.Tester) *RecurringRuleUsecaseMock {
+	m := &RecurringRuleUsecaseMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateRecurringRuleByDTOMock = mRecurringRuleUsecaseMockCreateRecurringRuleByDTO{mock: m}
+	m.CreateRecurringRuleByDTOMock.callArgs = []*RecurringRuleUsecaseMockCreateRecurringRuleByDTOParams{}
+
+	m.DeleteRecurringRuleByIDMock = mRecurringRuleUsecaseMockDeleteRecurringRuleByID{mock: m}
+	m.DeleteRecurringRuleByIDMock.callArgs = []*RecurringRuleUsecaseMockDeleteRecurringRuleByIDParams{}
+
+	m.FindListMock = mRecurringRuleUsecaseMockFindList{mock: m}
+	m.FindListMock.callArgs = []*RecurringRuleUsecaseMockFindListParams{}
+
+	m.FindOneByIDMock = mRecurringRuleUsecaseMockFindOneByID{mock: m}
+	m.FindOneByIDMock.callArgs = []*RecurringRuleUsecaseMockFindOneByIDParams{}
+
+	m.MaterializeDueRulesMock = mRecurringRuleUsecaseMockMaterializeDueRules{mock: m}
+	m.MaterializeDueRulesMock.callArgs = []*RecurringRuleUsecaseMockMaterializeDueRulesParams{}
+
+	m.PatchRecurringRuleByDTOMock = mRecurringRuleUsecaseMockPatchRecurringRuleByDTO{mock: m}
+	m.PatchRecurringRuleByDTOMock.callArgs = []*RecurringRuleUsecaseMockPatchRecurringRuleByDTOParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRecurringRuleUsecaseMockCreateRecurringRuleByDTO struct {
+	optional           bool
+	mock               *RecurringRuleUsecaseMock
+	defaultExpectation *RecurringRuleUsecaseMockCreateRecurringRuleByDTOExpectation
+	expectations       []*RecurringRuleUsecaseMockCreateRecurringRuleByDTOExpectation
+
+	callArgs []*RecurringRuleUsecaseMockCreateRecurringRuleByDTOParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RecurringRuleUsecaseMockCreateRecurringRuleByDTOExpectation specifies expectation struct of the RecurringRuleUsecase.CreateRecurringRuleByDTO
+type RecurringRuleUsecaseMockCreateRecurringRuleByDTOExpectation struct {
+	mock               *RecurringRuleUsecaseMock
+	params             *RecurringRuleUsecaseMockCreateRecurringRuleByDTOParams
+	paramPtrs          *RecurringRuleUsecaseMockCreateRecurringRuleByDTOParamPtrs
+	expectationOrigins RecurringRuleUsecaseMockCreateRecurringRuleByDTOExpectationOrigins
+	results            *RecurringRuleUsecaseMockCreateRecurringRuleByDTOResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RecurringRuleUsecaseMockCreateRecurringRuleByDTOParams contains parameters of the RecurringRuleUsecase.CreateRecurringRuleByDTO
+type RecurringRuleUsecaseMockCreateRecurringRuleByDTOParams struct {
+	ctx context.Context
+	in  mm_usecase.CreateRecurringRuleDataInput
+}
+
+// RecurringRuleUsecaseMockCreateRecurringRuleByDTOParamPtrs contains pointers to parameters of the RecurringRuleUsecase.CreateRecurringRuleByDTO
+type RecurringRuleUsecaseMockCreateRecurringRuleByDTOParamPtrs struct {
+	ctx *context.Context
+	in  *mm_usecase.CreateRecurringRuleDataInput
+}
+
+// RecurringRuleUsecaseMockCreateRecurringRuleByDTOResults contains results of the RecurringRuleUsecase.CreateRecurringRuleByDTO
+type RecurringRuleUsecaseMockCreateRecurringRuleByDTOResults struct {
+	resRecurringRuleDTO *mm_usecase.RecurringRuleDTO
+	err                 error
+}
+
+// RecurringRuleUsecaseMockCreateRecurringRuleByDTOOrigins contains origins of expectations of the RecurringRuleUsecase.CreateRecurringRuleByDTO
+type RecurringRuleUsecaseMockCreateRecurringRuleByDTOExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateRecurringRuleByDTO *mRecurringRuleUsecaseMockCreateRecurringRuleByDTO) Optional() *mRecurringRuleUsecaseMockCreateRecurringRuleByDTO {
+	mmCreateRecurringRuleByDTO.optional = true
+	return mmCreateRecurringRuleByDTO
+}
+
+// Expect sets up expected params for RecurringRuleUsecase.CreateRecurringRuleByDTO
+func (mmCreateRecurringRuleByDTO *mRecurringRuleUsecaseMockCreateRecurringRuleByDTO) Expect(ctx context.Context, in mm_usecase.CreateRecurringRuleDataInput) *mRecurringRuleUsecaseMockCreateRecurringRuleByDTO {
+	if mmCreateRecurringRuleByDTO.mock.funcCreateRecurringRuleByDTO != nil {
+		mmCreateRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.CreateRecurringRuleByDTO mock is already set by Set")
+	}
+
+	if mmCreateRecurringRuleByDTO.defaultExpectation == nil {
+		mmCreateRecurringRuleByDTO.defaultExpectation = &RecurringRuleUsecaseMockCreateRecurringRuleByDTOExpectation{}
+	}
+
+	if mmCreateRecurringRuleByDTO.defaultExpectation.paramPtrs != nil {
+		mmCreateRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.CreateRecurringRuleByDTO mock is already set by ExpectParams functions")
+	}
+
+	mmCreateRecurringRuleByDTO.defaultExpectation.params = &RecurringRuleUsecaseMockCreateRecurringRuleByDTOParams{ctx, in}
+	mmCreateRecurringRuleByDTO.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateRecurringRuleByDTO.expectations {
+		if minimock.Equal(e.params, mmCreateRecurringRuleByDTO.defaultExpectation.params) {
+			mmCreateRecurringRuleByDTO.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRecurringRuleByDTO.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateRecurringRuleByDTO
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RecurringRuleUsecase.CreateRecurringRuleByDTO
+func (mmCreateRecurringRuleByDTO *mRecurringRuleUsecaseMockCreateRecurringRuleByDTO) ExpectCtxParam1(ctx context.Context) *mRecurringRuleUsecaseMockCreateRecurringRuleByDTO {
+	if mmCreateRecurringRuleByDTO.mock.funcCreateRecurringRuleByDTO != nil {
+		mmCreateRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.CreateRecurringRuleByDTO mock is already set by Set")
+	}
+
+	if mmCreateRecurringRuleByDTO.defaultExpectation == nil {
+		mmCreateRecurringRuleByDTO.defaultExpectation = &RecurringRuleUsecaseMockCreateRecurringRuleByDTOExpectation{}
+	}
+
+	if mmCreateRecurringRuleByDTO.defaultExpectation.params != nil {
+		mmCreateRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.CreateRecurringRuleByDTO mock is already set by Expect")
+	}
+
+	if mmCreateRecurringRuleByDTO.defaultExpectation.paramPtrs == nil {
+		mmCreateRecurringRuleByDTO.defaultExpectation.paramPtrs = &RecurringRuleUsecaseMockCreateRecurringRuleByDTOParamPtrs{}
+	}
+	mmCreateRecurringRuleByDTO.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateRecurringRuleByDTO.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateRecurringRuleByDTO
+}
+
+// ExpectInParam2 sets up expected param in for RecurringRuleUsecase.CreateRecurringRuleByDTO
+func (mmCreateRecurringRuleByDTO *mRecurringRuleUsecaseMockCreateRecurringRuleByDTO) ExpectInParam2(in mm_usecase.CreateRecurringRuleDataInput) *mRecurringRuleUsecaseMockCreateRecurringRuleByDTO {
+	if mmCreateRecurringRuleByDTO.mock.funcCreateRecurringRuleByDTO != nil {
+		mmCreateRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.CreateRecurringRuleByDTO mock is already set by Set")
+	}
+
+	if mmCreateRecurringRuleByDTO.defaultExpectation == nil {
+		mmCreateRecurringRuleByDTO.defaultExpectation = &RecurringRuleUsecaseMockCreateRecurringRuleByDTOExpectation{}
+	}
+
+	if mmCreateRecurringRuleByDTO.defaultExpectation.params != nil {
+		mmCreateRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.CreateRecurringRuleByDTO mock is already set by Expect")
+	}
+
+	if mmCreateRecurringRuleByDTO.defaultExpectation.paramPtrs == nil {
+		mmCreateRecurringRuleByDTO.defaultExpectation.paramPtrs = &RecurringRuleUsecaseMockCreateRecurringRuleByDTOParamPtrs{}
+	}
+	mmCreateRecurringRuleByDTO.defaultExpectation.paramPtrs.in = &in
+	mmCreateRecurringRuleByDTO.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmCreateRecurringRuleByDTO
+}
+
+// Inspect accepts an inspector function that has same arguments as the RecurringRuleUsecase.CreateRecurringRuleByDTO
+func (mmCreateRecurringRuleByDTO *mRecurringRuleUsecaseMockCreateRecurringRuleByDTO) Inspect(f func(ctx context.Context, in mm_usecase.CreateRecurringRuleDataInput)) *mRecurringRuleUsecaseMockCreateRecurringRuleByDTO {
+	if mmCreateRecurringRuleByDTO.mock.inspectFuncCreateRecurringRuleByDTO != nil {
+		mmCreateRecurringRuleByDTO.mock.t.Fatalf("Inspect function is already set for RecurringRuleUsecaseMock.CreateRecurringRuleByDTO")
+	}
+
+	mmCreateRecurringRuleByDTO.mock.inspectFuncCreateRecurringRuleByDTO = f
+
+	return mmCreateRecurringRuleByDTO
+}
+
+// Return sets up results that will be returned by RecurringRuleUsecase.CreateRecurringRuleByDTO
+func (mmCreateRecurringRuleByDTO *mRecurringRuleUsecaseMockCreateRecurringRuleByDTO) Return(resRecurringRuleDTO *mm_usecase.RecurringRuleDTO, err error) *RecurringRuleUsecaseMock {
+	if mmCreateRecurringRuleByDTO.mock.funcCreateRecurringRuleByDTO != nil {
+		mmCreateRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.CreateRecurringRuleByDTO mock is already set by Set")
+	}
+
+	if mmCreateRecurringRuleByDTO.defaultExpectation == nil {
+		mmCreateRecurringRuleByDTO.defaultExpectation = &RecurringRuleUsecaseMockCreateRecurringRuleByDTOExpectation{mock: mmCreateRecurringRuleByDTO.mock}
+	}
+	mmCreateRecurringRuleByDTO.defaultExpectation.results = &RecurringRuleUsecaseMockCreateRecurringRuleByDTOResults{resRecurringRuleDTO, err}
+	mmCreateRecurringRuleByDTO.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateRecurringRuleByDTO.mock
+}
+
+// Set uses given function f to mock the RecurringRuleUsecase.CreateRecurringRuleByDTO method
+func (mmCreateRecurringRuleByDTO *mRecurringRuleUsecaseMockCreateRecurringRuleByDTO) Set(f func(ctx context.Context, in mm_usecase.CreateRecurringRuleDataInput) (resRecurringRuleDTO *mm_usecase.RecurringRuleDTO, err error)) *RecurringRuleUsecaseMock {
+	if mmCreateRecurringRuleByDTO.defaultExpectation != nil {
+		mmCreateRecurringRuleByDTO.mock.t.Fatalf("Default expectation is already set for the RecurringRuleUsecase.CreateRecurringRuleByDTO method")
+	}
+
+	if len(mmCreateRecurringRuleByDTO.expectations) > 0 {
+		mmCreateRecurringRuleByDTO.mock.t.Fatalf("Some expectations are already set for the RecurringRuleUsecase.CreateRecurringRuleByDTO method")
+	}
+
+	mmCreateRecurringRuleByDTO.mock.funcCreateRecurringRuleByDTO = f
+	mmCreateRecurringRuleByDTO.mock.funcCreateRecurringRuleByDTOOrigin = minimock.CallerInfo(1)
+	return mmCreateRecurringRuleByDTO.mock
+}
+
+// When sets expectation for the RecurringRuleUsecase.CreateRecurringRuleByDTO which will trigger the result defined by the following
+// Then helper
+func (mmCreateRecurringRuleByDTO *mRecurringRuleUsecaseMockCreateRecurringRuleByDTO) When(ctx context.Context, in mm_usecase.CreateRecurringRuleDataInput) *RecurringRuleUsecaseMockCreateRecurringRuleByDTOExpectation {
+	if mmCreateRecurringRuleByDTO.mock.funcCreateRecurringRuleByDTO != nil {
+		mmCreateRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.CreateRecurringRuleByDTO mock is already set by Set")
+	}
+
+	expectation := &RecurringRuleUsecaseMockCreateRecurringRuleByDTOExpectation{
+		mock:               mmCreateRecurringRuleByDTO.mock,
+		params:             &RecurringRuleUsecaseMockCreateRecurringRuleByDTOParams{ctx, in},
+		expectationOrigins: RecurringRuleUsecaseMockCreateRecurringRuleByDTOExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateRecurringRuleByDTO.expectations = append(mmCreateRecurringRuleByDTO.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RecurringRuleUsecase.CreateRecurringRuleByDTO return parameters for the expectation previously defined by the When method
+func (e *RecurringRuleUsecaseMockCreateRecurringRuleByDTOExpectation) Then(resRecurringRuleDTO *mm_usecase.RecurringRuleDTO, err error) *RecurringRuleUsecaseMock {
+	e.results = &RecurringRuleUsecaseMockCreateRecurringRuleByDTOResults{resRecurringRuleDTO, err}
+	return e.mock
+}
+
+// Times sets number of times RecurringRuleUsecase.CreateRecurringRuleByDTO should be invoked
+func (mmCreateRecurringRuleByDTO *mRecurringRuleUsecaseMockCreateRecurringRuleByDTO) Times(n uint64) *mRecurringRuleUsecaseMockCreateRecurringRuleByDTO {
+	if n == 0 {
+		mmCreateRecurringRuleByDTO.mock.t.Fatalf("Times of RecurringRuleUsecaseMock.CreateRecurringRuleByDTO mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateRecurringRuleByDTO.expectedInvocations, n)
+	mmCreateRecurringRuleByDTO.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateRecurringRuleByDTO
+}
+
+func (mmCreateRecurringRuleByDTO *mRecurringRuleUsecaseMockCreateRecurringRuleByDTO) invocationsDone() bool {
+	if len(mmCreateRecurringRuleByDTO.expectations) == 0 && mmCreateRecurringRuleByDTO.defaultExpectation == nil && mmCreateRecurringRuleByDTO.mock.funcCreateRecurringRuleByDTO == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateRecurringRuleByDTO.mock.afterCreateRecurringRuleByDTOCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateRecurringRuleByDTO.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateRecurringRuleByDTO implements mm_usecase.RecurringRuleUsecase
+func (mmCreateRecurringRuleByDTO *RecurringRuleUsecaseMock) CreateRecurringRuleByDTO(ctx context.Context, in mm_usecase.CreateRecurringRuleDataInput) (resRecurringRuleDTO *mm_usecase.RecurringRuleDTO, err error) {
+	mm_atomic.AddUint64(&mmCreateRecurringRuleByDTO.beforeCreateRecurringRuleByDTOCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateRecurringRuleByDTO.afterCreateRecurringRuleByDTOCounter, 1)
+
+	mmCreateRecurringRuleByDTO.t.Helper()
+
+	if mmCreateRecurringRuleByDTO.inspectFuncCreateRecurringRuleByDTO != nil {
+		mmCreateRecurringRuleByDTO.inspectFuncCreateRecurringRuleByDTO(ctx, in)
+	}
+
+	mm_params := RecurringRuleUsecaseMockCreateRecurringRuleByDTOParams{ctx, in}
+
+	// Record call args
+	mmCreateRecurringRuleByDTO.CreateRecurringRuleByDTOMock.mutex.Lock()
+	mmCreateRecurringRuleByDTO.CreateRecurringRuleByDTOMock.callArgs = append(mmCreateRecurringRuleByDTO.CreateRecurringRuleByDTOMock.callArgs, &mm_params)
+	mmCreateRecurringRuleByDTO.CreateRecurringRuleByDTOMock.mutex.Unlock()
+
+	for _, e := range mmCreateRecurringRuleByDTO.CreateRecurringRuleByDTOMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resRecurringRuleDTO, e.results.err
+		}
+	}
+
+	if mmCreateRecurringRuleByDTO.CreateRecurringRuleByDTOMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateRecurringRuleByDTO.CreateRecurringRuleByDTOMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateRecurringRuleByDTO.CreateRecurringRuleByDTOMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateRecurringRuleByDTO.CreateRecurringRuleByDTOMock.defaultExpectation.paramPtrs
+
+		mm_got := RecurringRuleUsecaseMockCreateRecurringRuleByDTOParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateRecurringRuleByDTO.t.Errorf("RecurringRuleUsecaseMock.CreateRecurringRuleByDTO got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRecurringRuleByDTO.CreateRecurringRuleByDTOMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmCreateRecurringRuleByDTO.t.Errorf("RecurringRuleUsecaseMock.CreateRecurringRuleByDTO got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRecurringRuleByDTO.CreateRecurringRuleByDTOMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateRecurringRuleByDTO.t.Errorf("RecurringRuleUsecaseMock.CreateRecurringRuleByDTO got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateRecurringRuleByDTO.CreateRecurringRuleByDTOMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateRecurringRuleByDTO.CreateRecurringRuleByDTOMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateRecurringRuleByDTO.t.Fatal("No results are set for the RecurringRuleUsecaseMock.CreateRecurringRuleByDTO")
+		}
+		return (*mm_results).resRecurringRuleDTO, (*mm_results).err
+	}
+	if mmCreateRecurringRuleByDTO.funcCreateRecurringRuleByDTO != nil {
+		return mmCreateRecurringRuleByDTO.funcCreateRecurringRuleByDTO(ctx, in)
+	}
+	mmCreateRecurringRuleByDTO.t.Fatalf("Unexpected call to RecurringRuleUsecaseMock.CreateRecurringRuleByDTO. %v %v", ctx, in)
+	return
+}
+
+// CreateRecurringRuleByDTOAfterCounter returns a count of finished RecurringRuleUsecaseMock.CreateRecurringRuleByDTO invocations
+func (mmCreateRecurringRuleByDTO *RecurringRuleUsecaseMock) CreateRecurringRuleByDTOAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRecurringRuleByDTO.afterCreateRecurringRuleByDTOCounter)
+}
+
+// CreateRecurringRuleByDTOBeforeCounter returns a count of RecurringRuleUsecaseMock.CreateRecurringRuleByDTO invocations
+func (mmCreateRecurringRuleByDTO *RecurringRuleUsecaseMock) CreateRecurringRuleByDTOBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRecurringRuleByDTO.beforeCreateRecurringRuleByDTOCounter)
+}
+
+// Calls returns a list of arguments used in each call to RecurringRuleUsecaseMock.CreateRecurringRuleByDTO.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateRecurringRuleByDTO *mRecurringRuleUsecaseMockCreateRecurringRuleByDTO) Calls() []*RecurringRuleUsecaseMockCreateRecurringRuleByDTOParams {
+	mmCreateRecurringRuleByDTO.mutex.RLock()
+
+	argCopy := make([]*RecurringRuleUsecaseMockCreateRecurringRuleByDTOParams, len(mmCreateRecurringRuleByDTO.callArgs))
+	copy(argCopy, mmCreateRecurringRuleByDTO.callArgs)
+
+	mmCreateRecurringRuleByDTO.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateRecurringRuleByDTODone returns true if the count of the CreateRecurringRuleByDTO invocations corresponds
+// the number of defined expectations
+func (m *RecurringRuleUsecaseMock) MinimockCreateRecurringRuleByDTODone() bool {
+	if m.CreateRecurringRuleByDTOMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateRecurringRuleByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateRecurringRuleByDTOMock.invocationsDone()
+}
+
+// MinimockCreateRecurringRuleByDTOInspect logs each unmet expectation
+func (m *RecurringRuleUsecaseMock) MinimockCreateRecurringRuleByDTOInspect() {
+	for _, e := range m.CreateRecurringRuleByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RecurringRuleUsecaseMock.CreateRecurringRuleByDTO at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateRecurringRuleByDTOCounter := mm_atomic.LoadUint64(&m.afterCreateRecurringRuleByDTOCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRecurringRuleByDTOMock.defaultExpectation != nil && afterCreateRecurringRuleByDTOCounter < 1 {
+		if m.CreateRecurringRuleByDTOMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RecurringRuleUsecaseMock.CreateRecurringRuleByDTO at\n%s", m.CreateRecurringRuleByDTOMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RecurringRuleUsecaseMock.CreateRecurringRuleByDTO at\n%s with params: %#v", m.CreateRecurringRuleByDTOMock.defaultExpectation.expectationOrigins.origin, *m.CreateRecurringRuleByDTOMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRecurringRuleByDTO != nil && afterCreateRecurringRuleByDTOCounter < 1 {
+		m.t.Errorf("Expected call to RecurringRuleUsecaseMock.CreateRecurringRuleByDTO at\n%s", m.funcCreateRecurringRuleByDTOOrigin)
+	}
+
+	if !m.CreateRecurringRuleByDTOMock.invocationsDone() && afterCreateRecurringRuleByDTOCounter > 0 {
+		m.t.Errorf("Expected %d calls to RecurringRuleUsecaseMock.CreateRecurringRuleByDTO at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateRecurringRuleByDTOMock.expectedInvocations), m.CreateRecurringRuleByDTOMock.expectedInvocationsOrigin, afterCreateRecurringRuleByDTOCounter)
+	}
+}
+
+type mRecurringRuleUsecaseMockDeleteRecurringRuleByID struct {
+	optional           bool
+	mock               *RecurringRuleUsecaseMock
+	defaultExpectation *RecurringRuleUsecaseMockDeleteRecurringRuleByIDExpectation
+	expectations       []*RecurringRuleUsecaseMockDeleteRecurringRuleByIDExpectation
+
+	callArgs []*RecurringRuleUsecaseMockDeleteRecurringRuleByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RecurringRuleUsecaseMockDeleteRecurringRuleByIDExpectation specifies expectation struct of the RecurringRuleUsecase.DeleteRecurringRuleByID
+type RecurringRuleUsecaseMockDeleteRecurringRuleByIDExpectation struct {
+	mock               *RecurringRuleUsecaseMock
+	params             *RecurringRuleUsecaseMockDeleteRecurringRuleByIDParams
+	paramPtrs          *RecurringRuleUsecaseMockDeleteRecurringRuleByIDParamPtrs
+	expectationOrigins RecurringRuleUsecaseMockDeleteRecurringRuleByIDExpectationOrigins
+	results            *RecurringRuleUsecaseMockDeleteRecurringRuleByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RecurringRuleUsecaseMockDeleteRecurringRuleByIDParams contains parameters of the RecurringRuleUsecase.DeleteRecurringRuleByID
+type RecurringRuleUsecaseMockDeleteRecurringRuleByIDParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// RecurringRuleUsecaseMockDeleteRecurringRuleByIDParamPtrs contains pointers to parameters of the RecurringRuleUsecase.DeleteRecurringRuleByID
+type RecurringRuleUsecaseMockDeleteRecurringRuleByIDParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// RecurringRuleUsecaseMockDeleteRecurringRuleByIDResults contains results of the RecurringRuleUsecase.DeleteRecurringRuleByID
+type RecurringRuleUsecaseMockDeleteRecurringRuleByIDResults struct {
+	err error
+}
+
+// RecurringRuleUsecaseMockDeleteRecurringRuleByIDOrigins contains origins of expectations of the RecurringRuleUsecase.DeleteRecurringRuleByID
+type RecurringRuleUsecaseMockDeleteRecurringRuleByIDExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteRecurringRuleByID *mRecurringRuleUsecaseMockDeleteRecurringRuleByID) Optional() *mRecurringRuleUsecaseMockDeleteRecurringRuleByID {
+	mmDeleteRecurringRuleByID.optional = true
+	return mmDeleteRecurringRuleByID
+}
+
+// Expect sets up expected params for RecurringRuleUsecase.DeleteRecurringRuleByID
+func (mmDeleteRecurringRuleByID *mRecurringRuleUsecaseMockDeleteRecurringRuleByID) Expect(ctx context.Context, id uuid.UUID) *mRecurringRuleUsecaseMockDeleteRecurringRuleByID {
+	if mmDeleteRecurringRuleByID.mock.funcDeleteRecurringRuleByID != nil {
+		mmDeleteRecurringRuleByID.mock.t.Fatalf("RecurringRuleUsecaseMock.DeleteRecurringRuleByID mock is already set by Set")
+	}
+
+	if mmDeleteRecurringRuleByID.defaultExpectation == nil {
+		mmDeleteRecurringRuleByID.defaultExpectation = &RecurringRuleUsecaseMockDeleteRecurringRuleByIDExpectation{}
+	}
+
+	if mmDeleteRecurringRuleByID.defaultExpectation.paramPtrs != nil {
+		mmDeleteRecurringRuleByID.mock.t.Fatalf("RecurringRuleUsecaseMock.DeleteRecurringRuleByID mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteRecurringRuleByID.defaultExpectation.params = &RecurringRuleUsecaseMockDeleteRecurringRuleByIDParams{ctx, id}
+	mmDeleteRecurringRuleByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteRecurringRuleByID.expectations {
+		if minimock.Equal(e.params, mmDeleteRecurringRuleByID.defaultExpectation.params) {
+			mmDeleteRecurringRuleByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteRecurringRuleByID.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteRecurringRuleByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RecurringRuleUsecase.DeleteRecurringRuleByID
+func (mmDeleteRecurringRuleByID *mRecurringRuleUsecaseMockDeleteRecurringRuleByID) ExpectCtxParam1(ctx context.Context) *mRecurringRuleUsecaseMockDeleteRecurringRuleByID {
+	if mmDeleteRecurringRuleByID.mock.funcDeleteRecurringRuleByID != nil {
+		mmDeleteRecurringRuleByID.mock.t.Fatalf("RecurringRuleUsecaseMock.DeleteRecurringRuleByID mock is already set by Set")
+	}
+
+	if mmDeleteRecurringRuleByID.defaultExpectation == nil {
+		mmDeleteRecurringRuleByID.defaultExpectation = &RecurringRuleUsecaseMockDeleteRecurringRuleByIDExpectation{}
+	}
+
+	if mmDeleteRecurringRuleByID.defaultExpectation.params != nil {
+		mmDeleteRecurringRuleByID.mock.t.Fatalf("RecurringRuleUsecaseMock.DeleteRecurringRuleByID mock is already set by Expect")
+	}
+
+	if mmDeleteRecurringRuleByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteRecurringRuleByID.defaultExpectation.paramPtrs = &RecurringRuleUsecaseMockDeleteRecurringRuleByIDParamPtrs{}
+	}
+	mmDeleteRecurringRuleByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteRecurringRuleByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteRecurringRuleByID
+}
+
+// ExpectIdParam2 sets up expected param id for RecurringRuleUsecase.DeleteRecurringRuleByID
+func (mmDeleteRecurringRuleByID *mRecurringRuleUsecaseMockDeleteRecurringRuleByID) ExpectIdParam2(id uuid.UUID) *mRecurringRuleUsecaseMockDeleteRecurringRuleByID {
+	if mmDeleteRecurringRuleByID.mock.funcDeleteRecurringRuleByID != nil {
+		mmDeleteRecurringRuleByID.mock.t.Fatalf("RecurringRuleUsecaseMock.DeleteRecurringRuleByID mock is already set by Set")
+	}
+
+	if mmDeleteRecurringRuleByID.defaultExpectation == nil {
+		mmDeleteRecurringRuleByID.defaultExpectation = &RecurringRuleUsecaseMockDeleteRecurringRuleByIDExpectation{}
+	}
+
+	if mmDeleteRecurringRuleByID.defaultExpectation.params != nil {
+		mmDeleteRecurringRuleByID.mock.t.Fatalf("RecurringRuleUsecaseMock.DeleteRecurringRuleByID mock is already set by Expect")
+	}
+
+	if mmDeleteRecurringRuleByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteRecurringRuleByID.defaultExpectation.paramPtrs = &RecurringRuleUsecaseMockDeleteRecurringRuleByIDParamPtrs{}
+	}
+	mmDeleteRecurringRuleByID.defaultExpectation.paramPtrs.id = &id
+	mmDeleteRecurringRuleByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteRecurringRuleByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the RecurringRuleUsecase.DeleteRecurringRuleByID
+func (mmDeleteRecurringRuleByID *mRecurringRuleUsecaseMockDeleteRecurringRuleByID) Inspect(f func(ctx context.Context, id uuid.UUID)) *mRecurringRuleUsecaseMockDeleteRecurringRuleByID {
+	if mmDeleteRecurringRuleByID.mock.inspectFuncDeleteRecurringRuleByID != nil {
+		mmDeleteRecurringRuleByID.mock.t.Fatalf("Inspect function is already set for RecurringRuleUsecaseMock.DeleteRecurringRuleByID")
+	}
+
+	mmDeleteRecurringRuleByID.mock.inspectFuncDeleteRecurringRuleByID = f
+
+	return mmDeleteRecurringRuleByID
+}
+
+// Return sets up results that will be returned by RecurringRuleUsecase.DeleteRecurringRuleByID
+func (mmDeleteRecurringRuleByID *mRecurringRuleUsecaseMockDeleteRecurringRuleByID) Return(err error) *RecurringRuleUsecaseMock {
+	if mmDeleteRecurringRuleByID.mock.funcDeleteRecurringRuleByID != nil {
+		mmDeleteRecurringRuleByID.mock.t.Fatalf("RecurringRuleUsecaseMock.DeleteRecurringRuleByID mock is already set by Set")
+	}
+
+	if mmDeleteRecurringRuleByID.defaultExpectation == nil {
+		mmDeleteRecurringRuleByID.defaultExpectation = &RecurringRuleUsecaseMockDeleteRecurringRuleByIDExpectation{mock: mmDeleteRecurringRuleByID.mock}
+	}
+	mmDeleteRecurringRuleByID.defaultExpectation.results = &RecurringRuleUsecaseMockDeleteRecurringRuleByIDResults{err}
+	mmDeleteRecurringRuleByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteRecurringRuleByID.mock
+}
+
+// Set uses given function f to mock the RecurringRuleUsecase.DeleteRecurringRuleByID method
+func (mmDeleteRecurringRuleByID *mRecurringRuleUsecaseMockDeleteRecurringRuleByID) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *RecurringRuleUsecaseMock {
+	if mmDeleteRecurringRuleByID.defaultExpectation != nil {
+		mmDeleteRecurringRuleByID.mock.t.Fatalf("Default expectation is already set for the RecurringRuleUsecase.DeleteRecurringRuleByID method")
+	}
+
+	if len(mmDeleteRecurringRuleByID.expectations) > 0 {
+		mmDeleteRecurringRuleByID.mock.t.Fatalf("Some expectations are already set for the RecurringRuleUsecase.DeleteRecurringRuleByID method")
+	}
+
+	mmDeleteRecurringRuleByID.mock.funcDeleteRecurringRuleByID = f
+	mmDeleteRecurringRuleByID.mock.funcDeleteRecurringRuleByIDOrigin = minimock.CallerInfo(1)
+	return mmDeleteRecurringRuleByID.mock
+}
+
+// When sets expectation for the RecurringRuleUsecase.DeleteRecurringRuleByID which will trigger the result defined by the following
+// Then helper
+func (mmDeleteRecurringRuleByID *mRecurringRuleUsecaseMockDeleteRecurringRuleByID) When(ctx context.Context, id uuid.UUID) *RecurringRuleUsecaseMockDeleteRecurringRuleByIDExpectation {
+	if mmDeleteRecurringRuleByID.mock.funcDeleteRecurringRuleByID != nil {
+		mmDeleteRecurringRuleByID.mock.t.Fatalf("RecurringRuleUsecaseMock.DeleteRecurringRuleByID mock is already set by Set")
+	}
+
+	expectation := &RecurringRuleUsecaseMockDeleteRecurringRuleByIDExpectation{
+		mock:               mmDeleteRecurringRuleByID.mock,
+		params:             &RecurringRuleUsecaseMockDeleteRecurringRuleByIDParams{ctx, id},
+		expectationOrigins: RecurringRuleUsecaseMockDeleteRecurringRuleByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteRecurringRuleByID.expectations = append(mmDeleteRecurringRuleByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RecurringRuleUsecase.DeleteRecurringRuleByID return parameters for the expectation previously defined by the When method
+func (e *RecurringRuleUsecaseMockDeleteRecurringRuleByIDExpectation) Then(err error) *RecurringRuleUsecaseMock {
+	e.results = &RecurringRuleUsecaseMockDeleteRecurringRuleByIDResults{err}
+	return e.mock
+}
+
+// Times sets number of times RecurringRuleUsecase.DeleteRecurringRuleByID should be invoked
+func (mmDeleteRecurringRuleByID *mRecurringRuleUsecaseMockDeleteRecurringRuleByID) Times(n uint64) *mRecurringRuleUsecaseMockDeleteRecurringRuleByID {
+	if n == 0 {
+		mmDeleteRecurringRuleByID.mock.t.Fatalf("Times of RecurringRuleUsecaseMock.DeleteRecurringRuleByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteRecurringRuleByID.expectedInvocations, n)
+	mmDeleteRecurringRuleByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteRecurringRuleByID
+}
+
+func (mmDeleteRecurringRuleByID *mRecurringRuleUsecaseMockDeleteRecurringRuleByID) invocationsDone() bool {
+	if len(mmDeleteRecurringRuleByID.expectations) == 0 && mmDeleteRecurringRuleByID.defaultExpectation == nil && mmDeleteRecurringRuleByID.mock.funcDeleteRecurringRuleByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteRecurringRuleByID.mock.afterDeleteRecurringRuleByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteRecurringRuleByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteRecurringRuleByID implements mm_usecase.RecurringRuleUsecase
+func (mmDeleteRecurringRuleByID *RecurringRuleUsecaseMock) DeleteRecurringRuleByID(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteRecurringRuleByID.beforeDeleteRecurringRuleByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteRecurringRuleByID.afterDeleteRecurringRuleByIDCounter, 1)
+
+	mmDeleteRecurringRuleByID.t.Helper()
+
+	if mmDeleteRecurringRuleByID.inspectFuncDeleteRecurringRuleByID != nil {
+		mmDeleteRecurringRuleByID.inspectFuncDeleteRecurringRuleByID(ctx, id)
+	}
+
+	mm_params := RecurringRuleUsecaseMockDeleteRecurringRuleByIDParams{ctx, id}
+
+	// Record call args
+	mmDeleteRecurringRuleByID.DeleteRecurringRuleByIDMock.mutex.Lock()
+	mmDeleteRecurringRuleByID.DeleteRecurringRuleByIDMock.callArgs = append(mmDeleteRecurringRuleByID.DeleteRecurringRuleByIDMock.callArgs, &mm_params)
+	mmDeleteRecurringRuleByID.DeleteRecurringRuleByIDMock.mutex.Unlock()
+
+	for _, e := range mmDeleteRecurringRuleByID.DeleteRecurringRuleByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteRecurringRuleByID.DeleteRecurringRuleByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteRecurringRuleByID.DeleteRecurringRuleByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteRecurringRuleByID.DeleteRecurringRuleByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteRecurringRuleByID.DeleteRecurringRuleByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := RecurringRuleUsecaseMockDeleteRecurringRuleByIDParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteRecurringRuleByID.t.Errorf("RecurringRuleUsecaseMock.DeleteRecurringRuleByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRecurringRuleByID.DeleteRecurringRuleByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteRecurringRuleByID.t.Errorf("RecurringRuleUsecaseMock.DeleteRecurringRuleByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRecurringRuleByID.DeleteRecurringRuleByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteRecurringRuleByID.t.Errorf("RecurringRuleUsecaseMock.DeleteRecurringRuleByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteRecurringRuleByID.DeleteRecurringRuleByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteRecurringRuleByID.DeleteRecurringRuleByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteRecurringRuleByID.t.Fatal("No results are set for the RecurringRuleUsecaseMock.DeleteRecurringRuleByID")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteRecurringRuleByID.funcDeleteRecurringRuleByID != nil {
+		return mmDeleteRecurringRuleByID.funcDeleteRecurringRuleByID(ctx, id)
+	}
+	mmDeleteRecurringRuleByID.t.Fatalf("Unexpected call to RecurringRuleUsecaseMock.DeleteRecurringRuleByID. %v %v", ctx, id)
+	return
+}
+
+// DeleteRecurringRuleByIDAfterCounter returns a count of finished RecurringRuleUsecaseMock.DeleteRecurringRuleByID invocations
+func (mmDeleteRecurringRuleByID *RecurringRuleUsecaseMock) DeleteRecurringRuleByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRecurringRuleByID.afterDeleteRecurringRuleByIDCounter)
+}
+
+// DeleteRecurringRuleByIDBeforeCounter returns a count of RecurringRuleUsecaseMock.DeleteRecurringRuleByID invocations
+func (mmDeleteRecurringRuleByID *RecurringRuleUsecaseMock) DeleteRecurringRuleByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRecurringRuleByID.beforeDeleteRecurringRuleByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to RecurringRuleUsecaseMock.DeleteRecurringRuleByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteRecurringRuleByID *mRecurringRuleUsecaseMockDeleteRecurringRuleByID) Calls() []*RecurringRuleUsecaseMockDeleteRecurringRuleByIDParams {
+	mmDeleteRecurringRuleByID.mutex.RLock()
+
+	argCopy := make([]*RecurringRuleUsecaseMockDeleteRecurringRuleByIDParams, len(mmDeleteRecurringRuleByID.callArgs))
+	copy(argCopy, mmDeleteRecurringRuleByID.callArgs)
+
+	mmDeleteRecurringRuleByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteRecurringRuleByIDDone returns true if the count of the DeleteRecurringRuleByID invocations corresponds
+// the number of defined expectations
+func (m *RecurringRuleUsecaseMock) MinimockDeleteRecurringRuleByIDDone() bool {
+	if m.DeleteRecurringRuleByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteRecurringRuleByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteRecurringRuleByIDMock.invocationsDone()
+}
+
+// MinimockDeleteRecurringRuleByIDInspect logs each unmet expectation
+func (m *RecurringRuleUsecaseMock) MinimockDeleteRecurringRuleByIDInspect() {
+	for _, e := range m.DeleteRecurringRuleByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RecurringRuleUsecaseMock.DeleteRecurringRuleByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteRecurringRuleByIDCounter := mm_atomic.LoadUint64(&m.afterDeleteRecurringRuleByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteRecurringRuleByIDMock.defaultExpectation != nil && afterDeleteRecurringRuleByIDCounter < 1 {
+		if m.DeleteRecurringRuleByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RecurringRuleUsecaseMock.DeleteRecurringRuleByID at\n%s", m.DeleteRecurringRuleByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RecurringRuleUsecaseMock.DeleteRecurringRuleByID at\n%s with params: %#v", m.DeleteRecurringRuleByIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteRecurringRuleByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteRecurringRuleByID != nil && afterDeleteRecurringRuleByIDCounter < 1 {
+		m.t.Errorf("Expected call to RecurringRuleUsecaseMock.DeleteRecurringRuleByID at\n%s", m.funcDeleteRecurringRuleByIDOrigin)
+	}
+
+	if !m.DeleteRecurringRuleByIDMock.invocationsDone() && afterDeleteRecurringRuleByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RecurringRuleUsecaseMock.DeleteRecurringRuleByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteRecurringRuleByIDMock.expectedInvocations), m.DeleteRecurringRuleByIDMock.expectedInvocationsOrigin, afterDeleteRecurringRuleByIDCounter)
+	}
+}
+
+type mRecurringRuleUsecaseMockFindList struct {
+	optional           bool
+	mock               *RecurringRuleUsecaseMock
+	defaultExpectation *RecurringRuleUsecaseMockFindListExpectation
+	expectations       []*RecurringRuleUsecaseMockFindListExpectation
+
+	callArgs []*RecurringRuleUsecaseMockFindListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RecurringRuleUsecaseMockFindListExpectation specifies expectation struct of the RecurringRuleUsecase.FindList
+type RecurringRuleUsecaseMockFindListExpectation struct {
+	mock               *RecurringRuleUsecaseMock
+	params             *RecurringRuleUsecaseMockFindListParams
+	paramPtrs          *RecurringRuleUsecaseMockFindListParamPtrs
+	expectationOrigins RecurringRuleUsecaseMockFindListExpectationOrigins
+	results            *RecurringRuleUsecaseMockFindListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RecurringRuleUsecaseMockFindListParams contains parameters of the RecurringRuleUsecase.FindList
+type RecurringRuleUsecaseMockFindListParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.RecurringRuleListOptions
+	queryParams *uctypes.QueryGetListParams
+}
+
+// RecurringRuleUsecaseMockFindListParamPtrs contains pointers to parameters of the RecurringRuleUsecase.FindList
+type RecurringRuleUsecaseMockFindListParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.RecurringRuleListOptions
+	queryParams **uctypes.QueryGetListParams
+}
+
+// RecurringRuleUsecaseMockFindListResults contains results of the RecurringRuleUsecase.FindList
+type RecurringRuleUsecaseMockFindListResults struct {
+	resItems []*mm_usecase.RecurringRuleDTO
+	err      error
+}
+
+// RecurringRuleUsecaseMockFindListOrigins contains origins of expectations of the RecurringRuleUsecase.FindList
+type RecurringRuleUsecaseMockFindListExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindList *mRecurringRuleUsecaseMockFindList) Optional() *mRecurringRuleUsecaseMockFindList {
+	mmFindList.optional = true
+	return mmFindList
+}
+
+// Expect sets up expected params for RecurringRuleUsecase.FindList
+func (mmFindList *mRecurringRuleUsecaseMockFindList) Expect(ctx context.Context, listOptions *mm_usecase.RecurringRuleListOptions, queryParams *uctypes.QueryGetListParams) *mRecurringRuleUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &RecurringRuleUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleUsecaseMock.FindList mock is already set by ExpectParams functions")
+	}
+
+	mmFindList.defaultExpectation.params = &RecurringRuleUsecaseMockFindListParams{ctx, listOptions, queryParams}
+	mmFindList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindList.expectations {
+		if minimock.Equal(e.params, mmFindList.defaultExpectation.params) {
+			mmFindList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindList.defaultExpectation.params)
+		}
+	}
+
+	return mmFindList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RecurringRuleUsecase.FindList
+func (mmFindList *mRecurringRuleUsecaseMockFindList) ExpectCtxParam1(ctx context.Context) *mRecurringRuleUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &RecurringRuleUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleUsecaseMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &RecurringRuleUsecaseMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for RecurringRuleUsecase.FindList
+func (mmFindList *mRecurringRuleUsecaseMockFindList) ExpectListOptionsParam2(listOptions *mm_usecase.RecurringRuleListOptions) *mRecurringRuleUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &RecurringRuleUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleUsecaseMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &RecurringRuleUsecaseMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmFindList.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for RecurringRuleUsecase.FindList
+func (mmFindList *mRecurringRuleUsecaseMockFindList) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mRecurringRuleUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &RecurringRuleUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleUsecaseMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &RecurringRuleUsecaseMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindList.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// Inspect accepts an inspector function that has same arguments as the RecurringRuleUsecase.FindList
+func (mmFindList *mRecurringRuleUsecaseMockFindList) Inspect(f func(ctx context.Context, listOptions *mm_usecase.RecurringRuleListOptions, queryParams *uctypes.QueryGetListParams)) *mRecurringRuleUsecaseMockFindList {
+	if mmFindList.mock.inspectFuncFindList != nil {
+		mmFindList.mock.t.Fatalf("Inspect function is already set for RecurringRuleUsecaseMock.FindList")
+	}
+
+	mmFindList.mock.inspectFuncFindList = f
+
+	return mmFindList
+}
+
+// Return sets up results that will be returned by RecurringRuleUsecase.FindList
+func (mmFindList *mRecurringRuleUsecaseMockFindList) Return(resItems []*mm_usecase.RecurringRuleDTO, err error) *RecurringRuleUsecaseMock {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &RecurringRuleUsecaseMockFindListExpectation{mock: mmFindList.mock}
+	}
+	mmFindList.defaultExpectation.results = &RecurringRuleUsecaseMockFindListResults{resItems, err}
+	mmFindList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// Set uses given function f to mock the RecurringRuleUsecase.FindList method
+func (mmFindList *mRecurringRuleUsecaseMockFindList) Set(f func(ctx context.Context, listOptions *mm_usecase.RecurringRuleListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.RecurringRuleDTO, err error)) *RecurringRuleUsecaseMock {
+	if mmFindList.defaultExpectation != nil {
+		mmFindList.mock.t.Fatalf("Default expectation is already set for the RecurringRuleUsecase.FindList method")
+	}
+
+	if len(mmFindList.expectations) > 0 {
+		mmFindList.mock.t.Fatalf("Some expectations are already set for the RecurringRuleUsecase.FindList method")
+	}
+
+	mmFindList.mock.funcFindList = f
+	mmFindList.mock.funcFindListOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// When sets expectation for the RecurringRuleUsecase.FindList which will trigger the result defined by the following
+// Then helper
+func (mmFindList *mRecurringRuleUsecaseMockFindList) When(ctx context.Context, listOptions *mm_usecase.RecurringRuleListOptions, queryParams *uctypes.QueryGetListParams) *RecurringRuleUsecaseMockFindListExpectation {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("RecurringRuleUsecaseMock.FindList mock is already set by Set")
+	}
+
+	expectation := &RecurringRuleUsecaseMockFindListExpectation{
+		mock:               mmFindList.mock,
+		params:             &RecurringRuleUsecaseMockFindListParams{ctx, listOptions, queryParams},
+		expectationOrigins: RecurringRuleUsecaseMockFindListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindList.expectations = append(mmFindList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RecurringRuleUsecase.FindList return parameters for the expectation previously defined by the When method
+func (e *RecurringRuleUsecaseMockFindListExpectation) Then(resItems []*mm_usecase.RecurringRuleDTO, err error) *RecurringRuleUsecaseMock {
+	e.results = &RecurringRuleUsecaseMockFindListResults{resItems, err}
+	return e.mock
+}
+
+// Times sets number of times RecurringRuleUsecase.FindList should be invoked
+func (mmFindList *mRecurringRuleUsecaseMockFindList) Times(n uint64) *mRecurringRuleUsecaseMockFindList {
+	if n == 0 {
+		mmFindList.mock.t.Fatalf("Times of RecurringRuleUsecaseMock.FindList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindList.expectedInvocations, n)
+	mmFindList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindList
+}
+
+func (mmFindList *mRecurringRuleUsecaseMockFindList) invocationsDone() bool {
+	if len(mmFindList.expectations) == 0 && mmFindList.defaultExpectation == nil && mmFindList.mock.funcFindList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindList.mock.afterFindListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindList implements mm_usecase.RecurringRuleUsecase
+func (mmFindList *RecurringRuleUsecaseMock) FindList(ctx context.Context, listOptions *mm_usecase.RecurringRuleListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.RecurringRuleDTO, err error) {
+	mm_atomic.AddUint64(&mmFindList.beforeFindListCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindList.afterFindListCounter, 1)
+
+	mmFindList.t.Helper()
+
+	if mmFindList.inspectFuncFindList != nil {
+		mmFindList.inspectFuncFindList(ctx, listOptions, queryParams)
+	}
+
+	mm_params := RecurringRuleUsecaseMockFindListParams{ctx, listOptions, queryParams}
+
+	// Record call args
+	mmFindList.FindListMock.mutex.Lock()
+	mmFindList.FindListMock.callArgs = append(mmFindList.FindListMock.callArgs, &mm_params)
+	mmFindList.FindListMock.mutex.Unlock()
+
+	for _, e := range mmFindList.FindListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resItems, e.results.err
+		}
+	}
+
+	if mmFindList.FindListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindList.FindListMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindList.FindListMock.defaultExpectation.params
+		mm_want_ptrs := mmFindList.FindListMock.defaultExpectation.paramPtrs
+
+		mm_got := RecurringRuleUsecaseMockFindListParams{ctx, listOptions, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindList.t.Errorf("RecurringRuleUsecaseMock.FindList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmFindList.t.Errorf("RecurringRuleUsecaseMock.FindList got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindList.t.Errorf("RecurringRuleUsecaseMock.FindList got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindList.t.Errorf("RecurringRuleUsecaseMock.FindList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindList.FindListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindList.FindListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindList.t.Fatal("No results are set for the RecurringRuleUsecaseMock.FindList")
+		}
+		return (*mm_results).resItems, (*mm_results).err
+	}
+	if mmFindList.funcFindList != nil {
+		return mmFindList.funcFindList(ctx, listOptions, queryParams)
+	}
+	mmFindList.t.Fatalf("Unexpected call to RecurringRuleUsecaseMock.FindList. %v %v %v", ctx, listOptions, queryParams)
+	return
+}
+
+// FindListAfterCounter returns a count of finished RecurringRuleUsecaseMock.FindList invocations
+func (mmFindList *RecurringRuleUsecaseMock) FindListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.afterFindListCounter)
+}
+
+// FindListBeforeCounter returns a count of RecurringRuleUsecaseMock.FindList invocations
+func (mmFindList *RecurringRuleUsecaseMock) FindListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.beforeFindListCounter)
+}
+
+// Calls returns a list of arguments used in each call to RecurringRuleUsecaseMock.FindList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindList *mRecurringRuleUsecaseMockFindList) Calls() []*RecurringRuleUsecaseMockFindListParams {
+	mmFindList.mutex.RLock()
+
+	argCopy := make([]*RecurringRuleUsecaseMockFindListParams, len(mmFindList.callArgs))
+	copy(argCopy, mmFindList.callArgs)
+
+	mmFindList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindListDone returns true if the count of the FindList invocations corresponds
+// the number of defined expectations
+func (m *RecurringRuleUsecaseMock) MinimockFindListDone() bool {
+	if m.FindListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindListMock.invocationsDone()
+}
+
+// MinimockFindListInspect logs each unmet expectation
+func (m *RecurringRuleUsecaseMock) MinimockFindListInspect() {
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RecurringRuleUsecaseMock.FindList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindListCounter := mm_atomic.LoadUint64(&m.afterFindListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindListMock.defaultExpectation != nil && afterFindListCounter < 1 {
+		if m.FindListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RecurringRuleUsecaseMock.FindList at\n%s", m.FindListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RecurringRuleUsecaseMock.FindList at\n%s with params: %#v", m.FindListMock.defaultExpectation.expectationOrigins.origin, *m.FindListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindList != nil && afterFindListCounter < 1 {
+		m.t.Errorf("Expected call to RecurringRuleUsecaseMock.FindList at\n%s", m.funcFindListOrigin)
+	}
+
+	if !m.FindListMock.invocationsDone() && afterFindListCounter > 0 {
+		m.t.Errorf("Expected %d calls to RecurringRuleUsecaseMock.FindList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindListMock.expectedInvocations), m.FindListMock.expectedInvocationsOrigin, afterFindListCounter)
+	}
+}
+
+type mRecurringRuleUsecaseMockFindOneByID struct {
+	optional           bool
+	mock               *RecurringRuleUsecaseMock
+	defaultExpectation *RecurringRuleUsecaseMockFindOneByIDExpectation
+	expectations       []*RecurringRuleUsecaseMockFindOneByIDExpectation
+
+	callArgs []*RecurringRuleUsecaseMockFindOneByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RecurringRuleUsecaseMockFindOneByIDExpectation specifies expectation struct of the RecurringRuleUsecase.FindOneByID
+type RecurringRuleUsecaseMockFindOneByIDExpectation struct {
+	mock               *RecurringRuleUsecaseMock
+	params             *RecurringRuleUsecaseMockFindOneByIDParams
+	paramPtrs          *RecurringRuleUsecaseMockFindOneByIDParamPtrs
+	expectationOrigins RecurringRuleUsecaseMockFindOneByIDExpectationOrigins
+	results            *RecurringRuleUsecaseMockFindOneByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RecurringRuleUsecaseMockFindOneByIDParams contains parameters of the RecurringRuleUsecase.FindOneByID
+type RecurringRuleUsecaseMockFindOneByIDParams struct {
+	ctx         context.Context
+	id          uuid.UUID
+	queryParams *uctypes.QueryGetOneParams
+}
+
+// RecurringRuleUsecaseMockFindOneByIDParamPtrs contains pointers to parameters of the RecurringRuleUsecase.FindOneByID
+type RecurringRuleUsecaseMockFindOneByIDParamPtrs struct {
+	ctx         *context.Context
+	id          *uuid.UUID
+	queryParams **uctypes.QueryGetOneParams
+}
+
+// RecurringRuleUsecaseMockFindOneByIDResults contains results of the RecurringRuleUsecase.FindOneByID
+type RecurringRuleUsecaseMockFindOneByIDResults struct {
+	resItem *mm_usecase.RecurringRuleDTO
+	err     error
+}
+
+// RecurringRuleUsecaseMockFindOneByIDOrigins contains origins of expectations of the RecurringRuleUsecase.FindOneByID
+type RecurringRuleUsecaseMockFindOneByIDExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originId          string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindOneByID *mRecurringRuleUsecaseMockFindOneByID) Optional() *mRecurringRuleUsecaseMockFindOneByID {
+	mmFindOneByID.optional = true
+	return mmFindOneByID
+}
+
+// Expect sets up expected params for RecurringRuleUsecase.FindOneByID
+func (mmFindOneByID *mRecurringRuleUsecaseMockFindOneByID) Expect(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *mRecurringRuleUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &RecurringRuleUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleUsecaseMock.FindOneByID mock is already set by ExpectParams functions")
+	}
+
+	mmFindOneByID.defaultExpectation.params = &RecurringRuleUsecaseMockFindOneByIDParams{ctx, id, queryParams}
+	mmFindOneByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindOneByID.expectations {
+		if minimock.Equal(e.params, mmFindOneByID.defaultExpectation.params) {
+			mmFindOneByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindOneByID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindOneByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RecurringRuleUsecase.FindOneByID
+func (mmFindOneByID *mRecurringRuleUsecaseMockFindOneByID) ExpectCtxParam1(ctx context.Context) *mRecurringRuleUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &RecurringRuleUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleUsecaseMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &RecurringRuleUsecaseMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindOneByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// ExpectIdParam2 sets up expected param id for RecurringRuleUsecase.FindOneByID
+func (mmFindOneByID *mRecurringRuleUsecaseMockFindOneByID) ExpectIdParam2(id uuid.UUID) *mRecurringRuleUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &RecurringRuleUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleUsecaseMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &RecurringRuleUsecaseMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.id = &id
+	mmFindOneByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for RecurringRuleUsecase.FindOneByID
+func (mmFindOneByID *mRecurringRuleUsecaseMockFindOneByID) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetOneParams) *mRecurringRuleUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &RecurringRuleUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleUsecaseMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &RecurringRuleUsecaseMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindOneByID.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the RecurringRuleUsecase.FindOneByID
+func (mmFindOneByID *mRecurringRuleUsecaseMockFindOneByID) Inspect(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)) *mRecurringRuleUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.inspectFuncFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("Inspect function is already set for RecurringRuleUsecaseMock.FindOneByID")
+	}
+
+	mmFindOneByID.mock.inspectFuncFindOneByID = f
+
+	return mmFindOneByID
+}
+
+// Return sets up results that will be returned by RecurringRuleUsecase.FindOneByID
+func (mmFindOneByID *mRecurringRuleUsecaseMockFindOneByID) Return(resItem *mm_usecase.RecurringRuleDTO, err error) *RecurringRuleUsecaseMock {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &RecurringRuleUsecaseMockFindOneByIDExpectation{mock: mmFindOneByID.mock}
+	}
+	mmFindOneByID.defaultExpectation.results = &RecurringRuleUsecaseMockFindOneByIDResults{resItem, err}
+	mmFindOneByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID.mock
+}
+
+// Set uses given function f to mock the RecurringRuleUsecase.FindOneByID method
+func (mmFindOneByID *mRecurringRuleUsecaseMockFindOneByID) Set(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.RecurringRuleDTO, err error)) *RecurringRuleUsecaseMock {
+	if mmFindOneByID.defaultExpectation != nil {
+		mmFindOneByID.mock.t.Fatalf("Default expectation is already set for the RecurringRuleUsecase.FindOneByID method")
+	}
+
+	if len(mmFindOneByID.expectations) > 0 {
+		mmFindOneByID.mock.t.Fatalf("Some expectations are already set for the RecurringRuleUsecase.FindOneByID method")
+	}
+
+	mmFindOneByID.mock.funcFindOneByID = f
+	mmFindOneByID.mock.funcFindOneByIDOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID.mock
+}
+
+// When sets expectation for the RecurringRuleUsecase.FindOneByID which will trigger the result defined by the following
+// Then helper
+func (mmFindOneByID *mRecurringRuleUsecaseMockFindOneByID) When(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *RecurringRuleUsecaseMockFindOneByIDExpectation {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("RecurringRuleUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	expectation := &RecurringRuleUsecaseMockFindOneByIDExpectation{
+		mock:               mmFindOneByID.mock,
+		params:             &RecurringRuleUsecaseMockFindOneByIDParams{ctx, id, queryParams},
+		expectationOrigins: RecurringRuleUsecaseMockFindOneByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindOneByID.expectations = append(mmFindOneByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RecurringRuleUsecase.FindOneByID return parameters for the expectation previously defined by the When method
+func (e *RecurringRuleUsecaseMockFindOneByIDExpectation) Then(resItem *mm_usecase.RecurringRuleDTO, err error) *RecurringRuleUsecaseMock {
+	e.results = &RecurringRuleUsecaseMockFindOneByIDResults{resItem, err}
+	return e.mock
+}
+
+// Times sets number of times RecurringRuleUsecase.FindOneByID should be invoked
+func (mmFindOneByID *mRecurringRuleUsecaseMockFindOneByID) Times(n uint64) *mRecurringRuleUsecaseMockFindOneByID {
+	if n == 0 {
+		mmFindOneByID.mock.t.Fatalf("Times of RecurringRuleUsecaseMock.FindOneByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindOneByID.expectedInvocations, n)
+	mmFindOneByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID
+}
+
+func (mmFindOneByID *mRecurringRuleUsecaseMockFindOneByID) invocationsDone() bool {
+	if len(mmFindOneByID.expectations) == 0 && mmFindOneByID.defaultExpectation == nil && mmFindOneByID.mock.funcFindOneByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindOneByID.mock.afterFindOneByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindOneByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindOneByID implements mm_usecase.RecurringRuleUsecase
+func (mmFindOneByID *RecurringRuleUsecaseMock) FindOneByID(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.RecurringRuleDTO, err error) {
+	mm_atomic.AddUint64(&mmFindOneByID.beforeFindOneByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindOneByID.afterFindOneByIDCounter, 1)
+
+	mmFindOneByID.t.Helper()
+
+	if mmFindOneByID.inspectFuncFindOneByID != nil {
+		mmFindOneByID.inspectFuncFindOneByID(ctx, id, queryParams)
+	}
+
+	mm_params := RecurringRuleUsecaseMockFindOneByIDParams{ctx, id, queryParams}
+
+	// Record call args
+	mmFindOneByID.FindOneByIDMock.mutex.Lock()
+	mmFindOneByID.FindOneByIDMock.callArgs = append(mmFindOneByID.FindOneByIDMock.callArgs, &mm_params)
+	mmFindOneByID.FindOneByIDMock.mutex.Unlock()
+
+	for _, e := range mmFindOneByID.FindOneByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resItem, e.results.err
+		}
+	}
+
+	if mmFindOneByID.FindOneByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindOneByID.FindOneByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindOneByID.FindOneByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindOneByID.FindOneByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := RecurringRuleUsecaseMockFindOneByIDParams{ctx, id, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindOneByID.t.Errorf("RecurringRuleUsecaseMock.FindOneByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmFindOneByID.t.Errorf("RecurringRuleUsecaseMock.FindOneByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindOneByID.t.Errorf("RecurringRuleUsecaseMock.FindOneByID got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindOneByID.t.Errorf("RecurringRuleUsecaseMock.FindOneByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindOneByID.FindOneByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindOneByID.t.Fatal("No results are set for the RecurringRuleUsecaseMock.FindOneByID")
+		}
+		return (*mm_results).resItem, (*mm_results).err
+	}
+	if mmFindOneByID.funcFindOneByID != nil {
+		return mmFindOneByID.funcFindOneByID(ctx, id, queryParams)
+	}
+	mmFindOneByID.t.Fatalf("Unexpected call to RecurringRuleUsecaseMock.FindOneByID. %v %v %v", ctx, id, queryParams)
+	return
+}
+
+// FindOneByIDAfterCounter returns a count of finished RecurringRuleUsecaseMock.FindOneByID invocations
+func (mmFindOneByID *RecurringRuleUsecaseMock) FindOneByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByID.afterFindOneByIDCounter)
+}
+
+// FindOneByIDBeforeCounter returns a count of RecurringRuleUsecaseMock.FindOneByID invocations
+func (mmFindOneByID *RecurringRuleUsecaseMock) FindOneByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByID.beforeFindOneByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to RecurringRuleUsecaseMock.FindOneByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindOneByID *mRecurringRuleUsecaseMockFindOneByID) Calls() []*RecurringRuleUsecaseMockFindOneByIDParams {
+	mmFindOneByID.mutex.RLock()
+
+	argCopy := make([]*RecurringRuleUsecaseMockFindOneByIDParams, len(mmFindOneByID.callArgs))
+	copy(argCopy, mmFindOneByID.callArgs)
+
+	mmFindOneByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindOneByIDDone returns true if the count of the FindOneByID invocations corresponds
+// the number of defined expectations
+func (m *RecurringRuleUsecaseMock) MinimockFindOneByIDDone() bool {
+	if m.FindOneByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindOneByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindOneByIDMock.invocationsDone()
+}
+
+// MinimockFindOneByIDInspect logs each unmet expectation
+func (m *RecurringRuleUsecaseMock) MinimockFindOneByIDInspect() {
+	for _, e := range m.FindOneByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RecurringRuleUsecaseMock.FindOneByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindOneByIDCounter := mm_atomic.LoadUint64(&m.afterFindOneByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindOneByIDMock.defaultExpectation != nil && afterFindOneByIDCounter < 1 {
+		if m.FindOneByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RecurringRuleUsecaseMock.FindOneByID at\n%s", m.FindOneByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RecurringRuleUsecaseMock.FindOneByID at\n%s with params: %#v", m.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindOneByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindOneByID != nil && afterFindOneByIDCounter < 1 {
+		m.t.Errorf("Expected call to RecurringRuleUsecaseMock.FindOneByID at\n%s", m.funcFindOneByIDOrigin)
+	}
+
+	if !m.FindOneByIDMock.invocationsDone() && afterFindOneByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RecurringRuleUsecaseMock.FindOneByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindOneByIDMock.expectedInvocations), m.FindOneByIDMock.expectedInvocationsOrigin, afterFindOneByIDCounter)
+	}
+}
+
+type mRecurringRuleUsecaseMockMaterializeDueRules struct {
+	optional           bool
+	mock               *RecurringRuleUsecaseMock
+	defaultExpectation *RecurringRuleUsecaseMockMaterializeDueRulesExpectation
+	expectations       []*RecurringRuleUsecaseMockMaterializeDueRulesExpectation
+
+	callArgs []*RecurringRuleUsecaseMockMaterializeDueRulesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RecurringRuleUsecaseMockMaterializeDueRulesExpectation specifies expectation struct of the RecurringRuleUsecase.MaterializeDueRules
+type RecurringRuleUsecaseMockMaterializeDueRulesExpectation struct {
+	mock               *RecurringRuleUsecaseMock
+	params             *RecurringRuleUsecaseMockMaterializeDueRulesParams
+	paramPtrs          *RecurringRuleUsecaseMockMaterializeDueRulesParamPtrs
+	expectationOrigins RecurringRuleUsecaseMockMaterializeDueRulesExpectationOrigins
+	results            *RecurringRuleUsecaseMockMaterializeDueRulesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RecurringRuleUsecaseMockMaterializeDueRulesParams contains parameters of the RecurringRuleUsecase.MaterializeDueRules
+type RecurringRuleUsecaseMockMaterializeDueRulesParams struct {
+	ctx   context.Context
+	today civil.Date
+	limit uint64
+}
+
+// RecurringRuleUsecaseMockMaterializeDueRulesParamPtrs contains pointers to parameters of the RecurringRuleUsecase.MaterializeDueRules
+type RecurringRuleUsecaseMockMaterializeDueRulesParamPtrs struct {
+	ctx   *context.Context
+	today *civil.Date
+	limit *uint64
+}
+
+// RecurringRuleUsecaseMockMaterializeDueRulesResults contains results of the RecurringRuleUsecase.MaterializeDueRules
+type RecurringRuleUsecaseMockMaterializeDueRulesResults struct {
+	processed int
+	err       error
+}
+
+// RecurringRuleUsecaseMockMaterializeDueRulesOrigins contains origins of expectations of the RecurringRuleUsecase.MaterializeDueRules
+type RecurringRuleUsecaseMockMaterializeDueRulesExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToday string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMaterializeDueRules *mRecurringRuleUsecaseMockMaterializeDueRules) Optional() *mRecurringRuleUsecaseMockMaterializeDueRules {
+	mmMaterializeDueRules.optional = true
+	return mmMaterializeDueRules
+}
+
+// Expect sets up expected params for RecurringRuleUsecase.MaterializeDueRules
+func (mmMaterializeDueRules *mRecurringRuleUsecaseMockMaterializeDueRules) Expect(ctx context.Context, today civil.Date, limit uint64) *mRecurringRuleUsecaseMockMaterializeDueRules {
+	if mmMaterializeDueRules.mock.funcMaterializeDueRules != nil {
+		mmMaterializeDueRules.mock.t.Fatalf("RecurringRuleUsecaseMock.MaterializeDueRules mock is already set by Set")
+	}
+
+	if mmMaterializeDueRules.defaultExpectation == nil {
+		mmMaterializeDueRules.defaultExpectation = &RecurringRuleUsecaseMockMaterializeDueRulesExpectation{}
+	}
+
+	if mmMaterializeDueRules.defaultExpectation.paramPtrs != nil {
+		mmMaterializeDueRules.mock.t.Fatalf("RecurringRuleUsecaseMock.MaterializeDueRules mock is already set by ExpectParams functions")
+	}
+
+	mmMaterializeDueRules.defaultExpectation.params = &RecurringRuleUsecaseMockMaterializeDueRulesParams{ctx, today, limit}
+	mmMaterializeDueRules.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMaterializeDueRules.expectations {
+		if minimock.Equal(e.params, mmMaterializeDueRules.defaultExpectation.params) {
+			mmMaterializeDueRules.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMaterializeDueRules.defaultExpectation.params)
+		}
+	}
+
+	return mmMaterializeDueRules
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RecurringRuleUsecase.MaterializeDueRules
+func (mmMaterializeDueRules *mRecurringRuleUsecaseMockMaterializeDueRules) ExpectCtxParam1(ctx context.Context) *mRecurringRuleUsecaseMockMaterializeDueRules {
+	if mmMaterializeDueRules.mock.funcMaterializeDueRules != nil {
+		mmMaterializeDueRules.mock.t.Fatalf("RecurringRuleUsecaseMock.MaterializeDueRules mock is already set by Set")
+	}
+
+	if mmMaterializeDueRules.defaultExpectation == nil {
+		mmMaterializeDueRules.defaultExpectation = &RecurringRuleUsecaseMockMaterializeDueRulesExpectation{}
+	}
+
+	if mmMaterializeDueRules.defaultExpectation.params != nil {
+		mmMaterializeDueRules.mock.t.Fatalf("RecurringRuleUsecaseMock.MaterializeDueRules mock is already set by Expect")
+	}
+
+	if mmMaterializeDueRules.defaultExpectation.paramPtrs == nil {
+		mmMaterializeDueRules.defaultExpectation.paramPtrs = &RecurringRuleUsecaseMockMaterializeDueRulesParamPtrs{}
+	}
+	mmMaterializeDueRules.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMaterializeDueRules.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMaterializeDueRules
+}
+
+// ExpectTodayParam2 sets up expected param today for RecurringRuleUsecase.MaterializeDueRules
+func (mmMaterializeDueRules *mRecurringRuleUsecaseMockMaterializeDueRules) ExpectTodayParam2(today civil.Date) *mRecurringRuleUsecaseMockMaterializeDueRules {
+	if mmMaterializeDueRules.mock.funcMaterializeDueRules != nil {
+		mmMaterializeDueRules.mock.t.Fatalf("RecurringRuleUsecaseMock.MaterializeDueRules mock is already set by Set")
+	}
+
+	if mmMaterializeDueRules.defaultExpectation == nil {
+		mmMaterializeDueRules.defaultExpectation = &RecurringRuleUsecaseMockMaterializeDueRulesExpectation{}
+	}
+
+	if mmMaterializeDueRules.defaultExpectation.params != nil {
+		mmMaterializeDueRules.mock.t.Fatalf("RecurringRuleUsecaseMock.MaterializeDueRules mock is already set by Expect")
+	}
+
+	if mmMaterializeDueRules.defaultExpectation.paramPtrs == nil {
+		mmMaterializeDueRules.defaultExpectation.paramPtrs = &RecurringRuleUsecaseMockMaterializeDueRulesParamPtrs{}
+	}
+	mmMaterializeDueRules.defaultExpectation.paramPtrs.today = &today
+	mmMaterializeDueRules.defaultExpectation.expectationOrigins.originToday = minimock.CallerInfo(1)
+
+	return mmMaterializeDueRules
+}
+
+// ExpectLimitParam3 sets up expected param limit for RecurringRuleUsecase.MaterializeDueRules
+func (mmMaterializeDueRules *mRecurringRuleUsecaseMockMaterializeDueRules) ExpectLimitParam3(limit uint64) *mRecurringRuleUsecaseMockMaterializeDueRules {
+	if mmMaterializeDueRules.mock.funcMaterializeDueRules != nil {
+		mmMaterializeDueRules.mock.t.Fatalf("RecurringRuleUsecaseMock.MaterializeDueRules mock is already set by Set")
+	}
+
+	if mmMaterializeDueRules.defaultExpectation == nil {
+		mmMaterializeDueRules.defaultExpectation = &RecurringRuleUsecaseMockMaterializeDueRulesExpectation{}
+	}
+
+	if mmMaterializeDueRules.defaultExpectation.params != nil {
+		mmMaterializeDueRules.mock.t.Fatalf("RecurringRuleUsecaseMock.MaterializeDueRules mock is already set by Expect")
+	}
+
+	if mmMaterializeDueRules.defaultExpectation.paramPtrs == nil {
+		mmMaterializeDueRules.defaultExpectation.paramPtrs = &RecurringRuleUsecaseMockMaterializeDueRulesParamPtrs{}
+	}
+	mmMaterializeDueRules.defaultExpectation.paramPtrs.limit = &limit
+	mmMaterializeDueRules.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmMaterializeDueRules
+}
+
+// Inspect accepts an inspector function that has same arguments as the RecurringRuleUsecase.MaterializeDueRules
+func (mmMaterializeDueRules *mRecurringRuleUsecaseMockMaterializeDueRules) Inspect(f func(ctx context.Context, today civil.Date, limit uint64)) *mRecurringRuleUsecaseMockMaterializeDueRules {
+	if mmMaterializeDueRules.mock.inspectFuncMaterializeDueRules != nil {
+		mmMaterializeDueRules.mock.t.Fatalf("Inspect function is already set for RecurringRuleUsecaseMock.MaterializeDueRules")
+	}
+
+	mmMaterializeDueRules.mock.inspectFuncMaterializeDueRules = f
+
+	return mmMaterializeDueRules
+}
+
+// Return sets up results that will be returned by RecurringRuleUsecase.MaterializeDueRules
+func (mmMaterializeDueRules *mRecurringRuleUsecaseMockMaterializeDueRules) Return(processed int, err error) *RecurringRuleUsecaseMock {
+	if mmMaterializeDueRules.mock.funcMaterializeDueRules != nil {
+		mmMaterializeDueRules.mock.t.Fatalf("RecurringRuleUsecaseMock.MaterializeDueRules mock is already set by Set")
+	}
+
+	if mmMaterializeDueRules.defaultExpectation == nil {
+		mmMaterializeDueRules.defaultExpectation = &RecurringRuleUsecaseMockMaterializeDueRulesExpectation{mock: mmMaterializeDueRules.mock}
+	}
+	mmMaterializeDueRules.defaultExpectation.results = &RecurringRuleUsecaseMockMaterializeDueRulesResults{processed, err}
+	mmMaterializeDueRules.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMaterializeDueRules.mock
+}
+
+// Set uses given function f to mock the RecurringRuleUsecase.MaterializeDueRules method
+func (mmMaterializeDueRules *mRecurringRuleUsecaseMockMaterializeDueRules) Set(f func(ctx context.Context, today civil.Date, limit uint64) (processed int, err error)) *RecurringRuleUsecaseMock {
+	if mmMaterializeDueRules.defaultExpectation != nil {
+		mmMaterializeDueRules.mock.t.Fatalf("Default expectation is already set for the RecurringRuleUsecase.MaterializeDueRules method")
+	}
+
+	if len(mmMaterializeDueRules.expectations) > 0 {
+		mmMaterializeDueRules.mock.t.Fatalf("Some expectations are already set for the RecurringRuleUsecase.MaterializeDueRules method")
+	}
+
+	mmMaterializeDueRules.mock.funcMaterializeDueRules = f
+	mmMaterializeDueRules.mock.funcMaterializeDueRulesOrigin = minimock.CallerInfo(1)
+	return mmMaterializeDueRules.mock
+}
+
+// When sets expectation for the RecurringRuleUsecase.MaterializeDueRules which will trigger the result defined by the following
+// Then helper
+func (mmMaterializeDueRules *mRecurringRuleUsecaseMockMaterializeDueRules) When(ctx context.Context, today civil.Date, limit uint64) *RecurringRuleUsecaseMockMaterializeDueRulesExpectation {
+	if mmMaterializeDueRules.mock.funcMaterializeDueRules != nil {
+		mmMaterializeDueRules.mock.t.Fatalf("RecurringRuleUsecaseMock.MaterializeDueRules mock is already set by Set")
+	}
+
+	expectation := &RecurringRuleUsecaseMockMaterializeDueRulesExpectation{
+		mock:               mmMaterializeDueRules.mock,
+		params:             &RecurringRuleUsecaseMockMaterializeDueRulesParams{ctx, today, limit},
+		expectationOrigins: RecurringRuleUsecaseMockMaterializeDueRulesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMaterializeDueRules.expectations = append(mmMaterializeDueRules.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RecurringRuleUsecase.MaterializeDueRules return parameters for the expectation previously defined by the When method
+func (e *RecurringRuleUsecaseMockMaterializeDueRulesExpectation) Then(processed int, err error) *RecurringRuleUsecaseMock {
+	e.results = &RecurringRuleUsecaseMockMaterializeDueRulesResults{processed, err}
+	return e.mock
+}
+
+// Times sets number of times RecurringRuleUsecase.MaterializeDueRules should be invoked
+func (mmMaterializeDueRules *mRecurringRuleUsecaseMockMaterializeDueRules) Times(n uint64) *mRecurringRuleUsecaseMockMaterializeDueRules {
+	if n == 0 {
+		mmMaterializeDueRules.mock.t.Fatalf("Times of RecurringRuleUsecaseMock.MaterializeDueRules mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMaterializeDueRules.expectedInvocations, n)
+	mmMaterializeDueRules.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMaterializeDueRules
+}
+
+func (mmMaterializeDueRules *mRecurringRuleUsecaseMockMaterializeDueRules) invocationsDone() bool {
+	if len(mmMaterializeDueRules.expectations) == 0 && mmMaterializeDueRules.defaultExpectation == nil && mmMaterializeDueRules.mock.funcMaterializeDueRules == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMaterializeDueRules.mock.afterMaterializeDueRulesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMaterializeDueRules.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MaterializeDueRules implements mm_usecase.RecurringRuleUsecase
+func (mmMaterializeDueRules *RecurringRuleUsecaseMock) MaterializeDueRules(ctx context.Context, today civil.Date, limit uint64) (processed int, err error) {
+	mm_atomic.AddUint64(&mmMaterializeDueRules.beforeMaterializeDueRulesCounter, 1)
+	defer mm_atomic.AddUint64(&mmMaterializeDueRules.afterMaterializeDueRulesCounter, 1)
+
+	mmMaterializeDueRules.t.Helper()
+
+	if mmMaterializeDueRules.inspectFuncMaterializeDueRules != nil {
+		mmMaterializeDueRules.inspectFuncMaterializeDueRules(ctx, today, limit)
+	}
+
+	mm_params := RecurringRuleUsecaseMockMaterializeDueRulesParams{ctx, today, limit}
+
+	// Record call args
+	mmMaterializeDueRules.MaterializeDueRulesMock.mutex.Lock()
+	mmMaterializeDueRules.MaterializeDueRulesMock.callArgs = append(mmMaterializeDueRules.MaterializeDueRulesMock.callArgs, &mm_params)
+	mmMaterializeDueRules.MaterializeDueRulesMock.mutex.Unlock()
+
+	for _, e := range mmMaterializeDueRules.MaterializeDueRulesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.processed, e.results.err
+		}
+	}
+
+	if mmMaterializeDueRules.MaterializeDueRulesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMaterializeDueRules.MaterializeDueRulesMock.defaultExpectation.Counter, 1)
+		mm_want := mmMaterializeDueRules.MaterializeDueRulesMock.defaultExpectation.params
+		mm_want_ptrs := mmMaterializeDueRules.MaterializeDueRulesMock.defaultExpectation.paramPtrs
+
+		mm_got := RecurringRuleUsecaseMockMaterializeDueRulesParams{ctx, today, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMaterializeDueRules.t.Errorf("RecurringRuleUsecaseMock.MaterializeDueRules got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMaterializeDueRules.MaterializeDueRulesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.today != nil && !minimock.Equal(*mm_want_ptrs.today, mm_got.today) {
+				mmMaterializeDueRules.t.Errorf("RecurringRuleUsecaseMock.MaterializeDueRules got unexpected parameter today, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMaterializeDueRules.MaterializeDueRulesMock.defaultExpectation.expectationOrigins.originToday, *mm_want_ptrs.today, mm_got.today, minimock.Diff(*mm_want_ptrs.today, mm_got.today))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmMaterializeDueRules.t.Errorf("RecurringRuleUsecaseMock.MaterializeDueRules got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMaterializeDueRules.MaterializeDueRulesMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMaterializeDueRules.t.Errorf("RecurringRuleUsecaseMock.MaterializeDueRules got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMaterializeDueRules.MaterializeDueRulesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMaterializeDueRules.MaterializeDueRulesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMaterializeDueRules.t.Fatal("No results are set for the RecurringRuleUsecaseMock.MaterializeDueRules")
+		}
+		return (*mm_results).processed, (*mm_results).err
+	}
+	if mmMaterializeDueRules.funcMaterializeDueRules != nil {
+		return mmMaterializeDueRules.funcMaterializeDueRules(ctx, today, limit)
+	}
+	mmMaterializeDueRules.t.Fatalf("Unexpected call to RecurringRuleUsecaseMock.MaterializeDueRules. %v %v %v", ctx, today, limit)
+	return
+}
+
+// MaterializeDueRulesAfterCounter returns a count of finished RecurringRuleUsecaseMock.MaterializeDueRules invocations
+func (mmMaterializeDueRules *RecurringRuleUsecaseMock) MaterializeDueRulesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMaterializeDueRules.afterMaterializeDueRulesCounter)
+}
+
+// MaterializeDueRulesBeforeCounter returns a count of RecurringRuleUsecaseMock.MaterializeDueRules invocations
+func (mmMaterializeDueRules *RecurringRuleUsecaseMock) MaterializeDueRulesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMaterializeDueRules.beforeMaterializeDueRulesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RecurringRuleUsecaseMock.MaterializeDueRules.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMaterializeDueRules *mRecurringRuleUsecaseMockMaterializeDueRules) Calls() []*RecurringRuleUsecaseMockMaterializeDueRulesParams {
+	mmMaterializeDueRules.mutex.RLock()
+
+	argCopy := make([]*RecurringRuleUsecaseMockMaterializeDueRulesParams, len(mmMaterializeDueRules.callArgs))
+	copy(argCopy, mmMaterializeDueRules.callArgs)
+
+	mmMaterializeDueRules.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMaterializeDueRulesDone returns true if the count of the MaterializeDueRules invocations corresponds
+// the number of defined expectations
+func (m *RecurringRuleUsecaseMock) MinimockMaterializeDueRulesDone() bool {
+	if m.MaterializeDueRulesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MaterializeDueRulesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MaterializeDueRulesMock.invocationsDone()
+}
+
+// MinimockMaterializeDueRulesInspect logs each unmet expectation
+func (m *RecurringRuleUsecaseMock) MinimockMaterializeDueRulesInspect() {
+	for _, e := range m.MaterializeDueRulesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RecurringRuleUsecaseMock.MaterializeDueRules at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMaterializeDueRulesCounter := mm_atomic.LoadUint64(&m.afterMaterializeDueRulesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MaterializeDueRulesMock.defaultExpectation != nil && afterMaterializeDueRulesCounter < 1 {
+		if m.MaterializeDueRulesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RecurringRuleUsecaseMock.MaterializeDueRules at\n%s", m.MaterializeDueRulesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RecurringRuleUsecaseMock.MaterializeDueRules at\n%s with params: %#v", m.MaterializeDueRulesMock.defaultExpectation.expectationOrigins.origin, *m.MaterializeDueRulesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMaterializeDueRules != nil && afterMaterializeDueRulesCounter < 1 {
+		m.t.Errorf("Expected call to RecurringRuleUsecaseMock.MaterializeDueRules at\n%s", m.funcMaterializeDueRulesOrigin)
+	}
+
+	if !m.MaterializeDueRulesMock.invocationsDone() && afterMaterializeDueRulesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RecurringRuleUsecaseMock.MaterializeDueRules at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MaterializeDueRulesMock.expectedInvocations), m.MaterializeDueRulesMock.expectedInvocationsOrigin, afterMaterializeDueRulesCounter)
+	}
+}
+
+type mRecurringRuleUsecaseMockPatchRecurringRuleByDTO struct {
+	optional           bool
+	mock               *RecurringRuleUsecaseMock
+	defaultExpectation *RecurringRuleUsecaseMockPatchRecurringRuleByDTOExpectation
+	expectations       []*RecurringRuleUsecaseMockPatchRecurringRuleByDTOExpectation
+
+	callArgs []*RecurringRuleUsecaseMockPatchRecurringRuleByDTOParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RecurringRuleUsecaseMockPatchRecurringRuleByDTOExpectation specifies expectation struct of the RecurringRuleUsecase.PatchRecurringRuleByDTO
+type RecurringRuleUsecaseMockPatchRecurringRuleByDTOExpectation struct {
+	mock               *RecurringRuleUsecaseMock
+	params             *RecurringRuleUsecaseMockPatchRecurringRuleByDTOParams
+	paramPtrs          *RecurringRuleUsecaseMockPatchRecurringRuleByDTOParamPtrs
+	expectationOrigins RecurringRuleUsecaseMockPatchRecurringRuleByDTOExpectationOrigins
+	results            *RecurringRuleUsecaseMockPatchRecurringRuleByDTOResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RecurringRuleUsecaseMockPatchRecurringRuleByDTOParams contains parameters of the RecurringRuleUsecase.PatchRecurringRuleByDTO
+type RecurringRuleUsecaseMockPatchRecurringRuleByDTOParams struct {
+	ctx              context.Context
+	id               uuid.UUID
+	in               mm_usecase.PatchRecurringRuleDataInput
+	skipVersionCheck bool
+}
+
+// RecurringRuleUsecaseMockPatchRecurringRuleByDTOParamPtrs contains pointers to parameters of the RecurringRuleUsecase.PatchRecurringRuleByDTO
+type RecurringRuleUsecaseMockPatchRecurringRuleByDTOParamPtrs struct {
+	ctx              *context.Context
+	id               *uuid.UUID
+	in               *mm_usecase.PatchRecurringRuleDataInput
+	skipVersionCheck *bool
+}
+
+// RecurringRuleUsecaseMockPatchRecurringRuleByDTOResults contains results of the RecurringRuleUsecase.PatchRecurringRuleByDTO
+type RecurringRuleUsecaseMockPatchRecurringRuleByDTOResults struct {
+	err error
+}
+
+// RecurringRuleUsecaseMockPatchRecurringRuleByDTOOrigins contains origins of expectations of the RecurringRuleUsecase.PatchRecurringRuleByDTO
+type RecurringRuleUsecaseMockPatchRecurringRuleByDTOExpectationOrigins struct {
+	origin                 string
+	originCtx              string
+	originId               string
+	originIn               string
+	originSkipVersionCheck string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPatchRecurringRuleByDTO *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO) Optional() *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO {
+	mmPatchRecurringRuleByDTO.optional = true
+	return mmPatchRecurringRuleByDTO
+}
+
+// Expect sets up expected params for RecurringRuleUsecase.PatchRecurringRuleByDTO
+func (mmPatchRecurringRuleByDTO *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO) Expect(ctx context.Context, id uuid.UUID, in mm_usecase.PatchRecurringRuleDataInput, skipVersionCheck bool) *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO {
+	if mmPatchRecurringRuleByDTO.mock.funcPatchRecurringRuleByDTO != nil {
+		mmPatchRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.PatchRecurringRuleByDTO mock is already set by Set")
+	}
+
+	if mmPatchRecurringRuleByDTO.defaultExpectation == nil {
+		mmPatchRecurringRuleByDTO.defaultExpectation = &RecurringRuleUsecaseMockPatchRecurringRuleByDTOExpectation{}
+	}
+
+	if mmPatchRecurringRuleByDTO.defaultExpectation.paramPtrs != nil {
+		mmPatchRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.PatchRecurringRuleByDTO mock is already set by ExpectParams functions")
+	}
+
+	mmPatchRecurringRuleByDTO.defaultExpectation.params = &RecurringRuleUsecaseMockPatchRecurringRuleByDTOParams{ctx, id, in, skipVersionCheck}
+	mmPatchRecurringRuleByDTO.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPatchRecurringRuleByDTO.expectations {
+		if minimock.Equal(e.params, mmPatchRecurringRuleByDTO.defaultExpectation.params) {
+			mmPatchRecurringRuleByDTO.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPatchRecurringRuleByDTO.defaultExpectation.params)
+		}
+	}
+
+	return mmPatchRecurringRuleByDTO
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RecurringRuleUsecase.PatchRecurringRuleByDTO
+func (mmPatchRecurringRuleByDTO *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO) ExpectCtxParam1(ctx context.Context) *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO {
+	if mmPatchRecurringRuleByDTO.mock.funcPatchRecurringRuleByDTO != nil {
+		mmPatchRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.PatchRecurringRuleByDTO mock is already set by Set")
+	}
+
+	if mmPatchRecurringRuleByDTO.defaultExpectation == nil {
+		mmPatchRecurringRuleByDTO.defaultExpectation = &RecurringRuleUsecaseMockPatchRecurringRuleByDTOExpectation{}
+	}
+
+	if mmPatchRecurringRuleByDTO.defaultExpectation.params != nil {
+		mmPatchRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.PatchRecurringRuleByDTO mock is already set by Expect")
+	}
+
+	if mmPatchRecurringRuleByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchRecurringRuleByDTO.defaultExpectation.paramPtrs = &RecurringRuleUsecaseMockPatchRecurringRuleByDTOParamPtrs{}
+	}
+	mmPatchRecurringRuleByDTO.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPatchRecurringRuleByDTO.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPatchRecurringRuleByDTO
+}
+
+// ExpectIdParam2 sets up expected param id for RecurringRuleUsecase.PatchRecurringRuleByDTO
+func (mmPatchRecurringRuleByDTO *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO) ExpectIdParam2(id uuid.UUID) *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO {
+	if mmPatchRecurringRuleByDTO.mock.funcPatchRecurringRuleByDTO != nil {
+		mmPatchRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.PatchRecurringRuleByDTO mock is already set by Set")
+	}
+
+	if mmPatchRecurringRuleByDTO.defaultExpectation == nil {
+		mmPatchRecurringRuleByDTO.defaultExpectation = &RecurringRuleUsecaseMockPatchRecurringRuleByDTOExpectation{}
+	}
+
+	if mmPatchRecurringRuleByDTO.defaultExpectation.params != nil {
+		mmPatchRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.PatchRecurringRuleByDTO mock is already set by Expect")
+	}
+
+	if mmPatchRecurringRuleByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchRecurringRuleByDTO.defaultExpectation.paramPtrs = &RecurringRuleUsecaseMockPatchRecurringRuleByDTOParamPtrs{}
+	}
+	mmPatchRecurringRuleByDTO.defaultExpectation.paramPtrs.id = &id
+	mmPatchRecurringRuleByDTO.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmPatchRecurringRuleByDTO
+}
+
+// ExpectInParam3 sets up expected param in for RecurringRuleUsecase.PatchRecurringRuleByDTO
+func (mmPatchRecurringRuleByDTO *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO) ExpectInParam3(in mm_usecase.PatchRecurringRuleDataInput) *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO {
+	if mmPatchRecurringRuleByDTO.mock.funcPatchRecurringRuleByDTO != nil {
+		mmPatchRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.PatchRecurringRuleByDTO mock is already set by Set")
+	}
+
+	if mmPatchRecurringRuleByDTO.defaultExpectation == nil {
+		mmPatchRecurringRuleByDTO.defaultExpectation = &RecurringRuleUsecaseMockPatchRecurringRuleByDTOExpectation{}
+	}
+
+	if mmPatchRecurringRuleByDTO.defaultExpectation.params != nil {
+		mmPatchRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.PatchRecurringRuleByDTO mock is already set by Expect")
+	}
+
+	if mmPatchRecurringRuleByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchRecurringRuleByDTO.defaultExpectation.paramPtrs = &RecurringRuleUsecaseMockPatchRecurringRuleByDTOParamPtrs{}
+	}
+	mmPatchRecurringRuleByDTO.defaultExpectation.paramPtrs.in = &in
+	mmPatchRecurringRuleByDTO.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmPatchRecurringRuleByDTO
+}
+
+// ExpectSkipVersionCheckParam4 sets up expected param skipVersionCheck for RecurringRuleUsecase.PatchRecurringRuleByDTO
+func (mmPatchRecurringRuleByDTO *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO) ExpectSkipVersionCheckParam4(skipVersionCheck bool) *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO {
+	if mmPatchRecurringRuleByDTO.mock.funcPatchRecurringRuleByDTO != nil {
+		mmPatchRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.PatchRecurringRuleByDTO mock is already set by Set")
+	}
+
+	if mmPatchRecurringRuleByDTO.defaultExpectation == nil {
+		mmPatchRecurringRuleByDTO.defaultExpectation = &RecurringRuleUsecaseMockPatchRecurringRuleByDTOExpectation{}
+	}
+
+	if mmPatchRecurringRuleByDTO.defaultExpectation.params != nil {
+		mmPatchRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.PatchRecurringRuleByDTO mock is already set by Expect")
+	}
+
+	if mmPatchRecurringRuleByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchRecurringRuleByDTO.defaultExpectation.paramPtrs = &RecurringRuleUsecaseMockPatchRecurringRuleByDTOParamPtrs{}
+	}
+	mmPatchRecurringRuleByDTO.defaultExpectation.paramPtrs.skipVersionCheck = &skipVersionCheck
+	mmPatchRecurringRuleByDTO.defaultExpectation.expectationOrigins.originSkipVersionCheck = minimock.CallerInfo(1)
+
+	return mmPatchRecurringRuleByDTO
+}
+
+// Inspect accepts an inspector function that has same arguments as the RecurringRuleUsecase.PatchRecurringRuleByDTO
+func (mmPatchRecurringRuleByDTO *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO) Inspect(f func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchRecurringRuleDataInput, skipVersionCheck bool)) *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO {
+	if mmPatchRecurringRuleByDTO.mock.inspectFuncPatchRecurringRuleByDTO != nil {
+		mmPatchRecurringRuleByDTO.mock.t.Fatalf("Inspect function is already set for RecurringRuleUsecaseMock.PatchRecurringRuleByDTO")
+	}
+
+	mmPatchRecurringRuleByDTO.mock.inspectFuncPatchRecurringRuleByDTO = f
+
+	return mmPatchRecurringRuleByDTO
+}
+
+// Return sets up results that will be returned by RecurringRuleUsecase.PatchRecurringRuleByDTO
+func (mmPatchRecurringRuleByDTO *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO) Return(err error) *RecurringRuleUsecaseMock {
+	if mmPatchRecurringRuleByDTO.mock.funcPatchRecurringRuleByDTO != nil {
+		mmPatchRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.PatchRecurringRuleByDTO mock is already set by Set")
+	}
+
+	if mmPatchRecurringRuleByDTO.defaultExpectation == nil {
+		mmPatchRecurringRuleByDTO.defaultExpectation = &RecurringRuleUsecaseMockPatchRecurringRuleByDTOExpectation{mock: mmPatchRecurringRuleByDTO.mock}
+	}
+	mmPatchRecurringRuleByDTO.defaultExpectation.results = &RecurringRuleUsecaseMockPatchRecurringRuleByDTOResults{err}
+	mmPatchRecurringRuleByDTO.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPatchRecurringRuleByDTO.mock
+}
+
+// Set uses given function f to mock the RecurringRuleUsecase.PatchRecurringRuleByDTO method
+func (mmPatchRecurringRuleByDTO *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO) Set(f func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchRecurringRuleDataInput, skipVersionCheck bool) (err error)) *RecurringRuleUsecaseMock {
+	if mmPatchRecurringRuleByDTO.defaultExpectation != nil {
+		mmPatchRecurringRuleByDTO.mock.t.Fatalf("Default expectation is already set for the RecurringRuleUsecase.PatchRecurringRuleByDTO method")
+	}
+
+	if len(mmPatchRecurringRuleByDTO.expectations) > 0 {
+		mmPatchRecurringRuleByDTO.mock.t.Fatalf("Some expectations are already set for the RecurringRuleUsecase.PatchRecurringRuleByDTO method")
+	}
+
+	mmPatchRecurringRuleByDTO.mock.funcPatchRecurringRuleByDTO = f
+	mmPatchRecurringRuleByDTO.mock.funcPatchRecurringRuleByDTOOrigin = minimock.CallerInfo(1)
+	return mmPatchRecurringRuleByDTO.mock
+}
+
+// When sets expectation for the RecurringRuleUsecase.PatchRecurringRuleByDTO which will trigger the result defined by the following
+// Then helper
+func (mmPatchRecurringRuleByDTO *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO) When(ctx context.Context, id uuid.UUID, in mm_usecase.PatchRecurringRuleDataInput, skipVersionCheck bool) *RecurringRuleUsecaseMockPatchRecurringRuleByDTOExpectation {
+	if mmPatchRecurringRuleByDTO.mock.funcPatchRecurringRuleByDTO != nil {
+		mmPatchRecurringRuleByDTO.mock.t.Fatalf("RecurringRuleUsecaseMock.PatchRecurringRuleByDTO mock is already set by Set")
+	}
+
+	expectation := &RecurringRuleUsecaseMockPatchRecurringRuleByDTOExpectation{
+		mock:               mmPatchRecurringRuleByDTO.mock,
+		params:             &RecurringRuleUsecaseMockPatchRecurringRuleByDTOParams{ctx, id, in, skipVersionCheck},
+		expectationOrigins: RecurringRuleUsecaseMockPatchRecurringRuleByDTOExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPatchRecurringRuleByDTO.expectations = append(mmPatchRecurringRuleByDTO.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RecurringRuleUsecase.PatchRecurringRuleByDTO return parameters for the expectation previously defined by the When method
+func (e *RecurringRuleUsecaseMockPatchRecurringRuleByDTOExpectation) Then(err error) *RecurringRuleUsecaseMock {
+	e.results = &RecurringRuleUsecaseMockPatchRecurringRuleByDTOResults{err}
+	return e.mock
+}
+
+// Times sets number of times RecurringRuleUsecase.PatchRecurringRuleByDTO should be invoked
+func (mmPatchRecurringRuleByDTO *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO) Times(n uint64) *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO {
+	if n == 0 {
+		mmPatchRecurringRuleByDTO.mock.t.Fatalf("Times of RecurringRuleUsecaseMock.PatchRecurringRuleByDTO mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPatchRecurringRuleByDTO.expectedInvocations, n)
+	mmPatchRecurringRuleByDTO.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPatchRecurringRuleByDTO
+}
+
+func (mmPatchRecurringRuleByDTO *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO) invocationsDone() bool {
+	if len(mmPatchRecurringRuleByDTO.expectations) == 0 && mmPatchRecurringRuleByDTO.defaultExpectation == nil && mmPatchRecurringRuleByDTO.mock.funcPatchRecurringRuleByDTO == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPatchRecurringRuleByDTO.mock.afterPatchRecurringRuleByDTOCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPatchRecurringRuleByDTO.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PatchRecurringRuleByDTO implements mm_usecase.RecurringRuleUsecase
+func (mmPatchRecurringRuleByDTO *RecurringRuleUsecaseMock) PatchRecurringRuleByDTO(ctx context.Context, id uuid.UUID, in mm_usecase.PatchRecurringRuleDataInput, skipVersionCheck bool) (err error) {
+	mm_atomic.AddUint64(&mmPatchRecurringRuleByDTO.beforePatchRecurringRuleByDTOCounter, 1)
+	defer mm_atomic.AddUint64(&mmPatchRecurringRuleByDTO.afterPatchRecurringRuleByDTOCounter, 1)
+
+	mmPatchRecurringRuleByDTO.t.Helper()
+
+	if mmPatchRecurringRuleByDTO.inspectFuncPatchRecurringRuleByDTO != nil {
+		mmPatchRecurringRuleByDTO.inspectFuncPatchRecurringRuleByDTO(ctx, id, in, skipVersionCheck)
+	}
+
+	mm_params := RecurringRuleUsecaseMockPatchRecurringRuleByDTOParams{ctx, id, in, skipVersionCheck}
+
+	// Record call args
+	mmPatchRecurringRuleByDTO.PatchRecurringRuleByDTOMock.mutex.Lock()
+	mmPatchRecurringRuleByDTO.PatchRecurringRuleByDTOMock.callArgs = append(mmPatchRecurringRuleByDTO.PatchRecurringRuleByDTOMock.callArgs, &mm_params)
+	mmPatchRecurringRuleByDTO.PatchRecurringRuleByDTOMock.mutex.Unlock()
+
+	for _, e := range mmPatchRecurringRuleByDTO.PatchRecurringRuleByDTOMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPatchRecurringRuleByDTO.PatchRecurringRuleByDTOMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPatchRecurringRuleByDTO.PatchRecurringRuleByDTOMock.defaultExpectation.Counter, 1)
+		mm_want := mmPatchRecurringRuleByDTO.PatchRecurringRuleByDTOMock.defaultExpectation.params
+		mm_want_ptrs := mmPatchRecurringRuleByDTO.PatchRecurringRuleByDTOMock.defaultExpectation.paramPtrs
+
+		mm_got := RecurringRuleUsecaseMockPatchRecurringRuleByDTOParams{ctx, id, in, skipVersionCheck}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPatchRecurringRuleByDTO.t.Errorf("RecurringRuleUsecaseMock.PatchRecurringRuleByDTO got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchRecurringRuleByDTO.PatchRecurringRuleByDTOMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmPatchRecurringRuleByDTO.t.Errorf("RecurringRuleUsecaseMock.PatchRecurringRuleByDTO got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchRecurringRuleByDTO.PatchRecurringRuleByDTOMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmPatchRecurringRuleByDTO.t.Errorf("RecurringRuleUsecaseMock.PatchRecurringRuleByDTO got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchRecurringRuleByDTO.PatchRecurringRuleByDTOMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+			if mm_want_ptrs.skipVersionCheck != nil && !minimock.Equal(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck) {
+				mmPatchRecurringRuleByDTO.t.Errorf("RecurringRuleUsecaseMock.PatchRecurringRuleByDTO got unexpected parameter skipVersionCheck, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchRecurringRuleByDTO.PatchRecurringRuleByDTOMock.defaultExpectation.expectationOrigins.originSkipVersionCheck, *mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck, minimock.Diff(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPatchRecurringRuleByDTO.t.Errorf("RecurringRuleUsecaseMock.PatchRecurringRuleByDTO got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPatchRecurringRuleByDTO.PatchRecurringRuleByDTOMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPatchRecurringRuleByDTO.PatchRecurringRuleByDTOMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPatchRecurringRuleByDTO.t.Fatal("No results are set for the RecurringRuleUsecaseMock.PatchRecurringRuleByDTO")
+		}
+		return (*mm_results).err
+	}
+	if mmPatchRecurringRuleByDTO.funcPatchRecurringRuleByDTO != nil {
+		return mmPatchRecurringRuleByDTO.funcPatchRecurringRuleByDTO(ctx, id, in, skipVersionCheck)
+	}
+	mmPatchRecurringRuleByDTO.t.Fatalf("Unexpected call to RecurringRuleUsecaseMock.PatchRecurringRuleByDTO. %v %v %v %v", ctx, id, in, skipVersionCheck)
+	return
+}
+
+// PatchRecurringRuleByDTOAfterCounter returns a count of finished RecurringRuleUsecaseMock.PatchRecurringRuleByDTO invocations
+func (mmPatchRecurringRuleByDTO *RecurringRuleUsecaseMock) PatchRecurringRuleByDTOAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPatchRecurringRuleByDTO.afterPatchRecurringRuleByDTOCounter)
+}
+
+// PatchRecurringRuleByDTOBeforeCounter returns a count of RecurringRuleUsecaseMock.PatchRecurringRuleByDTO invocations
+func (mmPatchRecurringRuleByDTO *RecurringRuleUsecaseMock) PatchRecurringRuleByDTOBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPatchRecurringRuleByDTO.beforePatchRecurringRuleByDTOCounter)
+}
+
+// Calls returns a list of arguments used in each call to RecurringRuleUsecaseMock.PatchRecurringRuleByDTO.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPatchRecurringRuleByDTO *mRecurringRuleUsecaseMockPatchRecurringRuleByDTO) Calls() []*RecurringRuleUsecaseMockPatchRecurringRuleByDTOParams {
+	mmPatchRecurringRuleByDTO.mutex.RLock()
+
+	argCopy := make([]*RecurringRuleUsecaseMockPatchRecurringRuleByDTOParams, len(mmPatchRecurringRuleByDTO.callArgs))
+	copy(argCopy, mmPatchRecurringRuleByDTO.callArgs)
+
+	mmPatchRecurringRuleByDTO.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPatchRecurringRuleByDTODone returns true if the count of the PatchRecurringRuleByDTO invocations corresponds
+// the number of defined expectations
+func (m *RecurringRuleUsecaseMock) MinimockPatchRecurringRuleByDTODone() bool {
+	if m.PatchRecurringRuleByDTOMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PatchRecurringRuleByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PatchRecurringRuleByDTOMock.invocationsDone()
+}
+
+// MinimockPatchRecurringRuleByDTOInspect logs each unmet expectation
+func (m *RecurringRuleUsecaseMock) MinimockPatchRecurringRuleByDTOInspect() {
+	for _, e := range m.PatchRecurringRuleByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RecurringRuleUsecaseMock.PatchRecurringRuleByDTO at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPatchRecurringRuleByDTOCounter := mm_atomic.LoadUint64(&m.afterPatchRecurringRuleByDTOCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PatchRecurringRuleByDTOMock.defaultExpectation != nil && afterPatchRecurringRuleByDTOCounter < 1 {
+		if m.PatchRecurringRuleByDTOMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RecurringRuleUsecaseMock.PatchRecurringRuleByDTO at\n%s", m.PatchRecurringRuleByDTOMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RecurringRuleUsecaseMock.PatchRecurringRuleByDTO at\n%s with params: %#v", m.PatchRecurringRuleByDTOMock.defaultExpectation.expectationOrigins.origin, *m.PatchRecurringRuleByDTOMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPatchRecurringRuleByDTO != nil && afterPatchRecurringRuleByDTOCounter < 1 {
+		m.t.Errorf("Expected call to RecurringRuleUsecaseMock.PatchRecurringRuleByDTO at\n%s", m.funcPatchRecurringRuleByDTOOrigin)
+	}
+
+	if !m.PatchRecurringRuleByDTOMock.invocationsDone() && afterPatchRecurringRuleByDTOCounter > 0 {
+		m.t.Errorf("Expected %d calls to RecurringRuleUsecaseMock.PatchRecurringRuleByDTO at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PatchRecurringRuleByDTOMock.expectedInvocations), m.PatchRecurringRuleByDTOMock.expectedInvocationsOrigin, afterPatchRecurringRuleByDTOCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RecurringRuleUsecaseMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateRecurringRuleByDTOInspect()
+
+			m.MinimockDeleteRecurringRuleByIDInspect()
+
+			m.MinimockFindListInspect()
+
+			m.MinimockFindOneByIDInspect()
+
+			m.MinimockMaterializeDueRulesInspect()
+
+			m.MinimockPatchRecurringRuleByDTOInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RecurringRuleUsecaseMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RecurringRuleUsecaseMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateRecurringRuleByDTODone() &&
+		m.MinimockDeleteRecurringRuleByIDDone() &&
+		m.MinimockFindListDone() &&
+		m.MinimockFindOneByIDDone() &&
+		m.MinimockMaterializeDueRulesDone() &&
+		m.MinimockPatchRecurringRuleByDTODone()
+}
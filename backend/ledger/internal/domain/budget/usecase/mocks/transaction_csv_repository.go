@@ -21,9 +21,9 @@ type TransactionCSVRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcItemsFromCSV          func(ctx context.Context, data []byte, accountID uuid.UUID) (items []*entity.Transaction, err error)
+	funcItemsFromCSV          func(ctx context.Context, data []byte, accountID uuid.UUID, defaultCurrency string, profile *entity.ImportProfile) (rows []*mm_usecase.TransactionImportRow, err error)
 	funcItemsFromCSVOrigin    string
-	inspectFuncItemsFromCSV   func(ctx context.Context, data []byte, accountID uuid.UUID)
+	inspectFuncItemsFromCSV   func(ctx context.Context, data []byte, accountID uuid.UUID, defaultCurrency string, profile *entity.ImportProfile)
 	afterItemsFromCSVCounter  uint64
 	beforeItemsFromCSVCounter uint64
 	ItemsFromCSVMock          mTransactionCSVRepositoryMockItemsFromCSV
@@ -34,6 +34,13 @@ type TransactionCSVRepositoryMock struct {
 	afterItemsToCSVCounter  uint64
 	beforeItemsToCSVCounter uint64
 	ItemsToCSVMock          mTransactionCSVRepositoryMockItemsToCSV
+
+	funcItemsToCSVChunk          func(ctx context.Context, items []*mm_usecase.TransactionDTO, withHeader bool) (ba1 []byte, err error)
+	funcItemsToCSVChunkOrigin    string
+	inspectFuncItemsToCSVChunk   func(ctx context.Context, items []*mm_usecase.TransactionDTO, withHeader bool)
+	afterItemsToCSVChunkCounter  uint64
+	beforeItemsToCSVChunkCounter uint64
+	ItemsToCSVChunkMock          mTransactionCSVRepositoryMockItemsToCSVChunk
 }
 
 // NewTransactionCSVRepositoryMock returns a mock for mm_usecase.TransactionCSVRepository
@@ -50,6 +57,9 @@ func NewTransactionCSVRepositoryMock(t minimock.Tester) *TransactionCSVRepositor
 	m.ItemsToCSVMock = mTransactionCSVRepositoryMockItemsToCSV{mock: m}
 	m.ItemsToCSVMock.callArgs = []*TransactionCSVRepositoryMockItemsToCSVParams{}
 
+	m.ItemsToCSVChunkMock = mTransactionCSVRepositoryMockItemsToCSVChunk{mock: m}
+	m.ItemsToCSVChunkMock.callArgs = []*TransactionCSVRepositoryMockItemsToCSVChunkParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -81,30 +91,36 @@ type TransactionCSVRepositoryMockItemsFromCSVExpectation struct {
 
 // TransactionCSVRepositoryMockItemsFromCSVParams contains parameters of the TransactionCSVRepository.ItemsFromCSV
 type TransactionCSVRepositoryMockItemsFromCSVParams struct {
-	ctx       context.Context
-	data      []byte
-	accountID uuid.UUID
+	ctx             context.Context
+	data            []byte
+	accountID       uuid.UUID
+	defaultCurrency string
+	profile         *entity.ImportProfile
 }
 
 // TransactionCSVRepositoryMockItemsFromCSVParamPtrs contains pointers to parameters of the TransactionCSVRepository.ItemsFromCSV
 type TransactionCSVRepositoryMockItemsFromCSVParamPtrs struct {
-	ctx       *context.Context
-	data      *[]byte
-	accountID *uuid.UUID
+	ctx             *context.Context
+	data            *[]byte
+	accountID       *uuid.UUID
+	defaultCurrency *string
+	profile         **entity.ImportProfile
 }
 
 // TransactionCSVRepositoryMockItemsFromCSVResults contains results of the TransactionCSVRepository.ItemsFromCSV
 type TransactionCSVRepositoryMockItemsFromCSVResults struct {
-	items []*entity.Transaction
-	err   error
+	rows []*mm_usecase.TransactionImportRow
+	err  error
 }
 
 // TransactionCSVRepositoryMockItemsFromCSVOrigins contains origins of expectations of the TransactionCSVRepository.ItemsFromCSV
 type TransactionCSVRepositoryMockItemsFromCSVExpectationOrigins struct {
-	origin          string
-	originCtx       string
-	originData      string
-	originAccountID string
+	origin                string
+	originCtx             string
+	originData            string
+	originAccountID       string
+	originDefaultCurrency string
+	originProfile         string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -118,7 +134,7 @@ func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) Optional() *mTr
 }
 
 // Expect sets up expected params for TransactionCSVRepository.ItemsFromCSV
-func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) Expect(ctx context.Context, data []byte, accountID uuid.UUID) *mTransactionCSVRepositoryMockItemsFromCSV {
+func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) Expect(ctx context.Context, data []byte, accountID uuid.UUID, defaultCurrency string, profile *entity.ImportProfile) *mTransactionCSVRepositoryMockItemsFromCSV {
 	if mmItemsFromCSV.mock.funcItemsFromCSV != nil {
 		mmItemsFromCSV.mock.t.Fatalf("TransactionCSVRepositoryMock.ItemsFromCSV mock is already set by Set")
 	}
@@ -131,7 +147,7 @@ func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) Expect(ctx cont
 		mmItemsFromCSV.mock.t.Fatalf("TransactionCSVRepositoryMock.ItemsFromCSV mock is already set by ExpectParams functions")
 	}
 
-	mmItemsFromCSV.defaultExpectation.params = &TransactionCSVRepositoryMockItemsFromCSVParams{ctx, data, accountID}
+	mmItemsFromCSV.defaultExpectation.params = &TransactionCSVRepositoryMockItemsFromCSVParams{ctx, data, accountID, defaultCurrency, profile}
 	mmItemsFromCSV.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmItemsFromCSV.expectations {
 		if minimock.Equal(e.params, mmItemsFromCSV.defaultExpectation.params) {
@@ -211,8 +227,54 @@ func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) ExpectAccountID
 	return mmItemsFromCSV
 }
 
+// ExpectDefaultCurrencyParam4 sets up expected param defaultCurrency for TransactionCSVRepository.ItemsFromCSV
+func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) ExpectDefaultCurrencyParam4(defaultCurrency string) *mTransactionCSVRepositoryMockItemsFromCSV {
+	if mmItemsFromCSV.mock.funcItemsFromCSV != nil {
+		mmItemsFromCSV.mock.t.Fatalf("TransactionCSVRepositoryMock.ItemsFromCSV mock is already set by Set")
+	}
+
+	if mmItemsFromCSV.defaultExpectation == nil {
+		mmItemsFromCSV.defaultExpectation = &TransactionCSVRepositoryMockItemsFromCSVExpectation{}
+	}
+
+	if mmItemsFromCSV.defaultExpectation.params != nil {
+		mmItemsFromCSV.mock.t.Fatalf("TransactionCSVRepositoryMock.ItemsFromCSV mock is already set by Expect")
+	}
+
+	if mmItemsFromCSV.defaultExpectation.paramPtrs == nil {
+		mmItemsFromCSV.defaultExpectation.paramPtrs = &TransactionCSVRepositoryMockItemsFromCSVParamPtrs{}
+	}
+	mmItemsFromCSV.defaultExpectation.paramPtrs.defaultCurrency = &defaultCurrency
+	mmItemsFromCSV.defaultExpectation.expectationOrigins.originDefaultCurrency = minimock.CallerInfo(1)
+
+	return mmItemsFromCSV
+}
+
+// ExpectProfileParam5 sets up expected param profile for TransactionCSVRepository.ItemsFromCSV
+func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) ExpectProfileParam5(profile *entity.ImportProfile) *mTransactionCSVRepositoryMockItemsFromCSV {
+	if mmItemsFromCSV.mock.funcItemsFromCSV != nil {
+		mmItemsFromCSV.mock.t.Fatalf("TransactionCSVRepositoryMock.ItemsFromCSV mock is already set by Set")
+	}
+
+	if mmItemsFromCSV.defaultExpectation == nil {
+		mmItemsFromCSV.defaultExpectation = &TransactionCSVRepositoryMockItemsFromCSVExpectation{}
+	}
+
+	if mmItemsFromCSV.defaultExpectation.params != nil {
+		mmItemsFromCSV.mock.t.Fatalf("TransactionCSVRepositoryMock.ItemsFromCSV mock is already set by Expect")
+	}
+
+	if mmItemsFromCSV.defaultExpectation.paramPtrs == nil {
+		mmItemsFromCSV.defaultExpectation.paramPtrs = &TransactionCSVRepositoryMockItemsFromCSVParamPtrs{}
+	}
+	mmItemsFromCSV.defaultExpectation.paramPtrs.profile = &profile
+	mmItemsFromCSV.defaultExpectation.expectationOrigins.originProfile = minimock.CallerInfo(1)
+
+	return mmItemsFromCSV
+}
+
 // Inspect accepts an inspector function that has same arguments as the TransactionCSVRepository.ItemsFromCSV
-func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) Inspect(f func(ctx context.Context, data []byte, accountID uuid.UUID)) *mTransactionCSVRepositoryMockItemsFromCSV {
+func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) Inspect(f func(ctx context.Context, data []byte, accountID uuid.UUID, defaultCurrency string, profile *entity.ImportProfile)) *mTransactionCSVRepositoryMockItemsFromCSV {
 	if mmItemsFromCSV.mock.inspectFuncItemsFromCSV != nil {
 		mmItemsFromCSV.mock.t.Fatalf("Inspect function is already set for TransactionCSVRepositoryMock.ItemsFromCSV")
 	}
@@ -223,7 +285,7 @@ func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) Inspect(f func(
 }
 
 // Return sets up results that will be returned by TransactionCSVRepository.ItemsFromCSV
-func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) Return(items []*entity.Transaction, err error) *TransactionCSVRepositoryMock {
+func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) Return(rows []*mm_usecase.TransactionImportRow, err error) *TransactionCSVRepositoryMock {
 	if mmItemsFromCSV.mock.funcItemsFromCSV != nil {
 		mmItemsFromCSV.mock.t.Fatalf("TransactionCSVRepositoryMock.ItemsFromCSV mock is already set by Set")
 	}
@@ -231,13 +293,13 @@ func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) Return(items []
 	if mmItemsFromCSV.defaultExpectation == nil {
 		mmItemsFromCSV.defaultExpectation = &TransactionCSVRepositoryMockItemsFromCSVExpectation{mock: mmItemsFromCSV.mock}
 	}
-	mmItemsFromCSV.defaultExpectation.results = &TransactionCSVRepositoryMockItemsFromCSVResults{items, err}
+	mmItemsFromCSV.defaultExpectation.results = &TransactionCSVRepositoryMockItemsFromCSVResults{rows, err}
 	mmItemsFromCSV.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
 	return mmItemsFromCSV.mock
 }
 
 // Set uses given function f to mock the TransactionCSVRepository.ItemsFromCSV method
-func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) Set(f func(ctx context.Context, data []byte, accountID uuid.UUID) (items []*entity.Transaction, err error)) *TransactionCSVRepositoryMock {
+func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) Set(f func(ctx context.Context, data []byte, accountID uuid.UUID, defaultCurrency string, profile *entity.ImportProfile) (rows []*mm_usecase.TransactionImportRow, err error)) *TransactionCSVRepositoryMock {
 	if mmItemsFromCSV.defaultExpectation != nil {
 		mmItemsFromCSV.mock.t.Fatalf("Default expectation is already set for the TransactionCSVRepository.ItemsFromCSV method")
 	}
@@ -253,14 +315,14 @@ func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) Set(f func(ctx
 
 // When sets expectation for the TransactionCSVRepository.ItemsFromCSV which will trigger the result defined by the following
 // Then helper
-func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) When(ctx context.Context, data []byte, accountID uuid.UUID) *TransactionCSVRepositoryMockItemsFromCSVExpectation {
+func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) When(ctx context.Context, data []byte, accountID uuid.UUID, defaultCurrency string, profile *entity.ImportProfile) *TransactionCSVRepositoryMockItemsFromCSVExpectation {
 	if mmItemsFromCSV.mock.funcItemsFromCSV != nil {
 		mmItemsFromCSV.mock.t.Fatalf("TransactionCSVRepositoryMock.ItemsFromCSV mock is already set by Set")
 	}
 
 	expectation := &TransactionCSVRepositoryMockItemsFromCSVExpectation{
 		mock:               mmItemsFromCSV.mock,
-		params:             &TransactionCSVRepositoryMockItemsFromCSVParams{ctx, data, accountID},
+		params:             &TransactionCSVRepositoryMockItemsFromCSVParams{ctx, data, accountID, defaultCurrency, profile},
 		expectationOrigins: TransactionCSVRepositoryMockItemsFromCSVExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmItemsFromCSV.expectations = append(mmItemsFromCSV.expectations, expectation)
@@ -268,8 +330,8 @@ func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) When(ctx contex
 }
 
 // Then sets up TransactionCSVRepository.ItemsFromCSV return parameters for the expectation previously defined by the When method
-func (e *TransactionCSVRepositoryMockItemsFromCSVExpectation) Then(items []*entity.Transaction, err error) *TransactionCSVRepositoryMock {
-	e.results = &TransactionCSVRepositoryMockItemsFromCSVResults{items, err}
+func (e *TransactionCSVRepositoryMockItemsFromCSVExpectation) Then(rows []*mm_usecase.TransactionImportRow, err error) *TransactionCSVRepositoryMock {
+	e.results = &TransactionCSVRepositoryMockItemsFromCSVResults{rows, err}
 	return e.mock
 }
 
@@ -295,17 +357,17 @@ func (mmItemsFromCSV *mTransactionCSVRepositoryMockItemsFromCSV) invocationsDone
 }
 
 // ItemsFromCSV implements mm_usecase.TransactionCSVRepository
-func (mmItemsFromCSV *TransactionCSVRepositoryMock) ItemsFromCSV(ctx context.Context, data []byte, accountID uuid.UUID) (items []*entity.Transaction, err error) {
+func (mmItemsFromCSV *TransactionCSVRepositoryMock) ItemsFromCSV(ctx context.Context, data []byte, accountID uuid.UUID, defaultCurrency string, profile *entity.ImportProfile) (rows []*mm_usecase.TransactionImportRow, err error) {
 	mm_atomic.AddUint64(&mmItemsFromCSV.beforeItemsFromCSVCounter, 1)
 	defer mm_atomic.AddUint64(&mmItemsFromCSV.afterItemsFromCSVCounter, 1)
 
 	mmItemsFromCSV.t.Helper()
 
 	if mmItemsFromCSV.inspectFuncItemsFromCSV != nil {
-		mmItemsFromCSV.inspectFuncItemsFromCSV(ctx, data, accountID)
+		mmItemsFromCSV.inspectFuncItemsFromCSV(ctx, data, accountID, defaultCurrency, profile)
 	}
 
-	mm_params := TransactionCSVRepositoryMockItemsFromCSVParams{ctx, data, accountID}
+	mm_params := TransactionCSVRepositoryMockItemsFromCSVParams{ctx, data, accountID, defaultCurrency, profile}
 
 	// Record call args
 	mmItemsFromCSV.ItemsFromCSVMock.mutex.Lock()
@@ -315,7 +377,7 @@ func (mmItemsFromCSV *TransactionCSVRepositoryMock) ItemsFromCSV(ctx context.Con
 	for _, e := range mmItemsFromCSV.ItemsFromCSVMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.items, e.results.err
+			return e.results.rows, e.results.err
 		}
 	}
 
@@ -324,7 +386,7 @@ func (mmItemsFromCSV *TransactionCSVRepositoryMock) ItemsFromCSV(ctx context.Con
 		mm_want := mmItemsFromCSV.ItemsFromCSVMock.defaultExpectation.params
 		mm_want_ptrs := mmItemsFromCSV.ItemsFromCSVMock.defaultExpectation.paramPtrs
 
-		mm_got := TransactionCSVRepositoryMockItemsFromCSVParams{ctx, data, accountID}
+		mm_got := TransactionCSVRepositoryMockItemsFromCSVParams{ctx, data, accountID, defaultCurrency, profile}
 
 		if mm_want_ptrs != nil {
 
@@ -343,6 +405,16 @@ func (mmItemsFromCSV *TransactionCSVRepositoryMock) ItemsFromCSV(ctx context.Con
 					mmItemsFromCSV.ItemsFromCSVMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
 			}
 
+			if mm_want_ptrs.defaultCurrency != nil && !minimock.Equal(*mm_want_ptrs.defaultCurrency, mm_got.defaultCurrency) {
+				mmItemsFromCSV.t.Errorf("TransactionCSVRepositoryMock.ItemsFromCSV got unexpected parameter defaultCurrency, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmItemsFromCSV.ItemsFromCSVMock.defaultExpectation.expectationOrigins.originDefaultCurrency, *mm_want_ptrs.defaultCurrency, mm_got.defaultCurrency, minimock.Diff(*mm_want_ptrs.defaultCurrency, mm_got.defaultCurrency))
+			}
+
+			if mm_want_ptrs.profile != nil && !minimock.Equal(*mm_want_ptrs.profile, mm_got.profile) {
+				mmItemsFromCSV.t.Errorf("TransactionCSVRepositoryMock.ItemsFromCSV got unexpected parameter profile, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmItemsFromCSV.ItemsFromCSVMock.defaultExpectation.expectationOrigins.originProfile, *mm_want_ptrs.profile, mm_got.profile, minimock.Diff(*mm_want_ptrs.profile, mm_got.profile))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmItemsFromCSV.t.Errorf("TransactionCSVRepositoryMock.ItemsFromCSV got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmItemsFromCSV.ItemsFromCSVMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -352,12 +424,12 @@ func (mmItemsFromCSV *TransactionCSVRepositoryMock) ItemsFromCSV(ctx context.Con
 		if mm_results == nil {
 			mmItemsFromCSV.t.Fatal("No results are set for the TransactionCSVRepositoryMock.ItemsFromCSV")
 		}
-		return (*mm_results).items, (*mm_results).err
+		return (*mm_results).rows, (*mm_results).err
 	}
 	if mmItemsFromCSV.funcItemsFromCSV != nil {
-		return mmItemsFromCSV.funcItemsFromCSV(ctx, data, accountID)
+		return mmItemsFromCSV.funcItemsFromCSV(ctx, data, accountID, defaultCurrency, profile)
 	}
-	mmItemsFromCSV.t.Fatalf("Unexpected call to TransactionCSVRepositoryMock.ItemsFromCSV. %v %v %v", ctx, data, accountID)
+	mmItemsFromCSV.t.Fatalf("Unexpected call to TransactionCSVRepositoryMock.ItemsFromCSV. %v %v %v %v %v", ctx, data, accountID, defaultCurrency, profile)
 	return
 }
 
@@ -772,6 +844,380 @@ func (m *TransactionCSVRepositoryMock) MinimockItemsToCSVInspect() {
 	}
 }
 
+type mTransactionCSVRepositoryMockItemsToCSVChunk struct {
+	optional           bool
+	mock               *TransactionCSVRepositoryMock
+	defaultExpectation *TransactionCSVRepositoryMockItemsToCSVChunkExpectation
+	expectations       []*TransactionCSVRepositoryMockItemsToCSVChunkExpectation
+
+	callArgs []*TransactionCSVRepositoryMockItemsToCSVChunkParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransactionCSVRepositoryMockItemsToCSVChunkExpectation specifies expectation struct of the TransactionCSVRepository.ItemsToCSVChunk
+type TransactionCSVRepositoryMockItemsToCSVChunkExpectation struct {
+	mock               *TransactionCSVRepositoryMock
+	params             *TransactionCSVRepositoryMockItemsToCSVChunkParams
+	paramPtrs          *TransactionCSVRepositoryMockItemsToCSVChunkParamPtrs
+	expectationOrigins TransactionCSVRepositoryMockItemsToCSVChunkExpectationOrigins
+	results            *TransactionCSVRepositoryMockItemsToCSVChunkResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TransactionCSVRepositoryMockItemsToCSVChunkParams contains parameters of the TransactionCSVRepository.ItemsToCSVChunk
+type TransactionCSVRepositoryMockItemsToCSVChunkParams struct {
+	ctx        context.Context
+	items      []*mm_usecase.TransactionDTO
+	withHeader bool
+}
+
+// TransactionCSVRepositoryMockItemsToCSVChunkParamPtrs contains pointers to parameters of the TransactionCSVRepository.ItemsToCSVChunk
+type TransactionCSVRepositoryMockItemsToCSVChunkParamPtrs struct {
+	ctx        *context.Context
+	items      *[]*mm_usecase.TransactionDTO
+	withHeader *bool
+}
+
+// TransactionCSVRepositoryMockItemsToCSVChunkResults contains results of the TransactionCSVRepository.ItemsToCSVChunk
+type TransactionCSVRepositoryMockItemsToCSVChunkResults struct {
+	ba1 []byte
+	err error
+}
+
+// TransactionCSVRepositoryMockItemsToCSVChunkOrigins contains origins of expectations of the TransactionCSVRepository.ItemsToCSVChunk
+type TransactionCSVRepositoryMockItemsToCSVChunkExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originItems      string
+	originWithHeader string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmItemsToCSVChunk *mTransactionCSVRepositoryMockItemsToCSVChunk) Optional() *mTransactionCSVRepositoryMockItemsToCSVChunk {
+	mmItemsToCSVChunk.optional = true
+	return mmItemsToCSVChunk
+}
+
+// Expect sets up expected params for TransactionCSVRepository.ItemsToCSVChunk
+func (mmItemsToCSVChunk *mTransactionCSVRepositoryMockItemsToCSVChunk) Expect(ctx context.Context, items []*mm_usecase.TransactionDTO, withHeader bool) *mTransactionCSVRepositoryMockItemsToCSVChunk {
+	if mmItemsToCSVChunk.mock.funcItemsToCSVChunk != nil {
+		mmItemsToCSVChunk.mock.t.Fatalf("TransactionCSVRepositoryMock.ItemsToCSVChunk mock is already set by Set")
+	}
+
+	if mmItemsToCSVChunk.defaultExpectation == nil {
+		mmItemsToCSVChunk.defaultExpectation = &TransactionCSVRepositoryMockItemsToCSVChunkExpectation{}
+	}
+
+	if mmItemsToCSVChunk.defaultExpectation.paramPtrs != nil {
+		mmItemsToCSVChunk.mock.t.Fatalf("TransactionCSVRepositoryMock.ItemsToCSVChunk mock is already set by ExpectParams functions")
+	}
+
+	mmItemsToCSVChunk.defaultExpectation.params = &TransactionCSVRepositoryMockItemsToCSVChunkParams{ctx, items, withHeader}
+	mmItemsToCSVChunk.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmItemsToCSVChunk.expectations {
+		if minimock.Equal(e.params, mmItemsToCSVChunk.defaultExpectation.params) {
+			mmItemsToCSVChunk.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmItemsToCSVChunk.defaultExpectation.params)
+		}
+	}
+
+	return mmItemsToCSVChunk
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransactionCSVRepository.ItemsToCSVChunk
+func (mmItemsToCSVChunk *mTransactionCSVRepositoryMockItemsToCSVChunk) ExpectCtxParam1(ctx context.Context) *mTransactionCSVRepositoryMockItemsToCSVChunk {
+	if mmItemsToCSVChunk.mock.funcItemsToCSVChunk != nil {
+		mmItemsToCSVChunk.mock.t.Fatalf("TransactionCSVRepositoryMock.ItemsToCSVChunk mock is already set by Set")
+	}
+
+	if mmItemsToCSVChunk.defaultExpectation == nil {
+		mmItemsToCSVChunk.defaultExpectation = &TransactionCSVRepositoryMockItemsToCSVChunkExpectation{}
+	}
+
+	if mmItemsToCSVChunk.defaultExpectation.params != nil {
+		mmItemsToCSVChunk.mock.t.Fatalf("TransactionCSVRepositoryMock.ItemsToCSVChunk mock is already set by Expect")
+	}
+
+	if mmItemsToCSVChunk.defaultExpectation.paramPtrs == nil {
+		mmItemsToCSVChunk.defaultExpectation.paramPtrs = &TransactionCSVRepositoryMockItemsToCSVChunkParamPtrs{}
+	}
+	mmItemsToCSVChunk.defaultExpectation.paramPtrs.ctx = &ctx
+	mmItemsToCSVChunk.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmItemsToCSVChunk
+}
+
+// ExpectItemsParam2 sets up expected param items for TransactionCSVRepository.ItemsToCSVChunk
+func (mmItemsToCSVChunk *mTransactionCSVRepositoryMockItemsToCSVChunk) ExpectItemsParam2(items []*mm_usecase.TransactionDTO) *mTransactionCSVRepositoryMockItemsToCSVChunk {
+	if mmItemsToCSVChunk.mock.funcItemsToCSVChunk != nil {
+		mmItemsToCSVChunk.mock.t.Fatalf("TransactionCSVRepositoryMock.ItemsToCSVChunk mock is already set by Set")
+	}
+
+	if mmItemsToCSVChunk.defaultExpectation == nil {
+		mmItemsToCSVChunk.defaultExpectation = &TransactionCSVRepositoryMockItemsToCSVChunkExpectation{}
+	}
+
+	if mmItemsToCSVChunk.defaultExpectation.params != nil {
+		mmItemsToCSVChunk.mock.t.Fatalf("TransactionCSVRepositoryMock.ItemsToCSVChunk mock is already set by Expect")
+	}
+
+	if mmItemsToCSVChunk.defaultExpectation.paramPtrs == nil {
+		mmItemsToCSVChunk.defaultExpectation.paramPtrs = &TransactionCSVRepositoryMockItemsToCSVChunkParamPtrs{}
+	}
+	mmItemsToCSVChunk.defaultExpectation.paramPtrs.items = &items
+	mmItemsToCSVChunk.defaultExpectation.expectationOrigins.originItems = minimock.CallerInfo(1)
+
+	return mmItemsToCSVChunk
+}
+
+// ExpectWithHeaderParam3 sets up expected param withHeader for TransactionCSVRepository.ItemsToCSVChunk
+func (mmItemsToCSVChunk *mTransactionCSVRepositoryMockItemsToCSVChunk) ExpectWithHeaderParam3(withHeader bool) *mTransactionCSVRepositoryMockItemsToCSVChunk {
+	if mmItemsToCSVChunk.mock.funcItemsToCSVChunk != nil {
+		mmItemsToCSVChunk.mock.t.Fatalf("TransactionCSVRepositoryMock.ItemsToCSVChunk mock is already set by Set")
+	}
+
+	if mmItemsToCSVChunk.defaultExpectation == nil {
+		mmItemsToCSVChunk.defaultExpectation = &TransactionCSVRepositoryMockItemsToCSVChunkExpectation{}
+	}
+
+	if mmItemsToCSVChunk.defaultExpectation.params != nil {
+		mmItemsToCSVChunk.mock.t.Fatalf("TransactionCSVRepositoryMock.ItemsToCSVChunk mock is already set by Expect")
+	}
+
+	if mmItemsToCSVChunk.defaultExpectation.paramPtrs == nil {
+		mmItemsToCSVChunk.defaultExpectation.paramPtrs = &TransactionCSVRepositoryMockItemsToCSVChunkParamPtrs{}
+	}
+	mmItemsToCSVChunk.defaultExpectation.paramPtrs.withHeader = &withHeader
+	mmItemsToCSVChunk.defaultExpectation.expectationOrigins.originWithHeader = minimock.CallerInfo(1)
+
+	return mmItemsToCSVChunk
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransactionCSVRepository.ItemsToCSVChunk
+func (mmItemsToCSVChunk *mTransactionCSVRepositoryMockItemsToCSVChunk) Inspect(f func(ctx context.Context, items []*mm_usecase.TransactionDTO, withHeader bool)) *mTransactionCSVRepositoryMockItemsToCSVChunk {
+	if mmItemsToCSVChunk.mock.inspectFuncItemsToCSVChunk != nil {
+		mmItemsToCSVChunk.mock.t.Fatalf("Inspect function is already set for TransactionCSVRepositoryMock.ItemsToCSVChunk")
+	}
+
+	mmItemsToCSVChunk.mock.inspectFuncItemsToCSVChunk = f
+
+	return mmItemsToCSVChunk
+}
+
+// Return sets up results that will be returned by TransactionCSVRepository.ItemsToCSVChunk
+func (mmItemsToCSVChunk *mTransactionCSVRepositoryMockItemsToCSVChunk) Return(ba1 []byte, err error) *TransactionCSVRepositoryMock {
+	if mmItemsToCSVChunk.mock.funcItemsToCSVChunk != nil {
+		mmItemsToCSVChunk.mock.t.Fatalf("TransactionCSVRepositoryMock.ItemsToCSVChunk mock is already set by Set")
+	}
+
+	if mmItemsToCSVChunk.defaultExpectation == nil {
+		mmItemsToCSVChunk.defaultExpectation = &TransactionCSVRepositoryMockItemsToCSVChunkExpectation{mock: mmItemsToCSVChunk.mock}
+	}
+	mmItemsToCSVChunk.defaultExpectation.results = &TransactionCSVRepositoryMockItemsToCSVChunkResults{ba1, err}
+	mmItemsToCSVChunk.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmItemsToCSVChunk.mock
+}
+
+// Set uses given function f to mock the TransactionCSVRepository.ItemsToCSVChunk method
+func (mmItemsToCSVChunk *mTransactionCSVRepositoryMockItemsToCSVChunk) Set(f func(ctx context.Context, items []*mm_usecase.TransactionDTO, withHeader bool) (ba1 []byte, err error)) *TransactionCSVRepositoryMock {
+	if mmItemsToCSVChunk.defaultExpectation != nil {
+		mmItemsToCSVChunk.mock.t.Fatalf("Default expectation is already set for the TransactionCSVRepository.ItemsToCSVChunk method")
+	}
+
+	if len(mmItemsToCSVChunk.expectations) > 0 {
+		mmItemsToCSVChunk.mock.t.Fatalf("Some expectations are already set for the TransactionCSVRepository.ItemsToCSVChunk method")
+	}
+
+	mmItemsToCSVChunk.mock.funcItemsToCSVChunk = f
+	mmItemsToCSVChunk.mock.funcItemsToCSVChunkOrigin = minimock.CallerInfo(1)
+	return mmItemsToCSVChunk.mock
+}
+
+// When sets expectation for the TransactionCSVRepository.ItemsToCSVChunk which will trigger the result defined by the following
+// Then helper
+func (mmItemsToCSVChunk *mTransactionCSVRepositoryMockItemsToCSVChunk) When(ctx context.Context, items []*mm_usecase.TransactionDTO, withHeader bool) *TransactionCSVRepositoryMockItemsToCSVChunkExpectation {
+	if mmItemsToCSVChunk.mock.funcItemsToCSVChunk != nil {
+		mmItemsToCSVChunk.mock.t.Fatalf("TransactionCSVRepositoryMock.ItemsToCSVChunk mock is already set by Set")
+	}
+
+	expectation := &TransactionCSVRepositoryMockItemsToCSVChunkExpectation{
+		mock:               mmItemsToCSVChunk.mock,
+		params:             &TransactionCSVRepositoryMockItemsToCSVChunkParams{ctx, items, withHeader},
+		expectationOrigins: TransactionCSVRepositoryMockItemsToCSVChunkExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmItemsToCSVChunk.expectations = append(mmItemsToCSVChunk.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TransactionCSVRepository.ItemsToCSVChunk return parameters for the expectation previously defined by the When method
+func (e *TransactionCSVRepositoryMockItemsToCSVChunkExpectation) Then(ba1 []byte, err error) *TransactionCSVRepositoryMock {
+	e.results = &TransactionCSVRepositoryMockItemsToCSVChunkResults{ba1, err}
+	return e.mock
+}
+
+// Times sets number of times TransactionCSVRepository.ItemsToCSVChunk should be invoked
+func (mmItemsToCSVChunk *mTransactionCSVRepositoryMockItemsToCSVChunk) Times(n uint64) *mTransactionCSVRepositoryMockItemsToCSVChunk {
+	if n == 0 {
+		mmItemsToCSVChunk.mock.t.Fatalf("Times of TransactionCSVRepositoryMock.ItemsToCSVChunk mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmItemsToCSVChunk.expectedInvocations, n)
+	mmItemsToCSVChunk.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmItemsToCSVChunk
+}
+
+func (mmItemsToCSVChunk *mTransactionCSVRepositoryMockItemsToCSVChunk) invocationsDone() bool {
+	if len(mmItemsToCSVChunk.expectations) == 0 && mmItemsToCSVChunk.defaultExpectation == nil && mmItemsToCSVChunk.mock.funcItemsToCSVChunk == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmItemsToCSVChunk.mock.afterItemsToCSVChunkCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmItemsToCSVChunk.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ItemsToCSVChunk implements mm_usecase.TransactionCSVRepository
+func (mmItemsToCSVChunk *TransactionCSVRepositoryMock) ItemsToCSVChunk(ctx context.Context, items []*mm_usecase.TransactionDTO, withHeader bool) (ba1 []byte, err error) {
+	mm_atomic.AddUint64(&mmItemsToCSVChunk.beforeItemsToCSVChunkCounter, 1)
+	defer mm_atomic.AddUint64(&mmItemsToCSVChunk.afterItemsToCSVChunkCounter, 1)
+
+	mmItemsToCSVChunk.t.Helper()
+
+	if mmItemsToCSVChunk.inspectFuncItemsToCSVChunk != nil {
+		mmItemsToCSVChunk.inspectFuncItemsToCSVChunk(ctx, items, withHeader)
+	}
+
+	mm_params := TransactionCSVRepositoryMockItemsToCSVChunkParams{ctx, items, withHeader}
+
+	// Record call args
+	mmItemsToCSVChunk.ItemsToCSVChunkMock.mutex.Lock()
+	mmItemsToCSVChunk.ItemsToCSVChunkMock.callArgs = append(mmItemsToCSVChunk.ItemsToCSVChunkMock.callArgs, &mm_params)
+	mmItemsToCSVChunk.ItemsToCSVChunkMock.mutex.Unlock()
+
+	for _, e := range mmItemsToCSVChunk.ItemsToCSVChunkMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ba1, e.results.err
+		}
+	}
+
+	if mmItemsToCSVChunk.ItemsToCSVChunkMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmItemsToCSVChunk.ItemsToCSVChunkMock.defaultExpectation.Counter, 1)
+		mm_want := mmItemsToCSVChunk.ItemsToCSVChunkMock.defaultExpectation.params
+		mm_want_ptrs := mmItemsToCSVChunk.ItemsToCSVChunkMock.defaultExpectation.paramPtrs
+
+		mm_got := TransactionCSVRepositoryMockItemsToCSVChunkParams{ctx, items, withHeader}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmItemsToCSVChunk.t.Errorf("TransactionCSVRepositoryMock.ItemsToCSVChunk got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmItemsToCSVChunk.ItemsToCSVChunkMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.items != nil && !minimock.Equal(*mm_want_ptrs.items, mm_got.items) {
+				mmItemsToCSVChunk.t.Errorf("TransactionCSVRepositoryMock.ItemsToCSVChunk got unexpected parameter items, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmItemsToCSVChunk.ItemsToCSVChunkMock.defaultExpectation.expectationOrigins.originItems, *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
+			}
+
+			if mm_want_ptrs.withHeader != nil && !minimock.Equal(*mm_want_ptrs.withHeader, mm_got.withHeader) {
+				mmItemsToCSVChunk.t.Errorf("TransactionCSVRepositoryMock.ItemsToCSVChunk got unexpected parameter withHeader, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmItemsToCSVChunk.ItemsToCSVChunkMock.defaultExpectation.expectationOrigins.originWithHeader, *mm_want_ptrs.withHeader, mm_got.withHeader, minimock.Diff(*mm_want_ptrs.withHeader, mm_got.withHeader))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmItemsToCSVChunk.t.Errorf("TransactionCSVRepositoryMock.ItemsToCSVChunk got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmItemsToCSVChunk.ItemsToCSVChunkMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmItemsToCSVChunk.ItemsToCSVChunkMock.defaultExpectation.results
+		if mm_results == nil {
+			mmItemsToCSVChunk.t.Fatal("No results are set for the TransactionCSVRepositoryMock.ItemsToCSVChunk")
+		}
+		return (*mm_results).ba1, (*mm_results).err
+	}
+	if mmItemsToCSVChunk.funcItemsToCSVChunk != nil {
+		return mmItemsToCSVChunk.funcItemsToCSVChunk(ctx, items, withHeader)
+	}
+	mmItemsToCSVChunk.t.Fatalf("Unexpected call to TransactionCSVRepositoryMock.ItemsToCSVChunk. %v %v %v", ctx, items, withHeader)
+	return
+}
+
+// ItemsToCSVChunkAfterCounter returns a count of finished TransactionCSVRepositoryMock.ItemsToCSVChunk invocations
+func (mmItemsToCSVChunk *TransactionCSVRepositoryMock) ItemsToCSVChunkAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmItemsToCSVChunk.afterItemsToCSVChunkCounter)
+}
+
+// ItemsToCSVChunkBeforeCounter returns a count of TransactionCSVRepositoryMock.ItemsToCSVChunk invocations
+func (mmItemsToCSVChunk *TransactionCSVRepositoryMock) ItemsToCSVChunkBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmItemsToCSVChunk.beforeItemsToCSVChunkCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransactionCSVRepositoryMock.ItemsToCSVChunk.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmItemsToCSVChunk *mTransactionCSVRepositoryMockItemsToCSVChunk) Calls() []*TransactionCSVRepositoryMockItemsToCSVChunkParams {
+	mmItemsToCSVChunk.mutex.RLock()
+
+	argCopy := make([]*TransactionCSVRepositoryMockItemsToCSVChunkParams, len(mmItemsToCSVChunk.callArgs))
+	copy(argCopy, mmItemsToCSVChunk.callArgs)
+
+	mmItemsToCSVChunk.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockItemsToCSVChunkDone returns true if the count of the ItemsToCSVChunk invocations corresponds
+// the number of defined expectations
+func (m *TransactionCSVRepositoryMock) MinimockItemsToCSVChunkDone() bool {
+	if m.ItemsToCSVChunkMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ItemsToCSVChunkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ItemsToCSVChunkMock.invocationsDone()
+}
+
+// MinimockItemsToCSVChunkInspect logs each unmet expectation
+func (m *TransactionCSVRepositoryMock) MinimockItemsToCSVChunkInspect() {
+	for _, e := range m.ItemsToCSVChunkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransactionCSVRepositoryMock.ItemsToCSVChunk at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterItemsToCSVChunkCounter := mm_atomic.LoadUint64(&m.afterItemsToCSVChunkCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ItemsToCSVChunkMock.defaultExpectation != nil && afterItemsToCSVChunkCounter < 1 {
+		if m.ItemsToCSVChunkMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionCSVRepositoryMock.ItemsToCSVChunk at\n%s", m.ItemsToCSVChunkMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TransactionCSVRepositoryMock.ItemsToCSVChunk at\n%s with params: %#v", m.ItemsToCSVChunkMock.defaultExpectation.expectationOrigins.origin, *m.ItemsToCSVChunkMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcItemsToCSVChunk != nil && afterItemsToCSVChunkCounter < 1 {
+		m.t.Errorf("Expected call to TransactionCSVRepositoryMock.ItemsToCSVChunk at\n%s", m.funcItemsToCSVChunkOrigin)
+	}
+
+	if !m.ItemsToCSVChunkMock.invocationsDone() && afterItemsToCSVChunkCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionCSVRepositoryMock.ItemsToCSVChunk at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ItemsToCSVChunkMock.expectedInvocations), m.ItemsToCSVChunkMock.expectedInvocationsOrigin, afterItemsToCSVChunkCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *TransactionCSVRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
@@ -779,6 +1225,8 @@ func (m *TransactionCSVRepositoryMock) MinimockFinish() {
 			m.MinimockItemsFromCSVInspect()
 
 			m.MinimockItemsToCSVInspect()
+
+			m.MinimockItemsToCSVChunkInspect()
 		}
 	})
 }
@@ -803,5 +1251,6 @@ func (m *TransactionCSVRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockItemsFromCSVDone() &&
-		m.MinimockItemsToCSVDone()
+		m.MinimockItemsToCSVDone() &&
+		m.MinimockItemsToCSVChunkDone()
 }
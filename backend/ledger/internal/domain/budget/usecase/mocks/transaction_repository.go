@@ -22,6 +22,13 @@ type TransactionRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcCount          func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions) (total uint64, err error)
+	funcCountOrigin    string
+	inspectFuncCount   func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions)
+	afterCountCounter  uint64
+	beforeCountCounter uint64
+	CountMock          mTransactionRepositoryMockCount
+
 	funcCountReportItems          func(ctx context.Context, queryFilter mm_usecase.CountReportItemsQueryFilter) (items []*entity.AccountTransactionReportItem, err error)
 	funcCountReportItemsOrigin    string
 	inspectFuncCountReportItems   func(ctx context.Context, queryFilter mm_usecase.CountReportItemsQueryFilter)
@@ -36,6 +43,13 @@ type TransactionRepositoryMock struct {
 	beforeCreateCounter uint64
 	CreateMock          mTransactionRepositoryMockCreate
 
+	funcCreateBatch          func(ctx context.Context, items []*entity.Transaction) (err error)
+	funcCreateBatchOrigin    string
+	inspectFuncCreateBatch   func(ctx context.Context, items []*entity.Transaction)
+	afterCreateBatchCounter  uint64
+	beforeCreateBatchCounter uint64
+	CreateBatchMock          mTransactionRepositoryMockCreateBatch
+
 	funcFindList          func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.Transaction, err error)
 	funcFindListOrigin    string
 	inspectFuncFindList   func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams)
@@ -57,6 +71,13 @@ type TransactionRepositoryMock struct {
 	beforeFindPagedListCounter uint64
 	FindPagedListMock          mTransactionRepositoryMockFindPagedList
 
+	funcReassignCategory          func(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) (err error)
+	funcReassignCategoryOrigin    string
+	inspectFuncReassignCategory   func(ctx context.Context, fromCategoryID uint64, toCategoryID uint64)
+	afterReassignCategoryCounter  uint64
+	beforeReassignCategoryCounter uint64
+	ReassignCategoryMock          mTransactionRepositoryMockReassignCategory
+
 	funcUpdate          func(ctx context.Context, item *entity.Transaction) (err error)
 	funcUpdateOrigin    string
 	inspectFuncUpdate   func(ctx context.Context, item *entity.Transaction)
@@ -73,12 +94,18 @@ func NewTransactionRepositoryMock(t minimock.Tester) *TransactionRepositoryMock
 		controller.RegisterMocker(m)
 	}
 
+	m.CountMock = mTransactionRepositoryMockCount{mock: m}
+	m.CountMock.callArgs = []*TransactionRepositoryMockCountParams{}
+
 	m.CountReportItemsMock = mTransactionRepositoryMockCountReportItems{mock: m}
 	m.CountReportItemsMock.callArgs = []*TransactionRepositoryMockCountReportItemsParams{}
 
 	m.CreateMock = mTransactionRepositoryMockCreate{mock: m}
 	m.CreateMock.callArgs = []*TransactionRepositoryMockCreateParams{}
 
+	m.CreateBatchMock = mTransactionRepositoryMockCreateBatch{mock: m}
+	m.CreateBatchMock.callArgs = []*TransactionRepositoryMockCreateBatchParams{}
+
 	m.FindListMock = mTransactionRepositoryMockFindList{mock: m}
 	m.FindListMock.callArgs = []*TransactionRepositoryMockFindListParams{}
 
@@ -88,6 +115,9 @@ func NewTransactionRepositoryMock(t minimock.Tester) *TransactionRepositoryMock
 	m.FindPagedListMock = mTransactionRepositoryMockFindPagedList{mock: m}
 	m.FindPagedListMock.callArgs = []*TransactionRepositoryMockFindPagedListParams{}
 
+	m.ReassignCategoryMock = mTransactionRepositoryMockReassignCategory{mock: m}
+	m.ReassignCategoryMock.callArgs = []*TransactionRepositoryMockReassignCategoryParams{}
+
 	m.UpdateMock = mTransactionRepositoryMockUpdate{mock: m}
 	m.UpdateMock.callArgs = []*TransactionRepositoryMockUpdateParams{}
 
@@ -96,6 +126,349 @@ func NewTransactionRepositoryMock(t minimock.Tester) *TransactionRepositoryMock
 	return m
 }
 
+type mTransactionRepositoryMockCount struct {
+	optional           bool
+	mock               *TransactionRepositoryMock
+	defaultExpectation *TransactionRepositoryMockCountExpectation
+	expectations       []*TransactionRepositoryMockCountExpectation
+
+	callArgs []*TransactionRepositoryMockCountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransactionRepositoryMockCountExpectation specifies expectation struct of the TransactionRepository.Count
+type TransactionRepositoryMockCountExpectation struct {
+	mock               *TransactionRepositoryMock
+	params             *TransactionRepositoryMockCountParams
+	paramPtrs          *TransactionRepositoryMockCountParamPtrs
+	expectationOrigins TransactionRepositoryMockCountExpectationOrigins
+	results            *TransactionRepositoryMockCountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TransactionRepositoryMockCountParams contains parameters of the TransactionRepository.Count
+type TransactionRepositoryMockCountParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.TransactionListOptions
+}
+
+// TransactionRepositoryMockCountParamPtrs contains pointers to parameters of the TransactionRepository.Count
+type TransactionRepositoryMockCountParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.TransactionListOptions
+}
+
+// TransactionRepositoryMockCountResults contains results of the TransactionRepository.Count
+type TransactionRepositoryMockCountResults struct {
+	total uint64
+	err   error
+}
+
+// TransactionRepositoryMockCountOrigins contains origins of expectations of the TransactionRepository.Count
+type TransactionRepositoryMockCountExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCount *mTransactionRepositoryMockCount) Optional() *mTransactionRepositoryMockCount {
+	mmCount.optional = true
+	return mmCount
+}
+
+// Expect sets up expected params for TransactionRepository.Count
+func (mmCount *mTransactionRepositoryMockCount) Expect(ctx context.Context, listOptions *mm_usecase.TransactionListOptions) *mTransactionRepositoryMockCount {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("TransactionRepositoryMock.Count mock is already set by Set")
+	}
+
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &TransactionRepositoryMockCountExpectation{}
+	}
+
+	if mmCount.defaultExpectation.paramPtrs != nil {
+		mmCount.mock.t.Fatalf("TransactionRepositoryMock.Count mock is already set by ExpectParams functions")
+	}
+
+	mmCount.defaultExpectation.params = &TransactionRepositoryMockCountParams{ctx, listOptions}
+	mmCount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCount.expectations {
+		if minimock.Equal(e.params, mmCount.defaultExpectation.params) {
+			mmCount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCount.defaultExpectation.params)
+		}
+	}
+
+	return mmCount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransactionRepository.Count
+func (mmCount *mTransactionRepositoryMockCount) ExpectCtxParam1(ctx context.Context) *mTransactionRepositoryMockCount {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("TransactionRepositoryMock.Count mock is already set by Set")
+	}
+
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &TransactionRepositoryMockCountExpectation{}
+	}
+
+	if mmCount.defaultExpectation.params != nil {
+		mmCount.mock.t.Fatalf("TransactionRepositoryMock.Count mock is already set by Expect")
+	}
+
+	if mmCount.defaultExpectation.paramPtrs == nil {
+		mmCount.defaultExpectation.paramPtrs = &TransactionRepositoryMockCountParamPtrs{}
+	}
+	mmCount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCount
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for TransactionRepository.Count
+func (mmCount *mTransactionRepositoryMockCount) ExpectListOptionsParam2(listOptions *mm_usecase.TransactionListOptions) *mTransactionRepositoryMockCount {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("TransactionRepositoryMock.Count mock is already set by Set")
+	}
+
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &TransactionRepositoryMockCountExpectation{}
+	}
+
+	if mmCount.defaultExpectation.params != nil {
+		mmCount.mock.t.Fatalf("TransactionRepositoryMock.Count mock is already set by Expect")
+	}
+
+	if mmCount.defaultExpectation.paramPtrs == nil {
+		mmCount.defaultExpectation.paramPtrs = &TransactionRepositoryMockCountParamPtrs{}
+	}
+	mmCount.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmCount.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmCount
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransactionRepository.Count
+func (mmCount *mTransactionRepositoryMockCount) Inspect(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions)) *mTransactionRepositoryMockCount {
+	if mmCount.mock.inspectFuncCount != nil {
+		mmCount.mock.t.Fatalf("Inspect function is already set for TransactionRepositoryMock.Count")
+	}
+
+	mmCount.mock.inspectFuncCount = f
+
+	return mmCount
+}
+
+// Return sets up results that will be returned by TransactionRepository.Count
+func (mmCount *mTransactionRepositoryMockCount) Return(total uint64, err error) *TransactionRepositoryMock {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("TransactionRepositoryMock.Count mock is already set by Set")
+	}
+
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &TransactionRepositoryMockCountExpectation{mock: mmCount.mock}
+	}
+	mmCount.defaultExpectation.results = &TransactionRepositoryMockCountResults{total, err}
+	mmCount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCount.mock
+}
+
+// Set uses given function f to mock the TransactionRepository.Count method
+func (mmCount *mTransactionRepositoryMockCount) Set(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions) (total uint64, err error)) *TransactionRepositoryMock {
+	if mmCount.defaultExpectation != nil {
+		mmCount.mock.t.Fatalf("Default expectation is already set for the TransactionRepository.Count method")
+	}
+
+	if len(mmCount.expectations) > 0 {
+		mmCount.mock.t.Fatalf("Some expectations are already set for the TransactionRepository.Count method")
+	}
+
+	mmCount.mock.funcCount = f
+	mmCount.mock.funcCountOrigin = minimock.CallerInfo(1)
+	return mmCount.mock
+}
+
+// When sets expectation for the TransactionRepository.Count which will trigger the result defined by the following
+// Then helper
+func (mmCount *mTransactionRepositoryMockCount) When(ctx context.Context, listOptions *mm_usecase.TransactionListOptions) *TransactionRepositoryMockCountExpectation {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("TransactionRepositoryMock.Count mock is already set by Set")
+	}
+
+	expectation := &TransactionRepositoryMockCountExpectation{
+		mock:               mmCount.mock,
+		params:             &TransactionRepositoryMockCountParams{ctx, listOptions},
+		expectationOrigins: TransactionRepositoryMockCountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCount.expectations = append(mmCount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TransactionRepository.Count return parameters for the expectation previously defined by the When method
+func (e *TransactionRepositoryMockCountExpectation) Then(total uint64, err error) *TransactionRepositoryMock {
+	e.results = &TransactionRepositoryMockCountResults{total, err}
+	return e.mock
+}
+
+// Times sets number of times TransactionRepository.Count should be invoked
+func (mmCount *mTransactionRepositoryMockCount) Times(n uint64) *mTransactionRepositoryMockCount {
+	if n == 0 {
+		mmCount.mock.t.Fatalf("Times of TransactionRepositoryMock.Count mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCount.expectedInvocations, n)
+	mmCount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCount
+}
+
+func (mmCount *mTransactionRepositoryMockCount) invocationsDone() bool {
+	if len(mmCount.expectations) == 0 && mmCount.defaultExpectation == nil && mmCount.mock.funcCount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCount.mock.afterCountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Count implements mm_usecase.TransactionRepository
+func (mmCount *TransactionRepositoryMock) Count(ctx context.Context, listOptions *mm_usecase.TransactionListOptions) (total uint64, err error) {
+	mm_atomic.AddUint64(&mmCount.beforeCountCounter, 1)
+	defer mm_atomic.AddUint64(&mmCount.afterCountCounter, 1)
+
+	mmCount.t.Helper()
+
+	if mmCount.inspectFuncCount != nil {
+		mmCount.inspectFuncCount(ctx, listOptions)
+	}
+
+	mm_params := TransactionRepositoryMockCountParams{ctx, listOptions}
+
+	// Record call args
+	mmCount.CountMock.mutex.Lock()
+	mmCount.CountMock.callArgs = append(mmCount.CountMock.callArgs, &mm_params)
+	mmCount.CountMock.mutex.Unlock()
+
+	for _, e := range mmCount.CountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.total, e.results.err
+		}
+	}
+
+	if mmCount.CountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCount.CountMock.defaultExpectation.Counter, 1)
+		mm_want := mmCount.CountMock.defaultExpectation.params
+		mm_want_ptrs := mmCount.CountMock.defaultExpectation.paramPtrs
+
+		mm_got := TransactionRepositoryMockCountParams{ctx, listOptions}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCount.t.Errorf("TransactionRepositoryMock.Count got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCount.CountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmCount.t.Errorf("TransactionRepositoryMock.Count got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCount.CountMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCount.t.Errorf("TransactionRepositoryMock.Count got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCount.CountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCount.CountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCount.t.Fatal("No results are set for the TransactionRepositoryMock.Count")
+		}
+		return (*mm_results).total, (*mm_results).err
+	}
+	if mmCount.funcCount != nil {
+		return mmCount.funcCount(ctx, listOptions)
+	}
+	mmCount.t.Fatalf("Unexpected call to TransactionRepositoryMock.Count. %v %v", ctx, listOptions)
+	return
+}
+
+// CountAfterCounter returns a count of finished TransactionRepositoryMock.Count invocations
+func (mmCount *TransactionRepositoryMock) CountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCount.afterCountCounter)
+}
+
+// CountBeforeCounter returns a count of TransactionRepositoryMock.Count invocations
+func (mmCount *TransactionRepositoryMock) CountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCount.beforeCountCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransactionRepositoryMock.Count.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCount *mTransactionRepositoryMockCount) Calls() []*TransactionRepositoryMockCountParams {
+	mmCount.mutex.RLock()
+
+	argCopy := make([]*TransactionRepositoryMockCountParams, len(mmCount.callArgs))
+	copy(argCopy, mmCount.callArgs)
+
+	mmCount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountDone returns true if the count of the Count invocations corresponds
+// the number of defined expectations
+func (m *TransactionRepositoryMock) MinimockCountDone() bool {
+	if m.CountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountMock.invocationsDone()
+}
+
+// MinimockCountInspect logs each unmet expectation
+func (m *TransactionRepositoryMock) MinimockCountInspect() {
+	for _, e := range m.CountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransactionRepositoryMock.Count at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountCounter := mm_atomic.LoadUint64(&m.afterCountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountMock.defaultExpectation != nil && afterCountCounter < 1 {
+		if m.CountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionRepositoryMock.Count at\n%s", m.CountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TransactionRepositoryMock.Count at\n%s with params: %#v", m.CountMock.defaultExpectation.expectationOrigins.origin, *m.CountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCount != nil && afterCountCounter < 1 {
+		m.t.Errorf("Expected call to TransactionRepositoryMock.Count at\n%s", m.funcCountOrigin)
+	}
+
+	if !m.CountMock.invocationsDone() && afterCountCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionRepositoryMock.Count at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountMock.expectedInvocations), m.CountMock.expectedInvocationsOrigin, afterCountCounter)
+	}
+}
+
 type mTransactionRepositoryMockCountReportItems struct {
 	optional           bool
 	mock               *TransactionRepositoryMock
@@ -624,160 +997,502 @@ func (mmCreate *mTransactionRepositoryMockCreate) When(ctx context.Context, item
 	return expectation
 }
 
-// Then sets up TransactionRepository.Create return parameters for the expectation previously defined by the When method
-func (e *TransactionRepositoryMockCreateExpectation) Then(err error) *TransactionRepositoryMock {
-	e.results = &TransactionRepositoryMockCreateResults{err}
+// Then sets up TransactionRepository.Create return parameters for the expectation previously defined by the When method
+func (e *TransactionRepositoryMockCreateExpectation) Then(err error) *TransactionRepositoryMock {
+	e.results = &TransactionRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times TransactionRepository.Create should be invoked
+func (mmCreate *mTransactionRepositoryMockCreate) Times(n uint64) *mTransactionRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of TransactionRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mTransactionRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_usecase.TransactionRepository
+func (mmCreate *TransactionRepositoryMock) Create(ctx context.Context, item *entity.Transaction) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, item)
+	}
+
+	mm_params := TransactionRepositoryMockCreateParams{ctx, item}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := TransactionRepositoryMockCreateParams{ctx, item}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("TransactionRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
+				mmCreate.t.Errorf("TransactionRepositoryMock.Create got unexpected parameter item, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originItem, *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("TransactionRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the TransactionRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, item)
+	}
+	mmCreate.t.Fatalf("Unexpected call to TransactionRepositoryMock.Create. %v %v", ctx, item)
+	return
+}
+
+// CreateAfterCounter returns a count of finished TransactionRepositoryMock.Create invocations
+func (mmCreate *TransactionRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of TransactionRepositoryMock.Create invocations
+func (mmCreate *TransactionRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransactionRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mTransactionRepositoryMockCreate) Calls() []*TransactionRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*TransactionRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *TransactionRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *TransactionRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransactionRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TransactionRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to TransactionRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mTransactionRepositoryMockCreateBatch struct {
+	optional           bool
+	mock               *TransactionRepositoryMock
+	defaultExpectation *TransactionRepositoryMockCreateBatchExpectation
+	expectations       []*TransactionRepositoryMockCreateBatchExpectation
+
+	callArgs []*TransactionRepositoryMockCreateBatchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransactionRepositoryMockCreateBatchExpectation specifies expectation struct of the TransactionRepository.CreateBatch
+type TransactionRepositoryMockCreateBatchExpectation struct {
+	mock               *TransactionRepositoryMock
+	params             *TransactionRepositoryMockCreateBatchParams
+	paramPtrs          *TransactionRepositoryMockCreateBatchParamPtrs
+	expectationOrigins TransactionRepositoryMockCreateBatchExpectationOrigins
+	results            *TransactionRepositoryMockCreateBatchResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TransactionRepositoryMockCreateBatchParams contains parameters of the TransactionRepository.CreateBatch
+type TransactionRepositoryMockCreateBatchParams struct {
+	ctx   context.Context
+	items []*entity.Transaction
+}
+
+// TransactionRepositoryMockCreateBatchParamPtrs contains pointers to parameters of the TransactionRepository.CreateBatch
+type TransactionRepositoryMockCreateBatchParamPtrs struct {
+	ctx   *context.Context
+	items *[]*entity.Transaction
+}
+
+// TransactionRepositoryMockCreateBatchResults contains results of the TransactionRepository.CreateBatch
+type TransactionRepositoryMockCreateBatchResults struct {
+	err error
+}
+
+// TransactionRepositoryMockCreateBatchOrigins contains origins of expectations of the TransactionRepository.CreateBatch
+type TransactionRepositoryMockCreateBatchExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originItems string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateBatch *mTransactionRepositoryMockCreateBatch) Optional() *mTransactionRepositoryMockCreateBatch {
+	mmCreateBatch.optional = true
+	return mmCreateBatch
+}
+
+// Expect sets up expected params for TransactionRepository.CreateBatch
+func (mmCreateBatch *mTransactionRepositoryMockCreateBatch) Expect(ctx context.Context, items []*entity.Transaction) *mTransactionRepositoryMockCreateBatch {
+	if mmCreateBatch.mock.funcCreateBatch != nil {
+		mmCreateBatch.mock.t.Fatalf("TransactionRepositoryMock.CreateBatch mock is already set by Set")
+	}
+
+	if mmCreateBatch.defaultExpectation == nil {
+		mmCreateBatch.defaultExpectation = &TransactionRepositoryMockCreateBatchExpectation{}
+	}
+
+	if mmCreateBatch.defaultExpectation.paramPtrs != nil {
+		mmCreateBatch.mock.t.Fatalf("TransactionRepositoryMock.CreateBatch mock is already set by ExpectParams functions")
+	}
+
+	mmCreateBatch.defaultExpectation.params = &TransactionRepositoryMockCreateBatchParams{ctx, items}
+	mmCreateBatch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateBatch.expectations {
+		if minimock.Equal(e.params, mmCreateBatch.defaultExpectation.params) {
+			mmCreateBatch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateBatch.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateBatch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransactionRepository.CreateBatch
+func (mmCreateBatch *mTransactionRepositoryMockCreateBatch) ExpectCtxParam1(ctx context.Context) *mTransactionRepositoryMockCreateBatch {
+	if mmCreateBatch.mock.funcCreateBatch != nil {
+		mmCreateBatch.mock.t.Fatalf("TransactionRepositoryMock.CreateBatch mock is already set by Set")
+	}
+
+	if mmCreateBatch.defaultExpectation == nil {
+		mmCreateBatch.defaultExpectation = &TransactionRepositoryMockCreateBatchExpectation{}
+	}
+
+	if mmCreateBatch.defaultExpectation.params != nil {
+		mmCreateBatch.mock.t.Fatalf("TransactionRepositoryMock.CreateBatch mock is already set by Expect")
+	}
+
+	if mmCreateBatch.defaultExpectation.paramPtrs == nil {
+		mmCreateBatch.defaultExpectation.paramPtrs = &TransactionRepositoryMockCreateBatchParamPtrs{}
+	}
+	mmCreateBatch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateBatch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateBatch
+}
+
+// ExpectItemsParam2 sets up expected param items for TransactionRepository.CreateBatch
+func (mmCreateBatch *mTransactionRepositoryMockCreateBatch) ExpectItemsParam2(items []*entity.Transaction) *mTransactionRepositoryMockCreateBatch {
+	if mmCreateBatch.mock.funcCreateBatch != nil {
+		mmCreateBatch.mock.t.Fatalf("TransactionRepositoryMock.CreateBatch mock is already set by Set")
+	}
+
+	if mmCreateBatch.defaultExpectation == nil {
+		mmCreateBatch.defaultExpectation = &TransactionRepositoryMockCreateBatchExpectation{}
+	}
+
+	if mmCreateBatch.defaultExpectation.params != nil {
+		mmCreateBatch.mock.t.Fatalf("TransactionRepositoryMock.CreateBatch mock is already set by Expect")
+	}
+
+	if mmCreateBatch.defaultExpectation.paramPtrs == nil {
+		mmCreateBatch.defaultExpectation.paramPtrs = &TransactionRepositoryMockCreateBatchParamPtrs{}
+	}
+	mmCreateBatch.defaultExpectation.paramPtrs.items = &items
+	mmCreateBatch.defaultExpectation.expectationOrigins.originItems = minimock.CallerInfo(1)
+
+	return mmCreateBatch
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransactionRepository.CreateBatch
+func (mmCreateBatch *mTransactionRepositoryMockCreateBatch) Inspect(f func(ctx context.Context, items []*entity.Transaction)) *mTransactionRepositoryMockCreateBatch {
+	if mmCreateBatch.mock.inspectFuncCreateBatch != nil {
+		mmCreateBatch.mock.t.Fatalf("Inspect function is already set for TransactionRepositoryMock.CreateBatch")
+	}
+
+	mmCreateBatch.mock.inspectFuncCreateBatch = f
+
+	return mmCreateBatch
+}
+
+// Return sets up results that will be returned by TransactionRepository.CreateBatch
+func (mmCreateBatch *mTransactionRepositoryMockCreateBatch) Return(err error) *TransactionRepositoryMock {
+	if mmCreateBatch.mock.funcCreateBatch != nil {
+		mmCreateBatch.mock.t.Fatalf("TransactionRepositoryMock.CreateBatch mock is already set by Set")
+	}
+
+	if mmCreateBatch.defaultExpectation == nil {
+		mmCreateBatch.defaultExpectation = &TransactionRepositoryMockCreateBatchExpectation{mock: mmCreateBatch.mock}
+	}
+	mmCreateBatch.defaultExpectation.results = &TransactionRepositoryMockCreateBatchResults{err}
+	mmCreateBatch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateBatch.mock
+}
+
+// Set uses given function f to mock the TransactionRepository.CreateBatch method
+func (mmCreateBatch *mTransactionRepositoryMockCreateBatch) Set(f func(ctx context.Context, items []*entity.Transaction) (err error)) *TransactionRepositoryMock {
+	if mmCreateBatch.defaultExpectation != nil {
+		mmCreateBatch.mock.t.Fatalf("Default expectation is already set for the TransactionRepository.CreateBatch method")
+	}
+
+	if len(mmCreateBatch.expectations) > 0 {
+		mmCreateBatch.mock.t.Fatalf("Some expectations are already set for the TransactionRepository.CreateBatch method")
+	}
+
+	mmCreateBatch.mock.funcCreateBatch = f
+	mmCreateBatch.mock.funcCreateBatchOrigin = minimock.CallerInfo(1)
+	return mmCreateBatch.mock
+}
+
+// When sets expectation for the TransactionRepository.CreateBatch which will trigger the result defined by the following
+// Then helper
+func (mmCreateBatch *mTransactionRepositoryMockCreateBatch) When(ctx context.Context, items []*entity.Transaction) *TransactionRepositoryMockCreateBatchExpectation {
+	if mmCreateBatch.mock.funcCreateBatch != nil {
+		mmCreateBatch.mock.t.Fatalf("TransactionRepositoryMock.CreateBatch mock is already set by Set")
+	}
+
+	expectation := &TransactionRepositoryMockCreateBatchExpectation{
+		mock:               mmCreateBatch.mock,
+		params:             &TransactionRepositoryMockCreateBatchParams{ctx, items},
+		expectationOrigins: TransactionRepositoryMockCreateBatchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateBatch.expectations = append(mmCreateBatch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TransactionRepository.CreateBatch return parameters for the expectation previously defined by the When method
+func (e *TransactionRepositoryMockCreateBatchExpectation) Then(err error) *TransactionRepositoryMock {
+	e.results = &TransactionRepositoryMockCreateBatchResults{err}
 	return e.mock
 }
 
-// Times sets number of times TransactionRepository.Create should be invoked
-func (mmCreate *mTransactionRepositoryMockCreate) Times(n uint64) *mTransactionRepositoryMockCreate {
+// Times sets number of times TransactionRepository.CreateBatch should be invoked
+func (mmCreateBatch *mTransactionRepositoryMockCreateBatch) Times(n uint64) *mTransactionRepositoryMockCreateBatch {
 	if n == 0 {
-		mmCreate.mock.t.Fatalf("Times of TransactionRepositoryMock.Create mock can not be zero")
+		mmCreateBatch.mock.t.Fatalf("Times of TransactionRepositoryMock.CreateBatch mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
-	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreate
+	mm_atomic.StoreUint64(&mmCreateBatch.expectedInvocations, n)
+	mmCreateBatch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateBatch
 }
 
-func (mmCreate *mTransactionRepositoryMockCreate) invocationsDone() bool {
-	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+func (mmCreateBatch *mTransactionRepositoryMockCreateBatch) invocationsDone() bool {
+	if len(mmCreateBatch.expectations) == 0 && mmCreateBatch.defaultExpectation == nil && mmCreateBatch.mock.funcCreateBatch == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateBatch.mock.afterCreateBatchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateBatch.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Create implements mm_usecase.TransactionRepository
-func (mmCreate *TransactionRepositoryMock) Create(ctx context.Context, item *entity.Transaction) (err error) {
-	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+// CreateBatch implements mm_usecase.TransactionRepository
+func (mmCreateBatch *TransactionRepositoryMock) CreateBatch(ctx context.Context, items []*entity.Transaction) (err error) {
+	mm_atomic.AddUint64(&mmCreateBatch.beforeCreateBatchCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateBatch.afterCreateBatchCounter, 1)
 
-	mmCreate.t.Helper()
+	mmCreateBatch.t.Helper()
 
-	if mmCreate.inspectFuncCreate != nil {
-		mmCreate.inspectFuncCreate(ctx, item)
+	if mmCreateBatch.inspectFuncCreateBatch != nil {
+		mmCreateBatch.inspectFuncCreateBatch(ctx, items)
 	}
 
-	mm_params := TransactionRepositoryMockCreateParams{ctx, item}
+	mm_params := TransactionRepositoryMockCreateBatchParams{ctx, items}
 
 	// Record call args
-	mmCreate.CreateMock.mutex.Lock()
-	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
-	mmCreate.CreateMock.mutex.Unlock()
+	mmCreateBatch.CreateBatchMock.mutex.Lock()
+	mmCreateBatch.CreateBatchMock.callArgs = append(mmCreateBatch.CreateBatchMock.callArgs, &mm_params)
+	mmCreateBatch.CreateBatchMock.mutex.Unlock()
 
-	for _, e := range mmCreate.CreateMock.expectations {
+	for _, e := range mmCreateBatch.CreateBatchMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmCreate.CreateMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreate.CreateMock.defaultExpectation.params
-		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+	if mmCreateBatch.CreateBatchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateBatch.CreateBatchMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateBatch.CreateBatchMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateBatch.CreateBatchMock.defaultExpectation.paramPtrs
 
-		mm_got := TransactionRepositoryMockCreateParams{ctx, item}
+		mm_got := TransactionRepositoryMockCreateBatchParams{ctx, items}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreate.t.Errorf("TransactionRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmCreateBatch.t.Errorf("TransactionRepositoryMock.CreateBatch got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateBatch.CreateBatchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
-				mmCreate.t.Errorf("TransactionRepositoryMock.Create got unexpected parameter item, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originItem, *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			if mm_want_ptrs.items != nil && !minimock.Equal(*mm_want_ptrs.items, mm_got.items) {
+				mmCreateBatch.t.Errorf("TransactionRepositoryMock.CreateBatch got unexpected parameter items, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateBatch.CreateBatchMock.defaultExpectation.expectationOrigins.originItems, *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreate.t.Errorf("TransactionRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmCreateBatch.t.Errorf("TransactionRepositoryMock.CreateBatch got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateBatch.CreateBatchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		mm_results := mmCreateBatch.CreateBatchMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreate.t.Fatal("No results are set for the TransactionRepositoryMock.Create")
+			mmCreateBatch.t.Fatal("No results are set for the TransactionRepositoryMock.CreateBatch")
 		}
 		return (*mm_results).err
 	}
-	if mmCreate.funcCreate != nil {
-		return mmCreate.funcCreate(ctx, item)
+	if mmCreateBatch.funcCreateBatch != nil {
+		return mmCreateBatch.funcCreateBatch(ctx, items)
 	}
-	mmCreate.t.Fatalf("Unexpected call to TransactionRepositoryMock.Create. %v %v", ctx, item)
+	mmCreateBatch.t.Fatalf("Unexpected call to TransactionRepositoryMock.CreateBatch. %v %v", ctx, items)
 	return
 }
 
-// CreateAfterCounter returns a count of finished TransactionRepositoryMock.Create invocations
-func (mmCreate *TransactionRepositoryMock) CreateAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+// CreateBatchAfterCounter returns a count of finished TransactionRepositoryMock.CreateBatch invocations
+func (mmCreateBatch *TransactionRepositoryMock) CreateBatchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateBatch.afterCreateBatchCounter)
 }
 
-// CreateBeforeCounter returns a count of TransactionRepositoryMock.Create invocations
-func (mmCreate *TransactionRepositoryMock) CreateBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+// CreateBatchBeforeCounter returns a count of TransactionRepositoryMock.CreateBatch invocations
+func (mmCreateBatch *TransactionRepositoryMock) CreateBatchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateBatch.beforeCreateBatchCounter)
 }
 
-// Calls returns a list of arguments used in each call to TransactionRepositoryMock.Create.
+// Calls returns a list of arguments used in each call to TransactionRepositoryMock.CreateBatch.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreate *mTransactionRepositoryMockCreate) Calls() []*TransactionRepositoryMockCreateParams {
-	mmCreate.mutex.RLock()
+func (mmCreateBatch *mTransactionRepositoryMockCreateBatch) Calls() []*TransactionRepositoryMockCreateBatchParams {
+	mmCreateBatch.mutex.RLock()
 
-	argCopy := make([]*TransactionRepositoryMockCreateParams, len(mmCreate.callArgs))
-	copy(argCopy, mmCreate.callArgs)
+	argCopy := make([]*TransactionRepositoryMockCreateBatchParams, len(mmCreateBatch.callArgs))
+	copy(argCopy, mmCreateBatch.callArgs)
 
-	mmCreate.mutex.RUnlock()
+	mmCreateBatch.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// MinimockCreateBatchDone returns true if the count of the CreateBatch invocations corresponds
 // the number of defined expectations
-func (m *TransactionRepositoryMock) MinimockCreateDone() bool {
-	if m.CreateMock.optional {
+func (m *TransactionRepositoryMock) MinimockCreateBatchDone() bool {
+	if m.CreateBatchMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateMock.expectations {
+	for _, e := range m.CreateBatchMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateMock.invocationsDone()
+	return m.CreateBatchMock.invocationsDone()
 }
 
-// MinimockCreateInspect logs each unmet expectation
-func (m *TransactionRepositoryMock) MinimockCreateInspect() {
-	for _, e := range m.CreateMock.expectations {
+// MinimockCreateBatchInspect logs each unmet expectation
+func (m *TransactionRepositoryMock) MinimockCreateBatchInspect() {
+	for _, e := range m.CreateBatchMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to TransactionRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to TransactionRepositoryMock.CreateBatch at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	afterCreateBatchCounter := mm_atomic.LoadUint64(&m.afterCreateBatchCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
-		if m.CreateMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to TransactionRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+	if m.CreateBatchMock.defaultExpectation != nil && afterCreateBatchCounter < 1 {
+		if m.CreateBatchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionRepositoryMock.CreateBatch at\n%s", m.CreateBatchMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to TransactionRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to TransactionRepositoryMock.CreateBatch at\n%s with params: %#v", m.CreateBatchMock.defaultExpectation.expectationOrigins.origin, *m.CreateBatchMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreate != nil && afterCreateCounter < 1 {
-		m.t.Errorf("Expected call to TransactionRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	if m.funcCreateBatch != nil && afterCreateBatchCounter < 1 {
+		m.t.Errorf("Expected call to TransactionRepositoryMock.CreateBatch at\n%s", m.funcCreateBatchOrigin)
 	}
 
-	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
-		m.t.Errorf("Expected %d calls to TransactionRepositoryMock.Create at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	if !m.CreateBatchMock.invocationsDone() && afterCreateBatchCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionRepositoryMock.CreateBatch at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateBatchMock.expectedInvocations), m.CreateBatchMock.expectedInvocationsOrigin, afterCreateBatchCounter)
 	}
 }
 
@@ -1904,6 +2619,379 @@ func (m *TransactionRepositoryMock) MinimockFindPagedListInspect() {
 	}
 }
 
+type mTransactionRepositoryMockReassignCategory struct {
+	optional           bool
+	mock               *TransactionRepositoryMock
+	defaultExpectation *TransactionRepositoryMockReassignCategoryExpectation
+	expectations       []*TransactionRepositoryMockReassignCategoryExpectation
+
+	callArgs []*TransactionRepositoryMockReassignCategoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransactionRepositoryMockReassignCategoryExpectation specifies expectation struct of the TransactionRepository.ReassignCategory
+type TransactionRepositoryMockReassignCategoryExpectation struct {
+	mock               *TransactionRepositoryMock
+	params             *TransactionRepositoryMockReassignCategoryParams
+	paramPtrs          *TransactionRepositoryMockReassignCategoryParamPtrs
+	expectationOrigins TransactionRepositoryMockReassignCategoryExpectationOrigins
+	results            *TransactionRepositoryMockReassignCategoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TransactionRepositoryMockReassignCategoryParams contains parameters of the TransactionRepository.ReassignCategory
+type TransactionRepositoryMockReassignCategoryParams struct {
+	ctx            context.Context
+	fromCategoryID uint64
+	toCategoryID   uint64
+}
+
+// TransactionRepositoryMockReassignCategoryParamPtrs contains pointers to parameters of the TransactionRepository.ReassignCategory
+type TransactionRepositoryMockReassignCategoryParamPtrs struct {
+	ctx            *context.Context
+	fromCategoryID *uint64
+	toCategoryID   *uint64
+}
+
+// TransactionRepositoryMockReassignCategoryResults contains results of the TransactionRepository.ReassignCategory
+type TransactionRepositoryMockReassignCategoryResults struct {
+	err error
+}
+
+// TransactionRepositoryMockReassignCategoryOrigins contains origins of expectations of the TransactionRepository.ReassignCategory
+type TransactionRepositoryMockReassignCategoryExpectationOrigins struct {
+	origin               string
+	originCtx            string
+	originFromCategoryID string
+	originToCategoryID   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReassignCategory *mTransactionRepositoryMockReassignCategory) Optional() *mTransactionRepositoryMockReassignCategory {
+	mmReassignCategory.optional = true
+	return mmReassignCategory
+}
+
+// Expect sets up expected params for TransactionRepository.ReassignCategory
+func (mmReassignCategory *mTransactionRepositoryMockReassignCategory) Expect(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) *mTransactionRepositoryMockReassignCategory {
+	if mmReassignCategory.mock.funcReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("TransactionRepositoryMock.ReassignCategory mock is already set by Set")
+	}
+
+	if mmReassignCategory.defaultExpectation == nil {
+		mmReassignCategory.defaultExpectation = &TransactionRepositoryMockReassignCategoryExpectation{}
+	}
+
+	if mmReassignCategory.defaultExpectation.paramPtrs != nil {
+		mmReassignCategory.mock.t.Fatalf("TransactionRepositoryMock.ReassignCategory mock is already set by ExpectParams functions")
+	}
+
+	mmReassignCategory.defaultExpectation.params = &TransactionRepositoryMockReassignCategoryParams{ctx, fromCategoryID, toCategoryID}
+	mmReassignCategory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReassignCategory.expectations {
+		if minimock.Equal(e.params, mmReassignCategory.defaultExpectation.params) {
+			mmReassignCategory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReassignCategory.defaultExpectation.params)
+		}
+	}
+
+	return mmReassignCategory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransactionRepository.ReassignCategory
+func (mmReassignCategory *mTransactionRepositoryMockReassignCategory) ExpectCtxParam1(ctx context.Context) *mTransactionRepositoryMockReassignCategory {
+	if mmReassignCategory.mock.funcReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("TransactionRepositoryMock.ReassignCategory mock is already set by Set")
+	}
+
+	if mmReassignCategory.defaultExpectation == nil {
+		mmReassignCategory.defaultExpectation = &TransactionRepositoryMockReassignCategoryExpectation{}
+	}
+
+	if mmReassignCategory.defaultExpectation.params != nil {
+		mmReassignCategory.mock.t.Fatalf("TransactionRepositoryMock.ReassignCategory mock is already set by Expect")
+	}
+
+	if mmReassignCategory.defaultExpectation.paramPtrs == nil {
+		mmReassignCategory.defaultExpectation.paramPtrs = &TransactionRepositoryMockReassignCategoryParamPtrs{}
+	}
+	mmReassignCategory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReassignCategory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReassignCategory
+}
+
+// ExpectFromCategoryIDParam2 sets up expected param fromCategoryID for TransactionRepository.ReassignCategory
+func (mmReassignCategory *mTransactionRepositoryMockReassignCategory) ExpectFromCategoryIDParam2(fromCategoryID uint64) *mTransactionRepositoryMockReassignCategory {
+	if mmReassignCategory.mock.funcReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("TransactionRepositoryMock.ReassignCategory mock is already set by Set")
+	}
+
+	if mmReassignCategory.defaultExpectation == nil {
+		mmReassignCategory.defaultExpectation = &TransactionRepositoryMockReassignCategoryExpectation{}
+	}
+
+	if mmReassignCategory.defaultExpectation.params != nil {
+		mmReassignCategory.mock.t.Fatalf("TransactionRepositoryMock.ReassignCategory mock is already set by Expect")
+	}
+
+	if mmReassignCategory.defaultExpectation.paramPtrs == nil {
+		mmReassignCategory.defaultExpectation.paramPtrs = &TransactionRepositoryMockReassignCategoryParamPtrs{}
+	}
+	mmReassignCategory.defaultExpectation.paramPtrs.fromCategoryID = &fromCategoryID
+	mmReassignCategory.defaultExpectation.expectationOrigins.originFromCategoryID = minimock.CallerInfo(1)
+
+	return mmReassignCategory
+}
+
+// ExpectToCategoryIDParam3 sets up expected param toCategoryID for TransactionRepository.ReassignCategory
+func (mmReassignCategory *mTransactionRepositoryMockReassignCategory) ExpectToCategoryIDParam3(toCategoryID uint64) *mTransactionRepositoryMockReassignCategory {
+	if mmReassignCategory.mock.funcReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("TransactionRepositoryMock.ReassignCategory mock is already set by Set")
+	}
+
+	if mmReassignCategory.defaultExpectation == nil {
+		mmReassignCategory.defaultExpectation = &TransactionRepositoryMockReassignCategoryExpectation{}
+	}
+
+	if mmReassignCategory.defaultExpectation.params != nil {
+		mmReassignCategory.mock.t.Fatalf("TransactionRepositoryMock.ReassignCategory mock is already set by Expect")
+	}
+
+	if mmReassignCategory.defaultExpectation.paramPtrs == nil {
+		mmReassignCategory.defaultExpectation.paramPtrs = &TransactionRepositoryMockReassignCategoryParamPtrs{}
+	}
+	mmReassignCategory.defaultExpectation.paramPtrs.toCategoryID = &toCategoryID
+	mmReassignCategory.defaultExpectation.expectationOrigins.originToCategoryID = minimock.CallerInfo(1)
+
+	return mmReassignCategory
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransactionRepository.ReassignCategory
+func (mmReassignCategory *mTransactionRepositoryMockReassignCategory) Inspect(f func(ctx context.Context, fromCategoryID uint64, toCategoryID uint64)) *mTransactionRepositoryMockReassignCategory {
+	if mmReassignCategory.mock.inspectFuncReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("Inspect function is already set for TransactionRepositoryMock.ReassignCategory")
+	}
+
+	mmReassignCategory.mock.inspectFuncReassignCategory = f
+
+	return mmReassignCategory
+}
+
+// Return sets up results that will be returned by TransactionRepository.ReassignCategory
+func (mmReassignCategory *mTransactionRepositoryMockReassignCategory) Return(err error) *TransactionRepositoryMock {
+	if mmReassignCategory.mock.funcReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("TransactionRepositoryMock.ReassignCategory mock is already set by Set")
+	}
+
+	if mmReassignCategory.defaultExpectation == nil {
+		mmReassignCategory.defaultExpectation = &TransactionRepositoryMockReassignCategoryExpectation{mock: mmReassignCategory.mock}
+	}
+	mmReassignCategory.defaultExpectation.results = &TransactionRepositoryMockReassignCategoryResults{err}
+	mmReassignCategory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReassignCategory.mock
+}
+
+// Set uses given function f to mock the TransactionRepository.ReassignCategory method
+func (mmReassignCategory *mTransactionRepositoryMockReassignCategory) Set(f func(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) (err error)) *TransactionRepositoryMock {
+	if mmReassignCategory.defaultExpectation != nil {
+		mmReassignCategory.mock.t.Fatalf("Default expectation is already set for the TransactionRepository.ReassignCategory method")
+	}
+
+	if len(mmReassignCategory.expectations) > 0 {
+		mmReassignCategory.mock.t.Fatalf("Some expectations are already set for the TransactionRepository.ReassignCategory method")
+	}
+
+	mmReassignCategory.mock.funcReassignCategory = f
+	mmReassignCategory.mock.funcReassignCategoryOrigin = minimock.CallerInfo(1)
+	return mmReassignCategory.mock
+}
+
+// When sets expectation for the TransactionRepository.ReassignCategory which will trigger the result defined by the following
+// Then helper
+func (mmReassignCategory *mTransactionRepositoryMockReassignCategory) When(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) *TransactionRepositoryMockReassignCategoryExpectation {
+	if mmReassignCategory.mock.funcReassignCategory != nil {
+		mmReassignCategory.mock.t.Fatalf("TransactionRepositoryMock.ReassignCategory mock is already set by Set")
+	}
+
+	expectation := &TransactionRepositoryMockReassignCategoryExpectation{
+		mock:               mmReassignCategory.mock,
+		params:             &TransactionRepositoryMockReassignCategoryParams{ctx, fromCategoryID, toCategoryID},
+		expectationOrigins: TransactionRepositoryMockReassignCategoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReassignCategory.expectations = append(mmReassignCategory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TransactionRepository.ReassignCategory return parameters for the expectation previously defined by the When method
+func (e *TransactionRepositoryMockReassignCategoryExpectation) Then(err error) *TransactionRepositoryMock {
+	e.results = &TransactionRepositoryMockReassignCategoryResults{err}
+	return e.mock
+}
+
+// Times sets number of times TransactionRepository.ReassignCategory should be invoked
+func (mmReassignCategory *mTransactionRepositoryMockReassignCategory) Times(n uint64) *mTransactionRepositoryMockReassignCategory {
+	if n == 0 {
+		mmReassignCategory.mock.t.Fatalf("Times of TransactionRepositoryMock.ReassignCategory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReassignCategory.expectedInvocations, n)
+	mmReassignCategory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReassignCategory
+}
+
+func (mmReassignCategory *mTransactionRepositoryMockReassignCategory) invocationsDone() bool {
+	if len(mmReassignCategory.expectations) == 0 && mmReassignCategory.defaultExpectation == nil && mmReassignCategory.mock.funcReassignCategory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReassignCategory.mock.afterReassignCategoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReassignCategory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReassignCategory implements mm_usecase.TransactionRepository
+func (mmReassignCategory *TransactionRepositoryMock) ReassignCategory(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) (err error) {
+	mm_atomic.AddUint64(&mmReassignCategory.beforeReassignCategoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmReassignCategory.afterReassignCategoryCounter, 1)
+
+	mmReassignCategory.t.Helper()
+
+	if mmReassignCategory.inspectFuncReassignCategory != nil {
+		mmReassignCategory.inspectFuncReassignCategory(ctx, fromCategoryID, toCategoryID)
+	}
+
+	mm_params := TransactionRepositoryMockReassignCategoryParams{ctx, fromCategoryID, toCategoryID}
+
+	// Record call args
+	mmReassignCategory.ReassignCategoryMock.mutex.Lock()
+	mmReassignCategory.ReassignCategoryMock.callArgs = append(mmReassignCategory.ReassignCategoryMock.callArgs, &mm_params)
+	mmReassignCategory.ReassignCategoryMock.mutex.Unlock()
+
+	for _, e := range mmReassignCategory.ReassignCategoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReassignCategory.ReassignCategoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReassignCategory.ReassignCategoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmReassignCategory.ReassignCategoryMock.defaultExpectation.params
+		mm_want_ptrs := mmReassignCategory.ReassignCategoryMock.defaultExpectation.paramPtrs
+
+		mm_got := TransactionRepositoryMockReassignCategoryParams{ctx, fromCategoryID, toCategoryID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReassignCategory.t.Errorf("TransactionRepositoryMock.ReassignCategory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReassignCategory.ReassignCategoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fromCategoryID != nil && !minimock.Equal(*mm_want_ptrs.fromCategoryID, mm_got.fromCategoryID) {
+				mmReassignCategory.t.Errorf("TransactionRepositoryMock.ReassignCategory got unexpected parameter fromCategoryID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReassignCategory.ReassignCategoryMock.defaultExpectation.expectationOrigins.originFromCategoryID, *mm_want_ptrs.fromCategoryID, mm_got.fromCategoryID, minimock.Diff(*mm_want_ptrs.fromCategoryID, mm_got.fromCategoryID))
+			}
+
+			if mm_want_ptrs.toCategoryID != nil && !minimock.Equal(*mm_want_ptrs.toCategoryID, mm_got.toCategoryID) {
+				mmReassignCategory.t.Errorf("TransactionRepositoryMock.ReassignCategory got unexpected parameter toCategoryID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReassignCategory.ReassignCategoryMock.defaultExpectation.expectationOrigins.originToCategoryID, *mm_want_ptrs.toCategoryID, mm_got.toCategoryID, minimock.Diff(*mm_want_ptrs.toCategoryID, mm_got.toCategoryID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReassignCategory.t.Errorf("TransactionRepositoryMock.ReassignCategory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReassignCategory.ReassignCategoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReassignCategory.ReassignCategoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReassignCategory.t.Fatal("No results are set for the TransactionRepositoryMock.ReassignCategory")
+		}
+		return (*mm_results).err
+	}
+	if mmReassignCategory.funcReassignCategory != nil {
+		return mmReassignCategory.funcReassignCategory(ctx, fromCategoryID, toCategoryID)
+	}
+	mmReassignCategory.t.Fatalf("Unexpected call to TransactionRepositoryMock.ReassignCategory. %v %v %v", ctx, fromCategoryID, toCategoryID)
+	return
+}
+
+// ReassignCategoryAfterCounter returns a count of finished TransactionRepositoryMock.ReassignCategory invocations
+func (mmReassignCategory *TransactionRepositoryMock) ReassignCategoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReassignCategory.afterReassignCategoryCounter)
+}
+
+// ReassignCategoryBeforeCounter returns a count of TransactionRepositoryMock.ReassignCategory invocations
+func (mmReassignCategory *TransactionRepositoryMock) ReassignCategoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReassignCategory.beforeReassignCategoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransactionRepositoryMock.ReassignCategory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReassignCategory *mTransactionRepositoryMockReassignCategory) Calls() []*TransactionRepositoryMockReassignCategoryParams {
+	mmReassignCategory.mutex.RLock()
+
+	argCopy := make([]*TransactionRepositoryMockReassignCategoryParams, len(mmReassignCategory.callArgs))
+	copy(argCopy, mmReassignCategory.callArgs)
+
+	mmReassignCategory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReassignCategoryDone returns true if the count of the ReassignCategory invocations corresponds
+// the number of defined expectations
+func (m *TransactionRepositoryMock) MinimockReassignCategoryDone() bool {
+	if m.ReassignCategoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReassignCategoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReassignCategoryMock.invocationsDone()
+}
+
+// MinimockReassignCategoryInspect logs each unmet expectation
+func (m *TransactionRepositoryMock) MinimockReassignCategoryInspect() {
+	for _, e := range m.ReassignCategoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransactionRepositoryMock.ReassignCategory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReassignCategoryCounter := mm_atomic.LoadUint64(&m.afterReassignCategoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReassignCategoryMock.defaultExpectation != nil && afterReassignCategoryCounter < 1 {
+		if m.ReassignCategoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionRepositoryMock.ReassignCategory at\n%s", m.ReassignCategoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TransactionRepositoryMock.ReassignCategory at\n%s with params: %#v", m.ReassignCategoryMock.defaultExpectation.expectationOrigins.origin, *m.ReassignCategoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReassignCategory != nil && afterReassignCategoryCounter < 1 {
+		m.t.Errorf("Expected call to TransactionRepositoryMock.ReassignCategory at\n%s", m.funcReassignCategoryOrigin)
+	}
+
+	if !m.ReassignCategoryMock.invocationsDone() && afterReassignCategoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionRepositoryMock.ReassignCategory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReassignCategoryMock.expectedInvocations), m.ReassignCategoryMock.expectedInvocationsOrigin, afterReassignCategoryCounter)
+	}
+}
+
 type mTransactionRepositoryMockUpdate struct {
 	optional           bool
 	mock               *TransactionRepositoryMock
@@ -2250,16 +3338,22 @@ func (m *TransactionRepositoryMock) MinimockUpdateInspect() {
 func (m *TransactionRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockCountInspect()
+
 			m.MinimockCountReportItemsInspect()
 
 			m.MinimockCreateInspect()
 
+			m.MinimockCreateBatchInspect()
+
 			m.MinimockFindListInspect()
 
 			m.MinimockFindOneByIDInspect()
 
 			m.MinimockFindPagedListInspect()
 
+			m.MinimockReassignCategoryInspect()
+
 			m.MinimockUpdateInspect()
 		}
 	})
@@ -2284,10 +3378,13 @@ func (m *TransactionRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *TransactionRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockCountDone() &&
 		m.MinimockCountReportItemsDone() &&
 		m.MinimockCreateDone() &&
+		m.MinimockCreateBatchDone() &&
 		m.MinimockFindListDone() &&
 		m.MinimockFindOneByIDDone() &&
 		m.MinimockFindPagedListDone() &&
+		m.MinimockReassignCategoryDone() &&
 		m.MinimockUpdateDone()
 }
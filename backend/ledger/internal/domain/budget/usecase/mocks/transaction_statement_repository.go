@@ -0,0 +1,710 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.TransactionStatementRepository -o transaction_statement_repository.go -n TransactionStatementRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	mm_usecase "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// TransactionStatementRepositoryMock implements mm_usecase.TransactionStatementRepository
+type TransactionStatementRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcFormat          func() (t1 mm_usecase.TransactionImportFormat)
+	funcFormatOrigin    string
+	inspectFuncFormat   func()
+	afterFormatCounter  uint64
+	beforeFormatCounter uint64
+	FormatMock          mTransactionStatementRepositoryMockFormat
+
+	funcItemsFromStatement          func(ctx context.Context, data []byte, accountID uuid.UUID, defaultCurrency string, defaultCategoryID *uint64) (rows []*mm_usecase.TransactionImportRow, err error)
+	funcItemsFromStatementOrigin    string
+	inspectFuncItemsFromStatement   func(ctx context.Context, data []byte, accountID uuid.UUID, defaultCurrency string, defaultCategoryID *uint64)
+	afterItemsFromStatementCounter  uint64
+	beforeItemsFromStatementCounter uint64
+	ItemsFromStatementMock          mTransactionStatementRepositoryMockItemsFromStatement
+}
+
+// NewTransactionStatementRepositoryMock returns a mock for mm_usecase.TransactionStatementRepository
+func NewTransactionStatementRepositoryMock(t minimock.Tester) *TransactionStatementRepositoryMock {
+	m := &TransactionStatementRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.FormatMock = mTransactionStatementRepositoryMockFormat{mock: m}
+
+	m.ItemsFromStatementMock = mTransactionStatementRepositoryMockItemsFromStatement{mock: m}
+	m.ItemsFromStatementMock.callArgs = []*TransactionStatementRepositoryMockItemsFromStatementParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mTransactionStatementRepositoryMockFormat struct {
+	optional           bool
+	mock               *TransactionStatementRepositoryMock
+	defaultExpectation *TransactionStatementRepositoryMockFormatExpectation
+	expectations       []*TransactionStatementRepositoryMockFormatExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransactionStatementRepositoryMockFormatExpectation specifies expectation struct of the TransactionStatementRepository.Format
+type TransactionStatementRepositoryMockFormatExpectation struct {
+	mock *TransactionStatementRepositoryMock
+
+	results      *TransactionStatementRepositoryMockFormatResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// TransactionStatementRepositoryMockFormatResults contains results of the TransactionStatementRepository.Format
+type TransactionStatementRepositoryMockFormatResults struct {
+	t1 mm_usecase.TransactionImportFormat
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFormat *mTransactionStatementRepositoryMockFormat) Optional() *mTransactionStatementRepositoryMockFormat {
+	mmFormat.optional = true
+	return mmFormat
+}
+
+// Expect sets up expected params for TransactionStatementRepository.Format
+func (mmFormat *mTransactionStatementRepositoryMockFormat) Expect() *mTransactionStatementRepositoryMockFormat {
+	if mmFormat.mock.funcFormat != nil {
+		mmFormat.mock.t.Fatalf("TransactionStatementRepositoryMock.Format mock is already set by Set")
+	}
+
+	if mmFormat.defaultExpectation == nil {
+		mmFormat.defaultExpectation = &TransactionStatementRepositoryMockFormatExpectation{}
+	}
+
+	return mmFormat
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransactionStatementRepository.Format
+func (mmFormat *mTransactionStatementRepositoryMockFormat) Inspect(f func()) *mTransactionStatementRepositoryMockFormat {
+	if mmFormat.mock.inspectFuncFormat != nil {
+		mmFormat.mock.t.Fatalf("Inspect function is already set for TransactionStatementRepositoryMock.Format")
+	}
+
+	mmFormat.mock.inspectFuncFormat = f
+
+	return mmFormat
+}
+
+// Return sets up results that will be returned by TransactionStatementRepository.Format
+func (mmFormat *mTransactionStatementRepositoryMockFormat) Return(t1 mm_usecase.TransactionImportFormat) *TransactionStatementRepositoryMock {
+	if mmFormat.mock.funcFormat != nil {
+		mmFormat.mock.t.Fatalf("TransactionStatementRepositoryMock.Format mock is already set by Set")
+	}
+
+	if mmFormat.defaultExpectation == nil {
+		mmFormat.defaultExpectation = &TransactionStatementRepositoryMockFormatExpectation{mock: mmFormat.mock}
+	}
+	mmFormat.defaultExpectation.results = &TransactionStatementRepositoryMockFormatResults{t1}
+	mmFormat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFormat.mock
+}
+
+// Set uses given function f to mock the TransactionStatementRepository.Format method
+func (mmFormat *mTransactionStatementRepositoryMockFormat) Set(f func() (t1 mm_usecase.TransactionImportFormat)) *TransactionStatementRepositoryMock {
+	if mmFormat.defaultExpectation != nil {
+		mmFormat.mock.t.Fatalf("Default expectation is already set for the TransactionStatementRepository.Format method")
+	}
+
+	if len(mmFormat.expectations) > 0 {
+		mmFormat.mock.t.Fatalf("Some expectations are already set for the TransactionStatementRepository.Format method")
+	}
+
+	mmFormat.mock.funcFormat = f
+	mmFormat.mock.funcFormatOrigin = minimock.CallerInfo(1)
+	return mmFormat.mock
+}
+
+// Times sets number of times TransactionStatementRepository.Format should be invoked
+func (mmFormat *mTransactionStatementRepositoryMockFormat) Times(n uint64) *mTransactionStatementRepositoryMockFormat {
+	if n == 0 {
+		mmFormat.mock.t.Fatalf("Times of TransactionStatementRepositoryMock.Format mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFormat.expectedInvocations, n)
+	mmFormat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFormat
+}
+
+func (mmFormat *mTransactionStatementRepositoryMockFormat) invocationsDone() bool {
+	if len(mmFormat.expectations) == 0 && mmFormat.defaultExpectation == nil && mmFormat.mock.funcFormat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFormat.mock.afterFormatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFormat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Format implements mm_usecase.TransactionStatementRepository
+func (mmFormat *TransactionStatementRepositoryMock) Format() (t1 mm_usecase.TransactionImportFormat) {
+	mm_atomic.AddUint64(&mmFormat.beforeFormatCounter, 1)
+	defer mm_atomic.AddUint64(&mmFormat.afterFormatCounter, 1)
+
+	mmFormat.t.Helper()
+
+	if mmFormat.inspectFuncFormat != nil {
+		mmFormat.inspectFuncFormat()
+	}
+
+	if mmFormat.FormatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFormat.FormatMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmFormat.FormatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFormat.t.Fatal("No results are set for the TransactionStatementRepositoryMock.Format")
+		}
+		return (*mm_results).t1
+	}
+	if mmFormat.funcFormat != nil {
+		return mmFormat.funcFormat()
+	}
+	mmFormat.t.Fatalf("Unexpected call to TransactionStatementRepositoryMock.Format.")
+	return
+}
+
+// FormatAfterCounter returns a count of finished TransactionStatementRepositoryMock.Format invocations
+func (mmFormat *TransactionStatementRepositoryMock) FormatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFormat.afterFormatCounter)
+}
+
+// FormatBeforeCounter returns a count of TransactionStatementRepositoryMock.Format invocations
+func (mmFormat *TransactionStatementRepositoryMock) FormatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFormat.beforeFormatCounter)
+}
+
+// MinimockFormatDone returns true if the count of the Format invocations corresponds
+// the number of defined expectations
+func (m *TransactionStatementRepositoryMock) MinimockFormatDone() bool {
+	if m.FormatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FormatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FormatMock.invocationsDone()
+}
+
+// MinimockFormatInspect logs each unmet expectation
+func (m *TransactionStatementRepositoryMock) MinimockFormatInspect() {
+	for _, e := range m.FormatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to TransactionStatementRepositoryMock.Format")
+		}
+	}
+
+	afterFormatCounter := mm_atomic.LoadUint64(&m.afterFormatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FormatMock.defaultExpectation != nil && afterFormatCounter < 1 {
+		m.t.Errorf("Expected call to TransactionStatementRepositoryMock.Format at\n%s", m.FormatMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFormat != nil && afterFormatCounter < 1 {
+		m.t.Errorf("Expected call to TransactionStatementRepositoryMock.Format at\n%s", m.funcFormatOrigin)
+	}
+
+	if !m.FormatMock.invocationsDone() && afterFormatCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionStatementRepositoryMock.Format at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FormatMock.expectedInvocations), m.FormatMock.expectedInvocationsOrigin, afterFormatCounter)
+	}
+}
+
+type mTransactionStatementRepositoryMockItemsFromStatement struct {
+	optional           bool
+	mock               *TransactionStatementRepositoryMock
+	defaultExpectation *TransactionStatementRepositoryMockItemsFromStatementExpectation
+	expectations       []*TransactionStatementRepositoryMockItemsFromStatementExpectation
+
+	callArgs []*TransactionStatementRepositoryMockItemsFromStatementParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransactionStatementRepositoryMockItemsFromStatementExpectation specifies expectation struct of the TransactionStatementRepository.ItemsFromStatement
+type TransactionStatementRepositoryMockItemsFromStatementExpectation struct {
+	mock               *TransactionStatementRepositoryMock
+	params             *TransactionStatementRepositoryMockItemsFromStatementParams
+	paramPtrs          *TransactionStatementRepositoryMockItemsFromStatementParamPtrs
+	expectationOrigins TransactionStatementRepositoryMockItemsFromStatementExpectationOrigins
+	results            *TransactionStatementRepositoryMockItemsFromStatementResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TransactionStatementRepositoryMockItemsFromStatementParams contains parameters of the TransactionStatementRepository.ItemsFromStatement
+type TransactionStatementRepositoryMockItemsFromStatementParams struct {
+	ctx               context.Context
+	data              []byte
+	accountID         uuid.UUID
+	defaultCurrency   string
+	defaultCategoryID *uint64
+}
+
+// TransactionStatementRepositoryMockItemsFromStatementParamPtrs contains pointers to parameters of the TransactionStatementRepository.ItemsFromStatement
+type TransactionStatementRepositoryMockItemsFromStatementParamPtrs struct {
+	ctx               *context.Context
+	data              *[]byte
+	accountID         *uuid.UUID
+	defaultCurrency   *string
+	defaultCategoryID **uint64
+}
+
+// TransactionStatementRepositoryMockItemsFromStatementResults contains results of the TransactionStatementRepository.ItemsFromStatement
+type TransactionStatementRepositoryMockItemsFromStatementResults struct {
+	rows []*mm_usecase.TransactionImportRow
+	err  error
+}
+
+// TransactionStatementRepositoryMockItemsFromStatementOrigins contains origins of expectations of the TransactionStatementRepository.ItemsFromStatement
+type TransactionStatementRepositoryMockItemsFromStatementExpectationOrigins struct {
+	origin                  string
+	originCtx               string
+	originData              string
+	originAccountID         string
+	originDefaultCurrency   string
+	originDefaultCategoryID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmItemsFromStatement *mTransactionStatementRepositoryMockItemsFromStatement) Optional() *mTransactionStatementRepositoryMockItemsFromStatement {
+	mmItemsFromStatement.optional = true
+	return mmItemsFromStatement
+}
+
+// Expect sets up expected params for TransactionStatementRepository.ItemsFromStatement
+func (mmItemsFromStatement *mTransactionStatementRepositoryMockItemsFromStatement) Expect(ctx context.Context, data []byte, accountID uuid.UUID, defaultCurrency string, defaultCategoryID *uint64) *mTransactionStatementRepositoryMockItemsFromStatement {
+	if mmItemsFromStatement.mock.funcItemsFromStatement != nil {
+		mmItemsFromStatement.mock.t.Fatalf("TransactionStatementRepositoryMock.ItemsFromStatement mock is already set by Set")
+	}
+
+	if mmItemsFromStatement.defaultExpectation == nil {
+		mmItemsFromStatement.defaultExpectation = &TransactionStatementRepositoryMockItemsFromStatementExpectation{}
+	}
+
+	if mmItemsFromStatement.defaultExpectation.paramPtrs != nil {
+		mmItemsFromStatement.mock.t.Fatalf("TransactionStatementRepositoryMock.ItemsFromStatement mock is already set by ExpectParams functions")
+	}
+
+	mmItemsFromStatement.defaultExpectation.params = &TransactionStatementRepositoryMockItemsFromStatementParams{ctx, data, accountID, defaultCurrency, defaultCategoryID}
+	mmItemsFromStatement.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmItemsFromStatement.expectations {
+		if minimock.Equal(e.params, mmItemsFromStatement.defaultExpectation.params) {
+			mmItemsFromStatement.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmItemsFromStatement.defaultExpectation.params)
+		}
+	}
+
+	return mmItemsFromStatement
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransactionStatementRepository.ItemsFromStatement
+func (mmItemsFromStatement *mTransactionStatementRepositoryMockItemsFromStatement) ExpectCtxParam1(ctx context.Context) *mTransactionStatementRepositoryMockItemsFromStatement {
+	if mmItemsFromStatement.mock.funcItemsFromStatement != nil {
+		mmItemsFromStatement.mock.t.Fatalf("TransactionStatementRepositoryMock.ItemsFromStatement mock is already set by Set")
+	}
+
+	if mmItemsFromStatement.defaultExpectation == nil {
+		mmItemsFromStatement.defaultExpectation = &TransactionStatementRepositoryMockItemsFromStatementExpectation{}
+	}
+
+	if mmItemsFromStatement.defaultExpectation.params != nil {
+		mmItemsFromStatement.mock.t.Fatalf("TransactionStatementRepositoryMock.ItemsFromStatement mock is already set by Expect")
+	}
+
+	if mmItemsFromStatement.defaultExpectation.paramPtrs == nil {
+		mmItemsFromStatement.defaultExpectation.paramPtrs = &TransactionStatementRepositoryMockItemsFromStatementParamPtrs{}
+	}
+	mmItemsFromStatement.defaultExpectation.paramPtrs.ctx = &ctx
+	mmItemsFromStatement.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmItemsFromStatement
+}
+
+// ExpectDataParam2 sets up expected param data for TransactionStatementRepository.ItemsFromStatement
+func (mmItemsFromStatement *mTransactionStatementRepositoryMockItemsFromStatement) ExpectDataParam2(data []byte) *mTransactionStatementRepositoryMockItemsFromStatement {
+	if mmItemsFromStatement.mock.funcItemsFromStatement != nil {
+		mmItemsFromStatement.mock.t.Fatalf("TransactionStatementRepositoryMock.ItemsFromStatement mock is already set by Set")
+	}
+
+	if mmItemsFromStatement.defaultExpectation == nil {
+		mmItemsFromStatement.defaultExpectation = &TransactionStatementRepositoryMockItemsFromStatementExpectation{}
+	}
+
+	if mmItemsFromStatement.defaultExpectation.params != nil {
+		mmItemsFromStatement.mock.t.Fatalf("TransactionStatementRepositoryMock.ItemsFromStatement mock is already set by Expect")
+	}
+
+	if mmItemsFromStatement.defaultExpectation.paramPtrs == nil {
+		mmItemsFromStatement.defaultExpectation.paramPtrs = &TransactionStatementRepositoryMockItemsFromStatementParamPtrs{}
+	}
+	mmItemsFromStatement.defaultExpectation.paramPtrs.data = &data
+	mmItemsFromStatement.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+
+	return mmItemsFromStatement
+}
+
+// ExpectAccountIDParam3 sets up expected param accountID for TransactionStatementRepository.ItemsFromStatement
+func (mmItemsFromStatement *mTransactionStatementRepositoryMockItemsFromStatement) ExpectAccountIDParam3(accountID uuid.UUID) *mTransactionStatementRepositoryMockItemsFromStatement {
+	if mmItemsFromStatement.mock.funcItemsFromStatement != nil {
+		mmItemsFromStatement.mock.t.Fatalf("TransactionStatementRepositoryMock.ItemsFromStatement mock is already set by Set")
+	}
+
+	if mmItemsFromStatement.defaultExpectation == nil {
+		mmItemsFromStatement.defaultExpectation = &TransactionStatementRepositoryMockItemsFromStatementExpectation{}
+	}
+
+	if mmItemsFromStatement.defaultExpectation.params != nil {
+		mmItemsFromStatement.mock.t.Fatalf("TransactionStatementRepositoryMock.ItemsFromStatement mock is already set by Expect")
+	}
+
+	if mmItemsFromStatement.defaultExpectation.paramPtrs == nil {
+		mmItemsFromStatement.defaultExpectation.paramPtrs = &TransactionStatementRepositoryMockItemsFromStatementParamPtrs{}
+	}
+	mmItemsFromStatement.defaultExpectation.paramPtrs.accountID = &accountID
+	mmItemsFromStatement.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+
+	return mmItemsFromStatement
+}
+
+// ExpectDefaultCurrencyParam4 sets up expected param defaultCurrency for TransactionStatementRepository.ItemsFromStatement
+func (mmItemsFromStatement *mTransactionStatementRepositoryMockItemsFromStatement) ExpectDefaultCurrencyParam4(defaultCurrency string) *mTransactionStatementRepositoryMockItemsFromStatement {
+	if mmItemsFromStatement.mock.funcItemsFromStatement != nil {
+		mmItemsFromStatement.mock.t.Fatalf("TransactionStatementRepositoryMock.ItemsFromStatement mock is already set by Set")
+	}
+
+	if mmItemsFromStatement.defaultExpectation == nil {
+		mmItemsFromStatement.defaultExpectation = &TransactionStatementRepositoryMockItemsFromStatementExpectation{}
+	}
+
+	if mmItemsFromStatement.defaultExpectation.params != nil {
+		mmItemsFromStatement.mock.t.Fatalf("TransactionStatementRepositoryMock.ItemsFromStatement mock is already set by Expect")
+	}
+
+	if mmItemsFromStatement.defaultExpectation.paramPtrs == nil {
+		mmItemsFromStatement.defaultExpectation.paramPtrs = &TransactionStatementRepositoryMockItemsFromStatementParamPtrs{}
+	}
+	mmItemsFromStatement.defaultExpectation.paramPtrs.defaultCurrency = &defaultCurrency
+	mmItemsFromStatement.defaultExpectation.expectationOrigins.originDefaultCurrency = minimock.CallerInfo(1)
+
+	return mmItemsFromStatement
+}
+
+// ExpectDefaultCategoryIDParam5 sets up expected param defaultCategoryID for TransactionStatementRepository.ItemsFromStatement
+func (mmItemsFromStatement *mTransactionStatementRepositoryMockItemsFromStatement) ExpectDefaultCategoryIDParam5(defaultCategoryID *uint64) *mTransactionStatementRepositoryMockItemsFromStatement {
+	if mmItemsFromStatement.mock.funcItemsFromStatement != nil {
+		mmItemsFromStatement.mock.t.Fatalf("TransactionStatementRepositoryMock.ItemsFromStatement mock is already set by Set")
+	}
+
+	if mmItemsFromStatement.defaultExpectation == nil {
+		mmItemsFromStatement.defaultExpectation = &TransactionStatementRepositoryMockItemsFromStatementExpectation{}
+	}
+
+	if mmItemsFromStatement.defaultExpectation.params != nil {
+		mmItemsFromStatement.mock.t.Fatalf("TransactionStatementRepositoryMock.ItemsFromStatement mock is already set by Expect")
+	}
+
+	if mmItemsFromStatement.defaultExpectation.paramPtrs == nil {
+		mmItemsFromStatement.defaultExpectation.paramPtrs = &TransactionStatementRepositoryMockItemsFromStatementParamPtrs{}
+	}
+	mmItemsFromStatement.defaultExpectation.paramPtrs.defaultCategoryID = &defaultCategoryID
+	mmItemsFromStatement.defaultExpectation.expectationOrigins.originDefaultCategoryID = minimock.CallerInfo(1)
+
+	return mmItemsFromStatement
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransactionStatementRepository.ItemsFromStatement
+func (mmItemsFromStatement *mTransactionStatementRepositoryMockItemsFromStatement) Inspect(f func(ctx context.Context, data []byte, accountID uuid.UUID, defaultCurrency string, defaultCategoryID *uint64)) *mTransactionStatementRepositoryMockItemsFromStatement {
+	if mmItemsFromStatement.mock.inspectFuncItemsFromStatement != nil {
+		mmItemsFromStatement.mock.t.Fatalf("Inspect function is already set for TransactionStatementRepositoryMock.ItemsFromStatement")
+	}
+
+	mmItemsFromStatement.mock.inspectFuncItemsFromStatement = f
+
+	return mmItemsFromStatement
+}
+
+// Return sets up results that will be returned by TransactionStatementRepository.ItemsFromStatement
+func (mmItemsFromStatement *mTransactionStatementRepositoryMockItemsFromStatement) Return(rows []*mm_usecase.TransactionImportRow, err error) *TransactionStatementRepositoryMock {
+	if mmItemsFromStatement.mock.funcItemsFromStatement != nil {
+		mmItemsFromStatement.mock.t.Fatalf("TransactionStatementRepositoryMock.ItemsFromStatement mock is already set by Set")
+	}
+
+	if mmItemsFromStatement.defaultExpectation == nil {
+		mmItemsFromStatement.defaultExpectation = &TransactionStatementRepositoryMockItemsFromStatementExpectation{mock: mmItemsFromStatement.mock}
+	}
+	mmItemsFromStatement.defaultExpectation.results = &TransactionStatementRepositoryMockItemsFromStatementResults{rows, err}
+	mmItemsFromStatement.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmItemsFromStatement.mock
+}
+
+// Set uses given function f to mock the TransactionStatementRepository.ItemsFromStatement method
+func (mmItemsFromStatement *mTransactionStatementRepositoryMockItemsFromStatement) Set(f func(ctx context.Context, data []byte, accountID uuid.UUID, defaultCurrency string, defaultCategoryID *uint64) (rows []*mm_usecase.TransactionImportRow, err error)) *TransactionStatementRepositoryMock {
+	if mmItemsFromStatement.defaultExpectation != nil {
+		mmItemsFromStatement.mock.t.Fatalf("Default expectation is already set for the TransactionStatementRepository.ItemsFromStatement method")
+	}
+
+	if len(mmItemsFromStatement.expectations) > 0 {
+		mmItemsFromStatement.mock.t.Fatalf("Some expectations are already set for the TransactionStatementRepository.ItemsFromStatement method")
+	}
+
+	mmItemsFromStatement.mock.funcItemsFromStatement = f
+	mmItemsFromStatement.mock.funcItemsFromStatementOrigin = minimock.CallerInfo(1)
+	return mmItemsFromStatement.mock
+}
+
+// When sets expectation for the TransactionStatementRepository.ItemsFromStatement which will trigger the result defined by the following
+// Then helper
+func (mmItemsFromStatement *mTransactionStatementRepositoryMockItemsFromStatement) When(ctx context.Context, data []byte, accountID uuid.UUID, defaultCurrency string, defaultCategoryID *uint64) *TransactionStatementRepositoryMockItemsFromStatementExpectation {
+	if mmItemsFromStatement.mock.funcItemsFromStatement != nil {
+		mmItemsFromStatement.mock.t.Fatalf("TransactionStatementRepositoryMock.ItemsFromStatement mock is already set by Set")
+	}
+
+	expectation := &TransactionStatementRepositoryMockItemsFromStatementExpectation{
+		mock:               mmItemsFromStatement.mock,
+		params:             &TransactionStatementRepositoryMockItemsFromStatementParams{ctx, data, accountID, defaultCurrency, defaultCategoryID},
+		expectationOrigins: TransactionStatementRepositoryMockItemsFromStatementExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmItemsFromStatement.expectations = append(mmItemsFromStatement.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TransactionStatementRepository.ItemsFromStatement return parameters for the expectation previously defined by the When method
+func (e *TransactionStatementRepositoryMockItemsFromStatementExpectation) Then(rows []*mm_usecase.TransactionImportRow, err error) *TransactionStatementRepositoryMock {
+	e.results = &TransactionStatementRepositoryMockItemsFromStatementResults{rows, err}
+	return e.mock
+}
+
+// Times sets number of times TransactionStatementRepository.ItemsFromStatement should be invoked
+func (mmItemsFromStatement *mTransactionStatementRepositoryMockItemsFromStatement) Times(n uint64) *mTransactionStatementRepositoryMockItemsFromStatement {
+	if n == 0 {
+		mmItemsFromStatement.mock.t.Fatalf("Times of TransactionStatementRepositoryMock.ItemsFromStatement mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmItemsFromStatement.expectedInvocations, n)
+	mmItemsFromStatement.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmItemsFromStatement
+}
+
+func (mmItemsFromStatement *mTransactionStatementRepositoryMockItemsFromStatement) invocationsDone() bool {
+	if len(mmItemsFromStatement.expectations) == 0 && mmItemsFromStatement.defaultExpectation == nil && mmItemsFromStatement.mock.funcItemsFromStatement == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmItemsFromStatement.mock.afterItemsFromStatementCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmItemsFromStatement.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ItemsFromStatement implements mm_usecase.TransactionStatementRepository
+func (mmItemsFromStatement *TransactionStatementRepositoryMock) ItemsFromStatement(ctx context.Context, data []byte, accountID uuid.UUID, defaultCurrency string, defaultCategoryID *uint64) (rows []*mm_usecase.TransactionImportRow, err error) {
+	mm_atomic.AddUint64(&mmItemsFromStatement.beforeItemsFromStatementCounter, 1)
+	defer mm_atomic.AddUint64(&mmItemsFromStatement.afterItemsFromStatementCounter, 1)
+
+	mmItemsFromStatement.t.Helper()
+
+	if mmItemsFromStatement.inspectFuncItemsFromStatement != nil {
+		mmItemsFromStatement.inspectFuncItemsFromStatement(ctx, data, accountID, defaultCurrency, defaultCategoryID)
+	}
+
+	mm_params := TransactionStatementRepositoryMockItemsFromStatementParams{ctx, data, accountID, defaultCurrency, defaultCategoryID}
+
+	// Record call args
+	mmItemsFromStatement.ItemsFromStatementMock.mutex.Lock()
+	mmItemsFromStatement.ItemsFromStatementMock.callArgs = append(mmItemsFromStatement.ItemsFromStatementMock.callArgs, &mm_params)
+	mmItemsFromStatement.ItemsFromStatementMock.mutex.Unlock()
+
+	for _, e := range mmItemsFromStatement.ItemsFromStatementMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rows, e.results.err
+		}
+	}
+
+	if mmItemsFromStatement.ItemsFromStatementMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmItemsFromStatement.ItemsFromStatementMock.defaultExpectation.Counter, 1)
+		mm_want := mmItemsFromStatement.ItemsFromStatementMock.defaultExpectation.params
+		mm_want_ptrs := mmItemsFromStatement.ItemsFromStatementMock.defaultExpectation.paramPtrs
+
+		mm_got := TransactionStatementRepositoryMockItemsFromStatementParams{ctx, data, accountID, defaultCurrency, defaultCategoryID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmItemsFromStatement.t.Errorf("TransactionStatementRepositoryMock.ItemsFromStatement got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmItemsFromStatement.ItemsFromStatementMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
+				mmItemsFromStatement.t.Errorf("TransactionStatementRepositoryMock.ItemsFromStatement got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmItemsFromStatement.ItemsFromStatementMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			}
+
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmItemsFromStatement.t.Errorf("TransactionStatementRepositoryMock.ItemsFromStatement got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmItemsFromStatement.ItemsFromStatementMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			}
+
+			if mm_want_ptrs.defaultCurrency != nil && !minimock.Equal(*mm_want_ptrs.defaultCurrency, mm_got.defaultCurrency) {
+				mmItemsFromStatement.t.Errorf("TransactionStatementRepositoryMock.ItemsFromStatement got unexpected parameter defaultCurrency, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmItemsFromStatement.ItemsFromStatementMock.defaultExpectation.expectationOrigins.originDefaultCurrency, *mm_want_ptrs.defaultCurrency, mm_got.defaultCurrency, minimock.Diff(*mm_want_ptrs.defaultCurrency, mm_got.defaultCurrency))
+			}
+
+			if mm_want_ptrs.defaultCategoryID != nil && !minimock.Equal(*mm_want_ptrs.defaultCategoryID, mm_got.defaultCategoryID) {
+				mmItemsFromStatement.t.Errorf("TransactionStatementRepositoryMock.ItemsFromStatement got unexpected parameter defaultCategoryID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmItemsFromStatement.ItemsFromStatementMock.defaultExpectation.expectationOrigins.originDefaultCategoryID, *mm_want_ptrs.defaultCategoryID, mm_got.defaultCategoryID, minimock.Diff(*mm_want_ptrs.defaultCategoryID, mm_got.defaultCategoryID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmItemsFromStatement.t.Errorf("TransactionStatementRepositoryMock.ItemsFromStatement got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmItemsFromStatement.ItemsFromStatementMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmItemsFromStatement.ItemsFromStatementMock.defaultExpectation.results
+		if mm_results == nil {
+			mmItemsFromStatement.t.Fatal("No results are set for the TransactionStatementRepositoryMock.ItemsFromStatement")
+		}
+		return (*mm_results).rows, (*mm_results).err
+	}
+	if mmItemsFromStatement.funcItemsFromStatement != nil {
+		return mmItemsFromStatement.funcItemsFromStatement(ctx, data, accountID, defaultCurrency, defaultCategoryID)
+	}
+	mmItemsFromStatement.t.Fatalf("Unexpected call to TransactionStatementRepositoryMock.ItemsFromStatement. %v %v %v %v %v", ctx, data, accountID, defaultCurrency, defaultCategoryID)
+	return
+}
+
+// ItemsFromStatementAfterCounter returns a count of finished TransactionStatementRepositoryMock.ItemsFromStatement invocations
+func (mmItemsFromStatement *TransactionStatementRepositoryMock) ItemsFromStatementAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmItemsFromStatement.afterItemsFromStatementCounter)
+}
+
+// ItemsFromStatementBeforeCounter returns a count of TransactionStatementRepositoryMock.ItemsFromStatement invocations
+func (mmItemsFromStatement *TransactionStatementRepositoryMock) ItemsFromStatementBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmItemsFromStatement.beforeItemsFromStatementCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransactionStatementRepositoryMock.ItemsFromStatement.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmItemsFromStatement *mTransactionStatementRepositoryMockItemsFromStatement) Calls() []*TransactionStatementRepositoryMockItemsFromStatementParams {
+	mmItemsFromStatement.mutex.RLock()
+
+	argCopy := make([]*TransactionStatementRepositoryMockItemsFromStatementParams, len(mmItemsFromStatement.callArgs))
+	copy(argCopy, mmItemsFromStatement.callArgs)
+
+	mmItemsFromStatement.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockItemsFromStatementDone returns true if the count of the ItemsFromStatement invocations corresponds
+// the number of defined expectations
+func (m *TransactionStatementRepositoryMock) MinimockItemsFromStatementDone() bool {
+	if m.ItemsFromStatementMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ItemsFromStatementMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ItemsFromStatementMock.invocationsDone()
+}
+
+// MinimockItemsFromStatementInspect logs each unmet expectation
+func (m *TransactionStatementRepositoryMock) MinimockItemsFromStatementInspect() {
+	for _, e := range m.ItemsFromStatementMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransactionStatementRepositoryMock.ItemsFromStatement at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterItemsFromStatementCounter := mm_atomic.LoadUint64(&m.afterItemsFromStatementCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ItemsFromStatementMock.defaultExpectation != nil && afterItemsFromStatementCounter < 1 {
+		if m.ItemsFromStatementMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionStatementRepositoryMock.ItemsFromStatement at\n%s", m.ItemsFromStatementMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TransactionStatementRepositoryMock.ItemsFromStatement at\n%s with params: %#v", m.ItemsFromStatementMock.defaultExpectation.expectationOrigins.origin, *m.ItemsFromStatementMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcItemsFromStatement != nil && afterItemsFromStatementCounter < 1 {
+		m.t.Errorf("Expected call to TransactionStatementRepositoryMock.ItemsFromStatement at\n%s", m.funcItemsFromStatementOrigin)
+	}
+
+	if !m.ItemsFromStatementMock.invocationsDone() && afterItemsFromStatementCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionStatementRepositoryMock.ItemsFromStatement at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ItemsFromStatementMock.expectedInvocations), m.ItemsFromStatementMock.expectedInvocationsOrigin, afterItemsFromStatementCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *TransactionStatementRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockFormatInspect()
+
+			m.MinimockItemsFromStatementInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *TransactionStatementRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *TransactionStatementRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockFormatDone() &&
+		m.MinimockItemsFromStatementDone()
+}
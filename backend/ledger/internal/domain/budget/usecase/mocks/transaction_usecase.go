@@ -36,13 +36,55 @@ type TransactionUsecaseMock struct {
 	beforeCreateTransactionByDTOCounter uint64
 	CreateTransactionByDTOMock          mTransactionUsecaseMockCreateTransactionByDTO
 
-	funcDeleteTransactionByID          func(ctx context.Context, id uuid.UUID) (err error)
+	funcCreateTransactionsBatch          func(ctx context.Context, in mm_usecase.CreateTransactionsBatchInput) (resResult *mm_usecase.TransactionsBatchResult, err error)
+	funcCreateTransactionsBatchOrigin    string
+	inspectFuncCreateTransactionsBatch   func(ctx context.Context, in mm_usecase.CreateTransactionsBatchInput)
+	afterCreateTransactionsBatchCounter  uint64
+	beforeCreateTransactionsBatchCounter uint64
+	CreateTransactionsBatchMock          mTransactionUsecaseMockCreateTransactionsBatch
+
+	funcCreateTransferByDTO          func(ctx context.Context, in mm_usecase.CreateTransferDataInput) (resTransferDTO *mm_usecase.TransferDTO, err error)
+	funcCreateTransferByDTOOrigin    string
+	inspectFuncCreateTransferByDTO   func(ctx context.Context, in mm_usecase.CreateTransferDataInput)
+	afterCreateTransferByDTOCounter  uint64
+	beforeCreateTransferByDTOCounter uint64
+	CreateTransferByDTOMock          mTransactionUsecaseMockCreateTransferByDTO
+
+	funcDeleteTransactionByID          func(ctx context.Context, id uuid.UUID, version int64, skipVersionCheck bool) (err error)
 	funcDeleteTransactionByIDOrigin    string
-	inspectFuncDeleteTransactionByID   func(ctx context.Context, id uuid.UUID)
+	inspectFuncDeleteTransactionByID   func(ctx context.Context, id uuid.UUID, version int64, skipVersionCheck bool)
 	afterDeleteTransactionByIDCounter  uint64
 	beforeDeleteTransactionByIDCounter uint64
 	DeleteTransactionByIDMock          mTransactionUsecaseMockDeleteTransactionByID
 
+	funcDeleteTransferByID          func(ctx context.Context, id uuid.UUID) (err error)
+	funcDeleteTransferByIDOrigin    string
+	inspectFuncDeleteTransferByID   func(ctx context.Context, id uuid.UUID)
+	afterDeleteTransferByIDCounter  uint64
+	beforeDeleteTransferByIDCounter uint64
+	DeleteTransferByIDMock          mTransactionUsecaseMockDeleteTransferByID
+
+	funcExportReports          func(ctx context.Context, format mm_usecase.ExportFormat, queryFilter mm_usecase.CountReportItemsQueryFilter) (resFile *mm_usecase.ExportFile, err error)
+	funcExportReportsOrigin    string
+	inspectFuncExportReports   func(ctx context.Context, format mm_usecase.ExportFormat, queryFilter mm_usecase.CountReportItemsQueryFilter)
+	afterExportReportsCounter  uint64
+	beforeExportReportsCounter uint64
+	ExportReportsMock          mTransactionUsecaseMockExportReports
+
+	funcExportTransactions          func(ctx context.Context, format mm_usecase.ExportFormat, listOptions *mm_usecase.TransactionListOptions) (resFile *mm_usecase.ExportFile, total uint64, err error)
+	funcExportTransactionsOrigin    string
+	inspectFuncExportTransactions   func(ctx context.Context, format mm_usecase.ExportFormat, listOptions *mm_usecase.TransactionListOptions)
+	afterExportTransactionsCounter  uint64
+	beforeExportTransactionsCounter uint64
+	ExportTransactionsMock          mTransactionUsecaseMockExportTransactions
+
+	funcFindCursorList          func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, limit uint64, withTotal bool) (resPage *mm_usecase.TransactionCursorPage, err error)
+	funcFindCursorListOrigin    string
+	inspectFuncFindCursorList   func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, limit uint64, withTotal bool)
+	afterFindCursorListCounter  uint64
+	beforeFindCursorListCounter uint64
+	FindCursorListMock          mTransactionUsecaseMockFindCursorList
+
 	funcFindList          func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.TransactionDTO, err error)
 	funcFindListOrigin    string
 	inspectFuncFindList   func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams)
@@ -78,19 +120,54 @@ type TransactionUsecaseMock struct {
 	beforeFindPagedListAsCSVCounter uint64
 	FindPagedListAsCSVMock          mTransactionUsecaseMockFindPagedListAsCSV
 
-	funcImportTransactionsFromCSV          func(ctx context.Context, data []byte, accountID uuid.UUID) (err error)
+	funcFindTransferByID          func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.TransferDTO, err error)
+	funcFindTransferByIDOrigin    string
+	inspectFuncFindTransferByID   func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)
+	afterFindTransferByIDCounter  uint64
+	beforeFindTransferByIDCounter uint64
+	FindTransferByIDMock          mTransactionUsecaseMockFindTransferByID
+
+	funcImportTransactionsFromCSV          func(ctx context.Context, in mm_usecase.ImportTransactionsFromCSVInput) (resReport *mm_usecase.TransactionImportReport, err error)
 	funcImportTransactionsFromCSVOrigin    string
-	inspectFuncImportTransactionsFromCSV   func(ctx context.Context, data []byte, accountID uuid.UUID)
+	inspectFuncImportTransactionsFromCSV   func(ctx context.Context, in mm_usecase.ImportTransactionsFromCSVInput)
 	afterImportTransactionsFromCSVCounter  uint64
 	beforeImportTransactionsFromCSVCounter uint64
 	ImportTransactionsFromCSVMock          mTransactionUsecaseMockImportTransactionsFromCSV
 
-	funcPatchTransactionByDTO          func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransactionDataInput, skipVersionCheck bool) (err error)
+	funcPatchTransactionByDTO          func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransactionDataInput, skipVersionCheck bool) (resWarnings []*entity.BudgetWarning, err error)
 	funcPatchTransactionByDTOOrigin    string
 	inspectFuncPatchTransactionByDTO   func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransactionDataInput, skipVersionCheck bool)
 	afterPatchTransactionByDTOCounter  uint64
 	beforePatchTransactionByDTOCounter uint64
 	PatchTransactionByDTOMock          mTransactionUsecaseMockPatchTransactionByDTO
+
+	funcPatchTransferByDTO          func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransferDataInput, skipVersionCheck bool) (err error)
+	funcPatchTransferByDTOOrigin    string
+	inspectFuncPatchTransferByDTO   func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransferDataInput, skipVersionCheck bool)
+	afterPatchTransferByDTOCounter  uint64
+	beforePatchTransferByDTOCounter uint64
+	PatchTransferByDTOMock          mTransactionUsecaseMockPatchTransferByDTO
+
+	funcReapplyCategoryRules          func(ctx context.Context, in mm_usecase.ReapplyCategoryRulesInput) (resReport *mm_usecase.ReapplyCategoryRulesReport, err error)
+	funcReapplyCategoryRulesOrigin    string
+	inspectFuncReapplyCategoryRules   func(ctx context.Context, in mm_usecase.ReapplyCategoryRulesInput)
+	afterReapplyCategoryRulesCounter  uint64
+	beforeReapplyCategoryRulesCounter uint64
+	ReapplyCategoryRulesMock          mTransactionUsecaseMockReapplyCategoryRules
+
+	funcResolveCurrency          func(ctx context.Context, accountID uuid.UUID, currency string, walletID *uuid.UUID) (resCurrency string, err error)
+	funcResolveCurrencyOrigin    string
+	inspectFuncResolveCurrency   func(ctx context.Context, accountID uuid.UUID, currency string, walletID *uuid.UUID)
+	afterResolveCurrencyCounter  uint64
+	beforeResolveCurrencyCounter uint64
+	ResolveCurrencyMock          mTransactionUsecaseMockResolveCurrency
+
+	funcStreamListAsCSV          func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, batchSize uint64, write func(chunk []byte) error) (err error)
+	funcStreamListAsCSVOrigin    string
+	inspectFuncStreamListAsCSV   func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, batchSize uint64, write func(chunk []byte) error)
+	afterStreamListAsCSVCounter  uint64
+	beforeStreamListAsCSVCounter uint64
+	StreamListAsCSVMock          mTransactionUsecaseMockStreamListAsCSV
 }
 
 // NewTransactionUsecaseMock returns a mock for mm_usecase.TransactionUsecase
@@ -107,9 +184,27 @@ func NewTransactionUsecaseMock(t minimock.Tester) *TransactionUsecaseMock {
 	m.CreateTransactionByDTOMock = mTransactionUsecaseMockCreateTransactionByDTO{mock: m}
 	m.CreateTransactionByDTOMock.callArgs = []*TransactionUsecaseMockCreateTransactionByDTOParams{}
 
+	m.CreateTransactionsBatchMock = mTransactionUsecaseMockCreateTransactionsBatch{mock: m}
+	m.CreateTransactionsBatchMock.callArgs = []*TransactionUsecaseMockCreateTransactionsBatchParams{}
+
+	m.CreateTransferByDTOMock = mTransactionUsecaseMockCreateTransferByDTO{mock: m}
+	m.CreateTransferByDTOMock.callArgs = []*TransactionUsecaseMockCreateTransferByDTOParams{}
+
 	m.DeleteTransactionByIDMock = mTransactionUsecaseMockDeleteTransactionByID{mock: m}
 	m.DeleteTransactionByIDMock.callArgs = []*TransactionUsecaseMockDeleteTransactionByIDParams{}
 
+	m.DeleteTransferByIDMock = mTransactionUsecaseMockDeleteTransferByID{mock: m}
+	m.DeleteTransferByIDMock.callArgs = []*TransactionUsecaseMockDeleteTransferByIDParams{}
+
+	m.ExportReportsMock = mTransactionUsecaseMockExportReports{mock: m}
+	m.ExportReportsMock.callArgs = []*TransactionUsecaseMockExportReportsParams{}
+
+	m.ExportTransactionsMock = mTransactionUsecaseMockExportTransactions{mock: m}
+	m.ExportTransactionsMock.callArgs = []*TransactionUsecaseMockExportTransactionsParams{}
+
+	m.FindCursorListMock = mTransactionUsecaseMockFindCursorList{mock: m}
+	m.FindCursorListMock.callArgs = []*TransactionUsecaseMockFindCursorListParams{}
+
 	m.FindListMock = mTransactionUsecaseMockFindList{mock: m}
 	m.FindListMock.callArgs = []*TransactionUsecaseMockFindListParams{}
 
@@ -125,12 +220,27 @@ func NewTransactionUsecaseMock(t minimock.Tester) *TransactionUsecaseMock {
 	m.FindPagedListAsCSVMock = mTransactionUsecaseMockFindPagedListAsCSV{mock: m}
 	m.FindPagedListAsCSVMock.callArgs = []*TransactionUsecaseMockFindPagedListAsCSVParams{}
 
+	m.FindTransferByIDMock = mTransactionUsecaseMockFindTransferByID{mock: m}
+	m.FindTransferByIDMock.callArgs = []*TransactionUsecaseMockFindTransferByIDParams{}
+
 	m.ImportTransactionsFromCSVMock = mTransactionUsecaseMockImportTransactionsFromCSV{mock: m}
 	m.ImportTransactionsFromCSVMock.callArgs = []*TransactionUsecaseMockImportTransactionsFromCSVParams{}
 
 	m.PatchTransactionByDTOMock = mTransactionUsecaseMockPatchTransactionByDTO{mock: m}
 	m.PatchTransactionByDTOMock.callArgs = []*TransactionUsecaseMockPatchTransactionByDTOParams{}
 
+	m.PatchTransferByDTOMock = mTransactionUsecaseMockPatchTransferByDTO{mock: m}
+	m.PatchTransferByDTOMock.callArgs = []*TransactionUsecaseMockPatchTransferByDTOParams{}
+
+	m.ReapplyCategoryRulesMock = mTransactionUsecaseMockReapplyCategoryRules{mock: m}
+	m.ReapplyCategoryRulesMock.callArgs = []*TransactionUsecaseMockReapplyCategoryRulesParams{}
+
+	m.ResolveCurrencyMock = mTransactionUsecaseMockResolveCurrency{mock: m}
+	m.ResolveCurrencyMock.callArgs = []*TransactionUsecaseMockResolveCurrencyParams{}
+
+	m.StreamListAsCSVMock = mTransactionUsecaseMockStreamListAsCSV{mock: m}
+	m.StreamListAsCSVMock.callArgs = []*TransactionUsecaseMockStreamListAsCSVParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -823,52 +933,53 @@ func (m *TransactionUsecaseMock) MinimockCreateTransactionByDTOInspect() {
 	}
 }
 
-type mTransactionUsecaseMockDeleteTransactionByID struct {
+type mTransactionUsecaseMockCreateTransactionsBatch struct {
 	optional           bool
 	mock               *TransactionUsecaseMock
-	defaultExpectation *TransactionUsecaseMockDeleteTransactionByIDExpectation
-	expectations       []*TransactionUsecaseMockDeleteTransactionByIDExpectation
+	defaultExpectation *TransactionUsecaseMockCreateTransactionsBatchExpectation
+	expectations       []*TransactionUsecaseMockCreateTransactionsBatchExpectation
 
-	callArgs []*TransactionUsecaseMockDeleteTransactionByIDParams
+	callArgs []*TransactionUsecaseMockCreateTransactionsBatchParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// TransactionUsecaseMockDeleteTransactionByIDExpectation specifies expectation struct of the TransactionUsecase.DeleteTransactionByID
-type TransactionUsecaseMockDeleteTransactionByIDExpectation struct {
+// TransactionUsecaseMockCreateTransactionsBatchExpectation specifies expectation struct of the TransactionUsecase.CreateTransactionsBatch
+type TransactionUsecaseMockCreateTransactionsBatchExpectation struct {
 	mock               *TransactionUsecaseMock
-	params             *TransactionUsecaseMockDeleteTransactionByIDParams
-	paramPtrs          *TransactionUsecaseMockDeleteTransactionByIDParamPtrs
-	expectationOrigins TransactionUsecaseMockDeleteTransactionByIDExpectationOrigins
-	results            *TransactionUsecaseMockDeleteTransactionByIDResults
+	params             *TransactionUsecaseMockCreateTransactionsBatchParams
+	paramPtrs          *TransactionUsecaseMockCreateTransactionsBatchParamPtrs
+	expectationOrigins TransactionUsecaseMockCreateTransactionsBatchExpectationOrigins
+	results            *TransactionUsecaseMockCreateTransactionsBatchResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// TransactionUsecaseMockDeleteTransactionByIDParams contains parameters of the TransactionUsecase.DeleteTransactionByID
-type TransactionUsecaseMockDeleteTransactionByIDParams struct {
+// TransactionUsecaseMockCreateTransactionsBatchParams contains parameters of the TransactionUsecase.CreateTransactionsBatch
+type TransactionUsecaseMockCreateTransactionsBatchParams struct {
 	ctx context.Context
-	id  uuid.UUID
+	in  mm_usecase.CreateTransactionsBatchInput
 }
 
-// TransactionUsecaseMockDeleteTransactionByIDParamPtrs contains pointers to parameters of the TransactionUsecase.DeleteTransactionByID
-type TransactionUsecaseMockDeleteTransactionByIDParamPtrs struct {
+// TransactionUsecaseMockCreateTransactionsBatchParamPtrs contains pointers to parameters of the TransactionUsecase.CreateTransactionsBatch
+type TransactionUsecaseMockCreateTransactionsBatchParamPtrs struct {
 	ctx *context.Context
-	id  *uuid.UUID
+	in  *mm_usecase.CreateTransactionsBatchInput
 }
 
-// TransactionUsecaseMockDeleteTransactionByIDResults contains results of the TransactionUsecase.DeleteTransactionByID
-type TransactionUsecaseMockDeleteTransactionByIDResults struct {
-	err error
+// TransactionUsecaseMockCreateTransactionsBatchResults contains results of the TransactionUsecase.CreateTransactionsBatch
+type TransactionUsecaseMockCreateTransactionsBatchResults struct {
+	resResult *mm_usecase.TransactionsBatchResult
+	err       error
 }
 
-// TransactionUsecaseMockDeleteTransactionByIDOrigins contains origins of expectations of the TransactionUsecase.DeleteTransactionByID
-type TransactionUsecaseMockDeleteTransactionByIDExpectationOrigins struct {
+// TransactionUsecaseMockCreateTransactionsBatchOrigins contains origins of expectations of the TransactionUsecase.CreateTransactionsBatch
+type TransactionUsecaseMockCreateTransactionsBatchExpectationOrigins struct {
 	origin    string
 	originCtx string
-	originId  string
+	originIn  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -876,345 +987,342 @@ type TransactionUsecaseMockDeleteTransactionByIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) Optional() *mTransactionUsecaseMockDeleteTransactionByID {
-	mmDeleteTransactionByID.optional = true
-	return mmDeleteTransactionByID
+func (mmCreateTransactionsBatch *mTransactionUsecaseMockCreateTransactionsBatch) Optional() *mTransactionUsecaseMockCreateTransactionsBatch {
+	mmCreateTransactionsBatch.optional = true
+	return mmCreateTransactionsBatch
 }
 
-// Expect sets up expected params for TransactionUsecase.DeleteTransactionByID
-func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) Expect(ctx context.Context, id uuid.UUID) *mTransactionUsecaseMockDeleteTransactionByID {
-	if mmDeleteTransactionByID.mock.funcDeleteTransactionByID != nil {
-		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by Set")
+// Expect sets up expected params for TransactionUsecase.CreateTransactionsBatch
+func (mmCreateTransactionsBatch *mTransactionUsecaseMockCreateTransactionsBatch) Expect(ctx context.Context, in mm_usecase.CreateTransactionsBatchInput) *mTransactionUsecaseMockCreateTransactionsBatch {
+	if mmCreateTransactionsBatch.mock.funcCreateTransactionsBatch != nil {
+		mmCreateTransactionsBatch.mock.t.Fatalf("TransactionUsecaseMock.CreateTransactionsBatch mock is already set by Set")
 	}
 
-	if mmDeleteTransactionByID.defaultExpectation == nil {
-		mmDeleteTransactionByID.defaultExpectation = &TransactionUsecaseMockDeleteTransactionByIDExpectation{}
+	if mmCreateTransactionsBatch.defaultExpectation == nil {
+		mmCreateTransactionsBatch.defaultExpectation = &TransactionUsecaseMockCreateTransactionsBatchExpectation{}
 	}
 
-	if mmDeleteTransactionByID.defaultExpectation.paramPtrs != nil {
-		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by ExpectParams functions")
+	if mmCreateTransactionsBatch.defaultExpectation.paramPtrs != nil {
+		mmCreateTransactionsBatch.mock.t.Fatalf("TransactionUsecaseMock.CreateTransactionsBatch mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteTransactionByID.defaultExpectation.params = &TransactionUsecaseMockDeleteTransactionByIDParams{ctx, id}
-	mmDeleteTransactionByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteTransactionByID.expectations {
-		if minimock.Equal(e.params, mmDeleteTransactionByID.defaultExpectation.params) {
-			mmDeleteTransactionByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteTransactionByID.defaultExpectation.params)
+	mmCreateTransactionsBatch.defaultExpectation.params = &TransactionUsecaseMockCreateTransactionsBatchParams{ctx, in}
+	mmCreateTransactionsBatch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateTransactionsBatch.expectations {
+		if minimock.Equal(e.params, mmCreateTransactionsBatch.defaultExpectation.params) {
+			mmCreateTransactionsBatch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateTransactionsBatch.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteTransactionByID
+	return mmCreateTransactionsBatch
 }
 
-// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.DeleteTransactionByID
-func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockDeleteTransactionByID {
-	if mmDeleteTransactionByID.mock.funcDeleteTransactionByID != nil {
-		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.CreateTransactionsBatch
+func (mmCreateTransactionsBatch *mTransactionUsecaseMockCreateTransactionsBatch) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockCreateTransactionsBatch {
+	if mmCreateTransactionsBatch.mock.funcCreateTransactionsBatch != nil {
+		mmCreateTransactionsBatch.mock.t.Fatalf("TransactionUsecaseMock.CreateTransactionsBatch mock is already set by Set")
 	}
 
-	if mmDeleteTransactionByID.defaultExpectation == nil {
-		mmDeleteTransactionByID.defaultExpectation = &TransactionUsecaseMockDeleteTransactionByIDExpectation{}
+	if mmCreateTransactionsBatch.defaultExpectation == nil {
+		mmCreateTransactionsBatch.defaultExpectation = &TransactionUsecaseMockCreateTransactionsBatchExpectation{}
 	}
 
-	if mmDeleteTransactionByID.defaultExpectation.params != nil {
-		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by Expect")
+	if mmCreateTransactionsBatch.defaultExpectation.params != nil {
+		mmCreateTransactionsBatch.mock.t.Fatalf("TransactionUsecaseMock.CreateTransactionsBatch mock is already set by Expect")
 	}
 
-	if mmDeleteTransactionByID.defaultExpectation.paramPtrs == nil {
-		mmDeleteTransactionByID.defaultExpectation.paramPtrs = &TransactionUsecaseMockDeleteTransactionByIDParamPtrs{}
+	if mmCreateTransactionsBatch.defaultExpectation.paramPtrs == nil {
+		mmCreateTransactionsBatch.defaultExpectation.paramPtrs = &TransactionUsecaseMockCreateTransactionsBatchParamPtrs{}
 	}
-	mmDeleteTransactionByID.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteTransactionByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCreateTransactionsBatch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateTransactionsBatch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteTransactionByID
+	return mmCreateTransactionsBatch
 }
 
-// ExpectIdParam2 sets up expected param id for TransactionUsecase.DeleteTransactionByID
-func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) ExpectIdParam2(id uuid.UUID) *mTransactionUsecaseMockDeleteTransactionByID {
-	if mmDeleteTransactionByID.mock.funcDeleteTransactionByID != nil {
-		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by Set")
+// ExpectInParam2 sets up expected param in for TransactionUsecase.CreateTransactionsBatch
+func (mmCreateTransactionsBatch *mTransactionUsecaseMockCreateTransactionsBatch) ExpectInParam2(in mm_usecase.CreateTransactionsBatchInput) *mTransactionUsecaseMockCreateTransactionsBatch {
+	if mmCreateTransactionsBatch.mock.funcCreateTransactionsBatch != nil {
+		mmCreateTransactionsBatch.mock.t.Fatalf("TransactionUsecaseMock.CreateTransactionsBatch mock is already set by Set")
 	}
 
-	if mmDeleteTransactionByID.defaultExpectation == nil {
-		mmDeleteTransactionByID.defaultExpectation = &TransactionUsecaseMockDeleteTransactionByIDExpectation{}
+	if mmCreateTransactionsBatch.defaultExpectation == nil {
+		mmCreateTransactionsBatch.defaultExpectation = &TransactionUsecaseMockCreateTransactionsBatchExpectation{}
 	}
 
-	if mmDeleteTransactionByID.defaultExpectation.params != nil {
-		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by Expect")
+	if mmCreateTransactionsBatch.defaultExpectation.params != nil {
+		mmCreateTransactionsBatch.mock.t.Fatalf("TransactionUsecaseMock.CreateTransactionsBatch mock is already set by Expect")
 	}
 
-	if mmDeleteTransactionByID.defaultExpectation.paramPtrs == nil {
-		mmDeleteTransactionByID.defaultExpectation.paramPtrs = &TransactionUsecaseMockDeleteTransactionByIDParamPtrs{}
+	if mmCreateTransactionsBatch.defaultExpectation.paramPtrs == nil {
+		mmCreateTransactionsBatch.defaultExpectation.paramPtrs = &TransactionUsecaseMockCreateTransactionsBatchParamPtrs{}
 	}
-	mmDeleteTransactionByID.defaultExpectation.paramPtrs.id = &id
-	mmDeleteTransactionByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmCreateTransactionsBatch.defaultExpectation.paramPtrs.in = &in
+	mmCreateTransactionsBatch.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
 
-	return mmDeleteTransactionByID
+	return mmCreateTransactionsBatch
 }
 
-// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.DeleteTransactionByID
-func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) Inspect(f func(ctx context.Context, id uuid.UUID)) *mTransactionUsecaseMockDeleteTransactionByID {
-	if mmDeleteTransactionByID.mock.inspectFuncDeleteTransactionByID != nil {
-		mmDeleteTransactionByID.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.DeleteTransactionByID")
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.CreateTransactionsBatch
+func (mmCreateTransactionsBatch *mTransactionUsecaseMockCreateTransactionsBatch) Inspect(f func(ctx context.Context, in mm_usecase.CreateTransactionsBatchInput)) *mTransactionUsecaseMockCreateTransactionsBatch {
+	if mmCreateTransactionsBatch.mock.inspectFuncCreateTransactionsBatch != nil {
+		mmCreateTransactionsBatch.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.CreateTransactionsBatch")
 	}
 
-	mmDeleteTransactionByID.mock.inspectFuncDeleteTransactionByID = f
+	mmCreateTransactionsBatch.mock.inspectFuncCreateTransactionsBatch = f
 
-	return mmDeleteTransactionByID
+	return mmCreateTransactionsBatch
 }
 
-// Return sets up results that will be returned by TransactionUsecase.DeleteTransactionByID
-func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) Return(err error) *TransactionUsecaseMock {
-	if mmDeleteTransactionByID.mock.funcDeleteTransactionByID != nil {
-		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by Set")
+// Return sets up results that will be returned by TransactionUsecase.CreateTransactionsBatch
+func (mmCreateTransactionsBatch *mTransactionUsecaseMockCreateTransactionsBatch) Return(resResult *mm_usecase.TransactionsBatchResult, err error) *TransactionUsecaseMock {
+	if mmCreateTransactionsBatch.mock.funcCreateTransactionsBatch != nil {
+		mmCreateTransactionsBatch.mock.t.Fatalf("TransactionUsecaseMock.CreateTransactionsBatch mock is already set by Set")
 	}
 
-	if mmDeleteTransactionByID.defaultExpectation == nil {
-		mmDeleteTransactionByID.defaultExpectation = &TransactionUsecaseMockDeleteTransactionByIDExpectation{mock: mmDeleteTransactionByID.mock}
+	if mmCreateTransactionsBatch.defaultExpectation == nil {
+		mmCreateTransactionsBatch.defaultExpectation = &TransactionUsecaseMockCreateTransactionsBatchExpectation{mock: mmCreateTransactionsBatch.mock}
 	}
-	mmDeleteTransactionByID.defaultExpectation.results = &TransactionUsecaseMockDeleteTransactionByIDResults{err}
-	mmDeleteTransactionByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteTransactionByID.mock
+	mmCreateTransactionsBatch.defaultExpectation.results = &TransactionUsecaseMockCreateTransactionsBatchResults{resResult, err}
+	mmCreateTransactionsBatch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateTransactionsBatch.mock
 }
 
-// Set uses given function f to mock the TransactionUsecase.DeleteTransactionByID method
-func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *TransactionUsecaseMock {
-	if mmDeleteTransactionByID.defaultExpectation != nil {
-		mmDeleteTransactionByID.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.DeleteTransactionByID method")
+// Set uses given function f to mock the TransactionUsecase.CreateTransactionsBatch method
+func (mmCreateTransactionsBatch *mTransactionUsecaseMockCreateTransactionsBatch) Set(f func(ctx context.Context, in mm_usecase.CreateTransactionsBatchInput) (resResult *mm_usecase.TransactionsBatchResult, err error)) *TransactionUsecaseMock {
+	if mmCreateTransactionsBatch.defaultExpectation != nil {
+		mmCreateTransactionsBatch.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.CreateTransactionsBatch method")
 	}
 
-	if len(mmDeleteTransactionByID.expectations) > 0 {
-		mmDeleteTransactionByID.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.DeleteTransactionByID method")
+	if len(mmCreateTransactionsBatch.expectations) > 0 {
+		mmCreateTransactionsBatch.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.CreateTransactionsBatch method")
 	}
 
-	mmDeleteTransactionByID.mock.funcDeleteTransactionByID = f
-	mmDeleteTransactionByID.mock.funcDeleteTransactionByIDOrigin = minimock.CallerInfo(1)
-	return mmDeleteTransactionByID.mock
+	mmCreateTransactionsBatch.mock.funcCreateTransactionsBatch = f
+	mmCreateTransactionsBatch.mock.funcCreateTransactionsBatchOrigin = minimock.CallerInfo(1)
+	return mmCreateTransactionsBatch.mock
 }
 
-// When sets expectation for the TransactionUsecase.DeleteTransactionByID which will trigger the result defined by the following
+// When sets expectation for the TransactionUsecase.CreateTransactionsBatch which will trigger the result defined by the following
 // Then helper
-func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) When(ctx context.Context, id uuid.UUID) *TransactionUsecaseMockDeleteTransactionByIDExpectation {
-	if mmDeleteTransactionByID.mock.funcDeleteTransactionByID != nil {
-		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by Set")
+func (mmCreateTransactionsBatch *mTransactionUsecaseMockCreateTransactionsBatch) When(ctx context.Context, in mm_usecase.CreateTransactionsBatchInput) *TransactionUsecaseMockCreateTransactionsBatchExpectation {
+	if mmCreateTransactionsBatch.mock.funcCreateTransactionsBatch != nil {
+		mmCreateTransactionsBatch.mock.t.Fatalf("TransactionUsecaseMock.CreateTransactionsBatch mock is already set by Set")
 	}
 
-	expectation := &TransactionUsecaseMockDeleteTransactionByIDExpectation{
-		mock:               mmDeleteTransactionByID.mock,
-		params:             &TransactionUsecaseMockDeleteTransactionByIDParams{ctx, id},
-		expectationOrigins: TransactionUsecaseMockDeleteTransactionByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &TransactionUsecaseMockCreateTransactionsBatchExpectation{
+		mock:               mmCreateTransactionsBatch.mock,
+		params:             &TransactionUsecaseMockCreateTransactionsBatchParams{ctx, in},
+		expectationOrigins: TransactionUsecaseMockCreateTransactionsBatchExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteTransactionByID.expectations = append(mmDeleteTransactionByID.expectations, expectation)
+	mmCreateTransactionsBatch.expectations = append(mmCreateTransactionsBatch.expectations, expectation)
 	return expectation
 }
 
-// Then sets up TransactionUsecase.DeleteTransactionByID return parameters for the expectation previously defined by the When method
-func (e *TransactionUsecaseMockDeleteTransactionByIDExpectation) Then(err error) *TransactionUsecaseMock {
-	e.results = &TransactionUsecaseMockDeleteTransactionByIDResults{err}
+// Then sets up TransactionUsecase.CreateTransactionsBatch return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockCreateTransactionsBatchExpectation) Then(resResult *mm_usecase.TransactionsBatchResult, err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockCreateTransactionsBatchResults{resResult, err}
 	return e.mock
 }
 
-// Times sets number of times TransactionUsecase.DeleteTransactionByID should be invoked
-func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) Times(n uint64) *mTransactionUsecaseMockDeleteTransactionByID {
+// Times sets number of times TransactionUsecase.CreateTransactionsBatch should be invoked
+func (mmCreateTransactionsBatch *mTransactionUsecaseMockCreateTransactionsBatch) Times(n uint64) *mTransactionUsecaseMockCreateTransactionsBatch {
 	if n == 0 {
-		mmDeleteTransactionByID.mock.t.Fatalf("Times of TransactionUsecaseMock.DeleteTransactionByID mock can not be zero")
+		mmCreateTransactionsBatch.mock.t.Fatalf("Times of TransactionUsecaseMock.CreateTransactionsBatch mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteTransactionByID.expectedInvocations, n)
-	mmDeleteTransactionByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteTransactionByID
+	mm_atomic.StoreUint64(&mmCreateTransactionsBatch.expectedInvocations, n)
+	mmCreateTransactionsBatch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateTransactionsBatch
 }
 
-func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) invocationsDone() bool {
-	if len(mmDeleteTransactionByID.expectations) == 0 && mmDeleteTransactionByID.defaultExpectation == nil && mmDeleteTransactionByID.mock.funcDeleteTransactionByID == nil {
+func (mmCreateTransactionsBatch *mTransactionUsecaseMockCreateTransactionsBatch) invocationsDone() bool {
+	if len(mmCreateTransactionsBatch.expectations) == 0 && mmCreateTransactionsBatch.defaultExpectation == nil && mmCreateTransactionsBatch.mock.funcCreateTransactionsBatch == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteTransactionByID.mock.afterDeleteTransactionByIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteTransactionByID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateTransactionsBatch.mock.afterCreateTransactionsBatchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateTransactionsBatch.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteTransactionByID implements mm_usecase.TransactionUsecase
-func (mmDeleteTransactionByID *TransactionUsecaseMock) DeleteTransactionByID(ctx context.Context, id uuid.UUID) (err error) {
-	mm_atomic.AddUint64(&mmDeleteTransactionByID.beforeDeleteTransactionByIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteTransactionByID.afterDeleteTransactionByIDCounter, 1)
+// CreateTransactionsBatch implements mm_usecase.TransactionUsecase
+func (mmCreateTransactionsBatch *TransactionUsecaseMock) CreateTransactionsBatch(ctx context.Context, in mm_usecase.CreateTransactionsBatchInput) (resResult *mm_usecase.TransactionsBatchResult, err error) {
+	mm_atomic.AddUint64(&mmCreateTransactionsBatch.beforeCreateTransactionsBatchCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateTransactionsBatch.afterCreateTransactionsBatchCounter, 1)
 
-	mmDeleteTransactionByID.t.Helper()
+	mmCreateTransactionsBatch.t.Helper()
 
-	if mmDeleteTransactionByID.inspectFuncDeleteTransactionByID != nil {
-		mmDeleteTransactionByID.inspectFuncDeleteTransactionByID(ctx, id)
+	if mmCreateTransactionsBatch.inspectFuncCreateTransactionsBatch != nil {
+		mmCreateTransactionsBatch.inspectFuncCreateTransactionsBatch(ctx, in)
 	}
 
-	mm_params := TransactionUsecaseMockDeleteTransactionByIDParams{ctx, id}
+	mm_params := TransactionUsecaseMockCreateTransactionsBatchParams{ctx, in}
 
 	// Record call args
-	mmDeleteTransactionByID.DeleteTransactionByIDMock.mutex.Lock()
-	mmDeleteTransactionByID.DeleteTransactionByIDMock.callArgs = append(mmDeleteTransactionByID.DeleteTransactionByIDMock.callArgs, &mm_params)
-	mmDeleteTransactionByID.DeleteTransactionByIDMock.mutex.Unlock()
+	mmCreateTransactionsBatch.CreateTransactionsBatchMock.mutex.Lock()
+	mmCreateTransactionsBatch.CreateTransactionsBatchMock.callArgs = append(mmCreateTransactionsBatch.CreateTransactionsBatchMock.callArgs, &mm_params)
+	mmCreateTransactionsBatch.CreateTransactionsBatchMock.mutex.Unlock()
 
-	for _, e := range mmDeleteTransactionByID.DeleteTransactionByIDMock.expectations {
+	for _, e := range mmCreateTransactionsBatch.CreateTransactionsBatchMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.resResult, e.results.err
 		}
 	}
 
-	if mmDeleteTransactionByID.DeleteTransactionByIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteTransactionByID.DeleteTransactionByIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteTransactionByID.DeleteTransactionByIDMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteTransactionByID.DeleteTransactionByIDMock.defaultExpectation.paramPtrs
+	if mmCreateTransactionsBatch.CreateTransactionsBatchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateTransactionsBatch.CreateTransactionsBatchMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateTransactionsBatch.CreateTransactionsBatchMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateTransactionsBatch.CreateTransactionsBatchMock.defaultExpectation.paramPtrs
 
-		mm_got := TransactionUsecaseMockDeleteTransactionByIDParams{ctx, id}
+		mm_got := TransactionUsecaseMockCreateTransactionsBatchParams{ctx, in}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteTransactionByID.t.Errorf("TransactionUsecaseMock.DeleteTransactionByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteTransactionByID.DeleteTransactionByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmCreateTransactionsBatch.t.Errorf("TransactionUsecaseMock.CreateTransactionsBatch got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateTransactionsBatch.CreateTransactionsBatchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmDeleteTransactionByID.t.Errorf("TransactionUsecaseMock.DeleteTransactionByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteTransactionByID.DeleteTransactionByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmCreateTransactionsBatch.t.Errorf("TransactionUsecaseMock.CreateTransactionsBatch got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateTransactionsBatch.CreateTransactionsBatchMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteTransactionByID.t.Errorf("TransactionUsecaseMock.DeleteTransactionByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteTransactionByID.DeleteTransactionByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmCreateTransactionsBatch.t.Errorf("TransactionUsecaseMock.CreateTransactionsBatch got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateTransactionsBatch.CreateTransactionsBatchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteTransactionByID.DeleteTransactionByIDMock.defaultExpectation.results
+		mm_results := mmCreateTransactionsBatch.CreateTransactionsBatchMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteTransactionByID.t.Fatal("No results are set for the TransactionUsecaseMock.DeleteTransactionByID")
+			mmCreateTransactionsBatch.t.Fatal("No results are set for the TransactionUsecaseMock.CreateTransactionsBatch")
 		}
-		return (*mm_results).err
+		return (*mm_results).resResult, (*mm_results).err
 	}
-	if mmDeleteTransactionByID.funcDeleteTransactionByID != nil {
-		return mmDeleteTransactionByID.funcDeleteTransactionByID(ctx, id)
+	if mmCreateTransactionsBatch.funcCreateTransactionsBatch != nil {
+		return mmCreateTransactionsBatch.funcCreateTransactionsBatch(ctx, in)
 	}
-	mmDeleteTransactionByID.t.Fatalf("Unexpected call to TransactionUsecaseMock.DeleteTransactionByID. %v %v", ctx, id)
+	mmCreateTransactionsBatch.t.Fatalf("Unexpected call to TransactionUsecaseMock.CreateTransactionsBatch. %v %v", ctx, in)
 	return
 }
 
-// DeleteTransactionByIDAfterCounter returns a count of finished TransactionUsecaseMock.DeleteTransactionByID invocations
-func (mmDeleteTransactionByID *TransactionUsecaseMock) DeleteTransactionByIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteTransactionByID.afterDeleteTransactionByIDCounter)
+// CreateTransactionsBatchAfterCounter returns a count of finished TransactionUsecaseMock.CreateTransactionsBatch invocations
+func (mmCreateTransactionsBatch *TransactionUsecaseMock) CreateTransactionsBatchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateTransactionsBatch.afterCreateTransactionsBatchCounter)
 }
 
-// DeleteTransactionByIDBeforeCounter returns a count of TransactionUsecaseMock.DeleteTransactionByID invocations
-func (mmDeleteTransactionByID *TransactionUsecaseMock) DeleteTransactionByIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteTransactionByID.beforeDeleteTransactionByIDCounter)
+// CreateTransactionsBatchBeforeCounter returns a count of TransactionUsecaseMock.CreateTransactionsBatch invocations
+func (mmCreateTransactionsBatch *TransactionUsecaseMock) CreateTransactionsBatchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateTransactionsBatch.beforeCreateTransactionsBatchCounter)
 }
 
-// Calls returns a list of arguments used in each call to TransactionUsecaseMock.DeleteTransactionByID.
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.CreateTransactionsBatch.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) Calls() []*TransactionUsecaseMockDeleteTransactionByIDParams {
-	mmDeleteTransactionByID.mutex.RLock()
+func (mmCreateTransactionsBatch *mTransactionUsecaseMockCreateTransactionsBatch) Calls() []*TransactionUsecaseMockCreateTransactionsBatchParams {
+	mmCreateTransactionsBatch.mutex.RLock()
 
-	argCopy := make([]*TransactionUsecaseMockDeleteTransactionByIDParams, len(mmDeleteTransactionByID.callArgs))
-	copy(argCopy, mmDeleteTransactionByID.callArgs)
+	argCopy := make([]*TransactionUsecaseMockCreateTransactionsBatchParams, len(mmCreateTransactionsBatch.callArgs))
+	copy(argCopy, mmCreateTransactionsBatch.callArgs)
 
-	mmDeleteTransactionByID.mutex.RUnlock()
+	mmCreateTransactionsBatch.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteTransactionByIDDone returns true if the count of the DeleteTransactionByID invocations corresponds
+// MinimockCreateTransactionsBatchDone returns true if the count of the CreateTransactionsBatch invocations corresponds
 // the number of defined expectations
-func (m *TransactionUsecaseMock) MinimockDeleteTransactionByIDDone() bool {
-	if m.DeleteTransactionByIDMock.optional {
+func (m *TransactionUsecaseMock) MinimockCreateTransactionsBatchDone() bool {
+	if m.CreateTransactionsBatchMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteTransactionByIDMock.expectations {
+	for _, e := range m.CreateTransactionsBatchMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteTransactionByIDMock.invocationsDone()
+	return m.CreateTransactionsBatchMock.invocationsDone()
 }
 
-// MinimockDeleteTransactionByIDInspect logs each unmet expectation
-func (m *TransactionUsecaseMock) MinimockDeleteTransactionByIDInspect() {
-	for _, e := range m.DeleteTransactionByIDMock.expectations {
+// MinimockCreateTransactionsBatchInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockCreateTransactionsBatchInspect() {
+	for _, e := range m.CreateTransactionsBatchMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.DeleteTransactionByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to TransactionUsecaseMock.CreateTransactionsBatch at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteTransactionByIDCounter := mm_atomic.LoadUint64(&m.afterDeleteTransactionByIDCounter)
+	afterCreateTransactionsBatchCounter := mm_atomic.LoadUint64(&m.afterCreateTransactionsBatchCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteTransactionByIDMock.defaultExpectation != nil && afterDeleteTransactionByIDCounter < 1 {
-		if m.DeleteTransactionByIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.DeleteTransactionByID at\n%s", m.DeleteTransactionByIDMock.defaultExpectation.returnOrigin)
+	if m.CreateTransactionsBatchMock.defaultExpectation != nil && afterCreateTransactionsBatchCounter < 1 {
+		if m.CreateTransactionsBatchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.CreateTransactionsBatch at\n%s", m.CreateTransactionsBatchMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.DeleteTransactionByID at\n%s with params: %#v", m.DeleteTransactionByIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteTransactionByIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to TransactionUsecaseMock.CreateTransactionsBatch at\n%s with params: %#v", m.CreateTransactionsBatchMock.defaultExpectation.expectationOrigins.origin, *m.CreateTransactionsBatchMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteTransactionByID != nil && afterDeleteTransactionByIDCounter < 1 {
-		m.t.Errorf("Expected call to TransactionUsecaseMock.DeleteTransactionByID at\n%s", m.funcDeleteTransactionByIDOrigin)
+	if m.funcCreateTransactionsBatch != nil && afterCreateTransactionsBatchCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.CreateTransactionsBatch at\n%s", m.funcCreateTransactionsBatchOrigin)
 	}
 
-	if !m.DeleteTransactionByIDMock.invocationsDone() && afterDeleteTransactionByIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.DeleteTransactionByID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteTransactionByIDMock.expectedInvocations), m.DeleteTransactionByIDMock.expectedInvocationsOrigin, afterDeleteTransactionByIDCounter)
+	if !m.CreateTransactionsBatchMock.invocationsDone() && afterCreateTransactionsBatchCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.CreateTransactionsBatch at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateTransactionsBatchMock.expectedInvocations), m.CreateTransactionsBatchMock.expectedInvocationsOrigin, afterCreateTransactionsBatchCounter)
 	}
 }
 
-type mTransactionUsecaseMockFindList struct {
+type mTransactionUsecaseMockCreateTransferByDTO struct {
 	optional           bool
 	mock               *TransactionUsecaseMock
-	defaultExpectation *TransactionUsecaseMockFindListExpectation
-	expectations       []*TransactionUsecaseMockFindListExpectation
+	defaultExpectation *TransactionUsecaseMockCreateTransferByDTOExpectation
+	expectations       []*TransactionUsecaseMockCreateTransferByDTOExpectation
 
-	callArgs []*TransactionUsecaseMockFindListParams
+	callArgs []*TransactionUsecaseMockCreateTransferByDTOParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// TransactionUsecaseMockFindListExpectation specifies expectation struct of the TransactionUsecase.FindList
-type TransactionUsecaseMockFindListExpectation struct {
+// TransactionUsecaseMockCreateTransferByDTOExpectation specifies expectation struct of the TransactionUsecase.CreateTransferByDTO
+type TransactionUsecaseMockCreateTransferByDTOExpectation struct {
 	mock               *TransactionUsecaseMock
-	params             *TransactionUsecaseMockFindListParams
-	paramPtrs          *TransactionUsecaseMockFindListParamPtrs
-	expectationOrigins TransactionUsecaseMockFindListExpectationOrigins
-	results            *TransactionUsecaseMockFindListResults
+	params             *TransactionUsecaseMockCreateTransferByDTOParams
+	paramPtrs          *TransactionUsecaseMockCreateTransferByDTOParamPtrs
+	expectationOrigins TransactionUsecaseMockCreateTransferByDTOExpectationOrigins
+	results            *TransactionUsecaseMockCreateTransferByDTOResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// TransactionUsecaseMockFindListParams contains parameters of the TransactionUsecase.FindList
-type TransactionUsecaseMockFindListParams struct {
-	ctx         context.Context
-	listOptions *mm_usecase.TransactionListOptions
-	queryParams *uctypes.QueryGetListParams
+// TransactionUsecaseMockCreateTransferByDTOParams contains parameters of the TransactionUsecase.CreateTransferByDTO
+type TransactionUsecaseMockCreateTransferByDTOParams struct {
+	ctx context.Context
+	in  mm_usecase.CreateTransferDataInput
 }
 
-// TransactionUsecaseMockFindListParamPtrs contains pointers to parameters of the TransactionUsecase.FindList
-type TransactionUsecaseMockFindListParamPtrs struct {
-	ctx         *context.Context
-	listOptions **mm_usecase.TransactionListOptions
-	queryParams **uctypes.QueryGetListParams
+// TransactionUsecaseMockCreateTransferByDTOParamPtrs contains pointers to parameters of the TransactionUsecase.CreateTransferByDTO
+type TransactionUsecaseMockCreateTransferByDTOParamPtrs struct {
+	ctx *context.Context
+	in  *mm_usecase.CreateTransferDataInput
 }
 
-// TransactionUsecaseMockFindListResults contains results of the TransactionUsecase.FindList
-type TransactionUsecaseMockFindListResults struct {
-	resItems []*mm_usecase.TransactionDTO
-	err      error
+// TransactionUsecaseMockCreateTransferByDTOResults contains results of the TransactionUsecase.CreateTransferByDTO
+type TransactionUsecaseMockCreateTransferByDTOResults struct {
+	resTransferDTO *mm_usecase.TransferDTO
+	err            error
 }
 
-// TransactionUsecaseMockFindListOrigins contains origins of expectations of the TransactionUsecase.FindList
-type TransactionUsecaseMockFindListExpectationOrigins struct {
-	origin            string
-	originCtx         string
-	originListOptions string
-	originQueryParams string
+// TransactionUsecaseMockCreateTransferByDTOOrigins contains origins of expectations of the TransactionUsecase.CreateTransferByDTO
+type TransactionUsecaseMockCreateTransferByDTOExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1222,373 +1330,347 @@ type TransactionUsecaseMockFindListExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmFindList *mTransactionUsecaseMockFindList) Optional() *mTransactionUsecaseMockFindList {
-	mmFindList.optional = true
-	return mmFindList
+func (mmCreateTransferByDTO *mTransactionUsecaseMockCreateTransferByDTO) Optional() *mTransactionUsecaseMockCreateTransferByDTO {
+	mmCreateTransferByDTO.optional = true
+	return mmCreateTransferByDTO
 }
 
-// Expect sets up expected params for TransactionUsecase.FindList
-func (mmFindList *mTransactionUsecaseMockFindList) Expect(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) *mTransactionUsecaseMockFindList {
-	if mmFindList.mock.funcFindList != nil {
-		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by Set")
+// Expect sets up expected params for TransactionUsecase.CreateTransferByDTO
+func (mmCreateTransferByDTO *mTransactionUsecaseMockCreateTransferByDTO) Expect(ctx context.Context, in mm_usecase.CreateTransferDataInput) *mTransactionUsecaseMockCreateTransferByDTO {
+	if mmCreateTransferByDTO.mock.funcCreateTransferByDTO != nil {
+		mmCreateTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.CreateTransferByDTO mock is already set by Set")
 	}
 
-	if mmFindList.defaultExpectation == nil {
-		mmFindList.defaultExpectation = &TransactionUsecaseMockFindListExpectation{}
+	if mmCreateTransferByDTO.defaultExpectation == nil {
+		mmCreateTransferByDTO.defaultExpectation = &TransactionUsecaseMockCreateTransferByDTOExpectation{}
 	}
 
-	if mmFindList.defaultExpectation.paramPtrs != nil {
-		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by ExpectParams functions")
+	if mmCreateTransferByDTO.defaultExpectation.paramPtrs != nil {
+		mmCreateTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.CreateTransferByDTO mock is already set by ExpectParams functions")
 	}
 
-	mmFindList.defaultExpectation.params = &TransactionUsecaseMockFindListParams{ctx, listOptions, queryParams}
-	mmFindList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmFindList.expectations {
-		if minimock.Equal(e.params, mmFindList.defaultExpectation.params) {
-			mmFindList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindList.defaultExpectation.params)
+	mmCreateTransferByDTO.defaultExpectation.params = &TransactionUsecaseMockCreateTransferByDTOParams{ctx, in}
+	mmCreateTransferByDTO.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateTransferByDTO.expectations {
+		if minimock.Equal(e.params, mmCreateTransferByDTO.defaultExpectation.params) {
+			mmCreateTransferByDTO.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateTransferByDTO.defaultExpectation.params)
 		}
 	}
 
-	return mmFindList
+	return mmCreateTransferByDTO
 }
 
-// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.FindList
-func (mmFindList *mTransactionUsecaseMockFindList) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockFindList {
-	if mmFindList.mock.funcFindList != nil {
-		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.CreateTransferByDTO
+func (mmCreateTransferByDTO *mTransactionUsecaseMockCreateTransferByDTO) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockCreateTransferByDTO {
+	if mmCreateTransferByDTO.mock.funcCreateTransferByDTO != nil {
+		mmCreateTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.CreateTransferByDTO mock is already set by Set")
 	}
 
-	if mmFindList.defaultExpectation == nil {
-		mmFindList.defaultExpectation = &TransactionUsecaseMockFindListExpectation{}
+	if mmCreateTransferByDTO.defaultExpectation == nil {
+		mmCreateTransferByDTO.defaultExpectation = &TransactionUsecaseMockCreateTransferByDTOExpectation{}
 	}
 
-	if mmFindList.defaultExpectation.params != nil {
-		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by Expect")
+	if mmCreateTransferByDTO.defaultExpectation.params != nil {
+		mmCreateTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.CreateTransferByDTO mock is already set by Expect")
 	}
 
-	if mmFindList.defaultExpectation.paramPtrs == nil {
-		mmFindList.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindListParamPtrs{}
+	if mmCreateTransferByDTO.defaultExpectation.paramPtrs == nil {
+		mmCreateTransferByDTO.defaultExpectation.paramPtrs = &TransactionUsecaseMockCreateTransferByDTOParamPtrs{}
 	}
-	mmFindList.defaultExpectation.paramPtrs.ctx = &ctx
-	mmFindList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCreateTransferByDTO.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateTransferByDTO.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmFindList
+	return mmCreateTransferByDTO
 }
 
-// ExpectListOptionsParam2 sets up expected param listOptions for TransactionUsecase.FindList
-func (mmFindList *mTransactionUsecaseMockFindList) ExpectListOptionsParam2(listOptions *mm_usecase.TransactionListOptions) *mTransactionUsecaseMockFindList {
-	if mmFindList.mock.funcFindList != nil {
-		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by Set")
+// ExpectInParam2 sets up expected param in for TransactionUsecase.CreateTransferByDTO
+func (mmCreateTransferByDTO *mTransactionUsecaseMockCreateTransferByDTO) ExpectInParam2(in mm_usecase.CreateTransferDataInput) *mTransactionUsecaseMockCreateTransferByDTO {
+	if mmCreateTransferByDTO.mock.funcCreateTransferByDTO != nil {
+		mmCreateTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.CreateTransferByDTO mock is already set by Set")
 	}
 
-	if mmFindList.defaultExpectation == nil {
-		mmFindList.defaultExpectation = &TransactionUsecaseMockFindListExpectation{}
+	if mmCreateTransferByDTO.defaultExpectation == nil {
+		mmCreateTransferByDTO.defaultExpectation = &TransactionUsecaseMockCreateTransferByDTOExpectation{}
 	}
 
-	if mmFindList.defaultExpectation.params != nil {
-		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by Expect")
+	if mmCreateTransferByDTO.defaultExpectation.params != nil {
+		mmCreateTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.CreateTransferByDTO mock is already set by Expect")
 	}
 
-	if mmFindList.defaultExpectation.paramPtrs == nil {
-		mmFindList.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindListParamPtrs{}
+	if mmCreateTransferByDTO.defaultExpectation.paramPtrs == nil {
+		mmCreateTransferByDTO.defaultExpectation.paramPtrs = &TransactionUsecaseMockCreateTransferByDTOParamPtrs{}
 	}
-	mmFindList.defaultExpectation.paramPtrs.listOptions = &listOptions
-	mmFindList.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+	mmCreateTransferByDTO.defaultExpectation.paramPtrs.in = &in
+	mmCreateTransferByDTO.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
 
-	return mmFindList
+	return mmCreateTransferByDTO
 }
 
-// ExpectQueryParamsParam3 sets up expected param queryParams for TransactionUsecase.FindList
-func (mmFindList *mTransactionUsecaseMockFindList) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mTransactionUsecaseMockFindList {
-	if mmFindList.mock.funcFindList != nil {
-		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by Set")
-	}
-
-	if mmFindList.defaultExpectation == nil {
-		mmFindList.defaultExpectation = &TransactionUsecaseMockFindListExpectation{}
-	}
-
-	if mmFindList.defaultExpectation.params != nil {
-		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by Expect")
-	}
-
-	if mmFindList.defaultExpectation.paramPtrs == nil {
-		mmFindList.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindListParamPtrs{}
-	}
-	mmFindList.defaultExpectation.paramPtrs.queryParams = &queryParams
-	mmFindList.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
-
-	return mmFindList
-}
-
-// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.FindList
-func (mmFindList *mTransactionUsecaseMockFindList) Inspect(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams)) *mTransactionUsecaseMockFindList {
-	if mmFindList.mock.inspectFuncFindList != nil {
-		mmFindList.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.FindList")
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.CreateTransferByDTO
+func (mmCreateTransferByDTO *mTransactionUsecaseMockCreateTransferByDTO) Inspect(f func(ctx context.Context, in mm_usecase.CreateTransferDataInput)) *mTransactionUsecaseMockCreateTransferByDTO {
+	if mmCreateTransferByDTO.mock.inspectFuncCreateTransferByDTO != nil {
+		mmCreateTransferByDTO.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.CreateTransferByDTO")
 	}
 
-	mmFindList.mock.inspectFuncFindList = f
+	mmCreateTransferByDTO.mock.inspectFuncCreateTransferByDTO = f
 
-	return mmFindList
+	return mmCreateTransferByDTO
 }
 
-// Return sets up results that will be returned by TransactionUsecase.FindList
-func (mmFindList *mTransactionUsecaseMockFindList) Return(resItems []*mm_usecase.TransactionDTO, err error) *TransactionUsecaseMock {
-	if mmFindList.mock.funcFindList != nil {
-		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by Set")
+// Return sets up results that will be returned by TransactionUsecase.CreateTransferByDTO
+func (mmCreateTransferByDTO *mTransactionUsecaseMockCreateTransferByDTO) Return(resTransferDTO *mm_usecase.TransferDTO, err error) *TransactionUsecaseMock {
+	if mmCreateTransferByDTO.mock.funcCreateTransferByDTO != nil {
+		mmCreateTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.CreateTransferByDTO mock is already set by Set")
 	}
 
-	if mmFindList.defaultExpectation == nil {
-		mmFindList.defaultExpectation = &TransactionUsecaseMockFindListExpectation{mock: mmFindList.mock}
+	if mmCreateTransferByDTO.defaultExpectation == nil {
+		mmCreateTransferByDTO.defaultExpectation = &TransactionUsecaseMockCreateTransferByDTOExpectation{mock: mmCreateTransferByDTO.mock}
 	}
-	mmFindList.defaultExpectation.results = &TransactionUsecaseMockFindListResults{resItems, err}
-	mmFindList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmFindList.mock
+	mmCreateTransferByDTO.defaultExpectation.results = &TransactionUsecaseMockCreateTransferByDTOResults{resTransferDTO, err}
+	mmCreateTransferByDTO.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateTransferByDTO.mock
 }
 
-// Set uses given function f to mock the TransactionUsecase.FindList method
-func (mmFindList *mTransactionUsecaseMockFindList) Set(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.TransactionDTO, err error)) *TransactionUsecaseMock {
-	if mmFindList.defaultExpectation != nil {
-		mmFindList.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.FindList method")
+// Set uses given function f to mock the TransactionUsecase.CreateTransferByDTO method
+func (mmCreateTransferByDTO *mTransactionUsecaseMockCreateTransferByDTO) Set(f func(ctx context.Context, in mm_usecase.CreateTransferDataInput) (resTransferDTO *mm_usecase.TransferDTO, err error)) *TransactionUsecaseMock {
+	if mmCreateTransferByDTO.defaultExpectation != nil {
+		mmCreateTransferByDTO.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.CreateTransferByDTO method")
 	}
 
-	if len(mmFindList.expectations) > 0 {
-		mmFindList.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.FindList method")
+	if len(mmCreateTransferByDTO.expectations) > 0 {
+		mmCreateTransferByDTO.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.CreateTransferByDTO method")
 	}
 
-	mmFindList.mock.funcFindList = f
-	mmFindList.mock.funcFindListOrigin = minimock.CallerInfo(1)
-	return mmFindList.mock
+	mmCreateTransferByDTO.mock.funcCreateTransferByDTO = f
+	mmCreateTransferByDTO.mock.funcCreateTransferByDTOOrigin = minimock.CallerInfo(1)
+	return mmCreateTransferByDTO.mock
 }
 
-// When sets expectation for the TransactionUsecase.FindList which will trigger the result defined by the following
+// When sets expectation for the TransactionUsecase.CreateTransferByDTO which will trigger the result defined by the following
 // Then helper
-func (mmFindList *mTransactionUsecaseMockFindList) When(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) *TransactionUsecaseMockFindListExpectation {
-	if mmFindList.mock.funcFindList != nil {
-		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by Set")
+func (mmCreateTransferByDTO *mTransactionUsecaseMockCreateTransferByDTO) When(ctx context.Context, in mm_usecase.CreateTransferDataInput) *TransactionUsecaseMockCreateTransferByDTOExpectation {
+	if mmCreateTransferByDTO.mock.funcCreateTransferByDTO != nil {
+		mmCreateTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.CreateTransferByDTO mock is already set by Set")
 	}
 
-	expectation := &TransactionUsecaseMockFindListExpectation{
-		mock:               mmFindList.mock,
-		params:             &TransactionUsecaseMockFindListParams{ctx, listOptions, queryParams},
-		expectationOrigins: TransactionUsecaseMockFindListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &TransactionUsecaseMockCreateTransferByDTOExpectation{
+		mock:               mmCreateTransferByDTO.mock,
+		params:             &TransactionUsecaseMockCreateTransferByDTOParams{ctx, in},
+		expectationOrigins: TransactionUsecaseMockCreateTransferByDTOExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmFindList.expectations = append(mmFindList.expectations, expectation)
+	mmCreateTransferByDTO.expectations = append(mmCreateTransferByDTO.expectations, expectation)
 	return expectation
 }
 
-// Then sets up TransactionUsecase.FindList return parameters for the expectation previously defined by the When method
-func (e *TransactionUsecaseMockFindListExpectation) Then(resItems []*mm_usecase.TransactionDTO, err error) *TransactionUsecaseMock {
-	e.results = &TransactionUsecaseMockFindListResults{resItems, err}
+// Then sets up TransactionUsecase.CreateTransferByDTO return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockCreateTransferByDTOExpectation) Then(resTransferDTO *mm_usecase.TransferDTO, err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockCreateTransferByDTOResults{resTransferDTO, err}
 	return e.mock
 }
 
-// Times sets number of times TransactionUsecase.FindList should be invoked
-func (mmFindList *mTransactionUsecaseMockFindList) Times(n uint64) *mTransactionUsecaseMockFindList {
+// Times sets number of times TransactionUsecase.CreateTransferByDTO should be invoked
+func (mmCreateTransferByDTO *mTransactionUsecaseMockCreateTransferByDTO) Times(n uint64) *mTransactionUsecaseMockCreateTransferByDTO {
 	if n == 0 {
-		mmFindList.mock.t.Fatalf("Times of TransactionUsecaseMock.FindList mock can not be zero")
+		mmCreateTransferByDTO.mock.t.Fatalf("Times of TransactionUsecaseMock.CreateTransferByDTO mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmFindList.expectedInvocations, n)
-	mmFindList.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmFindList
+	mm_atomic.StoreUint64(&mmCreateTransferByDTO.expectedInvocations, n)
+	mmCreateTransferByDTO.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateTransferByDTO
 }
 
-func (mmFindList *mTransactionUsecaseMockFindList) invocationsDone() bool {
-	if len(mmFindList.expectations) == 0 && mmFindList.defaultExpectation == nil && mmFindList.mock.funcFindList == nil {
+func (mmCreateTransferByDTO *mTransactionUsecaseMockCreateTransferByDTO) invocationsDone() bool {
+	if len(mmCreateTransferByDTO.expectations) == 0 && mmCreateTransferByDTO.defaultExpectation == nil && mmCreateTransferByDTO.mock.funcCreateTransferByDTO == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmFindList.mock.afterFindListCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmFindList.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateTransferByDTO.mock.afterCreateTransferByDTOCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateTransferByDTO.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// FindList implements mm_usecase.TransactionUsecase
-func (mmFindList *TransactionUsecaseMock) FindList(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.TransactionDTO, err error) {
-	mm_atomic.AddUint64(&mmFindList.beforeFindListCounter, 1)
-	defer mm_atomic.AddUint64(&mmFindList.afterFindListCounter, 1)
+// CreateTransferByDTO implements mm_usecase.TransactionUsecase
+func (mmCreateTransferByDTO *TransactionUsecaseMock) CreateTransferByDTO(ctx context.Context, in mm_usecase.CreateTransferDataInput) (resTransferDTO *mm_usecase.TransferDTO, err error) {
+	mm_atomic.AddUint64(&mmCreateTransferByDTO.beforeCreateTransferByDTOCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateTransferByDTO.afterCreateTransferByDTOCounter, 1)
 
-	mmFindList.t.Helper()
+	mmCreateTransferByDTO.t.Helper()
 
-	if mmFindList.inspectFuncFindList != nil {
-		mmFindList.inspectFuncFindList(ctx, listOptions, queryParams)
+	if mmCreateTransferByDTO.inspectFuncCreateTransferByDTO != nil {
+		mmCreateTransferByDTO.inspectFuncCreateTransferByDTO(ctx, in)
 	}
 
-	mm_params := TransactionUsecaseMockFindListParams{ctx, listOptions, queryParams}
+	mm_params := TransactionUsecaseMockCreateTransferByDTOParams{ctx, in}
 
 	// Record call args
-	mmFindList.FindListMock.mutex.Lock()
-	mmFindList.FindListMock.callArgs = append(mmFindList.FindListMock.callArgs, &mm_params)
-	mmFindList.FindListMock.mutex.Unlock()
+	mmCreateTransferByDTO.CreateTransferByDTOMock.mutex.Lock()
+	mmCreateTransferByDTO.CreateTransferByDTOMock.callArgs = append(mmCreateTransferByDTO.CreateTransferByDTOMock.callArgs, &mm_params)
+	mmCreateTransferByDTO.CreateTransferByDTOMock.mutex.Unlock()
 
-	for _, e := range mmFindList.FindListMock.expectations {
+	for _, e := range mmCreateTransferByDTO.CreateTransferByDTOMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.resItems, e.results.err
+			return e.results.resTransferDTO, e.results.err
 		}
 	}
 
-	if mmFindList.FindListMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmFindList.FindListMock.defaultExpectation.Counter, 1)
-		mm_want := mmFindList.FindListMock.defaultExpectation.params
-		mm_want_ptrs := mmFindList.FindListMock.defaultExpectation.paramPtrs
+	if mmCreateTransferByDTO.CreateTransferByDTOMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateTransferByDTO.CreateTransferByDTOMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateTransferByDTO.CreateTransferByDTOMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateTransferByDTO.CreateTransferByDTOMock.defaultExpectation.paramPtrs
 
-		mm_got := TransactionUsecaseMockFindListParams{ctx, listOptions, queryParams}
+		mm_got := TransactionUsecaseMockCreateTransferByDTOParams{ctx, in}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmFindList.t.Errorf("TransactionUsecaseMock.FindList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmCreateTransferByDTO.t.Errorf("TransactionUsecaseMock.CreateTransferByDTO got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateTransferByDTO.CreateTransferByDTOMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
-				mmFindList.t.Errorf("TransactionUsecaseMock.FindList got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
-			}
-
-			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
-				mmFindList.t.Errorf("TransactionUsecaseMock.FindList got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmCreateTransferByDTO.t.Errorf("TransactionUsecaseMock.CreateTransferByDTO got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateTransferByDTO.CreateTransferByDTOMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmFindList.t.Errorf("TransactionUsecaseMock.FindList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmFindList.FindListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmCreateTransferByDTO.t.Errorf("TransactionUsecaseMock.CreateTransferByDTO got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateTransferByDTO.CreateTransferByDTOMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmFindList.FindListMock.defaultExpectation.results
+		mm_results := mmCreateTransferByDTO.CreateTransferByDTOMock.defaultExpectation.results
 		if mm_results == nil {
-			mmFindList.t.Fatal("No results are set for the TransactionUsecaseMock.FindList")
+			mmCreateTransferByDTO.t.Fatal("No results are set for the TransactionUsecaseMock.CreateTransferByDTO")
 		}
-		return (*mm_results).resItems, (*mm_results).err
+		return (*mm_results).resTransferDTO, (*mm_results).err
 	}
-	if mmFindList.funcFindList != nil {
-		return mmFindList.funcFindList(ctx, listOptions, queryParams)
+	if mmCreateTransferByDTO.funcCreateTransferByDTO != nil {
+		return mmCreateTransferByDTO.funcCreateTransferByDTO(ctx, in)
 	}
-	mmFindList.t.Fatalf("Unexpected call to TransactionUsecaseMock.FindList. %v %v %v", ctx, listOptions, queryParams)
+	mmCreateTransferByDTO.t.Fatalf("Unexpected call to TransactionUsecaseMock.CreateTransferByDTO. %v %v", ctx, in)
 	return
 }
 
-// FindListAfterCounter returns a count of finished TransactionUsecaseMock.FindList invocations
-func (mmFindList *TransactionUsecaseMock) FindListAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFindList.afterFindListCounter)
+// CreateTransferByDTOAfterCounter returns a count of finished TransactionUsecaseMock.CreateTransferByDTO invocations
+func (mmCreateTransferByDTO *TransactionUsecaseMock) CreateTransferByDTOAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateTransferByDTO.afterCreateTransferByDTOCounter)
 }
 
-// FindListBeforeCounter returns a count of TransactionUsecaseMock.FindList invocations
-func (mmFindList *TransactionUsecaseMock) FindListBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFindList.beforeFindListCounter)
+// CreateTransferByDTOBeforeCounter returns a count of TransactionUsecaseMock.CreateTransferByDTO invocations
+func (mmCreateTransferByDTO *TransactionUsecaseMock) CreateTransferByDTOBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateTransferByDTO.beforeCreateTransferByDTOCounter)
 }
 
-// Calls returns a list of arguments used in each call to TransactionUsecaseMock.FindList.
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.CreateTransferByDTO.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmFindList *mTransactionUsecaseMockFindList) Calls() []*TransactionUsecaseMockFindListParams {
-	mmFindList.mutex.RLock()
+func (mmCreateTransferByDTO *mTransactionUsecaseMockCreateTransferByDTO) Calls() []*TransactionUsecaseMockCreateTransferByDTOParams {
+	mmCreateTransferByDTO.mutex.RLock()
 
-	argCopy := make([]*TransactionUsecaseMockFindListParams, len(mmFindList.callArgs))
-	copy(argCopy, mmFindList.callArgs)
+	argCopy := make([]*TransactionUsecaseMockCreateTransferByDTOParams, len(mmCreateTransferByDTO.callArgs))
+	copy(argCopy, mmCreateTransferByDTO.callArgs)
 
-	mmFindList.mutex.RUnlock()
+	mmCreateTransferByDTO.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockFindListDone returns true if the count of the FindList invocations corresponds
+// MinimockCreateTransferByDTODone returns true if the count of the CreateTransferByDTO invocations corresponds
 // the number of defined expectations
-func (m *TransactionUsecaseMock) MinimockFindListDone() bool {
-	if m.FindListMock.optional {
+func (m *TransactionUsecaseMock) MinimockCreateTransferByDTODone() bool {
+	if m.CreateTransferByDTOMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.FindListMock.expectations {
+	for _, e := range m.CreateTransferByDTOMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.FindListMock.invocationsDone()
+	return m.CreateTransferByDTOMock.invocationsDone()
 }
 
-// MinimockFindListInspect logs each unmet expectation
-func (m *TransactionUsecaseMock) MinimockFindListInspect() {
-	for _, e := range m.FindListMock.expectations {
+// MinimockCreateTransferByDTOInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockCreateTransferByDTOInspect() {
+	for _, e := range m.CreateTransferByDTOMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.FindList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to TransactionUsecaseMock.CreateTransferByDTO at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterFindListCounter := mm_atomic.LoadUint64(&m.afterFindListCounter)
+	afterCreateTransferByDTOCounter := mm_atomic.LoadUint64(&m.afterCreateTransferByDTOCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.FindListMock.defaultExpectation != nil && afterFindListCounter < 1 {
-		if m.FindListMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.FindList at\n%s", m.FindListMock.defaultExpectation.returnOrigin)
+	if m.CreateTransferByDTOMock.defaultExpectation != nil && afterCreateTransferByDTOCounter < 1 {
+		if m.CreateTransferByDTOMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.CreateTransferByDTO at\n%s", m.CreateTransferByDTOMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.FindList at\n%s with params: %#v", m.FindListMock.defaultExpectation.expectationOrigins.origin, *m.FindListMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to TransactionUsecaseMock.CreateTransferByDTO at\n%s with params: %#v", m.CreateTransferByDTOMock.defaultExpectation.expectationOrigins.origin, *m.CreateTransferByDTOMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcFindList != nil && afterFindListCounter < 1 {
-		m.t.Errorf("Expected call to TransactionUsecaseMock.FindList at\n%s", m.funcFindListOrigin)
+	if m.funcCreateTransferByDTO != nil && afterCreateTransferByDTOCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.CreateTransferByDTO at\n%s", m.funcCreateTransferByDTOOrigin)
 	}
 
-	if !m.FindListMock.invocationsDone() && afterFindListCounter > 0 {
-		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.FindList at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.FindListMock.expectedInvocations), m.FindListMock.expectedInvocationsOrigin, afterFindListCounter)
+	if !m.CreateTransferByDTOMock.invocationsDone() && afterCreateTransferByDTOCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.CreateTransferByDTO at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateTransferByDTOMock.expectedInvocations), m.CreateTransferByDTOMock.expectedInvocationsOrigin, afterCreateTransferByDTOCounter)
 	}
 }
 
-type mTransactionUsecaseMockFindListInMap struct {
+type mTransactionUsecaseMockDeleteTransactionByID struct {
 	optional           bool
 	mock               *TransactionUsecaseMock
-	defaultExpectation *TransactionUsecaseMockFindListInMapExpectation
-	expectations       []*TransactionUsecaseMockFindListInMapExpectation
+	defaultExpectation *TransactionUsecaseMockDeleteTransactionByIDExpectation
+	expectations       []*TransactionUsecaseMockDeleteTransactionByIDExpectation
 
-	callArgs []*TransactionUsecaseMockFindListInMapParams
+	callArgs []*TransactionUsecaseMockDeleteTransactionByIDParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// TransactionUsecaseMockFindListInMapExpectation specifies expectation struct of the TransactionUsecase.FindListInMap
-type TransactionUsecaseMockFindListInMapExpectation struct {
+// TransactionUsecaseMockDeleteTransactionByIDExpectation specifies expectation struct of the TransactionUsecase.DeleteTransactionByID
+type TransactionUsecaseMockDeleteTransactionByIDExpectation struct {
 	mock               *TransactionUsecaseMock
-	params             *TransactionUsecaseMockFindListInMapParams
-	paramPtrs          *TransactionUsecaseMockFindListInMapParamPtrs
-	expectationOrigins TransactionUsecaseMockFindListInMapExpectationOrigins
-	results            *TransactionUsecaseMockFindListInMapResults
+	params             *TransactionUsecaseMockDeleteTransactionByIDParams
+	paramPtrs          *TransactionUsecaseMockDeleteTransactionByIDParamPtrs
+	expectationOrigins TransactionUsecaseMockDeleteTransactionByIDExpectationOrigins
+	results            *TransactionUsecaseMockDeleteTransactionByIDResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// TransactionUsecaseMockFindListInMapParams contains parameters of the TransactionUsecase.FindListInMap
-type TransactionUsecaseMockFindListInMapParams struct {
-	ctx         context.Context
-	listOptions *mm_usecase.TransactionListOptions
-	queryParams *uctypes.QueryGetListParams
+// TransactionUsecaseMockDeleteTransactionByIDParams contains parameters of the TransactionUsecase.DeleteTransactionByID
+type TransactionUsecaseMockDeleteTransactionByIDParams struct {
+	ctx              context.Context
+	id               uuid.UUID
+	version          int64
+	skipVersionCheck bool
 }
 
-// TransactionUsecaseMockFindListInMapParamPtrs contains pointers to parameters of the TransactionUsecase.FindListInMap
-type TransactionUsecaseMockFindListInMapParamPtrs struct {
-	ctx         *context.Context
-	listOptions **mm_usecase.TransactionListOptions
-	queryParams **uctypes.QueryGetListParams
+// TransactionUsecaseMockDeleteTransactionByIDParamPtrs contains pointers to parameters of the TransactionUsecase.DeleteTransactionByID
+type TransactionUsecaseMockDeleteTransactionByIDParamPtrs struct {
+	ctx              *context.Context
+	id               *uuid.UUID
+	version          *int64
+	skipVersionCheck *bool
 }
 
-// TransactionUsecaseMockFindListInMapResults contains results of the TransactionUsecase.FindListInMap
-type TransactionUsecaseMockFindListInMapResults struct {
-	resItems map[uuid.UUID]*mm_usecase.TransactionDTO
-	err      error
+// TransactionUsecaseMockDeleteTransactionByIDResults contains results of the TransactionUsecase.DeleteTransactionByID
+type TransactionUsecaseMockDeleteTransactionByIDResults struct {
+	err error
 }
 
-// TransactionUsecaseMockFindListInMapOrigins contains origins of expectations of the TransactionUsecase.FindListInMap
-type TransactionUsecaseMockFindListInMapExpectationOrigins struct {
-	origin            string
-	originCtx         string
-	originListOptions string
-	originQueryParams string
+// TransactionUsecaseMockDeleteTransactionByIDOrigins contains origins of expectations of the TransactionUsecase.DeleteTransactionByID
+type TransactionUsecaseMockDeleteTransactionByIDExpectationOrigins struct {
+	origin                 string
+	originCtx              string
+	originId               string
+	originVersion          string
+	originSkipVersionCheck string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1596,373 +1678,397 @@ type TransactionUsecaseMockFindListInMapExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) Optional() *mTransactionUsecaseMockFindListInMap {
-	mmFindListInMap.optional = true
-	return mmFindListInMap
+func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) Optional() *mTransactionUsecaseMockDeleteTransactionByID {
+	mmDeleteTransactionByID.optional = true
+	return mmDeleteTransactionByID
 }
 
-// Expect sets up expected params for TransactionUsecase.FindListInMap
-func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) Expect(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) *mTransactionUsecaseMockFindListInMap {
-	if mmFindListInMap.mock.funcFindListInMap != nil {
-		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by Set")
+// Expect sets up expected params for TransactionUsecase.DeleteTransactionByID
+func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) Expect(ctx context.Context, id uuid.UUID, version int64, skipVersionCheck bool) *mTransactionUsecaseMockDeleteTransactionByID {
+	if mmDeleteTransactionByID.mock.funcDeleteTransactionByID != nil {
+		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by Set")
 	}
 
-	if mmFindListInMap.defaultExpectation == nil {
-		mmFindListInMap.defaultExpectation = &TransactionUsecaseMockFindListInMapExpectation{}
+	if mmDeleteTransactionByID.defaultExpectation == nil {
+		mmDeleteTransactionByID.defaultExpectation = &TransactionUsecaseMockDeleteTransactionByIDExpectation{}
 	}
 
-	if mmFindListInMap.defaultExpectation.paramPtrs != nil {
-		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by ExpectParams functions")
+	if mmDeleteTransactionByID.defaultExpectation.paramPtrs != nil {
+		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by ExpectParams functions")
 	}
 
-	mmFindListInMap.defaultExpectation.params = &TransactionUsecaseMockFindListInMapParams{ctx, listOptions, queryParams}
-	mmFindListInMap.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmFindListInMap.expectations {
-		if minimock.Equal(e.params, mmFindListInMap.defaultExpectation.params) {
-			mmFindListInMap.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindListInMap.defaultExpectation.params)
+	mmDeleteTransactionByID.defaultExpectation.params = &TransactionUsecaseMockDeleteTransactionByIDParams{ctx, id, version, skipVersionCheck}
+	mmDeleteTransactionByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteTransactionByID.expectations {
+		if minimock.Equal(e.params, mmDeleteTransactionByID.defaultExpectation.params) {
+			mmDeleteTransactionByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteTransactionByID.defaultExpectation.params)
 		}
 	}
 
-	return mmFindListInMap
+	return mmDeleteTransactionByID
 }
 
-// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.FindListInMap
-func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockFindListInMap {
-	if mmFindListInMap.mock.funcFindListInMap != nil {
-		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.DeleteTransactionByID
+func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockDeleteTransactionByID {
+	if mmDeleteTransactionByID.mock.funcDeleteTransactionByID != nil {
+		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by Set")
 	}
 
-	if mmFindListInMap.defaultExpectation == nil {
-		mmFindListInMap.defaultExpectation = &TransactionUsecaseMockFindListInMapExpectation{}
+	if mmDeleteTransactionByID.defaultExpectation == nil {
+		mmDeleteTransactionByID.defaultExpectation = &TransactionUsecaseMockDeleteTransactionByIDExpectation{}
 	}
 
-	if mmFindListInMap.defaultExpectation.params != nil {
-		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by Expect")
+	if mmDeleteTransactionByID.defaultExpectation.params != nil {
+		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by Expect")
 	}
 
-	if mmFindListInMap.defaultExpectation.paramPtrs == nil {
-		mmFindListInMap.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindListInMapParamPtrs{}
+	if mmDeleteTransactionByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteTransactionByID.defaultExpectation.paramPtrs = &TransactionUsecaseMockDeleteTransactionByIDParamPtrs{}
 	}
-	mmFindListInMap.defaultExpectation.paramPtrs.ctx = &ctx
-	mmFindListInMap.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmDeleteTransactionByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteTransactionByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmFindListInMap
+	return mmDeleteTransactionByID
 }
 
-// ExpectListOptionsParam2 sets up expected param listOptions for TransactionUsecase.FindListInMap
-func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) ExpectListOptionsParam2(listOptions *mm_usecase.TransactionListOptions) *mTransactionUsecaseMockFindListInMap {
-	if mmFindListInMap.mock.funcFindListInMap != nil {
-		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for TransactionUsecase.DeleteTransactionByID
+func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) ExpectIdParam2(id uuid.UUID) *mTransactionUsecaseMockDeleteTransactionByID {
+	if mmDeleteTransactionByID.mock.funcDeleteTransactionByID != nil {
+		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by Set")
 	}
 
-	if mmFindListInMap.defaultExpectation == nil {
-		mmFindListInMap.defaultExpectation = &TransactionUsecaseMockFindListInMapExpectation{}
+	if mmDeleteTransactionByID.defaultExpectation == nil {
+		mmDeleteTransactionByID.defaultExpectation = &TransactionUsecaseMockDeleteTransactionByIDExpectation{}
 	}
 
-	if mmFindListInMap.defaultExpectation.params != nil {
-		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by Expect")
+	if mmDeleteTransactionByID.defaultExpectation.params != nil {
+		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by Expect")
 	}
 
-	if mmFindListInMap.defaultExpectation.paramPtrs == nil {
-		mmFindListInMap.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindListInMapParamPtrs{}
+	if mmDeleteTransactionByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteTransactionByID.defaultExpectation.paramPtrs = &TransactionUsecaseMockDeleteTransactionByIDParamPtrs{}
 	}
-	mmFindListInMap.defaultExpectation.paramPtrs.listOptions = &listOptions
-	mmFindListInMap.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+	mmDeleteTransactionByID.defaultExpectation.paramPtrs.id = &id
+	mmDeleteTransactionByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmFindListInMap
+	return mmDeleteTransactionByID
 }
 
-// ExpectQueryParamsParam3 sets up expected param queryParams for TransactionUsecase.FindListInMap
-func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mTransactionUsecaseMockFindListInMap {
-	if mmFindListInMap.mock.funcFindListInMap != nil {
-		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by Set")
+// ExpectVersionParam3 sets up expected param version for TransactionUsecase.DeleteTransactionByID
+func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) ExpectVersionParam3(version int64) *mTransactionUsecaseMockDeleteTransactionByID {
+	if mmDeleteTransactionByID.mock.funcDeleteTransactionByID != nil {
+		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by Set")
 	}
 
-	if mmFindListInMap.defaultExpectation == nil {
-		mmFindListInMap.defaultExpectation = &TransactionUsecaseMockFindListInMapExpectation{}
+	if mmDeleteTransactionByID.defaultExpectation == nil {
+		mmDeleteTransactionByID.defaultExpectation = &TransactionUsecaseMockDeleteTransactionByIDExpectation{}
 	}
 
-	if mmFindListInMap.defaultExpectation.params != nil {
-		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by Expect")
+	if mmDeleteTransactionByID.defaultExpectation.params != nil {
+		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by Expect")
 	}
 
-	if mmFindListInMap.defaultExpectation.paramPtrs == nil {
-		mmFindListInMap.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindListInMapParamPtrs{}
+	if mmDeleteTransactionByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteTransactionByID.defaultExpectation.paramPtrs = &TransactionUsecaseMockDeleteTransactionByIDParamPtrs{}
 	}
-	mmFindListInMap.defaultExpectation.paramPtrs.queryParams = &queryParams
-	mmFindListInMap.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+	mmDeleteTransactionByID.defaultExpectation.paramPtrs.version = &version
+	mmDeleteTransactionByID.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
 
-	return mmFindListInMap
+	return mmDeleteTransactionByID
 }
 
-// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.FindListInMap
-func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) Inspect(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams)) *mTransactionUsecaseMockFindListInMap {
-	if mmFindListInMap.mock.inspectFuncFindListInMap != nil {
-		mmFindListInMap.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.FindListInMap")
+// ExpectSkipVersionCheckParam4 sets up expected param skipVersionCheck for TransactionUsecase.DeleteTransactionByID
+func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) ExpectSkipVersionCheckParam4(skipVersionCheck bool) *mTransactionUsecaseMockDeleteTransactionByID {
+	if mmDeleteTransactionByID.mock.funcDeleteTransactionByID != nil {
+		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by Set")
 	}
 
-	mmFindListInMap.mock.inspectFuncFindListInMap = f
+	if mmDeleteTransactionByID.defaultExpectation == nil {
+		mmDeleteTransactionByID.defaultExpectation = &TransactionUsecaseMockDeleteTransactionByIDExpectation{}
+	}
 
-	return mmFindListInMap
+	if mmDeleteTransactionByID.defaultExpectation.params != nil {
+		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by Expect")
+	}
+
+	if mmDeleteTransactionByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteTransactionByID.defaultExpectation.paramPtrs = &TransactionUsecaseMockDeleteTransactionByIDParamPtrs{}
+	}
+	mmDeleteTransactionByID.defaultExpectation.paramPtrs.skipVersionCheck = &skipVersionCheck
+	mmDeleteTransactionByID.defaultExpectation.expectationOrigins.originSkipVersionCheck = minimock.CallerInfo(1)
+
+	return mmDeleteTransactionByID
 }
 
-// Return sets up results that will be returned by TransactionUsecase.FindListInMap
-func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) Return(resItems map[uuid.UUID]*mm_usecase.TransactionDTO, err error) *TransactionUsecaseMock {
-	if mmFindListInMap.mock.funcFindListInMap != nil {
-		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.DeleteTransactionByID
+func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) Inspect(f func(ctx context.Context, id uuid.UUID, version int64, skipVersionCheck bool)) *mTransactionUsecaseMockDeleteTransactionByID {
+	if mmDeleteTransactionByID.mock.inspectFuncDeleteTransactionByID != nil {
+		mmDeleteTransactionByID.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.DeleteTransactionByID")
 	}
 
-	if mmFindListInMap.defaultExpectation == nil {
-		mmFindListInMap.defaultExpectation = &TransactionUsecaseMockFindListInMapExpectation{mock: mmFindListInMap.mock}
+	mmDeleteTransactionByID.mock.inspectFuncDeleteTransactionByID = f
+
+	return mmDeleteTransactionByID
+}
+
+// Return sets up results that will be returned by TransactionUsecase.DeleteTransactionByID
+func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) Return(err error) *TransactionUsecaseMock {
+	if mmDeleteTransactionByID.mock.funcDeleteTransactionByID != nil {
+		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by Set")
 	}
-	mmFindListInMap.defaultExpectation.results = &TransactionUsecaseMockFindListInMapResults{resItems, err}
-	mmFindListInMap.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmFindListInMap.mock
+
+	if mmDeleteTransactionByID.defaultExpectation == nil {
+		mmDeleteTransactionByID.defaultExpectation = &TransactionUsecaseMockDeleteTransactionByIDExpectation{mock: mmDeleteTransactionByID.mock}
+	}
+	mmDeleteTransactionByID.defaultExpectation.results = &TransactionUsecaseMockDeleteTransactionByIDResults{err}
+	mmDeleteTransactionByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteTransactionByID.mock
 }
 
-// Set uses given function f to mock the TransactionUsecase.FindListInMap method
-func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) Set(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) (resItems map[uuid.UUID]*mm_usecase.TransactionDTO, err error)) *TransactionUsecaseMock {
-	if mmFindListInMap.defaultExpectation != nil {
-		mmFindListInMap.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.FindListInMap method")
+// Set uses given function f to mock the TransactionUsecase.DeleteTransactionByID method
+func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) Set(f func(ctx context.Context, id uuid.UUID, version int64, skipVersionCheck bool) (err error)) *TransactionUsecaseMock {
+	if mmDeleteTransactionByID.defaultExpectation != nil {
+		mmDeleteTransactionByID.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.DeleteTransactionByID method")
 	}
 
-	if len(mmFindListInMap.expectations) > 0 {
-		mmFindListInMap.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.FindListInMap method")
+	if len(mmDeleteTransactionByID.expectations) > 0 {
+		mmDeleteTransactionByID.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.DeleteTransactionByID method")
 	}
 
-	mmFindListInMap.mock.funcFindListInMap = f
-	mmFindListInMap.mock.funcFindListInMapOrigin = minimock.CallerInfo(1)
-	return mmFindListInMap.mock
+	mmDeleteTransactionByID.mock.funcDeleteTransactionByID = f
+	mmDeleteTransactionByID.mock.funcDeleteTransactionByIDOrigin = minimock.CallerInfo(1)
+	return mmDeleteTransactionByID.mock
 }
 
-// When sets expectation for the TransactionUsecase.FindListInMap which will trigger the result defined by the following
+// When sets expectation for the TransactionUsecase.DeleteTransactionByID which will trigger the result defined by the following
 // Then helper
-func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) When(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) *TransactionUsecaseMockFindListInMapExpectation {
-	if mmFindListInMap.mock.funcFindListInMap != nil {
-		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by Set")
+func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) When(ctx context.Context, id uuid.UUID, version int64, skipVersionCheck bool) *TransactionUsecaseMockDeleteTransactionByIDExpectation {
+	if mmDeleteTransactionByID.mock.funcDeleteTransactionByID != nil {
+		mmDeleteTransactionByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransactionByID mock is already set by Set")
 	}
 
-	expectation := &TransactionUsecaseMockFindListInMapExpectation{
-		mock:               mmFindListInMap.mock,
-		params:             &TransactionUsecaseMockFindListInMapParams{ctx, listOptions, queryParams},
-		expectationOrigins: TransactionUsecaseMockFindListInMapExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &TransactionUsecaseMockDeleteTransactionByIDExpectation{
+		mock:               mmDeleteTransactionByID.mock,
+		params:             &TransactionUsecaseMockDeleteTransactionByIDParams{ctx, id, version, skipVersionCheck},
+		expectationOrigins: TransactionUsecaseMockDeleteTransactionByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmFindListInMap.expectations = append(mmFindListInMap.expectations, expectation)
+	mmDeleteTransactionByID.expectations = append(mmDeleteTransactionByID.expectations, expectation)
 	return expectation
 }
 
-// Then sets up TransactionUsecase.FindListInMap return parameters for the expectation previously defined by the When method
-func (e *TransactionUsecaseMockFindListInMapExpectation) Then(resItems map[uuid.UUID]*mm_usecase.TransactionDTO, err error) *TransactionUsecaseMock {
-	e.results = &TransactionUsecaseMockFindListInMapResults{resItems, err}
+// Then sets up TransactionUsecase.DeleteTransactionByID return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockDeleteTransactionByIDExpectation) Then(err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockDeleteTransactionByIDResults{err}
 	return e.mock
 }
 
-// Times sets number of times TransactionUsecase.FindListInMap should be invoked
-func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) Times(n uint64) *mTransactionUsecaseMockFindListInMap {
+// Times sets number of times TransactionUsecase.DeleteTransactionByID should be invoked
+func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) Times(n uint64) *mTransactionUsecaseMockDeleteTransactionByID {
 	if n == 0 {
-		mmFindListInMap.mock.t.Fatalf("Times of TransactionUsecaseMock.FindListInMap mock can not be zero")
+		mmDeleteTransactionByID.mock.t.Fatalf("Times of TransactionUsecaseMock.DeleteTransactionByID mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmFindListInMap.expectedInvocations, n)
-	mmFindListInMap.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmFindListInMap
+	mm_atomic.StoreUint64(&mmDeleteTransactionByID.expectedInvocations, n)
+	mmDeleteTransactionByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteTransactionByID
 }
 
-func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) invocationsDone() bool {
-	if len(mmFindListInMap.expectations) == 0 && mmFindListInMap.defaultExpectation == nil && mmFindListInMap.mock.funcFindListInMap == nil {
+func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) invocationsDone() bool {
+	if len(mmDeleteTransactionByID.expectations) == 0 && mmDeleteTransactionByID.defaultExpectation == nil && mmDeleteTransactionByID.mock.funcDeleteTransactionByID == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmFindListInMap.mock.afterFindListInMapCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmFindListInMap.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteTransactionByID.mock.afterDeleteTransactionByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteTransactionByID.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// FindListInMap implements mm_usecase.TransactionUsecase
-func (mmFindListInMap *TransactionUsecaseMock) FindListInMap(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) (resItems map[uuid.UUID]*mm_usecase.TransactionDTO, err error) {
-	mm_atomic.AddUint64(&mmFindListInMap.beforeFindListInMapCounter, 1)
-	defer mm_atomic.AddUint64(&mmFindListInMap.afterFindListInMapCounter, 1)
+// DeleteTransactionByID implements mm_usecase.TransactionUsecase
+func (mmDeleteTransactionByID *TransactionUsecaseMock) DeleteTransactionByID(ctx context.Context, id uuid.UUID, version int64, skipVersionCheck bool) (err error) {
+	mm_atomic.AddUint64(&mmDeleteTransactionByID.beforeDeleteTransactionByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteTransactionByID.afterDeleteTransactionByIDCounter, 1)
 
-	mmFindListInMap.t.Helper()
+	mmDeleteTransactionByID.t.Helper()
 
-	if mmFindListInMap.inspectFuncFindListInMap != nil {
-		mmFindListInMap.inspectFuncFindListInMap(ctx, listOptions, queryParams)
+	if mmDeleteTransactionByID.inspectFuncDeleteTransactionByID != nil {
+		mmDeleteTransactionByID.inspectFuncDeleteTransactionByID(ctx, id, version, skipVersionCheck)
 	}
 
-	mm_params := TransactionUsecaseMockFindListInMapParams{ctx, listOptions, queryParams}
+	mm_params := TransactionUsecaseMockDeleteTransactionByIDParams{ctx, id, version, skipVersionCheck}
 
 	// Record call args
-	mmFindListInMap.FindListInMapMock.mutex.Lock()
-	mmFindListInMap.FindListInMapMock.callArgs = append(mmFindListInMap.FindListInMapMock.callArgs, &mm_params)
-	mmFindListInMap.FindListInMapMock.mutex.Unlock()
+	mmDeleteTransactionByID.DeleteTransactionByIDMock.mutex.Lock()
+	mmDeleteTransactionByID.DeleteTransactionByIDMock.callArgs = append(mmDeleteTransactionByID.DeleteTransactionByIDMock.callArgs, &mm_params)
+	mmDeleteTransactionByID.DeleteTransactionByIDMock.mutex.Unlock()
 
-	for _, e := range mmFindListInMap.FindListInMapMock.expectations {
+	for _, e := range mmDeleteTransactionByID.DeleteTransactionByIDMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.resItems, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmFindListInMap.FindListInMapMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmFindListInMap.FindListInMapMock.defaultExpectation.Counter, 1)
-		mm_want := mmFindListInMap.FindListInMapMock.defaultExpectation.params
-		mm_want_ptrs := mmFindListInMap.FindListInMapMock.defaultExpectation.paramPtrs
+	if mmDeleteTransactionByID.DeleteTransactionByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteTransactionByID.DeleteTransactionByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteTransactionByID.DeleteTransactionByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteTransactionByID.DeleteTransactionByIDMock.defaultExpectation.paramPtrs
 
-		mm_got := TransactionUsecaseMockFindListInMapParams{ctx, listOptions, queryParams}
+		mm_got := TransactionUsecaseMockDeleteTransactionByIDParams{ctx, id, version, skipVersionCheck}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmFindListInMap.t.Errorf("TransactionUsecaseMock.FindListInMap got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindListInMap.FindListInMapMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmDeleteTransactionByID.t.Errorf("TransactionUsecaseMock.DeleteTransactionByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTransactionByID.DeleteTransactionByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
-				mmFindListInMap.t.Errorf("TransactionUsecaseMock.FindListInMap got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindListInMap.FindListInMapMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteTransactionByID.t.Errorf("TransactionUsecaseMock.DeleteTransactionByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTransactionByID.DeleteTransactionByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
-			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
-				mmFindListInMap.t.Errorf("TransactionUsecaseMock.FindListInMap got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindListInMap.FindListInMapMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmDeleteTransactionByID.t.Errorf("TransactionUsecaseMock.DeleteTransactionByID got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTransactionByID.DeleteTransactionByIDMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+			if mm_want_ptrs.skipVersionCheck != nil && !minimock.Equal(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck) {
+				mmDeleteTransactionByID.t.Errorf("TransactionUsecaseMock.DeleteTransactionByID got unexpected parameter skipVersionCheck, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTransactionByID.DeleteTransactionByIDMock.defaultExpectation.expectationOrigins.originSkipVersionCheck, *mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck, minimock.Diff(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmFindListInMap.t.Errorf("TransactionUsecaseMock.FindListInMap got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmFindListInMap.FindListInMapMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmDeleteTransactionByID.t.Errorf("TransactionUsecaseMock.DeleteTransactionByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteTransactionByID.DeleteTransactionByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmFindListInMap.FindListInMapMock.defaultExpectation.results
+		mm_results := mmDeleteTransactionByID.DeleteTransactionByIDMock.defaultExpectation.results
 		if mm_results == nil {
-			mmFindListInMap.t.Fatal("No results are set for the TransactionUsecaseMock.FindListInMap")
+			mmDeleteTransactionByID.t.Fatal("No results are set for the TransactionUsecaseMock.DeleteTransactionByID")
 		}
-		return (*mm_results).resItems, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmFindListInMap.funcFindListInMap != nil {
-		return mmFindListInMap.funcFindListInMap(ctx, listOptions, queryParams)
+	if mmDeleteTransactionByID.funcDeleteTransactionByID != nil {
+		return mmDeleteTransactionByID.funcDeleteTransactionByID(ctx, id, version, skipVersionCheck)
 	}
-	mmFindListInMap.t.Fatalf("Unexpected call to TransactionUsecaseMock.FindListInMap. %v %v %v", ctx, listOptions, queryParams)
+	mmDeleteTransactionByID.t.Fatalf("Unexpected call to TransactionUsecaseMock.DeleteTransactionByID. %v %v %v %v", ctx, id, version, skipVersionCheck)
 	return
 }
 
-// FindListInMapAfterCounter returns a count of finished TransactionUsecaseMock.FindListInMap invocations
-func (mmFindListInMap *TransactionUsecaseMock) FindListInMapAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFindListInMap.afterFindListInMapCounter)
+// DeleteTransactionByIDAfterCounter returns a count of finished TransactionUsecaseMock.DeleteTransactionByID invocations
+func (mmDeleteTransactionByID *TransactionUsecaseMock) DeleteTransactionByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTransactionByID.afterDeleteTransactionByIDCounter)
 }
 
-// FindListInMapBeforeCounter returns a count of TransactionUsecaseMock.FindListInMap invocations
-func (mmFindListInMap *TransactionUsecaseMock) FindListInMapBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFindListInMap.beforeFindListInMapCounter)
+// DeleteTransactionByIDBeforeCounter returns a count of TransactionUsecaseMock.DeleteTransactionByID invocations
+func (mmDeleteTransactionByID *TransactionUsecaseMock) DeleteTransactionByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTransactionByID.beforeDeleteTransactionByIDCounter)
 }
 
-// Calls returns a list of arguments used in each call to TransactionUsecaseMock.FindListInMap.
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.DeleteTransactionByID.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) Calls() []*TransactionUsecaseMockFindListInMapParams {
-	mmFindListInMap.mutex.RLock()
+func (mmDeleteTransactionByID *mTransactionUsecaseMockDeleteTransactionByID) Calls() []*TransactionUsecaseMockDeleteTransactionByIDParams {
+	mmDeleteTransactionByID.mutex.RLock()
 
-	argCopy := make([]*TransactionUsecaseMockFindListInMapParams, len(mmFindListInMap.callArgs))
-	copy(argCopy, mmFindListInMap.callArgs)
+	argCopy := make([]*TransactionUsecaseMockDeleteTransactionByIDParams, len(mmDeleteTransactionByID.callArgs))
+	copy(argCopy, mmDeleteTransactionByID.callArgs)
 
-	mmFindListInMap.mutex.RUnlock()
+	mmDeleteTransactionByID.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockFindListInMapDone returns true if the count of the FindListInMap invocations corresponds
+// MinimockDeleteTransactionByIDDone returns true if the count of the DeleteTransactionByID invocations corresponds
 // the number of defined expectations
-func (m *TransactionUsecaseMock) MinimockFindListInMapDone() bool {
-	if m.FindListInMapMock.optional {
+func (m *TransactionUsecaseMock) MinimockDeleteTransactionByIDDone() bool {
+	if m.DeleteTransactionByIDMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.FindListInMapMock.expectations {
+	for _, e := range m.DeleteTransactionByIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.FindListInMapMock.invocationsDone()
+	return m.DeleteTransactionByIDMock.invocationsDone()
 }
 
-// MinimockFindListInMapInspect logs each unmet expectation
-func (m *TransactionUsecaseMock) MinimockFindListInMapInspect() {
-	for _, e := range m.FindListInMapMock.expectations {
+// MinimockDeleteTransactionByIDInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockDeleteTransactionByIDInspect() {
+	for _, e := range m.DeleteTransactionByIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.FindListInMap at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to TransactionUsecaseMock.DeleteTransactionByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterFindListInMapCounter := mm_atomic.LoadUint64(&m.afterFindListInMapCounter)
+	afterDeleteTransactionByIDCounter := mm_atomic.LoadUint64(&m.afterDeleteTransactionByIDCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.FindListInMapMock.defaultExpectation != nil && afterFindListInMapCounter < 1 {
-		if m.FindListInMapMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.FindListInMap at\n%s", m.FindListInMapMock.defaultExpectation.returnOrigin)
+	if m.DeleteTransactionByIDMock.defaultExpectation != nil && afterDeleteTransactionByIDCounter < 1 {
+		if m.DeleteTransactionByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.DeleteTransactionByID at\n%s", m.DeleteTransactionByIDMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.FindListInMap at\n%s with params: %#v", m.FindListInMapMock.defaultExpectation.expectationOrigins.origin, *m.FindListInMapMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to TransactionUsecaseMock.DeleteTransactionByID at\n%s with params: %#v", m.DeleteTransactionByIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteTransactionByIDMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcFindListInMap != nil && afterFindListInMapCounter < 1 {
-		m.t.Errorf("Expected call to TransactionUsecaseMock.FindListInMap at\n%s", m.funcFindListInMapOrigin)
+	if m.funcDeleteTransactionByID != nil && afterDeleteTransactionByIDCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.DeleteTransactionByID at\n%s", m.funcDeleteTransactionByIDOrigin)
 	}
 
-	if !m.FindListInMapMock.invocationsDone() && afterFindListInMapCounter > 0 {
-		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.FindListInMap at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.FindListInMapMock.expectedInvocations), m.FindListInMapMock.expectedInvocationsOrigin, afterFindListInMapCounter)
+	if !m.DeleteTransactionByIDMock.invocationsDone() && afterDeleteTransactionByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.DeleteTransactionByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteTransactionByIDMock.expectedInvocations), m.DeleteTransactionByIDMock.expectedInvocationsOrigin, afterDeleteTransactionByIDCounter)
 	}
 }
 
-type mTransactionUsecaseMockFindOneByID struct {
+type mTransactionUsecaseMockDeleteTransferByID struct {
 	optional           bool
 	mock               *TransactionUsecaseMock
-	defaultExpectation *TransactionUsecaseMockFindOneByIDExpectation
-	expectations       []*TransactionUsecaseMockFindOneByIDExpectation
+	defaultExpectation *TransactionUsecaseMockDeleteTransferByIDExpectation
+	expectations       []*TransactionUsecaseMockDeleteTransferByIDExpectation
 
-	callArgs []*TransactionUsecaseMockFindOneByIDParams
+	callArgs []*TransactionUsecaseMockDeleteTransferByIDParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// TransactionUsecaseMockFindOneByIDExpectation specifies expectation struct of the TransactionUsecase.FindOneByID
-type TransactionUsecaseMockFindOneByIDExpectation struct {
+// TransactionUsecaseMockDeleteTransferByIDExpectation specifies expectation struct of the TransactionUsecase.DeleteTransferByID
+type TransactionUsecaseMockDeleteTransferByIDExpectation struct {
 	mock               *TransactionUsecaseMock
-	params             *TransactionUsecaseMockFindOneByIDParams
-	paramPtrs          *TransactionUsecaseMockFindOneByIDParamPtrs
-	expectationOrigins TransactionUsecaseMockFindOneByIDExpectationOrigins
-	results            *TransactionUsecaseMockFindOneByIDResults
+	params             *TransactionUsecaseMockDeleteTransferByIDParams
+	paramPtrs          *TransactionUsecaseMockDeleteTransferByIDParamPtrs
+	expectationOrigins TransactionUsecaseMockDeleteTransferByIDExpectationOrigins
+	results            *TransactionUsecaseMockDeleteTransferByIDResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// TransactionUsecaseMockFindOneByIDParams contains parameters of the TransactionUsecase.FindOneByID
-type TransactionUsecaseMockFindOneByIDParams struct {
-	ctx         context.Context
-	id          uuid.UUID
-	queryParams *uctypes.QueryGetOneParams
+// TransactionUsecaseMockDeleteTransferByIDParams contains parameters of the TransactionUsecase.DeleteTransferByID
+type TransactionUsecaseMockDeleteTransferByIDParams struct {
+	ctx context.Context
+	id  uuid.UUID
 }
 
-// TransactionUsecaseMockFindOneByIDParamPtrs contains pointers to parameters of the TransactionUsecase.FindOneByID
-type TransactionUsecaseMockFindOneByIDParamPtrs struct {
-	ctx         *context.Context
-	id          *uuid.UUID
-	queryParams **uctypes.QueryGetOneParams
+// TransactionUsecaseMockDeleteTransferByIDParamPtrs contains pointers to parameters of the TransactionUsecase.DeleteTransferByID
+type TransactionUsecaseMockDeleteTransferByIDParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
 }
 
-// TransactionUsecaseMockFindOneByIDResults contains results of the TransactionUsecase.FindOneByID
-type TransactionUsecaseMockFindOneByIDResults struct {
-	resItem *mm_usecase.TransactionDTO
-	err     error
+// TransactionUsecaseMockDeleteTransferByIDResults contains results of the TransactionUsecase.DeleteTransferByID
+type TransactionUsecaseMockDeleteTransferByIDResults struct {
+	err error
 }
 
-// TransactionUsecaseMockFindOneByIDOrigins contains origins of expectations of the TransactionUsecase.FindOneByID
-type TransactionUsecaseMockFindOneByIDExpectationOrigins struct {
-	origin            string
-	originCtx         string
-	originId          string
-	originQueryParams string
+// TransactionUsecaseMockDeleteTransferByIDOrigins contains origins of expectations of the TransactionUsecase.DeleteTransferByID
+type TransactionUsecaseMockDeleteTransferByIDExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1970,374 +2076,4495 @@ type TransactionUsecaseMockFindOneByIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) Optional() *mTransactionUsecaseMockFindOneByID {
-	mmFindOneByID.optional = true
-	return mmFindOneByID
+func (mmDeleteTransferByID *mTransactionUsecaseMockDeleteTransferByID) Optional() *mTransactionUsecaseMockDeleteTransferByID {
+	mmDeleteTransferByID.optional = true
+	return mmDeleteTransferByID
 }
 
-// Expect sets up expected params for TransactionUsecase.FindOneByID
-func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) Expect(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *mTransactionUsecaseMockFindOneByID {
-	if mmFindOneByID.mock.funcFindOneByID != nil {
-		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by Set")
+// Expect sets up expected params for TransactionUsecase.DeleteTransferByID
+func (mmDeleteTransferByID *mTransactionUsecaseMockDeleteTransferByID) Expect(ctx context.Context, id uuid.UUID) *mTransactionUsecaseMockDeleteTransferByID {
+	if mmDeleteTransferByID.mock.funcDeleteTransferByID != nil {
+		mmDeleteTransferByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransferByID mock is already set by Set")
 	}
 
-	if mmFindOneByID.defaultExpectation == nil {
-		mmFindOneByID.defaultExpectation = &TransactionUsecaseMockFindOneByIDExpectation{}
+	if mmDeleteTransferByID.defaultExpectation == nil {
+		mmDeleteTransferByID.defaultExpectation = &TransactionUsecaseMockDeleteTransferByIDExpectation{}
 	}
 
-	if mmFindOneByID.defaultExpectation.paramPtrs != nil {
-		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by ExpectParams functions")
+	if mmDeleteTransferByID.defaultExpectation.paramPtrs != nil {
+		mmDeleteTransferByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransferByID mock is already set by ExpectParams functions")
 	}
 
-	mmFindOneByID.defaultExpectation.params = &TransactionUsecaseMockFindOneByIDParams{ctx, id, queryParams}
-	mmFindOneByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmFindOneByID.expectations {
-		if minimock.Equal(e.params, mmFindOneByID.defaultExpectation.params) {
-			mmFindOneByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindOneByID.defaultExpectation.params)
+	mmDeleteTransferByID.defaultExpectation.params = &TransactionUsecaseMockDeleteTransferByIDParams{ctx, id}
+	mmDeleteTransferByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteTransferByID.expectations {
+		if minimock.Equal(e.params, mmDeleteTransferByID.defaultExpectation.params) {
+			mmDeleteTransferByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteTransferByID.defaultExpectation.params)
 		}
 	}
 
-	return mmFindOneByID
+	return mmDeleteTransferByID
 }
 
-// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.FindOneByID
-func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockFindOneByID {
-	if mmFindOneByID.mock.funcFindOneByID != nil {
-		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.DeleteTransferByID
+func (mmDeleteTransferByID *mTransactionUsecaseMockDeleteTransferByID) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockDeleteTransferByID {
+	if mmDeleteTransferByID.mock.funcDeleteTransferByID != nil {
+		mmDeleteTransferByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransferByID mock is already set by Set")
 	}
 
-	if mmFindOneByID.defaultExpectation == nil {
-		mmFindOneByID.defaultExpectation = &TransactionUsecaseMockFindOneByIDExpectation{}
+	if mmDeleteTransferByID.defaultExpectation == nil {
+		mmDeleteTransferByID.defaultExpectation = &TransactionUsecaseMockDeleteTransferByIDExpectation{}
 	}
 
-	if mmFindOneByID.defaultExpectation.params != nil {
-		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by Expect")
+	if mmDeleteTransferByID.defaultExpectation.params != nil {
+		mmDeleteTransferByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransferByID mock is already set by Expect")
 	}
 
-	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
-		mmFindOneByID.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindOneByIDParamPtrs{}
+	if mmDeleteTransferByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteTransferByID.defaultExpectation.paramPtrs = &TransactionUsecaseMockDeleteTransferByIDParamPtrs{}
 	}
-	mmFindOneByID.defaultExpectation.paramPtrs.ctx = &ctx
-	mmFindOneByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmDeleteTransferByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteTransferByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmFindOneByID
+	return mmDeleteTransferByID
 }
 
-// ExpectIdParam2 sets up expected param id for TransactionUsecase.FindOneByID
-func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) ExpectIdParam2(id uuid.UUID) *mTransactionUsecaseMockFindOneByID {
-	if mmFindOneByID.mock.funcFindOneByID != nil {
-		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for TransactionUsecase.DeleteTransferByID
+func (mmDeleteTransferByID *mTransactionUsecaseMockDeleteTransferByID) ExpectIdParam2(id uuid.UUID) *mTransactionUsecaseMockDeleteTransferByID {
+	if mmDeleteTransferByID.mock.funcDeleteTransferByID != nil {
+		mmDeleteTransferByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransferByID mock is already set by Set")
 	}
 
-	if mmFindOneByID.defaultExpectation == nil {
-		mmFindOneByID.defaultExpectation = &TransactionUsecaseMockFindOneByIDExpectation{}
+	if mmDeleteTransferByID.defaultExpectation == nil {
+		mmDeleteTransferByID.defaultExpectation = &TransactionUsecaseMockDeleteTransferByIDExpectation{}
 	}
 
-	if mmFindOneByID.defaultExpectation.params != nil {
-		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by Expect")
+	if mmDeleteTransferByID.defaultExpectation.params != nil {
+		mmDeleteTransferByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransferByID mock is already set by Expect")
 	}
 
-	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
-		mmFindOneByID.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindOneByIDParamPtrs{}
+	if mmDeleteTransferByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteTransferByID.defaultExpectation.paramPtrs = &TransactionUsecaseMockDeleteTransferByIDParamPtrs{}
 	}
-	mmFindOneByID.defaultExpectation.paramPtrs.id = &id
-	mmFindOneByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmDeleteTransferByID.defaultExpectation.paramPtrs.id = &id
+	mmDeleteTransferByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmFindOneByID
+	return mmDeleteTransferByID
 }
 
-// ExpectQueryParamsParam3 sets up expected param queryParams for TransactionUsecase.FindOneByID
-func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetOneParams) *mTransactionUsecaseMockFindOneByID {
-	if mmFindOneByID.mock.funcFindOneByID != nil {
-		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.DeleteTransferByID
+func (mmDeleteTransferByID *mTransactionUsecaseMockDeleteTransferByID) Inspect(f func(ctx context.Context, id uuid.UUID)) *mTransactionUsecaseMockDeleteTransferByID {
+	if mmDeleteTransferByID.mock.inspectFuncDeleteTransferByID != nil {
+		mmDeleteTransferByID.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.DeleteTransferByID")
 	}
 
-	if mmFindOneByID.defaultExpectation == nil {
-		mmFindOneByID.defaultExpectation = &TransactionUsecaseMockFindOneByIDExpectation{}
+	mmDeleteTransferByID.mock.inspectFuncDeleteTransferByID = f
+
+	return mmDeleteTransferByID
+}
+
+// Return sets up results that will be returned by TransactionUsecase.DeleteTransferByID
+func (mmDeleteTransferByID *mTransactionUsecaseMockDeleteTransferByID) Return(err error) *TransactionUsecaseMock {
+	if mmDeleteTransferByID.mock.funcDeleteTransferByID != nil {
+		mmDeleteTransferByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransferByID mock is already set by Set")
 	}
 
-	if mmFindOneByID.defaultExpectation.params != nil {
-		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by Expect")
+	if mmDeleteTransferByID.defaultExpectation == nil {
+		mmDeleteTransferByID.defaultExpectation = &TransactionUsecaseMockDeleteTransferByIDExpectation{mock: mmDeleteTransferByID.mock}
 	}
+	mmDeleteTransferByID.defaultExpectation.results = &TransactionUsecaseMockDeleteTransferByIDResults{err}
+	mmDeleteTransferByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteTransferByID.mock
+}
 
-	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
-		mmFindOneByID.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindOneByIDParamPtrs{}
+// Set uses given function f to mock the TransactionUsecase.DeleteTransferByID method
+func (mmDeleteTransferByID *mTransactionUsecaseMockDeleteTransferByID) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *TransactionUsecaseMock {
+	if mmDeleteTransferByID.defaultExpectation != nil {
+		mmDeleteTransferByID.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.DeleteTransferByID method")
 	}
-	mmFindOneByID.defaultExpectation.paramPtrs.queryParams = &queryParams
-	mmFindOneByID.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
 
-	return mmFindOneByID
+	if len(mmDeleteTransferByID.expectations) > 0 {
+		mmDeleteTransferByID.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.DeleteTransferByID method")
+	}
+
+	mmDeleteTransferByID.mock.funcDeleteTransferByID = f
+	mmDeleteTransferByID.mock.funcDeleteTransferByIDOrigin = minimock.CallerInfo(1)
+	return mmDeleteTransferByID.mock
 }
 
-// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.FindOneByID
-func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) Inspect(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)) *mTransactionUsecaseMockFindOneByID {
-	if mmFindOneByID.mock.inspectFuncFindOneByID != nil {
-		mmFindOneByID.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.FindOneByID")
+// When sets expectation for the TransactionUsecase.DeleteTransferByID which will trigger the result defined by the following
+// Then helper
+func (mmDeleteTransferByID *mTransactionUsecaseMockDeleteTransferByID) When(ctx context.Context, id uuid.UUID) *TransactionUsecaseMockDeleteTransferByIDExpectation {
+	if mmDeleteTransferByID.mock.funcDeleteTransferByID != nil {
+		mmDeleteTransferByID.mock.t.Fatalf("TransactionUsecaseMock.DeleteTransferByID mock is already set by Set")
 	}
 
-	mmFindOneByID.mock.inspectFuncFindOneByID = f
+	expectation := &TransactionUsecaseMockDeleteTransferByIDExpectation{
+		mock:               mmDeleteTransferByID.mock,
+		params:             &TransactionUsecaseMockDeleteTransferByIDParams{ctx, id},
+		expectationOrigins: TransactionUsecaseMockDeleteTransferByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteTransferByID.expectations = append(mmDeleteTransferByID.expectations, expectation)
+	return expectation
+}
 
-	return mmFindOneByID
+// Then sets up TransactionUsecase.DeleteTransferByID return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockDeleteTransferByIDExpectation) Then(err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockDeleteTransferByIDResults{err}
+	return e.mock
 }
 
-// Return sets up results that will be returned by TransactionUsecase.FindOneByID
-func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) Return(resItem *mm_usecase.TransactionDTO, err error) *TransactionUsecaseMock {
-	if mmFindOneByID.mock.funcFindOneByID != nil {
-		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by Set")
+// Times sets number of times TransactionUsecase.DeleteTransferByID should be invoked
+func (mmDeleteTransferByID *mTransactionUsecaseMockDeleteTransferByID) Times(n uint64) *mTransactionUsecaseMockDeleteTransferByID {
+	if n == 0 {
+		mmDeleteTransferByID.mock.t.Fatalf("Times of TransactionUsecaseMock.DeleteTransferByID mock can not be zero")
 	}
+	mm_atomic.StoreUint64(&mmDeleteTransferByID.expectedInvocations, n)
+	mmDeleteTransferByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteTransferByID
+}
 
-	if mmFindOneByID.defaultExpectation == nil {
-		mmFindOneByID.defaultExpectation = &TransactionUsecaseMockFindOneByIDExpectation{mock: mmFindOneByID.mock}
+func (mmDeleteTransferByID *mTransactionUsecaseMockDeleteTransferByID) invocationsDone() bool {
+	if len(mmDeleteTransferByID.expectations) == 0 && mmDeleteTransferByID.defaultExpectation == nil && mmDeleteTransferByID.mock.funcDeleteTransferByID == nil {
+		return true
 	}
-	mmFindOneByID.defaultExpectation.results = &TransactionUsecaseMockFindOneByIDResults{resItem, err}
-	mmFindOneByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmFindOneByID.mock
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteTransferByID.mock.afterDeleteTransferByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteTransferByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Set uses given function f to mock the TransactionUsecase.FindOneByID method
-func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) Set(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.TransactionDTO, err error)) *TransactionUsecaseMock {
-	if mmFindOneByID.defaultExpectation != nil {
-		mmFindOneByID.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.FindOneByID method")
+// DeleteTransferByID implements mm_usecase.TransactionUsecase
+func (mmDeleteTransferByID *TransactionUsecaseMock) DeleteTransferByID(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteTransferByID.beforeDeleteTransferByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteTransferByID.afterDeleteTransferByIDCounter, 1)
+
+	mmDeleteTransferByID.t.Helper()
+
+	if mmDeleteTransferByID.inspectFuncDeleteTransferByID != nil {
+		mmDeleteTransferByID.inspectFuncDeleteTransferByID(ctx, id)
+	}
+
+	mm_params := TransactionUsecaseMockDeleteTransferByIDParams{ctx, id}
+
+	// Record call args
+	mmDeleteTransferByID.DeleteTransferByIDMock.mutex.Lock()
+	mmDeleteTransferByID.DeleteTransferByIDMock.callArgs = append(mmDeleteTransferByID.DeleteTransferByIDMock.callArgs, &mm_params)
+	mmDeleteTransferByID.DeleteTransferByIDMock.mutex.Unlock()
+
+	for _, e := range mmDeleteTransferByID.DeleteTransferByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteTransferByID.DeleteTransferByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteTransferByID.DeleteTransferByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteTransferByID.DeleteTransferByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteTransferByID.DeleteTransferByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := TransactionUsecaseMockDeleteTransferByIDParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteTransferByID.t.Errorf("TransactionUsecaseMock.DeleteTransferByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTransferByID.DeleteTransferByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteTransferByID.t.Errorf("TransactionUsecaseMock.DeleteTransferByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTransferByID.DeleteTransferByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteTransferByID.t.Errorf("TransactionUsecaseMock.DeleteTransferByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteTransferByID.DeleteTransferByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteTransferByID.DeleteTransferByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteTransferByID.t.Fatal("No results are set for the TransactionUsecaseMock.DeleteTransferByID")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteTransferByID.funcDeleteTransferByID != nil {
+		return mmDeleteTransferByID.funcDeleteTransferByID(ctx, id)
+	}
+	mmDeleteTransferByID.t.Fatalf("Unexpected call to TransactionUsecaseMock.DeleteTransferByID. %v %v", ctx, id)
+	return
+}
+
+// DeleteTransferByIDAfterCounter returns a count of finished TransactionUsecaseMock.DeleteTransferByID invocations
+func (mmDeleteTransferByID *TransactionUsecaseMock) DeleteTransferByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTransferByID.afterDeleteTransferByIDCounter)
+}
+
+// DeleteTransferByIDBeforeCounter returns a count of TransactionUsecaseMock.DeleteTransferByID invocations
+func (mmDeleteTransferByID *TransactionUsecaseMock) DeleteTransferByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTransferByID.beforeDeleteTransferByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.DeleteTransferByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteTransferByID *mTransactionUsecaseMockDeleteTransferByID) Calls() []*TransactionUsecaseMockDeleteTransferByIDParams {
+	mmDeleteTransferByID.mutex.RLock()
+
+	argCopy := make([]*TransactionUsecaseMockDeleteTransferByIDParams, len(mmDeleteTransferByID.callArgs))
+	copy(argCopy, mmDeleteTransferByID.callArgs)
+
+	mmDeleteTransferByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteTransferByIDDone returns true if the count of the DeleteTransferByID invocations corresponds
+// the number of defined expectations
+func (m *TransactionUsecaseMock) MinimockDeleteTransferByIDDone() bool {
+	if m.DeleteTransferByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteTransferByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteTransferByIDMock.invocationsDone()
+}
+
+// MinimockDeleteTransferByIDInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockDeleteTransferByIDInspect() {
+	for _, e := range m.DeleteTransferByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.DeleteTransferByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteTransferByIDCounter := mm_atomic.LoadUint64(&m.afterDeleteTransferByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteTransferByIDMock.defaultExpectation != nil && afterDeleteTransferByIDCounter < 1 {
+		if m.DeleteTransferByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.DeleteTransferByID at\n%s", m.DeleteTransferByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.DeleteTransferByID at\n%s with params: %#v", m.DeleteTransferByIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteTransferByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteTransferByID != nil && afterDeleteTransferByIDCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.DeleteTransferByID at\n%s", m.funcDeleteTransferByIDOrigin)
+	}
+
+	if !m.DeleteTransferByIDMock.invocationsDone() && afterDeleteTransferByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.DeleteTransferByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteTransferByIDMock.expectedInvocations), m.DeleteTransferByIDMock.expectedInvocationsOrigin, afterDeleteTransferByIDCounter)
+	}
+}
+
+type mTransactionUsecaseMockExportReports struct {
+	optional           bool
+	mock               *TransactionUsecaseMock
+	defaultExpectation *TransactionUsecaseMockExportReportsExpectation
+	expectations       []*TransactionUsecaseMockExportReportsExpectation
+
+	callArgs []*TransactionUsecaseMockExportReportsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransactionUsecaseMockExportReportsExpectation specifies expectation struct of the TransactionUsecase.ExportReports
+type TransactionUsecaseMockExportReportsExpectation struct {
+	mock               *TransactionUsecaseMock
+	params             *TransactionUsecaseMockExportReportsParams
+	paramPtrs          *TransactionUsecaseMockExportReportsParamPtrs
+	expectationOrigins TransactionUsecaseMockExportReportsExpectationOrigins
+	results            *TransactionUsecaseMockExportReportsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TransactionUsecaseMockExportReportsParams contains parameters of the TransactionUsecase.ExportReports
+type TransactionUsecaseMockExportReportsParams struct {
+	ctx         context.Context
+	format      mm_usecase.ExportFormat
+	queryFilter mm_usecase.CountReportItemsQueryFilter
+}
+
+// TransactionUsecaseMockExportReportsParamPtrs contains pointers to parameters of the TransactionUsecase.ExportReports
+type TransactionUsecaseMockExportReportsParamPtrs struct {
+	ctx         *context.Context
+	format      *mm_usecase.ExportFormat
+	queryFilter *mm_usecase.CountReportItemsQueryFilter
+}
+
+// TransactionUsecaseMockExportReportsResults contains results of the TransactionUsecase.ExportReports
+type TransactionUsecaseMockExportReportsResults struct {
+	resFile *mm_usecase.ExportFile
+	err     error
+}
+
+// TransactionUsecaseMockExportReportsOrigins contains origins of expectations of the TransactionUsecase.ExportReports
+type TransactionUsecaseMockExportReportsExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originFormat      string
+	originQueryFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExportReports *mTransactionUsecaseMockExportReports) Optional() *mTransactionUsecaseMockExportReports {
+	mmExportReports.optional = true
+	return mmExportReports
+}
+
+// Expect sets up expected params for TransactionUsecase.ExportReports
+func (mmExportReports *mTransactionUsecaseMockExportReports) Expect(ctx context.Context, format mm_usecase.ExportFormat, queryFilter mm_usecase.CountReportItemsQueryFilter) *mTransactionUsecaseMockExportReports {
+	if mmExportReports.mock.funcExportReports != nil {
+		mmExportReports.mock.t.Fatalf("TransactionUsecaseMock.ExportReports mock is already set by Set")
+	}
+
+	if mmExportReports.defaultExpectation == nil {
+		mmExportReports.defaultExpectation = &TransactionUsecaseMockExportReportsExpectation{}
+	}
+
+	if mmExportReports.defaultExpectation.paramPtrs != nil {
+		mmExportReports.mock.t.Fatalf("TransactionUsecaseMock.ExportReports mock is already set by ExpectParams functions")
+	}
+
+	mmExportReports.defaultExpectation.params = &TransactionUsecaseMockExportReportsParams{ctx, format, queryFilter}
+	mmExportReports.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExportReports.expectations {
+		if minimock.Equal(e.params, mmExportReports.defaultExpectation.params) {
+			mmExportReports.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExportReports.defaultExpectation.params)
+		}
+	}
+
+	return mmExportReports
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.ExportReports
+func (mmExportReports *mTransactionUsecaseMockExportReports) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockExportReports {
+	if mmExportReports.mock.funcExportReports != nil {
+		mmExportReports.mock.t.Fatalf("TransactionUsecaseMock.ExportReports mock is already set by Set")
+	}
+
+	if mmExportReports.defaultExpectation == nil {
+		mmExportReports.defaultExpectation = &TransactionUsecaseMockExportReportsExpectation{}
+	}
+
+	if mmExportReports.defaultExpectation.params != nil {
+		mmExportReports.mock.t.Fatalf("TransactionUsecaseMock.ExportReports mock is already set by Expect")
+	}
+
+	if mmExportReports.defaultExpectation.paramPtrs == nil {
+		mmExportReports.defaultExpectation.paramPtrs = &TransactionUsecaseMockExportReportsParamPtrs{}
+	}
+	mmExportReports.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExportReports.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExportReports
+}
+
+// ExpectFormatParam2 sets up expected param format for TransactionUsecase.ExportReports
+func (mmExportReports *mTransactionUsecaseMockExportReports) ExpectFormatParam2(format mm_usecase.ExportFormat) *mTransactionUsecaseMockExportReports {
+	if mmExportReports.mock.funcExportReports != nil {
+		mmExportReports.mock.t.Fatalf("TransactionUsecaseMock.ExportReports mock is already set by Set")
+	}
+
+	if mmExportReports.defaultExpectation == nil {
+		mmExportReports.defaultExpectation = &TransactionUsecaseMockExportReportsExpectation{}
+	}
+
+	if mmExportReports.defaultExpectation.params != nil {
+		mmExportReports.mock.t.Fatalf("TransactionUsecaseMock.ExportReports mock is already set by Expect")
+	}
+
+	if mmExportReports.defaultExpectation.paramPtrs == nil {
+		mmExportReports.defaultExpectation.paramPtrs = &TransactionUsecaseMockExportReportsParamPtrs{}
+	}
+	mmExportReports.defaultExpectation.paramPtrs.format = &format
+	mmExportReports.defaultExpectation.expectationOrigins.originFormat = minimock.CallerInfo(1)
+
+	return mmExportReports
+}
+
+// ExpectQueryFilterParam3 sets up expected param queryFilter for TransactionUsecase.ExportReports
+func (mmExportReports *mTransactionUsecaseMockExportReports) ExpectQueryFilterParam3(queryFilter mm_usecase.CountReportItemsQueryFilter) *mTransactionUsecaseMockExportReports {
+	if mmExportReports.mock.funcExportReports != nil {
+		mmExportReports.mock.t.Fatalf("TransactionUsecaseMock.ExportReports mock is already set by Set")
+	}
+
+	if mmExportReports.defaultExpectation == nil {
+		mmExportReports.defaultExpectation = &TransactionUsecaseMockExportReportsExpectation{}
+	}
+
+	if mmExportReports.defaultExpectation.params != nil {
+		mmExportReports.mock.t.Fatalf("TransactionUsecaseMock.ExportReports mock is already set by Expect")
+	}
+
+	if mmExportReports.defaultExpectation.paramPtrs == nil {
+		mmExportReports.defaultExpectation.paramPtrs = &TransactionUsecaseMockExportReportsParamPtrs{}
+	}
+	mmExportReports.defaultExpectation.paramPtrs.queryFilter = &queryFilter
+	mmExportReports.defaultExpectation.expectationOrigins.originQueryFilter = minimock.CallerInfo(1)
+
+	return mmExportReports
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.ExportReports
+func (mmExportReports *mTransactionUsecaseMockExportReports) Inspect(f func(ctx context.Context, format mm_usecase.ExportFormat, queryFilter mm_usecase.CountReportItemsQueryFilter)) *mTransactionUsecaseMockExportReports {
+	if mmExportReports.mock.inspectFuncExportReports != nil {
+		mmExportReports.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.ExportReports")
+	}
+
+	mmExportReports.mock.inspectFuncExportReports = f
+
+	return mmExportReports
+}
+
+// Return sets up results that will be returned by TransactionUsecase.ExportReports
+func (mmExportReports *mTransactionUsecaseMockExportReports) Return(resFile *mm_usecase.ExportFile, err error) *TransactionUsecaseMock {
+	if mmExportReports.mock.funcExportReports != nil {
+		mmExportReports.mock.t.Fatalf("TransactionUsecaseMock.ExportReports mock is already set by Set")
+	}
+
+	if mmExportReports.defaultExpectation == nil {
+		mmExportReports.defaultExpectation = &TransactionUsecaseMockExportReportsExpectation{mock: mmExportReports.mock}
+	}
+	mmExportReports.defaultExpectation.results = &TransactionUsecaseMockExportReportsResults{resFile, err}
+	mmExportReports.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExportReports.mock
+}
+
+// Set uses given function f to mock the TransactionUsecase.ExportReports method
+func (mmExportReports *mTransactionUsecaseMockExportReports) Set(f func(ctx context.Context, format mm_usecase.ExportFormat, queryFilter mm_usecase.CountReportItemsQueryFilter) (resFile *mm_usecase.ExportFile, err error)) *TransactionUsecaseMock {
+	if mmExportReports.defaultExpectation != nil {
+		mmExportReports.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.ExportReports method")
+	}
+
+	if len(mmExportReports.expectations) > 0 {
+		mmExportReports.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.ExportReports method")
+	}
+
+	mmExportReports.mock.funcExportReports = f
+	mmExportReports.mock.funcExportReportsOrigin = minimock.CallerInfo(1)
+	return mmExportReports.mock
+}
+
+// When sets expectation for the TransactionUsecase.ExportReports which will trigger the result defined by the following
+// Then helper
+func (mmExportReports *mTransactionUsecaseMockExportReports) When(ctx context.Context, format mm_usecase.ExportFormat, queryFilter mm_usecase.CountReportItemsQueryFilter) *TransactionUsecaseMockExportReportsExpectation {
+	if mmExportReports.mock.funcExportReports != nil {
+		mmExportReports.mock.t.Fatalf("TransactionUsecaseMock.ExportReports mock is already set by Set")
+	}
+
+	expectation := &TransactionUsecaseMockExportReportsExpectation{
+		mock:               mmExportReports.mock,
+		params:             &TransactionUsecaseMockExportReportsParams{ctx, format, queryFilter},
+		expectationOrigins: TransactionUsecaseMockExportReportsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExportReports.expectations = append(mmExportReports.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TransactionUsecase.ExportReports return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockExportReportsExpectation) Then(resFile *mm_usecase.ExportFile, err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockExportReportsResults{resFile, err}
+	return e.mock
+}
+
+// Times sets number of times TransactionUsecase.ExportReports should be invoked
+func (mmExportReports *mTransactionUsecaseMockExportReports) Times(n uint64) *mTransactionUsecaseMockExportReports {
+	if n == 0 {
+		mmExportReports.mock.t.Fatalf("Times of TransactionUsecaseMock.ExportReports mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExportReports.expectedInvocations, n)
+	mmExportReports.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExportReports
+}
+
+func (mmExportReports *mTransactionUsecaseMockExportReports) invocationsDone() bool {
+	if len(mmExportReports.expectations) == 0 && mmExportReports.defaultExpectation == nil && mmExportReports.mock.funcExportReports == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExportReports.mock.afterExportReportsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExportReports.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExportReports implements mm_usecase.TransactionUsecase
+func (mmExportReports *TransactionUsecaseMock) ExportReports(ctx context.Context, format mm_usecase.ExportFormat, queryFilter mm_usecase.CountReportItemsQueryFilter) (resFile *mm_usecase.ExportFile, err error) {
+	mm_atomic.AddUint64(&mmExportReports.beforeExportReportsCounter, 1)
+	defer mm_atomic.AddUint64(&mmExportReports.afterExportReportsCounter, 1)
+
+	mmExportReports.t.Helper()
+
+	if mmExportReports.inspectFuncExportReports != nil {
+		mmExportReports.inspectFuncExportReports(ctx, format, queryFilter)
+	}
+
+	mm_params := TransactionUsecaseMockExportReportsParams{ctx, format, queryFilter}
+
+	// Record call args
+	mmExportReports.ExportReportsMock.mutex.Lock()
+	mmExportReports.ExportReportsMock.callArgs = append(mmExportReports.ExportReportsMock.callArgs, &mm_params)
+	mmExportReports.ExportReportsMock.mutex.Unlock()
+
+	for _, e := range mmExportReports.ExportReportsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resFile, e.results.err
+		}
+	}
+
+	if mmExportReports.ExportReportsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExportReports.ExportReportsMock.defaultExpectation.Counter, 1)
+		mm_want := mmExportReports.ExportReportsMock.defaultExpectation.params
+		mm_want_ptrs := mmExportReports.ExportReportsMock.defaultExpectation.paramPtrs
+
+		mm_got := TransactionUsecaseMockExportReportsParams{ctx, format, queryFilter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExportReports.t.Errorf("TransactionUsecaseMock.ExportReports got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportReports.ExportReportsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.format != nil && !minimock.Equal(*mm_want_ptrs.format, mm_got.format) {
+				mmExportReports.t.Errorf("TransactionUsecaseMock.ExportReports got unexpected parameter format, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportReports.ExportReportsMock.defaultExpectation.expectationOrigins.originFormat, *mm_want_ptrs.format, mm_got.format, minimock.Diff(*mm_want_ptrs.format, mm_got.format))
+			}
+
+			if mm_want_ptrs.queryFilter != nil && !minimock.Equal(*mm_want_ptrs.queryFilter, mm_got.queryFilter) {
+				mmExportReports.t.Errorf("TransactionUsecaseMock.ExportReports got unexpected parameter queryFilter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportReports.ExportReportsMock.defaultExpectation.expectationOrigins.originQueryFilter, *mm_want_ptrs.queryFilter, mm_got.queryFilter, minimock.Diff(*mm_want_ptrs.queryFilter, mm_got.queryFilter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExportReports.t.Errorf("TransactionUsecaseMock.ExportReports got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExportReports.ExportReportsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExportReports.ExportReportsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExportReports.t.Fatal("No results are set for the TransactionUsecaseMock.ExportReports")
+		}
+		return (*mm_results).resFile, (*mm_results).err
+	}
+	if mmExportReports.funcExportReports != nil {
+		return mmExportReports.funcExportReports(ctx, format, queryFilter)
+	}
+	mmExportReports.t.Fatalf("Unexpected call to TransactionUsecaseMock.ExportReports. %v %v %v", ctx, format, queryFilter)
+	return
+}
+
+// ExportReportsAfterCounter returns a count of finished TransactionUsecaseMock.ExportReports invocations
+func (mmExportReports *TransactionUsecaseMock) ExportReportsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportReports.afterExportReportsCounter)
+}
+
+// ExportReportsBeforeCounter returns a count of TransactionUsecaseMock.ExportReports invocations
+func (mmExportReports *TransactionUsecaseMock) ExportReportsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportReports.beforeExportReportsCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.ExportReports.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExportReports *mTransactionUsecaseMockExportReports) Calls() []*TransactionUsecaseMockExportReportsParams {
+	mmExportReports.mutex.RLock()
+
+	argCopy := make([]*TransactionUsecaseMockExportReportsParams, len(mmExportReports.callArgs))
+	copy(argCopy, mmExportReports.callArgs)
+
+	mmExportReports.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportReportsDone returns true if the count of the ExportReports invocations corresponds
+// the number of defined expectations
+func (m *TransactionUsecaseMock) MinimockExportReportsDone() bool {
+	if m.ExportReportsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExportReportsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExportReportsMock.invocationsDone()
+}
+
+// MinimockExportReportsInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockExportReportsInspect() {
+	for _, e := range m.ExportReportsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.ExportReports at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExportReportsCounter := mm_atomic.LoadUint64(&m.afterExportReportsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportReportsMock.defaultExpectation != nil && afterExportReportsCounter < 1 {
+		if m.ExportReportsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.ExportReports at\n%s", m.ExportReportsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.ExportReports at\n%s with params: %#v", m.ExportReportsMock.defaultExpectation.expectationOrigins.origin, *m.ExportReportsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExportReports != nil && afterExportReportsCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.ExportReports at\n%s", m.funcExportReportsOrigin)
+	}
+
+	if !m.ExportReportsMock.invocationsDone() && afterExportReportsCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.ExportReports at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExportReportsMock.expectedInvocations), m.ExportReportsMock.expectedInvocationsOrigin, afterExportReportsCounter)
+	}
+}
+
+type mTransactionUsecaseMockExportTransactions struct {
+	optional           bool
+	mock               *TransactionUsecaseMock
+	defaultExpectation *TransactionUsecaseMockExportTransactionsExpectation
+	expectations       []*TransactionUsecaseMockExportTransactionsExpectation
+
+	callArgs []*TransactionUsecaseMockExportTransactionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransactionUsecaseMockExportTransactionsExpectation specifies expectation struct of the TransactionUsecase.ExportTransactions
+type TransactionUsecaseMockExportTransactionsExpectation struct {
+	mock               *TransactionUsecaseMock
+	params             *TransactionUsecaseMockExportTransactionsParams
+	paramPtrs          *TransactionUsecaseMockExportTransactionsParamPtrs
+	expectationOrigins TransactionUsecaseMockExportTransactionsExpectationOrigins
+	results            *TransactionUsecaseMockExportTransactionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TransactionUsecaseMockExportTransactionsParams contains parameters of the TransactionUsecase.ExportTransactions
+type TransactionUsecaseMockExportTransactionsParams struct {
+	ctx         context.Context
+	format      mm_usecase.ExportFormat
+	listOptions *mm_usecase.TransactionListOptions
+}
+
+// TransactionUsecaseMockExportTransactionsParamPtrs contains pointers to parameters of the TransactionUsecase.ExportTransactions
+type TransactionUsecaseMockExportTransactionsParamPtrs struct {
+	ctx         *context.Context
+	format      *mm_usecase.ExportFormat
+	listOptions **mm_usecase.TransactionListOptions
+}
+
+// TransactionUsecaseMockExportTransactionsResults contains results of the TransactionUsecase.ExportTransactions
+type TransactionUsecaseMockExportTransactionsResults struct {
+	resFile *mm_usecase.ExportFile
+	total   uint64
+	err     error
+}
+
+// TransactionUsecaseMockExportTransactionsOrigins contains origins of expectations of the TransactionUsecase.ExportTransactions
+type TransactionUsecaseMockExportTransactionsExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originFormat      string
+	originListOptions string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExportTransactions *mTransactionUsecaseMockExportTransactions) Optional() *mTransactionUsecaseMockExportTransactions {
+	mmExportTransactions.optional = true
+	return mmExportTransactions
+}
+
+// Expect sets up expected params for TransactionUsecase.ExportTransactions
+func (mmExportTransactions *mTransactionUsecaseMockExportTransactions) Expect(ctx context.Context, format mm_usecase.ExportFormat, listOptions *mm_usecase.TransactionListOptions) *mTransactionUsecaseMockExportTransactions {
+	if mmExportTransactions.mock.funcExportTransactions != nil {
+		mmExportTransactions.mock.t.Fatalf("TransactionUsecaseMock.ExportTransactions mock is already set by Set")
+	}
+
+	if mmExportTransactions.defaultExpectation == nil {
+		mmExportTransactions.defaultExpectation = &TransactionUsecaseMockExportTransactionsExpectation{}
+	}
+
+	if mmExportTransactions.defaultExpectation.paramPtrs != nil {
+		mmExportTransactions.mock.t.Fatalf("TransactionUsecaseMock.ExportTransactions mock is already set by ExpectParams functions")
+	}
+
+	mmExportTransactions.defaultExpectation.params = &TransactionUsecaseMockExportTransactionsParams{ctx, format, listOptions}
+	mmExportTransactions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExportTransactions.expectations {
+		if minimock.Equal(e.params, mmExportTransactions.defaultExpectation.params) {
+			mmExportTransactions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExportTransactions.defaultExpectation.params)
+		}
+	}
+
+	return mmExportTransactions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.ExportTransactions
+func (mmExportTransactions *mTransactionUsecaseMockExportTransactions) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockExportTransactions {
+	if mmExportTransactions.mock.funcExportTransactions != nil {
+		mmExportTransactions.mock.t.Fatalf("TransactionUsecaseMock.ExportTransactions mock is already set by Set")
+	}
+
+	if mmExportTransactions.defaultExpectation == nil {
+		mmExportTransactions.defaultExpectation = &TransactionUsecaseMockExportTransactionsExpectation{}
+	}
+
+	if mmExportTransactions.defaultExpectation.params != nil {
+		mmExportTransactions.mock.t.Fatalf("TransactionUsecaseMock.ExportTransactions mock is already set by Expect")
+	}
+
+	if mmExportTransactions.defaultExpectation.paramPtrs == nil {
+		mmExportTransactions.defaultExpectation.paramPtrs = &TransactionUsecaseMockExportTransactionsParamPtrs{}
+	}
+	mmExportTransactions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExportTransactions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExportTransactions
+}
+
+// ExpectFormatParam2 sets up expected param format for TransactionUsecase.ExportTransactions
+func (mmExportTransactions *mTransactionUsecaseMockExportTransactions) ExpectFormatParam2(format mm_usecase.ExportFormat) *mTransactionUsecaseMockExportTransactions {
+	if mmExportTransactions.mock.funcExportTransactions != nil {
+		mmExportTransactions.mock.t.Fatalf("TransactionUsecaseMock.ExportTransactions mock is already set by Set")
+	}
+
+	if mmExportTransactions.defaultExpectation == nil {
+		mmExportTransactions.defaultExpectation = &TransactionUsecaseMockExportTransactionsExpectation{}
+	}
+
+	if mmExportTransactions.defaultExpectation.params != nil {
+		mmExportTransactions.mock.t.Fatalf("TransactionUsecaseMock.ExportTransactions mock is already set by Expect")
+	}
+
+	if mmExportTransactions.defaultExpectation.paramPtrs == nil {
+		mmExportTransactions.defaultExpectation.paramPtrs = &TransactionUsecaseMockExportTransactionsParamPtrs{}
+	}
+	mmExportTransactions.defaultExpectation.paramPtrs.format = &format
+	mmExportTransactions.defaultExpectation.expectationOrigins.originFormat = minimock.CallerInfo(1)
+
+	return mmExportTransactions
+}
+
+// ExpectListOptionsParam3 sets up expected param listOptions for TransactionUsecase.ExportTransactions
+func (mmExportTransactions *mTransactionUsecaseMockExportTransactions) ExpectListOptionsParam3(listOptions *mm_usecase.TransactionListOptions) *mTransactionUsecaseMockExportTransactions {
+	if mmExportTransactions.mock.funcExportTransactions != nil {
+		mmExportTransactions.mock.t.Fatalf("TransactionUsecaseMock.ExportTransactions mock is already set by Set")
+	}
+
+	if mmExportTransactions.defaultExpectation == nil {
+		mmExportTransactions.defaultExpectation = &TransactionUsecaseMockExportTransactionsExpectation{}
+	}
+
+	if mmExportTransactions.defaultExpectation.params != nil {
+		mmExportTransactions.mock.t.Fatalf("TransactionUsecaseMock.ExportTransactions mock is already set by Expect")
+	}
+
+	if mmExportTransactions.defaultExpectation.paramPtrs == nil {
+		mmExportTransactions.defaultExpectation.paramPtrs = &TransactionUsecaseMockExportTransactionsParamPtrs{}
+	}
+	mmExportTransactions.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmExportTransactions.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmExportTransactions
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.ExportTransactions
+func (mmExportTransactions *mTransactionUsecaseMockExportTransactions) Inspect(f func(ctx context.Context, format mm_usecase.ExportFormat, listOptions *mm_usecase.TransactionListOptions)) *mTransactionUsecaseMockExportTransactions {
+	if mmExportTransactions.mock.inspectFuncExportTransactions != nil {
+		mmExportTransactions.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.ExportTransactions")
+	}
+
+	mmExportTransactions.mock.inspectFuncExportTransactions = f
+
+	return mmExportTransactions
+}
+
+// Return sets up results that will be returned by TransactionUsecase.ExportTransactions
+func (mmExportTransactions *mTransactionUsecaseMockExportTransactions) Return(resFile *mm_usecase.ExportFile, total uint64, err error) *TransactionUsecaseMock {
+	if mmExportTransactions.mock.funcExportTransactions != nil {
+		mmExportTransactions.mock.t.Fatalf("TransactionUsecaseMock.ExportTransactions mock is already set by Set")
+	}
+
+	if mmExportTransactions.defaultExpectation == nil {
+		mmExportTransactions.defaultExpectation = &TransactionUsecaseMockExportTransactionsExpectation{mock: mmExportTransactions.mock}
+	}
+	mmExportTransactions.defaultExpectation.results = &TransactionUsecaseMockExportTransactionsResults{resFile, total, err}
+	mmExportTransactions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExportTransactions.mock
+}
+
+// Set uses given function f to mock the TransactionUsecase.ExportTransactions method
+func (mmExportTransactions *mTransactionUsecaseMockExportTransactions) Set(f func(ctx context.Context, format mm_usecase.ExportFormat, listOptions *mm_usecase.TransactionListOptions) (resFile *mm_usecase.ExportFile, total uint64, err error)) *TransactionUsecaseMock {
+	if mmExportTransactions.defaultExpectation != nil {
+		mmExportTransactions.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.ExportTransactions method")
+	}
+
+	if len(mmExportTransactions.expectations) > 0 {
+		mmExportTransactions.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.ExportTransactions method")
+	}
+
+	mmExportTransactions.mock.funcExportTransactions = f
+	mmExportTransactions.mock.funcExportTransactionsOrigin = minimock.CallerInfo(1)
+	return mmExportTransactions.mock
+}
+
+// When sets expectation for the TransactionUsecase.ExportTransactions which will trigger the result defined by the following
+// Then helper
+func (mmExportTransactions *mTransactionUsecaseMockExportTransactions) When(ctx context.Context, format mm_usecase.ExportFormat, listOptions *mm_usecase.TransactionListOptions) *TransactionUsecaseMockExportTransactionsExpectation {
+	if mmExportTransactions.mock.funcExportTransactions != nil {
+		mmExportTransactions.mock.t.Fatalf("TransactionUsecaseMock.ExportTransactions mock is already set by Set")
+	}
+
+	expectation := &TransactionUsecaseMockExportTransactionsExpectation{
+		mock:               mmExportTransactions.mock,
+		params:             &TransactionUsecaseMockExportTransactionsParams{ctx, format, listOptions},
+		expectationOrigins: TransactionUsecaseMockExportTransactionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExportTransactions.expectations = append(mmExportTransactions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TransactionUsecase.ExportTransactions return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockExportTransactionsExpectation) Then(resFile *mm_usecase.ExportFile, total uint64, err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockExportTransactionsResults{resFile, total, err}
+	return e.mock
+}
+
+// Times sets number of times TransactionUsecase.ExportTransactions should be invoked
+func (mmExportTransactions *mTransactionUsecaseMockExportTransactions) Times(n uint64) *mTransactionUsecaseMockExportTransactions {
+	if n == 0 {
+		mmExportTransactions.mock.t.Fatalf("Times of TransactionUsecaseMock.ExportTransactions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExportTransactions.expectedInvocations, n)
+	mmExportTransactions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExportTransactions
+}
+
+func (mmExportTransactions *mTransactionUsecaseMockExportTransactions) invocationsDone() bool {
+	if len(mmExportTransactions.expectations) == 0 && mmExportTransactions.defaultExpectation == nil && mmExportTransactions.mock.funcExportTransactions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExportTransactions.mock.afterExportTransactionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExportTransactions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExportTransactions implements mm_usecase.TransactionUsecase
+func (mmExportTransactions *TransactionUsecaseMock) ExportTransactions(ctx context.Context, format mm_usecase.ExportFormat, listOptions *mm_usecase.TransactionListOptions) (resFile *mm_usecase.ExportFile, total uint64, err error) {
+	mm_atomic.AddUint64(&mmExportTransactions.beforeExportTransactionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmExportTransactions.afterExportTransactionsCounter, 1)
+
+	mmExportTransactions.t.Helper()
+
+	if mmExportTransactions.inspectFuncExportTransactions != nil {
+		mmExportTransactions.inspectFuncExportTransactions(ctx, format, listOptions)
+	}
+
+	mm_params := TransactionUsecaseMockExportTransactionsParams{ctx, format, listOptions}
+
+	// Record call args
+	mmExportTransactions.ExportTransactionsMock.mutex.Lock()
+	mmExportTransactions.ExportTransactionsMock.callArgs = append(mmExportTransactions.ExportTransactionsMock.callArgs, &mm_params)
+	mmExportTransactions.ExportTransactionsMock.mutex.Unlock()
+
+	for _, e := range mmExportTransactions.ExportTransactionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resFile, e.results.total, e.results.err
+		}
+	}
+
+	if mmExportTransactions.ExportTransactionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExportTransactions.ExportTransactionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmExportTransactions.ExportTransactionsMock.defaultExpectation.params
+		mm_want_ptrs := mmExportTransactions.ExportTransactionsMock.defaultExpectation.paramPtrs
+
+		mm_got := TransactionUsecaseMockExportTransactionsParams{ctx, format, listOptions}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExportTransactions.t.Errorf("TransactionUsecaseMock.ExportTransactions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportTransactions.ExportTransactionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.format != nil && !minimock.Equal(*mm_want_ptrs.format, mm_got.format) {
+				mmExportTransactions.t.Errorf("TransactionUsecaseMock.ExportTransactions got unexpected parameter format, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportTransactions.ExportTransactionsMock.defaultExpectation.expectationOrigins.originFormat, *mm_want_ptrs.format, mm_got.format, minimock.Diff(*mm_want_ptrs.format, mm_got.format))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmExportTransactions.t.Errorf("TransactionUsecaseMock.ExportTransactions got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportTransactions.ExportTransactionsMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExportTransactions.t.Errorf("TransactionUsecaseMock.ExportTransactions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExportTransactions.ExportTransactionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExportTransactions.ExportTransactionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExportTransactions.t.Fatal("No results are set for the TransactionUsecaseMock.ExportTransactions")
+		}
+		return (*mm_results).resFile, (*mm_results).total, (*mm_results).err
+	}
+	if mmExportTransactions.funcExportTransactions != nil {
+		return mmExportTransactions.funcExportTransactions(ctx, format, listOptions)
+	}
+	mmExportTransactions.t.Fatalf("Unexpected call to TransactionUsecaseMock.ExportTransactions. %v %v %v", ctx, format, listOptions)
+	return
+}
+
+// ExportTransactionsAfterCounter returns a count of finished TransactionUsecaseMock.ExportTransactions invocations
+func (mmExportTransactions *TransactionUsecaseMock) ExportTransactionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportTransactions.afterExportTransactionsCounter)
+}
+
+// ExportTransactionsBeforeCounter returns a count of TransactionUsecaseMock.ExportTransactions invocations
+func (mmExportTransactions *TransactionUsecaseMock) ExportTransactionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportTransactions.beforeExportTransactionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.ExportTransactions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExportTransactions *mTransactionUsecaseMockExportTransactions) Calls() []*TransactionUsecaseMockExportTransactionsParams {
+	mmExportTransactions.mutex.RLock()
+
+	argCopy := make([]*TransactionUsecaseMockExportTransactionsParams, len(mmExportTransactions.callArgs))
+	copy(argCopy, mmExportTransactions.callArgs)
+
+	mmExportTransactions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportTransactionsDone returns true if the count of the ExportTransactions invocations corresponds
+// the number of defined expectations
+func (m *TransactionUsecaseMock) MinimockExportTransactionsDone() bool {
+	if m.ExportTransactionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExportTransactionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExportTransactionsMock.invocationsDone()
+}
+
+// MinimockExportTransactionsInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockExportTransactionsInspect() {
+	for _, e := range m.ExportTransactionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.ExportTransactions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExportTransactionsCounter := mm_atomic.LoadUint64(&m.afterExportTransactionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportTransactionsMock.defaultExpectation != nil && afterExportTransactionsCounter < 1 {
+		if m.ExportTransactionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.ExportTransactions at\n%s", m.ExportTransactionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.ExportTransactions at\n%s with params: %#v", m.ExportTransactionsMock.defaultExpectation.expectationOrigins.origin, *m.ExportTransactionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExportTransactions != nil && afterExportTransactionsCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.ExportTransactions at\n%s", m.funcExportTransactionsOrigin)
+	}
+
+	if !m.ExportTransactionsMock.invocationsDone() && afterExportTransactionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.ExportTransactions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExportTransactionsMock.expectedInvocations), m.ExportTransactionsMock.expectedInvocationsOrigin, afterExportTransactionsCounter)
+	}
+}
+
+type mTransactionUsecaseMockFindCursorList struct {
+	optional           bool
+	mock               *TransactionUsecaseMock
+	defaultExpectation *TransactionUsecaseMockFindCursorListExpectation
+	expectations       []*TransactionUsecaseMockFindCursorListExpectation
+
+	callArgs []*TransactionUsecaseMockFindCursorListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransactionUsecaseMockFindCursorListExpectation specifies expectation struct of the TransactionUsecase.FindCursorList
+type TransactionUsecaseMockFindCursorListExpectation struct {
+	mock               *TransactionUsecaseMock
+	params             *TransactionUsecaseMockFindCursorListParams
+	paramPtrs          *TransactionUsecaseMockFindCursorListParamPtrs
+	expectationOrigins TransactionUsecaseMockFindCursorListExpectationOrigins
+	results            *TransactionUsecaseMockFindCursorListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TransactionUsecaseMockFindCursorListParams contains parameters of the TransactionUsecase.FindCursorList
+type TransactionUsecaseMockFindCursorListParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.TransactionListOptions
+	limit       uint64
+	withTotal   bool
+}
+
+// TransactionUsecaseMockFindCursorListParamPtrs contains pointers to parameters of the TransactionUsecase.FindCursorList
+type TransactionUsecaseMockFindCursorListParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.TransactionListOptions
+	limit       *uint64
+	withTotal   *bool
+}
+
+// TransactionUsecaseMockFindCursorListResults contains results of the TransactionUsecase.FindCursorList
+type TransactionUsecaseMockFindCursorListResults struct {
+	resPage *mm_usecase.TransactionCursorPage
+	err     error
+}
+
+// TransactionUsecaseMockFindCursorListOrigins contains origins of expectations of the TransactionUsecase.FindCursorList
+type TransactionUsecaseMockFindCursorListExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originLimit       string
+	originWithTotal   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindCursorList *mTransactionUsecaseMockFindCursorList) Optional() *mTransactionUsecaseMockFindCursorList {
+	mmFindCursorList.optional = true
+	return mmFindCursorList
+}
+
+// Expect sets up expected params for TransactionUsecase.FindCursorList
+func (mmFindCursorList *mTransactionUsecaseMockFindCursorList) Expect(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, limit uint64, withTotal bool) *mTransactionUsecaseMockFindCursorList {
+	if mmFindCursorList.mock.funcFindCursorList != nil {
+		mmFindCursorList.mock.t.Fatalf("TransactionUsecaseMock.FindCursorList mock is already set by Set")
+	}
+
+	if mmFindCursorList.defaultExpectation == nil {
+		mmFindCursorList.defaultExpectation = &TransactionUsecaseMockFindCursorListExpectation{}
+	}
+
+	if mmFindCursorList.defaultExpectation.paramPtrs != nil {
+		mmFindCursorList.mock.t.Fatalf("TransactionUsecaseMock.FindCursorList mock is already set by ExpectParams functions")
+	}
+
+	mmFindCursorList.defaultExpectation.params = &TransactionUsecaseMockFindCursorListParams{ctx, listOptions, limit, withTotal}
+	mmFindCursorList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindCursorList.expectations {
+		if minimock.Equal(e.params, mmFindCursorList.defaultExpectation.params) {
+			mmFindCursorList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindCursorList.defaultExpectation.params)
+		}
+	}
+
+	return mmFindCursorList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.FindCursorList
+func (mmFindCursorList *mTransactionUsecaseMockFindCursorList) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockFindCursorList {
+	if mmFindCursorList.mock.funcFindCursorList != nil {
+		mmFindCursorList.mock.t.Fatalf("TransactionUsecaseMock.FindCursorList mock is already set by Set")
+	}
+
+	if mmFindCursorList.defaultExpectation == nil {
+		mmFindCursorList.defaultExpectation = &TransactionUsecaseMockFindCursorListExpectation{}
+	}
+
+	if mmFindCursorList.defaultExpectation.params != nil {
+		mmFindCursorList.mock.t.Fatalf("TransactionUsecaseMock.FindCursorList mock is already set by Expect")
+	}
+
+	if mmFindCursorList.defaultExpectation.paramPtrs == nil {
+		mmFindCursorList.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindCursorListParamPtrs{}
+	}
+	mmFindCursorList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindCursorList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindCursorList
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for TransactionUsecase.FindCursorList
+func (mmFindCursorList *mTransactionUsecaseMockFindCursorList) ExpectListOptionsParam2(listOptions *mm_usecase.TransactionListOptions) *mTransactionUsecaseMockFindCursorList {
+	if mmFindCursorList.mock.funcFindCursorList != nil {
+		mmFindCursorList.mock.t.Fatalf("TransactionUsecaseMock.FindCursorList mock is already set by Set")
+	}
+
+	if mmFindCursorList.defaultExpectation == nil {
+		mmFindCursorList.defaultExpectation = &TransactionUsecaseMockFindCursorListExpectation{}
+	}
+
+	if mmFindCursorList.defaultExpectation.params != nil {
+		mmFindCursorList.mock.t.Fatalf("TransactionUsecaseMock.FindCursorList mock is already set by Expect")
+	}
+
+	if mmFindCursorList.defaultExpectation.paramPtrs == nil {
+		mmFindCursorList.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindCursorListParamPtrs{}
+	}
+	mmFindCursorList.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmFindCursorList.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmFindCursorList
+}
+
+// ExpectLimitParam3 sets up expected param limit for TransactionUsecase.FindCursorList
+func (mmFindCursorList *mTransactionUsecaseMockFindCursorList) ExpectLimitParam3(limit uint64) *mTransactionUsecaseMockFindCursorList {
+	if mmFindCursorList.mock.funcFindCursorList != nil {
+		mmFindCursorList.mock.t.Fatalf("TransactionUsecaseMock.FindCursorList mock is already set by Set")
+	}
+
+	if mmFindCursorList.defaultExpectation == nil {
+		mmFindCursorList.defaultExpectation = &TransactionUsecaseMockFindCursorListExpectation{}
+	}
+
+	if mmFindCursorList.defaultExpectation.params != nil {
+		mmFindCursorList.mock.t.Fatalf("TransactionUsecaseMock.FindCursorList mock is already set by Expect")
+	}
+
+	if mmFindCursorList.defaultExpectation.paramPtrs == nil {
+		mmFindCursorList.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindCursorListParamPtrs{}
+	}
+	mmFindCursorList.defaultExpectation.paramPtrs.limit = &limit
+	mmFindCursorList.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmFindCursorList
+}
+
+// ExpectWithTotalParam4 sets up expected param withTotal for TransactionUsecase.FindCursorList
+func (mmFindCursorList *mTransactionUsecaseMockFindCursorList) ExpectWithTotalParam4(withTotal bool) *mTransactionUsecaseMockFindCursorList {
+	if mmFindCursorList.mock.funcFindCursorList != nil {
+		mmFindCursorList.mock.t.Fatalf("TransactionUsecaseMock.FindCursorList mock is already set by Set")
+	}
+
+	if mmFindCursorList.defaultExpectation == nil {
+		mmFindCursorList.defaultExpectation = &TransactionUsecaseMockFindCursorListExpectation{}
+	}
+
+	if mmFindCursorList.defaultExpectation.params != nil {
+		mmFindCursorList.mock.t.Fatalf("TransactionUsecaseMock.FindCursorList mock is already set by Expect")
+	}
+
+	if mmFindCursorList.defaultExpectation.paramPtrs == nil {
+		mmFindCursorList.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindCursorListParamPtrs{}
+	}
+	mmFindCursorList.defaultExpectation.paramPtrs.withTotal = &withTotal
+	mmFindCursorList.defaultExpectation.expectationOrigins.originWithTotal = minimock.CallerInfo(1)
+
+	return mmFindCursorList
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.FindCursorList
+func (mmFindCursorList *mTransactionUsecaseMockFindCursorList) Inspect(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, limit uint64, withTotal bool)) *mTransactionUsecaseMockFindCursorList {
+	if mmFindCursorList.mock.inspectFuncFindCursorList != nil {
+		mmFindCursorList.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.FindCursorList")
+	}
+
+	mmFindCursorList.mock.inspectFuncFindCursorList = f
+
+	return mmFindCursorList
+}
+
+// Return sets up results that will be returned by TransactionUsecase.FindCursorList
+func (mmFindCursorList *mTransactionUsecaseMockFindCursorList) Return(resPage *mm_usecase.TransactionCursorPage, err error) *TransactionUsecaseMock {
+	if mmFindCursorList.mock.funcFindCursorList != nil {
+		mmFindCursorList.mock.t.Fatalf("TransactionUsecaseMock.FindCursorList mock is already set by Set")
+	}
+
+	if mmFindCursorList.defaultExpectation == nil {
+		mmFindCursorList.defaultExpectation = &TransactionUsecaseMockFindCursorListExpectation{mock: mmFindCursorList.mock}
+	}
+	mmFindCursorList.defaultExpectation.results = &TransactionUsecaseMockFindCursorListResults{resPage, err}
+	mmFindCursorList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindCursorList.mock
+}
+
+// Set uses given function f to mock the TransactionUsecase.FindCursorList method
+func (mmFindCursorList *mTransactionUsecaseMockFindCursorList) Set(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, limit uint64, withTotal bool) (resPage *mm_usecase.TransactionCursorPage, err error)) *TransactionUsecaseMock {
+	if mmFindCursorList.defaultExpectation != nil {
+		mmFindCursorList.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.FindCursorList method")
+	}
+
+	if len(mmFindCursorList.expectations) > 0 {
+		mmFindCursorList.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.FindCursorList method")
+	}
+
+	mmFindCursorList.mock.funcFindCursorList = f
+	mmFindCursorList.mock.funcFindCursorListOrigin = minimock.CallerInfo(1)
+	return mmFindCursorList.mock
+}
+
+// When sets expectation for the TransactionUsecase.FindCursorList which will trigger the result defined by the following
+// Then helper
+func (mmFindCursorList *mTransactionUsecaseMockFindCursorList) When(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, limit uint64, withTotal bool) *TransactionUsecaseMockFindCursorListExpectation {
+	if mmFindCursorList.mock.funcFindCursorList != nil {
+		mmFindCursorList.mock.t.Fatalf("TransactionUsecaseMock.FindCursorList mock is already set by Set")
+	}
+
+	expectation := &TransactionUsecaseMockFindCursorListExpectation{
+		mock:               mmFindCursorList.mock,
+		params:             &TransactionUsecaseMockFindCursorListParams{ctx, listOptions, limit, withTotal},
+		expectationOrigins: TransactionUsecaseMockFindCursorListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindCursorList.expectations = append(mmFindCursorList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TransactionUsecase.FindCursorList return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockFindCursorListExpectation) Then(resPage *mm_usecase.TransactionCursorPage, err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockFindCursorListResults{resPage, err}
+	return e.mock
+}
+
+// Times sets number of times TransactionUsecase.FindCursorList should be invoked
+func (mmFindCursorList *mTransactionUsecaseMockFindCursorList) Times(n uint64) *mTransactionUsecaseMockFindCursorList {
+	if n == 0 {
+		mmFindCursorList.mock.t.Fatalf("Times of TransactionUsecaseMock.FindCursorList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindCursorList.expectedInvocations, n)
+	mmFindCursorList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindCursorList
+}
+
+func (mmFindCursorList *mTransactionUsecaseMockFindCursorList) invocationsDone() bool {
+	if len(mmFindCursorList.expectations) == 0 && mmFindCursorList.defaultExpectation == nil && mmFindCursorList.mock.funcFindCursorList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindCursorList.mock.afterFindCursorListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindCursorList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindCursorList implements mm_usecase.TransactionUsecase
+func (mmFindCursorList *TransactionUsecaseMock) FindCursorList(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, limit uint64, withTotal bool) (resPage *mm_usecase.TransactionCursorPage, err error) {
+	mm_atomic.AddUint64(&mmFindCursorList.beforeFindCursorListCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindCursorList.afterFindCursorListCounter, 1)
+
+	mmFindCursorList.t.Helper()
+
+	if mmFindCursorList.inspectFuncFindCursorList != nil {
+		mmFindCursorList.inspectFuncFindCursorList(ctx, listOptions, limit, withTotal)
+	}
+
+	mm_params := TransactionUsecaseMockFindCursorListParams{ctx, listOptions, limit, withTotal}
+
+	// Record call args
+	mmFindCursorList.FindCursorListMock.mutex.Lock()
+	mmFindCursorList.FindCursorListMock.callArgs = append(mmFindCursorList.FindCursorListMock.callArgs, &mm_params)
+	mmFindCursorList.FindCursorListMock.mutex.Unlock()
+
+	for _, e := range mmFindCursorList.FindCursorListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resPage, e.results.err
+		}
+	}
+
+	if mmFindCursorList.FindCursorListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindCursorList.FindCursorListMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindCursorList.FindCursorListMock.defaultExpectation.params
+		mm_want_ptrs := mmFindCursorList.FindCursorListMock.defaultExpectation.paramPtrs
+
+		mm_got := TransactionUsecaseMockFindCursorListParams{ctx, listOptions, limit, withTotal}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindCursorList.t.Errorf("TransactionUsecaseMock.FindCursorList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindCursorList.FindCursorListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmFindCursorList.t.Errorf("TransactionUsecaseMock.FindCursorList got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindCursorList.FindCursorListMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmFindCursorList.t.Errorf("TransactionUsecaseMock.FindCursorList got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindCursorList.FindCursorListMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+			if mm_want_ptrs.withTotal != nil && !minimock.Equal(*mm_want_ptrs.withTotal, mm_got.withTotal) {
+				mmFindCursorList.t.Errorf("TransactionUsecaseMock.FindCursorList got unexpected parameter withTotal, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindCursorList.FindCursorListMock.defaultExpectation.expectationOrigins.originWithTotal, *mm_want_ptrs.withTotal, mm_got.withTotal, minimock.Diff(*mm_want_ptrs.withTotal, mm_got.withTotal))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindCursorList.t.Errorf("TransactionUsecaseMock.FindCursorList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindCursorList.FindCursorListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindCursorList.FindCursorListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindCursorList.t.Fatal("No results are set for the TransactionUsecaseMock.FindCursorList")
+		}
+		return (*mm_results).resPage, (*mm_results).err
+	}
+	if mmFindCursorList.funcFindCursorList != nil {
+		return mmFindCursorList.funcFindCursorList(ctx, listOptions, limit, withTotal)
+	}
+	mmFindCursorList.t.Fatalf("Unexpected call to TransactionUsecaseMock.FindCursorList. %v %v %v %v", ctx, listOptions, limit, withTotal)
+	return
+}
+
+// FindCursorListAfterCounter returns a count of finished TransactionUsecaseMock.FindCursorList invocations
+func (mmFindCursorList *TransactionUsecaseMock) FindCursorListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindCursorList.afterFindCursorListCounter)
+}
+
+// FindCursorListBeforeCounter returns a count of TransactionUsecaseMock.FindCursorList invocations
+func (mmFindCursorList *TransactionUsecaseMock) FindCursorListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindCursorList.beforeFindCursorListCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.FindCursorList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindCursorList *mTransactionUsecaseMockFindCursorList) Calls() []*TransactionUsecaseMockFindCursorListParams {
+	mmFindCursorList.mutex.RLock()
+
+	argCopy := make([]*TransactionUsecaseMockFindCursorListParams, len(mmFindCursorList.callArgs))
+	copy(argCopy, mmFindCursorList.callArgs)
+
+	mmFindCursorList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindCursorListDone returns true if the count of the FindCursorList invocations corresponds
+// the number of defined expectations
+func (m *TransactionUsecaseMock) MinimockFindCursorListDone() bool {
+	if m.FindCursorListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindCursorListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindCursorListMock.invocationsDone()
+}
+
+// MinimockFindCursorListInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockFindCursorListInspect() {
+	for _, e := range m.FindCursorListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindCursorList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindCursorListCounter := mm_atomic.LoadUint64(&m.afterFindCursorListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindCursorListMock.defaultExpectation != nil && afterFindCursorListCounter < 1 {
+		if m.FindCursorListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindCursorList at\n%s", m.FindCursorListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindCursorList at\n%s with params: %#v", m.FindCursorListMock.defaultExpectation.expectationOrigins.origin, *m.FindCursorListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindCursorList != nil && afterFindCursorListCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.FindCursorList at\n%s", m.funcFindCursorListOrigin)
+	}
+
+	if !m.FindCursorListMock.invocationsDone() && afterFindCursorListCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.FindCursorList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindCursorListMock.expectedInvocations), m.FindCursorListMock.expectedInvocationsOrigin, afterFindCursorListCounter)
+	}
+}
+
+type mTransactionUsecaseMockFindList struct {
+	optional           bool
+	mock               *TransactionUsecaseMock
+	defaultExpectation *TransactionUsecaseMockFindListExpectation
+	expectations       []*TransactionUsecaseMockFindListExpectation
+
+	callArgs []*TransactionUsecaseMockFindListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransactionUsecaseMockFindListExpectation specifies expectation struct of the TransactionUsecase.FindList
+type TransactionUsecaseMockFindListExpectation struct {
+	mock               *TransactionUsecaseMock
+	params             *TransactionUsecaseMockFindListParams
+	paramPtrs          *TransactionUsecaseMockFindListParamPtrs
+	expectationOrigins TransactionUsecaseMockFindListExpectationOrigins
+	results            *TransactionUsecaseMockFindListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TransactionUsecaseMockFindListParams contains parameters of the TransactionUsecase.FindList
+type TransactionUsecaseMockFindListParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.TransactionListOptions
+	queryParams *uctypes.QueryGetListParams
+}
+
+// TransactionUsecaseMockFindListParamPtrs contains pointers to parameters of the TransactionUsecase.FindList
+type TransactionUsecaseMockFindListParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.TransactionListOptions
+	queryParams **uctypes.QueryGetListParams
+}
+
+// TransactionUsecaseMockFindListResults contains results of the TransactionUsecase.FindList
+type TransactionUsecaseMockFindListResults struct {
+	resItems []*mm_usecase.TransactionDTO
+	err      error
+}
+
+// TransactionUsecaseMockFindListOrigins contains origins of expectations of the TransactionUsecase.FindList
+type TransactionUsecaseMockFindListExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindList *mTransactionUsecaseMockFindList) Optional() *mTransactionUsecaseMockFindList {
+	mmFindList.optional = true
+	return mmFindList
+}
+
+// Expect sets up expected params for TransactionUsecase.FindList
+func (mmFindList *mTransactionUsecaseMockFindList) Expect(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) *mTransactionUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &TransactionUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs != nil {
+		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by ExpectParams functions")
+	}
+
+	mmFindList.defaultExpectation.params = &TransactionUsecaseMockFindListParams{ctx, listOptions, queryParams}
+	mmFindList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindList.expectations {
+		if minimock.Equal(e.params, mmFindList.defaultExpectation.params) {
+			mmFindList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindList.defaultExpectation.params)
+		}
+	}
+
+	return mmFindList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.FindList
+func (mmFindList *mTransactionUsecaseMockFindList) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &TransactionUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for TransactionUsecase.FindList
+func (mmFindList *mTransactionUsecaseMockFindList) ExpectListOptionsParam2(listOptions *mm_usecase.TransactionListOptions) *mTransactionUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &TransactionUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmFindList.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for TransactionUsecase.FindList
+func (mmFindList *mTransactionUsecaseMockFindList) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mTransactionUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &TransactionUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindList.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.FindList
+func (mmFindList *mTransactionUsecaseMockFindList) Inspect(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams)) *mTransactionUsecaseMockFindList {
+	if mmFindList.mock.inspectFuncFindList != nil {
+		mmFindList.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.FindList")
+	}
+
+	mmFindList.mock.inspectFuncFindList = f
+
+	return mmFindList
+}
+
+// Return sets up results that will be returned by TransactionUsecase.FindList
+func (mmFindList *mTransactionUsecaseMockFindList) Return(resItems []*mm_usecase.TransactionDTO, err error) *TransactionUsecaseMock {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &TransactionUsecaseMockFindListExpectation{mock: mmFindList.mock}
+	}
+	mmFindList.defaultExpectation.results = &TransactionUsecaseMockFindListResults{resItems, err}
+	mmFindList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// Set uses given function f to mock the TransactionUsecase.FindList method
+func (mmFindList *mTransactionUsecaseMockFindList) Set(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.TransactionDTO, err error)) *TransactionUsecaseMock {
+	if mmFindList.defaultExpectation != nil {
+		mmFindList.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.FindList method")
+	}
+
+	if len(mmFindList.expectations) > 0 {
+		mmFindList.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.FindList method")
+	}
+
+	mmFindList.mock.funcFindList = f
+	mmFindList.mock.funcFindListOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// When sets expectation for the TransactionUsecase.FindList which will trigger the result defined by the following
+// Then helper
+func (mmFindList *mTransactionUsecaseMockFindList) When(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) *TransactionUsecaseMockFindListExpectation {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("TransactionUsecaseMock.FindList mock is already set by Set")
+	}
+
+	expectation := &TransactionUsecaseMockFindListExpectation{
+		mock:               mmFindList.mock,
+		params:             &TransactionUsecaseMockFindListParams{ctx, listOptions, queryParams},
+		expectationOrigins: TransactionUsecaseMockFindListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindList.expectations = append(mmFindList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TransactionUsecase.FindList return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockFindListExpectation) Then(resItems []*mm_usecase.TransactionDTO, err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockFindListResults{resItems, err}
+	return e.mock
+}
+
+// Times sets number of times TransactionUsecase.FindList should be invoked
+func (mmFindList *mTransactionUsecaseMockFindList) Times(n uint64) *mTransactionUsecaseMockFindList {
+	if n == 0 {
+		mmFindList.mock.t.Fatalf("Times of TransactionUsecaseMock.FindList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindList.expectedInvocations, n)
+	mmFindList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindList
+}
+
+func (mmFindList *mTransactionUsecaseMockFindList) invocationsDone() bool {
+	if len(mmFindList.expectations) == 0 && mmFindList.defaultExpectation == nil && mmFindList.mock.funcFindList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindList.mock.afterFindListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindList implements mm_usecase.TransactionUsecase
+func (mmFindList *TransactionUsecaseMock) FindList(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.TransactionDTO, err error) {
+	mm_atomic.AddUint64(&mmFindList.beforeFindListCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindList.afterFindListCounter, 1)
+
+	mmFindList.t.Helper()
+
+	if mmFindList.inspectFuncFindList != nil {
+		mmFindList.inspectFuncFindList(ctx, listOptions, queryParams)
+	}
+
+	mm_params := TransactionUsecaseMockFindListParams{ctx, listOptions, queryParams}
+
+	// Record call args
+	mmFindList.FindListMock.mutex.Lock()
+	mmFindList.FindListMock.callArgs = append(mmFindList.FindListMock.callArgs, &mm_params)
+	mmFindList.FindListMock.mutex.Unlock()
+
+	for _, e := range mmFindList.FindListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resItems, e.results.err
+		}
+	}
+
+	if mmFindList.FindListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindList.FindListMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindList.FindListMock.defaultExpectation.params
+		mm_want_ptrs := mmFindList.FindListMock.defaultExpectation.paramPtrs
+
+		mm_got := TransactionUsecaseMockFindListParams{ctx, listOptions, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindList.t.Errorf("TransactionUsecaseMock.FindList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmFindList.t.Errorf("TransactionUsecaseMock.FindList got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindList.t.Errorf("TransactionUsecaseMock.FindList got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindList.t.Errorf("TransactionUsecaseMock.FindList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindList.FindListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindList.FindListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindList.t.Fatal("No results are set for the TransactionUsecaseMock.FindList")
+		}
+		return (*mm_results).resItems, (*mm_results).err
+	}
+	if mmFindList.funcFindList != nil {
+		return mmFindList.funcFindList(ctx, listOptions, queryParams)
+	}
+	mmFindList.t.Fatalf("Unexpected call to TransactionUsecaseMock.FindList. %v %v %v", ctx, listOptions, queryParams)
+	return
+}
+
+// FindListAfterCounter returns a count of finished TransactionUsecaseMock.FindList invocations
+func (mmFindList *TransactionUsecaseMock) FindListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.afterFindListCounter)
+}
+
+// FindListBeforeCounter returns a count of TransactionUsecaseMock.FindList invocations
+func (mmFindList *TransactionUsecaseMock) FindListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.beforeFindListCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.FindList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindList *mTransactionUsecaseMockFindList) Calls() []*TransactionUsecaseMockFindListParams {
+	mmFindList.mutex.RLock()
+
+	argCopy := make([]*TransactionUsecaseMockFindListParams, len(mmFindList.callArgs))
+	copy(argCopy, mmFindList.callArgs)
+
+	mmFindList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindListDone returns true if the count of the FindList invocations corresponds
+// the number of defined expectations
+func (m *TransactionUsecaseMock) MinimockFindListDone() bool {
+	if m.FindListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindListMock.invocationsDone()
+}
+
+// MinimockFindListInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockFindListInspect() {
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindListCounter := mm_atomic.LoadUint64(&m.afterFindListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindListMock.defaultExpectation != nil && afterFindListCounter < 1 {
+		if m.FindListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindList at\n%s", m.FindListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindList at\n%s with params: %#v", m.FindListMock.defaultExpectation.expectationOrigins.origin, *m.FindListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindList != nil && afterFindListCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.FindList at\n%s", m.funcFindListOrigin)
+	}
+
+	if !m.FindListMock.invocationsDone() && afterFindListCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.FindList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindListMock.expectedInvocations), m.FindListMock.expectedInvocationsOrigin, afterFindListCounter)
+	}
+}
+
+type mTransactionUsecaseMockFindListInMap struct {
+	optional           bool
+	mock               *TransactionUsecaseMock
+	defaultExpectation *TransactionUsecaseMockFindListInMapExpectation
+	expectations       []*TransactionUsecaseMockFindListInMapExpectation
+
+	callArgs []*TransactionUsecaseMockFindListInMapParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransactionUsecaseMockFindListInMapExpectation specifies expectation struct of the TransactionUsecase.FindListInMap
+type TransactionUsecaseMockFindListInMapExpectation struct {
+	mock               *TransactionUsecaseMock
+	params             *TransactionUsecaseMockFindListInMapParams
+	paramPtrs          *TransactionUsecaseMockFindListInMapParamPtrs
+	expectationOrigins TransactionUsecaseMockFindListInMapExpectationOrigins
+	results            *TransactionUsecaseMockFindListInMapResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TransactionUsecaseMockFindListInMapParams contains parameters of the TransactionUsecase.FindListInMap
+type TransactionUsecaseMockFindListInMapParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.TransactionListOptions
+	queryParams *uctypes.QueryGetListParams
+}
+
+// TransactionUsecaseMockFindListInMapParamPtrs contains pointers to parameters of the TransactionUsecase.FindListInMap
+type TransactionUsecaseMockFindListInMapParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.TransactionListOptions
+	queryParams **uctypes.QueryGetListParams
+}
+
+// TransactionUsecaseMockFindListInMapResults contains results of the TransactionUsecase.FindListInMap
+type TransactionUsecaseMockFindListInMapResults struct {
+	resItems map[uuid.UUID]*mm_usecase.TransactionDTO
+	err      error
+}
+
+// TransactionUsecaseMockFindListInMapOrigins contains origins of expectations of the TransactionUsecase.FindListInMap
+type TransactionUsecaseMockFindListInMapExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) Optional() *mTransactionUsecaseMockFindListInMap {
+	mmFindListInMap.optional = true
+	return mmFindListInMap
+}
+
+// Expect sets up expected params for TransactionUsecase.FindListInMap
+func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) Expect(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) *mTransactionUsecaseMockFindListInMap {
+	if mmFindListInMap.mock.funcFindListInMap != nil {
+		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by Set")
+	}
+
+	if mmFindListInMap.defaultExpectation == nil {
+		mmFindListInMap.defaultExpectation = &TransactionUsecaseMockFindListInMapExpectation{}
+	}
+
+	if mmFindListInMap.defaultExpectation.paramPtrs != nil {
+		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by ExpectParams functions")
+	}
+
+	mmFindListInMap.defaultExpectation.params = &TransactionUsecaseMockFindListInMapParams{ctx, listOptions, queryParams}
+	mmFindListInMap.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindListInMap.expectations {
+		if minimock.Equal(e.params, mmFindListInMap.defaultExpectation.params) {
+			mmFindListInMap.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindListInMap.defaultExpectation.params)
+		}
+	}
+
+	return mmFindListInMap
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.FindListInMap
+func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockFindListInMap {
+	if mmFindListInMap.mock.funcFindListInMap != nil {
+		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by Set")
+	}
+
+	if mmFindListInMap.defaultExpectation == nil {
+		mmFindListInMap.defaultExpectation = &TransactionUsecaseMockFindListInMapExpectation{}
+	}
+
+	if mmFindListInMap.defaultExpectation.params != nil {
+		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by Expect")
+	}
+
+	if mmFindListInMap.defaultExpectation.paramPtrs == nil {
+		mmFindListInMap.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindListInMapParamPtrs{}
+	}
+	mmFindListInMap.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindListInMap.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindListInMap
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for TransactionUsecase.FindListInMap
+func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) ExpectListOptionsParam2(listOptions *mm_usecase.TransactionListOptions) *mTransactionUsecaseMockFindListInMap {
+	if mmFindListInMap.mock.funcFindListInMap != nil {
+		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by Set")
+	}
+
+	if mmFindListInMap.defaultExpectation == nil {
+		mmFindListInMap.defaultExpectation = &TransactionUsecaseMockFindListInMapExpectation{}
+	}
+
+	if mmFindListInMap.defaultExpectation.params != nil {
+		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by Expect")
+	}
+
+	if mmFindListInMap.defaultExpectation.paramPtrs == nil {
+		mmFindListInMap.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindListInMapParamPtrs{}
+	}
+	mmFindListInMap.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmFindListInMap.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmFindListInMap
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for TransactionUsecase.FindListInMap
+func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mTransactionUsecaseMockFindListInMap {
+	if mmFindListInMap.mock.funcFindListInMap != nil {
+		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by Set")
+	}
+
+	if mmFindListInMap.defaultExpectation == nil {
+		mmFindListInMap.defaultExpectation = &TransactionUsecaseMockFindListInMapExpectation{}
+	}
+
+	if mmFindListInMap.defaultExpectation.params != nil {
+		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by Expect")
+	}
+
+	if mmFindListInMap.defaultExpectation.paramPtrs == nil {
+		mmFindListInMap.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindListInMapParamPtrs{}
+	}
+	mmFindListInMap.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindListInMap.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindListInMap
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.FindListInMap
+func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) Inspect(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams)) *mTransactionUsecaseMockFindListInMap {
+	if mmFindListInMap.mock.inspectFuncFindListInMap != nil {
+		mmFindListInMap.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.FindListInMap")
+	}
+
+	mmFindListInMap.mock.inspectFuncFindListInMap = f
+
+	return mmFindListInMap
+}
+
+// Return sets up results that will be returned by TransactionUsecase.FindListInMap
+func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) Return(resItems map[uuid.UUID]*mm_usecase.TransactionDTO, err error) *TransactionUsecaseMock {
+	if mmFindListInMap.mock.funcFindListInMap != nil {
+		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by Set")
+	}
+
+	if mmFindListInMap.defaultExpectation == nil {
+		mmFindListInMap.defaultExpectation = &TransactionUsecaseMockFindListInMapExpectation{mock: mmFindListInMap.mock}
+	}
+	mmFindListInMap.defaultExpectation.results = &TransactionUsecaseMockFindListInMapResults{resItems, err}
+	mmFindListInMap.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindListInMap.mock
+}
+
+// Set uses given function f to mock the TransactionUsecase.FindListInMap method
+func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) Set(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) (resItems map[uuid.UUID]*mm_usecase.TransactionDTO, err error)) *TransactionUsecaseMock {
+	if mmFindListInMap.defaultExpectation != nil {
+		mmFindListInMap.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.FindListInMap method")
+	}
+
+	if len(mmFindListInMap.expectations) > 0 {
+		mmFindListInMap.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.FindListInMap method")
+	}
+
+	mmFindListInMap.mock.funcFindListInMap = f
+	mmFindListInMap.mock.funcFindListInMapOrigin = minimock.CallerInfo(1)
+	return mmFindListInMap.mock
+}
+
+// When sets expectation for the TransactionUsecase.FindListInMap which will trigger the result defined by the following
+// Then helper
+func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) When(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) *TransactionUsecaseMockFindListInMapExpectation {
+	if mmFindListInMap.mock.funcFindListInMap != nil {
+		mmFindListInMap.mock.t.Fatalf("TransactionUsecaseMock.FindListInMap mock is already set by Set")
+	}
+
+	expectation := &TransactionUsecaseMockFindListInMapExpectation{
+		mock:               mmFindListInMap.mock,
+		params:             &TransactionUsecaseMockFindListInMapParams{ctx, listOptions, queryParams},
+		expectationOrigins: TransactionUsecaseMockFindListInMapExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindListInMap.expectations = append(mmFindListInMap.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TransactionUsecase.FindListInMap return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockFindListInMapExpectation) Then(resItems map[uuid.UUID]*mm_usecase.TransactionDTO, err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockFindListInMapResults{resItems, err}
+	return e.mock
+}
+
+// Times sets number of times TransactionUsecase.FindListInMap should be invoked
+func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) Times(n uint64) *mTransactionUsecaseMockFindListInMap {
+	if n == 0 {
+		mmFindListInMap.mock.t.Fatalf("Times of TransactionUsecaseMock.FindListInMap mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindListInMap.expectedInvocations, n)
+	mmFindListInMap.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindListInMap
+}
+
+func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) invocationsDone() bool {
+	if len(mmFindListInMap.expectations) == 0 && mmFindListInMap.defaultExpectation == nil && mmFindListInMap.mock.funcFindListInMap == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindListInMap.mock.afterFindListInMapCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindListInMap.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindListInMap implements mm_usecase.TransactionUsecase
+func (mmFindListInMap *TransactionUsecaseMock) FindListInMap(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) (resItems map[uuid.UUID]*mm_usecase.TransactionDTO, err error) {
+	mm_atomic.AddUint64(&mmFindListInMap.beforeFindListInMapCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindListInMap.afterFindListInMapCounter, 1)
+
+	mmFindListInMap.t.Helper()
+
+	if mmFindListInMap.inspectFuncFindListInMap != nil {
+		mmFindListInMap.inspectFuncFindListInMap(ctx, listOptions, queryParams)
+	}
+
+	mm_params := TransactionUsecaseMockFindListInMapParams{ctx, listOptions, queryParams}
+
+	// Record call args
+	mmFindListInMap.FindListInMapMock.mutex.Lock()
+	mmFindListInMap.FindListInMapMock.callArgs = append(mmFindListInMap.FindListInMapMock.callArgs, &mm_params)
+	mmFindListInMap.FindListInMapMock.mutex.Unlock()
+
+	for _, e := range mmFindListInMap.FindListInMapMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resItems, e.results.err
+		}
+	}
+
+	if mmFindListInMap.FindListInMapMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindListInMap.FindListInMapMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindListInMap.FindListInMapMock.defaultExpectation.params
+		mm_want_ptrs := mmFindListInMap.FindListInMapMock.defaultExpectation.paramPtrs
+
+		mm_got := TransactionUsecaseMockFindListInMapParams{ctx, listOptions, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindListInMap.t.Errorf("TransactionUsecaseMock.FindListInMap got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindListInMap.FindListInMapMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmFindListInMap.t.Errorf("TransactionUsecaseMock.FindListInMap got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindListInMap.FindListInMapMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindListInMap.t.Errorf("TransactionUsecaseMock.FindListInMap got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindListInMap.FindListInMapMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindListInMap.t.Errorf("TransactionUsecaseMock.FindListInMap got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindListInMap.FindListInMapMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindListInMap.FindListInMapMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindListInMap.t.Fatal("No results are set for the TransactionUsecaseMock.FindListInMap")
+		}
+		return (*mm_results).resItems, (*mm_results).err
+	}
+	if mmFindListInMap.funcFindListInMap != nil {
+		return mmFindListInMap.funcFindListInMap(ctx, listOptions, queryParams)
+	}
+	mmFindListInMap.t.Fatalf("Unexpected call to TransactionUsecaseMock.FindListInMap. %v %v %v", ctx, listOptions, queryParams)
+	return
+}
+
+// FindListInMapAfterCounter returns a count of finished TransactionUsecaseMock.FindListInMap invocations
+func (mmFindListInMap *TransactionUsecaseMock) FindListInMapAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindListInMap.afterFindListInMapCounter)
+}
+
+// FindListInMapBeforeCounter returns a count of TransactionUsecaseMock.FindListInMap invocations
+func (mmFindListInMap *TransactionUsecaseMock) FindListInMapBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindListInMap.beforeFindListInMapCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.FindListInMap.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindListInMap *mTransactionUsecaseMockFindListInMap) Calls() []*TransactionUsecaseMockFindListInMapParams {
+	mmFindListInMap.mutex.RLock()
+
+	argCopy := make([]*TransactionUsecaseMockFindListInMapParams, len(mmFindListInMap.callArgs))
+	copy(argCopy, mmFindListInMap.callArgs)
+
+	mmFindListInMap.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindListInMapDone returns true if the count of the FindListInMap invocations corresponds
+// the number of defined expectations
+func (m *TransactionUsecaseMock) MinimockFindListInMapDone() bool {
+	if m.FindListInMapMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindListInMapMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindListInMapMock.invocationsDone()
+}
+
+// MinimockFindListInMapInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockFindListInMapInspect() {
+	for _, e := range m.FindListInMapMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindListInMap at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindListInMapCounter := mm_atomic.LoadUint64(&m.afterFindListInMapCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindListInMapMock.defaultExpectation != nil && afterFindListInMapCounter < 1 {
+		if m.FindListInMapMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindListInMap at\n%s", m.FindListInMapMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindListInMap at\n%s with params: %#v", m.FindListInMapMock.defaultExpectation.expectationOrigins.origin, *m.FindListInMapMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindListInMap != nil && afterFindListInMapCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.FindListInMap at\n%s", m.funcFindListInMapOrigin)
+	}
+
+	if !m.FindListInMapMock.invocationsDone() && afterFindListInMapCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.FindListInMap at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindListInMapMock.expectedInvocations), m.FindListInMapMock.expectedInvocationsOrigin, afterFindListInMapCounter)
+	}
+}
+
+type mTransactionUsecaseMockFindOneByID struct {
+	optional           bool
+	mock               *TransactionUsecaseMock
+	defaultExpectation *TransactionUsecaseMockFindOneByIDExpectation
+	expectations       []*TransactionUsecaseMockFindOneByIDExpectation
+
+	callArgs []*TransactionUsecaseMockFindOneByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransactionUsecaseMockFindOneByIDExpectation specifies expectation struct of the TransactionUsecase.FindOneByID
+type TransactionUsecaseMockFindOneByIDExpectation struct {
+	mock               *TransactionUsecaseMock
+	params             *TransactionUsecaseMockFindOneByIDParams
+	paramPtrs          *TransactionUsecaseMockFindOneByIDParamPtrs
+	expectationOrigins TransactionUsecaseMockFindOneByIDExpectationOrigins
+	results            *TransactionUsecaseMockFindOneByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TransactionUsecaseMockFindOneByIDParams contains parameters of the TransactionUsecase.FindOneByID
+type TransactionUsecaseMockFindOneByIDParams struct {
+	ctx         context.Context
+	id          uuid.UUID
+	queryParams *uctypes.QueryGetOneParams
+}
+
+// TransactionUsecaseMockFindOneByIDParamPtrs contains pointers to parameters of the TransactionUsecase.FindOneByID
+type TransactionUsecaseMockFindOneByIDParamPtrs struct {
+	ctx         *context.Context
+	id          *uuid.UUID
+	queryParams **uctypes.QueryGetOneParams
+}
+
+// TransactionUsecaseMockFindOneByIDResults contains results of the TransactionUsecase.FindOneByID
+type TransactionUsecaseMockFindOneByIDResults struct {
+	resItem *mm_usecase.TransactionDTO
+	err     error
+}
+
+// TransactionUsecaseMockFindOneByIDOrigins contains origins of expectations of the TransactionUsecase.FindOneByID
+type TransactionUsecaseMockFindOneByIDExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originId          string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) Optional() *mTransactionUsecaseMockFindOneByID {
+	mmFindOneByID.optional = true
+	return mmFindOneByID
+}
+
+// Expect sets up expected params for TransactionUsecase.FindOneByID
+func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) Expect(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *mTransactionUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &TransactionUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs != nil {
+		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by ExpectParams functions")
+	}
+
+	mmFindOneByID.defaultExpectation.params = &TransactionUsecaseMockFindOneByIDParams{ctx, id, queryParams}
+	mmFindOneByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindOneByID.expectations {
+		if minimock.Equal(e.params, mmFindOneByID.defaultExpectation.params) {
+			mmFindOneByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindOneByID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindOneByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.FindOneByID
+func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &TransactionUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindOneByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// ExpectIdParam2 sets up expected param id for TransactionUsecase.FindOneByID
+func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) ExpectIdParam2(id uuid.UUID) *mTransactionUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &TransactionUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.id = &id
+	mmFindOneByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for TransactionUsecase.FindOneByID
+func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetOneParams) *mTransactionUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &TransactionUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindOneByID.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.FindOneByID
+func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) Inspect(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)) *mTransactionUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.inspectFuncFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.FindOneByID")
+	}
+
+	mmFindOneByID.mock.inspectFuncFindOneByID = f
+
+	return mmFindOneByID
+}
+
+// Return sets up results that will be returned by TransactionUsecase.FindOneByID
+func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) Return(resItem *mm_usecase.TransactionDTO, err error) *TransactionUsecaseMock {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &TransactionUsecaseMockFindOneByIDExpectation{mock: mmFindOneByID.mock}
+	}
+	mmFindOneByID.defaultExpectation.results = &TransactionUsecaseMockFindOneByIDResults{resItem, err}
+	mmFindOneByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID.mock
+}
+
+// Set uses given function f to mock the TransactionUsecase.FindOneByID method
+func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) Set(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.TransactionDTO, err error)) *TransactionUsecaseMock {
+	if mmFindOneByID.defaultExpectation != nil {
+		mmFindOneByID.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.FindOneByID method")
+	}
+
+	if len(mmFindOneByID.expectations) > 0 {
+		mmFindOneByID.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.FindOneByID method")
+	}
+
+	mmFindOneByID.mock.funcFindOneByID = f
+	mmFindOneByID.mock.funcFindOneByIDOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID.mock
+}
+
+// When sets expectation for the TransactionUsecase.FindOneByID which will trigger the result defined by the following
+// Then helper
+func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) When(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *TransactionUsecaseMockFindOneByIDExpectation {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	expectation := &TransactionUsecaseMockFindOneByIDExpectation{
+		mock:               mmFindOneByID.mock,
+		params:             &TransactionUsecaseMockFindOneByIDParams{ctx, id, queryParams},
+		expectationOrigins: TransactionUsecaseMockFindOneByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindOneByID.expectations = append(mmFindOneByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TransactionUsecase.FindOneByID return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockFindOneByIDExpectation) Then(resItem *mm_usecase.TransactionDTO, err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockFindOneByIDResults{resItem, err}
+	return e.mock
+}
+
+// Times sets number of times TransactionUsecase.FindOneByID should be invoked
+func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) Times(n uint64) *mTransactionUsecaseMockFindOneByID {
+	if n == 0 {
+		mmFindOneByID.mock.t.Fatalf("Times of TransactionUsecaseMock.FindOneByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindOneByID.expectedInvocations, n)
+	mmFindOneByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID
+}
+
+func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) invocationsDone() bool {
+	if len(mmFindOneByID.expectations) == 0 && mmFindOneByID.defaultExpectation == nil && mmFindOneByID.mock.funcFindOneByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindOneByID.mock.afterFindOneByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindOneByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindOneByID implements mm_usecase.TransactionUsecase
+func (mmFindOneByID *TransactionUsecaseMock) FindOneByID(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.TransactionDTO, err error) {
+	mm_atomic.AddUint64(&mmFindOneByID.beforeFindOneByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindOneByID.afterFindOneByIDCounter, 1)
+
+	mmFindOneByID.t.Helper()
+
+	if mmFindOneByID.inspectFuncFindOneByID != nil {
+		mmFindOneByID.inspectFuncFindOneByID(ctx, id, queryParams)
+	}
+
+	mm_params := TransactionUsecaseMockFindOneByIDParams{ctx, id, queryParams}
+
+	// Record call args
+	mmFindOneByID.FindOneByIDMock.mutex.Lock()
+	mmFindOneByID.FindOneByIDMock.callArgs = append(mmFindOneByID.FindOneByIDMock.callArgs, &mm_params)
+	mmFindOneByID.FindOneByIDMock.mutex.Unlock()
+
+	for _, e := range mmFindOneByID.FindOneByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resItem, e.results.err
+		}
+	}
+
+	if mmFindOneByID.FindOneByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindOneByID.FindOneByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindOneByID.FindOneByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindOneByID.FindOneByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := TransactionUsecaseMockFindOneByIDParams{ctx, id, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindOneByID.t.Errorf("TransactionUsecaseMock.FindOneByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmFindOneByID.t.Errorf("TransactionUsecaseMock.FindOneByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindOneByID.t.Errorf("TransactionUsecaseMock.FindOneByID got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindOneByID.t.Errorf("TransactionUsecaseMock.FindOneByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindOneByID.FindOneByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindOneByID.t.Fatal("No results are set for the TransactionUsecaseMock.FindOneByID")
+		}
+		return (*mm_results).resItem, (*mm_results).err
+	}
+	if mmFindOneByID.funcFindOneByID != nil {
+		return mmFindOneByID.funcFindOneByID(ctx, id, queryParams)
+	}
+	mmFindOneByID.t.Fatalf("Unexpected call to TransactionUsecaseMock.FindOneByID. %v %v %v", ctx, id, queryParams)
+	return
+}
+
+// FindOneByIDAfterCounter returns a count of finished TransactionUsecaseMock.FindOneByID invocations
+func (mmFindOneByID *TransactionUsecaseMock) FindOneByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByID.afterFindOneByIDCounter)
+}
+
+// FindOneByIDBeforeCounter returns a count of TransactionUsecaseMock.FindOneByID invocations
+func (mmFindOneByID *TransactionUsecaseMock) FindOneByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByID.beforeFindOneByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.FindOneByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) Calls() []*TransactionUsecaseMockFindOneByIDParams {
+	mmFindOneByID.mutex.RLock()
+
+	argCopy := make([]*TransactionUsecaseMockFindOneByIDParams, len(mmFindOneByID.callArgs))
+	copy(argCopy, mmFindOneByID.callArgs)
+
+	mmFindOneByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindOneByIDDone returns true if the count of the FindOneByID invocations corresponds
+// the number of defined expectations
+func (m *TransactionUsecaseMock) MinimockFindOneByIDDone() bool {
+	if m.FindOneByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindOneByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindOneByIDMock.invocationsDone()
+}
+
+// MinimockFindOneByIDInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockFindOneByIDInspect() {
+	for _, e := range m.FindOneByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindOneByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindOneByIDCounter := mm_atomic.LoadUint64(&m.afterFindOneByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindOneByIDMock.defaultExpectation != nil && afterFindOneByIDCounter < 1 {
+		if m.FindOneByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindOneByID at\n%s", m.FindOneByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindOneByID at\n%s with params: %#v", m.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindOneByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindOneByID != nil && afterFindOneByIDCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.FindOneByID at\n%s", m.funcFindOneByIDOrigin)
+	}
+
+	if !m.FindOneByIDMock.invocationsDone() && afterFindOneByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.FindOneByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindOneByIDMock.expectedInvocations), m.FindOneByIDMock.expectedInvocationsOrigin, afterFindOneByIDCounter)
+	}
+}
+
+type mTransactionUsecaseMockFindPagedList struct {
+	optional           bool
+	mock               *TransactionUsecaseMock
+	defaultExpectation *TransactionUsecaseMockFindPagedListExpectation
+	expectations       []*TransactionUsecaseMockFindPagedListExpectation
+
+	callArgs []*TransactionUsecaseMockFindPagedListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransactionUsecaseMockFindPagedListExpectation specifies expectation struct of the TransactionUsecase.FindPagedList
+type TransactionUsecaseMockFindPagedListExpectation struct {
+	mock               *TransactionUsecaseMock
+	params             *TransactionUsecaseMockFindPagedListParams
+	paramPtrs          *TransactionUsecaseMockFindPagedListParamPtrs
+	expectationOrigins TransactionUsecaseMockFindPagedListExpectationOrigins
+	results            *TransactionUsecaseMockFindPagedListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TransactionUsecaseMockFindPagedListParams contains parameters of the TransactionUsecase.FindPagedList
+type TransactionUsecaseMockFindPagedListParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.TransactionListOptions
+	queryParams *uctypes.QueryGetListParams
+}
+
+// TransactionUsecaseMockFindPagedListParamPtrs contains pointers to parameters of the TransactionUsecase.FindPagedList
+type TransactionUsecaseMockFindPagedListParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.TransactionListOptions
+	queryParams **uctypes.QueryGetListParams
+}
+
+// TransactionUsecaseMockFindPagedListResults contains results of the TransactionUsecase.FindPagedList
+type TransactionUsecaseMockFindPagedListResults struct {
+	resItems []*mm_usecase.TransactionDTO
+	total    uint64
+	err      error
+}
+
+// TransactionUsecaseMockFindPagedListOrigins contains origins of expectations of the TransactionUsecase.FindPagedList
+type TransactionUsecaseMockFindPagedListExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) Optional() *mTransactionUsecaseMockFindPagedList {
+	mmFindPagedList.optional = true
+	return mmFindPagedList
+}
+
+// Expect sets up expected params for TransactionUsecase.FindPagedList
+func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) Expect(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) *mTransactionUsecaseMockFindPagedList {
+	if mmFindPagedList.mock.funcFindPagedList != nil {
+		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by Set")
+	}
+
+	if mmFindPagedList.defaultExpectation == nil {
+		mmFindPagedList.defaultExpectation = &TransactionUsecaseMockFindPagedListExpectation{}
+	}
+
+	if mmFindPagedList.defaultExpectation.paramPtrs != nil {
+		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by ExpectParams functions")
+	}
+
+	mmFindPagedList.defaultExpectation.params = &TransactionUsecaseMockFindPagedListParams{ctx, listOptions, queryParams}
+	mmFindPagedList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindPagedList.expectations {
+		if minimock.Equal(e.params, mmFindPagedList.defaultExpectation.params) {
+			mmFindPagedList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindPagedList.defaultExpectation.params)
+		}
+	}
+
+	return mmFindPagedList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.FindPagedList
+func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockFindPagedList {
+	if mmFindPagedList.mock.funcFindPagedList != nil {
+		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by Set")
+	}
+
+	if mmFindPagedList.defaultExpectation == nil {
+		mmFindPagedList.defaultExpectation = &TransactionUsecaseMockFindPagedListExpectation{}
+	}
+
+	if mmFindPagedList.defaultExpectation.params != nil {
+		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by Expect")
+	}
+
+	if mmFindPagedList.defaultExpectation.paramPtrs == nil {
+		mmFindPagedList.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindPagedListParamPtrs{}
+	}
+	mmFindPagedList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindPagedList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindPagedList
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for TransactionUsecase.FindPagedList
+func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) ExpectListOptionsParam2(listOptions *mm_usecase.TransactionListOptions) *mTransactionUsecaseMockFindPagedList {
+	if mmFindPagedList.mock.funcFindPagedList != nil {
+		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by Set")
+	}
+
+	if mmFindPagedList.defaultExpectation == nil {
+		mmFindPagedList.defaultExpectation = &TransactionUsecaseMockFindPagedListExpectation{}
+	}
+
+	if mmFindPagedList.defaultExpectation.params != nil {
+		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by Expect")
+	}
+
+	if mmFindPagedList.defaultExpectation.paramPtrs == nil {
+		mmFindPagedList.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindPagedListParamPtrs{}
+	}
+	mmFindPagedList.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmFindPagedList.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmFindPagedList
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for TransactionUsecase.FindPagedList
+func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mTransactionUsecaseMockFindPagedList {
+	if mmFindPagedList.mock.funcFindPagedList != nil {
+		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by Set")
+	}
+
+	if mmFindPagedList.defaultExpectation == nil {
+		mmFindPagedList.defaultExpectation = &TransactionUsecaseMockFindPagedListExpectation{}
+	}
+
+	if mmFindPagedList.defaultExpectation.params != nil {
+		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by Expect")
+	}
+
+	if mmFindPagedList.defaultExpectation.paramPtrs == nil {
+		mmFindPagedList.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindPagedListParamPtrs{}
+	}
+	mmFindPagedList.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindPagedList.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindPagedList
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.FindPagedList
+func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) Inspect(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams)) *mTransactionUsecaseMockFindPagedList {
+	if mmFindPagedList.mock.inspectFuncFindPagedList != nil {
+		mmFindPagedList.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.FindPagedList")
+	}
+
+	mmFindPagedList.mock.inspectFuncFindPagedList = f
+
+	return mmFindPagedList
+}
+
+// Return sets up results that will be returned by TransactionUsecase.FindPagedList
+func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) Return(resItems []*mm_usecase.TransactionDTO, total uint64, err error) *TransactionUsecaseMock {
+	if mmFindPagedList.mock.funcFindPagedList != nil {
+		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by Set")
+	}
+
+	if mmFindPagedList.defaultExpectation == nil {
+		mmFindPagedList.defaultExpectation = &TransactionUsecaseMockFindPagedListExpectation{mock: mmFindPagedList.mock}
+	}
+	mmFindPagedList.defaultExpectation.results = &TransactionUsecaseMockFindPagedListResults{resItems, total, err}
+	mmFindPagedList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindPagedList.mock
+}
+
+// Set uses given function f to mock the TransactionUsecase.FindPagedList method
+func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) Set(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.TransactionDTO, total uint64, err error)) *TransactionUsecaseMock {
+	if mmFindPagedList.defaultExpectation != nil {
+		mmFindPagedList.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.FindPagedList method")
+	}
+
+	if len(mmFindPagedList.expectations) > 0 {
+		mmFindPagedList.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.FindPagedList method")
+	}
+
+	mmFindPagedList.mock.funcFindPagedList = f
+	mmFindPagedList.mock.funcFindPagedListOrigin = minimock.CallerInfo(1)
+	return mmFindPagedList.mock
+}
+
+// When sets expectation for the TransactionUsecase.FindPagedList which will trigger the result defined by the following
+// Then helper
+func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) When(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) *TransactionUsecaseMockFindPagedListExpectation {
+	if mmFindPagedList.mock.funcFindPagedList != nil {
+		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by Set")
+	}
+
+	expectation := &TransactionUsecaseMockFindPagedListExpectation{
+		mock:               mmFindPagedList.mock,
+		params:             &TransactionUsecaseMockFindPagedListParams{ctx, listOptions, queryParams},
+		expectationOrigins: TransactionUsecaseMockFindPagedListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindPagedList.expectations = append(mmFindPagedList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TransactionUsecase.FindPagedList return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockFindPagedListExpectation) Then(resItems []*mm_usecase.TransactionDTO, total uint64, err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockFindPagedListResults{resItems, total, err}
+	return e.mock
+}
+
+// Times sets number of times TransactionUsecase.FindPagedList should be invoked
+func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) Times(n uint64) *mTransactionUsecaseMockFindPagedList {
+	if n == 0 {
+		mmFindPagedList.mock.t.Fatalf("Times of TransactionUsecaseMock.FindPagedList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindPagedList.expectedInvocations, n)
+	mmFindPagedList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindPagedList
+}
+
+func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) invocationsDone() bool {
+	if len(mmFindPagedList.expectations) == 0 && mmFindPagedList.defaultExpectation == nil && mmFindPagedList.mock.funcFindPagedList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindPagedList.mock.afterFindPagedListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindPagedList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindPagedList implements mm_usecase.TransactionUsecase
+func (mmFindPagedList *TransactionUsecaseMock) FindPagedList(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.TransactionDTO, total uint64, err error) {
+	mm_atomic.AddUint64(&mmFindPagedList.beforeFindPagedListCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindPagedList.afterFindPagedListCounter, 1)
+
+	mmFindPagedList.t.Helper()
+
+	if mmFindPagedList.inspectFuncFindPagedList != nil {
+		mmFindPagedList.inspectFuncFindPagedList(ctx, listOptions, queryParams)
+	}
+
+	mm_params := TransactionUsecaseMockFindPagedListParams{ctx, listOptions, queryParams}
+
+	// Record call args
+	mmFindPagedList.FindPagedListMock.mutex.Lock()
+	mmFindPagedList.FindPagedListMock.callArgs = append(mmFindPagedList.FindPagedListMock.callArgs, &mm_params)
+	mmFindPagedList.FindPagedListMock.mutex.Unlock()
+
+	for _, e := range mmFindPagedList.FindPagedListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resItems, e.results.total, e.results.err
+		}
+	}
+
+	if mmFindPagedList.FindPagedListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindPagedList.FindPagedListMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindPagedList.FindPagedListMock.defaultExpectation.params
+		mm_want_ptrs := mmFindPagedList.FindPagedListMock.defaultExpectation.paramPtrs
+
+		mm_got := TransactionUsecaseMockFindPagedListParams{ctx, listOptions, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindPagedList.t.Errorf("TransactionUsecaseMock.FindPagedList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindPagedList.FindPagedListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmFindPagedList.t.Errorf("TransactionUsecaseMock.FindPagedList got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindPagedList.FindPagedListMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindPagedList.t.Errorf("TransactionUsecaseMock.FindPagedList got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindPagedList.FindPagedListMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindPagedList.t.Errorf("TransactionUsecaseMock.FindPagedList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindPagedList.FindPagedListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindPagedList.FindPagedListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindPagedList.t.Fatal("No results are set for the TransactionUsecaseMock.FindPagedList")
+		}
+		return (*mm_results).resItems, (*mm_results).total, (*mm_results).err
+	}
+	if mmFindPagedList.funcFindPagedList != nil {
+		return mmFindPagedList.funcFindPagedList(ctx, listOptions, queryParams)
+	}
+	mmFindPagedList.t.Fatalf("Unexpected call to TransactionUsecaseMock.FindPagedList. %v %v %v", ctx, listOptions, queryParams)
+	return
+}
+
+// FindPagedListAfterCounter returns a count of finished TransactionUsecaseMock.FindPagedList invocations
+func (mmFindPagedList *TransactionUsecaseMock) FindPagedListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindPagedList.afterFindPagedListCounter)
+}
+
+// FindPagedListBeforeCounter returns a count of TransactionUsecaseMock.FindPagedList invocations
+func (mmFindPagedList *TransactionUsecaseMock) FindPagedListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindPagedList.beforeFindPagedListCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.FindPagedList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) Calls() []*TransactionUsecaseMockFindPagedListParams {
+	mmFindPagedList.mutex.RLock()
+
+	argCopy := make([]*TransactionUsecaseMockFindPagedListParams, len(mmFindPagedList.callArgs))
+	copy(argCopy, mmFindPagedList.callArgs)
+
+	mmFindPagedList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindPagedListDone returns true if the count of the FindPagedList invocations corresponds
+// the number of defined expectations
+func (m *TransactionUsecaseMock) MinimockFindPagedListDone() bool {
+	if m.FindPagedListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindPagedListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindPagedListMock.invocationsDone()
+}
+
+// MinimockFindPagedListInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockFindPagedListInspect() {
+	for _, e := range m.FindPagedListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindPagedList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindPagedListCounter := mm_atomic.LoadUint64(&m.afterFindPagedListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindPagedListMock.defaultExpectation != nil && afterFindPagedListCounter < 1 {
+		if m.FindPagedListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindPagedList at\n%s", m.FindPagedListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindPagedList at\n%s with params: %#v", m.FindPagedListMock.defaultExpectation.expectationOrigins.origin, *m.FindPagedListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindPagedList != nil && afterFindPagedListCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.FindPagedList at\n%s", m.funcFindPagedListOrigin)
+	}
+
+	if !m.FindPagedListMock.invocationsDone() && afterFindPagedListCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.FindPagedList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindPagedListMock.expectedInvocations), m.FindPagedListMock.expectedInvocationsOrigin, afterFindPagedListCounter)
+	}
+}
+
+type mTransactionUsecaseMockFindPagedListAsCSV struct {
+	optional           bool
+	mock               *TransactionUsecaseMock
+	defaultExpectation *TransactionUsecaseMockFindPagedListAsCSVExpectation
+	expectations       []*TransactionUsecaseMockFindPagedListAsCSVExpectation
+
+	callArgs []*TransactionUsecaseMockFindPagedListAsCSVParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransactionUsecaseMockFindPagedListAsCSVExpectation specifies expectation struct of the TransactionUsecase.FindPagedListAsCSV
+type TransactionUsecaseMockFindPagedListAsCSVExpectation struct {
+	mock               *TransactionUsecaseMock
+	params             *TransactionUsecaseMockFindPagedListAsCSVParams
+	paramPtrs          *TransactionUsecaseMockFindPagedListAsCSVParamPtrs
+	expectationOrigins TransactionUsecaseMockFindPagedListAsCSVExpectationOrigins
+	results            *TransactionUsecaseMockFindPagedListAsCSVResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TransactionUsecaseMockFindPagedListAsCSVParams contains parameters of the TransactionUsecase.FindPagedListAsCSV
+type TransactionUsecaseMockFindPagedListAsCSVParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.TransactionListOptions
+	queryParams *uctypes.QueryGetListParams
+}
+
+// TransactionUsecaseMockFindPagedListAsCSVParamPtrs contains pointers to parameters of the TransactionUsecase.FindPagedListAsCSV
+type TransactionUsecaseMockFindPagedListAsCSVParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.TransactionListOptions
+	queryParams **uctypes.QueryGetListParams
+}
+
+// TransactionUsecaseMockFindPagedListAsCSVResults contains results of the TransactionUsecase.FindPagedListAsCSV
+type TransactionUsecaseMockFindPagedListAsCSVResults struct {
+	res   []byte
+	total uint64
+	err   error
+}
+
+// TransactionUsecaseMockFindPagedListAsCSVOrigins contains origins of expectations of the TransactionUsecase.FindPagedListAsCSV
+type TransactionUsecaseMockFindPagedListAsCSVExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) Optional() *mTransactionUsecaseMockFindPagedListAsCSV {
+	mmFindPagedListAsCSV.optional = true
+	return mmFindPagedListAsCSV
+}
+
+// Expect sets up expected params for TransactionUsecase.FindPagedListAsCSV
+func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) Expect(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) *mTransactionUsecaseMockFindPagedListAsCSV {
+	if mmFindPagedListAsCSV.mock.funcFindPagedListAsCSV != nil {
+		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by Set")
+	}
+
+	if mmFindPagedListAsCSV.defaultExpectation == nil {
+		mmFindPagedListAsCSV.defaultExpectation = &TransactionUsecaseMockFindPagedListAsCSVExpectation{}
+	}
+
+	if mmFindPagedListAsCSV.defaultExpectation.paramPtrs != nil {
+		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by ExpectParams functions")
+	}
+
+	mmFindPagedListAsCSV.defaultExpectation.params = &TransactionUsecaseMockFindPagedListAsCSVParams{ctx, listOptions, queryParams}
+	mmFindPagedListAsCSV.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindPagedListAsCSV.expectations {
+		if minimock.Equal(e.params, mmFindPagedListAsCSV.defaultExpectation.params) {
+			mmFindPagedListAsCSV.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindPagedListAsCSV.defaultExpectation.params)
+		}
+	}
+
+	return mmFindPagedListAsCSV
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.FindPagedListAsCSV
+func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockFindPagedListAsCSV {
+	if mmFindPagedListAsCSV.mock.funcFindPagedListAsCSV != nil {
+		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by Set")
+	}
+
+	if mmFindPagedListAsCSV.defaultExpectation == nil {
+		mmFindPagedListAsCSV.defaultExpectation = &TransactionUsecaseMockFindPagedListAsCSVExpectation{}
+	}
+
+	if mmFindPagedListAsCSV.defaultExpectation.params != nil {
+		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by Expect")
+	}
+
+	if mmFindPagedListAsCSV.defaultExpectation.paramPtrs == nil {
+		mmFindPagedListAsCSV.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindPagedListAsCSVParamPtrs{}
+	}
+	mmFindPagedListAsCSV.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindPagedListAsCSV.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindPagedListAsCSV
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for TransactionUsecase.FindPagedListAsCSV
+func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) ExpectListOptionsParam2(listOptions *mm_usecase.TransactionListOptions) *mTransactionUsecaseMockFindPagedListAsCSV {
+	if mmFindPagedListAsCSV.mock.funcFindPagedListAsCSV != nil {
+		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by Set")
+	}
+
+	if mmFindPagedListAsCSV.defaultExpectation == nil {
+		mmFindPagedListAsCSV.defaultExpectation = &TransactionUsecaseMockFindPagedListAsCSVExpectation{}
+	}
+
+	if mmFindPagedListAsCSV.defaultExpectation.params != nil {
+		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by Expect")
+	}
+
+	if mmFindPagedListAsCSV.defaultExpectation.paramPtrs == nil {
+		mmFindPagedListAsCSV.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindPagedListAsCSVParamPtrs{}
+	}
+	mmFindPagedListAsCSV.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmFindPagedListAsCSV.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmFindPagedListAsCSV
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for TransactionUsecase.FindPagedListAsCSV
+func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mTransactionUsecaseMockFindPagedListAsCSV {
+	if mmFindPagedListAsCSV.mock.funcFindPagedListAsCSV != nil {
+		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by Set")
+	}
+
+	if mmFindPagedListAsCSV.defaultExpectation == nil {
+		mmFindPagedListAsCSV.defaultExpectation = &TransactionUsecaseMockFindPagedListAsCSVExpectation{}
+	}
+
+	if mmFindPagedListAsCSV.defaultExpectation.params != nil {
+		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by Expect")
+	}
+
+	if mmFindPagedListAsCSV.defaultExpectation.paramPtrs == nil {
+		mmFindPagedListAsCSV.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindPagedListAsCSVParamPtrs{}
+	}
+	mmFindPagedListAsCSV.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindPagedListAsCSV.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindPagedListAsCSV
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.FindPagedListAsCSV
+func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) Inspect(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams)) *mTransactionUsecaseMockFindPagedListAsCSV {
+	if mmFindPagedListAsCSV.mock.inspectFuncFindPagedListAsCSV != nil {
+		mmFindPagedListAsCSV.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.FindPagedListAsCSV")
+	}
+
+	mmFindPagedListAsCSV.mock.inspectFuncFindPagedListAsCSV = f
+
+	return mmFindPagedListAsCSV
+}
+
+// Return sets up results that will be returned by TransactionUsecase.FindPagedListAsCSV
+func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) Return(res []byte, total uint64, err error) *TransactionUsecaseMock {
+	if mmFindPagedListAsCSV.mock.funcFindPagedListAsCSV != nil {
+		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by Set")
+	}
+
+	if mmFindPagedListAsCSV.defaultExpectation == nil {
+		mmFindPagedListAsCSV.defaultExpectation = &TransactionUsecaseMockFindPagedListAsCSVExpectation{mock: mmFindPagedListAsCSV.mock}
+	}
+	mmFindPagedListAsCSV.defaultExpectation.results = &TransactionUsecaseMockFindPagedListAsCSVResults{res, total, err}
+	mmFindPagedListAsCSV.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindPagedListAsCSV.mock
+}
+
+// Set uses given function f to mock the TransactionUsecase.FindPagedListAsCSV method
+func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) Set(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) (res []byte, total uint64, err error)) *TransactionUsecaseMock {
+	if mmFindPagedListAsCSV.defaultExpectation != nil {
+		mmFindPagedListAsCSV.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.FindPagedListAsCSV method")
+	}
+
+	if len(mmFindPagedListAsCSV.expectations) > 0 {
+		mmFindPagedListAsCSV.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.FindPagedListAsCSV method")
+	}
+
+	mmFindPagedListAsCSV.mock.funcFindPagedListAsCSV = f
+	mmFindPagedListAsCSV.mock.funcFindPagedListAsCSVOrigin = minimock.CallerInfo(1)
+	return mmFindPagedListAsCSV.mock
+}
+
+// When sets expectation for the TransactionUsecase.FindPagedListAsCSV which will trigger the result defined by the following
+// Then helper
+func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) When(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) *TransactionUsecaseMockFindPagedListAsCSVExpectation {
+	if mmFindPagedListAsCSV.mock.funcFindPagedListAsCSV != nil {
+		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by Set")
+	}
+
+	expectation := &TransactionUsecaseMockFindPagedListAsCSVExpectation{
+		mock:               mmFindPagedListAsCSV.mock,
+		params:             &TransactionUsecaseMockFindPagedListAsCSVParams{ctx, listOptions, queryParams},
+		expectationOrigins: TransactionUsecaseMockFindPagedListAsCSVExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindPagedListAsCSV.expectations = append(mmFindPagedListAsCSV.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TransactionUsecase.FindPagedListAsCSV return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockFindPagedListAsCSVExpectation) Then(res []byte, total uint64, err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockFindPagedListAsCSVResults{res, total, err}
+	return e.mock
+}
+
+// Times sets number of times TransactionUsecase.FindPagedListAsCSV should be invoked
+func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) Times(n uint64) *mTransactionUsecaseMockFindPagedListAsCSV {
+	if n == 0 {
+		mmFindPagedListAsCSV.mock.t.Fatalf("Times of TransactionUsecaseMock.FindPagedListAsCSV mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindPagedListAsCSV.expectedInvocations, n)
+	mmFindPagedListAsCSV.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindPagedListAsCSV
+}
+
+func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) invocationsDone() bool {
+	if len(mmFindPagedListAsCSV.expectations) == 0 && mmFindPagedListAsCSV.defaultExpectation == nil && mmFindPagedListAsCSV.mock.funcFindPagedListAsCSV == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindPagedListAsCSV.mock.afterFindPagedListAsCSVCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindPagedListAsCSV.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindPagedListAsCSV implements mm_usecase.TransactionUsecase
+func (mmFindPagedListAsCSV *TransactionUsecaseMock) FindPagedListAsCSV(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) (res []byte, total uint64, err error) {
+	mm_atomic.AddUint64(&mmFindPagedListAsCSV.beforeFindPagedListAsCSVCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindPagedListAsCSV.afterFindPagedListAsCSVCounter, 1)
+
+	mmFindPagedListAsCSV.t.Helper()
+
+	if mmFindPagedListAsCSV.inspectFuncFindPagedListAsCSV != nil {
+		mmFindPagedListAsCSV.inspectFuncFindPagedListAsCSV(ctx, listOptions, queryParams)
+	}
+
+	mm_params := TransactionUsecaseMockFindPagedListAsCSVParams{ctx, listOptions, queryParams}
+
+	// Record call args
+	mmFindPagedListAsCSV.FindPagedListAsCSVMock.mutex.Lock()
+	mmFindPagedListAsCSV.FindPagedListAsCSVMock.callArgs = append(mmFindPagedListAsCSV.FindPagedListAsCSVMock.callArgs, &mm_params)
+	mmFindPagedListAsCSV.FindPagedListAsCSVMock.mutex.Unlock()
+
+	for _, e := range mmFindPagedListAsCSV.FindPagedListAsCSVMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.res, e.results.total, e.results.err
+		}
+	}
+
+	if mmFindPagedListAsCSV.FindPagedListAsCSVMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindPagedListAsCSV.FindPagedListAsCSVMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindPagedListAsCSV.FindPagedListAsCSVMock.defaultExpectation.params
+		mm_want_ptrs := mmFindPagedListAsCSV.FindPagedListAsCSVMock.defaultExpectation.paramPtrs
+
+		mm_got := TransactionUsecaseMockFindPagedListAsCSVParams{ctx, listOptions, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindPagedListAsCSV.t.Errorf("TransactionUsecaseMock.FindPagedListAsCSV got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindPagedListAsCSV.FindPagedListAsCSVMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmFindPagedListAsCSV.t.Errorf("TransactionUsecaseMock.FindPagedListAsCSV got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindPagedListAsCSV.FindPagedListAsCSVMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindPagedListAsCSV.t.Errorf("TransactionUsecaseMock.FindPagedListAsCSV got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindPagedListAsCSV.FindPagedListAsCSVMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindPagedListAsCSV.t.Errorf("TransactionUsecaseMock.FindPagedListAsCSV got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindPagedListAsCSV.FindPagedListAsCSVMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindPagedListAsCSV.FindPagedListAsCSVMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindPagedListAsCSV.t.Fatal("No results are set for the TransactionUsecaseMock.FindPagedListAsCSV")
+		}
+		return (*mm_results).res, (*mm_results).total, (*mm_results).err
+	}
+	if mmFindPagedListAsCSV.funcFindPagedListAsCSV != nil {
+		return mmFindPagedListAsCSV.funcFindPagedListAsCSV(ctx, listOptions, queryParams)
+	}
+	mmFindPagedListAsCSV.t.Fatalf("Unexpected call to TransactionUsecaseMock.FindPagedListAsCSV. %v %v %v", ctx, listOptions, queryParams)
+	return
+}
+
+// FindPagedListAsCSVAfterCounter returns a count of finished TransactionUsecaseMock.FindPagedListAsCSV invocations
+func (mmFindPagedListAsCSV *TransactionUsecaseMock) FindPagedListAsCSVAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindPagedListAsCSV.afterFindPagedListAsCSVCounter)
+}
+
+// FindPagedListAsCSVBeforeCounter returns a count of TransactionUsecaseMock.FindPagedListAsCSV invocations
+func (mmFindPagedListAsCSV *TransactionUsecaseMock) FindPagedListAsCSVBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindPagedListAsCSV.beforeFindPagedListAsCSVCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.FindPagedListAsCSV.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) Calls() []*TransactionUsecaseMockFindPagedListAsCSVParams {
+	mmFindPagedListAsCSV.mutex.RLock()
+
+	argCopy := make([]*TransactionUsecaseMockFindPagedListAsCSVParams, len(mmFindPagedListAsCSV.callArgs))
+	copy(argCopy, mmFindPagedListAsCSV.callArgs)
+
+	mmFindPagedListAsCSV.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindPagedListAsCSVDone returns true if the count of the FindPagedListAsCSV invocations corresponds
+// the number of defined expectations
+func (m *TransactionUsecaseMock) MinimockFindPagedListAsCSVDone() bool {
+	if m.FindPagedListAsCSVMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindPagedListAsCSVMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindPagedListAsCSVMock.invocationsDone()
+}
+
+// MinimockFindPagedListAsCSVInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockFindPagedListAsCSVInspect() {
+	for _, e := range m.FindPagedListAsCSVMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindPagedListAsCSV at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindPagedListAsCSVCounter := mm_atomic.LoadUint64(&m.afterFindPagedListAsCSVCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindPagedListAsCSVMock.defaultExpectation != nil && afterFindPagedListAsCSVCounter < 1 {
+		if m.FindPagedListAsCSVMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindPagedListAsCSV at\n%s", m.FindPagedListAsCSVMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindPagedListAsCSV at\n%s with params: %#v", m.FindPagedListAsCSVMock.defaultExpectation.expectationOrigins.origin, *m.FindPagedListAsCSVMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindPagedListAsCSV != nil && afterFindPagedListAsCSVCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.FindPagedListAsCSV at\n%s", m.funcFindPagedListAsCSVOrigin)
+	}
+
+	if !m.FindPagedListAsCSVMock.invocationsDone() && afterFindPagedListAsCSVCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.FindPagedListAsCSV at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindPagedListAsCSVMock.expectedInvocations), m.FindPagedListAsCSVMock.expectedInvocationsOrigin, afterFindPagedListAsCSVCounter)
+	}
+}
+
+type mTransactionUsecaseMockFindTransferByID struct {
+	optional           bool
+	mock               *TransactionUsecaseMock
+	defaultExpectation *TransactionUsecaseMockFindTransferByIDExpectation
+	expectations       []*TransactionUsecaseMockFindTransferByIDExpectation
+
+	callArgs []*TransactionUsecaseMockFindTransferByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransactionUsecaseMockFindTransferByIDExpectation specifies expectation struct of the TransactionUsecase.FindTransferByID
+type TransactionUsecaseMockFindTransferByIDExpectation struct {
+	mock               *TransactionUsecaseMock
+	params             *TransactionUsecaseMockFindTransferByIDParams
+	paramPtrs          *TransactionUsecaseMockFindTransferByIDParamPtrs
+	expectationOrigins TransactionUsecaseMockFindTransferByIDExpectationOrigins
+	results            *TransactionUsecaseMockFindTransferByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TransactionUsecaseMockFindTransferByIDParams contains parameters of the TransactionUsecase.FindTransferByID
+type TransactionUsecaseMockFindTransferByIDParams struct {
+	ctx         context.Context
+	id          uuid.UUID
+	queryParams *uctypes.QueryGetOneParams
+}
+
+// TransactionUsecaseMockFindTransferByIDParamPtrs contains pointers to parameters of the TransactionUsecase.FindTransferByID
+type TransactionUsecaseMockFindTransferByIDParamPtrs struct {
+	ctx         *context.Context
+	id          *uuid.UUID
+	queryParams **uctypes.QueryGetOneParams
+}
+
+// TransactionUsecaseMockFindTransferByIDResults contains results of the TransactionUsecase.FindTransferByID
+type TransactionUsecaseMockFindTransferByIDResults struct {
+	resItem *mm_usecase.TransferDTO
+	err     error
+}
+
+// TransactionUsecaseMockFindTransferByIDOrigins contains origins of expectations of the TransactionUsecase.FindTransferByID
+type TransactionUsecaseMockFindTransferByIDExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originId          string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindTransferByID *mTransactionUsecaseMockFindTransferByID) Optional() *mTransactionUsecaseMockFindTransferByID {
+	mmFindTransferByID.optional = true
+	return mmFindTransferByID
+}
+
+// Expect sets up expected params for TransactionUsecase.FindTransferByID
+func (mmFindTransferByID *mTransactionUsecaseMockFindTransferByID) Expect(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *mTransactionUsecaseMockFindTransferByID {
+	if mmFindTransferByID.mock.funcFindTransferByID != nil {
+		mmFindTransferByID.mock.t.Fatalf("TransactionUsecaseMock.FindTransferByID mock is already set by Set")
+	}
+
+	if mmFindTransferByID.defaultExpectation == nil {
+		mmFindTransferByID.defaultExpectation = &TransactionUsecaseMockFindTransferByIDExpectation{}
+	}
+
+	if mmFindTransferByID.defaultExpectation.paramPtrs != nil {
+		mmFindTransferByID.mock.t.Fatalf("TransactionUsecaseMock.FindTransferByID mock is already set by ExpectParams functions")
+	}
+
+	mmFindTransferByID.defaultExpectation.params = &TransactionUsecaseMockFindTransferByIDParams{ctx, id, queryParams}
+	mmFindTransferByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindTransferByID.expectations {
+		if minimock.Equal(e.params, mmFindTransferByID.defaultExpectation.params) {
+			mmFindTransferByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindTransferByID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindTransferByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.FindTransferByID
+func (mmFindTransferByID *mTransactionUsecaseMockFindTransferByID) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockFindTransferByID {
+	if mmFindTransferByID.mock.funcFindTransferByID != nil {
+		mmFindTransferByID.mock.t.Fatalf("TransactionUsecaseMock.FindTransferByID mock is already set by Set")
+	}
+
+	if mmFindTransferByID.defaultExpectation == nil {
+		mmFindTransferByID.defaultExpectation = &TransactionUsecaseMockFindTransferByIDExpectation{}
+	}
+
+	if mmFindTransferByID.defaultExpectation.params != nil {
+		mmFindTransferByID.mock.t.Fatalf("TransactionUsecaseMock.FindTransferByID mock is already set by Expect")
+	}
+
+	if mmFindTransferByID.defaultExpectation.paramPtrs == nil {
+		mmFindTransferByID.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindTransferByIDParamPtrs{}
+	}
+	mmFindTransferByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindTransferByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindTransferByID
+}
+
+// ExpectIdParam2 sets up expected param id for TransactionUsecase.FindTransferByID
+func (mmFindTransferByID *mTransactionUsecaseMockFindTransferByID) ExpectIdParam2(id uuid.UUID) *mTransactionUsecaseMockFindTransferByID {
+	if mmFindTransferByID.mock.funcFindTransferByID != nil {
+		mmFindTransferByID.mock.t.Fatalf("TransactionUsecaseMock.FindTransferByID mock is already set by Set")
+	}
+
+	if mmFindTransferByID.defaultExpectation == nil {
+		mmFindTransferByID.defaultExpectation = &TransactionUsecaseMockFindTransferByIDExpectation{}
+	}
+
+	if mmFindTransferByID.defaultExpectation.params != nil {
+		mmFindTransferByID.mock.t.Fatalf("TransactionUsecaseMock.FindTransferByID mock is already set by Expect")
+	}
+
+	if mmFindTransferByID.defaultExpectation.paramPtrs == nil {
+		mmFindTransferByID.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindTransferByIDParamPtrs{}
+	}
+	mmFindTransferByID.defaultExpectation.paramPtrs.id = &id
+	mmFindTransferByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmFindTransferByID
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for TransactionUsecase.FindTransferByID
+func (mmFindTransferByID *mTransactionUsecaseMockFindTransferByID) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetOneParams) *mTransactionUsecaseMockFindTransferByID {
+	if mmFindTransferByID.mock.funcFindTransferByID != nil {
+		mmFindTransferByID.mock.t.Fatalf("TransactionUsecaseMock.FindTransferByID mock is already set by Set")
+	}
+
+	if mmFindTransferByID.defaultExpectation == nil {
+		mmFindTransferByID.defaultExpectation = &TransactionUsecaseMockFindTransferByIDExpectation{}
+	}
+
+	if mmFindTransferByID.defaultExpectation.params != nil {
+		mmFindTransferByID.mock.t.Fatalf("TransactionUsecaseMock.FindTransferByID mock is already set by Expect")
+	}
+
+	if mmFindTransferByID.defaultExpectation.paramPtrs == nil {
+		mmFindTransferByID.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindTransferByIDParamPtrs{}
+	}
+	mmFindTransferByID.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindTransferByID.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindTransferByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.FindTransferByID
+func (mmFindTransferByID *mTransactionUsecaseMockFindTransferByID) Inspect(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)) *mTransactionUsecaseMockFindTransferByID {
+	if mmFindTransferByID.mock.inspectFuncFindTransferByID != nil {
+		mmFindTransferByID.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.FindTransferByID")
+	}
+
+	mmFindTransferByID.mock.inspectFuncFindTransferByID = f
+
+	return mmFindTransferByID
+}
+
+// Return sets up results that will be returned by TransactionUsecase.FindTransferByID
+func (mmFindTransferByID *mTransactionUsecaseMockFindTransferByID) Return(resItem *mm_usecase.TransferDTO, err error) *TransactionUsecaseMock {
+	if mmFindTransferByID.mock.funcFindTransferByID != nil {
+		mmFindTransferByID.mock.t.Fatalf("TransactionUsecaseMock.FindTransferByID mock is already set by Set")
+	}
+
+	if mmFindTransferByID.defaultExpectation == nil {
+		mmFindTransferByID.defaultExpectation = &TransactionUsecaseMockFindTransferByIDExpectation{mock: mmFindTransferByID.mock}
+	}
+	mmFindTransferByID.defaultExpectation.results = &TransactionUsecaseMockFindTransferByIDResults{resItem, err}
+	mmFindTransferByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindTransferByID.mock
+}
+
+// Set uses given function f to mock the TransactionUsecase.FindTransferByID method
+func (mmFindTransferByID *mTransactionUsecaseMockFindTransferByID) Set(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.TransferDTO, err error)) *TransactionUsecaseMock {
+	if mmFindTransferByID.defaultExpectation != nil {
+		mmFindTransferByID.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.FindTransferByID method")
+	}
+
+	if len(mmFindTransferByID.expectations) > 0 {
+		mmFindTransferByID.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.FindTransferByID method")
+	}
+
+	mmFindTransferByID.mock.funcFindTransferByID = f
+	mmFindTransferByID.mock.funcFindTransferByIDOrigin = minimock.CallerInfo(1)
+	return mmFindTransferByID.mock
+}
+
+// When sets expectation for the TransactionUsecase.FindTransferByID which will trigger the result defined by the following
+// Then helper
+func (mmFindTransferByID *mTransactionUsecaseMockFindTransferByID) When(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *TransactionUsecaseMockFindTransferByIDExpectation {
+	if mmFindTransferByID.mock.funcFindTransferByID != nil {
+		mmFindTransferByID.mock.t.Fatalf("TransactionUsecaseMock.FindTransferByID mock is already set by Set")
+	}
+
+	expectation := &TransactionUsecaseMockFindTransferByIDExpectation{
+		mock:               mmFindTransferByID.mock,
+		params:             &TransactionUsecaseMockFindTransferByIDParams{ctx, id, queryParams},
+		expectationOrigins: TransactionUsecaseMockFindTransferByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindTransferByID.expectations = append(mmFindTransferByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TransactionUsecase.FindTransferByID return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockFindTransferByIDExpectation) Then(resItem *mm_usecase.TransferDTO, err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockFindTransferByIDResults{resItem, err}
+	return e.mock
+}
+
+// Times sets number of times TransactionUsecase.FindTransferByID should be invoked
+func (mmFindTransferByID *mTransactionUsecaseMockFindTransferByID) Times(n uint64) *mTransactionUsecaseMockFindTransferByID {
+	if n == 0 {
+		mmFindTransferByID.mock.t.Fatalf("Times of TransactionUsecaseMock.FindTransferByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindTransferByID.expectedInvocations, n)
+	mmFindTransferByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindTransferByID
+}
+
+func (mmFindTransferByID *mTransactionUsecaseMockFindTransferByID) invocationsDone() bool {
+	if len(mmFindTransferByID.expectations) == 0 && mmFindTransferByID.defaultExpectation == nil && mmFindTransferByID.mock.funcFindTransferByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindTransferByID.mock.afterFindTransferByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindTransferByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindTransferByID implements mm_usecase.TransactionUsecase
+func (mmFindTransferByID *TransactionUsecaseMock) FindTransferByID(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.TransferDTO, err error) {
+	mm_atomic.AddUint64(&mmFindTransferByID.beforeFindTransferByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindTransferByID.afterFindTransferByIDCounter, 1)
+
+	mmFindTransferByID.t.Helper()
+
+	if mmFindTransferByID.inspectFuncFindTransferByID != nil {
+		mmFindTransferByID.inspectFuncFindTransferByID(ctx, id, queryParams)
+	}
+
+	mm_params := TransactionUsecaseMockFindTransferByIDParams{ctx, id, queryParams}
+
+	// Record call args
+	mmFindTransferByID.FindTransferByIDMock.mutex.Lock()
+	mmFindTransferByID.FindTransferByIDMock.callArgs = append(mmFindTransferByID.FindTransferByIDMock.callArgs, &mm_params)
+	mmFindTransferByID.FindTransferByIDMock.mutex.Unlock()
+
+	for _, e := range mmFindTransferByID.FindTransferByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resItem, e.results.err
+		}
+	}
+
+	if mmFindTransferByID.FindTransferByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindTransferByID.FindTransferByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindTransferByID.FindTransferByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindTransferByID.FindTransferByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := TransactionUsecaseMockFindTransferByIDParams{ctx, id, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindTransferByID.t.Errorf("TransactionUsecaseMock.FindTransferByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindTransferByID.FindTransferByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmFindTransferByID.t.Errorf("TransactionUsecaseMock.FindTransferByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindTransferByID.FindTransferByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindTransferByID.t.Errorf("TransactionUsecaseMock.FindTransferByID got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindTransferByID.FindTransferByIDMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindTransferByID.t.Errorf("TransactionUsecaseMock.FindTransferByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindTransferByID.FindTransferByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindTransferByID.FindTransferByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindTransferByID.t.Fatal("No results are set for the TransactionUsecaseMock.FindTransferByID")
+		}
+		return (*mm_results).resItem, (*mm_results).err
+	}
+	if mmFindTransferByID.funcFindTransferByID != nil {
+		return mmFindTransferByID.funcFindTransferByID(ctx, id, queryParams)
+	}
+	mmFindTransferByID.t.Fatalf("Unexpected call to TransactionUsecaseMock.FindTransferByID. %v %v %v", ctx, id, queryParams)
+	return
+}
+
+// FindTransferByIDAfterCounter returns a count of finished TransactionUsecaseMock.FindTransferByID invocations
+func (mmFindTransferByID *TransactionUsecaseMock) FindTransferByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindTransferByID.afterFindTransferByIDCounter)
+}
+
+// FindTransferByIDBeforeCounter returns a count of TransactionUsecaseMock.FindTransferByID invocations
+func (mmFindTransferByID *TransactionUsecaseMock) FindTransferByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindTransferByID.beforeFindTransferByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.FindTransferByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindTransferByID *mTransactionUsecaseMockFindTransferByID) Calls() []*TransactionUsecaseMockFindTransferByIDParams {
+	mmFindTransferByID.mutex.RLock()
+
+	argCopy := make([]*TransactionUsecaseMockFindTransferByIDParams, len(mmFindTransferByID.callArgs))
+	copy(argCopy, mmFindTransferByID.callArgs)
+
+	mmFindTransferByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindTransferByIDDone returns true if the count of the FindTransferByID invocations corresponds
+// the number of defined expectations
+func (m *TransactionUsecaseMock) MinimockFindTransferByIDDone() bool {
+	if m.FindTransferByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindTransferByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindTransferByIDMock.invocationsDone()
+}
+
+// MinimockFindTransferByIDInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockFindTransferByIDInspect() {
+	for _, e := range m.FindTransferByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindTransferByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindTransferByIDCounter := mm_atomic.LoadUint64(&m.afterFindTransferByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindTransferByIDMock.defaultExpectation != nil && afterFindTransferByIDCounter < 1 {
+		if m.FindTransferByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindTransferByID at\n%s", m.FindTransferByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.FindTransferByID at\n%s with params: %#v", m.FindTransferByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindTransferByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindTransferByID != nil && afterFindTransferByIDCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.FindTransferByID at\n%s", m.funcFindTransferByIDOrigin)
+	}
+
+	if !m.FindTransferByIDMock.invocationsDone() && afterFindTransferByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.FindTransferByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindTransferByIDMock.expectedInvocations), m.FindTransferByIDMock.expectedInvocationsOrigin, afterFindTransferByIDCounter)
+	}
+}
+
+type mTransactionUsecaseMockImportTransactionsFromCSV struct {
+	optional           bool
+	mock               *TransactionUsecaseMock
+	defaultExpectation *TransactionUsecaseMockImportTransactionsFromCSVExpectation
+	expectations       []*TransactionUsecaseMockImportTransactionsFromCSVExpectation
+
+	callArgs []*TransactionUsecaseMockImportTransactionsFromCSVParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransactionUsecaseMockImportTransactionsFromCSVExpectation specifies expectation struct of the TransactionUsecase.ImportTransactionsFromCSV
+type TransactionUsecaseMockImportTransactionsFromCSVExpectation struct {
+	mock               *TransactionUsecaseMock
+	params             *TransactionUsecaseMockImportTransactionsFromCSVParams
+	paramPtrs          *TransactionUsecaseMockImportTransactionsFromCSVParamPtrs
+	expectationOrigins TransactionUsecaseMockImportTransactionsFromCSVExpectationOrigins
+	results            *TransactionUsecaseMockImportTransactionsFromCSVResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TransactionUsecaseMockImportTransactionsFromCSVParams contains parameters of the TransactionUsecase.ImportTransactionsFromCSV
+type TransactionUsecaseMockImportTransactionsFromCSVParams struct {
+	ctx context.Context
+	in  mm_usecase.ImportTransactionsFromCSVInput
+}
+
+// TransactionUsecaseMockImportTransactionsFromCSVParamPtrs contains pointers to parameters of the TransactionUsecase.ImportTransactionsFromCSV
+type TransactionUsecaseMockImportTransactionsFromCSVParamPtrs struct {
+	ctx *context.Context
+	in  *mm_usecase.ImportTransactionsFromCSVInput
+}
+
+// TransactionUsecaseMockImportTransactionsFromCSVResults contains results of the TransactionUsecase.ImportTransactionsFromCSV
+type TransactionUsecaseMockImportTransactionsFromCSVResults struct {
+	resReport *mm_usecase.TransactionImportReport
+	err       error
+}
+
+// TransactionUsecaseMockImportTransactionsFromCSVOrigins contains origins of expectations of the TransactionUsecase.ImportTransactionsFromCSV
+type TransactionUsecaseMockImportTransactionsFromCSVExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) Optional() *mTransactionUsecaseMockImportTransactionsFromCSV {
+	mmImportTransactionsFromCSV.optional = true
+	return mmImportTransactionsFromCSV
+}
+
+// Expect sets up expected params for TransactionUsecase.ImportTransactionsFromCSV
+func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) Expect(ctx context.Context, in mm_usecase.ImportTransactionsFromCSVInput) *mTransactionUsecaseMockImportTransactionsFromCSV {
+	if mmImportTransactionsFromCSV.mock.funcImportTransactionsFromCSV != nil {
+		mmImportTransactionsFromCSV.mock.t.Fatalf("TransactionUsecaseMock.ImportTransactionsFromCSV mock is already set by Set")
+	}
+
+	if mmImportTransactionsFromCSV.defaultExpectation == nil {
+		mmImportTransactionsFromCSV.defaultExpectation = &TransactionUsecaseMockImportTransactionsFromCSVExpectation{}
+	}
+
+	if mmImportTransactionsFromCSV.defaultExpectation.paramPtrs != nil {
+		mmImportTransactionsFromCSV.mock.t.Fatalf("TransactionUsecaseMock.ImportTransactionsFromCSV mock is already set by ExpectParams functions")
+	}
+
+	mmImportTransactionsFromCSV.defaultExpectation.params = &TransactionUsecaseMockImportTransactionsFromCSVParams{ctx, in}
+	mmImportTransactionsFromCSV.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmImportTransactionsFromCSV.expectations {
+		if minimock.Equal(e.params, mmImportTransactionsFromCSV.defaultExpectation.params) {
+			mmImportTransactionsFromCSV.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmImportTransactionsFromCSV.defaultExpectation.params)
+		}
+	}
+
+	return mmImportTransactionsFromCSV
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.ImportTransactionsFromCSV
+func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockImportTransactionsFromCSV {
+	if mmImportTransactionsFromCSV.mock.funcImportTransactionsFromCSV != nil {
+		mmImportTransactionsFromCSV.mock.t.Fatalf("TransactionUsecaseMock.ImportTransactionsFromCSV mock is already set by Set")
+	}
+
+	if mmImportTransactionsFromCSV.defaultExpectation == nil {
+		mmImportTransactionsFromCSV.defaultExpectation = &TransactionUsecaseMockImportTransactionsFromCSVExpectation{}
+	}
+
+	if mmImportTransactionsFromCSV.defaultExpectation.params != nil {
+		mmImportTransactionsFromCSV.mock.t.Fatalf("TransactionUsecaseMock.ImportTransactionsFromCSV mock is already set by Expect")
+	}
+
+	if mmImportTransactionsFromCSV.defaultExpectation.paramPtrs == nil {
+		mmImportTransactionsFromCSV.defaultExpectation.paramPtrs = &TransactionUsecaseMockImportTransactionsFromCSVParamPtrs{}
+	}
+	mmImportTransactionsFromCSV.defaultExpectation.paramPtrs.ctx = &ctx
+	mmImportTransactionsFromCSV.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmImportTransactionsFromCSV
+}
+
+// ExpectInParam2 sets up expected param in for TransactionUsecase.ImportTransactionsFromCSV
+func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) ExpectInParam2(in mm_usecase.ImportTransactionsFromCSVInput) *mTransactionUsecaseMockImportTransactionsFromCSV {
+	if mmImportTransactionsFromCSV.mock.funcImportTransactionsFromCSV != nil {
+		mmImportTransactionsFromCSV.mock.t.Fatalf("TransactionUsecaseMock.ImportTransactionsFromCSV mock is already set by Set")
+	}
+
+	if mmImportTransactionsFromCSV.defaultExpectation == nil {
+		mmImportTransactionsFromCSV.defaultExpectation = &TransactionUsecaseMockImportTransactionsFromCSVExpectation{}
+	}
+
+	if mmImportTransactionsFromCSV.defaultExpectation.params != nil {
+		mmImportTransactionsFromCSV.mock.t.Fatalf("TransactionUsecaseMock.ImportTransactionsFromCSV mock is already set by Expect")
+	}
+
+	if mmImportTransactionsFromCSV.defaultExpectation.paramPtrs == nil {
+		mmImportTransactionsFromCSV.defaultExpectation.paramPtrs = &TransactionUsecaseMockImportTransactionsFromCSVParamPtrs{}
+	}
+	mmImportTransactionsFromCSV.defaultExpectation.paramPtrs.in = &in
+	mmImportTransactionsFromCSV.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmImportTransactionsFromCSV
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.ImportTransactionsFromCSV
+func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) Inspect(f func(ctx context.Context, in mm_usecase.ImportTransactionsFromCSVInput)) *mTransactionUsecaseMockImportTransactionsFromCSV {
+	if mmImportTransactionsFromCSV.mock.inspectFuncImportTransactionsFromCSV != nil {
+		mmImportTransactionsFromCSV.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.ImportTransactionsFromCSV")
+	}
+
+	mmImportTransactionsFromCSV.mock.inspectFuncImportTransactionsFromCSV = f
+
+	return mmImportTransactionsFromCSV
+}
+
+// Return sets up results that will be returned by TransactionUsecase.ImportTransactionsFromCSV
+func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) Return(resReport *mm_usecase.TransactionImportReport, err error) *TransactionUsecaseMock {
+	if mmImportTransactionsFromCSV.mock.funcImportTransactionsFromCSV != nil {
+		mmImportTransactionsFromCSV.mock.t.Fatalf("TransactionUsecaseMock.ImportTransactionsFromCSV mock is already set by Set")
+	}
+
+	if mmImportTransactionsFromCSV.defaultExpectation == nil {
+		mmImportTransactionsFromCSV.defaultExpectation = &TransactionUsecaseMockImportTransactionsFromCSVExpectation{mock: mmImportTransactionsFromCSV.mock}
+	}
+	mmImportTransactionsFromCSV.defaultExpectation.results = &TransactionUsecaseMockImportTransactionsFromCSVResults{resReport, err}
+	mmImportTransactionsFromCSV.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmImportTransactionsFromCSV.mock
+}
+
+// Set uses given function f to mock the TransactionUsecase.ImportTransactionsFromCSV method
+func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) Set(f func(ctx context.Context, in mm_usecase.ImportTransactionsFromCSVInput) (resReport *mm_usecase.TransactionImportReport, err error)) *TransactionUsecaseMock {
+	if mmImportTransactionsFromCSV.defaultExpectation != nil {
+		mmImportTransactionsFromCSV.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.ImportTransactionsFromCSV method")
+	}
+
+	if len(mmImportTransactionsFromCSV.expectations) > 0 {
+		mmImportTransactionsFromCSV.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.ImportTransactionsFromCSV method")
+	}
+
+	mmImportTransactionsFromCSV.mock.funcImportTransactionsFromCSV = f
+	mmImportTransactionsFromCSV.mock.funcImportTransactionsFromCSVOrigin = minimock.CallerInfo(1)
+	return mmImportTransactionsFromCSV.mock
+}
+
+// When sets expectation for the TransactionUsecase.ImportTransactionsFromCSV which will trigger the result defined by the following
+// Then helper
+func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) When(ctx context.Context, in mm_usecase.ImportTransactionsFromCSVInput) *TransactionUsecaseMockImportTransactionsFromCSVExpectation {
+	if mmImportTransactionsFromCSV.mock.funcImportTransactionsFromCSV != nil {
+		mmImportTransactionsFromCSV.mock.t.Fatalf("TransactionUsecaseMock.ImportTransactionsFromCSV mock is already set by Set")
+	}
+
+	expectation := &TransactionUsecaseMockImportTransactionsFromCSVExpectation{
+		mock:               mmImportTransactionsFromCSV.mock,
+		params:             &TransactionUsecaseMockImportTransactionsFromCSVParams{ctx, in},
+		expectationOrigins: TransactionUsecaseMockImportTransactionsFromCSVExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmImportTransactionsFromCSV.expectations = append(mmImportTransactionsFromCSV.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TransactionUsecase.ImportTransactionsFromCSV return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockImportTransactionsFromCSVExpectation) Then(resReport *mm_usecase.TransactionImportReport, err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockImportTransactionsFromCSVResults{resReport, err}
+	return e.mock
+}
+
+// Times sets number of times TransactionUsecase.ImportTransactionsFromCSV should be invoked
+func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) Times(n uint64) *mTransactionUsecaseMockImportTransactionsFromCSV {
+	if n == 0 {
+		mmImportTransactionsFromCSV.mock.t.Fatalf("Times of TransactionUsecaseMock.ImportTransactionsFromCSV mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmImportTransactionsFromCSV.expectedInvocations, n)
+	mmImportTransactionsFromCSV.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmImportTransactionsFromCSV
+}
+
+func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) invocationsDone() bool {
+	if len(mmImportTransactionsFromCSV.expectations) == 0 && mmImportTransactionsFromCSV.defaultExpectation == nil && mmImportTransactionsFromCSV.mock.funcImportTransactionsFromCSV == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmImportTransactionsFromCSV.mock.afterImportTransactionsFromCSVCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmImportTransactionsFromCSV.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ImportTransactionsFromCSV implements mm_usecase.TransactionUsecase
+func (mmImportTransactionsFromCSV *TransactionUsecaseMock) ImportTransactionsFromCSV(ctx context.Context, in mm_usecase.ImportTransactionsFromCSVInput) (resReport *mm_usecase.TransactionImportReport, err error) {
+	mm_atomic.AddUint64(&mmImportTransactionsFromCSV.beforeImportTransactionsFromCSVCounter, 1)
+	defer mm_atomic.AddUint64(&mmImportTransactionsFromCSV.afterImportTransactionsFromCSVCounter, 1)
+
+	mmImportTransactionsFromCSV.t.Helper()
+
+	if mmImportTransactionsFromCSV.inspectFuncImportTransactionsFromCSV != nil {
+		mmImportTransactionsFromCSV.inspectFuncImportTransactionsFromCSV(ctx, in)
+	}
+
+	mm_params := TransactionUsecaseMockImportTransactionsFromCSVParams{ctx, in}
+
+	// Record call args
+	mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.mutex.Lock()
+	mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.callArgs = append(mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.callArgs, &mm_params)
+	mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.mutex.Unlock()
+
+	for _, e := range mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resReport, e.results.err
+		}
+	}
+
+	if mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.defaultExpectation.Counter, 1)
+		mm_want := mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.defaultExpectation.params
+		mm_want_ptrs := mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.defaultExpectation.paramPtrs
+
+		mm_got := TransactionUsecaseMockImportTransactionsFromCSVParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmImportTransactionsFromCSV.t.Errorf("TransactionUsecaseMock.ImportTransactionsFromCSV got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmImportTransactionsFromCSV.t.Errorf("TransactionUsecaseMock.ImportTransactionsFromCSV got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmImportTransactionsFromCSV.t.Errorf("TransactionUsecaseMock.ImportTransactionsFromCSV got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.defaultExpectation.results
+		if mm_results == nil {
+			mmImportTransactionsFromCSV.t.Fatal("No results are set for the TransactionUsecaseMock.ImportTransactionsFromCSV")
+		}
+		return (*mm_results).resReport, (*mm_results).err
+	}
+	if mmImportTransactionsFromCSV.funcImportTransactionsFromCSV != nil {
+		return mmImportTransactionsFromCSV.funcImportTransactionsFromCSV(ctx, in)
+	}
+	mmImportTransactionsFromCSV.t.Fatalf("Unexpected call to TransactionUsecaseMock.ImportTransactionsFromCSV. %v %v", ctx, in)
+	return
+}
+
+// ImportTransactionsFromCSVAfterCounter returns a count of finished TransactionUsecaseMock.ImportTransactionsFromCSV invocations
+func (mmImportTransactionsFromCSV *TransactionUsecaseMock) ImportTransactionsFromCSVAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImportTransactionsFromCSV.afterImportTransactionsFromCSVCounter)
+}
+
+// ImportTransactionsFromCSVBeforeCounter returns a count of TransactionUsecaseMock.ImportTransactionsFromCSV invocations
+func (mmImportTransactionsFromCSV *TransactionUsecaseMock) ImportTransactionsFromCSVBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImportTransactionsFromCSV.beforeImportTransactionsFromCSVCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.ImportTransactionsFromCSV.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) Calls() []*TransactionUsecaseMockImportTransactionsFromCSVParams {
+	mmImportTransactionsFromCSV.mutex.RLock()
+
+	argCopy := make([]*TransactionUsecaseMockImportTransactionsFromCSVParams, len(mmImportTransactionsFromCSV.callArgs))
+	copy(argCopy, mmImportTransactionsFromCSV.callArgs)
+
+	mmImportTransactionsFromCSV.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockImportTransactionsFromCSVDone returns true if the count of the ImportTransactionsFromCSV invocations corresponds
+// the number of defined expectations
+func (m *TransactionUsecaseMock) MinimockImportTransactionsFromCSVDone() bool {
+	if m.ImportTransactionsFromCSVMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ImportTransactionsFromCSVMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ImportTransactionsFromCSVMock.invocationsDone()
+}
+
+// MinimockImportTransactionsFromCSVInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockImportTransactionsFromCSVInspect() {
+	for _, e := range m.ImportTransactionsFromCSVMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.ImportTransactionsFromCSV at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterImportTransactionsFromCSVCounter := mm_atomic.LoadUint64(&m.afterImportTransactionsFromCSVCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ImportTransactionsFromCSVMock.defaultExpectation != nil && afterImportTransactionsFromCSVCounter < 1 {
+		if m.ImportTransactionsFromCSVMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.ImportTransactionsFromCSV at\n%s", m.ImportTransactionsFromCSVMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.ImportTransactionsFromCSV at\n%s with params: %#v", m.ImportTransactionsFromCSVMock.defaultExpectation.expectationOrigins.origin, *m.ImportTransactionsFromCSVMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcImportTransactionsFromCSV != nil && afterImportTransactionsFromCSVCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.ImportTransactionsFromCSV at\n%s", m.funcImportTransactionsFromCSVOrigin)
+	}
+
+	if !m.ImportTransactionsFromCSVMock.invocationsDone() && afterImportTransactionsFromCSVCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.ImportTransactionsFromCSV at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ImportTransactionsFromCSVMock.expectedInvocations), m.ImportTransactionsFromCSVMock.expectedInvocationsOrigin, afterImportTransactionsFromCSVCounter)
+	}
+}
+
+type mTransactionUsecaseMockPatchTransactionByDTO struct {
+	optional           bool
+	mock               *TransactionUsecaseMock
+	defaultExpectation *TransactionUsecaseMockPatchTransactionByDTOExpectation
+	expectations       []*TransactionUsecaseMockPatchTransactionByDTOExpectation
+
+	callArgs []*TransactionUsecaseMockPatchTransactionByDTOParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransactionUsecaseMockPatchTransactionByDTOExpectation specifies expectation struct of the TransactionUsecase.PatchTransactionByDTO
+type TransactionUsecaseMockPatchTransactionByDTOExpectation struct {
+	mock               *TransactionUsecaseMock
+	params             *TransactionUsecaseMockPatchTransactionByDTOParams
+	paramPtrs          *TransactionUsecaseMockPatchTransactionByDTOParamPtrs
+	expectationOrigins TransactionUsecaseMockPatchTransactionByDTOExpectationOrigins
+	results            *TransactionUsecaseMockPatchTransactionByDTOResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TransactionUsecaseMockPatchTransactionByDTOParams contains parameters of the TransactionUsecase.PatchTransactionByDTO
+type TransactionUsecaseMockPatchTransactionByDTOParams struct {
+	ctx              context.Context
+	id               uuid.UUID
+	in               mm_usecase.PatchTransactionDataInput
+	skipVersionCheck bool
+}
+
+// TransactionUsecaseMockPatchTransactionByDTOParamPtrs contains pointers to parameters of the TransactionUsecase.PatchTransactionByDTO
+type TransactionUsecaseMockPatchTransactionByDTOParamPtrs struct {
+	ctx              *context.Context
+	id               *uuid.UUID
+	in               *mm_usecase.PatchTransactionDataInput
+	skipVersionCheck *bool
+}
+
+// TransactionUsecaseMockPatchTransactionByDTOResults contains results of the TransactionUsecase.PatchTransactionByDTO
+type TransactionUsecaseMockPatchTransactionByDTOResults struct {
+	resWarnings []*entity.BudgetWarning
+	err         error
+}
+
+// TransactionUsecaseMockPatchTransactionByDTOOrigins contains origins of expectations of the TransactionUsecase.PatchTransactionByDTO
+type TransactionUsecaseMockPatchTransactionByDTOExpectationOrigins struct {
+	origin                 string
+	originCtx              string
+	originId               string
+	originIn               string
+	originSkipVersionCheck string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) Optional() *mTransactionUsecaseMockPatchTransactionByDTO {
+	mmPatchTransactionByDTO.optional = true
+	return mmPatchTransactionByDTO
+}
+
+// Expect sets up expected params for TransactionUsecase.PatchTransactionByDTO
+func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) Expect(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransactionDataInput, skipVersionCheck bool) *mTransactionUsecaseMockPatchTransactionByDTO {
+	if mmPatchTransactionByDTO.mock.funcPatchTransactionByDTO != nil {
+		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Set")
+	}
+
+	if mmPatchTransactionByDTO.defaultExpectation == nil {
+		mmPatchTransactionByDTO.defaultExpectation = &TransactionUsecaseMockPatchTransactionByDTOExpectation{}
+	}
+
+	if mmPatchTransactionByDTO.defaultExpectation.paramPtrs != nil {
+		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by ExpectParams functions")
+	}
+
+	mmPatchTransactionByDTO.defaultExpectation.params = &TransactionUsecaseMockPatchTransactionByDTOParams{ctx, id, in, skipVersionCheck}
+	mmPatchTransactionByDTO.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPatchTransactionByDTO.expectations {
+		if minimock.Equal(e.params, mmPatchTransactionByDTO.defaultExpectation.params) {
+			mmPatchTransactionByDTO.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPatchTransactionByDTO.defaultExpectation.params)
+		}
+	}
+
+	return mmPatchTransactionByDTO
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.PatchTransactionByDTO
+func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockPatchTransactionByDTO {
+	if mmPatchTransactionByDTO.mock.funcPatchTransactionByDTO != nil {
+		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Set")
+	}
+
+	if mmPatchTransactionByDTO.defaultExpectation == nil {
+		mmPatchTransactionByDTO.defaultExpectation = &TransactionUsecaseMockPatchTransactionByDTOExpectation{}
+	}
+
+	if mmPatchTransactionByDTO.defaultExpectation.params != nil {
+		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Expect")
+	}
+
+	if mmPatchTransactionByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchTransactionByDTO.defaultExpectation.paramPtrs = &TransactionUsecaseMockPatchTransactionByDTOParamPtrs{}
+	}
+	mmPatchTransactionByDTO.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPatchTransactionByDTO.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPatchTransactionByDTO
+}
+
+// ExpectIdParam2 sets up expected param id for TransactionUsecase.PatchTransactionByDTO
+func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) ExpectIdParam2(id uuid.UUID) *mTransactionUsecaseMockPatchTransactionByDTO {
+	if mmPatchTransactionByDTO.mock.funcPatchTransactionByDTO != nil {
+		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Set")
+	}
+
+	if mmPatchTransactionByDTO.defaultExpectation == nil {
+		mmPatchTransactionByDTO.defaultExpectation = &TransactionUsecaseMockPatchTransactionByDTOExpectation{}
+	}
+
+	if mmPatchTransactionByDTO.defaultExpectation.params != nil {
+		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Expect")
+	}
+
+	if mmPatchTransactionByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchTransactionByDTO.defaultExpectation.paramPtrs = &TransactionUsecaseMockPatchTransactionByDTOParamPtrs{}
+	}
+	mmPatchTransactionByDTO.defaultExpectation.paramPtrs.id = &id
+	mmPatchTransactionByDTO.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmPatchTransactionByDTO
+}
+
+// ExpectInParam3 sets up expected param in for TransactionUsecase.PatchTransactionByDTO
+func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) ExpectInParam3(in mm_usecase.PatchTransactionDataInput) *mTransactionUsecaseMockPatchTransactionByDTO {
+	if mmPatchTransactionByDTO.mock.funcPatchTransactionByDTO != nil {
+		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Set")
+	}
+
+	if mmPatchTransactionByDTO.defaultExpectation == nil {
+		mmPatchTransactionByDTO.defaultExpectation = &TransactionUsecaseMockPatchTransactionByDTOExpectation{}
+	}
+
+	if mmPatchTransactionByDTO.defaultExpectation.params != nil {
+		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Expect")
+	}
+
+	if mmPatchTransactionByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchTransactionByDTO.defaultExpectation.paramPtrs = &TransactionUsecaseMockPatchTransactionByDTOParamPtrs{}
+	}
+	mmPatchTransactionByDTO.defaultExpectation.paramPtrs.in = &in
+	mmPatchTransactionByDTO.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmPatchTransactionByDTO
+}
+
+// ExpectSkipVersionCheckParam4 sets up expected param skipVersionCheck for TransactionUsecase.PatchTransactionByDTO
+func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) ExpectSkipVersionCheckParam4(skipVersionCheck bool) *mTransactionUsecaseMockPatchTransactionByDTO {
+	if mmPatchTransactionByDTO.mock.funcPatchTransactionByDTO != nil {
+		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Set")
+	}
+
+	if mmPatchTransactionByDTO.defaultExpectation == nil {
+		mmPatchTransactionByDTO.defaultExpectation = &TransactionUsecaseMockPatchTransactionByDTOExpectation{}
+	}
+
+	if mmPatchTransactionByDTO.defaultExpectation.params != nil {
+		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Expect")
+	}
+
+	if mmPatchTransactionByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchTransactionByDTO.defaultExpectation.paramPtrs = &TransactionUsecaseMockPatchTransactionByDTOParamPtrs{}
+	}
+	mmPatchTransactionByDTO.defaultExpectation.paramPtrs.skipVersionCheck = &skipVersionCheck
+	mmPatchTransactionByDTO.defaultExpectation.expectationOrigins.originSkipVersionCheck = minimock.CallerInfo(1)
+
+	return mmPatchTransactionByDTO
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.PatchTransactionByDTO
+func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) Inspect(f func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransactionDataInput, skipVersionCheck bool)) *mTransactionUsecaseMockPatchTransactionByDTO {
+	if mmPatchTransactionByDTO.mock.inspectFuncPatchTransactionByDTO != nil {
+		mmPatchTransactionByDTO.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.PatchTransactionByDTO")
+	}
+
+	mmPatchTransactionByDTO.mock.inspectFuncPatchTransactionByDTO = f
+
+	return mmPatchTransactionByDTO
+}
+
+// Return sets up results that will be returned by TransactionUsecase.PatchTransactionByDTO
+func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) Return(resWarnings []*entity.BudgetWarning, err error) *TransactionUsecaseMock {
+	if mmPatchTransactionByDTO.mock.funcPatchTransactionByDTO != nil {
+		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Set")
+	}
+
+	if mmPatchTransactionByDTO.defaultExpectation == nil {
+		mmPatchTransactionByDTO.defaultExpectation = &TransactionUsecaseMockPatchTransactionByDTOExpectation{mock: mmPatchTransactionByDTO.mock}
+	}
+	mmPatchTransactionByDTO.defaultExpectation.results = &TransactionUsecaseMockPatchTransactionByDTOResults{resWarnings, err}
+	mmPatchTransactionByDTO.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPatchTransactionByDTO.mock
+}
+
+// Set uses given function f to mock the TransactionUsecase.PatchTransactionByDTO method
+func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) Set(f func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransactionDataInput, skipVersionCheck bool) (resWarnings []*entity.BudgetWarning, err error)) *TransactionUsecaseMock {
+	if mmPatchTransactionByDTO.defaultExpectation != nil {
+		mmPatchTransactionByDTO.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.PatchTransactionByDTO method")
 	}
 
-	if len(mmFindOneByID.expectations) > 0 {
-		mmFindOneByID.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.FindOneByID method")
+	if len(mmPatchTransactionByDTO.expectations) > 0 {
+		mmPatchTransactionByDTO.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.PatchTransactionByDTO method")
 	}
 
-	mmFindOneByID.mock.funcFindOneByID = f
-	mmFindOneByID.mock.funcFindOneByIDOrigin = minimock.CallerInfo(1)
-	return mmFindOneByID.mock
+	mmPatchTransactionByDTO.mock.funcPatchTransactionByDTO = f
+	mmPatchTransactionByDTO.mock.funcPatchTransactionByDTOOrigin = minimock.CallerInfo(1)
+	return mmPatchTransactionByDTO.mock
 }
 
-// When sets expectation for the TransactionUsecase.FindOneByID which will trigger the result defined by the following
+// When sets expectation for the TransactionUsecase.PatchTransactionByDTO which will trigger the result defined by the following
 // Then helper
-func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) When(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *TransactionUsecaseMockFindOneByIDExpectation {
-	if mmFindOneByID.mock.funcFindOneByID != nil {
-		mmFindOneByID.mock.t.Fatalf("TransactionUsecaseMock.FindOneByID mock is already set by Set")
+func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) When(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransactionDataInput, skipVersionCheck bool) *TransactionUsecaseMockPatchTransactionByDTOExpectation {
+	if mmPatchTransactionByDTO.mock.funcPatchTransactionByDTO != nil {
+		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Set")
 	}
 
-	expectation := &TransactionUsecaseMockFindOneByIDExpectation{
-		mock:               mmFindOneByID.mock,
-		params:             &TransactionUsecaseMockFindOneByIDParams{ctx, id, queryParams},
-		expectationOrigins: TransactionUsecaseMockFindOneByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &TransactionUsecaseMockPatchTransactionByDTOExpectation{
+		mock:               mmPatchTransactionByDTO.mock,
+		params:             &TransactionUsecaseMockPatchTransactionByDTOParams{ctx, id, in, skipVersionCheck},
+		expectationOrigins: TransactionUsecaseMockPatchTransactionByDTOExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmFindOneByID.expectations = append(mmFindOneByID.expectations, expectation)
+	mmPatchTransactionByDTO.expectations = append(mmPatchTransactionByDTO.expectations, expectation)
 	return expectation
 }
 
-// Then sets up TransactionUsecase.FindOneByID return parameters for the expectation previously defined by the When method
-func (e *TransactionUsecaseMockFindOneByIDExpectation) Then(resItem *mm_usecase.TransactionDTO, err error) *TransactionUsecaseMock {
-	e.results = &TransactionUsecaseMockFindOneByIDResults{resItem, err}
+// Then sets up TransactionUsecase.PatchTransactionByDTO return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockPatchTransactionByDTOExpectation) Then(resWarnings []*entity.BudgetWarning, err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockPatchTransactionByDTOResults{resWarnings, err}
 	return e.mock
 }
 
-// Times sets number of times TransactionUsecase.FindOneByID should be invoked
-func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) Times(n uint64) *mTransactionUsecaseMockFindOneByID {
+// Times sets number of times TransactionUsecase.PatchTransactionByDTO should be invoked
+func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) Times(n uint64) *mTransactionUsecaseMockPatchTransactionByDTO {
 	if n == 0 {
-		mmFindOneByID.mock.t.Fatalf("Times of TransactionUsecaseMock.FindOneByID mock can not be zero")
+		mmPatchTransactionByDTO.mock.t.Fatalf("Times of TransactionUsecaseMock.PatchTransactionByDTO mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmFindOneByID.expectedInvocations, n)
-	mmFindOneByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmFindOneByID
+	mm_atomic.StoreUint64(&mmPatchTransactionByDTO.expectedInvocations, n)
+	mmPatchTransactionByDTO.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPatchTransactionByDTO
 }
 
-func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) invocationsDone() bool {
-	if len(mmFindOneByID.expectations) == 0 && mmFindOneByID.defaultExpectation == nil && mmFindOneByID.mock.funcFindOneByID == nil {
+func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) invocationsDone() bool {
+	if len(mmPatchTransactionByDTO.expectations) == 0 && mmPatchTransactionByDTO.defaultExpectation == nil && mmPatchTransactionByDTO.mock.funcPatchTransactionByDTO == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmFindOneByID.mock.afterFindOneByIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmFindOneByID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmPatchTransactionByDTO.mock.afterPatchTransactionByDTOCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPatchTransactionByDTO.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// FindOneByID implements mm_usecase.TransactionUsecase
-func (mmFindOneByID *TransactionUsecaseMock) FindOneByID(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.TransactionDTO, err error) {
-	mm_atomic.AddUint64(&mmFindOneByID.beforeFindOneByIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmFindOneByID.afterFindOneByIDCounter, 1)
+// PatchTransactionByDTO implements mm_usecase.TransactionUsecase
+func (mmPatchTransactionByDTO *TransactionUsecaseMock) PatchTransactionByDTO(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransactionDataInput, skipVersionCheck bool) (resWarnings []*entity.BudgetWarning, err error) {
+	mm_atomic.AddUint64(&mmPatchTransactionByDTO.beforePatchTransactionByDTOCounter, 1)
+	defer mm_atomic.AddUint64(&mmPatchTransactionByDTO.afterPatchTransactionByDTOCounter, 1)
 
-	mmFindOneByID.t.Helper()
+	mmPatchTransactionByDTO.t.Helper()
 
-	if mmFindOneByID.inspectFuncFindOneByID != nil {
-		mmFindOneByID.inspectFuncFindOneByID(ctx, id, queryParams)
+	if mmPatchTransactionByDTO.inspectFuncPatchTransactionByDTO != nil {
+		mmPatchTransactionByDTO.inspectFuncPatchTransactionByDTO(ctx, id, in, skipVersionCheck)
 	}
 
-	mm_params := TransactionUsecaseMockFindOneByIDParams{ctx, id, queryParams}
+	mm_params := TransactionUsecaseMockPatchTransactionByDTOParams{ctx, id, in, skipVersionCheck}
 
 	// Record call args
-	mmFindOneByID.FindOneByIDMock.mutex.Lock()
-	mmFindOneByID.FindOneByIDMock.callArgs = append(mmFindOneByID.FindOneByIDMock.callArgs, &mm_params)
-	mmFindOneByID.FindOneByIDMock.mutex.Unlock()
+	mmPatchTransactionByDTO.PatchTransactionByDTOMock.mutex.Lock()
+	mmPatchTransactionByDTO.PatchTransactionByDTOMock.callArgs = append(mmPatchTransactionByDTO.PatchTransactionByDTOMock.callArgs, &mm_params)
+	mmPatchTransactionByDTO.PatchTransactionByDTOMock.mutex.Unlock()
 
-	for _, e := range mmFindOneByID.FindOneByIDMock.expectations {
+	for _, e := range mmPatchTransactionByDTO.PatchTransactionByDTOMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.resItem, e.results.err
+			return e.results.resWarnings, e.results.err
 		}
 	}
 
-	if mmFindOneByID.FindOneByIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmFindOneByID.FindOneByIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmFindOneByID.FindOneByIDMock.defaultExpectation.params
-		mm_want_ptrs := mmFindOneByID.FindOneByIDMock.defaultExpectation.paramPtrs
+	if mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation.Counter, 1)
+		mm_want := mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation.params
+		mm_want_ptrs := mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation.paramPtrs
 
-		mm_got := TransactionUsecaseMockFindOneByIDParams{ctx, id, queryParams}
+		mm_got := TransactionUsecaseMockPatchTransactionByDTOParams{ctx, id, in, skipVersionCheck}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmFindOneByID.t.Errorf("TransactionUsecaseMock.FindOneByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmPatchTransactionByDTO.t.Errorf("TransactionUsecaseMock.PatchTransactionByDTO got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmFindOneByID.t.Errorf("TransactionUsecaseMock.FindOneByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+				mmPatchTransactionByDTO.t.Errorf("TransactionUsecaseMock.PatchTransactionByDTO got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
-			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
-				mmFindOneByID.t.Errorf("TransactionUsecaseMock.FindOneByID got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmPatchTransactionByDTO.t.Errorf("TransactionUsecaseMock.PatchTransactionByDTO got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+			if mm_want_ptrs.skipVersionCheck != nil && !minimock.Equal(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck) {
+				mmPatchTransactionByDTO.t.Errorf("TransactionUsecaseMock.PatchTransactionByDTO got unexpected parameter skipVersionCheck, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation.expectationOrigins.originSkipVersionCheck, *mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck, minimock.Diff(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmFindOneByID.t.Errorf("TransactionUsecaseMock.FindOneByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmPatchTransactionByDTO.t.Errorf("TransactionUsecaseMock.PatchTransactionByDTO got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmFindOneByID.FindOneByIDMock.defaultExpectation.results
+		mm_results := mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation.results
 		if mm_results == nil {
-			mmFindOneByID.t.Fatal("No results are set for the TransactionUsecaseMock.FindOneByID")
+			mmPatchTransactionByDTO.t.Fatal("No results are set for the TransactionUsecaseMock.PatchTransactionByDTO")
 		}
-		return (*mm_results).resItem, (*mm_results).err
+		return (*mm_results).resWarnings, (*mm_results).err
 	}
-	if mmFindOneByID.funcFindOneByID != nil {
-		return mmFindOneByID.funcFindOneByID(ctx, id, queryParams)
+	if mmPatchTransactionByDTO.funcPatchTransactionByDTO != nil {
+		return mmPatchTransactionByDTO.funcPatchTransactionByDTO(ctx, id, in, skipVersionCheck)
 	}
-	mmFindOneByID.t.Fatalf("Unexpected call to TransactionUsecaseMock.FindOneByID. %v %v %v", ctx, id, queryParams)
+	mmPatchTransactionByDTO.t.Fatalf("Unexpected call to TransactionUsecaseMock.PatchTransactionByDTO. %v %v %v %v", ctx, id, in, skipVersionCheck)
 	return
 }
 
-// FindOneByIDAfterCounter returns a count of finished TransactionUsecaseMock.FindOneByID invocations
-func (mmFindOneByID *TransactionUsecaseMock) FindOneByIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFindOneByID.afterFindOneByIDCounter)
+// PatchTransactionByDTOAfterCounter returns a count of finished TransactionUsecaseMock.PatchTransactionByDTO invocations
+func (mmPatchTransactionByDTO *TransactionUsecaseMock) PatchTransactionByDTOAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPatchTransactionByDTO.afterPatchTransactionByDTOCounter)
 }
 
-// FindOneByIDBeforeCounter returns a count of TransactionUsecaseMock.FindOneByID invocations
-func (mmFindOneByID *TransactionUsecaseMock) FindOneByIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFindOneByID.beforeFindOneByIDCounter)
+// PatchTransactionByDTOBeforeCounter returns a count of TransactionUsecaseMock.PatchTransactionByDTO invocations
+func (mmPatchTransactionByDTO *TransactionUsecaseMock) PatchTransactionByDTOBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPatchTransactionByDTO.beforePatchTransactionByDTOCounter)
 }
 
-// Calls returns a list of arguments used in each call to TransactionUsecaseMock.FindOneByID.
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.PatchTransactionByDTO.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmFindOneByID *mTransactionUsecaseMockFindOneByID) Calls() []*TransactionUsecaseMockFindOneByIDParams {
-	mmFindOneByID.mutex.RLock()
+func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) Calls() []*TransactionUsecaseMockPatchTransactionByDTOParams {
+	mmPatchTransactionByDTO.mutex.RLock()
 
-	argCopy := make([]*TransactionUsecaseMockFindOneByIDParams, len(mmFindOneByID.callArgs))
-	copy(argCopy, mmFindOneByID.callArgs)
+	argCopy := make([]*TransactionUsecaseMockPatchTransactionByDTOParams, len(mmPatchTransactionByDTO.callArgs))
+	copy(argCopy, mmPatchTransactionByDTO.callArgs)
 
-	mmFindOneByID.mutex.RUnlock()
+	mmPatchTransactionByDTO.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockFindOneByIDDone returns true if the count of the FindOneByID invocations corresponds
+// MinimockPatchTransactionByDTODone returns true if the count of the PatchTransactionByDTO invocations corresponds
 // the number of defined expectations
-func (m *TransactionUsecaseMock) MinimockFindOneByIDDone() bool {
-	if m.FindOneByIDMock.optional {
+func (m *TransactionUsecaseMock) MinimockPatchTransactionByDTODone() bool {
+	if m.PatchTransactionByDTOMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.FindOneByIDMock.expectations {
+	for _, e := range m.PatchTransactionByDTOMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.FindOneByIDMock.invocationsDone()
+	return m.PatchTransactionByDTOMock.invocationsDone()
 }
 
-// MinimockFindOneByIDInspect logs each unmet expectation
-func (m *TransactionUsecaseMock) MinimockFindOneByIDInspect() {
-	for _, e := range m.FindOneByIDMock.expectations {
+// MinimockPatchTransactionByDTOInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockPatchTransactionByDTOInspect() {
+	for _, e := range m.PatchTransactionByDTOMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.FindOneByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to TransactionUsecaseMock.PatchTransactionByDTO at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterFindOneByIDCounter := mm_atomic.LoadUint64(&m.afterFindOneByIDCounter)
+	afterPatchTransactionByDTOCounter := mm_atomic.LoadUint64(&m.afterPatchTransactionByDTOCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.FindOneByIDMock.defaultExpectation != nil && afterFindOneByIDCounter < 1 {
-		if m.FindOneByIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.FindOneByID at\n%s", m.FindOneByIDMock.defaultExpectation.returnOrigin)
+	if m.PatchTransactionByDTOMock.defaultExpectation != nil && afterPatchTransactionByDTOCounter < 1 {
+		if m.PatchTransactionByDTOMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.PatchTransactionByDTO at\n%s", m.PatchTransactionByDTOMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.FindOneByID at\n%s with params: %#v", m.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindOneByIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to TransactionUsecaseMock.PatchTransactionByDTO at\n%s with params: %#v", m.PatchTransactionByDTOMock.defaultExpectation.expectationOrigins.origin, *m.PatchTransactionByDTOMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcFindOneByID != nil && afterFindOneByIDCounter < 1 {
-		m.t.Errorf("Expected call to TransactionUsecaseMock.FindOneByID at\n%s", m.funcFindOneByIDOrigin)
+	if m.funcPatchTransactionByDTO != nil && afterPatchTransactionByDTOCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.PatchTransactionByDTO at\n%s", m.funcPatchTransactionByDTOOrigin)
 	}
 
-	if !m.FindOneByIDMock.invocationsDone() && afterFindOneByIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.FindOneByID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.FindOneByIDMock.expectedInvocations), m.FindOneByIDMock.expectedInvocationsOrigin, afterFindOneByIDCounter)
+	if !m.PatchTransactionByDTOMock.invocationsDone() && afterPatchTransactionByDTOCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.PatchTransactionByDTO at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PatchTransactionByDTOMock.expectedInvocations), m.PatchTransactionByDTOMock.expectedInvocationsOrigin, afterPatchTransactionByDTOCounter)
 	}
 }
 
-type mTransactionUsecaseMockFindPagedList struct {
+type mTransactionUsecaseMockPatchTransferByDTO struct {
 	optional           bool
 	mock               *TransactionUsecaseMock
-	defaultExpectation *TransactionUsecaseMockFindPagedListExpectation
-	expectations       []*TransactionUsecaseMockFindPagedListExpectation
+	defaultExpectation *TransactionUsecaseMockPatchTransferByDTOExpectation
+	expectations       []*TransactionUsecaseMockPatchTransferByDTOExpectation
 
-	callArgs []*TransactionUsecaseMockFindPagedListParams
+	callArgs []*TransactionUsecaseMockPatchTransferByDTOParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// TransactionUsecaseMockFindPagedListExpectation specifies expectation struct of the TransactionUsecase.FindPagedList
-type TransactionUsecaseMockFindPagedListExpectation struct {
+// TransactionUsecaseMockPatchTransferByDTOExpectation specifies expectation struct of the TransactionUsecase.PatchTransferByDTO
+type TransactionUsecaseMockPatchTransferByDTOExpectation struct {
 	mock               *TransactionUsecaseMock
-	params             *TransactionUsecaseMockFindPagedListParams
-	paramPtrs          *TransactionUsecaseMockFindPagedListParamPtrs
-	expectationOrigins TransactionUsecaseMockFindPagedListExpectationOrigins
-	results            *TransactionUsecaseMockFindPagedListResults
+	params             *TransactionUsecaseMockPatchTransferByDTOParams
+	paramPtrs          *TransactionUsecaseMockPatchTransferByDTOParamPtrs
+	expectationOrigins TransactionUsecaseMockPatchTransferByDTOExpectationOrigins
+	results            *TransactionUsecaseMockPatchTransferByDTOResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// TransactionUsecaseMockFindPagedListParams contains parameters of the TransactionUsecase.FindPagedList
-type TransactionUsecaseMockFindPagedListParams struct {
-	ctx         context.Context
-	listOptions *mm_usecase.TransactionListOptions
-	queryParams *uctypes.QueryGetListParams
+// TransactionUsecaseMockPatchTransferByDTOParams contains parameters of the TransactionUsecase.PatchTransferByDTO
+type TransactionUsecaseMockPatchTransferByDTOParams struct {
+	ctx              context.Context
+	id               uuid.UUID
+	in               mm_usecase.PatchTransferDataInput
+	skipVersionCheck bool
 }
 
-// TransactionUsecaseMockFindPagedListParamPtrs contains pointers to parameters of the TransactionUsecase.FindPagedList
-type TransactionUsecaseMockFindPagedListParamPtrs struct {
-	ctx         *context.Context
-	listOptions **mm_usecase.TransactionListOptions
-	queryParams **uctypes.QueryGetListParams
+// TransactionUsecaseMockPatchTransferByDTOParamPtrs contains pointers to parameters of the TransactionUsecase.PatchTransferByDTO
+type TransactionUsecaseMockPatchTransferByDTOParamPtrs struct {
+	ctx              *context.Context
+	id               *uuid.UUID
+	in               *mm_usecase.PatchTransferDataInput
+	skipVersionCheck *bool
 }
 
-// TransactionUsecaseMockFindPagedListResults contains results of the TransactionUsecase.FindPagedList
-type TransactionUsecaseMockFindPagedListResults struct {
-	resItems []*mm_usecase.TransactionDTO
-	total    uint64
-	err      error
+// TransactionUsecaseMockPatchTransferByDTOResults contains results of the TransactionUsecase.PatchTransferByDTO
+type TransactionUsecaseMockPatchTransferByDTOResults struct {
+	err error
 }
 
-// TransactionUsecaseMockFindPagedListOrigins contains origins of expectations of the TransactionUsecase.FindPagedList
-type TransactionUsecaseMockFindPagedListExpectationOrigins struct {
-	origin            string
-	originCtx         string
-	originListOptions string
-	originQueryParams string
+// TransactionUsecaseMockPatchTransferByDTOOrigins contains origins of expectations of the TransactionUsecase.PatchTransferByDTO
+type TransactionUsecaseMockPatchTransferByDTOExpectationOrigins struct {
+	origin                 string
+	originCtx              string
+	originId               string
+	originIn               string
+	originSkipVersionCheck string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -2345,374 +6572,398 @@ type TransactionUsecaseMockFindPagedListExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) Optional() *mTransactionUsecaseMockFindPagedList {
-	mmFindPagedList.optional = true
-	return mmFindPagedList
+func (mmPatchTransferByDTO *mTransactionUsecaseMockPatchTransferByDTO) Optional() *mTransactionUsecaseMockPatchTransferByDTO {
+	mmPatchTransferByDTO.optional = true
+	return mmPatchTransferByDTO
 }
 
-// Expect sets up expected params for TransactionUsecase.FindPagedList
-func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) Expect(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) *mTransactionUsecaseMockFindPagedList {
-	if mmFindPagedList.mock.funcFindPagedList != nil {
-		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by Set")
+// Expect sets up expected params for TransactionUsecase.PatchTransferByDTO
+func (mmPatchTransferByDTO *mTransactionUsecaseMockPatchTransferByDTO) Expect(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransferDataInput, skipVersionCheck bool) *mTransactionUsecaseMockPatchTransferByDTO {
+	if mmPatchTransferByDTO.mock.funcPatchTransferByDTO != nil {
+		mmPatchTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransferByDTO mock is already set by Set")
 	}
 
-	if mmFindPagedList.defaultExpectation == nil {
-		mmFindPagedList.defaultExpectation = &TransactionUsecaseMockFindPagedListExpectation{}
+	if mmPatchTransferByDTO.defaultExpectation == nil {
+		mmPatchTransferByDTO.defaultExpectation = &TransactionUsecaseMockPatchTransferByDTOExpectation{}
 	}
 
-	if mmFindPagedList.defaultExpectation.paramPtrs != nil {
-		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by ExpectParams functions")
+	if mmPatchTransferByDTO.defaultExpectation.paramPtrs != nil {
+		mmPatchTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransferByDTO mock is already set by ExpectParams functions")
 	}
 
-	mmFindPagedList.defaultExpectation.params = &TransactionUsecaseMockFindPagedListParams{ctx, listOptions, queryParams}
-	mmFindPagedList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmFindPagedList.expectations {
-		if minimock.Equal(e.params, mmFindPagedList.defaultExpectation.params) {
-			mmFindPagedList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindPagedList.defaultExpectation.params)
+	mmPatchTransferByDTO.defaultExpectation.params = &TransactionUsecaseMockPatchTransferByDTOParams{ctx, id, in, skipVersionCheck}
+	mmPatchTransferByDTO.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPatchTransferByDTO.expectations {
+		if minimock.Equal(e.params, mmPatchTransferByDTO.defaultExpectation.params) {
+			mmPatchTransferByDTO.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPatchTransferByDTO.defaultExpectation.params)
 		}
 	}
 
-	return mmFindPagedList
+	return mmPatchTransferByDTO
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.PatchTransferByDTO
+func (mmPatchTransferByDTO *mTransactionUsecaseMockPatchTransferByDTO) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockPatchTransferByDTO {
+	if mmPatchTransferByDTO.mock.funcPatchTransferByDTO != nil {
+		mmPatchTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransferByDTO mock is already set by Set")
+	}
+
+	if mmPatchTransferByDTO.defaultExpectation == nil {
+		mmPatchTransferByDTO.defaultExpectation = &TransactionUsecaseMockPatchTransferByDTOExpectation{}
+	}
+
+	if mmPatchTransferByDTO.defaultExpectation.params != nil {
+		mmPatchTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransferByDTO mock is already set by Expect")
+	}
+
+	if mmPatchTransferByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchTransferByDTO.defaultExpectation.paramPtrs = &TransactionUsecaseMockPatchTransferByDTOParamPtrs{}
+	}
+	mmPatchTransferByDTO.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPatchTransferByDTO.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPatchTransferByDTO
 }
 
-// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.FindPagedList
-func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockFindPagedList {
-	if mmFindPagedList.mock.funcFindPagedList != nil {
-		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for TransactionUsecase.PatchTransferByDTO
+func (mmPatchTransferByDTO *mTransactionUsecaseMockPatchTransferByDTO) ExpectIdParam2(id uuid.UUID) *mTransactionUsecaseMockPatchTransferByDTO {
+	if mmPatchTransferByDTO.mock.funcPatchTransferByDTO != nil {
+		mmPatchTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransferByDTO mock is already set by Set")
 	}
 
-	if mmFindPagedList.defaultExpectation == nil {
-		mmFindPagedList.defaultExpectation = &TransactionUsecaseMockFindPagedListExpectation{}
+	if mmPatchTransferByDTO.defaultExpectation == nil {
+		mmPatchTransferByDTO.defaultExpectation = &TransactionUsecaseMockPatchTransferByDTOExpectation{}
 	}
 
-	if mmFindPagedList.defaultExpectation.params != nil {
-		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by Expect")
+	if mmPatchTransferByDTO.defaultExpectation.params != nil {
+		mmPatchTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransferByDTO mock is already set by Expect")
 	}
 
-	if mmFindPagedList.defaultExpectation.paramPtrs == nil {
-		mmFindPagedList.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindPagedListParamPtrs{}
+	if mmPatchTransferByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchTransferByDTO.defaultExpectation.paramPtrs = &TransactionUsecaseMockPatchTransferByDTOParamPtrs{}
 	}
-	mmFindPagedList.defaultExpectation.paramPtrs.ctx = &ctx
-	mmFindPagedList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmPatchTransferByDTO.defaultExpectation.paramPtrs.id = &id
+	mmPatchTransferByDTO.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmFindPagedList
+	return mmPatchTransferByDTO
 }
 
-// ExpectListOptionsParam2 sets up expected param listOptions for TransactionUsecase.FindPagedList
-func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) ExpectListOptionsParam2(listOptions *mm_usecase.TransactionListOptions) *mTransactionUsecaseMockFindPagedList {
-	if mmFindPagedList.mock.funcFindPagedList != nil {
-		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by Set")
+// ExpectInParam3 sets up expected param in for TransactionUsecase.PatchTransferByDTO
+func (mmPatchTransferByDTO *mTransactionUsecaseMockPatchTransferByDTO) ExpectInParam3(in mm_usecase.PatchTransferDataInput) *mTransactionUsecaseMockPatchTransferByDTO {
+	if mmPatchTransferByDTO.mock.funcPatchTransferByDTO != nil {
+		mmPatchTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransferByDTO mock is already set by Set")
 	}
 
-	if mmFindPagedList.defaultExpectation == nil {
-		mmFindPagedList.defaultExpectation = &TransactionUsecaseMockFindPagedListExpectation{}
+	if mmPatchTransferByDTO.defaultExpectation == nil {
+		mmPatchTransferByDTO.defaultExpectation = &TransactionUsecaseMockPatchTransferByDTOExpectation{}
 	}
 
-	if mmFindPagedList.defaultExpectation.params != nil {
-		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by Expect")
+	if mmPatchTransferByDTO.defaultExpectation.params != nil {
+		mmPatchTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransferByDTO mock is already set by Expect")
 	}
 
-	if mmFindPagedList.defaultExpectation.paramPtrs == nil {
-		mmFindPagedList.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindPagedListParamPtrs{}
+	if mmPatchTransferByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchTransferByDTO.defaultExpectation.paramPtrs = &TransactionUsecaseMockPatchTransferByDTOParamPtrs{}
 	}
-	mmFindPagedList.defaultExpectation.paramPtrs.listOptions = &listOptions
-	mmFindPagedList.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+	mmPatchTransferByDTO.defaultExpectation.paramPtrs.in = &in
+	mmPatchTransferByDTO.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
 
-	return mmFindPagedList
+	return mmPatchTransferByDTO
 }
 
-// ExpectQueryParamsParam3 sets up expected param queryParams for TransactionUsecase.FindPagedList
-func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mTransactionUsecaseMockFindPagedList {
-	if mmFindPagedList.mock.funcFindPagedList != nil {
-		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by Set")
+// ExpectSkipVersionCheckParam4 sets up expected param skipVersionCheck for TransactionUsecase.PatchTransferByDTO
+func (mmPatchTransferByDTO *mTransactionUsecaseMockPatchTransferByDTO) ExpectSkipVersionCheckParam4(skipVersionCheck bool) *mTransactionUsecaseMockPatchTransferByDTO {
+	if mmPatchTransferByDTO.mock.funcPatchTransferByDTO != nil {
+		mmPatchTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransferByDTO mock is already set by Set")
 	}
 
-	if mmFindPagedList.defaultExpectation == nil {
-		mmFindPagedList.defaultExpectation = &TransactionUsecaseMockFindPagedListExpectation{}
+	if mmPatchTransferByDTO.defaultExpectation == nil {
+		mmPatchTransferByDTO.defaultExpectation = &TransactionUsecaseMockPatchTransferByDTOExpectation{}
 	}
 
-	if mmFindPagedList.defaultExpectation.params != nil {
-		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by Expect")
+	if mmPatchTransferByDTO.defaultExpectation.params != nil {
+		mmPatchTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransferByDTO mock is already set by Expect")
 	}
 
-	if mmFindPagedList.defaultExpectation.paramPtrs == nil {
-		mmFindPagedList.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindPagedListParamPtrs{}
+	if mmPatchTransferByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchTransferByDTO.defaultExpectation.paramPtrs = &TransactionUsecaseMockPatchTransferByDTOParamPtrs{}
 	}
-	mmFindPagedList.defaultExpectation.paramPtrs.queryParams = &queryParams
-	mmFindPagedList.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+	mmPatchTransferByDTO.defaultExpectation.paramPtrs.skipVersionCheck = &skipVersionCheck
+	mmPatchTransferByDTO.defaultExpectation.expectationOrigins.originSkipVersionCheck = minimock.CallerInfo(1)
 
-	return mmFindPagedList
+	return mmPatchTransferByDTO
 }
 
-// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.FindPagedList
-func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) Inspect(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams)) *mTransactionUsecaseMockFindPagedList {
-	if mmFindPagedList.mock.inspectFuncFindPagedList != nil {
-		mmFindPagedList.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.FindPagedList")
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.PatchTransferByDTO
+func (mmPatchTransferByDTO *mTransactionUsecaseMockPatchTransferByDTO) Inspect(f func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransferDataInput, skipVersionCheck bool)) *mTransactionUsecaseMockPatchTransferByDTO {
+	if mmPatchTransferByDTO.mock.inspectFuncPatchTransferByDTO != nil {
+		mmPatchTransferByDTO.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.PatchTransferByDTO")
 	}
 
-	mmFindPagedList.mock.inspectFuncFindPagedList = f
+	mmPatchTransferByDTO.mock.inspectFuncPatchTransferByDTO = f
 
-	return mmFindPagedList
+	return mmPatchTransferByDTO
 }
 
-// Return sets up results that will be returned by TransactionUsecase.FindPagedList
-func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) Return(resItems []*mm_usecase.TransactionDTO, total uint64, err error) *TransactionUsecaseMock {
-	if mmFindPagedList.mock.funcFindPagedList != nil {
-		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by Set")
+// Return sets up results that will be returned by TransactionUsecase.PatchTransferByDTO
+func (mmPatchTransferByDTO *mTransactionUsecaseMockPatchTransferByDTO) Return(err error) *TransactionUsecaseMock {
+	if mmPatchTransferByDTO.mock.funcPatchTransferByDTO != nil {
+		mmPatchTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransferByDTO mock is already set by Set")
 	}
 
-	if mmFindPagedList.defaultExpectation == nil {
-		mmFindPagedList.defaultExpectation = &TransactionUsecaseMockFindPagedListExpectation{mock: mmFindPagedList.mock}
+	if mmPatchTransferByDTO.defaultExpectation == nil {
+		mmPatchTransferByDTO.defaultExpectation = &TransactionUsecaseMockPatchTransferByDTOExpectation{mock: mmPatchTransferByDTO.mock}
 	}
-	mmFindPagedList.defaultExpectation.results = &TransactionUsecaseMockFindPagedListResults{resItems, total, err}
-	mmFindPagedList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmFindPagedList.mock
+	mmPatchTransferByDTO.defaultExpectation.results = &TransactionUsecaseMockPatchTransferByDTOResults{err}
+	mmPatchTransferByDTO.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPatchTransferByDTO.mock
 }
 
-// Set uses given function f to mock the TransactionUsecase.FindPagedList method
-func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) Set(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.TransactionDTO, total uint64, err error)) *TransactionUsecaseMock {
-	if mmFindPagedList.defaultExpectation != nil {
-		mmFindPagedList.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.FindPagedList method")
+// Set uses given function f to mock the TransactionUsecase.PatchTransferByDTO method
+func (mmPatchTransferByDTO *mTransactionUsecaseMockPatchTransferByDTO) Set(f func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransferDataInput, skipVersionCheck bool) (err error)) *TransactionUsecaseMock {
+	if mmPatchTransferByDTO.defaultExpectation != nil {
+		mmPatchTransferByDTO.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.PatchTransferByDTO method")
 	}
 
-	if len(mmFindPagedList.expectations) > 0 {
-		mmFindPagedList.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.FindPagedList method")
+	if len(mmPatchTransferByDTO.expectations) > 0 {
+		mmPatchTransferByDTO.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.PatchTransferByDTO method")
 	}
 
-	mmFindPagedList.mock.funcFindPagedList = f
-	mmFindPagedList.mock.funcFindPagedListOrigin = minimock.CallerInfo(1)
-	return mmFindPagedList.mock
+	mmPatchTransferByDTO.mock.funcPatchTransferByDTO = f
+	mmPatchTransferByDTO.mock.funcPatchTransferByDTOOrigin = minimock.CallerInfo(1)
+	return mmPatchTransferByDTO.mock
 }
 
-// When sets expectation for the TransactionUsecase.FindPagedList which will trigger the result defined by the following
+// When sets expectation for the TransactionUsecase.PatchTransferByDTO which will trigger the result defined by the following
 // Then helper
-func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) When(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) *TransactionUsecaseMockFindPagedListExpectation {
-	if mmFindPagedList.mock.funcFindPagedList != nil {
-		mmFindPagedList.mock.t.Fatalf("TransactionUsecaseMock.FindPagedList mock is already set by Set")
+func (mmPatchTransferByDTO *mTransactionUsecaseMockPatchTransferByDTO) When(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransferDataInput, skipVersionCheck bool) *TransactionUsecaseMockPatchTransferByDTOExpectation {
+	if mmPatchTransferByDTO.mock.funcPatchTransferByDTO != nil {
+		mmPatchTransferByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransferByDTO mock is already set by Set")
 	}
 
-	expectation := &TransactionUsecaseMockFindPagedListExpectation{
-		mock:               mmFindPagedList.mock,
-		params:             &TransactionUsecaseMockFindPagedListParams{ctx, listOptions, queryParams},
-		expectationOrigins: TransactionUsecaseMockFindPagedListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &TransactionUsecaseMockPatchTransferByDTOExpectation{
+		mock:               mmPatchTransferByDTO.mock,
+		params:             &TransactionUsecaseMockPatchTransferByDTOParams{ctx, id, in, skipVersionCheck},
+		expectationOrigins: TransactionUsecaseMockPatchTransferByDTOExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmFindPagedList.expectations = append(mmFindPagedList.expectations, expectation)
+	mmPatchTransferByDTO.expectations = append(mmPatchTransferByDTO.expectations, expectation)
 	return expectation
 }
 
-// Then sets up TransactionUsecase.FindPagedList return parameters for the expectation previously defined by the When method
-func (e *TransactionUsecaseMockFindPagedListExpectation) Then(resItems []*mm_usecase.TransactionDTO, total uint64, err error) *TransactionUsecaseMock {
-	e.results = &TransactionUsecaseMockFindPagedListResults{resItems, total, err}
+// Then sets up TransactionUsecase.PatchTransferByDTO return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockPatchTransferByDTOExpectation) Then(err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockPatchTransferByDTOResults{err}
 	return e.mock
 }
 
-// Times sets number of times TransactionUsecase.FindPagedList should be invoked
-func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) Times(n uint64) *mTransactionUsecaseMockFindPagedList {
+// Times sets number of times TransactionUsecase.PatchTransferByDTO should be invoked
+func (mmPatchTransferByDTO *mTransactionUsecaseMockPatchTransferByDTO) Times(n uint64) *mTransactionUsecaseMockPatchTransferByDTO {
 	if n == 0 {
-		mmFindPagedList.mock.t.Fatalf("Times of TransactionUsecaseMock.FindPagedList mock can not be zero")
+		mmPatchTransferByDTO.mock.t.Fatalf("Times of TransactionUsecaseMock.PatchTransferByDTO mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmFindPagedList.expectedInvocations, n)
-	mmFindPagedList.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmFindPagedList
+	mm_atomic.StoreUint64(&mmPatchTransferByDTO.expectedInvocations, n)
+	mmPatchTransferByDTO.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPatchTransferByDTO
 }
 
-func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) invocationsDone() bool {
-	if len(mmFindPagedList.expectations) == 0 && mmFindPagedList.defaultExpectation == nil && mmFindPagedList.mock.funcFindPagedList == nil {
+func (mmPatchTransferByDTO *mTransactionUsecaseMockPatchTransferByDTO) invocationsDone() bool {
+	if len(mmPatchTransferByDTO.expectations) == 0 && mmPatchTransferByDTO.defaultExpectation == nil && mmPatchTransferByDTO.mock.funcPatchTransferByDTO == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmFindPagedList.mock.afterFindPagedListCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmFindPagedList.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmPatchTransferByDTO.mock.afterPatchTransferByDTOCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPatchTransferByDTO.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// FindPagedList implements mm_usecase.TransactionUsecase
-func (mmFindPagedList *TransactionUsecaseMock) FindPagedList(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.TransactionDTO, total uint64, err error) {
-	mm_atomic.AddUint64(&mmFindPagedList.beforeFindPagedListCounter, 1)
-	defer mm_atomic.AddUint64(&mmFindPagedList.afterFindPagedListCounter, 1)
+// PatchTransferByDTO implements mm_usecase.TransactionUsecase
+func (mmPatchTransferByDTO *TransactionUsecaseMock) PatchTransferByDTO(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransferDataInput, skipVersionCheck bool) (err error) {
+	mm_atomic.AddUint64(&mmPatchTransferByDTO.beforePatchTransferByDTOCounter, 1)
+	defer mm_atomic.AddUint64(&mmPatchTransferByDTO.afterPatchTransferByDTOCounter, 1)
 
-	mmFindPagedList.t.Helper()
+	mmPatchTransferByDTO.t.Helper()
 
-	if mmFindPagedList.inspectFuncFindPagedList != nil {
-		mmFindPagedList.inspectFuncFindPagedList(ctx, listOptions, queryParams)
+	if mmPatchTransferByDTO.inspectFuncPatchTransferByDTO != nil {
+		mmPatchTransferByDTO.inspectFuncPatchTransferByDTO(ctx, id, in, skipVersionCheck)
 	}
 
-	mm_params := TransactionUsecaseMockFindPagedListParams{ctx, listOptions, queryParams}
+	mm_params := TransactionUsecaseMockPatchTransferByDTOParams{ctx, id, in, skipVersionCheck}
 
 	// Record call args
-	mmFindPagedList.FindPagedListMock.mutex.Lock()
-	mmFindPagedList.FindPagedListMock.callArgs = append(mmFindPagedList.FindPagedListMock.callArgs, &mm_params)
-	mmFindPagedList.FindPagedListMock.mutex.Unlock()
+	mmPatchTransferByDTO.PatchTransferByDTOMock.mutex.Lock()
+	mmPatchTransferByDTO.PatchTransferByDTOMock.callArgs = append(mmPatchTransferByDTO.PatchTransferByDTOMock.callArgs, &mm_params)
+	mmPatchTransferByDTO.PatchTransferByDTOMock.mutex.Unlock()
 
-	for _, e := range mmFindPagedList.FindPagedListMock.expectations {
+	for _, e := range mmPatchTransferByDTO.PatchTransferByDTOMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.resItems, e.results.total, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmFindPagedList.FindPagedListMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmFindPagedList.FindPagedListMock.defaultExpectation.Counter, 1)
-		mm_want := mmFindPagedList.FindPagedListMock.defaultExpectation.params
-		mm_want_ptrs := mmFindPagedList.FindPagedListMock.defaultExpectation.paramPtrs
+	if mmPatchTransferByDTO.PatchTransferByDTOMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPatchTransferByDTO.PatchTransferByDTOMock.defaultExpectation.Counter, 1)
+		mm_want := mmPatchTransferByDTO.PatchTransferByDTOMock.defaultExpectation.params
+		mm_want_ptrs := mmPatchTransferByDTO.PatchTransferByDTOMock.defaultExpectation.paramPtrs
 
-		mm_got := TransactionUsecaseMockFindPagedListParams{ctx, listOptions, queryParams}
+		mm_got := TransactionUsecaseMockPatchTransferByDTOParams{ctx, id, in, skipVersionCheck}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmFindPagedList.t.Errorf("TransactionUsecaseMock.FindPagedList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindPagedList.FindPagedListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmPatchTransferByDTO.t.Errorf("TransactionUsecaseMock.PatchTransferByDTO got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchTransferByDTO.PatchTransferByDTOMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
-				mmFindPagedList.t.Errorf("TransactionUsecaseMock.FindPagedList got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindPagedList.FindPagedListMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmPatchTransferByDTO.t.Errorf("TransactionUsecaseMock.PatchTransferByDTO got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchTransferByDTO.PatchTransferByDTOMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
-			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
-				mmFindPagedList.t.Errorf("TransactionUsecaseMock.FindPagedList got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindPagedList.FindPagedListMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmPatchTransferByDTO.t.Errorf("TransactionUsecaseMock.PatchTransferByDTO got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchTransferByDTO.PatchTransferByDTOMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+			if mm_want_ptrs.skipVersionCheck != nil && !minimock.Equal(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck) {
+				mmPatchTransferByDTO.t.Errorf("TransactionUsecaseMock.PatchTransferByDTO got unexpected parameter skipVersionCheck, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchTransferByDTO.PatchTransferByDTOMock.defaultExpectation.expectationOrigins.originSkipVersionCheck, *mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck, minimock.Diff(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmFindPagedList.t.Errorf("TransactionUsecaseMock.FindPagedList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmFindPagedList.FindPagedListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmPatchTransferByDTO.t.Errorf("TransactionUsecaseMock.PatchTransferByDTO got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPatchTransferByDTO.PatchTransferByDTOMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmFindPagedList.FindPagedListMock.defaultExpectation.results
+		mm_results := mmPatchTransferByDTO.PatchTransferByDTOMock.defaultExpectation.results
 		if mm_results == nil {
-			mmFindPagedList.t.Fatal("No results are set for the TransactionUsecaseMock.FindPagedList")
+			mmPatchTransferByDTO.t.Fatal("No results are set for the TransactionUsecaseMock.PatchTransferByDTO")
 		}
-		return (*mm_results).resItems, (*mm_results).total, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmFindPagedList.funcFindPagedList != nil {
-		return mmFindPagedList.funcFindPagedList(ctx, listOptions, queryParams)
+	if mmPatchTransferByDTO.funcPatchTransferByDTO != nil {
+		return mmPatchTransferByDTO.funcPatchTransferByDTO(ctx, id, in, skipVersionCheck)
 	}
-	mmFindPagedList.t.Fatalf("Unexpected call to TransactionUsecaseMock.FindPagedList. %v %v %v", ctx, listOptions, queryParams)
+	mmPatchTransferByDTO.t.Fatalf("Unexpected call to TransactionUsecaseMock.PatchTransferByDTO. %v %v %v %v", ctx, id, in, skipVersionCheck)
 	return
 }
 
-// FindPagedListAfterCounter returns a count of finished TransactionUsecaseMock.FindPagedList invocations
-func (mmFindPagedList *TransactionUsecaseMock) FindPagedListAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFindPagedList.afterFindPagedListCounter)
+// PatchTransferByDTOAfterCounter returns a count of finished TransactionUsecaseMock.PatchTransferByDTO invocations
+func (mmPatchTransferByDTO *TransactionUsecaseMock) PatchTransferByDTOAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPatchTransferByDTO.afterPatchTransferByDTOCounter)
 }
 
-// FindPagedListBeforeCounter returns a count of TransactionUsecaseMock.FindPagedList invocations
-func (mmFindPagedList *TransactionUsecaseMock) FindPagedListBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFindPagedList.beforeFindPagedListCounter)
+// PatchTransferByDTOBeforeCounter returns a count of TransactionUsecaseMock.PatchTransferByDTO invocations
+func (mmPatchTransferByDTO *TransactionUsecaseMock) PatchTransferByDTOBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPatchTransferByDTO.beforePatchTransferByDTOCounter)
 }
 
-// Calls returns a list of arguments used in each call to TransactionUsecaseMock.FindPagedList.
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.PatchTransferByDTO.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmFindPagedList *mTransactionUsecaseMockFindPagedList) Calls() []*TransactionUsecaseMockFindPagedListParams {
-	mmFindPagedList.mutex.RLock()
+func (mmPatchTransferByDTO *mTransactionUsecaseMockPatchTransferByDTO) Calls() []*TransactionUsecaseMockPatchTransferByDTOParams {
+	mmPatchTransferByDTO.mutex.RLock()
 
-	argCopy := make([]*TransactionUsecaseMockFindPagedListParams, len(mmFindPagedList.callArgs))
-	copy(argCopy, mmFindPagedList.callArgs)
+	argCopy := make([]*TransactionUsecaseMockPatchTransferByDTOParams, len(mmPatchTransferByDTO.callArgs))
+	copy(argCopy, mmPatchTransferByDTO.callArgs)
 
-	mmFindPagedList.mutex.RUnlock()
+	mmPatchTransferByDTO.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockFindPagedListDone returns true if the count of the FindPagedList invocations corresponds
+// MinimockPatchTransferByDTODone returns true if the count of the PatchTransferByDTO invocations corresponds
 // the number of defined expectations
-func (m *TransactionUsecaseMock) MinimockFindPagedListDone() bool {
-	if m.FindPagedListMock.optional {
+func (m *TransactionUsecaseMock) MinimockPatchTransferByDTODone() bool {
+	if m.PatchTransferByDTOMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.FindPagedListMock.expectations {
+	for _, e := range m.PatchTransferByDTOMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.FindPagedListMock.invocationsDone()
+	return m.PatchTransferByDTOMock.invocationsDone()
 }
 
-// MinimockFindPagedListInspect logs each unmet expectation
-func (m *TransactionUsecaseMock) MinimockFindPagedListInspect() {
-	for _, e := range m.FindPagedListMock.expectations {
+// MinimockPatchTransferByDTOInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockPatchTransferByDTOInspect() {
+	for _, e := range m.PatchTransferByDTOMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.FindPagedList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to TransactionUsecaseMock.PatchTransferByDTO at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterFindPagedListCounter := mm_atomic.LoadUint64(&m.afterFindPagedListCounter)
+	afterPatchTransferByDTOCounter := mm_atomic.LoadUint64(&m.afterPatchTransferByDTOCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.FindPagedListMock.defaultExpectation != nil && afterFindPagedListCounter < 1 {
-		if m.FindPagedListMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.FindPagedList at\n%s", m.FindPagedListMock.defaultExpectation.returnOrigin)
+	if m.PatchTransferByDTOMock.defaultExpectation != nil && afterPatchTransferByDTOCounter < 1 {
+		if m.PatchTransferByDTOMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.PatchTransferByDTO at\n%s", m.PatchTransferByDTOMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.FindPagedList at\n%s with params: %#v", m.FindPagedListMock.defaultExpectation.expectationOrigins.origin, *m.FindPagedListMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to TransactionUsecaseMock.PatchTransferByDTO at\n%s with params: %#v", m.PatchTransferByDTOMock.defaultExpectation.expectationOrigins.origin, *m.PatchTransferByDTOMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcFindPagedList != nil && afterFindPagedListCounter < 1 {
-		m.t.Errorf("Expected call to TransactionUsecaseMock.FindPagedList at\n%s", m.funcFindPagedListOrigin)
+	if m.funcPatchTransferByDTO != nil && afterPatchTransferByDTOCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.PatchTransferByDTO at\n%s", m.funcPatchTransferByDTOOrigin)
 	}
 
-	if !m.FindPagedListMock.invocationsDone() && afterFindPagedListCounter > 0 {
-		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.FindPagedList at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.FindPagedListMock.expectedInvocations), m.FindPagedListMock.expectedInvocationsOrigin, afterFindPagedListCounter)
+	if !m.PatchTransferByDTOMock.invocationsDone() && afterPatchTransferByDTOCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.PatchTransferByDTO at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PatchTransferByDTOMock.expectedInvocations), m.PatchTransferByDTOMock.expectedInvocationsOrigin, afterPatchTransferByDTOCounter)
 	}
 }
 
-type mTransactionUsecaseMockFindPagedListAsCSV struct {
+type mTransactionUsecaseMockReapplyCategoryRules struct {
 	optional           bool
 	mock               *TransactionUsecaseMock
-	defaultExpectation *TransactionUsecaseMockFindPagedListAsCSVExpectation
-	expectations       []*TransactionUsecaseMockFindPagedListAsCSVExpectation
+	defaultExpectation *TransactionUsecaseMockReapplyCategoryRulesExpectation
+	expectations       []*TransactionUsecaseMockReapplyCategoryRulesExpectation
 
-	callArgs []*TransactionUsecaseMockFindPagedListAsCSVParams
+	callArgs []*TransactionUsecaseMockReapplyCategoryRulesParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// TransactionUsecaseMockFindPagedListAsCSVExpectation specifies expectation struct of the TransactionUsecase.FindPagedListAsCSV
-type TransactionUsecaseMockFindPagedListAsCSVExpectation struct {
+// TransactionUsecaseMockReapplyCategoryRulesExpectation specifies expectation struct of the TransactionUsecase.ReapplyCategoryRules
+type TransactionUsecaseMockReapplyCategoryRulesExpectation struct {
 	mock               *TransactionUsecaseMock
-	params             *TransactionUsecaseMockFindPagedListAsCSVParams
-	paramPtrs          *TransactionUsecaseMockFindPagedListAsCSVParamPtrs
-	expectationOrigins TransactionUsecaseMockFindPagedListAsCSVExpectationOrigins
-	results            *TransactionUsecaseMockFindPagedListAsCSVResults
+	params             *TransactionUsecaseMockReapplyCategoryRulesParams
+	paramPtrs          *TransactionUsecaseMockReapplyCategoryRulesParamPtrs
+	expectationOrigins TransactionUsecaseMockReapplyCategoryRulesExpectationOrigins
+	results            *TransactionUsecaseMockReapplyCategoryRulesResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// TransactionUsecaseMockFindPagedListAsCSVParams contains parameters of the TransactionUsecase.FindPagedListAsCSV
-type TransactionUsecaseMockFindPagedListAsCSVParams struct {
-	ctx         context.Context
-	listOptions *mm_usecase.TransactionListOptions
-	queryParams *uctypes.QueryGetListParams
+// TransactionUsecaseMockReapplyCategoryRulesParams contains parameters of the TransactionUsecase.ReapplyCategoryRules
+type TransactionUsecaseMockReapplyCategoryRulesParams struct {
+	ctx context.Context
+	in  mm_usecase.ReapplyCategoryRulesInput
 }
 
-// TransactionUsecaseMockFindPagedListAsCSVParamPtrs contains pointers to parameters of the TransactionUsecase.FindPagedListAsCSV
-type TransactionUsecaseMockFindPagedListAsCSVParamPtrs struct {
-	ctx         *context.Context
-	listOptions **mm_usecase.TransactionListOptions
-	queryParams **uctypes.QueryGetListParams
+// TransactionUsecaseMockReapplyCategoryRulesParamPtrs contains pointers to parameters of the TransactionUsecase.ReapplyCategoryRules
+type TransactionUsecaseMockReapplyCategoryRulesParamPtrs struct {
+	ctx *context.Context
+	in  *mm_usecase.ReapplyCategoryRulesInput
 }
 
-// TransactionUsecaseMockFindPagedListAsCSVResults contains results of the TransactionUsecase.FindPagedListAsCSV
-type TransactionUsecaseMockFindPagedListAsCSVResults struct {
-	res   []byte
-	total uint64
-	err   error
+// TransactionUsecaseMockReapplyCategoryRulesResults contains results of the TransactionUsecase.ReapplyCategoryRules
+type TransactionUsecaseMockReapplyCategoryRulesResults struct {
+	resReport *mm_usecase.ReapplyCategoryRulesReport
+	err       error
 }
 
-// TransactionUsecaseMockFindPagedListAsCSVOrigins contains origins of expectations of the TransactionUsecase.FindPagedListAsCSV
-type TransactionUsecaseMockFindPagedListAsCSVExpectationOrigins struct {
-	origin            string
-	originCtx         string
-	originListOptions string
-	originQueryParams string
+// TransactionUsecaseMockReapplyCategoryRulesOrigins contains origins of expectations of the TransactionUsecase.ReapplyCategoryRules
+type TransactionUsecaseMockReapplyCategoryRulesExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -2720,372 +6971,348 @@ type TransactionUsecaseMockFindPagedListAsCSVExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) Optional() *mTransactionUsecaseMockFindPagedListAsCSV {
-	mmFindPagedListAsCSV.optional = true
-	return mmFindPagedListAsCSV
+func (mmReapplyCategoryRules *mTransactionUsecaseMockReapplyCategoryRules) Optional() *mTransactionUsecaseMockReapplyCategoryRules {
+	mmReapplyCategoryRules.optional = true
+	return mmReapplyCategoryRules
 }
 
-// Expect sets up expected params for TransactionUsecase.FindPagedListAsCSV
-func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) Expect(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) *mTransactionUsecaseMockFindPagedListAsCSV {
-	if mmFindPagedListAsCSV.mock.funcFindPagedListAsCSV != nil {
-		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by Set")
+// Expect sets up expected params for TransactionUsecase.ReapplyCategoryRules
+func (mmReapplyCategoryRules *mTransactionUsecaseMockReapplyCategoryRules) Expect(ctx context.Context, in mm_usecase.ReapplyCategoryRulesInput) *mTransactionUsecaseMockReapplyCategoryRules {
+	if mmReapplyCategoryRules.mock.funcReapplyCategoryRules != nil {
+		mmReapplyCategoryRules.mock.t.Fatalf("TransactionUsecaseMock.ReapplyCategoryRules mock is already set by Set")
 	}
 
-	if mmFindPagedListAsCSV.defaultExpectation == nil {
-		mmFindPagedListAsCSV.defaultExpectation = &TransactionUsecaseMockFindPagedListAsCSVExpectation{}
+	if mmReapplyCategoryRules.defaultExpectation == nil {
+		mmReapplyCategoryRules.defaultExpectation = &TransactionUsecaseMockReapplyCategoryRulesExpectation{}
 	}
 
-	if mmFindPagedListAsCSV.defaultExpectation.paramPtrs != nil {
-		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by ExpectParams functions")
+	if mmReapplyCategoryRules.defaultExpectation.paramPtrs != nil {
+		mmReapplyCategoryRules.mock.t.Fatalf("TransactionUsecaseMock.ReapplyCategoryRules mock is already set by ExpectParams functions")
 	}
 
-	mmFindPagedListAsCSV.defaultExpectation.params = &TransactionUsecaseMockFindPagedListAsCSVParams{ctx, listOptions, queryParams}
-	mmFindPagedListAsCSV.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmFindPagedListAsCSV.expectations {
-		if minimock.Equal(e.params, mmFindPagedListAsCSV.defaultExpectation.params) {
-			mmFindPagedListAsCSV.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindPagedListAsCSV.defaultExpectation.params)
+	mmReapplyCategoryRules.defaultExpectation.params = &TransactionUsecaseMockReapplyCategoryRulesParams{ctx, in}
+	mmReapplyCategoryRules.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReapplyCategoryRules.expectations {
+		if minimock.Equal(e.params, mmReapplyCategoryRules.defaultExpectation.params) {
+			mmReapplyCategoryRules.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReapplyCategoryRules.defaultExpectation.params)
 		}
 	}
 
-	return mmFindPagedListAsCSV
-}
-
-// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.FindPagedListAsCSV
-func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockFindPagedListAsCSV {
-	if mmFindPagedListAsCSV.mock.funcFindPagedListAsCSV != nil {
-		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by Set")
-	}
-
-	if mmFindPagedListAsCSV.defaultExpectation == nil {
-		mmFindPagedListAsCSV.defaultExpectation = &TransactionUsecaseMockFindPagedListAsCSVExpectation{}
-	}
-
-	if mmFindPagedListAsCSV.defaultExpectation.params != nil {
-		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by Expect")
-	}
-
-	if mmFindPagedListAsCSV.defaultExpectation.paramPtrs == nil {
-		mmFindPagedListAsCSV.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindPagedListAsCSVParamPtrs{}
-	}
-	mmFindPagedListAsCSV.defaultExpectation.paramPtrs.ctx = &ctx
-	mmFindPagedListAsCSV.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmFindPagedListAsCSV
+	return mmReapplyCategoryRules
 }
 
-// ExpectListOptionsParam2 sets up expected param listOptions for TransactionUsecase.FindPagedListAsCSV
-func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) ExpectListOptionsParam2(listOptions *mm_usecase.TransactionListOptions) *mTransactionUsecaseMockFindPagedListAsCSV {
-	if mmFindPagedListAsCSV.mock.funcFindPagedListAsCSV != nil {
-		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.ReapplyCategoryRules
+func (mmReapplyCategoryRules *mTransactionUsecaseMockReapplyCategoryRules) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockReapplyCategoryRules {
+	if mmReapplyCategoryRules.mock.funcReapplyCategoryRules != nil {
+		mmReapplyCategoryRules.mock.t.Fatalf("TransactionUsecaseMock.ReapplyCategoryRules mock is already set by Set")
 	}
 
-	if mmFindPagedListAsCSV.defaultExpectation == nil {
-		mmFindPagedListAsCSV.defaultExpectation = &TransactionUsecaseMockFindPagedListAsCSVExpectation{}
+	if mmReapplyCategoryRules.defaultExpectation == nil {
+		mmReapplyCategoryRules.defaultExpectation = &TransactionUsecaseMockReapplyCategoryRulesExpectation{}
 	}
 
-	if mmFindPagedListAsCSV.defaultExpectation.params != nil {
-		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by Expect")
+	if mmReapplyCategoryRules.defaultExpectation.params != nil {
+		mmReapplyCategoryRules.mock.t.Fatalf("TransactionUsecaseMock.ReapplyCategoryRules mock is already set by Expect")
 	}
 
-	if mmFindPagedListAsCSV.defaultExpectation.paramPtrs == nil {
-		mmFindPagedListAsCSV.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindPagedListAsCSVParamPtrs{}
+	if mmReapplyCategoryRules.defaultExpectation.paramPtrs == nil {
+		mmReapplyCategoryRules.defaultExpectation.paramPtrs = &TransactionUsecaseMockReapplyCategoryRulesParamPtrs{}
 	}
-	mmFindPagedListAsCSV.defaultExpectation.paramPtrs.listOptions = &listOptions
-	mmFindPagedListAsCSV.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+	mmReapplyCategoryRules.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReapplyCategoryRules.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmFindPagedListAsCSV
+	return mmReapplyCategoryRules
 }
 
-// ExpectQueryParamsParam3 sets up expected param queryParams for TransactionUsecase.FindPagedListAsCSV
-func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mTransactionUsecaseMockFindPagedListAsCSV {
-	if mmFindPagedListAsCSV.mock.funcFindPagedListAsCSV != nil {
-		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by Set")
+// ExpectInParam2 sets up expected param in for TransactionUsecase.ReapplyCategoryRules
+func (mmReapplyCategoryRules *mTransactionUsecaseMockReapplyCategoryRules) ExpectInParam2(in mm_usecase.ReapplyCategoryRulesInput) *mTransactionUsecaseMockReapplyCategoryRules {
+	if mmReapplyCategoryRules.mock.funcReapplyCategoryRules != nil {
+		mmReapplyCategoryRules.mock.t.Fatalf("TransactionUsecaseMock.ReapplyCategoryRules mock is already set by Set")
 	}
 
-	if mmFindPagedListAsCSV.defaultExpectation == nil {
-		mmFindPagedListAsCSV.defaultExpectation = &TransactionUsecaseMockFindPagedListAsCSVExpectation{}
+	if mmReapplyCategoryRules.defaultExpectation == nil {
+		mmReapplyCategoryRules.defaultExpectation = &TransactionUsecaseMockReapplyCategoryRulesExpectation{}
 	}
 
-	if mmFindPagedListAsCSV.defaultExpectation.params != nil {
-		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by Expect")
+	if mmReapplyCategoryRules.defaultExpectation.params != nil {
+		mmReapplyCategoryRules.mock.t.Fatalf("TransactionUsecaseMock.ReapplyCategoryRules mock is already set by Expect")
 	}
 
-	if mmFindPagedListAsCSV.defaultExpectation.paramPtrs == nil {
-		mmFindPagedListAsCSV.defaultExpectation.paramPtrs = &TransactionUsecaseMockFindPagedListAsCSVParamPtrs{}
+	if mmReapplyCategoryRules.defaultExpectation.paramPtrs == nil {
+		mmReapplyCategoryRules.defaultExpectation.paramPtrs = &TransactionUsecaseMockReapplyCategoryRulesParamPtrs{}
 	}
-	mmFindPagedListAsCSV.defaultExpectation.paramPtrs.queryParams = &queryParams
-	mmFindPagedListAsCSV.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+	mmReapplyCategoryRules.defaultExpectation.paramPtrs.in = &in
+	mmReapplyCategoryRules.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
 
-	return mmFindPagedListAsCSV
+	return mmReapplyCategoryRules
 }
 
-// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.FindPagedListAsCSV
-func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) Inspect(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams)) *mTransactionUsecaseMockFindPagedListAsCSV {
-	if mmFindPagedListAsCSV.mock.inspectFuncFindPagedListAsCSV != nil {
-		mmFindPagedListAsCSV.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.FindPagedListAsCSV")
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.ReapplyCategoryRules
+func (mmReapplyCategoryRules *mTransactionUsecaseMockReapplyCategoryRules) Inspect(f func(ctx context.Context, in mm_usecase.ReapplyCategoryRulesInput)) *mTransactionUsecaseMockReapplyCategoryRules {
+	if mmReapplyCategoryRules.mock.inspectFuncReapplyCategoryRules != nil {
+		mmReapplyCategoryRules.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.ReapplyCategoryRules")
 	}
 
-	mmFindPagedListAsCSV.mock.inspectFuncFindPagedListAsCSV = f
+	mmReapplyCategoryRules.mock.inspectFuncReapplyCategoryRules = f
 
-	return mmFindPagedListAsCSV
+	return mmReapplyCategoryRules
 }
 
-// Return sets up results that will be returned by TransactionUsecase.FindPagedListAsCSV
-func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) Return(res []byte, total uint64, err error) *TransactionUsecaseMock {
-	if mmFindPagedListAsCSV.mock.funcFindPagedListAsCSV != nil {
-		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by Set")
+// Return sets up results that will be returned by TransactionUsecase.ReapplyCategoryRules
+func (mmReapplyCategoryRules *mTransactionUsecaseMockReapplyCategoryRules) Return(resReport *mm_usecase.ReapplyCategoryRulesReport, err error) *TransactionUsecaseMock {
+	if mmReapplyCategoryRules.mock.funcReapplyCategoryRules != nil {
+		mmReapplyCategoryRules.mock.t.Fatalf("TransactionUsecaseMock.ReapplyCategoryRules mock is already set by Set")
 	}
 
-	if mmFindPagedListAsCSV.defaultExpectation == nil {
-		mmFindPagedListAsCSV.defaultExpectation = &TransactionUsecaseMockFindPagedListAsCSVExpectation{mock: mmFindPagedListAsCSV.mock}
+	if mmReapplyCategoryRules.defaultExpectation == nil {
+		mmReapplyCategoryRules.defaultExpectation = &TransactionUsecaseMockReapplyCategoryRulesExpectation{mock: mmReapplyCategoryRules.mock}
 	}
-	mmFindPagedListAsCSV.defaultExpectation.results = &TransactionUsecaseMockFindPagedListAsCSVResults{res, total, err}
-	mmFindPagedListAsCSV.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmFindPagedListAsCSV.mock
+	mmReapplyCategoryRules.defaultExpectation.results = &TransactionUsecaseMockReapplyCategoryRulesResults{resReport, err}
+	mmReapplyCategoryRules.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReapplyCategoryRules.mock
 }
 
-// Set uses given function f to mock the TransactionUsecase.FindPagedListAsCSV method
-func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) Set(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) (res []byte, total uint64, err error)) *TransactionUsecaseMock {
-	if mmFindPagedListAsCSV.defaultExpectation != nil {
-		mmFindPagedListAsCSV.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.FindPagedListAsCSV method")
+// Set uses given function f to mock the TransactionUsecase.ReapplyCategoryRules method
+func (mmReapplyCategoryRules *mTransactionUsecaseMockReapplyCategoryRules) Set(f func(ctx context.Context, in mm_usecase.ReapplyCategoryRulesInput) (resReport *mm_usecase.ReapplyCategoryRulesReport, err error)) *TransactionUsecaseMock {
+	if mmReapplyCategoryRules.defaultExpectation != nil {
+		mmReapplyCategoryRules.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.ReapplyCategoryRules method")
 	}
 
-	if len(mmFindPagedListAsCSV.expectations) > 0 {
-		mmFindPagedListAsCSV.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.FindPagedListAsCSV method")
+	if len(mmReapplyCategoryRules.expectations) > 0 {
+		mmReapplyCategoryRules.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.ReapplyCategoryRules method")
 	}
 
-	mmFindPagedListAsCSV.mock.funcFindPagedListAsCSV = f
-	mmFindPagedListAsCSV.mock.funcFindPagedListAsCSVOrigin = minimock.CallerInfo(1)
-	return mmFindPagedListAsCSV.mock
+	mmReapplyCategoryRules.mock.funcReapplyCategoryRules = f
+	mmReapplyCategoryRules.mock.funcReapplyCategoryRulesOrigin = minimock.CallerInfo(1)
+	return mmReapplyCategoryRules.mock
 }
 
-// When sets expectation for the TransactionUsecase.FindPagedListAsCSV which will trigger the result defined by the following
+// When sets expectation for the TransactionUsecase.ReapplyCategoryRules which will trigger the result defined by the following
 // Then helper
-func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) When(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) *TransactionUsecaseMockFindPagedListAsCSVExpectation {
-	if mmFindPagedListAsCSV.mock.funcFindPagedListAsCSV != nil {
-		mmFindPagedListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.FindPagedListAsCSV mock is already set by Set")
+func (mmReapplyCategoryRules *mTransactionUsecaseMockReapplyCategoryRules) When(ctx context.Context, in mm_usecase.ReapplyCategoryRulesInput) *TransactionUsecaseMockReapplyCategoryRulesExpectation {
+	if mmReapplyCategoryRules.mock.funcReapplyCategoryRules != nil {
+		mmReapplyCategoryRules.mock.t.Fatalf("TransactionUsecaseMock.ReapplyCategoryRules mock is already set by Set")
 	}
 
-	expectation := &TransactionUsecaseMockFindPagedListAsCSVExpectation{
-		mock:               mmFindPagedListAsCSV.mock,
-		params:             &TransactionUsecaseMockFindPagedListAsCSVParams{ctx, listOptions, queryParams},
-		expectationOrigins: TransactionUsecaseMockFindPagedListAsCSVExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &TransactionUsecaseMockReapplyCategoryRulesExpectation{
+		mock:               mmReapplyCategoryRules.mock,
+		params:             &TransactionUsecaseMockReapplyCategoryRulesParams{ctx, in},
+		expectationOrigins: TransactionUsecaseMockReapplyCategoryRulesExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmFindPagedListAsCSV.expectations = append(mmFindPagedListAsCSV.expectations, expectation)
+	mmReapplyCategoryRules.expectations = append(mmReapplyCategoryRules.expectations, expectation)
 	return expectation
 }
 
-// Then sets up TransactionUsecase.FindPagedListAsCSV return parameters for the expectation previously defined by the When method
-func (e *TransactionUsecaseMockFindPagedListAsCSVExpectation) Then(res []byte, total uint64, err error) *TransactionUsecaseMock {
-	e.results = &TransactionUsecaseMockFindPagedListAsCSVResults{res, total, err}
+// Then sets up TransactionUsecase.ReapplyCategoryRules return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockReapplyCategoryRulesExpectation) Then(resReport *mm_usecase.ReapplyCategoryRulesReport, err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockReapplyCategoryRulesResults{resReport, err}
 	return e.mock
 }
 
-// Times sets number of times TransactionUsecase.FindPagedListAsCSV should be invoked
-func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) Times(n uint64) *mTransactionUsecaseMockFindPagedListAsCSV {
+// Times sets number of times TransactionUsecase.ReapplyCategoryRules should be invoked
+func (mmReapplyCategoryRules *mTransactionUsecaseMockReapplyCategoryRules) Times(n uint64) *mTransactionUsecaseMockReapplyCategoryRules {
 	if n == 0 {
-		mmFindPagedListAsCSV.mock.t.Fatalf("Times of TransactionUsecaseMock.FindPagedListAsCSV mock can not be zero")
+		mmReapplyCategoryRules.mock.t.Fatalf("Times of TransactionUsecaseMock.ReapplyCategoryRules mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmFindPagedListAsCSV.expectedInvocations, n)
-	mmFindPagedListAsCSV.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmFindPagedListAsCSV
+	mm_atomic.StoreUint64(&mmReapplyCategoryRules.expectedInvocations, n)
+	mmReapplyCategoryRules.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReapplyCategoryRules
 }
 
-func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) invocationsDone() bool {
-	if len(mmFindPagedListAsCSV.expectations) == 0 && mmFindPagedListAsCSV.defaultExpectation == nil && mmFindPagedListAsCSV.mock.funcFindPagedListAsCSV == nil {
+func (mmReapplyCategoryRules *mTransactionUsecaseMockReapplyCategoryRules) invocationsDone() bool {
+	if len(mmReapplyCategoryRules.expectations) == 0 && mmReapplyCategoryRules.defaultExpectation == nil && mmReapplyCategoryRules.mock.funcReapplyCategoryRules == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmFindPagedListAsCSV.mock.afterFindPagedListAsCSVCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmFindPagedListAsCSV.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmReapplyCategoryRules.mock.afterReapplyCategoryRulesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReapplyCategoryRules.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// FindPagedListAsCSV implements mm_usecase.TransactionUsecase
-func (mmFindPagedListAsCSV *TransactionUsecaseMock) FindPagedListAsCSV(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, queryParams *uctypes.QueryGetListParams) (res []byte, total uint64, err error) {
-	mm_atomic.AddUint64(&mmFindPagedListAsCSV.beforeFindPagedListAsCSVCounter, 1)
-	defer mm_atomic.AddUint64(&mmFindPagedListAsCSV.afterFindPagedListAsCSVCounter, 1)
+// ReapplyCategoryRules implements mm_usecase.TransactionUsecase
+func (mmReapplyCategoryRules *TransactionUsecaseMock) ReapplyCategoryRules(ctx context.Context, in mm_usecase.ReapplyCategoryRulesInput) (resReport *mm_usecase.ReapplyCategoryRulesReport, err error) {
+	mm_atomic.AddUint64(&mmReapplyCategoryRules.beforeReapplyCategoryRulesCounter, 1)
+	defer mm_atomic.AddUint64(&mmReapplyCategoryRules.afterReapplyCategoryRulesCounter, 1)
 
-	mmFindPagedListAsCSV.t.Helper()
+	mmReapplyCategoryRules.t.Helper()
 
-	if mmFindPagedListAsCSV.inspectFuncFindPagedListAsCSV != nil {
-		mmFindPagedListAsCSV.inspectFuncFindPagedListAsCSV(ctx, listOptions, queryParams)
+	if mmReapplyCategoryRules.inspectFuncReapplyCategoryRules != nil {
+		mmReapplyCategoryRules.inspectFuncReapplyCategoryRules(ctx, in)
 	}
 
-	mm_params := TransactionUsecaseMockFindPagedListAsCSVParams{ctx, listOptions, queryParams}
+	mm_params := TransactionUsecaseMockReapplyCategoryRulesParams{ctx, in}
 
 	// Record call args
-	mmFindPagedListAsCSV.FindPagedListAsCSVMock.mutex.Lock()
-	mmFindPagedListAsCSV.FindPagedListAsCSVMock.callArgs = append(mmFindPagedListAsCSV.FindPagedListAsCSVMock.callArgs, &mm_params)
-	mmFindPagedListAsCSV.FindPagedListAsCSVMock.mutex.Unlock()
+	mmReapplyCategoryRules.ReapplyCategoryRulesMock.mutex.Lock()
+	mmReapplyCategoryRules.ReapplyCategoryRulesMock.callArgs = append(mmReapplyCategoryRules.ReapplyCategoryRulesMock.callArgs, &mm_params)
+	mmReapplyCategoryRules.ReapplyCategoryRulesMock.mutex.Unlock()
 
-	for _, e := range mmFindPagedListAsCSV.FindPagedListAsCSVMock.expectations {
+	for _, e := range mmReapplyCategoryRules.ReapplyCategoryRulesMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.res, e.results.total, e.results.err
+			return e.results.resReport, e.results.err
 		}
 	}
 
-	if mmFindPagedListAsCSV.FindPagedListAsCSVMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmFindPagedListAsCSV.FindPagedListAsCSVMock.defaultExpectation.Counter, 1)
-		mm_want := mmFindPagedListAsCSV.FindPagedListAsCSVMock.defaultExpectation.params
-		mm_want_ptrs := mmFindPagedListAsCSV.FindPagedListAsCSVMock.defaultExpectation.paramPtrs
+	if mmReapplyCategoryRules.ReapplyCategoryRulesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReapplyCategoryRules.ReapplyCategoryRulesMock.defaultExpectation.Counter, 1)
+		mm_want := mmReapplyCategoryRules.ReapplyCategoryRulesMock.defaultExpectation.params
+		mm_want_ptrs := mmReapplyCategoryRules.ReapplyCategoryRulesMock.defaultExpectation.paramPtrs
 
-		mm_got := TransactionUsecaseMockFindPagedListAsCSVParams{ctx, listOptions, queryParams}
+		mm_got := TransactionUsecaseMockReapplyCategoryRulesParams{ctx, in}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmFindPagedListAsCSV.t.Errorf("TransactionUsecaseMock.FindPagedListAsCSV got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindPagedListAsCSV.FindPagedListAsCSVMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
-				mmFindPagedListAsCSV.t.Errorf("TransactionUsecaseMock.FindPagedListAsCSV got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindPagedListAsCSV.FindPagedListAsCSVMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+				mmReapplyCategoryRules.t.Errorf("TransactionUsecaseMock.ReapplyCategoryRules got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReapplyCategoryRules.ReapplyCategoryRulesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
-				mmFindPagedListAsCSV.t.Errorf("TransactionUsecaseMock.FindPagedListAsCSV got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmFindPagedListAsCSV.FindPagedListAsCSVMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmReapplyCategoryRules.t.Errorf("TransactionUsecaseMock.ReapplyCategoryRules got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReapplyCategoryRules.ReapplyCategoryRulesMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmFindPagedListAsCSV.t.Errorf("TransactionUsecaseMock.FindPagedListAsCSV got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmFindPagedListAsCSV.FindPagedListAsCSVMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmReapplyCategoryRules.t.Errorf("TransactionUsecaseMock.ReapplyCategoryRules got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReapplyCategoryRules.ReapplyCategoryRulesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmFindPagedListAsCSV.FindPagedListAsCSVMock.defaultExpectation.results
+		mm_results := mmReapplyCategoryRules.ReapplyCategoryRulesMock.defaultExpectation.results
 		if mm_results == nil {
-			mmFindPagedListAsCSV.t.Fatal("No results are set for the TransactionUsecaseMock.FindPagedListAsCSV")
+			mmReapplyCategoryRules.t.Fatal("No results are set for the TransactionUsecaseMock.ReapplyCategoryRules")
 		}
-		return (*mm_results).res, (*mm_results).total, (*mm_results).err
+		return (*mm_results).resReport, (*mm_results).err
 	}
-	if mmFindPagedListAsCSV.funcFindPagedListAsCSV != nil {
-		return mmFindPagedListAsCSV.funcFindPagedListAsCSV(ctx, listOptions, queryParams)
+	if mmReapplyCategoryRules.funcReapplyCategoryRules != nil {
+		return mmReapplyCategoryRules.funcReapplyCategoryRules(ctx, in)
 	}
-	mmFindPagedListAsCSV.t.Fatalf("Unexpected call to TransactionUsecaseMock.FindPagedListAsCSV. %v %v %v", ctx, listOptions, queryParams)
+	mmReapplyCategoryRules.t.Fatalf("Unexpected call to TransactionUsecaseMock.ReapplyCategoryRules. %v %v", ctx, in)
 	return
 }
 
-// FindPagedListAsCSVAfterCounter returns a count of finished TransactionUsecaseMock.FindPagedListAsCSV invocations
-func (mmFindPagedListAsCSV *TransactionUsecaseMock) FindPagedListAsCSVAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFindPagedListAsCSV.afterFindPagedListAsCSVCounter)
+// ReapplyCategoryRulesAfterCounter returns a count of finished TransactionUsecaseMock.ReapplyCategoryRules invocations
+func (mmReapplyCategoryRules *TransactionUsecaseMock) ReapplyCategoryRulesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReapplyCategoryRules.afterReapplyCategoryRulesCounter)
 }
 
-// FindPagedListAsCSVBeforeCounter returns a count of TransactionUsecaseMock.FindPagedListAsCSV invocations
-func (mmFindPagedListAsCSV *TransactionUsecaseMock) FindPagedListAsCSVBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFindPagedListAsCSV.beforeFindPagedListAsCSVCounter)
+// ReapplyCategoryRulesBeforeCounter returns a count of TransactionUsecaseMock.ReapplyCategoryRules invocations
+func (mmReapplyCategoryRules *TransactionUsecaseMock) ReapplyCategoryRulesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReapplyCategoryRules.beforeReapplyCategoryRulesCounter)
 }
 
-// Calls returns a list of arguments used in each call to TransactionUsecaseMock.FindPagedListAsCSV.
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.ReapplyCategoryRules.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmFindPagedListAsCSV *mTransactionUsecaseMockFindPagedListAsCSV) Calls() []*TransactionUsecaseMockFindPagedListAsCSVParams {
-	mmFindPagedListAsCSV.mutex.RLock()
+func (mmReapplyCategoryRules *mTransactionUsecaseMockReapplyCategoryRules) Calls() []*TransactionUsecaseMockReapplyCategoryRulesParams {
+	mmReapplyCategoryRules.mutex.RLock()
 
-	argCopy := make([]*TransactionUsecaseMockFindPagedListAsCSVParams, len(mmFindPagedListAsCSV.callArgs))
-	copy(argCopy, mmFindPagedListAsCSV.callArgs)
+	argCopy := make([]*TransactionUsecaseMockReapplyCategoryRulesParams, len(mmReapplyCategoryRules.callArgs))
+	copy(argCopy, mmReapplyCategoryRules.callArgs)
 
-	mmFindPagedListAsCSV.mutex.RUnlock()
+	mmReapplyCategoryRules.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockFindPagedListAsCSVDone returns true if the count of the FindPagedListAsCSV invocations corresponds
+// MinimockReapplyCategoryRulesDone returns true if the count of the ReapplyCategoryRules invocations corresponds
 // the number of defined expectations
-func (m *TransactionUsecaseMock) MinimockFindPagedListAsCSVDone() bool {
-	if m.FindPagedListAsCSVMock.optional {
+func (m *TransactionUsecaseMock) MinimockReapplyCategoryRulesDone() bool {
+	if m.ReapplyCategoryRulesMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.FindPagedListAsCSVMock.expectations {
+	for _, e := range m.ReapplyCategoryRulesMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.FindPagedListAsCSVMock.invocationsDone()
+	return m.ReapplyCategoryRulesMock.invocationsDone()
 }
 
-// MinimockFindPagedListAsCSVInspect logs each unmet expectation
-func (m *TransactionUsecaseMock) MinimockFindPagedListAsCSVInspect() {
-	for _, e := range m.FindPagedListAsCSVMock.expectations {
+// MinimockReapplyCategoryRulesInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockReapplyCategoryRulesInspect() {
+	for _, e := range m.ReapplyCategoryRulesMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.FindPagedListAsCSV at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to TransactionUsecaseMock.ReapplyCategoryRules at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterFindPagedListAsCSVCounter := mm_atomic.LoadUint64(&m.afterFindPagedListAsCSVCounter)
+	afterReapplyCategoryRulesCounter := mm_atomic.LoadUint64(&m.afterReapplyCategoryRulesCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.FindPagedListAsCSVMock.defaultExpectation != nil && afterFindPagedListAsCSVCounter < 1 {
-		if m.FindPagedListAsCSVMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.FindPagedListAsCSV at\n%s", m.FindPagedListAsCSVMock.defaultExpectation.returnOrigin)
+	if m.ReapplyCategoryRulesMock.defaultExpectation != nil && afterReapplyCategoryRulesCounter < 1 {
+		if m.ReapplyCategoryRulesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.ReapplyCategoryRules at\n%s", m.ReapplyCategoryRulesMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.FindPagedListAsCSV at\n%s with params: %#v", m.FindPagedListAsCSVMock.defaultExpectation.expectationOrigins.origin, *m.FindPagedListAsCSVMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to TransactionUsecaseMock.ReapplyCategoryRules at\n%s with params: %#v", m.ReapplyCategoryRulesMock.defaultExpectation.expectationOrigins.origin, *m.ReapplyCategoryRulesMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcFindPagedListAsCSV != nil && afterFindPagedListAsCSVCounter < 1 {
-		m.t.Errorf("Expected call to TransactionUsecaseMock.FindPagedListAsCSV at\n%s", m.funcFindPagedListAsCSVOrigin)
+	if m.funcReapplyCategoryRules != nil && afterReapplyCategoryRulesCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.ReapplyCategoryRules at\n%s", m.funcReapplyCategoryRulesOrigin)
 	}
 
-	if !m.FindPagedListAsCSVMock.invocationsDone() && afterFindPagedListAsCSVCounter > 0 {
-		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.FindPagedListAsCSV at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.FindPagedListAsCSVMock.expectedInvocations), m.FindPagedListAsCSVMock.expectedInvocationsOrigin, afterFindPagedListAsCSVCounter)
+	if !m.ReapplyCategoryRulesMock.invocationsDone() && afterReapplyCategoryRulesCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.ReapplyCategoryRules at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReapplyCategoryRulesMock.expectedInvocations), m.ReapplyCategoryRulesMock.expectedInvocationsOrigin, afterReapplyCategoryRulesCounter)
 	}
 }
 
-type mTransactionUsecaseMockImportTransactionsFromCSV struct {
+type mTransactionUsecaseMockResolveCurrency struct {
 	optional           bool
 	mock               *TransactionUsecaseMock
-	defaultExpectation *TransactionUsecaseMockImportTransactionsFromCSVExpectation
-	expectations       []*TransactionUsecaseMockImportTransactionsFromCSVExpectation
+	defaultExpectation *TransactionUsecaseMockResolveCurrencyExpectation
+	expectations       []*TransactionUsecaseMockResolveCurrencyExpectation
 
-	callArgs []*TransactionUsecaseMockImportTransactionsFromCSVParams
+	callArgs []*TransactionUsecaseMockResolveCurrencyParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// TransactionUsecaseMockImportTransactionsFromCSVExpectation specifies expectation struct of the TransactionUsecase.ImportTransactionsFromCSV
-type TransactionUsecaseMockImportTransactionsFromCSVExpectation struct {
+// TransactionUsecaseMockResolveCurrencyExpectation specifies expectation struct of the TransactionUsecase.ResolveCurrency
+type TransactionUsecaseMockResolveCurrencyExpectation struct {
 	mock               *TransactionUsecaseMock
-	params             *TransactionUsecaseMockImportTransactionsFromCSVParams
-	paramPtrs          *TransactionUsecaseMockImportTransactionsFromCSVParamPtrs
-	expectationOrigins TransactionUsecaseMockImportTransactionsFromCSVExpectationOrigins
-	results            *TransactionUsecaseMockImportTransactionsFromCSVResults
+	params             *TransactionUsecaseMockResolveCurrencyParams
+	paramPtrs          *TransactionUsecaseMockResolveCurrencyParamPtrs
+	expectationOrigins TransactionUsecaseMockResolveCurrencyExpectationOrigins
+	results            *TransactionUsecaseMockResolveCurrencyResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// TransactionUsecaseMockImportTransactionsFromCSVParams contains parameters of the TransactionUsecase.ImportTransactionsFromCSV
-type TransactionUsecaseMockImportTransactionsFromCSVParams struct {
+// TransactionUsecaseMockResolveCurrencyParams contains parameters of the TransactionUsecase.ResolveCurrency
+type TransactionUsecaseMockResolveCurrencyParams struct {
 	ctx       context.Context
-	data      []byte
 	accountID uuid.UUID
+	currency  string
+	walletID  *uuid.UUID
 }
 
-// TransactionUsecaseMockImportTransactionsFromCSVParamPtrs contains pointers to parameters of the TransactionUsecase.ImportTransactionsFromCSV
-type TransactionUsecaseMockImportTransactionsFromCSVParamPtrs struct {
+// TransactionUsecaseMockResolveCurrencyParamPtrs contains pointers to parameters of the TransactionUsecase.ResolveCurrency
+type TransactionUsecaseMockResolveCurrencyParamPtrs struct {
 	ctx       *context.Context
-	data      *[]byte
 	accountID *uuid.UUID
+	currency  *string
+	walletID  **uuid.UUID
 }
 
-// TransactionUsecaseMockImportTransactionsFromCSVResults contains results of the TransactionUsecase.ImportTransactionsFromCSV
-type TransactionUsecaseMockImportTransactionsFromCSVResults struct {
-	err error
+// TransactionUsecaseMockResolveCurrencyResults contains results of the TransactionUsecase.ResolveCurrency
+type TransactionUsecaseMockResolveCurrencyResults struct {
+	resCurrency string
+	err         error
 }
 
-// TransactionUsecaseMockImportTransactionsFromCSVOrigins contains origins of expectations of the TransactionUsecase.ImportTransactionsFromCSV
-type TransactionUsecaseMockImportTransactionsFromCSVExpectationOrigins struct {
+// TransactionUsecaseMockResolveCurrencyOrigins contains origins of expectations of the TransactionUsecase.ResolveCurrency
+type TransactionUsecaseMockResolveCurrencyExpectationOrigins struct {
 	origin          string
 	originCtx       string
-	originData      string
 	originAccountID string
+	originCurrency  string
+	originWalletID  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -3093,375 +7320,403 @@ type TransactionUsecaseMockImportTransactionsFromCSVExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) Optional() *mTransactionUsecaseMockImportTransactionsFromCSV {
-	mmImportTransactionsFromCSV.optional = true
-	return mmImportTransactionsFromCSV
+func (mmResolveCurrency *mTransactionUsecaseMockResolveCurrency) Optional() *mTransactionUsecaseMockResolveCurrency {
+	mmResolveCurrency.optional = true
+	return mmResolveCurrency
 }
 
-// Expect sets up expected params for TransactionUsecase.ImportTransactionsFromCSV
-func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) Expect(ctx context.Context, data []byte, accountID uuid.UUID) *mTransactionUsecaseMockImportTransactionsFromCSV {
-	if mmImportTransactionsFromCSV.mock.funcImportTransactionsFromCSV != nil {
-		mmImportTransactionsFromCSV.mock.t.Fatalf("TransactionUsecaseMock.ImportTransactionsFromCSV mock is already set by Set")
+// Expect sets up expected params for TransactionUsecase.ResolveCurrency
+func (mmResolveCurrency *mTransactionUsecaseMockResolveCurrency) Expect(ctx context.Context, accountID uuid.UUID, currency string, walletID *uuid.UUID) *mTransactionUsecaseMockResolveCurrency {
+	if mmResolveCurrency.mock.funcResolveCurrency != nil {
+		mmResolveCurrency.mock.t.Fatalf("TransactionUsecaseMock.ResolveCurrency mock is already set by Set")
 	}
 
-	if mmImportTransactionsFromCSV.defaultExpectation == nil {
-		mmImportTransactionsFromCSV.defaultExpectation = &TransactionUsecaseMockImportTransactionsFromCSVExpectation{}
+	if mmResolveCurrency.defaultExpectation == nil {
+		mmResolveCurrency.defaultExpectation = &TransactionUsecaseMockResolveCurrencyExpectation{}
 	}
 
-	if mmImportTransactionsFromCSV.defaultExpectation.paramPtrs != nil {
-		mmImportTransactionsFromCSV.mock.t.Fatalf("TransactionUsecaseMock.ImportTransactionsFromCSV mock is already set by ExpectParams functions")
+	if mmResolveCurrency.defaultExpectation.paramPtrs != nil {
+		mmResolveCurrency.mock.t.Fatalf("TransactionUsecaseMock.ResolveCurrency mock is already set by ExpectParams functions")
 	}
 
-	mmImportTransactionsFromCSV.defaultExpectation.params = &TransactionUsecaseMockImportTransactionsFromCSVParams{ctx, data, accountID}
-	mmImportTransactionsFromCSV.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmImportTransactionsFromCSV.expectations {
-		if minimock.Equal(e.params, mmImportTransactionsFromCSV.defaultExpectation.params) {
-			mmImportTransactionsFromCSV.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmImportTransactionsFromCSV.defaultExpectation.params)
+	mmResolveCurrency.defaultExpectation.params = &TransactionUsecaseMockResolveCurrencyParams{ctx, accountID, currency, walletID}
+	mmResolveCurrency.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmResolveCurrency.expectations {
+		if minimock.Equal(e.params, mmResolveCurrency.defaultExpectation.params) {
+			mmResolveCurrency.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResolveCurrency.defaultExpectation.params)
 		}
 	}
 
-	return mmImportTransactionsFromCSV
+	return mmResolveCurrency
 }
 
-// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.ImportTransactionsFromCSV
-func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockImportTransactionsFromCSV {
-	if mmImportTransactionsFromCSV.mock.funcImportTransactionsFromCSV != nil {
-		mmImportTransactionsFromCSV.mock.t.Fatalf("TransactionUsecaseMock.ImportTransactionsFromCSV mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.ResolveCurrency
+func (mmResolveCurrency *mTransactionUsecaseMockResolveCurrency) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockResolveCurrency {
+	if mmResolveCurrency.mock.funcResolveCurrency != nil {
+		mmResolveCurrency.mock.t.Fatalf("TransactionUsecaseMock.ResolveCurrency mock is already set by Set")
 	}
 
-	if mmImportTransactionsFromCSV.defaultExpectation == nil {
-		mmImportTransactionsFromCSV.defaultExpectation = &TransactionUsecaseMockImportTransactionsFromCSVExpectation{}
+	if mmResolveCurrency.defaultExpectation == nil {
+		mmResolveCurrency.defaultExpectation = &TransactionUsecaseMockResolveCurrencyExpectation{}
 	}
 
-	if mmImportTransactionsFromCSV.defaultExpectation.params != nil {
-		mmImportTransactionsFromCSV.mock.t.Fatalf("TransactionUsecaseMock.ImportTransactionsFromCSV mock is already set by Expect")
+	if mmResolveCurrency.defaultExpectation.params != nil {
+		mmResolveCurrency.mock.t.Fatalf("TransactionUsecaseMock.ResolveCurrency mock is already set by Expect")
 	}
 
-	if mmImportTransactionsFromCSV.defaultExpectation.paramPtrs == nil {
-		mmImportTransactionsFromCSV.defaultExpectation.paramPtrs = &TransactionUsecaseMockImportTransactionsFromCSVParamPtrs{}
+	if mmResolveCurrency.defaultExpectation.paramPtrs == nil {
+		mmResolveCurrency.defaultExpectation.paramPtrs = &TransactionUsecaseMockResolveCurrencyParamPtrs{}
 	}
-	mmImportTransactionsFromCSV.defaultExpectation.paramPtrs.ctx = &ctx
-	mmImportTransactionsFromCSV.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmResolveCurrency.defaultExpectation.paramPtrs.ctx = &ctx
+	mmResolveCurrency.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmImportTransactionsFromCSV
+	return mmResolveCurrency
 }
 
-// ExpectDataParam2 sets up expected param data for TransactionUsecase.ImportTransactionsFromCSV
-func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) ExpectDataParam2(data []byte) *mTransactionUsecaseMockImportTransactionsFromCSV {
-	if mmImportTransactionsFromCSV.mock.funcImportTransactionsFromCSV != nil {
-		mmImportTransactionsFromCSV.mock.t.Fatalf("TransactionUsecaseMock.ImportTransactionsFromCSV mock is already set by Set")
+// ExpectAccountIDParam2 sets up expected param accountID for TransactionUsecase.ResolveCurrency
+func (mmResolveCurrency *mTransactionUsecaseMockResolveCurrency) ExpectAccountIDParam2(accountID uuid.UUID) *mTransactionUsecaseMockResolveCurrency {
+	if mmResolveCurrency.mock.funcResolveCurrency != nil {
+		mmResolveCurrency.mock.t.Fatalf("TransactionUsecaseMock.ResolveCurrency mock is already set by Set")
 	}
 
-	if mmImportTransactionsFromCSV.defaultExpectation == nil {
-		mmImportTransactionsFromCSV.defaultExpectation = &TransactionUsecaseMockImportTransactionsFromCSVExpectation{}
+	if mmResolveCurrency.defaultExpectation == nil {
+		mmResolveCurrency.defaultExpectation = &TransactionUsecaseMockResolveCurrencyExpectation{}
 	}
 
-	if mmImportTransactionsFromCSV.defaultExpectation.params != nil {
-		mmImportTransactionsFromCSV.mock.t.Fatalf("TransactionUsecaseMock.ImportTransactionsFromCSV mock is already set by Expect")
+	if mmResolveCurrency.defaultExpectation.params != nil {
+		mmResolveCurrency.mock.t.Fatalf("TransactionUsecaseMock.ResolveCurrency mock is already set by Expect")
 	}
 
-	if mmImportTransactionsFromCSV.defaultExpectation.paramPtrs == nil {
-		mmImportTransactionsFromCSV.defaultExpectation.paramPtrs = &TransactionUsecaseMockImportTransactionsFromCSVParamPtrs{}
+	if mmResolveCurrency.defaultExpectation.paramPtrs == nil {
+		mmResolveCurrency.defaultExpectation.paramPtrs = &TransactionUsecaseMockResolveCurrencyParamPtrs{}
 	}
-	mmImportTransactionsFromCSV.defaultExpectation.paramPtrs.data = &data
-	mmImportTransactionsFromCSV.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+	mmResolveCurrency.defaultExpectation.paramPtrs.accountID = &accountID
+	mmResolveCurrency.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
 
-	return mmImportTransactionsFromCSV
+	return mmResolveCurrency
 }
 
-// ExpectAccountIDParam3 sets up expected param accountID for TransactionUsecase.ImportTransactionsFromCSV
-func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) ExpectAccountIDParam3(accountID uuid.UUID) *mTransactionUsecaseMockImportTransactionsFromCSV {
-	if mmImportTransactionsFromCSV.mock.funcImportTransactionsFromCSV != nil {
-		mmImportTransactionsFromCSV.mock.t.Fatalf("TransactionUsecaseMock.ImportTransactionsFromCSV mock is already set by Set")
+// ExpectCurrencyParam3 sets up expected param currency for TransactionUsecase.ResolveCurrency
+func (mmResolveCurrency *mTransactionUsecaseMockResolveCurrency) ExpectCurrencyParam3(currency string) *mTransactionUsecaseMockResolveCurrency {
+	if mmResolveCurrency.mock.funcResolveCurrency != nil {
+		mmResolveCurrency.mock.t.Fatalf("TransactionUsecaseMock.ResolveCurrency mock is already set by Set")
 	}
 
-	if mmImportTransactionsFromCSV.defaultExpectation == nil {
-		mmImportTransactionsFromCSV.defaultExpectation = &TransactionUsecaseMockImportTransactionsFromCSVExpectation{}
+	if mmResolveCurrency.defaultExpectation == nil {
+		mmResolveCurrency.defaultExpectation = &TransactionUsecaseMockResolveCurrencyExpectation{}
 	}
 
-	if mmImportTransactionsFromCSV.defaultExpectation.params != nil {
-		mmImportTransactionsFromCSV.mock.t.Fatalf("TransactionUsecaseMock.ImportTransactionsFromCSV mock is already set by Expect")
+	if mmResolveCurrency.defaultExpectation.params != nil {
+		mmResolveCurrency.mock.t.Fatalf("TransactionUsecaseMock.ResolveCurrency mock is already set by Expect")
 	}
 
-	if mmImportTransactionsFromCSV.defaultExpectation.paramPtrs == nil {
-		mmImportTransactionsFromCSV.defaultExpectation.paramPtrs = &TransactionUsecaseMockImportTransactionsFromCSVParamPtrs{}
+	if mmResolveCurrency.defaultExpectation.paramPtrs == nil {
+		mmResolveCurrency.defaultExpectation.paramPtrs = &TransactionUsecaseMockResolveCurrencyParamPtrs{}
 	}
-	mmImportTransactionsFromCSV.defaultExpectation.paramPtrs.accountID = &accountID
-	mmImportTransactionsFromCSV.defaultExpectation.expectationOrigins.originAccountID = minimock.CallerInfo(1)
+	mmResolveCurrency.defaultExpectation.paramPtrs.currency = &currency
+	mmResolveCurrency.defaultExpectation.expectationOrigins.originCurrency = minimock.CallerInfo(1)
 
-	return mmImportTransactionsFromCSV
+	return mmResolveCurrency
 }
 
-// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.ImportTransactionsFromCSV
-func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) Inspect(f func(ctx context.Context, data []byte, accountID uuid.UUID)) *mTransactionUsecaseMockImportTransactionsFromCSV {
-	if mmImportTransactionsFromCSV.mock.inspectFuncImportTransactionsFromCSV != nil {
-		mmImportTransactionsFromCSV.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.ImportTransactionsFromCSV")
+// ExpectWalletIDParam4 sets up expected param walletID for TransactionUsecase.ResolveCurrency
+func (mmResolveCurrency *mTransactionUsecaseMockResolveCurrency) ExpectWalletIDParam4(walletID *uuid.UUID) *mTransactionUsecaseMockResolveCurrency {
+	if mmResolveCurrency.mock.funcResolveCurrency != nil {
+		mmResolveCurrency.mock.t.Fatalf("TransactionUsecaseMock.ResolveCurrency mock is already set by Set")
 	}
 
-	mmImportTransactionsFromCSV.mock.inspectFuncImportTransactionsFromCSV = f
+	if mmResolveCurrency.defaultExpectation == nil {
+		mmResolveCurrency.defaultExpectation = &TransactionUsecaseMockResolveCurrencyExpectation{}
+	}
 
-	return mmImportTransactionsFromCSV
+	if mmResolveCurrency.defaultExpectation.params != nil {
+		mmResolveCurrency.mock.t.Fatalf("TransactionUsecaseMock.ResolveCurrency mock is already set by Expect")
+	}
+
+	if mmResolveCurrency.defaultExpectation.paramPtrs == nil {
+		mmResolveCurrency.defaultExpectation.paramPtrs = &TransactionUsecaseMockResolveCurrencyParamPtrs{}
+	}
+	mmResolveCurrency.defaultExpectation.paramPtrs.walletID = &walletID
+	mmResolveCurrency.defaultExpectation.expectationOrigins.originWalletID = minimock.CallerInfo(1)
+
+	return mmResolveCurrency
 }
 
-// Return sets up results that will be returned by TransactionUsecase.ImportTransactionsFromCSV
-func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) Return(err error) *TransactionUsecaseMock {
-	if mmImportTransactionsFromCSV.mock.funcImportTransactionsFromCSV != nil {
-		mmImportTransactionsFromCSV.mock.t.Fatalf("TransactionUsecaseMock.ImportTransactionsFromCSV mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.ResolveCurrency
+func (mmResolveCurrency *mTransactionUsecaseMockResolveCurrency) Inspect(f func(ctx context.Context, accountID uuid.UUID, currency string, walletID *uuid.UUID)) *mTransactionUsecaseMockResolveCurrency {
+	if mmResolveCurrency.mock.inspectFuncResolveCurrency != nil {
+		mmResolveCurrency.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.ResolveCurrency")
 	}
 
-	if mmImportTransactionsFromCSV.defaultExpectation == nil {
-		mmImportTransactionsFromCSV.defaultExpectation = &TransactionUsecaseMockImportTransactionsFromCSVExpectation{mock: mmImportTransactionsFromCSV.mock}
+	mmResolveCurrency.mock.inspectFuncResolveCurrency = f
+
+	return mmResolveCurrency
+}
+
+// Return sets up results that will be returned by TransactionUsecase.ResolveCurrency
+func (mmResolveCurrency *mTransactionUsecaseMockResolveCurrency) Return(resCurrency string, err error) *TransactionUsecaseMock {
+	if mmResolveCurrency.mock.funcResolveCurrency != nil {
+		mmResolveCurrency.mock.t.Fatalf("TransactionUsecaseMock.ResolveCurrency mock is already set by Set")
 	}
-	mmImportTransactionsFromCSV.defaultExpectation.results = &TransactionUsecaseMockImportTransactionsFromCSVResults{err}
-	mmImportTransactionsFromCSV.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmImportTransactionsFromCSV.mock
+
+	if mmResolveCurrency.defaultExpectation == nil {
+		mmResolveCurrency.defaultExpectation = &TransactionUsecaseMockResolveCurrencyExpectation{mock: mmResolveCurrency.mock}
+	}
+	mmResolveCurrency.defaultExpectation.results = &TransactionUsecaseMockResolveCurrencyResults{resCurrency, err}
+	mmResolveCurrency.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmResolveCurrency.mock
 }
 
-// Set uses given function f to mock the TransactionUsecase.ImportTransactionsFromCSV method
-func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) Set(f func(ctx context.Context, data []byte, accountID uuid.UUID) (err error)) *TransactionUsecaseMock {
-	if mmImportTransactionsFromCSV.defaultExpectation != nil {
-		mmImportTransactionsFromCSV.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.ImportTransactionsFromCSV method")
+// Set uses given function f to mock the TransactionUsecase.ResolveCurrency method
+func (mmResolveCurrency *mTransactionUsecaseMockResolveCurrency) Set(f func(ctx context.Context, accountID uuid.UUID, currency string, walletID *uuid.UUID) (resCurrency string, err error)) *TransactionUsecaseMock {
+	if mmResolveCurrency.defaultExpectation != nil {
+		mmResolveCurrency.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.ResolveCurrency method")
 	}
 
-	if len(mmImportTransactionsFromCSV.expectations) > 0 {
-		mmImportTransactionsFromCSV.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.ImportTransactionsFromCSV method")
+	if len(mmResolveCurrency.expectations) > 0 {
+		mmResolveCurrency.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.ResolveCurrency method")
 	}
 
-	mmImportTransactionsFromCSV.mock.funcImportTransactionsFromCSV = f
-	mmImportTransactionsFromCSV.mock.funcImportTransactionsFromCSVOrigin = minimock.CallerInfo(1)
-	return mmImportTransactionsFromCSV.mock
+	mmResolveCurrency.mock.funcResolveCurrency = f
+	mmResolveCurrency.mock.funcResolveCurrencyOrigin = minimock.CallerInfo(1)
+	return mmResolveCurrency.mock
 }
 
-// When sets expectation for the TransactionUsecase.ImportTransactionsFromCSV which will trigger the result defined by the following
+// When sets expectation for the TransactionUsecase.ResolveCurrency which will trigger the result defined by the following
 // Then helper
-func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) When(ctx context.Context, data []byte, accountID uuid.UUID) *TransactionUsecaseMockImportTransactionsFromCSVExpectation {
-	if mmImportTransactionsFromCSV.mock.funcImportTransactionsFromCSV != nil {
-		mmImportTransactionsFromCSV.mock.t.Fatalf("TransactionUsecaseMock.ImportTransactionsFromCSV mock is already set by Set")
+func (mmResolveCurrency *mTransactionUsecaseMockResolveCurrency) When(ctx context.Context, accountID uuid.UUID, currency string, walletID *uuid.UUID) *TransactionUsecaseMockResolveCurrencyExpectation {
+	if mmResolveCurrency.mock.funcResolveCurrency != nil {
+		mmResolveCurrency.mock.t.Fatalf("TransactionUsecaseMock.ResolveCurrency mock is already set by Set")
 	}
 
-	expectation := &TransactionUsecaseMockImportTransactionsFromCSVExpectation{
-		mock:               mmImportTransactionsFromCSV.mock,
-		params:             &TransactionUsecaseMockImportTransactionsFromCSVParams{ctx, data, accountID},
-		expectationOrigins: TransactionUsecaseMockImportTransactionsFromCSVExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &TransactionUsecaseMockResolveCurrencyExpectation{
+		mock:               mmResolveCurrency.mock,
+		params:             &TransactionUsecaseMockResolveCurrencyParams{ctx, accountID, currency, walletID},
+		expectationOrigins: TransactionUsecaseMockResolveCurrencyExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmImportTransactionsFromCSV.expectations = append(mmImportTransactionsFromCSV.expectations, expectation)
+	mmResolveCurrency.expectations = append(mmResolveCurrency.expectations, expectation)
 	return expectation
 }
 
-// Then sets up TransactionUsecase.ImportTransactionsFromCSV return parameters for the expectation previously defined by the When method
-func (e *TransactionUsecaseMockImportTransactionsFromCSVExpectation) Then(err error) *TransactionUsecaseMock {
-	e.results = &TransactionUsecaseMockImportTransactionsFromCSVResults{err}
+// Then sets up TransactionUsecase.ResolveCurrency return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockResolveCurrencyExpectation) Then(resCurrency string, err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockResolveCurrencyResults{resCurrency, err}
 	return e.mock
 }
 
-// Times sets number of times TransactionUsecase.ImportTransactionsFromCSV should be invoked
-func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) Times(n uint64) *mTransactionUsecaseMockImportTransactionsFromCSV {
+// Times sets number of times TransactionUsecase.ResolveCurrency should be invoked
+func (mmResolveCurrency *mTransactionUsecaseMockResolveCurrency) Times(n uint64) *mTransactionUsecaseMockResolveCurrency {
 	if n == 0 {
-		mmImportTransactionsFromCSV.mock.t.Fatalf("Times of TransactionUsecaseMock.ImportTransactionsFromCSV mock can not be zero")
+		mmResolveCurrency.mock.t.Fatalf("Times of TransactionUsecaseMock.ResolveCurrency mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmImportTransactionsFromCSV.expectedInvocations, n)
-	mmImportTransactionsFromCSV.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmImportTransactionsFromCSV
+	mm_atomic.StoreUint64(&mmResolveCurrency.expectedInvocations, n)
+	mmResolveCurrency.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmResolveCurrency
 }
 
-func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) invocationsDone() bool {
-	if len(mmImportTransactionsFromCSV.expectations) == 0 && mmImportTransactionsFromCSV.defaultExpectation == nil && mmImportTransactionsFromCSV.mock.funcImportTransactionsFromCSV == nil {
+func (mmResolveCurrency *mTransactionUsecaseMockResolveCurrency) invocationsDone() bool {
+	if len(mmResolveCurrency.expectations) == 0 && mmResolveCurrency.defaultExpectation == nil && mmResolveCurrency.mock.funcResolveCurrency == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmImportTransactionsFromCSV.mock.afterImportTransactionsFromCSVCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmImportTransactionsFromCSV.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmResolveCurrency.mock.afterResolveCurrencyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmResolveCurrency.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ImportTransactionsFromCSV implements mm_usecase.TransactionUsecase
-func (mmImportTransactionsFromCSV *TransactionUsecaseMock) ImportTransactionsFromCSV(ctx context.Context, data []byte, accountID uuid.UUID) (err error) {
-	mm_atomic.AddUint64(&mmImportTransactionsFromCSV.beforeImportTransactionsFromCSVCounter, 1)
-	defer mm_atomic.AddUint64(&mmImportTransactionsFromCSV.afterImportTransactionsFromCSVCounter, 1)
+// ResolveCurrency implements mm_usecase.TransactionUsecase
+func (mmResolveCurrency *TransactionUsecaseMock) ResolveCurrency(ctx context.Context, accountID uuid.UUID, currency string, walletID *uuid.UUID) (resCurrency string, err error) {
+	mm_atomic.AddUint64(&mmResolveCurrency.beforeResolveCurrencyCounter, 1)
+	defer mm_atomic.AddUint64(&mmResolveCurrency.afterResolveCurrencyCounter, 1)
 
-	mmImportTransactionsFromCSV.t.Helper()
+	mmResolveCurrency.t.Helper()
 
-	if mmImportTransactionsFromCSV.inspectFuncImportTransactionsFromCSV != nil {
-		mmImportTransactionsFromCSV.inspectFuncImportTransactionsFromCSV(ctx, data, accountID)
+	if mmResolveCurrency.inspectFuncResolveCurrency != nil {
+		mmResolveCurrency.inspectFuncResolveCurrency(ctx, accountID, currency, walletID)
 	}
 
-	mm_params := TransactionUsecaseMockImportTransactionsFromCSVParams{ctx, data, accountID}
+	mm_params := TransactionUsecaseMockResolveCurrencyParams{ctx, accountID, currency, walletID}
 
 	// Record call args
-	mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.mutex.Lock()
-	mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.callArgs = append(mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.callArgs, &mm_params)
-	mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.mutex.Unlock()
+	mmResolveCurrency.ResolveCurrencyMock.mutex.Lock()
+	mmResolveCurrency.ResolveCurrencyMock.callArgs = append(mmResolveCurrency.ResolveCurrencyMock.callArgs, &mm_params)
+	mmResolveCurrency.ResolveCurrencyMock.mutex.Unlock()
 
-	for _, e := range mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.expectations {
+	for _, e := range mmResolveCurrency.ResolveCurrencyMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.resCurrency, e.results.err
 		}
 	}
 
-	if mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.defaultExpectation.Counter, 1)
-		mm_want := mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.defaultExpectation.params
-		mm_want_ptrs := mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.defaultExpectation.paramPtrs
+	if mmResolveCurrency.ResolveCurrencyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResolveCurrency.ResolveCurrencyMock.defaultExpectation.Counter, 1)
+		mm_want := mmResolveCurrency.ResolveCurrencyMock.defaultExpectation.params
+		mm_want_ptrs := mmResolveCurrency.ResolveCurrencyMock.defaultExpectation.paramPtrs
 
-		mm_got := TransactionUsecaseMockImportTransactionsFromCSVParams{ctx, data, accountID}
+		mm_got := TransactionUsecaseMockResolveCurrencyParams{ctx, accountID, currency, walletID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmImportTransactionsFromCSV.t.Errorf("TransactionUsecaseMock.ImportTransactionsFromCSV got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmResolveCurrency.t.Errorf("TransactionUsecaseMock.ResolveCurrency got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResolveCurrency.ResolveCurrencyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
-				mmImportTransactionsFromCSV.t.Errorf("TransactionUsecaseMock.ImportTransactionsFromCSV got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
+				mmResolveCurrency.t.Errorf("TransactionUsecaseMock.ResolveCurrency got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResolveCurrency.ResolveCurrencyMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
 			}
 
-			if mm_want_ptrs.accountID != nil && !minimock.Equal(*mm_want_ptrs.accountID, mm_got.accountID) {
-				mmImportTransactionsFromCSV.t.Errorf("TransactionUsecaseMock.ImportTransactionsFromCSV got unexpected parameter accountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.defaultExpectation.expectationOrigins.originAccountID, *mm_want_ptrs.accountID, mm_got.accountID, minimock.Diff(*mm_want_ptrs.accountID, mm_got.accountID))
+			if mm_want_ptrs.currency != nil && !minimock.Equal(*mm_want_ptrs.currency, mm_got.currency) {
+				mmResolveCurrency.t.Errorf("TransactionUsecaseMock.ResolveCurrency got unexpected parameter currency, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResolveCurrency.ResolveCurrencyMock.defaultExpectation.expectationOrigins.originCurrency, *mm_want_ptrs.currency, mm_got.currency, minimock.Diff(*mm_want_ptrs.currency, mm_got.currency))
+			}
+
+			if mm_want_ptrs.walletID != nil && !minimock.Equal(*mm_want_ptrs.walletID, mm_got.walletID) {
+				mmResolveCurrency.t.Errorf("TransactionUsecaseMock.ResolveCurrency got unexpected parameter walletID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResolveCurrency.ResolveCurrencyMock.defaultExpectation.expectationOrigins.originWalletID, *mm_want_ptrs.walletID, mm_got.walletID, minimock.Diff(*mm_want_ptrs.walletID, mm_got.walletID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmImportTransactionsFromCSV.t.Errorf("TransactionUsecaseMock.ImportTransactionsFromCSV got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmResolveCurrency.t.Errorf("TransactionUsecaseMock.ResolveCurrency got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmResolveCurrency.ResolveCurrencyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmImportTransactionsFromCSV.ImportTransactionsFromCSVMock.defaultExpectation.results
+		mm_results := mmResolveCurrency.ResolveCurrencyMock.defaultExpectation.results
 		if mm_results == nil {
-			mmImportTransactionsFromCSV.t.Fatal("No results are set for the TransactionUsecaseMock.ImportTransactionsFromCSV")
+			mmResolveCurrency.t.Fatal("No results are set for the TransactionUsecaseMock.ResolveCurrency")
 		}
-		return (*mm_results).err
+		return (*mm_results).resCurrency, (*mm_results).err
 	}
-	if mmImportTransactionsFromCSV.funcImportTransactionsFromCSV != nil {
-		return mmImportTransactionsFromCSV.funcImportTransactionsFromCSV(ctx, data, accountID)
+	if mmResolveCurrency.funcResolveCurrency != nil {
+		return mmResolveCurrency.funcResolveCurrency(ctx, accountID, currency, walletID)
 	}
-	mmImportTransactionsFromCSV.t.Fatalf("Unexpected call to TransactionUsecaseMock.ImportTransactionsFromCSV. %v %v %v", ctx, data, accountID)
+	mmResolveCurrency.t.Fatalf("Unexpected call to TransactionUsecaseMock.ResolveCurrency. %v %v %v %v", ctx, accountID, currency, walletID)
 	return
 }
 
-// ImportTransactionsFromCSVAfterCounter returns a count of finished TransactionUsecaseMock.ImportTransactionsFromCSV invocations
-func (mmImportTransactionsFromCSV *TransactionUsecaseMock) ImportTransactionsFromCSVAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmImportTransactionsFromCSV.afterImportTransactionsFromCSVCounter)
+// ResolveCurrencyAfterCounter returns a count of finished TransactionUsecaseMock.ResolveCurrency invocations
+func (mmResolveCurrency *TransactionUsecaseMock) ResolveCurrencyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResolveCurrency.afterResolveCurrencyCounter)
 }
 
-// ImportTransactionsFromCSVBeforeCounter returns a count of TransactionUsecaseMock.ImportTransactionsFromCSV invocations
-func (mmImportTransactionsFromCSV *TransactionUsecaseMock) ImportTransactionsFromCSVBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmImportTransactionsFromCSV.beforeImportTransactionsFromCSVCounter)
+// ResolveCurrencyBeforeCounter returns a count of TransactionUsecaseMock.ResolveCurrency invocations
+func (mmResolveCurrency *TransactionUsecaseMock) ResolveCurrencyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResolveCurrency.beforeResolveCurrencyCounter)
 }
 
-// Calls returns a list of arguments used in each call to TransactionUsecaseMock.ImportTransactionsFromCSV.
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.ResolveCurrency.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmImportTransactionsFromCSV *mTransactionUsecaseMockImportTransactionsFromCSV) Calls() []*TransactionUsecaseMockImportTransactionsFromCSVParams {
-	mmImportTransactionsFromCSV.mutex.RLock()
+func (mmResolveCurrency *mTransactionUsecaseMockResolveCurrency) Calls() []*TransactionUsecaseMockResolveCurrencyParams {
+	mmResolveCurrency.mutex.RLock()
 
-	argCopy := make([]*TransactionUsecaseMockImportTransactionsFromCSVParams, len(mmImportTransactionsFromCSV.callArgs))
-	copy(argCopy, mmImportTransactionsFromCSV.callArgs)
+	argCopy := make([]*TransactionUsecaseMockResolveCurrencyParams, len(mmResolveCurrency.callArgs))
+	copy(argCopy, mmResolveCurrency.callArgs)
 
-	mmImportTransactionsFromCSV.mutex.RUnlock()
+	mmResolveCurrency.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockImportTransactionsFromCSVDone returns true if the count of the ImportTransactionsFromCSV invocations corresponds
+// MinimockResolveCurrencyDone returns true if the count of the ResolveCurrency invocations corresponds
 // the number of defined expectations
-func (m *TransactionUsecaseMock) MinimockImportTransactionsFromCSVDone() bool {
-	if m.ImportTransactionsFromCSVMock.optional {
+func (m *TransactionUsecaseMock) MinimockResolveCurrencyDone() bool {
+	if m.ResolveCurrencyMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ImportTransactionsFromCSVMock.expectations {
+	for _, e := range m.ResolveCurrencyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ImportTransactionsFromCSVMock.invocationsDone()
+	return m.ResolveCurrencyMock.invocationsDone()
 }
 
-// MinimockImportTransactionsFromCSVInspect logs each unmet expectation
-func (m *TransactionUsecaseMock) MinimockImportTransactionsFromCSVInspect() {
-	for _, e := range m.ImportTransactionsFromCSVMock.expectations {
+// MinimockResolveCurrencyInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockResolveCurrencyInspect() {
+	for _, e := range m.ResolveCurrencyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.ImportTransactionsFromCSV at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to TransactionUsecaseMock.ResolveCurrency at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterImportTransactionsFromCSVCounter := mm_atomic.LoadUint64(&m.afterImportTransactionsFromCSVCounter)
+	afterResolveCurrencyCounter := mm_atomic.LoadUint64(&m.afterResolveCurrencyCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ImportTransactionsFromCSVMock.defaultExpectation != nil && afterImportTransactionsFromCSVCounter < 1 {
-		if m.ImportTransactionsFromCSVMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.ImportTransactionsFromCSV at\n%s", m.ImportTransactionsFromCSVMock.defaultExpectation.returnOrigin)
+	if m.ResolveCurrencyMock.defaultExpectation != nil && afterResolveCurrencyCounter < 1 {
+		if m.ResolveCurrencyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.ResolveCurrency at\n%s", m.ResolveCurrencyMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.ImportTransactionsFromCSV at\n%s with params: %#v", m.ImportTransactionsFromCSVMock.defaultExpectation.expectationOrigins.origin, *m.ImportTransactionsFromCSVMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to TransactionUsecaseMock.ResolveCurrency at\n%s with params: %#v", m.ResolveCurrencyMock.defaultExpectation.expectationOrigins.origin, *m.ResolveCurrencyMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcImportTransactionsFromCSV != nil && afterImportTransactionsFromCSVCounter < 1 {
-		m.t.Errorf("Expected call to TransactionUsecaseMock.ImportTransactionsFromCSV at\n%s", m.funcImportTransactionsFromCSVOrigin)
+	if m.funcResolveCurrency != nil && afterResolveCurrencyCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.ResolveCurrency at\n%s", m.funcResolveCurrencyOrigin)
 	}
 
-	if !m.ImportTransactionsFromCSVMock.invocationsDone() && afterImportTransactionsFromCSVCounter > 0 {
-		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.ImportTransactionsFromCSV at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ImportTransactionsFromCSVMock.expectedInvocations), m.ImportTransactionsFromCSVMock.expectedInvocationsOrigin, afterImportTransactionsFromCSVCounter)
+	if !m.ResolveCurrencyMock.invocationsDone() && afterResolveCurrencyCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.ResolveCurrency at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ResolveCurrencyMock.expectedInvocations), m.ResolveCurrencyMock.expectedInvocationsOrigin, afterResolveCurrencyCounter)
 	}
 }
 
-type mTransactionUsecaseMockPatchTransactionByDTO struct {
+type mTransactionUsecaseMockStreamListAsCSV struct {
 	optional           bool
 	mock               *TransactionUsecaseMock
-	defaultExpectation *TransactionUsecaseMockPatchTransactionByDTOExpectation
-	expectations       []*TransactionUsecaseMockPatchTransactionByDTOExpectation
+	defaultExpectation *TransactionUsecaseMockStreamListAsCSVExpectation
+	expectations       []*TransactionUsecaseMockStreamListAsCSVExpectation
 
-	callArgs []*TransactionUsecaseMockPatchTransactionByDTOParams
+	callArgs []*TransactionUsecaseMockStreamListAsCSVParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// TransactionUsecaseMockPatchTransactionByDTOExpectation specifies expectation struct of the TransactionUsecase.PatchTransactionByDTO
-type TransactionUsecaseMockPatchTransactionByDTOExpectation struct {
+// TransactionUsecaseMockStreamListAsCSVExpectation specifies expectation struct of the TransactionUsecase.StreamListAsCSV
+type TransactionUsecaseMockStreamListAsCSVExpectation struct {
 	mock               *TransactionUsecaseMock
-	params             *TransactionUsecaseMockPatchTransactionByDTOParams
-	paramPtrs          *TransactionUsecaseMockPatchTransactionByDTOParamPtrs
-	expectationOrigins TransactionUsecaseMockPatchTransactionByDTOExpectationOrigins
-	results            *TransactionUsecaseMockPatchTransactionByDTOResults
+	params             *TransactionUsecaseMockStreamListAsCSVParams
+	paramPtrs          *TransactionUsecaseMockStreamListAsCSVParamPtrs
+	expectationOrigins TransactionUsecaseMockStreamListAsCSVExpectationOrigins
+	results            *TransactionUsecaseMockStreamListAsCSVResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// TransactionUsecaseMockPatchTransactionByDTOParams contains parameters of the TransactionUsecase.PatchTransactionByDTO
-type TransactionUsecaseMockPatchTransactionByDTOParams struct {
-	ctx              context.Context
-	id               uuid.UUID
-	in               mm_usecase.PatchTransactionDataInput
-	skipVersionCheck bool
+// TransactionUsecaseMockStreamListAsCSVParams contains parameters of the TransactionUsecase.StreamListAsCSV
+type TransactionUsecaseMockStreamListAsCSVParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.TransactionListOptions
+	batchSize   uint64
+	write       func(chunk []byte) error
 }
 
-// TransactionUsecaseMockPatchTransactionByDTOParamPtrs contains pointers to parameters of the TransactionUsecase.PatchTransactionByDTO
-type TransactionUsecaseMockPatchTransactionByDTOParamPtrs struct {
-	ctx              *context.Context
-	id               *uuid.UUID
-	in               *mm_usecase.PatchTransactionDataInput
-	skipVersionCheck *bool
+// TransactionUsecaseMockStreamListAsCSVParamPtrs contains pointers to parameters of the TransactionUsecase.StreamListAsCSV
+type TransactionUsecaseMockStreamListAsCSVParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.TransactionListOptions
+	batchSize   *uint64
+	write       *func(chunk []byte) error
 }
 
-// TransactionUsecaseMockPatchTransactionByDTOResults contains results of the TransactionUsecase.PatchTransactionByDTO
-type TransactionUsecaseMockPatchTransactionByDTOResults struct {
+// TransactionUsecaseMockStreamListAsCSVResults contains results of the TransactionUsecase.StreamListAsCSV
+type TransactionUsecaseMockStreamListAsCSVResults struct {
 	err error
 }
 
-// TransactionUsecaseMockPatchTransactionByDTOOrigins contains origins of expectations of the TransactionUsecase.PatchTransactionByDTO
-type TransactionUsecaseMockPatchTransactionByDTOExpectationOrigins struct {
-	origin                 string
-	originCtx              string
-	originId               string
-	originIn               string
-	originSkipVersionCheck string
+// TransactionUsecaseMockStreamListAsCSVOrigins contains origins of expectations of the TransactionUsecase.StreamListAsCSV
+type TransactionUsecaseMockStreamListAsCSVExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originBatchSize   string
+	originWrite       string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -3469,348 +7724,348 @@ type TransactionUsecaseMockPatchTransactionByDTOExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) Optional() *mTransactionUsecaseMockPatchTransactionByDTO {
-	mmPatchTransactionByDTO.optional = true
-	return mmPatchTransactionByDTO
+func (mmStreamListAsCSV *mTransactionUsecaseMockStreamListAsCSV) Optional() *mTransactionUsecaseMockStreamListAsCSV {
+	mmStreamListAsCSV.optional = true
+	return mmStreamListAsCSV
 }
 
-// Expect sets up expected params for TransactionUsecase.PatchTransactionByDTO
-func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) Expect(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransactionDataInput, skipVersionCheck bool) *mTransactionUsecaseMockPatchTransactionByDTO {
-	if mmPatchTransactionByDTO.mock.funcPatchTransactionByDTO != nil {
-		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Set")
+// Expect sets up expected params for TransactionUsecase.StreamListAsCSV
+func (mmStreamListAsCSV *mTransactionUsecaseMockStreamListAsCSV) Expect(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, batchSize uint64, write func(chunk []byte) error) *mTransactionUsecaseMockStreamListAsCSV {
+	if mmStreamListAsCSV.mock.funcStreamListAsCSV != nil {
+		mmStreamListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.StreamListAsCSV mock is already set by Set")
 	}
 
-	if mmPatchTransactionByDTO.defaultExpectation == nil {
-		mmPatchTransactionByDTO.defaultExpectation = &TransactionUsecaseMockPatchTransactionByDTOExpectation{}
+	if mmStreamListAsCSV.defaultExpectation == nil {
+		mmStreamListAsCSV.defaultExpectation = &TransactionUsecaseMockStreamListAsCSVExpectation{}
 	}
 
-	if mmPatchTransactionByDTO.defaultExpectation.paramPtrs != nil {
-		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by ExpectParams functions")
+	if mmStreamListAsCSV.defaultExpectation.paramPtrs != nil {
+		mmStreamListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.StreamListAsCSV mock is already set by ExpectParams functions")
 	}
 
-	mmPatchTransactionByDTO.defaultExpectation.params = &TransactionUsecaseMockPatchTransactionByDTOParams{ctx, id, in, skipVersionCheck}
-	mmPatchTransactionByDTO.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmPatchTransactionByDTO.expectations {
-		if minimock.Equal(e.params, mmPatchTransactionByDTO.defaultExpectation.params) {
-			mmPatchTransactionByDTO.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPatchTransactionByDTO.defaultExpectation.params)
+	mmStreamListAsCSV.defaultExpectation.params = &TransactionUsecaseMockStreamListAsCSVParams{ctx, listOptions, batchSize, write}
+	mmStreamListAsCSV.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmStreamListAsCSV.expectations {
+		if minimock.Equal(e.params, mmStreamListAsCSV.defaultExpectation.params) {
+			mmStreamListAsCSV.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStreamListAsCSV.defaultExpectation.params)
 		}
 	}
 
-	return mmPatchTransactionByDTO
+	return mmStreamListAsCSV
 }
 
-// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.PatchTransactionByDTO
-func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockPatchTransactionByDTO {
-	if mmPatchTransactionByDTO.mock.funcPatchTransactionByDTO != nil {
-		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for TransactionUsecase.StreamListAsCSV
+func (mmStreamListAsCSV *mTransactionUsecaseMockStreamListAsCSV) ExpectCtxParam1(ctx context.Context) *mTransactionUsecaseMockStreamListAsCSV {
+	if mmStreamListAsCSV.mock.funcStreamListAsCSV != nil {
+		mmStreamListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.StreamListAsCSV mock is already set by Set")
 	}
 
-	if mmPatchTransactionByDTO.defaultExpectation == nil {
-		mmPatchTransactionByDTO.defaultExpectation = &TransactionUsecaseMockPatchTransactionByDTOExpectation{}
+	if mmStreamListAsCSV.defaultExpectation == nil {
+		mmStreamListAsCSV.defaultExpectation = &TransactionUsecaseMockStreamListAsCSVExpectation{}
 	}
 
-	if mmPatchTransactionByDTO.defaultExpectation.params != nil {
-		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Expect")
+	if mmStreamListAsCSV.defaultExpectation.params != nil {
+		mmStreamListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.StreamListAsCSV mock is already set by Expect")
 	}
 
-	if mmPatchTransactionByDTO.defaultExpectation.paramPtrs == nil {
-		mmPatchTransactionByDTO.defaultExpectation.paramPtrs = &TransactionUsecaseMockPatchTransactionByDTOParamPtrs{}
+	if mmStreamListAsCSV.defaultExpectation.paramPtrs == nil {
+		mmStreamListAsCSV.defaultExpectation.paramPtrs = &TransactionUsecaseMockStreamListAsCSVParamPtrs{}
 	}
-	mmPatchTransactionByDTO.defaultExpectation.paramPtrs.ctx = &ctx
-	mmPatchTransactionByDTO.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmStreamListAsCSV.defaultExpectation.paramPtrs.ctx = &ctx
+	mmStreamListAsCSV.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmPatchTransactionByDTO
+	return mmStreamListAsCSV
 }
 
-// ExpectIdParam2 sets up expected param id for TransactionUsecase.PatchTransactionByDTO
-func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) ExpectIdParam2(id uuid.UUID) *mTransactionUsecaseMockPatchTransactionByDTO {
-	if mmPatchTransactionByDTO.mock.funcPatchTransactionByDTO != nil {
-		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Set")
+// ExpectListOptionsParam2 sets up expected param listOptions for TransactionUsecase.StreamListAsCSV
+func (mmStreamListAsCSV *mTransactionUsecaseMockStreamListAsCSV) ExpectListOptionsParam2(listOptions *mm_usecase.TransactionListOptions) *mTransactionUsecaseMockStreamListAsCSV {
+	if mmStreamListAsCSV.mock.funcStreamListAsCSV != nil {
+		mmStreamListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.StreamListAsCSV mock is already set by Set")
 	}
 
-	if mmPatchTransactionByDTO.defaultExpectation == nil {
-		mmPatchTransactionByDTO.defaultExpectation = &TransactionUsecaseMockPatchTransactionByDTOExpectation{}
+	if mmStreamListAsCSV.defaultExpectation == nil {
+		mmStreamListAsCSV.defaultExpectation = &TransactionUsecaseMockStreamListAsCSVExpectation{}
 	}
 
-	if mmPatchTransactionByDTO.defaultExpectation.params != nil {
-		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Expect")
+	if mmStreamListAsCSV.defaultExpectation.params != nil {
+		mmStreamListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.StreamListAsCSV mock is already set by Expect")
 	}
 
-	if mmPatchTransactionByDTO.defaultExpectation.paramPtrs == nil {
-		mmPatchTransactionByDTO.defaultExpectation.paramPtrs = &TransactionUsecaseMockPatchTransactionByDTOParamPtrs{}
+	if mmStreamListAsCSV.defaultExpectation.paramPtrs == nil {
+		mmStreamListAsCSV.defaultExpectation.paramPtrs = &TransactionUsecaseMockStreamListAsCSVParamPtrs{}
 	}
-	mmPatchTransactionByDTO.defaultExpectation.paramPtrs.id = &id
-	mmPatchTransactionByDTO.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmStreamListAsCSV.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmStreamListAsCSV.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
 
-	return mmPatchTransactionByDTO
+	return mmStreamListAsCSV
 }
 
-// ExpectInParam3 sets up expected param in for TransactionUsecase.PatchTransactionByDTO
-func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) ExpectInParam3(in mm_usecase.PatchTransactionDataInput) *mTransactionUsecaseMockPatchTransactionByDTO {
-	if mmPatchTransactionByDTO.mock.funcPatchTransactionByDTO != nil {
-		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Set")
+// ExpectBatchSizeParam3 sets up expected param batchSize for TransactionUsecase.StreamListAsCSV
+func (mmStreamListAsCSV *mTransactionUsecaseMockStreamListAsCSV) ExpectBatchSizeParam3(batchSize uint64) *mTransactionUsecaseMockStreamListAsCSV {
+	if mmStreamListAsCSV.mock.funcStreamListAsCSV != nil {
+		mmStreamListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.StreamListAsCSV mock is already set by Set")
 	}
 
-	if mmPatchTransactionByDTO.defaultExpectation == nil {
-		mmPatchTransactionByDTO.defaultExpectation = &TransactionUsecaseMockPatchTransactionByDTOExpectation{}
+	if mmStreamListAsCSV.defaultExpectation == nil {
+		mmStreamListAsCSV.defaultExpectation = &TransactionUsecaseMockStreamListAsCSVExpectation{}
 	}
 
-	if mmPatchTransactionByDTO.defaultExpectation.params != nil {
-		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Expect")
+	if mmStreamListAsCSV.defaultExpectation.params != nil {
+		mmStreamListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.StreamListAsCSV mock is already set by Expect")
 	}
 
-	if mmPatchTransactionByDTO.defaultExpectation.paramPtrs == nil {
-		mmPatchTransactionByDTO.defaultExpectation.paramPtrs = &TransactionUsecaseMockPatchTransactionByDTOParamPtrs{}
+	if mmStreamListAsCSV.defaultExpectation.paramPtrs == nil {
+		mmStreamListAsCSV.defaultExpectation.paramPtrs = &TransactionUsecaseMockStreamListAsCSVParamPtrs{}
 	}
-	mmPatchTransactionByDTO.defaultExpectation.paramPtrs.in = &in
-	mmPatchTransactionByDTO.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+	mmStreamListAsCSV.defaultExpectation.paramPtrs.batchSize = &batchSize
+	mmStreamListAsCSV.defaultExpectation.expectationOrigins.originBatchSize = minimock.CallerInfo(1)
 
-	return mmPatchTransactionByDTO
+	return mmStreamListAsCSV
 }
 
-// ExpectSkipVersionCheckParam4 sets up expected param skipVersionCheck for TransactionUsecase.PatchTransactionByDTO
-func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) ExpectSkipVersionCheckParam4(skipVersionCheck bool) *mTransactionUsecaseMockPatchTransactionByDTO {
-	if mmPatchTransactionByDTO.mock.funcPatchTransactionByDTO != nil {
-		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Set")
+// ExpectWriteParam4 sets up expected param write for TransactionUsecase.StreamListAsCSV
+func (mmStreamListAsCSV *mTransactionUsecaseMockStreamListAsCSV) ExpectWriteParam4(write func(chunk []byte) error) *mTransactionUsecaseMockStreamListAsCSV {
+	if mmStreamListAsCSV.mock.funcStreamListAsCSV != nil {
+		mmStreamListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.StreamListAsCSV mock is already set by Set")
 	}
 
-	if mmPatchTransactionByDTO.defaultExpectation == nil {
-		mmPatchTransactionByDTO.defaultExpectation = &TransactionUsecaseMockPatchTransactionByDTOExpectation{}
+	if mmStreamListAsCSV.defaultExpectation == nil {
+		mmStreamListAsCSV.defaultExpectation = &TransactionUsecaseMockStreamListAsCSVExpectation{}
 	}
 
-	if mmPatchTransactionByDTO.defaultExpectation.params != nil {
-		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Expect")
+	if mmStreamListAsCSV.defaultExpectation.params != nil {
+		mmStreamListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.StreamListAsCSV mock is already set by Expect")
 	}
 
-	if mmPatchTransactionByDTO.defaultExpectation.paramPtrs == nil {
-		mmPatchTransactionByDTO.defaultExpectation.paramPtrs = &TransactionUsecaseMockPatchTransactionByDTOParamPtrs{}
+	if mmStreamListAsCSV.defaultExpectation.paramPtrs == nil {
+		mmStreamListAsCSV.defaultExpectation.paramPtrs = &TransactionUsecaseMockStreamListAsCSVParamPtrs{}
 	}
-	mmPatchTransactionByDTO.defaultExpectation.paramPtrs.skipVersionCheck = &skipVersionCheck
-	mmPatchTransactionByDTO.defaultExpectation.expectationOrigins.originSkipVersionCheck = minimock.CallerInfo(1)
+	mmStreamListAsCSV.defaultExpectation.paramPtrs.write = &write
+	mmStreamListAsCSV.defaultExpectation.expectationOrigins.originWrite = minimock.CallerInfo(1)
 
-	return mmPatchTransactionByDTO
+	return mmStreamListAsCSV
 }
 
-// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.PatchTransactionByDTO
-func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) Inspect(f func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransactionDataInput, skipVersionCheck bool)) *mTransactionUsecaseMockPatchTransactionByDTO {
-	if mmPatchTransactionByDTO.mock.inspectFuncPatchTransactionByDTO != nil {
-		mmPatchTransactionByDTO.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.PatchTransactionByDTO")
+// Inspect accepts an inspector function that has same arguments as the TransactionUsecase.StreamListAsCSV
+func (mmStreamListAsCSV *mTransactionUsecaseMockStreamListAsCSV) Inspect(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, batchSize uint64, write func(chunk []byte) error)) *mTransactionUsecaseMockStreamListAsCSV {
+	if mmStreamListAsCSV.mock.inspectFuncStreamListAsCSV != nil {
+		mmStreamListAsCSV.mock.t.Fatalf("Inspect function is already set for TransactionUsecaseMock.StreamListAsCSV")
 	}
 
-	mmPatchTransactionByDTO.mock.inspectFuncPatchTransactionByDTO = f
+	mmStreamListAsCSV.mock.inspectFuncStreamListAsCSV = f
 
-	return mmPatchTransactionByDTO
+	return mmStreamListAsCSV
 }
 
-// Return sets up results that will be returned by TransactionUsecase.PatchTransactionByDTO
-func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) Return(err error) *TransactionUsecaseMock {
-	if mmPatchTransactionByDTO.mock.funcPatchTransactionByDTO != nil {
-		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Set")
+// Return sets up results that will be returned by TransactionUsecase.StreamListAsCSV
+func (mmStreamListAsCSV *mTransactionUsecaseMockStreamListAsCSV) Return(err error) *TransactionUsecaseMock {
+	if mmStreamListAsCSV.mock.funcStreamListAsCSV != nil {
+		mmStreamListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.StreamListAsCSV mock is already set by Set")
 	}
 
-	if mmPatchTransactionByDTO.defaultExpectation == nil {
-		mmPatchTransactionByDTO.defaultExpectation = &TransactionUsecaseMockPatchTransactionByDTOExpectation{mock: mmPatchTransactionByDTO.mock}
+	if mmStreamListAsCSV.defaultExpectation == nil {
+		mmStreamListAsCSV.defaultExpectation = &TransactionUsecaseMockStreamListAsCSVExpectation{mock: mmStreamListAsCSV.mock}
 	}
-	mmPatchTransactionByDTO.defaultExpectation.results = &TransactionUsecaseMockPatchTransactionByDTOResults{err}
-	mmPatchTransactionByDTO.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmPatchTransactionByDTO.mock
+	mmStreamListAsCSV.defaultExpectation.results = &TransactionUsecaseMockStreamListAsCSVResults{err}
+	mmStreamListAsCSV.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmStreamListAsCSV.mock
 }
 
-// Set uses given function f to mock the TransactionUsecase.PatchTransactionByDTO method
-func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) Set(f func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransactionDataInput, skipVersionCheck bool) (err error)) *TransactionUsecaseMock {
-	if mmPatchTransactionByDTO.defaultExpectation != nil {
-		mmPatchTransactionByDTO.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.PatchTransactionByDTO method")
+// Set uses given function f to mock the TransactionUsecase.StreamListAsCSV method
+func (mmStreamListAsCSV *mTransactionUsecaseMockStreamListAsCSV) Set(f func(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, batchSize uint64, write func(chunk []byte) error) (err error)) *TransactionUsecaseMock {
+	if mmStreamListAsCSV.defaultExpectation != nil {
+		mmStreamListAsCSV.mock.t.Fatalf("Default expectation is already set for the TransactionUsecase.StreamListAsCSV method")
 	}
 
-	if len(mmPatchTransactionByDTO.expectations) > 0 {
-		mmPatchTransactionByDTO.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.PatchTransactionByDTO method")
+	if len(mmStreamListAsCSV.expectations) > 0 {
+		mmStreamListAsCSV.mock.t.Fatalf("Some expectations are already set for the TransactionUsecase.StreamListAsCSV method")
 	}
 
-	mmPatchTransactionByDTO.mock.funcPatchTransactionByDTO = f
-	mmPatchTransactionByDTO.mock.funcPatchTransactionByDTOOrigin = minimock.CallerInfo(1)
-	return mmPatchTransactionByDTO.mock
+	mmStreamListAsCSV.mock.funcStreamListAsCSV = f
+	mmStreamListAsCSV.mock.funcStreamListAsCSVOrigin = minimock.CallerInfo(1)
+	return mmStreamListAsCSV.mock
 }
 
-// When sets expectation for the TransactionUsecase.PatchTransactionByDTO which will trigger the result defined by the following
+// When sets expectation for the TransactionUsecase.StreamListAsCSV which will trigger the result defined by the following
 // Then helper
-func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) When(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransactionDataInput, skipVersionCheck bool) *TransactionUsecaseMockPatchTransactionByDTOExpectation {
-	if mmPatchTransactionByDTO.mock.funcPatchTransactionByDTO != nil {
-		mmPatchTransactionByDTO.mock.t.Fatalf("TransactionUsecaseMock.PatchTransactionByDTO mock is already set by Set")
+func (mmStreamListAsCSV *mTransactionUsecaseMockStreamListAsCSV) When(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, batchSize uint64, write func(chunk []byte) error) *TransactionUsecaseMockStreamListAsCSVExpectation {
+	if mmStreamListAsCSV.mock.funcStreamListAsCSV != nil {
+		mmStreamListAsCSV.mock.t.Fatalf("TransactionUsecaseMock.StreamListAsCSV mock is already set by Set")
 	}
 
-	expectation := &TransactionUsecaseMockPatchTransactionByDTOExpectation{
-		mock:               mmPatchTransactionByDTO.mock,
-		params:             &TransactionUsecaseMockPatchTransactionByDTOParams{ctx, id, in, skipVersionCheck},
-		expectationOrigins: TransactionUsecaseMockPatchTransactionByDTOExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &TransactionUsecaseMockStreamListAsCSVExpectation{
+		mock:               mmStreamListAsCSV.mock,
+		params:             &TransactionUsecaseMockStreamListAsCSVParams{ctx, listOptions, batchSize, write},
+		expectationOrigins: TransactionUsecaseMockStreamListAsCSVExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmPatchTransactionByDTO.expectations = append(mmPatchTransactionByDTO.expectations, expectation)
+	mmStreamListAsCSV.expectations = append(mmStreamListAsCSV.expectations, expectation)
 	return expectation
 }
 
-// Then sets up TransactionUsecase.PatchTransactionByDTO return parameters for the expectation previously defined by the When method
-func (e *TransactionUsecaseMockPatchTransactionByDTOExpectation) Then(err error) *TransactionUsecaseMock {
-	e.results = &TransactionUsecaseMockPatchTransactionByDTOResults{err}
+// Then sets up TransactionUsecase.StreamListAsCSV return parameters for the expectation previously defined by the When method
+func (e *TransactionUsecaseMockStreamListAsCSVExpectation) Then(err error) *TransactionUsecaseMock {
+	e.results = &TransactionUsecaseMockStreamListAsCSVResults{err}
 	return e.mock
 }
 
-// Times sets number of times TransactionUsecase.PatchTransactionByDTO should be invoked
-func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) Times(n uint64) *mTransactionUsecaseMockPatchTransactionByDTO {
+// Times sets number of times TransactionUsecase.StreamListAsCSV should be invoked
+func (mmStreamListAsCSV *mTransactionUsecaseMockStreamListAsCSV) Times(n uint64) *mTransactionUsecaseMockStreamListAsCSV {
 	if n == 0 {
-		mmPatchTransactionByDTO.mock.t.Fatalf("Times of TransactionUsecaseMock.PatchTransactionByDTO mock can not be zero")
+		mmStreamListAsCSV.mock.t.Fatalf("Times of TransactionUsecaseMock.StreamListAsCSV mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmPatchTransactionByDTO.expectedInvocations, n)
-	mmPatchTransactionByDTO.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmPatchTransactionByDTO
+	mm_atomic.StoreUint64(&mmStreamListAsCSV.expectedInvocations, n)
+	mmStreamListAsCSV.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmStreamListAsCSV
 }
 
-func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) invocationsDone() bool {
-	if len(mmPatchTransactionByDTO.expectations) == 0 && mmPatchTransactionByDTO.defaultExpectation == nil && mmPatchTransactionByDTO.mock.funcPatchTransactionByDTO == nil {
+func (mmStreamListAsCSV *mTransactionUsecaseMockStreamListAsCSV) invocationsDone() bool {
+	if len(mmStreamListAsCSV.expectations) == 0 && mmStreamListAsCSV.defaultExpectation == nil && mmStreamListAsCSV.mock.funcStreamListAsCSV == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmPatchTransactionByDTO.mock.afterPatchTransactionByDTOCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmPatchTransactionByDTO.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmStreamListAsCSV.mock.afterStreamListAsCSVCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStreamListAsCSV.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// PatchTransactionByDTO implements mm_usecase.TransactionUsecase
-func (mmPatchTransactionByDTO *TransactionUsecaseMock) PatchTransactionByDTO(ctx context.Context, id uuid.UUID, in mm_usecase.PatchTransactionDataInput, skipVersionCheck bool) (err error) {
-	mm_atomic.AddUint64(&mmPatchTransactionByDTO.beforePatchTransactionByDTOCounter, 1)
-	defer mm_atomic.AddUint64(&mmPatchTransactionByDTO.afterPatchTransactionByDTOCounter, 1)
+// StreamListAsCSV implements mm_usecase.TransactionUsecase
+func (mmStreamListAsCSV *TransactionUsecaseMock) StreamListAsCSV(ctx context.Context, listOptions *mm_usecase.TransactionListOptions, batchSize uint64, write func(chunk []byte) error) (err error) {
+	mm_atomic.AddUint64(&mmStreamListAsCSV.beforeStreamListAsCSVCounter, 1)
+	defer mm_atomic.AddUint64(&mmStreamListAsCSV.afterStreamListAsCSVCounter, 1)
 
-	mmPatchTransactionByDTO.t.Helper()
+	mmStreamListAsCSV.t.Helper()
 
-	if mmPatchTransactionByDTO.inspectFuncPatchTransactionByDTO != nil {
-		mmPatchTransactionByDTO.inspectFuncPatchTransactionByDTO(ctx, id, in, skipVersionCheck)
+	if mmStreamListAsCSV.inspectFuncStreamListAsCSV != nil {
+		mmStreamListAsCSV.inspectFuncStreamListAsCSV(ctx, listOptions, batchSize, write)
 	}
 
-	mm_params := TransactionUsecaseMockPatchTransactionByDTOParams{ctx, id, in, skipVersionCheck}
+	mm_params := TransactionUsecaseMockStreamListAsCSVParams{ctx, listOptions, batchSize, write}
 
 	// Record call args
-	mmPatchTransactionByDTO.PatchTransactionByDTOMock.mutex.Lock()
-	mmPatchTransactionByDTO.PatchTransactionByDTOMock.callArgs = append(mmPatchTransactionByDTO.PatchTransactionByDTOMock.callArgs, &mm_params)
-	mmPatchTransactionByDTO.PatchTransactionByDTOMock.mutex.Unlock()
+	mmStreamListAsCSV.StreamListAsCSVMock.mutex.Lock()
+	mmStreamListAsCSV.StreamListAsCSVMock.callArgs = append(mmStreamListAsCSV.StreamListAsCSVMock.callArgs, &mm_params)
+	mmStreamListAsCSV.StreamListAsCSVMock.mutex.Unlock()
 
-	for _, e := range mmPatchTransactionByDTO.PatchTransactionByDTOMock.expectations {
+	for _, e := range mmStreamListAsCSV.StreamListAsCSVMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation.Counter, 1)
-		mm_want := mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation.params
-		mm_want_ptrs := mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation.paramPtrs
+	if mmStreamListAsCSV.StreamListAsCSVMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStreamListAsCSV.StreamListAsCSVMock.defaultExpectation.Counter, 1)
+		mm_want := mmStreamListAsCSV.StreamListAsCSVMock.defaultExpectation.params
+		mm_want_ptrs := mmStreamListAsCSV.StreamListAsCSVMock.defaultExpectation.paramPtrs
 
-		mm_got := TransactionUsecaseMockPatchTransactionByDTOParams{ctx, id, in, skipVersionCheck}
+		mm_got := TransactionUsecaseMockStreamListAsCSVParams{ctx, listOptions, batchSize, write}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmPatchTransactionByDTO.t.Errorf("TransactionUsecaseMock.PatchTransactionByDTO got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmStreamListAsCSV.t.Errorf("TransactionUsecaseMock.StreamListAsCSV got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStreamListAsCSV.StreamListAsCSVMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmPatchTransactionByDTO.t.Errorf("TransactionUsecaseMock.PatchTransactionByDTO got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmStreamListAsCSV.t.Errorf("TransactionUsecaseMock.StreamListAsCSV got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStreamListAsCSV.StreamListAsCSVMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
 			}
 
-			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
-				mmPatchTransactionByDTO.t.Errorf("TransactionUsecaseMock.PatchTransactionByDTO got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			if mm_want_ptrs.batchSize != nil && !minimock.Equal(*mm_want_ptrs.batchSize, mm_got.batchSize) {
+				mmStreamListAsCSV.t.Errorf("TransactionUsecaseMock.StreamListAsCSV got unexpected parameter batchSize, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStreamListAsCSV.StreamListAsCSVMock.defaultExpectation.expectationOrigins.originBatchSize, *mm_want_ptrs.batchSize, mm_got.batchSize, minimock.Diff(*mm_want_ptrs.batchSize, mm_got.batchSize))
 			}
 
-			if mm_want_ptrs.skipVersionCheck != nil && !minimock.Equal(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck) {
-				mmPatchTransactionByDTO.t.Errorf("TransactionUsecaseMock.PatchTransactionByDTO got unexpected parameter skipVersionCheck, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation.expectationOrigins.originSkipVersionCheck, *mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck, minimock.Diff(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck))
+			if mm_want_ptrs.write != nil && !minimock.Equal(*mm_want_ptrs.write, mm_got.write) {
+				mmStreamListAsCSV.t.Errorf("TransactionUsecaseMock.StreamListAsCSV got unexpected parameter write, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStreamListAsCSV.StreamListAsCSVMock.defaultExpectation.expectationOrigins.originWrite, *mm_want_ptrs.write, mm_got.write, minimock.Diff(*mm_want_ptrs.write, mm_got.write))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmPatchTransactionByDTO.t.Errorf("TransactionUsecaseMock.PatchTransactionByDTO got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmStreamListAsCSV.t.Errorf("TransactionUsecaseMock.StreamListAsCSV got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmStreamListAsCSV.StreamListAsCSVMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmPatchTransactionByDTO.PatchTransactionByDTOMock.defaultExpectation.results
+		mm_results := mmStreamListAsCSV.StreamListAsCSVMock.defaultExpectation.results
 		if mm_results == nil {
-			mmPatchTransactionByDTO.t.Fatal("No results are set for the TransactionUsecaseMock.PatchTransactionByDTO")
+			mmStreamListAsCSV.t.Fatal("No results are set for the TransactionUsecaseMock.StreamListAsCSV")
 		}
 		return (*mm_results).err
 	}
-	if mmPatchTransactionByDTO.funcPatchTransactionByDTO != nil {
-		return mmPatchTransactionByDTO.funcPatchTransactionByDTO(ctx, id, in, skipVersionCheck)
+	if mmStreamListAsCSV.funcStreamListAsCSV != nil {
+		return mmStreamListAsCSV.funcStreamListAsCSV(ctx, listOptions, batchSize, write)
 	}
-	mmPatchTransactionByDTO.t.Fatalf("Unexpected call to TransactionUsecaseMock.PatchTransactionByDTO. %v %v %v %v", ctx, id, in, skipVersionCheck)
+	mmStreamListAsCSV.t.Fatalf("Unexpected call to TransactionUsecaseMock.StreamListAsCSV. %v %v %v %v", ctx, listOptions, batchSize, write)
 	return
 }
 
-// PatchTransactionByDTOAfterCounter returns a count of finished TransactionUsecaseMock.PatchTransactionByDTO invocations
-func (mmPatchTransactionByDTO *TransactionUsecaseMock) PatchTransactionByDTOAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmPatchTransactionByDTO.afterPatchTransactionByDTOCounter)
+// StreamListAsCSVAfterCounter returns a count of finished TransactionUsecaseMock.StreamListAsCSV invocations
+func (mmStreamListAsCSV *TransactionUsecaseMock) StreamListAsCSVAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStreamListAsCSV.afterStreamListAsCSVCounter)
 }
 
-// PatchTransactionByDTOBeforeCounter returns a count of TransactionUsecaseMock.PatchTransactionByDTO invocations
-func (mmPatchTransactionByDTO *TransactionUsecaseMock) PatchTransactionByDTOBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmPatchTransactionByDTO.beforePatchTransactionByDTOCounter)
+// StreamListAsCSVBeforeCounter returns a count of TransactionUsecaseMock.StreamListAsCSV invocations
+func (mmStreamListAsCSV *TransactionUsecaseMock) StreamListAsCSVBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStreamListAsCSV.beforeStreamListAsCSVCounter)
 }
 
-// Calls returns a list of arguments used in each call to TransactionUsecaseMock.PatchTransactionByDTO.
+// Calls returns a list of arguments used in each call to TransactionUsecaseMock.StreamListAsCSV.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmPatchTransactionByDTO *mTransactionUsecaseMockPatchTransactionByDTO) Calls() []*TransactionUsecaseMockPatchTransactionByDTOParams {
-	mmPatchTransactionByDTO.mutex.RLock()
+func (mmStreamListAsCSV *mTransactionUsecaseMockStreamListAsCSV) Calls() []*TransactionUsecaseMockStreamListAsCSVParams {
+	mmStreamListAsCSV.mutex.RLock()
 
-	argCopy := make([]*TransactionUsecaseMockPatchTransactionByDTOParams, len(mmPatchTransactionByDTO.callArgs))
-	copy(argCopy, mmPatchTransactionByDTO.callArgs)
+	argCopy := make([]*TransactionUsecaseMockStreamListAsCSVParams, len(mmStreamListAsCSV.callArgs))
+	copy(argCopy, mmStreamListAsCSV.callArgs)
 
-	mmPatchTransactionByDTO.mutex.RUnlock()
+	mmStreamListAsCSV.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockPatchTransactionByDTODone returns true if the count of the PatchTransactionByDTO invocations corresponds
+// MinimockStreamListAsCSVDone returns true if the count of the StreamListAsCSV invocations corresponds
 // the number of defined expectations
-func (m *TransactionUsecaseMock) MinimockPatchTransactionByDTODone() bool {
-	if m.PatchTransactionByDTOMock.optional {
+func (m *TransactionUsecaseMock) MinimockStreamListAsCSVDone() bool {
+	if m.StreamListAsCSVMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.PatchTransactionByDTOMock.expectations {
+	for _, e := range m.StreamListAsCSVMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.PatchTransactionByDTOMock.invocationsDone()
+	return m.StreamListAsCSVMock.invocationsDone()
 }
 
-// MinimockPatchTransactionByDTOInspect logs each unmet expectation
-func (m *TransactionUsecaseMock) MinimockPatchTransactionByDTOInspect() {
-	for _, e := range m.PatchTransactionByDTOMock.expectations {
+// MinimockStreamListAsCSVInspect logs each unmet expectation
+func (m *TransactionUsecaseMock) MinimockStreamListAsCSVInspect() {
+	for _, e := range m.StreamListAsCSVMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.PatchTransactionByDTO at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to TransactionUsecaseMock.StreamListAsCSV at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterPatchTransactionByDTOCounter := mm_atomic.LoadUint64(&m.afterPatchTransactionByDTOCounter)
+	afterStreamListAsCSVCounter := mm_atomic.LoadUint64(&m.afterStreamListAsCSVCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.PatchTransactionByDTOMock.defaultExpectation != nil && afterPatchTransactionByDTOCounter < 1 {
-		if m.PatchTransactionByDTOMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.PatchTransactionByDTO at\n%s", m.PatchTransactionByDTOMock.defaultExpectation.returnOrigin)
+	if m.StreamListAsCSVMock.defaultExpectation != nil && afterStreamListAsCSVCounter < 1 {
+		if m.StreamListAsCSVMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransactionUsecaseMock.StreamListAsCSV at\n%s", m.StreamListAsCSVMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to TransactionUsecaseMock.PatchTransactionByDTO at\n%s with params: %#v", m.PatchTransactionByDTOMock.defaultExpectation.expectationOrigins.origin, *m.PatchTransactionByDTOMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to TransactionUsecaseMock.StreamListAsCSV at\n%s with params: %#v", m.StreamListAsCSVMock.defaultExpectation.expectationOrigins.origin, *m.StreamListAsCSVMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcPatchTransactionByDTO != nil && afterPatchTransactionByDTOCounter < 1 {
-		m.t.Errorf("Expected call to TransactionUsecaseMock.PatchTransactionByDTO at\n%s", m.funcPatchTransactionByDTOOrigin)
+	if m.funcStreamListAsCSV != nil && afterStreamListAsCSVCounter < 1 {
+		m.t.Errorf("Expected call to TransactionUsecaseMock.StreamListAsCSV at\n%s", m.funcStreamListAsCSVOrigin)
 	}
 
-	if !m.PatchTransactionByDTOMock.invocationsDone() && afterPatchTransactionByDTOCounter > 0 {
-		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.PatchTransactionByDTO at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.PatchTransactionByDTOMock.expectedInvocations), m.PatchTransactionByDTOMock.expectedInvocationsOrigin, afterPatchTransactionByDTOCounter)
+	if !m.StreamListAsCSVMock.invocationsDone() && afterStreamListAsCSVCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransactionUsecaseMock.StreamListAsCSV at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.StreamListAsCSVMock.expectedInvocations), m.StreamListAsCSVMock.expectedInvocationsOrigin, afterStreamListAsCSVCounter)
 	}
 }
 
@@ -3822,8 +8077,20 @@ func (m *TransactionUsecaseMock) MinimockFinish() {
 
 			m.MinimockCreateTransactionByDTOInspect()
 
+			m.MinimockCreateTransactionsBatchInspect()
+
+			m.MinimockCreateTransferByDTOInspect()
+
 			m.MinimockDeleteTransactionByIDInspect()
 
+			m.MinimockDeleteTransferByIDInspect()
+
+			m.MinimockExportReportsInspect()
+
+			m.MinimockExportTransactionsInspect()
+
+			m.MinimockFindCursorListInspect()
+
 			m.MinimockFindListInspect()
 
 			m.MinimockFindListInMapInspect()
@@ -3834,9 +8101,19 @@ func (m *TransactionUsecaseMock) MinimockFinish() {
 
 			m.MinimockFindPagedListAsCSVInspect()
 
+			m.MinimockFindTransferByIDInspect()
+
 			m.MinimockImportTransactionsFromCSVInspect()
 
 			m.MinimockPatchTransactionByDTOInspect()
+
+			m.MinimockPatchTransferByDTOInspect()
+
+			m.MinimockReapplyCategoryRulesInspect()
+
+			m.MinimockResolveCurrencyInspect()
+
+			m.MinimockStreamListAsCSVInspect()
 		}
 	})
 }
@@ -3862,12 +8139,23 @@ func (m *TransactionUsecaseMock) minimockDone() bool {
 	return done &&
 		m.MinimockCountReportItemsDone() &&
 		m.MinimockCreateTransactionByDTODone() &&
+		m.MinimockCreateTransactionsBatchDone() &&
+		m.MinimockCreateTransferByDTODone() &&
 		m.MinimockDeleteTransactionByIDDone() &&
+		m.MinimockDeleteTransferByIDDone() &&
+		m.MinimockExportReportsDone() &&
+		m.MinimockExportTransactionsDone() &&
+		m.MinimockFindCursorListDone() &&
 		m.MinimockFindListDone() &&
 		m.MinimockFindListInMapDone() &&
 		m.MinimockFindOneByIDDone() &&
 		m.MinimockFindPagedListDone() &&
 		m.MinimockFindPagedListAsCSVDone() &&
+		m.MinimockFindTransferByIDDone() &&
 		m.MinimockImportTransactionsFromCSVDone() &&
-		m.MinimockPatchTransactionByDTODone()
+		m.MinimockPatchTransactionByDTODone() &&
+		m.MinimockPatchTransferByDTODone() &&
+		m.MinimockReapplyCategoryRulesDone() &&
+		m.MinimockResolveCurrencyDone() &&
+		m.MinimockStreamListAsCSVDone()
 }
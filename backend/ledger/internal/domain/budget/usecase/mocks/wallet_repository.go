@@ -0,0 +1,1905 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.WalletRepository -o wallet_repository.go -n WalletRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	mm_usecase "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// WalletRepositoryMock implements mm_usecase.WalletRepository
+type WalletRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCountBalances          func(ctx context.Context, queryFilter mm_usecase.CountWalletBalancesQueryFilter) (items []*entity.WalletBalance, err error)
+	funcCountBalancesOrigin    string
+	inspectFuncCountBalances   func(ctx context.Context, queryFilter mm_usecase.CountWalletBalancesQueryFilter)
+	afterCountBalancesCounter  uint64
+	beforeCountBalancesCounter uint64
+	CountBalancesMock          mWalletRepositoryMockCountBalances
+
+	funcCreate          func(ctx context.Context, item *entity.Wallet) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, item *entity.Wallet)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mWalletRepositoryMockCreate
+
+	funcFindList          func(ctx context.Context, listOptions *mm_usecase.WalletListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.Wallet, err error)
+	funcFindListOrigin    string
+	inspectFuncFindList   func(ctx context.Context, listOptions *mm_usecase.WalletListOptions, queryParams *uctypes.QueryGetListParams)
+	afterFindListCounter  uint64
+	beforeFindListCounter uint64
+	FindListMock          mWalletRepositoryMockFindList
+
+	funcFindOneByID          func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (wallet *entity.Wallet, err error)
+	funcFindOneByIDOrigin    string
+	inspectFuncFindOneByID   func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)
+	afterFindOneByIDCounter  uint64
+	beforeFindOneByIDCounter uint64
+	FindOneByIDMock          mWalletRepositoryMockFindOneByID
+
+	funcUpdate          func(ctx context.Context, item *entity.Wallet) (err error)
+	funcUpdateOrigin    string
+	inspectFuncUpdate   func(ctx context.Context, item *entity.Wallet)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mWalletRepositoryMockUpdate
+}
+
+// NewWalletRepositoryMock returns a mock for mm_usecase.WalletRepository
+func NewWalletRepositoryMock(t minimock.Tester) *WalletRepositoryMock {
+	m := &WalletRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CountBalancesMock = mWalletRepositoryMockCountBalances{mock: m}
+	m.CountBalancesMock.callArgs = []*WalletRepositoryMockCountBalancesParams{}
+
+	m.CreateMock = mWalletRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*WalletRepositoryMockCreateParams{}
+
+	m.FindListMock = mWalletRepositoryMockFindList{mock: m}
+	m.FindListMock.callArgs = []*WalletRepositoryMockFindListParams{}
+
+	m.FindOneByIDMock = mWalletRepositoryMockFindOneByID{mock: m}
+	m.FindOneByIDMock.callArgs = []*WalletRepositoryMockFindOneByIDParams{}
+
+	m.UpdateMock = mWalletRepositoryMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*WalletRepositoryMockUpdateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mWalletRepositoryMockCountBalances struct {
+	optional           bool
+	mock               *WalletRepositoryMock
+	defaultExpectation *WalletRepositoryMockCountBalancesExpectation
+	expectations       []*WalletRepositoryMockCountBalancesExpectation
+
+	callArgs []*WalletRepositoryMockCountBalancesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WalletRepositoryMockCountBalancesExpectation specifies expectation struct of the WalletRepository.CountBalances
+type WalletRepositoryMockCountBalancesExpectation struct {
+	mock               *WalletRepositoryMock
+	params             *WalletRepositoryMockCountBalancesParams
+	paramPtrs          *WalletRepositoryMockCountBalancesParamPtrs
+	expectationOrigins WalletRepositoryMockCountBalancesExpectationOrigins
+	results            *WalletRepositoryMockCountBalancesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WalletRepositoryMockCountBalancesParams contains parameters of the WalletRepository.CountBalances
+type WalletRepositoryMockCountBalancesParams struct {
+	ctx         context.Context
+	queryFilter mm_usecase.CountWalletBalancesQueryFilter
+}
+
+// WalletRepositoryMockCountBalancesParamPtrs contains pointers to parameters of the WalletRepository.CountBalances
+type WalletRepositoryMockCountBalancesParamPtrs struct {
+	ctx         *context.Context
+	queryFilter *mm_usecase.CountWalletBalancesQueryFilter
+}
+
+// WalletRepositoryMockCountBalancesResults contains results of the WalletRepository.CountBalances
+type WalletRepositoryMockCountBalancesResults struct {
+	items []*entity.WalletBalance
+	err   error
+}
+
+// WalletRepositoryMockCountBalancesOrigins contains origins of expectations of the WalletRepository.CountBalances
+type WalletRepositoryMockCountBalancesExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originQueryFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountBalances *mWalletRepositoryMockCountBalances) Optional() *mWalletRepositoryMockCountBalances {
+	mmCountBalances.optional = true
+	return mmCountBalances
+}
+
+// Expect sets up expected params for WalletRepository.CountBalances
+func (mmCountBalances *mWalletRepositoryMockCountBalances) Expect(ctx context.Context, queryFilter mm_usecase.CountWalletBalancesQueryFilter) *mWalletRepositoryMockCountBalances {
+	if mmCountBalances.mock.funcCountBalances != nil {
+		mmCountBalances.mock.t.Fatalf("WalletRepositoryMock.CountBalances mock is already set by Set")
+	}
+
+	if mmCountBalances.defaultExpectation == nil {
+		mmCountBalances.defaultExpectation = &WalletRepositoryMockCountBalancesExpectation{}
+	}
+
+	if mmCountBalances.defaultExpectation.paramPtrs != nil {
+		mmCountBalances.mock.t.Fatalf("WalletRepositoryMock.CountBalances mock is already set by ExpectParams functions")
+	}
+
+	mmCountBalances.defaultExpectation.params = &WalletRepositoryMockCountBalancesParams{ctx, queryFilter}
+	mmCountBalances.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountBalances.expectations {
+		if minimock.Equal(e.params, mmCountBalances.defaultExpectation.params) {
+			mmCountBalances.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountBalances.defaultExpectation.params)
+		}
+	}
+
+	return mmCountBalances
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WalletRepository.CountBalances
+func (mmCountBalances *mWalletRepositoryMockCountBalances) ExpectCtxParam1(ctx context.Context) *mWalletRepositoryMockCountBalances {
+	if mmCountBalances.mock.funcCountBalances != nil {
+		mmCountBalances.mock.t.Fatalf("WalletRepositoryMock.CountBalances mock is already set by Set")
+	}
+
+	if mmCountBalances.defaultExpectation == nil {
+		mmCountBalances.defaultExpectation = &WalletRepositoryMockCountBalancesExpectation{}
+	}
+
+	if mmCountBalances.defaultExpectation.params != nil {
+		mmCountBalances.mock.t.Fatalf("WalletRepositoryMock.CountBalances mock is already set by Expect")
+	}
+
+	if mmCountBalances.defaultExpectation.paramPtrs == nil {
+		mmCountBalances.defaultExpectation.paramPtrs = &WalletRepositoryMockCountBalancesParamPtrs{}
+	}
+	mmCountBalances.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountBalances.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountBalances
+}
+
+// ExpectQueryFilterParam2 sets up expected param queryFilter for WalletRepository.CountBalances
+func (mmCountBalances *mWalletRepositoryMockCountBalances) ExpectQueryFilterParam2(queryFilter mm_usecase.CountWalletBalancesQueryFilter) *mWalletRepositoryMockCountBalances {
+	if mmCountBalances.mock.funcCountBalances != nil {
+		mmCountBalances.mock.t.Fatalf("WalletRepositoryMock.CountBalances mock is already set by Set")
+	}
+
+	if mmCountBalances.defaultExpectation == nil {
+		mmCountBalances.defaultExpectation = &WalletRepositoryMockCountBalancesExpectation{}
+	}
+
+	if mmCountBalances.defaultExpectation.params != nil {
+		mmCountBalances.mock.t.Fatalf("WalletRepositoryMock.CountBalances mock is already set by Expect")
+	}
+
+	if mmCountBalances.defaultExpectation.paramPtrs == nil {
+		mmCountBalances.defaultExpectation.paramPtrs = &WalletRepositoryMockCountBalancesParamPtrs{}
+	}
+	mmCountBalances.defaultExpectation.paramPtrs.queryFilter = &queryFilter
+	mmCountBalances.defaultExpectation.expectationOrigins.originQueryFilter = minimock.CallerInfo(1)
+
+	return mmCountBalances
+}
+
+// Inspect accepts an inspector function that has same arguments as the WalletRepository.CountBalances
+func (mmCountBalances *mWalletRepositoryMockCountBalances) Inspect(f func(ctx context.Context, queryFilter mm_usecase.CountWalletBalancesQueryFilter)) *mWalletRepositoryMockCountBalances {
+	if mmCountBalances.mock.inspectFuncCountBalances != nil {
+		mmCountBalances.mock.t.Fatalf("Inspect function is already set for WalletRepositoryMock.CountBalances")
+	}
+
+	mmCountBalances.mock.inspectFuncCountBalances = f
+
+	return mmCountBalances
+}
+
+// Return sets up results that will be returned by WalletRepository.CountBalances
+func (mmCountBalances *mWalletRepositoryMockCountBalances) Return(items []*entity.WalletBalance, err error) *WalletRepositoryMock {
+	if mmCountBalances.mock.funcCountBalances != nil {
+		mmCountBalances.mock.t.Fatalf("WalletRepositoryMock.CountBalances mock is already set by Set")
+	}
+
+	if mmCountBalances.defaultExpectation == nil {
+		mmCountBalances.defaultExpectation = &WalletRepositoryMockCountBalancesExpectation{mock: mmCountBalances.mock}
+	}
+	mmCountBalances.defaultExpectation.results = &WalletRepositoryMockCountBalancesResults{items, err}
+	mmCountBalances.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountBalances.mock
+}
+
+// Set uses given function f to mock the WalletRepository.CountBalances method
+func (mmCountBalances *mWalletRepositoryMockCountBalances) Set(f func(ctx context.Context, queryFilter mm_usecase.CountWalletBalancesQueryFilter) (items []*entity.WalletBalance, err error)) *WalletRepositoryMock {
+	if mmCountBalances.defaultExpectation != nil {
+		mmCountBalances.mock.t.Fatalf("Default expectation is already set for the WalletRepository.CountBalances method")
+	}
+
+	if len(mmCountBalances.expectations) > 0 {
+		mmCountBalances.mock.t.Fatalf("Some expectations are already set for the WalletRepository.CountBalances method")
+	}
+
+	mmCountBalances.mock.funcCountBalances = f
+	mmCountBalances.mock.funcCountBalancesOrigin = minimock.CallerInfo(1)
+	return mmCountBalances.mock
+}
+
+// When sets expectation for the WalletRepository.CountBalances which will trigger the result defined by the following
+// Then helper
+func (mmCountBalances *mWalletRepositoryMockCountBalances) When(ctx context.Context, queryFilter mm_usecase.CountWalletBalancesQueryFilter) *WalletRepositoryMockCountBalancesExpectation {
+	if mmCountBalances.mock.funcCountBalances != nil {
+		mmCountBalances.mock.t.Fatalf("WalletRepositoryMock.CountBalances mock is already set by Set")
+	}
+
+	expectation := &WalletRepositoryMockCountBalancesExpectation{
+		mock:               mmCountBalances.mock,
+		params:             &WalletRepositoryMockCountBalancesParams{ctx, queryFilter},
+		expectationOrigins: WalletRepositoryMockCountBalancesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountBalances.expectations = append(mmCountBalances.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WalletRepository.CountBalances return parameters for the expectation previously defined by the When method
+func (e *WalletRepositoryMockCountBalancesExpectation) Then(items []*entity.WalletBalance, err error) *WalletRepositoryMock {
+	e.results = &WalletRepositoryMockCountBalancesResults{items, err}
+	return e.mock
+}
+
+// Times sets number of times WalletRepository.CountBalances should be invoked
+func (mmCountBalances *mWalletRepositoryMockCountBalances) Times(n uint64) *mWalletRepositoryMockCountBalances {
+	if n == 0 {
+		mmCountBalances.mock.t.Fatalf("Times of WalletRepositoryMock.CountBalances mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountBalances.expectedInvocations, n)
+	mmCountBalances.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountBalances
+}
+
+func (mmCountBalances *mWalletRepositoryMockCountBalances) invocationsDone() bool {
+	if len(mmCountBalances.expectations) == 0 && mmCountBalances.defaultExpectation == nil && mmCountBalances.mock.funcCountBalances == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountBalances.mock.afterCountBalancesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountBalances.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountBalances implements mm_usecase.WalletRepository
+func (mmCountBalances *WalletRepositoryMock) CountBalances(ctx context.Context, queryFilter mm_usecase.CountWalletBalancesQueryFilter) (items []*entity.WalletBalance, err error) {
+	mm_atomic.AddUint64(&mmCountBalances.beforeCountBalancesCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountBalances.afterCountBalancesCounter, 1)
+
+	mmCountBalances.t.Helper()
+
+	if mmCountBalances.inspectFuncCountBalances != nil {
+		mmCountBalances.inspectFuncCountBalances(ctx, queryFilter)
+	}
+
+	mm_params := WalletRepositoryMockCountBalancesParams{ctx, queryFilter}
+
+	// Record call args
+	mmCountBalances.CountBalancesMock.mutex.Lock()
+	mmCountBalances.CountBalancesMock.callArgs = append(mmCountBalances.CountBalancesMock.callArgs, &mm_params)
+	mmCountBalances.CountBalancesMock.mutex.Unlock()
+
+	for _, e := range mmCountBalances.CountBalancesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.items, e.results.err
+		}
+	}
+
+	if mmCountBalances.CountBalancesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountBalances.CountBalancesMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountBalances.CountBalancesMock.defaultExpectation.params
+		mm_want_ptrs := mmCountBalances.CountBalancesMock.defaultExpectation.paramPtrs
+
+		mm_got := WalletRepositoryMockCountBalancesParams{ctx, queryFilter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountBalances.t.Errorf("WalletRepositoryMock.CountBalances got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountBalances.CountBalancesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.queryFilter != nil && !minimock.Equal(*mm_want_ptrs.queryFilter, mm_got.queryFilter) {
+				mmCountBalances.t.Errorf("WalletRepositoryMock.CountBalances got unexpected parameter queryFilter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountBalances.CountBalancesMock.defaultExpectation.expectationOrigins.originQueryFilter, *mm_want_ptrs.queryFilter, mm_got.queryFilter, minimock.Diff(*mm_want_ptrs.queryFilter, mm_got.queryFilter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountBalances.t.Errorf("WalletRepositoryMock.CountBalances got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountBalances.CountBalancesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountBalances.CountBalancesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountBalances.t.Fatal("No results are set for the WalletRepositoryMock.CountBalances")
+		}
+		return (*mm_results).items, (*mm_results).err
+	}
+	if mmCountBalances.funcCountBalances != nil {
+		return mmCountBalances.funcCountBalances(ctx, queryFilter)
+	}
+	mmCountBalances.t.Fatalf("Unexpected call to WalletRepositoryMock.CountBalances. %v %v", ctx, queryFilter)
+	return
+}
+
+// CountBalancesAfterCounter returns a count of finished WalletRepositoryMock.CountBalances invocations
+func (mmCountBalances *WalletRepositoryMock) CountBalancesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountBalances.afterCountBalancesCounter)
+}
+
+// CountBalancesBeforeCounter returns a count of WalletRepositoryMock.CountBalances invocations
+func (mmCountBalances *WalletRepositoryMock) CountBalancesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountBalances.beforeCountBalancesCounter)
+}
+
+// Calls returns a list of arguments used in each call to WalletRepositoryMock.CountBalances.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountBalances *mWalletRepositoryMockCountBalances) Calls() []*WalletRepositoryMockCountBalancesParams {
+	mmCountBalances.mutex.RLock()
+
+	argCopy := make([]*WalletRepositoryMockCountBalancesParams, len(mmCountBalances.callArgs))
+	copy(argCopy, mmCountBalances.callArgs)
+
+	mmCountBalances.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountBalancesDone returns true if the count of the CountBalances invocations corresponds
+// the number of defined expectations
+func (m *WalletRepositoryMock) MinimockCountBalancesDone() bool {
+	if m.CountBalancesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountBalancesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountBalancesMock.invocationsDone()
+}
+
+// MinimockCountBalancesInspect logs each unmet expectation
+func (m *WalletRepositoryMock) MinimockCountBalancesInspect() {
+	for _, e := range m.CountBalancesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WalletRepositoryMock.CountBalances at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountBalancesCounter := mm_atomic.LoadUint64(&m.afterCountBalancesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountBalancesMock.defaultExpectation != nil && afterCountBalancesCounter < 1 {
+		if m.CountBalancesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WalletRepositoryMock.CountBalances at\n%s", m.CountBalancesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WalletRepositoryMock.CountBalances at\n%s with params: %#v", m.CountBalancesMock.defaultExpectation.expectationOrigins.origin, *m.CountBalancesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountBalances != nil && afterCountBalancesCounter < 1 {
+		m.t.Errorf("Expected call to WalletRepositoryMock.CountBalances at\n%s", m.funcCountBalancesOrigin)
+	}
+
+	if !m.CountBalancesMock.invocationsDone() && afterCountBalancesCounter > 0 {
+		m.t.Errorf("Expected %d calls to WalletRepositoryMock.CountBalances at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountBalancesMock.expectedInvocations), m.CountBalancesMock.expectedInvocationsOrigin, afterCountBalancesCounter)
+	}
+}
+
+type mWalletRepositoryMockCreate struct {
+	optional           bool
+	mock               *WalletRepositoryMock
+	defaultExpectation *WalletRepositoryMockCreateExpectation
+	expectations       []*WalletRepositoryMockCreateExpectation
+
+	callArgs []*WalletRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WalletRepositoryMockCreateExpectation specifies expectation struct of the WalletRepository.Create
+type WalletRepositoryMockCreateExpectation struct {
+	mock               *WalletRepositoryMock
+	params             *WalletRepositoryMockCreateParams
+	paramPtrs          *WalletRepositoryMockCreateParamPtrs
+	expectationOrigins WalletRepositoryMockCreateExpectationOrigins
+	results            *WalletRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WalletRepositoryMockCreateParams contains parameters of the WalletRepository.Create
+type WalletRepositoryMockCreateParams struct {
+	ctx  context.Context
+	item *entity.Wallet
+}
+
+// WalletRepositoryMockCreateParamPtrs contains pointers to parameters of the WalletRepository.Create
+type WalletRepositoryMockCreateParamPtrs struct {
+	ctx  *context.Context
+	item **entity.Wallet
+}
+
+// WalletRepositoryMockCreateResults contains results of the WalletRepository.Create
+type WalletRepositoryMockCreateResults struct {
+	err error
+}
+
+// WalletRepositoryMockCreateOrigins contains origins of expectations of the WalletRepository.Create
+type WalletRepositoryMockCreateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originItem string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mWalletRepositoryMockCreate) Optional() *mWalletRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for WalletRepository.Create
+func (mmCreate *mWalletRepositoryMockCreate) Expect(ctx context.Context, item *entity.Wallet) *mWalletRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("WalletRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &WalletRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("WalletRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &WalletRepositoryMockCreateParams{ctx, item}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WalletRepository.Create
+func (mmCreate *mWalletRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mWalletRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("WalletRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &WalletRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("WalletRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &WalletRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectItemParam2 sets up expected param item for WalletRepository.Create
+func (mmCreate *mWalletRepositoryMockCreate) ExpectItemParam2(item *entity.Wallet) *mWalletRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("WalletRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &WalletRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("WalletRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &WalletRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.item = &item
+	mmCreate.defaultExpectation.expectationOrigins.originItem = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the WalletRepository.Create
+func (mmCreate *mWalletRepositoryMockCreate) Inspect(f func(ctx context.Context, item *entity.Wallet)) *mWalletRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for WalletRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by WalletRepository.Create
+func (mmCreate *mWalletRepositoryMockCreate) Return(err error) *WalletRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("WalletRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &WalletRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &WalletRepositoryMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the WalletRepository.Create method
+func (mmCreate *mWalletRepositoryMockCreate) Set(f func(ctx context.Context, item *entity.Wallet) (err error)) *WalletRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the WalletRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the WalletRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the WalletRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mWalletRepositoryMockCreate) When(ctx context.Context, item *entity.Wallet) *WalletRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("WalletRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &WalletRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &WalletRepositoryMockCreateParams{ctx, item},
+		expectationOrigins: WalletRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WalletRepository.Create return parameters for the expectation previously defined by the When method
+func (e *WalletRepositoryMockCreateExpectation) Then(err error) *WalletRepositoryMock {
+	e.results = &WalletRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times WalletRepository.Create should be invoked
+func (mmCreate *mWalletRepositoryMockCreate) Times(n uint64) *mWalletRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of WalletRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mWalletRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_usecase.WalletRepository
+func (mmCreate *WalletRepositoryMock) Create(ctx context.Context, item *entity.Wallet) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, item)
+	}
+
+	mm_params := WalletRepositoryMockCreateParams{ctx, item}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := WalletRepositoryMockCreateParams{ctx, item}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("WalletRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
+				mmCreate.t.Errorf("WalletRepositoryMock.Create got unexpected parameter item, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originItem, *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("WalletRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the WalletRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, item)
+	}
+	mmCreate.t.Fatalf("Unexpected call to WalletRepositoryMock.Create. %v %v", ctx, item)
+	return
+}
+
+// CreateAfterCounter returns a count of finished WalletRepositoryMock.Create invocations
+func (mmCreate *WalletRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of WalletRepositoryMock.Create invocations
+func (mmCreate *WalletRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to WalletRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mWalletRepositoryMockCreate) Calls() []*WalletRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*WalletRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *WalletRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *WalletRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WalletRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WalletRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WalletRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to WalletRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to WalletRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mWalletRepositoryMockFindList struct {
+	optional           bool
+	mock               *WalletRepositoryMock
+	defaultExpectation *WalletRepositoryMockFindListExpectation
+	expectations       []*WalletRepositoryMockFindListExpectation
+
+	callArgs []*WalletRepositoryMockFindListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WalletRepositoryMockFindListExpectation specifies expectation struct of the WalletRepository.FindList
+type WalletRepositoryMockFindListExpectation struct {
+	mock               *WalletRepositoryMock
+	params             *WalletRepositoryMockFindListParams
+	paramPtrs          *WalletRepositoryMockFindListParamPtrs
+	expectationOrigins WalletRepositoryMockFindListExpectationOrigins
+	results            *WalletRepositoryMockFindListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WalletRepositoryMockFindListParams contains parameters of the WalletRepository.FindList
+type WalletRepositoryMockFindListParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.WalletListOptions
+	queryParams *uctypes.QueryGetListParams
+}
+
+// WalletRepositoryMockFindListParamPtrs contains pointers to parameters of the WalletRepository.FindList
+type WalletRepositoryMockFindListParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.WalletListOptions
+	queryParams **uctypes.QueryGetListParams
+}
+
+// WalletRepositoryMockFindListResults contains results of the WalletRepository.FindList
+type WalletRepositoryMockFindListResults struct {
+	items []*entity.Wallet
+	err   error
+}
+
+// WalletRepositoryMockFindListOrigins contains origins of expectations of the WalletRepository.FindList
+type WalletRepositoryMockFindListExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindList *mWalletRepositoryMockFindList) Optional() *mWalletRepositoryMockFindList {
+	mmFindList.optional = true
+	return mmFindList
+}
+
+// Expect sets up expected params for WalletRepository.FindList
+func (mmFindList *mWalletRepositoryMockFindList) Expect(ctx context.Context, listOptions *mm_usecase.WalletListOptions, queryParams *uctypes.QueryGetListParams) *mWalletRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("WalletRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &WalletRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs != nil {
+		mmFindList.mock.t.Fatalf("WalletRepositoryMock.FindList mock is already set by ExpectParams functions")
+	}
+
+	mmFindList.defaultExpectation.params = &WalletRepositoryMockFindListParams{ctx, listOptions, queryParams}
+	mmFindList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindList.expectations {
+		if minimock.Equal(e.params, mmFindList.defaultExpectation.params) {
+			mmFindList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindList.defaultExpectation.params)
+		}
+	}
+
+	return mmFindList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WalletRepository.FindList
+func (mmFindList *mWalletRepositoryMockFindList) ExpectCtxParam1(ctx context.Context) *mWalletRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("WalletRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &WalletRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("WalletRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &WalletRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for WalletRepository.FindList
+func (mmFindList *mWalletRepositoryMockFindList) ExpectListOptionsParam2(listOptions *mm_usecase.WalletListOptions) *mWalletRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("WalletRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &WalletRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("WalletRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &WalletRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmFindList.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for WalletRepository.FindList
+func (mmFindList *mWalletRepositoryMockFindList) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mWalletRepositoryMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("WalletRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &WalletRepositoryMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("WalletRepositoryMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &WalletRepositoryMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindList.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// Inspect accepts an inspector function that has same arguments as the WalletRepository.FindList
+func (mmFindList *mWalletRepositoryMockFindList) Inspect(f func(ctx context.Context, listOptions *mm_usecase.WalletListOptions, queryParams *uctypes.QueryGetListParams)) *mWalletRepositoryMockFindList {
+	if mmFindList.mock.inspectFuncFindList != nil {
+		mmFindList.mock.t.Fatalf("Inspect function is already set for WalletRepositoryMock.FindList")
+	}
+
+	mmFindList.mock.inspectFuncFindList = f
+
+	return mmFindList
+}
+
+// Return sets up results that will be returned by WalletRepository.FindList
+func (mmFindList *mWalletRepositoryMockFindList) Return(items []*entity.Wallet, err error) *WalletRepositoryMock {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("WalletRepositoryMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &WalletRepositoryMockFindListExpectation{mock: mmFindList.mock}
+	}
+	mmFindList.defaultExpectation.results = &WalletRepositoryMockFindListResults{items, err}
+	mmFindList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// Set uses given function f to mock the WalletRepository.FindList method
+func (mmFindList *mWalletRepositoryMockFindList) Set(f func(ctx context.Context, listOptions *mm_usecase.WalletListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.Wallet, err error)) *WalletRepositoryMock {
+	if mmFindList.defaultExpectation != nil {
+		mmFindList.mock.t.Fatalf("Default expectation is already set for the WalletRepository.FindList method")
+	}
+
+	if len(mmFindList.expectations) > 0 {
+		mmFindList.mock.t.Fatalf("Some expectations are already set for the WalletRepository.FindList method")
+	}
+
+	mmFindList.mock.funcFindList = f
+	mmFindList.mock.funcFindListOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// When sets expectation for the WalletRepository.FindList which will trigger the result defined by the following
+// Then helper
+func (mmFindList *mWalletRepositoryMockFindList) When(ctx context.Context, listOptions *mm_usecase.WalletListOptions, queryParams *uctypes.QueryGetListParams) *WalletRepositoryMockFindListExpectation {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("WalletRepositoryMock.FindList mock is already set by Set")
+	}
+
+	expectation := &WalletRepositoryMockFindListExpectation{
+		mock:               mmFindList.mock,
+		params:             &WalletRepositoryMockFindListParams{ctx, listOptions, queryParams},
+		expectationOrigins: WalletRepositoryMockFindListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindList.expectations = append(mmFindList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WalletRepository.FindList return parameters for the expectation previously defined by the When method
+func (e *WalletRepositoryMockFindListExpectation) Then(items []*entity.Wallet, err error) *WalletRepositoryMock {
+	e.results = &WalletRepositoryMockFindListResults{items, err}
+	return e.mock
+}
+
+// Times sets number of times WalletRepository.FindList should be invoked
+func (mmFindList *mWalletRepositoryMockFindList) Times(n uint64) *mWalletRepositoryMockFindList {
+	if n == 0 {
+		mmFindList.mock.t.Fatalf("Times of WalletRepositoryMock.FindList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindList.expectedInvocations, n)
+	mmFindList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindList
+}
+
+func (mmFindList *mWalletRepositoryMockFindList) invocationsDone() bool {
+	if len(mmFindList.expectations) == 0 && mmFindList.defaultExpectation == nil && mmFindList.mock.funcFindList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindList.mock.afterFindListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindList implements mm_usecase.WalletRepository
+func (mmFindList *WalletRepositoryMock) FindList(ctx context.Context, listOptions *mm_usecase.WalletListOptions, queryParams *uctypes.QueryGetListParams) (items []*entity.Wallet, err error) {
+	mm_atomic.AddUint64(&mmFindList.beforeFindListCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindList.afterFindListCounter, 1)
+
+	mmFindList.t.Helper()
+
+	if mmFindList.inspectFuncFindList != nil {
+		mmFindList.inspectFuncFindList(ctx, listOptions, queryParams)
+	}
+
+	mm_params := WalletRepositoryMockFindListParams{ctx, listOptions, queryParams}
+
+	// Record call args
+	mmFindList.FindListMock.mutex.Lock()
+	mmFindList.FindListMock.callArgs = append(mmFindList.FindListMock.callArgs, &mm_params)
+	mmFindList.FindListMock.mutex.Unlock()
+
+	for _, e := range mmFindList.FindListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.items, e.results.err
+		}
+	}
+
+	if mmFindList.FindListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindList.FindListMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindList.FindListMock.defaultExpectation.params
+		mm_want_ptrs := mmFindList.FindListMock.defaultExpectation.paramPtrs
+
+		mm_got := WalletRepositoryMockFindListParams{ctx, listOptions, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindList.t.Errorf("WalletRepositoryMock.FindList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmFindList.t.Errorf("WalletRepositoryMock.FindList got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindList.t.Errorf("WalletRepositoryMock.FindList got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindList.t.Errorf("WalletRepositoryMock.FindList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindList.FindListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindList.FindListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindList.t.Fatal("No results are set for the WalletRepositoryMock.FindList")
+		}
+		return (*mm_results).items, (*mm_results).err
+	}
+	if mmFindList.funcFindList != nil {
+		return mmFindList.funcFindList(ctx, listOptions, queryParams)
+	}
+	mmFindList.t.Fatalf("Unexpected call to WalletRepositoryMock.FindList. %v %v %v", ctx, listOptions, queryParams)
+	return
+}
+
+// FindListAfterCounter returns a count of finished WalletRepositoryMock.FindList invocations
+func (mmFindList *WalletRepositoryMock) FindListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.afterFindListCounter)
+}
+
+// FindListBeforeCounter returns a count of WalletRepositoryMock.FindList invocations
+func (mmFindList *WalletRepositoryMock) FindListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.beforeFindListCounter)
+}
+
+// Calls returns a list of arguments used in each call to WalletRepositoryMock.FindList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindList *mWalletRepositoryMockFindList) Calls() []*WalletRepositoryMockFindListParams {
+	mmFindList.mutex.RLock()
+
+	argCopy := make([]*WalletRepositoryMockFindListParams, len(mmFindList.callArgs))
+	copy(argCopy, mmFindList.callArgs)
+
+	mmFindList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindListDone returns true if the count of the FindList invocations corresponds
+// the number of defined expectations
+func (m *WalletRepositoryMock) MinimockFindListDone() bool {
+	if m.FindListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindListMock.invocationsDone()
+}
+
+// MinimockFindListInspect logs each unmet expectation
+func (m *WalletRepositoryMock) MinimockFindListInspect() {
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WalletRepositoryMock.FindList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindListCounter := mm_atomic.LoadUint64(&m.afterFindListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindListMock.defaultExpectation != nil && afterFindListCounter < 1 {
+		if m.FindListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WalletRepositoryMock.FindList at\n%s", m.FindListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WalletRepositoryMock.FindList at\n%s with params: %#v", m.FindListMock.defaultExpectation.expectationOrigins.origin, *m.FindListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindList != nil && afterFindListCounter < 1 {
+		m.t.Errorf("Expected call to WalletRepositoryMock.FindList at\n%s", m.funcFindListOrigin)
+	}
+
+	if !m.FindListMock.invocationsDone() && afterFindListCounter > 0 {
+		m.t.Errorf("Expected %d calls to WalletRepositoryMock.FindList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindListMock.expectedInvocations), m.FindListMock.expectedInvocationsOrigin, afterFindListCounter)
+	}
+}
+
+type mWalletRepositoryMockFindOneByID struct {
+	optional           bool
+	mock               *WalletRepositoryMock
+	defaultExpectation *WalletRepositoryMockFindOneByIDExpectation
+	expectations       []*WalletRepositoryMockFindOneByIDExpectation
+
+	callArgs []*WalletRepositoryMockFindOneByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WalletRepositoryMockFindOneByIDExpectation specifies expectation struct of the WalletRepository.FindOneByID
+type WalletRepositoryMockFindOneByIDExpectation struct {
+	mock               *WalletRepositoryMock
+	params             *WalletRepositoryMockFindOneByIDParams
+	paramPtrs          *WalletRepositoryMockFindOneByIDParamPtrs
+	expectationOrigins WalletRepositoryMockFindOneByIDExpectationOrigins
+	results            *WalletRepositoryMockFindOneByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WalletRepositoryMockFindOneByIDParams contains parameters of the WalletRepository.FindOneByID
+type WalletRepositoryMockFindOneByIDParams struct {
+	ctx         context.Context
+	id          uuid.UUID
+	queryParams *uctypes.QueryGetOneParams
+}
+
+// WalletRepositoryMockFindOneByIDParamPtrs contains pointers to parameters of the WalletRepository.FindOneByID
+type WalletRepositoryMockFindOneByIDParamPtrs struct {
+	ctx         *context.Context
+	id          *uuid.UUID
+	queryParams **uctypes.QueryGetOneParams
+}
+
+// WalletRepositoryMockFindOneByIDResults contains results of the WalletRepository.FindOneByID
+type WalletRepositoryMockFindOneByIDResults struct {
+	wallet *entity.Wallet
+	err    error
+}
+
+// WalletRepositoryMockFindOneByIDOrigins contains origins of expectations of the WalletRepository.FindOneByID
+type WalletRepositoryMockFindOneByIDExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originId          string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindOneByID *mWalletRepositoryMockFindOneByID) Optional() *mWalletRepositoryMockFindOneByID {
+	mmFindOneByID.optional = true
+	return mmFindOneByID
+}
+
+// Expect sets up expected params for WalletRepository.FindOneByID
+func (mmFindOneByID *mWalletRepositoryMockFindOneByID) Expect(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *mWalletRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &WalletRepositoryMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletRepositoryMock.FindOneByID mock is already set by ExpectParams functions")
+	}
+
+	mmFindOneByID.defaultExpectation.params = &WalletRepositoryMockFindOneByIDParams{ctx, id, queryParams}
+	mmFindOneByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindOneByID.expectations {
+		if minimock.Equal(e.params, mmFindOneByID.defaultExpectation.params) {
+			mmFindOneByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindOneByID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindOneByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WalletRepository.FindOneByID
+func (mmFindOneByID *mWalletRepositoryMockFindOneByID) ExpectCtxParam1(ctx context.Context) *mWalletRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &WalletRepositoryMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletRepositoryMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &WalletRepositoryMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindOneByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// ExpectIdParam2 sets up expected param id for WalletRepository.FindOneByID
+func (mmFindOneByID *mWalletRepositoryMockFindOneByID) ExpectIdParam2(id uuid.UUID) *mWalletRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &WalletRepositoryMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletRepositoryMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &WalletRepositoryMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.id = &id
+	mmFindOneByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for WalletRepository.FindOneByID
+func (mmFindOneByID *mWalletRepositoryMockFindOneByID) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetOneParams) *mWalletRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &WalletRepositoryMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletRepositoryMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &WalletRepositoryMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindOneByID.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the WalletRepository.FindOneByID
+func (mmFindOneByID *mWalletRepositoryMockFindOneByID) Inspect(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)) *mWalletRepositoryMockFindOneByID {
+	if mmFindOneByID.mock.inspectFuncFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("Inspect function is already set for WalletRepositoryMock.FindOneByID")
+	}
+
+	mmFindOneByID.mock.inspectFuncFindOneByID = f
+
+	return mmFindOneByID
+}
+
+// Return sets up results that will be returned by WalletRepository.FindOneByID
+func (mmFindOneByID *mWalletRepositoryMockFindOneByID) Return(wallet *entity.Wallet, err error) *WalletRepositoryMock {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &WalletRepositoryMockFindOneByIDExpectation{mock: mmFindOneByID.mock}
+	}
+	mmFindOneByID.defaultExpectation.results = &WalletRepositoryMockFindOneByIDResults{wallet, err}
+	mmFindOneByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID.mock
+}
+
+// Set uses given function f to mock the WalletRepository.FindOneByID method
+func (mmFindOneByID *mWalletRepositoryMockFindOneByID) Set(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (wallet *entity.Wallet, err error)) *WalletRepositoryMock {
+	if mmFindOneByID.defaultExpectation != nil {
+		mmFindOneByID.mock.t.Fatalf("Default expectation is already set for the WalletRepository.FindOneByID method")
+	}
+
+	if len(mmFindOneByID.expectations) > 0 {
+		mmFindOneByID.mock.t.Fatalf("Some expectations are already set for the WalletRepository.FindOneByID method")
+	}
+
+	mmFindOneByID.mock.funcFindOneByID = f
+	mmFindOneByID.mock.funcFindOneByIDOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID.mock
+}
+
+// When sets expectation for the WalletRepository.FindOneByID which will trigger the result defined by the following
+// Then helper
+func (mmFindOneByID *mWalletRepositoryMockFindOneByID) When(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *WalletRepositoryMockFindOneByIDExpectation {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletRepositoryMock.FindOneByID mock is already set by Set")
+	}
+
+	expectation := &WalletRepositoryMockFindOneByIDExpectation{
+		mock:               mmFindOneByID.mock,
+		params:             &WalletRepositoryMockFindOneByIDParams{ctx, id, queryParams},
+		expectationOrigins: WalletRepositoryMockFindOneByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindOneByID.expectations = append(mmFindOneByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WalletRepository.FindOneByID return parameters for the expectation previously defined by the When method
+func (e *WalletRepositoryMockFindOneByIDExpectation) Then(wallet *entity.Wallet, err error) *WalletRepositoryMock {
+	e.results = &WalletRepositoryMockFindOneByIDResults{wallet, err}
+	return e.mock
+}
+
+// Times sets number of times WalletRepository.FindOneByID should be invoked
+func (mmFindOneByID *mWalletRepositoryMockFindOneByID) Times(n uint64) *mWalletRepositoryMockFindOneByID {
+	if n == 0 {
+		mmFindOneByID.mock.t.Fatalf("Times of WalletRepositoryMock.FindOneByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindOneByID.expectedInvocations, n)
+	mmFindOneByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID
+}
+
+func (mmFindOneByID *mWalletRepositoryMockFindOneByID) invocationsDone() bool {
+	if len(mmFindOneByID.expectations) == 0 && mmFindOneByID.defaultExpectation == nil && mmFindOneByID.mock.funcFindOneByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindOneByID.mock.afterFindOneByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindOneByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindOneByID implements mm_usecase.WalletRepository
+func (mmFindOneByID *WalletRepositoryMock) FindOneByID(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (wallet *entity.Wallet, err error) {
+	mm_atomic.AddUint64(&mmFindOneByID.beforeFindOneByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindOneByID.afterFindOneByIDCounter, 1)
+
+	mmFindOneByID.t.Helper()
+
+	if mmFindOneByID.inspectFuncFindOneByID != nil {
+		mmFindOneByID.inspectFuncFindOneByID(ctx, id, queryParams)
+	}
+
+	mm_params := WalletRepositoryMockFindOneByIDParams{ctx, id, queryParams}
+
+	// Record call args
+	mmFindOneByID.FindOneByIDMock.mutex.Lock()
+	mmFindOneByID.FindOneByIDMock.callArgs = append(mmFindOneByID.FindOneByIDMock.callArgs, &mm_params)
+	mmFindOneByID.FindOneByIDMock.mutex.Unlock()
+
+	for _, e := range mmFindOneByID.FindOneByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.wallet, e.results.err
+		}
+	}
+
+	if mmFindOneByID.FindOneByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindOneByID.FindOneByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindOneByID.FindOneByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindOneByID.FindOneByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := WalletRepositoryMockFindOneByIDParams{ctx, id, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindOneByID.t.Errorf("WalletRepositoryMock.FindOneByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmFindOneByID.t.Errorf("WalletRepositoryMock.FindOneByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindOneByID.t.Errorf("WalletRepositoryMock.FindOneByID got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindOneByID.t.Errorf("WalletRepositoryMock.FindOneByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindOneByID.FindOneByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindOneByID.t.Fatal("No results are set for the WalletRepositoryMock.FindOneByID")
+		}
+		return (*mm_results).wallet, (*mm_results).err
+	}
+	if mmFindOneByID.funcFindOneByID != nil {
+		return mmFindOneByID.funcFindOneByID(ctx, id, queryParams)
+	}
+	mmFindOneByID.t.Fatalf("Unexpected call to WalletRepositoryMock.FindOneByID. %v %v %v", ctx, id, queryParams)
+	return
+}
+
+// FindOneByIDAfterCounter returns a count of finished WalletRepositoryMock.FindOneByID invocations
+func (mmFindOneByID *WalletRepositoryMock) FindOneByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByID.afterFindOneByIDCounter)
+}
+
+// FindOneByIDBeforeCounter returns a count of WalletRepositoryMock.FindOneByID invocations
+func (mmFindOneByID *WalletRepositoryMock) FindOneByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByID.beforeFindOneByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to WalletRepositoryMock.FindOneByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindOneByID *mWalletRepositoryMockFindOneByID) Calls() []*WalletRepositoryMockFindOneByIDParams {
+	mmFindOneByID.mutex.RLock()
+
+	argCopy := make([]*WalletRepositoryMockFindOneByIDParams, len(mmFindOneByID.callArgs))
+	copy(argCopy, mmFindOneByID.callArgs)
+
+	mmFindOneByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindOneByIDDone returns true if the count of the FindOneByID invocations corresponds
+// the number of defined expectations
+func (m *WalletRepositoryMock) MinimockFindOneByIDDone() bool {
+	if m.FindOneByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindOneByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindOneByIDMock.invocationsDone()
+}
+
+// MinimockFindOneByIDInspect logs each unmet expectation
+func (m *WalletRepositoryMock) MinimockFindOneByIDInspect() {
+	for _, e := range m.FindOneByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WalletRepositoryMock.FindOneByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindOneByIDCounter := mm_atomic.LoadUint64(&m.afterFindOneByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindOneByIDMock.defaultExpectation != nil && afterFindOneByIDCounter < 1 {
+		if m.FindOneByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WalletRepositoryMock.FindOneByID at\n%s", m.FindOneByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WalletRepositoryMock.FindOneByID at\n%s with params: %#v", m.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindOneByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindOneByID != nil && afterFindOneByIDCounter < 1 {
+		m.t.Errorf("Expected call to WalletRepositoryMock.FindOneByID at\n%s", m.funcFindOneByIDOrigin)
+	}
+
+	if !m.FindOneByIDMock.invocationsDone() && afterFindOneByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to WalletRepositoryMock.FindOneByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindOneByIDMock.expectedInvocations), m.FindOneByIDMock.expectedInvocationsOrigin, afterFindOneByIDCounter)
+	}
+}
+
+type mWalletRepositoryMockUpdate struct {
+	optional           bool
+	mock               *WalletRepositoryMock
+	defaultExpectation *WalletRepositoryMockUpdateExpectation
+	expectations       []*WalletRepositoryMockUpdateExpectation
+
+	callArgs []*WalletRepositoryMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WalletRepositoryMockUpdateExpectation specifies expectation struct of the WalletRepository.Update
+type WalletRepositoryMockUpdateExpectation struct {
+	mock               *WalletRepositoryMock
+	params             *WalletRepositoryMockUpdateParams
+	paramPtrs          *WalletRepositoryMockUpdateParamPtrs
+	expectationOrigins WalletRepositoryMockUpdateExpectationOrigins
+	results            *WalletRepositoryMockUpdateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WalletRepositoryMockUpdateParams contains parameters of the WalletRepository.Update
+type WalletRepositoryMockUpdateParams struct {
+	ctx  context.Context
+	item *entity.Wallet
+}
+
+// WalletRepositoryMockUpdateParamPtrs contains pointers to parameters of the WalletRepository.Update
+type WalletRepositoryMockUpdateParamPtrs struct {
+	ctx  *context.Context
+	item **entity.Wallet
+}
+
+// WalletRepositoryMockUpdateResults contains results of the WalletRepository.Update
+type WalletRepositoryMockUpdateResults struct {
+	err error
+}
+
+// WalletRepositoryMockUpdateOrigins contains origins of expectations of the WalletRepository.Update
+type WalletRepositoryMockUpdateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originItem string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mWalletRepositoryMockUpdate) Optional() *mWalletRepositoryMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for WalletRepository.Update
+func (mmUpdate *mWalletRepositoryMockUpdate) Expect(ctx context.Context, item *entity.Wallet) *mWalletRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("WalletRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &WalletRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("WalletRepositoryMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &WalletRepositoryMockUpdateParams{ctx, item}
+	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WalletRepository.Update
+func (mmUpdate *mWalletRepositoryMockUpdate) ExpectCtxParam1(ctx context.Context) *mWalletRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("WalletRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &WalletRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("WalletRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &WalletRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectItemParam2 sets up expected param item for WalletRepository.Update
+func (mmUpdate *mWalletRepositoryMockUpdate) ExpectItemParam2(item *entity.Wallet) *mWalletRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("WalletRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &WalletRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("WalletRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &WalletRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.item = &item
+	mmUpdate.defaultExpectation.expectationOrigins.originItem = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the WalletRepository.Update
+func (mmUpdate *mWalletRepositoryMockUpdate) Inspect(f func(ctx context.Context, item *entity.Wallet)) *mWalletRepositoryMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for WalletRepositoryMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by WalletRepository.Update
+func (mmUpdate *mWalletRepositoryMockUpdate) Return(err error) *WalletRepositoryMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("WalletRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &WalletRepositoryMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &WalletRepositoryMockUpdateResults{err}
+	mmUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the WalletRepository.Update method
+func (mmUpdate *mWalletRepositoryMockUpdate) Set(f func(ctx context.Context, item *entity.Wallet) (err error)) *WalletRepositoryMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the WalletRepository.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the WalletRepository.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	mmUpdate.mock.funcUpdateOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// When sets expectation for the WalletRepository.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mWalletRepositoryMockUpdate) When(ctx context.Context, item *entity.Wallet) *WalletRepositoryMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("WalletRepositoryMock.Update mock is already set by Set")
+	}
+
+	expectation := &WalletRepositoryMockUpdateExpectation{
+		mock:               mmUpdate.mock,
+		params:             &WalletRepositoryMockUpdateParams{ctx, item},
+		expectationOrigins: WalletRepositoryMockUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WalletRepository.Update return parameters for the expectation previously defined by the When method
+func (e *WalletRepositoryMockUpdateExpectation) Then(err error) *WalletRepositoryMock {
+	e.results = &WalletRepositoryMockUpdateResults{err}
+	return e.mock
+}
+
+// Times sets number of times WalletRepository.Update should be invoked
+func (mmUpdate *mWalletRepositoryMockUpdate) Times(n uint64) *mWalletRepositoryMockUpdate {
+	if n == 0 {
+		mmUpdate.mock.t.Fatalf("Times of WalletRepositoryMock.Update mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	mmUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdate
+}
+
+func (mmUpdate *mWalletRepositoryMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Update implements mm_usecase.WalletRepository
+func (mmUpdate *WalletRepositoryMock) Update(ctx context.Context, item *entity.Wallet) (err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	mmUpdate.t.Helper()
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, item)
+	}
+
+	mm_params := WalletRepositoryMockUpdateParams{ctx, item}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := WalletRepositoryMockUpdateParams{ctx, item}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("WalletRepositoryMock.Update got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
+				mmUpdate.t.Errorf("WalletRepositoryMock.Update got unexpected parameter item, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originItem, *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("WalletRepositoryMock.Update got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the WalletRepositoryMock.Update")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, item)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to WalletRepositoryMock.Update. %v %v", ctx, item)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished WalletRepositoryMock.Update invocations
+func (mmUpdate *WalletRepositoryMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of WalletRepositoryMock.Update invocations
+func (mmUpdate *WalletRepositoryMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to WalletRepositoryMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mWalletRepositoryMockUpdate) Calls() []*WalletRepositoryMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*WalletRepositoryMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *WalletRepositoryMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMock.invocationsDone()
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *WalletRepositoryMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WalletRepositoryMock.Update at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WalletRepositoryMock.Update at\n%s", m.UpdateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WalletRepositoryMock.Update at\n%s with params: %#v", m.UpdateMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Errorf("Expected call to WalletRepositoryMock.Update at\n%s", m.funcUpdateOrigin)
+	}
+
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to WalletRepositoryMock.Update at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), m.UpdateMock.expectedInvocationsOrigin, afterUpdateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *WalletRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCountBalancesInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockFindListInspect()
+
+			m.MinimockFindOneByIDInspect()
+
+			m.MinimockUpdateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *WalletRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *WalletRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCountBalancesDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockFindListDone() &&
+		m.MinimockFindOneByIDDone() &&
+		m.MinimockUpdateDone()
+}
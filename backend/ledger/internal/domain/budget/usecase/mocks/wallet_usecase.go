@@ -0,0 +1,2322 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.WalletUsecase -o wallet_usecase.go -n WalletUsecaseMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	mm_usecase "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// WalletUsecaseMock implements mm_usecase.WalletUsecase
+type WalletUsecaseMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateWalletByDTO          func(ctx context.Context, in mm_usecase.CreateWalletDataInput) (resWalletDTO *mm_usecase.WalletDTO, err error)
+	funcCreateWalletByDTOOrigin    string
+	inspectFuncCreateWalletByDTO   func(ctx context.Context, in mm_usecase.CreateWalletDataInput)
+	afterCreateWalletByDTOCounter  uint64
+	beforeCreateWalletByDTOCounter uint64
+	CreateWalletByDTOMock          mWalletUsecaseMockCreateWalletByDTO
+
+	funcDeleteWalletByID          func(ctx context.Context, id uuid.UUID) (err error)
+	funcDeleteWalletByIDOrigin    string
+	inspectFuncDeleteWalletByID   func(ctx context.Context, id uuid.UUID)
+	afterDeleteWalletByIDCounter  uint64
+	beforeDeleteWalletByIDCounter uint64
+	DeleteWalletByIDMock          mWalletUsecaseMockDeleteWalletByID
+
+	funcFindList          func(ctx context.Context, listOptions *mm_usecase.WalletListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.WalletDTO, err error)
+	funcFindListOrigin    string
+	inspectFuncFindList   func(ctx context.Context, listOptions *mm_usecase.WalletListOptions, queryParams *uctypes.QueryGetListParams)
+	afterFindListCounter  uint64
+	beforeFindListCounter uint64
+	FindListMock          mWalletUsecaseMockFindList
+
+	funcFindOneByID          func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.WalletDTO, err error)
+	funcFindOneByIDOrigin    string
+	inspectFuncFindOneByID   func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)
+	afterFindOneByIDCounter  uint64
+	beforeFindOneByIDCounter uint64
+	FindOneByIDMock          mWalletUsecaseMockFindOneByID
+
+	funcGetWalletBalances          func(ctx context.Context, queryFilter mm_usecase.CountWalletBalancesQueryFilter) (resItems []*mm_usecase.WalletBalanceDTO, err error)
+	funcGetWalletBalancesOrigin    string
+	inspectFuncGetWalletBalances   func(ctx context.Context, queryFilter mm_usecase.CountWalletBalancesQueryFilter)
+	afterGetWalletBalancesCounter  uint64
+	beforeGetWalletBalancesCounter uint64
+	GetWalletBalancesMock          mWalletUsecaseMockGetWalletBalances
+
+	funcPatchWalletByDTO          func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchWalletDataInput, skipVersionCheck bool) (err error)
+	funcPatchWalletByDTOOrigin    string
+	inspectFuncPatchWalletByDTO   func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchWalletDataInput, skipVersionCheck bool)
+	afterPatchWalletByDTOCounter  uint64
+	beforePatchWalletByDTOCounter uint64
+	PatchWalletByDTOMock          mWalletUsecaseMockPatchWalletByDTO
+}
+
+// NewWalletUsecaseMock returns a mock for mm_usecase.WalletUsecase
+func NewWalletUsecaseMock(t minimock.Tester) *WalletUsecaseMock {
+	m := &WalletUsecaseMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateWalletByDTOMock = mWalletUsecaseMockCreateWalletByDTO{mock: m}
+	m.CreateWalletByDTOMock.callArgs = []*WalletUsecaseMockCreateWalletByDTOParams{}
+
+	m.DeleteWalletByIDMock = mWalletUsecaseMockDeleteWalletByID{mock: m}
+	m.DeleteWalletByIDMock.callArgs = []*WalletUsecaseMockDeleteWalletByIDParams{}
+
+	m.FindListMock = mWalletUsecaseMockFindList{mock: m}
+	m.FindListMock.callArgs = []*WalletUsecaseMockFindListParams{}
+
+	m.FindOneByIDMock = mWalletUsecaseMockFindOneByID{mock: m}
+	m.FindOneByIDMock.callArgs = []*WalletUsecaseMockFindOneByIDParams{}
+
+	m.GetWalletBalancesMock = mWalletUsecaseMockGetWalletBalances{mock: m}
+	m.GetWalletBalancesMock.callArgs = []*WalletUsecaseMockGetWalletBalancesParams{}
+
+	m.PatchWalletByDTOMock = mWalletUsecaseMockPatchWalletByDTO{mock: m}
+	m.PatchWalletByDTOMock.callArgs = []*WalletUsecaseMockPatchWalletByDTOParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mWalletUsecaseMockCreateWalletByDTO struct {
+	optional           bool
+	mock               *WalletUsecaseMock
+	defaultExpectation *WalletUsecaseMockCreateWalletByDTOExpectation
+	expectations       []*WalletUsecaseMockCreateWalletByDTOExpectation
+
+	callArgs []*WalletUsecaseMockCreateWalletByDTOParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WalletUsecaseMockCreateWalletByDTOExpectation specifies expectation struct of the WalletUsecase.CreateWalletByDTO
+type WalletUsecaseMockCreateWalletByDTOExpectation struct {
+	mock               *WalletUsecaseMock
+	params             *WalletUsecaseMockCreateWalletByDTOParams
+	paramPtrs          *WalletUsecaseMockCreateWalletByDTOParamPtrs
+	expectationOrigins WalletUsecaseMockCreateWalletByDTOExpectationOrigins
+	results            *WalletUsecaseMockCreateWalletByDTOResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WalletUsecaseMockCreateWalletByDTOParams contains parameters of the WalletUsecase.CreateWalletByDTO
+type WalletUsecaseMockCreateWalletByDTOParams struct {
+	ctx context.Context
+	in  mm_usecase.CreateWalletDataInput
+}
+
+// WalletUsecaseMockCreateWalletByDTOParamPtrs contains pointers to parameters of the WalletUsecase.CreateWalletByDTO
+type WalletUsecaseMockCreateWalletByDTOParamPtrs struct {
+	ctx *context.Context
+	in  *mm_usecase.CreateWalletDataInput
+}
+
+// WalletUsecaseMockCreateWalletByDTOResults contains results of the WalletUsecase.CreateWalletByDTO
+type WalletUsecaseMockCreateWalletByDTOResults struct {
+	resWalletDTO *mm_usecase.WalletDTO
+	err          error
+}
+
+// WalletUsecaseMockCreateWalletByDTOOrigins contains origins of expectations of the WalletUsecase.CreateWalletByDTO
+type WalletUsecaseMockCreateWalletByDTOExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateWalletByDTO *mWalletUsecaseMockCreateWalletByDTO) Optional() *mWalletUsecaseMockCreateWalletByDTO {
+	mmCreateWalletByDTO.optional = true
+	return mmCreateWalletByDTO
+}
+
+// Expect sets up expected params for WalletUsecase.CreateWalletByDTO
+func (mmCreateWalletByDTO *mWalletUsecaseMockCreateWalletByDTO) Expect(ctx context.Context, in mm_usecase.CreateWalletDataInput) *mWalletUsecaseMockCreateWalletByDTO {
+	if mmCreateWalletByDTO.mock.funcCreateWalletByDTO != nil {
+		mmCreateWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.CreateWalletByDTO mock is already set by Set")
+	}
+
+	if mmCreateWalletByDTO.defaultExpectation == nil {
+		mmCreateWalletByDTO.defaultExpectation = &WalletUsecaseMockCreateWalletByDTOExpectation{}
+	}
+
+	if mmCreateWalletByDTO.defaultExpectation.paramPtrs != nil {
+		mmCreateWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.CreateWalletByDTO mock is already set by ExpectParams functions")
+	}
+
+	mmCreateWalletByDTO.defaultExpectation.params = &WalletUsecaseMockCreateWalletByDTOParams{ctx, in}
+	mmCreateWalletByDTO.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateWalletByDTO.expectations {
+		if minimock.Equal(e.params, mmCreateWalletByDTO.defaultExpectation.params) {
+			mmCreateWalletByDTO.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateWalletByDTO.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateWalletByDTO
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WalletUsecase.CreateWalletByDTO
+func (mmCreateWalletByDTO *mWalletUsecaseMockCreateWalletByDTO) ExpectCtxParam1(ctx context.Context) *mWalletUsecaseMockCreateWalletByDTO {
+	if mmCreateWalletByDTO.mock.funcCreateWalletByDTO != nil {
+		mmCreateWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.CreateWalletByDTO mock is already set by Set")
+	}
+
+	if mmCreateWalletByDTO.defaultExpectation == nil {
+		mmCreateWalletByDTO.defaultExpectation = &WalletUsecaseMockCreateWalletByDTOExpectation{}
+	}
+
+	if mmCreateWalletByDTO.defaultExpectation.params != nil {
+		mmCreateWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.CreateWalletByDTO mock is already set by Expect")
+	}
+
+	if mmCreateWalletByDTO.defaultExpectation.paramPtrs == nil {
+		mmCreateWalletByDTO.defaultExpectation.paramPtrs = &WalletUsecaseMockCreateWalletByDTOParamPtrs{}
+	}
+	mmCreateWalletByDTO.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateWalletByDTO.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateWalletByDTO
+}
+
+// ExpectInParam2 sets up expected param in for WalletUsecase.CreateWalletByDTO
+func (mmCreateWalletByDTO *mWalletUsecaseMockCreateWalletByDTO) ExpectInParam2(in mm_usecase.CreateWalletDataInput) *mWalletUsecaseMockCreateWalletByDTO {
+	if mmCreateWalletByDTO.mock.funcCreateWalletByDTO != nil {
+		mmCreateWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.CreateWalletByDTO mock is already set by Set")
+	}
+
+	if mmCreateWalletByDTO.defaultExpectation == nil {
+		mmCreateWalletByDTO.defaultExpectation = &WalletUsecaseMockCreateWalletByDTOExpectation{}
+	}
+
+	if mmCreateWalletByDTO.defaultExpectation.params != nil {
+		mmCreateWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.CreateWalletByDTO mock is already set by Expect")
+	}
+
+	if mmCreateWalletByDTO.defaultExpectation.paramPtrs == nil {
+		mmCreateWalletByDTO.defaultExpectation.paramPtrs = &WalletUsecaseMockCreateWalletByDTOParamPtrs{}
+	}
+	mmCreateWalletByDTO.defaultExpectation.paramPtrs.in = &in
+	mmCreateWalletByDTO.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmCreateWalletByDTO
+}
+
+// Inspect accepts an inspector function that has same arguments as the WalletUsecase.CreateWalletByDTO
+func (mmCreateWalletByDTO *mWalletUsecaseMockCreateWalletByDTO) Inspect(f func(ctx context.Context, in mm_usecase.CreateWalletDataInput)) *mWalletUsecaseMockCreateWalletByDTO {
+	if mmCreateWalletByDTO.mock.inspectFuncCreateWalletByDTO != nil {
+		mmCreateWalletByDTO.mock.t.Fatalf("Inspect function is already set for WalletUsecaseMock.CreateWalletByDTO")
+	}
+
+	mmCreateWalletByDTO.mock.inspectFuncCreateWalletByDTO = f
+
+	return mmCreateWalletByDTO
+}
+
+// Return sets up results that will be returned by WalletUsecase.CreateWalletByDTO
+func (mmCreateWalletByDTO *mWalletUsecaseMockCreateWalletByDTO) Return(resWalletDTO *mm_usecase.WalletDTO, err error) *WalletUsecaseMock {
+	if mmCreateWalletByDTO.mock.funcCreateWalletByDTO != nil {
+		mmCreateWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.CreateWalletByDTO mock is already set by Set")
+	}
+
+	if mmCreateWalletByDTO.defaultExpectation == nil {
+		mmCreateWalletByDTO.defaultExpectation = &WalletUsecaseMockCreateWalletByDTOExpectation{mock: mmCreateWalletByDTO.mock}
+	}
+	mmCreateWalletByDTO.defaultExpectation.results = &WalletUsecaseMockCreateWalletByDTOResults{resWalletDTO, err}
+	mmCreateWalletByDTO.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateWalletByDTO.mock
+}
+
+// Set uses given function f to mock the WalletUsecase.CreateWalletByDTO method
+func (mmCreateWalletByDTO *mWalletUsecaseMockCreateWalletByDTO) Set(f func(ctx context.Context, in mm_usecase.CreateWalletDataInput) (resWalletDTO *mm_usecase.WalletDTO, err error)) *WalletUsecaseMock {
+	if mmCreateWalletByDTO.defaultExpectation != nil {
+		mmCreateWalletByDTO.mock.t.Fatalf("Default expectation is already set for the WalletUsecase.CreateWalletByDTO method")
+	}
+
+	if len(mmCreateWalletByDTO.expectations) > 0 {
+		mmCreateWalletByDTO.mock.t.Fatalf("Some expectations are already set for the WalletUsecase.CreateWalletByDTO method")
+	}
+
+	mmCreateWalletByDTO.mock.funcCreateWalletByDTO = f
+	mmCreateWalletByDTO.mock.funcCreateWalletByDTOOrigin = minimock.CallerInfo(1)
+	return mmCreateWalletByDTO.mock
+}
+
+// When sets expectation for the WalletUsecase.CreateWalletByDTO which will trigger the result defined by the following
+// Then helper
+func (mmCreateWalletByDTO *mWalletUsecaseMockCreateWalletByDTO) When(ctx context.Context, in mm_usecase.CreateWalletDataInput) *WalletUsecaseMockCreateWalletByDTOExpectation {
+	if mmCreateWalletByDTO.mock.funcCreateWalletByDTO != nil {
+		mmCreateWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.CreateWalletByDTO mock is already set by Set")
+	}
+
+	expectation := &WalletUsecaseMockCreateWalletByDTOExpectation{
+		mock:               mmCreateWalletByDTO.mock,
+		params:             &WalletUsecaseMockCreateWalletByDTOParams{ctx, in},
+		expectationOrigins: WalletUsecaseMockCreateWalletByDTOExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateWalletByDTO.expectations = append(mmCreateWalletByDTO.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WalletUsecase.CreateWalletByDTO return parameters for the expectation previously defined by the When method
+func (e *WalletUsecaseMockCreateWalletByDTOExpectation) Then(resWalletDTO *mm_usecase.WalletDTO, err error) *WalletUsecaseMock {
+	e.results = &WalletUsecaseMockCreateWalletByDTOResults{resWalletDTO, err}
+	return e.mock
+}
+
+// Times sets number of times WalletUsecase.CreateWalletByDTO should be invoked
+func (mmCreateWalletByDTO *mWalletUsecaseMockCreateWalletByDTO) Times(n uint64) *mWalletUsecaseMockCreateWalletByDTO {
+	if n == 0 {
+		mmCreateWalletByDTO.mock.t.Fatalf("Times of WalletUsecaseMock.CreateWalletByDTO mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateWalletByDTO.expectedInvocations, n)
+	mmCreateWalletByDTO.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateWalletByDTO
+}
+
+func (mmCreateWalletByDTO *mWalletUsecaseMockCreateWalletByDTO) invocationsDone() bool {
+	if len(mmCreateWalletByDTO.expectations) == 0 && mmCreateWalletByDTO.defaultExpectation == nil && mmCreateWalletByDTO.mock.funcCreateWalletByDTO == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateWalletByDTO.mock.afterCreateWalletByDTOCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateWalletByDTO.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateWalletByDTO implements mm_usecase.WalletUsecase
+func (mmCreateWalletByDTO *WalletUsecaseMock) CreateWalletByDTO(ctx context.Context, in mm_usecase.CreateWalletDataInput) (resWalletDTO *mm_usecase.WalletDTO, err error) {
+	mm_atomic.AddUint64(&mmCreateWalletByDTO.beforeCreateWalletByDTOCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateWalletByDTO.afterCreateWalletByDTOCounter, 1)
+
+	mmCreateWalletByDTO.t.Helper()
+
+	if mmCreateWalletByDTO.inspectFuncCreateWalletByDTO != nil {
+		mmCreateWalletByDTO.inspectFuncCreateWalletByDTO(ctx, in)
+	}
+
+	mm_params := WalletUsecaseMockCreateWalletByDTOParams{ctx, in}
+
+	// Record call args
+	mmCreateWalletByDTO.CreateWalletByDTOMock.mutex.Lock()
+	mmCreateWalletByDTO.CreateWalletByDTOMock.callArgs = append(mmCreateWalletByDTO.CreateWalletByDTOMock.callArgs, &mm_params)
+	mmCreateWalletByDTO.CreateWalletByDTOMock.mutex.Unlock()
+
+	for _, e := range mmCreateWalletByDTO.CreateWalletByDTOMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resWalletDTO, e.results.err
+		}
+	}
+
+	if mmCreateWalletByDTO.CreateWalletByDTOMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateWalletByDTO.CreateWalletByDTOMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateWalletByDTO.CreateWalletByDTOMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateWalletByDTO.CreateWalletByDTOMock.defaultExpectation.paramPtrs
+
+		mm_got := WalletUsecaseMockCreateWalletByDTOParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateWalletByDTO.t.Errorf("WalletUsecaseMock.CreateWalletByDTO got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateWalletByDTO.CreateWalletByDTOMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmCreateWalletByDTO.t.Errorf("WalletUsecaseMock.CreateWalletByDTO got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateWalletByDTO.CreateWalletByDTOMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateWalletByDTO.t.Errorf("WalletUsecaseMock.CreateWalletByDTO got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateWalletByDTO.CreateWalletByDTOMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateWalletByDTO.CreateWalletByDTOMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateWalletByDTO.t.Fatal("No results are set for the WalletUsecaseMock.CreateWalletByDTO")
+		}
+		return (*mm_results).resWalletDTO, (*mm_results).err
+	}
+	if mmCreateWalletByDTO.funcCreateWalletByDTO != nil {
+		return mmCreateWalletByDTO.funcCreateWalletByDTO(ctx, in)
+	}
+	mmCreateWalletByDTO.t.Fatalf("Unexpected call to WalletUsecaseMock.CreateWalletByDTO. %v %v", ctx, in)
+	return
+}
+
+// CreateWalletByDTOAfterCounter returns a count of finished WalletUsecaseMock.CreateWalletByDTO invocations
+func (mmCreateWalletByDTO *WalletUsecaseMock) CreateWalletByDTOAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateWalletByDTO.afterCreateWalletByDTOCounter)
+}
+
+// CreateWalletByDTOBeforeCounter returns a count of WalletUsecaseMock.CreateWalletByDTO invocations
+func (mmCreateWalletByDTO *WalletUsecaseMock) CreateWalletByDTOBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateWalletByDTO.beforeCreateWalletByDTOCounter)
+}
+
+// Calls returns a list of arguments used in each call to WalletUsecaseMock.CreateWalletByDTO.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateWalletByDTO *mWalletUsecaseMockCreateWalletByDTO) Calls() []*WalletUsecaseMockCreateWalletByDTOParams {
+	mmCreateWalletByDTO.mutex.RLock()
+
+	argCopy := make([]*WalletUsecaseMockCreateWalletByDTOParams, len(mmCreateWalletByDTO.callArgs))
+	copy(argCopy, mmCreateWalletByDTO.callArgs)
+
+	mmCreateWalletByDTO.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateWalletByDTODone returns true if the count of the CreateWalletByDTO invocations corresponds
+// the number of defined expectations
+func (m *WalletUsecaseMock) MinimockCreateWalletByDTODone() bool {
+	if m.CreateWalletByDTOMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateWalletByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateWalletByDTOMock.invocationsDone()
+}
+
+// MinimockCreateWalletByDTOInspect logs each unmet expectation
+func (m *WalletUsecaseMock) MinimockCreateWalletByDTOInspect() {
+	for _, e := range m.CreateWalletByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WalletUsecaseMock.CreateWalletByDTO at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateWalletByDTOCounter := mm_atomic.LoadUint64(&m.afterCreateWalletByDTOCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateWalletByDTOMock.defaultExpectation != nil && afterCreateWalletByDTOCounter < 1 {
+		if m.CreateWalletByDTOMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WalletUsecaseMock.CreateWalletByDTO at\n%s", m.CreateWalletByDTOMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WalletUsecaseMock.CreateWalletByDTO at\n%s with params: %#v", m.CreateWalletByDTOMock.defaultExpectation.expectationOrigins.origin, *m.CreateWalletByDTOMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateWalletByDTO != nil && afterCreateWalletByDTOCounter < 1 {
+		m.t.Errorf("Expected call to WalletUsecaseMock.CreateWalletByDTO at\n%s", m.funcCreateWalletByDTOOrigin)
+	}
+
+	if !m.CreateWalletByDTOMock.invocationsDone() && afterCreateWalletByDTOCounter > 0 {
+		m.t.Errorf("Expected %d calls to WalletUsecaseMock.CreateWalletByDTO at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateWalletByDTOMock.expectedInvocations), m.CreateWalletByDTOMock.expectedInvocationsOrigin, afterCreateWalletByDTOCounter)
+	}
+}
+
+type mWalletUsecaseMockDeleteWalletByID struct {
+	optional           bool
+	mock               *WalletUsecaseMock
+	defaultExpectation *WalletUsecaseMockDeleteWalletByIDExpectation
+	expectations       []*WalletUsecaseMockDeleteWalletByIDExpectation
+
+	callArgs []*WalletUsecaseMockDeleteWalletByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WalletUsecaseMockDeleteWalletByIDExpectation specifies expectation struct of the WalletUsecase.DeleteWalletByID
+type WalletUsecaseMockDeleteWalletByIDExpectation struct {
+	mock               *WalletUsecaseMock
+	params             *WalletUsecaseMockDeleteWalletByIDParams
+	paramPtrs          *WalletUsecaseMockDeleteWalletByIDParamPtrs
+	expectationOrigins WalletUsecaseMockDeleteWalletByIDExpectationOrigins
+	results            *WalletUsecaseMockDeleteWalletByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WalletUsecaseMockDeleteWalletByIDParams contains parameters of the WalletUsecase.DeleteWalletByID
+type WalletUsecaseMockDeleteWalletByIDParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// WalletUsecaseMockDeleteWalletByIDParamPtrs contains pointers to parameters of the WalletUsecase.DeleteWalletByID
+type WalletUsecaseMockDeleteWalletByIDParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// WalletUsecaseMockDeleteWalletByIDResults contains results of the WalletUsecase.DeleteWalletByID
+type WalletUsecaseMockDeleteWalletByIDResults struct {
+	err error
+}
+
+// WalletUsecaseMockDeleteWalletByIDOrigins contains origins of expectations of the WalletUsecase.DeleteWalletByID
+type WalletUsecaseMockDeleteWalletByIDExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteWalletByID *mWalletUsecaseMockDeleteWalletByID) Optional() *mWalletUsecaseMockDeleteWalletByID {
+	mmDeleteWalletByID.optional = true
+	return mmDeleteWalletByID
+}
+
+// Expect sets up expected params for WalletUsecase.DeleteWalletByID
+func (mmDeleteWalletByID *mWalletUsecaseMockDeleteWalletByID) Expect(ctx context.Context, id uuid.UUID) *mWalletUsecaseMockDeleteWalletByID {
+	if mmDeleteWalletByID.mock.funcDeleteWalletByID != nil {
+		mmDeleteWalletByID.mock.t.Fatalf("WalletUsecaseMock.DeleteWalletByID mock is already set by Set")
+	}
+
+	if mmDeleteWalletByID.defaultExpectation == nil {
+		mmDeleteWalletByID.defaultExpectation = &WalletUsecaseMockDeleteWalletByIDExpectation{}
+	}
+
+	if mmDeleteWalletByID.defaultExpectation.paramPtrs != nil {
+		mmDeleteWalletByID.mock.t.Fatalf("WalletUsecaseMock.DeleteWalletByID mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteWalletByID.defaultExpectation.params = &WalletUsecaseMockDeleteWalletByIDParams{ctx, id}
+	mmDeleteWalletByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteWalletByID.expectations {
+		if minimock.Equal(e.params, mmDeleteWalletByID.defaultExpectation.params) {
+			mmDeleteWalletByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteWalletByID.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteWalletByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WalletUsecase.DeleteWalletByID
+func (mmDeleteWalletByID *mWalletUsecaseMockDeleteWalletByID) ExpectCtxParam1(ctx context.Context) *mWalletUsecaseMockDeleteWalletByID {
+	if mmDeleteWalletByID.mock.funcDeleteWalletByID != nil {
+		mmDeleteWalletByID.mock.t.Fatalf("WalletUsecaseMock.DeleteWalletByID mock is already set by Set")
+	}
+
+	if mmDeleteWalletByID.defaultExpectation == nil {
+		mmDeleteWalletByID.defaultExpectation = &WalletUsecaseMockDeleteWalletByIDExpectation{}
+	}
+
+	if mmDeleteWalletByID.defaultExpectation.params != nil {
+		mmDeleteWalletByID.mock.t.Fatalf("WalletUsecaseMock.DeleteWalletByID mock is already set by Expect")
+	}
+
+	if mmDeleteWalletByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteWalletByID.defaultExpectation.paramPtrs = &WalletUsecaseMockDeleteWalletByIDParamPtrs{}
+	}
+	mmDeleteWalletByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteWalletByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteWalletByID
+}
+
+// ExpectIdParam2 sets up expected param id for WalletUsecase.DeleteWalletByID
+func (mmDeleteWalletByID *mWalletUsecaseMockDeleteWalletByID) ExpectIdParam2(id uuid.UUID) *mWalletUsecaseMockDeleteWalletByID {
+	if mmDeleteWalletByID.mock.funcDeleteWalletByID != nil {
+		mmDeleteWalletByID.mock.t.Fatalf("WalletUsecaseMock.DeleteWalletByID mock is already set by Set")
+	}
+
+	if mmDeleteWalletByID.defaultExpectation == nil {
+		mmDeleteWalletByID.defaultExpectation = &WalletUsecaseMockDeleteWalletByIDExpectation{}
+	}
+
+	if mmDeleteWalletByID.defaultExpectation.params != nil {
+		mmDeleteWalletByID.mock.t.Fatalf("WalletUsecaseMock.DeleteWalletByID mock is already set by Expect")
+	}
+
+	if mmDeleteWalletByID.defaultExpectation.paramPtrs == nil {
+		mmDeleteWalletByID.defaultExpectation.paramPtrs = &WalletUsecaseMockDeleteWalletByIDParamPtrs{}
+	}
+	mmDeleteWalletByID.defaultExpectation.paramPtrs.id = &id
+	mmDeleteWalletByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteWalletByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the WalletUsecase.DeleteWalletByID
+func (mmDeleteWalletByID *mWalletUsecaseMockDeleteWalletByID) Inspect(f func(ctx context.Context, id uuid.UUID)) *mWalletUsecaseMockDeleteWalletByID {
+	if mmDeleteWalletByID.mock.inspectFuncDeleteWalletByID != nil {
+		mmDeleteWalletByID.mock.t.Fatalf("Inspect function is already set for WalletUsecaseMock.DeleteWalletByID")
+	}
+
+	mmDeleteWalletByID.mock.inspectFuncDeleteWalletByID = f
+
+	return mmDeleteWalletByID
+}
+
+// Return sets up results that will be returned by WalletUsecase.DeleteWalletByID
+func (mmDeleteWalletByID *mWalletUsecaseMockDeleteWalletByID) Return(err error) *WalletUsecaseMock {
+	if mmDeleteWalletByID.mock.funcDeleteWalletByID != nil {
+		mmDeleteWalletByID.mock.t.Fatalf("WalletUsecaseMock.DeleteWalletByID mock is already set by Set")
+	}
+
+	if mmDeleteWalletByID.defaultExpectation == nil {
+		mmDeleteWalletByID.defaultExpectation = &WalletUsecaseMockDeleteWalletByIDExpectation{mock: mmDeleteWalletByID.mock}
+	}
+	mmDeleteWalletByID.defaultExpectation.results = &WalletUsecaseMockDeleteWalletByIDResults{err}
+	mmDeleteWalletByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteWalletByID.mock
+}
+
+// Set uses given function f to mock the WalletUsecase.DeleteWalletByID method
+func (mmDeleteWalletByID *mWalletUsecaseMockDeleteWalletByID) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *WalletUsecaseMock {
+	if mmDeleteWalletByID.defaultExpectation != nil {
+		mmDeleteWalletByID.mock.t.Fatalf("Default expectation is already set for the WalletUsecase.DeleteWalletByID method")
+	}
+
+	if len(mmDeleteWalletByID.expectations) > 0 {
+		mmDeleteWalletByID.mock.t.Fatalf("Some expectations are already set for the WalletUsecase.DeleteWalletByID method")
+	}
+
+	mmDeleteWalletByID.mock.funcDeleteWalletByID = f
+	mmDeleteWalletByID.mock.funcDeleteWalletByIDOrigin = minimock.CallerInfo(1)
+	return mmDeleteWalletByID.mock
+}
+
+// When sets expectation for the WalletUsecase.DeleteWalletByID which will trigger the result defined by the following
+// Then helper
+func (mmDeleteWalletByID *mWalletUsecaseMockDeleteWalletByID) When(ctx context.Context, id uuid.UUID) *WalletUsecaseMockDeleteWalletByIDExpectation {
+	if mmDeleteWalletByID.mock.funcDeleteWalletByID != nil {
+		mmDeleteWalletByID.mock.t.Fatalf("WalletUsecaseMock.DeleteWalletByID mock is already set by Set")
+	}
+
+	expectation := &WalletUsecaseMockDeleteWalletByIDExpectation{
+		mock:               mmDeleteWalletByID.mock,
+		params:             &WalletUsecaseMockDeleteWalletByIDParams{ctx, id},
+		expectationOrigins: WalletUsecaseMockDeleteWalletByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteWalletByID.expectations = append(mmDeleteWalletByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WalletUsecase.DeleteWalletByID return parameters for the expectation previously defined by the When method
+func (e *WalletUsecaseMockDeleteWalletByIDExpectation) Then(err error) *WalletUsecaseMock {
+	e.results = &WalletUsecaseMockDeleteWalletByIDResults{err}
+	return e.mock
+}
+
+// Times sets number of times WalletUsecase.DeleteWalletByID should be invoked
+func (mmDeleteWalletByID *mWalletUsecaseMockDeleteWalletByID) Times(n uint64) *mWalletUsecaseMockDeleteWalletByID {
+	if n == 0 {
+		mmDeleteWalletByID.mock.t.Fatalf("Times of WalletUsecaseMock.DeleteWalletByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteWalletByID.expectedInvocations, n)
+	mmDeleteWalletByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteWalletByID
+}
+
+func (mmDeleteWalletByID *mWalletUsecaseMockDeleteWalletByID) invocationsDone() bool {
+	if len(mmDeleteWalletByID.expectations) == 0 && mmDeleteWalletByID.defaultExpectation == nil && mmDeleteWalletByID.mock.funcDeleteWalletByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteWalletByID.mock.afterDeleteWalletByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteWalletByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteWalletByID implements mm_usecase.WalletUsecase
+func (mmDeleteWalletByID *WalletUsecaseMock) DeleteWalletByID(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteWalletByID.beforeDeleteWalletByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteWalletByID.afterDeleteWalletByIDCounter, 1)
+
+	mmDeleteWalletByID.t.Helper()
+
+	if mmDeleteWalletByID.inspectFuncDeleteWalletByID != nil {
+		mmDeleteWalletByID.inspectFuncDeleteWalletByID(ctx, id)
+	}
+
+	mm_params := WalletUsecaseMockDeleteWalletByIDParams{ctx, id}
+
+	// Record call args
+	mmDeleteWalletByID.DeleteWalletByIDMock.mutex.Lock()
+	mmDeleteWalletByID.DeleteWalletByIDMock.callArgs = append(mmDeleteWalletByID.DeleteWalletByIDMock.callArgs, &mm_params)
+	mmDeleteWalletByID.DeleteWalletByIDMock.mutex.Unlock()
+
+	for _, e := range mmDeleteWalletByID.DeleteWalletByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteWalletByID.DeleteWalletByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteWalletByID.DeleteWalletByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteWalletByID.DeleteWalletByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteWalletByID.DeleteWalletByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := WalletUsecaseMockDeleteWalletByIDParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteWalletByID.t.Errorf("WalletUsecaseMock.DeleteWalletByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteWalletByID.DeleteWalletByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteWalletByID.t.Errorf("WalletUsecaseMock.DeleteWalletByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteWalletByID.DeleteWalletByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteWalletByID.t.Errorf("WalletUsecaseMock.DeleteWalletByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteWalletByID.DeleteWalletByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteWalletByID.DeleteWalletByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteWalletByID.t.Fatal("No results are set for the WalletUsecaseMock.DeleteWalletByID")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteWalletByID.funcDeleteWalletByID != nil {
+		return mmDeleteWalletByID.funcDeleteWalletByID(ctx, id)
+	}
+	mmDeleteWalletByID.t.Fatalf("Unexpected call to WalletUsecaseMock.DeleteWalletByID. %v %v", ctx, id)
+	return
+}
+
+// DeleteWalletByIDAfterCounter returns a count of finished WalletUsecaseMock.DeleteWalletByID invocations
+func (mmDeleteWalletByID *WalletUsecaseMock) DeleteWalletByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteWalletByID.afterDeleteWalletByIDCounter)
+}
+
+// DeleteWalletByIDBeforeCounter returns a count of WalletUsecaseMock.DeleteWalletByID invocations
+func (mmDeleteWalletByID *WalletUsecaseMock) DeleteWalletByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteWalletByID.beforeDeleteWalletByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to WalletUsecaseMock.DeleteWalletByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteWalletByID *mWalletUsecaseMockDeleteWalletByID) Calls() []*WalletUsecaseMockDeleteWalletByIDParams {
+	mmDeleteWalletByID.mutex.RLock()
+
+	argCopy := make([]*WalletUsecaseMockDeleteWalletByIDParams, len(mmDeleteWalletByID.callArgs))
+	copy(argCopy, mmDeleteWalletByID.callArgs)
+
+	mmDeleteWalletByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteWalletByIDDone returns true if the count of the DeleteWalletByID invocations corresponds
+// the number of defined expectations
+func (m *WalletUsecaseMock) MinimockDeleteWalletByIDDone() bool {
+	if m.DeleteWalletByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteWalletByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteWalletByIDMock.invocationsDone()
+}
+
+// MinimockDeleteWalletByIDInspect logs each unmet expectation
+func (m *WalletUsecaseMock) MinimockDeleteWalletByIDInspect() {
+	for _, e := range m.DeleteWalletByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WalletUsecaseMock.DeleteWalletByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteWalletByIDCounter := mm_atomic.LoadUint64(&m.afterDeleteWalletByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteWalletByIDMock.defaultExpectation != nil && afterDeleteWalletByIDCounter < 1 {
+		if m.DeleteWalletByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WalletUsecaseMock.DeleteWalletByID at\n%s", m.DeleteWalletByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WalletUsecaseMock.DeleteWalletByID at\n%s with params: %#v", m.DeleteWalletByIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteWalletByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteWalletByID != nil && afterDeleteWalletByIDCounter < 1 {
+		m.t.Errorf("Expected call to WalletUsecaseMock.DeleteWalletByID at\n%s", m.funcDeleteWalletByIDOrigin)
+	}
+
+	if !m.DeleteWalletByIDMock.invocationsDone() && afterDeleteWalletByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to WalletUsecaseMock.DeleteWalletByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteWalletByIDMock.expectedInvocations), m.DeleteWalletByIDMock.expectedInvocationsOrigin, afterDeleteWalletByIDCounter)
+	}
+}
+
+type mWalletUsecaseMockFindList struct {
+	optional           bool
+	mock               *WalletUsecaseMock
+	defaultExpectation *WalletUsecaseMockFindListExpectation
+	expectations       []*WalletUsecaseMockFindListExpectation
+
+	callArgs []*WalletUsecaseMockFindListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WalletUsecaseMockFindListExpectation specifies expectation struct of the WalletUsecase.FindList
+type WalletUsecaseMockFindListExpectation struct {
+	mock               *WalletUsecaseMock
+	params             *WalletUsecaseMockFindListParams
+	paramPtrs          *WalletUsecaseMockFindListParamPtrs
+	expectationOrigins WalletUsecaseMockFindListExpectationOrigins
+	results            *WalletUsecaseMockFindListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WalletUsecaseMockFindListParams contains parameters of the WalletUsecase.FindList
+type WalletUsecaseMockFindListParams struct {
+	ctx         context.Context
+	listOptions *mm_usecase.WalletListOptions
+	queryParams *uctypes.QueryGetListParams
+}
+
+// WalletUsecaseMockFindListParamPtrs contains pointers to parameters of the WalletUsecase.FindList
+type WalletUsecaseMockFindListParamPtrs struct {
+	ctx         *context.Context
+	listOptions **mm_usecase.WalletListOptions
+	queryParams **uctypes.QueryGetListParams
+}
+
+// WalletUsecaseMockFindListResults contains results of the WalletUsecase.FindList
+type WalletUsecaseMockFindListResults struct {
+	resItems []*mm_usecase.WalletDTO
+	err      error
+}
+
+// WalletUsecaseMockFindListOrigins contains origins of expectations of the WalletUsecase.FindList
+type WalletUsecaseMockFindListExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originListOptions string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindList *mWalletUsecaseMockFindList) Optional() *mWalletUsecaseMockFindList {
+	mmFindList.optional = true
+	return mmFindList
+}
+
+// Expect sets up expected params for WalletUsecase.FindList
+func (mmFindList *mWalletUsecaseMockFindList) Expect(ctx context.Context, listOptions *mm_usecase.WalletListOptions, queryParams *uctypes.QueryGetListParams) *mWalletUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("WalletUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &WalletUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs != nil {
+		mmFindList.mock.t.Fatalf("WalletUsecaseMock.FindList mock is already set by ExpectParams functions")
+	}
+
+	mmFindList.defaultExpectation.params = &WalletUsecaseMockFindListParams{ctx, listOptions, queryParams}
+	mmFindList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindList.expectations {
+		if minimock.Equal(e.params, mmFindList.defaultExpectation.params) {
+			mmFindList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindList.defaultExpectation.params)
+		}
+	}
+
+	return mmFindList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WalletUsecase.FindList
+func (mmFindList *mWalletUsecaseMockFindList) ExpectCtxParam1(ctx context.Context) *mWalletUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("WalletUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &WalletUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("WalletUsecaseMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &WalletUsecaseMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectListOptionsParam2 sets up expected param listOptions for WalletUsecase.FindList
+func (mmFindList *mWalletUsecaseMockFindList) ExpectListOptionsParam2(listOptions *mm_usecase.WalletListOptions) *mWalletUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("WalletUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &WalletUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("WalletUsecaseMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &WalletUsecaseMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.listOptions = &listOptions
+	mmFindList.defaultExpectation.expectationOrigins.originListOptions = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for WalletUsecase.FindList
+func (mmFindList *mWalletUsecaseMockFindList) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetListParams) *mWalletUsecaseMockFindList {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("WalletUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &WalletUsecaseMockFindListExpectation{}
+	}
+
+	if mmFindList.defaultExpectation.params != nil {
+		mmFindList.mock.t.Fatalf("WalletUsecaseMock.FindList mock is already set by Expect")
+	}
+
+	if mmFindList.defaultExpectation.paramPtrs == nil {
+		mmFindList.defaultExpectation.paramPtrs = &WalletUsecaseMockFindListParamPtrs{}
+	}
+	mmFindList.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindList.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindList
+}
+
+// Inspect accepts an inspector function that has same arguments as the WalletUsecase.FindList
+func (mmFindList *mWalletUsecaseMockFindList) Inspect(f func(ctx context.Context, listOptions *mm_usecase.WalletListOptions, queryParams *uctypes.QueryGetListParams)) *mWalletUsecaseMockFindList {
+	if mmFindList.mock.inspectFuncFindList != nil {
+		mmFindList.mock.t.Fatalf("Inspect function is already set for WalletUsecaseMock.FindList")
+	}
+
+	mmFindList.mock.inspectFuncFindList = f
+
+	return mmFindList
+}
+
+// Return sets up results that will be returned by WalletUsecase.FindList
+func (mmFindList *mWalletUsecaseMockFindList) Return(resItems []*mm_usecase.WalletDTO, err error) *WalletUsecaseMock {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("WalletUsecaseMock.FindList mock is already set by Set")
+	}
+
+	if mmFindList.defaultExpectation == nil {
+		mmFindList.defaultExpectation = &WalletUsecaseMockFindListExpectation{mock: mmFindList.mock}
+	}
+	mmFindList.defaultExpectation.results = &WalletUsecaseMockFindListResults{resItems, err}
+	mmFindList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// Set uses given function f to mock the WalletUsecase.FindList method
+func (mmFindList *mWalletUsecaseMockFindList) Set(f func(ctx context.Context, listOptions *mm_usecase.WalletListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.WalletDTO, err error)) *WalletUsecaseMock {
+	if mmFindList.defaultExpectation != nil {
+		mmFindList.mock.t.Fatalf("Default expectation is already set for the WalletUsecase.FindList method")
+	}
+
+	if len(mmFindList.expectations) > 0 {
+		mmFindList.mock.t.Fatalf("Some expectations are already set for the WalletUsecase.FindList method")
+	}
+
+	mmFindList.mock.funcFindList = f
+	mmFindList.mock.funcFindListOrigin = minimock.CallerInfo(1)
+	return mmFindList.mock
+}
+
+// When sets expectation for the WalletUsecase.FindList which will trigger the result defined by the following
+// Then helper
+func (mmFindList *mWalletUsecaseMockFindList) When(ctx context.Context, listOptions *mm_usecase.WalletListOptions, queryParams *uctypes.QueryGetListParams) *WalletUsecaseMockFindListExpectation {
+	if mmFindList.mock.funcFindList != nil {
+		mmFindList.mock.t.Fatalf("WalletUsecaseMock.FindList mock is already set by Set")
+	}
+
+	expectation := &WalletUsecaseMockFindListExpectation{
+		mock:               mmFindList.mock,
+		params:             &WalletUsecaseMockFindListParams{ctx, listOptions, queryParams},
+		expectationOrigins: WalletUsecaseMockFindListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindList.expectations = append(mmFindList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WalletUsecase.FindList return parameters for the expectation previously defined by the When method
+func (e *WalletUsecaseMockFindListExpectation) Then(resItems []*mm_usecase.WalletDTO, err error) *WalletUsecaseMock {
+	e.results = &WalletUsecaseMockFindListResults{resItems, err}
+	return e.mock
+}
+
+// Times sets number of times WalletUsecase.FindList should be invoked
+func (mmFindList *mWalletUsecaseMockFindList) Times(n uint64) *mWalletUsecaseMockFindList {
+	if n == 0 {
+		mmFindList.mock.t.Fatalf("Times of WalletUsecaseMock.FindList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindList.expectedInvocations, n)
+	mmFindList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindList
+}
+
+func (mmFindList *mWalletUsecaseMockFindList) invocationsDone() bool {
+	if len(mmFindList.expectations) == 0 && mmFindList.defaultExpectation == nil && mmFindList.mock.funcFindList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindList.mock.afterFindListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindList implements mm_usecase.WalletUsecase
+func (mmFindList *WalletUsecaseMock) FindList(ctx context.Context, listOptions *mm_usecase.WalletListOptions, queryParams *uctypes.QueryGetListParams) (resItems []*mm_usecase.WalletDTO, err error) {
+	mm_atomic.AddUint64(&mmFindList.beforeFindListCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindList.afterFindListCounter, 1)
+
+	mmFindList.t.Helper()
+
+	if mmFindList.inspectFuncFindList != nil {
+		mmFindList.inspectFuncFindList(ctx, listOptions, queryParams)
+	}
+
+	mm_params := WalletUsecaseMockFindListParams{ctx, listOptions, queryParams}
+
+	// Record call args
+	mmFindList.FindListMock.mutex.Lock()
+	mmFindList.FindListMock.callArgs = append(mmFindList.FindListMock.callArgs, &mm_params)
+	mmFindList.FindListMock.mutex.Unlock()
+
+	for _, e := range mmFindList.FindListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resItems, e.results.err
+		}
+	}
+
+	if mmFindList.FindListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindList.FindListMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindList.FindListMock.defaultExpectation.params
+		mm_want_ptrs := mmFindList.FindListMock.defaultExpectation.paramPtrs
+
+		mm_got := WalletUsecaseMockFindListParams{ctx, listOptions, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindList.t.Errorf("WalletUsecaseMock.FindList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.listOptions != nil && !minimock.Equal(*mm_want_ptrs.listOptions, mm_got.listOptions) {
+				mmFindList.t.Errorf("WalletUsecaseMock.FindList got unexpected parameter listOptions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originListOptions, *mm_want_ptrs.listOptions, mm_got.listOptions, minimock.Diff(*mm_want_ptrs.listOptions, mm_got.listOptions))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindList.t.Errorf("WalletUsecaseMock.FindList got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindList.FindListMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindList.t.Errorf("WalletUsecaseMock.FindList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindList.FindListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindList.FindListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindList.t.Fatal("No results are set for the WalletUsecaseMock.FindList")
+		}
+		return (*mm_results).resItems, (*mm_results).err
+	}
+	if mmFindList.funcFindList != nil {
+		return mmFindList.funcFindList(ctx, listOptions, queryParams)
+	}
+	mmFindList.t.Fatalf("Unexpected call to WalletUsecaseMock.FindList. %v %v %v", ctx, listOptions, queryParams)
+	return
+}
+
+// FindListAfterCounter returns a count of finished WalletUsecaseMock.FindList invocations
+func (mmFindList *WalletUsecaseMock) FindListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.afterFindListCounter)
+}
+
+// FindListBeforeCounter returns a count of WalletUsecaseMock.FindList invocations
+func (mmFindList *WalletUsecaseMock) FindListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindList.beforeFindListCounter)
+}
+
+// Calls returns a list of arguments used in each call to WalletUsecaseMock.FindList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindList *mWalletUsecaseMockFindList) Calls() []*WalletUsecaseMockFindListParams {
+	mmFindList.mutex.RLock()
+
+	argCopy := make([]*WalletUsecaseMockFindListParams, len(mmFindList.callArgs))
+	copy(argCopy, mmFindList.callArgs)
+
+	mmFindList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindListDone returns true if the count of the FindList invocations corresponds
+// the number of defined expectations
+func (m *WalletUsecaseMock) MinimockFindListDone() bool {
+	if m.FindListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindListMock.invocationsDone()
+}
+
+// MinimockFindListInspect logs each unmet expectation
+func (m *WalletUsecaseMock) MinimockFindListInspect() {
+	for _, e := range m.FindListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WalletUsecaseMock.FindList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindListCounter := mm_atomic.LoadUint64(&m.afterFindListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindListMock.defaultExpectation != nil && afterFindListCounter < 1 {
+		if m.FindListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WalletUsecaseMock.FindList at\n%s", m.FindListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WalletUsecaseMock.FindList at\n%s with params: %#v", m.FindListMock.defaultExpectation.expectationOrigins.origin, *m.FindListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindList != nil && afterFindListCounter < 1 {
+		m.t.Errorf("Expected call to WalletUsecaseMock.FindList at\n%s", m.funcFindListOrigin)
+	}
+
+	if !m.FindListMock.invocationsDone() && afterFindListCounter > 0 {
+		m.t.Errorf("Expected %d calls to WalletUsecaseMock.FindList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindListMock.expectedInvocations), m.FindListMock.expectedInvocationsOrigin, afterFindListCounter)
+	}
+}
+
+type mWalletUsecaseMockFindOneByID struct {
+	optional           bool
+	mock               *WalletUsecaseMock
+	defaultExpectation *WalletUsecaseMockFindOneByIDExpectation
+	expectations       []*WalletUsecaseMockFindOneByIDExpectation
+
+	callArgs []*WalletUsecaseMockFindOneByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WalletUsecaseMockFindOneByIDExpectation specifies expectation struct of the WalletUsecase.FindOneByID
+type WalletUsecaseMockFindOneByIDExpectation struct {
+	mock               *WalletUsecaseMock
+	params             *WalletUsecaseMockFindOneByIDParams
+	paramPtrs          *WalletUsecaseMockFindOneByIDParamPtrs
+	expectationOrigins WalletUsecaseMockFindOneByIDExpectationOrigins
+	results            *WalletUsecaseMockFindOneByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WalletUsecaseMockFindOneByIDParams contains parameters of the WalletUsecase.FindOneByID
+type WalletUsecaseMockFindOneByIDParams struct {
+	ctx         context.Context
+	id          uuid.UUID
+	queryParams *uctypes.QueryGetOneParams
+}
+
+// WalletUsecaseMockFindOneByIDParamPtrs contains pointers to parameters of the WalletUsecase.FindOneByID
+type WalletUsecaseMockFindOneByIDParamPtrs struct {
+	ctx         *context.Context
+	id          *uuid.UUID
+	queryParams **uctypes.QueryGetOneParams
+}
+
+// WalletUsecaseMockFindOneByIDResults contains results of the WalletUsecase.FindOneByID
+type WalletUsecaseMockFindOneByIDResults struct {
+	resItem *mm_usecase.WalletDTO
+	err     error
+}
+
+// WalletUsecaseMockFindOneByIDOrigins contains origins of expectations of the WalletUsecase.FindOneByID
+type WalletUsecaseMockFindOneByIDExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originId          string
+	originQueryParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindOneByID *mWalletUsecaseMockFindOneByID) Optional() *mWalletUsecaseMockFindOneByID {
+	mmFindOneByID.optional = true
+	return mmFindOneByID
+}
+
+// Expect sets up expected params for WalletUsecase.FindOneByID
+func (mmFindOneByID *mWalletUsecaseMockFindOneByID) Expect(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *mWalletUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &WalletUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletUsecaseMock.FindOneByID mock is already set by ExpectParams functions")
+	}
+
+	mmFindOneByID.defaultExpectation.params = &WalletUsecaseMockFindOneByIDParams{ctx, id, queryParams}
+	mmFindOneByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindOneByID.expectations {
+		if minimock.Equal(e.params, mmFindOneByID.defaultExpectation.params) {
+			mmFindOneByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindOneByID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindOneByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WalletUsecase.FindOneByID
+func (mmFindOneByID *mWalletUsecaseMockFindOneByID) ExpectCtxParam1(ctx context.Context) *mWalletUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &WalletUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletUsecaseMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &WalletUsecaseMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindOneByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// ExpectIdParam2 sets up expected param id for WalletUsecase.FindOneByID
+func (mmFindOneByID *mWalletUsecaseMockFindOneByID) ExpectIdParam2(id uuid.UUID) *mWalletUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &WalletUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletUsecaseMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &WalletUsecaseMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.id = &id
+	mmFindOneByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// ExpectQueryParamsParam3 sets up expected param queryParams for WalletUsecase.FindOneByID
+func (mmFindOneByID *mWalletUsecaseMockFindOneByID) ExpectQueryParamsParam3(queryParams *uctypes.QueryGetOneParams) *mWalletUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &WalletUsecaseMockFindOneByIDExpectation{}
+	}
+
+	if mmFindOneByID.defaultExpectation.params != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletUsecaseMock.FindOneByID mock is already set by Expect")
+	}
+
+	if mmFindOneByID.defaultExpectation.paramPtrs == nil {
+		mmFindOneByID.defaultExpectation.paramPtrs = &WalletUsecaseMockFindOneByIDParamPtrs{}
+	}
+	mmFindOneByID.defaultExpectation.paramPtrs.queryParams = &queryParams
+	mmFindOneByID.defaultExpectation.expectationOrigins.originQueryParams = minimock.CallerInfo(1)
+
+	return mmFindOneByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the WalletUsecase.FindOneByID
+func (mmFindOneByID *mWalletUsecaseMockFindOneByID) Inspect(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams)) *mWalletUsecaseMockFindOneByID {
+	if mmFindOneByID.mock.inspectFuncFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("Inspect function is already set for WalletUsecaseMock.FindOneByID")
+	}
+
+	mmFindOneByID.mock.inspectFuncFindOneByID = f
+
+	return mmFindOneByID
+}
+
+// Return sets up results that will be returned by WalletUsecase.FindOneByID
+func (mmFindOneByID *mWalletUsecaseMockFindOneByID) Return(resItem *mm_usecase.WalletDTO, err error) *WalletUsecaseMock {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	if mmFindOneByID.defaultExpectation == nil {
+		mmFindOneByID.defaultExpectation = &WalletUsecaseMockFindOneByIDExpectation{mock: mmFindOneByID.mock}
+	}
+	mmFindOneByID.defaultExpectation.results = &WalletUsecaseMockFindOneByIDResults{resItem, err}
+	mmFindOneByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID.mock
+}
+
+// Set uses given function f to mock the WalletUsecase.FindOneByID method
+func (mmFindOneByID *mWalletUsecaseMockFindOneByID) Set(f func(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.WalletDTO, err error)) *WalletUsecaseMock {
+	if mmFindOneByID.defaultExpectation != nil {
+		mmFindOneByID.mock.t.Fatalf("Default expectation is already set for the WalletUsecase.FindOneByID method")
+	}
+
+	if len(mmFindOneByID.expectations) > 0 {
+		mmFindOneByID.mock.t.Fatalf("Some expectations are already set for the WalletUsecase.FindOneByID method")
+	}
+
+	mmFindOneByID.mock.funcFindOneByID = f
+	mmFindOneByID.mock.funcFindOneByIDOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID.mock
+}
+
+// When sets expectation for the WalletUsecase.FindOneByID which will trigger the result defined by the following
+// Then helper
+func (mmFindOneByID *mWalletUsecaseMockFindOneByID) When(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) *WalletUsecaseMockFindOneByIDExpectation {
+	if mmFindOneByID.mock.funcFindOneByID != nil {
+		mmFindOneByID.mock.t.Fatalf("WalletUsecaseMock.FindOneByID mock is already set by Set")
+	}
+
+	expectation := &WalletUsecaseMockFindOneByIDExpectation{
+		mock:               mmFindOneByID.mock,
+		params:             &WalletUsecaseMockFindOneByIDParams{ctx, id, queryParams},
+		expectationOrigins: WalletUsecaseMockFindOneByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindOneByID.expectations = append(mmFindOneByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WalletUsecase.FindOneByID return parameters for the expectation previously defined by the When method
+func (e *WalletUsecaseMockFindOneByIDExpectation) Then(resItem *mm_usecase.WalletDTO, err error) *WalletUsecaseMock {
+	e.results = &WalletUsecaseMockFindOneByIDResults{resItem, err}
+	return e.mock
+}
+
+// Times sets number of times WalletUsecase.FindOneByID should be invoked
+func (mmFindOneByID *mWalletUsecaseMockFindOneByID) Times(n uint64) *mWalletUsecaseMockFindOneByID {
+	if n == 0 {
+		mmFindOneByID.mock.t.Fatalf("Times of WalletUsecaseMock.FindOneByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindOneByID.expectedInvocations, n)
+	mmFindOneByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindOneByID
+}
+
+func (mmFindOneByID *mWalletUsecaseMockFindOneByID) invocationsDone() bool {
+	if len(mmFindOneByID.expectations) == 0 && mmFindOneByID.defaultExpectation == nil && mmFindOneByID.mock.funcFindOneByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindOneByID.mock.afterFindOneByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindOneByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindOneByID implements mm_usecase.WalletUsecase
+func (mmFindOneByID *WalletUsecaseMock) FindOneByID(ctx context.Context, id uuid.UUID, queryParams *uctypes.QueryGetOneParams) (resItem *mm_usecase.WalletDTO, err error) {
+	mm_atomic.AddUint64(&mmFindOneByID.beforeFindOneByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindOneByID.afterFindOneByIDCounter, 1)
+
+	mmFindOneByID.t.Helper()
+
+	if mmFindOneByID.inspectFuncFindOneByID != nil {
+		mmFindOneByID.inspectFuncFindOneByID(ctx, id, queryParams)
+	}
+
+	mm_params := WalletUsecaseMockFindOneByIDParams{ctx, id, queryParams}
+
+	// Record call args
+	mmFindOneByID.FindOneByIDMock.mutex.Lock()
+	mmFindOneByID.FindOneByIDMock.callArgs = append(mmFindOneByID.FindOneByIDMock.callArgs, &mm_params)
+	mmFindOneByID.FindOneByIDMock.mutex.Unlock()
+
+	for _, e := range mmFindOneByID.FindOneByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resItem, e.results.err
+		}
+	}
+
+	if mmFindOneByID.FindOneByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindOneByID.FindOneByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindOneByID.FindOneByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindOneByID.FindOneByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := WalletUsecaseMockFindOneByIDParams{ctx, id, queryParams}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindOneByID.t.Errorf("WalletUsecaseMock.FindOneByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmFindOneByID.t.Errorf("WalletUsecaseMock.FindOneByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.queryParams != nil && !minimock.Equal(*mm_want_ptrs.queryParams, mm_got.queryParams) {
+				mmFindOneByID.t.Errorf("WalletUsecaseMock.FindOneByID got unexpected parameter queryParams, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.originQueryParams, *mm_want_ptrs.queryParams, mm_got.queryParams, minimock.Diff(*mm_want_ptrs.queryParams, mm_got.queryParams))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindOneByID.t.Errorf("WalletUsecaseMock.FindOneByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindOneByID.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindOneByID.FindOneByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindOneByID.t.Fatal("No results are set for the WalletUsecaseMock.FindOneByID")
+		}
+		return (*mm_results).resItem, (*mm_results).err
+	}
+	if mmFindOneByID.funcFindOneByID != nil {
+		return mmFindOneByID.funcFindOneByID(ctx, id, queryParams)
+	}
+	mmFindOneByID.t.Fatalf("Unexpected call to WalletUsecaseMock.FindOneByID. %v %v %v", ctx, id, queryParams)
+	return
+}
+
+// FindOneByIDAfterCounter returns a count of finished WalletUsecaseMock.FindOneByID invocations
+func (mmFindOneByID *WalletUsecaseMock) FindOneByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByID.afterFindOneByIDCounter)
+}
+
+// FindOneByIDBeforeCounter returns a count of WalletUsecaseMock.FindOneByID invocations
+func (mmFindOneByID *WalletUsecaseMock) FindOneByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindOneByID.beforeFindOneByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to WalletUsecaseMock.FindOneByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindOneByID *mWalletUsecaseMockFindOneByID) Calls() []*WalletUsecaseMockFindOneByIDParams {
+	mmFindOneByID.mutex.RLock()
+
+	argCopy := make([]*WalletUsecaseMockFindOneByIDParams, len(mmFindOneByID.callArgs))
+	copy(argCopy, mmFindOneByID.callArgs)
+
+	mmFindOneByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindOneByIDDone returns true if the count of the FindOneByID invocations corresponds
+// the number of defined expectations
+func (m *WalletUsecaseMock) MinimockFindOneByIDDone() bool {
+	if m.FindOneByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindOneByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindOneByIDMock.invocationsDone()
+}
+
+// MinimockFindOneByIDInspect logs each unmet expectation
+func (m *WalletUsecaseMock) MinimockFindOneByIDInspect() {
+	for _, e := range m.FindOneByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WalletUsecaseMock.FindOneByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindOneByIDCounter := mm_atomic.LoadUint64(&m.afterFindOneByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindOneByIDMock.defaultExpectation != nil && afterFindOneByIDCounter < 1 {
+		if m.FindOneByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WalletUsecaseMock.FindOneByID at\n%s", m.FindOneByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WalletUsecaseMock.FindOneByID at\n%s with params: %#v", m.FindOneByIDMock.defaultExpectation.expectationOrigins.origin, *m.FindOneByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindOneByID != nil && afterFindOneByIDCounter < 1 {
+		m.t.Errorf("Expected call to WalletUsecaseMock.FindOneByID at\n%s", m.funcFindOneByIDOrigin)
+	}
+
+	if !m.FindOneByIDMock.invocationsDone() && afterFindOneByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to WalletUsecaseMock.FindOneByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindOneByIDMock.expectedInvocations), m.FindOneByIDMock.expectedInvocationsOrigin, afterFindOneByIDCounter)
+	}
+}
+
+type mWalletUsecaseMockGetWalletBalances struct {
+	optional           bool
+	mock               *WalletUsecaseMock
+	defaultExpectation *WalletUsecaseMockGetWalletBalancesExpectation
+	expectations       []*WalletUsecaseMockGetWalletBalancesExpectation
+
+	callArgs []*WalletUsecaseMockGetWalletBalancesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WalletUsecaseMockGetWalletBalancesExpectation specifies expectation struct of the WalletUsecase.GetWalletBalances
+type WalletUsecaseMockGetWalletBalancesExpectation struct {
+	mock               *WalletUsecaseMock
+	params             *WalletUsecaseMockGetWalletBalancesParams
+	paramPtrs          *WalletUsecaseMockGetWalletBalancesParamPtrs
+	expectationOrigins WalletUsecaseMockGetWalletBalancesExpectationOrigins
+	results            *WalletUsecaseMockGetWalletBalancesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WalletUsecaseMockGetWalletBalancesParams contains parameters of the WalletUsecase.GetWalletBalances
+type WalletUsecaseMockGetWalletBalancesParams struct {
+	ctx         context.Context
+	queryFilter mm_usecase.CountWalletBalancesQueryFilter
+}
+
+// WalletUsecaseMockGetWalletBalancesParamPtrs contains pointers to parameters of the WalletUsecase.GetWalletBalances
+type WalletUsecaseMockGetWalletBalancesParamPtrs struct {
+	ctx         *context.Context
+	queryFilter *mm_usecase.CountWalletBalancesQueryFilter
+}
+
+// WalletUsecaseMockGetWalletBalancesResults contains results of the WalletUsecase.GetWalletBalances
+type WalletUsecaseMockGetWalletBalancesResults struct {
+	resItems []*mm_usecase.WalletBalanceDTO
+	err      error
+}
+
+// WalletUsecaseMockGetWalletBalancesOrigins contains origins of expectations of the WalletUsecase.GetWalletBalances
+type WalletUsecaseMockGetWalletBalancesExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originQueryFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetWalletBalances *mWalletUsecaseMockGetWalletBalances) Optional() *mWalletUsecaseMockGetWalletBalances {
+	mmGetWalletBalances.optional = true
+	return mmGetWalletBalances
+}
+
+// Expect sets up expected params for WalletUsecase.GetWalletBalances
+func (mmGetWalletBalances *mWalletUsecaseMockGetWalletBalances) Expect(ctx context.Context, queryFilter mm_usecase.CountWalletBalancesQueryFilter) *mWalletUsecaseMockGetWalletBalances {
+	if mmGetWalletBalances.mock.funcGetWalletBalances != nil {
+		mmGetWalletBalances.mock.t.Fatalf("WalletUsecaseMock.GetWalletBalances mock is already set by Set")
+	}
+
+	if mmGetWalletBalances.defaultExpectation == nil {
+		mmGetWalletBalances.defaultExpectation = &WalletUsecaseMockGetWalletBalancesExpectation{}
+	}
+
+	if mmGetWalletBalances.defaultExpectation.paramPtrs != nil {
+		mmGetWalletBalances.mock.t.Fatalf("WalletUsecaseMock.GetWalletBalances mock is already set by ExpectParams functions")
+	}
+
+	mmGetWalletBalances.defaultExpectation.params = &WalletUsecaseMockGetWalletBalancesParams{ctx, queryFilter}
+	mmGetWalletBalances.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetWalletBalances.expectations {
+		if minimock.Equal(e.params, mmGetWalletBalances.defaultExpectation.params) {
+			mmGetWalletBalances.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetWalletBalances.defaultExpectation.params)
+		}
+	}
+
+	return mmGetWalletBalances
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WalletUsecase.GetWalletBalances
+func (mmGetWalletBalances *mWalletUsecaseMockGetWalletBalances) ExpectCtxParam1(ctx context.Context) *mWalletUsecaseMockGetWalletBalances {
+	if mmGetWalletBalances.mock.funcGetWalletBalances != nil {
+		mmGetWalletBalances.mock.t.Fatalf("WalletUsecaseMock.GetWalletBalances mock is already set by Set")
+	}
+
+	if mmGetWalletBalances.defaultExpectation == nil {
+		mmGetWalletBalances.defaultExpectation = &WalletUsecaseMockGetWalletBalancesExpectation{}
+	}
+
+	if mmGetWalletBalances.defaultExpectation.params != nil {
+		mmGetWalletBalances.mock.t.Fatalf("WalletUsecaseMock.GetWalletBalances mock is already set by Expect")
+	}
+
+	if mmGetWalletBalances.defaultExpectation.paramPtrs == nil {
+		mmGetWalletBalances.defaultExpectation.paramPtrs = &WalletUsecaseMockGetWalletBalancesParamPtrs{}
+	}
+	mmGetWalletBalances.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetWalletBalances.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetWalletBalances
+}
+
+// ExpectQueryFilterParam2 sets up expected param queryFilter for WalletUsecase.GetWalletBalances
+func (mmGetWalletBalances *mWalletUsecaseMockGetWalletBalances) ExpectQueryFilterParam2(queryFilter mm_usecase.CountWalletBalancesQueryFilter) *mWalletUsecaseMockGetWalletBalances {
+	if mmGetWalletBalances.mock.funcGetWalletBalances != nil {
+		mmGetWalletBalances.mock.t.Fatalf("WalletUsecaseMock.GetWalletBalances mock is already set by Set")
+	}
+
+	if mmGetWalletBalances.defaultExpectation == nil {
+		mmGetWalletBalances.defaultExpectation = &WalletUsecaseMockGetWalletBalancesExpectation{}
+	}
+
+	if mmGetWalletBalances.defaultExpectation.params != nil {
+		mmGetWalletBalances.mock.t.Fatalf("WalletUsecaseMock.GetWalletBalances mock is already set by Expect")
+	}
+
+	if mmGetWalletBalances.defaultExpectation.paramPtrs == nil {
+		mmGetWalletBalances.defaultExpectation.paramPtrs = &WalletUsecaseMockGetWalletBalancesParamPtrs{}
+	}
+	mmGetWalletBalances.defaultExpectation.paramPtrs.queryFilter = &queryFilter
+	mmGetWalletBalances.defaultExpectation.expectationOrigins.originQueryFilter = minimock.CallerInfo(1)
+
+	return mmGetWalletBalances
+}
+
+// Inspect accepts an inspector function that has same arguments as the WalletUsecase.GetWalletBalances
+func (mmGetWalletBalances *mWalletUsecaseMockGetWalletBalances) Inspect(f func(ctx context.Context, queryFilter mm_usecase.CountWalletBalancesQueryFilter)) *mWalletUsecaseMockGetWalletBalances {
+	if mmGetWalletBalances.mock.inspectFuncGetWalletBalances != nil {
+		mmGetWalletBalances.mock.t.Fatalf("Inspect function is already set for WalletUsecaseMock.GetWalletBalances")
+	}
+
+	mmGetWalletBalances.mock.inspectFuncGetWalletBalances = f
+
+	return mmGetWalletBalances
+}
+
+// Return sets up results that will be returned by WalletUsecase.GetWalletBalances
+func (mmGetWalletBalances *mWalletUsecaseMockGetWalletBalances) Return(resItems []*mm_usecase.WalletBalanceDTO, err error) *WalletUsecaseMock {
+	if mmGetWalletBalances.mock.funcGetWalletBalances != nil {
+		mmGetWalletBalances.mock.t.Fatalf("WalletUsecaseMock.GetWalletBalances mock is already set by Set")
+	}
+
+	if mmGetWalletBalances.defaultExpectation == nil {
+		mmGetWalletBalances.defaultExpectation = &WalletUsecaseMockGetWalletBalancesExpectation{mock: mmGetWalletBalances.mock}
+	}
+	mmGetWalletBalances.defaultExpectation.results = &WalletUsecaseMockGetWalletBalancesResults{resItems, err}
+	mmGetWalletBalances.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetWalletBalances.mock
+}
+
+// Set uses given function f to mock the WalletUsecase.GetWalletBalances method
+func (mmGetWalletBalances *mWalletUsecaseMockGetWalletBalances) Set(f func(ctx context.Context, queryFilter mm_usecase.CountWalletBalancesQueryFilter) (resItems []*mm_usecase.WalletBalanceDTO, err error)) *WalletUsecaseMock {
+	if mmGetWalletBalances.defaultExpectation != nil {
+		mmGetWalletBalances.mock.t.Fatalf("Default expectation is already set for the WalletUsecase.GetWalletBalances method")
+	}
+
+	if len(mmGetWalletBalances.expectations) > 0 {
+		mmGetWalletBalances.mock.t.Fatalf("Some expectations are already set for the WalletUsecase.GetWalletBalances method")
+	}
+
+	mmGetWalletBalances.mock.funcGetWalletBalances = f
+	mmGetWalletBalances.mock.funcGetWalletBalancesOrigin = minimock.CallerInfo(1)
+	return mmGetWalletBalances.mock
+}
+
+// When sets expectation for the WalletUsecase.GetWalletBalances which will trigger the result defined by the following
+// Then helper
+func (mmGetWalletBalances *mWalletUsecaseMockGetWalletBalances) When(ctx context.Context, queryFilter mm_usecase.CountWalletBalancesQueryFilter) *WalletUsecaseMockGetWalletBalancesExpectation {
+	if mmGetWalletBalances.mock.funcGetWalletBalances != nil {
+		mmGetWalletBalances.mock.t.Fatalf("WalletUsecaseMock.GetWalletBalances mock is already set by Set")
+	}
+
+	expectation := &WalletUsecaseMockGetWalletBalancesExpectation{
+		mock:               mmGetWalletBalances.mock,
+		params:             &WalletUsecaseMockGetWalletBalancesParams{ctx, queryFilter},
+		expectationOrigins: WalletUsecaseMockGetWalletBalancesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetWalletBalances.expectations = append(mmGetWalletBalances.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WalletUsecase.GetWalletBalances return parameters for the expectation previously defined by the When method
+func (e *WalletUsecaseMockGetWalletBalancesExpectation) Then(resItems []*mm_usecase.WalletBalanceDTO, err error) *WalletUsecaseMock {
+	e.results = &WalletUsecaseMockGetWalletBalancesResults{resItems, err}
+	return e.mock
+}
+
+// Times sets number of times WalletUsecase.GetWalletBalances should be invoked
+func (mmGetWalletBalances *mWalletUsecaseMockGetWalletBalances) Times(n uint64) *mWalletUsecaseMockGetWalletBalances {
+	if n == 0 {
+		mmGetWalletBalances.mock.t.Fatalf("Times of WalletUsecaseMock.GetWalletBalances mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetWalletBalances.expectedInvocations, n)
+	mmGetWalletBalances.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetWalletBalances
+}
+
+func (mmGetWalletBalances *mWalletUsecaseMockGetWalletBalances) invocationsDone() bool {
+	if len(mmGetWalletBalances.expectations) == 0 && mmGetWalletBalances.defaultExpectation == nil && mmGetWalletBalances.mock.funcGetWalletBalances == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetWalletBalances.mock.afterGetWalletBalancesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetWalletBalances.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetWalletBalances implements mm_usecase.WalletUsecase
+func (mmGetWalletBalances *WalletUsecaseMock) GetWalletBalances(ctx context.Context, queryFilter mm_usecase.CountWalletBalancesQueryFilter) (resItems []*mm_usecase.WalletBalanceDTO, err error) {
+	mm_atomic.AddUint64(&mmGetWalletBalances.beforeGetWalletBalancesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetWalletBalances.afterGetWalletBalancesCounter, 1)
+
+	mmGetWalletBalances.t.Helper()
+
+	if mmGetWalletBalances.inspectFuncGetWalletBalances != nil {
+		mmGetWalletBalances.inspectFuncGetWalletBalances(ctx, queryFilter)
+	}
+
+	mm_params := WalletUsecaseMockGetWalletBalancesParams{ctx, queryFilter}
+
+	// Record call args
+	mmGetWalletBalances.GetWalletBalancesMock.mutex.Lock()
+	mmGetWalletBalances.GetWalletBalancesMock.callArgs = append(mmGetWalletBalances.GetWalletBalancesMock.callArgs, &mm_params)
+	mmGetWalletBalances.GetWalletBalancesMock.mutex.Unlock()
+
+	for _, e := range mmGetWalletBalances.GetWalletBalancesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resItems, e.results.err
+		}
+	}
+
+	if mmGetWalletBalances.GetWalletBalancesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetWalletBalances.GetWalletBalancesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetWalletBalances.GetWalletBalancesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetWalletBalances.GetWalletBalancesMock.defaultExpectation.paramPtrs
+
+		mm_got := WalletUsecaseMockGetWalletBalancesParams{ctx, queryFilter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetWalletBalances.t.Errorf("WalletUsecaseMock.GetWalletBalances got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetWalletBalances.GetWalletBalancesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.queryFilter != nil && !minimock.Equal(*mm_want_ptrs.queryFilter, mm_got.queryFilter) {
+				mmGetWalletBalances.t.Errorf("WalletUsecaseMock.GetWalletBalances got unexpected parameter queryFilter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetWalletBalances.GetWalletBalancesMock.defaultExpectation.expectationOrigins.originQueryFilter, *mm_want_ptrs.queryFilter, mm_got.queryFilter, minimock.Diff(*mm_want_ptrs.queryFilter, mm_got.queryFilter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetWalletBalances.t.Errorf("WalletUsecaseMock.GetWalletBalances got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetWalletBalances.GetWalletBalancesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetWalletBalances.GetWalletBalancesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetWalletBalances.t.Fatal("No results are set for the WalletUsecaseMock.GetWalletBalances")
+		}
+		return (*mm_results).resItems, (*mm_results).err
+	}
+	if mmGetWalletBalances.funcGetWalletBalances != nil {
+		return mmGetWalletBalances.funcGetWalletBalances(ctx, queryFilter)
+	}
+	mmGetWalletBalances.t.Fatalf("Unexpected call to WalletUsecaseMock.GetWalletBalances. %v %v", ctx, queryFilter)
+	return
+}
+
+// GetWalletBalancesAfterCounter returns a count of finished WalletUsecaseMock.GetWalletBalances invocations
+func (mmGetWalletBalances *WalletUsecaseMock) GetWalletBalancesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetWalletBalances.afterGetWalletBalancesCounter)
+}
+
+// GetWalletBalancesBeforeCounter returns a count of WalletUsecaseMock.GetWalletBalances invocations
+func (mmGetWalletBalances *WalletUsecaseMock) GetWalletBalancesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetWalletBalances.beforeGetWalletBalancesCounter)
+}
+
+// Calls returns a list of arguments used in each call to WalletUsecaseMock.GetWalletBalances.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetWalletBalances *mWalletUsecaseMockGetWalletBalances) Calls() []*WalletUsecaseMockGetWalletBalancesParams {
+	mmGetWalletBalances.mutex.RLock()
+
+	argCopy := make([]*WalletUsecaseMockGetWalletBalancesParams, len(mmGetWalletBalances.callArgs))
+	copy(argCopy, mmGetWalletBalances.callArgs)
+
+	mmGetWalletBalances.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetWalletBalancesDone returns true if the count of the GetWalletBalances invocations corresponds
+// the number of defined expectations
+func (m *WalletUsecaseMock) MinimockGetWalletBalancesDone() bool {
+	if m.GetWalletBalancesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetWalletBalancesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetWalletBalancesMock.invocationsDone()
+}
+
+// MinimockGetWalletBalancesInspect logs each unmet expectation
+func (m *WalletUsecaseMock) MinimockGetWalletBalancesInspect() {
+	for _, e := range m.GetWalletBalancesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WalletUsecaseMock.GetWalletBalances at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetWalletBalancesCounter := mm_atomic.LoadUint64(&m.afterGetWalletBalancesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetWalletBalancesMock.defaultExpectation != nil && afterGetWalletBalancesCounter < 1 {
+		if m.GetWalletBalancesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WalletUsecaseMock.GetWalletBalances at\n%s", m.GetWalletBalancesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WalletUsecaseMock.GetWalletBalances at\n%s with params: %#v", m.GetWalletBalancesMock.defaultExpectation.expectationOrigins.origin, *m.GetWalletBalancesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetWalletBalances != nil && afterGetWalletBalancesCounter < 1 {
+		m.t.Errorf("Expected call to WalletUsecaseMock.GetWalletBalances at\n%s", m.funcGetWalletBalancesOrigin)
+	}
+
+	if !m.GetWalletBalancesMock.invocationsDone() && afterGetWalletBalancesCounter > 0 {
+		m.t.Errorf("Expected %d calls to WalletUsecaseMock.GetWalletBalances at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetWalletBalancesMock.expectedInvocations), m.GetWalletBalancesMock.expectedInvocationsOrigin, afterGetWalletBalancesCounter)
+	}
+}
+
+type mWalletUsecaseMockPatchWalletByDTO struct {
+	optional           bool
+	mock               *WalletUsecaseMock
+	defaultExpectation *WalletUsecaseMockPatchWalletByDTOExpectation
+	expectations       []*WalletUsecaseMockPatchWalletByDTOExpectation
+
+	callArgs []*WalletUsecaseMockPatchWalletByDTOParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WalletUsecaseMockPatchWalletByDTOExpectation specifies expectation struct of the WalletUsecase.PatchWalletByDTO
+type WalletUsecaseMockPatchWalletByDTOExpectation struct {
+	mock               *WalletUsecaseMock
+	params             *WalletUsecaseMockPatchWalletByDTOParams
+	paramPtrs          *WalletUsecaseMockPatchWalletByDTOParamPtrs
+	expectationOrigins WalletUsecaseMockPatchWalletByDTOExpectationOrigins
+	results            *WalletUsecaseMockPatchWalletByDTOResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WalletUsecaseMockPatchWalletByDTOParams contains parameters of the WalletUsecase.PatchWalletByDTO
+type WalletUsecaseMockPatchWalletByDTOParams struct {
+	ctx              context.Context
+	id               uuid.UUID
+	in               mm_usecase.PatchWalletDataInput
+	skipVersionCheck bool
+}
+
+// WalletUsecaseMockPatchWalletByDTOParamPtrs contains pointers to parameters of the WalletUsecase.PatchWalletByDTO
+type WalletUsecaseMockPatchWalletByDTOParamPtrs struct {
+	ctx              *context.Context
+	id               *uuid.UUID
+	in               *mm_usecase.PatchWalletDataInput
+	skipVersionCheck *bool
+}
+
+// WalletUsecaseMockPatchWalletByDTOResults contains results of the WalletUsecase.PatchWalletByDTO
+type WalletUsecaseMockPatchWalletByDTOResults struct {
+	err error
+}
+
+// WalletUsecaseMockPatchWalletByDTOOrigins contains origins of expectations of the WalletUsecase.PatchWalletByDTO
+type WalletUsecaseMockPatchWalletByDTOExpectationOrigins struct {
+	origin                 string
+	originCtx              string
+	originId               string
+	originIn               string
+	originSkipVersionCheck string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPatchWalletByDTO *mWalletUsecaseMockPatchWalletByDTO) Optional() *mWalletUsecaseMockPatchWalletByDTO {
+	mmPatchWalletByDTO.optional = true
+	return mmPatchWalletByDTO
+}
+
+// Expect sets up expected params for WalletUsecase.PatchWalletByDTO
+func (mmPatchWalletByDTO *mWalletUsecaseMockPatchWalletByDTO) Expect(ctx context.Context, id uuid.UUID, in mm_usecase.PatchWalletDataInput, skipVersionCheck bool) *mWalletUsecaseMockPatchWalletByDTO {
+	if mmPatchWalletByDTO.mock.funcPatchWalletByDTO != nil {
+		mmPatchWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.PatchWalletByDTO mock is already set by Set")
+	}
+
+	if mmPatchWalletByDTO.defaultExpectation == nil {
+		mmPatchWalletByDTO.defaultExpectation = &WalletUsecaseMockPatchWalletByDTOExpectation{}
+	}
+
+	if mmPatchWalletByDTO.defaultExpectation.paramPtrs != nil {
+		mmPatchWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.PatchWalletByDTO mock is already set by ExpectParams functions")
+	}
+
+	mmPatchWalletByDTO.defaultExpectation.params = &WalletUsecaseMockPatchWalletByDTOParams{ctx, id, in, skipVersionCheck}
+	mmPatchWalletByDTO.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPatchWalletByDTO.expectations {
+		if minimock.Equal(e.params, mmPatchWalletByDTO.defaultExpectation.params) {
+			mmPatchWalletByDTO.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPatchWalletByDTO.defaultExpectation.params)
+		}
+	}
+
+	return mmPatchWalletByDTO
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WalletUsecase.PatchWalletByDTO
+func (mmPatchWalletByDTO *mWalletUsecaseMockPatchWalletByDTO) ExpectCtxParam1(ctx context.Context) *mWalletUsecaseMockPatchWalletByDTO {
+	if mmPatchWalletByDTO.mock.funcPatchWalletByDTO != nil {
+		mmPatchWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.PatchWalletByDTO mock is already set by Set")
+	}
+
+	if mmPatchWalletByDTO.defaultExpectation == nil {
+		mmPatchWalletByDTO.defaultExpectation = &WalletUsecaseMockPatchWalletByDTOExpectation{}
+	}
+
+	if mmPatchWalletByDTO.defaultExpectation.params != nil {
+		mmPatchWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.PatchWalletByDTO mock is already set by Expect")
+	}
+
+	if mmPatchWalletByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchWalletByDTO.defaultExpectation.paramPtrs = &WalletUsecaseMockPatchWalletByDTOParamPtrs{}
+	}
+	mmPatchWalletByDTO.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPatchWalletByDTO.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPatchWalletByDTO
+}
+
+// ExpectIdParam2 sets up expected param id for WalletUsecase.PatchWalletByDTO
+func (mmPatchWalletByDTO *mWalletUsecaseMockPatchWalletByDTO) ExpectIdParam2(id uuid.UUID) *mWalletUsecaseMockPatchWalletByDTO {
+	if mmPatchWalletByDTO.mock.funcPatchWalletByDTO != nil {
+		mmPatchWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.PatchWalletByDTO mock is already set by Set")
+	}
+
+	if mmPatchWalletByDTO.defaultExpectation == nil {
+		mmPatchWalletByDTO.defaultExpectation = &WalletUsecaseMockPatchWalletByDTOExpectation{}
+	}
+
+	if mmPatchWalletByDTO.defaultExpectation.params != nil {
+		mmPatchWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.PatchWalletByDTO mock is already set by Expect")
+	}
+
+	if mmPatchWalletByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchWalletByDTO.defaultExpectation.paramPtrs = &WalletUsecaseMockPatchWalletByDTOParamPtrs{}
+	}
+	mmPatchWalletByDTO.defaultExpectation.paramPtrs.id = &id
+	mmPatchWalletByDTO.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmPatchWalletByDTO
+}
+
+// ExpectInParam3 sets up expected param in for WalletUsecase.PatchWalletByDTO
+func (mmPatchWalletByDTO *mWalletUsecaseMockPatchWalletByDTO) ExpectInParam3(in mm_usecase.PatchWalletDataInput) *mWalletUsecaseMockPatchWalletByDTO {
+	if mmPatchWalletByDTO.mock.funcPatchWalletByDTO != nil {
+		mmPatchWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.PatchWalletByDTO mock is already set by Set")
+	}
+
+	if mmPatchWalletByDTO.defaultExpectation == nil {
+		mmPatchWalletByDTO.defaultExpectation = &WalletUsecaseMockPatchWalletByDTOExpectation{}
+	}
+
+	if mmPatchWalletByDTO.defaultExpectation.params != nil {
+		mmPatchWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.PatchWalletByDTO mock is already set by Expect")
+	}
+
+	if mmPatchWalletByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchWalletByDTO.defaultExpectation.paramPtrs = &WalletUsecaseMockPatchWalletByDTOParamPtrs{}
+	}
+	mmPatchWalletByDTO.defaultExpectation.paramPtrs.in = &in
+	mmPatchWalletByDTO.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmPatchWalletByDTO
+}
+
+// ExpectSkipVersionCheckParam4 sets up expected param skipVersionCheck for WalletUsecase.PatchWalletByDTO
+func (mmPatchWalletByDTO *mWalletUsecaseMockPatchWalletByDTO) ExpectSkipVersionCheckParam4(skipVersionCheck bool) *mWalletUsecaseMockPatchWalletByDTO {
+	if mmPatchWalletByDTO.mock.funcPatchWalletByDTO != nil {
+		mmPatchWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.PatchWalletByDTO mock is already set by Set")
+	}
+
+	if mmPatchWalletByDTO.defaultExpectation == nil {
+		mmPatchWalletByDTO.defaultExpectation = &WalletUsecaseMockPatchWalletByDTOExpectation{}
+	}
+
+	if mmPatchWalletByDTO.defaultExpectation.params != nil {
+		mmPatchWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.PatchWalletByDTO mock is already set by Expect")
+	}
+
+	if mmPatchWalletByDTO.defaultExpectation.paramPtrs == nil {
+		mmPatchWalletByDTO.defaultExpectation.paramPtrs = &WalletUsecaseMockPatchWalletByDTOParamPtrs{}
+	}
+	mmPatchWalletByDTO.defaultExpectation.paramPtrs.skipVersionCheck = &skipVersionCheck
+	mmPatchWalletByDTO.defaultExpectation.expectationOrigins.originSkipVersionCheck = minimock.CallerInfo(1)
+
+	return mmPatchWalletByDTO
+}
+
+// Inspect accepts an inspector function that has same arguments as the WalletUsecase.PatchWalletByDTO
+func (mmPatchWalletByDTO *mWalletUsecaseMockPatchWalletByDTO) Inspect(f func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchWalletDataInput, skipVersionCheck bool)) *mWalletUsecaseMockPatchWalletByDTO {
+	if mmPatchWalletByDTO.mock.inspectFuncPatchWalletByDTO != nil {
+		mmPatchWalletByDTO.mock.t.Fatalf("Inspect function is already set for WalletUsecaseMock.PatchWalletByDTO")
+	}
+
+	mmPatchWalletByDTO.mock.inspectFuncPatchWalletByDTO = f
+
+	return mmPatchWalletByDTO
+}
+
+// Return sets up results that will be returned by WalletUsecase.PatchWalletByDTO
+func (mmPatchWalletByDTO *mWalletUsecaseMockPatchWalletByDTO) Return(err error) *WalletUsecaseMock {
+	if mmPatchWalletByDTO.mock.funcPatchWalletByDTO != nil {
+		mmPatchWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.PatchWalletByDTO mock is already set by Set")
+	}
+
+	if mmPatchWalletByDTO.defaultExpectation == nil {
+		mmPatchWalletByDTO.defaultExpectation = &WalletUsecaseMockPatchWalletByDTOExpectation{mock: mmPatchWalletByDTO.mock}
+	}
+	mmPatchWalletByDTO.defaultExpectation.results = &WalletUsecaseMockPatchWalletByDTOResults{err}
+	mmPatchWalletByDTO.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPatchWalletByDTO.mock
+}
+
+// Set uses given function f to mock the WalletUsecase.PatchWalletByDTO method
+func (mmPatchWalletByDTO *mWalletUsecaseMockPatchWalletByDTO) Set(f func(ctx context.Context, id uuid.UUID, in mm_usecase.PatchWalletDataInput, skipVersionCheck bool) (err error)) *WalletUsecaseMock {
+	if mmPatchWalletByDTO.defaultExpectation != nil {
+		mmPatchWalletByDTO.mock.t.Fatalf("Default expectation is already set for the WalletUsecase.PatchWalletByDTO method")
+	}
+
+	if len(mmPatchWalletByDTO.expectations) > 0 {
+		mmPatchWalletByDTO.mock.t.Fatalf("Some expectations are already set for the WalletUsecase.PatchWalletByDTO method")
+	}
+
+	mmPatchWalletByDTO.mock.funcPatchWalletByDTO = f
+	mmPatchWalletByDTO.mock.funcPatchWalletByDTOOrigin = minimock.CallerInfo(1)
+	return mmPatchWalletByDTO.mock
+}
+
+// When sets expectation for the WalletUsecase.PatchWalletByDTO which will trigger the result defined by the following
+// Then helper
+func (mmPatchWalletByDTO *mWalletUsecaseMockPatchWalletByDTO) When(ctx context.Context, id uuid.UUID, in mm_usecase.PatchWalletDataInput, skipVersionCheck bool) *WalletUsecaseMockPatchWalletByDTOExpectation {
+	if mmPatchWalletByDTO.mock.funcPatchWalletByDTO != nil {
+		mmPatchWalletByDTO.mock.t.Fatalf("WalletUsecaseMock.PatchWalletByDTO mock is already set by Set")
+	}
+
+	expectation := &WalletUsecaseMockPatchWalletByDTOExpectation{
+		mock:               mmPatchWalletByDTO.mock,
+		params:             &WalletUsecaseMockPatchWalletByDTOParams{ctx, id, in, skipVersionCheck},
+		expectationOrigins: WalletUsecaseMockPatchWalletByDTOExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPatchWalletByDTO.expectations = append(mmPatchWalletByDTO.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WalletUsecase.PatchWalletByDTO return parameters for the expectation previously defined by the When method
+func (e *WalletUsecaseMockPatchWalletByDTOExpectation) Then(err error) *WalletUsecaseMock {
+	e.results = &WalletUsecaseMockPatchWalletByDTOResults{err}
+	return e.mock
+}
+
+// Times sets number of times WalletUsecase.PatchWalletByDTO should be invoked
+func (mmPatchWalletByDTO *mWalletUsecaseMockPatchWalletByDTO) Times(n uint64) *mWalletUsecaseMockPatchWalletByDTO {
+	if n == 0 {
+		mmPatchWalletByDTO.mock.t.Fatalf("Times of WalletUsecaseMock.PatchWalletByDTO mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPatchWalletByDTO.expectedInvocations, n)
+	mmPatchWalletByDTO.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPatchWalletByDTO
+}
+
+func (mmPatchWalletByDTO *mWalletUsecaseMockPatchWalletByDTO) invocationsDone() bool {
+	if len(mmPatchWalletByDTO.expectations) == 0 && mmPatchWalletByDTO.defaultExpectation == nil && mmPatchWalletByDTO.mock.funcPatchWalletByDTO == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPatchWalletByDTO.mock.afterPatchWalletByDTOCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPatchWalletByDTO.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PatchWalletByDTO implements mm_usecase.WalletUsecase
+func (mmPatchWalletByDTO *WalletUsecaseMock) PatchWalletByDTO(ctx context.Context, id uuid.UUID, in mm_usecase.PatchWalletDataInput, skipVersionCheck bool) (err error) {
+	mm_atomic.AddUint64(&mmPatchWalletByDTO.beforePatchWalletByDTOCounter, 1)
+	defer mm_atomic.AddUint64(&mmPatchWalletByDTO.afterPatchWalletByDTOCounter, 1)
+
+	mmPatchWalletByDTO.t.Helper()
+
+	if mmPatchWalletByDTO.inspectFuncPatchWalletByDTO != nil {
+		mmPatchWalletByDTO.inspectFuncPatchWalletByDTO(ctx, id, in, skipVersionCheck)
+	}
+
+	mm_params := WalletUsecaseMockPatchWalletByDTOParams{ctx, id, in, skipVersionCheck}
+
+	// Record call args
+	mmPatchWalletByDTO.PatchWalletByDTOMock.mutex.Lock()
+	mmPatchWalletByDTO.PatchWalletByDTOMock.callArgs = append(mmPatchWalletByDTO.PatchWalletByDTOMock.callArgs, &mm_params)
+	mmPatchWalletByDTO.PatchWalletByDTOMock.mutex.Unlock()
+
+	for _, e := range mmPatchWalletByDTO.PatchWalletByDTOMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPatchWalletByDTO.PatchWalletByDTOMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPatchWalletByDTO.PatchWalletByDTOMock.defaultExpectation.Counter, 1)
+		mm_want := mmPatchWalletByDTO.PatchWalletByDTOMock.defaultExpectation.params
+		mm_want_ptrs := mmPatchWalletByDTO.PatchWalletByDTOMock.defaultExpectation.paramPtrs
+
+		mm_got := WalletUsecaseMockPatchWalletByDTOParams{ctx, id, in, skipVersionCheck}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPatchWalletByDTO.t.Errorf("WalletUsecaseMock.PatchWalletByDTO got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchWalletByDTO.PatchWalletByDTOMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmPatchWalletByDTO.t.Errorf("WalletUsecaseMock.PatchWalletByDTO got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchWalletByDTO.PatchWalletByDTOMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmPatchWalletByDTO.t.Errorf("WalletUsecaseMock.PatchWalletByDTO got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchWalletByDTO.PatchWalletByDTOMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+			if mm_want_ptrs.skipVersionCheck != nil && !minimock.Equal(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck) {
+				mmPatchWalletByDTO.t.Errorf("WalletUsecaseMock.PatchWalletByDTO got unexpected parameter skipVersionCheck, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPatchWalletByDTO.PatchWalletByDTOMock.defaultExpectation.expectationOrigins.originSkipVersionCheck, *mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck, minimock.Diff(*mm_want_ptrs.skipVersionCheck, mm_got.skipVersionCheck))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPatchWalletByDTO.t.Errorf("WalletUsecaseMock.PatchWalletByDTO got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPatchWalletByDTO.PatchWalletByDTOMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPatchWalletByDTO.PatchWalletByDTOMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPatchWalletByDTO.t.Fatal("No results are set for the WalletUsecaseMock.PatchWalletByDTO")
+		}
+		return (*mm_results).err
+	}
+	if mmPatchWalletByDTO.funcPatchWalletByDTO != nil {
+		return mmPatchWalletByDTO.funcPatchWalletByDTO(ctx, id, in, skipVersionCheck)
+	}
+	mmPatchWalletByDTO.t.Fatalf("Unexpected call to WalletUsecaseMock.PatchWalletByDTO. %v %v %v %v", ctx, id, in, skipVersionCheck)
+	return
+}
+
+// PatchWalletByDTOAfterCounter returns a count of finished WalletUsecaseMock.PatchWalletByDTO invocations
+func (mmPatchWalletByDTO *WalletUsecaseMock) PatchWalletByDTOAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPatchWalletByDTO.afterPatchWalletByDTOCounter)
+}
+
+// PatchWalletByDTOBeforeCounter returns a count of WalletUsecaseMock.PatchWalletByDTO invocations
+func (mmPatchWalletByDTO *WalletUsecaseMock) PatchWalletByDTOBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPatchWalletByDTO.beforePatchWalletByDTOCounter)
+}
+
+// Calls returns a list of arguments used in each call to WalletUsecaseMock.PatchWalletByDTO.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPatchWalletByDTO *mWalletUsecaseMockPatchWalletByDTO) Calls() []*WalletUsecaseMockPatchWalletByDTOParams {
+	mmPatchWalletByDTO.mutex.RLock()
+
+	argCopy := make([]*WalletUsecaseMockPatchWalletByDTOParams, len(mmPatchWalletByDTO.callArgs))
+	copy(argCopy, mmPatchWalletByDTO.callArgs)
+
+	mmPatchWalletByDTO.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPatchWalletByDTODone returns true if the count of the PatchWalletByDTO invocations corresponds
+// the number of defined expectations
+func (m *WalletUsecaseMock) MinimockPatchWalletByDTODone() bool {
+	if m.PatchWalletByDTOMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PatchWalletByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PatchWalletByDTOMock.invocationsDone()
+}
+
+// MinimockPatchWalletByDTOInspect logs each unmet expectation
+func (m *WalletUsecaseMock) MinimockPatchWalletByDTOInspect() {
+	for _, e := range m.PatchWalletByDTOMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WalletUsecaseMock.PatchWalletByDTO at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPatchWalletByDTOCounter := mm_atomic.LoadUint64(&m.afterPatchWalletByDTOCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PatchWalletByDTOMock.defaultExpectation != nil && afterPatchWalletByDTOCounter < 1 {
+		if m.PatchWalletByDTOMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WalletUsecaseMock.PatchWalletByDTO at\n%s", m.PatchWalletByDTOMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WalletUsecaseMock.PatchWalletByDTO at\n%s with params: %#v", m.PatchWalletByDTOMock.defaultExpectation.expectationOrigins.origin, *m.PatchWalletByDTOMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPatchWalletByDTO != nil && afterPatchWalletByDTOCounter < 1 {
+		m.t.Errorf("Expected call to WalletUsecaseMock.PatchWalletByDTO at\n%s", m.funcPatchWalletByDTOOrigin)
+	}
+
+	if !m.PatchWalletByDTOMock.invocationsDone() && afterPatchWalletByDTOCounter > 0 {
+		m.t.Errorf("Expected %d calls to WalletUsecaseMock.PatchWalletByDTO at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PatchWalletByDTOMock.expectedInvocations), m.PatchWalletByDTOMock.expectedInvocationsOrigin, afterPatchWalletByDTOCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *WalletUsecaseMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateWalletByDTOInspect()
+
+			m.MinimockDeleteWalletByIDInspect()
+
+			m.MinimockFindListInspect()
+
+			m.MinimockFindOneByIDInspect()
+
+			m.MinimockGetWalletBalancesInspect()
+
+			m.MinimockPatchWalletByDTOInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *WalletUsecaseMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *WalletUsecaseMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateWalletByDTODone() &&
+		m.MinimockDeleteWalletByIDDone() &&
+		m.MinimockFindListDone() &&
+		m.MinimockFindOneByIDDone() &&
+		m.MinimockGetWalletBalancesDone() &&
+		m.MinimockPatchWalletByDTODone()
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+)
+
+type OutboxEventListOptions struct {
+	// FilterPendingAt - недоставленные события, время попытки которых не позже указанного
+	FilterPendingAt *time.Time
+	Sort            []uctypes.SortOption[OutboxEventListOptionsSortField]
+}
+
+type OutboxEventListOptionsSortField string
+
+const (
+	OutboxEventListOptionsSortFieldCreatedAt OutboxEventListOptionsSortField = "created_at"
+)
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.OutboxUsecase -o mocks/outbox_usecase.go
+type OutboxUsecase interface {
+	// DispatchOutboxEvents - доставляет пачку событий всем получателям вне транзакции БД,
+	// возвращает количество событий, результат доставки которых сохранен
+	DispatchOutboxEvents(
+		ctx context.Context,
+		limit uint64,
+	) (processed int, resErr error)
+}
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.OutboxEventRepository -o mocks/outbox_event_repository.go
+type OutboxEventRepository interface {
+	FindList(
+		ctx context.Context,
+		listOptions *OutboxEventListOptions,
+		queryParams *uctypes.QueryGetListParams,
+	) (items []*entity.OutboxEvent, err error)
+
+	Create(ctx context.Context, item *entity.OutboxEvent) (err error)
+
+	Update(ctx context.Context, item *entity.OutboxEvent) (err error)
+}
+
+// NotificationSink - получатель событий outbox (webhook, email)
+//
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.NotificationSink -o mocks/notification_sink.go
+type NotificationSink interface {
+	// Name - уникальное имя получателя, сохраняется в событии после успешной доставки
+	Name() string
+
+	Send(ctx context.Context, event *entity.OutboxEvent) (err error)
+}
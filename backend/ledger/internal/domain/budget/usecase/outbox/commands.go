@@ -0,0 +1,166 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+func (uc *UsecaseImpl) DispatchOutboxEvents(ctx context.Context, limit uint64) (int, error) {
+	const op = "DispatchOutboxEvents"
+
+	events, err := uc.claimEvents(ctx, limit)
+	if err != nil {
+		return 0, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	processed := 0
+	var saveErrs []error
+
+	// отправка идет вне транзакции, медленный получатель не держит блокировки и соединение с БД
+	for _, event := range events {
+		uc.dispatchEvent(ctx, event)
+
+		// результат каждого события фиксируется отдельно, сбой записи не теряет результаты остальных
+		err = uc.dbMasterClient.Do(ctx, func(ctx context.Context) error {
+			return uc.outboxEventRepo.Update(ctx, event)
+		})
+		if err != nil {
+			uc.logger.ErrorContext(
+				ctx,
+				"failed to save outbox event delivery result",
+				slog.String("event_id", event.ID.String()),
+				slog.Any("error", err),
+			)
+			saveErrs = append(saveErrs, err)
+
+			continue
+		}
+
+		processed++
+	}
+
+	if len(saveErrs) > 0 {
+		return processed, appErrors.Chainf(errors.Join(saveErrs...), "%s.%s", uc.pkg, op)
+	}
+
+	return processed, nil
+}
+
+// claimEvents - выбирает пачку готовых к отправке событий и переносит их next_attempt_at на время аренды.
+// События, заблокированные другой репликой, пропускаются (SKIP LOCKED). Транзакция фиксируется до отправки,
+// поэтому другие реплики не возьмут события до истечения аренды, а события упавшей реплики будут отправлены повторно
+func (uc *UsecaseImpl) claimEvents(ctx context.Context, limit uint64) ([]*entity.OutboxEvent, error) {
+	var events []*entity.OutboxEvent
+
+	err := uc.dbMasterClient.Do(ctx, func(ctx context.Context) error {
+		timeNow := time.Now()
+
+		found, err := uc.outboxEventRepo.FindList(ctx, &usecase.OutboxEventListOptions{
+			FilterPendingAt: &timeNow,
+			Sort: []uctypes.SortOption[usecase.OutboxEventListOptionsSortField]{
+				{
+					Field:  usecase.OutboxEventListOptionsSortFieldCreatedAt,
+					IsDesc: false,
+				},
+			},
+		}, &uctypes.QueryGetListParams{
+			ForUpdateSkipLocked: true,
+			Limit:               limit,
+		})
+		if err != nil {
+			return err
+		}
+
+		leaseUntil := timeNow.Add(uc.leaseDuration()).Truncate(time.Microsecond)
+
+		for _, event := range found {
+			event.NextAttemptAt = leaseUntil
+
+			err = uc.outboxEventRepo.Update(ctx, event)
+			if err != nil {
+				return err
+			}
+		}
+
+		events = found
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// leaseDuration - время, на которое выбранное событие скрывается от других реплик
+func (uc *UsecaseImpl) leaseDuration() time.Duration {
+	lease := time.Duration(uc.cfg.Budget.Outbox.LeaseSec) * time.Second
+	if lease <= 0 {
+		lease = defaultLease
+	}
+
+	return lease
+}
+
+// dispatchEvent - отправка события получателям, которым оно еще не доставлено.
+// При ошибке следующая попытка откладывается по backoff, после MaxAttempts доставка прекращается
+func (uc *UsecaseImpl) dispatchEvent(ctx context.Context, event *entity.OutboxEvent) {
+	var sendErrs []error
+
+	for _, sink := range uc.sinks {
+		if event.IsDelivered(sink.Name()) {
+			continue
+		}
+
+		err := sink.Send(ctx, event)
+		if err != nil {
+			uc.logger.WarnContext(
+				ctx,
+				"failed to deliver outbox event",
+				slog.String("event_id", event.ID.String()),
+				slog.String("sink", sink.Name()),
+				slog.Any("error", err),
+			)
+			sendErrs = append(sendErrs, errors.New(sink.Name()+": "+err.Error()))
+			continue
+		}
+
+		event.MarkDelivered(sink.Name())
+	}
+
+	session := uc.backoffCtrl.Get(event.ID.String(), backoffGroup)
+	timeNow := time.Now().Truncate(time.Microsecond)
+
+	if len(sendErrs) == 0 {
+		event.ProcessedAt = &timeNow
+		session.Reset()
+		return
+	}
+
+	event.Attempts++
+	event.SetLastError(errors.Join(sendErrs...).Error())
+
+	maxAttempts := uc.cfg.Budget.Outbox.MaxAttempts
+	if maxAttempts > 0 && event.Attempts >= maxAttempts {
+		event.FailedAt = &timeNow
+		session.Reset()
+		uc.logger.ErrorContext(
+			ctx,
+			"outbox event delivery failed after max attempts",
+			slog.String("event_id", event.ID.String()),
+			slog.Int("attempts", event.Attempts),
+		)
+		return
+	}
+
+	session.AddBackoff()
+	event.NextAttemptAt = session.NextAllowed().Truncate(time.Microsecond)
+}
@@ -0,0 +1,247 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEvent(t *testing.T) *entity.OutboxEvent {
+	t.Helper()
+
+	event, err := entity.NewOutboxEvent(uuid.New(), entity.OutboxEventTypeBudgetThresholdCrossed, map[string]any{"threshold": 80})
+	require.NoError(t, err)
+
+	return event
+}
+
+func TestOutboxUsecase_DispatchOutboxEvents_Table(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+
+		attempts  int
+		delivered []string
+		// failSinks - получатели, отправка которым завершается ошибкой
+		failSinks []string
+
+		wantWebhookCalls uint64
+		wantSMTPCalls    uint64
+		wantDelivered    []string
+		wantAttempts     int
+		wantProcessed    bool
+		wantFailed       bool
+	}{
+		{
+			name:             "OK_all_sinks",
+			wantWebhookCalls: 1,
+			wantSMTPCalls:    1,
+			wantDelivered:    []string{"webhook", "smtp"},
+			wantProcessed:    true,
+		},
+		{
+			name:             "retry_failed_sink_with_backoff",
+			failSinks:        []string{"smtp"},
+			wantWebhookCalls: 1,
+			wantSMTPCalls:    1,
+			wantDelivered:    []string{"webhook"},
+			wantAttempts:     1,
+		},
+		{
+			name:             "skip_already_delivered_sink",
+			attempts:         1,
+			delivered:        []string{"webhook"},
+			wantWebhookCalls: 0,
+			wantSMTPCalls:    1,
+			wantDelivered:    []string{"webhook", "smtp"},
+			wantAttempts:     1,
+			wantProcessed:    true,
+		},
+		{
+			name:             "fail_after_max_attempts",
+			attempts:         2,
+			delivered:        []string{"webhook"},
+			failSinks:        []string{"smtp"},
+			wantWebhookCalls: 0,
+			wantSMTPCalls:    1,
+			wantDelivered:    []string{"webhook"},
+			wantAttempts:     3,
+			wantFailed:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			event := newTestEvent(t)
+			event.Attempts = tt.attempts
+			event.DeliveredSinks = append(event.DeliveredSinks, tt.delivered...)
+
+			s.outboxEventRepo.FindListMock.Set(func(
+				ctx context.Context,
+				listOptions *usecase.OutboxEventListOptions,
+				queryParams *uctypes.QueryGetListParams,
+			) ([]*entity.OutboxEvent, error) {
+				require.NotNil(t, listOptions.FilterPendingAt)
+				require.True(t, queryParams.ForUpdateSkipLocked)
+				require.Equal(t, uint64(10), queryParams.Limit)
+				return []*entity.OutboxEvent{event}, nil
+			})
+
+			sendFn := func(name string) func(ctx context.Context, got *entity.OutboxEvent) error {
+				return func(ctx context.Context, got *entity.OutboxEvent) error {
+					require.Equal(t, event.ID, got.ID)
+					require.False(t, isInTx(ctx))
+					for _, failSink := range tt.failSinks {
+						if failSink == name {
+							return errors.New("connection refused")
+						}
+					}
+					return nil
+				}
+			}
+
+			s.webhookSink.SendMock.Optional().Set(sendFn("webhook"))
+			s.smtpSink.SendMock.Optional().Set(sendFn("smtp"))
+
+			timeStart := time.Now()
+
+			var leaseUntil time.Time
+			s.outboxEventRepo.UpdateMock.Set(func(ctx context.Context, got *entity.OutboxEvent) error {
+				require.True(t, isInTx(ctx))
+				// первое сохранение - захват события до отправки
+				if s.outboxEventRepo.UpdateAfterCounter() == 0 {
+					require.Zero(t, s.webhookSink.SendAfterCounter()+s.smtpSink.SendAfterCounter())
+					leaseUntil = got.NextAttemptAt
+				}
+				return nil
+			})
+
+			processed, err := s.uc.DispatchOutboxEvents(testCtx(), 10)
+			require.NoError(t, err)
+			require.Equal(t, 1, processed)
+			require.Equal(t, uint64(2), s.outboxEventRepo.UpdateAfterCounter())
+			require.True(t, leaseUntil.After(timeStart.Add(4*time.Minute)))
+
+			require.Equal(t, tt.wantWebhookCalls, s.webhookSink.SendAfterCounter())
+			require.Equal(t, tt.wantSMTPCalls, s.smtpSink.SendAfterCounter())
+			require.ElementsMatch(t, tt.wantDelivered, event.DeliveredSinks)
+			require.Equal(t, tt.wantAttempts, event.Attempts)
+			require.Equal(t, tt.wantProcessed, event.ProcessedAt != nil)
+			require.Equal(t, tt.wantFailed, event.FailedAt != nil)
+
+			if len(tt.failSinks) > 0 {
+				require.NotNil(t, event.LastError)
+				require.Contains(t, *event.LastError, "smtp: connection refused")
+			}
+
+			if len(tt.failSinks) > 0 && !tt.wantFailed {
+				require.True(t, event.NextAttemptAt.After(timeStart.Add(9*time.Second)))
+			}
+		})
+	}
+}
+
+func TestOutboxUsecase_DispatchOutboxEvents_BackoffGrows(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	event := newTestEvent(t)
+
+	s.outboxEventRepo.FindListMock.Return([]*entity.OutboxEvent{event}, nil)
+	s.outboxEventRepo.UpdateMock.Return(nil)
+	s.webhookSink.SendMock.Return(errors.New("status 503"))
+	s.smtpSink.SendMock.Return(nil)
+
+	_, err := s.uc.DispatchOutboxEvents(testCtx(), 10)
+	require.NoError(t, err)
+	firstDelay := time.Until(event.NextAttemptAt)
+
+	_, err = s.uc.DispatchOutboxEvents(testCtx(), 10)
+	require.NoError(t, err)
+	secondDelay := time.Until(event.NextAttemptAt)
+
+	require.Equal(t, 2, event.Attempts)
+	require.Greater(t, secondDelay, firstDelay)
+	require.LessOrEqual(t, secondDelay, 60*time.Second)
+	require.Equal(t, uint64(1), s.smtpSink.SendAfterCounter())
+}
+
+func TestOutboxUsecase_DispatchOutboxEvents_NoSinks(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	s.uc.sinks = nil
+
+	event := newTestEvent(t)
+
+	s.outboxEventRepo.FindListMock.Return([]*entity.OutboxEvent{event}, nil)
+	s.outboxEventRepo.UpdateMock.Return(nil)
+
+	processed, err := s.uc.DispatchOutboxEvents(testCtx(), 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, processed)
+	require.NotNil(t, event.ProcessedAt)
+}
+
+func TestOutboxUsecase_DispatchOutboxEvents_ClaimError(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	s.outboxEventRepo.FindListMock.Return([]*entity.OutboxEvent{newTestEvent(t)}, nil)
+	s.outboxEventRepo.UpdateMock.Return(errors.New("db error"))
+	s.webhookSink.SendMock.Optional().Return(nil)
+	s.smtpSink.SendMock.Optional().Return(nil)
+
+	processed, err := s.uc.DispatchOutboxEvents(testCtx(), 10)
+	require.Error(t, err)
+	require.Equal(t, 0, processed)
+	require.Zero(t, s.webhookSink.SendAfterCounter())
+	require.Zero(t, s.smtpSink.SendAfterCounter())
+}
+
+func TestOutboxUsecase_DispatchOutboxEvents_SaveResultError(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	failedEvent := newTestEvent(t)
+	okEvent := newTestEvent(t)
+
+	s.outboxEventRepo.FindListMock.Return([]*entity.OutboxEvent{failedEvent, okEvent}, nil)
+	s.outboxEventRepo.UpdateMock.Set(func(ctx context.Context, got *entity.OutboxEvent) error {
+		// захват проходит, падает только сохранение результата первого события
+		if got.ID == failedEvent.ID && got.ProcessedAt != nil {
+			return errors.New("db error")
+		}
+		return nil
+	})
+	s.webhookSink.SendMock.Return(nil)
+	s.smtpSink.SendMock.Return(nil)
+
+	processed, err := s.uc.DispatchOutboxEvents(testCtx(), 10)
+	require.Error(t, err)
+	require.Equal(t, 1, processed)
+	require.Equal(t, uint64(2), s.webhookSink.SendAfterCounter())
+	require.Equal(t, uint64(4), s.outboxEventRepo.UpdateAfterCounter())
+	require.NotNil(t, okEvent.ProcessedAt)
+}
@@ -0,0 +1,64 @@
+package outbox
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/m11ano/budget_planner/backend/ledger/internal/app/config"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/db"
+	"github.com/m11ano/budget_planner/backend/ledger/pkg/backoff"
+)
+
+const (
+	backoffGroup = "budget_outbox"
+	defaultLease = 5 * time.Minute
+)
+
+type UsecaseImpl struct {
+	pkg             string
+	logger          *slog.Logger
+	cfg             config.Config
+	dbMasterClient  db.MasterClient
+	outboxEventRepo usecase.OutboxEventRepository
+	sinks           []usecase.NotificationSink
+	backoffCtrl     *backoff.Controller
+}
+
+func NewUsecaseImpl(
+	logger *slog.Logger,
+	cfg config.Config,
+	dbMasterClient db.MasterClient,
+	outboxEventRepo usecase.OutboxEventRepository,
+	sinks []usecase.NotificationSink,
+	backoffCtrl *backoff.Controller,
+) *UsecaseImpl {
+	initialInterval := time.Duration(cfg.Budget.Outbox.BackoffInitialSec) * time.Second
+	if initialInterval <= 0 {
+		initialInterval = 10 * time.Second
+	}
+
+	maxInterval := time.Duration(cfg.Budget.Outbox.BackoffMaxSec) * time.Second
+	if maxInterval < initialInterval {
+		maxInterval = initialInterval
+	}
+
+	backoffCtrl.SetConfigForGroup(
+		backoffGroup,
+		backoff.WithInitialInterval(initialInterval),
+		backoff.WithMultiplier(2),
+		backoff.WithMaxInterval(maxInterval),
+		backoff.WithTtl(2*maxInterval),
+	)
+
+	uc := &UsecaseImpl{
+		pkg:             "Budget.Usecase.Outbox",
+		logger:          logger,
+		cfg:             cfg,
+		dbMasterClient:  dbMasterClient,
+		outboxEventRepo: outboxEventRepo,
+		sinks:           sinks,
+		backoffCtrl:     backoffCtrl,
+	}
+	return uc
+}
@@ -0,0 +1,111 @@
+package outbox
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/app/config"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	usecasemocks "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase/mocks"
+	"github.com/m11ano/budget_planner/backend/ledger/pkg/backoff"
+	"github.com/m11ano/budget_planner/backend/ledger/pkg/pgclient"
+)
+
+type dependencies struct {
+	mc *minimock.Controller
+
+	uc *UsecaseImpl
+
+	logger *slog.Logger
+	cfg    config.Config
+
+	dbMasterClient  any
+	outboxEventRepo *usecasemocks.OutboxEventRepositoryMock
+	webhookSink     *usecasemocks.NotificationSinkMock
+	smtpSink        *usecasemocks.NotificationSinkMock
+	backoffCtrl     *backoff.Controller
+}
+
+func newDependencies(t *testing.T) *dependencies {
+	t.Helper()
+
+	mc := minimock.NewController(t)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+
+	cfg := config.Config{}
+	cfg.Budget.Outbox.MaxAttempts = 3
+	cfg.Budget.Outbox.BackoffInitialSec = 10
+	cfg.Budget.Outbox.BackoffMaxSec = 60
+
+	outboxEventRepo := usecasemocks.NewOutboxEventRepositoryMock(mc)
+
+	webhookSink := usecasemocks.NewNotificationSinkMock(mc)
+	webhookSink.NameMock.Optional().Return("webhook")
+
+	smtpSink := usecasemocks.NewNotificationSinkMock(mc)
+	smtpSink.NameMock.Optional().Return("smtp")
+
+	backoffCtrl := backoff.NewController()
+	t.Cleanup(func() {
+		backoffCtrl.Stop(context.Background())
+	})
+
+	dbMasterClient := &txTrackingClient{Client: pgclient.NewMock()}
+
+	uc := NewUsecaseImpl(
+		logger,
+		cfg,
+		dbMasterClient,
+		outboxEventRepo,
+		[]usecase.NotificationSink{webhookSink, smtpSink},
+		backoffCtrl,
+	)
+
+	return &dependencies{
+		mc:              mc,
+		uc:              uc,
+		logger:          logger,
+		cfg:             cfg,
+		dbMasterClient:  dbMasterClient,
+		outboxEventRepo: outboxEventRepo,
+		webhookSink:     webhookSink,
+		smtpSink:        smtpSink,
+		backoffCtrl:     backoffCtrl,
+	}
+}
+
+func finishDependencies(s *dependencies) {
+	s.mc.Finish()
+}
+
+func testCtx() context.Context {
+	return context.Background()
+}
+
+type txKey struct{}
+
+// txTrackingClient - помечает контекст внутри транзакции, чтобы тесты могли проверить границы транзакций
+type txTrackingClient struct {
+	pgclient.Client
+}
+
+func (c *txTrackingClient) Do(ctx context.Context, fn func(context.Context) error) error {
+	return c.Client.Do(ctx, func(ctx context.Context) error {
+		return fn(context.WithValue(ctx, txKey{}, true))
+	})
+}
+
+func (c *txTrackingClient) DoWithIsoLvl(ctx context.Context, isoLvl pgclient.TxIsoLevel, fn func(context.Context) error) error {
+	return c.Client.DoWithIsoLvl(ctx, isoLvl, func(ctx context.Context) error {
+		return fn(context.WithValue(ctx, txKey{}, true))
+	})
+}
+
+func isInTx(ctx context.Context) bool {
+	inTx, _ := ctx.Value(txKey{}).(bool)
+	return inTx
+}
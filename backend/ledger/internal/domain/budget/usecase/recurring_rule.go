@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+)
+
+type RecurringRuleListOptions struct {
+	FilterIDs        *[]uuid.UUID
+	FilterAccountID  *uuid.UUID
+	FilterCategoryID *uint64
+	FilterIsPaused   *bool
+	// FilterDueOn - правила, дата следующего повторения которых не позже указанной
+	FilterDueOn *civil.Date
+	Sort        []uctypes.SortOption[RecurringRuleListOptionsSortField]
+}
+
+type RecurringRuleListOptionsSortField string
+
+const (
+	RecurringRuleListOptionsSortFieldCreatedAt        RecurringRuleListOptionsSortField = "created_at"
+	RecurringRuleListOptionsSortFieldNextOccurrenceOn RecurringRuleListOptionsSortField = "next_occurrence_on"
+)
+
+type RecurringRuleDTO struct {
+	RecurringRule *entity.RecurringRule
+}
+
+type CreateRecurringRuleDataInput struct {
+	AccountID uuid.UUID
+	IsIncome  bool
+	Amount    decimal.Decimal
+	// Currency - пустая строка: валюта кошелька, либо базовая валюта аккаунта
+	Currency    string
+	CategoryID  uint64
+	WalletID    *uuid.UUID
+	Description string
+
+	Frequency  entity.RecurringFrequency
+	Interval   int
+	DayOfMonth int
+	StartDate  civil.Date
+	EndDate    *civil.Date
+	Count      *int
+}
+
+type PatchRecurringRuleDataInput struct {
+	Version int64
+
+	Amount     *decimal.Decimal
+	Currency   *string
+	CategoryID *uint64
+	// WalletID - новый кошелек, uuid.Nil - отвязать от кошелька
+	WalletID    *uuid.UUID
+	Description *string
+	// EndDate - нулевая дата снимает ограничение
+	EndDate *civil.Date
+	// Count - 0 снимает ограничение
+	Count    *int
+	IsPaused *bool
+}
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.RecurringRuleUsecase -o mocks/recurring_rule_usecase.go
+type RecurringRuleUsecase interface {
+	FindOneByID(
+		ctx context.Context,
+		id uuid.UUID,
+		queryParams *uctypes.QueryGetOneParams,
+	) (resItem *RecurringRuleDTO, resErr error)
+
+	FindList(
+		ctx context.Context,
+		listOptions *RecurringRuleListOptions,
+		queryParams *uctypes.QueryGetListParams,
+	) (resItems []*RecurringRuleDTO, resErr error)
+
+	CreateRecurringRuleByDTO(
+		ctx context.Context,
+		in CreateRecurringRuleDataInput,
+	) (resRecurringRuleDTO *RecurringRuleDTO, resErr error)
+
+	PatchRecurringRuleByDTO(
+		ctx context.Context,
+		id uuid.UUID,
+		in PatchRecurringRuleDataInput,
+		skipVersionCheck bool,
+	) (resErr error)
+
+	DeleteRecurringRuleByID(
+		ctx context.Context,
+		id uuid.UUID,
+	) (resErr error)
+
+	// MaterializeDueRules - создает транзакции по наступившим повторениям, возвращает количество обработанных правил.
+	// Ошибка одного правила не прерывает обработку остальных и возвращается вместе с количеством
+	MaterializeDueRules(
+		ctx context.Context,
+		today civil.Date,
+		limit uint64,
+	) (processed int, resErr error)
+}
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.RecurringRuleRepository -o mocks/recurring_rule_repository.go
+type RecurringRuleRepository interface {
+	FindOneByID(
+		ctx context.Context,
+		id uuid.UUID,
+		queryParams *uctypes.QueryGetOneParams,
+	) (item *entity.RecurringRule, err error)
+
+	FindList(
+		ctx context.Context,
+		listOptions *RecurringRuleListOptions,
+		queryParams *uctypes.QueryGetListParams,
+	) (items []*entity.RecurringRule, err error)
+
+	Create(ctx context.Context, item *entity.RecurringRule) (err error)
+
+	Update(ctx context.Context, item *entity.RecurringRule) (err error)
+
+	ReassignCategory(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) (err error)
+}
@@ -0,0 +1,303 @@
+package recurringrule
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/loghandler"
+	"github.com/samber/lo"
+)
+
+func (uc *UsecaseImpl) CreateRecurringRuleByDTO(
+	ctx context.Context,
+	in usecase.CreateRecurringRuleDataInput,
+) (*usecase.RecurringRuleDTO, error) {
+	const op = "CreateRecurringRuleByDTO"
+
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != in.AccountID {
+			return nil, appErrors.ErrForbidden
+		}
+	}
+
+	currency, err := uc.transactionUC.ResolveCurrency(ctx, in.AccountID, in.Currency, in.WalletID)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	rule, err := entity.NewRecurringRule(
+		in.AccountID,
+		in.IsIncome,
+		in.Amount,
+		currency,
+		in.CategoryID,
+		in.Frequency,
+		in.Interval,
+		in.DayOfMonth,
+		in.StartDate,
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	err = rule.SetEnd(in.EndDate, in.Count)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	err = rule.SetDescription(in.Description)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	rule.WalletID = in.WalletID
+
+	err = uc.categoryUC.CheckCategoryAccess(ctx, rule.CategoryID, rule.AccountID)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	if rule.WalletID != nil {
+		err = uc.checkRuleWallet(ctx, rule)
+		if err != nil {
+			return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+		}
+	}
+
+	err = uc.recurringRuleRepo.Create(ctx, rule)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	ruleDTO, err := uc.entitiesToDTO(ctx, []*entity.RecurringRule{rule})
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	if len(ruleDTO) == 0 {
+		uc.logger.ErrorContext(loghandler.WithSource(ctx), "unpredicted empty recurring rule dto")
+		return nil, appErrors.Chainf(appErrors.ErrInternal, "%s.%s", uc.pkg, op)
+	}
+
+	return ruleDTO[0], nil
+}
+
+func (uc *UsecaseImpl) PatchRecurringRuleByDTO(
+	ctx context.Context,
+	id uuid.UUID,
+	in usecase.PatchRecurringRuleDataInput,
+	skipVersionCheck bool,
+) error {
+	const op = "PatchRecurringRuleByDTO"
+
+	err := uc.dbMasterClient.Do(ctx, func(ctx context.Context) error {
+		rule, err := uc.recurringRuleRepo.FindOneByID(ctx, id, &uctypes.QueryGetOneParams{
+			ForUpdate: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = uc.checkRecurringRuleOwner(ctx, rule)
+		if err != nil {
+			return err
+		}
+
+		if !skipVersionCheck && rule.Version() != in.Version {
+			return appErrors.ErrVersionConflict.
+				WithDetail("last_version", false, rule.Version()).
+				WithDetail("last_updated_at", false, rule.UpdatedAt)
+		}
+
+		if in.Currency != nil {
+			err = rule.SetCurrency(*in.Currency)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.Amount != nil {
+			err = rule.SetAmount(*in.Amount)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.CategoryID != nil {
+			err = uc.categoryUC.CheckCategoryAccess(ctx, *in.CategoryID, rule.AccountID)
+			if err != nil {
+				return err
+			}
+
+			rule.CategoryID = *in.CategoryID
+		}
+
+		if in.WalletID != nil {
+			if *in.WalletID == uuid.Nil {
+				rule.WalletID = nil
+			} else {
+				rule.WalletID = lo.ToPtr(*in.WalletID)
+			}
+		}
+
+		if rule.WalletID != nil && (in.WalletID != nil || in.Currency != nil) {
+			err = uc.checkRuleWallet(ctx, rule)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.Description != nil {
+			err = rule.SetDescription(*in.Description)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.EndDate != nil || in.Count != nil {
+			endDate, count := rule.EndDate, rule.Count
+
+			if in.EndDate != nil {
+				endDate = nil
+				if !in.EndDate.IsZero() {
+					endDate = lo.ToPtr(*in.EndDate)
+				}
+			}
+
+			if in.Count != nil {
+				count = nil
+				if *in.Count != 0 {
+					count = lo.ToPtr(*in.Count)
+				}
+			}
+
+			err = rule.SetEnd(endDate, count)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.IsPaused != nil {
+			rule.SetIsPaused(*in.IsPaused, civil.DateOf(time.Now()))
+		}
+
+		err = uc.recurringRuleRepo.Update(ctx, rule)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return nil
+}
+
+func (uc *UsecaseImpl) DeleteRecurringRuleByID(ctx context.Context, id uuid.UUID) error {
+	const op = "DeleteRecurringRuleByID"
+
+	err := uc.dbMasterClient.Do(ctx, func(ctx context.Context) error {
+		rule, err := uc.recurringRuleRepo.FindOneByID(ctx, id, &uctypes.QueryGetOneParams{
+			ForUpdate: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = uc.checkRecurringRuleOwner(ctx, rule)
+		if err != nil {
+			return err
+		}
+
+		rule.DeletedAt = lo.ToPtr(time.Now())
+
+		err = uc.recurringRuleRepo.Update(ctx, rule)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return nil
+}
+
+func (uc *UsecaseImpl) MaterializeDueRules(
+	ctx context.Context,
+	today civil.Date,
+	limit uint64,
+) (int, error) {
+	const op = "MaterializeDueRules"
+
+	ctx = auth.WithoutCheckRight(ctx)
+
+	rules, err := uc.recurringRuleRepo.FindList(ctx, &usecase.RecurringRuleListOptions{
+		FilterIsPaused: lo.ToPtr(false),
+		FilterDueOn:    &today,
+		Sort: []uctypes.SortOption[usecase.RecurringRuleListOptionsSortField]{
+			{
+				Field:  usecase.RecurringRuleListOptionsSortFieldNextOccurrenceOn,
+				IsDesc: false,
+			},
+		},
+	}, &uctypes.QueryGetListParams{
+		Limit: limit,
+	})
+	if err != nil {
+		return 0, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	processed := 0
+	var ruleErrs []error
+
+	// каждое правило обрабатывается в своей serializable транзакции: сбой одного правила не откатывает остальные
+	for _, rule := range rules {
+		if ctx.Err() != nil {
+			ruleErrs = append(ruleErrs, ctx.Err())
+			break
+		}
+
+		isMaterialized, err := uc.materializeDueRule(ctx, rule.ID, today)
+		if err != nil {
+			uc.logger.ErrorContext(
+				ctx,
+				"failed to materialize recurring rule",
+				slog.String("ruleID", rule.ID.String()),
+				slog.Any("error", err),
+			)
+			ruleErrs = append(ruleErrs, err)
+
+			continue
+		}
+
+		if !isMaterialized {
+			continue
+		}
+
+		processed++
+
+		// транзакции повторений создаются внутри транзакции правила, поэтому кеш отчетов сбрасывается после ее фиксации
+		uc.invalidateReportsCache(ctx, rule.AccountID)
+	}
+
+	if len(ruleErrs) > 0 {
+		return processed, appErrors.Chainf(errors.Join(ruleErrs...), "%s.%s", uc.pkg, op)
+	}
+
+	return processed, nil
+}
@@ -0,0 +1,476 @@
+package recurringrule
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurringRuleUsecase_CreateRecurringRuleByDTO_Table(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+	walletID := uuid.New()
+
+	tests := []struct {
+		name string
+		in   usecase.CreateRecurringRuleDataInput
+
+		walletCurrency string
+
+		wantNext civil.Date
+		wantErr  error
+	}{
+		{
+			name: "OK_monthly_default_currency",
+			in: usecase.CreateRecurringRuleDataInput{
+				AccountID:   accountID,
+				Amount:      decimal.MustParse("-1500"),
+				CategoryID:  10,
+				Description: " Rent ",
+				Frequency:   entity.RecurringFrequencyMonthly,
+				Interval:    1,
+				StartDate:   civil.Date{Year: 2025, Month: 1, Day: 31},
+			},
+			wantNext: civil.Date{Year: 2025, Month: 1, Day: 31},
+		},
+		{
+			name: "OK_monthly_day_before_start",
+			in: usecase.CreateRecurringRuleDataInput{
+				AccountID:  accountID,
+				Amount:     decimal.MustParse("-1500"),
+				CategoryID: 10,
+				Frequency:  entity.RecurringFrequencyMonthly,
+				Interval:   1,
+				DayOfMonth: 5,
+				StartDate:  civil.Date{Year: 2025, Month: 1, Day: 20},
+			},
+			wantNext: civil.Date{Year: 2025, Month: 2, Day: 5},
+		},
+		{
+			name: "OK_wallet_currency",
+			in: usecase.CreateRecurringRuleDataInput{
+				AccountID:  accountID,
+				Amount:     decimal.MustParse("-10"),
+				CategoryID: 10,
+				WalletID:   &walletID,
+				Frequency:  entity.RecurringFrequencyWeekly,
+				Interval:   2,
+				StartDate:  civil.Date{Year: 2025, Month: 1, Day: 1},
+			},
+			walletCurrency: "USD",
+			wantNext:       civil.Date{Year: 2025, Month: 1, Day: 1},
+		},
+		{
+			name: "Negative_invalid_frequency",
+			in: usecase.CreateRecurringRuleDataInput{
+				AccountID:  accountID,
+				Amount:     decimal.MustParse("-10"),
+				Currency:   "RUB",
+				CategoryID: 10,
+				Frequency:  "daily",
+				Interval:   1,
+				StartDate:  civil.Date{Year: 2025, Month: 1, Day: 1},
+			},
+			wantErr: entity.ErrRecurringRuleFrequencyInvalid,
+		},
+		{
+			name: "Negative_end_before_start",
+			in: usecase.CreateRecurringRuleDataInput{
+				AccountID:  accountID,
+				Amount:     decimal.MustParse("-10"),
+				Currency:   "RUB",
+				CategoryID: 10,
+				Frequency:  entity.RecurringFrequencyYearly,
+				Interval:   1,
+				StartDate:  civil.Date{Year: 2025, Month: 1, Day: 1},
+				EndDate:    &civil.Date{Year: 2024, Month: 1, Day: 1},
+			},
+			wantErr: entity.ErrRecurringRuleEndDateInvalid,
+		},
+		{
+			name: "Negative_wallet_currency_mismatch",
+			in: usecase.CreateRecurringRuleDataInput{
+				AccountID:  accountID,
+				Amount:     decimal.MustParse("-10"),
+				Currency:   "EUR",
+				CategoryID: 10,
+				WalletID:   &walletID,
+				Frequency:  entity.RecurringFrequencyWeekly,
+				Interval:   1,
+				StartDate:  civil.Date{Year: 2025, Month: 1, Day: 1},
+			},
+			walletCurrency: "USD",
+			wantErr:        appErrors.ErrBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.transactionUC.ResolveCurrencyMock.Optional().Set(func(
+				ctx context.Context,
+				gotAccountID uuid.UUID,
+				currency string,
+				gotWalletID *uuid.UUID,
+			) (string, error) {
+				require.Equal(t, accountID, gotAccountID)
+
+				if currency != "" {
+					return currency, nil
+				}
+
+				if gotWalletID != nil {
+					require.Equal(t, walletID, *gotWalletID)
+					return tt.walletCurrency, nil
+				}
+
+				return "RUB", nil
+			})
+
+			s.categoryUC.CheckCategoryAccessMock.Optional().Return(nil)
+
+			if tt.wantErr == nil {
+				s.recurringRuleRepo.CreateMock.Set(func(ctx context.Context, item *entity.RecurringRule) error {
+					require.Equal(t, accountID, item.AccountID)
+					require.NotNil(t, item.NextOccurrenceOn)
+					require.Equal(t, tt.wantNext, *item.NextOccurrenceOn)
+
+					if tt.walletCurrency != "" {
+						require.Equal(t, tt.walletCurrency, item.Currency)
+					} else {
+						require.Equal(t, "RUB", item.Currency)
+					}
+
+					return nil
+				})
+			}
+
+			got, err := s.uc.CreateRecurringRuleByDTO(testCtx(), tt.in)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			require.Equal(t, strings.TrimSpace(tt.in.Description), got.RecurringRule.Description)
+		})
+	}
+}
+
+func newTestRule(t *testing.T, frequency entity.RecurringFrequency, startDate civil.Date) *entity.RecurringRule {
+	t.Helper()
+
+	rule, err := entity.NewRecurringRule(
+		uuid.New(),
+		false,
+		decimal.MustParse("-100"),
+		"RUB",
+		10,
+		frequency,
+		1,
+		0,
+		startDate,
+	)
+	require.NoError(t, err)
+
+	return rule
+}
+
+func TestRecurringRuleUsecase_MaterializeDueRules_Table(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+
+		frequency entity.RecurringFrequency
+		startDate civil.Date
+		count     *int
+		today     civil.Date
+		// existing - даты, на которые транзакция уже была создана
+		existing []civil.Date
+		// failOn - дата, на которой создание транзакции завершается ошибкой
+		failOn  *civil.Date
+		failErr error
+
+		wantCreated []civil.Date
+		wantNext    *civil.Date
+		wantPaused  bool
+		wantErr     bool
+	}{
+		{
+			name:      "OK_monthly_end_of_month_clamp",
+			frequency: entity.RecurringFrequencyMonthly,
+			startDate: civil.Date{Year: 2024, Month: 1, Day: 31},
+			today:     civil.Date{Year: 2024, Month: 4, Day: 15},
+			wantCreated: []civil.Date{
+				{Year: 2024, Month: 1, Day: 31},
+				{Year: 2024, Month: 2, Day: 29},
+				{Year: 2024, Month: 3, Day: 31},
+			},
+			wantNext: &civil.Date{Year: 2024, Month: 4, Day: 30},
+		},
+		{
+			name:      "OK_weekly_skip_existing",
+			frequency: entity.RecurringFrequencyWeekly,
+			startDate: civil.Date{Year: 2025, Month: 1, Day: 1},
+			today:     civil.Date{Year: 2025, Month: 1, Day: 15},
+			existing:  []civil.Date{{Year: 2025, Month: 1, Day: 8}},
+			wantCreated: []civil.Date{
+				{Year: 2025, Month: 1, Day: 1},
+				{Year: 2025, Month: 1, Day: 15},
+			},
+			wantNext: &civil.Date{Year: 2025, Month: 1, Day: 22},
+		},
+		{
+			name:      "OK_count_finished",
+			frequency: entity.RecurringFrequencyYearly,
+			startDate: civil.Date{Year: 2020, Month: 2, Day: 29},
+			count:     lo.ToPtr(2),
+			today:     civil.Date{Year: 2025, Month: 1, Day: 1},
+			wantCreated: []civil.Date{
+				{Year: 2020, Month: 2, Day: 29},
+				{Year: 2021, Month: 2, Day: 28},
+			},
+			wantNext: nil,
+		},
+		{
+			name:      "Paused_on_bad_request",
+			frequency: entity.RecurringFrequencyWeekly,
+			startDate: civil.Date{Year: 2025, Month: 1, Day: 1},
+			today:     civil.Date{Year: 2025, Month: 1, Day: 15},
+			failOn:    &civil.Date{Year: 2025, Month: 1, Day: 8},
+			failErr:   appErrors.ErrBadRequest.WithHints("category is archived"),
+			wantCreated: []civil.Date{
+				{Year: 2025, Month: 1, Day: 1},
+			},
+			wantNext:   &civil.Date{Year: 2025, Month: 1, Day: 8},
+			wantPaused: true,
+		},
+		{
+			name:      "Negative_internal_error",
+			frequency: entity.RecurringFrequencyWeekly,
+			startDate: civil.Date{Year: 2025, Month: 1, Day: 1},
+			today:     civil.Date{Year: 2025, Month: 1, Day: 1},
+			failOn:    &civil.Date{Year: 2025, Month: 1, Day: 1},
+			failErr:   appErrors.ErrInternal,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			rule := newTestRule(t, tt.frequency, tt.startDate)
+			require.NoError(t, rule.SetEnd(nil, tt.count))
+
+			s.recurringRuleRepo.FindListMock.Set(func(
+				ctx context.Context,
+				lo *usecase.RecurringRuleListOptions,
+				qp *uctypes.QueryGetListParams,
+			) ([]*entity.RecurringRule, error) {
+				require.NotNil(t, lo.FilterIsPaused)
+				require.False(t, *lo.FilterIsPaused)
+				require.NotNil(t, lo.FilterDueOn)
+				require.Equal(t, tt.today, *lo.FilterDueOn)
+				require.NotNil(t, qp)
+				require.False(t, qp.ForUpdateSkipLocked)
+				require.Equal(t, uint64(10), qp.Limit)
+				return []*entity.RecurringRule{rule}, nil
+			})
+
+			s.recurringRuleRepo.FindOneByIDMock.Set(func(
+				ctx context.Context,
+				id uuid.UUID,
+				qp *uctypes.QueryGetOneParams,
+			) (*entity.RecurringRule, error) {
+				require.Equal(t, rule.ID, id)
+				require.True(t, qp.ForUpdateSkipLocked)
+				return rule, nil
+			})
+
+			s.transactionRepo.FindListMock.Set(func(
+				ctx context.Context,
+				lo *usecase.TransactionListOptions,
+				qp *uctypes.QueryGetListParams,
+			) ([]*entity.Transaction, error) {
+				require.Equal(t, rule.ID, *lo.FilterRecurringRuleID)
+				require.True(t, qp.WithDeleted)
+
+				for _, date := range tt.existing {
+					if date == *lo.FilterOccurredOnFrom {
+						return []*entity.Transaction{{ID: uuid.New()}}, nil
+					}
+				}
+
+				return nil, nil
+			})
+
+			created := make([]civil.Date, 0)
+			s.transactionUC.CreateTransactionByDTOMock.Set(func(
+				ctx context.Context,
+				in usecase.CreateTransactionDataInput,
+			) (*usecase.TransactionDTO, error) {
+				require.NotNil(t, in.RecurringRuleID)
+				require.Equal(t, rule.ID, *in.RecurringRuleID)
+				require.Equal(t, rule.Amount, in.Amount)
+
+				if tt.failOn != nil && *tt.failOn == in.OccurredOn {
+					return nil, tt.failErr
+				}
+
+				created = append(created, in.OccurredOn)
+
+				return &usecase.TransactionDTO{}, nil
+			})
+
+			if !tt.wantErr {
+				s.recurringRuleRepo.UpdateMock.Set(func(ctx context.Context, item *entity.RecurringRule) error {
+					require.Equal(t, rule.ID, item.ID)
+					return nil
+				})
+				s.cacheGenerationRepo.BumpGenerationsMock.Set(func(ctx context.Context, scopes ...string) error {
+					require.Equal(t, []string{usecase.CacheScopeReports(rule.AccountID)}, scopes)
+					return nil
+				})
+			}
+
+			processed, err := s.uc.MaterializeDueRules(testCtx(), tt.today, 10)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Equal(t, 0, processed)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, 1, processed)
+			require.Equal(t, tt.wantCreated, created)
+			require.Equal(t, tt.wantNext, rule.NextOccurrenceOn)
+			require.Equal(t, tt.wantPaused, rule.IsPaused)
+
+			if tt.wantPaused {
+				require.NotNil(t, rule.LastError)
+				require.Equal(t, "category is archived", *rule.LastError)
+			}
+		})
+	}
+}
+
+func TestRecurringRuleUsecase_MaterializeDueRules_FailedRuleDoesNotAbortBatch(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	today := civil.Date{Year: 2025, Month: 1, Day: 1}
+
+	failed := newTestRule(t, entity.RecurringFrequencyWeekly, today)
+	locked := newTestRule(t, entity.RecurringFrequencyWeekly, today)
+	ok := newTestRule(t, entity.RecurringFrequencyWeekly, today)
+
+	s.recurringRuleRepo.FindListMock.Return([]*entity.RecurringRule{failed, locked, ok}, nil)
+
+	s.recurringRuleRepo.FindOneByIDMock.Set(func(
+		ctx context.Context,
+		id uuid.UUID,
+		qp *uctypes.QueryGetOneParams,
+	) (*entity.RecurringRule, error) {
+		require.True(t, qp.ForUpdateSkipLocked)
+
+		switch id {
+		case failed.ID:
+			return failed, nil
+		case ok.ID:
+			return ok, nil
+		default:
+			// правило заблокировано другой репликой
+			return nil, appErrors.ErrNotFound
+		}
+	})
+
+	s.transactionRepo.FindListMock.Return(nil, nil)
+
+	s.transactionUC.CreateTransactionByDTOMock.Set(func(
+		ctx context.Context,
+		in usecase.CreateTransactionDataInput,
+	) (*usecase.TransactionDTO, error) {
+		if *in.RecurringRuleID == failed.ID {
+			return nil, appErrors.ErrInternal
+		}
+
+		return &usecase.TransactionDTO{}, nil
+	})
+
+	s.recurringRuleRepo.UpdateMock.Set(func(ctx context.Context, item *entity.RecurringRule) error {
+		require.Equal(t, ok.ID, item.ID)
+		return nil
+	})
+
+	s.cacheGenerationRepo.BumpGenerationsMock.Set(func(ctx context.Context, scopes ...string) error {
+		require.Equal(t, []string{usecase.CacheScopeReports(ok.AccountID)}, scopes)
+		return nil
+	})
+
+	processed, err := s.uc.MaterializeDueRules(testCtx(), today, 10)
+	require.ErrorIs(t, err, appErrors.ErrInternal)
+	require.Equal(t, 1, processed)
+	require.Equal(t, uint64(1), s.recurringRuleRepo.UpdateAfterCounter())
+	require.Equal(t, uint64(1), s.cacheGenerationRepo.BumpGenerationsAfterCounter())
+}
+
+func TestRecurringRuleUsecase_PatchRecurringRuleByDTO_Resume(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	rule := newTestRule(t, entity.RecurringFrequencyWeekly, civil.Date{Year: 2020, Month: 1, Day: 1})
+	rule.PauseWithError("category is archived")
+
+	s.recurringRuleRepo.FindOneByIDMock.Set(func(ctx context.Context, id uuid.UUID, qp *uctypes.QueryGetOneParams) (*entity.RecurringRule, error) {
+		require.Equal(t, rule.ID, id)
+		require.True(t, qp.ForUpdate)
+		return rule, nil
+	})
+
+	today := civil.DateOf(time.Now())
+
+	s.recurringRuleRepo.UpdateMock.Set(func(ctx context.Context, item *entity.RecurringRule) error {
+		require.False(t, item.IsPaused)
+		require.Nil(t, item.LastError)
+		require.NotNil(t, item.NextOccurrenceOn)
+		// пропущенные за время паузы повторения не создаются
+		require.False(t, item.NextOccurrenceOn.Before(today))
+		return nil
+	})
+
+	err := s.uc.PatchRecurringRuleByDTO(testCtx(), rule.ID, usecase.PatchRecurringRuleDataInput{
+		IsPaused: lo.ToPtr(false),
+	}, true)
+	require.NoError(t, err)
+}
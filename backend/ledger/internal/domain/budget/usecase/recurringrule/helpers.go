@@ -0,0 +1,177 @@
+package recurringrule
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/loghandler"
+	"github.com/m11ano/budget_planner/backend/ledger/pkg/pgclient"
+)
+
+func (uc *UsecaseImpl) entitiesToDTO(
+	_ context.Context,
+	items []*entity.RecurringRule,
+) ([]*usecase.RecurringRuleDTO, error) {
+	out := make([]*usecase.RecurringRuleDTO, 0, len(items))
+
+	for _, item := range items {
+		out = append(out, &usecase.RecurringRuleDTO{
+			RecurringRule: item,
+		})
+	}
+
+	return out, nil
+}
+
+func (uc *UsecaseImpl) checkRecurringRuleOwner(ctx context.Context, rule *entity.RecurringRule) error {
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != rule.AccountID {
+			return appErrors.ErrForbidden
+		}
+	}
+
+	return nil
+}
+
+// checkRuleWallet - кошелек должен быть доступен аккаунту, валюты правила и кошелька должны совпадать
+func (uc *UsecaseImpl) checkRuleWallet(ctx context.Context, rule *entity.RecurringRule) error {
+	walletCurrency, err := uc.transactionUC.ResolveCurrency(ctx, rule.AccountID, "", rule.WalletID)
+	if err != nil {
+		return err
+	}
+
+	if walletCurrency != rule.Currency {
+		return appErrors.ErrBadRequest.WithHints("recurring rule currency must match wallet currency")
+	}
+
+	return nil
+}
+
+// materializeDueRule - блокирует правило и создает его наступившие повторения в отдельной транзакции.
+// Правило, заблокированное другой репликой (SKIP LOCKED) или уже обработанное, пропускается,
+// а повторная транзакция на ту же дату отсекается уникальным индексом (recurring_rule_id, occurred_on)
+func (uc *UsecaseImpl) materializeDueRule(ctx context.Context, id uuid.UUID, today civil.Date) (bool, error) {
+	isMaterialized := false
+
+	err := uc.dbMasterClient.DoWithIsoLvl(ctx, pgclient.Serializable, func(ctx context.Context) error {
+		isMaterialized = false
+
+		rule, err := uc.recurringRuleRepo.FindOneByID(ctx, id, &uctypes.QueryGetOneParams{
+			ForUpdateSkipLocked: true,
+		})
+		if err != nil {
+			if errors.Is(err, appErrors.ErrNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		if !rule.IsDue(today) {
+			return nil
+		}
+
+		err = uc.materializeRule(ctx, rule, today)
+		if err != nil {
+			return err
+		}
+
+		isMaterialized = true
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return isMaterialized, nil
+}
+
+func (uc *UsecaseImpl) invalidateReportsCache(ctx context.Context, accountID uuid.UUID) {
+	err := uc.cacheGenerationRepo.BumpGenerations(context.WithoutCancel(ctx), usecase.CacheScopeReports(accountID))
+	if err != nil {
+		uc.logger.ErrorContext(loghandler.WithSource(ctx), "redis bump cache generation err", slog.Any("error", err))
+	}
+}
+
+// materializeRule - создает транзакции по всем наступившим повторениям правила
+func (uc *UsecaseImpl) materializeRule(ctx context.Context, rule *entity.RecurringRule, today civil.Date) error {
+	for rule.IsDue(today) {
+		occurredOn := *rule.NextOccurrenceOn
+
+		err := uc.createOccurrence(ctx, rule, occurredOn)
+		if err != nil {
+			// ошибки данных (категория в архиве, превышен бюджет и т.п.) не исчезнут сами,
+			// поэтому правило приостанавливается, чтобы не блокировать остальные
+			if !errors.Is(err, appErrors.ErrBadRequest) {
+				return err
+			}
+
+			reason := err.Error()
+			if hints, ok := appErrors.NearestHints(err); ok && len(hints) > 0 {
+				reason = strings.Join(hints, "; ")
+			}
+
+			uc.logger.WarnContext(
+				ctx,
+				"recurring rule paused",
+				slog.String("ruleID", rule.ID.String()),
+				slog.String("occurredOn", occurredOn.String()),
+				slog.String("reason", reason),
+			)
+
+			rule.PauseWithError(reason)
+
+			break
+		}
+
+		rule.Advance()
+	}
+
+	return uc.recurringRuleRepo.Update(ctx, rule)
+}
+
+// createOccurrence - создает транзакцию повторения, если она еще не была создана
+func (uc *UsecaseImpl) createOccurrence(ctx context.Context, rule *entity.RecurringRule, occurredOn civil.Date) error {
+	existing, err := uc.transactionRepo.FindList(ctx, &usecase.TransactionListOptions{
+		FilterRecurringRuleID: &rule.ID,
+		FilterOccurredOnFrom:  &occurredOn,
+		FilterOccurredOnTo:    &occurredOn,
+	}, &uctypes.QueryGetListParams{
+		WithDeleted: true,
+		Limit:       1,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(existing) > 0 {
+		return nil
+	}
+
+	_, err = uc.transactionUC.CreateTransactionByDTO(ctx, usecase.CreateTransactionDataInput{
+		AccountID:       rule.AccountID,
+		IsIncome:        rule.IsIncome,
+		Amount:          rule.Amount,
+		Currency:        rule.Currency,
+		OccurredOn:      occurredOn,
+		CategoryID:      rule.CategoryID,
+		WalletID:        rule.WalletID,
+		Description:     rule.Description,
+		RecurringRuleID: &rule.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
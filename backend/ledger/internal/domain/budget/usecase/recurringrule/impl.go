@@ -0,0 +1,45 @@
+package recurringrule
+
+import (
+	"log/slog"
+
+	"github.com/m11ano/budget_planner/backend/ledger/internal/app/config"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/db"
+)
+
+type UsecaseImpl struct {
+	pkg                 string
+	logger              *slog.Logger
+	cfg                 config.Config
+	dbMasterClient      db.MasterClient
+	recurringRuleRepo   usecase.RecurringRuleRepository
+	transactionRepo     usecase.TransactionRepository
+	categoryUC          usecase.CategoryUsecase
+	transactionUC       usecase.TransactionUsecase
+	cacheGenerationRepo usecase.CacheGenerationRepository
+}
+
+func NewUsecaseImpl(
+	logger *slog.Logger,
+	cfg config.Config,
+	dbMasterClient db.MasterClient,
+	recurringRuleRepo usecase.RecurringRuleRepository,
+	transactionRepo usecase.TransactionRepository,
+	categoryUC usecase.CategoryUsecase,
+	transactionUC usecase.TransactionUsecase,
+	cacheGenerationRepo usecase.CacheGenerationRepository,
+) *UsecaseImpl {
+	uc := &UsecaseImpl{
+		pkg:                 "Budget.Usecase.RecurringRule",
+		logger:              logger,
+		cfg:                 cfg,
+		dbMasterClient:      dbMasterClient,
+		recurringRuleRepo:   recurringRuleRepo,
+		transactionRepo:     transactionRepo,
+		categoryUC:          categoryUC,
+		transactionUC:       transactionUC,
+		cacheGenerationRepo: cacheGenerationRepo,
+	}
+	return uc
+}
@@ -0,0 +1,35 @@
+package recurringrule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurringRuleUsecase_FindOneByID_OK(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	id := uuid.New()
+	rule := &entity.RecurringRule{
+		ID:        id,
+		AccountID: uuid.New(),
+		Frequency: entity.RecurringFrequencyWeekly,
+	}
+
+	s.recurringRuleRepo.FindOneByIDMock.Set(func(ctx context.Context, gotID uuid.UUID, qp *uctypes.QueryGetOneParams) (*entity.RecurringRule, error) {
+		require.Equal(t, id, gotID)
+		return rule, nil
+	})
+
+	got, err := s.uc.FindOneByID(testCtx(), id, nil)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	require.Equal(t, rule, got.RecurringRule)
+}
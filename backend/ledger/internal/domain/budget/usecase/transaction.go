@@ -15,7 +15,54 @@ type TransactionListOptions struct {
 	FilterAccountID      *uuid.UUID
 	FilterOccurredOnFrom *civil.Date
 	FilterOccurredOnTo   *civil.Date
-	Sort                 []uctypes.SortOption[TransactionListOptionsSortField]
+	FilterCategoryID     *uint64
+	FilterCategoryIDs    []uint64
+	FilterIsIncome       *bool
+	// FilterAmountMin, FilterAmountMax - границы суммы по модулю включительно
+	FilterAmountMin *decimal.Decimal
+	FilterAmountMax *decimal.Decimal
+	// FilterSearch - полнотекстовый поиск по описанию с русской и английской морфологией
+	FilterSearch *string
+	// FilterTags - транзакции, у которых есть все перечисленные теги
+	FilterTags            []string
+	FilterWalletID        *uuid.UUID
+	FilterTransferID      *uuid.UUID
+	FilterRecurringRuleID *uuid.UUID
+	// FilterBefore - транзакции строго после курсора в порядке (occurred_on, created_at, id) по убыванию
+	FilterBefore *TransactionListCursor
+	Sort         []uctypes.SortOption[TransactionListOptionsSortField]
+}
+
+// TransactionListCursor - позиция для keyset-пагинации списка транзакций
+type TransactionListCursor struct {
+	OccurredOn civil.Date `json:"o"`
+	CreatedAt  time.Time  `json:"c"`
+	ID         uuid.UUID  `json:"i"`
+}
+
+// Token - непрозрачный токен курсора для API
+func (c *TransactionListCursor) Token() string {
+	return encodeCursor(c)
+}
+
+func ParseTransactionListCursor(token string) (*TransactionListCursor, error) {
+	out := &TransactionListCursor{}
+
+	err := decodeCursor(token, out)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// TransactionCursorPage - страница keyset-пагинации в порядке (occurred_on, created_at, id) по убыванию
+type TransactionCursorPage struct {
+	Items []*TransactionDTO
+	// NextCursor - nil на последней странице
+	NextCursor *TransactionListCursor
+	// Total - заполняется по запросу, без учета курсора
+	Total *uint64
 }
 
 type TransactionListOptionsSortField string
@@ -23,37 +70,283 @@ type TransactionListOptionsSortField string
 const (
 	TransactionListOptionsSortFieldOccurredOn TransactionListOptionsSortField = "occurred_on"
 	TransactionListOptionsSortFieldCreatedAt  TransactionListOptionsSortField = "created_at"
+	TransactionListOptionsSortFieldID         TransactionListOptionsSortField = "id"
+	// TransactionListOptionsSortFieldAmount - сортировка по сумме по модулю
+	TransactionListOptionsSortFieldAmount TransactionListOptionsSortField = "amount"
 )
 
 type TransactionDTO struct {
 	Transaction *entity.Transaction
 	Category    *entity.Category
+	Wallet      *entity.Wallet
+	// Warnings - предупреждения по бюджетам, заполняются при создании транзакции
+	Warnings []*entity.BudgetWarning
 }
 
 type CreateTransactionDataInput struct {
-	AccountID   uuid.UUID
-	IsIncome    bool
-	Amount      decimal.Decimal
-	OccurredOn  civil.Date
+	AccountID uuid.UUID
+	IsIncome  bool
+	Amount    decimal.Decimal
+	// Currency - пустая строка: валюта кошелька, либо базовая валюта аккаунта
+	Currency   string
+	OccurredOn civil.Date
+	// CategoryID - 0: категория подбирается по правилам аккаунта
 	CategoryID  uint64
+	WalletID    *uuid.UUID
 	Description string
+	Tags        []string
+	// RecurringRuleID - заполняется при материализации регулярной транзакции
+	RecurringRuleID *uuid.UUID
+	// ExternalID, ImportFingerprint, DuplicateOfID - заполняются при импорте из файла
+	ExternalID        *string
+	ImportFingerprint *string
+	DuplicateOfID     *uuid.UUID
+}
+
+// TransactionsBatchMaxItems - ограничение пакетного создания транзакций за один запрос
+const TransactionsBatchMaxItems = 50000
+
+// CreateTransactionsBatchInput - пакет транзакций одного аккаунта, AccountID элементов не используется
+type CreateTransactionsBatchInput struct {
+	AccountID uuid.UUID
+	Items     []CreateTransactionDataInput
+}
+
+type TransactionsBatchResult struct {
+	// IDs - идентификаторы созданных транзакций в порядке элементов пакета
+	IDs []uuid.UUID
+	// Warnings - предупреждения по бюджетам с учетом всего пакета
+	Warnings []*entity.BudgetWarning
 }
 
 type PatchTransactionDataInput struct {
 	Version int64
 
-	Amount      *decimal.Decimal
-	OccurredOn  *civil.Date
-	CategoryID  *uint64
+	Amount     *decimal.Decimal
+	Currency   *string
+	OccurredOn *civil.Date
+	CategoryID *uint64
+	// WalletID - новый кошелек, uuid.Nil - отвязать от кошелька
+	WalletID    *uuid.UUID
 	Description *string
+	// Tags - теги заменяются целиком
+	Tags *[]string
+}
+
+type TransferDTO struct {
+	Transfer   *entity.Transfer
+	FromWallet *entity.Wallet
+	ToWallet   *entity.Wallet
+}
+
+type CreateTransferDataInput struct {
+	AccountID    uuid.UUID
+	FromWalletID uuid.UUID
+	ToWalletID   uuid.UUID
+	Amount       decimal.Decimal
+	OccurredOn   civil.Date
+	Description  string
+}
+
+type PatchTransferDataInput struct {
+	Version int64
+
+	FromWalletID *uuid.UUID
+	ToWalletID   *uuid.UUID
+	Amount       *decimal.Decimal
+	OccurredOn   *civil.Date
+	Description  *string
+}
+
+// TransactionImportFormat - формат файла выписки
+type TransactionImportFormat string
+
+const (
+	TransactionImportFormatCSV TransactionImportFormat = "csv"
+	// TransactionImportFormatOFX - OFX 2.x (XML)
+	TransactionImportFormatOFX TransactionImportFormat = "ofx"
+	TransactionImportFormatQIF TransactionImportFormat = "qif"
+	// TransactionImportFormatCAMT053 - ISO 20022 camt.053, выписка по счету
+	TransactionImportFormatCAMT053 TransactionImportFormat = "camt053"
+)
+
+func (f TransactionImportFormat) IsValid() bool {
+	switch f {
+	case TransactionImportFormatCSV,
+		TransactionImportFormatOFX,
+		TransactionImportFormatQIF,
+		TransactionImportFormatCAMT053:
+		return true
+	}
+
+	return false
+}
+
+// TransactionImportMode - режим импорта транзакций из файла
+type TransactionImportMode string
+
+const (
+	// TransactionImportModeStrict - импорт прерывается на первой отклоненной строке
+	TransactionImportModeStrict TransactionImportMode = "strict"
+	// TransactionImportModePartial - импортируются корректные строки, отклоненные возвращаются в отчете
+	TransactionImportModePartial TransactionImportMode = "partial"
+)
+
+func (m TransactionImportMode) IsValid() bool {
+	switch m {
+	case TransactionImportModeStrict, TransactionImportModePartial:
+		return true
+	}
+
+	return false
+}
+
+// TransactionImportDuplicatePolicy - действие со строкой, совпавшей с существующей транзакцией
+type TransactionImportDuplicatePolicy string
+
+const (
+	// TransactionImportDuplicatePolicySkip - строка не импортируется
+	TransactionImportDuplicatePolicySkip TransactionImportDuplicatePolicy = "skip"
+	// TransactionImportDuplicatePolicyUpdate - дата, категория и описание существующей транзакции обновляются из строки
+	TransactionImportDuplicatePolicyUpdate TransactionImportDuplicatePolicy = "update"
+	// TransactionImportDuplicatePolicyFlag - строка импортируется со ссылкой на совпавшую транзакцию
+	TransactionImportDuplicatePolicyFlag TransactionImportDuplicatePolicy = "flag"
+)
+
+func (p TransactionImportDuplicatePolicy) IsValid() bool {
+	switch p {
+	case TransactionImportDuplicatePolicySkip,
+		TransactionImportDuplicatePolicyUpdate,
+		TransactionImportDuplicatePolicyFlag:
+		return true
+	}
+
+	return false
+}
+
+// TransactionImportMaxMatchToleranceDays - максимальный допуск по дате при поиске дубликатов
+const TransactionImportMaxMatchToleranceDays = 31
+
+type TransactionImportRowStatus string
+
+const (
+	TransactionImportRowStatusOK             TransactionImportRowStatus = "ok"
+	TransactionImportRowStatusError          TransactionImportRowStatus = "error"
+	TransactionImportRowStatusDuplicate      TransactionImportRowStatus = "duplicate"
+	TransactionImportRowStatusUpdated        TransactionImportRowStatus = "updated"
+	TransactionImportRowStatusFlagged        TransactionImportRowStatus = "flagged"
+	TransactionImportRowStatusBudgetExceeded TransactionImportRowStatus = "budget_exceeded"
+)
+
+type ImportTransactionsFromCSVInput struct {
+	Data      []byte
+	AccountID uuid.UUID
+	// Format - пустое значение: TransactionImportFormatCSV
+	Format TransactionImportFormat
+	// ImportProfileID - профиль разбора выписки аккаунта, nil - формат по умолчанию.
+	// Для форматов кроме CSV из профиля используется только категория по умолчанию
+	ImportProfileID *uuid.UUID
+	// DefaultCategoryID - категория операций выписок OFX, QIF и camt.053, приоритетнее категории профиля.
+	// Строкам без категории она подбирается по правилам аккаунта
+	DefaultCategoryID *uint64
+	// Mode - пустое значение: TransactionImportModeStrict
+	Mode TransactionImportMode
+	// DryRun - строки проверяются и сохраняются в транзакции БД, которая затем откатывается
+	DryRun bool
+	// DuplicatePolicy - пустое значение: TransactionImportDuplicatePolicySkip
+	DuplicatePolicy TransactionImportDuplicatePolicy
+	// MatchToleranceDays - допустимое расхождение дат строки и существующей транзакции в днях
+	MatchToleranceDays int
+}
+
+// TransactionImportRow - строка файла импорта
+type TransactionImportRow struct {
+	// Line - номер строки в файле начиная с 1, для выписок OFX, QIF и camt.053 - номер операции
+	Line int
+	// Transaction - разобранная транзакция, nil - строку не удалось разобрать
+	Transaction *entity.Transaction
+	Status      TransactionImportRowStatus
+	// MatchedID - существующая транзакция, с которой совпала строка
+	MatchedID *uuid.UUID
+	// Err - причина отклонения строки
+	Err        error
+	ErrorCodes []string
+	Hints      []string
+}
+
+type TransactionImportReport struct {
+	Rows []*TransactionImportRow
+	// Imported - создано транзакций, включая помеченные дубликаты, при DryRun - сколько было бы создано
+	Imported int
+	// Rejected - строки со статусом error или budget_exceeded
+	Rejected int
+	// Skipped - пропущенные строки-дубликаты существующих транзакций
+	Skipped int
+	// Updated - существующие транзакции, обновленные из строк-дубликатов
+	Updated int
+	// Flagged - строки, импортированные с пометкой дубликата
+	Flagged int
+	DryRun  bool
+}
+
+// ExportFormat - формат файла выгрузки
+type ExportFormat string
+
+const (
+	ExportFormatCSV ExportFormat = "csv"
+	// ExportFormatXLSX - суммы и даты записываются числовыми ячейками и ячейками дат
+	ExportFormatXLSX ExportFormat = "xlsx"
+	// ExportFormatJSONL - один JSON-объект на строку
+	ExportFormatJSONL ExportFormat = "jsonl"
+)
+
+func (f ExportFormat) IsValid() bool {
+	switch f {
+	case ExportFormatCSV, ExportFormatXLSX, ExportFormatJSONL:
+		return true
+	}
+
+	return false
+}
+
+// ExportTransactionsMaxItems - ограничение выгрузки в файл, чтобы ответ помещался в сообщение gRPC (4 МБ).
+// Полную историю в CSV отдает StreamListAsCSV
+const ExportTransactionsMaxItems = 10000
+
+type ExportFile struct {
+	Data          []byte
+	ContentType   string
+	FileExtension string
+}
+
+// ReportExportRow - строка выгрузки отчета: категория за период с бюджетом
+type ReportExportRow struct {
+	PeriodStart      civil.Date
+	PeriodEnd        civil.Date
+	Currency         string
+	CategoryID       uint64
+	CategoryTitle    string
+	ParentCategoryID *uint64
+	Sum              *decimal.Decimal
+	TotalSum         *decimal.Decimal
+	Budget           *decimal.Decimal
+	CarriedOver      *decimal.Decimal
+	EffectiveBudget  *decimal.Decimal
+	// SpentBudget - процент использования бюджета
+	SpentBudget      *decimal.Decimal
+	TotalBudget      *decimal.Decimal
+	TotalSpentBudget *decimal.Decimal
 }
 
 type CountReportItemsQueryFilter struct {
-	AccountID  uuid.UUID
-	DateFrom   *civil.Date
-	DateTo     *civil.Date
-	CategoryID *uint64
-	ExcludeIDs []uuid.UUID
+	AccountID uuid.UUID
+	// BaseCurrency - валюта отчета, суммы пересчитываются по курсу на дату транзакции
+	BaseCurrency string
+	DateFrom     *civil.Date
+	DateTo       *civil.Date
+	CategoryID   *uint64
+	CategoryIDs  []uint64
+	ExcludeIDs   []uuid.UUID
 }
 
 //go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.TransactionUsecase -o mocks/transaction_usecase.go
@@ -76,6 +369,14 @@ type TransactionUsecase interface {
 		queryParams *uctypes.QueryGetListParams,
 	) (resItems []*TransactionDTO, total uint64, resErr error)
 
+	// FindCursorList - сортировка listOptions игнорируется, позиция задается FilterBefore
+	FindCursorList(
+		ctx context.Context,
+		listOptions *TransactionListOptions,
+		limit uint64,
+		withTotal bool,
+	) (resPage *TransactionCursorPage, resErr error)
+
 	FindListInMap(
 		ctx context.Context,
 		listOptions *TransactionListOptions,
@@ -87,16 +388,34 @@ type TransactionUsecase interface {
 		in CreateTransactionDataInput,
 	) (resTransactionDTO *TransactionDTO, resErr error)
 
+	// CreateTransactionsBatch - создает транзакции одной транзакцией БД: ошибка в любом элементе
+	// отменяет весь пакет. Бюджеты проверяются по итогу пакета, один раз на период и категорию
+	CreateTransactionsBatch(
+		ctx context.Context,
+		in CreateTransactionsBatchInput,
+	) (resResult *TransactionsBatchResult, resErr error)
+
+	// ResolveCurrency - валюта новой операции: явно заданная, валюта кошелька, либо базовая валюта аккаунта.
+	// Кошелек должен принадлежать аккаунту и не находиться в архиве
+	ResolveCurrency(
+		ctx context.Context,
+		accountID uuid.UUID,
+		currency string,
+		walletID *uuid.UUID,
+	) (resCurrency string, resErr error)
+
 	PatchTransactionByDTO(
 		ctx context.Context,
 		id uuid.UUID,
 		in PatchTransactionDataInput,
 		skipVersionCheck bool,
-	) (resErr error)
+	) (resWarnings []*entity.BudgetWarning, resErr error)
 
 	DeleteTransactionByID(
 		ctx context.Context,
 		id uuid.UUID,
+		version int64,
+		skipVersionCheck bool,
 	) (resErr error)
 
 	CountReportItems(
@@ -104,17 +423,69 @@ type TransactionUsecase interface {
 		queryFilter CountReportItemsQueryFilter,
 	) (items []*entity.ReportItem, cacheHit bool, err error)
 
+	FindTransferByID(
+		ctx context.Context,
+		id uuid.UUID,
+		queryParams *uctypes.QueryGetOneParams,
+	) (resItem *TransferDTO, resErr error)
+
+	CreateTransferByDTO(
+		ctx context.Context,
+		in CreateTransferDataInput,
+	) (resTransferDTO *TransferDTO, resErr error)
+
+	PatchTransferByDTO(
+		ctx context.Context,
+		id uuid.UUID,
+		in PatchTransferDataInput,
+		skipVersionCheck bool,
+	) (resErr error)
+
+	DeleteTransferByID(
+		ctx context.Context,
+		id uuid.UUID,
+	) (resErr error)
+
 	FindPagedListAsCSV(
 		ctx context.Context,
 		listOptions *TransactionListOptions,
 		queryParams *uctypes.QueryGetListParams,
 	) (res []byte, total uint64, resErr error)
 
-	ImportTransactionsFromCSV(
+	// StreamListAsCSV - выгружает все транзакции по фильтру частями по batchSize, пролистывая их курсором
+	StreamListAsCSV(
 		ctx context.Context,
-		data []byte,
-		accountID uuid.UUID,
+		listOptions *TransactionListOptions,
+		batchSize uint64,
+		write func(chunk []byte) error,
 	) (resErr error)
+
+	// ExportTransactions - выгрузка транзакций по фильтру в файл, не больше ExportTransactionsMaxItems
+	ExportTransactions(
+		ctx context.Context,
+		format ExportFormat,
+		listOptions *TransactionListOptions,
+	) (resFile *ExportFile, total uint64, resErr error)
+
+	// ExportReports - выгрузка отчетов CountReportItems в файл с названиями категорий и бюджетами
+	ExportReports(
+		ctx context.Context,
+		format ExportFormat,
+		queryFilter CountReportItemsQueryFilter,
+	) (resFile *ExportFile, resErr error)
+
+	// ImportTransactionsFromCSV - в режиме strict без DryRun возвращает ошибку первой отклоненной строки
+	ImportTransactionsFromCSV(
+		ctx context.Context,
+		in ImportTransactionsFromCSVInput,
+	) (resReport *TransactionImportReport, resErr error)
+
+	// ReapplyCategoryRules - заново подбирает категории транзакций периода по правилам аккаунта.
+	// Транзакции, не подошедшие ни под одно правило, не изменяются
+	ReapplyCategoryRules(
+		ctx context.Context,
+		in ReapplyCategoryRulesInput,
+	) (resReport *ReapplyCategoryRulesReport, resErr error)
 }
 
 //go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.TransactionRepository -o mocks/transaction_repository.go
@@ -137,10 +508,17 @@ type TransactionRepository interface {
 		queryParams *uctypes.QueryGetListParams,
 	) (items []*entity.Transaction, total uint64, err error)
 
+	Count(ctx context.Context, listOptions *TransactionListOptions) (total uint64, err error)
+
 	Create(ctx context.Context, item *entity.Transaction) (err error)
 
+	// CreateBatch - вставка пакета через COPY, пакет вставляется целиком либо не вставляется
+	CreateBatch(ctx context.Context, items []*entity.Transaction) (err error)
+
 	Update(ctx context.Context, item *entity.Transaction) (err error)
 
+	ReassignCategory(ctx context.Context, fromCategoryID uint64, toCategoryID uint64) (err error)
+
 	CountReportItems(
 		ctx context.Context,
 		queryFilter CountReportItemsQueryFilter,
@@ -158,5 +536,68 @@ type TransactionCacheRepository interface {
 type TransactionCSVRepository interface {
 	ItemsToCSV(ctx context.Context, items []*TransactionDTO) ([]byte, error)
 
-	ItemsFromCSV(ctx context.Context, data []byte, accountID uuid.UUID) (items []*entity.Transaction, err error)
+	// ItemsToCSVChunk - часть CSV для потоковой выгрузки, withHeader - добавить BOM и заголовок
+	ItemsToCSVChunk(ctx context.Context, items []*TransactionDTO, withHeader bool) ([]byte, error)
+
+	// ItemsFromCSV - defaultCurrency используется для строк без колонки валюты,
+	// profile - настройки разбора выписки, nil - позиционный формат по умолчанию.
+	// Ошибки разбора строк возвращаются в Err строки, ошибка метода - файл не удалось прочитать
+	ItemsFromCSV(
+		ctx context.Context,
+		data []byte,
+		accountID uuid.UUID,
+		defaultCurrency string,
+		profile *entity.ImportProfile,
+	) (rows []*TransactionImportRow, err error)
+}
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.TransactionStatementRepository -o mocks/transaction_statement_repository.go
+type TransactionStatementRepository interface {
+	Format() TransactionImportFormat
+
+	// ItemsFromStatement - разбор банковской выписки, defaultCurrency используется для операций без валюты,
+	// defaultCategoryID - категория всех операций, nil - строки отклоняются.
+	// Ошибки разбора операций возвращаются в Err строки, ошибка метода - файл не удалось прочитать
+	ItemsFromStatement(
+		ctx context.Context,
+		data []byte,
+		accountID uuid.UUID,
+		defaultCurrency string,
+		defaultCategoryID *uint64,
+	) (rows []*TransactionImportRow, err error)
+}
+
+// TransactionStatementRepositories - разборщики выписок по форматам
+type TransactionStatementRepositories map[TransactionImportFormat]TransactionStatementRepository
+
+func NewTransactionStatementRepositories(items []TransactionStatementRepository) TransactionStatementRepositories {
+	out := make(TransactionStatementRepositories, len(items))
+	for _, item := range items {
+		out[item.Format()] = item
+	}
+
+	return out
+}
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.ExportRepository -o mocks/export_repository.go
+type ExportRepository interface {
+	Format() ExportFormat
+	ContentType() string
+	FileExtension() string
+
+	TransactionsToFile(ctx context.Context, items []*TransactionDTO) ([]byte, error)
+
+	ReportsToFile(ctx context.Context, rows []*ReportExportRow) ([]byte, error)
+}
+
+// ExportRepositories - выгрузка в файл по форматам
+type ExportRepositories map[ExportFormat]ExportRepository
+
+func NewExportRepositories(items []ExportRepository) ExportRepositories {
+	out := make(ExportRepositories, len(items))
+	for _, item := range items {
+		out[item.Format()] = item
+	}
+
+	return out
 }
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"time"
 
-	"cloud.google.com/go/civil"
 	"github.com/google/uuid"
 	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
 	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
@@ -31,10 +30,16 @@ func (uc *UsecaseImpl) CreateTransactionByDTO(
 		}
 	}
 
+	currency, err := uc.resolveCurrency(ctx, in.AccountID, in.Currency, in.WalletID)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
 	transaction, err := entity.NewTransaction(
 		in.AccountID,
 		in.IsIncome,
 		in.Amount,
+		currency,
 		in.OccurredOn,
 		in.CategoryID,
 	)
@@ -47,61 +52,43 @@ func (uc *UsecaseImpl) CreateTransactionByDTO(
 		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
 	}
 
+	err = transaction.SetTags(in.Tags)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	transaction.WalletID = in.WalletID
+	transaction.RecurringRuleID = in.RecurringRuleID
+	transaction.ExternalID = in.ExternalID
+	transaction.ImportFingerprint = in.ImportFingerprint
+	transaction.DuplicateOfID = in.DuplicateOfID
+
+	var warnings []*entity.BudgetWarning
+
 	err = uc.dbMasterClient.DoWithIsoLvl(ctx, pgclient.Serializable, func(ctx context.Context) error {
-		_, err := uc.categoryRepo.FindOneByID(ctx, transaction.CategoryID, nil)
-		if err != nil {
-			if errors.Is(err, appErrors.ErrNotFound) {
-				return appErrors.Chainf(appErrors.ErrBadRequest.WithHints("category not found"), "%s.%s", uc.pkg, op)
+		if transaction.CategoryID == 0 {
+			err := uc.applyCategoryRules(ctx, transaction)
+			if err != nil {
+				return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
 			}
-			return err
 		}
 
-		if !in.IsIncome {
-			period := civil.Date{
-				Year:  transaction.OccurredOn.Year,
-				Month: transaction.OccurredOn.Month,
-				Day:   1,
-			}
-
-			budgetCheck, err := uc.budgetRepo.FindList(ctx, &usecase.BudgetListOptions{
-				FilterAccountID:  &transaction.AccountID,
-				FilterPeriod:     &period,
-				FilterCategoryID: &transaction.CategoryID,
-			}, &uctypes.QueryGetListParams{
-				Limit: 1,
-			})
+		err := uc.categoryUC.CheckCategoryAccess(ctx, transaction.CategoryID, transaction.AccountID)
+		if err != nil {
+			return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+		}
+
+		if transaction.WalletID != nil {
+			err = uc.checkTransactionWallet(ctx, transaction)
 			if err != nil {
-				return err
+				return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
 			}
+		}
 
-			if len(budgetCheck) > 0 {
-				budget := budgetCheck[0]
-
-				periodEnd := period.AddMonths(1).AddDays(-1)
-
-				reports, err := uc.transactionRepo.CountReportItems(ctx, usecase.CountReportItemsQueryFilter{
-					AccountID:  transaction.AccountID,
-					DateFrom:   &period,
-					DateTo:     &periodEnd,
-					CategoryID: &transaction.CategoryID,
-				})
-				if err != nil {
-					return err
-				}
-
-				balance := transaction.Amount
-				for _, report := range reports {
-					if report.Sum != nil {
-						balance, err = balance.Add(*report.Sum)
-						if err != nil {
-							return err
-						}
-					}
-				}
-
-				if balance.Cmp(budget.Amount.Neg()) == -1 {
-					return appErrors.Chainf(appErrors.ErrBadRequest.WithHints("budget limit exceeded"), "%s.%s", uc.pkg, op)
-				}
+		if !in.IsIncome {
+			warnings, err = uc.checkBudgetLimit(ctx, transaction, transaction.Amount, nil)
+			if err != nil {
+				return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
 			}
 		}
 
@@ -110,12 +97,19 @@ func (uc *UsecaseImpl) CreateTransactionByDTO(
 			return err
 		}
 
+		err = uc.createBudgetOutboxEvents(ctx, transaction, warnings)
+		if err != nil {
+			return err
+		}
+
 		return nil
 	})
 	if err != nil {
 		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
 	}
 
+	uc.invalidateReportsCache(ctx, transaction.AccountID)
+
 	transactionDTO, err := uc.entitiesToDTO(ctx, []*entity.Transaction{transaction})
 	if err != nil {
 		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
@@ -126,17 +120,100 @@ func (uc *UsecaseImpl) CreateTransactionByDTO(
 		return nil, appErrors.Chainf(appErrors.ErrInternal, "%s.%s", uc.pkg, op)
 	}
 
+	transactionDTO[0].Warnings = warnings
+
 	return transactionDTO[0], nil
 }
 
+func (uc *UsecaseImpl) CreateTransactionsBatch(
+	ctx context.Context,
+	in usecase.CreateTransactionsBatchInput,
+) (*usecase.TransactionsBatchResult, error) {
+	const op = "CreateTransactionsBatch"
+
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != in.AccountID {
+			return nil, appErrors.ErrForbidden
+		}
+	}
+
+	if len(in.Items) == 0 {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("items are required"), "%s.%s", uc.pkg, op)
+	}
+
+	if len(in.Items) > usecase.TransactionsBatchMaxItems {
+		return nil, appErrors.Chainf(
+			appErrors.ErrBadRequest.WithHints(fmt.Sprintf("items must be <= %d", usecase.TransactionsBatchMaxItems)),
+			"%s.%s", uc.pkg, op,
+		)
+	}
+
+	baseCurrency, err := uc.baseCurrency(ctx, in.AccountID)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	var (
+		transactions []*entity.Transaction
+		warnings     []*entity.BudgetWarning
+	)
+
+	err = uc.dbMasterClient.DoWithIsoLvl(ctx, pgclient.Serializable, func(ctx context.Context) error {
+		batch, err := uc.newTransactionsBatch(ctx, in.AccountID, baseCurrency)
+		if err != nil {
+			return err
+		}
+
+		transactions = make([]*entity.Transaction, 0, len(in.Items))
+		for i, item := range in.Items {
+			transaction, err := uc.newBatchTransaction(ctx, batch, item)
+			if err != nil {
+				return withBatchItemHint(err, i)
+			}
+
+			transactions = append(transactions, transaction)
+		}
+
+		err = uc.transactionRepo.CreateBatch(ctx, transactions)
+		if err != nil {
+			return err
+		}
+
+		var crossedBy map[uuid.UUID]*entity.Transaction
+
+		warnings, crossedBy, err = uc.checkBatchBudgetLimits(ctx, batch, transactions)
+		if err != nil {
+			return err
+		}
+
+		return uc.createBatchOutboxEvents(ctx, warnings, crossedBy)
+	})
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	uc.invalidateReportsCache(ctx, in.AccountID)
+
+	return &usecase.TransactionsBatchResult{
+		IDs: lo.Map(transactions, func(item *entity.Transaction, _ int) uuid.UUID {
+			return item.ID
+		}),
+		Warnings: warnings,
+	}, nil
+}
+
 func (uc *UsecaseImpl) PatchTransactionByDTO(
 	ctx context.Context,
 	id uuid.UUID,
 	in usecase.PatchTransactionDataInput,
 	skipVersionCheck bool,
-) error {
+) ([]*entity.BudgetWarning, error) {
 	const op = "PatchTransactionByDTO"
 
+	var warnings []*entity.BudgetWarning
+	var accountID uuid.UUID
+
 	err := uc.dbMasterClient.DoWithIsoLvl(ctx, pgclient.Serializable, func(ctx context.Context) error {
 		transaction, err := uc.transactionRepo.FindOneByID(ctx, id, &uctypes.QueryGetOneParams{
 			ForUpdate: true,
@@ -145,6 +222,8 @@ func (uc *UsecaseImpl) PatchTransactionByDTO(
 			return err
 		}
 
+		accountID = transaction.AccountID
+
 		if auth.IsNeedToCheckRights(ctx) {
 			authData := auth.GetAuthData(ctx)
 			if authData == nil || authData.AccountID != transaction.AccountID {
@@ -152,6 +231,11 @@ func (uc *UsecaseImpl) PatchTransactionByDTO(
 			}
 		}
 
+		err = checkNotTransfer(transaction)
+		if err != nil {
+			return err
+		}
+
 		if !skipVersionCheck && transaction.Version() != in.Version {
 			return appErrors.ErrVersionConflict.
 				WithDetail("last_version", false, transaction.Version()).
@@ -159,81 +243,57 @@ func (uc *UsecaseImpl) PatchTransactionByDTO(
 		}
 
 		if in.CategoryID != nil {
-			_, err := uc.categoryRepo.FindOneByID(ctx, *in.CategoryID, nil)
+			err := uc.categoryUC.CheckCategoryAccess(ctx, *in.CategoryID, transaction.AccountID)
 			if err != nil {
-				if errors.Is(err, appErrors.ErrNotFound) {
-					return appErrors.Chainf(appErrors.ErrBadRequest.WithHints("category not found"), "%s.%s", uc.pkg, op)
-				}
-				return err
+				return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
 			}
 
 			transaction.CategoryID = *in.CategoryID
 		}
 
-		if in.OccurredOn != nil {
-			err := transaction.SetOccuredOn(*in.OccurredOn)
+		if in.Currency != nil {
+			err = transaction.SetCurrency(*in.Currency)
 			if err != nil {
 				return err
 			}
 		}
 
-		if in.Amount != nil {
-			if !transaction.IsIncome {
-				period := civil.Date{
-					Year:  transaction.OccurredOn.Year,
-					Month: transaction.OccurredOn.Month,
-					Day:   1,
-				}
-
-				budgetCheck, err := uc.budgetRepo.FindList(ctx, &usecase.BudgetListOptions{
-					FilterAccountID:  &transaction.AccountID,
-					FilterPeriod:     &period,
-					FilterCategoryID: &transaction.CategoryID,
-				}, &uctypes.QueryGetListParams{
-					Limit: 1,
-				})
-				if err != nil {
-					return err
-				}
-
-				if len(budgetCheck) > 0 {
-					budget := budgetCheck[0]
-
-					periodEnd := period.AddMonths(1).AddDays(-1)
-
-					reports, err := uc.transactionRepo.CountReportItems(ctx, usecase.CountReportItemsQueryFilter{
-						AccountID:  transaction.AccountID,
-						DateFrom:   &period,
-						DateTo:     &periodEnd,
-						CategoryID: &transaction.CategoryID,
-						ExcludeIDs: []uuid.UUID{transaction.ID},
-					})
-					if err != nil {
-						return err
-					}
+		if in.WalletID != nil {
+			if *in.WalletID == uuid.Nil {
+				transaction.WalletID = nil
+			} else {
+				transaction.WalletID = in.WalletID
+			}
+		}
 
-					balance := *in.Amount
-					for _, report := range reports {
-						if report.Sum != nil {
-							balance, err = balance.Add(*report.Sum)
-							if err != nil {
-								return err
-							}
-						}
-					}
+		if transaction.WalletID != nil && (in.WalletID != nil || in.Currency != nil) {
+			err := uc.checkTransactionWallet(ctx, transaction)
+			if err != nil {
+				return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+			}
+		}
 
-					if balance.Cmp(budget.Amount.Neg()) == -1 {
-						return appErrors.Chainf(appErrors.ErrBadRequest.WithHints("budget limit exceeded"), "%s.%s", uc.pkg, op)
-					}
-				}
+		if in.OccurredOn != nil {
+			err := transaction.SetOccuredOn(*in.OccurredOn)
+			if err != nil {
+				return err
 			}
+		}
 
+		if in.Amount != nil {
 			err = transaction.SetAmount(*in.Amount)
 			if err != nil {
 				return err
 			}
 		}
 
+		if !transaction.IsIncome && (in.Amount != nil || in.Currency != nil || in.CategoryID != nil || in.OccurredOn != nil) {
+			warnings, err = uc.checkBudgetLimit(ctx, transaction, transaction.Amount, []uuid.UUID{transaction.ID})
+			if err != nil {
+				return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+			}
+		}
+
 		if in.Description != nil {
 			err = transaction.SetDescription(*in.Description)
 			if err != nil {
@@ -241,23 +301,44 @@ func (uc *UsecaseImpl) PatchTransactionByDTO(
 			}
 		}
 
+		if in.Tags != nil {
+			err = transaction.SetTags(*in.Tags)
+			if err != nil {
+				return err
+			}
+		}
+
 		err = uc.transactionRepo.Update(ctx, transaction)
 		if err != nil {
 			return err
 		}
 
+		err = uc.createBudgetOutboxEvents(ctx, transaction, warnings)
+		if err != nil {
+			return err
+		}
+
 		return nil
 	})
 	if err != nil {
-		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
 	}
 
-	return nil
+	uc.invalidateReportsCache(ctx, accountID)
+
+	return warnings, nil
 }
 
-func (uc *UsecaseImpl) DeleteTransactionByID(ctx context.Context, id uuid.UUID) error {
+func (uc *UsecaseImpl) DeleteTransactionByID(
+	ctx context.Context,
+	id uuid.UUID,
+	version int64,
+	skipVersionCheck bool,
+) error {
 	const op = "DeleteTransactionByID"
 
+	var accountID uuid.UUID
+
 	err := uc.dbMasterClient.Do(ctx, func(ctx context.Context) error {
 		transaction, err := uc.transactionRepo.FindOneByID(ctx, id, &uctypes.QueryGetOneParams{
 			ForUpdate: true,
@@ -266,6 +347,8 @@ func (uc *UsecaseImpl) DeleteTransactionByID(ctx context.Context, id uuid.UUID)
 			return err
 		}
 
+		accountID = transaction.AccountID
+
 		if auth.IsNeedToCheckRights(ctx) {
 			authData := auth.GetAuthData(ctx)
 			if authData == nil || authData.AccountID != transaction.AccountID {
@@ -273,6 +356,17 @@ func (uc *UsecaseImpl) DeleteTransactionByID(ctx context.Context, id uuid.UUID)
 			}
 		}
 
+		err = checkNotTransfer(transaction)
+		if err != nil {
+			return err
+		}
+
+		if !skipVersionCheck && transaction.Version() != version {
+			return appErrors.ErrVersionConflict.
+				WithDetail("last_version", false, transaction.Version()).
+				WithDetail("last_updated_at", false, transaction.UpdatedAt)
+		}
+
 		transaction.DeletedAt = lo.ToPtr(time.Now())
 
 		err = uc.transactionRepo.Update(ctx, transaction)
@@ -286,40 +380,520 @@ func (uc *UsecaseImpl) DeleteTransactionByID(ctx context.Context, id uuid.UUID)
 		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
 	}
 
+	uc.invalidateReportsCache(ctx, accountID)
+
 	return nil
 }
 
 func (uc *UsecaseImpl) ImportTransactionsFromCSV(
 	ctx context.Context,
-	data []byte,
-	accountID uuid.UUID,
-) error {
+	in usecase.ImportTransactionsFromCSVInput,
+) (*usecase.TransactionImportReport, error) {
 	const op = "ImportTransactionsFromCSV"
 
-	items, err := uc.transactionCSVRepo.ItemsFromCSV(ctx, data, accountID)
+	mode := in.Mode
+	if mode == "" {
+		mode = usecase.TransactionImportModeStrict
+	}
+
+	if !mode.IsValid() {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("invalid import mode"), "%s.%s", uc.pkg, op)
+	}
+
+	duplicatePolicy := in.DuplicatePolicy
+	if duplicatePolicy == "" {
+		duplicatePolicy = usecase.TransactionImportDuplicatePolicySkip
+	}
+
+	if !duplicatePolicy.IsValid() {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("invalid duplicate policy"), "%s.%s", uc.pkg, op)
+	}
+
+	if in.MatchToleranceDays < 0 || in.MatchToleranceDays > usecase.TransactionImportMaxMatchToleranceDays {
+		return nil, appErrors.Chainf(
+			appErrors.ErrBadRequest.WithHints(
+				fmt.Sprintf("match tolerance must be from 0 to %d days", usecase.TransactionImportMaxMatchToleranceDays),
+			),
+			"%s.%s", uc.pkg, op,
+		)
+	}
+
+	if in.Format != "" && !in.Format.IsValid() {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("invalid import format"), "%s.%s", uc.pkg, op)
+	}
+
+	baseCurrency, err := uc.baseCurrency(ctx, in.AccountID)
 	if err != nil {
-		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	var importProfile *entity.ImportProfile
+	if in.ImportProfileID != nil {
+		importProfile, err = uc.findImportProfile(ctx, *in.ImportProfileID, in.AccountID)
+		if err != nil {
+			return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+		}
+	}
+
+	rows, err := uc.importRows(ctx, in, baseCurrency, importProfile)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	err = uc.resolveImportCategories(ctx, in.AccountID, rows)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	setImportFingerprints(rows)
+
+	existing, err := uc.importExistingTransactions(ctx, in.AccountID, rows, in.MatchToleranceDays)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	matcher := newImportMatcher(existing, in.MatchToleranceDays)
+
+	report := &usecase.TransactionImportReport{
+		Rows:   rows,
+		DryRun: in.DryRun,
 	}
 
 	err = uc.dbMasterClient.DoWithIsoLvl(ctx, pgclient.Serializable, func(ctx context.Context) error {
-		for idx, item := range items {
-			_, err := uc.CreateTransactionByDTO(ctx, usecase.CreateTransactionDataInput{
-				AccountID:   accountID,
-				IsIncome:    item.IsIncome,
-				Amount:      item.Amount,
-				OccurredOn:  item.OccurredOn,
-				CategoryID:  item.CategoryID,
-				Description: item.Description,
-			})
+		report.Imported, report.Rejected, report.Skipped, report.Updated, report.Flagged = 0, 0, 0, 0, 0
+		matcher.reset()
+
+		reject := func(row *usecase.TransactionImportRow, status usecase.TransactionImportRowStatus, err error) error {
+			rejectImportRow(row, status, err)
+			report.Rejected++
+
+			if mode == usecase.TransactionImportModeStrict && !in.DryRun {
+				return appErrors.Chainf(withImportLineHint(row.Err, row.Line), "%s.%s: line %d", uc.pkg, op, row.Line)
+			}
+
+			return nil
+		}
+
+		accept := func(row *usecase.TransactionImportRow, status usecase.TransactionImportRowStatus) {
+			row.Status = status
+			row.Err, row.ErrorCodes, row.Hints = nil, nil, nil
+
+			switch status {
+			case usecase.TransactionImportRowStatusDuplicate:
+				report.Skipped++
+			case usecase.TransactionImportRowStatusUpdated:
+				report.Updated++
+			case usecase.TransactionImportRowStatusFlagged:
+				report.Flagged++
+				report.Imported++
+			default:
+				report.Imported++
+			}
+		}
+
+		// пробный импорт и обновление совпавших транзакций проходят по одной строке
+		if in.DryRun || duplicatePolicy == usecase.TransactionImportDuplicatePolicyUpdate {
+			for _, row := range rows {
+				row.MatchedID = nil
+
+				if row.Transaction == nil {
+					if err := reject(row, usecase.TransactionImportRowStatusError, row.Err); err != nil {
+						return err
+					}
+
+					continue
+				}
+
+				status, err := uc.importRow(ctx, in.AccountID, row, matcher.match(row.Transaction), duplicatePolicy)
+				if err == nil {
+					accept(row, status)
+					continue
+				}
+
+				if !isImportRowError(err) {
+					return err
+				}
+
+				status = usecase.TransactionImportRowStatusError
+				if errors.Is(err, entity.ErrBudgetLimitExceeded) {
+					status = usecase.TransactionImportRowStatusBudgetExceeded
+				}
+
+				if err := reject(row, status, err); err != nil {
+					return err
+				}
+			}
+
+			if in.DryRun {
+				return errImportDryRun
+			}
+
+			return nil
+		}
+
+		var batch *transactionsBatch
+
+		pending := make([]*importBatchRow, 0, min(len(rows), importBatchSize))
+
+		flush := func() error {
+			inserted, failed, err := uc.insertImportBatch(ctx, batch, pending, mode == usecase.TransactionImportModePartial)
 			if err != nil {
-				appErr, ok := appErrors.ExtractError(err)
-				if ok {
-					hints := appErr.Hints()
-					hints = append(hints, fmt.Sprintf("line %d", idx+1))
+				if failed == nil {
+					return err
+				}
 
-					return appErrors.Chainf(appErr.WithHints(hints...), "%s.%s: line %d", uc.pkg, op, idx+1)
+				status := usecase.TransactionImportRowStatusError
+				if errors.Is(err, entity.ErrBudgetLimitExceeded) {
+					status = usecase.TransactionImportRowStatusBudgetExceeded
 				}
-				return appErrors.Chainf(err, "%s.%s: line %d", uc.pkg, op, idx+1)
+
+				return reject(failed.row, status, err)
+			}
+
+			report.Rejected += len(pending) - len(inserted)
+
+			for _, item := range inserted {
+				accept(item.row, item.status)
+			}
+
+			pending = pending[:0]
+
+			return nil
+		}
+
+		for _, row := range rows {
+			row.MatchedID = nil
+
+			if row.Transaction == nil {
+				if err := reject(row, usecase.TransactionImportRowStatusError, row.Err); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if batch == nil {
+				var err error
+
+				batch, err = uc.newTransactionsBatch(ctx, in.AccountID, baseCurrency)
+				if err != nil {
+					return err
+				}
+			}
+
+			item, err := uc.prepareImportRow(ctx, batch, row, matcher.match(row.Transaction), duplicatePolicy)
+			if err != nil {
+				if !isImportRowError(err) {
+					return err
+				}
+
+				if err := reject(row, usecase.TransactionImportRowStatusError, err); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if item.transaction == nil {
+				accept(row, item.status)
+				continue
+			}
+
+			pending = append(pending, item)
+
+			if len(pending) == importBatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+
+		return flush()
+	})
+	if err != nil && !errors.Is(err, errImportDryRun) {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	// в режиме strict отклоненная строка отменяет весь импорт
+	if mode == usecase.TransactionImportModeStrict && report.Rejected > 0 {
+		report.Imported, report.Updated, report.Flagged = 0, 0, 0
+	}
+
+	if !in.DryRun && report.Imported+report.Updated > 0 {
+		// внутренние вызовы PatchTransactionByDTO сдвигают поколение до фиксации общей транзакции
+		uc.invalidateReportsCache(ctx, in.AccountID)
+	}
+
+	return report, nil
+}
+
+func (uc *UsecaseImpl) ReapplyCategoryRules(
+	ctx context.Context,
+	in usecase.ReapplyCategoryRulesInput,
+) (*usecase.ReapplyCategoryRulesReport, error) {
+	const op = "ReapplyCategoryRules"
+
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != in.AccountID {
+			return nil, appErrors.ErrForbidden
+		}
+	}
+
+	if in.DateTo.Before(in.DateFrom) {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("date_to is before date_from"), "%s.%s", uc.pkg, op)
+	}
+
+	if in.DateTo.DaysSince(in.DateFrom) >= usecase.CategoryRulesReapplyMaxDays {
+		return nil, appErrors.Chainf(
+			appErrors.ErrBadRequest.WithHints(fmt.Sprintf("period must be at most %d days", usecase.CategoryRulesReapplyMaxDays)),
+			"%s.%s", uc.pkg, op,
+		)
+	}
+
+	report := &usecase.ReapplyCategoryRulesReport{
+		DryRun: in.DryRun,
+	}
+
+	// лимиты бюджетов не проверяются: операции уже совершены, меняется только их учет
+	err := uc.dbMasterClient.DoWithIsoLvl(ctx, pgclient.Serializable, func(ctx context.Context) error {
+		report.Checked, report.Changes = 0, nil
+
+		rules, err := uc.categoryRules(ctx, in.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if len(rules) == 0 {
+			return nil
+		}
+
+		listOptions := &usecase.TransactionListOptions{
+			FilterAccountID:      &in.AccountID,
+			FilterOccurredOnFrom: &in.DateFrom,
+			FilterOccurredOnTo:   &in.DateTo,
+		}
+
+		return uc.forEachTransactionPage(ctx, listOptions, exportBatchSize, func(dtos []*usecase.TransactionDTO) error {
+			for _, dto := range dtos {
+				transaction := dto.Transaction
+				if transaction.IsTransfer() {
+					continue
+				}
+
+				report.Checked++
+
+				rule := entity.MatchCategoryRule(rules, transaction)
+				if rule == nil || rule.CategoryID == transaction.CategoryID {
+					continue
+				}
+
+				report.Changes = append(report.Changes, &usecase.CategoryRuleChange{
+					TransactionID: transaction.ID,
+					RuleID:        rule.ID,
+					OldCategoryID: transaction.CategoryID,
+					NewCategoryID: rule.CategoryID,
+				})
+
+				if in.DryRun {
+					continue
+				}
+
+				transaction.CategoryID = rule.CategoryID
+
+				err := uc.transactionRepo.Update(ctx, transaction)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	if !in.DryRun && len(report.Changes) > 0 {
+		uc.invalidateReportsCache(ctx, in.AccountID)
+	}
+
+	return report, nil
+}
+
+func (uc *UsecaseImpl) CreateTransferByDTO(
+	ctx context.Context,
+	in usecase.CreateTransferDataInput,
+) (*usecase.TransferDTO, error) {
+	const op = "CreateTransferByDTO"
+
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != in.AccountID {
+			return nil, appErrors.ErrForbidden
+		}
+	}
+
+	transfer, err := entity.NewTransfer(
+		in.AccountID,
+		in.FromWalletID,
+		in.ToWalletID,
+		in.Amount,
+		in.OccurredOn,
+		in.Description,
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	err = uc.dbMasterClient.DoWithIsoLvl(ctx, pgclient.Serializable, func(ctx context.Context) error {
+		currency, err := uc.checkTransferWallets(ctx, transfer)
+		if err != nil {
+			return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+		}
+
+		err = transfer.SetCurrency(currency)
+		if err != nil {
+			return err
+		}
+
+		// сумма заново приводится к точности валюты кошельков
+		err = transfer.SetAmount(in.Amount)
+		if err != nil {
+			return err
+		}
+
+		for _, leg := range transfer.Legs() {
+			err = uc.transactionRepo.Create(ctx, leg)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	transferDTO, err := uc.transferToDTO(ctx, transfer)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return transferDTO, nil
+}
+
+func (uc *UsecaseImpl) PatchTransferByDTO(
+	ctx context.Context,
+	id uuid.UUID,
+	in usecase.PatchTransferDataInput,
+	skipVersionCheck bool,
+) error {
+	const op = "PatchTransferByDTO"
+
+	err := uc.dbMasterClient.DoWithIsoLvl(ctx, pgclient.Serializable, func(ctx context.Context) error {
+		transfer, err := uc.loadTransfer(ctx, id, &uctypes.QueryGetOneParams{
+			ForUpdate: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		if auth.IsNeedToCheckRights(ctx) {
+			authData := auth.GetAuthData(ctx)
+			if authData == nil || authData.AccountID != transfer.AccountID() {
+				return appErrors.ErrForbidden
+			}
+		}
+
+		if !skipVersionCheck && transfer.Version() != in.Version {
+			return appErrors.ErrVersionConflict.
+				WithDetail("last_version", false, transfer.Version()).
+				WithDetail("last_updated_at", false, transfer.Outcome.UpdatedAt)
+		}
+
+		if in.FromWalletID != nil || in.ToWalletID != nil {
+			err = transfer.SetWallets(
+				lo.FromPtrOr(in.FromWalletID, transfer.FromWalletID()),
+				lo.FromPtrOr(in.ToWalletID, transfer.ToWalletID()),
+			)
+			if err != nil {
+				return err
+			}
+
+			currency, err := uc.checkTransferWallets(ctx, transfer)
+			if err != nil {
+				return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+			}
+
+			err = transfer.SetCurrency(currency)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.Amount != nil {
+			err = transfer.SetAmount(*in.Amount)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.OccurredOn != nil {
+			err = transfer.SetOccuredOn(*in.OccurredOn)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.Description != nil {
+			err = transfer.SetDescription(*in.Description)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, leg := range transfer.Legs() {
+			err = uc.transactionRepo.Update(ctx, leg)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return nil
+}
+
+func (uc *UsecaseImpl) DeleteTransferByID(ctx context.Context, id uuid.UUID) error {
+	const op = "DeleteTransferByID"
+
+	err := uc.dbMasterClient.DoWithIsoLvl(ctx, pgclient.Serializable, func(ctx context.Context) error {
+		transfer, err := uc.loadTransfer(ctx, id, &uctypes.QueryGetOneParams{
+			ForUpdate: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		if auth.IsNeedToCheckRights(ctx) {
+			authData := auth.GetAuthData(ctx)
+			if authData == nil || authData.AccountID != transfer.AccountID() {
+				return appErrors.ErrForbidden
+			}
+		}
+
+		transfer.MarkDeleted()
+
+		for _, leg := range transfer.Legs() {
+			err = uc.transactionRepo.Update(ctx, leg)
+			if err != nil {
+				return err
 			}
 		}
 
@@ -2,15 +2,20 @@ package transaction
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"cloud.google.com/go/civil"
 	"github.com/google/uuid"
 	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 )
 
@@ -20,17 +25,22 @@ func TestTransactionUsecase_CreateTransactionByDTO_OK_income(t *testing.T) {
 	s := newDependencies(t)
 	defer finishDependencies(s)
 
+	s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+
 	accID := uuid.New()
 	catID := uint64(10)
 
-	s.categoryRepo.FindOneByIDMock.Set(func(ctx context.Context, id uint64, _ *uctypes.QueryGetOneParams) (*entity.Category, error) {
+	s.accountSettingsRepo.FindOneByAccountIDMock.Return(nil, appErrors.ErrNotFound)
+
+	s.categoryUC.CheckCategoryAccessMock.Set(func(ctx context.Context, id uint64, _ uuid.UUID) error {
 		require.Equal(t, catID, id)
-		return &entity.Category{ID: id}, nil
+		return nil
 	})
 
 	s.transactionRepo.CreateMock.Set(func(ctx context.Context, item *entity.Transaction) error {
 		require.Equal(t, accID, item.AccountID)
 		require.True(t, item.IsIncome)
+		require.Equal(t, "RUB", item.Currency)
 		require.Equal(t, catID, item.CategoryID)
 
 		require.Equal(t, decimal.MustParse("100.12"), item.Amount)
@@ -60,18 +70,107 @@ func TestTransactionUsecase_CreateTransactionByDTO_OK_income(t *testing.T) {
 	require.Equal(t, "hello", got.Transaction.Description)
 }
 
+func TestTransactionUsecase_CreateTransactionByDTO_Wallet_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+	catID := uint64(10)
+
+	tests := []struct {
+		name   string
+		wallet *entity.Wallet
+
+		wantErr bool
+	}{
+		{
+			name:    "OK",
+			wallet:  &entity.Wallet{ID: uuid.New(), AccountID: accID, Currency: "EUR"},
+			wantErr: false,
+		},
+		{
+			name:    "Negative_foreign_wallet",
+			wallet:  &entity.Wallet{ID: uuid.New(), AccountID: uuid.New(), Currency: "EUR"},
+			wantErr: true,
+		},
+		{
+			name:    "Negative_archived_wallet",
+			wallet:  &entity.Wallet{ID: uuid.New(), AccountID: accID, Currency: "EUR", IsArchived: true},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+
+			s.categoryUC.CheckCategoryAccessMock.Optional().Return(nil)
+
+			s.walletRepo.FindOneByIDMock.Set(func(ctx context.Context, id uuid.UUID, _ *uctypes.QueryGetOneParams) (*entity.Wallet, error) {
+				require.Equal(t, tt.wallet.ID, id)
+				return tt.wallet, nil
+			})
+
+			if !tt.wantErr {
+				s.transactionRepo.CreateMock.Set(func(ctx context.Context, item *entity.Transaction) error {
+					require.NotNil(t, item.WalletID)
+					require.Equal(t, tt.wallet.ID, *item.WalletID)
+					require.Equal(t, tt.wallet.Currency, item.Currency)
+					return nil
+				})
+
+				s.categoryRepo.FindListMock.Return([]*entity.Category{{ID: catID}}, nil)
+
+				s.walletRepo.FindListMock.Set(func(ctx context.Context, opt *usecase.WalletListOptions, _ *uctypes.QueryGetListParams) ([]*entity.Wallet, error) {
+					require.NotNil(t, opt.FilterIDs)
+					require.Equal(t, []uuid.UUID{tt.wallet.ID}, *opt.FilterIDs)
+					return []*entity.Wallet{tt.wallet}, nil
+				})
+			}
+
+			got, err := s.uc.CreateTransactionByDTO(testCtx(), usecase.CreateTransactionDataInput{
+				AccountID:  accID,
+				IsIncome:   true,
+				Amount:     decimal.MustParse("10"),
+				OccurredOn: civil.Date{Year: 2025, Month: 12, Day: 15},
+				CategoryID: catID,
+				WalletID:   &tt.wallet.ID,
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			require.Same(t, tt.wallet, got.Wallet)
+		})
+	}
+}
+
 func TestTransactionUsecase_CreateTransactionByDTO_OK_outcome_no_budget(t *testing.T) {
 	t.Parallel()
 
 	s := newDependencies(t)
 	defer finishDependencies(s)
 
+	s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+
 	accID := uuid.New()
 	catID := uint64(7)
 
-	s.categoryRepo.FindOneByIDMock.Set(func(ctx context.Context, id uint64, _ *uctypes.QueryGetOneParams) (*entity.Category, error) {
+	s.accountSettingsRepo.FindOneByAccountIDMock.Return(&entity.AccountSettings{AccountID: accID, BaseCurrency: "USD"}, nil)
+
+	s.categoryUC.CheckCategoryAccessMock.Set(func(ctx context.Context, id uint64, _ uuid.UUID) error {
 		require.Equal(t, catID, id)
-		return &entity.Category{ID: id}, nil
+		return nil
 	})
 
 	s.budgetRepo.FindListMock.Set(func(ctx context.Context, opt *usecase.BudgetListOptions, qp *uctypes.QueryGetListParams) ([]*entity.Budget, error) {
@@ -125,6 +224,8 @@ func TestTransactionUsecase_PatchTransactionByDTO_OK(t *testing.T) {
 	s := newDependencies(t)
 	defer finishDependencies(s)
 
+	s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+
 	id := uuid.New()
 	accID := uuid.New()
 
@@ -158,12 +259,127 @@ func TestTransactionUsecase_PatchTransactionByDTO_OK(t *testing.T) {
 		return nil
 	})
 
-	err := s.uc.PatchTransactionByDTO(testCtx(), id, usecase.PatchTransactionDataInput{
+	warnings, err := s.uc.PatchTransactionByDTO(testCtx(), id, usecase.PatchTransactionDataInput{
 		Version:     now.UnixMicro(),
 		Amount:      &newAmount,
 		Description: &newDesc,
 	}, true)
 	require.NoError(t, err)
+	require.Empty(t, warnings)
+}
+
+func TestTransactionUsecase_CreateTransactionByDTO_Tags_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+	catID := uint64(10)
+
+	tooManyTags := make([]string, 0, entity.TransactionMaxTags+1)
+	for i := 0; i <= entity.TransactionMaxTags; i++ {
+		tooManyTags = append(tooManyTags, fmt.Sprintf("tag%d", i))
+	}
+
+	tests := []struct {
+		name string
+		tags []string
+
+		wantTags []string
+		wantErr  bool
+	}{
+		{
+			name:     "OK_normalized",
+			tags:     []string{" Trip ", "", "trip", "Отпуск"},
+			wantTags: []string{"trip", "отпуск"},
+		},
+		{
+			name:     "OK_no_tags",
+			wantTags: []string{},
+		},
+		{
+			name:    "Negative_too_many_tags",
+			tags:    tooManyTags,
+			wantErr: true,
+		},
+		{
+			name:    "Negative_too_long_tag",
+			tags:    []string{strings.Repeat("я", entity.TransactionMaxTagLen+1)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+			s.accountSettingsRepo.FindOneByAccountIDMock.Optional().Return(nil, appErrors.ErrNotFound)
+			s.categoryUC.CheckCategoryAccessMock.Optional().Return(nil)
+			s.categoryRepo.FindListMock.Optional().Return([]*entity.Category{{ID: catID}}, nil)
+
+			if !tt.wantErr {
+				s.transactionRepo.CreateMock.Set(func(ctx context.Context, item *entity.Transaction) error {
+					require.Equal(t, tt.wantTags, item.Tags)
+					return nil
+				})
+			}
+
+			_, err := s.uc.CreateTransactionByDTO(testCtx(), usecase.CreateTransactionDataInput{
+				AccountID:  accID,
+				IsIncome:   true,
+				Amount:     decimal.MustParse("10"),
+				OccurredOn: civil.Date{Year: 2025, Month: 12, Day: 15},
+				CategoryID: catID,
+				Tags:       tt.tags,
+			})
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, entity.ErrTransactionTagsInvalid)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTransactionUsecase_PatchTransactionByDTO_Tags_OK(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+
+	now := time.Now().Truncate(time.Microsecond)
+
+	tx := &entity.Transaction{
+		ID:         uuid.New(),
+		AccountID:  uuid.New(),
+		IsIncome:   true,
+		Amount:     decimal.MustParse("10.00"),
+		OccurredOn: civil.Date{Year: 2025, Month: 12, Day: 1},
+		CategoryID: 2,
+		Tags:       []string{"old"},
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	s.transactionRepo.FindOneByIDMock.Return(tx, nil)
+
+	s.transactionRepo.UpdateMock.Set(func(ctx context.Context, got *entity.Transaction) error {
+		require.Equal(t, []string{"work", "taxi"}, got.Tags)
+		return nil
+	})
+
+	_, err := s.uc.PatchTransactionByDTO(testCtx(), tx.ID, usecase.PatchTransactionDataInput{
+		Version: tx.Version(),
+		Tags:    &[]string{"Work", "TAXI"},
+	}, false)
+	require.NoError(t, err)
 }
 
 func TestTransactionUsecase_DeleteTransactionByID_OK(t *testing.T) {
@@ -172,6 +388,8 @@ func TestTransactionUsecase_DeleteTransactionByID_OK(t *testing.T) {
 	s := newDependencies(t)
 	defer finishDependencies(s)
 
+	s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+
 	id := uuid.New()
 	accID := uuid.New()
 
@@ -196,24 +414,1943 @@ func TestTransactionUsecase_DeleteTransactionByID_OK(t *testing.T) {
 		return nil
 	})
 
-	err := s.uc.DeleteTransactionByID(testCtx(), id)
+	err := s.uc.DeleteTransactionByID(testCtx(), id, 0, true)
 	require.NoError(t, err)
 }
 
+func TestTransactionUsecase_DeleteTransactionByID_VersionConflict(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	id := uuid.New()
+
+	tx := &entity.Transaction{
+		ID:        id,
+		AccountID: uuid.New(),
+		UpdatedAt: time.Now().Truncate(time.Microsecond),
+	}
+
+	s.transactionRepo.FindOneByIDMock.Return(tx, nil)
+
+	err := s.uc.DeleteTransactionByID(testCtx(), id, tx.Version()-1, false)
+	require.ErrorIs(t, err, appErrors.ErrVersionConflict)
+}
+
 func TestTransactionUsecase_ImportTransactionsFromCSV_OK_empty(t *testing.T) {
 	t.Parallel()
 
 	s := newDependencies(t)
 	defer finishDependencies(s)
 
+	s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+
 	accID := uuid.New()
 
-	s.transactionCSVRepo.ItemsFromCSVMock.Set(func(ctx context.Context, data []byte, gotAcc uuid.UUID) ([]*entity.Transaction, error) {
+	s.accountSettingsRepo.FindOneByAccountIDMock.Return(nil, appErrors.ErrNotFound)
+
+	s.transactionCSVRepo.ItemsFromCSVMock.Set(func(
+		ctx context.Context,
+		data []byte,
+		gotAcc uuid.UUID,
+		defaultCurrency string,
+		profile *entity.ImportProfile,
+	) ([]*usecase.TransactionImportRow, error) {
 		require.Equal(t, accID, gotAcc)
+		require.Equal(t, "RUB", defaultCurrency)
 		require.Equal(t, []byte("csv-data"), data)
+		require.Nil(t, profile)
 		return nil, nil
 	})
 
-	err := s.uc.ImportTransactionsFromCSV(testCtx(), []byte("csv-data"), accID)
+	report, err := s.uc.ImportTransactionsFromCSV(testCtx(), usecase.ImportTransactionsFromCSVInput{
+		Data:      []byte("csv-data"),
+		AccountID: accID,
+	})
+	require.NoError(t, err)
+	require.Empty(t, report.Rows)
+	require.Zero(t, report.Imported)
+}
+
+func TestTransactionUsecase_ImportTransactionsFromCSV_Profile_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+
+	tests := []struct {
+		name string
+
+		profileAccountID uuid.UUID
+		findErr          error
+
+		wantErr bool
+	}{
+		{
+			name:             "OK_own_profile",
+			profileAccountID: accID,
+		},
+		{
+			name:             "Negative_foreign_profile",
+			profileAccountID: uuid.New(),
+			wantErr:          true,
+		},
+		{
+			name:    "Negative_profile_not_found",
+			findErr: appErrors.ErrNotFound,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+			s.accountSettingsRepo.FindOneByAccountIDMock.Return(nil, appErrors.ErrNotFound)
+
+			importProfile := &entity.ImportProfile{
+				ID:        uuid.New(),
+				AccountID: tt.profileAccountID,
+			}
+
+			s.importProfileRepo.FindOneByIDMock.Set(func(
+				ctx context.Context,
+				id uuid.UUID,
+				qp *uctypes.QueryGetOneParams,
+			) (*entity.ImportProfile, error) {
+				require.Equal(t, importProfile.ID, id)
+				if tt.findErr != nil {
+					return nil, tt.findErr
+				}
+				return importProfile, nil
+			})
+
+			if !tt.wantErr {
+				s.transactionCSVRepo.ItemsFromCSVMock.Set(func(
+					ctx context.Context,
+					data []byte,
+					gotAcc uuid.UUID,
+					defaultCurrency string,
+					profile *entity.ImportProfile,
+				) ([]*usecase.TransactionImportRow, error) {
+					require.Equal(t, importProfile, profile)
+					return nil, nil
+				})
+			}
+
+			_, err := s.uc.ImportTransactionsFromCSV(testCtx(), usecase.ImportTransactionsFromCSVInput{
+				Data:            []byte("csv-data"),
+				AccountID:       accID,
+				ImportProfileID: &importProfile.ID,
+			})
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, appErrors.ErrBadRequest)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTransactionUsecase_ImportTransactionsFromCSV_Format_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+	requestCategoryID := uint64(5)
+	profileCategoryID := uint64(9)
+
+	tests := []struct {
+		name string
+
+		format            usecase.TransactionImportFormat
+		defaultCategoryID *uint64
+		withProfile       bool
+
+		wantCategoryID *uint64
+		wantErr        bool
+	}{
+		{
+			name:              "OK_request_category",
+			format:            usecase.TransactionImportFormatOFX,
+			defaultCategoryID: &requestCategoryID,
+			withProfile:       true,
+			wantCategoryID:    &requestCategoryID,
+		},
+		{
+			name:           "OK_profile_category",
+			format:         usecase.TransactionImportFormatOFX,
+			withProfile:    true,
+			wantCategoryID: &profileCategoryID,
+		},
+		{
+			name:   "OK_without_category",
+			format: usecase.TransactionImportFormatOFX,
+		},
+		{
+			name:    "Negative_format_not_registered",
+			format:  usecase.TransactionImportFormatQIF,
+			wantErr: true,
+		},
+		{
+			name:    "Negative_invalid_format",
+			format:  usecase.TransactionImportFormat("xlsx"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+			s.accountSettingsRepo.FindOneByAccountIDMock.Optional().Return(nil, appErrors.ErrNotFound)
+
+			var importProfileID *uuid.UUID
+			if tt.withProfile {
+				importProfile := &entity.ImportProfile{
+					ID:                uuid.New(),
+					AccountID:         accID,
+					DefaultCategoryID: &profileCategoryID,
+				}
+				importProfileID = &importProfile.ID
+
+				s.importProfileRepo.FindOneByIDMock.Return(importProfile, nil)
+			}
+
+			if !tt.wantErr {
+				s.statementRepo.ItemsFromStatementMock.Set(func(
+					ctx context.Context,
+					data []byte,
+					gotAcc uuid.UUID,
+					defaultCurrency string,
+					defaultCategoryID *uint64,
+				) ([]*usecase.TransactionImportRow, error) {
+					require.Equal(t, accID, gotAcc)
+					require.Equal(t, "RUB", defaultCurrency)
+					require.Equal(t, tt.wantCategoryID, defaultCategoryID)
+					return nil, nil
+				})
+			}
+
+			report, err := s.uc.ImportTransactionsFromCSV(testCtx(), usecase.ImportTransactionsFromCSVInput{
+				Data:              []byte("statement"),
+				AccountID:         accID,
+				Format:            tt.format,
+				ImportProfileID:   importProfileID,
+				DefaultCategoryID: tt.defaultCategoryID,
+			})
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, appErrors.ErrBadRequest)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Empty(t, report.Rows)
+		})
+	}
+}
+
+func TestTransactionUsecase_ImportTransactionsFromCSV_Modes_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+	catID := uint64(10)
+	occurredOn := civil.Date{Year: 2025, Month: 12, Day: 15}
+
+	newRows := func() []*usecase.TransactionImportRow {
+		return []*usecase.TransactionImportRow{
+			{
+				Line: 1,
+				Transaction: &entity.Transaction{
+					AccountID: accID, IsIncome: true, Amount: decimal.MustParse("100"),
+					Currency: "RUB", OccurredOn: occurredOn, CategoryID: catID, Description: "salary",
+				},
+			},
+			{
+				Line: 2,
+				Transaction: &entity.Transaction{
+					AccountID: accID, IsIncome: true, Amount: decimal.MustParse("50"),
+					Currency: "RUB", OccurredOn: occurredOn, CategoryID: catID, Description: "bonus",
+				},
+			},
+			{
+				Line: 3,
+				Err:  appErrors.ErrBadRequest.WithHints("invalid amount"),
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		mode   usecase.TransactionImportMode
+		dryRun bool
+
+		wantErr      bool
+		wantImported int
+		wantCreated  int
+	}{
+		{
+			name:    "Negative_strict",
+			wantErr: true,
+		},
+		{
+			name:         "OK_strict_dry_run",
+			dryRun:       true,
+			wantImported: 0,
+			wantCreated:  1,
+		},
+		{
+			name:         "OK_partial",
+			mode:         usecase.TransactionImportModePartial,
+			wantImported: 1,
+			wantCreated:  1,
+		},
+		{
+			name:         "OK_partial_dry_run",
+			mode:         usecase.TransactionImportModePartial,
+			dryRun:       true,
+			wantImported: 1,
+			wantCreated:  1,
+		},
+		{
+			name:    "Negative_invalid_mode",
+			mode:    "unknown",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+			s.accountSettingsRepo.FindOneByAccountIDMock.Optional().Return(nil, appErrors.ErrNotFound)
+			s.categoryUC.CheckCategoryAccessMock.Optional().Return(nil)
+			s.categoryRepo.FindListMock.Optional().Return([]*entity.Category{{ID: catID}}, nil)
+
+			s.transactionCSVRepo.ItemsFromCSVMock.Optional().Return(newRows(), nil)
+
+			// вторая строка совпадает с существующей транзакцией
+			s.transactionRepo.FindListMock.Optional().Set(func(
+				ctx context.Context,
+				opt *usecase.TransactionListOptions,
+				_ *uctypes.QueryGetListParams,
+			) ([]*entity.Transaction, error) {
+				require.Equal(t, occurredOn, *opt.FilterOccurredOnFrom)
+				require.Equal(t, occurredOn, *opt.FilterOccurredOnTo)
+				return []*entity.Transaction{{
+					AccountID: accID, IsIncome: true, Amount: decimal.MustParse("50.00"),
+					Currency: "RUB", OccurredOn: occurredOn, CategoryID: catID, Description: "bonus",
+				}}, nil
+			})
+
+			created := 0
+			s.transactionRepo.CreateMock.Optional().Set(func(ctx context.Context, item *entity.Transaction) error {
+				require.Equal(t, "salary", item.Description)
+				created++
+				return nil
+			})
+			s.transactionRepo.CreateBatchMock.Optional().Set(func(ctx context.Context, items []*entity.Transaction) error {
+				for _, item := range items {
+					require.Equal(t, "salary", item.Description)
+					created++
+				}
+				return nil
+			})
+			s.budgetRepo.FindListMock.Optional().Return(nil, nil)
+
+			report, err := s.uc.ImportTransactionsFromCSV(testCtx(), usecase.ImportTransactionsFromCSVInput{
+				Data:      []byte("csv-data"),
+				AccountID: accID,
+				Mode:      tt.mode,
+				DryRun:    tt.dryRun,
+			})
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, appErrors.ErrBadRequest)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.dryRun, report.DryRun)
+			require.Equal(t, tt.wantImported, report.Imported)
+			require.Equal(t, tt.wantCreated, created)
+			require.Equal(t, 1, report.Rejected)
+			require.Equal(t, 1, report.Skipped)
+
+			require.Len(t, report.Rows, 3)
+			require.Equal(t, usecase.TransactionImportRowStatusOK, report.Rows[0].Status)
+			require.Equal(t, usecase.TransactionImportRowStatusDuplicate, report.Rows[1].Status)
+			require.Equal(t, usecase.TransactionImportRowStatusError, report.Rows[2].Status)
+			require.Equal(t, []string{"BAD_REQUEST"}, report.Rows[2].ErrorCodes)
+			require.Equal(t, []string{"invalid amount"}, report.Rows[2].Hints)
+		})
+	}
+}
+
+func TestTransactionUsecase_ImportTransactionsFromCSV_DuplicatePolicy_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+	catID := uint64(10)
+	occurredOn := civil.Date{Year: 2025, Month: 12, Day: 15}
+
+	tests := []struct {
+		name            string
+		policy          usecase.TransactionImportDuplicatePolicy
+		toleranceDays   int
+		rowExternalID   *string
+		existingExtID   *string
+		existingAmount  string
+		rowNoCategory   bool
+		wantErr         bool
+		wantStatus      usecase.TransactionImportRowStatus
+		wantMatched     bool
+		wantCreated     int
+		wantUpdated     int
+		wantDuplicateOf bool
+	}{
+		{
+			name:           "OK_no_match_without_tolerance",
+			policy:         usecase.TransactionImportDuplicatePolicySkip,
+			existingAmount: "100.00",
+			wantStatus:     usecase.TransactionImportRowStatusOK,
+			wantCreated:    1,
+		},
+		{
+			name:           "OK_skip_within_tolerance",
+			policy:         usecase.TransactionImportDuplicatePolicySkip,
+			toleranceDays:  3,
+			existingAmount: "100.00",
+			wantStatus:     usecase.TransactionImportRowStatusDuplicate,
+			wantMatched:    true,
+		},
+		{
+			name:            "OK_flag_within_tolerance",
+			policy:          usecase.TransactionImportDuplicatePolicyFlag,
+			toleranceDays:   3,
+			existingAmount:  "100.00",
+			wantStatus:      usecase.TransactionImportRowStatusFlagged,
+			wantMatched:     true,
+			wantCreated:     1,
+			wantDuplicateOf: true,
+		},
+		{
+			name:           "OK_update_within_tolerance",
+			policy:         usecase.TransactionImportDuplicatePolicyUpdate,
+			toleranceDays:  3,
+			existingAmount: "100.00",
+			wantStatus:     usecase.TransactionImportRowStatusUpdated,
+			wantMatched:    true,
+			wantUpdated:    1,
+		},
+		{
+			name:           "OK_update_keeps_category_without_row_category",
+			policy:         usecase.TransactionImportDuplicatePolicyUpdate,
+			toleranceDays:  3,
+			existingAmount: "100.00",
+			rowNoCategory:  true,
+			wantStatus:     usecase.TransactionImportRowStatusUpdated,
+			wantMatched:    true,
+			wantUpdated:    1,
+		},
+		{
+			name:           "OK_skip_by_external_id",
+			policy:         usecase.TransactionImportDuplicatePolicySkip,
+			rowExternalID:  lo.ToPtr("op-1"),
+			existingExtID:  lo.ToPtr("op-1"),
+			existingAmount: "70.00",
+			wantStatus:     usecase.TransactionImportRowStatusDuplicate,
+			wantMatched:    true,
+		},
+		{
+			name:           "Negative_tolerance_too_big",
+			policy:         usecase.TransactionImportDuplicatePolicySkip,
+			toleranceDays:  usecase.TransactionImportMaxMatchToleranceDays + 1,
+			existingAmount: "100.00",
+			wantErr:        true,
+		},
+		{
+			name:           "Negative_invalid_policy",
+			policy:         "merge",
+			existingAmount: "100.00",
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+			s.accountSettingsRepo.FindOneByAccountIDMock.Optional().Return(nil, appErrors.ErrNotFound)
+			s.categoryUC.CheckCategoryAccessMock.Optional().Return(nil)
+			s.categoryRepo.FindListMock.Optional().Return([]*entity.Category{{ID: catID}}, nil)
+			s.categoryRuleRepo.FindListMock.Optional().Return(nil, nil)
+
+			rowDate := occurredOn.AddDays(2)
+
+			rowCategoryID := catID
+			if tt.rowNoCategory {
+				rowCategoryID = 0
+			}
+
+			s.transactionCSVRepo.ItemsFromCSVMock.Optional().Return([]*usecase.TransactionImportRow{
+				{
+					Line: 1,
+					Transaction: &entity.Transaction{
+						AccountID: accID, IsIncome: true, Amount: decimal.MustParse("100"), Currency: "RUB",
+						OccurredOn: rowDate, CategoryID: rowCategoryID, Description: "Salary   CORP", ExternalID: tt.rowExternalID,
+					},
+				},
+			}, nil)
+
+			existing := &entity.Transaction{
+				ID: uuid.New(), AccountID: accID, IsIncome: true, Amount: decimal.MustParse(tt.existingAmount),
+				Currency: "RUB", OccurredOn: occurredOn, CategoryID: catID, Description: "salary corp",
+				ExternalID: tt.existingExtID,
+			}
+
+			s.transactionRepo.FindListMock.Optional().Set(func(
+				ctx context.Context,
+				opt *usecase.TransactionListOptions,
+				_ *uctypes.QueryGetListParams,
+			) ([]*entity.Transaction, error) {
+				require.Equal(t, rowDate.AddDays(-tt.toleranceDays), *opt.FilterOccurredOnFrom)
+				require.Equal(t, rowDate.AddDays(tt.toleranceDays), *opt.FilterOccurredOnTo)
+				return []*entity.Transaction{existing}, nil
+			})
+
+			created := 0
+			s.transactionRepo.CreateBatchMock.Optional().Set(func(ctx context.Context, items []*entity.Transaction) error {
+				for _, item := range items {
+					require.Equal(t, tt.rowExternalID, item.ExternalID)
+					if tt.wantDuplicateOf {
+						require.Equal(t, &existing.ID, item.DuplicateOfID)
+						require.Nil(t, item.ImportFingerprint)
+					} else {
+						require.NotNil(t, item.ImportFingerprint)
+						require.Nil(t, item.DuplicateOfID)
+					}
+					created++
+				}
+				return nil
+			})
+			s.budgetRepo.FindListMock.Optional().Return(nil, nil)
+
+			s.transactionRepo.FindOneByIDMock.Optional().Return(existing, nil)
+
+			updated := 0
+			s.transactionRepo.UpdateMock.Optional().Set(func(ctx context.Context, item *entity.Transaction) error {
+				require.Equal(t, existing.ID, item.ID)
+				require.Equal(t, rowDate, item.OccurredOn)
+				require.Equal(t, "Salary   CORP", item.Description)
+				require.Equal(t, catID, item.CategoryID)
+				updated++
+				return nil
+			})
+
+			report, err := s.uc.ImportTransactionsFromCSV(testCtx(), usecase.ImportTransactionsFromCSVInput{
+				Data:               []byte("csv-data"),
+				AccountID:          accID,
+				DuplicatePolicy:    tt.policy,
+				MatchToleranceDays: tt.toleranceDays,
+			})
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, appErrors.ErrBadRequest)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, report.Rows, 1)
+			require.Equal(t, tt.wantStatus, report.Rows[0].Status)
+			require.Equal(t, tt.wantCreated, created)
+			require.Equal(t, tt.wantUpdated, updated)
+
+			if tt.wantMatched {
+				require.Equal(t, &existing.ID, report.Rows[0].MatchedID)
+			} else {
+				require.Nil(t, report.Rows[0].MatchedID)
+			}
+		})
+	}
+}
+
+func TestTransactionUsecase_PatchTransactionByDTO_Negative_transfer_leg(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+
+	id := uuid.New()
+	walletID := uuid.New()
+
+	tx := &entity.Transaction{
+		ID:         id,
+		AccountID:  uuid.New(),
+		Amount:     decimal.MustParse("-10.00"),
+		OccurredOn: civil.Date{Year: 2025, Month: 12, Day: 1},
+		WalletID:   &walletID,
+		TransferID: lo.ToPtr(uuid.New()),
+	}
+
+	s.transactionRepo.FindOneByIDMock.Return(tx, nil)
+
+	newDesc := "new desc"
+
+	_, err := s.uc.PatchTransactionByDTO(testCtx(), id, usecase.PatchTransactionDataInput{
+		Description: &newDesc,
+	}, true)
+	require.ErrorIs(t, err, appErrors.ErrBadRequest)
+}
+
+func TestTransactionUsecase_CreateTransferByDTO_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+
+	tests := []struct {
+		name       string
+		fromWallet *entity.Wallet
+		toWallet   *entity.Wallet
+
+		wantErr bool
+	}{
+		{
+			name:       "OK",
+			fromWallet: &entity.Wallet{ID: uuid.New(), AccountID: accID, Currency: "RUB"},
+			toWallet:   &entity.Wallet{ID: uuid.New(), AccountID: accID, Currency: "RUB"},
+			wantErr:    false,
+		},
+		{
+			name:       "Negative_currency_mismatch",
+			fromWallet: &entity.Wallet{ID: uuid.New(), AccountID: accID, Currency: "RUB"},
+			toWallet:   &entity.Wallet{ID: uuid.New(), AccountID: accID, Currency: "USD"},
+			wantErr:    true,
+		},
+		{
+			name:       "Negative_archived_wallet",
+			fromWallet: &entity.Wallet{ID: uuid.New(), AccountID: accID, Currency: "RUB"},
+			toWallet:   &entity.Wallet{ID: uuid.New(), AccountID: accID, Currency: "RUB", IsArchived: true},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			wallets := map[uuid.UUID]*entity.Wallet{
+				tt.fromWallet.ID: tt.fromWallet,
+				tt.toWallet.ID:   tt.toWallet,
+			}
+
+			s.walletRepo.FindOneByIDMock.Set(func(ctx context.Context, id uuid.UUID, _ *uctypes.QueryGetOneParams) (*entity.Wallet, error) {
+				wallet, ok := wallets[id]
+				require.True(t, ok)
+				return wallet, nil
+			})
+
+			if !tt.wantErr {
+				s.transactionRepo.CreateMock.Set(func(ctx context.Context, item *entity.Transaction) error {
+					require.NotNil(t, item.TransferID)
+					require.NotNil(t, item.WalletID)
+					require.Equal(t, uint64(0), item.CategoryID)
+
+					if item.IsIncome {
+						require.Equal(t, tt.toWallet.ID, *item.WalletID)
+						require.Equal(t, decimal.MustParse("100.50"), item.Amount)
+					} else {
+						require.Equal(t, tt.fromWallet.ID, *item.WalletID)
+						require.Equal(t, decimal.MustParse("-100.50"), item.Amount)
+					}
+					return nil
+				})
+
+				s.walletRepo.FindListMock.Return([]*entity.Wallet{tt.fromWallet, tt.toWallet}, nil)
+			}
+
+			got, err := s.uc.CreateTransferByDTO(testCtx(), usecase.CreateTransferDataInput{
+				AccountID:    accID,
+				FromWalletID: tt.fromWallet.ID,
+				ToWalletID:   tt.toWallet.ID,
+				Amount:       decimal.MustParse("100.50"),
+				OccurredOn:   civil.Date{Year: 2025, Month: 12, Day: 15},
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			require.Same(t, tt.fromWallet, got.FromWallet)
+			require.Same(t, tt.toWallet, got.ToWallet)
+			require.Equal(t, uint64(2), s.transactionRepo.CreateAfterCounter())
+		})
+	}
+}
+
+func TestTransactionUsecase_CreateTransferByDTO_Negative_same_wallet(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	walletID := uuid.New()
+
+	got, err := s.uc.CreateTransferByDTO(testCtx(), usecase.CreateTransferDataInput{
+		AccountID:    uuid.New(),
+		FromWalletID: walletID,
+		ToWalletID:   walletID,
+		Amount:       decimal.MustParse("10"),
+		OccurredOn:   civil.Date{Year: 2025, Month: 12, Day: 15},
+	})
+	require.Error(t, err)
+	require.Nil(t, got)
+}
+
+func TestTransactionUsecase_DeleteTransferByID_OK(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	accID := uuid.New()
+
+	transfer, err := entity.NewTransfer(
+		accID,
+		uuid.New(),
+		uuid.New(),
+		decimal.MustParse("10"),
+		civil.Date{Year: 2025, Month: 12, Day: 1},
+		"",
+	)
+	require.NoError(t, err)
+
+	s.transactionRepo.FindListMock.Set(func(ctx context.Context, opt *usecase.TransactionListOptions, qp *uctypes.QueryGetListParams) ([]*entity.Transaction, error) {
+		require.NotNil(t, opt.FilterTransferID)
+		require.Equal(t, transfer.ID, *opt.FilterTransferID)
+		require.NotNil(t, qp)
+		require.True(t, qp.ForUpdate)
+		return transfer.Legs(), nil
+	})
+
+	s.transactionRepo.UpdateMock.Set(func(ctx context.Context, got *entity.Transaction) error {
+		require.NotNil(t, got.DeletedAt)
+		return nil
+	})
+
+	err = s.uc.DeleteTransferByID(testCtx(), transfer.ID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), s.transactionRepo.UpdateAfterCounter())
+}
+
+func TestTransactionUsecase_CreateTransactionByDTO_Negative_wallet_currency_mismatch(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+
+	accID := uuid.New()
+	catID := uint64(10)
+	wallet := &entity.Wallet{ID: uuid.New(), AccountID: accID, Currency: "EUR"}
+
+	s.categoryUC.CheckCategoryAccessMock.Return(nil)
+	s.walletRepo.FindOneByIDMock.Return(wallet, nil)
+
+	got, err := s.uc.CreateTransactionByDTO(testCtx(), usecase.CreateTransactionDataInput{
+		AccountID:  accID,
+		IsIncome:   true,
+		Amount:     decimal.MustParse("10"),
+		Currency:   "usd",
+		OccurredOn: civil.Date{Year: 2025, Month: 12, Day: 15},
+		CategoryID: catID,
+		WalletID:   &wallet.ID,
+	})
+	require.ErrorIs(t, err, appErrors.ErrBadRequest)
+	require.Nil(t, got)
+}
+
+func TestTransactionUsecase_CreateTransactionByDTO_Negative_budget_limit_converted(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+
+	accID := uuid.New()
+	catID := uint64(7)
+	occurredOn := civil.Date{Year: 2025, Month: 12, Day: 20}
+	period := civil.Date{Year: 2025, Month: 12, Day: 1}
+
+	s.accountSettingsRepo.FindOneByAccountIDMock.Return(&entity.AccountSettings{AccountID: accID, BaseCurrency: "RUB"}, nil)
+
+	s.categoryUC.CheckCategoryAccessMock.Return(nil)
+	s.categoryRepo.FindListMock.Return([]*entity.Category{{ID: catID}}, nil)
+
+	s.budgetRepo.FindListMock.Return([]*entity.Budget{
+		{
+			ID:         uuid.New(),
+			AccountID:  accID,
+			Period:     period,
+			CategoryID: catID,
+			Amount:     decimal.MustParse("1000"),
+			Currency:   "RUB",
+		},
+	}, nil)
+
+	s.exchangeRateRepo.FindActualMock.Set(func(
+		ctx context.Context,
+		gotAcc uuid.UUID,
+		currencyFrom string,
+		currencyTo string,
+		date civil.Date,
+	) (*entity.ExchangeRate, error) {
+		require.Equal(t, accID, gotAcc)
+		require.Equal(t, "USD", currencyFrom)
+		require.Equal(t, "RUB", currencyTo)
+		require.Equal(t, occurredOn, date)
+
+		return &entity.ExchangeRate{
+			AccountID:    accID,
+			CurrencyFrom: "USD",
+			CurrencyTo:   "RUB",
+			RateDate:     period,
+			Rate:         decimal.MustParse("90"),
+		}, nil
+	})
+
+	s.transactionRepo.CountReportItemsMock.Set(func(
+		ctx context.Context,
+		queryFilter usecase.CountReportItemsQueryFilter,
+	) ([]*entity.AccountTransactionReportItem, error) {
+		require.Equal(t, "RUB", queryFilter.BaseCurrency)
+
+		return []*entity.AccountTransactionReportItem{
+			{Period: period, CategoryID: catID, Sum: lo.ToPtr(decimal.MustParse("-500"))},
+		}, nil
+	})
+
+	// 10 USD = 900 RUB, вместе с потраченными 500 RUB превышает бюджет 1000 RUB
+	got, err := s.uc.CreateTransactionByDTO(testCtx(), usecase.CreateTransactionDataInput{
+		AccountID:  accID,
+		IsIncome:   false,
+		Amount:     decimal.MustParse("-10"),
+		Currency:   "USD",
+		OccurredOn: occurredOn,
+		CategoryID: catID,
+	})
+	require.ErrorIs(t, err, appErrors.ErrBadRequest)
+	require.Nil(t, got)
+}
+
+func TestTransactionUsecase_CreateTransactionByDTO_BudgetMissingRates_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+	catID := uint64(7)
+	occurredOn := civil.Date{Year: 2025, Month: 12, Day: 20}
+	period := civil.Date{Year: 2025, Month: 12, Day: 1}
+
+	tests := []struct {
+		name     string
+		currency string
+		wantErr  error
+	}{
+		{
+			// курса нет только у прошлых транзакций в другой валюте, проверка идет по остальным суммам
+			name:     "OK_missing_rate_of_other_currency",
+			currency: "RUB",
+		},
+		{
+			name:     "missing_rate_of_written_currency",
+			currency: "USD",
+			wantErr:  entity.ErrExchangeRateNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+
+			s.accountSettingsRepo.FindOneByAccountIDMock.Return(&entity.AccountSettings{AccountID: accID, BaseCurrency: "RUB"}, nil)
+			s.categoryUC.CheckCategoryAccessMock.Return(nil)
+			s.categoryRepo.FindListMock.Optional().Return([]*entity.Category{{ID: catID}}, nil)
+
+			s.budgetRepo.FindListMock.Optional().Return([]*entity.Budget{
+				{
+					ID:         uuid.New(),
+					AccountID:  accID,
+					Period:     period,
+					CategoryID: catID,
+					Amount:     decimal.MustParse("1000"),
+					Currency:   "RUB",
+				},
+			}, nil)
+
+			s.exchangeRateRepo.FindActualMock.Optional().Return(nil, appErrors.ErrNotFound)
+
+			s.transactionRepo.CountReportItemsMock.Optional().Return([]*entity.AccountTransactionReportItem{
+				{Period: period, CategoryID: catID, Sum: lo.ToPtr(decimal.MustParse("-500")), MissingCurrencies: []string{"USD"}},
+			}, nil)
+
+			s.transactionRepo.CreateMock.Optional().Return(nil)
+
+			got, err := s.uc.CreateTransactionByDTO(testCtx(), usecase.CreateTransactionDataInput{
+				AccountID:  accID,
+				Amount:     decimal.MustParse("-100"),
+				Currency:   tt.currency,
+				OccurredOn: occurredOn,
+				CategoryID: catID,
+			})
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, got)
+				require.Zero(t, s.transactionRepo.CreateAfterCounter())
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			require.Equal(t, uint64(1), s.transactionRepo.CreateAfterCounter())
+		})
+	}
+}
+
+func TestTransactionUsecase_CreateTransactionByDTO_BudgetCarryOver_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+	catID := uint64(7)
+	period := civil.Date{Year: 2025, Month: 12, Day: 1}
+	prevPeriod := civil.Date{Year: 2025, Month: 11, Day: 1}
+
+	tests := []struct {
+		name      string
+		carryOver bool
+		prevSum   string
+		amount    string
+		wantErr   bool
+	}{
+		{
+			name:      "unspent remainder extends limit",
+			carryOver: true,
+			prevSum:   "-200",
+			amount:    "-1200",
+		},
+		{
+			name:      "without carry over limit is not extended",
+			carryOver: false,
+			prevSum:   "-200",
+			amount:    "-1200",
+			wantErr:   true,
+		},
+		{
+			name:      "overspend reduces limit",
+			carryOver: true,
+			prevSum:   "-1300",
+			amount:    "-300",
+			wantErr:   true,
+		},
+		{
+			name:      "overspend within reduced limit",
+			carryOver: true,
+			prevSum:   "-1300",
+			amount:    "-100",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+
+			s.accountSettingsRepo.FindOneByAccountIDMock.Return(&entity.AccountSettings{AccountID: accID, BaseCurrency: "RUB"}, nil)
+
+			s.categoryUC.CheckCategoryAccessMock.Return(nil)
+			s.categoryRepo.FindListMock.Return([]*entity.Category{{ID: catID}}, nil)
+
+			budgets := map[civil.Date]*entity.Budget{
+				period: {
+					ID:         uuid.New(),
+					AccountID:  accID,
+					Period:     period,
+					CategoryID: catID,
+					Amount:     decimal.MustParse("1000"),
+					Currency:   "RUB",
+					CarryOver:  tt.carryOver,
+				},
+				prevPeriod: {
+					ID:         uuid.New(),
+					AccountID:  accID,
+					Period:     prevPeriod,
+					CategoryID: catID,
+					Amount:     decimal.MustParse("1000"),
+					Currency:   "RUB",
+				},
+			}
+
+			s.budgetRepo.FindListMock.Set(func(
+				ctx context.Context,
+				opt *usecase.BudgetListOptions,
+				_ *uctypes.QueryGetListParams,
+			) ([]*entity.Budget, error) {
+				require.NotNil(t, opt.FilterPeriod)
+
+				budget, ok := budgets[*opt.FilterPeriod]
+				if !ok {
+					return nil, nil
+				}
+
+				return []*entity.Budget{budget}, nil
+			})
+
+			s.transactionRepo.CountReportItemsMock.Set(func(
+				ctx context.Context,
+				queryFilter usecase.CountReportItemsQueryFilter,
+			) ([]*entity.AccountTransactionReportItem, error) {
+				require.NotNil(t, queryFilter.DateFrom)
+
+				if *queryFilter.DateFrom == prevPeriod {
+					return []*entity.AccountTransactionReportItem{
+						{Period: prevPeriod, CategoryID: catID, Sum: lo.ToPtr(decimal.MustParse(tt.prevSum))},
+					}, nil
+				}
+
+				return []*entity.AccountTransactionReportItem{
+					{Period: period, CategoryID: catID, Sum: lo.ToPtr(decimal.MustParse("-500"))},
+				}, nil
+			})
+
+			s.transactionRepo.CreateMock.Optional().Return(nil)
+			s.outboxEventRepo.CreateMock.Optional().Set(func(ctx context.Context, item *entity.OutboxEvent) error {
+				require.Equal(t, entity.OutboxEventTypeBudgetThresholdCrossed, item.EventType)
+				require.Equal(t, accID, item.AccountID)
+				return nil
+			})
+
+			got, err := s.uc.CreateTransactionByDTO(testCtx(), usecase.CreateTransactionDataInput{
+				AccountID:  accID,
+				IsIncome:   false,
+				Amount:     decimal.MustParse(tt.amount),
+				OccurredOn: civil.Date{Year: 2025, Month: 12, Day: 20},
+				CategoryID: catID,
+			})
+			if tt.wantErr {
+				require.ErrorIs(t, err, appErrors.ErrBadRequest)
+				require.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+		})
+	}
+}
+
+func TestTransactionUsecase_CreateTransactionByDTO_BudgetEnforcement_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+	catID := uint64(7)
+	period := civil.Date{Year: 2025, Month: 12, Day: 1}
+
+	tests := []struct {
+		name        string
+		enforcement entity.BudgetEnforcement
+		thresholds  []int
+		amount      string
+
+		wantErr          bool
+		wantWarnings     int
+		wantThreshold    int
+		wantSpentPercent string
+		wantExceeded     bool
+		wantEvents       uint64
+	}{
+		{
+			name:        "hard rejects over limit",
+			enforcement: entity.BudgetEnforcementHard,
+			amount:      "-600",
+			wantErr:     true,
+		},
+		{
+			name:             "hard warns on reached threshold",
+			enforcement:      entity.BudgetEnforcementHard,
+			thresholds:       []int{50, 80, 100},
+			amount:           "-400",
+			wantWarnings:     1,
+			wantThreshold:    80,
+			wantSpentPercent: "90",
+			wantEvents:       1,
+		},
+		{
+			name:             "soft saves over limit with warning",
+			enforcement:      entity.BudgetEnforcementSoft,
+			thresholds:       []int{80, 100},
+			amount:           "-600",
+			wantWarnings:     1,
+			wantThreshold:    100,
+			wantSpentPercent: "110",
+			wantExceeded:     true,
+			wantEvents:       1,
+		},
+		{
+			name:             "soft without thresholds warns only when exceeded",
+			enforcement:      entity.BudgetEnforcementSoft,
+			amount:           "-600",
+			wantWarnings:     1,
+			wantSpentPercent: "110",
+			wantExceeded:     true,
+			wantEvents:       1,
+		},
+		{
+			name:        "soft below thresholds without warning",
+			enforcement: entity.BudgetEnforcementSoft,
+			thresholds:  []int{95},
+			amount:      "-100",
+		},
+		{
+			name:             "already reached threshold is not crossed again",
+			enforcement:      entity.BudgetEnforcementSoft,
+			thresholds:       []int{50},
+			amount:           "-100",
+			wantWarnings:     1,
+			wantThreshold:    50,
+			wantSpentPercent: "60",
+		},
+		{
+			name:        "off skips check",
+			enforcement: entity.BudgetEnforcementOff,
+			thresholds:  []int{80},
+			amount:      "-600",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+
+			budgetID := uuid.New()
+
+			s.accountSettingsRepo.FindOneByAccountIDMock.Return(&entity.AccountSettings{AccountID: accID, BaseCurrency: "RUB"}, nil)
+
+			s.categoryUC.CheckCategoryAccessMock.Return(nil)
+			s.categoryRepo.FindListMock.Return([]*entity.Category{{ID: catID}}, nil)
+
+			s.budgetRepo.FindListMock.Return([]*entity.Budget{
+				{
+					ID:                budgetID,
+					AccountID:         accID,
+					Period:            period,
+					CategoryID:        catID,
+					Amount:            decimal.MustParse("1000"),
+					Currency:          "RUB",
+					Enforcement:       tt.enforcement,
+					WarningThresholds: tt.thresholds,
+				},
+			}, nil)
+
+			s.transactionRepo.CountReportItemsMock.Optional().Return([]*entity.AccountTransactionReportItem{
+				{Period: period, CategoryID: catID, Sum: lo.ToPtr(decimal.MustParse("-500"))},
+			}, nil)
+
+			s.transactionRepo.CreateMock.Optional().Return(nil)
+			s.outboxEventRepo.CreateMock.Optional().Set(func(ctx context.Context, item *entity.OutboxEvent) error {
+				require.Equal(t, entity.OutboxEventTypeBudgetThresholdCrossed, item.EventType)
+				require.Equal(t, accID, item.AccountID)
+				return nil
+			})
+
+			got, err := s.uc.CreateTransactionByDTO(testCtx(), usecase.CreateTransactionDataInput{
+				AccountID:  accID,
+				IsIncome:   false,
+				Amount:     decimal.MustParse(tt.amount),
+				OccurredOn: civil.Date{Year: 2025, Month: 12, Day: 20},
+				CategoryID: catID,
+			})
+			if tt.wantErr {
+				require.ErrorIs(t, err, appErrors.ErrBadRequest)
+				require.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			require.Len(t, got.Warnings, tt.wantWarnings)
+			require.Equal(t, tt.wantEvents, s.outboxEventRepo.CreateAfterCounter())
+
+			if tt.wantWarnings == 0 {
+				return
+			}
+
+			warning := got.Warnings[0]
+			require.Equal(t, budgetID, warning.BudgetID)
+			require.Equal(t, catID, warning.CategoryID)
+			require.Equal(t, tt.enforcement, warning.Enforcement)
+			require.Equal(t, tt.wantThreshold, warning.Threshold)
+			require.Equal(t, tt.wantExceeded, warning.Exceeded)
+			require.NotNil(t, warning.SpentPercent)
+			require.Zero(t, warning.SpentPercent.Cmp(decimal.MustParse(tt.wantSpentPercent)))
+		})
+	}
+}
+
+func TestTransactionUsecase_CreateTransactionByDTO_CategoryRules_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+	taxiCatID := uint64(21)
+	foodCatID := uint64(22)
+
+	now := time.Now().Truncate(time.Microsecond)
+	rules := []*entity.CategoryRule{
+		{
+			ID: uuid.New(), AccountID: accID, Priority: 20, CategoryID: foodCatID, CreatedAt: now,
+			Conditions: entity.CategoryRuleConditions{DescriptionContains: lo.ToPtr("uber")},
+		},
+		{
+			ID: uuid.New(), AccountID: accID, Priority: 10, CategoryID: taxiCatID, CreatedAt: now,
+			Conditions: entity.CategoryRuleConditions{DescriptionRegex: lo.ToPtr(`(?i)^uber\s+trip`)},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		description string
+
+		wantCategoryID uint64
+		wantErr        error
+	}{
+		{
+			name:           "OK_lowest_priority_rule",
+			description:    "Uber trip 15.12",
+			wantCategoryID: taxiCatID,
+		},
+		{
+			name:           "OK_second_rule",
+			description:    "Uber eats",
+			wantCategoryID: foodCatID,
+		},
+		{
+			name:        "Negative_no_rule_matched",
+			description: "coffee",
+			wantErr:     entity.ErrTransactionCategoryRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+			s.accountSettingsRepo.FindOneByAccountIDMock.Optional().Return(nil, appErrors.ErrNotFound)
+
+			s.categoryRuleRepo.FindListMock.Set(func(
+				ctx context.Context,
+				opt *usecase.CategoryRuleListOptions,
+				_ *uctypes.QueryGetListParams,
+			) ([]*entity.CategoryRule, error) {
+				require.Equal(t, accID, *opt.FilterAccountID)
+				return rules, nil
+			})
+
+			s.categoryUC.CheckCategoryAccessMock.Optional().Return(nil)
+			s.categoryRepo.FindListMock.Optional().Return([]*entity.Category{{ID: taxiCatID}, {ID: foodCatID}}, nil)
+
+			if tt.wantErr == nil {
+				s.transactionRepo.CreateMock.Set(func(ctx context.Context, item *entity.Transaction) error {
+					require.Equal(t, tt.wantCategoryID, item.CategoryID)
+					return nil
+				})
+			}
+
+			got, err := s.uc.CreateTransactionByDTO(testCtx(), usecase.CreateTransactionDataInput{
+				AccountID:   accID,
+				IsIncome:    true,
+				Amount:      decimal.MustParse("10"),
+				OccurredOn:  civil.Date{Year: 2025, Month: 12, Day: 15},
+				Description: tt.description,
+			})
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCategoryID, got.Transaction.CategoryID)
+		})
+	}
+}
+
+func TestTransactionUsecase_ImportTransactionsFromCSV_CategoryRules_OK(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	accID := uuid.New()
+	catID := uint64(10)
+	ruleCatID := uint64(30)
+	archivedCatID := uint64(31)
+	occurredOn := civil.Date{Year: 2025, Month: 12, Day: 15}
+
+	s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+	s.accountSettingsRepo.FindOneByAccountIDMock.Optional().Return(nil, appErrors.ErrNotFound)
+	s.transactionRepo.FindListMock.Optional().Return(nil, nil)
+
+	s.categoryUC.CheckCategoryAccessMock.Optional().Set(func(ctx context.Context, id uint64, _ uuid.UUID) error {
+		if id == archivedCatID {
+			return appErrors.ErrBadRequest.WithHints("category is archived")
+		}
+		return nil
+	})
+	s.categoryRepo.FindListMock.Optional().Return([]*entity.Category{{ID: catID}, {ID: ruleCatID}}, nil)
+
+	// правило с архивной категорией пропускается
+	s.categoryRuleRepo.FindListMock.Return([]*entity.CategoryRule{
+		{
+			ID: uuid.New(), AccountID: accID, CategoryID: archivedCatID,
+			Conditions: entity.CategoryRuleConditions{DescriptionContains: lo.ToPtr("rent")},
+		},
+		{
+			ID: uuid.New(), AccountID: accID, Priority: 1, CategoryID: ruleCatID,
+			Conditions: entity.CategoryRuleConditions{IsIncome: lo.ToPtr(true)},
+		},
+	}, nil)
+
+	s.transactionCSVRepo.ItemsFromCSVMock.Return([]*usecase.TransactionImportRow{
+		{
+			Line: 1,
+			Transaction: &entity.Transaction{
+				AccountID: accID, IsIncome: true, Amount: decimal.MustParse("100"),
+				Currency: "RUB", OccurredOn: occurredOn, Description: "salary",
+			},
+		},
+		{
+			Line: 2,
+			Transaction: &entity.Transaction{
+				AccountID: accID, IsIncome: false, Amount: decimal.MustParse("-50"),
+				Currency: "RUB", OccurredOn: occurredOn, Description: "rent",
+			},
+		},
+		{
+			Line: 3,
+			Transaction: &entity.Transaction{
+				AccountID: accID, IsIncome: true, Amount: decimal.MustParse("5"),
+				Currency: "RUB", OccurredOn: occurredOn, CategoryID: catID, Description: "cashback",
+			},
+		},
+	}, nil)
+
+	categoryIDs := map[string]uint64{}
+	s.transactionRepo.CreateBatchMock.Set(func(ctx context.Context, items []*entity.Transaction) error {
+		for _, item := range items {
+			categoryIDs[item.Description] = item.CategoryID
+		}
+		return nil
+	})
+	s.budgetRepo.FindListMock.Optional().Return(nil, nil)
+
+	report, err := s.uc.ImportTransactionsFromCSV(testCtx(), usecase.ImportTransactionsFromCSVInput{
+		Data:      []byte("csv-data"),
+		AccountID: accID,
+		Mode:      usecase.TransactionImportModePartial,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, report.Imported)
+	require.Equal(t, 1, report.Rejected)
+	require.Equal(t, map[string]uint64{"salary": ruleCatID, "cashback": catID}, categoryIDs)
+
+	require.Equal(t, usecase.TransactionImportRowStatusError, report.Rows[1].Status)
+	require.Equal(t, []string{"TRANSACTION_CATEGORY_REQUIRED"}, report.Rows[1].ErrorCodes)
+}
+
+func TestTransactionUsecase_ImportTransactionsFromCSV_Batch_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+	catID := uint64(7)
+	period := civil.Date{Year: 2025, Month: 12, Day: 1}
+
+	tests := []struct {
+		name    string
+		mode    usecase.TransactionImportMode
+		amounts []string
+
+		wantErr         bool
+		wantImported    int
+		wantRejected    int
+		wantBatchCalls  uint64
+		wantRowStatuses []usecase.TransactionImportRowStatus
+	}{
+		{
+			name:            "OK_one_batch",
+			mode:            usecase.TransactionImportModePartial,
+			amounts:         []string{"-100", "-200"},
+			wantImported:    2,
+			wantBatchCalls:  1,
+			wantRowStatuses: []usecase.TransactionImportRowStatus{usecase.TransactionImportRowStatusOK, usecase.TransactionImportRowStatusOK},
+		},
+		{
+			name:           "OK_partial_rejects_row_over_budget",
+			mode:           usecase.TransactionImportModePartial,
+			amounts:        []string{"-600", "-300"},
+			wantImported:   1,
+			wantRejected:   1,
+			wantBatchCalls: 2,
+			wantRowStatuses: []usecase.TransactionImportRowStatus{
+				usecase.TransactionImportRowStatusOK,
+				usecase.TransactionImportRowStatusBudgetExceeded,
+			},
+		},
+		{
+			name:           "Negative_strict_row_over_budget",
+			mode:           usecase.TransactionImportModeStrict,
+			amounts:        []string{"-600", "-300"},
+			wantErr:        true,
+			wantBatchCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+			s.accountSettingsRepo.FindOneByAccountIDMock.Return(&entity.AccountSettings{AccountID: accID, BaseCurrency: "RUB"}, nil)
+			s.categoryRepo.FindListMock.Return([]*entity.Category{{ID: catID}}, nil)
+			s.transactionRepo.FindListMock.Return(nil, nil)
+
+			s.budgetRepo.FindListMock.Return([]*entity.Budget{
+				{
+					ID:          uuid.New(),
+					AccountID:   accID,
+					Period:      period,
+					CategoryID:  catID,
+					Amount:      decimal.MustParse("1000"),
+					Currency:    "RUB",
+					Enforcement: entity.BudgetEnforcementHard,
+				},
+			}, nil)
+
+			rows := make([]*usecase.TransactionImportRow, 0, len(tt.amounts))
+			for i, amount := range tt.amounts {
+				rows = append(rows, &usecase.TransactionImportRow{
+					Line: i + 1,
+					Transaction: &entity.Transaction{
+						AccountID: accID, Amount: decimal.MustParse(amount), Currency: "RUB",
+						OccurredOn: civil.Date{Year: 2025, Month: 12, Day: 10 + i}, CategoryID: catID,
+						Description: fmt.Sprintf("row %d", i+1),
+					},
+				})
+			}
+
+			s.transactionCSVRepo.ItemsFromCSVMock.Return(rows, nil)
+
+			// точка сохранения отменяет предыдущую попытку, в балансе только последний пакет
+			var inserted []*entity.Transaction
+			s.transactionRepo.CreateBatchMock.Set(func(ctx context.Context, items []*entity.Transaction) error {
+				inserted = items
+				return nil
+			})
+
+			s.transactionRepo.CountReportItemsMock.Set(func(
+				ctx context.Context,
+				_ usecase.CountReportItemsQueryFilter,
+			) ([]*entity.AccountTransactionReportItem, error) {
+				total := decimal.MustParse("-200")
+				for _, item := range inserted {
+					var err error
+					total, err = total.Add(item.Amount)
+					require.NoError(t, err)
+				}
+
+				return []*entity.AccountTransactionReportItem{{Period: period, CategoryID: catID, Sum: &total}}, nil
+			})
+
+			report, err := s.uc.ImportTransactionsFromCSV(testCtx(), usecase.ImportTransactionsFromCSVInput{
+				Data:      []byte("csv-data"),
+				AccountID: accID,
+				Mode:      tt.mode,
+			})
+
+			require.Equal(t, tt.wantBatchCalls, s.transactionRepo.CreateBatchAfterCounter())
+			require.Zero(t, s.transactionRepo.CreateAfterCounter())
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, entity.ErrBudgetLimitExceeded)
+
+				hints, ok := appErrors.NearestHints(err)
+				require.True(t, ok)
+				require.Contains(t, hints, "line 2")
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantImported, report.Imported)
+			require.Equal(t, tt.wantRejected, report.Rejected)
+			require.Len(t, inserted, tt.wantImported)
+
+			for i, status := range tt.wantRowStatuses {
+				require.Equal(t, status, report.Rows[i].Status)
+			}
+		})
+	}
+}
+
+func TestTransactionUsecase_ReapplyCategoryRules_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+	oldCatID := uint64(1)
+	taxiCatID := uint64(2)
+	dateFrom := civil.Date{Year: 2025, Month: 12, Day: 1}
+	dateTo := civil.Date{Year: 2025, Month: 12, Day: 31}
+
+	ruleID := uuid.New()
+
+	tests := []struct {
+		name   string
+		dateTo civil.Date
+		dryRun bool
+
+		wantErr     bool
+		wantUpdated int
+	}{
+		{
+			name:        "OK",
+			dateTo:      dateTo,
+			wantUpdated: 1,
+		},
+		{
+			name:   "OK_dry_run",
+			dateTo: dateTo,
+			dryRun: true,
+		},
+		{
+			name:    "Negative_date_to_before_date_from",
+			dateTo:  civil.Date{Year: 2025, Month: 11, Day: 30},
+			wantErr: true,
+		},
+		{
+			name:    "Negative_period_too_long",
+			dateTo:  civil.Date{Year: 2026, Month: 12, Day: 2},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+			s.categoryUC.CheckCategoryAccessMock.Optional().Return(nil)
+			s.categoryRepo.FindListMock.Optional().Return([]*entity.Category{{ID: oldCatID}, {ID: taxiCatID}}, nil)
+
+			s.categoryRuleRepo.FindListMock.Optional().Return([]*entity.CategoryRule{{
+				ID: ruleID, AccountID: accID, CategoryID: taxiCatID,
+				Conditions: entity.CategoryRuleConditions{DescriptionContains: lo.ToPtr("taxi")},
+			}}, nil)
+
+			matched := &entity.Transaction{
+				ID: uuid.New(), AccountID: accID, Amount: decimal.MustParse("-300"),
+				OccurredOn: dateTo, CategoryID: oldCatID, Description: "Taxi home",
+			}
+
+			s.transactionRepo.FindListMock.Optional().Set(func(
+				ctx context.Context,
+				opt *usecase.TransactionListOptions,
+				_ *uctypes.QueryGetListParams,
+			) ([]*entity.Transaction, error) {
+				require.Equal(t, accID, *opt.FilterAccountID)
+				require.Equal(t, dateFrom, *opt.FilterOccurredOnFrom)
+				require.Equal(t, dateTo, *opt.FilterOccurredOnTo)
+
+				return []*entity.Transaction{
+					matched,
+					{
+						ID: uuid.New(), AccountID: accID, Amount: decimal.MustParse("-100"),
+						OccurredOn: dateTo, CategoryID: taxiCatID, Description: "taxi already set",
+					},
+					{
+						ID: uuid.New(), AccountID: accID, Amount: decimal.MustParse("-50"),
+						OccurredOn: dateTo, CategoryID: oldCatID, Description: "coffee",
+					},
+					{
+						ID: uuid.New(), AccountID: accID, Amount: decimal.MustParse("-500"),
+						OccurredOn: dateFrom, CategoryID: oldCatID, Description: "taxi card top up",
+						TransferID: lo.ToPtr(uuid.New()),
+					},
+				}, nil
+			})
+
+			updated := 0
+			s.transactionRepo.UpdateMock.Optional().Set(func(ctx context.Context, item *entity.Transaction) error {
+				require.Equal(t, matched.ID, item.ID)
+				require.Equal(t, taxiCatID, item.CategoryID)
+				updated++
+				return nil
+			})
+
+			report, err := s.uc.ReapplyCategoryRules(testCtx(), usecase.ReapplyCategoryRulesInput{
+				AccountID: accID,
+				DateFrom:  dateFrom,
+				DateTo:    tt.dateTo,
+				DryRun:    tt.dryRun,
+			})
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, appErrors.ErrBadRequest)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.dryRun, report.DryRun)
+			require.Equal(t, 3, report.Checked)
+			require.Equal(t, []*usecase.CategoryRuleChange{{
+				TransactionID: matched.ID,
+				RuleID:        ruleID,
+				OldCategoryID: oldCatID,
+				NewCategoryID: taxiCatID,
+			}}, report.Changes)
+			require.Equal(t, tt.wantUpdated, updated)
+		})
+	}
+}
+
+func TestTransactionUsecase_CreateTransactionsBatch_OK(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	s.cacheGenerationRepo.BumpGenerationsMock.Return(nil)
+
+	accID := uuid.New()
+	incomeCatID := uint64(3)
+	catID := uint64(10)
+	wallet := &entity.Wallet{ID: uuid.New(), AccountID: accID, Currency: "RUB"}
+
+	s.accountSettingsRepo.FindOneByAccountIDMock.Return(nil, appErrors.ErrNotFound)
+	s.categoryRepo.FindListMock.Return([]*entity.Category{{ID: incomeCatID}, {ID: catID}}, nil)
+	s.walletRepo.FindOneByIDMock.Return(wallet, nil)
+	s.budgetRepo.FindListMock.Return(nil, nil)
+
+	var created []*entity.Transaction
+	s.transactionRepo.CreateBatchMock.Set(func(ctx context.Context, items []*entity.Transaction) error {
+		created = items
+		return nil
+	})
+
+	items := []usecase.CreateTransactionDataInput{
+		{IsIncome: true, Amount: decimal.MustParse("1000"), OccurredOn: civil.Date{Year: 2025, Month: 12, Day: 1}, CategoryID: incomeCatID},
+		{Amount: decimal.MustParse("-10.555"), OccurredOn: civil.Date{Year: 2025, Month: 12, Day: 2}, CategoryID: catID, WalletID: &wallet.ID},
+		{Amount: decimal.MustParse("-20"), OccurredOn: civil.Date{Year: 2025, Month: 11, Day: 3}, CategoryID: catID, WalletID: &wallet.ID, Tags: []string{"Food"}},
+	}
+
+	got, err := s.uc.CreateTransactionsBatch(testCtx(), usecase.CreateTransactionsBatchInput{
+		AccountID: accID,
+		Items:     items,
+	})
 	require.NoError(t, err)
+	require.Len(t, created, len(items))
+	require.Len(t, got.IDs, len(items))
+	require.Empty(t, got.Warnings)
+
+	for i, item := range created {
+		require.Equal(t, got.IDs[i], item.ID)
+		require.Equal(t, accID, item.AccountID)
+		require.Equal(t, "RUB", item.Currency)
+	}
+
+	require.Equal(t, decimal.MustParse("-10.55"), created[1].Amount)
+	require.Equal(t, []string{"food"}, created[2].Tags)
+
+	// справочники читаются один раз на пакет, бюджеты - один раз на месяц и категорию
+	require.Equal(t, uint64(1), s.categoryRepo.FindListAfterCounter())
+	require.Equal(t, uint64(1), s.walletRepo.FindOneByIDAfterCounter())
+	require.Equal(t, uint64(3), s.budgetRepo.FindListAfterCounter())
+}
+
+func TestTransactionUsecase_CreateTransactionsBatch_Validation_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+	catID := uint64(10)
+	occurredOn := civil.Date{Year: 2025, Month: 12, Day: 1}
+
+	tests := []struct {
+		name  string
+		items []usecase.CreateTransactionDataInput
+
+		wantHints []string
+	}{
+		{
+			name:      "empty",
+			items:     nil,
+			wantHints: []string{"items are required"},
+		},
+		{
+			name:      "too many items",
+			items:     make([]usecase.CreateTransactionDataInput, usecase.TransactionsBatchMaxItems+1),
+			wantHints: []string{fmt.Sprintf("items must be <= %d", usecase.TransactionsBatchMaxItems)},
+		},
+		{
+			name: "unknown category points to item",
+			items: []usecase.CreateTransactionDataInput{
+				{IsIncome: true, Amount: decimal.MustParse("1"), OccurredOn: occurredOn, CategoryID: catID},
+				{IsIncome: true, Amount: decimal.MustParse("1"), OccurredOn: occurredOn, CategoryID: 99},
+			},
+			wantHints: []string{"category not found", "items[1]"},
+		},
+		{
+			name: "archived category points to item",
+			items: []usecase.CreateTransactionDataInput{
+				{IsIncome: true, Amount: decimal.MustParse("1"), OccurredOn: occurredOn, CategoryID: 11},
+			},
+			wantHints: []string{"category is archived", "items[0]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.accountSettingsRepo.FindOneByAccountIDMock.Optional().Return(nil, appErrors.ErrNotFound)
+			s.categoryRepo.FindListMock.Optional().Return([]*entity.Category{
+				{ID: catID},
+				{ID: 11, IsArchived: true},
+			}, nil)
+
+			got, err := s.uc.CreateTransactionsBatch(testCtx(), usecase.CreateTransactionsBatchInput{
+				AccountID: accID,
+				Items:     tt.items,
+			})
+			require.ErrorIs(t, err, appErrors.ErrBadRequest)
+			require.Nil(t, got)
+
+			hints, ok := appErrors.NearestHints(err)
+			require.True(t, ok)
+			require.Equal(t, tt.wantHints, hints)
+			require.Zero(t, s.transactionRepo.CreateBatchAfterCounter())
+		})
+	}
+}
+
+func TestTransactionUsecase_CreateTransactionsBatch_Budget_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+	catID := uint64(7)
+	period := civil.Date{Year: 2025, Month: 12, Day: 1}
+
+	tests := []struct {
+		name        string
+		enforcement entity.BudgetEnforcement
+		thresholds  []int
+		amounts     []string
+
+		wantHints        []string
+		wantThreshold    int
+		wantSpentPercent string
+		wantExceeded     bool
+		wantEventBy      int
+	}{
+		{
+			name:        "hard rejects batch and points to item over limit",
+			enforcement: entity.BudgetEnforcementHard,
+			amounts:     []string{"-300", "-300"},
+			wantHints:   []string{"budget limit exceeded", "items[1]"},
+		},
+		{
+			name:        "hard accepts batch compensated by income",
+			enforcement: entity.BudgetEnforcementHard,
+			amounts:     []string{"-600", "200"},
+			wantEventBy: -1,
+		},
+		{
+			name:             "threshold crossed by later item",
+			enforcement:      entity.BudgetEnforcementHard,
+			thresholds:       []int{50, 80},
+			amounts:          []string{"-100", "-200"},
+			wantThreshold:    80,
+			wantSpentPercent: "80",
+			wantEventBy:      1,
+		},
+		{
+			name:             "soft saves over limit with single warning",
+			enforcement:      entity.BudgetEnforcementSoft,
+			amounts:          []string{"-400", "-200", "-100"},
+			wantSpentPercent: "120",
+			wantExceeded:     true,
+			wantEventBy:      1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.cacheGenerationRepo.BumpGenerationsMock.Optional().Return(nil)
+
+			budgetID := uuid.New()
+
+			s.accountSettingsRepo.FindOneByAccountIDMock.Return(&entity.AccountSettings{AccountID: accID, BaseCurrency: "RUB"}, nil)
+			s.categoryRepo.FindListMock.Return([]*entity.Category{{ID: catID}}, nil)
+
+			s.budgetRepo.FindListMock.Return([]*entity.Budget{
+				{
+					ID:                budgetID,
+					AccountID:         accID,
+					Period:            period,
+					CategoryID:        catID,
+					Amount:            decimal.MustParse("1000"),
+					Currency:          "RUB",
+					Enforcement:       tt.enforcement,
+					WarningThresholds: tt.thresholds,
+				},
+			}, nil)
+
+			items := make([]usecase.CreateTransactionDataInput, 0, len(tt.amounts))
+			total := decimal.MustParse("-500")
+			for i, amount := range tt.amounts {
+				value := decimal.MustParse(amount)
+
+				var err error
+				total, err = total.Add(value)
+				require.NoError(t, err)
+
+				items = append(items, usecase.CreateTransactionDataInput{
+					IsIncome:   value.Sign() > 0,
+					Amount:     value,
+					OccurredOn: civil.Date{Year: 2025, Month: 12, Day: 10 + i},
+					CategoryID: catID,
+				})
+			}
+
+			// пакет уже вставлен, баланс периода включает его
+			s.transactionRepo.CountReportItemsMock.Return([]*entity.AccountTransactionReportItem{
+				{Period: period, CategoryID: catID, Sum: &total},
+			}, nil)
+
+			var createdIDs []uuid.UUID
+			s.transactionRepo.CreateBatchMock.Set(func(ctx context.Context, items []*entity.Transaction) error {
+				for _, item := range items {
+					createdIDs = append(createdIDs, item.ID)
+				}
+				return nil
+			})
+
+			var eventPayload *entity.BudgetThresholdCrossedPayload
+			s.outboxEventRepo.CreateMock.Optional().Set(func(ctx context.Context, item *entity.OutboxEvent) error {
+				eventPayload = &entity.BudgetThresholdCrossedPayload{}
+				require.NoError(t, json.Unmarshal(item.Payload, eventPayload))
+				return nil
+			})
+
+			got, err := s.uc.CreateTransactionsBatch(testCtx(), usecase.CreateTransactionsBatchInput{
+				AccountID: accID,
+				Items:     items,
+			})
+
+			require.Equal(t, uint64(1), s.budgetRepo.FindListAfterCounter())
+			require.Equal(t, uint64(1), s.transactionRepo.CountReportItemsAfterCounter())
+
+			if tt.wantHints != nil {
+				require.ErrorIs(t, err, entity.ErrBudgetLimitExceeded)
+				require.Nil(t, got)
+
+				hints, ok := appErrors.NearestHints(err)
+				require.True(t, ok)
+				require.Equal(t, tt.wantHints, hints)
+				return
+			}
+
+			require.NoError(t, err)
+
+			if tt.wantEventBy < 0 {
+				require.Empty(t, got.Warnings)
+				require.Nil(t, eventPayload)
+				return
+			}
+
+			require.Len(t, got.Warnings, 1)
+			warning := got.Warnings[0]
+			require.Equal(t, budgetID, warning.BudgetID)
+			require.Equal(t, tt.wantThreshold, warning.Threshold)
+			require.Equal(t, tt.wantExceeded, warning.Exceeded)
+			require.True(t, warning.Crossed)
+			require.Zero(t, warning.SpentPercent.Cmp(decimal.MustParse(tt.wantSpentPercent)))
+
+			require.NotNil(t, eventPayload)
+			require.Equal(t, createdIDs[tt.wantEventBy], eventPayload.TransactionID)
+		})
+	}
 }
@@ -2,11 +2,20 @@ package transaction
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"strconv"
 	"strings"
 
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/loghandler"
 	"github.com/samber/lo"
 )
 
@@ -31,6 +40,30 @@ func (uc *UsecaseImpl) entitiesToDTO(
 		return item.ID, item
 	})
 
+	walletsIDs := lo.Uniq(lo.FilterMap(items, func(item *entity.Transaction, _ int) (uuid.UUID, bool) {
+		if item.WalletID == nil {
+			return uuid.Nil, false
+		}
+		return *item.WalletID, true
+	}))
+
+	walletsMap := make(map[uuid.UUID]*entity.Wallet, len(walletsIDs))
+
+	if len(walletsIDs) > 0 {
+		wallets, err := uc.walletRepo.FindList(ctx, &usecase.WalletListOptions{
+			FilterIDs: &walletsIDs,
+		}, &uctypes.QueryGetListParams{
+			WithDeleted: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, wallet := range wallets {
+			walletsMap[wallet.ID] = wallet
+		}
+	}
+
 	for _, item := range items {
 		resItem := &usecase.TransactionDTO{
 			Transaction: item,
@@ -40,19 +73,31 @@ func (uc *UsecaseImpl) entitiesToDTO(
 			resItem.Category = category
 		}
 
+		if item.WalletID != nil {
+			if wallet, ok := walletsMap[*item.WalletID]; ok {
+				resItem.Wallet = wallet
+			}
+		}
+
 		out = append(out, resItem)
 	}
 
 	return out, nil
 }
 
-func buildKeyForCountReportItems(queryFilter usecase.CountReportItemsQueryFilter) string {
+func buildKeyForCountReportItems(queryFilter usecase.CountReportItemsQueryFilter, generation uint64) string {
 	var strBuilder strings.Builder
 
 	strBuilder.WriteString("Transaction::CountReportItems::")
 
 	strBuilder.WriteString(queryFilter.AccountID.String())
 
+	strBuilder.WriteString("::gen:")
+	strBuilder.WriteString(strconv.FormatUint(generation, 10))
+
+	strBuilder.WriteString("::currency:")
+	strBuilder.WriteString(queryFilter.BaseCurrency)
+
 	if queryFilter.DateFrom != nil {
 		strBuilder.WriteString("::dateFrom:")
 		strBuilder.WriteString(queryFilter.DateFrom.String())
@@ -68,5 +113,1645 @@ func buildKeyForCountReportItems(queryFilter usecase.CountReportItemsQueryFilter
 		strBuilder.WriteString(fmt.Sprintf("%d", *queryFilter.CategoryID))
 	}
 
+	if len(queryFilter.CategoryIDs) > 0 {
+		strBuilder.WriteString("::categoryIDs:")
+		for _, categoryID := range queryFilter.CategoryIDs {
+			strBuilder.WriteString(fmt.Sprintf("%d,", categoryID))
+		}
+	}
+
 	return strBuilder.String()
 }
+
+func (uc *UsecaseImpl) loadCategoryTree(ctx context.Context, accountID uuid.UUID) (*entity.CategoryTree, error) {
+	categories, err := uc.categoryRepo.FindList(ctx, &usecase.CategoryListOptions{
+		FilterAvailableForAccountID: &accountID,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.NewCategoryTree(categories), nil
+}
+
+// categoryRules - правила аккаунта, категории которых доступны для транзакций.
+// Правила с архивной или недоступной категорией пропускаются
+func (uc *UsecaseImpl) categoryRules(ctx context.Context, accountID uuid.UUID) ([]*entity.CategoryRule, error) {
+	rules, err := uc.categoryRuleRepo.FindList(ctx, &usecase.CategoryRuleListOptions{
+		FilterAccountID: &accountID,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	isAvailable := make(map[uint64]bool)
+	out := make([]*entity.CategoryRule, 0, len(rules))
+
+	for _, rule := range rules {
+		ok, isChecked := isAvailable[rule.CategoryID]
+		if !isChecked {
+			err := uc.categoryUC.CheckCategoryAccess(ctx, rule.CategoryID, accountID)
+			if err != nil && !errors.Is(err, appErrors.ErrBadRequest) {
+				return nil, err
+			}
+
+			ok = err == nil
+			isAvailable[rule.CategoryID] = ok
+		}
+
+		if ok {
+			out = append(out, rule)
+		}
+	}
+
+	return out, nil
+}
+
+// applyCategoryRules - категория транзакции без категории по первому подходящему правилу
+func (uc *UsecaseImpl) applyCategoryRules(ctx context.Context, transaction *entity.Transaction) error {
+	rules, err := uc.categoryRules(ctx, transaction.AccountID)
+	if err != nil {
+		return err
+	}
+
+	rule := entity.MatchCategoryRule(rules, transaction)
+	if rule == nil {
+		return entity.ErrTransactionCategoryRequired
+	}
+
+	transaction.CategoryID = rule.CategoryID
+
+	return nil
+}
+
+// checkBudgetLimit - проверка бюджетов категории транзакции и всех её родителей.
+// Бюджет родителя ограничивает сумму по всем вложенным категориям.
+// Возвращает предупреждения по бюджетам в режиме soft и по достигнутым порогам
+func (uc *UsecaseImpl) checkBudgetLimit(
+	ctx context.Context,
+	transaction *entity.Transaction,
+	amount decimal.Decimal,
+	excludeIDs []uuid.UUID,
+) ([]*entity.BudgetWarning, error) {
+	period := civil.Date{
+		Year:  transaction.OccurredOn.Year,
+		Month: transaction.OccurredOn.Month,
+		Day:   1,
+	}
+
+	tree, err := uc.loadCategoryTree(ctx, transaction.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	baseCurrency, err := uc.baseCurrency(ctx, transaction.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err = uc.convertAmount(ctx, transaction.AccountID, amount, transaction.Currency, baseCurrency, transaction.OccurredOn)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryIDs := append([]uint64{transaction.CategoryID}, tree.AncestorIDs(transaction.CategoryID)...)
+
+	warnings := make([]*entity.BudgetWarning, 0)
+
+	for _, categoryID := range categoryIDs {
+		budget, err := uc.findPeriodBudget(ctx, transaction.AccountID, period, categoryID)
+		if err != nil {
+			return nil, err
+		}
+
+		if budget == nil || budget.Enforcement == entity.BudgetEnforcementOff {
+			continue
+		}
+
+		budgetAmount, err := uc.budgetLimitAmount(ctx, tree, budget, baseCurrency, excludeIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		// состояние бюджета без учета транзакции, для определения пересечения порога
+		balanceBefore, err := uc.budgetPeriodBalance(ctx, tree, budget, baseCurrency, excludeIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		balance, err := balanceBefore.Add(amount)
+		if err != nil {
+			return nil, err
+		}
+
+		if isBudgetExceeded(balance, budgetAmount) && budget.Enforcement != entity.BudgetEnforcementSoft {
+			return nil, budgetLimitExceededErr(categoryID, transaction.CategoryID)
+		}
+
+		warning, err := newBudgetWarning(budget, budgetAmount, balanceBefore, balance)
+		if err != nil {
+			return nil, err
+		}
+
+		if warning != nil {
+			warnings = append(warnings, warning)
+		}
+	}
+
+	return warnings, nil
+}
+
+// findPeriodBudget - бюджет категории на месяц, nil - бюджета нет
+func (uc *UsecaseImpl) findPeriodBudget(
+	ctx context.Context,
+	accountID uuid.UUID,
+	period civil.Date,
+	categoryID uint64,
+) (*entity.Budget, error) {
+	budgets, err := uc.budgetRepo.FindList(ctx, &usecase.BudgetListOptions{
+		FilterAccountID:  &accountID,
+		FilterPeriod:     &period,
+		FilterCategoryID: &categoryID,
+	}, &uctypes.QueryGetListParams{
+		Limit: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(budgets) == 0 {
+		return nil, nil
+	}
+
+	return budgets[0], nil
+}
+
+// budgetLimitAmount - сумма бюджета в базовой валюте вместе с перенесенным остатком
+func (uc *UsecaseImpl) budgetLimitAmount(
+	ctx context.Context,
+	tree *entity.CategoryTree,
+	budget *entity.Budget,
+	baseCurrency string,
+	excludeIDs []uuid.UUID,
+) (decimal.Decimal, error) {
+	budgetAmount, err := uc.convertAmount(ctx, budget.AccountID, budget.Amount, budget.Currency, baseCurrency, budget.Period)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	if !budget.CarryOver {
+		return budgetAmount, nil
+	}
+
+	carriedOver, err := uc.budgetCarriedOver(ctx, tree, budget, baseCurrency, excludeIDs, nil)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return budgetAmount.Add(carriedOver)
+}
+
+// budgetPeriodBalance - баланс месяца бюджета по категории вместе с вложенными, в базовой валюте
+func (uc *UsecaseImpl) budgetPeriodBalance(
+	ctx context.Context,
+	tree *entity.CategoryTree,
+	budget *entity.Budget,
+	baseCurrency string,
+	excludeIDs []uuid.UUID,
+) (decimal.Decimal, error) {
+	periodEnd := budget.Period.AddMonths(1).AddDays(-1)
+
+	reports, err := uc.transactionRepo.CountReportItems(ctx, usecase.CountReportItemsQueryFilter{
+		AccountID:    budget.AccountID,
+		BaseCurrency: baseCurrency,
+		DateFrom:     &budget.Period,
+		DateTo:       &periodEnd,
+		CategoryIDs:  append([]uint64{budget.CategoryID}, tree.DescendantIDs(budget.CategoryID)...),
+		ExcludeIDs:   excludeIDs,
+	})
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	balance := decimal.Zero
+	for _, report := range reports {
+		if report.Sum != nil {
+			balance, err = balance.Add(*report.Sum)
+			if err != nil {
+				return decimal.Zero, err
+			}
+		}
+	}
+
+	return balance, nil
+}
+
+func isBudgetExceeded(balance decimal.Decimal, budgetAmount decimal.Decimal) bool {
+	return balance.Cmp(budgetAmount.Neg()) == -1
+}
+
+func budgetLimitExceededErr(budgetCategoryID uint64, transactionCategoryID uint64) error {
+	hint := "budget limit exceeded"
+	if budgetCategoryID != transactionCategoryID {
+		hint = fmt.Sprintf("budget limit of parent category %d exceeded", budgetCategoryID)
+	}
+
+	return entity.ErrBudgetLimitExceeded.WithHints(hint)
+}
+
+// newBudgetWarning - предупреждение по балансу периода до и после изменения, nil - бюджет не превышен
+// и пороги не достигнуты
+func newBudgetWarning(
+	budget *entity.Budget,
+	budgetAmount decimal.Decimal,
+	balanceBefore decimal.Decimal,
+	balance decimal.Decimal,
+) (*entity.BudgetWarning, error) {
+	exceeded := isBudgetExceeded(balance, budgetAmount)
+	exceededBefore := isBudgetExceeded(balanceBefore, budgetAmount)
+
+	warning := &entity.BudgetWarning{
+		BudgetID:    budget.ID,
+		CategoryID:  budget.CategoryID,
+		Period:      budget.Period,
+		Enforcement: budget.Enforcement,
+		Exceeded:    exceeded,
+	}
+
+	thresholdBefore := 0
+
+	if budgetAmount.Sign() > 0 {
+		spentPercent, err := budgetSpentPercent(balance, budgetAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		spentPercentBefore, err := budgetSpentPercent(balanceBefore, budgetAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		warning.SpentPercent = &spentPercent
+		warning.Threshold = budget.ReachedWarningThreshold(spentPercent)
+		thresholdBefore = budget.ReachedWarningThreshold(spentPercentBefore)
+	} else if len(budget.WarningThresholds) > 0 {
+		if exceeded {
+			warning.Threshold = budget.WarningThresholds[len(budget.WarningThresholds)-1]
+		}
+
+		if exceededBefore {
+			thresholdBefore = budget.WarningThresholds[len(budget.WarningThresholds)-1]
+		}
+	}
+
+	warning.Crossed = warning.Threshold > thresholdBefore || (exceeded && !exceededBefore)
+
+	if !warning.Exceeded && warning.Threshold == 0 {
+		return nil, nil
+	}
+
+	return warning, nil
+}
+
+// budgetSpentPercent - процент расходования бюджета по балансу периода
+func budgetSpentPercent(balance decimal.Decimal, budgetAmount decimal.Decimal) (decimal.Decimal, error) {
+	if balance.Sign() >= 0 {
+		return decimal.Zero, nil
+	}
+
+	spentPercent, err := balance.Neg().Quo(budgetAmount)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	spentPercent, err = spentPercent.Mul(decimal.Hundred)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return spentPercent.Trunc(2), nil
+}
+
+// budgetCarryOverMaxDepth - на сколько месяцев назад учитывается цепочка переносов
+const budgetCarryOverMaxDepth = 12
+
+// budgetCarriedOver - остаток (или перерасход) прошлого месяца, переносимый на бюджет, в базовой валюте.
+// Остаток считается по категории вместе с вложенными, как и проверка лимита.
+// known - уже посчитанные бюджеты с учетом переноса, по ним цепочка обрывается
+func (uc *UsecaseImpl) budgetCarriedOver(
+	ctx context.Context,
+	tree *entity.CategoryTree,
+	budget *entity.Budget,
+	baseCurrency string,
+	excludeIDs []uuid.UUID,
+	known map[uuid.UUID]decimal.Decimal,
+) (decimal.Decimal, error) {
+	// chain - бюджеты прошлых месяцев, от ближнего к дальнему
+	chain := make([]*entity.Budget, 0)
+
+	for current := budget; current.CarryOver && len(chain) < budgetCarryOverMaxDepth; {
+		prevPeriod := current.Period.AddMonths(-1)
+
+		prev, err := uc.budgetRepo.FindList(ctx, &usecase.BudgetListOptions{
+			FilterAccountID:  &budget.AccountID,
+			FilterPeriod:     &prevPeriod,
+			FilterCategoryID: &budget.CategoryID,
+		}, &uctypes.QueryGetListParams{
+			Limit: 1,
+		})
+		if err != nil {
+			return decimal.Zero, err
+		}
+
+		if len(prev) == 0 {
+			break
+		}
+
+		current = prev[0]
+		chain = append(chain, current)
+
+		if _, ok := known[current.ID]; ok {
+			break
+		}
+	}
+
+	if len(chain) == 0 {
+		return decimal.Zero, nil
+	}
+
+	dateFrom := chain[len(chain)-1].Period
+	dateTo := budget.Period.AddDays(-1)
+
+	reports, err := uc.transactionRepo.CountReportItems(ctx, usecase.CountReportItemsQueryFilter{
+		AccountID:    budget.AccountID,
+		BaseCurrency: baseCurrency,
+		DateFrom:     &dateFrom,
+		DateTo:       &dateTo,
+		CategoryIDs:  append([]uint64{budget.CategoryID}, tree.DescendantIDs(budget.CategoryID)...),
+		ExcludeIDs:   excludeIDs,
+	})
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	sums := make(map[civil.Date]decimal.Decimal, len(chain))
+	for _, report := range reports {
+		if report.Sum == nil {
+			continue
+		}
+
+		sums[report.Period], err = sums[report.Period].Add(*report.Sum)
+		if err != nil {
+			return decimal.Zero, err
+		}
+	}
+
+	carriedOver := decimal.Zero
+
+	for i := len(chain) - 1; i >= 0; i-- {
+		prevBudget := chain[i]
+
+		effective, ok := known[prevBudget.ID]
+		if !ok {
+			effective, err = uc.convertAmount(
+				ctx,
+				prevBudget.AccountID,
+				prevBudget.Amount,
+				prevBudget.Currency,
+				baseCurrency,
+				prevBudget.Period,
+			)
+			if err != nil {
+				return decimal.Zero, err
+			}
+
+			effective, err = effective.Add(carriedOver)
+			if err != nil {
+				return decimal.Zero, err
+			}
+		}
+
+		carriedOver, err = effective.Add(sums[prevBudget.Period])
+		if err != nil {
+			return decimal.Zero, err
+		}
+	}
+
+	return carriedOver, nil
+}
+
+// fillReportRollups - заполняет сумму и бюджет с учетом вложенных категорий
+func fillReportRollups(tree *entity.CategoryTree, items []*entity.AccountTransactionReportItem) {
+	itemsByCategory := lo.SliceToMap(items, func(item *entity.AccountTransactionReportItem) (uint64, *entity.AccountTransactionReportItem) {
+		return item.CategoryID, item
+	})
+
+	var fill func(item *entity.AccountTransactionReportItem)
+	fill = func(item *entity.AccountTransactionReportItem) {
+		if item.TotalSum != nil || item.TotalBudgetAmount != nil {
+			return
+		}
+
+		item.TotalSum = item.Sum
+		item.TotalBudgetAmount = item.EffectiveBudgetAmount()
+
+		var childrenBudget *decimal.Decimal
+
+		for _, child := range tree.Children(item.CategoryID) {
+			childItem, ok := itemsByCategory[child.ID]
+			if !ok {
+				continue
+			}
+
+			fill(childItem)
+
+			item.TotalSum = addDecimalPtr(item.TotalSum, childItem.TotalSum)
+			childrenBudget = addDecimalPtr(childrenBudget, childItem.TotalBudgetAmount)
+		}
+
+		if item.TotalBudgetAmount == nil {
+			item.TotalBudgetAmount = childrenBudget
+		}
+	}
+
+	for _, item := range items {
+		if category, ok := tree.Get(item.CategoryID); ok {
+			item.ParentCategoryID = category.ParentID
+		}
+
+		fill(item)
+	}
+}
+
+func addDecimalPtr(a *decimal.Decimal, b *decimal.Decimal) *decimal.Decimal {
+	if b == nil {
+		return a
+	}
+
+	if a == nil {
+		return lo.ToPtr(*b)
+	}
+
+	sum, err := a.Add(*b)
+	if err != nil {
+		return a
+	}
+
+	return &sum
+}
+
+func (uc *UsecaseImpl) checkWallet(ctx context.Context, walletID uuid.UUID, accountID uuid.UUID) (*entity.Wallet, error) {
+	wallet, err := uc.walletRepo.FindOneByID(ctx, walletID, nil)
+	if err != nil {
+		if errors.Is(err, appErrors.ErrNotFound) {
+			return nil, appErrors.ErrBadRequest.WithHints("wallet not found")
+		}
+		return nil, err
+	}
+
+	if wallet.AccountID != accountID {
+		return nil, appErrors.ErrBadRequest.WithHints("wallet not found")
+	}
+
+	if wallet.IsArchived {
+		return nil, appErrors.ErrBadRequest.WithHints("wallet is archived")
+	}
+
+	return wallet, nil
+}
+
+// checkTransactionWallet - проверка кошелька транзакции, валюты транзакции и кошелька должны совпадать
+func (uc *UsecaseImpl) checkTransactionWallet(ctx context.Context, transaction *entity.Transaction) error {
+	wallet, err := uc.checkWallet(ctx, *transaction.WalletID, transaction.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if wallet.Currency != transaction.Currency {
+		return appErrors.ErrBadRequest.WithHints("transaction currency must match wallet currency")
+	}
+
+	return nil
+}
+
+// checkTransferWallets - проверка кошельков перевода, возвращает их общую валюту
+func (uc *UsecaseImpl) checkTransferWallets(ctx context.Context, transfer *entity.Transfer) (string, error) {
+	fromWallet, err := uc.checkWallet(ctx, transfer.FromWalletID(), transfer.AccountID())
+	if err != nil {
+		return "", err
+	}
+
+	toWallet, err := uc.checkWallet(ctx, transfer.ToWalletID(), transfer.AccountID())
+	if err != nil {
+		return "", err
+	}
+
+	if fromWallet.Currency != toWallet.Currency {
+		return "", appErrors.ErrBadRequest.WithHints("transfer wallets must have the same currency")
+	}
+
+	return fromWallet.Currency, nil
+}
+
+// resolveCurrency - валюта новой транзакции: явно заданная, валюта кошелька, либо базовая валюта аккаунта
+func (uc *UsecaseImpl) resolveCurrency(
+	ctx context.Context,
+	accountID uuid.UUID,
+	currency string,
+	walletID *uuid.UUID,
+) (string, error) {
+	if currency != "" {
+		return currency, nil
+	}
+
+	if walletID != nil {
+		wallet, err := uc.checkWallet(ctx, *walletID, accountID)
+		if err != nil {
+			return "", err
+		}
+
+		return wallet.Currency, nil
+	}
+
+	return uc.baseCurrency(ctx, accountID)
+}
+
+func (uc *UsecaseImpl) baseCurrency(ctx context.Context, accountID uuid.UUID) (string, error) {
+	settings, err := uc.accountSettingsRepo.FindOneByAccountID(ctx, accountID, nil)
+	if err != nil {
+		if errors.Is(err, appErrors.ErrNotFound) {
+			return uc.cfg.Budget.DefaultCurrency, nil
+		}
+		return "", err
+	}
+
+	return settings.BaseCurrency, nil
+}
+
+// convertAmount - пересчет суммы в другую валюту по последнему курсу на дату
+func (uc *UsecaseImpl) convertAmount(
+	ctx context.Context,
+	accountID uuid.UUID,
+	amount decimal.Decimal,
+	currencyFrom string,
+	currencyTo string,
+	date civil.Date,
+) (decimal.Decimal, error) {
+	if currencyFrom == currencyTo {
+		return amount, nil
+	}
+
+	rate, err := uc.findExchangeRate(ctx, accountID, currencyFrom, currencyTo, date)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return convertByRate(rate, amount, currencyFrom, currencyTo)
+}
+
+func (uc *UsecaseImpl) findExchangeRate(
+	ctx context.Context,
+	accountID uuid.UUID,
+	currencyFrom string,
+	currencyTo string,
+	date civil.Date,
+) (*entity.ExchangeRate, error) {
+	rate, err := uc.exchangeRateRepo.FindActual(ctx, accountID, currencyFrom, currencyTo, date)
+	if err != nil {
+		if errors.Is(err, appErrors.ErrNotFound) {
+			return nil, entity.ErrExchangeRateNotFound.WithHints(missingRateHint(currencyFrom, currencyTo))
+		}
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+func missingRateHint(currencyFrom string, currencyTo string) string {
+	return fmt.Sprintf("exchange rate %s/%s not found", currencyFrom, currencyTo)
+}
+
+// missingRateHints - подсказки ошибки об отсутствии курса, ok = false для остальных ошибок
+func missingRateHints(err error) ([]string, bool) {
+	if !errors.Is(err, entity.ErrExchangeRateNotFound) {
+		return nil, false
+	}
+
+	hints, _ := appErrors.NearestHints(err)
+
+	return hints, true
+}
+
+func convertByRate(
+	rate *entity.ExchangeRate,
+	amount decimal.Decimal,
+	currencyFrom string,
+	currencyTo string,
+) (decimal.Decimal, error) {
+	converted, err := rate.Convert(amount, currencyFrom)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return converted.Round(entity.CurrencyScale(currencyTo)), nil
+}
+
+func (uc *UsecaseImpl) loadTransfer(
+	ctx context.Context,
+	id uuid.UUID,
+	queryParams *uctypes.QueryGetOneParams,
+) (*entity.Transfer, error) {
+	listParams := &uctypes.QueryGetListParams{}
+	if queryParams != nil {
+		listParams.ForUpdate = queryParams.ForUpdate
+		listParams.ForShare = queryParams.ForShare
+		listParams.WithDeleted = queryParams.WithDeleted
+	}
+
+	legs, err := uc.transactionRepo.FindList(ctx, &usecase.TransactionListOptions{
+		FilterTransferID: &id,
+	}, listParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(legs) == 0 {
+		return nil, appErrors.ErrNotFound
+	}
+
+	return entity.NewTransferFromLegs(legs)
+}
+
+func (uc *UsecaseImpl) transferToDTO(ctx context.Context, transfer *entity.Transfer) (*usecase.TransferDTO, error) {
+	walletsIDs := []uuid.UUID{transfer.FromWalletID(), transfer.ToWalletID()}
+
+	wallets, err := uc.walletRepo.FindList(ctx, &usecase.WalletListOptions{
+		FilterIDs: &walletsIDs,
+	}, &uctypes.QueryGetListParams{
+		WithDeleted: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := &usecase.TransferDTO{
+		Transfer: transfer,
+	}
+
+	for _, wallet := range wallets {
+		switch wallet.ID {
+		case transfer.FromWalletID():
+			out.FromWallet = wallet
+		case transfer.ToWalletID():
+			out.ToWallet = wallet
+		}
+	}
+
+	return out, nil
+}
+
+func checkNotTransfer(transaction *entity.Transaction) error {
+	if transaction.IsTransfer() {
+		return appErrors.ErrBadRequest.WithHints("transaction is a part of transfer, use transfer methods")
+	}
+
+	return nil
+}
+
+// createBudgetOutboxEvents - запись событий о пересечении порогов бюджетов в outbox,
+// вызывается в той же транзакции БД, что и сохранение транзакции
+func (uc *UsecaseImpl) createBudgetOutboxEvents(
+	ctx context.Context,
+	transaction *entity.Transaction,
+	warnings []*entity.BudgetWarning,
+) error {
+	for _, warning := range warnings {
+		if !warning.Crossed {
+			continue
+		}
+
+		event, err := entity.NewBudgetThresholdCrossedEvent(transaction, warning)
+		if err != nil {
+			return appErrors.ErrInternal.WithWrap(err)
+		}
+
+		err = uc.outboxEventRepo.Create(ctx, event)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createBatchOutboxEvents - события пакета: по бюджету одно событие от транзакции, пересекшей порог
+func (uc *UsecaseImpl) createBatchOutboxEvents(
+	ctx context.Context,
+	warnings []*entity.BudgetWarning,
+	crossedBy map[uuid.UUID]*entity.Transaction,
+) error {
+	for _, warning := range warnings {
+		transaction, ok := crossedBy[warning.BudgetID]
+		if !ok {
+			continue
+		}
+
+		err := uc.createBudgetOutboxEvents(ctx, transaction, []*entity.BudgetWarning{warning})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// invalidateReportsCache - сдвигает поколение кеша отчетов аккаунта, вызывается после фиксации транзакции БД.
+// Вызывающий код с внешней транзакцией повторяет сдвиг после своей фиксации
+func (uc *UsecaseImpl) invalidateReportsCache(ctx context.Context, accountID uuid.UUID) {
+	err := uc.cacheGenerationRepo.BumpGenerations(context.WithoutCancel(ctx), usecase.CacheScopeReports(accountID))
+	if err != nil {
+		uc.logger.ErrorContext(loghandler.WithSource(ctx), "redis bump cache generation err", slog.Any("error", err))
+	}
+}
+
+// findImportProfile - профиль импорта должен принадлежать аккаунту, в который идет импорт
+func (uc *UsecaseImpl) findImportProfile(
+	ctx context.Context,
+	id uuid.UUID,
+	accountID uuid.UUID,
+) (*entity.ImportProfile, error) {
+	importProfile, err := uc.importProfileRepo.FindOneByID(ctx, id, nil)
+	if err != nil {
+		if errors.Is(err, appErrors.ErrNotFound) {
+			return nil, appErrors.ErrBadRequest.WithHints("import profile not found")
+		}
+		return nil, err
+	}
+
+	if importProfile.AccountID != accountID {
+		return nil, appErrors.ErrBadRequest.WithHints("import profile not found")
+	}
+
+	return importProfile, nil
+}
+
+// errImportDryRun - откатывает транзакцию БД пробного импорта
+var errImportDryRun = errors.New("import dry run")
+
+// importRows - разбор файла импорта выбранным форматом, для выписок без категорий
+// используется категория из запроса или профиля
+func (uc *UsecaseImpl) importRows(
+	ctx context.Context,
+	in usecase.ImportTransactionsFromCSVInput,
+	baseCurrency string,
+	importProfile *entity.ImportProfile,
+) ([]*usecase.TransactionImportRow, error) {
+	if in.Format == "" || in.Format == usecase.TransactionImportFormatCSV {
+		return uc.transactionCSVRepo.ItemsFromCSV(ctx, in.Data, in.AccountID, baseCurrency, importProfile)
+	}
+
+	repo, ok := uc.statementRepos[in.Format]
+	if !ok {
+		return nil, appErrors.ErrBadRequest.WithHints(fmt.Sprintf("import format %q is not supported", in.Format))
+	}
+
+	defaultCategoryID := in.DefaultCategoryID
+	if defaultCategoryID == nil && importProfile != nil {
+		defaultCategoryID = importProfile.DefaultCategoryID
+	}
+
+	return repo.ItemsFromStatement(ctx, in.Data, in.AccountID, baseCurrency, defaultCategoryID)
+}
+
+// resolveImportCategories - строкам без категории она подбирается по правилам аккаунта.
+// Строки, не подошедшие ни под одно правило, остаются без категории и отклоняются при сохранении,
+// если не обновляют совпавшую транзакцию
+func (uc *UsecaseImpl) resolveImportCategories(
+	ctx context.Context,
+	accountID uuid.UUID,
+	rows []*usecase.TransactionImportRow,
+) error {
+	var rules []*entity.CategoryRule
+	isLoaded := false
+
+	for _, row := range rows {
+		if row.Transaction == nil || row.Transaction.CategoryID != 0 {
+			continue
+		}
+
+		if !isLoaded {
+			var err error
+
+			rules, err = uc.categoryRules(ctx, accountID)
+			if err != nil {
+				return err
+			}
+
+			isLoaded = true
+		}
+
+		rule := entity.MatchCategoryRule(rules, row.Transaction)
+		if rule != nil {
+			row.Transaction.CategoryID = rule.CategoryID
+		}
+	}
+
+	return nil
+}
+
+// setImportFingerprints - одинаковые строки файла нумеруются по порядку, номер входит в отпечаток
+func setImportFingerprints(rows []*usecase.TransactionImportRow) {
+	ordinals := make(map[string]int, len(rows))
+
+	for _, row := range rows {
+		if row.Transaction == nil {
+			continue
+		}
+
+		base := row.Transaction.ComputeImportFingerprint(0)
+		fingerprint := row.Transaction.ComputeImportFingerprint(ordinals[base])
+		ordinals[base]++
+
+		row.Transaction.ImportFingerprint = &fingerprint
+	}
+}
+
+// importExistingTransactions - транзакции аккаунта за период строк импорта с учетом допуска по дате
+func (uc *UsecaseImpl) importExistingTransactions(
+	ctx context.Context,
+	accountID uuid.UUID,
+	rows []*usecase.TransactionImportRow,
+	toleranceDays int,
+) ([]*entity.Transaction, error) {
+	var dateFrom, dateTo *civil.Date
+
+	for _, row := range rows {
+		if row.Transaction == nil {
+			continue
+		}
+
+		occurredOn := row.Transaction.OccurredOn
+		if dateFrom == nil || occurredOn.Before(*dateFrom) {
+			dateFrom = &occurredOn
+		}
+
+		if dateTo == nil || occurredOn.After(*dateTo) {
+			dateTo = &occurredOn
+		}
+	}
+
+	if dateFrom == nil {
+		return nil, nil
+	}
+
+	return uc.transactionRepo.FindList(ctx, &usecase.TransactionListOptions{
+		FilterAccountID:      &accountID,
+		FilterOccurredOnFrom: lo.ToPtr(dateFrom.AddDays(-toleranceDays)),
+		FilterOccurredOnTo:   lo.ToPtr(dateTo.AddDays(toleranceDays)),
+	}, nil)
+}
+
+// importMatcher - поиск существующих транзакций, совпадающих со строками импорта.
+// Каждая существующая транзакция сопоставляется не более чем одной строке
+type importMatcher struct {
+	items         []*entity.Transaction
+	byFingerprint map[string]*entity.Transaction
+	toleranceDays int
+	used          map[uuid.UUID]struct{}
+}
+
+func newImportMatcher(items []*entity.Transaction, toleranceDays int) *importMatcher {
+	byFingerprint := make(map[string]*entity.Transaction, len(items))
+	for _, item := range items {
+		if item.ImportFingerprint != nil {
+			byFingerprint[*item.ImportFingerprint] = item
+		}
+	}
+
+	return &importMatcher{
+		items:         items,
+		byFingerprint: byFingerprint,
+		toleranceDays: toleranceDays,
+		used:          make(map[uuid.UUID]struct{}),
+	}
+}
+
+func (m *importMatcher) reset() {
+	clear(m.used)
+}
+
+func (m *importMatcher) match(item *entity.Transaction) *entity.Transaction {
+	if item.ImportFingerprint != nil {
+		existing, ok := m.byFingerprint[*item.ImportFingerprint]
+		if ok && !lo.HasKey(m.used, existing.ID) {
+			m.used[existing.ID] = struct{}{}
+			return existing
+		}
+	}
+
+	for _, existing := range m.items {
+		if lo.HasKey(m.used, existing.ID) || existing.IsTransfer() {
+			continue
+		}
+
+		if item.IsImportMatch(existing, m.toleranceDays) {
+			m.used[existing.ID] = struct{}{}
+			return existing
+		}
+	}
+
+	return nil
+}
+
+// importRow - сохраняет строку импорта с учетом совпавшей транзакции и политики дубликатов
+func (uc *UsecaseImpl) importRow(
+	ctx context.Context,
+	accountID uuid.UUID,
+	row *usecase.TransactionImportRow,
+	matched *entity.Transaction,
+	duplicatePolicy usecase.TransactionImportDuplicatePolicy,
+) (usecase.TransactionImportRowStatus, error) {
+	item := row.Transaction
+	in := importTransactionInput(accountID, item)
+
+	status := usecase.TransactionImportRowStatusOK
+
+	if matched != nil {
+		row.MatchedID = &matched.ID
+
+		switch duplicatePolicy {
+		case usecase.TransactionImportDuplicatePolicyUpdate:
+			patch := usecase.PatchTransactionDataInput{
+				OccurredOn:  &item.OccurredOn,
+				Description: &item.Description,
+			}
+			// строка без категории не сбрасывает категорию, выбранную пользователем
+			if item.CategoryID != 0 {
+				patch.CategoryID = &item.CategoryID
+			}
+
+			_, err := uc.PatchTransactionByDTO(ctx, matched.ID, patch, true)
+			if err != nil {
+				return "", err
+			}
+
+			return usecase.TransactionImportRowStatusUpdated, nil
+		case usecase.TransactionImportDuplicatePolicyFlag:
+			// отпечаток не сохраняется, чтобы не конфликтовать с совпавшей транзакцией
+			in.ImportFingerprint = nil
+			in.DuplicateOfID = &matched.ID
+			status = usecase.TransactionImportRowStatusFlagged
+		default:
+			return usecase.TransactionImportRowStatusDuplicate, nil
+		}
+	}
+
+	if in.CategoryID == 0 {
+		return "", entity.ErrTransactionCategoryRequired
+	}
+
+	_, err := uc.CreateTransactionByDTO(ctx, in)
+	if err != nil {
+		// строка с тем же отпечатком уже импортирована параллельным запросом
+		if errors.Is(err, appErrors.ErrStoreUniqueViolation) {
+			return usecase.TransactionImportRowStatusDuplicate, nil
+		}
+
+		return "", err
+	}
+
+	return status, nil
+}
+
+func importTransactionInput(accountID uuid.UUID, item *entity.Transaction) usecase.CreateTransactionDataInput {
+	return usecase.CreateTransactionDataInput{
+		AccountID:         accountID,
+		IsIncome:          item.IsIncome,
+		Amount:            item.Amount,
+		Currency:          item.Currency,
+		OccurredOn:        item.OccurredOn,
+		CategoryID:        item.CategoryID,
+		Description:       item.Description,
+		ExternalID:        item.ExternalID,
+		ImportFingerprint: item.ImportFingerprint,
+	}
+}
+
+// importBatchSize - число строк импорта в одной вставке CreateBatch
+const importBatchSize = 1000
+
+// importBatchRow - строка импорта, ожидающая пакетной вставки
+type importBatchRow struct {
+	row         *usecase.TransactionImportRow
+	transaction *entity.Transaction
+	status      usecase.TransactionImportRowStatus
+}
+
+// prepareImportRow - транзакция строки для пакетной вставки с проверками newBatchTransaction.
+// Совпавшая строка с политикой skip возвращается без транзакции со статусом дубликата
+func (uc *UsecaseImpl) prepareImportRow(
+	ctx context.Context,
+	batch *transactionsBatch,
+	row *usecase.TransactionImportRow,
+	matched *entity.Transaction,
+	duplicatePolicy usecase.TransactionImportDuplicatePolicy,
+) (*importBatchRow, error) {
+	in := importTransactionInput(batch.accountID, row.Transaction)
+
+	status := usecase.TransactionImportRowStatusOK
+
+	if matched != nil {
+		row.MatchedID = &matched.ID
+
+		if duplicatePolicy != usecase.TransactionImportDuplicatePolicyFlag {
+			return &importBatchRow{row: row, status: usecase.TransactionImportRowStatusDuplicate}, nil
+		}
+
+		// отпечаток не сохраняется, чтобы не конфликтовать с совпавшей транзакцией
+		in.ImportFingerprint = nil
+		in.DuplicateOfID = &matched.ID
+		status = usecase.TransactionImportRowStatusFlagged
+	}
+
+	if in.CategoryID == 0 {
+		return nil, entity.ErrTransactionCategoryRequired
+	}
+
+	transaction, err := uc.newBatchTransaction(ctx, batch, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &importBatchRow{row: row, transaction: transaction, status: status}, nil
+}
+
+// insertImportBatch - вставка строк через CreateBatch с проверкой бюджетов в точке сохранения.
+// В режиме partial строка с ошибкой данных отклоняется, и вставка повторяется без неё.
+// Возвращает вставленные строки, при остановке импорта - строку, вызвавшую ошибку
+func (uc *UsecaseImpl) insertImportBatch(
+	ctx context.Context,
+	batch *transactionsBatch,
+	items []*importBatchRow,
+	isPartial bool,
+) ([]*importBatchRow, *importBatchRow, error) {
+	for len(items) > 0 {
+		failed := -1
+
+		err := uc.dbMasterClient.Do(ctx, func(ctx context.Context) error {
+			transactions := lo.Map(items, func(item *importBatchRow, _ int) *entity.Transaction {
+				return item.transaction
+			})
+
+			err := uc.transactionRepo.CreateBatch(ctx, transactions)
+			if err != nil {
+				return err
+			}
+
+			var (
+				warnings  []*entity.BudgetWarning
+				crossedBy map[uuid.UUID]*entity.Transaction
+			)
+
+			warnings, crossedBy, failed, err = uc.batchBudgetLimits(ctx, batch, transactions)
+			if err != nil {
+				return err
+			}
+
+			return uc.createBatchOutboxEvents(ctx, warnings, crossedBy)
+		})
+		if err == nil {
+			return items, nil, nil
+		}
+
+		if failed < 0 || !isImportRowError(err) {
+			return nil, nil, err
+		}
+
+		if !isPartial {
+			return nil, items[failed], err
+		}
+
+		status := usecase.TransactionImportRowStatusError
+		if errors.Is(err, entity.ErrBudgetLimitExceeded) {
+			status = usecase.TransactionImportRowStatusBudgetExceeded
+		}
+
+		rejectImportRow(items[failed].row, status, err)
+		items = append(items[:failed:failed], items[failed+1:]...)
+	}
+
+	return items, nil, nil
+}
+
+// isImportRowError - ошибка вызвана данными строки, а не сбоем сервиса
+func isImportRowError(err error) bool {
+	return errors.Is(err, appErrors.ErrBadRequest) || errors.Is(err, appErrors.ErrNotFound)
+}
+
+func rejectImportRow(row *usecase.TransactionImportRow, status usecase.TransactionImportRowStatus, err error) {
+	row.Status = status
+	row.Err = err
+	row.ErrorCodes = nil
+	row.Hints = nil
+
+	if appErr, ok := appErrors.ExtractError(err); ok && appErr.Meta().TextCode != "" {
+		row.ErrorCodes = []string{appErr.Meta().TextCode}
+	}
+
+	if hints, ok := appErrors.NearestHints(err); ok {
+		row.Hints = hints
+	}
+}
+
+func withImportLineHint(err error, line int) error {
+	return withExtraHint(err, fmt.Sprintf("line %d", line))
+}
+
+// withBatchItemHint - указывает на элемент пакета, вызвавший ошибку, индекс с нуля
+func withBatchItemHint(err error, index int) error {
+	return withExtraHint(err, fmt.Sprintf("items[%d]", index))
+}
+
+func withExtraHint(err error, hint string) error {
+	appErr, ok := appErrors.ExtractError(err)
+	if ok {
+		hints, _ := appErrors.NearestHints(err)
+		hints = append(append([]string{}, hints...), hint)
+
+		return appErr.WithHints(hints...)
+	}
+
+	return err
+}
+
+// transactionsBatch - справочники аккаунта для пакетного создания транзакций, каждый читается из БД один раз
+type transactionsBatch struct {
+	accountID    uuid.UUID
+	baseCurrency string
+	tree         *entity.CategoryTree
+	rules        []*entity.CategoryRule
+	rulesLoaded  bool
+	wallets      map[uuid.UUID]*entity.Wallet
+	rates        map[batchRateKey]*entity.ExchangeRate
+}
+
+type batchRateKey struct {
+	currency string
+	date     civil.Date
+}
+
+type batchBudgetKey struct {
+	period     civil.Date
+	categoryID uint64
+}
+
+func (uc *UsecaseImpl) newTransactionsBatch(
+	ctx context.Context,
+	accountID uuid.UUID,
+	baseCurrency string,
+) (*transactionsBatch, error) {
+	tree, err := uc.loadCategoryTree(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transactionsBatch{
+		accountID:    accountID,
+		baseCurrency: baseCurrency,
+		tree:         tree,
+		wallets:      make(map[uuid.UUID]*entity.Wallet),
+		rates:        make(map[batchRateKey]*entity.ExchangeRate),
+	}, nil
+}
+
+// newBatchTransaction - транзакция элемента пакета с проверками CreateTransactionByDTO,
+// категории, правила и кошельки берутся из справочников пакета
+func (uc *UsecaseImpl) newBatchTransaction(
+	ctx context.Context,
+	batch *transactionsBatch,
+	in usecase.CreateTransactionDataInput,
+) (*entity.Transaction, error) {
+	currency := in.Currency
+	if currency == "" {
+		currency = batch.baseCurrency
+
+		if in.WalletID != nil {
+			wallet, err := uc.batchWallet(ctx, batch, *in.WalletID)
+			if err != nil {
+				return nil, err
+			}
+
+			currency = wallet.Currency
+		}
+	}
+
+	transaction, err := entity.NewTransaction(
+		batch.accountID,
+		in.IsIncome,
+		in.Amount,
+		currency,
+		in.OccurredOn,
+		in.CategoryID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = transaction.SetDescription(in.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	err = transaction.SetTags(in.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction.WalletID = in.WalletID
+	transaction.RecurringRuleID = in.RecurringRuleID
+	transaction.ExternalID = in.ExternalID
+	transaction.ImportFingerprint = in.ImportFingerprint
+	transaction.DuplicateOfID = in.DuplicateOfID
+
+	if transaction.CategoryID == 0 {
+		if !batch.rulesLoaded {
+			batch.rules, err = uc.categoryRules(ctx, batch.accountID)
+			if err != nil {
+				return nil, err
+			}
+
+			batch.rulesLoaded = true
+		}
+
+		rule := entity.MatchCategoryRule(batch.rules, transaction)
+		if rule == nil {
+			return nil, entity.ErrTransactionCategoryRequired
+		}
+
+		transaction.CategoryID = rule.CategoryID
+	}
+
+	category, ok := batch.tree.Get(transaction.CategoryID)
+	if !ok {
+		return nil, appErrors.ErrBadRequest.WithHints("category not found")
+	}
+
+	if category.IsArchived {
+		return nil, appErrors.ErrBadRequest.WithHints("category is archived")
+	}
+
+	if transaction.WalletID != nil {
+		wallet, err := uc.batchWallet(ctx, batch, *transaction.WalletID)
+		if err != nil {
+			return nil, err
+		}
+
+		if wallet.Currency != transaction.Currency {
+			return nil, appErrors.ErrBadRequest.WithHints("transaction currency must match wallet currency")
+		}
+	}
+
+	return transaction, nil
+}
+
+func (uc *UsecaseImpl) batchWallet(
+	ctx context.Context,
+	batch *transactionsBatch,
+	walletID uuid.UUID,
+) (*entity.Wallet, error) {
+	if wallet, ok := batch.wallets[walletID]; ok {
+		return wallet, nil
+	}
+
+	wallet, err := uc.checkWallet(ctx, walletID, batch.accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	batch.wallets[walletID] = wallet
+
+	return wallet, nil
+}
+
+// batchConvert - пересчет в базовую валюту, курс читается один раз на валюту и дату
+func (uc *UsecaseImpl) batchConvert(
+	ctx context.Context,
+	batch *transactionsBatch,
+	amount decimal.Decimal,
+	currency string,
+	date civil.Date,
+) (decimal.Decimal, error) {
+	if currency == batch.baseCurrency {
+		return amount, nil
+	}
+
+	key := batchRateKey{currency: currency, date: date}
+
+	rate, ok := batch.rates[key]
+	if !ok {
+		var err error
+
+		rate, err = uc.findExchangeRate(ctx, batch.accountID, currency, batch.baseCurrency, date)
+		if err != nil {
+			return decimal.Zero, err
+		}
+
+		batch.rates[key] = rate
+	}
+
+	return convertByRate(rate, amount, currency, batch.baseCurrency)
+}
+
+// checkBatchBudgetLimits - проверка бюджетов по итогу уже вставленного пакета. Бюджет, перенос остатка
+// и баланс читаются один раз на месяц и категорию, баланс до пакета и вклад каждой транзакции
+// восстанавливаются в памяти. Возвращает предупреждения и транзакции, пересекшие порог, по ID бюджета
+func (uc *UsecaseImpl) checkBatchBudgetLimits(
+	ctx context.Context,
+	batch *transactionsBatch,
+	transactions []*entity.Transaction,
+) ([]*entity.BudgetWarning, map[uuid.UUID]*entity.Transaction, error) {
+	warnings, crossedBy, index, err := uc.batchBudgetLimits(ctx, batch, transactions)
+	if err != nil {
+		if index >= 0 {
+			return nil, nil, withBatchItemHint(err, index)
+		}
+
+		return nil, nil, err
+	}
+
+	return warnings, crossedBy, nil
+}
+
+// batchBudgetLimits - проверка checkBatchBudgetLimits, при ошибке из-за транзакции пакета
+// возвращает её индекс, иначе -1
+func (uc *UsecaseImpl) batchBudgetLimits(
+	ctx context.Context,
+	batch *transactionsBatch,
+	transactions []*entity.Transaction,
+) ([]*entity.BudgetWarning, map[uuid.UUID]*entity.Transaction, int, error) {
+	keys := make([]batchBudgetKey, 0)
+	indexesByKey := make(map[batchBudgetKey][]int)
+
+	for i, transaction := range transactions {
+		period := civil.Date{
+			Year:  transaction.OccurredOn.Year,
+			Month: transaction.OccurredOn.Month,
+			Day:   1,
+		}
+
+		categoryIDs := append([]uint64{transaction.CategoryID}, batch.tree.AncestorIDs(transaction.CategoryID)...)
+		for _, categoryID := range categoryIDs {
+			key := batchBudgetKey{period: period, categoryID: categoryID}
+			if _, ok := indexesByKey[key]; !ok {
+				keys = append(keys, key)
+			}
+
+			indexesByKey[key] = append(indexesByKey[key], i)
+		}
+	}
+
+	failed := -1
+	amounts := make(map[int]decimal.Decimal, len(transactions))
+	amountOf := func(i int) (decimal.Decimal, error) {
+		if amount, ok := amounts[i]; ok {
+			return amount, nil
+		}
+
+		amount, err := uc.batchConvert(ctx, batch, transactions[i].Amount, transactions[i].Currency, transactions[i].OccurredOn)
+		if err != nil {
+			failed = i
+			return decimal.Zero, err
+		}
+
+		amounts[i] = amount
+
+		return amount, nil
+	}
+
+	// как и при создании по одной, расход без курса к базовой валюте отклоняется даже без бюджета
+	for i, transaction := range transactions {
+		if !transaction.IsIncome {
+			if _, err := amountOf(i); err != nil {
+				return nil, nil, failed, err
+			}
+		}
+	}
+
+	warnings := make([]*entity.BudgetWarning, 0)
+	crossedBy := make(map[uuid.UUID]*entity.Transaction)
+
+	for _, key := range keys {
+		budget, err := uc.findPeriodBudget(ctx, batch.accountID, key.period, key.categoryID)
+		if err != nil {
+			return nil, nil, -1, err
+		}
+
+		if budget == nil || budget.Enforcement == entity.BudgetEnforcementOff {
+			continue
+		}
+
+		budgetAmount, err := uc.budgetLimitAmount(ctx, batch.tree, budget, batch.baseCurrency, nil)
+		if err != nil {
+			return nil, nil, -1, err
+		}
+
+		balance, err := uc.budgetPeriodBalance(ctx, batch.tree, budget, batch.baseCurrency, nil)
+		if err != nil {
+			return nil, nil, -1, err
+		}
+
+		balanceBefore := balance
+		for _, i := range indexesByKey[key] {
+			amount, err := amountOf(i)
+			if err != nil {
+				return nil, nil, failed, err
+			}
+
+			balanceBefore, err = balanceBefore.Sub(amount)
+			if err != nil {
+				return nil, nil, -1, err
+			}
+		}
+
+		// проход в порядке элементов: последний расход, после которого бюджет превышен,
+		// и последняя транзакция, пересекшая порог
+		exceededBy, crossedByIndex := -1, -1
+		running := balanceBefore
+
+		for _, i := range indexesByKey[key] {
+			next, err := running.Add(amounts[i])
+			if err != nil {
+				return nil, nil, -1, err
+			}
+
+			if !transactions[i].IsIncome && isBudgetExceeded(next, budgetAmount) {
+				exceededBy = i
+			}
+
+			step, err := newBudgetWarning(budget, budgetAmount, running, next)
+			if err != nil {
+				return nil, nil, -1, err
+			}
+
+			if step != nil && step.Crossed {
+				crossedByIndex = i
+			}
+
+			running = next
+		}
+
+		if isBudgetExceeded(balance, budgetAmount) && budget.Enforcement != entity.BudgetEnforcementSoft && exceededBy >= 0 {
+			return nil, nil, exceededBy, budgetLimitExceededErr(key.categoryID, transactions[exceededBy].CategoryID)
+		}
+
+		warning, err := newBudgetWarning(budget, budgetAmount, balanceBefore, balance)
+		if err != nil {
+			return nil, nil, -1, err
+		}
+
+		if warning == nil {
+			continue
+		}
+
+		warnings = append(warnings, warning)
+
+		if warning.Crossed && crossedByIndex >= 0 {
+			crossedBy[budget.ID] = transactions[crossedByIndex]
+		}
+	}
+
+	return warnings, crossedBy, -1, nil
+}
+
+// exportBatchSize - размер страницы при чтении транзакций для выгрузки в файл
+const exportBatchSize = 500
+
+// forEachTransactionPage - пролистывает транзакции по фильтру курсором страницами по batchSize.
+// Первая страница передается в fn даже пустой
+func (uc *UsecaseImpl) forEachTransactionPage(
+	ctx context.Context,
+	listOptions *usecase.TransactionListOptions,
+	batchSize uint64,
+	fn func(dtos []*usecase.TransactionDTO) error,
+) error {
+	pageOptions := usecase.TransactionListOptions{}
+	if listOptions != nil {
+		pageOptions = *listOptions
+	}
+
+	pageOptions.FilterBefore = nil
+	pageOptions.Sort = transactionKeysetSort
+
+	isFirst := true
+
+	for {
+		items, err := uc.transactionRepo.FindList(ctx, &pageOptions, &uctypes.QueryGetListParams{
+			Limit: batchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(items) == 0 && !isFirst {
+			return nil
+		}
+
+		dtos, err := uc.entitiesToDTO(ctx, items)
+		if err != nil {
+			return err
+		}
+
+		err = fn(dtos)
+		if err != nil {
+			return err
+		}
+
+		isFirst = false
+
+		if uint64(len(items)) < batchSize {
+			return nil
+		}
+
+		pageOptions.FilterBefore = newTransactionListCursor(items[len(items)-1])
+	}
+}
+
+// transactionKeysetSort - порядок должен совпадать с курсором: (occurred_on, created_at, id) по убыванию
+var transactionKeysetSort = []uctypes.SortOption[usecase.TransactionListOptionsSortField]{
+	{
+		Field:  usecase.TransactionListOptionsSortFieldOccurredOn,
+		IsDesc: true,
+	},
+	{
+		Field:  usecase.TransactionListOptionsSortFieldCreatedAt,
+		IsDesc: true,
+	},
+	{
+		Field:  usecase.TransactionListOptionsSortFieldID,
+		IsDesc: true,
+	},
+}
+
+func newTransactionListCursor(item *entity.Transaction) *usecase.TransactionListCursor {
+	return &usecase.TransactionListCursor{
+		OccurredOn: item.OccurredOn,
+		CreatedAt:  item.CreatedAt,
+		ID:         item.ID,
+	}
+}
+
+func (uc *UsecaseImpl) findExportRepo(format usecase.ExportFormat) (usecase.ExportRepository, error) {
+	if format == "" {
+		format = usecase.ExportFormatCSV
+	}
+
+	if !format.IsValid() {
+		return nil, appErrors.ErrBadRequest.WithHints("invalid export format")
+	}
+
+	exportRepo, ok := uc.exportRepos[format]
+	if !ok {
+		return nil, appErrors.ErrBadRequest.WithHints(fmt.Sprintf("export format %q is not supported", format))
+	}
+
+	return exportRepo, nil
+}
+
+// reportExportRows - строки отчетов по периодам с названиями категорий
+func reportExportRows(reports []*entity.ReportItem, tree *entity.CategoryTree) []*usecase.ReportExportRow {
+	var out []*usecase.ReportExportRow
+
+	for _, report := range reports {
+		for _, item := range report.Items {
+			row := &usecase.ReportExportRow{
+				PeriodStart:      report.DateFrom,
+				PeriodEnd:        report.DateTo,
+				Currency:         report.Currency,
+				CategoryID:       item.CategoryID,
+				ParentCategoryID: item.ParentCategoryID,
+				Sum:              item.Sum,
+				TotalSum:         item.TotalSum,
+				Budget:           item.BudgetAmount,
+				CarriedOver:      item.CarriedOver,
+				EffectiveBudget:  item.EffectiveBudget,
+				TotalBudget:      item.TotalBudgetAmount,
+			}
+
+			if category, ok := tree.Get(item.CategoryID); ok {
+				row.CategoryTitle = category.Title
+			}
+
+			if spentBudget, err := item.SpentBudget(); err == nil {
+				row.SpentBudget = spentBudget
+			}
+
+			if totalSpentBudget, err := item.TotalSpentBudget(); err == nil {
+				row.TotalSpentBudget = totalSpentBudget
+			}
+
+			out = append(out, row)
+		}
+	}
+
+	return out
+}
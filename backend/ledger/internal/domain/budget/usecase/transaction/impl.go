@@ -20,6 +20,16 @@ type UsecaseImpl struct {
 	transactionCSVRepo   usecase.TransactionCSVRepository
 	categoryRepo         usecase.CategoryRepository
 	budgetRepo           usecase.BudgetRepository
+	walletRepo           usecase.WalletRepository
+	accountSettingsRepo  usecase.AccountSettingsRepository
+	exchangeRateRepo     usecase.ExchangeRateRepository
+	outboxEventRepo      usecase.OutboxEventRepository
+	cacheGenerationRepo  usecase.CacheGenerationRepository
+	importProfileRepo    usecase.ImportProfileRepository
+	statementRepos       usecase.TransactionStatementRepositories
+	exportRepos          usecase.ExportRepositories
+	categoryRuleRepo     usecase.CategoryRuleRepository
+	categoryUC           usecase.CategoryUsecase
 }
 
 func NewUsecaseImpl(
@@ -31,6 +41,16 @@ func NewUsecaseImpl(
 	transactionCSVRepo usecase.TransactionCSVRepository,
 	categoryRepo usecase.CategoryRepository,
 	budgetRepo usecase.BudgetRepository,
+	walletRepo usecase.WalletRepository,
+	accountSettingsRepo usecase.AccountSettingsRepository,
+	exchangeRateRepo usecase.ExchangeRateRepository,
+	outboxEventRepo usecase.OutboxEventRepository,
+	cacheGenerationRepo usecase.CacheGenerationRepository,
+	importProfileRepo usecase.ImportProfileRepository,
+	statementRepos usecase.TransactionStatementRepositories,
+	exportRepos usecase.ExportRepositories,
+	categoryRuleRepo usecase.CategoryRuleRepository,
+	categoryUC usecase.CategoryUsecase,
 ) *UsecaseImpl {
 	uc := &UsecaseImpl{
 		pkg:                  "Budget.Usecase.Transaction",
@@ -42,6 +62,16 @@ func NewUsecaseImpl(
 		transactionCSVRepo:   transactionCSVRepo,
 		categoryRepo:         categoryRepo,
 		budgetRepo:           budgetRepo,
+		walletRepo:           walletRepo,
+		accountSettingsRepo:  accountSettingsRepo,
+		exchangeRateRepo:     exchangeRateRepo,
+		outboxEventRepo:      outboxEventRepo,
+		cacheGenerationRepo:  cacheGenerationRepo,
+		importProfileRepo:    importProfileRepo,
+		statementRepos:       statementRepos,
+		exportRepos:          exportRepos,
+		categoryRuleRepo:     categoryRuleRepo,
+		categoryUC:           categoryUC,
 	}
 	return uc
 }
@@ -3,12 +3,14 @@ package transaction
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"slices"
 	"time"
 
 	"cloud.google.com/go/civil"
 	"github.com/google/uuid"
+	"github.com/govalues/decimal"
 	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
@@ -51,6 +53,33 @@ func (uc *UsecaseImpl) FindOneByID(
 	return dto[0], nil
 }
 
+func (uc *UsecaseImpl) FindTransferByID(
+	ctx context.Context,
+	id uuid.UUID,
+	queryParams *uctypes.QueryGetOneParams,
+) (*usecase.TransferDTO, error) {
+	const op = "FindTransferByID"
+
+	transfer, err := uc.loadTransfer(ctx, id, queryParams)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != transfer.AccountID() {
+			return nil, appErrors.ErrForbidden
+		}
+	}
+
+	dto, err := uc.transferToDTO(ctx, transfer)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return dto, nil
+}
+
 func (uc *UsecaseImpl) FindList(
 	ctx context.Context,
 	listOptions *usecase.TransactionListOptions,
@@ -78,6 +107,9 @@ func (uc *UsecaseImpl) FindPagedList(
 ) ([]*usecase.TransactionDTO, uint64, error) {
 	const op = "FindPagedList"
 
+	// страница и total читаются с реплики, если она есть и не отстает больше допустимого
+	ctx = pgclient.WithReplica(ctx)
+
 	items, total, err := uc.transactionRepo.FindPagedList(ctx, listOptions, queryParams)
 	if err != nil {
 		return nil, 0, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
@@ -91,6 +123,60 @@ func (uc *UsecaseImpl) FindPagedList(
 	return out, total, nil
 }
 
+func (uc *UsecaseImpl) FindCursorList(
+	ctx context.Context,
+	listOptions *usecase.TransactionListOptions,
+	limit uint64,
+	withTotal bool,
+) (*usecase.TransactionCursorPage, error) {
+	const op = "FindCursorList"
+
+	if limit < 1 {
+		return nil, appErrors.Chainf(appErrors.ErrBadRequest.WithHints("limit must be > 0"), "%s.%s", uc.pkg, op)
+	}
+
+	pageOptions := usecase.TransactionListOptions{}
+	if listOptions != nil {
+		pageOptions = *listOptions
+	}
+
+	pageOptions.Sort = transactionKeysetSort
+
+	// лишняя запись показывает, что за страницей есть продолжение
+	items, err := uc.transactionRepo.FindList(ctx, &pageOptions, &uctypes.QueryGetListParams{
+		Limit: limit + 1,
+	})
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	page := &usecase.TransactionCursorPage{}
+
+	if uint64(len(items)) > limit {
+		items = items[:limit]
+		page.NextCursor = newTransactionListCursor(items[len(items)-1])
+	}
+
+	if withTotal {
+		totalOptions := pageOptions
+		totalOptions.FilterBefore = nil
+
+		total, err := uc.transactionRepo.Count(ctx, &totalOptions)
+		if err != nil {
+			return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+		}
+
+		page.Total = &total
+	}
+
+	page.Items, err = uc.entitiesToDTO(ctx, items)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return page, nil
+}
+
 func (uc *UsecaseImpl) FindListInMap(
 	ctx context.Context,
 	listOptions *usecase.TransactionListOptions,
@@ -128,7 +214,37 @@ func (uc *UsecaseImpl) CountReportItems(
 ) ([]*entity.ReportItem, bool, error) {
 	const op = "CountReportItems"
 
-	key := buildKeyForCountReportItems(queryFilter)
+	if queryFilter.BaseCurrency == "" {
+		baseCurrency, err := uc.baseCurrency(ctx, queryFilter.AccountID)
+		if err != nil {
+			return nil, false, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+		}
+
+		queryFilter.BaseCurrency = baseCurrency
+	} else {
+		baseCurrency, err := entity.NormalizeCurrency(queryFilter.BaseCurrency)
+		if err != nil {
+			return nil, false, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+		}
+
+		queryFilter.BaseCurrency = baseCurrency
+	}
+
+	// поколение читается до запроса в БД: запись, зафиксированная позже, сдвинет поколение
+	// и ответ, сохраненный под старым ключом, больше не будет прочитан
+	generations, err := uc.cacheGenerationRepo.GetGenerations(ctx, usecase.CacheScopeReports(queryFilter.AccountID))
+	if err != nil {
+		uc.logger.ErrorContext(loghandler.WithSource(ctx), "redis get cache generation err", slog.Any("error", err))
+
+		items, _, err := uc.countReportItems(ctx, queryFilter)
+		if err != nil {
+			return nil, false, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+		}
+
+		return items, false, nil
+	}
+
+	key := buildKeyForCountReportItems(queryFilter, generations[0])
 
 	result, err, _ := uc.sfGroup.Do(key, func() (any, error) {
 		cacheItems, err := uc.transactionCacheRepo.GetReports(ctx, key)
@@ -145,14 +261,18 @@ func (uc *UsecaseImpl) CountReportItems(
 
 		uc.logger.InfoContext(ctx, "CountReportItems cache miss", slog.Any("key", key))
 
-		items, err := uc.countReportItems(ctx, queryFilter)
+		items, fromReplica, err := uc.countReportItems(ctx, queryFilter)
 		if err != nil {
 			return nil, err
 		}
 
-		err = uc.transactionCacheRepo.SaveReports(ctx, key, items, &reportsCacheTTL)
-		if err != nil {
-			uc.logger.ErrorContext(loghandler.WithSource(ctx), "redis save err", slog.Any("error", err))
+		// реплика может еще не содержать запись, сдвинувшую поколение, и такой отчет
+		// под новым ключом жил бы весь TTL, поэтому кешируются только отчеты из мастера
+		if !fromReplica {
+			err = uc.transactionCacheRepo.SaveReports(ctx, key, items, &reportsCacheTTL)
+			if err != nil {
+				uc.logger.ErrorContext(loghandler.WithSource(ctx), "redis save err", slog.Any("error", err))
+			}
 		}
 
 		return CountReportItemsSFResult{
@@ -172,18 +292,22 @@ func (uc *UsecaseImpl) CountReportItems(
 	return sfResult.Items, sfResult.HitCache, nil
 }
 
+// countReportItems - считает отчет, читая с реплики, если она доступна; fromReplica - отчет посчитан на реплике
 func (uc *UsecaseImpl) countReportItems(
 	ctx context.Context,
 	queryFilter usecase.CountReportItemsQueryFilter,
-) ([]*entity.ReportItem, error) {
+) ([]*entity.ReportItem, bool, error) {
 	const op = "CountReportItems"
 
+	ctx = pgclient.WithReplica(ctx)
+
 	out := make([]*entity.ReportItem, 0)
 
 	err := uc.dbMasterClient.DoWithIsoLvl(ctx, pgclient.RepeatableRead, func(ctx context.Context) error {
 		var err error
 
 		categories, err := uc.categoryRepo.FindList(ctx, &usecase.CategoryListOptions{
+			FilterAvailableForAccountID: &queryFilter.AccountID,
 			Sort: []uctypes.SortOption[usecase.CategoryListOptionsSortField]{
 				{
 					Field:  usecase.CategoryListOptionsSortFieldID,
@@ -195,17 +319,37 @@ func (uc *UsecaseImpl) countReportItems(
 			return err
 		}
 
+		tree := entity.NewCategoryTree(categories)
+
+		repoFilter := queryFilter
+
 		if queryFilter.CategoryID != nil {
+			subtreeIDs := append([]uint64{*queryFilter.CategoryID}, tree.DescendantIDs(*queryFilter.CategoryID)...)
+
 			categories = lo.Filter(categories, func(item *entity.Category, _ int) bool {
-				return item.ID == *queryFilter.CategoryID
+				return lo.Contains(subtreeIDs, item.ID)
 			})
+
+			repoFilter.CategoryID = nil
+			repoFilter.CategoryIDs = subtreeIDs
 		}
 
-		txReportItems, err := uc.transactionRepo.CountReportItems(ctx, queryFilter)
+		txReportItems, err := uc.transactionRepo.CountReportItems(ctx, repoFilter)
 		if err != nil {
 			return err
 		}
 
+		// недостающий курс не ломает отчет: суммы без курса пропускаются, а курс попадает в подсказки периода
+		missingRates := make(map[civil.Date][]string)
+		for _, txReportItem := range txReportItems {
+			for _, currency := range txReportItem.MissingCurrencies {
+				missingRates[txReportItem.Period] = append(
+					missingRates[txReportItem.Period],
+					missingRateHint(currency, queryFilter.BaseCurrency),
+				)
+			}
+		}
+
 		slices.SortFunc(txReportItems, func(a, b *entity.AccountTransactionReportItem) int {
 			return a.Period.Compare(b.Period)
 		})
@@ -247,10 +391,97 @@ func (uc *UsecaseImpl) countReportItems(
 			return err
 		}
 
+		// бюджеты пересчитываются в базовую валюту по курсу на начало периода
+		budgetAmounts := make(map[uuid.UUID]decimal.Decimal, len(budgets))
+		for _, budget := range budgets {
+			budgetAmount, err := uc.convertAmount(
+				ctx,
+				budget.AccountID,
+				budget.Amount,
+				budget.Currency,
+				queryFilter.BaseCurrency,
+				budget.Period,
+			)
+			if err != nil {
+				if hints, ok := missingRateHints(err); ok {
+					missingRates[budget.Period] = append(missingRates[budget.Period], hints...)
+					continue
+				}
+				return err
+			}
+
+			budgetAmounts[budget.ID] = budgetAmount
+		}
+
+		// переносы считаются от ранних месяцев к поздним, чтобы переиспользовать посчитанные бюджеты
+		slices.SortFunc(budgets, func(a, b *entity.Budget) int {
+			return a.Period.Compare(b.Period)
+		})
+
+		carriedOvers := make(map[uuid.UUID]decimal.Decimal)
+		effectiveBudgets := make(map[uuid.UUID]decimal.Decimal)
+		for _, budget := range budgets {
+			if _, ok := budgetAmounts[budget.ID]; !ok || !budget.CarryOver {
+				continue
+			}
+
+			carriedOver, err := uc.budgetCarriedOver(
+				ctx,
+				tree,
+				budget,
+				queryFilter.BaseCurrency,
+				queryFilter.ExcludeIDs,
+				effectiveBudgets,
+			)
+			if err != nil {
+				if hints, ok := missingRateHints(err); ok {
+					missingRates[budget.Period] = append(missingRates[budget.Period], hints...)
+					continue
+				}
+				return err
+			}
+
+			effectiveBudget, err := budgetAmounts[budget.ID].Add(carriedOver)
+			if err != nil {
+				return err
+			}
+
+			carriedOvers[budget.ID] = carriedOver
+			effectiveBudgets[budget.ID] = effectiveBudget
+		}
+
+		setBudget := func(item *entity.AccountTransactionReportItem, budgetID uuid.UUID) {
+			budgetAmount, ok := budgetAmounts[budgetID]
+			if !ok {
+				// бюджет без курса остался бы в своей валюте рядом с суммами в базовой
+				item.BudgetAmount = nil
+				item.CarriedOver = nil
+				item.EffectiveBudget = nil
+				return
+			}
+
+			item.BudgetAmount = &budgetAmount
+
+			if carriedOver, ok := carriedOvers[budgetID]; ok {
+				item.CarriedOver = &carriedOver
+				item.EffectiveBudget = lo.ToPtr(effectiveBudgets[budgetID])
+			}
+		}
+
+		for _, txReportItem := range txReportItems {
+			if txReportItem.BudgetID == nil {
+				continue
+			}
+
+			setBudget(txReportItem, *txReportItem.BudgetID)
+		}
+
 		for p := periodStart; p.Compare(periodEnd) <= 0; p = p.AddMonths(1) {
 			item := &entity.ReportItem{
-				AccountID: queryFilter.AccountID,
-				Items:     make([]*entity.AccountTransactionReportItem, 0),
+				AccountID:    queryFilter.AccountID,
+				Currency:     queryFilter.BaseCurrency,
+				Items:        make([]*entity.AccountTransactionReportItem, 0),
+				MissingRates: lo.Uniq(missingRates[p]),
 			}
 
 			if p.Compare(periodStart) == 0 {
@@ -283,23 +514,25 @@ func (uc *UsecaseImpl) countReportItems(
 					})
 					if ok {
 						repItem.BudgetID = &budgetForItem.ID
-						repItem.BudgetAmount = &budgetForItem.Amount
+						setBudget(repItem, budgetForItem.ID)
 					}
 
 					item.Items = append(item.Items, repItem)
 				}
 			}
 
+			fillReportRollups(tree, item.Items)
+
 			out = append(out, item)
 		}
 
 		return nil
 	})
 	if err != nil {
-		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+		return nil, false, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
 	}
 
-	return out, nil
+	return out, pgclient.ServedByReplica(ctx), nil
 }
 
 func (uc *UsecaseImpl) FindPagedListAsCSV(
@@ -321,3 +554,126 @@ func (uc *UsecaseImpl) FindPagedListAsCSV(
 
 	return data, total, nil
 }
+
+func (uc *UsecaseImpl) StreamListAsCSV(
+	ctx context.Context,
+	listOptions *usecase.TransactionListOptions,
+	batchSize uint64,
+	write func(chunk []byte) error,
+) error {
+	const op = "StreamListAsCSV"
+
+	if batchSize == 0 {
+		return appErrors.Chainf(appErrors.ErrBadRequest.WithHints("batch size must be > 0"), "%s.%s", uc.pkg, op)
+	}
+
+	withHeader := true
+
+	err := uc.forEachTransactionPage(ctx, listOptions, batchSize, func(dtos []*usecase.TransactionDTO) error {
+		chunk, err := uc.transactionCSVRepo.ItemsToCSVChunk(ctx, dtos, withHeader)
+		if err != nil {
+			return err
+		}
+
+		withHeader = false
+
+		return write(chunk)
+	})
+	if err != nil {
+		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return nil
+}
+
+func (uc *UsecaseImpl) ExportTransactions(
+	ctx context.Context,
+	format usecase.ExportFormat,
+	listOptions *usecase.TransactionListOptions,
+) (*usecase.ExportFile, uint64, error) {
+	const op = "ExportTransactions"
+
+	exportRepo, err := uc.findExportRepo(format)
+	if err != nil {
+		return nil, 0, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	var items []*usecase.TransactionDTO
+
+	err = uc.forEachTransactionPage(ctx, listOptions, exportBatchSize, func(dtos []*usecase.TransactionDTO) error {
+		if len(items)+len(dtos) > usecase.ExportTransactionsMaxItems {
+			return appErrors.ErrBadRequest.WithHints(fmt.Sprintf(
+				"export is limited to %d transactions, narrow the filter or use csv stream export",
+				usecase.ExportTransactionsMaxItems,
+			))
+		}
+
+		items = append(items, dtos...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	data, err := exportRepo.TransactionsToFile(ctx, items)
+	if err != nil {
+		return nil, 0, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return &usecase.ExportFile{
+		Data:          data,
+		ContentType:   exportRepo.ContentType(),
+		FileExtension: exportRepo.FileExtension(),
+	}, uint64(len(items)), nil
+}
+
+func (uc *UsecaseImpl) ExportReports(
+	ctx context.Context,
+	format usecase.ExportFormat,
+	queryFilter usecase.CountReportItemsQueryFilter,
+) (*usecase.ExportFile, error) {
+	const op = "ExportReports"
+
+	exportRepo, err := uc.findExportRepo(format)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	reports, _, err := uc.CountReportItems(ctx, queryFilter)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	tree, err := uc.loadCategoryTree(ctx, queryFilter.AccountID)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	data, err := exportRepo.ReportsToFile(ctx, reportExportRows(reports, tree))
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return &usecase.ExportFile{
+		Data:          data,
+		ContentType:   exportRepo.ContentType(),
+		FileExtension: exportRepo.FileExtension(),
+	}, nil
+}
+
+func (uc *UsecaseImpl) ResolveCurrency(
+	ctx context.Context,
+	accountID uuid.UUID,
+	currency string,
+	walletID *uuid.UUID,
+) (string, error) {
+	const op = "ResolveCurrency"
+
+	out, err := uc.resolveCurrency(ctx, accountID, currency, walletID)
+	if err != nil {
+		return "", appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return out, nil
+}
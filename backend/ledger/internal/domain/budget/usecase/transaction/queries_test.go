@@ -2,15 +2,21 @@ package transaction
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	"cloud.google.com/go/civil"
 	"github.com/google/uuid"
 	"github.com/govalues/decimal"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 )
 
@@ -170,6 +176,131 @@ func TestTransactionUsecase_FindPagedList_OK(t *testing.T) {
 	require.Equal(t, catID, got[0].Category.ID)
 }
 
+func TestTransactionUsecase_FindCursorList_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+	catID := uint64(7)
+	now := time.Now().Truncate(time.Microsecond)
+
+	newItem := func(day int) *entity.Transaction {
+		return &entity.Transaction{
+			ID:         uuid.New(),
+			AccountID:  accID,
+			IsIncome:   true,
+			Amount:     decimal.MustParse("10"),
+			OccurredOn: civil.Date{Year: 2025, Month: 11, Day: day},
+			CategoryID: catID,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}
+	}
+
+	items := []*entity.Transaction{newItem(30), newItem(20), newItem(10)}
+
+	tests := []struct {
+		name      string
+		limit     uint64
+		withTotal bool
+
+		wantItems      int
+		wantNextCursor *usecase.TransactionListCursor
+		wantTotal      *uint64
+		wantErr        bool
+	}{
+		{
+			name:      "OK_has_next_page",
+			limit:     2,
+			wantItems: 2,
+			wantNextCursor: &usecase.TransactionListCursor{
+				OccurredOn: items[1].OccurredOn,
+				CreatedAt:  items[1].CreatedAt,
+				ID:         items[1].ID,
+			},
+		},
+		{
+			name:      "OK_last_page_with_total",
+			limit:     5,
+			withTotal: true,
+			wantItems: 3,
+			wantTotal: lo.ToPtr(uint64(3)),
+		},
+		{
+			name:    "Negative_zero_limit",
+			limit:   0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			s.categoryRepo.FindListMock.Optional().Return([]*entity.Category{{ID: catID}}, nil)
+
+			listOptions := &usecase.TransactionListOptions{
+				FilterAccountID: &accID,
+				// сортировка по сумме заменяется порядком курсора
+				Sort: []uctypes.SortOption[usecase.TransactionListOptionsSortField]{
+					{Field: usecase.TransactionListOptionsSortFieldAmount},
+				},
+			}
+
+			s.transactionRepo.FindListMock.Optional().Set(func(
+				ctx context.Context,
+				gotOpt *usecase.TransactionListOptions,
+				gotQP *uctypes.QueryGetListParams,
+			) ([]*entity.Transaction, error) {
+				require.Equal(t, transactionKeysetSort, gotOpt.Sort)
+				require.Equal(t, tt.limit+1, gotQP.Limit)
+				return items[:min(len(items), int(gotQP.Limit))], nil
+			})
+
+			if tt.withTotal {
+				s.transactionRepo.CountMock.Return(3, nil)
+			}
+
+			page, err := s.uc.FindCursorList(testCtx(), listOptions, tt.limit, tt.withTotal)
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, appErrors.ErrBadRequest)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, page.Items, tt.wantItems)
+			require.Equal(t, tt.wantNextCursor, page.NextCursor)
+			require.Equal(t, tt.wantTotal, page.Total)
+		})
+	}
+}
+
+func TestTransactionListCursor_Token(t *testing.T) {
+	t.Parallel()
+
+	cursor := &usecase.TransactionListCursor{
+		OccurredOn: civil.Date{Year: 2025, Month: 12, Day: 31},
+		CreatedAt:  time.Date(2025, 12, 31, 10, 20, 30, 123456000, time.UTC),
+		ID:         uuid.New(),
+	}
+
+	got, err := usecase.ParseTransactionListCursor(cursor.Token())
+	require.NoError(t, err)
+	require.Equal(t, cursor.OccurredOn, got.OccurredOn)
+	require.True(t, cursor.CreatedAt.Equal(got.CreatedAt))
+	require.Equal(t, cursor.ID, got.ID)
+
+	_, err = usecase.ParseTransactionListCursor("e30")
+	require.NoError(t, err)
+
+	_, err = usecase.ParseTransactionListCursor("%%%")
+	require.ErrorIs(t, err, usecase.ErrCursorInvalid)
+}
+
 func TestTransactionUsecase_FindListInMap_OK(t *testing.T) {
 	t.Parallel()
 
@@ -282,12 +413,321 @@ func TestTransactionUsecase_FindPagedListAsCSV_OK(t *testing.T) {
 	require.Equal(t, wantCSV, data)
 }
 
+func TestTransactionUsecase_StreamListAsCSV_Table(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		total     int
+		batchSize uint64
+		// wantHeaders - признак заголовка у каждой отправленной части
+		wantHeaders []bool
+		writeErr    error
+		wantErr     bool
+	}{
+		{
+			name:        "OK_empty_history_sends_header",
+			total:       0,
+			batchSize:   2,
+			wantHeaders: []bool{true},
+		},
+		{
+			name:        "OK_last_page_partial",
+			total:       5,
+			batchSize:   2,
+			wantHeaders: []bool{true, false, false},
+		},
+		{
+			name:        "OK_last_page_full",
+			total:       4,
+			batchSize:   2,
+			wantHeaders: []bool{true, false},
+		},
+		{
+			name:        "Negative_write_error",
+			total:       5,
+			batchSize:   2,
+			writeErr:    errors.New("client gone"),
+			wantHeaders: []bool{true},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			accID := uuid.New()
+			catID := uint64(3)
+			createdAt := time.Now().Truncate(time.Microsecond)
+
+			// история отсортирована так же, как ее отдает БД: по убыванию
+			history := make([]*entity.Transaction, 0, tt.total)
+			for i := 0; i < tt.total; i++ {
+				history = append(history, &entity.Transaction{
+					ID:         uuid.New(),
+					AccountID:  accID,
+					Amount:     decimal.MustParse("1.00"),
+					OccurredOn: civil.Date{Year: 2025, Month: 12, Day: 28 - i},
+					CategoryID: catID,
+					CreatedAt:  createdAt,
+				})
+			}
+
+			s.transactionRepo.FindListMock.Set(func(
+				ctx context.Context,
+				opt *usecase.TransactionListOptions,
+				qp *uctypes.QueryGetListParams,
+			) ([]*entity.Transaction, error) {
+				require.Equal(t, accID, *opt.FilterAccountID)
+				require.Equal(t, tt.batchSize, qp.Limit)
+				require.Zero(t, qp.Offset)
+				require.Len(t, opt.Sort, 3)
+				require.Equal(t, usecase.TransactionListOptionsSortFieldID, opt.Sort[2].Field)
+
+				start := 0
+				if opt.FilterBefore != nil {
+					idx := slices.IndexFunc(history, func(item *entity.Transaction) bool {
+						return item.ID == opt.FilterBefore.ID
+					})
+					require.GreaterOrEqual(t, idx, 0)
+					require.Equal(t, history[idx].OccurredOn, opt.FilterBefore.OccurredOn)
+					require.Equal(t, history[idx].CreatedAt, opt.FilterBefore.CreatedAt)
+					start = idx + 1
+				}
+
+				end := min(start+int(qp.Limit), len(history))
+
+				return history[start:end], nil
+			})
+
+			s.categoryRepo.FindListMock.Optional().Return([]*entity.Category{{ID: catID}}, nil)
+
+			exported := make([]uuid.UUID, 0, tt.total)
+			s.transactionCSVRepo.ItemsToCSVChunkMock.Set(func(
+				ctx context.Context,
+				items []*usecase.TransactionDTO,
+				withHeader bool,
+			) ([]byte, error) {
+				for _, item := range items {
+					exported = append(exported, item.Transaction.ID)
+				}
+
+				return []byte(fmt.Sprintf("%t:%d", withHeader, len(items))), nil
+			})
+
+			gotHeaders := make([]bool, 0)
+			err := s.uc.StreamListAsCSV(
+				testCtx(),
+				&usecase.TransactionListOptions{FilterAccountID: &accID},
+				tt.batchSize,
+				func(chunk []byte) error {
+					gotHeaders = append(gotHeaders, strings.HasPrefix(string(chunk), "true:"))
+					return tt.writeErr
+				},
+			)
+
+			require.Equal(t, tt.wantHeaders, gotHeaders)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			wantExported := lo.Map(history, func(item *entity.Transaction, _ int) uuid.UUID {
+				return item.ID
+			})
+			require.Equal(t, wantExported, exported)
+		})
+	}
+}
+
+func TestTransactionUsecase_ExportTransactions_Table(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		format usecase.ExportFormat
+		total  int
+
+		wantErr bool
+	}{
+		{
+			name:   "OK_several_pages",
+			format: usecase.ExportFormatXLSX,
+			total:  exportBatchSize + 3,
+		},
+		{
+			name:   "OK_empty",
+			format: usecase.ExportFormatXLSX,
+		},
+		{
+			name:    "Negative_format_not_registered",
+			format:  usecase.ExportFormatJSONL,
+			wantErr: true,
+		},
+		{
+			name:    "Negative_invalid_format",
+			format:  usecase.ExportFormat("pdf"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			accID := uuid.New()
+			catID := uint64(3)
+			createdAt := time.Now().Truncate(time.Microsecond)
+
+			history := make([]*entity.Transaction, 0, tt.total)
+			for i := 0; i < tt.total; i++ {
+				history = append(history, &entity.Transaction{
+					ID:         uuid.New(),
+					AccountID:  accID,
+					Amount:     decimal.MustParse("-1.00"),
+					OccurredOn: civil.Date{Year: 2025, Month: 12, Day: 28}.AddDays(-i),
+					CategoryID: catID,
+					CreatedAt:  createdAt,
+				})
+			}
+
+			if !tt.wantErr {
+				s.transactionRepo.FindListMock.Set(func(
+					ctx context.Context,
+					opt *usecase.TransactionListOptions,
+					qp *uctypes.QueryGetListParams,
+				) ([]*entity.Transaction, error) {
+					require.Equal(t, accID, *opt.FilterAccountID)
+					require.Equal(t, uint64(exportBatchSize), qp.Limit)
+
+					start := 0
+					if opt.FilterBefore != nil {
+						start = slices.IndexFunc(history, func(item *entity.Transaction) bool {
+							return item.ID == opt.FilterBefore.ID
+						}) + 1
+					}
+
+					end := min(start+int(qp.Limit), len(history))
+
+					return history[start:end], nil
+				})
+
+				s.categoryRepo.FindListMock.Optional().Return([]*entity.Category{{ID: catID, Title: "Food"}}, nil)
+
+				s.exportRepo.TransactionsToFileMock.Set(func(
+					ctx context.Context,
+					items []*usecase.TransactionDTO,
+				) ([]byte, error) {
+					require.Len(t, items, tt.total)
+					for idx, item := range items {
+						require.Equal(t, history[idx].ID, item.Transaction.ID)
+						require.Equal(t, "Food", item.Category.Title)
+					}
+					return []byte("xlsx"), nil
+				})
+				s.exportRepo.ContentTypeMock.Return("application/xlsx")
+				s.exportRepo.FileExtensionMock.Return("xlsx")
+			}
+
+			file, total, err := s.uc.ExportTransactions(
+				testCtx(),
+				tt.format,
+				&usecase.TransactionListOptions{FilterAccountID: &accID},
+			)
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, appErrors.ErrBadRequest)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, uint64(tt.total), total)
+			require.Equal(t, []byte("xlsx"), file.Data)
+			require.Equal(t, "application/xlsx", file.ContentType)
+			require.Equal(t, "xlsx", file.FileExtension)
+		})
+	}
+}
+
+func TestTransactionUsecase_ExportReports_OK(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	accID := uuid.New()
+	parentID := uint64(1)
+	childID := uint64(2)
+	periodStart := civil.Date{Year: 2025, Month: 12, Day: 1}
+	periodEnd := civil.Date{Year: 2025, Month: 12, Day: 31}
+
+	s.cacheGenerationRepo.GetGenerationsMock.Return([]uint64{0}, nil)
+	s.transactionCacheRepo.GetReportsMock.Return([]*entity.ReportItem{
+		{
+			AccountID: accID,
+			Currency:  "RUB",
+			DateFrom:  periodStart,
+			DateTo:    periodEnd,
+			Items: []*entity.AccountTransactionReportItem{
+				{
+					CategoryID:   childID,
+					Sum:          lo.ToPtr(decimal.MustParse("-50")),
+					TotalSum:     lo.ToPtr(decimal.MustParse("-50")),
+					BudgetAmount: lo.ToPtr(decimal.MustParse("200")),
+
+					ParentCategoryID: &parentID,
+				},
+			},
+		},
+	}, nil)
+
+	s.categoryRepo.FindListMock.Return([]*entity.Category{
+		{ID: parentID, Title: "Home"},
+		{ID: childID, Title: "Rent", ParentID: &parentID},
+	}, nil)
+
+	s.exportRepo.ReportsToFileMock.Set(func(ctx context.Context, rows []*usecase.ReportExportRow) ([]byte, error) {
+		require.Len(t, rows, 1)
+		require.Equal(t, periodStart, rows[0].PeriodStart)
+		require.Equal(t, periodEnd, rows[0].PeriodEnd)
+		require.Equal(t, "RUB", rows[0].Currency)
+		require.Equal(t, "Rent", rows[0].CategoryTitle)
+		require.Equal(t, &parentID, rows[0].ParentCategoryID)
+		require.Equal(t, "200", rows[0].Budget.String())
+		require.NotNil(t, rows[0].SpentBudget)
+		return []byte("report"), nil
+	})
+	s.exportRepo.ContentTypeMock.Return("application/xlsx")
+	s.exportRepo.FileExtensionMock.Return("xlsx")
+
+	file, err := s.uc.ExportReports(testCtx(), usecase.ExportFormatXLSX, usecase.CountReportItemsQueryFilter{
+		AccountID:    accID,
+		BaseCurrency: "RUB",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte("report"), file.Data)
+}
+
 func TestTransactionUsecase_CountReportItems_CacheHit_OK(t *testing.T) {
 	t.Parallel()
 
 	s := newDependencies(t)
 	defer finishDependencies(s)
 
+	s.cacheGenerationRepo.GetGenerationsMock.Return([]uint64{0}, nil)
+
 	accID := uuid.New()
 
 	filter := usecase.CountReportItemsQueryFilter{
@@ -303,8 +743,10 @@ func TestTransactionUsecase_CountReportItems_CacheHit_OK(t *testing.T) {
 		},
 	}
 
+	s.accountSettingsRepo.FindOneByAccountIDMock.Return(&entity.AccountSettings{AccountID: accID, BaseCurrency: "EUR"}, nil)
+
 	s.transactionCacheRepo.GetReportsMock.Set(func(ctx context.Context, key string) ([]*entity.ReportItem, error) {
-		require.NotEmpty(t, key)
+		require.Contains(t, key, "::currency:EUR")
 		return want, nil
 	})
 
@@ -313,3 +755,427 @@ func TestTransactionUsecase_CountReportItems_CacheHit_OK(t *testing.T) {
 	require.True(t, hit)
 	require.Equal(t, want, items)
 }
+
+func TestTransactionUsecase_CountReportItems_BudgetCarryOver_OK(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	s.cacheGenerationRepo.GetGenerationsMock.Return([]uint64{0}, nil)
+
+	accID := uuid.New()
+	catID := uint64(7)
+	octPeriod := civil.Date{Year: 2025, Month: 10, Day: 1}
+	novPeriod := civil.Date{Year: 2025, Month: 11, Day: 1}
+	decPeriod := civil.Date{Year: 2025, Month: 12, Day: 1}
+	dateTo := civil.Date{Year: 2025, Month: 12, Day: 31}
+
+	octBudget := &entity.Budget{
+		ID: uuid.New(), AccountID: accID, Period: octPeriod, CategoryID: catID,
+		Amount: decimal.MustParse("1000"), Currency: "RUB",
+	}
+	novBudget := &entity.Budget{
+		ID: uuid.New(), AccountID: accID, Period: novPeriod, CategoryID: catID,
+		Amount: decimal.MustParse("1000"), Currency: "RUB", CarryOver: true,
+	}
+	decBudget := &entity.Budget{
+		ID: uuid.New(), AccountID: accID, Period: decPeriod, CategoryID: catID,
+		Amount: decimal.MustParse("1000"), Currency: "RUB", CarryOver: true,
+	}
+
+	s.transactionCacheRepo.GetReportsMock.Return(nil, appErrors.ErrNotFound)
+	s.transactionCacheRepo.SaveReportsMock.Return(nil)
+
+	s.categoryRepo.FindListMock.Return([]*entity.Category{{ID: catID}}, nil)
+
+	s.budgetRepo.FindListMock.Set(func(
+		ctx context.Context,
+		opt *usecase.BudgetListOptions,
+		_ *uctypes.QueryGetListParams,
+	) ([]*entity.Budget, error) {
+		if opt.FilterPeriod == nil {
+			require.Equal(t, novPeriod, *opt.FilterPeriodFrom)
+			return []*entity.Budget{decBudget, novBudget}, nil
+		}
+
+		if *opt.FilterPeriod == octPeriod {
+			return []*entity.Budget{octBudget}, nil
+		}
+
+		require.Equal(t, novPeriod, *opt.FilterPeriod)
+		return []*entity.Budget{novBudget}, nil
+	})
+
+	items := map[civil.Date]*entity.AccountTransactionReportItem{
+		octPeriod: {Period: octPeriod, CategoryID: catID, Sum: lo.ToPtr(decimal.MustParse("-700"))},
+		novPeriod: {Period: novPeriod, CategoryID: catID, Sum: lo.ToPtr(decimal.MustParse("-1500"))},
+		decPeriod: {Period: decPeriod, CategoryID: catID, Sum: lo.ToPtr(decimal.MustParse("-400")), BudgetID: &decBudget.ID},
+	}
+
+	s.transactionRepo.CountReportItemsMock.Set(func(
+		ctx context.Context,
+		queryFilter usecase.CountReportItemsQueryFilter,
+	) ([]*entity.AccountTransactionReportItem, error) {
+		out := make([]*entity.AccountTransactionReportItem, 0)
+		for p := *queryFilter.DateFrom; p.Compare(*queryFilter.DateTo) <= 0; p = p.AddMonths(1) {
+			item := *items[p]
+			if p == novPeriod {
+				item.BudgetID = &novBudget.ID
+			}
+			out = append(out, &item)
+		}
+
+		return out, nil
+	})
+
+	got, hit, err := s.uc.CountReportItems(testCtx(), usecase.CountReportItemsQueryFilter{
+		AccountID:    accID,
+		BaseCurrency: "RUB",
+		DateFrom:     &novPeriod,
+		DateTo:       &dateTo,
+	})
+	require.NoError(t, err)
+	require.False(t, hit)
+	require.Len(t, got, 2)
+
+	// ноябрь: 1000 + остаток октября 300 = 1300, перерасход 200
+	nov := got[0].Items[0]
+	require.Equal(t, "300", nov.CarriedOver.String())
+	require.Equal(t, "1300", nov.EffectiveBudget.String())
+
+	// декабрь: 1000 - перерасход ноября 200 = 800
+	dec := got[1].Items[0]
+	require.Equal(t, "-200", dec.CarriedOver.String())
+	require.Equal(t, "800", dec.EffectiveBudget.String())
+	require.Equal(t, "800", dec.TotalBudgetAmount.String())
+
+	spent, err := dec.SpentBudget()
+	require.NoError(t, err)
+	require.Zero(t, spent.Cmp(decimal.MustParse("50")))
+}
+
+func TestTransactionUsecase_CountReportItems_MissingRates_Partial(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	s.cacheGenerationRepo.GetGenerationsMock.Return([]uint64{0}, nil)
+	s.transactionCacheRepo.GetReportsMock.Return(nil, appErrors.ErrNotFound)
+	s.transactionCacheRepo.SaveReportsMock.Return(nil)
+
+	accID := uuid.New()
+	foodID := uint64(7)
+	travelID := uint64(8)
+	period := civil.Date{Year: 2025, Month: 11, Day: 1}
+	dateTo := civil.Date{Year: 2025, Month: 11, Day: 30}
+
+	travelBudget := &entity.Budget{
+		ID: uuid.New(), AccountID: accID, Period: period, CategoryID: travelID,
+		Amount: decimal.MustParse("500"), Currency: "EUR",
+	}
+
+	s.categoryRepo.FindListMock.Return([]*entity.Category{{ID: foodID}, {ID: travelID}}, nil)
+	s.budgetRepo.FindListMock.Return([]*entity.Budget{travelBudget}, nil)
+	s.exchangeRateRepo.FindActualMock.Return(nil, appErrors.ErrNotFound)
+
+	s.transactionRepo.CountReportItemsMock.Return([]*entity.AccountTransactionReportItem{
+		{Period: period, CategoryID: foodID, Sum: lo.ToPtr(decimal.MustParse("-300"))},
+		{
+			Period: period, CategoryID: travelID, Sum: lo.ToPtr(decimal.MustParse("-200")),
+			MissingCurrencies: []string{"USD"}, BudgetID: &travelBudget.ID,
+			// из SQL бюджет приходит в своей валюте
+			BudgetAmount: lo.ToPtr(decimal.MustParse("500")),
+		},
+	}, nil)
+
+	got, _, err := s.uc.CountReportItems(testCtx(), usecase.CountReportItemsQueryFilter{
+		AccountID:    accID,
+		BaseCurrency: "RUB",
+		DateFrom:     &period,
+		DateTo:       &dateTo,
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+
+	require.ElementsMatch(t, []string{
+		"exchange rate USD/RUB not found",
+		"exchange rate EUR/RUB not found",
+	}, got[0].MissingRates)
+
+	// суммы с курсом остаются в отчете, бюджет без курса не пересчитывается
+	require.Equal(t, "-300", got[0].Items[0].Sum.String())
+	require.Equal(t, "-200", got[0].Items[1].Sum.String())
+	require.Nil(t, got[0].Items[1].BudgetAmount)
+	require.Nil(t, got[0].Items[1].CarriedOver)
+	require.Nil(t, got[0].Items[1].EffectiveBudget)
+	require.Nil(t, got[0].Items[1].TotalBudgetAmount)
+
+	spent, err := got[0].Items[1].SpentBudget()
+	require.NoError(t, err)
+	require.Nil(t, spent)
+}
+
+func TestTransactionUsecase_CountReportItems_CacheKeyGeneration(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	accID := uuid.New()
+
+	s.cacheGenerationRepo.GetGenerationsMock.Set(func(ctx context.Context, scopes ...string) ([]uint64, error) {
+		require.Equal(t, []string{usecase.CacheScopeReports(accID)}, scopes)
+		return []uint64{3}, nil
+	})
+
+	s.transactionCacheRepo.GetReportsMock.Set(func(ctx context.Context, key string) ([]*entity.ReportItem, error) {
+		require.Contains(t, key, accID.String()+"::gen:3::")
+		return []*entity.ReportItem{}, nil
+	})
+
+	_, hit, err := s.uc.CountReportItems(testCtx(), usecase.CountReportItemsQueryFilter{
+		AccountID:    accID,
+		BaseCurrency: "RUB",
+	})
+	require.NoError(t, err)
+	require.True(t, hit)
+}
+
+func TestTransactionUsecase_CountReportItems_GenerationError_SkipsCache(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	accID := uuid.New()
+	catID := uint64(7)
+	dateFrom := civil.Date{Year: 2025, Month: 12, Day: 1}
+	dateTo := civil.Date{Year: 2025, Month: 12, Day: 31}
+
+	s.cacheGenerationRepo.GetGenerationsMock.Return(nil, appErrors.ErrInternal)
+
+	s.categoryRepo.FindListMock.Return([]*entity.Category{{ID: catID}}, nil)
+	s.budgetRepo.FindListMock.Return([]*entity.Budget{}, nil)
+	s.transactionRepo.CountReportItemsMock.Return([]*entity.AccountTransactionReportItem{
+		{Period: dateFrom, CategoryID: catID, Sum: lo.ToPtr(decimal.MustParse("100"))},
+	}, nil)
+
+	got, hit, err := s.uc.CountReportItems(testCtx(), usecase.CountReportItemsQueryFilter{
+		AccountID:    accID,
+		BaseCurrency: "RUB",
+		DateFrom:     &dateFrom,
+		DateTo:       &dateTo,
+	})
+	require.NoError(t, err)
+	require.False(t, hit)
+	require.Len(t, got, 1)
+	require.Equal(t, uint64(0), s.transactionCacheRepo.GetReportsAfterCounter())
+	require.Equal(t, uint64(0), s.transactionCacheRepo.SaveReportsAfterCounter())
+}
+
+func TestTransactionUsecase_CountReportItems_NeverStaleAfterWrite_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+	catID := uint64(7)
+	dateFrom := civil.Date{Year: 2025, Month: 12, Day: 1}
+	dateTo := civil.Date{Year: 2025, Month: 12, Day: 31}
+
+	tests := []struct {
+		name  string
+		write func(t *testing.T, s *dependencies, existing *entity.Transaction)
+		// wantSum - сумма отчета после записи
+		wantSum string
+	}{
+		{
+			name: "create",
+			write: func(t *testing.T, s *dependencies, _ *entity.Transaction) {
+				_, err := s.uc.CreateTransactionByDTO(testCtx(), usecase.CreateTransactionDataInput{
+					AccountID:  accID,
+					IsIncome:   true,
+					Amount:     decimal.MustParse("50"),
+					OccurredOn: civil.Date{Year: 2025, Month: 12, Day: 10},
+					CategoryID: catID,
+				})
+				require.NoError(t, err)
+			},
+			wantSum: "150",
+		},
+		{
+			name: "patch",
+			write: func(t *testing.T, s *dependencies, existing *entity.Transaction) {
+				_, err := s.uc.PatchTransactionByDTO(testCtx(), existing.ID, usecase.PatchTransactionDataInput{
+					Amount: lo.ToPtr(decimal.MustParse("30")),
+				}, true)
+				require.NoError(t, err)
+			},
+			wantSum: "30",
+		},
+		{
+			name: "delete",
+			write: func(t *testing.T, s *dependencies, existing *entity.Transaction) {
+				err := s.uc.DeleteTransactionByID(testCtx(), existing.ID, 0, true)
+				require.NoError(t, err)
+			},
+			wantSum: "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			attachFakeCache(s)
+
+			// stored - состояние БД: одна доходная транзакция на 100
+			existing := &entity.Transaction{
+				ID:         uuid.New(),
+				AccountID:  accID,
+				IsIncome:   true,
+				Amount:     decimal.MustParse("100"),
+				Currency:   "RUB",
+				OccurredOn: civil.Date{Year: 2025, Month: 12, Day: 5},
+				CategoryID: catID,
+			}
+			stored := map[uuid.UUID]*entity.Transaction{existing.ID: existing}
+
+			s.accountSettingsRepo.FindOneByAccountIDMock.Optional().Return(nil, appErrors.ErrNotFound)
+			s.categoryUC.CheckCategoryAccessMock.Optional().Return(nil)
+			s.categoryRepo.FindListMock.Return([]*entity.Category{{ID: catID}}, nil)
+			s.budgetRepo.FindListMock.Optional().Return([]*entity.Budget{}, nil)
+
+			s.transactionRepo.FindOneByIDMock.Optional().Set(func(ctx context.Context, id uuid.UUID, _ *uctypes.QueryGetOneParams) (*entity.Transaction, error) {
+				item := *stored[id]
+				return &item, nil
+			})
+			s.transactionRepo.CreateMock.Optional().Set(func(ctx context.Context, item *entity.Transaction) error {
+				stored[item.ID] = item
+				return nil
+			})
+			s.transactionRepo.UpdateMock.Optional().Set(func(ctx context.Context, item *entity.Transaction) error {
+				stored[item.ID] = item
+				return nil
+			})
+			s.transactionRepo.CountReportItemsMock.Set(func(
+				ctx context.Context,
+				_ usecase.CountReportItemsQueryFilter,
+			) ([]*entity.AccountTransactionReportItem, error) {
+				sum := decimal.Zero
+				for _, item := range stored {
+					if item.DeletedAt != nil {
+						continue
+					}
+					sum, _ = sum.Add(item.Amount)
+				}
+				return []*entity.AccountTransactionReportItem{
+					{Period: dateFrom, CategoryID: catID, Sum: &sum},
+				}, nil
+			})
+
+			filter := usecase.CountReportItemsQueryFilter{
+				AccountID:    accID,
+				BaseCurrency: "RUB",
+				DateFrom:     &dateFrom,
+				DateTo:       &dateTo,
+			}
+
+			reportSum := func() (decimal.Decimal, bool) {
+				got, hit, err := s.uc.CountReportItems(testCtx(), filter)
+				require.NoError(t, err)
+				require.Len(t, got, 1)
+				require.Len(t, got[0].Items, 1)
+				return *got[0].Items[0].Sum, hit
+			}
+
+			sum, hit := reportSum()
+			require.False(t, hit)
+			require.Zero(t, sum.Cmp(decimal.MustParse("100")))
+
+			_, hit = reportSum()
+			require.True(t, hit)
+
+			tt.write(t, s, existing)
+
+			sum, hit = reportSum()
+			require.False(t, hit)
+			require.Zero(t, sum.Cmp(decimal.MustParse(tt.wantSum)))
+		})
+	}
+}
+
+func TestTransactionUsecase_ResolveCurrency_Table(t *testing.T) {
+	t.Parallel()
+
+	accID := uuid.New()
+	walletID := uuid.New()
+
+	tests := []struct {
+		name     string
+		currency string
+		walletID *uuid.UUID
+		wallet   *entity.Wallet
+
+		want    string
+		wantErr error
+	}{
+		{
+			name:     "OK_explicit_currency",
+			currency: "EUR",
+			walletID: &walletID,
+			want:     "EUR",
+		},
+		{
+			name:     "OK_wallet_currency",
+			walletID: &walletID,
+			wallet:   &entity.Wallet{ID: walletID, AccountID: accID, Currency: "USD"},
+			want:     "USD",
+		},
+		{
+			name: "OK_default_base_currency",
+			want: "RUB",
+		},
+		{
+			name:     "Negative_foreign_wallet",
+			walletID: &walletID,
+			wallet:   &entity.Wallet{ID: walletID, AccountID: uuid.New(), Currency: "USD"},
+			wantErr:  appErrors.ErrBadRequest,
+		},
+		{
+			name:     "Negative_archived_wallet",
+			walletID: &walletID,
+			wallet:   &entity.Wallet{ID: walletID, AccountID: accID, Currency: "USD", IsArchived: true},
+			wantErr:  appErrors.ErrBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			if tt.wallet != nil {
+				s.walletRepo.FindOneByIDMock.Return(tt.wallet, nil)
+			}
+
+			s.accountSettingsRepo.FindOneByAccountIDMock.Optional().Return(nil, appErrors.ErrNotFound)
+
+			got, err := s.uc.ResolveCurrency(testCtx(), accID, tt.currency, tt.walletID)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -4,10 +4,15 @@ import (
 	"context"
 	"io"
 	"log/slog"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/m11ano/budget_planner/backend/ledger/internal/app/config"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
 	usecasemocks "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase/mocks"
 	"github.com/m11ano/budget_planner/backend/ledger/pkg/pgclient"
 )
@@ -26,6 +31,16 @@ type dependencies struct {
 	transactionCSVRepo   *usecasemocks.TransactionCSVRepositoryMock
 	categoryRepo         *usecasemocks.CategoryRepositoryMock
 	budgetRepo           *usecasemocks.BudgetRepositoryMock
+	walletRepo           *usecasemocks.WalletRepositoryMock
+	accountSettingsRepo  *usecasemocks.AccountSettingsRepositoryMock
+	exchangeRateRepo     *usecasemocks.ExchangeRateRepositoryMock
+	outboxEventRepo      *usecasemocks.OutboxEventRepositoryMock
+	cacheGenerationRepo  *usecasemocks.CacheGenerationRepositoryMock
+	importProfileRepo    *usecasemocks.ImportProfileRepositoryMock
+	statementRepo        *usecasemocks.TransactionStatementRepositoryMock
+	exportRepo           *usecasemocks.ExportRepositoryMock
+	categoryRuleRepo     *usecasemocks.CategoryRuleRepositoryMock
+	categoryUC           *usecasemocks.CategoryUsecaseMock
 }
 
 func newDependencies(t *testing.T) *dependencies {
@@ -35,36 +50,71 @@ func newDependencies(t *testing.T) *dependencies {
 
 	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
 
+	cfg := config.Config{}
+	cfg.Budget.DefaultCurrency = "RUB"
+
 	transactionRepo := usecasemocks.NewTransactionRepositoryMock(mc)
 	transactionCacheRepo := usecasemocks.NewTransactionCacheRepositoryMock(mc)
 	transactionCSVRepo := usecasemocks.NewTransactionCSVRepositoryMock(mc)
 	categoryRepo := usecasemocks.NewCategoryRepositoryMock(mc)
 	budgetRepo := usecasemocks.NewBudgetRepositoryMock(mc)
+	walletRepo := usecasemocks.NewWalletRepositoryMock(mc)
+	accountSettingsRepo := usecasemocks.NewAccountSettingsRepositoryMock(mc)
+	exchangeRateRepo := usecasemocks.NewExchangeRateRepositoryMock(mc)
+	outboxEventRepo := usecasemocks.NewOutboxEventRepositoryMock(mc)
+	cacheGenerationRepo := usecasemocks.NewCacheGenerationRepositoryMock(mc)
+	importProfileRepo := usecasemocks.NewImportProfileRepositoryMock(mc)
+	statementRepo := usecasemocks.NewTransactionStatementRepositoryMock(mc)
+	statementRepo.FormatMock.Return(usecase.TransactionImportFormatOFX)
+	exportRepo := usecasemocks.NewExportRepositoryMock(mc)
+	exportRepo.FormatMock.Return(usecase.ExportFormatXLSX)
+	categoryRuleRepo := usecasemocks.NewCategoryRuleRepositoryMock(mc)
+	categoryUC := usecasemocks.NewCategoryUsecaseMock(mc)
 
 	dbMasterClient := pgclient.NewMock()
 
 	uc := NewUsecaseImpl(
 		logger,
-		config.Config{},
+		cfg,
 		dbMasterClient,
 		transactionRepo,
 		transactionCacheRepo,
 		transactionCSVRepo,
 		categoryRepo,
 		budgetRepo,
+		walletRepo,
+		accountSettingsRepo,
+		exchangeRateRepo,
+		outboxEventRepo,
+		cacheGenerationRepo,
+		importProfileRepo,
+		usecase.NewTransactionStatementRepositories([]usecase.TransactionStatementRepository{statementRepo}),
+		usecase.NewExportRepositories([]usecase.ExportRepository{exportRepo}),
+		categoryRuleRepo,
+		categoryUC,
 	)
 
 	return &dependencies{
 		mc:                   mc,
 		uc:                   uc,
 		logger:               logger,
-		cfg:                  config.Config{},
+		cfg:                  cfg,
 		dbMasterClient:       dbMasterClient,
 		transactionRepo:      transactionRepo,
 		transactionCacheRepo: transactionCacheRepo,
 		transactionCSVRepo:   transactionCSVRepo,
 		categoryRepo:         categoryRepo,
 		budgetRepo:           budgetRepo,
+		walletRepo:           walletRepo,
+		accountSettingsRepo:  accountSettingsRepo,
+		exchangeRateRepo:     exchangeRateRepo,
+		outboxEventRepo:      outboxEventRepo,
+		cacheGenerationRepo:  cacheGenerationRepo,
+		importProfileRepo:    importProfileRepo,
+		statementRepo:        statementRepo,
+		exportRepo:           exportRepo,
+		categoryRuleRepo:     categoryRuleRepo,
+		categoryUC:           categoryUC,
 	}
 }
 
@@ -75,3 +125,59 @@ func finishDependencies(s *dependencies) {
 func testCtx() context.Context {
 	return context.Background()
 }
+
+// fakeCache - redis в памяти для проверки того, что после записи отчет не читается из устаревшего кеша
+type fakeCache struct {
+	mu          sync.Mutex
+	generations map[string]uint64
+	reports     map[string][]*entity.ReportItem
+}
+
+func attachFakeCache(s *dependencies) *fakeCache {
+	f := &fakeCache{
+		generations: map[string]uint64{},
+		reports:     map[string][]*entity.ReportItem{},
+	}
+
+	s.cacheGenerationRepo.GetGenerationsMock.Set(func(ctx context.Context, scopes ...string) ([]uint64, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		out := make([]uint64, 0, len(scopes))
+		for _, scope := range scopes {
+			out = append(out, f.generations[scope])
+		}
+		return out, nil
+	})
+
+	s.cacheGenerationRepo.BumpGenerationsMock.Set(func(ctx context.Context, scopes ...string) error {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		for _, scope := range scopes {
+			f.generations[scope]++
+		}
+		return nil
+	})
+
+	s.transactionCacheRepo.GetReportsMock.Set(func(ctx context.Context, key string) ([]*entity.ReportItem, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		items, ok := f.reports[key]
+		if !ok {
+			return nil, appErrors.ErrNotFound
+		}
+		return items, nil
+	})
+
+	s.transactionCacheRepo.SaveReportsMock.Set(func(ctx context.Context, key string, items []*entity.ReportItem, _ *time.Duration) error {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		f.reports[key] = items
+		return nil
+	})
+
+	return f
+}
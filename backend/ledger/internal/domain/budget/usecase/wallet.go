@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+)
+
+type WalletListOptions struct {
+	FilterIDs        *[]uuid.UUID
+	FilterAccountID  *uuid.UUID
+	FilterIsArchived *bool
+	Sort             []uctypes.SortOption[WalletListOptionsSortField]
+}
+
+type WalletListOptionsSortField string
+
+const (
+	WalletListOptionsSortFieldTitle     WalletListOptionsSortField = "title"
+	WalletListOptionsSortFieldCreatedAt WalletListOptionsSortField = "created_at"
+)
+
+type WalletDTO struct {
+	Wallet *entity.Wallet
+}
+
+type WalletBalanceDTO struct {
+	Wallet  *entity.Wallet
+	Balance *entity.WalletBalance
+}
+
+type CreateWalletDataInput struct {
+	AccountID      uuid.UUID
+	Title          string
+	Currency       string
+	OpeningBalance decimal.Decimal
+}
+
+type PatchWalletDataInput struct {
+	Version int64
+
+	Title          *string
+	OpeningBalance *decimal.Decimal
+	IsArchived     *bool
+}
+
+type CountWalletBalancesQueryFilter struct {
+	AccountID uuid.UUID
+	WalletIDs []uuid.UUID
+	// DateTo - остаток на конец дня, nil - текущий остаток
+	DateTo *civil.Date
+}
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.WalletUsecase -o mocks/wallet_usecase.go
+type WalletUsecase interface {
+	FindOneByID(
+		ctx context.Context,
+		id uuid.UUID,
+		queryParams *uctypes.QueryGetOneParams,
+	) (resItem *WalletDTO, resErr error)
+
+	FindList(
+		ctx context.Context,
+		listOptions *WalletListOptions,
+		queryParams *uctypes.QueryGetListParams,
+	) (resItems []*WalletDTO, resErr error)
+
+	CreateWalletByDTO(
+		ctx context.Context,
+		in CreateWalletDataInput,
+	) (resWalletDTO *WalletDTO, resErr error)
+
+	PatchWalletByDTO(
+		ctx context.Context,
+		id uuid.UUID,
+		in PatchWalletDataInput,
+		skipVersionCheck bool,
+	) (resErr error)
+
+	DeleteWalletByID(
+		ctx context.Context,
+		id uuid.UUID,
+	) (resErr error)
+
+	GetWalletBalances(
+		ctx context.Context,
+		queryFilter CountWalletBalancesQueryFilter,
+	) (resItems []*WalletBalanceDTO, resErr error)
+}
+
+//go:generate minimock -i github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase.WalletRepository -o mocks/wallet_repository.go
+type WalletRepository interface {
+	FindOneByID(
+		ctx context.Context,
+		id uuid.UUID,
+		queryParams *uctypes.QueryGetOneParams,
+	) (wallet *entity.Wallet, err error)
+
+	FindList(
+		ctx context.Context,
+		listOptions *WalletListOptions,
+		queryParams *uctypes.QueryGetListParams,
+	) (items []*entity.Wallet, err error)
+
+	Create(ctx context.Context, item *entity.Wallet) (err error)
+
+	Update(ctx context.Context, item *entity.Wallet) (err error)
+
+	CountBalances(
+		ctx context.Context,
+		queryFilter CountWalletBalancesQueryFilter,
+	) (items []*entity.WalletBalance, err error)
+}
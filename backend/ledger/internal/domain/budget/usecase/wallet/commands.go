@@ -0,0 +1,159 @@
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/loghandler"
+	"github.com/m11ano/budget_planner/backend/ledger/pkg/pgclient"
+	"github.com/samber/lo"
+)
+
+func (uc *UsecaseImpl) CreateWalletByDTO(
+	ctx context.Context,
+	in usecase.CreateWalletDataInput,
+) (*usecase.WalletDTO, error) {
+	const op = "CreateWalletByDTO"
+
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != in.AccountID {
+			return nil, appErrors.ErrForbidden
+		}
+	}
+
+	wallet, err := entity.NewWallet(
+		in.AccountID,
+		in.Title,
+		in.Currency,
+		in.OpeningBalance,
+	)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	err = uc.walletRepo.Create(ctx, wallet)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	walletDTO, err := uc.entitiesToDTO(ctx, []*entity.Wallet{wallet})
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	if len(walletDTO) == 0 {
+		uc.logger.ErrorContext(loghandler.WithSource(ctx), "unpredicted empty wallet dto")
+		return nil, appErrors.Chainf(appErrors.ErrInternal, "%s.%s", uc.pkg, op)
+	}
+
+	return walletDTO[0], nil
+}
+
+func (uc *UsecaseImpl) PatchWalletByDTO(
+	ctx context.Context,
+	id uuid.UUID,
+	in usecase.PatchWalletDataInput,
+	skipVersionCheck bool,
+) error {
+	const op = "PatchWalletByDTO"
+
+	err := uc.dbMasterClient.Do(ctx, func(ctx context.Context) error {
+		wallet, err := uc.walletRepo.FindOneByID(ctx, id, &uctypes.QueryGetOneParams{
+			ForUpdate: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = uc.checkWalletOwner(ctx, wallet)
+		if err != nil {
+			return err
+		}
+
+		if !skipVersionCheck && wallet.Version() != in.Version {
+			return appErrors.ErrVersionConflict.
+				WithDetail("last_version", false, wallet.Version()).
+				WithDetail("last_updated_at", false, wallet.UpdatedAt)
+		}
+
+		if in.Title != nil {
+			err = wallet.SetTitle(*in.Title)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.OpeningBalance != nil {
+			err = wallet.SetOpeningBalance(*in.OpeningBalance)
+			if err != nil {
+				return err
+			}
+		}
+
+		if in.IsArchived != nil {
+			wallet.IsArchived = *in.IsArchived
+		}
+
+		err = uc.walletRepo.Update(ctx, wallet)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return nil
+}
+
+func (uc *UsecaseImpl) DeleteWalletByID(ctx context.Context, id uuid.UUID) error {
+	const op = "DeleteWalletByID"
+
+	err := uc.dbMasterClient.DoWithIsoLvl(ctx, pgclient.Serializable, func(ctx context.Context) error {
+		wallet, err := uc.walletRepo.FindOneByID(ctx, id, &uctypes.QueryGetOneParams{
+			ForUpdate: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = uc.checkWalletOwner(ctx, wallet)
+		if err != nil {
+			return err
+		}
+
+		hasTransactions, err := uc.hasWalletTransactions(ctx, wallet.ID)
+		if err != nil {
+			return err
+		}
+
+		if hasTransactions {
+			return appErrors.Chainf(
+				appErrors.ErrBadRequest.WithHints("wallet has transactions, archive it instead"),
+				"%s.%s", uc.pkg, op)
+		}
+
+		wallet.DeletedAt = lo.ToPtr(time.Now())
+
+		err = uc.walletRepo.Update(ctx, wallet)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return nil
+}
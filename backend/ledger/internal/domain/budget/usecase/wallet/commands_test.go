@@ -0,0 +1,218 @@
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalletUsecase_CreateWalletByDTO_Table(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+
+	tests := []struct {
+		name string
+		in   usecase.CreateWalletDataInput
+
+		wantErr bool
+	}{
+		{
+			name: "OK",
+			in: usecase.CreateWalletDataInput{
+				AccountID:      accountID,
+				Title:          " Cash ",
+				Currency:       "rub",
+				OpeningBalance: decimal.MustParse("100.129"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Negative_empty_title",
+			in: usecase.CreateWalletDataInput{
+				AccountID: accountID,
+				Title:     " ",
+				Currency:  "RUB",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Negative_invalid_currency",
+			in: usecase.CreateWalletDataInput{
+				AccountID: accountID,
+				Title:     "Card",
+				Currency:  "RU1",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			if !tt.wantErr {
+				s.walletRepo.CreateMock.Set(func(ctx context.Context, w *entity.Wallet) error {
+					require.Equal(t, tt.in.AccountID, w.AccountID)
+					require.Equal(t, "Cash", w.Title)
+					require.Equal(t, "RUB", w.Currency)
+					require.Equal(t, decimal.MustParse("100.12"), w.OpeningBalance)
+
+					return nil
+				})
+			}
+
+			got, err := s.uc.CreateWalletByDTO(testCtx(), tt.in)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			require.NotNil(t, got.Wallet)
+		})
+	}
+}
+
+func TestWalletUsecase_PatchWalletByDTO_OK(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	id := uuid.New()
+
+	now := time.Now().Truncate(time.Microsecond)
+	w := &entity.Wallet{
+		ID:        id,
+		AccountID: uuid.New(),
+		Title:     "Cash",
+		Currency:  "RUB",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	s.walletRepo.FindOneByIDMock.Set(func(ctx context.Context, gotID uuid.UUID, qp *uctypes.QueryGetOneParams) (*entity.Wallet, error) {
+		require.Equal(t, id, gotID)
+		require.NotNil(t, qp)
+		require.True(t, qp.ForUpdate)
+		return w, nil
+	})
+
+	s.walletRepo.UpdateMock.Set(func(ctx context.Context, item *entity.Wallet) error {
+		require.Equal(t, "Savings", item.Title)
+		require.Equal(t, decimal.MustParse("5.55"), item.OpeningBalance)
+		require.True(t, item.IsArchived)
+		return nil
+	})
+
+	err := s.uc.PatchWalletByDTO(testCtx(), id, usecase.PatchWalletDataInput{
+		Version:        w.Version(),
+		Title:          lo.ToPtr(" Savings "),
+		OpeningBalance: lo.ToPtr(decimal.MustParse("5.555")),
+		IsArchived:     lo.ToPtr(true),
+	}, false)
+	require.NoError(t, err)
+}
+
+func TestWalletUsecase_PatchWalletByDTO_VersionConflict(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	id := uuid.New()
+
+	w := &entity.Wallet{
+		ID:        id,
+		AccountID: uuid.New(),
+		UpdatedAt: time.Now().Truncate(time.Microsecond),
+	}
+
+	s.walletRepo.FindOneByIDMock.Return(w, nil)
+
+	err := s.uc.PatchWalletByDTO(testCtx(), id, usecase.PatchWalletDataInput{
+		Version: w.Version() - 1,
+	}, false)
+	require.Error(t, err)
+}
+
+func TestWalletUsecase_DeleteWalletByID_Table(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+
+		hasTransactions bool
+
+		wantErr bool
+	}{
+		{
+			name:            "OK",
+			hasTransactions: false,
+			wantErr:         false,
+		},
+		{
+			name:            "Negative_has_transactions",
+			hasTransactions: true,
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newDependencies(t)
+			defer finishDependencies(s)
+
+			id := uuid.New()
+
+			s.walletRepo.FindOneByIDMock.Return(&entity.Wallet{ID: id, AccountID: uuid.New()}, nil)
+
+			s.transactionRepo.FindListMock.Set(func(ctx context.Context, opt *usecase.TransactionListOptions, qp *uctypes.QueryGetListParams) ([]*entity.Transaction, error) {
+				require.NotNil(t, opt.FilterWalletID)
+				require.Equal(t, id, *opt.FilterWalletID)
+				require.NotNil(t, qp)
+				require.Equal(t, uint64(1), qp.Limit)
+
+				if tt.hasTransactions {
+					return []*entity.Transaction{{ID: uuid.New()}}, nil
+				}
+				return nil, nil
+			})
+
+			if !tt.wantErr {
+				s.walletRepo.UpdateMock.Set(func(ctx context.Context, item *entity.Wallet) error {
+					require.NotNil(t, item.DeletedAt)
+					return nil
+				})
+			}
+
+			err := s.uc.DeleteWalletByID(testCtx(), id)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+func (uc *UsecaseImpl) entitiesToDTO(
+	_ context.Context,
+	items []*entity.Wallet,
+) ([]*usecase.WalletDTO, error) {
+	out := make([]*usecase.WalletDTO, 0, len(items))
+
+	for _, item := range items {
+		resItem := &usecase.WalletDTO{
+			Wallet: item,
+		}
+
+		out = append(out, resItem)
+	}
+
+	return out, nil
+}
+
+func (uc *UsecaseImpl) checkWalletOwner(ctx context.Context, wallet *entity.Wallet) error {
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != wallet.AccountID {
+			return appErrors.ErrForbidden
+		}
+	}
+
+	return nil
+}
+
+func (uc *UsecaseImpl) hasWalletTransactions(ctx context.Context, walletID uuid.UUID) (bool, error) {
+	items, err := uc.transactionRepo.FindList(ctx, &usecase.TransactionListOptions{
+		FilterWalletID: &walletID,
+	}, &uctypes.QueryGetListParams{
+		Limit: 1,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return len(items) > 0, nil
+}
@@ -0,0 +1,36 @@
+package wallet
+
+import (
+	"log/slog"
+
+	"github.com/m11ano/budget_planner/backend/ledger/internal/app/config"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/infra/db"
+)
+
+type UsecaseImpl struct {
+	pkg             string
+	logger          *slog.Logger
+	cfg             config.Config
+	dbMasterClient  db.MasterClient
+	walletRepo      usecase.WalletRepository
+	transactionRepo usecase.TransactionRepository
+}
+
+func NewUsecaseImpl(
+	logger *slog.Logger,
+	cfg config.Config,
+	dbMasterClient db.MasterClient,
+	walletRepo usecase.WalletRepository,
+	transactionRepo usecase.TransactionRepository,
+) *UsecaseImpl {
+	uc := &UsecaseImpl{
+		pkg:             "Budget.Usecase.Wallet",
+		logger:          logger,
+		cfg:             cfg,
+		dbMasterClient:  dbMasterClient,
+		walletRepo:      walletRepo,
+		transactionRepo: transactionRepo,
+	}
+	return uc
+}
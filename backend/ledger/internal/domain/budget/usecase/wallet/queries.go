@@ -0,0 +1,125 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/m11ano/budget_planner/backend/auth/pkg/auth"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/samber/lo"
+
+	appErrors "github.com/m11ano/budget_planner/backend/ledger/internal/app/errors"
+)
+
+func (uc *UsecaseImpl) FindOneByID(
+	ctx context.Context,
+	id uuid.UUID,
+	queryParams *uctypes.QueryGetOneParams,
+) (*usecase.WalletDTO, error) {
+	const op = "FindOneByID"
+
+	item, err := uc.walletRepo.FindOneByID(ctx, id, queryParams)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	err = uc.checkWalletOwner(ctx, item)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	dto, err := uc.entitiesToDTO(ctx, []*entity.Wallet{item})
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	if len(dto) == 0 {
+		return nil, appErrors.Chainf(appErrors.ErrInternal, "%s.%s", uc.pkg, op)
+	}
+
+	return dto[0], nil
+}
+
+func (uc *UsecaseImpl) FindList(
+	ctx context.Context,
+	listOptions *usecase.WalletListOptions,
+	queryParams *uctypes.QueryGetListParams,
+) ([]*usecase.WalletDTO, error) {
+	const op = "FindList"
+
+	items, err := uc.walletRepo.FindList(ctx, listOptions, queryParams)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	out, err := uc.entitiesToDTO(ctx, items)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	return out, nil
+}
+
+func (uc *UsecaseImpl) GetWalletBalances(
+	ctx context.Context,
+	queryFilter usecase.CountWalletBalancesQueryFilter,
+) ([]*usecase.WalletBalanceDTO, error) {
+	const op = "GetWalletBalances"
+
+	if auth.IsNeedToCheckRights(ctx) {
+		authData := auth.GetAuthData(ctx)
+		if authData == nil || authData.AccountID != queryFilter.AccountID {
+			return nil, appErrors.Chainf(appErrors.ErrForbidden, "%s.%s", uc.pkg, op)
+		}
+	}
+
+	listOptions := &usecase.WalletListOptions{
+		FilterAccountID: &queryFilter.AccountID,
+		Sort: []uctypes.SortOption[usecase.WalletListOptionsSortField]{
+			{
+				Field:  usecase.WalletListOptionsSortFieldCreatedAt,
+				IsDesc: false,
+			},
+		},
+	}
+
+	if len(queryFilter.WalletIDs) > 0 {
+		listOptions.FilterIDs = &queryFilter.WalletIDs
+	}
+
+	wallets, err := uc.walletRepo.FindList(ctx, listOptions, nil)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	balances, err := uc.walletRepo.CountBalances(ctx, queryFilter)
+	if err != nil {
+		return nil, appErrors.Chainf(err, "%s.%s", uc.pkg, op)
+	}
+
+	balancesMap := lo.SliceToMap(balances, func(item *entity.WalletBalance) (uuid.UUID, *entity.WalletBalance) {
+		return item.WalletID, item
+	})
+
+	out := make([]*usecase.WalletBalanceDTO, 0, len(wallets))
+
+	for _, wallet := range wallets {
+		balance, ok := balancesMap[wallet.ID]
+		if !ok {
+			balance = &entity.WalletBalance{
+				WalletID:       wallet.ID,
+				OpeningBalance: wallet.OpeningBalance,
+				Balance:        wallet.OpeningBalance,
+			}
+		}
+
+		out = append(out, &usecase.WalletBalanceDTO{
+			Wallet:  wallet,
+			Balance: balance,
+		})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,61 @@
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/common/uctypes"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/entity"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalletUsecase_GetWalletBalances_OK(t *testing.T) {
+	t.Parallel()
+
+	s := newDependencies(t)
+	defer finishDependencies(s)
+
+	accountID := uuid.New()
+	dateTo := civil.Date{Year: 2025, Month: 10, Day: 31}
+
+	cash := &entity.Wallet{ID: uuid.New(), AccountID: accountID, OpeningBalance: decimal.MustParse("10.00")}
+	card := &entity.Wallet{ID: uuid.New(), AccountID: accountID, OpeningBalance: decimal.MustParse("5.00")}
+
+	queryFilter := usecase.CountWalletBalancesQueryFilter{
+		AccountID: accountID,
+		DateTo:    &dateTo,
+	}
+
+	s.walletRepo.FindListMock.Set(func(ctx context.Context, opt *usecase.WalletListOptions, _ *uctypes.QueryGetListParams) ([]*entity.Wallet, error) {
+		require.NotNil(t, opt.FilterAccountID)
+		require.Equal(t, accountID, *opt.FilterAccountID)
+		require.Nil(t, opt.FilterIDs)
+		return []*entity.Wallet{cash, card}, nil
+	})
+
+	s.walletRepo.CountBalancesMock.Set(func(ctx context.Context, qf usecase.CountWalletBalancesQueryFilter) ([]*entity.WalletBalance, error) {
+		require.Equal(t, queryFilter, qf)
+		return []*entity.WalletBalance{
+			{
+				WalletID:        cash.ID,
+				OpeningBalance:  cash.OpeningBalance,
+				TransactionsSum: decimal.MustParse("-3.50"),
+				Balance:         decimal.MustParse("6.50"),
+			},
+		}, nil
+	})
+
+	got, err := s.uc.GetWalletBalances(testCtx(), queryFilter)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+
+	require.Same(t, cash, got[0].Wallet)
+	require.Equal(t, decimal.MustParse("6.50"), got[0].Balance.Balance)
+
+	require.Same(t, card, got[1].Wallet)
+	require.Equal(t, decimal.MustParse("5.00"), got[1].Balance.Balance)
+}
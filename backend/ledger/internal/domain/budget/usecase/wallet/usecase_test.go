@@ -0,0 +1,65 @@
+package wallet
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/app/config"
+	usecasemocks "github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase/mocks"
+	"github.com/m11ano/budget_planner/backend/ledger/pkg/pgclient"
+)
+
+type dependencies struct {
+	mc *minimock.Controller
+
+	uc *UsecaseImpl
+
+	logger *slog.Logger
+	cfg    config.Config
+
+	dbMasterClient  any
+	walletRepo      *usecasemocks.WalletRepositoryMock
+	transactionRepo *usecasemocks.TransactionRepositoryMock
+}
+
+func newDependencies(t *testing.T) *dependencies {
+	t.Helper()
+
+	mc := minimock.NewController(t)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+
+	walletRepo := usecasemocks.NewWalletRepositoryMock(mc)
+	transactionRepo := usecasemocks.NewTransactionRepositoryMock(mc)
+
+	dbMasterClient := pgclient.NewMock()
+
+	uc := NewUsecaseImpl(
+		logger,
+		config.Config{},
+		dbMasterClient,
+		walletRepo,
+		transactionRepo,
+	)
+
+	return &dependencies{
+		mc:              mc,
+		uc:              uc,
+		logger:          logger,
+		cfg:             config.Config{},
+		dbMasterClient:  dbMasterClient,
+		walletRepo:      walletRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+func finishDependencies(s *dependencies) {
+	s.mc.Finish()
+}
+
+func testCtx() context.Context {
+	return context.Background()
+}
@@ -0,0 +1,83 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/app/config"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// BudgetRolloverWorker - в начале месяца переносит бюджеты прошлого месяца для аккаунтов с автопереносом
+type BudgetRolloverWorker struct {
+	periodic
+	logger   *slog.Logger
+	cfg      config.Config
+	budgetUC usecase.BudgetUsecase
+}
+
+func NewBudgetRolloverWorker(
+	logger *slog.Logger,
+	cfg config.Config,
+	budgetUC usecase.BudgetUsecase,
+) *BudgetRolloverWorker {
+	return &BudgetRolloverWorker{
+		logger:   logger,
+		cfg:      cfg,
+		budgetUC: budgetUC,
+	}
+}
+
+func (w *BudgetRolloverWorker) Start(_ context.Context) error {
+	if !w.cfg.Budget.Rollover.Enabled {
+		return nil
+	}
+
+	interval := time.Duration(w.cfg.Budget.Rollover.IntervalSec) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+
+	w.start(interval, func(ctx context.Context) {
+		w.RunOnce(ctx, civil.DateOf(time.Now()))
+	})
+
+	w.logger.Info("budget rollover worker started", slog.Duration("interval", interval))
+
+	return nil
+}
+
+// RunOnce - переносит бюджеты в месяц даты today пачками аккаунтов, пока они не закончатся
+func (w *BudgetRolloverWorker) RunOnce(ctx context.Context, today civil.Date) {
+	batchSize := w.cfg.Budget.Rollover.BatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	for ctx.Err() == nil {
+		processed, err := w.budgetUC.RolloverBudgets(ctx, today, uint64(batchSize))
+		if err != nil {
+			w.logger.ErrorContext(ctx, "failed to rollover budgets", slog.Any("error", err))
+			return
+		}
+
+		if processed < batchSize {
+			return
+		}
+	}
+}
+
+func (w *BudgetRolloverWorker) Stop(ctx context.Context) error {
+	started, err := w.stop(ctx)
+	if err != nil {
+		return err
+	}
+
+	if started {
+		w.logger.Info("budget rollover worker stopped")
+	}
+
+	return nil
+}
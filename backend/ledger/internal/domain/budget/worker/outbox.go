@@ -0,0 +1,80 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/m11ano/budget_planner/backend/ledger/internal/app/config"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// OutboxWorker - периодически доставляет события outbox внешним получателям
+type OutboxWorker struct {
+	periodic
+	logger   *slog.Logger
+	cfg      config.Config
+	outboxUC usecase.OutboxUsecase
+}
+
+func NewOutboxWorker(
+	logger *slog.Logger,
+	cfg config.Config,
+	outboxUC usecase.OutboxUsecase,
+) *OutboxWorker {
+	return &OutboxWorker{
+		logger:   logger,
+		cfg:      cfg,
+		outboxUC: outboxUC,
+	}
+}
+
+func (w *OutboxWorker) Start(_ context.Context) error {
+	if !w.cfg.Budget.Outbox.Enabled {
+		return nil
+	}
+
+	interval := time.Duration(w.cfg.Budget.Outbox.IntervalSec) * time.Second
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+
+	w.start(interval, w.RunOnce)
+
+	w.logger.Info("outbox worker started", slog.Duration("interval", interval))
+
+	return nil
+}
+
+// RunOnce - доставляет готовые к отправке события пачками, пока они не закончатся
+func (w *OutboxWorker) RunOnce(ctx context.Context) {
+	batchSize := w.cfg.Budget.Outbox.BatchSize
+	if batchSize <= 0 {
+		batchSize = 50
+	}
+
+	for ctx.Err() == nil {
+		processed, err := w.outboxUC.DispatchOutboxEvents(ctx, uint64(batchSize))
+		if err != nil {
+			w.logger.ErrorContext(ctx, "failed to dispatch outbox events", slog.Any("error", err))
+			return
+		}
+
+		if processed < batchSize {
+			return
+		}
+	}
+}
+
+func (w *OutboxWorker) Stop(ctx context.Context) error {
+	started, err := w.stop(ctx)
+	if err != nil {
+		return err
+	}
+
+	if started {
+		w.logger.Info("outbox worker stopped")
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// periodic - запуск функции в отдельной горутине с заданным интервалом до остановки
+type periodic struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func (p *periodic) start(interval time.Duration, fn func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			fn(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// stop - возвращает false, если запуска не было
+func (p *periodic) stop(ctx context.Context) (bool, error) {
+	if p.cancel == nil {
+		return false, nil
+	}
+
+	p.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true, nil
+	case <-ctx.Done():
+		return true, ctx.Err()
+	}
+}
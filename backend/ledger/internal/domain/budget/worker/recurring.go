@@ -0,0 +1,83 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/app/config"
+	"github.com/m11ano/budget_planner/backend/ledger/internal/domain/budget/usecase"
+)
+
+// RecurringWorker - периодически создает транзакции по наступившим повторениям регулярных правил
+type RecurringWorker struct {
+	periodic
+	logger      *slog.Logger
+	cfg         config.Config
+	recurringUC usecase.RecurringRuleUsecase
+}
+
+func NewRecurringWorker(
+	logger *slog.Logger,
+	cfg config.Config,
+	recurringUC usecase.RecurringRuleUsecase,
+) *RecurringWorker {
+	return &RecurringWorker{
+		logger:      logger,
+		cfg:         cfg,
+		recurringUC: recurringUC,
+	}
+}
+
+func (w *RecurringWorker) Start(_ context.Context) error {
+	if !w.cfg.Budget.Recurring.Enabled {
+		return nil
+	}
+
+	interval := time.Duration(w.cfg.Budget.Recurring.IntervalSec) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	w.start(interval, func(ctx context.Context) {
+		w.RunOnce(ctx, civil.DateOf(time.Now()))
+	})
+
+	w.logger.Info("recurring worker started", slog.Duration("interval", interval))
+
+	return nil
+}
+
+// RunOnce - обрабатывает наступившие правила пачками, пока они не закончатся
+func (w *RecurringWorker) RunOnce(ctx context.Context, today civil.Date) {
+	batchSize := w.cfg.Budget.Recurring.BatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	for ctx.Err() == nil {
+		processed, err := w.recurringUC.MaterializeDueRules(ctx, today, uint64(batchSize))
+		if err != nil {
+			w.logger.ErrorContext(ctx, "failed to materialize recurring rules", slog.Any("error", err))
+			return
+		}
+
+		if processed < batchSize {
+			return
+		}
+	}
+}
+
+func (w *RecurringWorker) Stop(ctx context.Context) error {
+	started, err := w.stop(ctx)
+	if err != nil {
+		return err
+	}
+
+	if started {
+		w.logger.Info("recurring worker stopped")
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+-- +goose Up
+
+-- Пользовательские категории: account_id IS NULL - системная категория
+ALTER TABLE "category" ADD COLUMN account_id UUID;
+ALTER TABLE "category" ADD COLUMN is_archived BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX category_account_idx ON "category" (account_id) WHERE deleted_at IS NULL;
+
+CREATE INDEX transaction_category_idx ON "transaction" (category_id) WHERE deleted_at IS NULL;
+
+CREATE INDEX budget_category_idx ON "budget" (category_id) WHERE deleted_at IS NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS budget_category_idx;
+
+DROP INDEX IF EXISTS transaction_category_idx;
+
+DROP INDEX IF EXISTS category_account_idx;
+
+ALTER TABLE "category" DROP COLUMN IF EXISTS is_archived;
+ALTER TABLE "category" DROP COLUMN IF EXISTS account_id;
@@ -0,0 +1,16 @@
+-- +goose Up
+
+-- Иерархия категорий
+ALTER TABLE "category" ADD COLUMN parent_id BIGINT REFERENCES "category"(id) ON DELETE RESTRICT;
+
+ALTER TABLE "category" ADD CONSTRAINT category_parent_not_self_chk CHECK (parent_id IS NULL OR parent_id <> id);
+
+CREATE INDEX category_parent_idx ON "category" (parent_id) WHERE deleted_at IS NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS category_parent_idx;
+
+ALTER TABLE "category" DROP CONSTRAINT IF EXISTS category_parent_not_self_chk;
+
+ALTER TABLE "category" DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,30 @@
+-- +goose Up
+
+-- Таблица wallet
+CREATE TABLE "wallet" (
+    id                              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id                      UUID NOT NULL,
+    title                           TEXT NOT NULL,
+    currency                        CHAR(3) NOT NULL,
+    opening_balance                 NUMERIC(18,2) NOT NULL DEFAULT 0,
+    is_archived                     BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at                      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at                      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at                      TIMESTAMPTZ
+);
+
+CREATE INDEX wallet_account_idx ON "wallet" (account_id) WHERE deleted_at IS NULL;
+
+ALTER TABLE "transaction" ADD COLUMN wallet_id UUID REFERENCES "wallet"(id) ON DELETE RESTRICT;
+
+CREATE INDEX transaction_wallet_date_idx ON "transaction" (wallet_id, occurred_on) WHERE deleted_at IS NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS transaction_wallet_date_idx;
+
+ALTER TABLE "transaction" DROP COLUMN IF EXISTS wallet_id;
+
+DROP INDEX IF EXISTS wallet_account_idx;
+
+DROP TABLE IF EXISTS "wallet";
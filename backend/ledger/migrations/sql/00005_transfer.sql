@@ -0,0 +1,25 @@
+-- +goose Up
+
+-- Переводы между кошельками: две связанные записи transaction с общим transfer_id
+ALTER TABLE "transaction" ADD COLUMN transfer_id UUID;
+
+ALTER TABLE "transaction" ALTER COLUMN category_id DROP NOT NULL;
+
+ALTER TABLE "transaction" ADD CONSTRAINT transaction_category_or_transfer_chk CHECK (
+    (transfer_id IS NULL AND category_id IS NOT NULL)
+    OR (transfer_id IS NOT NULL AND category_id IS NULL AND wallet_id IS NOT NULL)
+);
+
+CREATE INDEX transaction_transfer_idx ON "transaction" (transfer_id) WHERE transfer_id IS NOT NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS transaction_transfer_idx;
+
+ALTER TABLE "transaction" DROP CONSTRAINT IF EXISTS transaction_category_or_transfer_chk;
+
+DELETE FROM "transaction" WHERE transfer_id IS NOT NULL;
+
+ALTER TABLE "transaction" ALTER COLUMN category_id SET NOT NULL;
+
+ALTER TABLE "transaction" DROP COLUMN IF EXISTS transfer_id;
@@ -0,0 +1,57 @@
+-- +goose Up
+
+-- Точность сумм зависит от валюты (JPY - 0 знаков, KWD - 3 знака)
+ALTER TABLE "transaction" ALTER COLUMN amount TYPE NUMERIC(20,4);
+
+ALTER TABLE "budget" ALTER COLUMN amount TYPE NUMERIC(20,4);
+
+ALTER TABLE "wallet" ALTER COLUMN opening_balance TYPE NUMERIC(20,4);
+
+-- Валюта транзакций и бюджетов, существующие данные считаются в валюте по умолчанию
+ALTER TABLE "transaction" ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'RUB';
+
+UPDATE "transaction" t SET currency = w.currency FROM "wallet" w WHERE t.wallet_id = w.id;
+
+ALTER TABLE "transaction" ALTER COLUMN currency DROP DEFAULT;
+
+ALTER TABLE "budget" ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'RUB';
+
+ALTER TABLE "budget" ALTER COLUMN currency DROP DEFAULT;
+
+-- Таблица account_settings
+CREATE TABLE "account_settings" (
+    account_id                      UUID PRIMARY KEY,
+    base_currency                   CHAR(3) NOT NULL,
+    created_at                      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at                      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Таблица exchange_rate: 1 currency_from = rate currency_to на дату rate_date
+CREATE TABLE "exchange_rate" (
+    account_id                      UUID NOT NULL,
+    currency_from                   CHAR(3) NOT NULL,
+    currency_to                     CHAR(3) NOT NULL,
+    rate_date                       DATE NOT NULL,
+    rate                            NUMERIC(30,10) NOT NULL,
+    created_at                      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at                      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (account_id, currency_from, currency_to, rate_date)
+);
+
+ALTER TABLE "exchange_rate" ADD CONSTRAINT exchange_rate_rate_chk CHECK (rate > 0);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS "exchange_rate";
+
+DROP TABLE IF EXISTS "account_settings";
+
+ALTER TABLE "budget" DROP COLUMN IF EXISTS currency;
+
+ALTER TABLE "transaction" DROP COLUMN IF EXISTS currency;
+
+ALTER TABLE "wallet" ALTER COLUMN opening_balance TYPE NUMERIC(18,2);
+
+ALTER TABLE "budget" ALTER COLUMN amount TYPE NUMERIC(18,2);
+
+ALTER TABLE "transaction" ALTER COLUMN amount TYPE NUMERIC(18,2);
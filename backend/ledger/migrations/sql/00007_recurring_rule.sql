@@ -0,0 +1,54 @@
+-- +goose Up
+
+-- Таблица recurring_rule: шаблон регулярной транзакции с расписанием
+CREATE TABLE "recurring_rule" (
+    id                              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id                      UUID NOT NULL,
+    is_income                       BOOLEAN NOT NULL,
+    amount                          NUMERIC(20,4) NOT NULL,
+    currency                        CHAR(3) NOT NULL,
+    category_id                     BIGINT NOT NULL REFERENCES "category"(id) ON DELETE RESTRICT,
+    wallet_id                       UUID REFERENCES "wallet"(id) ON DELETE RESTRICT,
+    "description"                   TEXT NOT NULL,
+    frequency                       TEXT NOT NULL,
+    repeat_interval                 INT NOT NULL,
+    day_of_month                    INT NOT NULL DEFAULT 0,
+    start_date                      DATE NOT NULL,
+    end_date                        DATE,
+    max_count                       INT,
+    occurrences_count               INT NOT NULL DEFAULT 0,
+    next_occurrence_on              DATE,
+    is_paused                       BOOLEAN NOT NULL DEFAULT FALSE,
+    last_error                      TEXT,
+    created_at                      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at                      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at                      TIMESTAMPTZ
+);
+
+ALTER TABLE "recurring_rule" ADD CONSTRAINT recurring_rule_frequency_chk CHECK (frequency IN ('weekly', 'monthly', 'yearly'));
+
+ALTER TABLE "recurring_rule" ADD CONSTRAINT recurring_rule_interval_chk CHECK (repeat_interval > 0);
+
+CREATE INDEX recurring_rule_account_idx ON "recurring_rule" (account_id) WHERE deleted_at IS NULL;
+
+-- Индекс для воркера: правила, у которых наступила дата следующего повторения
+CREATE INDEX recurring_rule_due_idx ON "recurring_rule" (next_occurrence_on)
+    WHERE deleted_at IS NULL AND is_paused = FALSE AND next_occurrence_on IS NOT NULL;
+
+-- Транзакции, созданные по правилу: не больше одной на дату повторения
+ALTER TABLE "transaction" ADD COLUMN recurring_rule_id UUID REFERENCES "recurring_rule"(id) ON DELETE SET NULL;
+
+CREATE UNIQUE INDEX transaction_recurring_rule_date_uidx ON "transaction" (recurring_rule_id, occurred_on)
+    WHERE recurring_rule_id IS NOT NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS transaction_recurring_rule_date_uidx;
+
+ALTER TABLE "transaction" DROP COLUMN IF EXISTS recurring_rule_id;
+
+DROP INDEX IF EXISTS recurring_rule_due_idx;
+
+DROP INDEX IF EXISTS recurring_rule_account_idx;
+
+DROP TABLE IF EXISTS "recurring_rule";
@@ -0,0 +1,17 @@
+-- +goose Up
+
+-- Автоматический перенос бюджетов на следующий месяц
+ALTER TABLE "account_settings" ADD COLUMN budget_auto_rollover BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE "account_settings" ADD COLUMN budget_rolled_over_period DATE;
+
+CREATE INDEX account_settings_budget_rollover_idx ON "account_settings" (budget_rolled_over_period)
+    WHERE budget_auto_rollover;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS account_settings_budget_rollover_idx;
+
+ALTER TABLE "account_settings" DROP COLUMN IF EXISTS budget_rolled_over_period;
+
+ALTER TABLE "account_settings" DROP COLUMN IF EXISTS budget_auto_rollover;
@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- Перенос остатка бюджета прошлого месяца
+ALTER TABLE "budget" ADD COLUMN carry_over BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+
+ALTER TABLE "budget" DROP COLUMN IF EXISTS carry_over;
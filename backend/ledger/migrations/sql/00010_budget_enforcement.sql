@@ -0,0 +1,12 @@
+-- +goose Up
+
+-- Режим контроля лимита бюджета и пороги предупреждений
+ALTER TABLE "budget" ADD COLUMN enforcement VARCHAR(8) NOT NULL DEFAULT 'hard';
+
+ALTER TABLE "budget" ADD COLUMN warning_thresholds INTEGER[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+
+ALTER TABLE "budget" DROP COLUMN IF EXISTS warning_thresholds;
+
+ALTER TABLE "budget" DROP COLUMN IF EXISTS enforcement;
@@ -0,0 +1,27 @@
+-- +goose Up
+
+-- Таблица outbox_event: события для доставки внешним получателям (webhook, email)
+CREATE TABLE "outbox_event" (
+    id                              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id                      UUID NOT NULL,
+    event_type                      TEXT NOT NULL,
+    payload                         JSONB NOT NULL,
+    attempts                        INT NOT NULL DEFAULT 0,
+    delivered_sinks                 TEXT[] NOT NULL DEFAULT '{}',
+    last_error                      TEXT,
+    next_attempt_at                 TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    processed_at                    TIMESTAMPTZ,
+    failed_at                       TIMESTAMPTZ,
+    created_at                      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at                      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Индекс для диспетчера: недоставленные события, у которых наступило время попытки
+CREATE INDEX outbox_event_pending_idx ON "outbox_event" (next_attempt_at)
+    WHERE processed_at IS NULL AND failed_at IS NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS outbox_event_pending_idx;
+
+DROP TABLE IF EXISTS "outbox_event";
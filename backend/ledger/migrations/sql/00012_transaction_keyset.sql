@@ -0,0 +1,9 @@
+-- +goose Up
+
+-- Индекс для keyset-пагинации транзакций аккаунта по (occurred_on, created_at, id)
+CREATE INDEX transaction_account_keyset_idx ON "transaction" (account_id, occurred_on DESC, created_at DESC, id DESC)
+    WHERE deleted_at IS NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS transaction_account_keyset_idx;
@@ -0,0 +1,36 @@
+-- +goose Up
+
+-- Таблица import_profile: настройки разбора CSV-выписок банков
+CREATE TABLE "import_profile" (
+    id                              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id                      UUID NOT NULL,
+    name                            TEXT NOT NULL,
+    delimiter                       TEXT NOT NULL DEFAULT ',',
+    decimal_separator               TEXT NOT NULL DEFAULT '.',
+    date_layout                     TEXT NOT NULL DEFAULT 'YYYY-MM-DD',
+    has_header                      BOOLEAN NOT NULL DEFAULT FALSE,
+    skip_rows                       INT NOT NULL DEFAULT 0,
+    sign_convention                 TEXT NOT NULL,
+    income_values                   TEXT[] NOT NULL DEFAULT '{}',
+    encoding                        TEXT NOT NULL DEFAULT 'utf-8',
+    column_date                     TEXT NOT NULL,
+    column_amount                   TEXT NOT NULL DEFAULT '',
+    column_debit                    TEXT NOT NULL DEFAULT '',
+    column_credit                   TEXT NOT NULL DEFAULT '',
+    column_is_income                TEXT NOT NULL DEFAULT '',
+    column_description              TEXT NOT NULL DEFAULT '',
+    column_category_id              TEXT NOT NULL DEFAULT '',
+    column_currency                 TEXT NOT NULL DEFAULT '',
+    default_category_id             BIGINT REFERENCES "category"(id) ON DELETE RESTRICT,
+    created_at                      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at                      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at                      TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX import_profile_account_name_uidx ON "import_profile" (account_id, name) WHERE deleted_at IS NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS import_profile_account_name_uidx;
+
+DROP TABLE IF EXISTS "import_profile";
@@ -0,0 +1,27 @@
+-- +goose Up
+
+-- external_id - идентификатор операции в выписке банка,
+-- import_fingerprint - отпечаток импортированной строки для идемпотентного импорта,
+-- duplicate_of_id - транзакция, с которой строка совпала при импорте с пометкой дубликатов
+ALTER TABLE "transaction"
+    ADD COLUMN external_id TEXT,
+    ADD COLUMN import_fingerprint TEXT,
+    ADD COLUMN duplicate_of_id UUID REFERENCES "transaction"(id) ON DELETE SET NULL;
+
+CREATE UNIQUE INDEX transaction_import_fingerprint_uidx ON "transaction" (account_id, import_fingerprint)
+    WHERE deleted_at IS NULL AND import_fingerprint IS NOT NULL;
+
+ALTER TABLE "import_profile"
+    ADD COLUMN column_external_id TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+
+ALTER TABLE "import_profile"
+    DROP COLUMN IF EXISTS column_external_id;
+
+DROP INDEX IF EXISTS transaction_import_fingerprint_uidx;
+
+ALTER TABLE "transaction"
+    DROP COLUMN IF EXISTS duplicate_of_id,
+    DROP COLUMN IF EXISTS import_fingerprint,
+    DROP COLUMN IF EXISTS external_id;
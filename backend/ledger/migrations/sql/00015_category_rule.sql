@@ -0,0 +1,29 @@
+-- +goose Up
+
+-- Таблица category_rule: правила выбора категории для транзакций без категории
+CREATE TABLE "category_rule" (
+    id                              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id                      UUID NOT NULL,
+    name                            TEXT NOT NULL,
+    priority                        INT NOT NULL DEFAULT 0,
+    category_id                     BIGINT NOT NULL REFERENCES "category"(id) ON DELETE RESTRICT,
+    description_contains            TEXT,
+    description_regex               TEXT,
+    amount_min                      NUMERIC(20,4),
+    amount_max                      NUMERIC(20,4),
+    is_income                       BOOLEAN,
+    wallet_id                       UUID REFERENCES "wallet"(id) ON DELETE RESTRICT,
+    created_at                      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at                      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at                      TIMESTAMPTZ
+);
+
+ALTER TABLE "category_rule" ADD CONSTRAINT category_rule_priority_chk CHECK (priority >= 0);
+
+CREATE INDEX category_rule_account_idx ON "category_rule" (account_id, priority) WHERE deleted_at IS NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS category_rule_account_idx;
+
+DROP TABLE IF EXISTS "category_rule";
@@ -0,0 +1,25 @@
+-- +goose Up
+
+-- tags - метки транзакции в нижнем регистре,
+-- search_vector - описание для полнотекстового поиска с русской и английской морфологией
+ALTER TABLE "transaction"
+    ADD COLUMN tags TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        to_tsvector('russian', description) || to_tsvector('english', description)
+    ) STORED;
+
+CREATE INDEX transaction_search_vector_idx ON "transaction" USING GIN (search_vector)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX transaction_tags_idx ON "transaction" USING GIN (tags)
+    WHERE deleted_at IS NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS transaction_tags_idx;
+
+DROP INDEX IF EXISTS transaction_search_vector_idx;
+
+ALTER TABLE "transaction"
+    DROP COLUMN IF EXISTS search_vector,
+    DROP COLUMN IF EXISTS tags;
@@ -0,0 +1,9 @@
+-- +goose Up
+
+-- Индекс для keyset-пагинации бюджетов аккаунта по (period, id)
+CREATE INDEX budget_account_keyset_idx ON "budget" (account_id, period DESC, id DESC)
+    WHERE deleted_at IS NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS budget_account_keyset_idx;
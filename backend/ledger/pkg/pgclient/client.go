@@ -23,8 +23,11 @@ type Client interface {
 	ServerID() string
 	Pool() Pool
 	GetConn(ctx context.Context) Conn
+	// Do, DoWithIsoLvl - inside an existing tx fn runs in a SAVEPOINT: an error rolls back
+	// only the work of fn and the outer tx keeps going
 	Do(ctx context.Context, fn func(context.Context) error) error
 	DoWithIsoLvl(ctx context.Context, isoLvl TxIsoLevel, fn func(context.Context) error) error
+	RetryMetrics() RetryMetrics
 	Close()
 }
 
@@ -33,6 +36,7 @@ type Conn interface {
 	Exec(ctx context.Context, sql string, arguments ...any) (commandTag pgconn.CommandTag, err error)
 	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
 	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	CopyFrom(ctx context.Context, table pgx.Identifier, columns []string, rowSrc pgx.CopyFromSource) (int64, error)
 }
 
 // Pool - interface for pg pool connection
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sync/atomic"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -22,6 +24,14 @@ type clientImpl struct {
 	txKey           txKey
 	txDepthKey      txDepthKey
 	logger          *slog.Logger
+	retryPolicy     RetryPolicy
+	retryCounters   retryCounters
+
+	replicas          []*replica
+	replicaNext       atomic.Uint64
+	replicaMaxLag     time.Duration
+	stopReplicaChecks context.CancelFunc
+	replicaChecksDone chan struct{}
 }
 
 // NewClientOpts - options for constructing pg client
@@ -30,6 +40,10 @@ type NewClientOpts struct {
 	DefaultIsoLevel TxIsoLevel
 	Logger          *slog.Logger
 	LogQueries      bool
+	// RetryPolicy - политика повторов транзакций по умолчанию, нулевое значение отключает повторы
+	RetryPolicy RetryPolicy
+	// Replicas - реплики для чтения, помеченного WithReplica, без DSN все запросы идут в мастер
+	Replicas ReplicaOpts
 }
 
 // NewClient - create new pg client
@@ -57,6 +71,7 @@ func NewClient(ctx context.Context, serverID string, dsn string, opts NewClientO
 		pool:            dbpool,
 		readOnly:        opts.ReadOnly,
 		defaultIsoLevel: ReadCommitted,
+		retryPolicy:     opts.RetryPolicy,
 	}
 
 	if opts.DefaultIsoLevel != "" {
@@ -67,6 +82,31 @@ func NewClient(ctx context.Context, serverID string, dsn string, opts NewClientO
 		client.logger = opts.Logger
 	}
 
+	if len(opts.Replicas.DSNs) > 0 {
+		replicas, err := newReplicas(ctx, opts)
+		if err != nil {
+			dbpool.Close()
+			return nil, err
+		}
+
+		client.replicas = replicas
+		client.replicaMaxLag = opts.Replicas.MaxLag
+		if client.replicaMaxLag <= 0 {
+			client.replicaMaxLag = defaultReplicaMaxLag
+		}
+
+		interval := opts.Replicas.CheckInterval
+		if interval <= 0 {
+			interval = defaultReplicaCheckInterval
+		}
+
+		checksCtx, cancel := context.WithCancel(context.Background())
+		client.stopReplicaChecks = cancel
+		client.replicaChecksDone = make(chan struct{})
+
+		go client.runReplicaChecks(checksCtx, interval)
+	}
+
 	return client, nil
 }
 
@@ -77,18 +117,27 @@ func (c *clientImpl) ServerID() string {
 	return c.serverID
 }
 
+// RetryMetrics - get retry counters
+func (c *clientImpl) RetryMetrics() RetryMetrics {
+	return c.retryCounters.snapshot()
+}
+
 // Pool - get pool connection
 func (c *clientImpl) Pool() Pool {
 	return c.pool
 }
 
-// GetConn - get pg connection: pool or tx
+// GetConn - get pg connection: tx, healthy replica for ctx marked with WithReplica, or master pool
 func (c *clientImpl) GetConn(ctx context.Context) Conn {
 	tx, ok := ctx.Value(c.txKey).(pgx.Tx)
 	if ok && tx != nil {
 		return tx
 	}
 
+	if r := c.pickReplica(ctx); r != nil {
+		return r.pool
+	}
+
 	return c.pool
 }
 
@@ -97,43 +146,69 @@ func (c *clientImpl) Do(ctx context.Context, fn func(context.Context) error) err
 	return c.DoWithIsoLvl(ctx, c.defaultIsoLevel, fn)
 }
 
-// DoWithIsoLvl - execute tx with iso level
+// DoWithIsoLvl - execute tx with iso level, top-level tx is retried according to retry policy.
+// Top-level non-serializable tx with ctx marked by WithReplica runs read-only on a healthy replica.
+// Inside an existing tx fn runs in a savepoint with the outer iso level: on error only the work
+// of fn is rolled back and the outer tx stays usable
 func (c *clientImpl) DoWithIsoLvl(ctx context.Context, isoLvl TxIsoLevel, fn func(context.Context) error) error {
 	tx, ok := ctx.Value(c.txKey).(pgx.Tx)
 	if ok && tx != nil {
-		depth, ok := ctx.Value(c.txKey).(int)
-		if !ok {
-			depth = 0
-		}
+		return c.runSavepoint(ctx, tx, fn)
+	}
 
-		spName := fmt.Sprintf("sp_%d", depth+1)
+	policy := c.retryPolicy
+	if override, ok := ctx.Value(retryPolicyKey{}).(RetryPolicy); ok {
+		policy = override
+	}
 
-		if _, err := tx.Exec(ctx, "SAVEPOINT "+spName); err != nil {
-			return err
-		}
+	for attempt := 1; ; attempt++ {
+		err := c.runTx(ctx, isoLvl, fn)
+		if err == nil {
+			if attempt > 1 {
+				c.retryCounters.recovered.Add(1)
+			}
 
-		ctx = context.WithValue(ctx, c.txDepthKey, depth+1)
+			return nil
+		}
 
-		err := fn(ctx)
-		if err != nil {
-			_, rbErr := tx.Exec(ctx, "ROLLBACK TO SAVEPOINT "+spName)
-			if rbErr != nil {
-				if c.logger != nil {
-					c.logger.ErrorContext(ctx, "rollback to savepoint failed", slog.Any("error", rbErr.Error()))
-				}
+		if attempt >= policy.MaxAttempts || !policy.isRetryable(err) {
+			if attempt > 1 {
+				c.retryCounters.exhausted.Add(1)
 			}
 
 			return err
 		}
 
-		if _, err := tx.Exec(ctx, "RELEASE SAVEPOINT "+spName); err != nil {
+		c.retryCounters.retries.Add(1)
+
+		if c.logger != nil {
+			c.logger.WarnContext(ctx, "retrying transaction",
+				slog.Int("attempt", attempt+1),
+				slog.Int("max_attempts", policy.MaxAttempts),
+				slog.Any("error", err.Error()),
+			)
+		}
+
+		if sleepErr := sleepCtx(ctx, policy.delay(attempt)); sleepErr != nil {
 			return err
 		}
+	}
+}
+
+// runTx - one attempt of top-level tx
+func (c *clientImpl) runTx(ctx context.Context, isoLvl TxIsoLevel, fn func(context.Context) error) error {
+	pool := c.pool
+	txOpts := pgx.TxOptions{IsoLevel: pgx.TxIsoLevel(isoLvl)}
 
-		return nil
+	// serializable tx on a replica does not protect against anomalies, it always goes to master
+	if isoLvl != Serializable {
+		if r := c.pickReplica(ctx); r != nil {
+			pool = r.pool
+			txOpts.AccessMode = pgx.ReadOnly
+		}
 	}
 
-	tx, err := c.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.TxIsoLevel(isoLvl)})
+	tx, err := pool.BeginTx(ctx, txOpts)
 	if err != nil {
 		return err
 	}
@@ -173,7 +248,56 @@ func (c *clientImpl) DoWithIsoLvl(ctx context.Context, isoLvl TxIsoLevel, fn fun
 	return nil
 }
 
-// Close - close pool
+// runSavepoint - run fn in a nested savepoint of the current tx
+func (c *clientImpl) runSavepoint(ctx context.Context, tx pgx.Tx, fn func(context.Context) error) error {
+	depth, ok := ctx.Value(c.txDepthKey).(int)
+	if !ok {
+		depth = 0
+	}
+
+	spName := fmt.Sprintf("sp_%d", depth+1)
+
+	if _, err := tx.Exec(ctx, "SAVEPOINT "+spName); err != nil {
+		return err
+	}
+
+	ctx = context.WithValue(ctx, c.txDepthKey, depth+1)
+
+	err := fn(ctx)
+	if err != nil {
+		_, rbErr := tx.Exec(ctx, "ROLLBACK TO SAVEPOINT "+spName)
+		if rbErr != nil {
+			if c.logger != nil {
+				c.logger.ErrorContext(ctx, "rollback to savepoint failed", slog.Any("error", rbErr.Error()))
+			}
+
+			return err
+		}
+
+		// savepoint is released so that long loops of nested calls do not pile up subtransactions
+		if _, relErr := tx.Exec(ctx, "RELEASE SAVEPOINT "+spName); relErr != nil {
+			if c.logger != nil {
+				c.logger.ErrorContext(ctx, "release savepoint after rollback failed", slog.Any("error", relErr.Error()))
+			}
+		}
+
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, "RELEASE SAVEPOINT "+spName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Close - stop replica checks and close pools
 func (c *clientImpl) Close() {
+	if c.stopReplicaChecks != nil {
+		c.stopReplicaChecks()
+		<-c.replicaChecksDone
+	}
+
+	closeReplicas(c.replicas)
 	c.pool.Close()
 }
@@ -23,13 +23,15 @@ func (m *mockImpl) GetConn(ctx context.Context) Conn {
 }
 
 func (m *mockImpl) Do(ctx context.Context, fn func(context.Context) error) error {
-	fn(ctx)
-	return nil
+	return fn(ctx)
 }
 
 func (m *mockImpl) DoWithIsoLvl(ctx context.Context, isoLvl TxIsoLevel, fn func(context.Context) error) error {
-	fn(ctx)
-	return nil
+	return fn(ctx)
+}
+
+func (m *mockImpl) RetryMetrics() RetryMetrics {
+	return RetryMetrics{}
 }
 
 func (m *mockImpl) Close() {}
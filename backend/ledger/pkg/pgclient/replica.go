@@ -0,0 +1,196 @@
+package pgclient
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	defaultReplicaMaxLag        = time.Second
+	defaultReplicaCheckInterval = time.Second
+	replicaCheckTimeout         = time.Second
+)
+
+// replicaLagQuery - отставание реплики в секундах, 0 если все полученные изменения применены
+const replicaLagQuery = `SELECT CASE
+	WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END::float8`
+
+// ReplicaOpts - реплики для чтения, помеченного WithReplica
+type ReplicaOpts struct {
+	DSNs []string
+	// MaxLag - реплика с большим отставанием не используется, пока не догонит мастер
+	MaxLag time.Duration
+	// CheckInterval - период проверки доступности и отставания реплик
+	CheckInterval time.Duration
+}
+
+type replica struct {
+	dsnIndex int
+	pool     Pool
+	healthy  atomic.Bool
+	lag      atomic.Int64
+}
+
+// ReplicaStatus - состояние реплики по последней проверке
+type ReplicaStatus struct {
+	Index   int
+	Healthy bool
+	Lag     time.Duration
+}
+
+type replicaRouteKey struct{}
+
+type replicaRoute struct {
+	served atomic.Bool
+}
+
+// WithReplica - разрешает читать с реплики вне транзакции и в транзакциях, начатых с этим контекстом,
+// кроме serializable. Данные могут отставать от мастера на MaxLag, при недоступности реплик чтение идет в мастер
+func WithReplica(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replicaRouteKey{}, &replicaRoute{})
+}
+
+// ServedByReplica - была ли реплика выбрана для запроса или транзакции с контекстом из WithReplica
+func ServedByReplica(ctx context.Context) bool {
+	route, ok := ctx.Value(replicaRouteKey{}).(*replicaRoute)
+	return ok && route.served.Load()
+}
+
+func newReplicas(ctx context.Context, opts NewClientOpts) ([]*replica, error) {
+	replicas := make([]*replica, 0, len(opts.Replicas.DSNs))
+
+	for i, dsn := range opts.Replicas.DSNs {
+		pgxCfg, err := pgxpool.ParseConfig(dsn)
+		if err != nil {
+			closeReplicas(replicas)
+			return nil, fmt.Errorf("unable to parse replica %d dsn: %w", i, err)
+		}
+
+		pgxCfg.ConnConfig.RuntimeParams["default_transaction_read_only"] = "true"
+
+		if opts.LogQueries {
+			pgxCfg.ConnConfig.Tracer = &pgxTracer{logger: opts.Logger}
+		}
+
+		dbpool, err := pgxpool.NewWithConfig(ctx, pgxCfg)
+		if err != nil {
+			closeReplicas(replicas)
+			return nil, fmt.Errorf("unable to create replica %d connection pool: %w", i, err)
+		}
+
+		replicas = append(replicas, &replica{dsnIndex: i, pool: dbpool})
+	}
+
+	return replicas, nil
+}
+
+func closeReplicas(replicas []*replica) {
+	for _, r := range replicas {
+		r.pool.Close()
+	}
+}
+
+// ReplicaStatuses - состояние реплик по последней проверке
+func (c *clientImpl) ReplicaStatuses() []ReplicaStatus {
+	out := make([]ReplicaStatus, 0, len(c.replicas))
+	for _, r := range c.replicas {
+		out = append(out, ReplicaStatus{
+			Index:   r.dsnIndex,
+			Healthy: r.healthy.Load(),
+			Lag:     time.Duration(r.lag.Load()),
+		})
+	}
+
+	return out
+}
+
+// pickReplica - следующая по кругу исправная реплика, nil если чтение с реплики не разрешено или реплик нет
+func (c *clientImpl) pickReplica(ctx context.Context) *replica {
+	if len(c.replicas) == 0 {
+		return nil
+	}
+
+	route, ok := ctx.Value(replicaRouteKey{}).(*replicaRoute)
+	if !ok {
+		return nil
+	}
+
+	start := c.replicaNext.Add(1)
+	for i := range uint64(len(c.replicas)) {
+		r := c.replicas[(start+i)%uint64(len(c.replicas))]
+		if r.healthy.Load() {
+			route.served.Store(true)
+			return r
+		}
+	}
+
+	return nil
+}
+
+// runReplicaChecks - проверяет реплики сразу и далее с периодом CheckInterval до остановки клиента
+func (c *clientImpl) runReplicaChecks(ctx context.Context, interval time.Duration) {
+	defer close(c.replicaChecksDone)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		c.checkReplicas(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *clientImpl) checkReplicas(ctx context.Context) {
+	for _, r := range c.replicas {
+		c.checkReplica(ctx, r)
+	}
+}
+
+func (c *clientImpl) checkReplica(ctx context.Context, r *replica) {
+	checkCtx, cancel := context.WithTimeout(ctx, replicaCheckTimeout)
+	defer cancel()
+
+	var lagSeconds float64
+	err := r.pool.QueryRow(checkCtx, replicaLagQuery).Scan(&lagSeconds)
+	if err != nil {
+		if r.healthy.Swap(false) && c.logger != nil {
+			c.logger.WarnContext(ctx, "replica is unavailable, reads fall back to master",
+				slog.Int("replica", r.dsnIndex),
+				slog.Any("error", err.Error()),
+			)
+		}
+
+		return
+	}
+
+	lag := time.Duration(lagSeconds * float64(time.Second))
+	r.lag.Store(int64(lag))
+
+	healthy := lag <= c.replicaMaxLag
+	if r.healthy.Swap(healthy) != healthy && c.logger != nil {
+		if healthy {
+			c.logger.InfoContext(ctx, "replica is available for reads",
+				slog.Int("replica", r.dsnIndex),
+				slog.Duration("lag", lag),
+			)
+		} else {
+			c.logger.WarnContext(ctx, "replica lag exceeds limit, reads fall back to master",
+				slog.Int("replica", r.dsnIndex),
+				slog.Duration("lag", lag),
+				slog.Duration("max_lag", c.replicaMaxLag),
+			)
+		}
+	}
+}
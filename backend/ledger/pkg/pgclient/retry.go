@@ -0,0 +1,154 @@
+package pgclient
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/rand/v2"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	sqlStateSerializationFailure = "40001"
+	sqlStateDeadlockDetected     = "40P01"
+)
+
+// RetryPolicy - повтор транзакции целиком при serialization failure и deadlock
+type RetryPolicy struct {
+	// MaxAttempts - всего попыток, включая первую, значение меньше 2 отключает повторы
+	MaxAttempts int
+	// BaseDelay - верхняя граница паузы перед первым повтором, дальше удваивается до MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// IsRetryable - классификатор ошибок, по умолчанию IsSerializationFailure
+	IsRetryable func(err error) bool
+}
+
+// RetryMetrics - счетчики повторов транзакций с момента создания клиента
+type RetryMetrics struct {
+	// Retries - повторные запуски транзакций
+	Retries uint64
+	// Recovered - транзакции, завершенные успешно после повтора
+	Recovered uint64
+	// Exhausted - транзакции, завершенные ошибкой после повтора
+	Exhausted uint64
+}
+
+type retryPolicyKey struct{}
+
+// WithRetryPolicy - переопределяет политику повторов для транзакций, начатых с этим контекстом
+func WithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+// WithoutRetry - отключает повторы для транзакций, начатых с этим контекстом
+func WithoutRetry(ctx context.Context) context.Context {
+	return WithRetryPolicy(ctx, RetryPolicy{MaxAttempts: 1})
+}
+
+// IsSerializationFailure - ошибка postgres, после которой транзакцию можно повторить целиком
+func IsSerializationFailure(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == sqlStateSerializationFailure || pgErr.Code == sqlStateDeadlockDetected
+	}
+
+	return false
+}
+
+func (p RetryPolicy) isRetryable(err error) bool {
+	if p.IsRetryable != nil {
+		return p.IsRetryable(err)
+	}
+
+	return IsSerializationFailure(err)
+}
+
+// delay - пауза перед повтором с полным jitter, attempt - номер завершившейся попытки начиная с 1
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+
+	ceiling := p.BaseDelay
+	for i := 1; i < attempt && (p.MaxDelay <= 0 || ceiling < p.MaxDelay); i++ {
+		ceiling *= 2
+	}
+
+	if p.MaxDelay > 0 && ceiling > p.MaxDelay {
+		ceiling = p.MaxDelay
+	}
+
+	return rand.N(ceiling + 1)
+}
+
+type retryCounters struct {
+	retries   atomic.Uint64
+	recovered atomic.Uint64
+	exhausted atomic.Uint64
+}
+
+func (c *retryCounters) snapshot() RetryMetrics {
+	return RetryMetrics{
+		Retries:   c.retries.Load(),
+		Recovered: c.recovered.Load(),
+		Exhausted: c.exhausted.Load(),
+	}
+}
+
+// LogRetryMetrics - пишет счетчики повторов клиента в лог с периодом interval, пока не отменен ctx.
+// Запись делается, только если с прошлой записи счетчики изменились, при отмене ctx пишутся итоговые значения
+func LogRetryMetrics(ctx context.Context, client Client, logger *slog.Logger, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last RetryMetrics
+
+	for {
+		select {
+		case <-ctx.Done():
+			logRetryMetrics(context.WithoutCancel(ctx), client, logger, &last)
+			return
+		case <-ticker.C:
+			logRetryMetrics(ctx, client, logger, &last)
+		}
+	}
+}
+
+func logRetryMetrics(ctx context.Context, client Client, logger *slog.Logger, last *RetryMetrics) {
+	metrics := client.RetryMetrics()
+	if metrics == *last {
+		return
+	}
+
+	logger.InfoContext(ctx, "transaction retry metrics",
+		slog.String("serverID", client.ServerID()),
+		slog.Uint64("retries", metrics.Retries),
+		slog.Uint64("recovered", metrics.Recovered),
+		slog.Uint64("exhausted", metrics.Exhausted),
+		slog.Uint64("retries_delta", metrics.Retries-last.Retries),
+		slog.Uint64("recovered_delta", metrics.Recovered-last.Recovered),
+		slog.Uint64("exhausted_delta", metrics.Exhausted-last.Exhausted),
+	)
+
+	*last = metrics
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -61,6 +61,108 @@ func (m *Category) validate(all bool) error {
 
 	// no validation rules for Title
 
+	// no validation rules for IsArchived
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CategoryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CategoryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CategoryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CategoryValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CategoryValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CategoryValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetChildren() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CategoryValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CategoryValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CategoryValidationError{
+					field:  fmt.Sprintf("Children[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.AccountId != nil {
+		// no validation rules for AccountId
+	}
+
+	if m.ParentId != nil {
+		// no validation rules for ParentId
+	}
+
 	if len(errors) > 0 {
 		return CategoryMultiError(errors)
 	}
@@ -466,6 +568,30 @@ func (m *Transaction) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Currency
+
+	// no validation rules for Version
+
+	if m.WalletId != nil {
+		// no validation rules for WalletId
+	}
+
+	if m.TransferId != nil {
+		// no validation rules for TransferId
+	}
+
+	if m.RecurringRuleId != nil {
+		// no validation rules for RecurringRuleId
+	}
+
+	if m.ExternalId != nil {
+		// no validation rules for ExternalId
+	}
+
+	if m.DuplicateOfId != nil {
+		// no validation rules for DuplicateOfId
+	}
+
 	if len(errors) > 0 {
 		return TransactionMultiError(errors)
 	}
@@ -543,21 +669,121 @@ var _ interface {
 	ErrorName() string
 } = TransactionValidationError{}
 
-// Validate checks the field values on Budget with the rules defined in the
+// Validate checks the field values on TransactionTags with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TransactionTags) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransactionTags with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransactionTagsMultiError, or nil if none found.
+func (m *TransactionTags) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransactionTags) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TransactionTagsMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransactionTagsMultiError is an error wrapping multiple validation errors
+// returned by TransactionTags.ValidateAll() if the designated constraints
+// aren't met.
+type TransactionTagsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransactionTagsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransactionTagsMultiError) AllErrors() []error { return m }
+
+// TransactionTagsValidationError is the validation error returned by
+// TransactionTags.Validate if the designated constraints aren't met.
+type TransactionTagsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransactionTagsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransactionTagsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransactionTagsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransactionTagsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransactionTagsValidationError) ErrorName() string { return "TransactionTagsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TransactionTagsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransactionTags.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransactionTagsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransactionTagsValidationError{}
+
+// Validate checks the field values on Wallet with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Budget) Validate() error {
+func (m *Wallet) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Budget with the rules defined in the
+// ValidateAll checks the field values on Wallet with the rules defined in the
 // proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in BudgetMultiError, or nil if none found.
-func (m *Budget) ValidateAll() error {
+// a list of violation errors wrapped in WalletMultiError, or nil if none found.
+func (m *Wallet) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Budget) validate(all bool) error {
+func (m *Wallet) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -568,44 +794,19 @@ func (m *Budget) validate(all bool) error {
 
 	// no validation rules for AccountId
 
-	if all {
-		switch v := interface{}(m.GetPeriod()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, BudgetValidationError{
-					field:  "Period",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, BudgetValidationError{
-					field:  "Period",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPeriod()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return BudgetValidationError{
-				field:  "Period",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Title
 
-	// no validation rules for CategoryId
+	// no validation rules for Currency
 
-	// no validation rules for Amount
+	// no validation rules for OpeningBalance
+
+	// no validation rules for IsArchived
 
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, BudgetValidationError{
+				errors = append(errors, WalletValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -613,7 +814,7 @@ func (m *Budget) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, BudgetValidationError{
+				errors = append(errors, WalletValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -622,7 +823,7 @@ func (m *Budget) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return BudgetValidationError{
+			return WalletValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -634,7 +835,7 @@ func (m *Budget) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, BudgetValidationError{
+				errors = append(errors, WalletValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -642,7 +843,7 @@ func (m *Budget) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, BudgetValidationError{
+				errors = append(errors, WalletValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -651,7 +852,7 @@ func (m *Budget) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return BudgetValidationError{
+			return WalletValidationError{
 				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -660,18 +861,18 @@ func (m *Budget) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return BudgetMultiError(errors)
+		return WalletMultiError(errors)
 	}
 
 	return nil
 }
 
-// BudgetMultiError is an error wrapping multiple validation errors returned by
-// Budget.ValidateAll() if the designated constraints aren't met.
-type BudgetMultiError []error
+// WalletMultiError is an error wrapping multiple validation errors returned by
+// Wallet.ValidateAll() if the designated constraints aren't met.
+type WalletMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BudgetMultiError) Error() string {
+func (m WalletMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -680,11 +881,11 @@ func (m BudgetMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BudgetMultiError) AllErrors() []error { return m }
+func (m WalletMultiError) AllErrors() []error { return m }
 
-// BudgetValidationError is the validation error returned by Budget.Validate if
+// WalletValidationError is the validation error returned by Wallet.Validate if
 // the designated constraints aren't met.
-type BudgetValidationError struct {
+type WalletValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -692,22 +893,22 @@ type BudgetValidationError struct {
 }
 
 // Field function returns field value.
-func (e BudgetValidationError) Field() string { return e.field }
+func (e WalletValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BudgetValidationError) Reason() string { return e.reason }
+func (e WalletValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BudgetValidationError) Cause() error { return e.cause }
+func (e WalletValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BudgetValidationError) Key() bool { return e.key }
+func (e WalletValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BudgetValidationError) ErrorName() string { return "BudgetValidationError" }
+func (e WalletValidationError) ErrorName() string { return "WalletValidationError" }
 
 // Error satisfies the builtin error interface
-func (e BudgetValidationError) Error() string {
+func (e WalletValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -719,14 +920,14 @@ func (e BudgetValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBudget.%s: %s%s",
+		"invalid %sWallet.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BudgetValidationError{}
+var _ error = WalletValidationError{}
 
 var _ interface {
 	Field() string
@@ -734,57 +935,12809 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BudgetValidationError{}
+} = WalletValidationError{}
 
-// Validate checks the field values on ReportItem with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ReportItem) Validate() error {
+// Validate checks the field values on ImportProfileColumns with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportProfileColumns) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReportItem with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ReportItemMultiError, or
-// nil if none found.
-func (m *ReportItem) ValidateAll() error {
+// ValidateAll checks the field values on ImportProfileColumns with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportProfileColumnsMultiError, or nil if none found.
+func (m *ImportProfileColumns) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReportItem) validate(all bool) error {
+func (m *ImportProfileColumns) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CategoryId
+	// no validation rules for Date
 
-	if m.Sum != nil {
-		// no validation rules for Sum
-	}
+	// no validation rules for Amount
 
-	if m.SpentBudget != nil {
-		// no validation rules for SpentBudget
-	}
+	// no validation rules for Debit
 
-	if m.ItemBudget != nil {
+	// no validation rules for Credit
+
+	// no validation rules for IsIncome
+
+	// no validation rules for Description
+
+	// no validation rules for CategoryId
+
+	// no validation rules for Currency
+
+	// no validation rules for ExternalId
+
+	if len(errors) > 0 {
+		return ImportProfileColumnsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportProfileColumnsMultiError is an error wrapping multiple validation
+// errors returned by ImportProfileColumns.ValidateAll() if the designated
+// constraints aren't met.
+type ImportProfileColumnsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportProfileColumnsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportProfileColumnsMultiError) AllErrors() []error { return m }
+
+// ImportProfileColumnsValidationError is the validation error returned by
+// ImportProfileColumns.Validate if the designated constraints aren't met.
+type ImportProfileColumnsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportProfileColumnsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportProfileColumnsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportProfileColumnsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportProfileColumnsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportProfileColumnsValidationError) ErrorName() string {
+	return "ImportProfileColumnsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportProfileColumnsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportProfileColumns.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportProfileColumnsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportProfileColumnsValidationError{}
+
+// Validate checks the field values on ImportProfileMapping with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportProfileMapping) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportProfileMapping with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportProfileMappingMultiError, or nil if none found.
+func (m *ImportProfileMapping) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportProfileMapping) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HasHeader
+
+	// no validation rules for SignConvention
+
+	if all {
+		switch v := interface{}(m.GetColumns()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportProfileMappingValidationError{
+					field:  "Columns",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportProfileMappingValidationError{
+					field:  "Columns",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetColumns()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportProfileMappingValidationError{
+				field:  "Columns",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.DefaultCategoryId != nil {
+		// no validation rules for DefaultCategoryId
+	}
+
+	if len(errors) > 0 {
+		return ImportProfileMappingMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportProfileMappingMultiError is an error wrapping multiple validation
+// errors returned by ImportProfileMapping.ValidateAll() if the designated
+// constraints aren't met.
+type ImportProfileMappingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportProfileMappingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportProfileMappingMultiError) AllErrors() []error { return m }
+
+// ImportProfileMappingValidationError is the validation error returned by
+// ImportProfileMapping.Validate if the designated constraints aren't met.
+type ImportProfileMappingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportProfileMappingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportProfileMappingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportProfileMappingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportProfileMappingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportProfileMappingValidationError) ErrorName() string {
+	return "ImportProfileMappingValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportProfileMappingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportProfileMapping.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportProfileMappingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportProfileMappingValidationError{}
+
+// Validate checks the field values on ImportProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportProfile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportProfile with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportProfileMultiError, or
+// nil if none found.
+func (m *ImportProfile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportProfile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for AccountId
+
+	// no validation rules for Name
+
+	// no validation rules for Delimiter
+
+	// no validation rules for DecimalSeparator
+
+	// no validation rules for DateLayout
+
+	// no validation rules for SkipRows
+
+	// no validation rules for Encoding
+
+	if all {
+		switch v := interface{}(m.GetMapping()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportProfileValidationError{
+					field:  "Mapping",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportProfileValidationError{
+					field:  "Mapping",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMapping()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportProfileValidationError{
+				field:  "Mapping",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportProfileValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportProfileValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportProfileValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportProfileValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportProfileValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportProfileValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportProfileMultiError is an error wrapping multiple validation errors
+// returned by ImportProfile.ValidateAll() if the designated constraints
+// aren't met.
+type ImportProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportProfileMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportProfileMultiError) AllErrors() []error { return m }
+
+// ImportProfileValidationError is the validation error returned by
+// ImportProfile.Validate if the designated constraints aren't met.
+type ImportProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportProfileValidationError) ErrorName() string { return "ImportProfileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportProfileValidationError{}
+
+// Validate checks the field values on CategoryRuleConditions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CategoryRuleConditions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryRuleConditions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CategoryRuleConditionsMultiError, or nil if none found.
+func (m *CategoryRuleConditions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryRuleConditions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.DescriptionContains != nil {
+		// no validation rules for DescriptionContains
+	}
+
+	if m.DescriptionRegex != nil {
+		// no validation rules for DescriptionRegex
+	}
+
+	if m.AmountMin != nil {
+		// no validation rules for AmountMin
+	}
+
+	if m.AmountMax != nil {
+		// no validation rules for AmountMax
+	}
+
+	if m.IsIncome != nil {
+		// no validation rules for IsIncome
+	}
+
+	if m.WalletId != nil {
+		// no validation rules for WalletId
+	}
+
+	if len(errors) > 0 {
+		return CategoryRuleConditionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryRuleConditionsMultiError is an error wrapping multiple validation
+// errors returned by CategoryRuleConditions.ValidateAll() if the designated
+// constraints aren't met.
+type CategoryRuleConditionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryRuleConditionsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryRuleConditionsMultiError) AllErrors() []error { return m }
+
+// CategoryRuleConditionsValidationError is the validation error returned by
+// CategoryRuleConditions.Validate if the designated constraints aren't met.
+type CategoryRuleConditionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryRuleConditionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryRuleConditionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryRuleConditionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryRuleConditionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryRuleConditionsValidationError) ErrorName() string {
+	return "CategoryRuleConditionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CategoryRuleConditionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryRuleConditions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryRuleConditionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryRuleConditionsValidationError{}
+
+// Validate checks the field values on CategoryRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CategoryRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CategoryRuleMultiError, or
+// nil if none found.
+func (m *CategoryRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for AccountId
+
+	// no validation rules for Name
+
+	// no validation rules for Priority
+
+	// no validation rules for CategoryId
+
+	if all {
+		switch v := interface{}(m.GetConditions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CategoryRuleValidationError{
+					field:  "Conditions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CategoryRuleValidationError{
+					field:  "Conditions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConditions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CategoryRuleValidationError{
+				field:  "Conditions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CategoryRuleValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CategoryRuleValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CategoryRuleValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CategoryRuleValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CategoryRuleValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CategoryRuleValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CategoryRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryRuleMultiError is an error wrapping multiple validation errors
+// returned by CategoryRule.ValidateAll() if the designated constraints aren't met.
+type CategoryRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryRuleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryRuleMultiError) AllErrors() []error { return m }
+
+// CategoryRuleValidationError is the validation error returned by
+// CategoryRule.Validate if the designated constraints aren't met.
+type CategoryRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryRuleValidationError) ErrorName() string { return "CategoryRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CategoryRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryRuleValidationError{}
+
+// Validate checks the field values on CategoryRuleChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CategoryRuleChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryRuleChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CategoryRuleChangeMultiError, or nil if none found.
+func (m *CategoryRuleChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryRuleChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TransactionId
+
+	// no validation rules for RuleId
+
+	// no validation rules for OldCategoryId
+
+	// no validation rules for NewCategoryId
+
+	if len(errors) > 0 {
+		return CategoryRuleChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryRuleChangeMultiError is an error wrapping multiple validation errors
+// returned by CategoryRuleChange.ValidateAll() if the designated constraints
+// aren't met.
+type CategoryRuleChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryRuleChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryRuleChangeMultiError) AllErrors() []error { return m }
+
+// CategoryRuleChangeValidationError is the validation error returned by
+// CategoryRuleChange.Validate if the designated constraints aren't met.
+type CategoryRuleChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryRuleChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryRuleChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryRuleChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryRuleChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryRuleChangeValidationError) ErrorName() string {
+	return "CategoryRuleChangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CategoryRuleChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryRuleChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryRuleChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryRuleChangeValidationError{}
+
+// Validate checks the field values on WalletBalance with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WalletBalance) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WalletBalance with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WalletBalanceMultiError, or
+// nil if none found.
+func (m *WalletBalance) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WalletBalance) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWallet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WalletBalanceValidationError{
+					field:  "Wallet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WalletBalanceValidationError{
+					field:  "Wallet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWallet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WalletBalanceValidationError{
+				field:  "Wallet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TransactionsSum
+
+	// no validation rules for Balance
+
+	if len(errors) > 0 {
+		return WalletBalanceMultiError(errors)
+	}
+
+	return nil
+}
+
+// WalletBalanceMultiError is an error wrapping multiple validation errors
+// returned by WalletBalance.ValidateAll() if the designated constraints
+// aren't met.
+type WalletBalanceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WalletBalanceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WalletBalanceMultiError) AllErrors() []error { return m }
+
+// WalletBalanceValidationError is the validation error returned by
+// WalletBalance.Validate if the designated constraints aren't met.
+type WalletBalanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WalletBalanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WalletBalanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WalletBalanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WalletBalanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WalletBalanceValidationError) ErrorName() string { return "WalletBalanceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WalletBalanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWalletBalance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WalletBalanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WalletBalanceValidationError{}
+
+// Validate checks the field values on Transfer with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Transfer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Transfer with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransferMultiError, or nil
+// if none found.
+func (m *Transfer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Transfer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for AccountId
+
+	// no validation rules for FromWalletId
+
+	// no validation rules for ToWalletId
+
+	// no validation rules for Amount
+
+	if all {
+		switch v := interface{}(m.GetOccurredOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransferValidationError{
+					field:  "OccurredOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransferValidationError{
+					field:  "OccurredOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransferValidationError{
+				field:  "OccurredOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransferValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransferValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransferValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransferValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransferValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransferValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Currency
+
+	if len(errors) > 0 {
+		return TransferMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferMultiError is an error wrapping multiple validation errors returned
+// by Transfer.ValidateAll() if the designated constraints aren't met.
+type TransferMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferMultiError) AllErrors() []error { return m }
+
+// TransferValidationError is the validation error returned by
+// Transfer.Validate if the designated constraints aren't met.
+type TransferValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferValidationError) ErrorName() string { return "TransferValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TransferValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransfer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferValidationError{}
+
+// Validate checks the field values on Budget with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Budget) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Budget with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BudgetMultiError, or nil if none found.
+func (m *Budget) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Budget) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for AccountId
+
+	if all {
+		switch v := interface{}(m.GetPeriod()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BudgetValidationError{
+					field:  "Period",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BudgetValidationError{
+					field:  "Period",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriod()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BudgetValidationError{
+				field:  "Period",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CategoryId
+
+	// no validation rules for Amount
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BudgetValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BudgetValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BudgetValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BudgetValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BudgetValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BudgetValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Currency
+
+	// no validation rules for CarryOver
+
+	// no validation rules for Enforcement
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return BudgetMultiError(errors)
+	}
+
+	return nil
+}
+
+// BudgetMultiError is an error wrapping multiple validation errors returned by
+// Budget.ValidateAll() if the designated constraints aren't met.
+type BudgetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BudgetMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BudgetMultiError) AllErrors() []error { return m }
+
+// BudgetValidationError is the validation error returned by Budget.Validate if
+// the designated constraints aren't met.
+type BudgetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BudgetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BudgetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BudgetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BudgetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BudgetValidationError) ErrorName() string { return "BudgetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BudgetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBudget.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BudgetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BudgetValidationError{}
+
+// Validate checks the field values on BudgetWarningThresholds with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BudgetWarningThresholds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BudgetWarningThresholds with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BudgetWarningThresholdsMultiError, or nil if none found.
+func (m *BudgetWarningThresholds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BudgetWarningThresholds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return BudgetWarningThresholdsMultiError(errors)
+	}
+
+	return nil
+}
+
+// BudgetWarningThresholdsMultiError is an error wrapping multiple validation
+// errors returned by BudgetWarningThresholds.ValidateAll() if the designated
+// constraints aren't met.
+type BudgetWarningThresholdsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BudgetWarningThresholdsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BudgetWarningThresholdsMultiError) AllErrors() []error { return m }
+
+// BudgetWarningThresholdsValidationError is the validation error returned by
+// BudgetWarningThresholds.Validate if the designated constraints aren't met.
+type BudgetWarningThresholdsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BudgetWarningThresholdsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BudgetWarningThresholdsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BudgetWarningThresholdsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BudgetWarningThresholdsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BudgetWarningThresholdsValidationError) ErrorName() string {
+	return "BudgetWarningThresholdsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BudgetWarningThresholdsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBudgetWarningThresholds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BudgetWarningThresholdsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BudgetWarningThresholdsValidationError{}
+
+// Validate checks the field values on BudgetWarning with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BudgetWarning) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BudgetWarning with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BudgetWarningMultiError, or
+// nil if none found.
+func (m *BudgetWarning) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BudgetWarning) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BudgetId
+
+	// no validation rules for CategoryId
+
+	if all {
+		switch v := interface{}(m.GetPeriod()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BudgetWarningValidationError{
+					field:  "Period",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BudgetWarningValidationError{
+					field:  "Period",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriod()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BudgetWarningValidationError{
+				field:  "Period",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Enforcement
+
+	// no validation rules for Threshold
+
+	// no validation rules for Exceeded
+
+	if m.SpentPercent != nil {
+		// no validation rules for SpentPercent
+	}
+
+	if len(errors) > 0 {
+		return BudgetWarningMultiError(errors)
+	}
+
+	return nil
+}
+
+// BudgetWarningMultiError is an error wrapping multiple validation errors
+// returned by BudgetWarning.ValidateAll() if the designated constraints
+// aren't met.
+type BudgetWarningMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BudgetWarningMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BudgetWarningMultiError) AllErrors() []error { return m }
+
+// BudgetWarningValidationError is the validation error returned by
+// BudgetWarning.Validate if the designated constraints aren't met.
+type BudgetWarningValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BudgetWarningValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BudgetWarningValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BudgetWarningValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BudgetWarningValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BudgetWarningValidationError) ErrorName() string { return "BudgetWarningValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BudgetWarningValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBudgetWarning.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BudgetWarningValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BudgetWarningValidationError{}
+
+// Validate checks the field values on ExchangeRate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExchangeRate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExchangeRate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExchangeRateMultiError, or
+// nil if none found.
+func (m *ExchangeRate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExchangeRate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CurrencyFrom
+
+	// no validation rules for CurrencyTo
+
+	if all {
+		switch v := interface{}(m.GetRateDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExchangeRateValidationError{
+					field:  "RateDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExchangeRateValidationError{
+					field:  "RateDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRateDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExchangeRateValidationError{
+				field:  "RateDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Rate
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExchangeRateValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExchangeRateValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExchangeRateValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExchangeRateValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExchangeRateValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExchangeRateValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExchangeRateMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExchangeRateMultiError is an error wrapping multiple validation errors
+// returned by ExchangeRate.ValidateAll() if the designated constraints aren't met.
+type ExchangeRateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExchangeRateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExchangeRateMultiError) AllErrors() []error { return m }
+
+// ExchangeRateValidationError is the validation error returned by
+// ExchangeRate.Validate if the designated constraints aren't met.
+type ExchangeRateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExchangeRateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExchangeRateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExchangeRateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExchangeRateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExchangeRateValidationError) ErrorName() string { return "ExchangeRateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExchangeRateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExchangeRate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExchangeRateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExchangeRateValidationError{}
+
+// Validate checks the field values on RecurringRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RecurringRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecurringRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RecurringRuleMultiError, or
+// nil if none found.
+func (m *RecurringRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecurringRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for AccountId
+
+	// no validation rules for IsIncome
+
+	// no validation rules for Amount
+
+	// no validation rules for Currency
+
+	// no validation rules for CategoryId
+
+	// no validation rules for Description
+
+	// no validation rules for Frequency
+
+	// no validation rules for Interval
+
+	// no validation rules for DayOfMonth
+
+	if all {
+		switch v := interface{}(m.GetStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecurringRuleValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecurringRuleValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecurringRuleValidationError{
+				field:  "StartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OccurrencesCount
+
+	// no validation rules for IsPaused
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecurringRuleValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecurringRuleValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecurringRuleValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecurringRuleValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecurringRuleValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecurringRuleValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.WalletId != nil {
+		// no validation rules for WalletId
+	}
+
+	if m.EndDate != nil {
+
+		if all {
+			switch v := interface{}(m.GetEndDate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RecurringRuleValidationError{
+						field:  "EndDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RecurringRuleValidationError{
+						field:  "EndDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecurringRuleValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Count != nil {
+		// no validation rules for Count
+	}
+
+	if m.NextOccurrenceOn != nil {
+
+		if all {
+			switch v := interface{}(m.GetNextOccurrenceOn()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RecurringRuleValidationError{
+						field:  "NextOccurrenceOn",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RecurringRuleValidationError{
+						field:  "NextOccurrenceOn",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNextOccurrenceOn()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecurringRuleValidationError{
+					field:  "NextOccurrenceOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.LastError != nil {
+		// no validation rules for LastError
+	}
+
+	if len(errors) > 0 {
+		return RecurringRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecurringRuleMultiError is an error wrapping multiple validation errors
+// returned by RecurringRule.ValidateAll() if the designated constraints
+// aren't met.
+type RecurringRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecurringRuleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecurringRuleMultiError) AllErrors() []error { return m }
+
+// RecurringRuleValidationError is the validation error returned by
+// RecurringRule.Validate if the designated constraints aren't met.
+type RecurringRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecurringRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecurringRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecurringRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecurringRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecurringRuleValidationError) ErrorName() string { return "RecurringRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecurringRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecurringRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecurringRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecurringRuleValidationError{}
+
+// Validate checks the field values on ReportItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReportItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReportItemMultiError, or
+// nil if none found.
+func (m *ReportItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CategoryId
+
+	if m.Sum != nil {
+		// no validation rules for Sum
+	}
+
+	if m.SpentBudget != nil {
+		// no validation rules for SpentBudget
+	}
+
+	if m.ItemBudget != nil {
 		// no validation rules for ItemBudget
 	}
 
+	if m.ParentCategoryId != nil {
+		// no validation rules for ParentCategoryId
+	}
+
+	if m.TotalSum != nil {
+		// no validation rules for TotalSum
+	}
+
+	if m.TotalSpentBudget != nil {
+		// no validation rules for TotalSpentBudget
+	}
+
+	if m.TotalBudget != nil {
+		// no validation rules for TotalBudget
+	}
+
+	if m.CarriedOver != nil {
+		// no validation rules for CarriedOver
+	}
+
+	if m.EffectiveBudget != nil {
+		// no validation rules for EffectiveBudget
+	}
+
+	if len(errors) > 0 {
+		return ReportItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportItemMultiError is an error wrapping multiple validation errors
+// returned by ReportItem.ValidateAll() if the designated constraints aren't met.
+type ReportItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportItemMultiError) AllErrors() []error { return m }
+
+// ReportItemValidationError is the validation error returned by
+// ReportItem.Validate if the designated constraints aren't met.
+type ReportItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportItemValidationError) ErrorName() string { return "ReportItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReportItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportItemValidationError{}
+
+// Validate checks the field values on PeriodReport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PeriodReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PeriodReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PeriodReportMultiError, or
+// nil if none found.
+func (m *PeriodReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PeriodReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPeriodStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PeriodReportValidationError{
+					field:  "PeriodStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PeriodReportValidationError{
+					field:  "PeriodStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PeriodReportValidationError{
+				field:  "PeriodStart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPeriodEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PeriodReportValidationError{
+					field:  "PeriodEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PeriodReportValidationError{
+					field:  "PeriodEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PeriodReportValidationError{
+				field:  "PeriodEnd",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PeriodReportValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PeriodReportValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PeriodReportValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PeriodReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// PeriodReportMultiError is an error wrapping multiple validation errors
+// returned by PeriodReport.ValidateAll() if the designated constraints aren't met.
+type PeriodReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PeriodReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PeriodReportMultiError) AllErrors() []error { return m }
+
+// PeriodReportValidationError is the validation error returned by
+// PeriodReport.Validate if the designated constraints aren't met.
+type PeriodReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeriodReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeriodReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeriodReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeriodReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeriodReportValidationError) ErrorName() string { return "PeriodReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PeriodReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeriodReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeriodReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeriodReportValidationError{}
+
+// Validate checks the field values on ListCategoriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCategoriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCategoriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCategoriesRequestMultiError, or nil if none found.
+func (m *ListCategoriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCategoriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.FilterIsArchived != nil {
+		// no validation rules for FilterIsArchived
+	}
+
+	if len(errors) > 0 {
+		return ListCategoriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCategoriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCategoriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCategoriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCategoriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCategoriesRequestMultiError) AllErrors() []error { return m }
+
+// ListCategoriesRequestValidationError is the validation error returned by
+// ListCategoriesRequest.Validate if the designated constraints aren't met.
+type ListCategoriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCategoriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCategoriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCategoriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCategoriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCategoriesRequestValidationError) ErrorName() string {
+	return "ListCategoriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCategoriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCategoriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCategoriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCategoriesRequestValidationError{}
+
+// Validate checks the field values on ListCategoriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCategoriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCategoriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCategoriesResponseMultiError, or nil if none found.
+func (m *ListCategoriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCategoriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCategoriesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCategoriesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCategoriesResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCategoriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCategoriesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListCategoriesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListCategoriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCategoriesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCategoriesResponseMultiError) AllErrors() []error { return m }
+
+// ListCategoriesResponseValidationError is the validation error returned by
+// ListCategoriesResponse.Validate if the designated constraints aren't met.
+type ListCategoriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCategoriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCategoriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCategoriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCategoriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCategoriesResponseValidationError) ErrorName() string {
+	return "ListCategoriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCategoriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCategoriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCategoriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCategoriesResponseValidationError{}
+
+// Validate checks the field values on AddCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddCategoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddCategoryRequestMultiError, or nil if none found.
+func (m *AddCategoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddCategoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	if m.ParentId != nil {
+		// no validation rules for ParentId
+	}
+
+	if len(errors) > 0 {
+		return AddCategoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddCategoryRequestMultiError is an error wrapping multiple validation errors
+// returned by AddCategoryRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddCategoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddCategoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddCategoryRequestMultiError) AllErrors() []error { return m }
+
+// AddCategoryRequestValidationError is the validation error returned by
+// AddCategoryRequest.Validate if the designated constraints aren't met.
+type AddCategoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddCategoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddCategoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddCategoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddCategoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddCategoryRequestValidationError) ErrorName() string {
+	return "AddCategoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddCategoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddCategoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddCategoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddCategoryRequestValidationError{}
+
+// Validate checks the field values on AddCategoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddCategoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddCategoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddCategoryResponseMultiError, or nil if none found.
+func (m *AddCategoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddCategoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddCategoryResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddCategoryResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddCategoryResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddCategoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddCategoryResponseMultiError is an error wrapping multiple validation
+// errors returned by AddCategoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddCategoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddCategoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddCategoryResponseMultiError) AllErrors() []error { return m }
+
+// AddCategoryResponseValidationError is the validation error returned by
+// AddCategoryResponse.Validate if the designated constraints aren't met.
+type AddCategoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddCategoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddCategoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddCategoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddCategoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddCategoryResponseValidationError) ErrorName() string {
+	return "AddCategoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddCategoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddCategoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddCategoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddCategoryResponseValidationError{}
+
+// Validate checks the field values on PatchCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PatchCategoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PatchCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PatchCategoryRequestMultiError, or nil if none found.
+func (m *PatchCategoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PatchCategoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Title != nil {
+		// no validation rules for Title
+	}
+
+	if m.IsArchived != nil {
+		// no validation rules for IsArchived
+	}
+
+	if m.ParentId != nil {
+		// no validation rules for ParentId
+	}
+
+	if len(errors) > 0 {
+		return PatchCategoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PatchCategoryRequestMultiError is an error wrapping multiple validation
+// errors returned by PatchCategoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PatchCategoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PatchCategoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PatchCategoryRequestMultiError) AllErrors() []error { return m }
+
+// PatchCategoryRequestValidationError is the validation error returned by
+// PatchCategoryRequest.Validate if the designated constraints aren't met.
+type PatchCategoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchCategoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchCategoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchCategoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchCategoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchCategoryRequestValidationError) ErrorName() string {
+	return "PatchCategoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchCategoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchCategoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchCategoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchCategoryRequestValidationError{}
+
+// Validate checks the field values on PatchCategoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PatchCategoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PatchCategoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PatchCategoryResponseMultiError, or nil if none found.
+func (m *PatchCategoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PatchCategoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PatchCategoryResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PatchCategoryResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PatchCategoryResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PatchCategoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PatchCategoryResponseMultiError is an error wrapping multiple validation
+// errors returned by PatchCategoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PatchCategoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PatchCategoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PatchCategoryResponseMultiError) AllErrors() []error { return m }
+
+// PatchCategoryResponseValidationError is the validation error returned by
+// PatchCategoryResponse.Validate if the designated constraints aren't met.
+type PatchCategoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchCategoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchCategoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchCategoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchCategoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchCategoryResponseValidationError) ErrorName() string {
+	return "PatchCategoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchCategoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchCategoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchCategoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchCategoryResponseValidationError{}
+
+// Validate checks the field values on DeleteCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCategoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCategoryRequestMultiError, or nil if none found.
+func (m *DeleteCategoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCategoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.ReassignToId != nil {
+		// no validation rules for ReassignToId
+	}
+
+	if len(errors) > 0 {
+		return DeleteCategoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCategoryRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCategoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCategoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCategoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCategoryRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCategoryRequestValidationError is the validation error returned by
+// DeleteCategoryRequest.Validate if the designated constraints aren't met.
+type DeleteCategoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCategoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCategoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCategoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCategoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCategoryRequestValidationError) ErrorName() string {
+	return "DeleteCategoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCategoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCategoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCategoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCategoryRequestValidationError{}
+
+// Validate checks the field values on DeleteCategoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCategoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCategoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCategoryResponseMultiError, or nil if none found.
+func (m *DeleteCategoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCategoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteCategoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCategoryResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteCategoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCategoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCategoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCategoryResponseMultiError) AllErrors() []error { return m }
+
+// DeleteCategoryResponseValidationError is the validation error returned by
+// DeleteCategoryResponse.Validate if the designated constraints aren't met.
+type DeleteCategoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCategoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCategoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCategoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCategoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCategoryResponseValidationError) ErrorName() string {
+	return "DeleteCategoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCategoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCategoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCategoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCategoryResponseValidationError{}
+
+// Validate checks the field values on ListTransactionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTransactionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTransactionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTransactionsRequestMultiError, or nil if none found.
+func (m *ListTransactionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTransactionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	// no validation rules for Sort
+
+	// no validation rules for WithTotal
+
+	if m.FilterOccurredOnFrom != nil {
+
+		if all {
+			switch v := interface{}(m.GetFilterOccurredOnFrom()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTransactionsRequestValidationError{
+						field:  "FilterOccurredOnFrom",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTransactionsRequestValidationError{
+						field:  "FilterOccurredOnFrom",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFilterOccurredOnFrom()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTransactionsRequestValidationError{
+					field:  "FilterOccurredOnFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.FilterOccurredOnTo != nil {
+
+		if all {
+			switch v := interface{}(m.GetFilterOccurredOnTo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTransactionsRequestValidationError{
+						field:  "FilterOccurredOnTo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTransactionsRequestValidationError{
+						field:  "FilterOccurredOnTo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFilterOccurredOnTo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTransactionsRequestValidationError{
+					field:  "FilterOccurredOnTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.FilterWalletId != nil {
+		// no validation rules for FilterWalletId
+	}
+
+	if m.FilterIsIncome != nil {
+		// no validation rules for FilterIsIncome
+	}
+
+	if m.FilterAmountMin != nil {
+		// no validation rules for FilterAmountMin
+	}
+
+	if m.FilterAmountMax != nil {
+		// no validation rules for FilterAmountMax
+	}
+
+	if m.FilterSearch != nil {
+		// no validation rules for FilterSearch
+	}
+
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
+	if len(errors) > 0 {
+		return ListTransactionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTransactionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTransactionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTransactionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTransactionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTransactionsRequestMultiError) AllErrors() []error { return m }
+
+// ListTransactionsRequestValidationError is the validation error returned by
+// ListTransactionsRequest.Validate if the designated constraints aren't met.
+type ListTransactionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTransactionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTransactionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTransactionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTransactionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTransactionsRequestValidationError) ErrorName() string {
+	return "ListTransactionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTransactionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTransactionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTransactionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTransactionsRequestValidationError{}
+
+// Validate checks the field values on ListTransactionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTransactionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTransactionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTransactionsResponseMultiError, or nil if none found.
+func (m *ListTransactionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTransactionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTransactionsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTransactionsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTransactionsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if m.Total != nil {
+		// no validation rules for Total
+	}
+
+	if len(errors) > 0 {
+		return ListTransactionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTransactionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTransactionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTransactionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTransactionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTransactionsResponseMultiError) AllErrors() []error { return m }
+
+// ListTransactionsResponseValidationError is the validation error returned by
+// ListTransactionsResponse.Validate if the designated constraints aren't met.
+type ListTransactionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTransactionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTransactionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTransactionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTransactionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTransactionsResponseValidationError) ErrorName() string {
+	return "ListTransactionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTransactionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTransactionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTransactionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTransactionsResponseValidationError{}
+
+// Validate checks the field values on GetTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTransactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTransactionRequestMultiError, or nil if none found.
+func (m *GetTransactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTransactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetTransactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTransactionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTransactionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTransactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTransactionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTransactionRequestMultiError) AllErrors() []error { return m }
+
+// GetTransactionRequestValidationError is the validation error returned by
+// GetTransactionRequest.Validate if the designated constraints aren't met.
+type GetTransactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTransactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTransactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTransactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTransactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTransactionRequestValidationError) ErrorName() string {
+	return "GetTransactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTransactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTransactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTransactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTransactionRequestValidationError{}
+
+// Validate checks the field values on GetTransactionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTransactionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTransactionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTransactionResponseMultiError, or nil if none found.
+func (m *GetTransactionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTransactionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTransactionResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTransactionResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTransactionResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTransactionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTransactionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetTransactionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetTransactionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTransactionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTransactionResponseMultiError) AllErrors() []error { return m }
+
+// GetTransactionResponseValidationError is the validation error returned by
+// GetTransactionResponse.Validate if the designated constraints aren't met.
+type GetTransactionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTransactionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTransactionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTransactionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTransactionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTransactionResponseValidationError) ErrorName() string {
+	return "GetTransactionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTransactionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTransactionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTransactionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTransactionResponseValidationError{}
+
+// Validate checks the field values on AddTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddTransactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddTransactionRequestMultiError, or nil if none found.
+func (m *AddTransactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddTransactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsIncome
+
+	// no validation rules for Amount
+
+	if all {
+		switch v := interface{}(m.GetOccurredOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddTransactionRequestValidationError{
+					field:  "OccurredOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddTransactionRequestValidationError{
+					field:  "OccurredOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddTransactionRequestValidationError{
+				field:  "OccurredOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CategoryId
+
+	// no validation rules for Description
+
+	if m.WalletId != nil {
+		// no validation rules for WalletId
+	}
+
+	if m.Currency != nil {
+		// no validation rules for Currency
+	}
+
+	if len(errors) > 0 {
+		return AddTransactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddTransactionRequestMultiError is an error wrapping multiple validation
+// errors returned by AddTransactionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddTransactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddTransactionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddTransactionRequestMultiError) AllErrors() []error { return m }
+
+// AddTransactionRequestValidationError is the validation error returned by
+// AddTransactionRequest.Validate if the designated constraints aren't met.
+type AddTransactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddTransactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddTransactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddTransactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddTransactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddTransactionRequestValidationError) ErrorName() string {
+	return "AddTransactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddTransactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddTransactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddTransactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddTransactionRequestValidationError{}
+
+// Validate checks the field values on AddTransactionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddTransactionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddTransactionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddTransactionResponseMultiError, or nil if none found.
+func (m *AddTransactionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddTransactionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddTransactionResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddTransactionResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddTransactionResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddTransactionResponseValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddTransactionResponseValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddTransactionResponseValidationError{
+					field:  fmt.Sprintf("Warnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddTransactionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddTransactionResponseMultiError is an error wrapping multiple validation
+// errors returned by AddTransactionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddTransactionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddTransactionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddTransactionResponseMultiError) AllErrors() []error { return m }
+
+// AddTransactionResponseValidationError is the validation error returned by
+// AddTransactionResponse.Validate if the designated constraints aren't met.
+type AddTransactionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddTransactionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddTransactionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddTransactionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddTransactionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddTransactionResponseValidationError) ErrorName() string {
+	return "AddTransactionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddTransactionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddTransactionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddTransactionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddTransactionResponseValidationError{}
+
+// Validate checks the field values on AddTransactionsBatchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddTransactionsBatchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddTransactionsBatchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddTransactionsBatchRequestMultiError, or nil if none found.
+func (m *AddTransactionsBatchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddTransactionsBatchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddTransactionsBatchRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddTransactionsBatchRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddTransactionsBatchRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddTransactionsBatchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddTransactionsBatchRequestMultiError is an error wrapping multiple
+// validation errors returned by AddTransactionsBatchRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AddTransactionsBatchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddTransactionsBatchRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddTransactionsBatchRequestMultiError) AllErrors() []error { return m }
+
+// AddTransactionsBatchRequestValidationError is the validation error returned
+// by AddTransactionsBatchRequest.Validate if the designated constraints
+// aren't met.
+type AddTransactionsBatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddTransactionsBatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddTransactionsBatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddTransactionsBatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddTransactionsBatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddTransactionsBatchRequestValidationError) ErrorName() string {
+	return "AddTransactionsBatchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddTransactionsBatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddTransactionsBatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddTransactionsBatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddTransactionsBatchRequestValidationError{}
+
+// Validate checks the field values on AddTransactionsBatchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddTransactionsBatchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddTransactionsBatchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddTransactionsBatchResponseMultiError, or nil if none found.
+func (m *AddTransactionsBatchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddTransactionsBatchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddTransactionsBatchResponseValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddTransactionsBatchResponseValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddTransactionsBatchResponseValidationError{
+					field:  fmt.Sprintf("Warnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddTransactionsBatchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddTransactionsBatchResponseMultiError is an error wrapping multiple
+// validation errors returned by AddTransactionsBatchResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AddTransactionsBatchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddTransactionsBatchResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddTransactionsBatchResponseMultiError) AllErrors() []error { return m }
+
+// AddTransactionsBatchResponseValidationError is the validation error returned
+// by AddTransactionsBatchResponse.Validate if the designated constraints
+// aren't met.
+type AddTransactionsBatchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddTransactionsBatchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddTransactionsBatchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddTransactionsBatchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddTransactionsBatchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddTransactionsBatchResponseValidationError) ErrorName() string {
+	return "AddTransactionsBatchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddTransactionsBatchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddTransactionsBatchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddTransactionsBatchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddTransactionsBatchResponseValidationError{}
+
+// Validate checks the field values on PatchTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PatchTransactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PatchTransactionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PatchTransactionRequestMultiError, or nil if none found.
+func (m *PatchTransactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PatchTransactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Amount != nil {
+		// no validation rules for Amount
+	}
+
+	if m.OccurredOn != nil {
+
+		if all {
+			switch v := interface{}(m.GetOccurredOn()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PatchTransactionRequestValidationError{
+						field:  "OccurredOn",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PatchTransactionRequestValidationError{
+						field:  "OccurredOn",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOccurredOn()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PatchTransactionRequestValidationError{
+					field:  "OccurredOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CategoryId != nil {
+		// no validation rules for CategoryId
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if m.WalletId != nil {
+		// no validation rules for WalletId
+	}
+
+	if m.Currency != nil {
+		// no validation rules for Currency
+	}
+
+	if m.Tags != nil {
+
+		if all {
+			switch v := interface{}(m.GetTags()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PatchTransactionRequestValidationError{
+						field:  "Tags",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PatchTransactionRequestValidationError{
+						field:  "Tags",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTags()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PatchTransactionRequestValidationError{
+					field:  "Tags",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Version != nil {
+		// no validation rules for Version
+	}
+
+	if len(errors) > 0 {
+		return PatchTransactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PatchTransactionRequestMultiError is an error wrapping multiple validation
+// errors returned by PatchTransactionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PatchTransactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PatchTransactionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PatchTransactionRequestMultiError) AllErrors() []error { return m }
+
+// PatchTransactionRequestValidationError is the validation error returned by
+// PatchTransactionRequest.Validate if the designated constraints aren't met.
+type PatchTransactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchTransactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchTransactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchTransactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchTransactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchTransactionRequestValidationError) ErrorName() string {
+	return "PatchTransactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchTransactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchTransactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchTransactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchTransactionRequestValidationError{}
+
+// Validate checks the field values on PatchTransactionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PatchTransactionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PatchTransactionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PatchTransactionResponseMultiError, or nil if none found.
+func (m *PatchTransactionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PatchTransactionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PatchTransactionResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PatchTransactionResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PatchTransactionResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PatchTransactionResponseValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PatchTransactionResponseValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PatchTransactionResponseValidationError{
+					field:  fmt.Sprintf("Warnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PatchTransactionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PatchTransactionResponseMultiError is an error wrapping multiple validation
+// errors returned by PatchTransactionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PatchTransactionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PatchTransactionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PatchTransactionResponseMultiError) AllErrors() []error { return m }
+
+// PatchTransactionResponseValidationError is the validation error returned by
+// PatchTransactionResponse.Validate if the designated constraints aren't met.
+type PatchTransactionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchTransactionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchTransactionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchTransactionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchTransactionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchTransactionResponseValidationError) ErrorName() string {
+	return "PatchTransactionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchTransactionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchTransactionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchTransactionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchTransactionResponseValidationError{}
+
+// Validate checks the field values on DeleteTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTransactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTransactionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTransactionRequestMultiError, or nil if none found.
+func (m *DeleteTransactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTransactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Version != nil {
+		// no validation rules for Version
+	}
+
+	if len(errors) > 0 {
+		return DeleteTransactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTransactionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteTransactionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTransactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTransactionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTransactionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTransactionRequestValidationError is the validation error returned by
+// DeleteTransactionRequest.Validate if the designated constraints aren't met.
+type DeleteTransactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTransactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTransactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTransactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTransactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTransactionRequestValidationError) ErrorName() string {
+	return "DeleteTransactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTransactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTransactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTransactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTransactionRequestValidationError{}
+
+// Validate checks the field values on DeleteTransactionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTransactionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTransactionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTransactionResponseMultiError, or nil if none found.
+func (m *DeleteTransactionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTransactionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteTransactionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTransactionResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteTransactionResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteTransactionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTransactionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTransactionResponseMultiError) AllErrors() []error { return m }
+
+// DeleteTransactionResponseValidationError is the validation error returned by
+// DeleteTransactionResponse.Validate if the designated constraints aren't met.
+type DeleteTransactionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTransactionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTransactionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTransactionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTransactionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTransactionResponseValidationError) ErrorName() string {
+	return "DeleteTransactionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTransactionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTransactionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTransactionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTransactionResponseValidationError{}
+
+// Validate checks the field values on ListBudgetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBudgetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBudgetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBudgetsRequestMultiError, or nil if none found.
+func (m *ListBudgetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBudgetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	// no validation rules for WithTotal
+
+	if m.FilterPeriodFrom != nil {
+
+		if all {
+			switch v := interface{}(m.GetFilterPeriodFrom()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBudgetsRequestValidationError{
+						field:  "FilterPeriodFrom",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBudgetsRequestValidationError{
+						field:  "FilterPeriodFrom",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFilterPeriodFrom()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBudgetsRequestValidationError{
+					field:  "FilterPeriodFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.FilterPeriodTo != nil {
+
+		if all {
+			switch v := interface{}(m.GetFilterPeriodTo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBudgetsRequestValidationError{
+						field:  "FilterPeriodTo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBudgetsRequestValidationError{
+						field:  "FilterPeriodTo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFilterPeriodTo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBudgetsRequestValidationError{
+					field:  "FilterPeriodTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
+	if len(errors) > 0 {
+		return ListBudgetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBudgetsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListBudgetsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListBudgetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBudgetsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBudgetsRequestMultiError) AllErrors() []error { return m }
+
+// ListBudgetsRequestValidationError is the validation error returned by
+// ListBudgetsRequest.Validate if the designated constraints aren't met.
+type ListBudgetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBudgetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBudgetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBudgetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBudgetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBudgetsRequestValidationError) ErrorName() string {
+	return "ListBudgetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBudgetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBudgetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBudgetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBudgetsRequestValidationError{}
+
+// Validate checks the field values on ListBudgetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBudgetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBudgetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBudgetsResponseMultiError, or nil if none found.
+func (m *ListBudgetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBudgetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBudgetsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBudgetsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBudgetsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for HitCache
+
+	// no validation rules for NextCursor
+
+	if m.Total != nil {
+		// no validation rules for Total
+	}
+
+	if len(errors) > 0 {
+		return ListBudgetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBudgetsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBudgetsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListBudgetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBudgetsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBudgetsResponseMultiError) AllErrors() []error { return m }
+
+// ListBudgetsResponseValidationError is the validation error returned by
+// ListBudgetsResponse.Validate if the designated constraints aren't met.
+type ListBudgetsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBudgetsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBudgetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBudgetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBudgetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBudgetsResponseValidationError) ErrorName() string {
+	return "ListBudgetsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBudgetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBudgetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBudgetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBudgetsResponseValidationError{}
+
+// Validate checks the field values on GetBudgetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBudgetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBudgetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBudgetRequestMultiError, or nil if none found.
+func (m *GetBudgetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBudgetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetBudgetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBudgetRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBudgetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBudgetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBudgetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBudgetRequestMultiError) AllErrors() []error { return m }
+
+// GetBudgetRequestValidationError is the validation error returned by
+// GetBudgetRequest.Validate if the designated constraints aren't met.
+type GetBudgetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBudgetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBudgetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBudgetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBudgetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBudgetRequestValidationError) ErrorName() string { return "GetBudgetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetBudgetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBudgetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBudgetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBudgetRequestValidationError{}
+
+// Validate checks the field values on GetBudgetResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBudgetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBudgetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBudgetResponseMultiError, or nil if none found.
+func (m *GetBudgetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBudgetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBudgetResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBudgetResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBudgetResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for HitCache
+
+	if len(errors) > 0 {
+		return GetBudgetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBudgetResponseMultiError is an error wrapping multiple validation errors
+// returned by GetBudgetResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetBudgetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBudgetResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBudgetResponseMultiError) AllErrors() []error { return m }
+
+// GetBudgetResponseValidationError is the validation error returned by
+// GetBudgetResponse.Validate if the designated constraints aren't met.
+type GetBudgetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBudgetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBudgetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBudgetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBudgetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBudgetResponseValidationError) ErrorName() string {
+	return "GetBudgetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBudgetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBudgetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBudgetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBudgetResponseValidationError{}
+
+// Validate checks the field values on AddBudgetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddBudgetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddBudgetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddBudgetRequestMultiError, or nil if none found.
+func (m *AddBudgetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddBudgetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPeriod()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddBudgetRequestValidationError{
+					field:  "Period",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddBudgetRequestValidationError{
+					field:  "Period",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriod()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddBudgetRequestValidationError{
+				field:  "Period",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CategoryId
+
+	// no validation rules for Amount
+
+	// no validation rules for CarryOver
+
+	if m.Currency != nil {
+		// no validation rules for Currency
+	}
+
+	if m.Enforcement != nil {
+		// no validation rules for Enforcement
+	}
+
+	if len(errors) > 0 {
+		return AddBudgetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddBudgetRequestMultiError is an error wrapping multiple validation errors
+// returned by AddBudgetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddBudgetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddBudgetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddBudgetRequestMultiError) AllErrors() []error { return m }
+
+// AddBudgetRequestValidationError is the validation error returned by
+// AddBudgetRequest.Validate if the designated constraints aren't met.
+type AddBudgetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddBudgetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddBudgetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddBudgetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddBudgetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddBudgetRequestValidationError) ErrorName() string { return "AddBudgetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddBudgetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddBudgetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddBudgetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddBudgetRequestValidationError{}
+
+// Validate checks the field values on AddBudgetResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddBudgetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddBudgetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddBudgetResponseMultiError, or nil if none found.
+func (m *AddBudgetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddBudgetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddBudgetResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddBudgetResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddBudgetResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddBudgetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddBudgetResponseMultiError is an error wrapping multiple validation errors
+// returned by AddBudgetResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AddBudgetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddBudgetResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddBudgetResponseMultiError) AllErrors() []error { return m }
+
+// AddBudgetResponseValidationError is the validation error returned by
+// AddBudgetResponse.Validate if the designated constraints aren't met.
+type AddBudgetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddBudgetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddBudgetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddBudgetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddBudgetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddBudgetResponseValidationError) ErrorName() string {
+	return "AddBudgetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddBudgetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddBudgetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddBudgetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddBudgetResponseValidationError{}
+
+// Validate checks the field values on PatchBudgetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PatchBudgetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PatchBudgetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PatchBudgetRequestMultiError, or nil if none found.
+func (m *PatchBudgetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PatchBudgetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Period != nil {
+
+		if all {
+			switch v := interface{}(m.GetPeriod()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PatchBudgetRequestValidationError{
+						field:  "Period",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PatchBudgetRequestValidationError{
+						field:  "Period",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPeriod()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PatchBudgetRequestValidationError{
+					field:  "Period",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CategoryId != nil {
+		// no validation rules for CategoryId
+	}
+
+	if m.Amount != nil {
+		// no validation rules for Amount
+	}
+
+	if m.Currency != nil {
+		// no validation rules for Currency
+	}
+
+	if m.CarryOver != nil {
+		// no validation rules for CarryOver
+	}
+
+	if m.Enforcement != nil {
+		// no validation rules for Enforcement
+	}
+
+	if m.WarningThresholds != nil {
+
+		if all {
+			switch v := interface{}(m.GetWarningThresholds()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PatchBudgetRequestValidationError{
+						field:  "WarningThresholds",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PatchBudgetRequestValidationError{
+						field:  "WarningThresholds",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetWarningThresholds()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PatchBudgetRequestValidationError{
+					field:  "WarningThresholds",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Version != nil {
+		// no validation rules for Version
+	}
+
+	if len(errors) > 0 {
+		return PatchBudgetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PatchBudgetRequestMultiError is an error wrapping multiple validation errors
+// returned by PatchBudgetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PatchBudgetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PatchBudgetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PatchBudgetRequestMultiError) AllErrors() []error { return m }
+
+// PatchBudgetRequestValidationError is the validation error returned by
+// PatchBudgetRequest.Validate if the designated constraints aren't met.
+type PatchBudgetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchBudgetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchBudgetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchBudgetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchBudgetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchBudgetRequestValidationError) ErrorName() string {
+	return "PatchBudgetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchBudgetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchBudgetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchBudgetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchBudgetRequestValidationError{}
+
+// Validate checks the field values on PatchBudgetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PatchBudgetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PatchBudgetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PatchBudgetResponseMultiError, or nil if none found.
+func (m *PatchBudgetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PatchBudgetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PatchBudgetResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PatchBudgetResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PatchBudgetResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PatchBudgetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PatchBudgetResponseMultiError is an error wrapping multiple validation
+// errors returned by PatchBudgetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PatchBudgetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PatchBudgetResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PatchBudgetResponseMultiError) AllErrors() []error { return m }
+
+// PatchBudgetResponseValidationError is the validation error returned by
+// PatchBudgetResponse.Validate if the designated constraints aren't met.
+type PatchBudgetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchBudgetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchBudgetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchBudgetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchBudgetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchBudgetResponseValidationError) ErrorName() string {
+	return "PatchBudgetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchBudgetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchBudgetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchBudgetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchBudgetResponseValidationError{}
+
+// Validate checks the field values on DeleteBudgetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBudgetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBudgetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBudgetRequestMultiError, or nil if none found.
+func (m *DeleteBudgetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBudgetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Version != nil {
+		// no validation rules for Version
+	}
+
+	if len(errors) > 0 {
+		return DeleteBudgetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBudgetRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteBudgetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBudgetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBudgetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBudgetRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBudgetRequestValidationError is the validation error returned by
+// DeleteBudgetRequest.Validate if the designated constraints aren't met.
+type DeleteBudgetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBudgetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBudgetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBudgetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBudgetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBudgetRequestValidationError) ErrorName() string {
+	return "DeleteBudgetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBudgetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBudgetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBudgetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBudgetRequestValidationError{}
+
+// Validate checks the field values on DeleteBudgetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBudgetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBudgetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBudgetResponseMultiError, or nil if none found.
+func (m *DeleteBudgetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBudgetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteBudgetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBudgetResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteBudgetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBudgetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBudgetResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBudgetResponseMultiError) AllErrors() []error { return m }
+
+// DeleteBudgetResponseValidationError is the validation error returned by
+// DeleteBudgetResponse.Validate if the designated constraints aren't met.
+type DeleteBudgetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBudgetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBudgetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBudgetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBudgetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBudgetResponseValidationError) ErrorName() string {
+	return "DeleteBudgetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBudgetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBudgetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBudgetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBudgetResponseValidationError{}
+
+// Validate checks the field values on CopyBudgetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CopyBudgetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CopyBudgetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CopyBudgetsRequestMultiError, or nil if none found.
+func (m *CopyBudgetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CopyBudgetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSourcePeriod()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CopyBudgetsRequestValidationError{
+					field:  "SourcePeriod",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CopyBudgetsRequestValidationError{
+					field:  "SourcePeriod",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSourcePeriod()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CopyBudgetsRequestValidationError{
+				field:  "SourcePeriod",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTargetPeriodFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CopyBudgetsRequestValidationError{
+					field:  "TargetPeriodFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CopyBudgetsRequestValidationError{
+					field:  "TargetPeriodFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTargetPeriodFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CopyBudgetsRequestValidationError{
+				field:  "TargetPeriodFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTargetPeriodTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CopyBudgetsRequestValidationError{
+					field:  "TargetPeriodTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CopyBudgetsRequestValidationError{
+					field:  "TargetPeriodTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTargetPeriodTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CopyBudgetsRequestValidationError{
+				field:  "TargetPeriodTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Mode
+
+	if len(errors) > 0 {
+		return CopyBudgetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CopyBudgetsRequestMultiError is an error wrapping multiple validation errors
+// returned by CopyBudgetsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CopyBudgetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CopyBudgetsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CopyBudgetsRequestMultiError) AllErrors() []error { return m }
+
+// CopyBudgetsRequestValidationError is the validation error returned by
+// CopyBudgetsRequest.Validate if the designated constraints aren't met.
+type CopyBudgetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CopyBudgetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CopyBudgetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CopyBudgetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CopyBudgetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CopyBudgetsRequestValidationError) ErrorName() string {
+	return "CopyBudgetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CopyBudgetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCopyBudgetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CopyBudgetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CopyBudgetsRequestValidationError{}
+
+// Validate checks the field values on CopyBudgetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CopyBudgetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CopyBudgetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CopyBudgetsResponseMultiError, or nil if none found.
+func (m *CopyBudgetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CopyBudgetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CopyBudgetsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CopyBudgetsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CopyBudgetsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Created
+
+	// no validation rules for Overwritten
+
+	// no validation rules for Skipped
+
+	if len(errors) > 0 {
+		return CopyBudgetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CopyBudgetsResponseMultiError is an error wrapping multiple validation
+// errors returned by CopyBudgetsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CopyBudgetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CopyBudgetsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CopyBudgetsResponseMultiError) AllErrors() []error { return m }
+
+// CopyBudgetsResponseValidationError is the validation error returned by
+// CopyBudgetsResponse.Validate if the designated constraints aren't met.
+type CopyBudgetsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CopyBudgetsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CopyBudgetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CopyBudgetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CopyBudgetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CopyBudgetsResponseValidationError) ErrorName() string {
+	return "CopyBudgetsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CopyBudgetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCopyBudgetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CopyBudgetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CopyBudgetsResponseValidationError{}
+
+// Validate checks the field values on GetBudgetAutoRolloverRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBudgetAutoRolloverRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBudgetAutoRolloverRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBudgetAutoRolloverRequestMultiError, or nil if none found.
+func (m *GetBudgetAutoRolloverRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBudgetAutoRolloverRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetBudgetAutoRolloverRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBudgetAutoRolloverRequestMultiError is an error wrapping multiple
+// validation errors returned by GetBudgetAutoRolloverRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetBudgetAutoRolloverRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBudgetAutoRolloverRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBudgetAutoRolloverRequestMultiError) AllErrors() []error { return m }
+
+// GetBudgetAutoRolloverRequestValidationError is the validation error returned
+// by GetBudgetAutoRolloverRequest.Validate if the designated constraints
+// aren't met.
+type GetBudgetAutoRolloverRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBudgetAutoRolloverRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBudgetAutoRolloverRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBudgetAutoRolloverRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBudgetAutoRolloverRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBudgetAutoRolloverRequestValidationError) ErrorName() string {
+	return "GetBudgetAutoRolloverRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBudgetAutoRolloverRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBudgetAutoRolloverRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBudgetAutoRolloverRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBudgetAutoRolloverRequestValidationError{}
+
+// Validate checks the field values on GetBudgetAutoRolloverResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBudgetAutoRolloverResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBudgetAutoRolloverResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetBudgetAutoRolloverResponseMultiError, or nil if none found.
+func (m *GetBudgetAutoRolloverResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBudgetAutoRolloverResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return GetBudgetAutoRolloverResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBudgetAutoRolloverResponseMultiError is an error wrapping multiple
+// validation errors returned by GetBudgetAutoRolloverResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetBudgetAutoRolloverResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBudgetAutoRolloverResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBudgetAutoRolloverResponseMultiError) AllErrors() []error { return m }
+
+// GetBudgetAutoRolloverResponseValidationError is the validation error
+// returned by GetBudgetAutoRolloverResponse.Validate if the designated
+// constraints aren't met.
+type GetBudgetAutoRolloverResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBudgetAutoRolloverResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBudgetAutoRolloverResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBudgetAutoRolloverResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBudgetAutoRolloverResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBudgetAutoRolloverResponseValidationError) ErrorName() string {
+	return "GetBudgetAutoRolloverResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBudgetAutoRolloverResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBudgetAutoRolloverResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBudgetAutoRolloverResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBudgetAutoRolloverResponseValidationError{}
+
+// Validate checks the field values on SetBudgetAutoRolloverRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetBudgetAutoRolloverRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetBudgetAutoRolloverRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetBudgetAutoRolloverRequestMultiError, or nil if none found.
+func (m *SetBudgetAutoRolloverRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetBudgetAutoRolloverRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return SetBudgetAutoRolloverRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetBudgetAutoRolloverRequestMultiError is an error wrapping multiple
+// validation errors returned by SetBudgetAutoRolloverRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetBudgetAutoRolloverRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetBudgetAutoRolloverRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetBudgetAutoRolloverRequestMultiError) AllErrors() []error { return m }
+
+// SetBudgetAutoRolloverRequestValidationError is the validation error returned
+// by SetBudgetAutoRolloverRequest.Validate if the designated constraints
+// aren't met.
+type SetBudgetAutoRolloverRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetBudgetAutoRolloverRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetBudgetAutoRolloverRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetBudgetAutoRolloverRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetBudgetAutoRolloverRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetBudgetAutoRolloverRequestValidationError) ErrorName() string {
+	return "SetBudgetAutoRolloverRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetBudgetAutoRolloverRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetBudgetAutoRolloverRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetBudgetAutoRolloverRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetBudgetAutoRolloverRequestValidationError{}
+
+// Validate checks the field values on SetBudgetAutoRolloverResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetBudgetAutoRolloverResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetBudgetAutoRolloverResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetBudgetAutoRolloverResponseMultiError, or nil if none found.
+func (m *SetBudgetAutoRolloverResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetBudgetAutoRolloverResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return SetBudgetAutoRolloverResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetBudgetAutoRolloverResponseMultiError is an error wrapping multiple
+// validation errors returned by SetBudgetAutoRolloverResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SetBudgetAutoRolloverResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetBudgetAutoRolloverResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetBudgetAutoRolloverResponseMultiError) AllErrors() []error { return m }
+
+// SetBudgetAutoRolloverResponseValidationError is the validation error
+// returned by SetBudgetAutoRolloverResponse.Validate if the designated
+// constraints aren't met.
+type SetBudgetAutoRolloverResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetBudgetAutoRolloverResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetBudgetAutoRolloverResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetBudgetAutoRolloverResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetBudgetAutoRolloverResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetBudgetAutoRolloverResponseValidationError) ErrorName() string {
+	return "SetBudgetAutoRolloverResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetBudgetAutoRolloverResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetBudgetAutoRolloverResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetBudgetAutoRolloverResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetBudgetAutoRolloverResponseValidationError{}
+
+// Validate checks the field values on ListReportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReportsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReportsRequestMultiError, or nil if none found.
+func (m *ListReportsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReportsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDateFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListReportsRequestValidationError{
+					field:  "DateFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListReportsRequestValidationError{
+					field:  "DateFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDateFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListReportsRequestValidationError{
+				field:  "DateFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDateTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListReportsRequestValidationError{
+					field:  "DateTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListReportsRequestValidationError{
+					field:  "DateTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDateTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListReportsRequestValidationError{
+				field:  "DateTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Currency != nil {
+		// no validation rules for Currency
+	}
+
+	if len(errors) > 0 {
+		return ListReportsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReportsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListReportsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListReportsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReportsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReportsRequestMultiError) AllErrors() []error { return m }
+
+// ListReportsRequestValidationError is the validation error returned by
+// ListReportsRequest.Validate if the designated constraints aren't met.
+type ListReportsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportsRequestValidationError) ErrorName() string {
+	return "ListReportsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportsRequestValidationError{}
+
+// Validate checks the field values on ListReportsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReportsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReportsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReportsResponseMultiError, or nil if none found.
+func (m *ListReportsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReportsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReports() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReportsResponseValidationError{
+						field:  fmt.Sprintf("Reports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReportsResponseValidationError{
+						field:  fmt.Sprintf("Reports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReportsResponseValidationError{
+					field:  fmt.Sprintf("Reports[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for HitCache
+
+	// no validation rules for Currency
+
+	if len(errors) > 0 {
+		return ListReportsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReportsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListReportsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListReportsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReportsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReportsResponseMultiError) AllErrors() []error { return m }
+
+// ListReportsResponseValidationError is the validation error returned by
+// ListReportsResponse.Validate if the designated constraints aren't met.
+type ListReportsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportsResponseValidationError) ErrorName() string {
+	return "ListReportsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportsResponseValidationError{}
+
+// Validate checks the field values on CSVExportTransactionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CSVExportTransactionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CSVExportTransactionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CSVExportTransactionsResponseMultiError, or nil if none found.
+func (m *CSVExportTransactionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CSVExportTransactionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	// no validation rules for Total
+
+	// no validation rules for HitCache
+
+	if len(errors) > 0 {
+		return CSVExportTransactionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CSVExportTransactionsResponseMultiError is an error wrapping multiple
+// validation errors returned by CSVExportTransactionsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CSVExportTransactionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CSVExportTransactionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CSVExportTransactionsResponseMultiError) AllErrors() []error { return m }
+
+// CSVExportTransactionsResponseValidationError is the validation error
+// returned by CSVExportTransactionsResponse.Validate if the designated
+// constraints aren't met.
+type CSVExportTransactionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CSVExportTransactionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CSVExportTransactionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CSVExportTransactionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CSVExportTransactionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CSVExportTransactionsResponseValidationError) ErrorName() string {
+	return "CSVExportTransactionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CSVExportTransactionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCSVExportTransactionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CSVExportTransactionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CSVExportTransactionsResponseValidationError{}
+
+// Validate checks the field values on StreamExportTransactionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamExportTransactionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamExportTransactionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StreamExportTransactionsRequestMultiError, or nil if none found.
+func (m *StreamExportTransactionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamExportTransactionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BatchSize
+
+	if m.FilterOccurredOnFrom != nil {
+
+		if all {
+			switch v := interface{}(m.GetFilterOccurredOnFrom()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StreamExportTransactionsRequestValidationError{
+						field:  "FilterOccurredOnFrom",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StreamExportTransactionsRequestValidationError{
+						field:  "FilterOccurredOnFrom",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFilterOccurredOnFrom()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StreamExportTransactionsRequestValidationError{
+					field:  "FilterOccurredOnFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.FilterOccurredOnTo != nil {
+
+		if all {
+			switch v := interface{}(m.GetFilterOccurredOnTo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StreamExportTransactionsRequestValidationError{
+						field:  "FilterOccurredOnTo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StreamExportTransactionsRequestValidationError{
+						field:  "FilterOccurredOnTo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFilterOccurredOnTo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StreamExportTransactionsRequestValidationError{
+					field:  "FilterOccurredOnTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.FilterWalletId != nil {
+		// no validation rules for FilterWalletId
+	}
+
+	if len(errors) > 0 {
+		return StreamExportTransactionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamExportTransactionsRequestMultiError is an error wrapping multiple
+// validation errors returned by StreamExportTransactionsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type StreamExportTransactionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamExportTransactionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamExportTransactionsRequestMultiError) AllErrors() []error { return m }
+
+// StreamExportTransactionsRequestValidationError is the validation error
+// returned by StreamExportTransactionsRequest.Validate if the designated
+// constraints aren't met.
+type StreamExportTransactionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamExportTransactionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamExportTransactionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamExportTransactionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamExportTransactionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamExportTransactionsRequestValidationError) ErrorName() string {
+	return "StreamExportTransactionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamExportTransactionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamExportTransactionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamExportTransactionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamExportTransactionsRequestValidationError{}
+
+// Validate checks the field values on StreamExportTransactionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *StreamExportTransactionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamExportTransactionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StreamExportTransactionsResponseMultiError, or nil if none found.
+func (m *StreamExportTransactionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamExportTransactionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Chunk
+
+	if len(errors) > 0 {
+		return StreamExportTransactionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamExportTransactionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// StreamExportTransactionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StreamExportTransactionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamExportTransactionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamExportTransactionsResponseMultiError) AllErrors() []error { return m }
+
+// StreamExportTransactionsResponseValidationError is the validation error
+// returned by StreamExportTransactionsResponse.Validate if the designated
+// constraints aren't met.
+type StreamExportTransactionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamExportTransactionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamExportTransactionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamExportTransactionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamExportTransactionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamExportTransactionsResponseValidationError) ErrorName() string {
+	return "StreamExportTransactionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamExportTransactionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamExportTransactionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamExportTransactionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamExportTransactionsResponseValidationError{}
+
+// Validate checks the field values on ExportTransactionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportTransactionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportTransactionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportTransactionsRequestMultiError, or nil if none found.
+func (m *ExportTransactionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportTransactionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Format
+
+	if m.FilterOccurredOnFrom != nil {
+
+		if all {
+			switch v := interface{}(m.GetFilterOccurredOnFrom()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportTransactionsRequestValidationError{
+						field:  "FilterOccurredOnFrom",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportTransactionsRequestValidationError{
+						field:  "FilterOccurredOnFrom",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFilterOccurredOnFrom()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportTransactionsRequestValidationError{
+					field:  "FilterOccurredOnFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.FilterOccurredOnTo != nil {
+
+		if all {
+			switch v := interface{}(m.GetFilterOccurredOnTo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportTransactionsRequestValidationError{
+						field:  "FilterOccurredOnTo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportTransactionsRequestValidationError{
+						field:  "FilterOccurredOnTo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFilterOccurredOnTo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportTransactionsRequestValidationError{
+					field:  "FilterOccurredOnTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.FilterWalletId != nil {
+		// no validation rules for FilterWalletId
+	}
+
+	if len(errors) > 0 {
+		return ExportTransactionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportTransactionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportTransactionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ExportTransactionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportTransactionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportTransactionsRequestMultiError) AllErrors() []error { return m }
+
+// ExportTransactionsRequestValidationError is the validation error returned by
+// ExportTransactionsRequest.Validate if the designated constraints aren't met.
+type ExportTransactionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportTransactionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportTransactionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportTransactionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportTransactionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportTransactionsRequestValidationError) ErrorName() string {
+	return "ExportTransactionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportTransactionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportTransactionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportTransactionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportTransactionsRequestValidationError{}
+
+// Validate checks the field values on ExportTransactionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportTransactionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportTransactionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportTransactionsResponseMultiError, or nil if none found.
+func (m *ExportTransactionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportTransactionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	// no validation rules for ContentType
+
+	// no validation rules for FileExtension
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ExportTransactionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportTransactionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ExportTransactionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ExportTransactionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportTransactionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportTransactionsResponseMultiError) AllErrors() []error { return m }
+
+// ExportTransactionsResponseValidationError is the validation error returned
+// by ExportTransactionsResponse.Validate if the designated constraints aren't met.
+type ExportTransactionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportTransactionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportTransactionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportTransactionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportTransactionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportTransactionsResponseValidationError) ErrorName() string {
+	return "ExportTransactionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportTransactionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportTransactionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportTransactionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportTransactionsResponseValidationError{}
+
+// Validate checks the field values on ExportReportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportReportsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportReportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportReportsRequestMultiError, or nil if none found.
+func (m *ExportReportsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportReportsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Format
+
+	if all {
+		switch v := interface{}(m.GetDateFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportReportsRequestValidationError{
+					field:  "DateFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportReportsRequestValidationError{
+					field:  "DateFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDateFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportReportsRequestValidationError{
+				field:  "DateFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDateTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportReportsRequestValidationError{
+					field:  "DateTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportReportsRequestValidationError{
+					field:  "DateTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDateTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportReportsRequestValidationError{
+				field:  "DateTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Currency != nil {
+		// no validation rules for Currency
+	}
+
+	if len(errors) > 0 {
+		return ExportReportsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportReportsRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportReportsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportReportsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportReportsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportReportsRequestMultiError) AllErrors() []error { return m }
+
+// ExportReportsRequestValidationError is the validation error returned by
+// ExportReportsRequest.Validate if the designated constraints aren't met.
+type ExportReportsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportReportsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportReportsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportReportsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportReportsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportReportsRequestValidationError) ErrorName() string {
+	return "ExportReportsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportReportsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportReportsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportReportsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportReportsRequestValidationError{}
+
+// Validate checks the field values on ExportReportsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportReportsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportReportsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportReportsResponseMultiError, or nil if none found.
+func (m *ExportReportsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportReportsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	// no validation rules for ContentType
+
+	// no validation rules for FileExtension
+
+	if len(errors) > 0 {
+		return ExportReportsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportReportsResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportReportsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportReportsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportReportsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportReportsResponseMultiError) AllErrors() []error { return m }
+
+// ExportReportsResponseValidationError is the validation error returned by
+// ExportReportsResponse.Validate if the designated constraints aren't met.
+type ExportReportsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportReportsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportReportsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportReportsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportReportsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportReportsResponseValidationError) ErrorName() string {
+	return "ExportReportsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportReportsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportReportsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportReportsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportReportsResponseValidationError{}
+
+// Validate checks the field values on CSVImportTransactionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CSVImportTransactionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CSVImportTransactionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CSVImportTransactionsRequestMultiError, or nil if none found.
+func (m *CSVImportTransactionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CSVImportTransactionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	// no validation rules for DryRun
+
+	// no validation rules for Mode
+
+	// no validation rules for DuplicatePolicy
+
+	// no validation rules for MatchToleranceDays
+
+	// no validation rules for Format
+
+	if m.ImportProfileId != nil {
+		// no validation rules for ImportProfileId
+	}
+
+	if m.DefaultCategoryId != nil {
+		// no validation rules for DefaultCategoryId
+	}
+
+	if len(errors) > 0 {
+		return CSVImportTransactionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CSVImportTransactionsRequestMultiError is an error wrapping multiple
+// validation errors returned by CSVImportTransactionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CSVImportTransactionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CSVImportTransactionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CSVImportTransactionsRequestMultiError) AllErrors() []error { return m }
+
+// CSVImportTransactionsRequestValidationError is the validation error returned
+// by CSVImportTransactionsRequest.Validate if the designated constraints
+// aren't met.
+type CSVImportTransactionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CSVImportTransactionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CSVImportTransactionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CSVImportTransactionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CSVImportTransactionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CSVImportTransactionsRequestValidationError) ErrorName() string {
+	return "CSVImportTransactionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CSVImportTransactionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCSVImportTransactionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CSVImportTransactionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CSVImportTransactionsRequestValidationError{}
+
+// Validate checks the field values on CSVImportRow with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CSVImportRow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CSVImportRow with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CSVImportRowMultiError, or
+// nil if none found.
+func (m *CSVImportRow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CSVImportRow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Line
+
+	// no validation rules for Status
+
+	if m.IsIncome != nil {
+		// no validation rules for IsIncome
+	}
+
+	if m.Amount != nil {
+		// no validation rules for Amount
+	}
+
+	if m.Currency != nil {
+		// no validation rules for Currency
+	}
+
+	if m.OccurredOn != nil {
+
+		if all {
+			switch v := interface{}(m.GetOccurredOn()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CSVImportRowValidationError{
+						field:  "OccurredOn",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CSVImportRowValidationError{
+						field:  "OccurredOn",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOccurredOn()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CSVImportRowValidationError{
+					field:  "OccurredOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CategoryId != nil {
+		// no validation rules for CategoryId
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if m.ExternalId != nil {
+		// no validation rules for ExternalId
+	}
+
+	if m.MatchedTransactionId != nil {
+		// no validation rules for MatchedTransactionId
+	}
+
+	if len(errors) > 0 {
+		return CSVImportRowMultiError(errors)
+	}
+
+	return nil
+}
+
+// CSVImportRowMultiError is an error wrapping multiple validation errors
+// returned by CSVImportRow.ValidateAll() if the designated constraints aren't met.
+type CSVImportRowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CSVImportRowMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CSVImportRowMultiError) AllErrors() []error { return m }
+
+// CSVImportRowValidationError is the validation error returned by
+// CSVImportRow.Validate if the designated constraints aren't met.
+type CSVImportRowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CSVImportRowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CSVImportRowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CSVImportRowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CSVImportRowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CSVImportRowValidationError) ErrorName() string { return "CSVImportRowValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CSVImportRowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCSVImportRow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CSVImportRowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CSVImportRowValidationError{}
+
+// Validate checks the field values on CSVImportTransactionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CSVImportTransactionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CSVImportTransactionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CSVImportTransactionsResponseMultiError, or nil if none found.
+func (m *CSVImportTransactionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CSVImportTransactionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CSVImportTransactionsResponseValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CSVImportTransactionsResponseValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CSVImportTransactionsResponseValidationError{
+					field:  fmt.Sprintf("Rows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Imported
+
+	// no validation rules for Rejected
+
+	// no validation rules for Skipped
+
+	// no validation rules for DryRun
+
+	// no validation rules for Updated
+
+	// no validation rules for Flagged
+
+	if len(errors) > 0 {
+		return CSVImportTransactionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CSVImportTransactionsResponseMultiError is an error wrapping multiple
+// validation errors returned by CSVImportTransactionsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CSVImportTransactionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CSVImportTransactionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CSVImportTransactionsResponseMultiError) AllErrors() []error { return m }
+
+// CSVImportTransactionsResponseValidationError is the validation error
+// returned by CSVImportTransactionsResponse.Validate if the designated
+// constraints aren't met.
+type CSVImportTransactionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CSVImportTransactionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CSVImportTransactionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CSVImportTransactionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CSVImportTransactionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CSVImportTransactionsResponseValidationError) ErrorName() string {
+	return "CSVImportTransactionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CSVImportTransactionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCSVImportTransactionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CSVImportTransactionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CSVImportTransactionsResponseValidationError{}
+
+// Validate checks the field values on ListWalletsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWalletsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWalletsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWalletsRequestMultiError, or nil if none found.
+func (m *ListWalletsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWalletsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.FilterIsArchived != nil {
+		// no validation rules for FilterIsArchived
+	}
+
+	if len(errors) > 0 {
+		return ListWalletsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWalletsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListWalletsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListWalletsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWalletsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWalletsRequestMultiError) AllErrors() []error { return m }
+
+// ListWalletsRequestValidationError is the validation error returned by
+// ListWalletsRequest.Validate if the designated constraints aren't met.
+type ListWalletsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWalletsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWalletsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWalletsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWalletsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWalletsRequestValidationError) ErrorName() string {
+	return "ListWalletsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWalletsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWalletsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWalletsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWalletsRequestValidationError{}
+
+// Validate checks the field values on ListWalletsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWalletsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWalletsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWalletsResponseMultiError, or nil if none found.
+func (m *ListWalletsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWalletsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWalletsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWalletsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWalletsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListWalletsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWalletsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListWalletsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListWalletsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWalletsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWalletsResponseMultiError) AllErrors() []error { return m }
+
+// ListWalletsResponseValidationError is the validation error returned by
+// ListWalletsResponse.Validate if the designated constraints aren't met.
+type ListWalletsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWalletsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWalletsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWalletsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWalletsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWalletsResponseValidationError) ErrorName() string {
+	return "ListWalletsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWalletsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWalletsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWalletsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWalletsResponseValidationError{}
+
+// Validate checks the field values on GetWalletRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetWalletRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWalletRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWalletRequestMultiError, or nil if none found.
+func (m *GetWalletRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWalletRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetWalletRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWalletRequestMultiError is an error wrapping multiple validation errors
+// returned by GetWalletRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetWalletRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWalletRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWalletRequestMultiError) AllErrors() []error { return m }
+
+// GetWalletRequestValidationError is the validation error returned by
+// GetWalletRequest.Validate if the designated constraints aren't met.
+type GetWalletRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWalletRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWalletRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWalletRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWalletRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWalletRequestValidationError) ErrorName() string { return "GetWalletRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetWalletRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWalletRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWalletRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWalletRequestValidationError{}
+
+// Validate checks the field values on GetWalletResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetWalletResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWalletResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWalletResponseMultiError, or nil if none found.
+func (m *GetWalletResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWalletResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetWalletResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetWalletResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetWalletResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetWalletResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWalletResponseMultiError is an error wrapping multiple validation errors
+// returned by GetWalletResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetWalletResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWalletResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWalletResponseMultiError) AllErrors() []error { return m }
+
+// GetWalletResponseValidationError is the validation error returned by
+// GetWalletResponse.Validate if the designated constraints aren't met.
+type GetWalletResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWalletResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWalletResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWalletResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWalletResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWalletResponseValidationError) ErrorName() string {
+	return "GetWalletResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWalletResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWalletResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWalletResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWalletResponseValidationError{}
+
+// Validate checks the field values on AddWalletRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddWalletRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddWalletRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddWalletRequestMultiError, or nil if none found.
+func (m *AddWalletRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddWalletRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Currency
+
+	// no validation rules for OpeningBalance
+
+	if len(errors) > 0 {
+		return AddWalletRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddWalletRequestMultiError is an error wrapping multiple validation errors
+// returned by AddWalletRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddWalletRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddWalletRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddWalletRequestMultiError) AllErrors() []error { return m }
+
+// AddWalletRequestValidationError is the validation error returned by
+// AddWalletRequest.Validate if the designated constraints aren't met.
+type AddWalletRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddWalletRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddWalletRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddWalletRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddWalletRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddWalletRequestValidationError) ErrorName() string { return "AddWalletRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddWalletRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddWalletRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddWalletRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddWalletRequestValidationError{}
+
+// Validate checks the field values on AddWalletResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddWalletResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddWalletResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddWalletResponseMultiError, or nil if none found.
+func (m *AddWalletResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddWalletResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddWalletResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddWalletResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddWalletResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddWalletResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddWalletResponseMultiError is an error wrapping multiple validation errors
+// returned by AddWalletResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AddWalletResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddWalletResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddWalletResponseMultiError) AllErrors() []error { return m }
+
+// AddWalletResponseValidationError is the validation error returned by
+// AddWalletResponse.Validate if the designated constraints aren't met.
+type AddWalletResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddWalletResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddWalletResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddWalletResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddWalletResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddWalletResponseValidationError) ErrorName() string {
+	return "AddWalletResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddWalletResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddWalletResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddWalletResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddWalletResponseValidationError{}
+
+// Validate checks the field values on PatchWalletRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PatchWalletRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PatchWalletRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PatchWalletRequestMultiError, or nil if none found.
+func (m *PatchWalletRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PatchWalletRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Title != nil {
+		// no validation rules for Title
+	}
+
+	if m.OpeningBalance != nil {
+		// no validation rules for OpeningBalance
+	}
+
+	if m.IsArchived != nil {
+		// no validation rules for IsArchived
+	}
+
+	if len(errors) > 0 {
+		return PatchWalletRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PatchWalletRequestMultiError is an error wrapping multiple validation errors
+// returned by PatchWalletRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PatchWalletRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PatchWalletRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PatchWalletRequestMultiError) AllErrors() []error { return m }
+
+// PatchWalletRequestValidationError is the validation error returned by
+// PatchWalletRequest.Validate if the designated constraints aren't met.
+type PatchWalletRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchWalletRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchWalletRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchWalletRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchWalletRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchWalletRequestValidationError) ErrorName() string {
+	return "PatchWalletRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchWalletRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchWalletRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchWalletRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchWalletRequestValidationError{}
+
+// Validate checks the field values on PatchWalletResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PatchWalletResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PatchWalletResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PatchWalletResponseMultiError, or nil if none found.
+func (m *PatchWalletResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PatchWalletResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PatchWalletResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PatchWalletResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PatchWalletResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PatchWalletResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PatchWalletResponseMultiError is an error wrapping multiple validation
+// errors returned by PatchWalletResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PatchWalletResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PatchWalletResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PatchWalletResponseMultiError) AllErrors() []error { return m }
+
+// PatchWalletResponseValidationError is the validation error returned by
+// PatchWalletResponse.Validate if the designated constraints aren't met.
+type PatchWalletResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchWalletResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchWalletResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchWalletResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchWalletResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchWalletResponseValidationError) ErrorName() string {
+	return "PatchWalletResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchWalletResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchWalletResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchWalletResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchWalletResponseValidationError{}
+
+// Validate checks the field values on DeleteWalletRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWalletRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWalletRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWalletRequestMultiError, or nil if none found.
+func (m *DeleteWalletRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWalletRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteWalletRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWalletRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteWalletRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWalletRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWalletRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWalletRequestMultiError) AllErrors() []error { return m }
+
+// DeleteWalletRequestValidationError is the validation error returned by
+// DeleteWalletRequest.Validate if the designated constraints aren't met.
+type DeleteWalletRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWalletRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWalletRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWalletRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWalletRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWalletRequestValidationError) ErrorName() string {
+	return "DeleteWalletRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWalletRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWalletRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWalletRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWalletRequestValidationError{}
+
+// Validate checks the field values on DeleteWalletResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWalletResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWalletResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWalletResponseMultiError, or nil if none found.
+func (m *DeleteWalletResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWalletResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteWalletResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWalletResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteWalletResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWalletResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWalletResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWalletResponseMultiError) AllErrors() []error { return m }
+
+// DeleteWalletResponseValidationError is the validation error returned by
+// DeleteWalletResponse.Validate if the designated constraints aren't met.
+type DeleteWalletResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWalletResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWalletResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWalletResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWalletResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWalletResponseValidationError) ErrorName() string {
+	return "DeleteWalletResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWalletResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWalletResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWalletResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWalletResponseValidationError{}
+
+// Validate checks the field values on GetWalletBalancesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWalletBalancesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWalletBalancesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWalletBalancesRequestMultiError, or nil if none found.
+func (m *GetWalletBalancesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWalletBalancesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.DateTo != nil {
+
+		if all {
+			switch v := interface{}(m.GetDateTo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetWalletBalancesRequestValidationError{
+						field:  "DateTo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetWalletBalancesRequestValidationError{
+						field:  "DateTo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDateTo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetWalletBalancesRequestValidationError{
+					field:  "DateTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetWalletBalancesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWalletBalancesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetWalletBalancesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetWalletBalancesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWalletBalancesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWalletBalancesRequestMultiError) AllErrors() []error { return m }
+
+// GetWalletBalancesRequestValidationError is the validation error returned by
+// GetWalletBalancesRequest.Validate if the designated constraints aren't met.
+type GetWalletBalancesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWalletBalancesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWalletBalancesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWalletBalancesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWalletBalancesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWalletBalancesRequestValidationError) ErrorName() string {
+	return "GetWalletBalancesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWalletBalancesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWalletBalancesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWalletBalancesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWalletBalancesRequestValidationError{}
+
+// Validate checks the field values on GetWalletBalancesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWalletBalancesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWalletBalancesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWalletBalancesResponseMultiError, or nil if none found.
+func (m *GetWalletBalancesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWalletBalancesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetWalletBalancesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetWalletBalancesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetWalletBalancesResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetWalletBalancesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWalletBalancesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetWalletBalancesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetWalletBalancesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWalletBalancesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWalletBalancesResponseMultiError) AllErrors() []error { return m }
+
+// GetWalletBalancesResponseValidationError is the validation error returned by
+// GetWalletBalancesResponse.Validate if the designated constraints aren't met.
+type GetWalletBalancesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWalletBalancesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWalletBalancesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWalletBalancesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWalletBalancesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWalletBalancesResponseValidationError) ErrorName() string {
+	return "GetWalletBalancesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWalletBalancesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWalletBalancesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWalletBalancesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWalletBalancesResponseValidationError{}
+
+// Validate checks the field values on ListImportProfilesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListImportProfilesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListImportProfilesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListImportProfilesRequestMultiError, or nil if none found.
+func (m *ListImportProfilesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListImportProfilesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListImportProfilesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListImportProfilesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListImportProfilesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListImportProfilesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListImportProfilesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListImportProfilesRequestMultiError) AllErrors() []error { return m }
+
+// ListImportProfilesRequestValidationError is the validation error returned by
+// ListImportProfilesRequest.Validate if the designated constraints aren't met.
+type ListImportProfilesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListImportProfilesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListImportProfilesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListImportProfilesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListImportProfilesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListImportProfilesRequestValidationError) ErrorName() string {
+	return "ListImportProfilesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListImportProfilesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListImportProfilesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListImportProfilesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListImportProfilesRequestValidationError{}
+
+// Validate checks the field values on ListImportProfilesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListImportProfilesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListImportProfilesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListImportProfilesResponseMultiError, or nil if none found.
+func (m *ListImportProfilesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListImportProfilesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListImportProfilesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListImportProfilesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListImportProfilesResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListImportProfilesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListImportProfilesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListImportProfilesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListImportProfilesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListImportProfilesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListImportProfilesResponseMultiError) AllErrors() []error { return m }
+
+// ListImportProfilesResponseValidationError is the validation error returned
+// by ListImportProfilesResponse.Validate if the designated constraints aren't met.
+type ListImportProfilesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListImportProfilesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListImportProfilesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListImportProfilesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListImportProfilesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListImportProfilesResponseValidationError) ErrorName() string {
+	return "ListImportProfilesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListImportProfilesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListImportProfilesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListImportProfilesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListImportProfilesResponseValidationError{}
+
+// Validate checks the field values on GetImportProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetImportProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetImportProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetImportProfileRequestMultiError, or nil if none found.
+func (m *GetImportProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetImportProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetImportProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetImportProfileRequestMultiError is an error wrapping multiple validation
+// errors returned by GetImportProfileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetImportProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetImportProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetImportProfileRequestMultiError) AllErrors() []error { return m }
+
+// GetImportProfileRequestValidationError is the validation error returned by
+// GetImportProfileRequest.Validate if the designated constraints aren't met.
+type GetImportProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetImportProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetImportProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetImportProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetImportProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetImportProfileRequestValidationError) ErrorName() string {
+	return "GetImportProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetImportProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetImportProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetImportProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetImportProfileRequestValidationError{}
+
+// Validate checks the field values on GetImportProfileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetImportProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetImportProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetImportProfileResponseMultiError, or nil if none found.
+func (m *GetImportProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetImportProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetImportProfileResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetImportProfileResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetImportProfileResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetImportProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetImportProfileResponseMultiError is an error wrapping multiple validation
+// errors returned by GetImportProfileResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetImportProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetImportProfileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetImportProfileResponseMultiError) AllErrors() []error { return m }
+
+// GetImportProfileResponseValidationError is the validation error returned by
+// GetImportProfileResponse.Validate if the designated constraints aren't met.
+type GetImportProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetImportProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetImportProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetImportProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetImportProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetImportProfileResponseValidationError) ErrorName() string {
+	return "GetImportProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetImportProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetImportProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetImportProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetImportProfileResponseValidationError{}
+
+// Validate checks the field values on AddImportProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddImportProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddImportProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddImportProfileRequestMultiError, or nil if none found.
+func (m *AddImportProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddImportProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Delimiter
+
+	// no validation rules for DecimalSeparator
+
+	// no validation rules for DateLayout
+
+	// no validation rules for SkipRows
+
+	// no validation rules for Encoding
+
+	if all {
+		switch v := interface{}(m.GetMapping()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddImportProfileRequestValidationError{
+					field:  "Mapping",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddImportProfileRequestValidationError{
+					field:  "Mapping",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMapping()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddImportProfileRequestValidationError{
+				field:  "Mapping",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddImportProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddImportProfileRequestMultiError is an error wrapping multiple validation
+// errors returned by AddImportProfileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddImportProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddImportProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddImportProfileRequestMultiError) AllErrors() []error { return m }
+
+// AddImportProfileRequestValidationError is the validation error returned by
+// AddImportProfileRequest.Validate if the designated constraints aren't met.
+type AddImportProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddImportProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddImportProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddImportProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddImportProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddImportProfileRequestValidationError) ErrorName() string {
+	return "AddImportProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddImportProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddImportProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddImportProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddImportProfileRequestValidationError{}
+
+// Validate checks the field values on AddImportProfileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddImportProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddImportProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddImportProfileResponseMultiError, or nil if none found.
+func (m *AddImportProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddImportProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddImportProfileResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddImportProfileResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddImportProfileResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddImportProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddImportProfileResponseMultiError is an error wrapping multiple validation
+// errors returned by AddImportProfileResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddImportProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddImportProfileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddImportProfileResponseMultiError) AllErrors() []error { return m }
+
+// AddImportProfileResponseValidationError is the validation error returned by
+// AddImportProfileResponse.Validate if the designated constraints aren't met.
+type AddImportProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddImportProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddImportProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddImportProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddImportProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddImportProfileResponseValidationError) ErrorName() string {
+	return "AddImportProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddImportProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddImportProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddImportProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddImportProfileResponseValidationError{}
+
+// Validate checks the field values on PatchImportProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PatchImportProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PatchImportProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PatchImportProfileRequestMultiError, or nil if none found.
+func (m *PatchImportProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PatchImportProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Name != nil {
+		// no validation rules for Name
+	}
+
+	if m.Delimiter != nil {
+		// no validation rules for Delimiter
+	}
+
+	if m.DecimalSeparator != nil {
+		// no validation rules for DecimalSeparator
+	}
+
+	if m.DateLayout != nil {
+		// no validation rules for DateLayout
+	}
+
+	if m.SkipRows != nil {
+		// no validation rules for SkipRows
+	}
+
+	if m.Encoding != nil {
+		// no validation rules for Encoding
+	}
+
+	if m.Mapping != nil {
+
+		if all {
+			switch v := interface{}(m.GetMapping()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PatchImportProfileRequestValidationError{
+						field:  "Mapping",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PatchImportProfileRequestValidationError{
+						field:  "Mapping",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMapping()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PatchImportProfileRequestValidationError{
+					field:  "Mapping",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PatchImportProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PatchImportProfileRequestMultiError is an error wrapping multiple validation
+// errors returned by PatchImportProfileRequest.ValidateAll() if the
+// designated constraints aren't met.
+type PatchImportProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PatchImportProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PatchImportProfileRequestMultiError) AllErrors() []error { return m }
+
+// PatchImportProfileRequestValidationError is the validation error returned by
+// PatchImportProfileRequest.Validate if the designated constraints aren't met.
+type PatchImportProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchImportProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchImportProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchImportProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchImportProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchImportProfileRequestValidationError) ErrorName() string {
+	return "PatchImportProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchImportProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchImportProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchImportProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchImportProfileRequestValidationError{}
+
+// Validate checks the field values on PatchImportProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PatchImportProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PatchImportProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PatchImportProfileResponseMultiError, or nil if none found.
+func (m *PatchImportProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PatchImportProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PatchImportProfileResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PatchImportProfileResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PatchImportProfileResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PatchImportProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PatchImportProfileResponseMultiError is an error wrapping multiple
+// validation errors returned by PatchImportProfileResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PatchImportProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PatchImportProfileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PatchImportProfileResponseMultiError) AllErrors() []error { return m }
+
+// PatchImportProfileResponseValidationError is the validation error returned
+// by PatchImportProfileResponse.Validate if the designated constraints aren't met.
+type PatchImportProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchImportProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchImportProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchImportProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchImportProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchImportProfileResponseValidationError) ErrorName() string {
+	return "PatchImportProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchImportProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchImportProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchImportProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchImportProfileResponseValidationError{}
+
+// Validate checks the field values on DeleteImportProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteImportProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteImportProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteImportProfileRequestMultiError, or nil if none found.
+func (m *DeleteImportProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteImportProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteImportProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteImportProfileRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteImportProfileRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteImportProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteImportProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteImportProfileRequestMultiError) AllErrors() []error { return m }
+
+// DeleteImportProfileRequestValidationError is the validation error returned
+// by DeleteImportProfileRequest.Validate if the designated constraints aren't met.
+type DeleteImportProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteImportProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteImportProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteImportProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteImportProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteImportProfileRequestValidationError) ErrorName() string {
+	return "DeleteImportProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteImportProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteImportProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteImportProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteImportProfileRequestValidationError{}
+
+// Validate checks the field values on DeleteImportProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteImportProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteImportProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteImportProfileResponseMultiError, or nil if none found.
+func (m *DeleteImportProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteImportProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteImportProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteImportProfileResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteImportProfileResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteImportProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteImportProfileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteImportProfileResponseMultiError) AllErrors() []error { return m }
+
+// DeleteImportProfileResponseValidationError is the validation error returned
+// by DeleteImportProfileResponse.Validate if the designated constraints
+// aren't met.
+type DeleteImportProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteImportProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteImportProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteImportProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteImportProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteImportProfileResponseValidationError) ErrorName() string {
+	return "DeleteImportProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteImportProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteImportProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteImportProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteImportProfileResponseValidationError{}
+
+// Validate checks the field values on ListCategoryRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCategoryRulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCategoryRulesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCategoryRulesRequestMultiError, or nil if none found.
+func (m *ListCategoryRulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCategoryRulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListCategoryRulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCategoryRulesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCategoryRulesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCategoryRulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCategoryRulesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCategoryRulesRequestMultiError) AllErrors() []error { return m }
+
+// ListCategoryRulesRequestValidationError is the validation error returned by
+// ListCategoryRulesRequest.Validate if the designated constraints aren't met.
+type ListCategoryRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCategoryRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCategoryRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCategoryRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCategoryRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCategoryRulesRequestValidationError) ErrorName() string {
+	return "ListCategoryRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCategoryRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCategoryRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCategoryRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCategoryRulesRequestValidationError{}
+
+// Validate checks the field values on ListCategoryRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCategoryRulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCategoryRulesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCategoryRulesResponseMultiError, or nil if none found.
+func (m *ListCategoryRulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCategoryRulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCategoryRulesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCategoryRulesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCategoryRulesResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCategoryRulesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCategoryRulesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListCategoryRulesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListCategoryRulesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCategoryRulesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCategoryRulesResponseMultiError) AllErrors() []error { return m }
+
+// ListCategoryRulesResponseValidationError is the validation error returned by
+// ListCategoryRulesResponse.Validate if the designated constraints aren't met.
+type ListCategoryRulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCategoryRulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCategoryRulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCategoryRulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCategoryRulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCategoryRulesResponseValidationError) ErrorName() string {
+	return "ListCategoryRulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCategoryRulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCategoryRulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCategoryRulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCategoryRulesResponseValidationError{}
+
+// Validate checks the field values on GetCategoryRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCategoryRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCategoryRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCategoryRuleRequestMultiError, or nil if none found.
+func (m *GetCategoryRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCategoryRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetCategoryRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCategoryRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCategoryRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCategoryRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCategoryRuleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCategoryRuleRequestMultiError) AllErrors() []error { return m }
+
+// GetCategoryRuleRequestValidationError is the validation error returned by
+// GetCategoryRuleRequest.Validate if the designated constraints aren't met.
+type GetCategoryRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCategoryRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCategoryRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCategoryRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCategoryRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCategoryRuleRequestValidationError) ErrorName() string {
+	return "GetCategoryRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCategoryRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCategoryRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCategoryRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCategoryRuleRequestValidationError{}
+
+// Validate checks the field values on GetCategoryRuleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCategoryRuleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCategoryRuleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCategoryRuleResponseMultiError, or nil if none found.
+func (m *GetCategoryRuleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCategoryRuleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCategoryRuleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCategoryRuleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCategoryRuleResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCategoryRuleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCategoryRuleResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCategoryRuleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCategoryRuleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCategoryRuleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCategoryRuleResponseMultiError) AllErrors() []error { return m }
+
+// GetCategoryRuleResponseValidationError is the validation error returned by
+// GetCategoryRuleResponse.Validate if the designated constraints aren't met.
+type GetCategoryRuleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCategoryRuleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCategoryRuleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCategoryRuleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCategoryRuleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCategoryRuleResponseValidationError) ErrorName() string {
+	return "GetCategoryRuleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCategoryRuleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCategoryRuleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCategoryRuleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCategoryRuleResponseValidationError{}
+
+// Validate checks the field values on AddCategoryRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddCategoryRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddCategoryRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddCategoryRuleRequestMultiError, or nil if none found.
+func (m *AddCategoryRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddCategoryRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Priority
+
+	// no validation rules for CategoryId
+
+	if all {
+		switch v := interface{}(m.GetConditions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddCategoryRuleRequestValidationError{
+					field:  "Conditions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddCategoryRuleRequestValidationError{
+					field:  "Conditions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConditions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddCategoryRuleRequestValidationError{
+				field:  "Conditions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddCategoryRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddCategoryRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by AddCategoryRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddCategoryRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddCategoryRuleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddCategoryRuleRequestMultiError) AllErrors() []error { return m }
+
+// AddCategoryRuleRequestValidationError is the validation error returned by
+// AddCategoryRuleRequest.Validate if the designated constraints aren't met.
+type AddCategoryRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddCategoryRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddCategoryRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddCategoryRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddCategoryRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddCategoryRuleRequestValidationError) ErrorName() string {
+	return "AddCategoryRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddCategoryRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddCategoryRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddCategoryRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddCategoryRuleRequestValidationError{}
+
+// Validate checks the field values on AddCategoryRuleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddCategoryRuleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddCategoryRuleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddCategoryRuleResponseMultiError, or nil if none found.
+func (m *AddCategoryRuleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddCategoryRuleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddCategoryRuleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddCategoryRuleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddCategoryRuleResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddCategoryRuleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddCategoryRuleResponseMultiError is an error wrapping multiple validation
+// errors returned by AddCategoryRuleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddCategoryRuleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddCategoryRuleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddCategoryRuleResponseMultiError) AllErrors() []error { return m }
+
+// AddCategoryRuleResponseValidationError is the validation error returned by
+// AddCategoryRuleResponse.Validate if the designated constraints aren't met.
+type AddCategoryRuleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddCategoryRuleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddCategoryRuleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddCategoryRuleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddCategoryRuleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddCategoryRuleResponseValidationError) ErrorName() string {
+	return "AddCategoryRuleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddCategoryRuleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddCategoryRuleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddCategoryRuleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddCategoryRuleResponseValidationError{}
+
+// Validate checks the field values on PatchCategoryRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PatchCategoryRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PatchCategoryRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PatchCategoryRuleRequestMultiError, or nil if none found.
+func (m *PatchCategoryRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PatchCategoryRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Name != nil {
+		// no validation rules for Name
+	}
+
+	if m.Priority != nil {
+		// no validation rules for Priority
+	}
+
+	if m.CategoryId != nil {
+		// no validation rules for CategoryId
+	}
+
+	if m.Conditions != nil {
+
+		if all {
+			switch v := interface{}(m.GetConditions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PatchCategoryRuleRequestValidationError{
+						field:  "Conditions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PatchCategoryRuleRequestValidationError{
+						field:  "Conditions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConditions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PatchCategoryRuleRequestValidationError{
+					field:  "Conditions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PatchCategoryRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PatchCategoryRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by PatchCategoryRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PatchCategoryRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PatchCategoryRuleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PatchCategoryRuleRequestMultiError) AllErrors() []error { return m }
+
+// PatchCategoryRuleRequestValidationError is the validation error returned by
+// PatchCategoryRuleRequest.Validate if the designated constraints aren't met.
+type PatchCategoryRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchCategoryRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchCategoryRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchCategoryRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchCategoryRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchCategoryRuleRequestValidationError) ErrorName() string {
+	return "PatchCategoryRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchCategoryRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchCategoryRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchCategoryRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchCategoryRuleRequestValidationError{}
+
+// Validate checks the field values on PatchCategoryRuleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PatchCategoryRuleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PatchCategoryRuleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PatchCategoryRuleResponseMultiError, or nil if none found.
+func (m *PatchCategoryRuleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PatchCategoryRuleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PatchCategoryRuleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PatchCategoryRuleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PatchCategoryRuleResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return ReportItemMultiError(errors)
+		return PatchCategoryRuleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReportItemMultiError is an error wrapping multiple validation errors
-// returned by ReportItem.ValidateAll() if the designated constraints aren't met.
-type ReportItemMultiError []error
+// PatchCategoryRuleResponseMultiError is an error wrapping multiple validation
+// errors returned by PatchCategoryRuleResponse.ValidateAll() if the
+// designated constraints aren't met.
+type PatchCategoryRuleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PatchCategoryRuleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PatchCategoryRuleResponseMultiError) AllErrors() []error { return m }
+
+// PatchCategoryRuleResponseValidationError is the validation error returned by
+// PatchCategoryRuleResponse.Validate if the designated constraints aren't met.
+type PatchCategoryRuleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchCategoryRuleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchCategoryRuleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchCategoryRuleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchCategoryRuleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchCategoryRuleResponseValidationError) ErrorName() string {
+	return "PatchCategoryRuleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchCategoryRuleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchCategoryRuleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchCategoryRuleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchCategoryRuleResponseValidationError{}
+
+// Validate checks the field values on DeleteCategoryRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCategoryRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCategoryRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCategoryRuleRequestMultiError, or nil if none found.
+func (m *DeleteCategoryRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCategoryRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteCategoryRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCategoryRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCategoryRuleRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteCategoryRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCategoryRuleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCategoryRuleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCategoryRuleRequestValidationError is the validation error returned by
+// DeleteCategoryRuleRequest.Validate if the designated constraints aren't met.
+type DeleteCategoryRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCategoryRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCategoryRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCategoryRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCategoryRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCategoryRuleRequestValidationError) ErrorName() string {
+	return "DeleteCategoryRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCategoryRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCategoryRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCategoryRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCategoryRuleRequestValidationError{}
+
+// Validate checks the field values on DeleteCategoryRuleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCategoryRuleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCategoryRuleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCategoryRuleResponseMultiError, or nil if none found.
+func (m *DeleteCategoryRuleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCategoryRuleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteCategoryRuleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCategoryRuleResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteCategoryRuleResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteCategoryRuleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReportItemMultiError) Error() string {
+func (m DeleteCategoryRuleResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -793,11 +13746,11 @@ func (m ReportItemMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReportItemMultiError) AllErrors() []error { return m }
+func (m DeleteCategoryRuleResponseMultiError) AllErrors() []error { return m }
 
-// ReportItemValidationError is the validation error returned by
-// ReportItem.Validate if the designated constraints aren't met.
-type ReportItemValidationError struct {
+// DeleteCategoryRuleResponseValidationError is the validation error returned
+// by DeleteCategoryRuleResponse.Validate if the designated constraints aren't met.
+type DeleteCategoryRuleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -805,22 +13758,24 @@ type ReportItemValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReportItemValidationError) Field() string { return e.field }
+func (e DeleteCategoryRuleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReportItemValidationError) Reason() string { return e.reason }
+func (e DeleteCategoryRuleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReportItemValidationError) Cause() error { return e.cause }
+func (e DeleteCategoryRuleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReportItemValidationError) Key() bool { return e.key }
+func (e DeleteCategoryRuleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReportItemValidationError) ErrorName() string { return "ReportItemValidationError" }
+func (e DeleteCategoryRuleResponseValidationError) ErrorName() string {
+	return "DeleteCategoryRuleResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ReportItemValidationError) Error() string {
+func (e DeleteCategoryRuleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -832,14 +13787,14 @@ func (e ReportItemValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReportItem.%s: %s%s",
+		"invalid %sDeleteCategoryRuleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReportItemValidationError{}
+var _ error = DeleteCategoryRuleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -847,24 +13802,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReportItemValidationError{}
+} = DeleteCategoryRuleResponseValidationError{}
 
-// Validate checks the field values on PeriodReport with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *PeriodReport) Validate() error {
+// Validate checks the field values on ReapplyRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReapplyRulesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PeriodReport with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PeriodReportMultiError, or
-// nil if none found.
-func (m *PeriodReport) ValidateAll() error {
+// ValidateAll checks the field values on ReapplyRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReapplyRulesRequestMultiError, or nil if none found.
+func (m *ReapplyRulesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PeriodReport) validate(all bool) error {
+func (m *ReapplyRulesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -872,28 +13827,28 @@ func (m *PeriodReport) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetPeriodStart()).(type) {
+		switch v := interface{}(m.GetDateFrom()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PeriodReportValidationError{
-					field:  "PeriodStart",
+				errors = append(errors, ReapplyRulesRequestValidationError{
+					field:  "DateFrom",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PeriodReportValidationError{
-					field:  "PeriodStart",
+				errors = append(errors, ReapplyRulesRequestValidationError{
+					field:  "DateFrom",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetPeriodStart()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDateFrom()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PeriodReportValidationError{
-				field:  "PeriodStart",
+			return ReapplyRulesRequestValidationError{
+				field:  "DateFrom",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -901,51 +13856,157 @@ func (m *PeriodReport) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetPeriodEnd()).(type) {
+		switch v := interface{}(m.GetDateTo()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PeriodReportValidationError{
-					field:  "PeriodEnd",
+				errors = append(errors, ReapplyRulesRequestValidationError{
+					field:  "DateTo",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PeriodReportValidationError{
-					field:  "PeriodEnd",
+				errors = append(errors, ReapplyRulesRequestValidationError{
+					field:  "DateTo",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetPeriodEnd()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDateTo()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PeriodReportValidationError{
-				field:  "PeriodEnd",
+			return ReapplyRulesRequestValidationError{
+				field:  "DateTo",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetItems() {
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ReapplyRulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReapplyRulesRequestMultiError is an error wrapping multiple validation
+// errors returned by ReapplyRulesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReapplyRulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReapplyRulesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReapplyRulesRequestMultiError) AllErrors() []error { return m }
+
+// ReapplyRulesRequestValidationError is the validation error returned by
+// ReapplyRulesRequest.Validate if the designated constraints aren't met.
+type ReapplyRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReapplyRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReapplyRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReapplyRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReapplyRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReapplyRulesRequestValidationError) ErrorName() string {
+	return "ReapplyRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReapplyRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReapplyRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReapplyRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReapplyRulesRequestValidationError{}
+
+// Validate checks the field values on ReapplyRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReapplyRulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReapplyRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReapplyRulesResponseMultiError, or nil if none found.
+func (m *ReapplyRulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReapplyRulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Checked
+
+	for idx, item := range m.GetChanges() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PeriodReportValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
+					errors = append(errors, ReapplyRulesResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PeriodReportValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
+					errors = append(errors, ReapplyRulesResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -953,8 +14014,8 @@ func (m *PeriodReport) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PeriodReportValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
+				return ReapplyRulesResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -963,19 +14024,22 @@ func (m *PeriodReport) validate(all bool) error {
 
 	}
 
+	// no validation rules for DryRun
+
 	if len(errors) > 0 {
-		return PeriodReportMultiError(errors)
+		return ReapplyRulesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PeriodReportMultiError is an error wrapping multiple validation errors
-// returned by PeriodReport.ValidateAll() if the designated constraints aren't met.
-type PeriodReportMultiError []error
+// ReapplyRulesResponseMultiError is an error wrapping multiple validation
+// errors returned by ReapplyRulesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReapplyRulesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PeriodReportMultiError) Error() string {
+func (m ReapplyRulesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -984,11 +14048,11 @@ func (m PeriodReportMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PeriodReportMultiError) AllErrors() []error { return m }
+func (m ReapplyRulesResponseMultiError) AllErrors() []error { return m }
 
-// PeriodReportValidationError is the validation error returned by
-// PeriodReport.Validate if the designated constraints aren't met.
-type PeriodReportValidationError struct {
+// ReapplyRulesResponseValidationError is the validation error returned by
+// ReapplyRulesResponse.Validate if the designated constraints aren't met.
+type ReapplyRulesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -996,22 +14060,24 @@ type PeriodReportValidationError struct {
 }
 
 // Field function returns field value.
-func (e PeriodReportValidationError) Field() string { return e.field }
+func (e ReapplyRulesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PeriodReportValidationError) Reason() string { return e.reason }
+func (e ReapplyRulesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PeriodReportValidationError) Cause() error { return e.cause }
+func (e ReapplyRulesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PeriodReportValidationError) Key() bool { return e.key }
+func (e ReapplyRulesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PeriodReportValidationError) ErrorName() string { return "PeriodReportValidationError" }
+func (e ReapplyRulesResponseValidationError) ErrorName() string {
+	return "ReapplyRulesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PeriodReportValidationError) Error() string {
+func (e ReapplyRulesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1023,14 +14089,14 @@ func (e PeriodReportValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPeriodReport.%s: %s%s",
+		"invalid %sReapplyRulesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PeriodReportValidationError{}
+var _ error = ReapplyRulesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1038,44 +14104,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PeriodReportValidationError{}
+} = ReapplyRulesResponseValidationError{}
 
-// Validate checks the field values on ListCategoriesRequest with the rules
+// Validate checks the field values on GetTransferRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListCategoriesRequest) Validate() error {
+func (m *GetTransferRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListCategoriesRequest with the rules
+// ValidateAll checks the field values on GetTransferRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListCategoriesRequestMultiError, or nil if none found.
-func (m *ListCategoriesRequest) ValidateAll() error {
+// GetTransferRequestMultiError, or nil if none found.
+func (m *GetTransferRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListCategoriesRequest) validate(all bool) error {
+func (m *GetTransferRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	if len(errors) > 0 {
-		return ListCategoriesRequestMultiError(errors)
+		return GetTransferRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListCategoriesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListCategoriesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListCategoriesRequestMultiError []error
+// GetTransferRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTransferRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTransferRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListCategoriesRequestMultiError) Error() string {
+func (m GetTransferRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1084,11 +14152,11 @@ func (m ListCategoriesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListCategoriesRequestMultiError) AllErrors() []error { return m }
+func (m GetTransferRequestMultiError) AllErrors() []error { return m }
 
-// ListCategoriesRequestValidationError is the validation error returned by
-// ListCategoriesRequest.Validate if the designated constraints aren't met.
-type ListCategoriesRequestValidationError struct {
+// GetTransferRequestValidationError is the validation error returned by
+// GetTransferRequest.Validate if the designated constraints aren't met.
+type GetTransferRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1096,24 +14164,24 @@ type ListCategoriesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListCategoriesRequestValidationError) Field() string { return e.field }
+func (e GetTransferRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListCategoriesRequestValidationError) Reason() string { return e.reason }
+func (e GetTransferRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListCategoriesRequestValidationError) Cause() error { return e.cause }
+func (e GetTransferRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListCategoriesRequestValidationError) Key() bool { return e.key }
+func (e GetTransferRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListCategoriesRequestValidationError) ErrorName() string {
-	return "ListCategoriesRequestValidationError"
+func (e GetTransferRequestValidationError) ErrorName() string {
+	return "GetTransferRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListCategoriesRequestValidationError) Error() string {
+func (e GetTransferRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1125,14 +14193,14 @@ func (e ListCategoriesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListCategoriesRequest.%s: %s%s",
+		"invalid %sGetTransferRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListCategoriesRequestValidationError{}
+var _ error = GetTransferRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1140,78 +14208,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListCategoriesRequestValidationError{}
+} = GetTransferRequestValidationError{}
 
-// Validate checks the field values on ListCategoriesResponse with the rules
+// Validate checks the field values on GetTransferResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListCategoriesResponse) Validate() error {
+func (m *GetTransferResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListCategoriesResponse with the rules
+// ValidateAll checks the field values on GetTransferResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListCategoriesResponseMultiError, or nil if none found.
-func (m *ListCategoriesResponse) ValidateAll() error {
+// GetTransferResponseMultiError, or nil if none found.
+func (m *GetTransferResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListCategoriesResponse) validate(all bool) error {
+func (m *GetTransferResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetItems() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListCategoriesResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListCategoriesResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTransferResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListCategoriesResponseValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
+				errors = append(errors, GetTransferResponseValidationError{
+					field:  "Item",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTransferResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return ListCategoriesResponseMultiError(errors)
+		return GetTransferResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListCategoriesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListCategoriesResponse.ValidateAll() if the designated
+// GetTransferResponseMultiError is an error wrapping multiple validation
+// errors returned by GetTransferResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListCategoriesResponseMultiError []error
+type GetTransferResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListCategoriesResponseMultiError) Error() string {
+func (m GetTransferResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1220,11 +14283,11 @@ func (m ListCategoriesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListCategoriesResponseMultiError) AllErrors() []error { return m }
+func (m GetTransferResponseMultiError) AllErrors() []error { return m }
 
-// ListCategoriesResponseValidationError is the validation error returned by
-// ListCategoriesResponse.Validate if the designated constraints aren't met.
-type ListCategoriesResponseValidationError struct {
+// GetTransferResponseValidationError is the validation error returned by
+// GetTransferResponse.Validate if the designated constraints aren't met.
+type GetTransferResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1232,24 +14295,24 @@ type ListCategoriesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListCategoriesResponseValidationError) Field() string { return e.field }
+func (e GetTransferResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListCategoriesResponseValidationError) Reason() string { return e.reason }
+func (e GetTransferResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListCategoriesResponseValidationError) Cause() error { return e.cause }
+func (e GetTransferResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListCategoriesResponseValidationError) Key() bool { return e.key }
+func (e GetTransferResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListCategoriesResponseValidationError) ErrorName() string {
-	return "ListCategoriesResponseValidationError"
+func (e GetTransferResponseValidationError) ErrorName() string {
+	return "GetTransferResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListCategoriesResponseValidationError) Error() string {
+func (e GetTransferResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1261,14 +14324,14 @@ func (e ListCategoriesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListCategoriesResponse.%s: %s%s",
+		"invalid %sGetTransferResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListCategoriesResponseValidationError{}
+var _ error = GetTransferResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1276,114 +14339,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListCategoriesResponseValidationError{}
+} = GetTransferResponseValidationError{}
 
-// Validate checks the field values on ListTransactionsRequest with the rules
+// Validate checks the field values on AddTransferRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListTransactionsRequest) Validate() error {
+func (m *AddTransferRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListTransactionsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AddTransferRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListTransactionsRequestMultiError, or nil if none found.
-func (m *ListTransactionsRequest) ValidateAll() error {
+// AddTransferRequestMultiError, or nil if none found.
+func (m *AddTransferRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListTransactionsRequest) validate(all bool) error {
+func (m *AddTransferRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Limit
+	// no validation rules for FromWalletId
 
-	// no validation rules for Offset
+	// no validation rules for ToWalletId
 
-	if m.FilterOccurredOnFrom != nil {
+	// no validation rules for Amount
 
-		if all {
-			switch v := interface{}(m.GetFilterOccurredOnFrom()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListTransactionsRequestValidationError{
-						field:  "FilterOccurredOnFrom",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListTransactionsRequestValidationError{
-						field:  "FilterOccurredOnFrom",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetFilterOccurredOnFrom()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListTransactionsRequestValidationError{
-					field:  "FilterOccurredOnFrom",
+	if all {
+		switch v := interface{}(m.GetOccurredOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddTransferRequestValidationError{
+					field:  "OccurredOn",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if m.FilterOccurredOnTo != nil {
-
-		if all {
-			switch v := interface{}(m.GetFilterOccurredOnTo()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListTransactionsRequestValidationError{
-						field:  "FilterOccurredOnTo",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListTransactionsRequestValidationError{
-						field:  "FilterOccurredOnTo",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+				})
 			}
-		} else if v, ok := interface{}(m.GetFilterOccurredOnTo()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListTransactionsRequestValidationError{
-					field:  "FilterOccurredOnTo",
+				errors = append(errors, AddTransferRequestValidationError{
+					field:  "OccurredOn",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddTransferRequestValidationError{
+				field:  "OccurredOn",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
+	// no validation rules for Description
+
 	if len(errors) > 0 {
-		return ListTransactionsRequestMultiError(errors)
+		return AddTransferRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListTransactionsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListTransactionsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListTransactionsRequestMultiError []error
+// AddTransferRequestMultiError is an error wrapping multiple validation errors
+// returned by AddTransferRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddTransferRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListTransactionsRequestMultiError) Error() string {
+func (m AddTransferRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1392,11 +14422,11 @@ func (m ListTransactionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListTransactionsRequestMultiError) AllErrors() []error { return m }
+func (m AddTransferRequestMultiError) AllErrors() []error { return m }
 
-// ListTransactionsRequestValidationError is the validation error returned by
-// ListTransactionsRequest.Validate if the designated constraints aren't met.
-type ListTransactionsRequestValidationError struct {
+// AddTransferRequestValidationError is the validation error returned by
+// AddTransferRequest.Validate if the designated constraints aren't met.
+type AddTransferRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1404,24 +14434,24 @@ type ListTransactionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListTransactionsRequestValidationError) Field() string { return e.field }
+func (e AddTransferRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListTransactionsRequestValidationError) Reason() string { return e.reason }
+func (e AddTransferRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListTransactionsRequestValidationError) Cause() error { return e.cause }
+func (e AddTransferRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListTransactionsRequestValidationError) Key() bool { return e.key }
+func (e AddTransferRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListTransactionsRequestValidationError) ErrorName() string {
-	return "ListTransactionsRequestValidationError"
+func (e AddTransferRequestValidationError) ErrorName() string {
+	return "AddTransferRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListTransactionsRequestValidationError) Error() string {
+func (e AddTransferRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1433,14 +14463,14 @@ func (e ListTransactionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListTransactionsRequest.%s: %s%s",
+		"invalid %sAddTransferRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListTransactionsRequestValidationError{}
+var _ error = AddTransferRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1448,80 +14478,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListTransactionsRequestValidationError{}
+} = AddTransferRequestValidationError{}
 
-// Validate checks the field values on ListTransactionsResponse with the rules
+// Validate checks the field values on AddTransferResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListTransactionsResponse) Validate() error {
+func (m *AddTransferResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListTransactionsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AddTransferResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListTransactionsResponseMultiError, or nil if none found.
-func (m *ListTransactionsResponse) ValidateAll() error {
+// AddTransferResponseMultiError, or nil if none found.
+func (m *AddTransferResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListTransactionsResponse) validate(all bool) error {
+func (m *AddTransferResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetItems() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListTransactionsResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListTransactionsResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddTransferResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListTransactionsResponseValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
+				errors = append(errors, AddTransferResponseValidationError{
+					field:  "Item",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddTransferResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for Total
-
 	if len(errors) > 0 {
-		return ListTransactionsResponseMultiError(errors)
+		return AddTransferResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListTransactionsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListTransactionsResponse.ValidateAll() if the designated
+// AddTransferResponseMultiError is an error wrapping multiple validation
+// errors returned by AddTransferResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListTransactionsResponseMultiError []error
+type AddTransferResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListTransactionsResponseMultiError) Error() string {
+func (m AddTransferResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1530,11 +14553,11 @@ func (m ListTransactionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListTransactionsResponseMultiError) AllErrors() []error { return m }
+func (m AddTransferResponseMultiError) AllErrors() []error { return m }
 
-// ListTransactionsResponseValidationError is the validation error returned by
-// ListTransactionsResponse.Validate if the designated constraints aren't met.
-type ListTransactionsResponseValidationError struct {
+// AddTransferResponseValidationError is the validation error returned by
+// AddTransferResponse.Validate if the designated constraints aren't met.
+type AddTransferResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1542,24 +14565,24 @@ type ListTransactionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListTransactionsResponseValidationError) Field() string { return e.field }
+func (e AddTransferResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListTransactionsResponseValidationError) Reason() string { return e.reason }
+func (e AddTransferResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListTransactionsResponseValidationError) Cause() error { return e.cause }
+func (e AddTransferResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListTransactionsResponseValidationError) Key() bool { return e.key }
+func (e AddTransferResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListTransactionsResponseValidationError) ErrorName() string {
-	return "ListTransactionsResponseValidationError"
+func (e AddTransferResponseValidationError) ErrorName() string {
+	return "AddTransferResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListTransactionsResponseValidationError) Error() string {
+func (e AddTransferResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1571,14 +14594,14 @@ func (e ListTransactionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListTransactionsResponse.%s: %s%s",
+		"invalid %sAddTransferResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListTransactionsResponseValidationError{}
+var _ error = AddTransferResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1586,24 +14609,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListTransactionsResponseValidationError{}
+} = AddTransferResponseValidationError{}
 
-// Validate checks the field values on GetTransactionRequest with the rules
+// Validate checks the field values on PatchTransferRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetTransactionRequest) Validate() error {
+func (m *PatchTransferRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetTransactionRequest with the rules
+// ValidateAll checks the field values on PatchTransferRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetTransactionRequestMultiError, or nil if none found.
-func (m *GetTransactionRequest) ValidateAll() error {
+// PatchTransferRequestMultiError, or nil if none found.
+func (m *PatchTransferRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetTransactionRequest) validate(all bool) error {
+func (m *PatchTransferRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1612,20 +14635,69 @@ func (m *GetTransactionRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	if m.FromWalletId != nil {
+		// no validation rules for FromWalletId
+	}
+
+	if m.ToWalletId != nil {
+		// no validation rules for ToWalletId
+	}
+
+	if m.Amount != nil {
+		// no validation rules for Amount
+	}
+
+	if m.OccurredOn != nil {
+
+		if all {
+			switch v := interface{}(m.GetOccurredOn()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PatchTransferRequestValidationError{
+						field:  "OccurredOn",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PatchTransferRequestValidationError{
+						field:  "OccurredOn",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOccurredOn()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PatchTransferRequestValidationError{
+					field:  "OccurredOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
 	if len(errors) > 0 {
-		return GetTransactionRequestMultiError(errors)
+		return PatchTransferRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetTransactionRequestMultiError is an error wrapping multiple validation
-// errors returned by GetTransactionRequest.ValidateAll() if the designated
+// PatchTransferRequestMultiError is an error wrapping multiple validation
+// errors returned by PatchTransferRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetTransactionRequestMultiError []error
+type PatchTransferRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetTransactionRequestMultiError) Error() string {
+func (m PatchTransferRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1634,11 +14706,11 @@ func (m GetTransactionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetTransactionRequestMultiError) AllErrors() []error { return m }
+func (m PatchTransferRequestMultiError) AllErrors() []error { return m }
 
-// GetTransactionRequestValidationError is the validation error returned by
-// GetTransactionRequest.Validate if the designated constraints aren't met.
-type GetTransactionRequestValidationError struct {
+// PatchTransferRequestValidationError is the validation error returned by
+// PatchTransferRequest.Validate if the designated constraints aren't met.
+type PatchTransferRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1646,24 +14718,24 @@ type GetTransactionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetTransactionRequestValidationError) Field() string { return e.field }
+func (e PatchTransferRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetTransactionRequestValidationError) Reason() string { return e.reason }
+func (e PatchTransferRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetTransactionRequestValidationError) Cause() error { return e.cause }
+func (e PatchTransferRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetTransactionRequestValidationError) Key() bool { return e.key }
+func (e PatchTransferRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetTransactionRequestValidationError) ErrorName() string {
-	return "GetTransactionRequestValidationError"
+func (e PatchTransferRequestValidationError) ErrorName() string {
+	return "PatchTransferRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetTransactionRequestValidationError) Error() string {
+func (e PatchTransferRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1675,14 +14747,14 @@ func (e GetTransactionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetTransactionRequest.%s: %s%s",
+		"invalid %sPatchTransferRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetTransactionRequestValidationError{}
+var _ error = PatchTransferRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1690,24 +14762,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetTransactionRequestValidationError{}
+} = PatchTransferRequestValidationError{}
 
-// Validate checks the field values on GetTransactionResponse with the rules
+// Validate checks the field values on PatchTransferResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetTransactionResponse) Validate() error {
+func (m *PatchTransferResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetTransactionResponse with the rules
+// ValidateAll checks the field values on PatchTransferResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetTransactionResponseMultiError, or nil if none found.
-func (m *GetTransactionResponse) ValidateAll() error {
+// PatchTransferResponseMultiError, or nil if none found.
+func (m *PatchTransferResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetTransactionResponse) validate(all bool) error {
+func (m *PatchTransferResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1718,7 +14790,7 @@ func (m *GetTransactionResponse) validate(all bool) error {
 		switch v := interface{}(m.GetItem()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetTransactionResponseValidationError{
+				errors = append(errors, PatchTransferResponseValidationError{
 					field:  "Item",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1726,7 +14798,7 @@ func (m *GetTransactionResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetTransactionResponseValidationError{
+				errors = append(errors, PatchTransferResponseValidationError{
 					field:  "Item",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1735,7 +14807,7 @@ func (m *GetTransactionResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetTransactionResponseValidationError{
+			return PatchTransferResponseValidationError{
 				field:  "Item",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1744,19 +14816,19 @@ func (m *GetTransactionResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetTransactionResponseMultiError(errors)
+		return PatchTransferResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetTransactionResponseMultiError is an error wrapping multiple validation
-// errors returned by GetTransactionResponse.ValidateAll() if the designated
+// PatchTransferResponseMultiError is an error wrapping multiple validation
+// errors returned by PatchTransferResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetTransactionResponseMultiError []error
+type PatchTransferResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetTransactionResponseMultiError) Error() string {
+func (m PatchTransferResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1765,11 +14837,11 @@ func (m GetTransactionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetTransactionResponseMultiError) AllErrors() []error { return m }
+func (m PatchTransferResponseMultiError) AllErrors() []error { return m }
 
-// GetTransactionResponseValidationError is the validation error returned by
-// GetTransactionResponse.Validate if the designated constraints aren't met.
-type GetTransactionResponseValidationError struct {
+// PatchTransferResponseValidationError is the validation error returned by
+// PatchTransferResponse.Validate if the designated constraints aren't met.
+type PatchTransferResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1777,24 +14849,24 @@ type GetTransactionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetTransactionResponseValidationError) Field() string { return e.field }
+func (e PatchTransferResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetTransactionResponseValidationError) Reason() string { return e.reason }
+func (e PatchTransferResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetTransactionResponseValidationError) Cause() error { return e.cause }
+func (e PatchTransferResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetTransactionResponseValidationError) Key() bool { return e.key }
+func (e PatchTransferResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetTransactionResponseValidationError) ErrorName() string {
-	return "GetTransactionResponseValidationError"
+func (e PatchTransferResponseValidationError) ErrorName() string {
+	return "PatchTransferResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetTransactionResponseValidationError) Error() string {
+func (e PatchTransferResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1806,14 +14878,14 @@ func (e GetTransactionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetTransactionResponse.%s: %s%s",
+		"invalid %sPatchTransferResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetTransactionResponseValidationError{}
+var _ error = PatchTransferResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1821,81 +14893,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetTransactionResponseValidationError{}
+} = PatchTransferResponseValidationError{}
 
-// Validate checks the field values on AddTransactionRequest with the rules
+// Validate checks the field values on DeleteTransferRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddTransactionRequest) Validate() error {
+func (m *DeleteTransferRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddTransactionRequest with the rules
+// ValidateAll checks the field values on DeleteTransferRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddTransactionRequestMultiError, or nil if none found.
-func (m *AddTransactionRequest) ValidateAll() error {
+// DeleteTransferRequestMultiError, or nil if none found.
+func (m *DeleteTransferRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddTransactionRequest) validate(all bool) error {
+func (m *DeleteTransferRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IsIncome
-
-	// no validation rules for Amount
-
-	if all {
-		switch v := interface{}(m.GetOccurredOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AddTransactionRequestValidationError{
-					field:  "OccurredOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AddTransactionRequestValidationError{
-					field:  "OccurredOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOccurredOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AddTransactionRequestValidationError{
-				field:  "OccurredOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for CategoryId
-
-	// no validation rules for Description
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return AddTransactionRequestMultiError(errors)
+		return DeleteTransferRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddTransactionRequestMultiError is an error wrapping multiple validation
-// errors returned by AddTransactionRequest.ValidateAll() if the designated
+// DeleteTransferRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteTransferRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AddTransactionRequestMultiError []error
+type DeleteTransferRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddTransactionRequestMultiError) Error() string {
+func (m DeleteTransferRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1904,11 +14941,11 @@ func (m AddTransactionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddTransactionRequestMultiError) AllErrors() []error { return m }
+func (m DeleteTransferRequestMultiError) AllErrors() []error { return m }
 
-// AddTransactionRequestValidationError is the validation error returned by
-// AddTransactionRequest.Validate if the designated constraints aren't met.
-type AddTransactionRequestValidationError struct {
+// DeleteTransferRequestValidationError is the validation error returned by
+// DeleteTransferRequest.Validate if the designated constraints aren't met.
+type DeleteTransferRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1916,24 +14953,24 @@ type AddTransactionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddTransactionRequestValidationError) Field() string { return e.field }
+func (e DeleteTransferRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddTransactionRequestValidationError) Reason() string { return e.reason }
+func (e DeleteTransferRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddTransactionRequestValidationError) Cause() error { return e.cause }
+func (e DeleteTransferRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddTransactionRequestValidationError) Key() bool { return e.key }
+func (e DeleteTransferRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddTransactionRequestValidationError) ErrorName() string {
-	return "AddTransactionRequestValidationError"
+func (e DeleteTransferRequestValidationError) ErrorName() string {
+	return "DeleteTransferRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddTransactionRequestValidationError) Error() string {
+func (e DeleteTransferRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1945,14 +14982,14 @@ func (e AddTransactionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddTransactionRequest.%s: %s%s",
+		"invalid %sDeleteTransferRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddTransactionRequestValidationError{}
+var _ error = DeleteTransferRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1960,73 +14997,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddTransactionRequestValidationError{}
+} = DeleteTransferRequestValidationError{}
 
-// Validate checks the field values on AddTransactionResponse with the rules
+// Validate checks the field values on DeleteTransferResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddTransactionResponse) Validate() error {
+func (m *DeleteTransferResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddTransactionResponse with the rules
+// ValidateAll checks the field values on DeleteTransferResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddTransactionResponseMultiError, or nil if none found.
-func (m *AddTransactionResponse) ValidateAll() error {
+// DeleteTransferResponseMultiError, or nil if none found.
+func (m *DeleteTransferResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddTransactionResponse) validate(all bool) error {
+func (m *DeleteTransferResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetItem()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AddTransactionResponseValidationError{
-					field:  "Item",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AddTransactionResponseValidationError{
-					field:  "Item",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AddTransactionResponseValidationError{
-				field:  "Item",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return AddTransactionResponseMultiError(errors)
+		return DeleteTransferResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddTransactionResponseMultiError is an error wrapping multiple validation
-// errors returned by AddTransactionResponse.ValidateAll() if the designated
+// DeleteTransferResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteTransferResponse.ValidateAll() if the designated
 // constraints aren't met.
-type AddTransactionResponseMultiError []error
+type DeleteTransferResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddTransactionResponseMultiError) Error() string {
+func (m DeleteTransferResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2035,11 +15043,11 @@ func (m AddTransactionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddTransactionResponseMultiError) AllErrors() []error { return m }
+func (m DeleteTransferResponseMultiError) AllErrors() []error { return m }
 
-// AddTransactionResponseValidationError is the validation error returned by
-// AddTransactionResponse.Validate if the designated constraints aren't met.
-type AddTransactionResponseValidationError struct {
+// DeleteTransferResponseValidationError is the validation error returned by
+// DeleteTransferResponse.Validate if the designated constraints aren't met.
+type DeleteTransferResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2047,24 +15055,24 @@ type AddTransactionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddTransactionResponseValidationError) Field() string { return e.field }
+func (e DeleteTransferResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddTransactionResponseValidationError) Reason() string { return e.reason }
+func (e DeleteTransferResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddTransactionResponseValidationError) Cause() error { return e.cause }
+func (e DeleteTransferResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddTransactionResponseValidationError) Key() bool { return e.key }
+func (e DeleteTransferResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddTransactionResponseValidationError) ErrorName() string {
-	return "AddTransactionResponseValidationError"
+func (e DeleteTransferResponseValidationError) ErrorName() string {
+	return "DeleteTransferResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddTransactionResponseValidationError) Error() string {
+func (e DeleteTransferResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2076,14 +15084,14 @@ func (e AddTransactionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddTransactionResponse.%s: %s%s",
+		"invalid %sDeleteTransferResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddTransactionResponseValidationError{}
+var _ error = DeleteTransferResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2091,91 +15099,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddTransactionResponseValidationError{}
+} = DeleteTransferResponseValidationError{}
 
-// Validate checks the field values on PatchTransactionRequest with the rules
+// Validate checks the field values on GetBaseCurrencyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PatchTransactionRequest) Validate() error {
+func (m *GetBaseCurrencyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PatchTransactionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetBaseCurrencyRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PatchTransactionRequestMultiError, or nil if none found.
-func (m *PatchTransactionRequest) ValidateAll() error {
+// GetBaseCurrencyRequestMultiError, or nil if none found.
+func (m *GetBaseCurrencyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PatchTransactionRequest) validate(all bool) error {
+func (m *GetBaseCurrencyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if m.Amount != nil {
-		// no validation rules for Amount
-	}
-
-	if m.OccurredOn != nil {
-
-		if all {
-			switch v := interface{}(m.GetOccurredOn()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PatchTransactionRequestValidationError{
-						field:  "OccurredOn",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PatchTransactionRequestValidationError{
-						field:  "OccurredOn",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetOccurredOn()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return PatchTransactionRequestValidationError{
-					field:  "OccurredOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if m.CategoryId != nil {
-		// no validation rules for CategoryId
-	}
-
-	if m.Description != nil {
-		// no validation rules for Description
-	}
-
 	if len(errors) > 0 {
-		return PatchTransactionRequestMultiError(errors)
+		return GetBaseCurrencyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PatchTransactionRequestMultiError is an error wrapping multiple validation
-// errors returned by PatchTransactionRequest.ValidateAll() if the designated
+// GetBaseCurrencyRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBaseCurrencyRequest.ValidateAll() if the designated
 // constraints aren't met.
-type PatchTransactionRequestMultiError []error
+type GetBaseCurrencyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PatchTransactionRequestMultiError) Error() string {
+func (m GetBaseCurrencyRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2184,11 +15145,11 @@ func (m PatchTransactionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PatchTransactionRequestMultiError) AllErrors() []error { return m }
+func (m GetBaseCurrencyRequestMultiError) AllErrors() []error { return m }
 
-// PatchTransactionRequestValidationError is the validation error returned by
-// PatchTransactionRequest.Validate if the designated constraints aren't met.
-type PatchTransactionRequestValidationError struct {
+// GetBaseCurrencyRequestValidationError is the validation error returned by
+// GetBaseCurrencyRequest.Validate if the designated constraints aren't met.
+type GetBaseCurrencyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2196,24 +15157,24 @@ type PatchTransactionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PatchTransactionRequestValidationError) Field() string { return e.field }
+func (e GetBaseCurrencyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PatchTransactionRequestValidationError) Reason() string { return e.reason }
+func (e GetBaseCurrencyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PatchTransactionRequestValidationError) Cause() error { return e.cause }
+func (e GetBaseCurrencyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PatchTransactionRequestValidationError) Key() bool { return e.key }
+func (e GetBaseCurrencyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PatchTransactionRequestValidationError) ErrorName() string {
-	return "PatchTransactionRequestValidationError"
+func (e GetBaseCurrencyRequestValidationError) ErrorName() string {
+	return "GetBaseCurrencyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PatchTransactionRequestValidationError) Error() string {
+func (e GetBaseCurrencyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2225,14 +15186,14 @@ func (e PatchTransactionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPatchTransactionRequest.%s: %s%s",
+		"invalid %sGetBaseCurrencyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PatchTransactionRequestValidationError{}
+var _ error = GetBaseCurrencyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2240,73 +15201,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PatchTransactionRequestValidationError{}
+} = GetBaseCurrencyRequestValidationError{}
 
-// Validate checks the field values on PatchTransactionResponse with the rules
+// Validate checks the field values on GetBaseCurrencyResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PatchTransactionResponse) Validate() error {
+func (m *GetBaseCurrencyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PatchTransactionResponse with the
+// ValidateAll checks the field values on GetBaseCurrencyResponse with the
 // rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PatchTransactionResponseMultiError, or nil if none found.
-func (m *PatchTransactionResponse) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *PatchTransactionResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetItem()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PatchTransactionResponseValidationError{
-					field:  "Item",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PatchTransactionResponseValidationError{
-					field:  "Item",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PatchTransactionResponseValidationError{
-				field:  "Item",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// violated, the result is a list of violation errors wrapped in
+// GetBaseCurrencyResponseMultiError, or nil if none found.
+func (m *GetBaseCurrencyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBaseCurrencyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Currency
+
 	if len(errors) > 0 {
-		return PatchTransactionResponseMultiError(errors)
+		return GetBaseCurrencyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PatchTransactionResponseMultiError is an error wrapping multiple validation
-// errors returned by PatchTransactionResponse.ValidateAll() if the designated
+// GetBaseCurrencyResponseMultiError is an error wrapping multiple validation
+// errors returned by GetBaseCurrencyResponse.ValidateAll() if the designated
 // constraints aren't met.
-type PatchTransactionResponseMultiError []error
+type GetBaseCurrencyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PatchTransactionResponseMultiError) Error() string {
+func (m GetBaseCurrencyResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2315,11 +15249,11 @@ func (m PatchTransactionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PatchTransactionResponseMultiError) AllErrors() []error { return m }
+func (m GetBaseCurrencyResponseMultiError) AllErrors() []error { return m }
 
-// PatchTransactionResponseValidationError is the validation error returned by
-// PatchTransactionResponse.Validate if the designated constraints aren't met.
-type PatchTransactionResponseValidationError struct {
+// GetBaseCurrencyResponseValidationError is the validation error returned by
+// GetBaseCurrencyResponse.Validate if the designated constraints aren't met.
+type GetBaseCurrencyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2327,24 +15261,24 @@ type PatchTransactionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PatchTransactionResponseValidationError) Field() string { return e.field }
+func (e GetBaseCurrencyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PatchTransactionResponseValidationError) Reason() string { return e.reason }
+func (e GetBaseCurrencyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PatchTransactionResponseValidationError) Cause() error { return e.cause }
+func (e GetBaseCurrencyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PatchTransactionResponseValidationError) Key() bool { return e.key }
+func (e GetBaseCurrencyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PatchTransactionResponseValidationError) ErrorName() string {
-	return "PatchTransactionResponseValidationError"
+func (e GetBaseCurrencyResponseValidationError) ErrorName() string {
+	return "GetBaseCurrencyResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PatchTransactionResponseValidationError) Error() string {
+func (e GetBaseCurrencyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2356,14 +15290,14 @@ func (e PatchTransactionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPatchTransactionResponse.%s: %s%s",
+		"invalid %sGetBaseCurrencyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PatchTransactionResponseValidationError{}
+var _ error = GetBaseCurrencyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2371,46 +15305,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PatchTransactionResponseValidationError{}
+} = GetBaseCurrencyResponseValidationError{}
 
-// Validate checks the field values on DeleteTransactionRequest with the rules
+// Validate checks the field values on SetBaseCurrencyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTransactionRequest) Validate() error {
+func (m *SetBaseCurrencyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTransactionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetBaseCurrencyRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteTransactionRequestMultiError, or nil if none found.
-func (m *DeleteTransactionRequest) ValidateAll() error {
+// SetBaseCurrencyRequestMultiError, or nil if none found.
+func (m *SetBaseCurrencyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTransactionRequest) validate(all bool) error {
+func (m *SetBaseCurrencyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Currency
 
 	if len(errors) > 0 {
-		return DeleteTransactionRequestMultiError(errors)
+		return SetBaseCurrencyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTransactionRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteTransactionRequest.ValidateAll() if the designated
+// SetBaseCurrencyRequestMultiError is an error wrapping multiple validation
+// errors returned by SetBaseCurrencyRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteTransactionRequestMultiError []error
+type SetBaseCurrencyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTransactionRequestMultiError) Error() string {
+func (m SetBaseCurrencyRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2419,11 +15353,11 @@ func (m DeleteTransactionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTransactionRequestMultiError) AllErrors() []error { return m }
+func (m SetBaseCurrencyRequestMultiError) AllErrors() []error { return m }
 
-// DeleteTransactionRequestValidationError is the validation error returned by
-// DeleteTransactionRequest.Validate if the designated constraints aren't met.
-type DeleteTransactionRequestValidationError struct {
+// SetBaseCurrencyRequestValidationError is the validation error returned by
+// SetBaseCurrencyRequest.Validate if the designated constraints aren't met.
+type SetBaseCurrencyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2431,24 +15365,24 @@ type DeleteTransactionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTransactionRequestValidationError) Field() string { return e.field }
+func (e SetBaseCurrencyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTransactionRequestValidationError) Reason() string { return e.reason }
+func (e SetBaseCurrencyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTransactionRequestValidationError) Cause() error { return e.cause }
+func (e SetBaseCurrencyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTransactionRequestValidationError) Key() bool { return e.key }
+func (e SetBaseCurrencyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTransactionRequestValidationError) ErrorName() string {
-	return "DeleteTransactionRequestValidationError"
+func (e SetBaseCurrencyRequestValidationError) ErrorName() string {
+	return "SetBaseCurrencyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteTransactionRequestValidationError) Error() string {
+func (e SetBaseCurrencyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2460,14 +15394,14 @@ func (e DeleteTransactionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTransactionRequest.%s: %s%s",
+		"invalid %sSetBaseCurrencyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTransactionRequestValidationError{}
+var _ error = SetBaseCurrencyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2475,44 +15409,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTransactionRequestValidationError{}
+} = SetBaseCurrencyRequestValidationError{}
 
-// Validate checks the field values on DeleteTransactionResponse with the rules
+// Validate checks the field values on SetBaseCurrencyResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTransactionResponse) Validate() error {
+func (m *SetBaseCurrencyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTransactionResponse with the
+// ValidateAll checks the field values on SetBaseCurrencyResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteTransactionResponseMultiError, or nil if none found.
-func (m *DeleteTransactionResponse) ValidateAll() error {
+// SetBaseCurrencyResponseMultiError, or nil if none found.
+func (m *SetBaseCurrencyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTransactionResponse) validate(all bool) error {
+func (m *SetBaseCurrencyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Currency
+
 	if len(errors) > 0 {
-		return DeleteTransactionResponseMultiError(errors)
+		return SetBaseCurrencyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTransactionResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteTransactionResponse.ValidateAll() if the
-// designated constraints aren't met.
-type DeleteTransactionResponseMultiError []error
+// SetBaseCurrencyResponseMultiError is an error wrapping multiple validation
+// errors returned by SetBaseCurrencyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetBaseCurrencyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTransactionResponseMultiError) Error() string {
+func (m SetBaseCurrencyResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2521,11 +15457,11 @@ func (m DeleteTransactionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTransactionResponseMultiError) AllErrors() []error { return m }
+func (m SetBaseCurrencyResponseMultiError) AllErrors() []error { return m }
 
-// DeleteTransactionResponseValidationError is the validation error returned by
-// DeleteTransactionResponse.Validate if the designated constraints aren't met.
-type DeleteTransactionResponseValidationError struct {
+// SetBaseCurrencyResponseValidationError is the validation error returned by
+// SetBaseCurrencyResponse.Validate if the designated constraints aren't met.
+type SetBaseCurrencyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2533,24 +15469,24 @@ type DeleteTransactionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTransactionResponseValidationError) Field() string { return e.field }
+func (e SetBaseCurrencyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTransactionResponseValidationError) Reason() string { return e.reason }
+func (e SetBaseCurrencyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTransactionResponseValidationError) Cause() error { return e.cause }
+func (e SetBaseCurrencyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTransactionResponseValidationError) Key() bool { return e.key }
+func (e SetBaseCurrencyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTransactionResponseValidationError) ErrorName() string {
-	return "DeleteTransactionResponseValidationError"
+func (e SetBaseCurrencyResponseValidationError) ErrorName() string {
+	return "SetBaseCurrencyResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteTransactionResponseValidationError) Error() string {
+func (e SetBaseCurrencyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2562,14 +15498,14 @@ func (e DeleteTransactionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTransactionResponse.%s: %s%s",
+		"invalid %sSetBaseCurrencyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTransactionResponseValidationError{}
+var _ error = SetBaseCurrencyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2577,59 +15513,59 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTransactionResponseValidationError{}
+} = SetBaseCurrencyResponseValidationError{}
 
-// Validate checks the field values on ListBudgetsRequest with the rules
+// Validate checks the field values on ListExchangeRatesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBudgetsRequest) Validate() error {
+func (m *ListExchangeRatesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBudgetsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListExchangeRatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBudgetsRequestMultiError, or nil if none found.
-func (m *ListBudgetsRequest) ValidateAll() error {
+// ListExchangeRatesRequestMultiError, or nil if none found.
+func (m *ListExchangeRatesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBudgetsRequest) validate(all bool) error {
+func (m *ListExchangeRatesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Limit
-
-	// no validation rules for Offset
+	if m.FilterCurrency != nil {
+		// no validation rules for FilterCurrency
+	}
 
-	if m.FilterPeriodFrom != nil {
+	if m.FilterRateDateFrom != nil {
 
 		if all {
-			switch v := interface{}(m.GetFilterPeriodFrom()).(type) {
+			switch v := interface{}(m.GetFilterRateDateFrom()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListBudgetsRequestValidationError{
-						field:  "FilterPeriodFrom",
+					errors = append(errors, ListExchangeRatesRequestValidationError{
+						field:  "FilterRateDateFrom",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListBudgetsRequestValidationError{
-						field:  "FilterPeriodFrom",
+					errors = append(errors, ListExchangeRatesRequestValidationError{
+						field:  "FilterRateDateFrom",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetFilterPeriodFrom()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetFilterRateDateFrom()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListBudgetsRequestValidationError{
-					field:  "FilterPeriodFrom",
+				return ListExchangeRatesRequestValidationError{
+					field:  "FilterRateDateFrom",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2638,31 +15574,31 @@ func (m *ListBudgetsRequest) validate(all bool) error {
 
 	}
 
-	if m.FilterPeriodTo != nil {
+	if m.FilterRateDateTo != nil {
 
 		if all {
-			switch v := interface{}(m.GetFilterPeriodTo()).(type) {
+			switch v := interface{}(m.GetFilterRateDateTo()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListBudgetsRequestValidationError{
-						field:  "FilterPeriodTo",
+					errors = append(errors, ListExchangeRatesRequestValidationError{
+						field:  "FilterRateDateTo",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListBudgetsRequestValidationError{
-						field:  "FilterPeriodTo",
+					errors = append(errors, ListExchangeRatesRequestValidationError{
+						field:  "FilterRateDateTo",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetFilterPeriodTo()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetFilterRateDateTo()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListBudgetsRequestValidationError{
-					field:  "FilterPeriodTo",
+				return ListExchangeRatesRequestValidationError{
+					field:  "FilterRateDateTo",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2672,19 +15608,19 @@ func (m *ListBudgetsRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListBudgetsRequestMultiError(errors)
+		return ListExchangeRatesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBudgetsRequestMultiError is an error wrapping multiple validation errors
-// returned by ListBudgetsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListBudgetsRequestMultiError []error
+// ListExchangeRatesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListExchangeRatesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListExchangeRatesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBudgetsRequestMultiError) Error() string {
+func (m ListExchangeRatesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2693,11 +15629,11 @@ func (m ListBudgetsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBudgetsRequestMultiError) AllErrors() []error { return m }
+func (m ListExchangeRatesRequestMultiError) AllErrors() []error { return m }
 
-// ListBudgetsRequestValidationError is the validation error returned by
-// ListBudgetsRequest.Validate if the designated constraints aren't met.
-type ListBudgetsRequestValidationError struct {
+// ListExchangeRatesRequestValidationError is the validation error returned by
+// ListExchangeRatesRequest.Validate if the designated constraints aren't met.
+type ListExchangeRatesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2705,24 +15641,24 @@ type ListBudgetsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBudgetsRequestValidationError) Field() string { return e.field }
+func (e ListExchangeRatesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBudgetsRequestValidationError) Reason() string { return e.reason }
+func (e ListExchangeRatesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBudgetsRequestValidationError) Cause() error { return e.cause }
+func (e ListExchangeRatesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBudgetsRequestValidationError) Key() bool { return e.key }
+func (e ListExchangeRatesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBudgetsRequestValidationError) ErrorName() string {
-	return "ListBudgetsRequestValidationError"
+func (e ListExchangeRatesRequestValidationError) ErrorName() string {
+	return "ListExchangeRatesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBudgetsRequestValidationError) Error() string {
+func (e ListExchangeRatesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2734,14 +15670,14 @@ func (e ListBudgetsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBudgetsRequest.%s: %s%s",
+		"invalid %sListExchangeRatesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBudgetsRequestValidationError{}
+var _ error = ListExchangeRatesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2749,24 +15685,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBudgetsRequestValidationError{}
+} = ListExchangeRatesRequestValidationError{}
 
-// Validate checks the field values on ListBudgetsResponse with the rules
+// Validate checks the field values on ListExchangeRatesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBudgetsResponse) Validate() error {
+func (m *ListExchangeRatesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBudgetsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListExchangeRatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBudgetsResponseMultiError, or nil if none found.
-func (m *ListBudgetsResponse) ValidateAll() error {
+// ListExchangeRatesResponseMultiError, or nil if none found.
+func (m *ListExchangeRatesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBudgetsResponse) validate(all bool) error {
+func (m *ListExchangeRatesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2780,7 +15716,7 @@ func (m *ListBudgetsResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListBudgetsResponseValidationError{
+					errors = append(errors, ListExchangeRatesResponseValidationError{
 						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -2788,147 +15724,39 @@ func (m *ListBudgetsResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListBudgetsResponseValidationError{
+					errors = append(errors, ListExchangeRatesResponseValidationError{
 						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListBudgetsResponseValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Total
-
-	// no validation rules for HitCache
-
-	if len(errors) > 0 {
-		return ListBudgetsResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-// ListBudgetsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListBudgetsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListBudgetsResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m ListBudgetsResponseMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m ListBudgetsResponseMultiError) AllErrors() []error { return m }
-
-// ListBudgetsResponseValidationError is the validation error returned by
-// ListBudgetsResponse.Validate if the designated constraints aren't met.
-type ListBudgetsResponseValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e ListBudgetsResponseValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e ListBudgetsResponseValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e ListBudgetsResponseValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e ListBudgetsResponseValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e ListBudgetsResponseValidationError) ErrorName() string {
-	return "ListBudgetsResponseValidationError"
-}
-
-// Error satisfies the builtin error interface
-func (e ListBudgetsResponseValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sListBudgetsResponse.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = ListBudgetsResponseValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = ListBudgetsResponseValidationError{}
-
-// Validate checks the field values on GetBudgetRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetBudgetRequest) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on GetBudgetRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetBudgetRequestMultiError, or nil if none found.
-func (m *GetBudgetRequest) ValidateAll() error {
-	return m.validate(true)
-}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListExchangeRatesResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-func (m *GetBudgetRequest) validate(all bool) error {
-	if m == nil {
-		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for Id
-
 	if len(errors) > 0 {
-		return GetBudgetRequestMultiError(errors)
+		return ListExchangeRatesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBudgetRequestMultiError is an error wrapping multiple validation errors
-// returned by GetBudgetRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetBudgetRequestMultiError []error
+// ListExchangeRatesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListExchangeRatesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListExchangeRatesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBudgetRequestMultiError) Error() string {
+func (m ListExchangeRatesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2937,11 +15765,11 @@ func (m GetBudgetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBudgetRequestMultiError) AllErrors() []error { return m }
+func (m ListExchangeRatesResponseMultiError) AllErrors() []error { return m }
 
-// GetBudgetRequestValidationError is the validation error returned by
-// GetBudgetRequest.Validate if the designated constraints aren't met.
-type GetBudgetRequestValidationError struct {
+// ListExchangeRatesResponseValidationError is the validation error returned by
+// ListExchangeRatesResponse.Validate if the designated constraints aren't met.
+type ListExchangeRatesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2949,22 +15777,24 @@ type GetBudgetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBudgetRequestValidationError) Field() string { return e.field }
+func (e ListExchangeRatesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBudgetRequestValidationError) Reason() string { return e.reason }
+func (e ListExchangeRatesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBudgetRequestValidationError) Cause() error { return e.cause }
+func (e ListExchangeRatesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBudgetRequestValidationError) Key() bool { return e.key }
+func (e ListExchangeRatesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBudgetRequestValidationError) ErrorName() string { return "GetBudgetRequestValidationError" }
+func (e ListExchangeRatesResponseValidationError) ErrorName() string {
+	return "ListExchangeRatesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetBudgetRequestValidationError) Error() string {
+func (e ListExchangeRatesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2976,14 +15806,14 @@ func (e GetBudgetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBudgetRequest.%s: %s%s",
+		"invalid %sListExchangeRatesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBudgetRequestValidationError{}
+var _ error = ListExchangeRatesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2991,75 +15821,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBudgetRequestValidationError{}
+} = ListExchangeRatesResponseValidationError{}
 
-// Validate checks the field values on GetBudgetResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetBudgetResponse) Validate() error {
+// Validate checks the field values on UpsertExchangeRatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpsertExchangeRatesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBudgetResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpsertExchangeRatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetBudgetResponseMultiError, or nil if none found.
-func (m *GetBudgetResponse) ValidateAll() error {
+// UpsertExchangeRatesRequestMultiError, or nil if none found.
+func (m *UpsertExchangeRatesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBudgetResponse) validate(all bool) error {
+func (m *UpsertExchangeRatesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetItem()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetBudgetResponseValidationError{
-					field:  "Item",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpsertExchangeRatesRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpsertExchangeRatesRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetBudgetResponseValidationError{
-					field:  "Item",
+				return UpsertExchangeRatesRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetBudgetResponseValidationError{
-				field:  "Item",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	// no validation rules for HitCache
+	}
 
 	if len(errors) > 0 {
-		return GetBudgetResponseMultiError(errors)
+		return UpsertExchangeRatesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBudgetResponseMultiError is an error wrapping multiple validation errors
-// returned by GetBudgetResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetBudgetResponseMultiError []error
+// UpsertExchangeRatesRequestMultiError is an error wrapping multiple
+// validation errors returned by UpsertExchangeRatesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpsertExchangeRatesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBudgetResponseMultiError) Error() string {
+func (m UpsertExchangeRatesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3068,11 +15901,11 @@ func (m GetBudgetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBudgetResponseMultiError) AllErrors() []error { return m }
+func (m UpsertExchangeRatesRequestMultiError) AllErrors() []error { return m }
 
-// GetBudgetResponseValidationError is the validation error returned by
-// GetBudgetResponse.Validate if the designated constraints aren't met.
-type GetBudgetResponseValidationError struct {
+// UpsertExchangeRatesRequestValidationError is the validation error returned
+// by UpsertExchangeRatesRequest.Validate if the designated constraints aren't met.
+type UpsertExchangeRatesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3080,24 +15913,24 @@ type GetBudgetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBudgetResponseValidationError) Field() string { return e.field }
+func (e UpsertExchangeRatesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBudgetResponseValidationError) Reason() string { return e.reason }
+func (e UpsertExchangeRatesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBudgetResponseValidationError) Cause() error { return e.cause }
+func (e UpsertExchangeRatesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBudgetResponseValidationError) Key() bool { return e.key }
+func (e UpsertExchangeRatesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBudgetResponseValidationError) ErrorName() string {
-	return "GetBudgetResponseValidationError"
+func (e UpsertExchangeRatesRequestValidationError) ErrorName() string {
+	return "UpsertExchangeRatesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetBudgetResponseValidationError) Error() string {
+func (e UpsertExchangeRatesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3109,14 +15942,14 @@ func (e GetBudgetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBudgetResponse.%s: %s%s",
+		"invalid %sUpsertExchangeRatesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBudgetResponseValidationError{}
+var _ error = UpsertExchangeRatesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3124,77 +15957,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBudgetResponseValidationError{}
+} = UpsertExchangeRatesRequestValidationError{}
 
-// Validate checks the field values on AddBudgetRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AddBudgetRequest) Validate() error {
+// Validate checks the field values on UpsertExchangeRatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpsertExchangeRatesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddBudgetRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpsertExchangeRatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddBudgetRequestMultiError, or nil if none found.
-func (m *AddBudgetRequest) ValidateAll() error {
+// UpsertExchangeRatesResponseMultiError, or nil if none found.
+func (m *UpsertExchangeRatesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddBudgetRequest) validate(all bool) error {
+func (m *UpsertExchangeRatesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetPeriod()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AddBudgetRequestValidationError{
-					field:  "Period",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpsertExchangeRatesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpsertExchangeRatesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AddBudgetRequestValidationError{
-					field:  "Period",
+				return UpsertExchangeRatesResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPeriod()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AddBudgetRequestValidationError{
-				field:  "Period",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	// no validation rules for CategoryId
-
-	// no validation rules for Amount
+	}
 
 	if len(errors) > 0 {
-		return AddBudgetRequestMultiError(errors)
+		return UpsertExchangeRatesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddBudgetRequestMultiError is an error wrapping multiple validation errors
-// returned by AddBudgetRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AddBudgetRequestMultiError []error
+// UpsertExchangeRatesResponseMultiError is an error wrapping multiple
+// validation errors returned by UpsertExchangeRatesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpsertExchangeRatesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddBudgetRequestMultiError) Error() string {
+func (m UpsertExchangeRatesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3203,11 +16037,12 @@ func (m AddBudgetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddBudgetRequestMultiError) AllErrors() []error { return m }
+func (m UpsertExchangeRatesResponseMultiError) AllErrors() []error { return m }
 
-// AddBudgetRequestValidationError is the validation error returned by
-// AddBudgetRequest.Validate if the designated constraints aren't met.
-type AddBudgetRequestValidationError struct {
+// UpsertExchangeRatesResponseValidationError is the validation error returned
+// by UpsertExchangeRatesResponse.Validate if the designated constraints
+// aren't met.
+type UpsertExchangeRatesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3215,22 +16050,24 @@ type AddBudgetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddBudgetRequestValidationError) Field() string { return e.field }
+func (e UpsertExchangeRatesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddBudgetRequestValidationError) Reason() string { return e.reason }
+func (e UpsertExchangeRatesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddBudgetRequestValidationError) Cause() error { return e.cause }
+func (e UpsertExchangeRatesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddBudgetRequestValidationError) Key() bool { return e.key }
+func (e UpsertExchangeRatesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddBudgetRequestValidationError) ErrorName() string { return "AddBudgetRequestValidationError" }
+func (e UpsertExchangeRatesResponseValidationError) ErrorName() string {
+	return "UpsertExchangeRatesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AddBudgetRequestValidationError) Error() string {
+func (e UpsertExchangeRatesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3242,14 +16079,14 @@ func (e AddBudgetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddBudgetRequest.%s: %s%s",
+		"invalid %sUpsertExchangeRatesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddBudgetRequestValidationError{}
+var _ error = UpsertExchangeRatesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3257,73 +16094,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddBudgetRequestValidationError{}
+} = UpsertExchangeRatesResponseValidationError{}
 
-// Validate checks the field values on AddBudgetResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AddBudgetResponse) Validate() error {
+// Validate checks the field values on ListRecurringRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRecurringRulesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddBudgetResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListRecurringRulesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddBudgetResponseMultiError, or nil if none found.
-func (m *AddBudgetResponse) ValidateAll() error {
+// ListRecurringRulesRequestMultiError, or nil if none found.
+func (m *ListRecurringRulesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddBudgetResponse) validate(all bool) error {
+func (m *ListRecurringRulesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetItem()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AddBudgetResponseValidationError{
-					field:  "Item",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AddBudgetResponseValidationError{
-					field:  "Item",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AddBudgetResponseValidationError{
-				field:  "Item",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if m.FilterIsPaused != nil {
+		// no validation rules for FilterIsPaused
 	}
 
 	if len(errors) > 0 {
-		return AddBudgetResponseMultiError(errors)
+		return ListRecurringRulesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddBudgetResponseMultiError is an error wrapping multiple validation errors
-// returned by AddBudgetResponse.ValidateAll() if the designated constraints
-// aren't met.
-type AddBudgetResponseMultiError []error
+// ListRecurringRulesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRecurringRulesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListRecurringRulesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddBudgetResponseMultiError) Error() string {
+func (m ListRecurringRulesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3332,11 +16144,11 @@ func (m AddBudgetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddBudgetResponseMultiError) AllErrors() []error { return m }
+func (m ListRecurringRulesRequestMultiError) AllErrors() []error { return m }
 
-// AddBudgetResponseValidationError is the validation error returned by
-// AddBudgetResponse.Validate if the designated constraints aren't met.
-type AddBudgetResponseValidationError struct {
+// ListRecurringRulesRequestValidationError is the validation error returned by
+// ListRecurringRulesRequest.Validate if the designated constraints aren't met.
+type ListRecurringRulesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3344,24 +16156,24 @@ type AddBudgetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddBudgetResponseValidationError) Field() string { return e.field }
+func (e ListRecurringRulesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddBudgetResponseValidationError) Reason() string { return e.reason }
+func (e ListRecurringRulesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddBudgetResponseValidationError) Cause() error { return e.cause }
+func (e ListRecurringRulesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddBudgetResponseValidationError) Key() bool { return e.key }
+func (e ListRecurringRulesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddBudgetResponseValidationError) ErrorName() string {
-	return "AddBudgetResponseValidationError"
+func (e ListRecurringRulesRequestValidationError) ErrorName() string {
+	return "ListRecurringRulesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddBudgetResponseValidationError) Error() string {
+func (e ListRecurringRulesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3373,14 +16185,14 @@ func (e AddBudgetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddBudgetResponse.%s: %s%s",
+		"invalid %sListRecurringRulesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddBudgetResponseValidationError{}
+var _ error = ListRecurringRulesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3388,57 +16200,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddBudgetResponseValidationError{}
+} = ListRecurringRulesRequestValidationError{}
 
-// Validate checks the field values on PatchBudgetRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListRecurringRulesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PatchBudgetRequest) Validate() error {
+func (m *ListRecurringRulesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PatchBudgetRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListRecurringRulesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PatchBudgetRequestMultiError, or nil if none found.
-func (m *PatchBudgetRequest) ValidateAll() error {
+// ListRecurringRulesResponseMultiError, or nil if none found.
+func (m *ListRecurringRulesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PatchBudgetRequest) validate(all bool) error {
+func (m *ListRecurringRulesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if m.Period != nil {
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetPeriod()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PatchBudgetRequestValidationError{
-						field:  "Period",
+					errors = append(errors, ListRecurringRulesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PatchBudgetRequestValidationError{
-						field:  "Period",
+					errors = append(errors, ListRecurringRulesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetPeriod()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PatchBudgetRequestValidationError{
-					field:  "Period",
+				return ListRecurringRulesResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3447,28 +16258,20 @@ func (m *PatchBudgetRequest) validate(all bool) error {
 
 	}
 
-	if m.CategoryId != nil {
-		// no validation rules for CategoryId
-	}
-
-	if m.Amount != nil {
-		// no validation rules for Amount
-	}
-
 	if len(errors) > 0 {
-		return PatchBudgetRequestMultiError(errors)
+		return ListRecurringRulesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PatchBudgetRequestMultiError is an error wrapping multiple validation errors
-// returned by PatchBudgetRequest.ValidateAll() if the designated constraints
-// aren't met.
-type PatchBudgetRequestMultiError []error
+// ListRecurringRulesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListRecurringRulesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListRecurringRulesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PatchBudgetRequestMultiError) Error() string {
+func (m ListRecurringRulesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3477,11 +16280,11 @@ func (m PatchBudgetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PatchBudgetRequestMultiError) AllErrors() []error { return m }
+func (m ListRecurringRulesResponseMultiError) AllErrors() []error { return m }
 
-// PatchBudgetRequestValidationError is the validation error returned by
-// PatchBudgetRequest.Validate if the designated constraints aren't met.
-type PatchBudgetRequestValidationError struct {
+// ListRecurringRulesResponseValidationError is the validation error returned
+// by ListRecurringRulesResponse.Validate if the designated constraints aren't met.
+type ListRecurringRulesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3489,24 +16292,24 @@ type PatchBudgetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PatchBudgetRequestValidationError) Field() string { return e.field }
+func (e ListRecurringRulesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PatchBudgetRequestValidationError) Reason() string { return e.reason }
+func (e ListRecurringRulesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PatchBudgetRequestValidationError) Cause() error { return e.cause }
+func (e ListRecurringRulesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PatchBudgetRequestValidationError) Key() bool { return e.key }
+func (e ListRecurringRulesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PatchBudgetRequestValidationError) ErrorName() string {
-	return "PatchBudgetRequestValidationError"
+func (e ListRecurringRulesResponseValidationError) ErrorName() string {
+	return "ListRecurringRulesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PatchBudgetRequestValidationError) Error() string {
+func (e ListRecurringRulesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3518,14 +16321,14 @@ func (e PatchBudgetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPatchBudgetRequest.%s: %s%s",
+		"invalid %sListRecurringRulesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PatchBudgetRequestValidationError{}
+var _ error = ListRecurringRulesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3533,73 +16336,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PatchBudgetRequestValidationError{}
+} = ListRecurringRulesResponseValidationError{}
 
-// Validate checks the field values on PatchBudgetResponse with the rules
+// Validate checks the field values on GetRecurringRuleRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PatchBudgetResponse) Validate() error {
+func (m *GetRecurringRuleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PatchBudgetResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetRecurringRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PatchBudgetResponseMultiError, or nil if none found.
-func (m *PatchBudgetResponse) ValidateAll() error {
+// GetRecurringRuleRequestMultiError, or nil if none found.
+func (m *GetRecurringRuleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PatchBudgetResponse) validate(all bool) error {
+func (m *GetRecurringRuleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetItem()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PatchBudgetResponseValidationError{
-					field:  "Item",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PatchBudgetResponseValidationError{
-					field:  "Item",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PatchBudgetResponseValidationError{
-				field:  "Item",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return PatchBudgetResponseMultiError(errors)
+		return GetRecurringRuleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PatchBudgetResponseMultiError is an error wrapping multiple validation
-// errors returned by PatchBudgetResponse.ValidateAll() if the designated
+// GetRecurringRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRecurringRuleRequest.ValidateAll() if the designated
 // constraints aren't met.
-type PatchBudgetResponseMultiError []error
+type GetRecurringRuleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PatchBudgetResponseMultiError) Error() string {
+func (m GetRecurringRuleRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3608,11 +16384,11 @@ func (m PatchBudgetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PatchBudgetResponseMultiError) AllErrors() []error { return m }
+func (m GetRecurringRuleRequestMultiError) AllErrors() []error { return m }
 
-// PatchBudgetResponseValidationError is the validation error returned by
-// PatchBudgetResponse.Validate if the designated constraints aren't met.
-type PatchBudgetResponseValidationError struct {
+// GetRecurringRuleRequestValidationError is the validation error returned by
+// GetRecurringRuleRequest.Validate if the designated constraints aren't met.
+type GetRecurringRuleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3620,24 +16396,24 @@ type PatchBudgetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PatchBudgetResponseValidationError) Field() string { return e.field }
+func (e GetRecurringRuleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PatchBudgetResponseValidationError) Reason() string { return e.reason }
+func (e GetRecurringRuleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PatchBudgetResponseValidationError) Cause() error { return e.cause }
+func (e GetRecurringRuleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PatchBudgetResponseValidationError) Key() bool { return e.key }
+func (e GetRecurringRuleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PatchBudgetResponseValidationError) ErrorName() string {
-	return "PatchBudgetResponseValidationError"
+func (e GetRecurringRuleRequestValidationError) ErrorName() string {
+	return "GetRecurringRuleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PatchBudgetResponseValidationError) Error() string {
+func (e GetRecurringRuleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3649,14 +16425,14 @@ func (e PatchBudgetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPatchBudgetResponse.%s: %s%s",
+		"invalid %sGetRecurringRuleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PatchBudgetResponseValidationError{}
+var _ error = GetRecurringRuleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3664,46 +16440,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PatchBudgetResponseValidationError{}
+} = GetRecurringRuleRequestValidationError{}
 
-// Validate checks the field values on DeleteBudgetRequest with the rules
+// Validate checks the field values on GetRecurringRuleResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteBudgetRequest) Validate() error {
+func (m *GetRecurringRuleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBudgetRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetRecurringRuleResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteBudgetRequestMultiError, or nil if none found.
-func (m *DeleteBudgetRequest) ValidateAll() error {
+// GetRecurringRuleResponseMultiError, or nil if none found.
+func (m *GetRecurringRuleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBudgetRequest) validate(all bool) error {
+func (m *GetRecurringRuleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRecurringRuleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRecurringRuleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRecurringRuleResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteBudgetRequestMultiError(errors)
+		return GetRecurringRuleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBudgetRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteBudgetRequest.ValidateAll() if the designated
+// GetRecurringRuleResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRecurringRuleResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteBudgetRequestMultiError []error
+type GetRecurringRuleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBudgetRequestMultiError) Error() string {
+func (m GetRecurringRuleResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3712,11 +16515,11 @@ func (m DeleteBudgetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBudgetRequestMultiError) AllErrors() []error { return m }
+func (m GetRecurringRuleResponseMultiError) AllErrors() []error { return m }
 
-// DeleteBudgetRequestValidationError is the validation error returned by
-// DeleteBudgetRequest.Validate if the designated constraints aren't met.
-type DeleteBudgetRequestValidationError struct {
+// GetRecurringRuleResponseValidationError is the validation error returned by
+// GetRecurringRuleResponse.Validate if the designated constraints aren't met.
+type GetRecurringRuleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3724,24 +16527,24 @@ type DeleteBudgetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBudgetRequestValidationError) Field() string { return e.field }
+func (e GetRecurringRuleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBudgetRequestValidationError) Reason() string { return e.reason }
+func (e GetRecurringRuleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBudgetRequestValidationError) Cause() error { return e.cause }
+func (e GetRecurringRuleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBudgetRequestValidationError) Key() bool { return e.key }
+func (e GetRecurringRuleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBudgetRequestValidationError) ErrorName() string {
-	return "DeleteBudgetRequestValidationError"
+func (e GetRecurringRuleResponseValidationError) ErrorName() string {
+	return "GetRecurringRuleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBudgetRequestValidationError) Error() string {
+func (e GetRecurringRuleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3753,14 +16556,14 @@ func (e DeleteBudgetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBudgetRequest.%s: %s%s",
+		"invalid %sGetRecurringRuleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBudgetRequestValidationError{}
+var _ error = GetRecurringRuleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3768,44 +16571,132 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBudgetRequestValidationError{}
+} = GetRecurringRuleResponseValidationError{}
 
-// Validate checks the field values on DeleteBudgetResponse with the rules
+// Validate checks the field values on AddRecurringRuleRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteBudgetResponse) Validate() error {
+func (m *AddRecurringRuleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBudgetResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AddRecurringRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteBudgetResponseMultiError, or nil if none found.
-func (m *DeleteBudgetResponse) ValidateAll() error {
+// AddRecurringRuleRequestMultiError, or nil if none found.
+func (m *AddRecurringRuleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBudgetResponse) validate(all bool) error {
+func (m *AddRecurringRuleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for IsIncome
+
+	// no validation rules for Amount
+
+	// no validation rules for CategoryId
+
+	// no validation rules for Description
+
+	// no validation rules for Frequency
+
+	// no validation rules for Interval
+
+	// no validation rules for DayOfMonth
+
+	if all {
+		switch v := interface{}(m.GetStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddRecurringRuleRequestValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddRecurringRuleRequestValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddRecurringRuleRequestValidationError{
+				field:  "StartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.WalletId != nil {
+		// no validation rules for WalletId
+	}
+
+	if m.Currency != nil {
+		// no validation rules for Currency
+	}
+
+	if m.EndDate != nil {
+
+		if all {
+			switch v := interface{}(m.GetEndDate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddRecurringRuleRequestValidationError{
+						field:  "EndDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddRecurringRuleRequestValidationError{
+						field:  "EndDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddRecurringRuleRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Count != nil {
+		// no validation rules for Count
+	}
+
 	if len(errors) > 0 {
-		return DeleteBudgetResponseMultiError(errors)
+		return AddRecurringRuleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBudgetResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteBudgetResponse.ValidateAll() if the designated
+// AddRecurringRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by AddRecurringRuleRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteBudgetResponseMultiError []error
+type AddRecurringRuleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBudgetResponseMultiError) Error() string {
+func (m AddRecurringRuleRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3814,11 +16705,11 @@ func (m DeleteBudgetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBudgetResponseMultiError) AllErrors() []error { return m }
+func (m AddRecurringRuleRequestMultiError) AllErrors() []error { return m }
 
-// DeleteBudgetResponseValidationError is the validation error returned by
-// DeleteBudgetResponse.Validate if the designated constraints aren't met.
-type DeleteBudgetResponseValidationError struct {
+// AddRecurringRuleRequestValidationError is the validation error returned by
+// AddRecurringRuleRequest.Validate if the designated constraints aren't met.
+type AddRecurringRuleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3826,24 +16717,24 @@ type DeleteBudgetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBudgetResponseValidationError) Field() string { return e.field }
+func (e AddRecurringRuleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBudgetResponseValidationError) Reason() string { return e.reason }
+func (e AddRecurringRuleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBudgetResponseValidationError) Cause() error { return e.cause }
+func (e AddRecurringRuleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBudgetResponseValidationError) Key() bool { return e.key }
+func (e AddRecurringRuleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBudgetResponseValidationError) ErrorName() string {
-	return "DeleteBudgetResponseValidationError"
+func (e AddRecurringRuleRequestValidationError) ErrorName() string {
+	return "AddRecurringRuleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBudgetResponseValidationError) Error() string {
+func (e AddRecurringRuleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3855,14 +16746,14 @@ func (e DeleteBudgetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBudgetResponse.%s: %s%s",
+		"invalid %sAddRecurringRuleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBudgetResponseValidationError{}
+var _ error = AddRecurringRuleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3870,24 +16761,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBudgetResponseValidationError{}
+} = AddRecurringRuleRequestValidationError{}
 
-// Validate checks the field values on ListReportsRequest with the rules
+// Validate checks the field values on AddRecurringRuleResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListReportsRequest) Validate() error {
+func (m *AddRecurringRuleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListReportsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AddRecurringRuleResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListReportsRequestMultiError, or nil if none found.
-func (m *ListReportsRequest) ValidateAll() error {
+// AddRecurringRuleResponseMultiError, or nil if none found.
+func (m *AddRecurringRuleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListReportsRequest) validate(all bool) error {
+func (m *AddRecurringRuleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3895,57 +16786,28 @@ func (m *ListReportsRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetDateFrom()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListReportsRequestValidationError{
-					field:  "DateFrom",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListReportsRequestValidationError{
-					field:  "DateFrom",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDateFrom()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListReportsRequestValidationError{
-				field:  "DateFrom",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetDateTo()).(type) {
+		switch v := interface{}(m.GetItem()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListReportsRequestValidationError{
-					field:  "DateTo",
+				errors = append(errors, AddRecurringRuleResponseValidationError{
+					field:  "Item",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListReportsRequestValidationError{
-					field:  "DateTo",
+				errors = append(errors, AddRecurringRuleResponseValidationError{
+					field:  "Item",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDateTo()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListReportsRequestValidationError{
-				field:  "DateTo",
+			return AddRecurringRuleResponseValidationError{
+				field:  "Item",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3953,19 +16815,19 @@ func (m *ListReportsRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListReportsRequestMultiError(errors)
+		return AddRecurringRuleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListReportsRequestMultiError is an error wrapping multiple validation errors
-// returned by ListReportsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListReportsRequestMultiError []error
+// AddRecurringRuleResponseMultiError is an error wrapping multiple validation
+// errors returned by AddRecurringRuleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddRecurringRuleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListReportsRequestMultiError) Error() string {
+func (m AddRecurringRuleResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3974,11 +16836,11 @@ func (m ListReportsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListReportsRequestMultiError) AllErrors() []error { return m }
+func (m AddRecurringRuleResponseMultiError) AllErrors() []error { return m }
 
-// ListReportsRequestValidationError is the validation error returned by
-// ListReportsRequest.Validate if the designated constraints aren't met.
-type ListReportsRequestValidationError struct {
+// AddRecurringRuleResponseValidationError is the validation error returned by
+// AddRecurringRuleResponse.Validate if the designated constraints aren't met.
+type AddRecurringRuleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3986,24 +16848,24 @@ type ListReportsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListReportsRequestValidationError) Field() string { return e.field }
+func (e AddRecurringRuleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListReportsRequestValidationError) Reason() string { return e.reason }
+func (e AddRecurringRuleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListReportsRequestValidationError) Cause() error { return e.cause }
+func (e AddRecurringRuleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListReportsRequestValidationError) Key() bool { return e.key }
+func (e AddRecurringRuleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListReportsRequestValidationError) ErrorName() string {
-	return "ListReportsRequestValidationError"
+func (e AddRecurringRuleResponseValidationError) ErrorName() string {
+	return "AddRecurringRuleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListReportsRequestValidationError) Error() string {
+func (e AddRecurringRuleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4015,14 +16877,14 @@ func (e ListReportsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListReportsRequest.%s: %s%s",
+		"invalid %sAddRecurringRuleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListReportsRequestValidationError{}
+var _ error = AddRecurringRuleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4030,56 +16892,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListReportsRequestValidationError{}
+} = AddRecurringRuleResponseValidationError{}
 
-// Validate checks the field values on ListReportsResponse with the rules
+// Validate checks the field values on PatchRecurringRuleRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListReportsResponse) Validate() error {
+func (m *PatchRecurringRuleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListReportsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PatchRecurringRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListReportsResponseMultiError, or nil if none found.
-func (m *ListReportsResponse) ValidateAll() error {
+// PatchRecurringRuleRequestMultiError, or nil if none found.
+func (m *PatchRecurringRuleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListReportsResponse) validate(all bool) error {
+func (m *PatchRecurringRuleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetReports() {
-		_, _ = idx, item
+	// no validation rules for Id
+
+	if m.Amount != nil {
+		// no validation rules for Amount
+	}
+
+	if m.Currency != nil {
+		// no validation rules for Currency
+	}
+
+	if m.CategoryId != nil {
+		// no validation rules for CategoryId
+	}
+
+	if m.WalletId != nil {
+		// no validation rules for WalletId
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if m.EndDate != nil {
 
 		if all {
-			switch v := interface{}(item).(type) {
+			switch v := interface{}(m.GetEndDate()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListReportsResponseValidationError{
-						field:  fmt.Sprintf("Reports[%v]", idx),
+					errors = append(errors, PatchRecurringRuleRequestValidationError{
+						field:  "EndDate",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListReportsResponseValidationError{
-						field:  fmt.Sprintf("Reports[%v]", idx),
+					errors = append(errors, PatchRecurringRuleRequestValidationError{
+						field:  "EndDate",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListReportsResponseValidationError{
-					field:  fmt.Sprintf("Reports[%v]", idx),
+				return PatchRecurringRuleRequestValidationError{
+					field:  "EndDate",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -4088,22 +16971,28 @@ func (m *ListReportsResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for HitCache
+	if m.Count != nil {
+		// no validation rules for Count
+	}
+
+	if m.IsPaused != nil {
+		// no validation rules for IsPaused
+	}
 
 	if len(errors) > 0 {
-		return ListReportsResponseMultiError(errors)
+		return PatchRecurringRuleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListReportsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListReportsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListReportsResponseMultiError []error
+// PatchRecurringRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by PatchRecurringRuleRequest.ValidateAll() if the
+// designated constraints aren't met.
+type PatchRecurringRuleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListReportsResponseMultiError) Error() string {
+func (m PatchRecurringRuleRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4112,11 +17001,11 @@ func (m ListReportsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListReportsResponseMultiError) AllErrors() []error { return m }
+func (m PatchRecurringRuleRequestMultiError) AllErrors() []error { return m }
 
-// ListReportsResponseValidationError is the validation error returned by
-// ListReportsResponse.Validate if the designated constraints aren't met.
-type ListReportsResponseValidationError struct {
+// PatchRecurringRuleRequestValidationError is the validation error returned by
+// PatchRecurringRuleRequest.Validate if the designated constraints aren't met.
+type PatchRecurringRuleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4124,24 +17013,24 @@ type ListReportsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListReportsResponseValidationError) Field() string { return e.field }
+func (e PatchRecurringRuleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListReportsResponseValidationError) Reason() string { return e.reason }
+func (e PatchRecurringRuleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListReportsResponseValidationError) Cause() error { return e.cause }
+func (e PatchRecurringRuleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListReportsResponseValidationError) Key() bool { return e.key }
+func (e PatchRecurringRuleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListReportsResponseValidationError) ErrorName() string {
-	return "ListReportsResponseValidationError"
+func (e PatchRecurringRuleRequestValidationError) ErrorName() string {
+	return "PatchRecurringRuleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListReportsResponseValidationError) Error() string {
+func (e PatchRecurringRuleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4153,14 +17042,14 @@ func (e ListReportsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListReportsResponse.%s: %s%s",
+		"invalid %sPatchRecurringRuleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListReportsResponseValidationError{}
+var _ error = PatchRecurringRuleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4168,50 +17057,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListReportsResponseValidationError{}
+} = PatchRecurringRuleRequestValidationError{}
 
-// Validate checks the field values on CSVExportTransactionsResponse with the
+// Validate checks the field values on PatchRecurringRuleResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CSVExportTransactionsResponse) Validate() error {
+func (m *PatchRecurringRuleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CSVExportTransactionsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CSVExportTransactionsResponseMultiError, or nil if none found.
-func (m *CSVExportTransactionsResponse) ValidateAll() error {
+// ValidateAll checks the field values on PatchRecurringRuleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PatchRecurringRuleResponseMultiError, or nil if none found.
+func (m *PatchRecurringRuleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CSVExportTransactionsResponse) validate(all bool) error {
+func (m *PatchRecurringRuleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Data
-
-	// no validation rules for Total
-
-	// no validation rules for HitCache
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PatchRecurringRuleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PatchRecurringRuleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PatchRecurringRuleResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return CSVExportTransactionsResponseMultiError(errors)
+		return PatchRecurringRuleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CSVExportTransactionsResponseMultiError is an error wrapping multiple
-// validation errors returned by CSVExportTransactionsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type CSVExportTransactionsResponseMultiError []error
+// PatchRecurringRuleResponseMultiError is an error wrapping multiple
+// validation errors returned by PatchRecurringRuleResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PatchRecurringRuleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CSVExportTransactionsResponseMultiError) Error() string {
+func (m PatchRecurringRuleResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4220,12 +17132,11 @@ func (m CSVExportTransactionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CSVExportTransactionsResponseMultiError) AllErrors() []error { return m }
+func (m PatchRecurringRuleResponseMultiError) AllErrors() []error { return m }
 
-// CSVExportTransactionsResponseValidationError is the validation error
-// returned by CSVExportTransactionsResponse.Validate if the designated
-// constraints aren't met.
-type CSVExportTransactionsResponseValidationError struct {
+// PatchRecurringRuleResponseValidationError is the validation error returned
+// by PatchRecurringRuleResponse.Validate if the designated constraints aren't met.
+type PatchRecurringRuleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4233,24 +17144,24 @@ type CSVExportTransactionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CSVExportTransactionsResponseValidationError) Field() string { return e.field }
+func (e PatchRecurringRuleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CSVExportTransactionsResponseValidationError) Reason() string { return e.reason }
+func (e PatchRecurringRuleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CSVExportTransactionsResponseValidationError) Cause() error { return e.cause }
+func (e PatchRecurringRuleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CSVExportTransactionsResponseValidationError) Key() bool { return e.key }
+func (e PatchRecurringRuleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CSVExportTransactionsResponseValidationError) ErrorName() string {
-	return "CSVExportTransactionsResponseValidationError"
+func (e PatchRecurringRuleResponseValidationError) ErrorName() string {
+	return "PatchRecurringRuleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CSVExportTransactionsResponseValidationError) Error() string {
+func (e PatchRecurringRuleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4262,14 +17173,14 @@ func (e CSVExportTransactionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCSVExportTransactionsResponse.%s: %s%s",
+		"invalid %sPatchRecurringRuleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CSVExportTransactionsResponseValidationError{}
+var _ error = PatchRecurringRuleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4277,46 +17188,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CSVExportTransactionsResponseValidationError{}
+} = PatchRecurringRuleResponseValidationError{}
 
-// Validate checks the field values on CSVImportTransactionsRequest with the
+// Validate checks the field values on DeleteRecurringRuleRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CSVImportTransactionsRequest) Validate() error {
+func (m *DeleteRecurringRuleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CSVImportTransactionsRequest with the
+// ValidateAll checks the field values on DeleteRecurringRuleRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CSVImportTransactionsRequestMultiError, or nil if none found.
-func (m *CSVImportTransactionsRequest) ValidateAll() error {
+// DeleteRecurringRuleRequestMultiError, or nil if none found.
+func (m *DeleteRecurringRuleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CSVImportTransactionsRequest) validate(all bool) error {
+func (m *DeleteRecurringRuleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Data
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return CSVImportTransactionsRequestMultiError(errors)
+		return DeleteRecurringRuleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CSVImportTransactionsRequestMultiError is an error wrapping multiple
-// validation errors returned by CSVImportTransactionsRequest.ValidateAll() if
+// DeleteRecurringRuleRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteRecurringRuleRequest.ValidateAll() if
 // the designated constraints aren't met.
-type CSVImportTransactionsRequestMultiError []error
+type DeleteRecurringRuleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CSVImportTransactionsRequestMultiError) Error() string {
+func (m DeleteRecurringRuleRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4325,12 +17236,11 @@ func (m CSVImportTransactionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CSVImportTransactionsRequestMultiError) AllErrors() []error { return m }
+func (m DeleteRecurringRuleRequestMultiError) AllErrors() []error { return m }
 
-// CSVImportTransactionsRequestValidationError is the validation error returned
-// by CSVImportTransactionsRequest.Validate if the designated constraints
-// aren't met.
-type CSVImportTransactionsRequestValidationError struct {
+// DeleteRecurringRuleRequestValidationError is the validation error returned
+// by DeleteRecurringRuleRequest.Validate if the designated constraints aren't met.
+type DeleteRecurringRuleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4338,24 +17248,24 @@ type CSVImportTransactionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CSVImportTransactionsRequestValidationError) Field() string { return e.field }
+func (e DeleteRecurringRuleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CSVImportTransactionsRequestValidationError) Reason() string { return e.reason }
+func (e DeleteRecurringRuleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CSVImportTransactionsRequestValidationError) Cause() error { return e.cause }
+func (e DeleteRecurringRuleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CSVImportTransactionsRequestValidationError) Key() bool { return e.key }
+func (e DeleteRecurringRuleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CSVImportTransactionsRequestValidationError) ErrorName() string {
-	return "CSVImportTransactionsRequestValidationError"
+func (e DeleteRecurringRuleRequestValidationError) ErrorName() string {
+	return "DeleteRecurringRuleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CSVImportTransactionsRequestValidationError) Error() string {
+func (e DeleteRecurringRuleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4367,14 +17277,14 @@ func (e CSVImportTransactionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCSVImportTransactionsRequest.%s: %s%s",
+		"invalid %sDeleteRecurringRuleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CSVImportTransactionsRequestValidationError{}
+var _ error = DeleteRecurringRuleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4382,24 +17292,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CSVImportTransactionsRequestValidationError{}
+} = DeleteRecurringRuleRequestValidationError{}
 
-// Validate checks the field values on CSVImportTransactionsResponse with the
+// Validate checks the field values on DeleteRecurringRuleResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CSVImportTransactionsResponse) Validate() error {
+func (m *DeleteRecurringRuleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CSVImportTransactionsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CSVImportTransactionsResponseMultiError, or nil if none found.
-func (m *CSVImportTransactionsResponse) ValidateAll() error {
+// ValidateAll checks the field values on DeleteRecurringRuleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRecurringRuleResponseMultiError, or nil if none found.
+func (m *DeleteRecurringRuleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CSVImportTransactionsResponse) validate(all bool) error {
+func (m *DeleteRecurringRuleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4407,19 +17317,19 @@ func (m *CSVImportTransactionsResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return CSVImportTransactionsResponseMultiError(errors)
+		return DeleteRecurringRuleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CSVImportTransactionsResponseMultiError is an error wrapping multiple
-// validation errors returned by CSVImportTransactionsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type CSVImportTransactionsResponseMultiError []error
+// DeleteRecurringRuleResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteRecurringRuleResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteRecurringRuleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CSVImportTransactionsResponseMultiError) Error() string {
+func (m DeleteRecurringRuleResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4428,12 +17338,12 @@ func (m CSVImportTransactionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CSVImportTransactionsResponseMultiError) AllErrors() []error { return m }
+func (m DeleteRecurringRuleResponseMultiError) AllErrors() []error { return m }
 
-// CSVImportTransactionsResponseValidationError is the validation error
-// returned by CSVImportTransactionsResponse.Validate if the designated
-// constraints aren't met.
-type CSVImportTransactionsResponseValidationError struct {
+// DeleteRecurringRuleResponseValidationError is the validation error returned
+// by DeleteRecurringRuleResponse.Validate if the designated constraints
+// aren't met.
+type DeleteRecurringRuleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4441,24 +17351,24 @@ type CSVImportTransactionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CSVImportTransactionsResponseValidationError) Field() string { return e.field }
+func (e DeleteRecurringRuleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CSVImportTransactionsResponseValidationError) Reason() string { return e.reason }
+func (e DeleteRecurringRuleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CSVImportTransactionsResponseValidationError) Cause() error { return e.cause }
+func (e DeleteRecurringRuleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CSVImportTransactionsResponseValidationError) Key() bool { return e.key }
+func (e DeleteRecurringRuleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CSVImportTransactionsResponseValidationError) ErrorName() string {
-	return "CSVImportTransactionsResponseValidationError"
+func (e DeleteRecurringRuleResponseValidationError) ErrorName() string {
+	return "DeleteRecurringRuleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CSVImportTransactionsResponseValidationError) Error() string {
+func (e DeleteRecurringRuleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4470,14 +17380,14 @@ func (e CSVImportTransactionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCSVImportTransactionsResponse.%s: %s%s",
+		"invalid %sDeleteRecurringRuleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CSVImportTransactionsResponseValidationError{}
+var _ error = DeleteRecurringRuleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4485,4 +17395,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CSVImportTransactionsResponseValidationError{}
+} = DeleteRecurringRuleResponseValidationError{}
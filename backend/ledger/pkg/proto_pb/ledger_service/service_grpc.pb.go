@@ -19,20 +19,60 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Ledger_ListCategories_FullMethodName        = "/ledger_service.v1.Ledger/ListCategories"
-	Ledger_ListTransactions_FullMethodName      = "/ledger_service.v1.Ledger/ListTransactions"
-	Ledger_GetTransaction_FullMethodName        = "/ledger_service.v1.Ledger/GetTransaction"
-	Ledger_AddTransaction_FullMethodName        = "/ledger_service.v1.Ledger/AddTransaction"
-	Ledger_PatchTransaction_FullMethodName      = "/ledger_service.v1.Ledger/PatchTransaction"
-	Ledger_DeleteTransaction_FullMethodName     = "/ledger_service.v1.Ledger/DeleteTransaction"
-	Ledger_ListBudgets_FullMethodName           = "/ledger_service.v1.Ledger/ListBudgets"
-	Ledger_GetBudget_FullMethodName             = "/ledger_service.v1.Ledger/GetBudget"
-	Ledger_AddBudget_FullMethodName             = "/ledger_service.v1.Ledger/AddBudget"
-	Ledger_PatchBudget_FullMethodName           = "/ledger_service.v1.Ledger/PatchBudget"
-	Ledger_DeleteBudget_FullMethodName          = "/ledger_service.v1.Ledger/DeleteBudget"
-	Ledger_ListReports_FullMethodName           = "/ledger_service.v1.Ledger/ListReports"
-	Ledger_CSVExportTransactions_FullMethodName = "/ledger_service.v1.Ledger/CSVExportTransactions"
-	Ledger_CSVImportTransactions_FullMethodName = "/ledger_service.v1.Ledger/CSVImportTransactions"
+	Ledger_ListCategories_FullMethodName           = "/ledger_service.v1.Ledger/ListCategories"
+	Ledger_AddCategory_FullMethodName              = "/ledger_service.v1.Ledger/AddCategory"
+	Ledger_PatchCategory_FullMethodName            = "/ledger_service.v1.Ledger/PatchCategory"
+	Ledger_DeleteCategory_FullMethodName           = "/ledger_service.v1.Ledger/DeleteCategory"
+	Ledger_ListTransactions_FullMethodName         = "/ledger_service.v1.Ledger/ListTransactions"
+	Ledger_GetTransaction_FullMethodName           = "/ledger_service.v1.Ledger/GetTransaction"
+	Ledger_AddTransaction_FullMethodName           = "/ledger_service.v1.Ledger/AddTransaction"
+	Ledger_AddTransactionsBatch_FullMethodName     = "/ledger_service.v1.Ledger/AddTransactionsBatch"
+	Ledger_PatchTransaction_FullMethodName         = "/ledger_service.v1.Ledger/PatchTransaction"
+	Ledger_DeleteTransaction_FullMethodName        = "/ledger_service.v1.Ledger/DeleteTransaction"
+	Ledger_ListBudgets_FullMethodName              = "/ledger_service.v1.Ledger/ListBudgets"
+	Ledger_GetBudget_FullMethodName                = "/ledger_service.v1.Ledger/GetBudget"
+	Ledger_AddBudget_FullMethodName                = "/ledger_service.v1.Ledger/AddBudget"
+	Ledger_PatchBudget_FullMethodName              = "/ledger_service.v1.Ledger/PatchBudget"
+	Ledger_DeleteBudget_FullMethodName             = "/ledger_service.v1.Ledger/DeleteBudget"
+	Ledger_CopyBudgets_FullMethodName              = "/ledger_service.v1.Ledger/CopyBudgets"
+	Ledger_GetBudgetAutoRollover_FullMethodName    = "/ledger_service.v1.Ledger/GetBudgetAutoRollover"
+	Ledger_SetBudgetAutoRollover_FullMethodName    = "/ledger_service.v1.Ledger/SetBudgetAutoRollover"
+	Ledger_ListReports_FullMethodName              = "/ledger_service.v1.Ledger/ListReports"
+	Ledger_CSVExportTransactions_FullMethodName    = "/ledger_service.v1.Ledger/CSVExportTransactions"
+	Ledger_StreamExportTransactions_FullMethodName = "/ledger_service.v1.Ledger/StreamExportTransactions"
+	Ledger_ExportTransactions_FullMethodName       = "/ledger_service.v1.Ledger/ExportTransactions"
+	Ledger_ExportReports_FullMethodName            = "/ledger_service.v1.Ledger/ExportReports"
+	Ledger_CSVImportTransactions_FullMethodName    = "/ledger_service.v1.Ledger/CSVImportTransactions"
+	Ledger_ListWallets_FullMethodName              = "/ledger_service.v1.Ledger/ListWallets"
+	Ledger_GetWallet_FullMethodName                = "/ledger_service.v1.Ledger/GetWallet"
+	Ledger_AddWallet_FullMethodName                = "/ledger_service.v1.Ledger/AddWallet"
+	Ledger_PatchWallet_FullMethodName              = "/ledger_service.v1.Ledger/PatchWallet"
+	Ledger_DeleteWallet_FullMethodName             = "/ledger_service.v1.Ledger/DeleteWallet"
+	Ledger_GetWalletBalances_FullMethodName        = "/ledger_service.v1.Ledger/GetWalletBalances"
+	Ledger_ListImportProfiles_FullMethodName       = "/ledger_service.v1.Ledger/ListImportProfiles"
+	Ledger_GetImportProfile_FullMethodName         = "/ledger_service.v1.Ledger/GetImportProfile"
+	Ledger_AddImportProfile_FullMethodName         = "/ledger_service.v1.Ledger/AddImportProfile"
+	Ledger_PatchImportProfile_FullMethodName       = "/ledger_service.v1.Ledger/PatchImportProfile"
+	Ledger_DeleteImportProfile_FullMethodName      = "/ledger_service.v1.Ledger/DeleteImportProfile"
+	Ledger_ListCategoryRules_FullMethodName        = "/ledger_service.v1.Ledger/ListCategoryRules"
+	Ledger_GetCategoryRule_FullMethodName          = "/ledger_service.v1.Ledger/GetCategoryRule"
+	Ledger_AddCategoryRule_FullMethodName          = "/ledger_service.v1.Ledger/AddCategoryRule"
+	Ledger_PatchCategoryRule_FullMethodName        = "/ledger_service.v1.Ledger/PatchCategoryRule"
+	Ledger_DeleteCategoryRule_FullMethodName       = "/ledger_service.v1.Ledger/DeleteCategoryRule"
+	Ledger_ReapplyRules_FullMethodName             = "/ledger_service.v1.Ledger/ReapplyRules"
+	Ledger_GetTransfer_FullMethodName              = "/ledger_service.v1.Ledger/GetTransfer"
+	Ledger_AddTransfer_FullMethodName              = "/ledger_service.v1.Ledger/AddTransfer"
+	Ledger_PatchTransfer_FullMethodName            = "/ledger_service.v1.Ledger/PatchTransfer"
+	Ledger_DeleteTransfer_FullMethodName           = "/ledger_service.v1.Ledger/DeleteTransfer"
+	Ledger_GetBaseCurrency_FullMethodName          = "/ledger_service.v1.Ledger/GetBaseCurrency"
+	Ledger_SetBaseCurrency_FullMethodName          = "/ledger_service.v1.Ledger/SetBaseCurrency"
+	Ledger_ListExchangeRates_FullMethodName        = "/ledger_service.v1.Ledger/ListExchangeRates"
+	Ledger_UpsertExchangeRates_FullMethodName      = "/ledger_service.v1.Ledger/UpsertExchangeRates"
+	Ledger_ListRecurringRules_FullMethodName       = "/ledger_service.v1.Ledger/ListRecurringRules"
+	Ledger_GetRecurringRule_FullMethodName         = "/ledger_service.v1.Ledger/GetRecurringRule"
+	Ledger_AddRecurringRule_FullMethodName         = "/ledger_service.v1.Ledger/AddRecurringRule"
+	Ledger_PatchRecurringRule_FullMethodName       = "/ledger_service.v1.Ledger/PatchRecurringRule"
+	Ledger_DeleteRecurringRule_FullMethodName      = "/ledger_service.v1.Ledger/DeleteRecurringRule"
 )
 
 // LedgerClient is the client API for Ledger service.
@@ -40,9 +80,13 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LedgerClient interface {
 	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	AddCategory(ctx context.Context, in *AddCategoryRequest, opts ...grpc.CallOption) (*AddCategoryResponse, error)
+	PatchCategory(ctx context.Context, in *PatchCategoryRequest, opts ...grpc.CallOption) (*PatchCategoryResponse, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error)
 	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
 	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
 	AddTransaction(ctx context.Context, in *AddTransactionRequest, opts ...grpc.CallOption) (*AddTransactionResponse, error)
+	AddTransactionsBatch(ctx context.Context, in *AddTransactionsBatchRequest, opts ...grpc.CallOption) (*AddTransactionsBatchResponse, error)
 	PatchTransaction(ctx context.Context, in *PatchTransactionRequest, opts ...grpc.CallOption) (*PatchTransactionResponse, error)
 	DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*DeleteTransactionResponse, error)
 	ListBudgets(ctx context.Context, in *ListBudgetsRequest, opts ...grpc.CallOption) (*ListBudgetsResponse, error)
@@ -50,9 +94,45 @@ type LedgerClient interface {
 	AddBudget(ctx context.Context, in *AddBudgetRequest, opts ...grpc.CallOption) (*AddBudgetResponse, error)
 	PatchBudget(ctx context.Context, in *PatchBudgetRequest, opts ...grpc.CallOption) (*PatchBudgetResponse, error)
 	DeleteBudget(ctx context.Context, in *DeleteBudgetRequest, opts ...grpc.CallOption) (*DeleteBudgetResponse, error)
+	CopyBudgets(ctx context.Context, in *CopyBudgetsRequest, opts ...grpc.CallOption) (*CopyBudgetsResponse, error)
+	GetBudgetAutoRollover(ctx context.Context, in *GetBudgetAutoRolloverRequest, opts ...grpc.CallOption) (*GetBudgetAutoRolloverResponse, error)
+	SetBudgetAutoRollover(ctx context.Context, in *SetBudgetAutoRolloverRequest, opts ...grpc.CallOption) (*SetBudgetAutoRolloverResponse, error)
 	ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error)
 	CSVExportTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*CSVExportTransactionsResponse, error)
+	StreamExportTransactions(ctx context.Context, in *StreamExportTransactionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamExportTransactionsResponse], error)
+	ExportTransactions(ctx context.Context, in *ExportTransactionsRequest, opts ...grpc.CallOption) (*ExportTransactionsResponse, error)
+	ExportReports(ctx context.Context, in *ExportReportsRequest, opts ...grpc.CallOption) (*ExportReportsResponse, error)
 	CSVImportTransactions(ctx context.Context, in *CSVImportTransactionsRequest, opts ...grpc.CallOption) (*CSVImportTransactionsResponse, error)
+	ListWallets(ctx context.Context, in *ListWalletsRequest, opts ...grpc.CallOption) (*ListWalletsResponse, error)
+	GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error)
+	AddWallet(ctx context.Context, in *AddWalletRequest, opts ...grpc.CallOption) (*AddWalletResponse, error)
+	PatchWallet(ctx context.Context, in *PatchWalletRequest, opts ...grpc.CallOption) (*PatchWalletResponse, error)
+	DeleteWallet(ctx context.Context, in *DeleteWalletRequest, opts ...grpc.CallOption) (*DeleteWalletResponse, error)
+	GetWalletBalances(ctx context.Context, in *GetWalletBalancesRequest, opts ...grpc.CallOption) (*GetWalletBalancesResponse, error)
+	ListImportProfiles(ctx context.Context, in *ListImportProfilesRequest, opts ...grpc.CallOption) (*ListImportProfilesResponse, error)
+	GetImportProfile(ctx context.Context, in *GetImportProfileRequest, opts ...grpc.CallOption) (*GetImportProfileResponse, error)
+	AddImportProfile(ctx context.Context, in *AddImportProfileRequest, opts ...grpc.CallOption) (*AddImportProfileResponse, error)
+	PatchImportProfile(ctx context.Context, in *PatchImportProfileRequest, opts ...grpc.CallOption) (*PatchImportProfileResponse, error)
+	DeleteImportProfile(ctx context.Context, in *DeleteImportProfileRequest, opts ...grpc.CallOption) (*DeleteImportProfileResponse, error)
+	ListCategoryRules(ctx context.Context, in *ListCategoryRulesRequest, opts ...grpc.CallOption) (*ListCategoryRulesResponse, error)
+	GetCategoryRule(ctx context.Context, in *GetCategoryRuleRequest, opts ...grpc.CallOption) (*GetCategoryRuleResponse, error)
+	AddCategoryRule(ctx context.Context, in *AddCategoryRuleRequest, opts ...grpc.CallOption) (*AddCategoryRuleResponse, error)
+	PatchCategoryRule(ctx context.Context, in *PatchCategoryRuleRequest, opts ...grpc.CallOption) (*PatchCategoryRuleResponse, error)
+	DeleteCategoryRule(ctx context.Context, in *DeleteCategoryRuleRequest, opts ...grpc.CallOption) (*DeleteCategoryRuleResponse, error)
+	ReapplyRules(ctx context.Context, in *ReapplyRulesRequest, opts ...grpc.CallOption) (*ReapplyRulesResponse, error)
+	GetTransfer(ctx context.Context, in *GetTransferRequest, opts ...grpc.CallOption) (*GetTransferResponse, error)
+	AddTransfer(ctx context.Context, in *AddTransferRequest, opts ...grpc.CallOption) (*AddTransferResponse, error)
+	PatchTransfer(ctx context.Context, in *PatchTransferRequest, opts ...grpc.CallOption) (*PatchTransferResponse, error)
+	DeleteTransfer(ctx context.Context, in *DeleteTransferRequest, opts ...grpc.CallOption) (*DeleteTransferResponse, error)
+	GetBaseCurrency(ctx context.Context, in *GetBaseCurrencyRequest, opts ...grpc.CallOption) (*GetBaseCurrencyResponse, error)
+	SetBaseCurrency(ctx context.Context, in *SetBaseCurrencyRequest, opts ...grpc.CallOption) (*SetBaseCurrencyResponse, error)
+	ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error)
+	UpsertExchangeRates(ctx context.Context, in *UpsertExchangeRatesRequest, opts ...grpc.CallOption) (*UpsertExchangeRatesResponse, error)
+	ListRecurringRules(ctx context.Context, in *ListRecurringRulesRequest, opts ...grpc.CallOption) (*ListRecurringRulesResponse, error)
+	GetRecurringRule(ctx context.Context, in *GetRecurringRuleRequest, opts ...grpc.CallOption) (*GetRecurringRuleResponse, error)
+	AddRecurringRule(ctx context.Context, in *AddRecurringRuleRequest, opts ...grpc.CallOption) (*AddRecurringRuleResponse, error)
+	PatchRecurringRule(ctx context.Context, in *PatchRecurringRuleRequest, opts ...grpc.CallOption) (*PatchRecurringRuleResponse, error)
+	DeleteRecurringRule(ctx context.Context, in *DeleteRecurringRuleRequest, opts ...grpc.CallOption) (*DeleteRecurringRuleResponse, error)
 }
 
 type ledgerClient struct {
@@ -73,6 +153,36 @@ func (c *ledgerClient) ListCategories(ctx context.Context, in *ListCategoriesReq
 	return out, nil
 }
 
+func (c *ledgerClient) AddCategory(ctx context.Context, in *AddCategoryRequest, opts ...grpc.CallOption) (*AddCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCategoryResponse)
+	err := c.cc.Invoke(ctx, Ledger_AddCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) PatchCategory(ctx context.Context, in *PatchCategoryRequest, opts ...grpc.CallOption) (*PatchCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchCategoryResponse)
+	err := c.cc.Invoke(ctx, Ledger_PatchCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryResponse)
+	err := c.cc.Invoke(ctx, Ledger_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ledgerClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListTransactionsResponse)
@@ -103,6 +213,16 @@ func (c *ledgerClient) AddTransaction(ctx context.Context, in *AddTransactionReq
 	return out, nil
 }
 
+func (c *ledgerClient) AddTransactionsBatch(ctx context.Context, in *AddTransactionsBatchRequest, opts ...grpc.CallOption) (*AddTransactionsBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTransactionsBatchResponse)
+	err := c.cc.Invoke(ctx, Ledger_AddTransactionsBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ledgerClient) PatchTransaction(ctx context.Context, in *PatchTransactionRequest, opts ...grpc.CallOption) (*PatchTransactionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PatchTransactionResponse)
@@ -173,6 +293,36 @@ func (c *ledgerClient) DeleteBudget(ctx context.Context, in *DeleteBudgetRequest
 	return out, nil
 }
 
+func (c *ledgerClient) CopyBudgets(ctx context.Context, in *CopyBudgetsRequest, opts ...grpc.CallOption) (*CopyBudgetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CopyBudgetsResponse)
+	err := c.cc.Invoke(ctx, Ledger_CopyBudgets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) GetBudgetAutoRollover(ctx context.Context, in *GetBudgetAutoRolloverRequest, opts ...grpc.CallOption) (*GetBudgetAutoRolloverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBudgetAutoRolloverResponse)
+	err := c.cc.Invoke(ctx, Ledger_GetBudgetAutoRollover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) SetBudgetAutoRollover(ctx context.Context, in *SetBudgetAutoRolloverRequest, opts ...grpc.CallOption) (*SetBudgetAutoRolloverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBudgetAutoRolloverResponse)
+	err := c.cc.Invoke(ctx, Ledger_SetBudgetAutoRollover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ledgerClient) ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListReportsResponse)
@@ -193,6 +343,45 @@ func (c *ledgerClient) CSVExportTransactions(ctx context.Context, in *ListTransa
 	return out, nil
 }
 
+func (c *ledgerClient) StreamExportTransactions(ctx context.Context, in *StreamExportTransactionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamExportTransactionsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Ledger_ServiceDesc.Streams[0], Ledger_StreamExportTransactions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamExportTransactionsRequest, StreamExportTransactionsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ledger_StreamExportTransactionsClient = grpc.ServerStreamingClient[StreamExportTransactionsResponse]
+
+func (c *ledgerClient) ExportTransactions(ctx context.Context, in *ExportTransactionsRequest, opts ...grpc.CallOption) (*ExportTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportTransactionsResponse)
+	err := c.cc.Invoke(ctx, Ledger_ExportTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) ExportReports(ctx context.Context, in *ExportReportsRequest, opts ...grpc.CallOption) (*ExportReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportReportsResponse)
+	err := c.cc.Invoke(ctx, Ledger_ExportReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ledgerClient) CSVImportTransactions(ctx context.Context, in *CSVImportTransactionsRequest, opts ...grpc.CallOption) (*CSVImportTransactionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CSVImportTransactionsResponse)
@@ -203,345 +392,1518 @@ func (c *ledgerClient) CSVImportTransactions(ctx context.Context, in *CSVImportT
 	return out, nil
 }
 
-// LedgerServer is the server API for Ledger service.
-// All implementations must embed UnimplementedLedgerServer
-// for forward compatibility.
-type LedgerServer interface {
-	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
-	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
-	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
-	AddTransaction(context.Context, *AddTransactionRequest) (*AddTransactionResponse, error)
-	PatchTransaction(context.Context, *PatchTransactionRequest) (*PatchTransactionResponse, error)
-	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error)
-	ListBudgets(context.Context, *ListBudgetsRequest) (*ListBudgetsResponse, error)
-	GetBudget(context.Context, *GetBudgetRequest) (*GetBudgetResponse, error)
-	AddBudget(context.Context, *AddBudgetRequest) (*AddBudgetResponse, error)
-	PatchBudget(context.Context, *PatchBudgetRequest) (*PatchBudgetResponse, error)
-	DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetResponse, error)
-	ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error)
-	CSVExportTransactions(context.Context, *ListTransactionsRequest) (*CSVExportTransactionsResponse, error)
-	CSVImportTransactions(context.Context, *CSVImportTransactionsRequest) (*CSVImportTransactionsResponse, error)
-	mustEmbedUnimplementedLedgerServer()
+func (c *ledgerClient) ListWallets(ctx context.Context, in *ListWalletsRequest, opts ...grpc.CallOption) (*ListWalletsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWalletsResponse)
+	err := c.cc.Invoke(ctx, Ledger_ListWallets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedLedgerServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedLedgerServer struct{}
+func (c *ledgerClient) GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletResponse)
+	err := c.cc.Invoke(ctx, Ledger_GetWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedLedgerServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+func (c *ledgerClient) AddWallet(ctx context.Context, in *AddWalletRequest, opts ...grpc.CallOption) (*AddWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddWalletResponse)
+	err := c.cc.Invoke(ctx, Ledger_AddWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedLedgerServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+
+func (c *ledgerClient) PatchWallet(ctx context.Context, in *PatchWalletRequest, opts ...grpc.CallOption) (*PatchWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchWalletResponse)
+	err := c.cc.Invoke(ctx, Ledger_PatchWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedLedgerServer) GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+
+func (c *ledgerClient) DeleteWallet(ctx context.Context, in *DeleteWalletRequest, opts ...grpc.CallOption) (*DeleteWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWalletResponse)
+	err := c.cc.Invoke(ctx, Ledger_DeleteWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedLedgerServer) AddTransaction(context.Context, *AddTransactionRequest) (*AddTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddTransaction not implemented")
+
+func (c *ledgerClient) GetWalletBalances(ctx context.Context, in *GetWalletBalancesRequest, opts ...grpc.CallOption) (*GetWalletBalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletBalancesResponse)
+	err := c.cc.Invoke(ctx, Ledger_GetWalletBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedLedgerServer) PatchTransaction(context.Context, *PatchTransactionRequest) (*PatchTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PatchTransaction not implemented")
+
+func (c *ledgerClient) ListImportProfiles(ctx context.Context, in *ListImportProfilesRequest, opts ...grpc.CallOption) (*ListImportProfilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListImportProfilesResponse)
+	err := c.cc.Invoke(ctx, Ledger_ListImportProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedLedgerServer) DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTransaction not implemented")
+
+func (c *ledgerClient) GetImportProfile(ctx context.Context, in *GetImportProfileRequest, opts ...grpc.CallOption) (*GetImportProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetImportProfileResponse)
+	err := c.cc.Invoke(ctx, Ledger_GetImportProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedLedgerServer) ListBudgets(context.Context, *ListBudgetsRequest) (*ListBudgetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListBudgets not implemented")
+
+func (c *ledgerClient) AddImportProfile(ctx context.Context, in *AddImportProfileRequest, opts ...grpc.CallOption) (*AddImportProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddImportProfileResponse)
+	err := c.cc.Invoke(ctx, Ledger_AddImportProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedLedgerServer) GetBudget(context.Context, *GetBudgetRequest) (*GetBudgetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBudget not implemented")
+
+func (c *ledgerClient) PatchImportProfile(ctx context.Context, in *PatchImportProfileRequest, opts ...grpc.CallOption) (*PatchImportProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchImportProfileResponse)
+	err := c.cc.Invoke(ctx, Ledger_PatchImportProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedLedgerServer) AddBudget(context.Context, *AddBudgetRequest) (*AddBudgetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddBudget not implemented")
+
+func (c *ledgerClient) DeleteImportProfile(ctx context.Context, in *DeleteImportProfileRequest, opts ...grpc.CallOption) (*DeleteImportProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteImportProfileResponse)
+	err := c.cc.Invoke(ctx, Ledger_DeleteImportProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedLedgerServer) PatchBudget(context.Context, *PatchBudgetRequest) (*PatchBudgetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PatchBudget not implemented")
+
+func (c *ledgerClient) ListCategoryRules(ctx context.Context, in *ListCategoryRulesRequest, opts ...grpc.CallOption) (*ListCategoryRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoryRulesResponse)
+	err := c.cc.Invoke(ctx, Ledger_ListCategoryRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) GetCategoryRule(ctx context.Context, in *GetCategoryRuleRequest, opts ...grpc.CallOption) (*GetCategoryRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryRuleResponse)
+	err := c.cc.Invoke(ctx, Ledger_GetCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) AddCategoryRule(ctx context.Context, in *AddCategoryRuleRequest, opts ...grpc.CallOption) (*AddCategoryRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCategoryRuleResponse)
+	err := c.cc.Invoke(ctx, Ledger_AddCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) PatchCategoryRule(ctx context.Context, in *PatchCategoryRuleRequest, opts ...grpc.CallOption) (*PatchCategoryRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchCategoryRuleResponse)
+	err := c.cc.Invoke(ctx, Ledger_PatchCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) DeleteCategoryRule(ctx context.Context, in *DeleteCategoryRuleRequest, opts ...grpc.CallOption) (*DeleteCategoryRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryRuleResponse)
+	err := c.cc.Invoke(ctx, Ledger_DeleteCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) ReapplyRules(ctx context.Context, in *ReapplyRulesRequest, opts ...grpc.CallOption) (*ReapplyRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReapplyRulesResponse)
+	err := c.cc.Invoke(ctx, Ledger_ReapplyRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) GetTransfer(ctx context.Context, in *GetTransferRequest, opts ...grpc.CallOption) (*GetTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransferResponse)
+	err := c.cc.Invoke(ctx, Ledger_GetTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) AddTransfer(ctx context.Context, in *AddTransferRequest, opts ...grpc.CallOption) (*AddTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTransferResponse)
+	err := c.cc.Invoke(ctx, Ledger_AddTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) PatchTransfer(ctx context.Context, in *PatchTransferRequest, opts ...grpc.CallOption) (*PatchTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchTransferResponse)
+	err := c.cc.Invoke(ctx, Ledger_PatchTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) DeleteTransfer(ctx context.Context, in *DeleteTransferRequest, opts ...grpc.CallOption) (*DeleteTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTransferResponse)
+	err := c.cc.Invoke(ctx, Ledger_DeleteTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) GetBaseCurrency(ctx context.Context, in *GetBaseCurrencyRequest, opts ...grpc.CallOption) (*GetBaseCurrencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBaseCurrencyResponse)
+	err := c.cc.Invoke(ctx, Ledger_GetBaseCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) SetBaseCurrency(ctx context.Context, in *SetBaseCurrencyRequest, opts ...grpc.CallOption) (*SetBaseCurrencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBaseCurrencyResponse)
+	err := c.cc.Invoke(ctx, Ledger_SetBaseCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, Ledger_ListExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) UpsertExchangeRates(ctx context.Context, in *UpsertExchangeRatesRequest, opts ...grpc.CallOption) (*UpsertExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, Ledger_UpsertExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) ListRecurringRules(ctx context.Context, in *ListRecurringRulesRequest, opts ...grpc.CallOption) (*ListRecurringRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecurringRulesResponse)
+	err := c.cc.Invoke(ctx, Ledger_ListRecurringRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) GetRecurringRule(ctx context.Context, in *GetRecurringRuleRequest, opts ...grpc.CallOption) (*GetRecurringRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecurringRuleResponse)
+	err := c.cc.Invoke(ctx, Ledger_GetRecurringRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) AddRecurringRule(ctx context.Context, in *AddRecurringRuleRequest, opts ...grpc.CallOption) (*AddRecurringRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddRecurringRuleResponse)
+	err := c.cc.Invoke(ctx, Ledger_AddRecurringRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) PatchRecurringRule(ctx context.Context, in *PatchRecurringRuleRequest, opts ...grpc.CallOption) (*PatchRecurringRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchRecurringRuleResponse)
+	err := c.cc.Invoke(ctx, Ledger_PatchRecurringRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) DeleteRecurringRule(ctx context.Context, in *DeleteRecurringRuleRequest, opts ...grpc.CallOption) (*DeleteRecurringRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRecurringRuleResponse)
+	err := c.cc.Invoke(ctx, Ledger_DeleteRecurringRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LedgerServer is the server API for Ledger service.
+// All implementations must embed UnimplementedLedgerServer
+// for forward compatibility.
+type LedgerServer interface {
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	AddCategory(context.Context, *AddCategoryRequest) (*AddCategoryResponse, error)
+	PatchCategory(context.Context, *PatchCategoryRequest) (*PatchCategoryResponse, error)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
+	AddTransaction(context.Context, *AddTransactionRequest) (*AddTransactionResponse, error)
+	AddTransactionsBatch(context.Context, *AddTransactionsBatchRequest) (*AddTransactionsBatchResponse, error)
+	PatchTransaction(context.Context, *PatchTransactionRequest) (*PatchTransactionResponse, error)
+	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error)
+	ListBudgets(context.Context, *ListBudgetsRequest) (*ListBudgetsResponse, error)
+	GetBudget(context.Context, *GetBudgetRequest) (*GetBudgetResponse, error)
+	AddBudget(context.Context, *AddBudgetRequest) (*AddBudgetResponse, error)
+	PatchBudget(context.Context, *PatchBudgetRequest) (*PatchBudgetResponse, error)
+	DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetResponse, error)
+	CopyBudgets(context.Context, *CopyBudgetsRequest) (*CopyBudgetsResponse, error)
+	GetBudgetAutoRollover(context.Context, *GetBudgetAutoRolloverRequest) (*GetBudgetAutoRolloverResponse, error)
+	SetBudgetAutoRollover(context.Context, *SetBudgetAutoRolloverRequest) (*SetBudgetAutoRolloverResponse, error)
+	ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error)
+	CSVExportTransactions(context.Context, *ListTransactionsRequest) (*CSVExportTransactionsResponse, error)
+	StreamExportTransactions(*StreamExportTransactionsRequest, grpc.ServerStreamingServer[StreamExportTransactionsResponse]) error
+	ExportTransactions(context.Context, *ExportTransactionsRequest) (*ExportTransactionsResponse, error)
+	ExportReports(context.Context, *ExportReportsRequest) (*ExportReportsResponse, error)
+	CSVImportTransactions(context.Context, *CSVImportTransactionsRequest) (*CSVImportTransactionsResponse, error)
+	ListWallets(context.Context, *ListWalletsRequest) (*ListWalletsResponse, error)
+	GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error)
+	AddWallet(context.Context, *AddWalletRequest) (*AddWalletResponse, error)
+	PatchWallet(context.Context, *PatchWalletRequest) (*PatchWalletResponse, error)
+	DeleteWallet(context.Context, *DeleteWalletRequest) (*DeleteWalletResponse, error)
+	GetWalletBalances(context.Context, *GetWalletBalancesRequest) (*GetWalletBalancesResponse, error)
+	ListImportProfiles(context.Context, *ListImportProfilesRequest) (*ListImportProfilesResponse, error)
+	GetImportProfile(context.Context, *GetImportProfileRequest) (*GetImportProfileResponse, error)
+	AddImportProfile(context.Context, *AddImportProfileRequest) (*AddImportProfileResponse, error)
+	PatchImportProfile(context.Context, *PatchImportProfileRequest) (*PatchImportProfileResponse, error)
+	DeleteImportProfile(context.Context, *DeleteImportProfileRequest) (*DeleteImportProfileResponse, error)
+	ListCategoryRules(context.Context, *ListCategoryRulesRequest) (*ListCategoryRulesResponse, error)
+	GetCategoryRule(context.Context, *GetCategoryRuleRequest) (*GetCategoryRuleResponse, error)
+	AddCategoryRule(context.Context, *AddCategoryRuleRequest) (*AddCategoryRuleResponse, error)
+	PatchCategoryRule(context.Context, *PatchCategoryRuleRequest) (*PatchCategoryRuleResponse, error)
+	DeleteCategoryRule(context.Context, *DeleteCategoryRuleRequest) (*DeleteCategoryRuleResponse, error)
+	ReapplyRules(context.Context, *ReapplyRulesRequest) (*ReapplyRulesResponse, error)
+	GetTransfer(context.Context, *GetTransferRequest) (*GetTransferResponse, error)
+	AddTransfer(context.Context, *AddTransferRequest) (*AddTransferResponse, error)
+	PatchTransfer(context.Context, *PatchTransferRequest) (*PatchTransferResponse, error)
+	DeleteTransfer(context.Context, *DeleteTransferRequest) (*DeleteTransferResponse, error)
+	GetBaseCurrency(context.Context, *GetBaseCurrencyRequest) (*GetBaseCurrencyResponse, error)
+	SetBaseCurrency(context.Context, *SetBaseCurrencyRequest) (*SetBaseCurrencyResponse, error)
+	ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error)
+	UpsertExchangeRates(context.Context, *UpsertExchangeRatesRequest) (*UpsertExchangeRatesResponse, error)
+	ListRecurringRules(context.Context, *ListRecurringRulesRequest) (*ListRecurringRulesResponse, error)
+	GetRecurringRule(context.Context, *GetRecurringRuleRequest) (*GetRecurringRuleResponse, error)
+	AddRecurringRule(context.Context, *AddRecurringRuleRequest) (*AddRecurringRuleResponse, error)
+	PatchRecurringRule(context.Context, *PatchRecurringRuleRequest) (*PatchRecurringRuleResponse, error)
+	DeleteRecurringRule(context.Context, *DeleteRecurringRuleRequest) (*DeleteRecurringRuleResponse, error)
+	mustEmbedUnimplementedLedgerServer()
+}
+
+// UnimplementedLedgerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLedgerServer struct{}
+
+func (UnimplementedLedgerServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedLedgerServer) AddCategory(context.Context, *AddCategoryRequest) (*AddCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCategory not implemented")
+}
+func (UnimplementedLedgerServer) PatchCategory(context.Context, *PatchCategoryRequest) (*PatchCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchCategory not implemented")
+}
+func (UnimplementedLedgerServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedLedgerServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedLedgerServer) GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedLedgerServer) AddTransaction(context.Context, *AddTransactionRequest) (*AddTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTransaction not implemented")
+}
+func (UnimplementedLedgerServer) AddTransactionsBatch(context.Context, *AddTransactionsBatchRequest) (*AddTransactionsBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTransactionsBatch not implemented")
+}
+func (UnimplementedLedgerServer) PatchTransaction(context.Context, *PatchTransactionRequest) (*PatchTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchTransaction not implemented")
+}
+func (UnimplementedLedgerServer) DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTransaction not implemented")
+}
+func (UnimplementedLedgerServer) ListBudgets(context.Context, *ListBudgetsRequest) (*ListBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBudgets not implemented")
+}
+func (UnimplementedLedgerServer) GetBudget(context.Context, *GetBudgetRequest) (*GetBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudget not implemented")
+}
+func (UnimplementedLedgerServer) AddBudget(context.Context, *AddBudgetRequest) (*AddBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBudget not implemented")
+}
+func (UnimplementedLedgerServer) PatchBudget(context.Context, *PatchBudgetRequest) (*PatchBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchBudget not implemented")
+}
+func (UnimplementedLedgerServer) DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBudget not implemented")
+}
+func (UnimplementedLedgerServer) CopyBudgets(context.Context, *CopyBudgetsRequest) (*CopyBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyBudgets not implemented")
+}
+func (UnimplementedLedgerServer) GetBudgetAutoRollover(context.Context, *GetBudgetAutoRolloverRequest) (*GetBudgetAutoRolloverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudgetAutoRollover not implemented")
+}
+func (UnimplementedLedgerServer) SetBudgetAutoRollover(context.Context, *SetBudgetAutoRolloverRequest) (*SetBudgetAutoRolloverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBudgetAutoRollover not implemented")
+}
+func (UnimplementedLedgerServer) ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
+}
+func (UnimplementedLedgerServer) CSVExportTransactions(context.Context, *ListTransactionsRequest) (*CSVExportTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CSVExportTransactions not implemented")
+}
+func (UnimplementedLedgerServer) StreamExportTransactions(*StreamExportTransactionsRequest, grpc.ServerStreamingServer[StreamExportTransactionsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamExportTransactions not implemented")
+}
+func (UnimplementedLedgerServer) ExportTransactions(context.Context, *ExportTransactionsRequest) (*ExportTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTransactions not implemented")
+}
+func (UnimplementedLedgerServer) ExportReports(context.Context, *ExportReportsRequest) (*ExportReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportReports not implemented")
+}
+func (UnimplementedLedgerServer) CSVImportTransactions(context.Context, *CSVImportTransactionsRequest) (*CSVImportTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CSVImportTransactions not implemented")
+}
+func (UnimplementedLedgerServer) ListWallets(context.Context, *ListWalletsRequest) (*ListWalletsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWallets not implemented")
+}
+func (UnimplementedLedgerServer) GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWallet not implemented")
+}
+func (UnimplementedLedgerServer) AddWallet(context.Context, *AddWalletRequest) (*AddWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWallet not implemented")
+}
+func (UnimplementedLedgerServer) PatchWallet(context.Context, *PatchWalletRequest) (*PatchWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchWallet not implemented")
+}
+func (UnimplementedLedgerServer) DeleteWallet(context.Context, *DeleteWalletRequest) (*DeleteWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWallet not implemented")
+}
+func (UnimplementedLedgerServer) GetWalletBalances(context.Context, *GetWalletBalancesRequest) (*GetWalletBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWalletBalances not implemented")
+}
+func (UnimplementedLedgerServer) ListImportProfiles(context.Context, *ListImportProfilesRequest) (*ListImportProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListImportProfiles not implemented")
+}
+func (UnimplementedLedgerServer) GetImportProfile(context.Context, *GetImportProfileRequest) (*GetImportProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportProfile not implemented")
+}
+func (UnimplementedLedgerServer) AddImportProfile(context.Context, *AddImportProfileRequest) (*AddImportProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddImportProfile not implemented")
+}
+func (UnimplementedLedgerServer) PatchImportProfile(context.Context, *PatchImportProfileRequest) (*PatchImportProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchImportProfile not implemented")
+}
+func (UnimplementedLedgerServer) DeleteImportProfile(context.Context, *DeleteImportProfileRequest) (*DeleteImportProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteImportProfile not implemented")
+}
+func (UnimplementedLedgerServer) ListCategoryRules(context.Context, *ListCategoryRulesRequest) (*ListCategoryRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategoryRules not implemented")
+}
+func (UnimplementedLedgerServer) GetCategoryRule(context.Context, *GetCategoryRuleRequest) (*GetCategoryRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryRule not implemented")
+}
+func (UnimplementedLedgerServer) AddCategoryRule(context.Context, *AddCategoryRuleRequest) (*AddCategoryRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCategoryRule not implemented")
+}
+func (UnimplementedLedgerServer) PatchCategoryRule(context.Context, *PatchCategoryRuleRequest) (*PatchCategoryRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchCategoryRule not implemented")
+}
+func (UnimplementedLedgerServer) DeleteCategoryRule(context.Context, *DeleteCategoryRuleRequest) (*DeleteCategoryRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryRule not implemented")
+}
+func (UnimplementedLedgerServer) ReapplyRules(context.Context, *ReapplyRulesRequest) (*ReapplyRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReapplyRules not implemented")
+}
+func (UnimplementedLedgerServer) GetTransfer(context.Context, *GetTransferRequest) (*GetTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransfer not implemented")
+}
+func (UnimplementedLedgerServer) AddTransfer(context.Context, *AddTransferRequest) (*AddTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTransfer not implemented")
+}
+func (UnimplementedLedgerServer) PatchTransfer(context.Context, *PatchTransferRequest) (*PatchTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchTransfer not implemented")
+}
+func (UnimplementedLedgerServer) DeleteTransfer(context.Context, *DeleteTransferRequest) (*DeleteTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTransfer not implemented")
+}
+func (UnimplementedLedgerServer) GetBaseCurrency(context.Context, *GetBaseCurrencyRequest) (*GetBaseCurrencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBaseCurrency not implemented")
+}
+func (UnimplementedLedgerServer) SetBaseCurrency(context.Context, *SetBaseCurrencyRequest) (*SetBaseCurrencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBaseCurrency not implemented")
+}
+func (UnimplementedLedgerServer) ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExchangeRates not implemented")
+}
+func (UnimplementedLedgerServer) UpsertExchangeRates(context.Context, *UpsertExchangeRatesRequest) (*UpsertExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertExchangeRates not implemented")
+}
+func (UnimplementedLedgerServer) ListRecurringRules(context.Context, *ListRecurringRulesRequest) (*ListRecurringRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecurringRules not implemented")
+}
+func (UnimplementedLedgerServer) GetRecurringRule(context.Context, *GetRecurringRuleRequest) (*GetRecurringRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecurringRule not implemented")
+}
+func (UnimplementedLedgerServer) AddRecurringRule(context.Context, *AddRecurringRuleRequest) (*AddRecurringRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRecurringRule not implemented")
+}
+func (UnimplementedLedgerServer) PatchRecurringRule(context.Context, *PatchRecurringRuleRequest) (*PatchRecurringRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchRecurringRule not implemented")
+}
+func (UnimplementedLedgerServer) DeleteRecurringRule(context.Context, *DeleteRecurringRuleRequest) (*DeleteRecurringRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecurringRule not implemented")
+}
+func (UnimplementedLedgerServer) mustEmbedUnimplementedLedgerServer() {}
+func (UnimplementedLedgerServer) testEmbeddedByValue()                {}
+
+// UnsafeLedgerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LedgerServer will
+// result in compilation errors.
+type UnsafeLedgerServer interface {
+	mustEmbedUnimplementedLedgerServer()
+}
+
+func RegisterLedgerServer(s grpc.ServiceRegistrar, srv LedgerServer) {
+	// If the following call pancis, it indicates UnimplementedLedgerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Ledger_ServiceDesc, srv)
+}
+
+func _Ledger_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_AddCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).AddCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_AddCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).AddCategory(ctx, req.(*AddCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_PatchCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).PatchCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_PatchCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).PatchCategory(ctx, req.(*PatchCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_ListTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_GetTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_AddTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).AddTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_AddTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).AddTransaction(ctx, req.(*AddTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_AddTransactionsBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTransactionsBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).AddTransactionsBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_AddTransactionsBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).AddTransactionsBatch(ctx, req.(*AddTransactionsBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_PatchTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).PatchTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_PatchTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).PatchTransaction(ctx, req.(*PatchTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_DeleteTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).DeleteTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_DeleteTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).DeleteTransaction(ctx, req.(*DeleteTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_ListBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).ListBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_ListBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).ListBudgets(ctx, req.(*ListBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_GetBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).GetBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_GetBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).GetBudget(ctx, req.(*GetBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_AddBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).AddBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_AddBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).AddBudget(ctx, req.(*AddBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_PatchBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).PatchBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_PatchBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).PatchBudget(ctx, req.(*PatchBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_DeleteBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).DeleteBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_DeleteBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).DeleteBudget(ctx, req.(*DeleteBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_CopyBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).CopyBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_CopyBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).CopyBudgets(ctx, req.(*CopyBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_GetBudgetAutoRollover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetAutoRolloverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).GetBudgetAutoRollover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_GetBudgetAutoRollover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).GetBudgetAutoRollover(ctx, req.(*GetBudgetAutoRolloverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_SetBudgetAutoRollover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBudgetAutoRolloverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).SetBudgetAutoRollover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_SetBudgetAutoRollover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).SetBudgetAutoRollover(ctx, req.(*SetBudgetAutoRolloverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).ListReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_ListReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).ListReports(ctx, req.(*ListReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_CSVExportTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).CSVExportTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_CSVExportTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).CSVExportTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_StreamExportTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamExportTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LedgerServer).StreamExportTransactions(m, &grpc.GenericServerStream[StreamExportTransactionsRequest, StreamExportTransactionsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ledger_StreamExportTransactionsServer = grpc.ServerStreamingServer[StreamExportTransactionsResponse]
+
+func _Ledger_ExportTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).ExportTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_ExportTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).ExportTransactions(ctx, req.(*ExportTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_ExportReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).ExportReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_ExportReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).ExportReports(ctx, req.(*ExportReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_CSVImportTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CSVImportTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).CSVImportTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_CSVImportTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).CSVImportTransactions(ctx, req.(*CSVImportTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_ListWallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWalletsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).ListWallets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_ListWallets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).ListWallets(ctx, req.(*ListWalletsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_GetWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).GetWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_GetWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).GetWallet(ctx, req.(*GetWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_AddWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).AddWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_AddWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).AddWallet(ctx, req.(*AddWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_PatchWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).PatchWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_PatchWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).PatchWallet(ctx, req.(*PatchWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_DeleteWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).DeleteWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_DeleteWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).DeleteWallet(ctx, req.(*DeleteWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_GetWalletBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).GetWalletBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_GetWalletBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).GetWalletBalances(ctx, req.(*GetWalletBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_ListImportProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImportProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).ListImportProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_ListImportProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).ListImportProfiles(ctx, req.(*ListImportProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_GetImportProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).GetImportProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_GetImportProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).GetImportProfile(ctx, req.(*GetImportProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_AddImportProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddImportProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).AddImportProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_AddImportProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).AddImportProfile(ctx, req.(*AddImportProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLedgerServer) DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteBudget not implemented")
+
+func _Ledger_PatchImportProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchImportProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).PatchImportProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_PatchImportProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).PatchImportProfile(ctx, req.(*PatchImportProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLedgerServer) ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
+
+func _Ledger_DeleteImportProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteImportProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).DeleteImportProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_DeleteImportProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).DeleteImportProfile(ctx, req.(*DeleteImportProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLedgerServer) CSVExportTransactions(context.Context, *ListTransactionsRequest) (*CSVExportTransactionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CSVExportTransactions not implemented")
+
+func _Ledger_ListCategoryRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoryRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).ListCategoryRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_ListCategoryRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).ListCategoryRules(ctx, req.(*ListCategoryRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLedgerServer) CSVImportTransactions(context.Context, *CSVImportTransactionsRequest) (*CSVImportTransactionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CSVImportTransactions not implemented")
+
+func _Ledger_GetCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).GetCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_GetCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).GetCategoryRule(ctx, req.(*GetCategoryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLedgerServer) mustEmbedUnimplementedLedgerServer() {}
-func (UnimplementedLedgerServer) testEmbeddedByValue()                {}
 
-// UnsafeLedgerServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to LedgerServer will
-// result in compilation errors.
-type UnsafeLedgerServer interface {
-	mustEmbedUnimplementedLedgerServer()
+func _Ledger_AddCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCategoryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).AddCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_AddCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).AddCategoryRule(ctx, req.(*AddCategoryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterLedgerServer(s grpc.ServiceRegistrar, srv LedgerServer) {
-	// If the following call pancis, it indicates UnimplementedLedgerServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _Ledger_PatchCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchCategoryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&Ledger_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(LedgerServer).PatchCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_PatchCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).PatchCategoryRule(ctx, req.(*PatchCategoryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Ledger_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCategoriesRequest)
+func _Ledger_DeleteCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServer).ListCategories(ctx, in)
+		return srv.(LedgerServer).DeleteCategoryRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ledger_ListCategories_FullMethodName,
+		FullMethod: Ledger_DeleteCategoryRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+		return srv.(LedgerServer).DeleteCategoryRule(ctx, req.(*DeleteCategoryRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ledger_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTransactionsRequest)
+func _Ledger_ReapplyRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReapplyRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServer).ListTransactions(ctx, in)
+		return srv.(LedgerServer).ReapplyRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ledger_ListTransactions_FullMethodName,
+		FullMethod: Ledger_ReapplyRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+		return srv.(LedgerServer).ReapplyRules(ctx, req.(*ReapplyRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ledger_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionRequest)
+func _Ledger_GetTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServer).GetTransaction(ctx, in)
+		return srv.(LedgerServer).GetTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ledger_GetTransaction_FullMethodName,
+		FullMethod: Ledger_GetTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+		return srv.(LedgerServer).GetTransfer(ctx, req.(*GetTransferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ledger_AddTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddTransactionRequest)
+func _Ledger_AddTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTransferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServer).AddTransaction(ctx, in)
+		return srv.(LedgerServer).AddTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ledger_AddTransaction_FullMethodName,
+		FullMethod: Ledger_AddTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServer).AddTransaction(ctx, req.(*AddTransactionRequest))
+		return srv.(LedgerServer).AddTransfer(ctx, req.(*AddTransferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ledger_PatchTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PatchTransactionRequest)
+func _Ledger_PatchTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchTransferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServer).PatchTransaction(ctx, in)
+		return srv.(LedgerServer).PatchTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ledger_PatchTransaction_FullMethodName,
+		FullMethod: Ledger_PatchTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServer).PatchTransaction(ctx, req.(*PatchTransactionRequest))
+		return srv.(LedgerServer).PatchTransfer(ctx, req.(*PatchTransferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ledger_DeleteTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTransactionRequest)
+func _Ledger_DeleteTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServer).DeleteTransaction(ctx, in)
+		return srv.(LedgerServer).DeleteTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ledger_DeleteTransaction_FullMethodName,
+		FullMethod: Ledger_DeleteTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServer).DeleteTransaction(ctx, req.(*DeleteTransactionRequest))
+		return srv.(LedgerServer).DeleteTransfer(ctx, req.(*DeleteTransferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ledger_ListBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBudgetsRequest)
+func _Ledger_GetBaseCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBaseCurrencyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServer).ListBudgets(ctx, in)
+		return srv.(LedgerServer).GetBaseCurrency(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ledger_ListBudgets_FullMethodName,
+		FullMethod: Ledger_GetBaseCurrency_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServer).ListBudgets(ctx, req.(*ListBudgetsRequest))
+		return srv.(LedgerServer).GetBaseCurrency(ctx, req.(*GetBaseCurrencyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ledger_GetBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBudgetRequest)
+func _Ledger_SetBaseCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBaseCurrencyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServer).GetBudget(ctx, in)
+		return srv.(LedgerServer).SetBaseCurrency(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ledger_GetBudget_FullMethodName,
+		FullMethod: Ledger_SetBaseCurrency_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServer).GetBudget(ctx, req.(*GetBudgetRequest))
+		return srv.(LedgerServer).SetBaseCurrency(ctx, req.(*SetBaseCurrencyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ledger_AddBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddBudgetRequest)
+func _Ledger_ListExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExchangeRatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServer).AddBudget(ctx, in)
+		return srv.(LedgerServer).ListExchangeRates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ledger_AddBudget_FullMethodName,
+		FullMethod: Ledger_ListExchangeRates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServer).AddBudget(ctx, req.(*AddBudgetRequest))
+		return srv.(LedgerServer).ListExchangeRates(ctx, req.(*ListExchangeRatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ledger_PatchBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PatchBudgetRequest)
+func _Ledger_UpsertExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertExchangeRatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServer).PatchBudget(ctx, in)
+		return srv.(LedgerServer).UpsertExchangeRates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ledger_PatchBudget_FullMethodName,
+		FullMethod: Ledger_UpsertExchangeRates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServer).PatchBudget(ctx, req.(*PatchBudgetRequest))
+		return srv.(LedgerServer).UpsertExchangeRates(ctx, req.(*UpsertExchangeRatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ledger_DeleteBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteBudgetRequest)
+func _Ledger_ListRecurringRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecurringRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServer).DeleteBudget(ctx, in)
+		return srv.(LedgerServer).ListRecurringRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ledger_DeleteBudget_FullMethodName,
+		FullMethod: Ledger_ListRecurringRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServer).DeleteBudget(ctx, req.(*DeleteBudgetRequest))
+		return srv.(LedgerServer).ListRecurringRules(ctx, req.(*ListRecurringRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ledger_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReportsRequest)
+func _Ledger_GetRecurringRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecurringRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServer).ListReports(ctx, in)
+		return srv.(LedgerServer).GetRecurringRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ledger_ListReports_FullMethodName,
+		FullMethod: Ledger_GetRecurringRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServer).ListReports(ctx, req.(*ListReportsRequest))
+		return srv.(LedgerServer).GetRecurringRule(ctx, req.(*GetRecurringRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ledger_CSVExportTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTransactionsRequest)
+func _Ledger_AddRecurringRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRecurringRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServer).CSVExportTransactions(ctx, in)
+		return srv.(LedgerServer).AddRecurringRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ledger_CSVExportTransactions_FullMethodName,
+		FullMethod: Ledger_AddRecurringRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServer).CSVExportTransactions(ctx, req.(*ListTransactionsRequest))
+		return srv.(LedgerServer).AddRecurringRule(ctx, req.(*AddRecurringRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ledger_CSVImportTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CSVImportTransactionsRequest)
+func _Ledger_PatchRecurringRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchRecurringRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServer).CSVImportTransactions(ctx, in)
+		return srv.(LedgerServer).PatchRecurringRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ledger_CSVImportTransactions_FullMethodName,
+		FullMethod: Ledger_PatchRecurringRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServer).CSVImportTransactions(ctx, req.(*CSVImportTransactionsRequest))
+		return srv.(LedgerServer).PatchRecurringRule(ctx, req.(*PatchRecurringRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_DeleteRecurringRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecurringRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).DeleteRecurringRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ledger_DeleteRecurringRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).DeleteRecurringRule(ctx, req.(*DeleteRecurringRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -557,6 +1919,18 @@ var Ledger_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCategories",
 			Handler:    _Ledger_ListCategories_Handler,
 		},
+		{
+			MethodName: "AddCategory",
+			Handler:    _Ledger_AddCategory_Handler,
+		},
+		{
+			MethodName: "PatchCategory",
+			Handler:    _Ledger_PatchCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _Ledger_DeleteCategory_Handler,
+		},
 		{
 			MethodName: "ListTransactions",
 			Handler:    _Ledger_ListTransactions_Handler,
@@ -569,6 +1943,10 @@ var Ledger_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddTransaction",
 			Handler:    _Ledger_AddTransaction_Handler,
 		},
+		{
+			MethodName: "AddTransactionsBatch",
+			Handler:    _Ledger_AddTransactionsBatch_Handler,
+		},
 		{
 			MethodName: "PatchTransaction",
 			Handler:    _Ledger_PatchTransaction_Handler,
@@ -597,6 +1975,18 @@ var Ledger_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBudget",
 			Handler:    _Ledger_DeleteBudget_Handler,
 		},
+		{
+			MethodName: "CopyBudgets",
+			Handler:    _Ledger_CopyBudgets_Handler,
+		},
+		{
+			MethodName: "GetBudgetAutoRollover",
+			Handler:    _Ledger_GetBudgetAutoRollover_Handler,
+		},
+		{
+			MethodName: "SetBudgetAutoRollover",
+			Handler:    _Ledger_SetBudgetAutoRollover_Handler,
+		},
 		{
 			MethodName: "ListReports",
 			Handler:    _Ledger_ListReports_Handler,
@@ -605,11 +1995,145 @@ var Ledger_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CSVExportTransactions",
 			Handler:    _Ledger_CSVExportTransactions_Handler,
 		},
+		{
+			MethodName: "ExportTransactions",
+			Handler:    _Ledger_ExportTransactions_Handler,
+		},
+		{
+			MethodName: "ExportReports",
+			Handler:    _Ledger_ExportReports_Handler,
+		},
 		{
 			MethodName: "CSVImportTransactions",
 			Handler:    _Ledger_CSVImportTransactions_Handler,
 		},
+		{
+			MethodName: "ListWallets",
+			Handler:    _Ledger_ListWallets_Handler,
+		},
+		{
+			MethodName: "GetWallet",
+			Handler:    _Ledger_GetWallet_Handler,
+		},
+		{
+			MethodName: "AddWallet",
+			Handler:    _Ledger_AddWallet_Handler,
+		},
+		{
+			MethodName: "PatchWallet",
+			Handler:    _Ledger_PatchWallet_Handler,
+		},
+		{
+			MethodName: "DeleteWallet",
+			Handler:    _Ledger_DeleteWallet_Handler,
+		},
+		{
+			MethodName: "GetWalletBalances",
+			Handler:    _Ledger_GetWalletBalances_Handler,
+		},
+		{
+			MethodName: "ListImportProfiles",
+			Handler:    _Ledger_ListImportProfiles_Handler,
+		},
+		{
+			MethodName: "GetImportProfile",
+			Handler:    _Ledger_GetImportProfile_Handler,
+		},
+		{
+			MethodName: "AddImportProfile",
+			Handler:    _Ledger_AddImportProfile_Handler,
+		},
+		{
+			MethodName: "PatchImportProfile",
+			Handler:    _Ledger_PatchImportProfile_Handler,
+		},
+		{
+			MethodName: "DeleteImportProfile",
+			Handler:    _Ledger_DeleteImportProfile_Handler,
+		},
+		{
+			MethodName: "ListCategoryRules",
+			Handler:    _Ledger_ListCategoryRules_Handler,
+		},
+		{
+			MethodName: "GetCategoryRule",
+			Handler:    _Ledger_GetCategoryRule_Handler,
+		},
+		{
+			MethodName: "AddCategoryRule",
+			Handler:    _Ledger_AddCategoryRule_Handler,
+		},
+		{
+			MethodName: "PatchCategoryRule",
+			Handler:    _Ledger_PatchCategoryRule_Handler,
+		},
+		{
+			MethodName: "DeleteCategoryRule",
+			Handler:    _Ledger_DeleteCategoryRule_Handler,
+		},
+		{
+			MethodName: "ReapplyRules",
+			Handler:    _Ledger_ReapplyRules_Handler,
+		},
+		{
+			MethodName: "GetTransfer",
+			Handler:    _Ledger_GetTransfer_Handler,
+		},
+		{
+			MethodName: "AddTransfer",
+			Handler:    _Ledger_AddTransfer_Handler,
+		},
+		{
+			MethodName: "PatchTransfer",
+			Handler:    _Ledger_PatchTransfer_Handler,
+		},
+		{
+			MethodName: "DeleteTransfer",
+			Handler:    _Ledger_DeleteTransfer_Handler,
+		},
+		{
+			MethodName: "GetBaseCurrency",
+			Handler:    _Ledger_GetBaseCurrency_Handler,
+		},
+		{
+			MethodName: "SetBaseCurrency",
+			Handler:    _Ledger_SetBaseCurrency_Handler,
+		},
+		{
+			MethodName: "ListExchangeRates",
+			Handler:    _Ledger_ListExchangeRates_Handler,
+		},
+		{
+			MethodName: "UpsertExchangeRates",
+			Handler:    _Ledger_UpsertExchangeRates_Handler,
+		},
+		{
+			MethodName: "ListRecurringRules",
+			Handler:    _Ledger_ListRecurringRules_Handler,
+		},
+		{
+			MethodName: "GetRecurringRule",
+			Handler:    _Ledger_GetRecurringRule_Handler,
+		},
+		{
+			MethodName: "AddRecurringRule",
+			Handler:    _Ledger_AddRecurringRule_Handler,
+		},
+		{
+			MethodName: "PatchRecurringRule",
+			Handler:    _Ledger_PatchRecurringRule_Handler,
+		},
+		{
+			MethodName: "DeleteRecurringRule",
+			Handler:    _Ledger_DeleteRecurringRule_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamExportTransactions",
+			Handler:       _Ledger_StreamExportTransactions_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "ledger_service/service.proto",
 }